@@ -0,0 +1,8 @@
+module github.com/unidoc/unidoc
+
+go 1.20
+
+require (
+	github.com/boombuler/barcode v1.0.1
+	golang.org/x/image v0.18.0
+)
@@ -616,3 +616,23 @@ func (this *ContentCreator) Add_TJ(vals ...PdfObject) *ContentCreator {
 	this.operands = append(this.operands, &op)
 	return this
 }
+
+/* Type 3 font operators. */
+
+// d0: Set the glyph width of a Type 3 glyph that may specify its color.
+func (this *ContentCreator) Add_d0(wx, wy float64) *ContentCreator {
+	op := ContentStreamOperation{}
+	op.Operand = "d0"
+	op.Params = makeParamsFromFloats([]float64{wx, wy})
+	this.operands = append(this.operands, &op)
+	return this
+}
+
+// d1: Set the glyph width and bounding box of a Type 3 glyph painted with the current color.
+func (this *ContentCreator) Add_d1(wx, wy, llx, lly, urx, ury float64) *ContentCreator {
+	op := ContentStreamOperation{}
+	op.Operand = "d1"
+	op.Params = makeParamsFromFloats([]float64{wx, wy, llx, lly, urx, ury})
+	this.operands = append(this.operands, &op)
+	return this
+}
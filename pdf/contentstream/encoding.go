@@ -69,7 +69,7 @@ func newEncoderFromInlineImage(inlineImage *ContentStreamInlineImage) (core.Stre
 	case "LZW", "LZWDecode":
 		return newLZWEncoderFromInlineImage(inlineImage, nil)
 	case "CCF", "CCITTFaxDecode":
-		return core.NewCCITTFaxEncoder(), nil
+		return newCCITTFaxEncoderFromInlineImage(inlineImage, nil)
 	case "RL", "RunLengthDecode":
 		return core.NewRunLengthEncoder(), nil
 	default:
@@ -154,6 +154,21 @@ func newFlateEncoderFromInlineImage(inlineImage *ContentStreamInlineImage, decod
 	return encoder, nil
 }
 
+// Create a new CCITTFax encoder/decoder from an inline image object, getting the encoding parameters
+// from the DecodeParms entry if not provided.
+func newCCITTFaxEncoderFromInlineImage(inlineImage *ContentStreamInlineImage, decodeParams *core.PdfObjectDictionary) (*core.CCITTFaxEncoder, error) {
+	if decodeParams == nil && inlineImage.DecodeParms != nil {
+		dp, isDict := inlineImage.DecodeParms.(*core.PdfObjectDictionary)
+		if !isDict {
+			common.Log.Debug("Error: DecodeParms not a dictionary (%T)", inlineImage.DecodeParms)
+			return nil, fmt.Errorf("Invalid DecodeParms")
+		}
+		decodeParams = dp
+	}
+
+	return core.NewCCITTFaxEncoderFromDecodeParams(decodeParams)
+}
+
 // Create a new LZW encoder/decoder based on an inline image object, getting all the encoding parameters
 // from the DecodeParms stream object dictionary entry.
 func newLZWEncoderFromInlineImage(inlineImage *ContentStreamInlineImage, decodeParams *core.PdfObjectDictionary) (*core.LZWEncoder, error) {
@@ -378,6 +393,12 @@ func newMultiEncoderFromInlineImage(inlineImage *ContentStreamInlineImage) (*cor
 				return nil, err
 			}
 			mencoder.AddEncoder(encoder)
+		} else if *name == core.StreamEncodingFilterNameCCITTFax || *name == "CCF" {
+			encoder, err := newCCITTFaxEncoderFromInlineImage(inlineImage, dParams)
+			if err != nil {
+				return nil, err
+			}
+			mencoder.AddEncoder(encoder)
 		} else if *name == core.StreamEncodingFilterNameASCIIHex {
 			encoder := core.NewASCIIHexEncoder()
 			mencoder.AddEncoder(encoder)
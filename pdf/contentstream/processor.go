@@ -10,6 +10,7 @@ import (
 
 	"github.com/unidoc/unidoc/common"
 	. "github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
 	. "github.com/unidoc/unidoc/pdf/model"
 )
 
@@ -20,6 +21,7 @@ type GraphicsState struct {
 	ColorspaceNonStroking PdfColorspace
 	ColorStroking         PdfColor
 	ColorNonStroking      PdfColor
+	CTM                   transform.Matrix // Current transformation matrix, set by cm.
 }
 
 type GraphicStateStack []GraphicsState
@@ -200,6 +202,7 @@ func (this *ContentStreamProcessor) Process(resources *PdfPageResources) error {
 	this.graphicsState.ColorspaceNonStroking = NewPdfColorspaceDeviceGray()
 	this.graphicsState.ColorStroking = NewPdfColorDeviceGray(0)
 	this.graphicsState.ColorNonStroking = NewPdfColorDeviceGray(0)
+	this.graphicsState.CTM = transform.IdentityMatrix()
 
 	for _, op := range this.operations {
 		var err error
@@ -209,7 +212,13 @@ func (this *ContentStreamProcessor) Process(resources *PdfPageResources) error {
 		case "q":
 			this.graphicsStack.Push(this.graphicsState)
 		case "Q":
+			if len(this.graphicsStack) == 0 {
+				common.Log.Debug("Q without q")
+				break
+			}
 			this.graphicsState = this.graphicsStack.Pop()
+		case "cm":
+			err = this.handleCommand_cm(op)
 
 		// Color operations (Table 74 p. 179)
 		case "CS":
@@ -543,3 +552,19 @@ func (this *ContentStreamProcessor) handleCommand_k(op *ContentStreamOperation,
 
 	return nil
 }
+
+// handleCommand_cm concatenates the matrix operand of cm to the CTM.
+func (this *ContentStreamProcessor) handleCommand_cm(op *ContentStreamOperation) error {
+	if len(op.Params) != 6 {
+		common.Log.Debug("Invalid number of parameters for cm: %d", len(op.Params))
+		return errors.New("Invalid number of parameters")
+	}
+	params := PdfObjectArray(op.Params)
+	vals, err := params.ToFloat64Array()
+	if err != nil {
+		return err
+	}
+	m := transform.NewMatrix(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5])
+	this.graphicsState.CTM = m.Mult(this.graphicsState.CTM)
+	return nil
+}
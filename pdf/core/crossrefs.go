@@ -25,6 +25,17 @@ const (
 	XREF_OBJECT_STREAM = iota
 )
 
+// XrefType is the type of a cross-reference section: a table or a stream.
+type XrefType int
+
+const (
+	// XrefTypeTable is a cross-reference table, followed by a trailer.
+	XrefTypeTable XrefType = iota
+
+	// XrefTypeStream is a cross-reference stream (PDF 1.5).
+	XrefTypeStream
+)
+
 // XrefObject defines a cross reference entry which is a map between object number (with generation number) and the
 // location of the actual object, either as a file offset (xref table entry), or as a location within an xref
 // stream object (xref object stream).
@@ -49,6 +49,8 @@ type PdfCrypt struct {
 	CryptFilters CryptFilters
 	StreamFilter string
 	StringFilter string
+	// Recipients of the public-key security handler (DER encoded CMS EnvelopedData).
+	Recipients [][]byte
 
 	parser *PdfParser
 
@@ -238,17 +240,20 @@ func (crypt *PdfCrypt) LoadCryptFilters(ed *PdfObjectDictionary) error {
 				return fmt.Errorf("Crypt filter length not multiple of 8 (%d)", *length)
 			}
 
-			// Standard security handler expresses the length in multiples of 8 (16 means 128)
-			// We only deal with standard so far. (Public key not supported yet).
-			if *length < 5 || *length > 16 {
-				if *length == 64 || *length == 128 {
+			// Standard security handler expresses the length in multiples of 8 (16 means 128),
+			// the public-key security handler in bits.
+			n := int(*length)
+			if n < 5 || n > 16 {
+				if crypt.Filter == PubSecFilter && n >= 40 && n <= 256 {
+					n /= 8
+				} else if n == 64 || n == 128 {
 					common.Log.Debug("STANDARD VIOLATION: Crypt Length appears to be in bits rather than bytes - assuming bits (%d)", *length)
-					*length /= 8
-				} else if !(*length == 32 && cf.Cfm == CryptFilterAESV3) {
+					n /= 8
+				} else if !(n == 32 && cf.Cfm == CryptFilterAESV3) {
 					return fmt.Errorf("Crypt filter length not in range 40 - 128 bit (%d)", *length)
 				}
 			}
-			cf.Length = int(*length)
+			cf.Length = n
 		}
 
 		crypt.CryptFilters[string(name)] = cf
@@ -296,7 +301,15 @@ func (crypt *PdfCrypt) SaveCryptFilters(ed *PdfObjectDictionary) error {
 		v.Set("Type", MakeName("CryptFilter"))
 		v.Set("AuthEvent", MakeName("DocOpen"))
 		v.Set("CFM", MakeName(string(filter.Cfm)))
-		v.Set("Length", MakeInteger(int64(filter.Length)))
+		if crypt.Filter == PubSecFilter {
+			v.Set("Length", MakeInteger(int64(filter.Length*8)))
+			if crypt.Subfilter == PubSecS5 {
+				v.Set("Recipients", crypt.recipientsArray())
+				v.Set("EncryptMetadata", MakeBool(crypt.EncryptMetadata))
+			}
+		} else {
+			v.Set("Length", MakeInteger(int64(filter.Length)))
+		}
 	}
 	ed.Set("StrF", MakeName(crypt.StringFilter))
 	ed.Set("StmF", MakeName(crypt.StreamFilter))
@@ -317,14 +330,17 @@ func PdfCryptMakeNew(parser *PdfParser, ed, trailer *PdfObjectDictionary) (PdfCr
 		common.Log.Debug("ERROR Crypt dictionary missing required Filter field!")
 		return crypter, errors.New("Required crypt field Filter missing")
 	}
-	if *filter != "Standard" {
+	if *filter != "Standard" && *filter != PubSecFilter {
 		common.Log.Debug("ERROR Unsupported filter (%s)", *filter)
 		return crypter, errors.New("Unsupported Filter")
 	}
 	crypter.Filter = string(*filter)
 
-	subfilter, ok := ed.Get("SubFilter").(*PdfObjectString)
-	if ok {
+	switch subfilter := ed.Get("SubFilter").(type) {
+	case *PdfObjectString:
+		crypter.Subfilter = string(*subfilter)
+		common.Log.Debug("Using subfilter %s", subfilter)
+	case *PdfObjectName:
 		crypter.Subfilter = string(*subfilter)
 		common.Log.Debug("Using subfilter %s", subfilter)
 	}
@@ -356,6 +372,14 @@ func PdfCryptMakeNew(parser *PdfParser, ed, trailer *PdfObjectDictionary) (PdfCr
 		}
 	}
 
+	if crypter.Filter == PubSecFilter {
+		// The key is derived from the seed enveloped for the recipients.
+		if err := crypter.loadRecipients(ed); err != nil {
+			return crypter, err
+		}
+		return crypter, nil
+	}
+
 	R, ok := ed.Get("R").(*PdfObjectInteger)
 	if !ok {
 		return crypter, errors.New("Encrypt dictionary missing R")
@@ -527,6 +551,9 @@ func (crypt *PdfCrypt) authenticate(password []byte) (bool, error) {
 	// Also build the encryption/decryption key.
 
 	crypt.Authenticated = false
+	if crypt.Filter == PubSecFilter {
+		return false, errors.New("Public-key encryption requires a private key")
+	}
 	if crypt.R >= 5 {
 		authenticated, err := crypt.alg2a(password)
 		if err != nil {
@@ -574,6 +601,14 @@ func (crypt *PdfCrypt) authenticate(password []byte) (bool, error) {
 func (crypt *PdfCrypt) checkAccessRights(password []byte) (bool, AccessPermissions, error) {
 	perms := AccessPermissions{}
 
+	if crypt.Filter == PubSecFilter {
+		// The permissions are those of the recipient authenticated with a private key.
+		if !crypt.Authenticated {
+			return false, perms, nil
+		}
+		return true, crypt.GetAccessPermissions(), nil
+	}
+
 	// Try owner password -> full rights.
 	var (
 		isOwner bool
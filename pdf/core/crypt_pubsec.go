@@ -0,0 +1,231 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/internal/pkcs7"
+)
+
+// PubSecFilter is the name of the public-key security handler (section 7.6.4).
+const PubSecFilter = "Adobe.PubSec"
+
+// Subfilters of the public-key security handler.
+const (
+	PubSecS3 = "adbe.pkcs7.s3" // RC4 with a key of up to 128 bit (V 1 or 2)
+	PubSecS4 = "adbe.pkcs7.s4" // crypt filters with the recipients in the encryption dictionary (V 4)
+	PubSecS5 = "adbe.pkcs7.s5" // crypt filters with the recipients in the crypt filters (V 4 or 5)
+)
+
+// PubSecCryptFilter is the name of the crypt filter used with the public-key security handler.
+const PubSecCryptFilter = "DefaultCryptFilter"
+
+// pubSecSeedLength is the length of the seed enveloped for the recipients.
+const pubSecSeedLength = 20
+
+// PubSecRecipient is a recipient of a document encrypted with the public-key security handler.
+type PubSecRecipient struct {
+	// Certificate of the recipient, with an RSA public key.
+	Certificate *x509.Certificate
+	// Permissions granted to the recipient.
+	Permissions AccessPermissions
+}
+
+// loadRecipients loads the Recipients of the public-key security handler from the encryption
+// dictionary `ed`, or from the crypt filter of the streams for the adbe.pkcs7.s5 subfilter.
+func (crypt *PdfCrypt) loadRecipients(ed *PdfObjectDictionary) error {
+	dict := ed
+	if crypt.Subfilter == PubSecS5 {
+		name := crypt.StreamFilter
+		if name == "Identity" {
+			name = crypt.StringFilter
+		}
+		cf, ok := crypt.resolve(ed.Get("CF")).(*PdfObjectDictionary)
+		if !ok {
+			return errors.New("Invalid CF")
+		}
+		dict, ok = crypt.resolve(cf.Get(PdfObjectName(name))).(*PdfObjectDictionary)
+		if !ok {
+			return fmt.Errorf("Crypt filter %s missing", name)
+		}
+	}
+
+	crypt.EncryptMetadata = true
+	if em, ok := dict.Get("EncryptMetadata").(*PdfObjectBool); ok {
+		crypt.EncryptMetadata = bool(*em)
+	}
+
+	crypt.Recipients = nil
+	switch t := crypt.resolve(dict.Get("Recipients")).(type) {
+	case *PdfObjectArray:
+		for _, obj := range *t {
+			s, ok := crypt.resolve(obj).(*PdfObjectString)
+			if !ok {
+				return fmt.Errorf("Invalid recipient type %T", obj)
+			}
+			crypt.Recipients = append(crypt.Recipients, []byte(*s))
+		}
+	case *PdfObjectString:
+		crypt.Recipients = [][]byte{[]byte(*t)}
+	}
+	if len(crypt.Recipients) == 0 {
+		common.Log.Debug("ERROR Public-key encryption without recipients")
+		return errors.New("Encrypt dictionary missing Recipients")
+	}
+	return nil
+}
+
+// resolve returns the direct object of `obj`, looking up references with the parser.
+func (crypt *PdfCrypt) resolve(obj PdfObject) PdfObject {
+	if ref, isRef := obj.(*PdfObjectReference); isRef && crypt.parser != nil {
+		o, err := crypt.parser.LookupByReference(*ref)
+		if err != nil {
+			common.Log.Debug("ERROR: Failed to look up %s", ref)
+			return nil
+		}
+		obj = o
+	}
+	return TraceToDirectObject(obj)
+}
+
+// recipientsArray returns the Recipients entry of the public-key security handler.
+func (crypt *PdfCrypt) recipientsArray() *PdfObjectArray {
+	arr := PdfObjectArray{}
+	for _, r := range crypt.Recipients {
+		arr = append(arr, MakeString(string(r)))
+	}
+	return &arr
+}
+
+// pubSecKeyLength returns the length of the encryption key in bytes with the public-key security
+// handler.
+func (crypt *PdfCrypt) pubSecKeyLength() int {
+	if crypt.V < 4 {
+		return crypt.Length / 8
+	}
+	cf := crypt.CryptFilters[crypt.StreamFilter]
+	if crypt.StreamFilter == "Identity" {
+		cf = crypt.CryptFilters[crypt.StringFilter]
+	}
+	if cf.Length > 0 {
+		return cf.Length
+	}
+	if cf.Cfm == CryptFilterAESV3 {
+		return 32
+	}
+	return 16
+}
+
+// pubSecKey computes the encryption key from the `seed` and the Recipients.  The digest algorithm
+// is SHA-256 for 256 bit keys and SHA-1 otherwise.
+func (crypt *PdfCrypt) pubSecKey(seed []byte) ([]byte, error) {
+	n := crypt.pubSecKeyLength()
+	var h hash.Hash
+	if n == 32 {
+		h = sha256.New()
+	} else {
+		h = sha1.New()
+	}
+	if n < 5 || n > h.Size() {
+		return nil, fmt.Errorf("Invalid key length (%d)", n)
+	}
+	h.Write(seed)
+	for _, r := range crypt.Recipients {
+		h.Write(r)
+	}
+	if !crypt.EncryptMetadata {
+		h.Write([]byte{0xff, 0xff, 0xff, 0xff})
+	}
+	return h.Sum(nil)[:n], nil
+}
+
+// authenticateKey checks whether the private key `key` of the certificate `cert` belongs to one of
+// the recipients, in which case the encryption key and the permissions of the recipient are set.
+func (crypt *PdfCrypt) authenticateKey(cert *x509.Certificate, key crypto.Decrypter) (bool, error) {
+	crypt.Authenticated = false
+	if crypt.Filter != PubSecFilter {
+		return false, errors.New("Not encrypted with the public-key security handler")
+	}
+
+	for _, r := range crypt.Recipients {
+		content, err := pkcs7.Decrypt(r, cert, key)
+		if err == pkcs7.ErrNotRecipient {
+			continue
+		}
+		if err != nil {
+			common.Log.Debug("ERROR: Failed to decrypt recipient: %v", err)
+			return false, err
+		}
+		if len(content) < pubSecSeedLength+4 {
+			return false, fmt.Errorf("Invalid recipient content length (%d)", len(content))
+		}
+		ekey, err := crypt.pubSecKey(content[:pubSecSeedLength])
+		if err != nil {
+			return false, err
+		}
+		crypt.EncryptionKey = ekey
+		crypt.P = int(int32(binary.BigEndian.Uint32(content[pubSecSeedLength:])))
+		crypt.Authenticated = true
+		return true, nil
+	}
+	return false, nil
+}
+
+// GeneratePubSecParams generates the Recipients and the encryption key of the public-key security
+// handler for `recipients`.  Recipients with the same permissions share a Recipients entry.
+// V, Length, the crypt filters and EncryptMetadata must be set.
+func (crypt *PdfCrypt) GeneratePubSecParams(recipients []PubSecRecipient) error {
+	if len(recipients) == 0 {
+		return errors.New("No recipients")
+	}
+	seed := make([]byte, pubSecSeedLength)
+	if _, err := rand.Read(seed); err != nil {
+		return err
+	}
+
+	var perms []int32
+	certs := map[int32][]*x509.Certificate{}
+	for _, r := range recipients {
+		if r.Certificate == nil {
+			return errors.New("Recipient certificate missing")
+		}
+		P := r.Permissions.GetP()
+		if _, has := certs[P]; !has {
+			perms = append(perms, P)
+		}
+		certs[P] = append(certs[P], r.Certificate)
+	}
+
+	crypt.Recipients = nil
+	for _, P := range perms {
+		content := make([]byte, pubSecSeedLength+4)
+		copy(content, seed)
+		binary.BigEndian.PutUint32(content[pubSecSeedLength:], uint32(P))
+		r, err := pkcs7.Encrypt(content, certs[P])
+		if err != nil {
+			return err
+		}
+		crypt.Recipients = append(crypt.Recipients, r)
+	}
+	crypt.P = int(perms[0])
+
+	ekey, err := crypt.pubSecKey(seed)
+	if err != nil {
+		return err
+	}
+	crypt.EncryptionKey = ekey
+	return nil
+}
@@ -13,9 +13,9 @@ package core
 // - RunLength
 // - ASCII Hex
 // - ASCII85
-// - CCITT Fax (dummy)
-// - JBIG2 (dummy)
-// - JPX (dummy)
+// - CCITT Fax (Group 3 and Group 4)
+// - JBIG2 (decoding only)
+// - JPX (decoding only)
 
 import (
 	"bytes"
@@ -34,6 +34,9 @@ import (
 	lzw1 "golang.org/x/image/tiff/lzw"
 
 	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/internal/ccittfax"
+	"github.com/unidoc/unidoc/pdf/internal/jbig2"
+	"github.com/unidoc/unidoc/pdf/internal/jpx"
 )
 
 const (
@@ -79,7 +82,7 @@ func NewFlateEncoder() *FlateEncoder {
 	// Default (No prediction)
 	encoder.Predictor = 1
 
-	// Currently only supporting 8.
+	// Default for predictors.
 	encoder.BitsPerComponent = 8
 
 	encoder.Colors = 1
@@ -91,8 +94,8 @@ func NewFlateEncoder() *FlateEncoder {
 // Set the predictor function.  Specify the number of columns per row.
 // The columns indicates the number of samples per row.
 // Used for grouping data together for compression.
+// Sets the PNG Sub predictor (11), other predictors can be used by setting the Predictor field.
 func (this *FlateEncoder) SetPredictor(columns int) {
-	// Only supporting PNG sub predictor for encoding.
 	this.Predictor = 11
 	this.Columns = columns
 }
@@ -225,6 +228,7 @@ func newFlateEncoderFromStream(streamObj *PdfObjectStream, decodeParams *PdfObje
 	return encoder, nil
 }
 
+// DecodeBytes decodes the Flate compressed data and reverses the predictor function, if any.
 func (this *FlateEncoder) DecodeBytes(encoded []byte) ([]byte, error) {
 	common.Log.Trace("FlateDecode bytes")
 
@@ -243,198 +247,23 @@ func (this *FlateEncoder) DecodeBytes(encoded []byte) ([]byte, error) {
 	common.Log.Trace("En: % x\n", encoded)
 	common.Log.Trace("De: % x\n", outBuf.Bytes())
 
-	return outBuf.Bytes(), nil
+	common.Log.Trace("Predictor: %d", this.Predictor)
+	return reversePredictor(outBuf.Bytes(), this.Predictor, this.Colors, this.BitsPerComponent, this.Columns)
 }
 
 // Decode a FlateEncoded stream object and give back decoded bytes.
 func (this *FlateEncoder) DecodeStream(streamObj *PdfObjectStream) ([]byte, error) {
-	// TODO: Handle more filter bytes and support more values of BitsPerComponent.
-
 	common.Log.Trace("FlateDecode stream")
-	common.Log.Trace("Predictor: %d", this.Predictor)
-	if this.BitsPerComponent != 8 {
-		return nil, fmt.Errorf("Invalid BitsPerComponent=%d (only 8 supported)", this.BitsPerComponent)
-	}
-
-	outData, err := this.DecodeBytes(streamObj.Stream)
-	if err != nil {
-		return nil, err
-	}
-	common.Log.Trace("En: % x\n", streamObj.Stream)
-	common.Log.Trace("De: % x\n", outData)
-
-	if this.Predictor > 1 {
-		if this.Predictor == 2 { // TIFF encoding: Needs some tests.
-			common.Log.Trace("Tiff encoding")
-			common.Log.Trace("Colors: %d", this.Colors)
-
-			rowLength := int(this.Columns) * this.Colors
-			if rowLength < 1 {
-				// No data. Return empty set.
-				return []byte{}, nil
-			}
-			rows := len(outData) / rowLength
-			if len(outData)%rowLength != 0 {
-				common.Log.Debug("ERROR: TIFF encoding: Invalid row length...")
-				return nil, fmt.Errorf("Invalid row length (%d/%d)", len(outData), rowLength)
-			}
-			if rowLength%this.Colors != 0 {
-				return nil, fmt.Errorf("Invalid row length (%d) for colors %d", rowLength, this.Colors)
-			}
-			if rowLength > len(outData) {
-				common.Log.Debug("Row length cannot be longer than data length (%d/%d)", rowLength, len(outData))
-				return nil, errors.New("Range check error")
-			}
-			common.Log.Trace("inp outData (%d): % x", len(outData), outData)
-
-			pOutBuffer := bytes.NewBuffer(nil)
-
-			// 0-255  -255 255 ; 0-255=-255;
-			for i := 0; i < rows; i++ {
-				rowData := outData[rowLength*i : rowLength*(i+1)]
-				// Predicts the same as the sample to the left.
-				// Interleaved by colors.
-				for j := this.Colors; j < rowLength; j++ {
-					rowData[j] = byte(int(rowData[j]+rowData[j-this.Colors]) % 256)
-				}
-				pOutBuffer.Write(rowData)
-			}
-			pOutData := pOutBuffer.Bytes()
-			common.Log.Trace("POutData (%d): % x", len(pOutData), pOutData)
-			return pOutData, nil
-		} else if this.Predictor >= 10 && this.Predictor <= 15 {
-			common.Log.Trace("PNG Encoding")
-			// Columns represents the number of samples per row; Each sample can contain multiple color
-			// components.
-			rowLength := int(this.Columns*this.Colors + 1) // 1 byte to specify predictor algorithms per row.
-			rows := len(outData) / rowLength
-			if len(outData)%rowLength != 0 {
-				return nil, fmt.Errorf("Invalid row length (%d/%d)", len(outData), rowLength)
-			}
-			if rowLength > len(outData) {
-				common.Log.Debug("Row length cannot be longer than data length (%d/%d)", rowLength, len(outData))
-				return nil, errors.New("Range check error")
-			}
-
-			pOutBuffer := bytes.NewBuffer(nil)
-
-			common.Log.Trace("Predictor columns: %d", this.Columns)
-			common.Log.Trace("Length: %d / %d = %d rows", len(outData), rowLength, rows)
-			prevRowData := make([]byte, rowLength)
-			for i := 0; i < rowLength; i++ {
-				prevRowData[i] = 0
-			}
-
-			for i := 0; i < rows; i++ {
-				rowData := outData[rowLength*i : rowLength*(i+1)]
-
-				fb := rowData[0]
-				switch fb {
-				case 0:
-					// No prediction. (No operation).
-				case 1:
-					// Sub: Predicts the same as the sample to the left.
-					for j := 2; j < rowLength; j++ {
-						rowData[j] = byte(int(rowData[j]+rowData[j-1]) % 256)
-					}
-				case 2:
-					// Up: Predicts the same as the sample above
-					for j := 1; j < rowLength; j++ {
-						rowData[j] = byte(int(rowData[j]+prevRowData[j]) % 256)
-					}
-				case 3:
-					// Avg: Predicts the same as the average of the sample to the left and above.
-					for j := 1; j < rowLength; j++ {
-						if j == 1 {
-							rowData[j] = byte(int(rowData[j]+prevRowData[j]) % 256)
-						} else {
-							avg := (rowData[j-1] + prevRowData[j]) / 2
-							rowData[j] = byte(int(rowData[j]+avg) % 256)
-						}
-					}
-				case 4:
-					// Paeth: a nonlinear function of the sample above, the sample to the left and the sample
-					// to the upper left.
-					for j := 2; j < rowLength; j++ {
-						a := rowData[j-1]     // left
-						b := prevRowData[j]   // above
-						c := prevRowData[j-1] // upper left
-
-						p := int(a + b - c)
-						pa := absInt(p - int(a))
-						pb := absInt(p - int(b))
-						pc := absInt(p - int(c))
-
-						if pa <= pb && pa <= pc {
-							// Use a (left).
-							rowData[j] = byte(int(rowData[j]+a) % 256)
-						} else if pb <= pc {
-							// Use b (upper).
-							rowData[j] = byte(int(rowData[j]+b) % 256)
-						} else {
-							// Use c (upper left).
-							rowData[j] = byte(int(rowData[j]+c) % 256)
-						}
-					}
-
-				default:
-					common.Log.Debug("ERROR: Invalid filter byte (%d) @row %d", fb, i)
-					return nil, fmt.Errorf("Invalid filter byte (%d)", fb)
-				}
-
-				for i := 0; i < rowLength; i++ {
-					prevRowData[i] = rowData[i]
-				}
-				pOutBuffer.Write(rowData[1:])
-			}
-			pOutData := pOutBuffer.Bytes()
-			return pOutData, nil
-		} else {
-			common.Log.Debug("ERROR: Unsupported predictor (%d)", this.Predictor)
-			return nil, fmt.Errorf("Unsupported predictor (%d)", this.Predictor)
-		}
-	}
-
-	return outData, nil
+	return this.DecodeBytes(streamObj.Stream)
 }
 
 // Encode a bytes array and return the encoded value based on the encoder parameters.
+// The predictor function, if any, is applied to the data before compressing it.
 func (this *FlateEncoder) EncodeBytes(data []byte) ([]byte, error) {
-	if this.Predictor != 1 && this.Predictor != 11 {
-		common.Log.Debug("Encoding error: FlateEncoder Predictor = 1, 11 only supported")
-		return nil, ErrUnsupportedEncodingParameters
-	}
-
-	if this.Predictor == 11 {
-		// The length of each output row in number of samples.
-		// N.B. Each output row has one extra sample as compared to the input to indicate the
-		// predictor type.
-		rowLength := int(this.Columns)
-		rows := len(data) / rowLength
-		if len(data)%rowLength != 0 {
-			common.Log.Error("Invalid column length")
-			return nil, errors.New("Invalid row length")
-		}
-
-		pOutBuffer := bytes.NewBuffer(nil)
-
-		tmpData := make([]byte, rowLength)
-
-		for i := 0; i < rows; i++ {
-			rowData := data[rowLength*i : rowLength*(i+1)]
-
-			// PNG SUB method.
-			// Sub: Predicts the same as the sample to the left.
-			tmpData[0] = rowData[0]
-			for j := 1; j < rowLength; j++ {
-				tmpData[j] = byte(int(rowData[j]-rowData[j-1]) % 256)
-			}
-
-			pOutBuffer.WriteByte(1) // sub method
-			pOutBuffer.Write(tmpData)
-		}
-
-		data = pOutBuffer.Bytes()
+	data, err := applyPredictor(data, this.Predictor, this.Colors, this.BitsPerComponent, this.Columns)
+	if err != nil {
+		common.Log.Debug("Encoding error: %v", err)
+		return nil, err
 	}
 
 	var b bytes.Buffer
@@ -463,7 +292,7 @@ func NewLZWEncoder() *LZWEncoder {
 	// Default (No prediction)
 	encoder.Predictor = 1
 
-	// Currently only supporting 8.
+	// Default for predictors.
 	encoder.BitsPerComponent = 8
 
 	encoder.Colors = 1
@@ -623,6 +452,7 @@ func newLZWEncoderFromStream(streamObj *PdfObjectStream, decodeParams *PdfObject
 	return encoder, nil
 }
 
+// DecodeBytes decodes the LZW compressed data and reverses the predictor function, if any.
 func (this *LZWEncoder) DecodeBytes(encoded []byte) ([]byte, error) {
 	var outBuf bytes.Buffer
 	bufReader := bytes.NewReader(encoded)
@@ -642,147 +472,34 @@ func (this *LZWEncoder) DecodeBytes(encoded []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	return outBuf.Bytes(), nil
+	common.Log.Trace(" IN: (%d) % x", len(encoded), encoded)
+	common.Log.Trace("OUT: (%d) % x", outBuf.Len(), outBuf.Bytes())
+
+	common.Log.Trace("Predictor: %d", this.Predictor)
+	return reversePredictor(outBuf.Bytes(), this.Predictor, this.Colors, this.BitsPerComponent, this.Columns)
 }
 
 func (this *LZWEncoder) DecodeStream(streamObj *PdfObjectStream) ([]byte, error) {
-	// Revamp this support to handle TIFF predictor (2).
-	// Also handle more filter bytes and check
-	// BitsPerComponent.  Default value is 8, currently we are only
-	// supporting that one.
-
 	common.Log.Trace("LZW Decoding")
-	common.Log.Trace("Predictor: %d", this.Predictor)
-
-	outData, err := this.DecodeBytes(streamObj.Stream)
-	if err != nil {
-		return nil, err
-	}
-
-	common.Log.Trace(" IN: (%d) % x", len(streamObj.Stream), streamObj.Stream)
-	common.Log.Trace("OUT: (%d) % x", len(outData), outData)
-
-	if this.Predictor > 1 {
-		if this.Predictor == 2 { // TIFF encoding: Needs some tests.
-			common.Log.Trace("Tiff encoding")
-
-			rowLength := int(this.Columns) * this.Colors
-			if rowLength < 1 {
-				// No data. Return empty set.
-				return []byte{}, nil
-			}
-
-			rows := len(outData) / rowLength
-			if len(outData)%rowLength != 0 {
-				common.Log.Debug("ERROR: TIFF encoding: Invalid row length...")
-				return nil, fmt.Errorf("Invalid row length (%d/%d)", len(outData), rowLength)
-			}
-
-			if rowLength%this.Colors != 0 {
-				return nil, fmt.Errorf("Invalid row length (%d) for colors %d", rowLength, this.Colors)
-			}
-
-			if rowLength > len(outData) {
-				common.Log.Debug("Row length cannot be longer than data length (%d/%d)", rowLength, len(outData))
-				return nil, errors.New("Range check error")
-			}
-			common.Log.Trace("inp outData (%d): % x", len(outData), outData)
-
-			pOutBuffer := bytes.NewBuffer(nil)
-
-			// 0-255  -255 255 ; 0-255=-255;
-			for i := 0; i < rows; i++ {
-				rowData := outData[rowLength*i : rowLength*(i+1)]
-				// Predicts the same as the sample to the left.
-				// Interleaved by colors.
-				for j := this.Colors; j < rowLength; j++ {
-					rowData[j] = byte(int(rowData[j]+rowData[j-this.Colors]) % 256)
-				}
-				// GH: Appears that this is not working as expected...
-
-				pOutBuffer.Write(rowData)
-			}
-			pOutData := pOutBuffer.Bytes()
-			common.Log.Trace("POutData (%d): % x", len(pOutData), pOutData)
-			return pOutData, nil
-		} else if this.Predictor >= 10 && this.Predictor <= 15 {
-			common.Log.Trace("PNG Encoding")
-			// Columns represents the number of samples per row; Each sample can contain multiple color
-			// components.
-			rowLength := int(this.Columns*this.Colors + 1) // 1 byte to specify predictor algorithms per row.
-			if rowLength < 1 {
-				// No data. Return empty set.
-				return []byte{}, nil
-			}
-			rows := len(outData) / rowLength
-			if len(outData)%rowLength != 0 {
-				return nil, fmt.Errorf("Invalid row length (%d/%d)", len(outData), rowLength)
-			}
-			if rowLength > len(outData) {
-				common.Log.Debug("Row length cannot be longer than data length (%d/%d)", rowLength, len(outData))
-				return nil, errors.New("Range check error")
-			}
-
-			pOutBuffer := bytes.NewBuffer(nil)
-
-			common.Log.Trace("Predictor columns: %d", this.Columns)
-			common.Log.Trace("Length: %d / %d = %d rows", len(outData), rowLength, rows)
-			prevRowData := make([]byte, rowLength)
-			for i := 0; i < rowLength; i++ {
-				prevRowData[i] = 0
-			}
-
-			for i := 0; i < rows; i++ {
-				rowData := outData[rowLength*i : rowLength*(i+1)]
-
-				fb := rowData[0]
-				switch fb {
-				case 0:
-					// No prediction. (No operation).
-				case 1:
-					// Sub: Predicts the same as the sample to the left.
-					for j := 2; j < rowLength; j++ {
-						rowData[j] = byte(int(rowData[j]+rowData[j-1]) % 256)
-					}
-				case 2:
-					// Up: Predicts the same as the sample above
-					for j := 1; j < rowLength; j++ {
-						rowData[j] = byte(int(rowData[j]+prevRowData[j]) % 256)
-					}
-				default:
-					common.Log.Debug("ERROR: Invalid filter byte (%d)", fb)
-					return nil, fmt.Errorf("Invalid filter byte (%d)", fb)
-				}
-
-				for i := 0; i < rowLength; i++ {
-					prevRowData[i] = rowData[i]
-				}
-				pOutBuffer.Write(rowData[1:])
-			}
-			pOutData := pOutBuffer.Bytes()
-			return pOutData, nil
-		} else {
-			common.Log.Debug("ERROR: Unsupported predictor (%d)", this.Predictor)
-			return nil, fmt.Errorf("Unsupported predictor (%d)", this.Predictor)
-		}
-	}
-
-	return outData, nil
+	return this.DecodeBytes(streamObj.Stream)
 }
 
-// Support for encoding LZW.  Currently not supporting predictors (raw compressed data only).
-// Only supports the Early change = 1 algorithm (compress/lzw) as the other implementation
+// Support for encoding LZW.  The predictor function, if any, is applied to the data before
+// compressing it.
+// Only supports the Early change = 0 algorithm (compress/lzw) as the other implementation
 // does not have a write method.
 // TODO: Consider refactoring compress/lzw to allow both.
 func (this *LZWEncoder) EncodeBytes(data []byte) ([]byte, error) {
-	if this.Predictor != 1 {
-		return nil, fmt.Errorf("LZW Predictor = 1 only supported yet")
-	}
-
 	if this.EarlyChange == 1 {
 		return nil, fmt.Errorf("LZW Early Change = 0 only supported yet")
 	}
 
+	data, err := applyPredictor(data, this.Predictor, this.Colors, this.BitsPerComponent, this.Columns)
+	if err != nil {
+		common.Log.Debug("Encoding error: %v", err)
+		return nil, err
+	}
+
 	var b bytes.Buffer
 	w := lzw0.NewWriter(&b, lzw0.MSB, 8)
 	w.Write(data)
@@ -1525,86 +1242,416 @@ func (this *RawEncoder) EncodeBytes(data []byte) ([]byte, error) {
 }
 
 //
-// CCITTFax encoder/decoder (dummy, for now)
+// CCITTFax encoder/decoder.
 //
-type CCITTFaxEncoder struct{}
-
+type CCITTFaxEncoder struct {
+	// K selects the encoding scheme: < 0 for pure two-dimensional encoding (Group 4), 0 for
+	// one-dimensional encoding (Group 3, 1-D), > 0 for mixed one- and two-dimensional encoding
+	// (Group 3, 2-D).
+	K int
+	// Width of the image in pixels.
+	Columns int
+	// Height of the image in pixels.  If 0, determined by the encoded data.
+	Rows int
+	// Indicates whether each encoded line begins on a byte boundary.
+	EncodedByteAlign bool
+	// Indicates whether 1 bits represent black pixels.
+	BlackIs1 bool
+	// Indicates whether end-of-line bit patterns are present.
+	EndOfLine bool
+	// Indicates whether the data is terminated by an end-of-block pattern.
+	EndOfBlock bool
+	// Number of damaged rows tolerated when decoding.
+	DamagedRowsBeforeError int
+}
+
+// NewCCITTFaxEncoder makes a new CCITTFax encoder with Group 4 (pure two-dimensional) encoding, and
+// otherwise the default parameters (1728 columns).  Set K to 0 or a positive value to encode with
+// Group 3.
 func NewCCITTFaxEncoder() *CCITTFaxEncoder {
-	return &CCITTFaxEncoder{}
+	encoder := newDefaultCCITTFaxEncoder()
+	encoder.K = -1
+	return encoder
+}
+
+// newDefaultCCITTFaxEncoder makes a new CCITTFax encoder with the default parameters of DecodeParms
+// dictionaries (Group 3 one-dimensional encoding of 1728 columns), which apply to the parameters
+// missing from them.
+func newDefaultCCITTFaxEncoder() *CCITTFaxEncoder {
+	params := ccittfax.DefaultParams()
+	return &CCITTFaxEncoder{
+		K:          params.K,
+		Columns:    params.Columns,
+		EndOfBlock: params.EndOfBlock,
+	}
+}
+
+// Create a new CCITTFax encoder/decoder from a stream object, getting all the encoding parameters
+// from the DecodeParms stream object dictionary entry.
+func newCCITTFaxEncoderFromStream(streamObj *PdfObjectStream, decodeParams *PdfObjectDictionary) (*CCITTFaxEncoder, error) {
+	encoder := newDefaultCCITTFaxEncoder()
+
+	encDict := streamObj.PdfObjectDictionary
+	if encDict == nil {
+		// No encoding dictionary.
+		return encoder, nil
+	}
+
+	// If decodeParams not provided, see if we can get from the stream.
+	if decodeParams == nil {
+		obj := TraceToDirectObject(encDict.Get("DecodeParms"))
+		if obj != nil {
+			if arr, isArr := obj.(*PdfObjectArray); isArr {
+				if len(*arr) != 1 {
+					common.Log.Debug("Error: DecodeParms array length != 1 (%d)", len(*arr))
+					return nil, errors.New("Range check error")
+				}
+				obj = TraceToDirectObject((*arr)[0])
+			}
+
+			dp, isDict := obj.(*PdfObjectDictionary)
+			if !isDict {
+				common.Log.Debug("Error: DecodeParms not a dictionary (%T)", obj)
+				return nil, fmt.Errorf("Invalid DecodeParms")
+			}
+			decodeParams = dp
+		}
+	}
+	if decodeParams == nil {
+		// No decode params, use defaults.
+		return encoder, nil
+	}
+
+	return encoder, encoder.setDecodeParams(decodeParams)
+}
+
+// NewCCITTFaxEncoderFromDecodeParams makes a new CCITTFax encoder with the parameters specified
+// by the DecodeParms dictionary `decodeParams` (default parameters if nil).
+func NewCCITTFaxEncoderFromDecodeParams(decodeParams *PdfObjectDictionary) (*CCITTFaxEncoder, error) {
+	encoder := newDefaultCCITTFaxEncoder()
+	if decodeParams == nil {
+		return encoder, nil
+	}
+	return encoder, encoder.setDecodeParams(decodeParams)
+}
+
+// setDecodeParams loads the encoding parameters from the DecodeParms dictionary `decodeParams`.
+func (this *CCITTFaxEncoder) setDecodeParams(decodeParams *PdfObjectDictionary) error {
+	common.Log.Trace("decode params: %s", decodeParams.String())
+
+	intParams := []struct {
+		key string
+		val *int
+	}{
+		{"K", &this.K},
+		{"Columns", &this.Columns},
+		{"Rows", &this.Rows},
+		{"DamagedRowsBeforeError", &this.DamagedRowsBeforeError},
+	}
+	for _, p := range intParams {
+		obj := TraceToDirectObject(decodeParams.Get(PdfObjectName(p.key)))
+		if obj == nil {
+			continue
+		}
+		val, ok := obj.(*PdfObjectInteger)
+		if !ok {
+			common.Log.Debug("ERROR: CCITTFax %s not an integer (%T)", p.key, obj)
+			return fmt.Errorf("Invalid %s", p.key)
+		}
+		*p.val = int(*val)
+	}
+
+	boolParams := []struct {
+		key string
+		val *bool
+	}{
+		{"EncodedByteAlign", &this.EncodedByteAlign},
+		{"BlackIs1", &this.BlackIs1},
+		{"EndOfLine", &this.EndOfLine},
+		{"EndOfBlock", &this.EndOfBlock},
+	}
+	for _, p := range boolParams {
+		obj := TraceToDirectObject(decodeParams.Get(PdfObjectName(p.key)))
+		if obj == nil {
+			continue
+		}
+		val, ok := obj.(*PdfObjectBool)
+		if !ok {
+			common.Log.Debug("ERROR: CCITTFax %s not a boolean (%T)", p.key, obj)
+			return fmt.Errorf("Invalid %s", p.key)
+		}
+		*p.val = bool(*val)
+	}
+
+	if this.Columns <= 0 {
+		common.Log.Debug("ERROR: CCITTFax invalid Columns (%d)", this.Columns)
+		return errors.New("Range check error")
+	}
+	return nil
 }
 
 func (this *CCITTFaxEncoder) GetFilterName() string {
 	return StreamEncodingFilterNameCCITTFax
 }
 
+// MakeDecodeParams makes a new DecodeParms dictionary with the non-default encoding parameters.
 func (this *CCITTFaxEncoder) MakeDecodeParams() PdfObject {
-	return nil
+	def := ccittfax.DefaultParams()
+
+	decodeParams := MakeDict()
+	if this.K != def.K {
+		decodeParams.Set("K", MakeInteger(int64(this.K)))
+	}
+	if this.Columns != def.Columns {
+		decodeParams.Set("Columns", MakeInteger(int64(this.Columns)))
+	}
+	if this.Rows != def.Rows {
+		decodeParams.Set("Rows", MakeInteger(int64(this.Rows)))
+	}
+	if this.EncodedByteAlign != def.EncodedByteAlign {
+		decodeParams.Set("EncodedByteAlign", MakeBool(this.EncodedByteAlign))
+	}
+	if this.BlackIs1 != def.BlackIs1 {
+		decodeParams.Set("BlackIs1", MakeBool(this.BlackIs1))
+	}
+	if this.EndOfLine != def.EndOfLine {
+		decodeParams.Set("EndOfLine", MakeBool(this.EndOfLine))
+	}
+	if this.EndOfBlock != def.EndOfBlock {
+		decodeParams.Set("EndOfBlock", MakeBool(this.EndOfBlock))
+	}
+	if this.DamagedRowsBeforeError != def.DamagedRowsBeforeError {
+		decodeParams.Set("DamagedRowsBeforeError", MakeInteger(int64(this.DamagedRowsBeforeError)))
+	}
+
+	if len(decodeParams.Keys()) == 0 {
+		return nil
+	}
+	return decodeParams
 }
 
 // Make a new instance of an encoding dictionary for a stream object.
+// Has the Filter set and the DecodeParms.
 func (this *CCITTFaxEncoder) MakeStreamDict() *PdfObjectDictionary {
-	return MakeDict()
+	dict := MakeDict()
+	dict.Set("Filter", MakeName(this.GetFilterName()))
+
+	decodeParams := this.MakeDecodeParams()
+	if decodeParams != nil {
+		dict.Set("DecodeParms", decodeParams)
+	}
+
+	return dict
 }
 
+// params returns the codec parameters.
+func (this *CCITTFaxEncoder) params() ccittfax.Params {
+	return ccittfax.Params{
+		K:                      this.K,
+		Columns:                this.Columns,
+		Rows:                   this.Rows,
+		EncodedByteAlign:       this.EncodedByteAlign,
+		BlackIs1:               this.BlackIs1,
+		EndOfLine:              this.EndOfLine,
+		EndOfBlock:             this.EndOfBlock,
+		DamagedRowsBeforeError: this.DamagedRowsBeforeError,
+	}
+}
+
+// DecodeBytes decodes CCITT Group 3 or Group 4 encoded data.  The decoded data has 1 bit per pixel,
+// with each row padded to a byte boundary.
 func (this *CCITTFaxEncoder) DecodeBytes(encoded []byte) ([]byte, error) {
-	common.Log.Debug("Error: Attempting to use unsupported encoding %s", this.GetFilterName())
-	return encoded, ErrNoCCITTFaxDecode
+	decoded, err := ccittfax.Decode(encoded, this.params())
+	if err != nil {
+		common.Log.Debug("CCITTFax decoding error: %v", err)
+		return nil, err
+	}
+	return decoded, nil
 }
 
+// Decode a CCITTFax encoded stream object and give back decoded bytes.
 func (this *CCITTFaxEncoder) DecodeStream(streamObj *PdfObjectStream) ([]byte, error) {
-	common.Log.Debug("Error: Attempting to use unsupported encoding %s", this.GetFilterName())
-	return streamObj.Stream, ErrNoCCITTFaxDecode
+	return this.DecodeBytes(streamObj.Stream)
 }
 
+// EncodeBytes encodes 1 bit per pixel image data (each row padded to a byte boundary) with the
+// encoder parameters.  The number of rows is determined from the data length and Columns.
 func (this *CCITTFaxEncoder) EncodeBytes(data []byte) ([]byte, error) {
-	common.Log.Debug("Error: Attempting to use unsupported encoding %s", this.GetFilterName())
-	return data, ErrNoCCITTFaxDecode
+	encoded, err := ccittfax.Encode(data, this.params())
+	if err != nil {
+		common.Log.Debug("CCITTFax encoding error: %v", err)
+		return nil, err
+	}
+	return encoded, nil
 }
 
 //
-// JBIG2 encoder/decoder (dummy, for now)
+// JBIG2 encoder/decoder (decoding only)
 //
-type JBIG2Encoder struct{}
+type JBIG2Encoder struct {
+	// Globals holds the decoded data of the JBIG2Globals stream: segments shared between images
+	// (optional).
+	Globals []byte
+
+	// JBIG2Globals stream object, kept for writing out the DecodeParms.
+	globalsObj PdfObject
+}
 
 func NewJBIG2Encoder() *JBIG2Encoder {
 	return &JBIG2Encoder{}
 }
 
+// Create a new JBIG2 decoder from a stream object, loading the global segments referred to by the
+// JBIG2Globals entry of the DecodeParms.
+func newJBIG2EncoderFromStream(streamObj *PdfObjectStream, decodeParams *PdfObjectDictionary) (*JBIG2Encoder, error) {
+	encoder := NewJBIG2Encoder()
+
+	encDict := streamObj.PdfObjectDictionary
+	if encDict == nil {
+		// No encoding dictionary.
+		return encoder, nil
+	}
+
+	// If decodeParams not provided, see if we can get from the stream.
+	if decodeParams == nil {
+		obj := TraceToDirectObject(encDict.Get("DecodeParms"))
+		if arr, isArr := obj.(*PdfObjectArray); isArr && len(*arr) == 1 {
+			obj = TraceToDirectObject((*arr)[0])
+		}
+		if dp, isDict := obj.(*PdfObjectDictionary); isDict {
+			decodeParams = dp
+		}
+	}
+	if decodeParams == nil {
+		return encoder, nil
+	}
+
+	globalsObj := decodeParams.Get("JBIG2Globals")
+	if globalsObj == nil {
+		return encoder, nil
+	}
+	globalsStream, ok := TraceToDirectObject(globalsObj).(*PdfObjectStream)
+	if !ok {
+		common.Log.Debug("ERROR: JBIG2Globals not a stream (%T)", globalsObj)
+		return nil, errors.New("Invalid JBIG2Globals")
+	}
+	globals, err := DecodeStream(globalsStream)
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to decode JBIG2Globals: %v", err)
+		return nil, err
+	}
+	encoder.Globals = globals
+	encoder.globalsObj = globalsObj
+
+	return encoder, nil
+}
+
 func (this *JBIG2Encoder) GetFilterName() string {
 	return StreamEncodingFilterNameJBIG2
 }
 
+// MakeDecodeParams makes a new DecodeParms dictionary referring to the JBIG2Globals stream, if any.
 func (this *JBIG2Encoder) MakeDecodeParams() PdfObject {
-	return nil
+	if this.globalsObj == nil {
+		return nil
+	}
+	decodeParams := MakeDict()
+	decodeParams.Set("JBIG2Globals", this.globalsObj)
+	return decodeParams
 }
 
 // Make a new instance of an encoding dictionary for a stream object.
 func (this *JBIG2Encoder) MakeStreamDict() *PdfObjectDictionary {
-	return MakeDict()
+	dict := MakeDict()
+	dict.Set("Filter", MakeName(this.GetFilterName()))
+
+	decodeParams := this.MakeDecodeParams()
+	if decodeParams != nil {
+		dict.Set("DecodeParms", decodeParams)
+	}
+
+	return dict
 }
 
+// DecodeBytes decodes JBIG2 embedded stream data.  The decoded data has 1 bit per pixel, with each
+// row padded to a byte boundary and 0 bits representing black pixels.
 func (this *JBIG2Encoder) DecodeBytes(encoded []byte) ([]byte, error) {
-	common.Log.Debug("Error: Attempting to use unsupported encoding %s", this.GetFilterName())
-	return encoded, ErrNoJBIG2Decode
+	img, err := jbig2.Decode(encoded, this.Globals)
+	if err != nil {
+		common.Log.Debug("JBIG2 decoding error: %v", err)
+		return nil, err
+	}
+	return img.Data, nil
 }
 
+// Decode a JBIG2 encoded stream object and give back decoded bytes.
 func (this *JBIG2Encoder) DecodeStream(streamObj *PdfObjectStream) ([]byte, error) {
-	common.Log.Debug("Error: Attempting to use unsupported encoding %s", this.GetFilterName())
-	return streamObj.Stream, ErrNoJBIG2Decode
+	return this.DecodeBytes(streamObj.Stream)
 }
 
+// EncodeBytes is not supported, JBIG2 encoding is not implemented.
 func (this *JBIG2Encoder) EncodeBytes(data []byte) ([]byte, error) {
 	common.Log.Debug("Error: Attempting to use unsupported encoding %s", this.GetFilterName())
 	return data, ErrNoJBIG2Decode
 }
 
 //
-// JPX encoder/decoder (dummy, for now)
+// JPX (JPEG 2000) encoder/decoder (decoding only)
 //
-type JPXEncoder struct{}
+type JPXEncoder struct {
+	ColorComponents  int // Number of colour components, excluding the alpha channel.
+	BitsPerComponent int // Always 8 for the decoded data.
+	Width            int
+	Height           int
+
+	// SMaskInData specifies whether the alpha channel embedded in the image data is used as a
+	// soft mask: 0 (ignored), 1 (used) or 2 (used, with the colours premultiplied by alpha).
+	SMaskInData int
+}
 
 func NewJPXEncoder() *JPXEncoder {
-	return &JPXEncoder{}
+	encoder := &JPXEncoder{}
+	encoder.BitsPerComponent = 8
+	return encoder
+}
+
+// Create a new JPX decoder from a stream object, getting the image parameters from the stream
+// dictionary and the image data itself.  If the data was encoded with other filters before JPX,
+// `multiEnc` is used for decoding it first.
+func newJPXEncoderFromStream(streamObj *PdfObjectStream, multiEnc *MultiEncoder) (*JPXEncoder, error) {
+	encoder := NewJPXEncoder()
+
+	encDict := streamObj.PdfObjectDictionary
+	if encDict == nil {
+		// No encoding dictionary.
+		return encoder, nil
+	}
+
+	if obj, ok := TraceToDirectObject(encDict.Get("SMaskInData")).(*PdfObjectInteger); ok {
+		encoder.SMaskInData = int(*obj)
+	}
+
+	encoded := streamObj.Stream
+	if multiEnc != nil {
+		e, err := multiEnc.DecodeBytes(encoded)
+		if err != nil {
+			return nil, err
+		}
+		encoded = e
+	}
+
+	cfg, err := jpx.DecodeConfig(encoded)
+	if err != nil {
+		// The image can still be handled if it is not decoded.
+		common.Log.Debug("ERROR: Unable to read JPX image parameters: %v", err)
+		return encoder, nil
+	}
+	encoder.ColorComponents = cfg.ColorComponents()
+	encoder.Width = cfg.Width
+	encoder.Height = cfg.Height
+	common.Log.Trace("JPX Encoder: %+v", encoder)
+
+	return encoder, nil
 }
 
 func (this *JPXEncoder) GetFilterName() string {
@@ -1612,24 +1659,41 @@ func (this *JPXEncoder) GetFilterName() string {
 }
 
 func (this *JPXEncoder) MakeDecodeParams() PdfObject {
+	// Does not have decode params.
 	return nil
 }
 
 // Make a new instance of an encoding dictionary for a stream object.
 func (this *JPXEncoder) MakeStreamDict() *PdfObjectDictionary {
-	return MakeDict()
+	dict := MakeDict()
+	dict.Set("Filter", MakeName(this.GetFilterName()))
+	return dict
 }
 
+// DecodeBytes decodes JPEG 2000 image data.  The decoded data has 8 bits per component, with the
+// components of each pixel interleaved.  The alpha channel, if any, is not included.
 func (this *JPXEncoder) DecodeBytes(encoded []byte) ([]byte, error) {
-	common.Log.Debug("Error: Attempting to use unsupported encoding %s", this.GetFilterName())
-	return encoded, ErrNoJPXDecode
+	data, _, err := this.DecodeBytesWithAlpha(encoded)
+	return data, err
+}
+
+// DecodeBytesWithAlpha decodes JPEG 2000 image data as DecodeBytes does, and also returns the alpha
+// channel embedded in the data with 8 bits per sample (nil if there is none).
+func (this *JPXEncoder) DecodeBytesWithAlpha(encoded []byte) ([]byte, []byte, error) {
+	img, err := jpx.Decode(encoded)
+	if err != nil {
+		common.Log.Debug("JPX decoding error: %v", err)
+		return nil, nil, err
+	}
+	return img.Data, img.Alpha, nil
 }
 
+// Decode a JPX encoded stream object and give back decoded bytes.
 func (this *JPXEncoder) DecodeStream(streamObj *PdfObjectStream) ([]byte, error) {
-	common.Log.Debug("Error: Attempting to use unsupported encoding %s", this.GetFilterName())
-	return streamObj.Stream, ErrNoJPXDecode
+	return this.DecodeBytes(streamObj.Stream)
 }
 
+// EncodeBytes is not supported, JPX encoding is not implemented.
 func (this *JPXEncoder) EncodeBytes(data []byte) ([]byte, error) {
 	common.Log.Debug("Error: Attempting to use unsupported encoding %s", this.GetFilterName())
 	return data, ErrNoJPXDecode
@@ -1742,6 +1806,24 @@ func newMultiEncoderFromStream(streamObj *PdfObjectStream) (*MultiEncoder, error
 		} else if *name == StreamEncodingFilterNameASCII85 {
 			encoder := NewASCII85Encoder()
 			mencoder.AddEncoder(encoder)
+		} else if *name == StreamEncodingFilterNameCCITTFax {
+			encoder, err := newCCITTFaxEncoderFromStream(streamObj, dParams)
+			if err != nil {
+				return nil, err
+			}
+			mencoder.AddEncoder(encoder)
+		} else if *name == StreamEncodingFilterNameJBIG2 {
+			encoder, err := newJBIG2EncoderFromStream(streamObj, dParams)
+			if err != nil {
+				return nil, err
+			}
+			mencoder.AddEncoder(encoder)
+		} else if *name == StreamEncodingFilterNameJPX {
+			encoder, err := newJPXEncoderFromStream(streamObj, mencoder)
+			if err != nil {
+				return nil, err
+			}
+			mencoder.AddEncoder(encoder)
 		} else if *name == StreamEncodingFilterNameDCT {
 			encoder, err := newDCTEncoderFromStream(streamObj, mencoder)
 			if err != nil {
@@ -7,9 +7,12 @@ package core
 
 import (
 	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	"testing"
 
 	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/internal/ccittfax"
 )
 
 func init() {
@@ -71,6 +74,78 @@ func TestLZWEncoding(t *testing.T) {
 	}
 }
 
+// Test the TIFF and PNG predictors with Flate and LZW encoding, for all values of BitsPerComponent.
+func TestPredictorEncoding(t *testing.T) {
+	for _, predictor := range []int{2, 10, 11, 12, 13, 14, 15} {
+		for _, bpc := range []int{1, 2, 4, 8, 16} {
+			for _, colors := range []int{1, 3} {
+				columns := 7
+				rowLength := (columns*colors*bpc + 7) / 8
+				rawStream := make([]byte, 5*rowLength)
+				for i := range rawStream {
+					rawStream[i] = byte(i*i/7 + i%5*40)
+				}
+				// Padding bits at the end of rows are 0.
+				if pad := uint(rowLength*8 - columns*colors*bpc); pad > 0 {
+					for i := rowLength - 1; i < len(rawStream); i += rowLength {
+						rawStream[i] &^= 1<<pad - 1
+					}
+				}
+
+				flate := NewFlateEncoder()
+				lzw := NewLZWEncoder()
+				lzw.EarlyChange = 0
+				flate.Predictor, flate.BitsPerComponent, flate.Colors, flate.Columns = predictor, bpc, colors, columns
+				lzw.Predictor, lzw.BitsPerComponent, lzw.Colors, lzw.Columns = predictor, bpc, colors, columns
+
+				for _, encoder := range []StreamEncoder{flate, lzw} {
+					name := fmt.Sprintf("%s predictor=%d bpc=%d colors=%d", encoder.GetFilterName(), predictor,
+						bpc, colors)
+					encoded, err := encoder.EncodeBytes(rawStream)
+					if err != nil {
+						t.Fatalf("%s: Failed to encode data: %v", name, err)
+					}
+
+					// Decode with the parameters written in the stream dictionary.
+					stream := &PdfObjectStream{}
+					stream.PdfObjectDictionary = encoder.MakeStreamDict()
+					stream.Stream = encoded
+					decoded, err := DecodeStream(stream)
+					if err != nil {
+						t.Fatalf("%s: Failed to decode stream: %v", name, err)
+					}
+					if !compareSlices(decoded, rawStream) {
+						t.Errorf("%s: Slices not matching", name)
+						t.Errorf("Decoded (%d): % x", len(decoded), decoded)
+						t.Errorf("Raw     (%d): % x", len(rawStream), rawStream)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Test the PNG filter types chosen by the adaptive PNG predictor (15).
+func TestPNGPredictorAdaptive(t *testing.T) {
+	rawStream := []byte{
+		10, 20, 30, 40, 50, 60, // Increasing: Sub.
+		10, 20, 30, 40, 50, 60, // Same as above: Up.
+		0, 0, 0, 0, 0, 0, // Zeros: None.
+	}
+	encoded, err := pngPredictorEncode(rawStream, 15, 1, 8, 6)
+	if err != nil {
+		t.Fatalf("Failed to encode data: %v", err)
+	}
+	expected := []byte{
+		1, 10, 10, 10, 10, 10, 10,
+		2, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0,
+	}
+	if !compareSlices(encoded, expected) {
+		t.Errorf("Encoded data not matching: % x", encoded)
+	}
+}
+
 // Test run length encoding.
 func TestRunLengthEncoding(t *testing.T) {
 	rawStream := []byte("this is a dummy text with some \x01\x02\x03 binary data")
@@ -245,3 +320,175 @@ func TestMultiEncoder(t *testing.T) {
 		return
 	}
 }
+
+// Test CCITTFax Group 4 encoding and decoding via the stream dictionary parameters.
+func TestCCITTFaxEncoding(t *testing.T) {
+	// 16x4 image, 1 bit per pixel (0 is black).
+	rawStream := []byte{
+		0xFF, 0xFF,
+		0xF0, 0x0F,
+		0x00, 0xF0,
+		0xAA, 0x55,
+	}
+
+	encoder := NewCCITTFaxEncoder()
+	if encoder.K >= 0 {
+		t.Errorf("Default encoding not Group 4 (K %d)", encoder.K)
+	}
+	encoder.Columns = 16
+	encoder.Rows = 4
+
+	encoded, err := encoder.EncodeBytes(rawStream)
+	if err != nil {
+		t.Fatalf("Failed to encode data: %v", err)
+	}
+
+	stream := &PdfObjectStream{}
+	stream.PdfObjectDictionary = encoder.MakeStreamDict()
+	stream.Stream = encoded
+
+	decoded, err := DecodeStream(stream)
+	if err != nil {
+		t.Fatalf("Failed to decode stream: %v", err)
+	}
+
+	if !compareSlices(decoded, rawStream) {
+		t.Errorf("Slices not matching")
+		t.Errorf("Decoded (%d): % x", len(decoded), decoded)
+		t.Errorf("Raw     (%d): % x", len(rawStream), rawStream)
+	}
+
+	// Streams without K are decoded with Group 3 one-dimensional encoding.
+	dp := MakeDict()
+	dp.Set("Columns", MakeInteger(16))
+	decoder, err := NewCCITTFaxEncoderFromDecodeParams(dp)
+	if err != nil {
+		t.Fatalf("Failed to load DecodeParms: %v", err)
+	}
+	if decoder.K != 0 {
+		t.Errorf("Wrong default K %d of DecodeParms", decoder.K)
+	}
+}
+
+// Test JBIG2 decoding of an MMR coded generic region, with an (empty) symbol dictionary in the
+// globals stream.
+func TestJBIG2Decoding(t *testing.T) {
+	// 16x4 image, 1 bit per pixel (0 is black).
+	rawStream := []byte{
+		0xFF, 0xFF,
+		0xF0, 0x0F,
+		0x00, 0xF0,
+		0xAA, 0x55,
+	}
+
+	// JBIG2 uses 1 for black.
+	inverted := make([]byte, len(rawStream))
+	for i, b := range rawStream {
+		inverted[i] = ^b
+	}
+	mmr, err := ccittfax.Encode(inverted, ccittfax.Params{K: -1, Columns: 16, BlackIs1: true})
+	if err != nil {
+		t.Fatalf("Failed to encode data: %v", err)
+	}
+
+	segment := func(number, kind, page byte, data []byte) []byte {
+		header := []byte{0, 0, 0, number, kind, 0, page, 0, 0, 0, byte(len(data))}
+		return append(header, data...)
+	}
+	pageInfo := []byte{0, 0, 0, 16, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	region := []byte{0, 0, 0, 16, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+	region = append(region, mmr...)
+
+	var data []byte
+	data = append(data, segment(1, 48, 1, pageInfo)...)
+	data = append(data, segment(2, 39, 1, region)...)
+	data = append(data, segment(3, 49, 1, nil)...)
+
+	// Symbol dictionary without symbols.
+	dict := []byte{0, 0, 3, 0xFF, 0xFD, 0xFF, 2, 0xFE, 0xFE, 0xFE, 0, 0, 0, 0, 0, 0, 0, 0}
+	globals := &PdfObjectStream{PdfObjectDictionary: MakeDict(), Stream: segment(0, 0, 0, dict)}
+
+	decodeParams := MakeDict()
+	decodeParams.Set("JBIG2Globals", globals)
+	stream := &PdfObjectStream{PdfObjectDictionary: MakeDict(), Stream: data}
+	stream.Set("Filter", MakeName(StreamEncodingFilterNameJBIG2))
+	stream.Set("DecodeParms", decodeParams)
+
+	encoder, err := NewEncoderFromStream(stream)
+	if err != nil {
+		t.Fatalf("Failed to create encoder: %v", err)
+	}
+	jbig2Encoder, ok := encoder.(*JBIG2Encoder)
+	if !ok {
+		t.Fatalf("Not a JBIG2 encoder: %T", encoder)
+	}
+	if len(jbig2Encoder.Globals) != len(globals.Stream) {
+		t.Errorf("Globals not loaded")
+	}
+
+	decoded, err := DecodeStream(stream)
+	if err != nil {
+		t.Fatalf("Failed to decode stream: %v", err)
+	}
+	if !compareSlices(decoded, rawStream) {
+		t.Errorf("Slices not matching")
+		t.Errorf("Decoded (%d): % x", len(decoded), decoded)
+		t.Errorf("Raw     (%d): % x", len(rawStream), rawStream)
+	}
+}
+
+// Test JPX decoding of a JP2 file with an sRGB colour space and an alpha channel.
+func TestJPXDecoding(t *testing.T) {
+	// 4x2 image, 8 bits per component.
+	rawStream := []byte{
+		0, 52, 88, 0, 47, 83, 7, 41, 79, 19, 35, 71,
+		0, 53, 91, 0, 48, 86, 8, 44, 80, 233, 219, 181,
+	}
+	alpha := []byte{125, 120, 116, 108, 128, 123, 117, 144}
+
+	data, err := hex.DecodeString("0000000c6a5020200d0a870a00000014667479706a703220000000006a703220" +
+		"0000002d6a703268000000166968647200000002000000040004070700000000000f636f6c720100000000" +
+		"0010000000c46a703263ff4fff51003200000000000400000002000000000000000000000004000000020000" +
+		"0000000000000004070101070101070101070101ff52000c00000001000102020001ff5c00074050585860" +
+		"ff90000a00000000006d0001ff93c7e00605fdbfc3ed0309233fc1f50180091e7fc07c80800c4bc1f681c0fa" +
+		"80a0fc00c00b0b510b3e0b3cbfc1f68140fa80a0fc00c00b650b2d0b69bdc0fa80e03e70703ed0300b6c7f0a" +
+		"fb7f0b3e97c03e40500f901407d4040b8f0ad00b7cffd9")
+	if err != nil {
+		t.Fatalf("Invalid test data: %v", err)
+	}
+
+	stream := &PdfObjectStream{PdfObjectDictionary: MakeDict(), Stream: data}
+	stream.Set("Filter", MakeName(StreamEncodingFilterNameJPX))
+	stream.Set("SMaskInData", MakeInteger(1))
+
+	encoder, err := NewEncoderFromStream(stream)
+	if err != nil {
+		t.Fatalf("Failed to create encoder: %v", err)
+	}
+	jpxEncoder, ok := encoder.(*JPXEncoder)
+	if !ok {
+		t.Fatalf("Not a JPX encoder: %T", encoder)
+	}
+	if jpxEncoder.ColorComponents != 3 || jpxEncoder.Width != 4 || jpxEncoder.Height != 2 ||
+		jpxEncoder.SMaskInData != 1 {
+		t.Errorf("Wrong encoder parameters: %+v", *jpxEncoder)
+	}
+
+	decoded, err := DecodeStream(stream)
+	if err != nil {
+		t.Fatalf("Failed to decode stream: %v", err)
+	}
+	if !compareSlices(decoded, rawStream) {
+		t.Errorf("Slices not matching")
+		t.Errorf("Decoded (%d): % x", len(decoded), decoded)
+		t.Errorf("Raw     (%d): % x", len(rawStream), rawStream)
+	}
+
+	_, decodedAlpha, err := jpxEncoder.DecodeBytesWithAlpha(data)
+	if err != nil {
+		t.Fatalf("Failed to decode data: %v", err)
+	}
+	if !compareSlices(decodedAlpha, alpha) {
+		t.Errorf("Alpha channel not matching: % x", decodedAlpha)
+	}
+}
@@ -0,0 +1,513 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+// Linearized files (Annex F): the linearization parameter dictionary and the hint tables of the
+// primary hint stream.
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// LinearizationParams are the entries of the linearization parameter dictionary of a linearized
+// file (section F.2).
+type LinearizationParams struct {
+	FileLength      int64 // L: Length of the file in bytes.
+	HintOffset      int64 // H: Offset of the primary hint stream.
+	HintLength      int64 // H: Length of the primary hint stream.
+	FirstPageObject int64 // O: Object number of the first page's page object.
+	FirstPageEnd    int64 // E: Offset of the end of the first page section.
+	NumPages        int64 // N: Number of pages.
+	MainXrefOffset  int64 // T: Offset of the first entry of the main cross-reference table.
+}
+
+// Maximum offset of the linearization parameter dictionary, which has to be the first object of
+// the file.
+const linearizationSearchLength = 1024
+
+// GetLinearizationParams returns the linearization parameters of the file, or nil if the file is
+// not linearized, i.e. its first object is not a linearization parameter dictionary.
+func (parser *PdfParser) GetLinearizationParams() (*LinearizationParams, error) {
+	if _, err := parser.rs.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, linearizationSearchLength)
+	n, err := io.ReadFull(parser.rs, buf)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	loc := reIndirectObject.FindIndex(buf[:n])
+	if loc == nil {
+		return nil, nil
+	}
+
+	parser.rs.Seek(int64(loc[0]), io.SeekStart)
+	parser.reader = bufio.NewReader(parser.rs)
+	obj, err := parser.ParseIndirectObject()
+	if err != nil {
+		common.Log.Debug("ERROR: Failed to parse first object (%s)", err)
+		return nil, nil
+	}
+	io, isIndirect := obj.(*PdfIndirectObject)
+	if !isIndirect {
+		return nil, nil
+	}
+	dict, isDict := io.PdfObject.(*PdfObjectDictionary)
+	if !isDict || dict.Get("Linearized") == nil {
+		return nil, nil
+	}
+
+	getInt := func(key PdfObjectName) (int64, error) {
+		val, ok := dict.Get(key).(*PdfObjectInteger)
+		if !ok {
+			common.Log.Debug("ERROR: Invalid linearization parameter %s: %v", key, dict.Get(key))
+			return 0, fmt.Errorf("Invalid linearization parameter %s", key)
+		}
+		return int64(*val), nil
+	}
+	params := &LinearizationParams{}
+	for _, p := range []struct {
+		key PdfObjectName
+		val *int64
+	}{
+		{"L", &params.FileLength},
+		{"O", &params.FirstPageObject},
+		{"E", &params.FirstPageEnd},
+		{"N", &params.NumPages},
+		{"T", &params.MainXrefOffset},
+	} {
+		if *p.val, err = getInt(p.key); err != nil {
+			return nil, err
+		}
+	}
+	hint, ok := dict.Get("H").(*PdfObjectArray)
+	if !ok || len(*hint) < 2 {
+		common.Log.Debug("ERROR: Invalid linearization parameter H: %v", dict.Get("H"))
+		return nil, errors.New("Invalid linearization parameter H")
+	}
+	vals, err := hint.ToIntegerArray()
+	if err != nil {
+		return nil, err
+	}
+	params.HintOffset, params.HintLength = int64(vals[0]), int64(vals[1])
+	return params, nil
+}
+
+// GetLinearizationHints loads the hint tables of the primary hint stream of a linearized file with
+// parameters `params`.
+func (parser *PdfParser) GetLinearizationHints(params *LinearizationParams) (*LinearizationHints, error) {
+	objNum := -1
+	for num, xref := range parser.xrefs {
+		if xref.xtype == XREF_TABLE_ENTRY && xref.offset == params.HintOffset {
+			objNum = num
+			break
+		}
+	}
+	if objNum < 0 {
+		common.Log.Debug("ERROR: No object at hint stream offset %d", params.HintOffset)
+		return nil, errors.New("Hint stream not found")
+	}
+	obj, err := parser.LookupByNumber(objNum)
+	if err != nil {
+		return nil, err
+	}
+	stream, ok := obj.(*PdfObjectStream)
+	if !ok {
+		common.Log.Debug("ERROR: Hint stream not a stream (%T)", obj)
+		return nil, errors.New("Hint stream not a stream")
+	}
+	sharedOffset, ok := stream.Get("S").(*PdfObjectInteger)
+	if !ok {
+		common.Log.Debug("ERROR: Hint stream without shared object hint table offset")
+		return nil, errors.New("Invalid hint stream")
+	}
+	data, err := DecodeStream(stream)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLinearizationHints(data, int(*sharedOffset), int(params.NumPages))
+}
+
+// GetObjectOffset returns the offset of object `objNum` from the cross-reference table, if it is
+// not compressed in an object stream.
+func (parser *PdfParser) GetObjectOffset(objNum int) (int64, bool) {
+	xref, ok := parser.xrefs[objNum]
+	if !ok || xref.xtype != XREF_TABLE_ENTRY {
+		return 0, false
+	}
+	return xref.offset, true
+}
+
+// LinearizationHints are the page offset and shared object hint tables of the primary hint stream
+// (section F.4).  The offsets are computed as if the primary hint stream were not present in the
+// file.  The bit widths of the table items are derived from the values.
+type LinearizationHints struct {
+	// Page offset hint table.
+	FirstPageOffset int64 // Offset of the first page's page object.
+	Denominator     int64 // Denominator of the fractional positions of shared object references.
+	Pages           []PageOffsetHint
+
+	// Shared object hint table.  The entries of the first page are followed by those of the shared
+	// objects section.
+	FirstSharedObject  int64 // Object number of the first object of the shared objects section.
+	FirstSharedOffset  int64 // Offset of the first object of the shared objects section.
+	NumFirstPageShared int64 // Number of entries for objects of the first page section.
+	SharedObjectGroups []SharedObjectGroupHint
+}
+
+// PageOffsetHint is a per-page entry of the page offset hint table.
+type PageOffsetHint struct {
+	NumObjects    int64   // Number of objects of the page.
+	PageLength    int64   // Length of the page in bytes.
+	SharedObjects []int64 // Identifiers of the shared objects referenced by the page.
+	Numerators    []int64 // Numerators of the fractional positions of the shared object references.
+	ContentOffset int64   // Offset of the content stream relative to the start of the page.
+	ContentLength int64   // Length of the content stream.
+}
+
+// SharedObjectGroupHint is an entry of the shared object hint table.
+type SharedObjectGroupHint struct {
+	Length     int64  // Length of the group in bytes.
+	Signature  []byte // MD5 signature of the group, optional.
+	NumObjects int64  // Number of objects in the group.
+}
+
+// Encode encodes the hint tables as the data of a hint stream.  Returns the data and the offset of
+// the shared object hint table in it, the value of the S entry of the hint stream.
+func (hints *LinearizationHints) Encode() ([]byte, int) {
+	w := &hintWriter{}
+	pages := hints.Pages
+
+	minMax := func(get func(p *PageOffsetHint) int64) (int64, int64) {
+		var least, greatest int64
+		for i := range pages {
+			v := get(&pages[i])
+			if i == 0 || v < least {
+				least = v
+			}
+			if i == 0 || v > greatest {
+				greatest = v
+			}
+		}
+		return least, greatest
+	}
+	leastObjects, mostObjects := minMax(func(p *PageOffsetHint) int64 { return p.NumObjects })
+	leastLength, mostLength := minMax(func(p *PageOffsetHint) int64 { return p.PageLength })
+	leastContentOffset, mostContentOffset := minMax(func(p *PageOffsetHint) int64 { return p.ContentOffset })
+	leastContentLength, mostContentLength := minMax(func(p *PageOffsetHint) int64 { return p.ContentLength })
+	var mostShared, mostId, mostNumerator int64
+	for _, p := range pages {
+		if n := int64(len(p.SharedObjects)); n > mostShared {
+			mostShared = n
+		}
+		for i, id := range p.SharedObjects {
+			if id > mostId {
+				mostId = id
+			}
+			if i < len(p.Numerators) && p.Numerators[i] > mostNumerator {
+				mostNumerator = p.Numerators[i]
+			}
+		}
+	}
+	denominator := hints.Denominator
+	if denominator == 0 {
+		denominator = 1
+	}
+
+	objectsBits := bitsNeeded(mostObjects - leastObjects)
+	lengthBits := bitsNeeded(mostLength - leastLength)
+	contentOffsetBits := bitsNeeded(mostContentOffset - leastContentOffset)
+	contentLengthBits := bitsNeeded(mostContentLength - leastContentLength)
+	sharedBits := bitsNeeded(mostShared)
+	idBits := bitsNeeded(mostId)
+	numeratorBits := bitsNeeded(mostNumerator)
+
+	// Page offset hint table header (Table F.3).
+	w.write(leastObjects, 32)
+	w.write(hints.FirstPageOffset, 32)
+	w.write(int64(objectsBits), 16)
+	w.write(leastLength, 32)
+	w.write(int64(lengthBits), 16)
+	w.write(leastContentOffset, 32)
+	w.write(int64(contentOffsetBits), 16)
+	w.write(leastContentLength, 32)
+	w.write(int64(contentLengthBits), 16)
+	w.write(int64(sharedBits), 16)
+	w.write(int64(idBits), 16)
+	w.write(int64(numeratorBits), 16)
+	w.write(denominator, 16)
+
+	// Per-page entries (Table F.4), grouped by item, each group starting at a byte boundary.
+	for _, p := range pages {
+		w.write(p.NumObjects-leastObjects, objectsBits)
+	}
+	w.align()
+	for _, p := range pages {
+		w.write(p.PageLength-leastLength, lengthBits)
+	}
+	w.align()
+	for _, p := range pages {
+		w.write(int64(len(p.SharedObjects)), sharedBits)
+	}
+	w.align()
+	for _, p := range pages {
+		for _, id := range p.SharedObjects {
+			w.write(id, idBits)
+		}
+	}
+	w.align()
+	for _, p := range pages {
+		for i := range p.SharedObjects {
+			var numerator int64
+			if i < len(p.Numerators) {
+				numerator = p.Numerators[i]
+			}
+			w.write(numerator, numeratorBits)
+		}
+	}
+	w.align()
+	for _, p := range pages {
+		w.write(p.ContentOffset-leastContentOffset, contentOffsetBits)
+	}
+	w.align()
+	for _, p := range pages {
+		w.write(p.ContentLength-leastContentLength, contentLengthBits)
+	}
+	w.align()
+	sharedOffset := len(w.data)
+
+	// Shared object hint table header (Table F.5).
+	groups := hints.SharedObjectGroups
+	var leastGroupLength, mostGroupLength, mostGroupObjects int64
+	for i, g := range groups {
+		if i == 0 || g.Length < leastGroupLength {
+			leastGroupLength = g.Length
+		}
+		if g.Length > mostGroupLength {
+			mostGroupLength = g.Length
+		}
+		if g.NumObjects-1 > mostGroupObjects {
+			mostGroupObjects = g.NumObjects - 1
+		}
+	}
+	groupObjectsBits := bitsNeeded(mostGroupObjects)
+	groupLengthBits := bitsNeeded(mostGroupLength - leastGroupLength)
+	w.write(hints.FirstSharedObject, 32)
+	w.write(hints.FirstSharedOffset, 32)
+	w.write(hints.NumFirstPageShared, 32)
+	w.write(int64(len(groups)), 32)
+	w.write(int64(groupObjectsBits), 16)
+	w.write(leastGroupLength, 32)
+	w.write(int64(groupLengthBits), 16)
+
+	// Shared object group entries (Table F.6).
+	for _, g := range groups {
+		w.write(g.Length-leastGroupLength, groupLengthBits)
+	}
+	w.align()
+	for _, g := range groups {
+		if len(g.Signature) == 16 {
+			w.write(1, 1)
+		} else {
+			w.write(0, 1)
+		}
+	}
+	w.align()
+	for _, g := range groups {
+		if len(g.Signature) == 16 {
+			for _, b := range g.Signature {
+				w.write(int64(b), 8)
+			}
+		}
+	}
+	for _, g := range groups {
+		w.write(g.NumObjects-1, groupObjectsBits)
+	}
+	w.align()
+
+	return w.data, sharedOffset
+}
+
+// ParseLinearizationHints parses the hint tables of hint stream data `data` for a document of
+// `numPages` pages, `sharedOffset` being the offset of the shared object hint table.
+func ParseLinearizationHints(data []byte, sharedOffset int, numPages int) (*LinearizationHints, error) {
+	if sharedOffset < 0 || sharedOffset > len(data) {
+		common.Log.Debug("ERROR: Invalid shared object hint table offset %d", sharedOffset)
+		return nil, errors.New("Invalid hint stream")
+	}
+	hints := &LinearizationHints{}
+	r := &hintReader{data: data[:sharedOffset]}
+
+	leastObjects := r.read(32)
+	hints.FirstPageOffset = r.read(32)
+	objectsBits := int(r.read(16))
+	leastLength := r.read(32)
+	lengthBits := int(r.read(16))
+	leastContentOffset := r.read(32)
+	contentOffsetBits := int(r.read(16))
+	leastContentLength := r.read(32)
+	contentLengthBits := int(r.read(16))
+	sharedBits := int(r.read(16))
+	idBits := int(r.read(16))
+	numeratorBits := int(r.read(16))
+	hints.Denominator = r.read(16)
+	if r.err != nil {
+		return nil, r.err
+	}
+	for _, bits := range []int{objectsBits, lengthBits, contentOffsetBits, contentLengthBits, sharedBits, idBits, numeratorBits} {
+		if bits > 32 {
+			common.Log.Debug("ERROR: Invalid page offset hint table field width %d", bits)
+			return nil, errors.New("Invalid hint stream")
+		}
+	}
+
+	pages := make([]PageOffsetHint, numPages)
+	for i := range pages {
+		pages[i].NumObjects = leastObjects + r.read(objectsBits)
+	}
+	r.align()
+	for i := range pages {
+		pages[i].PageLength = leastLength + r.read(lengthBits)
+	}
+	r.align()
+	for i := range pages {
+		n := r.read(sharedBits)
+		if n > int64(len(data))*8 {
+			return nil, errors.New("Invalid hint stream")
+		}
+		pages[i].SharedObjects = make([]int64, n)
+		pages[i].Numerators = make([]int64, n)
+	}
+	r.align()
+	for i := range pages {
+		for j := range pages[i].SharedObjects {
+			pages[i].SharedObjects[j] = r.read(idBits)
+		}
+	}
+	r.align()
+	for i := range pages {
+		for j := range pages[i].Numerators {
+			pages[i].Numerators[j] = r.read(numeratorBits)
+		}
+	}
+	r.align()
+	for i := range pages {
+		pages[i].ContentOffset = leastContentOffset + r.read(contentOffsetBits)
+	}
+	r.align()
+	for i := range pages {
+		pages[i].ContentLength = leastContentLength + r.read(contentLengthBits)
+	}
+	if r.err != nil {
+		common.Log.Debug("ERROR: Page offset hint table truncated")
+		return nil, r.err
+	}
+	hints.Pages = pages
+
+	r = &hintReader{data: data[sharedOffset:]}
+	hints.FirstSharedObject = r.read(32)
+	hints.FirstSharedOffset = r.read(32)
+	hints.NumFirstPageShared = r.read(32)
+	numGroups := r.read(32)
+	groupObjectsBits := int(r.read(16))
+	leastGroupLength := r.read(32)
+	groupLengthBits := int(r.read(16))
+	if r.err != nil {
+		return nil, r.err
+	}
+	if groupObjectsBits > 32 || groupLengthBits > 32 || numGroups > int64(len(data))*8 {
+		common.Log.Debug("ERROR: Invalid shared object hint table header")
+		return nil, errors.New("Invalid hint stream")
+	}
+
+	groups := make([]SharedObjectGroupHint, numGroups)
+	for i := range groups {
+		groups[i].Length = leastGroupLength + r.read(groupLengthBits)
+	}
+	r.align()
+	for i := range groups {
+		if r.read(1) == 1 {
+			groups[i].Signature = make([]byte, 16)
+		}
+	}
+	r.align()
+	for i := range groups {
+		for j := range groups[i].Signature {
+			groups[i].Signature[j] = byte(r.read(8))
+		}
+	}
+	for i := range groups {
+		groups[i].NumObjects = 1 + r.read(groupObjectsBits)
+	}
+	if r.err != nil {
+		common.Log.Debug("ERROR: Shared object hint table truncated")
+		return nil, r.err
+	}
+	hints.SharedObjectGroups = groups
+	return hints, nil
+}
+
+// bitsNeeded returns the number of bits needed to represent `v`.
+func bitsNeeded(v int64) int {
+	n := 0
+	for ; v > 0; v >>= 1 {
+		n++
+	}
+	return n
+}
+
+// hintWriter writes the bit fields of hint tables, most significant bit first.
+type hintWriter struct {
+	data  []byte
+	nbits uint // Number of bits used in the last byte, 0 if it is complete.
+}
+
+func (w *hintWriter) write(v int64, bits int) {
+	for i := bits - 1; i >= 0; i-- {
+		if w.nbits == 0 {
+			w.data = append(w.data, 0)
+		}
+		if v>>uint(i)&1 != 0 {
+			w.data[len(w.data)-1] |= 0x80 >> w.nbits
+		}
+		w.nbits = (w.nbits + 1) % 8
+	}
+}
+
+// align pads the data to a byte boundary.
+func (w *hintWriter) align() {
+	w.nbits = 0
+}
+
+// hintReader reads the bit fields of hint tables.
+type hintReader struct {
+	data []byte
+	pos  int // Bit position.
+	err  error
+}
+
+func (r *hintReader) read(bits int) int64 {
+	var v int64
+	for i := 0; i < bits; i++ {
+		if r.pos >= 8*len(r.data) {
+			r.err = errors.New("Hint table truncated")
+			return 0
+		}
+		bit := r.data[r.pos/8] >> uint(7-r.pos%8) & 1
+		v = v<<1 | int64(bit)
+		r.pos++
+	}
+	return v
+}
+
+func (r *hintReader) align() {
+	r.pos = (r.pos + 7) / 8 * 8
+}
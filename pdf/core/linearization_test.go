@@ -0,0 +1,48 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLinearizationHintsEncoding(t *testing.T) {
+	hints := &LinearizationHints{
+		FirstPageOffset: 1234,
+		Denominator:     1,
+		Pages: []PageOffsetHint{
+			{NumObjects: 5, PageLength: 3000, SharedObjects: []int64{2}, Numerators: []int64{0}, ContentLength: 3000},
+			{NumObjects: 2, PageLength: 700, SharedObjects: []int64{}, Numerators: []int64{}, ContentLength: 700},
+			{NumObjects: 9, PageLength: 12345, SharedObjects: []int64{2, 5, 6}, Numerators: []int64{0, 0, 0}, ContentLength: 12345},
+		},
+		FirstSharedObject:  20,
+		FirstSharedOffset:  20000,
+		NumFirstPageShared: 5,
+		SharedObjectGroups: []SharedObjectGroupHint{
+			{Length: 100, NumObjects: 1},
+			{Length: 2000, NumObjects: 1},
+			{Length: 30, NumObjects: 1},
+			{Length: 31, NumObjects: 1},
+			{Length: 839, NumObjects: 1},
+			{Length: 77, NumObjects: 3, Signature: []byte("0123456789abcdef")},
+			{Length: 5000, NumObjects: 1},
+		},
+	}
+
+	data, sharedOffset := hints.Encode()
+	decoded, err := ParseLinearizationHints(data, sharedOffset, len(hints.Pages))
+	if err != nil {
+		t.Fatalf("Failed to parse hints: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, hints) {
+		t.Errorf("Wrong hints\n%+v\n!=\n%+v", decoded, hints)
+	}
+
+	if _, err := ParseLinearizationHints(data[:sharedOffset+10], sharedOffset, len(hints.Pages)); err == nil {
+		t.Errorf("Truncated hints parsed")
+	}
+}
@@ -8,6 +8,8 @@ package core
 import (
 	"bufio"
 	"bytes"
+	"crypto"
+	"crypto/x509"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -47,6 +49,10 @@ type PdfParser struct {
 	crypter          *PdfCrypt
 	repairsAttempted bool // Avoid multiple attempts for repair.
 
+	// Offset and type of the last cross-reference section, referred to by startxref.
+	xrefOffset int64
+	xrefType   XrefType
+
 	// Tracker for reference lookups when looking up Length entry of stream objects.
 	// The Length entries of stream objects are a special case, as they can require recursive parsing, i.e. look up
 	// the length reference (if not object) prior to reading the actual stream.  This has risks of endless looping.
@@ -59,6 +65,17 @@ func (parser *PdfParser) GetCrypter() *PdfCrypt {
 	return parser.crypter
 }
 
+// GetXrefOffset returns the offset of the last cross-reference section of the file, which is
+// referred to by startxref.
+func (parser *PdfParser) GetXrefOffset() int64 {
+	return parser.xrefOffset
+}
+
+// GetXrefType returns the type of the last cross-reference section of the file.
+func (parser *PdfParser) GetXrefType() XrefType {
+	return parser.xrefType
+}
+
 // IsAuthenticated returns true if the PDF has already been authenticated for accessing.
 func (parser *PdfParser) IsAuthenticated() bool {
 	return parser.crypter.Authenticated
@@ -1207,6 +1224,10 @@ func (parser *PdfParser) loadXrefs() (*PdfObjectDictionary, error) {
 	if err != nil {
 		return nil, err
 	}
+	parser.xrefOffset = offsetXref
+	if t, ok := trailerDict.Get("Type").(*PdfObjectName); ok && *t == "XRef" {
+		parser.xrefType = XrefTypeStream
+	}
 
 	// Check the XrefStm object also from the trailer.
 	xx := trailerDict.Get("XRefStm")
@@ -1489,6 +1510,54 @@ func (parser *PdfParser) ParseIndirectObject() (PdfObject, error) {
 	return &indirect, nil
 }
 
+// GetDictEntryOffset returns the file offset of the value of entry `key` of the dictionary of
+// object `objNum`, if the object is not compressed in an object stream and has the entry.
+func (parser *PdfParser) GetDictEntryOffset(objNum int, key PdfObjectName) (int64, bool) {
+	offset, ok := parser.GetObjectOffset(objNum)
+	if !ok {
+		return 0, false
+	}
+	parser.SetFileOffset(offset)
+
+	// Pass the object header.
+	bb, _ := parser.reader.Peek(20)
+	indices := reIndirectObject.FindSubmatchIndex(bb)
+	if len(indices) < 6 {
+		common.Log.Debug("ERROR: Unable to find object signature (%s)", string(bb))
+		return 0, false
+	}
+	if on, _ := strconv.Atoi(string(bb[indices[2]:indices[3]])); on != objNum {
+		return 0, false
+	}
+	parser.reader.Discard(indices[1])
+	parser.skipSpaces()
+	parser.skipComments()
+	if bb, err := parser.reader.Peek(2); err != nil || bb[0] != '<' || bb[1] != '<' {
+		return 0, false
+	}
+	parser.reader.Discard(2)
+
+	for {
+		parser.skipSpaces()
+		parser.skipComments()
+		bb, err := parser.reader.Peek(2)
+		if err != nil || (bb[0] == '>' && bb[1] == '>') {
+			return 0, false
+		}
+		keyName, err := parser.parseName()
+		if err != nil {
+			return 0, false
+		}
+		parser.skipSpaces()
+		if keyName == key {
+			return parser.GetFileOffset(), true
+		}
+		if _, err := parser.parseObject(); err != nil {
+			return 0, false
+		}
+	}
+}
+
 // For testing purposes.
 // TODO: Unexport (v3) or move to test files, if needed by external test cases.
 func NewParserFromString(txt string) *PdfParser {
@@ -1625,6 +1694,16 @@ func (parser *PdfParser) Decrypt(password []byte) (bool, error) {
 	return authenticated, err
 }
 
+// DecryptWithKey attempts to decrypt the PDF file encrypted with the public-key security handler
+// with the private key `key` of the recipient certificate `cert`.  Returns true if `cert` is one of
+// the recipients, false otherwise.  An error is returned when there is a problem with decrypting.
+func (parser *PdfParser) DecryptWithKey(cert *x509.Certificate, key crypto.Decrypter) (bool, error) {
+	if parser.crypter == nil {
+		return false, errors.New("Check encryption first")
+	}
+	return parser.crypter.authenticateKey(cert, key)
+}
+
 // CheckAccessRights checks access rights and permissions for a specified password. If either user/owner password is
 // specified, full rights are granted, otherwise the access rights are specified by the Permissions flag.
 //
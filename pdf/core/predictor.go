@@ -0,0 +1,298 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package core
+
+// Predictor functions (section 7.4.4.4), used with the FlateDecode and LZWDecode filters.
+// Predictor 2 is the TIFF predictor 2, predictors 10-15 are the PNG predictors, where 10-14 use
+// the same PNG filter type for all rows and 15 selects the filter type for each row.
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// PNG filter types.
+const (
+	pngFilterNone  = 0
+	pngFilterSub   = 1
+	pngFilterUp    = 2
+	pngFilterAvg   = 3
+	pngFilterPaeth = 4
+)
+
+// predictorRowLength returns the number of bytes of a row of `columns` samples of `colors`
+// components with `bpc` bits each, excluding the PNG filter type byte.
+func predictorRowLength(colors, bpc, columns int) int {
+	return (colors*bpc*columns + 7) / 8
+}
+
+// checkPredictorParams checks the Colors, BitsPerComponent and Columns parameters of a predictor.
+func checkPredictorParams(colors, bpc, columns int) error {
+	if colors < 1 || columns < 1 {
+		common.Log.Debug("ERROR: Invalid predictor parameters: Colors=%d Columns=%d", colors, columns)
+		return errors.New("Invalid predictor parameters")
+	}
+	switch bpc {
+	case 1, 2, 4, 8, 16:
+	default:
+		common.Log.Debug("ERROR: Invalid predictor BitsPerComponent=%d", bpc)
+		return fmt.Errorf("Invalid BitsPerComponent=%d", bpc)
+	}
+	return nil
+}
+
+// applyPredictor applies the predictor function `predictor` to the sample `data` before encoding.
+func applyPredictor(data []byte, predictor, colors, bpc, columns int) ([]byte, error) {
+	switch {
+	case predictor == 1:
+		return data, nil
+	case predictor == 2:
+		return tiffPredictorEncode(data, colors, bpc, columns)
+	case predictor >= 10 && predictor <= 15:
+		return pngPredictorEncode(data, predictor, colors, bpc, columns)
+	}
+	common.Log.Debug("ERROR: Unsupported predictor (%d)", predictor)
+	return nil, fmt.Errorf("Unsupported predictor (%d)", predictor)
+}
+
+// reversePredictor reverses the predictor function `predictor` applied to decoded `data`.
+func reversePredictor(data []byte, predictor, colors, bpc, columns int) ([]byte, error) {
+	switch {
+	case predictor <= 1:
+		return data, nil
+	case predictor == 2:
+		return tiffPredictorDecode(data, colors, bpc, columns)
+	case predictor >= 10 && predictor <= 15:
+		return pngPredictorDecode(data, colors, bpc, columns)
+	}
+	common.Log.Debug("ERROR: Unsupported predictor (%d)", predictor)
+	return nil, fmt.Errorf("Unsupported predictor (%d)", predictor)
+}
+
+// tiffPredictorEncode replaces each sample by its difference with the preceding sample of the
+// same colour component in the row, modulo 2^bpc (TIFF predictor 2).
+func tiffPredictorEncode(data []byte, colors, bpc, columns int) ([]byte, error) {
+	return tiffPredictor(data, colors, bpc, columns, true)
+}
+
+// tiffPredictorDecode reverses TIFF predictor 2.
+func tiffPredictorDecode(data []byte, colors, bpc, columns int) ([]byte, error) {
+	return tiffPredictor(data, colors, bpc, columns, false)
+}
+
+func tiffPredictor(data []byte, colors, bpc, columns int, encode bool) ([]byte, error) {
+	if err := checkPredictorParams(colors, bpc, columns); err != nil {
+		return nil, err
+	}
+	rowLength := predictorRowLength(colors, bpc, columns)
+	if len(data)%rowLength != 0 {
+		common.Log.Debug("ERROR: TIFF predictor: Invalid row length (%d/%d)", len(data), rowLength)
+		return nil, fmt.Errorf("Invalid row length (%d/%d)", len(data), rowLength)
+	}
+
+	out := make([]byte, len(data))
+	copy(out, data)
+	samples := colors * columns
+	mask := uint32(1)<<uint(bpc) - 1
+	for start := 0; start < len(out); start += rowLength {
+		row := out[start : start+rowLength]
+		switch bpc {
+		case 8:
+			if encode {
+				for j := samples - 1; j >= colors; j-- {
+					row[j] -= row[j-colors]
+				}
+			} else {
+				for j := colors; j < samples; j++ {
+					row[j] += row[j-colors]
+				}
+			}
+		default:
+			// Samples are processed from the end when encoding, so that the preceding samples
+			// still have their original values.
+			if encode {
+				for j := samples - 1; j >= colors; j-- {
+					v := getSample(row, j, bpc) - getSample(row, j-colors, bpc)
+					setSample(row, j, bpc, v&mask)
+				}
+			} else {
+				for j := colors; j < samples; j++ {
+					v := getSample(row, j, bpc) + getSample(row, j-colors, bpc)
+					setSample(row, j, bpc, v&mask)
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+// getSample returns sample `i` of `bpc` bits of `row`.
+func getSample(row []byte, i, bpc int) uint32 {
+	switch bpc {
+	case 16:
+		return uint32(row[2*i])<<8 | uint32(row[2*i+1])
+	case 8:
+		return uint32(row[i])
+	}
+	bit := i * bpc
+	shift := uint(8 - bpc - bit%8)
+	return uint32(row[bit/8]>>shift) & (1<<uint(bpc) - 1)
+}
+
+// setSample sets sample `i` of `bpc` bits of `row` to `v`.
+func setSample(row []byte, i, bpc int, v uint32) {
+	switch bpc {
+	case 16:
+		row[2*i], row[2*i+1] = byte(v>>8), byte(v)
+		return
+	case 8:
+		row[i] = byte(v)
+		return
+	}
+	bit := i * bpc
+	shift := uint(8 - bpc - bit%8)
+	mask := byte(1<<uint(bpc)-1) << shift
+	row[bit/8] = row[bit/8]&^mask | byte(v)<<shift&mask
+}
+
+// pngPredictorEncode prefixes each row of `data` with a PNG filter type byte and filters it.
+// Predictors 10 to 14 use the filter types None, Sub, Up, Average and Paeth respectively for all
+// rows, predictor 15 chooses the filter type of each row with the heuristic recommended by the PNG
+// specification: the one minimizing the sum of the absolute values of the filtered bytes, taken as
+// signed values.
+func pngPredictorEncode(data []byte, predictor, colors, bpc, columns int) ([]byte, error) {
+	if err := checkPredictorParams(colors, bpc, columns); err != nil {
+		return nil, err
+	}
+	rowLength := predictorRowLength(colors, bpc, columns)
+	if len(data)%rowLength != 0 {
+		common.Log.Debug("ERROR: PNG predictor: Invalid row length (%d/%d)", len(data), rowLength)
+		return nil, fmt.Errorf("Invalid row length (%d/%d)", len(data), rowLength)
+	}
+	bpp := (colors*bpc + 7) / 8 // Bytes per complete pixel, at least 1.
+
+	rows := len(data) / rowLength
+	out := make([]byte, 0, rows*(rowLength+1))
+	prev := make([]byte, rowLength) // The row above the first one is all zeros.
+	filtered := make([]byte, rowLength)
+	best := make([]byte, rowLength)
+	for i := 0; i < rows; i++ {
+		row := data[i*rowLength : (i+1)*rowLength]
+		if predictor < 15 {
+			ft := byte(predictor - 10)
+			pngFilterRow(filtered, row, prev, ft, bpp)
+			out = append(out, ft)
+			out = append(out, filtered...)
+		} else {
+			bestType, bestSum := byte(0), -1
+			for ft := byte(pngFilterNone); ft <= pngFilterPaeth; ft++ {
+				pngFilterRow(filtered, row, prev, ft, bpp)
+				sum := 0
+				for _, b := range filtered {
+					sum += absInt(int(int8(b)))
+				}
+				if bestSum < 0 || sum < bestSum {
+					bestType, bestSum = ft, sum
+					copy(best, filtered)
+				}
+			}
+			out = append(out, bestType)
+			out = append(out, best...)
+		}
+		prev = row
+	}
+	return out, nil
+}
+
+// pngFilterRow filters `row` with filter type `ft` into `out`, `prev` being the previous row and
+// `bpp` the number of bytes per pixel.
+func pngFilterRow(out, row, prev []byte, ft byte, bpp int) {
+	for j := range row {
+		var a, b, c byte // Left, above and upper left bytes.
+		if j >= bpp {
+			a = row[j-bpp]
+			c = prev[j-bpp]
+		}
+		b = prev[j]
+		switch ft {
+		case pngFilterNone:
+			out[j] = row[j]
+		case pngFilterSub:
+			out[j] = row[j] - a
+		case pngFilterUp:
+			out[j] = row[j] - b
+		case pngFilterAvg:
+			out[j] = row[j] - byte((int(a)+int(b))/2)
+		case pngFilterPaeth:
+			out[j] = row[j] - paeth(a, b, c)
+		}
+	}
+}
+
+// pngPredictorDecode reverses the PNG filters of the rows of `data`, which are each prefixed by a
+// filter type byte.
+func pngPredictorDecode(data []byte, colors, bpc, columns int) ([]byte, error) {
+	if err := checkPredictorParams(colors, bpc, columns); err != nil {
+		return nil, err
+	}
+	rowLength := predictorRowLength(colors, bpc, columns)
+	if len(data)%(rowLength+1) != 0 {
+		common.Log.Debug("ERROR: PNG predictor: Invalid row length (%d/%d)", len(data), rowLength+1)
+		return nil, fmt.Errorf("Invalid row length (%d/%d)", len(data), rowLength+1)
+	}
+	bpp := (colors*bpc + 7) / 8
+
+	rows := len(data) / (rowLength + 1)
+	out := make([]byte, rows*rowLength)
+	prev := make([]byte, rowLength)
+	for i := 0; i < rows; i++ {
+		ft := data[i*(rowLength+1)]
+		in := data[i*(rowLength+1)+1 : (i+1)*(rowLength+1)]
+		row := out[i*rowLength : (i+1)*rowLength]
+		for j := range row {
+			var a, b, c byte
+			if j >= bpp {
+				a = row[j-bpp]
+				c = prev[j-bpp]
+			}
+			b = prev[j]
+			switch ft {
+			case pngFilterNone:
+				row[j] = in[j]
+			case pngFilterSub:
+				row[j] = in[j] + a
+			case pngFilterUp:
+				row[j] = in[j] + b
+			case pngFilterAvg:
+				row[j] = in[j] + byte((int(a)+int(b))/2)
+			case pngFilterPaeth:
+				row[j] = in[j] + paeth(a, b, c)
+			default:
+				common.Log.Debug("ERROR: Invalid filter byte (%d) @row %d", ft, i)
+				return nil, fmt.Errorf("Invalid filter byte (%d)", ft)
+			}
+		}
+		prev = row
+	}
+	return out, nil
+}
+
+// paeth returns the Paeth predictor of a byte from the bytes to its left `a`, above `b` and upper
+// left `c`.
+func paeth(a, b, c byte) byte {
+	p := int(a) + int(b) - int(c)
+	pa := absInt(p - int(a))
+	pb := absInt(p - int(b))
+	pc := absInt(p - int(c))
+	if pa <= pb && pa <= pc {
+		return a
+	} else if pb <= pc {
+		return b
+	}
+	return c
+}
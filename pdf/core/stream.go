@@ -68,11 +68,11 @@ func NewEncoderFromStream(streamObj *PdfObjectStream) (StreamEncoder, error) {
 	} else if *method == StreamEncodingFilterNameASCII85 || *method == "A85" {
 		return NewASCII85Encoder(), nil
 	} else if *method == StreamEncodingFilterNameCCITTFax {
-		return NewCCITTFaxEncoder(), nil
+		return newCCITTFaxEncoderFromStream(streamObj, nil)
 	} else if *method == StreamEncodingFilterNameJBIG2 {
-		return NewJBIG2Encoder(), nil
+		return newJBIG2EncoderFromStream(streamObj, nil)
 	} else if *method == StreamEncodingFilterNameJPX {
-		return NewJPXEncoder(), nil
+		return newJPXEncoderFromStream(streamObj, nil)
 	} else {
 		common.Log.Debug("ERROR: Unsupported encoding method!")
 		return nil, fmt.Errorf("Unsupported encoding method (%s)", *method)
@@ -43,7 +43,6 @@ func compareSlices(a, b []byte) bool {
 }
 
 // This tests the TIFF predictor (Predictor 2) for PDF.
-// Passes the test, but seems not to work on certain PDF files.
 func TestFlateTiffPredictor(t *testing.T) {
 	// 2 rows of data, 3 colors, 2 columns per row
 	rawStream := []byte("\x01\x02\x01\x00\x03\x04\x05\xff\x01\xaf\x01\x02")
@@ -70,7 +69,7 @@ func TestFlateTiffPredictor(t *testing.T) {
                 /Columns 2
              >>
 /Filter /FlateDecode
-/Length 24
+/Length ` + fmt.Sprintf("%d", len(encoded)) + `
 >>
 stream
 ` + string(encoded) + `endstream
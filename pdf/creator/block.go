@@ -405,7 +405,11 @@ func mergeContents(contents *contentstream.ContentStreamOperations, resources *m
 							}
 						}
 
-						resources.SetFontByName(useName, obj)
+						if font, has := resourcesToAdd.GetFont(*name); has {
+							resources.SetFont(useName, font)
+						} else {
+							resources.SetFontByName(useName, obj)
+						}
 						fontMap[*name] = useName
 					}
 
@@ -10,16 +10,19 @@ package creator
 // if every detail is correct.
 
 import (
+	"bytes"
 	"fmt"
 	goimage "image"
 	"io/ioutil"
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/boombuler/barcode"
 	"github.com/boombuler/barcode/qr"
 
 	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream/draw"
 	"github.com/unidoc/unidoc/pdf/core"
 	"github.com/unidoc/unidoc/pdf/model"
 	"github.com/unidoc/unidoc/pdf/model/fonts"
@@ -524,6 +527,116 @@ func TestParagraphFonts(t *testing.T) {
 	}
 }
 
+// Test writing multilingual text with a composite font.
+func TestParagraphCompositeFont(t *testing.T) {
+	creator := New()
+
+	roboto, err := model.NewCompositePdfFontFromTTFFile(testRobotoRegularTTFFile)
+	if err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+
+	p := NewParagraph("Invoice / Счёт-фактура / Τιμολόγιο: 1 234,56 €")
+	p.SetFont(roboto)
+	p.SetFontSize(14)
+	p.SetTextAlignment(TextAlignmentJustify)
+	if err := creator.Draw(p); err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+
+	style := NewTextStyle()
+	style.Font = roboto
+	sp := NewStyledParagraph("Итого: ", style)
+	sp.Append("100 €", NewTextStyle())
+	if err := creator.Draw(sp); err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+
+	err = creator.WriteToFile("/tmp/2_pComposite.pdf")
+	if err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+	data, err := ioutil.ReadFile("/tmp/2_pComposite.pdf")
+	if err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+	if !strings.Contains(string(data), "/Identity-H") {
+		t.Errorf("Composite font not written")
+	}
+}
+
+func TestParagraphType3Font(t *testing.T) {
+	builder := NewType3FontBuilder(1000)
+	square := draw.NewPath()
+	for _, p := range [][2]float64{{100, 0}, {700, 0}, {700, 600}, {100, 600}} {
+		square = square.AppendPoint(draw.NewPoint(p[0], p[1]))
+	}
+	builder.AddGlyph('A', 800, square)
+	builder.AddGlyph(' ', 250)
+	heart := draw.NewCubicBezierPath()
+	heart = heart.AppendCurve(draw.NewCubicBezierCurve(400, 0, 0, 300, 200, 700, 400, 500))
+	heart = heart.AppendCurve(draw.NewCubicBezierCurve(400, 500, 600, 700, 800, 300, 400, 0))
+	builder.AddCurveGlyph(0xE000, 900, heart)
+
+	font, err := builder.Font()
+	if err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+	if metrics, found := font.GetGlyphCharMetrics("uniE000"); !found || metrics.Wx != 900 {
+		t.Errorf("Wrong metrics %+v", metrics)
+	}
+
+	creator := New()
+	p := NewParagraph("AA \uE000")
+	p.SetFont(font)
+	p.SetFontSize(20)
+	p.SetColor(ColorRGBFrom8bit(200, 0, 0))
+	if err := creator.Draw(p); err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+
+	err = creator.WriteToFile("/tmp/2_pType3.pdf")
+	if err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+	data, err := ioutil.ReadFile("/tmp/2_pType3.pdf")
+	if err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+	reader, err := model.NewPdfReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+	page, err := reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Fail: %v\n", err)
+	}
+	fontDict, ok := core.TraceToDirectObject(page.Resources.Font).(*core.PdfObjectDictionary)
+	if !ok {
+		t.Fatalf("Font resources not written")
+	}
+	var loaded *model.PdfFont
+	for _, name := range fontDict.Keys() {
+		obj := core.TraceToDirectObject(fontDict.Get(name)).(*core.PdfObjectDictionary)
+		if subtype, _ := obj.Get("Subtype").(*core.PdfObjectName); subtype != nil && *subtype == "Type3" {
+			loaded, err = model.NewPdfFontFromPdfObject(obj)
+			if err != nil {
+				t.Fatalf("Fail: %v\n", err)
+			}
+		}
+	}
+	if loaded == nil {
+		t.Fatalf("Type3 font not written")
+	}
+	if metrics, found := loaded.GetGlyphCharMetrics("A"); !found || metrics.Wx != 800 {
+		t.Errorf("Wrong loaded metrics %+v", metrics)
+	}
+	encoded := loaded.Encoder().Encode("AA \uE000")
+	if text, ok := loaded.CharcodeBytesToUnicode([]byte(encoded)); !ok || text != "AA \uE000" {
+		t.Errorf("Wrong text %q", text)
+	}
+}
+
 // Test writing with the 14 built in fonts.
 func TestParagraphStandardFonts(t *testing.T) {
 	creator := New()
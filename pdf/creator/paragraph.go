@@ -95,7 +95,8 @@ func NewParagraph(text string) *Paragraph {
 	return p
 }
 
-// SetFont sets the Paragraph's font.
+// SetFont sets the Paragraph's font.  Text shown with composite fonts is encoded with the encoder
+// of the font rather than that of the Paragraph.
 func (p *Paragraph) SetFont(font fonts.Font) {
 	p.textFont = font
 }
@@ -211,9 +212,10 @@ func (p *Paragraph) Height() float64 {
 // getTextWidth calculates the text width as if all in one line (not taking wrapping into account).
 func (p *Paragraph) getTextWidth() float64 {
 	w := float64(0.0)
+	encoder := fontEncoder(p.textFont, p.encoder)
 
 	for _, rune := range p.text {
-		glyph, found := p.encoder.RuneToGlyph(rune)
+		glyph, found := encoder.RuneToGlyph(rune)
 		if !found {
 			common.Log.Debug("Error! Glyph not found for rune: %s\n", rune)
 			return -1 // XXX/FIXME: return error.
@@ -250,9 +252,10 @@ func (p *Paragraph) wrapText() error {
 	runes := []rune(p.text)
 	glyphs := []string{}
 	widths := []float64{}
+	encoder := fontEncoder(p.textFont, p.encoder)
 
 	for _, val := range runes {
-		glyph, found := p.encoder.RuneToGlyph(val)
+		glyph, found := encoder.RuneToGlyph(val)
 		if !found {
 			common.Log.Debug("Error! Glyph not found for rune: %v\n", val)
 			return errors.New("Glyph not found for rune") // XXX/FIXME: return error.
@@ -397,13 +400,14 @@ func drawParagraphOnBlock(blk *Block, p *Paragraph, ctx DrawContext) (DrawContex
 	}
 
 	// Add to the Page resources.
-	err := blk.resources.SetFontByName(fontName, p.textFont.ToPdfObject())
+	err := setFont(blk.resources, fontName, p.textFont)
 	if err != nil {
 		return ctx, err
 	}
 
 	// Wrap the text into lines.
 	p.wrapText()
+	encoder := fontEncoder(p.textFont, p.encoder)
 
 	// Create the content stream.
 	cc := contentstream.NewContentCreator()
@@ -433,7 +437,7 @@ func drawParagraphOnBlock(blk *Block, p *Paragraph, ctx DrawContext) (DrawContex
 		w := float64(0)
 		spaces := 0
 		for _, runeVal := range runes {
-			glyph, found := p.encoder.RuneToGlyph(runeVal)
+			glyph, found := encoder.RuneToGlyph(runeVal)
 			if !found {
 				common.Log.Debug("Rune 0x%x not supported by text encoder", runeVal)
 				return ctx, errors.New("Unsupported rune in text encoding")
@@ -479,7 +483,7 @@ func drawParagraphOnBlock(blk *Block, p *Paragraph, ctx DrawContext) (DrawContex
 		encStr := ""
 		for _, runeVal := range runes {
 			//creator.Add_Tj(core.PdfObjectString(tb.Encoder.Encode(line)))
-			glyph, found := p.encoder.RuneToGlyph(runeVal)
+			glyph, found := encoder.RuneToGlyph(runeVal)
 			if !found {
 				common.Log.Debug("Rune 0x%x not supported by text encoder", runeVal)
 				return ctx, errors.New("Unsupported rune in text encoding")
@@ -497,7 +501,7 @@ func drawParagraphOnBlock(blk *Block, p *Paragraph, ctx DrawContext) (DrawContex
 				}
 				objs = append(objs, core.MakeFloat(-spaceWidth))
 			} else {
-				encStr += string(p.encoder.Encode(string(runeVal)))
+				encStr += string(encoder.Encode(string(runeVal)))
 			}
 		}
 		if len(encStr) > 0 {
@@ -527,3 +531,12 @@ func drawParagraphOnBlock(blk *Block, p *Paragraph, ctx DrawContext) (DrawContex
 
 	return ctx, nil
 }
+
+// setFont sets the font resource `name` of `resources` to `font`.  Fonts given by a PdfFont are set
+// with SetFont, so that the fonts created from TrueType files are subset when writing.
+func setFont(resources *model.PdfPageResources, name core.PdfObjectName, font fonts.Font) error {
+	if pdfFont, ok := font.(*model.PdfFont); ok {
+		return resources.SetFont(name, pdfFont)
+	}
+	return resources.SetFontByName(name, font.ToPdfObject())
+}
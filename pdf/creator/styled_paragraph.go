@@ -208,9 +208,10 @@ func (p *StyledParagraph) getTextWidth() float64 {
 	var width float64
 	for _, chunk := range p.chunks {
 		style := &chunk.Style
+		encoder := fontEncoder(style.Font, p.encoder)
 
 		for _, rune := range chunk.Text {
-			glyph, found := p.encoder.RuneToGlyph(rune)
+			glyph, found := encoder.RuneToGlyph(rune)
 			if !found {
 				common.Log.Debug("Error! Glyph not found for rune: %s\n", rune)
 
@@ -266,13 +267,14 @@ func (p *StyledParagraph) wrapText() error {
 
 	for _, chunk := range p.chunks {
 		style := chunk.Style
+		encoder := fontEncoder(style.Font, p.encoder)
 
 		var part []rune
 		var glyphs []string
 		var widths []float64
 
 		for _, r := range chunk.Text {
-			glyph, found := p.encoder.RuneToGlyph(r)
+			glyph, found := encoder.RuneToGlyph(r)
 			if !found {
 				common.Log.Debug("Error! Glyph not found for rune: %v\n", r)
 
@@ -443,7 +445,7 @@ func drawStyledParagraphOnBlock(blk *Block, p *StyledParagraph, ctx DrawContext)
 	}
 
 	// Add default font to the page resources
-	err := blk.resources.SetFontByName(fontName, p.defaultStyle.Font.ToPdfObject())
+	err := setFont(blk.resources, fontName, p.defaultStyle.Font)
 	if err != nil {
 		return ctx, err
 	}
@@ -464,7 +466,7 @@ func drawStyledParagraphOnBlock(blk *Block, p *StyledParagraph, ctx DrawContext)
 		for _, chunk := range line {
 			fontName = core.PdfObjectName(fmt.Sprintf("Font%d", num))
 
-			err := blk.resources.SetFontByName(fontName, chunk.Style.Font.ToPdfObject())
+			err := setFont(blk.resources, fontName, chunk.Style.Font)
 			if err != nil {
 				return ctx, err
 			}
@@ -504,6 +506,7 @@ func drawStyledParagraphOnBlock(blk *Block, p *StyledParagraph, ctx DrawContext)
 
 		for _, chunk := range line {
 			style := &chunk.Style
+			encoder := fontEncoder(style.Font, p.encoder)
 
 			spaceMetrics, found := style.Font.GetGlyphCharMetrics("space")
 			if !found {
@@ -512,7 +515,7 @@ func drawStyledParagraphOnBlock(blk *Block, p *StyledParagraph, ctx DrawContext)
 
 			var chunkSpaces uint
 			for _, r := range chunk.Text {
-				glyph, found := p.encoder.RuneToGlyph(r)
+				glyph, found := encoder.RuneToGlyph(r)
 				if !found {
 					common.Log.Debug("Rune 0x%x not supported by text encoder", r)
 					return ctx, errors.New("Unsupported rune in text encoding")
@@ -564,6 +567,7 @@ func drawStyledParagraphOnBlock(blk *Block, p *StyledParagraph, ctx DrawContext)
 		// Render line text chunks
 		for k, chunk := range line {
 			style := &chunk.Style
+			encoder := fontEncoder(style.Font, p.encoder)
 
 			r, g, b := style.Color.ToRGB()
 			fontName := defaultFontName
@@ -582,7 +586,7 @@ func drawStyledParagraphOnBlock(blk *Block, p *StyledParagraph, ctx DrawContext)
 
 			encStr := ""
 			for _, rn := range chunk.Text {
-				glyph, found := p.encoder.RuneToGlyph(rn)
+				glyph, found := encoder.RuneToGlyph(rn)
 				if !found {
 					common.Log.Debug("Rune 0x%x not supported by text encoder", r)
 					return ctx, errors.New("Unsupported rune in text encoding")
@@ -607,7 +611,7 @@ func drawStyledParagraphOnBlock(blk *Block, p *StyledParagraph, ctx DrawContext)
 						Add_TL(fontSize * p.lineHeight).
 						Add_TJ([]core.PdfObject{core.MakeFloat(-spaceWidth)}...)
 				} else {
-					encStr += p.encoder.Encode(string(rn))
+					encStr += encoder.Encode(string(rn))
 				}
 			}
 
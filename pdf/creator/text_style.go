@@ -36,6 +36,19 @@ func NewTextStyle() TextStyle {
 	}
 }
 
+// fontEncoder returns the encoder of text shown with `font`: the encoder of composite fonts, which
+// have their own, and `encoder` otherwise.
+func fontEncoder(font fonts.Font, encoder textencoding.TextEncoder) textencoding.TextEncoder {
+	if f, ok := font.(interface {
+		Encoder() textencoding.TextEncoder
+	}); ok {
+		if enc := f.Encoder(); enc != nil {
+			return enc
+		}
+	}
+	return encoder
+}
+
 // TextChunk represents a chunk of text along with a particular style.
 type TextChunk struct {
 	Text  string
@@ -0,0 +1,114 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package creator
+
+import (
+	"errors"
+	"math"
+
+	pdfcontent "github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/contentstream/draw"
+	pdf "github.com/unidoc/unidoc/pdf/model"
+)
+
+// Type3FontBuilder builds Type3 fonts with glyphs drawn from paths, such as icon fonts.  The glyphs
+// are filled with the color of the text shown with the font.
+type Type3FontBuilder struct {
+	unitsPerEm float64
+	glyphs     []pdf.Type3Glyph
+
+	// Glyph bounding box of the font.
+	llx, lly, urx, ury float64
+}
+
+// NewType3FontBuilder returns a builder of a Type3 font whose glyphs are drawn in a coordinate
+// system of `unitsPerEm` units per em, e.g. 1000.
+func NewType3FontBuilder(unitsPerEm float64) *Type3FontBuilder {
+	return &Type3FontBuilder{
+		unitsPerEm: unitsPerEm,
+		llx:        math.Inf(1),
+		lly:        math.Inf(1),
+		urx:        math.Inf(-1),
+		ury:        math.Inf(-1),
+	}
+}
+
+// AddGlyph adds the glyph of `r` with the advance width `width`, filling the polygons `paths`
+// with the nonzero winding number rule.
+func (b *Type3FontBuilder) AddGlyph(r rune, width float64, paths ...draw.Path) {
+	bboxes := make([]draw.BoundingBox, 0, len(paths))
+	body := pdfcontent.NewContentCreator()
+	for _, path := range paths {
+		if path.Length() == 0 {
+			continue
+		}
+		draw.DrawPathWithCreator(path, body)
+		body.Add_h()
+		bboxes = append(bboxes, path.GetBoundingBox())
+	}
+	b.addGlyph(r, width, body, bboxes)
+}
+
+// AddCurveGlyph adds the glyph of `r` with the advance width `width`, filling the closed paths of
+// Bezier curves `paths` with the nonzero winding number rule.
+func (b *Type3FontBuilder) AddCurveGlyph(r rune, width float64, paths ...draw.CubicBezierPath) {
+	bboxes := make([]draw.BoundingBox, 0, len(paths))
+	body := pdfcontent.NewContentCreator()
+	for _, path := range paths {
+		if len(path.Curves) == 0 {
+			continue
+		}
+		draw.DrawBezierPathWithCreator(path, body)
+		body.Add_h()
+		rect := path.GetBoundingBox()
+		bboxes = append(bboxes, draw.BoundingBox{X: rect.X, Y: rect.Y, Width: rect.Width, Height: rect.Height})
+	}
+	b.addGlyph(r, width, body, bboxes)
+}
+
+// addGlyph adds the glyph of `r` with the path construction operations `body` bounded by `bboxes`.
+func (b *Type3FontBuilder) addGlyph(r rune, width float64, body *pdfcontent.ContentCreator, bboxes []draw.BoundingBox) {
+	llx, lly, urx, ury := 0.0, 0.0, 0.0, 0.0
+	for i, bbox := range bboxes {
+		if i == 0 || bbox.X < llx {
+			llx = bbox.X
+		}
+		if i == 0 || bbox.Y < lly {
+			lly = bbox.Y
+		}
+		if i == 0 || bbox.X+bbox.Width > urx {
+			urx = bbox.X + bbox.Width
+		}
+		if i == 0 || bbox.Y+bbox.Height > ury {
+			ury = bbox.Y + bbox.Height
+		}
+	}
+	b.llx, b.lly = math.Min(b.llx, llx), math.Min(b.lly, lly)
+	b.urx, b.ury = math.Max(b.urx, urx), math.Max(b.ury, ury)
+
+	creator := pdfcontent.NewContentCreator()
+	creator.Add_d1(width, 0, llx, lly, urx, ury)
+	*creator.Operations() = append(*creator.Operations(), *body.Operations()...)
+	if len(bboxes) > 0 {
+		creator.Add_f()
+	}
+
+	b.glyphs = append(b.glyphs, pdf.Type3Glyph{Rune: r, Width: width, Content: creator.Bytes()})
+}
+
+// Font returns the font with the added glyphs, which can be used in text styles and paragraphs.
+func (b *Type3FontBuilder) Font() (*pdf.PdfFont, error) {
+	if len(b.glyphs) == 0 {
+		return nil, errors.New("no glyphs")
+	}
+	if b.unitsPerEm <= 0 {
+		return nil, errors.New("invalid units per em")
+	}
+
+	scale := 1 / b.unitsPerEm
+	bbox := pdf.PdfRectangle{Llx: b.llx, Lly: b.lly, Urx: b.urx, Ury: b.ury}
+	return pdf.NewPdfFontType3(b.glyphs, [6]float64{scale, 0, 0, scale, 0, 0}, bbox)
+}
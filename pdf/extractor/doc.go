@@ -5,6 +5,6 @@
 
 //
 // Package extractor is used for quickly extracting PDF content through a simple interface.
-// Currently offers functionality for extracting textual content.
+// Currently offers functionality for extracting textual content, positioned text, tables and images.
 //
 package extractor
@@ -9,12 +9,32 @@ import "github.com/unidoc/unidoc/pdf/model"
 
 // Extractor stores and offers functionality for extracting content from PDF pages.
 type Extractor struct {
-	contents  string
-	resources *model.PdfPageResources
+	contents    string
+	resources   *model.PdfPageResources
+	annotations []*model.PdfAnnotation
+	options     Options
+}
+
+// Options control the content extracted besides the content streams of the page and the form
+// XObjects they paint.
+type Options struct {
+	// IncludeAnnotations includes the text of the normal appearance streams (/AP /N) of the
+	// annotations of the page which are not hidden.
+	IncludeAnnotations bool
+
+	// IncludeFormFieldValues includes the values of the text and choice fields of the widget
+	// annotations of the page as text, in place of the text of their appearance streams.
+	IncludeFormFieldValues bool
 }
 
 // New returns an Extractor instance for extracting content from the input PDF page.
 func New(page *model.PdfPage) (*Extractor, error) {
+	return NewWithOptions(page, Options{})
+}
+
+// NewWithOptions returns an Extractor instance for extracting content from the input PDF page
+// with the options `options`.
+func NewWithOptions(page *model.PdfPage, options Options) (*Extractor, error) {
 	contents, err := page.GetAllContentStreams()
 	if err != nil {
 		return nil, err
@@ -23,6 +43,8 @@ func New(page *model.PdfPage) (*Extractor, error) {
 	e := &Extractor{}
 	e.contents = contents
 	e.resources = page.Resources
+	e.annotations = page.Annotations
+	e.options = options
 
 	return e, nil
 }
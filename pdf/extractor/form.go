@@ -0,0 +1,334 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// Annotation flags (12.5.3 "Annotation Flags") of annotations whose appearance is not shown.
+const (
+	annotationFlagHidden = 1 << 1
+	annotationFlagNoView = 1 << 5
+)
+
+// Maximum depth of the field hierarchy searched for inherited field attributes.
+const maxFieldDepth = 32
+
+// formStream is the content of a form XObject or annotation appearance stream.
+type formStream struct {
+	stream    *core.PdfObjectStream
+	content   string
+	resources *model.PdfPageResources
+	matrix    transform.Matrix // Maps the form space to the user space it is painted in.
+	bbox      *model.PdfRectangle
+}
+
+// formStack is the set of the form XObjects being processed, nested in one another.  Painting a
+// form again within itself is a cycle.
+type formStack map[*core.PdfObjectStream]bool
+
+// operationHandler handles an operation of a content stream walked by a contentWalker, with the
+// graphics state `gs` and the resources `resources` of the content stream.  `base` maps the user
+// space of the content stream, before any cm operation, to the default user space of the page.
+type operationHandler func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState,
+	resources *model.PdfPageResources, base transform.Matrix) error
+
+// contentWalker walks content streams with the form XObjects they paint, calling its handler for
+// the operations of each.
+type contentWalker struct {
+	// handle is called for each operation except the Do operations painting form XObjects, whose
+	// content streams are walked in place.
+	handle operationHandler
+	// enterForm, if set, is called before walking a nested content stream and returns the function
+	// called after it.
+	enterForm func() func()
+	forms     formStack // Form XObjects and patterns being walked.
+}
+
+// newContentWalker returns a contentWalker calling `handle` for the operations of the content
+// streams it walks.
+func newContentWalker(handle operationHandler) *contentWalker {
+	return &contentWalker{handle: handle, forms: formStack{}}
+}
+
+// walk walks the content stream `contents` with the resources `resources`, whose user space is
+// mapped to the default user space of the page by `base`.
+func (w *contentWalker) walk(contents string, resources *model.PdfPageResources, base transform.Matrix) error {
+	cstreamParser := contentstream.NewContentStreamParser(contents)
+	operations, err := cstreamParser.Parse()
+	if err != nil {
+		return err
+	}
+
+	processor := contentstream.NewContentStreamProcessor(*operations)
+	processor.AddHandler(contentstream.HandlerConditionEnumAllOperands, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			if op.Operand == "Do" && len(op.Params) == 1 {
+				if name, ok := op.Params[0].(*core.PdfObjectName); ok {
+					form, err := loadFormXObject(*name, resources)
+					if err != nil {
+						common.Log.Debug("Unable to load form XObject %s: %v", *name, err)
+						return nil
+					}
+					if form != nil {
+						w.walkNested(form.stream, form.content, form.resources, form.matrix.Mult(gs.CTM.Mult(base)))
+						return nil
+					}
+				}
+			}
+			return w.handle(op, gs, resources, base)
+		})
+
+	err = processor.Process(resources)
+	if err != nil {
+		common.Log.Error("Error processing: %v", err)
+		return err
+	}
+	return nil
+}
+
+// walkNested walks the content stream `contents` of the form XObject, annotation appearance or
+// tiling pattern `stream`, with the resources `resources` and whose space is mapped to the default
+// user space of the page by `base`, unless painted within itself.  Errors are logged and the
+// content stream skipped.
+func (w *contentWalker) walkNested(stream *core.PdfObjectStream, contents string, resources *model.PdfPageResources, base transform.Matrix) {
+	if w.forms[stream] {
+		common.Log.Debug("Form painted within itself")
+		return
+	}
+	w.forms[stream] = true
+	defer delete(w.forms, stream)
+	if w.enterForm != nil {
+		exitForm := w.enterForm()
+		defer exitForm()
+	}
+
+	if err := w.walk(contents, resources, base); err != nil {
+		common.Log.Debug("Error walking form content: %v", err)
+	}
+}
+
+// loadFormXObject returns the form XObject `name` of `resources`, or nil if not a form XObject.
+func loadFormXObject(name core.PdfObjectName, resources *model.PdfPageResources) (*formStream, error) {
+	if resources == nil {
+		return nil, nil
+	}
+	stream, xtype := resources.GetXObjectByName(name)
+	if xtype != model.XObjectTypeForm {
+		return nil, nil
+	}
+	return newFormStream(stream, resources)
+}
+
+// newFormStream returns the form XObject `stream`, whose resources default to the resources
+// `parentResources` of the content stream painting it.
+func newFormStream(stream *core.PdfObjectStream, parentResources *model.PdfPageResources) (*formStream, error) {
+	xform, err := model.NewXObjectFormFromStream(stream)
+	if err != nil {
+		return nil, err
+	}
+	content, err := xform.GetContentStream()
+	if err != nil {
+		return nil, err
+	}
+	matrix, err := toMatrix(xform.Matrix)
+	if err != nil {
+		common.Log.Debug("Invalid form Matrix: %v", err)
+		return nil, err
+	}
+
+	form := &formStream{
+		stream:    stream,
+		content:   string(content),
+		resources: xform.Resources,
+		matrix:    matrix,
+	}
+	if form.resources == nil {
+		form.resources = parentResources
+	}
+	if arr, ok := core.TraceToDirectObject(xform.BBox).(*core.PdfObjectArray); ok {
+		form.bbox, _ = model.NewPdfRectangle(*arr)
+	}
+	return form, nil
+}
+
+// toMatrix returns the transformation of the Matrix array `obj`, or the identity if nil.
+func toMatrix(obj core.PdfObject) (transform.Matrix, error) {
+	if obj == nil {
+		return transform.IdentityMatrix(), nil
+	}
+	arr, ok := core.TraceToDirectObject(obj).(*core.PdfObjectArray)
+	if !ok {
+		return transform.Matrix{}, errors.New("Type check error")
+	}
+	vals, err := arr.ToFloat64Array()
+	if err != nil {
+		return transform.Matrix{}, err
+	}
+	if len(vals) != 6 {
+		return transform.Matrix{}, errors.New("Range check error")
+	}
+	return transform.NewMatrix(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5]), nil
+}
+
+// annotationAppearance is the normal appearance stream of an annotation painted on a page.
+type annotationAppearance struct {
+	form *formStream
+	ctm  transform.Matrix // Maps the transformed appearance box to the annotation rectangle.
+}
+
+// annotationAppearances returns the normal appearance streams (/AP /N) of the annotations of the
+// page shown on screen, without those of widget annotations if `skipWidgets`.
+func (e *Extractor) annotationAppearances(skipWidgets bool) []annotationAppearance {
+	var appearances []annotationAppearance
+	for _, annotation := range e.annotations {
+		if _, isWidget := annotation.GetContext().(*model.PdfAnnotationWidget); isWidget && skipWidgets {
+			continue
+		}
+		if flags, ok := core.TraceToDirectObject(annotation.F).(*core.PdfObjectInteger); ok &&
+			*flags&(annotationFlagHidden|annotationFlagNoView) != 0 {
+			continue
+		}
+
+		stream := appearanceStream(annotation)
+		if stream == nil {
+			continue
+		}
+		form, err := newFormStream(stream, nil)
+		if err != nil {
+			common.Log.Debug("Unable to load annotation appearance: %v", err)
+			continue
+		}
+		rectArr, ok := core.TraceToDirectObject(annotation.Rect).(*core.PdfObjectArray)
+		if !ok || form.bbox == nil {
+			continue
+		}
+		rect, err := model.NewPdfRectangle(*rectArr)
+		if err != nil {
+			continue
+		}
+
+		// Algorithm 8.1: the appearance box transformed by Matrix is mapped to Rect.
+		llx, lly, urx, ury := form.matrix.TransformRect(form.bbox.Llx, form.bbox.Lly, form.bbox.Urx, form.bbox.Ury)
+		sx, sy := 1.0, 1.0
+		if urx > llx {
+			sx = (rect.Urx - rect.Llx) / (urx - llx)
+		}
+		if ury > lly {
+			sy = (rect.Ury - rect.Lly) / (ury - lly)
+		}
+		ctm := transform.TranslationMatrix(-llx, -lly).Mult(transform.ScaleMatrix(sx, sy)).
+			Mult(transform.TranslationMatrix(math.Min(rect.Llx, rect.Urx), math.Min(rect.Lly, rect.Ury)))
+		appearances = append(appearances, annotationAppearance{form: form, ctm: ctm})
+	}
+	return appearances
+}
+
+// appearanceStream returns the normal appearance stream of `annotation`, selected by its
+// appearance state if it has several, or nil if none.
+func appearanceStream(annotation *model.PdfAnnotation) *core.PdfObjectStream {
+	ap, ok := core.TraceToDirectObject(annotation.AP).(*core.PdfObjectDictionary)
+	if !ok {
+		return nil
+	}
+	switch n := core.TraceToDirectObject(ap.Get("N")).(type) {
+	case *core.PdfObjectStream:
+		return n
+	case *core.PdfObjectDictionary:
+		state, ok := core.TraceToDirectObject(annotation.AS).(*core.PdfObjectName)
+		if !ok {
+			return nil
+		}
+		stream, _ := core.TraceToDirectObject(n.Get(*state)).(*core.PdfObjectStream)
+		return stream
+	}
+	return nil
+}
+
+// formFieldValues returns the values of the text and choice fields of the widget annotations of
+// the page, the items of multiple selections separated by newlines.
+func (e *Extractor) formFieldValues() []string {
+	var values []string
+	for _, annotation := range e.annotations {
+		if _, isWidget := annotation.GetContext().(*model.PdfAnnotationWidget); !isWidget {
+			continue
+		}
+		ind, ok := annotation.GetContainingPdfObject().(*core.PdfIndirectObject)
+		if !ok {
+			continue
+		}
+		dict, ok := ind.PdfObject.(*core.PdfObjectDictionary)
+		if !ok {
+			continue
+		}
+
+		fieldType, _ := inheritedFieldAttribute(dict, "FT").(*core.PdfObjectName)
+		if fieldType == nil || (*fieldType != "Tx" && *fieldType != "Ch") {
+			continue
+		}
+		var items []string
+		switch v := inheritedFieldAttribute(dict, "V").(type) {
+		case *core.PdfObjectString:
+			items = append(items, decodeTextString(string(*v)))
+		case *core.PdfObjectArray:
+			for _, obj := range *v {
+				if s, ok := core.TraceToDirectObject(obj).(*core.PdfObjectString); ok {
+					items = append(items, decodeTextString(string(*s)))
+				}
+			}
+		}
+		if value := strings.Join(items, "\n"); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// inheritedFieldAttribute returns the attribute `key` of the field or widget dictionary `dict`,
+// inherited from its ancestors (12.7.3.1 "Field Dictionaries") if not set.
+func inheritedFieldAttribute(dict *core.PdfObjectDictionary, key core.PdfObjectName) core.PdfObject {
+	for depth := 0; dict != nil && depth < maxFieldDepth; depth++ {
+		if obj := core.TraceToDirectObject(dict.Get(key)); obj != nil {
+			return obj
+		}
+		dict, _ = core.TraceToDirectObject(dict.Get("Parent")).(*core.PdfObjectDictionary)
+	}
+	return nil
+}
+
+// decodeTextString returns the text string `s` (7.9.2.2 "Text String Type") decoded from UTF-16BE
+// if it starts with the byte order mark, and else from PDFDocEncoding.
+func decodeTextString(s string) string {
+	if len(s) >= 2 && s[0] == 0xFE && s[1] == 0xFF {
+		units := make([]uint16, 0, len(s)/2)
+		for i := 2; i+1 < len(s); i += 2 {
+			units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+
+	encoder := textencoding.NewPdfDocTextEncoder()
+	runes := make([]rune, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if r, ok := encoder.CharcodeToRune(s[i]); ok {
+			runes = append(runes, r)
+		} else {
+			runes = append(runes, rune(s[i]))
+		}
+	}
+	return string(runes)
+}
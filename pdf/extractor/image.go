@@ -0,0 +1,182 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// ImageMark is an image drawn on a page, by an image XObject or inline image, possibly within form
+// XObjects or the cells of tiling patterns.
+type ImageMark struct {
+	Image *model.Image
+
+	// Name of the image XObject in the resources of the content stream drawing it, or empty for
+	// inline images.
+	Name   string
+	Inline bool
+
+	// CTM maps the unit square of the image space to the default user space of the page.
+	CTM transform.Matrix
+	// Bounding box of the image in the default user space of the page.
+	BBox model.PdfRectangle
+
+	// Rendered size in points of the image edges.
+	Width, Height float64
+	// Resolution of the image as rendered, in pixels per inch.
+	DPIX, DPIY float64
+}
+
+// ExtractPageImages returns the images drawn by the content streams of the page in the order
+// they are drawn, including the images of form XObjects, of tiling patterns painted with and
+// inline images.  Images drawn several times are returned for each placement.
+func (e *Extractor) ExtractPageImages() ([]ImageMark, error) {
+	c := imageCollector{images: map[*core.PdfObjectStream]*model.Image{}, patterns: map[core.PdfObjectName]bool{}}
+	c.walker = newContentWalker(c.handle)
+	c.walker.enterForm = c.enterForm
+	err := c.walker.walk(e.contents, e.resources, transform.IdentityMatrix())
+	return c.marks, err
+}
+
+// imageCollector collects the images drawn by content streams.
+type imageCollector struct {
+	marks    []ImageMark
+	images   map[*core.PdfObjectStream]*model.Image // Decoded image XObjects.
+	patterns map[core.PdfObjectName]bool            // Patterns walked by the current content stream.
+	walker   *contentWalker                         // Walks the form XObjects and patterns.
+}
+
+// enterForm starts the patterns walked by a form XObject or pattern cell, and returns the function
+// restoring those of the content stream painting it.
+func (c *imageCollector) enterForm() func() {
+	saved := c.patterns
+	c.patterns = map[core.PdfObjectName]bool{}
+	return func() {
+		c.patterns = saved
+	}
+}
+
+// handle collects the images drawn by the operation `op`, and the images of the cells of the
+// tiling patterns it paints with.
+func (c *imageCollector) handle(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState,
+	resources *model.PdfPageResources, base transform.Matrix) error {
+	ctm := gs.CTM.Mult(base)
+	switch op.Operand {
+	case "BI":
+		if len(op.Params) != 1 {
+			return nil
+		}
+		iimg, ok := op.Params[0].(*contentstream.ContentStreamInlineImage)
+		if !ok {
+			return nil
+		}
+		img, err := iimg.ToImage(resources)
+		if err != nil {
+			common.Log.Debug("Unable to decode inline image: %v", err)
+			return nil
+		}
+		c.addMark(img, "", true, ctm)
+	case "Do":
+		if len(op.Params) != 1 || resources == nil {
+			return nil
+		}
+		name, ok := op.Params[0].(*core.PdfObjectName)
+		if !ok {
+			return nil
+		}
+		c.addImageXObject(*name, resources, ctm)
+	case "S", "s", "f", "F", "f*", "B", "B*", "b", "b*", "Tj", "TJ", "'", "\"":
+		// Painting with tiling patterns draws the images of their cells.
+		for _, color := range []model.PdfColor{gs.ColorNonStroking, gs.ColorStroking} {
+			pcolor, ok := color.(*model.PdfColorPattern)
+			if !ok || c.patterns[pcolor.PatternName] || resources == nil {
+				continue
+			}
+			c.patterns[pcolor.PatternName] = true
+			if err := c.walkPattern(pcolor.PatternName, resources, base); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addImageXObject collects the image XObject `name` of `resources` drawn with `ctm`.
+func (c *imageCollector) addImageXObject(name core.PdfObjectName, resources *model.PdfPageResources, ctm transform.Matrix) {
+	stream, xtype := resources.GetXObjectByName(name)
+	if xtype != model.XObjectTypeImage {
+		return
+	}
+	img, has := c.images[stream]
+	if !has {
+		ximg, err := model.NewXObjectImageFromStream(stream)
+		if err != nil {
+			common.Log.Debug("Unable to load image XObject %s: %v", name, err)
+			return
+		}
+		img, err = ximg.ToImage()
+		if err != nil {
+			common.Log.Debug("Unable to decode image XObject %s: %v", name, err)
+			return
+		}
+		c.images[stream] = img
+	}
+	c.addMark(img, string(name), false, ctm)
+}
+
+// walkPattern collects the images of the cell of the tiling pattern `name` of `resources` of a
+// content stream whose user space is mapped to the default user space of the page by `base`.
+func (c *imageCollector) walkPattern(name core.PdfObjectName, resources *model.PdfPageResources, base transform.Matrix) error {
+	pattern, found := resources.GetPatternByName(name)
+	if !found || !pattern.IsTiling() {
+		return nil
+	}
+	stream, ok := pattern.GetContainingPdfObject().(*core.PdfObjectStream)
+	if !ok {
+		return nil
+	}
+	tiling := pattern.GetAsTilingPattern()
+	content, err := tiling.GetContentStream()
+	if err != nil {
+		return err
+	}
+	var matrixObj core.PdfObject
+	if tiling.Matrix != nil {
+		matrixObj = tiling.Matrix
+	}
+	matrix, err := toMatrix(matrixObj)
+	if err != nil {
+		common.Log.Debug("Invalid Matrix of pattern %s: %v", name, err)
+		return nil
+	}
+	c.walker.walkNested(stream, string(content), tiling.Resources, matrix.Mult(base))
+	return nil
+}
+
+// addMark adds the mark of the image `img` drawn with `ctm`.
+func (c *imageCollector) addMark(img *model.Image, name string, inline bool, ctm transform.Matrix) {
+	llx, lly, urx, ury := ctm.TransformRect(0, 0, 1, 1)
+	mark := ImageMark{
+		Image:  img,
+		Name:   name,
+		Inline: inline,
+		CTM:    ctm,
+		BBox:   model.PdfRectangle{Llx: llx, Lly: lly, Urx: urx, Ury: ury},
+		Width:  ctm.ScalingFactorX(),
+		Height: ctm.ScalingFactorY(),
+	}
+	if mark.Width > 0 {
+		mark.DPIX = float64(img.Width) / mark.Width * 72
+	}
+	if mark.Height > 0 {
+		mark.DPIY = float64(img.Height) / mark.Height * 72
+	}
+	c.marks = append(c.marks, mark)
+}
@@ -0,0 +1,99 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func TestImageExtraction(t *testing.T) {
+	img := &model.Image{Width: 2, Height: 2, BitsPerComponent: 8, ColorComponents: 1, Data: []byte{0, 64, 128, 255}}
+	ximg, err := model.NewXObjectImageFromImage(img, nil, nil)
+	if err != nil {
+		t.Fatalf("Error creating image XObject: %v", err)
+	}
+	imgStream := ximg.ToPdfObject().(*core.PdfObjectStream)
+
+	// Form drawing the image scaled by 10, translated by (50, 50) by its matrix.
+	form := model.NewXObjectForm()
+	form.Resources = model.NewPdfPageResources()
+	form.Resources.SetXObjectByName("Im1", imgStream)
+	form.Matrix = core.MakeArrayFromFloats([]float64{1, 0, 0, 1, 50, 50})
+	if err := form.SetContentStream([]byte("q 10 0 0 10 0 0 cm /Im1 Do Q"), nil); err != nil {
+		t.Fatalf("Error setting form content: %v", err)
+	}
+
+	// Colored tiling pattern drawing the image scaled by 20 in its cell, scaled by 2 by its matrix.
+	patternResources := model.NewPdfPageResources()
+	patternResources.SetXObjectByName("Im1", imgStream)
+	pattern, err := core.MakeStream([]byte("20 0 0 20 0 0 cm /Im1 Do"), nil)
+	if err != nil {
+		t.Fatalf("Error creating pattern: %v", err)
+	}
+	pattern.Set("PatternType", core.MakeInteger(1))
+	pattern.Set("PaintType", core.MakeInteger(1))
+	pattern.Set("TilingType", core.MakeInteger(1))
+	pattern.Set("BBox", core.MakeArrayFromFloats([]float64{0, 0, 20, 20}))
+	pattern.Set("XStep", core.MakeFloat(20))
+	pattern.Set("YStep", core.MakeFloat(20))
+	pattern.Set("Resources", patternResources.ToPdfObject())
+	pattern.Set("Matrix", core.MakeArrayFromFloats([]float64{2, 0, 0, 2, 0, 0}))
+
+	e := Extractor{resources: model.NewPdfPageResources()}
+	e.resources.SetXObjectByName("Im1", imgStream)
+	e.resources.SetXObjectByName("Fm1", form.ToPdfObject().(*core.PdfObjectStream))
+	e.resources.SetPatternByName("P1", pattern)
+	e.contents = `
+q 100 0 0 50 200 300 cm /Im1 Do Q
+/Fm1 Do
+q 8 0 0 4 0 0 cm
+BI /W 4 /H 4 /BPC 8 /CS /G ID AAAAAAAAAAAAAAAA EI
+Q
+/Pattern cs /P1 scn 0 0 100 100 re f
+`
+
+	marks, err := e.ExtractPageImages()
+	if err != nil {
+		t.Fatalf("Error extracting images: %v", err)
+	}
+	if len(marks) != 4 {
+		t.Fatalf("Incorrect number of images %d", len(marks))
+	}
+
+	expected := []struct {
+		name       string
+		inline     bool
+		bbox       model.PdfRectangle
+		dpiX, dpiY float64
+	}{
+		{"Im1", false, model.PdfRectangle{Llx: 200, Lly: 300, Urx: 300, Ury: 350}, 1.44, 2.88},
+		{"Im1", false, model.PdfRectangle{Llx: 50, Lly: 50, Urx: 60, Ury: 60}, 14.4, 14.4},
+		{"", true, model.PdfRectangle{Llx: 0, Lly: 0, Urx: 8, Ury: 4}, 36, 72},
+		{"Im1", false, model.PdfRectangle{Llx: 0, Lly: 0, Urx: 40, Ury: 40}, 3.6, 3.6},
+	}
+	for i, mark := range marks {
+		exp := expected[i]
+		if mark.Name != exp.name || mark.Inline != exp.inline || !equalRects(mark.BBox, exp.bbox) {
+			t.Errorf("Incorrect image %d: %s %t %+v", i, mark.Name, mark.Inline, mark.BBox)
+		}
+		if math.Abs(mark.DPIX-exp.dpiX) > 1e-6 || math.Abs(mark.DPIY-exp.dpiY) > 1e-6 {
+			t.Errorf("Incorrect resolution of image %d: %g x %g", i, mark.DPIX, mark.DPIY)
+		}
+		if mark.Image == nil {
+			t.Errorf("Image %d missing", i)
+		}
+	}
+	if marks[0].Width != 100 || marks[0].Height != 50 || marks[0].Image.Width != 2 {
+		t.Errorf("Incorrect size of image 0: %g x %g", marks[0].Width, marks[0].Height)
+	}
+	if marks[2].Image.Width != 4 || len(marks[2].Image.Data) != 16 {
+		t.Errorf("Incorrect inline image %+v", marks[2].Image)
+	}
+}
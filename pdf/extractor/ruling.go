@@ -0,0 +1,213 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"sort"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Tolerance in points of the alignment of rulings and of the thickness of filled rectangles drawn
+// as rulings.
+const (
+	rulingTolerance = 1.0
+	rulingThickness = 2.0
+)
+
+// ruling is a horizontal or vertical line segment stroked or filled on a page, such as the border
+// of a table cell, in the default user space of the page.
+type ruling struct {
+	vertical bool
+	pos      float64 // y of horizontal rulings and x of vertical rulings.
+	lo, hi   float64 // Extent along the other coordinate.
+}
+
+// point is a point in the default user space of a page.
+type point struct {
+	x, y float64
+}
+
+// extractRulings returns the horizontal and vertical rulings painted by the content streams and
+// the form XObjects they paint: the axis aligned segments of stroked and filled paths.  Filled
+// rectangles thinner than rulingThickness give the ruling along their middle.
+func (e *Extractor) extractRulings() ([]ruling, error) {
+	c := rulingCollector{}
+	err := newContentWalker(c.handle).walk(e.contents, e.resources, transform.IdentityMatrix())
+	if err != nil {
+		return nil, err
+	}
+	return mergeRulings(c.rulings), nil
+}
+
+// rulingCollector collects the rulings of the paths painted by content streams.
+type rulingCollector struct {
+	rulings  []ruling
+	subpaths [][]point // Current path.
+	rects    [][]point // Rectangles of the current path, also in subpaths.
+}
+
+// handle constructs the current path with the operation `op`, and collects its rulings when
+// painted.
+func (c *rulingCollector) handle(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState,
+	resources *model.PdfPageResources, base transform.Matrix) error {
+	switch op.Operand {
+	case "m", "l", "c", "v", "y", "re":
+	case "h":
+		if n := len(c.subpaths); n > 0 && len(c.subpaths[n-1]) > 0 {
+			c.subpaths[n-1] = append(c.subpaths[n-1], c.subpaths[n-1][0])
+		}
+		return nil
+	case "S", "s", "f", "F", "f*", "B", "B*", "b", "b*":
+		closed := op.Operand == "s" || op.Operand == "b" || op.Operand == "b*"
+		filled := op.Operand != "S" && op.Operand != "s"
+		for _, path := range c.subpaths {
+			if (closed || filled) && len(path) > 0 {
+				path = append(path, path[0])
+			}
+			c.rulings = appendSegmentRulings(c.rulings, path)
+		}
+		if filled {
+			for _, rect := range c.rects {
+				c.rulings = appendThinRectRuling(c.rulings, rect)
+			}
+		}
+		c.subpaths, c.rects = nil, nil
+		return nil
+	case "n":
+		c.subpaths, c.rects = nil, nil
+		return nil
+	default:
+		return nil
+	}
+
+	vals, err := getNumbersAsFloat(op.Params)
+	if err != nil {
+		common.Log.Debug("Invalid %s operands: %v", op.Operand, err)
+		return nil
+	}
+	ctm := gs.CTM.Mult(base)
+	toPoint := func(i int) point {
+		x, y := ctm.Transform(vals[i], vals[i+1])
+		return point{x, y}
+	}
+	switch op.Operand {
+	case "m":
+		if len(vals) == 2 {
+			c.subpaths = append(c.subpaths, []point{toPoint(0)})
+		}
+	case "l", "c", "v", "y":
+		// Curves only move the current point.
+		if len(vals) < 2 || len(c.subpaths) == 0 {
+			return nil
+		}
+		n := len(c.subpaths)
+		if op.Operand == "l" {
+			c.subpaths[n-1] = append(c.subpaths[n-1], toPoint(len(vals)-2))
+		} else {
+			c.subpaths = append(c.subpaths, []point{toPoint(len(vals) - 2)})
+		}
+	case "re":
+		if len(vals) != 4 {
+			return nil
+		}
+		x, y, w, h := vals[0], vals[1], vals[2], vals[3]
+		var rect []point
+		for _, p := range [][2]float64{{x, y}, {x + w, y}, {x + w, y + h}, {x, y + h}, {x, y}} {
+			px, py := ctm.Transform(p[0], p[1])
+			rect = append(rect, point{px, py})
+		}
+		c.subpaths = append(c.subpaths, rect)
+		c.rects = append(c.rects, rect)
+	}
+	return nil
+}
+
+// appendSegmentRulings appends the rulings of the horizontal and vertical segments of the polyline
+// `path` to `rulings`.
+func appendSegmentRulings(rulings []ruling, path []point) []ruling {
+	for i := 1; i < len(path); i++ {
+		p, q := path[i-1], path[i]
+		switch {
+		case math.Abs(p.y-q.y) < rulingTolerance && math.Abs(p.x-q.x) >= rulingTolerance:
+			rulings = append(rulings, ruling{pos: (p.y + q.y) / 2, lo: math.Min(p.x, q.x), hi: math.Max(p.x, q.x)})
+		case math.Abs(p.x-q.x) < rulingTolerance && math.Abs(p.y-q.y) >= rulingTolerance:
+			rulings = append(rulings, ruling{vertical: true, pos: (p.x + q.x) / 2, lo: math.Min(p.y, q.y), hi: math.Max(p.y, q.y)})
+		}
+	}
+	return rulings
+}
+
+// appendThinRectRuling appends the ruling along the middle of the filled rectangle `rect` to
+// `rulings` if it is axis aligned and thinner than rulingThickness.
+func appendThinRectRuling(rulings []ruling, rect []point) []ruling {
+	llx, lly := math.Min(rect[0].x, rect[2].x), math.Min(rect[0].y, rect[2].y)
+	urx, ury := math.Max(rect[0].x, rect[2].x), math.Max(rect[0].y, rect[2].y)
+	if rect[0].x != rect[3].x && rect[0].y != rect[3].y {
+		return rulings // Rotated.
+	}
+	w, h := urx-llx, ury-lly
+	switch {
+	case h < rulingThickness && w >= rulingThickness:
+		rulings = append(rulings, ruling{pos: (lly + ury) / 2, lo: llx, hi: urx})
+	case w < rulingThickness && h >= rulingThickness:
+		rulings = append(rulings, ruling{vertical: true, pos: (llx + urx) / 2, lo: lly, hi: ury})
+	}
+	return rulings
+}
+
+// mergeRulings returns `rulings` with the aligned rulings which overlap or touch joined.
+func mergeRulings(rulings []ruling) []ruling {
+	sorted := make([]ruling, len(rulings))
+	copy(sorted, rulings)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.vertical != b.vertical {
+			return !a.vertical
+		}
+		if a.pos != b.pos {
+			return a.pos < b.pos
+		}
+		return a.lo < b.lo
+	})
+
+	var merged []ruling
+	for _, r := range sorted {
+		joined := false
+		for i := len(merged) - 1; i >= 0; i-- {
+			m := &merged[i]
+			if m.vertical != r.vertical || r.pos-m.pos >= rulingTolerance {
+				break
+			}
+			if r.lo <= m.hi+rulingTolerance && m.lo <= r.hi+rulingTolerance {
+				m.lo, m.hi = math.Min(m.lo, r.lo), math.Max(m.hi, r.hi)
+				joined = true
+				break
+			}
+		}
+		if !joined {
+			merged = append(merged, r)
+		}
+	}
+	return merged
+}
+
+// covers returns true if the ruling `r` covers the position `v` along its extent.
+func (r ruling) covers(v float64) bool {
+	return r.lo-rulingTolerance <= v && v <= r.hi+rulingTolerance
+}
+
+// intersects returns true if the rulings `r` and `s` cross or touch.
+func (r ruling) intersects(s ruling) bool {
+	if r.vertical == s.vertical {
+		return false
+	}
+	return r.covers(s.pos) && s.covers(r.pos)
+}
@@ -0,0 +1,435 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Minimum number of rows of tables detected by the alignment of text alone.
+const minTextTableRows = 3
+
+// TableCell is a cell of a table, which spans RowSpan rows and ColSpan columns of the table grid
+// from row Row and column Col.
+type TableCell struct {
+	Text    string // Lines separated by newlines.
+	BBox    model.PdfRectangle
+	Row     int
+	Col     int
+	RowSpan int
+	ColSpan int
+}
+
+// Table is a table detected on a page, either by its ruling lines or by the alignment of its text
+// in columns separated by whitespace.
+type Table struct {
+	BBox    model.PdfRectangle
+	Ruled   bool // True if the cells are delimited by ruling lines.
+	NumRows int
+	NumCols int
+	Rows    [][]TableCell // Cells by the row they start in, ordered left to right.
+}
+
+// Strings returns the text of the cells of the table by row and column.  The text of cells
+// spanning several rows or columns is in their top left position, and the others are empty.
+func (t Table) Strings() [][]string {
+	grid := make([][]string, t.NumRows)
+	for i := range grid {
+		grid[i] = make([]string, t.NumCols)
+	}
+	for _, row := range t.Rows {
+		for _, cell := range row {
+			grid[cell.Row][cell.Col] = cell.Text
+		}
+	}
+	return grid
+}
+
+// WriteCSV writes the text of the cells of the table to `w` as CSV records, one per row, as given
+// by Strings.
+func (t Table) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(t.Strings()); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// CSV returns the text of the cells of the table in CSV format, as written by WriteCSV.
+func (t Table) CSV() (string, error) {
+	var buf bytes.Buffer
+	err := t.WriteCSV(&buf)
+	return buf.String(), err
+}
+
+// ExtractTables returns the tables of the page, ordered top to bottom.  Tables are detected from
+// the grids formed by stroked or filled ruling lines, with cells merged where rulings are missing,
+// and from the text outside them aligned in at least two columns over minTextTableRows rows.
+func (e *Extractor) ExtractTables() ([]Table, error) {
+	marks, err := e.ExtractTextMarks()
+	if err != nil {
+		return nil, err
+	}
+	rulings, err := e.extractRulings()
+	if err != nil {
+		return nil, err
+	}
+
+	words := GroupWords(marks)
+	tables, words := findRuledTables(rulings, words)
+	tables = append(tables, findTextTables(words)...)
+
+	sort.SliceStable(tables, func(i, j int) bool {
+		return tables[i].BBox.Ury > tables[j].BBox.Ury
+	})
+	return tables, nil
+}
+
+// findRuledTables returns the tables of the grids formed by `rulings` with the text of `words`,
+// and the words outside them.
+func findRuledTables(rulings []ruling, words []TextWord) ([]Table, []TextWord) {
+	var tables []Table
+	for _, group := range connectedRulings(rulings) {
+		table, ok := newRuledTable(group, words)
+		if !ok {
+			continue
+		}
+		var outside []TextWord
+		for _, word := range words {
+			if !contains(table.BBox, word.BBox) {
+				outside = append(outside, word)
+			}
+		}
+		words = outside
+		tables = append(tables, table)
+	}
+	return tables, words
+}
+
+// connectedRulings returns the groups of `rulings` connected by their intersections.
+func connectedRulings(rulings []ruling) [][]ruling {
+	parent := make([]int, len(rulings))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := range rulings {
+		for j := i + 1; j < len(rulings); j++ {
+			if rulings[i].intersects(rulings[j]) {
+				parent[find(i)] = find(j)
+			}
+		}
+	}
+
+	var groups [][]ruling
+	index := map[int]int{}
+	for i, r := range rulings {
+		root := find(i)
+		k, has := index[root]
+		if !has {
+			k = len(groups)
+			index[root] = k
+			groups = append(groups, nil)
+		}
+		groups[k] = append(groups[k], r)
+	}
+	return groups
+}
+
+// newRuledTable returns the table of the grid formed by the connected rulings `group` with the
+// text of the words of `words` within its cells.  The bool return flag is false if the grid has a
+// single cell or no text.
+func newRuledTable(group []ruling, words []TextWord) (Table, bool) {
+	var xs, ys []float64
+	for _, r := range group {
+		if r.vertical {
+			xs = append(xs, r.pos)
+		} else {
+			ys = append(ys, r.pos)
+		}
+	}
+	xs, ys = clusterPositions(xs), clusterPositions(ys)
+	if len(xs) < 2 || len(ys) < 2 || (len(xs)-1)*(len(ys)-1) < 2 {
+		return Table{}, false
+	}
+	// Rows top to bottom.
+	for i, j := 0, len(ys)-1; i < j; i, j = i+1, j-1 {
+		ys[i], ys[j] = ys[j], ys[i]
+	}
+
+	// hasBoundary returns true if a ruling of the group at `pos` covers `v`.
+	hasBoundary := func(vertical bool, pos, v float64) bool {
+		for _, r := range group {
+			if r.vertical == vertical && math.Abs(r.pos-pos) < rulingTolerance && r.covers(v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	numRows, numCols := len(ys)-1, len(xs)-1
+	table := Table{
+		BBox:    model.PdfRectangle{Llx: xs[0], Lly: ys[numRows], Urx: xs[numCols], Ury: ys[0]},
+		Ruled:   true,
+		NumRows: numRows,
+		NumCols: numCols,
+		Rows:    make([][]TableCell, numRows),
+	}
+
+	assigned := make([][]bool, numRows)
+	for i := range assigned {
+		assigned[i] = make([]bool, numCols)
+	}
+	hasText := false
+	for row := 0; row < numRows; row++ {
+		midY := (ys[row] + ys[row+1]) / 2
+		for col := 0; col < numCols; col++ {
+			if assigned[row][col] {
+				continue
+			}
+			colSpan := 1
+			for col+colSpan < numCols && !assigned[row][col+colSpan] &&
+				!hasBoundary(true, xs[col+colSpan], midY) {
+				colSpan++
+			}
+			rowSpan := 1
+			for row+rowSpan < numRows {
+				open := true
+				for c := col; c < col+colSpan; c++ {
+					midX := (xs[c] + xs[c+1]) / 2
+					if assigned[row+rowSpan][c] || hasBoundary(false, ys[row+rowSpan], midX) {
+						open = false
+						break
+					}
+				}
+				if !open {
+					break
+				}
+				rowSpan++
+			}
+			for r := row; r < row+rowSpan; r++ {
+				for c := col; c < col+colSpan; c++ {
+					assigned[r][c] = true
+				}
+			}
+
+			cell := TableCell{
+				BBox:    model.PdfRectangle{Llx: xs[col], Lly: ys[row+rowSpan], Urx: xs[col+colSpan], Ury: ys[row]},
+				Row:     row,
+				Col:     col,
+				RowSpan: rowSpan,
+				ColSpan: colSpan,
+			}
+			var cellWords []TextWord
+			for _, word := range words {
+				if contains(cell.BBox, word.BBox) {
+					cellWords = append(cellWords, word)
+				}
+			}
+			cell.Text = wordsText(cellWords)
+			if cell.Text != "" {
+				hasText = true
+			}
+			table.Rows[row] = append(table.Rows[row], cell)
+		}
+	}
+	return table, hasText
+}
+
+// findTextTables returns the tables formed by `words` aligned in columns separated by whitespace:
+// runs of at least minTextTableRows consecutive lines of two or more segments, separated by gaps
+// wider than their height, whose segments fall into two or more columns.
+func findTextTables(words []TextWord) []Table {
+	var tables []Table
+	var run []textRow
+	flush := func() {
+		if table, ok := newTextTable(run); ok {
+			tables = append(tables, table)
+		}
+		run = nil
+	}
+	for _, row := range textRows(words) {
+		if len(row.segments) < 2 {
+			flush()
+			continue
+		}
+		if n := len(run); n > 0 {
+			last := run[n-1].bbox
+			h := math.Max(height(last), height(row.bbox))
+			if last.Lly-row.bbox.Ury >= 2*h {
+				flush()
+			}
+		}
+		run = append(run, row)
+	}
+	flush()
+	return tables
+}
+
+// textRow is a row of words of a page split into segments at wide gaps.
+type textRow struct {
+	bbox     model.PdfRectangle
+	segments []TextWord // Words of each segment joined, ordered left to right.
+}
+
+// textRows returns the rows of `words` overlapping vertically, ordered top to bottom, with their
+// words joined into segments separated by gaps wider than their height.
+func textRows(words []TextWord) []textRow {
+	sorted := make([]TextWord, len(words))
+	copy(sorted, words)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].BBox.Ury > sorted[j].BBox.Ury
+	})
+
+	var rows [][]TextWord
+	var bboxes []model.PdfRectangle
+	for _, word := range sorted {
+		added := false
+		for i := range rows {
+			h := math.Min(height(bboxes[i]), height(word.BBox))
+			if verticalOverlap(bboxes[i], word.BBox) >= 0.5*h {
+				rows[i] = append(rows[i], word)
+				bboxes[i] = union(bboxes[i], word.BBox)
+				added = true
+				break
+			}
+		}
+		if !added {
+			rows = append(rows, []TextWord{word})
+			bboxes = append(bboxes, word.BBox)
+		}
+	}
+
+	textRows := make([]textRow, len(rows))
+	for i, row := range rows {
+		sort.SliceStable(row, func(a, b int) bool {
+			return row[a].BBox.Llx < row[b].BBox.Llx
+		})
+		h := height(bboxes[i])
+		var segments []TextWord
+		for _, word := range row {
+			if n := len(segments); n > 0 && word.BBox.Llx-segments[n-1].BBox.Urx < h {
+				segments[n-1].Text += " " + word.Text
+				segments[n-1].BBox = union(segments[n-1].BBox, word.BBox)
+				segments[n-1].Marks = append(segments[n-1].Marks, word.Marks...)
+				continue
+			}
+			segments = append(segments, word)
+		}
+		textRows[i] = textRow{bbox: bboxes[i], segments: segments}
+	}
+	sort.SliceStable(textRows, func(i, j int) bool {
+		return textRows[i].bbox.Ury > textRows[j].bbox.Ury
+	})
+	return textRows
+}
+
+// newTextTable returns the table of the consecutive rows `rows` with columns given by the union
+// of the horizontal extents of their segments.  The bool return flag is false if there are less
+// than minTextTableRows rows or 2 columns.
+func newTextTable(rows []textRow) (Table, bool) {
+	if len(rows) < minTextTableRows {
+		return Table{}, false
+	}
+
+	type interval struct{ lo, hi float64 }
+	var columns []interval
+	for _, row := range rows {
+		for _, segment := range row.segments {
+			columns = append(columns, interval{segment.BBox.Llx, segment.BBox.Urx})
+		}
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		return columns[i].lo < columns[j].lo
+	})
+	merged := columns[:1]
+	for _, c := range columns[1:] {
+		last := &merged[len(merged)-1]
+		if c.lo <= last.hi {
+			last.hi = math.Max(last.hi, c.hi)
+		} else {
+			merged = append(merged, c)
+		}
+	}
+	columns = merged
+	if len(columns) < 2 {
+		return Table{}, false
+	}
+
+	table := Table{
+		NumRows: len(rows),
+		NumCols: len(columns),
+		Rows:    make([][]TableCell, len(rows)),
+	}
+	for i, row := range rows {
+		for j, column := range columns {
+			cell := TableCell{
+				BBox:    model.PdfRectangle{Llx: column.lo, Lly: row.bbox.Lly, Urx: column.hi, Ury: row.bbox.Ury},
+				Row:     i,
+				Col:     j,
+				RowSpan: 1,
+				ColSpan: 1,
+			}
+			var texts []string
+			for _, segment := range row.segments {
+				if segment.BBox.Llx >= column.lo && segment.BBox.Urx <= column.hi {
+					texts = append(texts, segment.Text)
+				}
+			}
+			cell.Text = strings.Join(texts, " ")
+			table.Rows[i] = append(table.Rows[i], cell)
+			if i == 0 && j == 0 {
+				table.BBox = cell.BBox
+			} else {
+				table.BBox = union(table.BBox, cell.BBox)
+			}
+		}
+	}
+	return table, true
+}
+
+// clusterPositions returns the distinct positions of `positions` in increasing order, positions
+// closer than rulingTolerance being merged.
+func clusterPositions(positions []float64) []float64 {
+	sort.Float64s(positions)
+	var clusters []float64
+	for _, pos := range positions {
+		if n := len(clusters); n > 0 && pos-clusters[n-1] < rulingTolerance {
+			continue
+		}
+		clusters = append(clusters, pos)
+	}
+	return clusters
+}
+
+// wordsText returns the text of `words` grouped into lines, separated by newlines.
+func wordsText(words []TextWord) string {
+	lines := GroupLines(words)
+	texts := make([]string, len(lines))
+	for i, line := range lines {
+		texts[i] = line.Text
+	}
+	return strings.Join(texts, "\n")
+}
+
+// contains returns true if the center of `inner` is in `outer`.
+func contains(outer, inner model.PdfRectangle) bool {
+	x, y := (inner.Llx+inner.Urx)/2, (inner.Lly+inner.Ury)/2
+	return outer.Llx <= x && x <= outer.Urx && outer.Lly <= y && y <= outer.Ury
+}
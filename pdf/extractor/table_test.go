@@ -0,0 +1,89 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+func TestRuledTableExtraction(t *testing.T) {
+	// A 2x2 grid whose top row is a single cell, the vertical ruling only separating the bottom row.
+	e := newHelveticaExtractor(`
+100 660 200 40 re S
+100 680 m 300 680 l S
+200 660 m 200 680 l S
+BT
+/F1 10 Tf
+1 0 0 1 110 685 Tm (Account) Tj
+1 0 0 1 110 665 Tm (Date) Tj
+1 0 0 1 210 665 Tm (12.50) Tj
+ET
+`)
+
+	tables, err := e.ExtractTables()
+	if err != nil {
+		t.Fatalf("Error extracting tables: %v", err)
+	}
+	if len(tables) != 1 {
+		t.Fatalf("Incorrect number of tables %d", len(tables))
+	}
+	table := tables[0]
+	if !table.Ruled || table.NumRows != 2 || table.NumCols != 2 {
+		t.Fatalf("Incorrect table %+v", table)
+	}
+	if len(table.Rows[0]) != 1 || table.Rows[0][0].ColSpan != 2 || table.Rows[0][0].RowSpan != 1 {
+		t.Errorf("Incorrect header row %+v", table.Rows[0])
+	}
+	if !equalRects(table.Rows[1][1].BBox, model.PdfRectangle{Llx: 200, Lly: 660, Urx: 300, Ury: 680}) {
+		t.Errorf("Incorrect cell bbox %+v", table.Rows[1][1].BBox)
+	}
+
+	expected := [][]string{{"Account", ""}, {"Date", "12.50"}}
+	if strings := table.Strings(); !reflect.DeepEqual(strings, expected) {
+		t.Errorf("Incorrect cells %q", strings)
+	}
+	csv, err := table.CSV()
+	if err != nil || csv != "Account,\nDate,12.50\n" {
+		t.Errorf("Incorrect CSV %q (%v)", csv, err)
+	}
+}
+
+func TestTextTableExtraction(t *testing.T) {
+	e := newHelveticaExtractor(`
+BT
+/F1 10 Tf
+1 0 0 1 100 500 Tm (Date) Tj
+1 0 0 1 250 500 Tm (Amount) Tj
+1 0 0 1 100 485 Tm (01/02) Tj
+1 0 0 1 250 485 Tm (10.00) Tj
+1 0 0 1 100 470 Tm (03/04 ATM) Tj
+1 0 0 1 250 470 Tm (7.25) Tj
+1 0 0 1 100 400 Tm (Total due) Tj
+ET
+`)
+
+	tables, err := e.ExtractTables()
+	if err != nil {
+		t.Fatalf("Error extracting tables: %v", err)
+	}
+	if len(tables) != 1 {
+		t.Fatalf("Incorrect number of tables %d", len(tables))
+	}
+	table := tables[0]
+	if table.Ruled || table.NumRows != 3 || table.NumCols != 2 {
+		t.Fatalf("Incorrect table %+v", table)
+	}
+	expected := [][]string{{"Date", "Amount"}, {"01/02", "10.00"}, {"03/04 ATM", "7.25"}}
+	if strings := table.Strings(); !reflect.DeepEqual(strings, expected) {
+		t.Errorf("Incorrect cells %q", strings)
+	}
+	if table.BBox.Llx != 100 || table.BBox.Lly != 468 || table.BBox.Ury != 508 {
+		t.Errorf("Incorrect table bbox %+v", table.BBox)
+	}
+}
@@ -9,11 +9,13 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/unidoc/unidoc/common"
 	"github.com/unidoc/unidoc/pdf/contentstream"
 	"github.com/unidoc/unidoc/pdf/core"
 	"github.com/unidoc/unidoc/pdf/internal/cmap"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
 	"github.com/unidoc/unidoc/pdf/model"
 )
 
@@ -21,213 +23,305 @@ import (
 // account character encoding via CMaps in the PDF file.
 // The text is processed linearly e.g. in the order in which it appears. A best effort is done to add
 // spaces and newlines.
+// The text of form XObjects is included where they are painted, and the text of annotations and
+// form fields as set by the Options of the Extractor after the text of the page.
 func (e *Extractor) ExtractText() (string, error) {
 	var buf bytes.Buffer
 
-	cstreamParser := contentstream.NewContentStreamParser(e.contents)
-	operations, err := cstreamParser.Parse()
+	x := newTextExtractor(&buf)
+	w := newContentWalker(x.handle)
+	w.enterForm = x.enterForm
+	err := w.walk(e.contents, e.resources, transform.IdentityMatrix())
 	if err != nil {
 		return buf.String(), err
 	}
 
-	processor := contentstream.NewContentStreamProcessor(*operations)
-
-	var codemap *cmap.CMap
-	inText := false
-	xPos, yPos := float64(-1), float64(-1)
-
-	processor.AddHandler(contentstream.HandlerConditionEnumAllOperands, "",
-		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
-			operand := op.Operand
-			switch operand {
-			case "BT":
-				inText = true
-			case "ET":
-				inText = false
-			case "Tf":
-				if !inText {
-					common.Log.Debug("Tf operand outside text")
-					return nil
-				}
+	if e.options.IncludeAnnotations {
+		for _, appearance := range e.annotationAppearances(e.options.IncludeFormFieldValues) {
+			form := appearance.form
+			w.walkNested(form.stream, form.content, form.resources, form.matrix.Mult(appearance.ctm))
+		}
+	}
+	if e.options.IncludeFormFieldValues {
+		for _, value := range e.formFieldValues() {
+			appendBlock(&buf, value)
+		}
+	}
 
-				if len(op.Params) != 2 {
-					common.Log.Debug("Error Tf should only get 2 input params, got %d", len(op.Params))
-					return errors.New("Incorrect parameter count")
-				}
+	procBuf(&buf)
 
-				codemap = nil
+	return buf.String(), nil
+}
 
-				fontName, ok := op.Params[0].(*core.PdfObjectName)
-				if !ok {
-					common.Log.Debug("Error Tf font input not a name")
-					return errors.New("Tf range error")
-				}
+// textExtractor writes the text of content streams to a buffer.
+type textExtractor struct {
+	buf        *bytes.Buffer
+	codemap    *cmap.CMap
+	font       *model.PdfFont
+	inText     bool
+	xPos, yPos float64
+}
 
-				if resources == nil {
-					return nil
-				}
+// newTextExtractor returns a textExtractor writing to `buf`.
+func newTextExtractor(buf *bytes.Buffer) *textExtractor {
+	return &textExtractor{buf: buf, xPos: -1, yPos: -1}
+}
 
-				fontObj, found := resources.GetFontByName(*fontName)
-				if !found {
-					common.Log.Debug("Font not found...")
-					return errors.New("Font not in resources")
-				}
+// enterForm starts the text of a form XObject or annotation appearance, written to a buffer of its
+// own and appended to the text of the content stream painting it on a new line when done.
+func (x *textExtractor) enterForm() func() {
+	saved := *x
+	*x = *newTextExtractor(&bytes.Buffer{})
+	return func() {
+		text := x.buf.String()
+		*x = saved
+		appendBlock(x.buf, text)
+	}
+}
 
-				fontObj = core.TraceToDirectObject(fontObj)
-				if fontDict, isDict := fontObj.(*core.PdfObjectDictionary); isDict {
-					toUnicode := fontDict.Get("ToUnicode")
-					if toUnicode != nil {
-						toUnicode = core.TraceToDirectObject(toUnicode)
-						toUnicodeStream, ok := toUnicode.(*core.PdfObjectStream)
-						if !ok {
-							return errors.New("Invalid ToUnicode entry - not a stream")
-						}
-						decoded, err := core.DecodeStream(toUnicodeStream)
-						if err != nil {
-							return err
-						}
-
-						codemap, err = cmap.LoadCmapFromData(decoded)
-						if err != nil {
-							return err
-						}
-					}
-				}
-			case "T*":
-				if !inText {
-					common.Log.Debug("T* operand outside text")
-					return nil
+// handle writes the text shown by the operation `op`.
+func (x *textExtractor) handle(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState,
+	resources *model.PdfPageResources, base transform.Matrix) error {
+	operand := op.Operand
+	switch operand {
+	case "BT":
+		x.inText = true
+	case "ET":
+		x.inText = false
+	case "Tf":
+		if !x.inText {
+			common.Log.Debug("Tf operand outside text")
+			return nil
+		}
+
+		if len(op.Params) != 2 {
+			common.Log.Debug("Error Tf should only get 2 input params, got %d", len(op.Params))
+			return errors.New("Incorrect parameter count")
+		}
+
+		x.codemap = nil
+		x.font = nil
+
+		fontName, ok := op.Params[0].(*core.PdfObjectName)
+		if !ok {
+			common.Log.Debug("Error Tf font input not a name")
+			return errors.New("Tf range error")
+		}
+
+		if resources == nil {
+			return nil
+		}
+
+		fontObj, found := resources.GetFontByName(*fontName)
+		if !found {
+			common.Log.Debug("Font not found...")
+			return errors.New("Font not in resources")
+		}
+
+		fontObj = core.TraceToDirectObject(fontObj)
+		if fontDict, isDict := fontObj.(*core.PdfObjectDictionary); isDict {
+			toUnicode := fontDict.Get("ToUnicode")
+			if toUnicode != nil {
+				toUnicode = core.TraceToDirectObject(toUnicode)
+				toUnicodeStream, ok := toUnicode.(*core.PdfObjectStream)
+				if !ok {
+					return errors.New("Invalid ToUnicode entry - not a stream")
 				}
-				buf.WriteString("\n")
-			case "Td", "TD":
-				if !inText {
-					common.Log.Debug("Td/TD operand outside text")
-					return nil
+				decoded, err := core.DecodeStream(toUnicodeStream)
+				if err != nil {
+					return err
 				}
 
-				// Params: [tx ty], corresponeds to Tm=Tlm=[1 0 0;0 1 0;tx ty 1]*Tm
-				if len(op.Params) != 2 {
-					common.Log.Debug("Td/TD invalid arguments")
-					return nil
-				}
-				tx, err := getNumberAsFloat(op.Params[0])
+				x.codemap, err = cmap.LoadCmapFromData(decoded)
 				if err != nil {
-					common.Log.Debug("Td Float parse error")
-					return nil
+					return err
 				}
-				ty, err := getNumberAsFloat(op.Params[1])
+			} else if cm := encodingCMap(fontDict); cm != nil && cm.HasUnicode() {
+				// Without a ToUnicode CMap, the codes of composite fonts are decoded by
+				// their predefined CMap, e.g. 90ms-RKSJ-H.
+				x.codemap = cm
+			} else {
+				// Without a ToUnicode CMap, the codes of simple fonts are decoded by their
+				// encoding, possibly the built-in encoding of the font program.
+				f, err := model.NewPdfFontFromPdfObject(fontObj)
 				if err != nil {
-					common.Log.Debug("Td Float parse error")
-					return nil
+					common.Log.Debug("Unable to load font: %v", err)
+				} else {
+					x.font = f
 				}
+			}
+		}
+	case "T*":
+		if !x.inText {
+			common.Log.Debug("T* operand outside text")
+			return nil
+		}
+		x.buf.WriteString("\n")
+	case "Td", "TD":
+		if !x.inText {
+			common.Log.Debug("Td/TD operand outside text")
+			return nil
+		}
 
-				if tx > 0 {
-					buf.WriteString(" ")
-				}
-				if ty < 0 {
-					// TODO: More flexible space characters?
-					buf.WriteString("\n")
-				}
-			case "Tm":
-				if !inText {
-					common.Log.Debug("Tm operand outside text")
-					return nil
-				}
+		// Params: [tx ty], corresponeds to Tm=Tlm=[1 0 0;0 1 0;tx ty 1]*Tm
+		if len(op.Params) != 2 {
+			common.Log.Debug("Td/TD invalid arguments")
+			return nil
+		}
+		tx, err := getNumberAsFloat(op.Params[0])
+		if err != nil {
+			common.Log.Debug("Td Float parse error")
+			return nil
+		}
+		ty, err := getNumberAsFloat(op.Params[1])
+		if err != nil {
+			common.Log.Debug("Td Float parse error")
+			return nil
+		}
 
-				// Params: a,b,c,d,e,f as in Tm = [a b 0; c d 0; e f 1].
-				// The last two (e,f) represent translation.
-				if len(op.Params) != 6 {
-					return errors.New("Tm: Invalid number of inputs")
-				}
-				xfloat, ok := op.Params[4].(*core.PdfObjectFloat)
-				if !ok {
-					xint, ok := op.Params[4].(*core.PdfObjectInteger)
-					if !ok {
-						return nil
-					}
-					xfloat = core.MakeFloat(float64(*xint))
-				}
-				yfloat, ok := op.Params[5].(*core.PdfObjectFloat)
-				if !ok {
-					yint, ok := op.Params[5].(*core.PdfObjectInteger)
-					if !ok {
-						return nil
-					}
-					yfloat = core.MakeFloat(float64(*yint))
-				}
-				if yPos == -1 {
-					yPos = float64(*yfloat)
-				} else if yPos > float64(*yfloat) {
-					buf.WriteString("\n")
-					xPos = float64(*xfloat)
-					yPos = float64(*yfloat)
-					return nil
-				}
-				if xPos == -1 {
-					xPos = float64(*xfloat)
-				} else if xPos < float64(*xfloat) {
-					buf.WriteString("\t")
-					xPos = float64(*xfloat)
-				}
-			case "TJ":
-				if !inText {
-					common.Log.Debug("TJ operand outside text")
-					return nil
-				}
-				if len(op.Params) < 1 {
-					return nil
-				}
-				paramList, ok := op.Params[0].(*core.PdfObjectArray)
-				if !ok {
-					return fmt.Errorf("Invalid parameter type, no array (%T)", op.Params[0])
-				}
-				for _, obj := range *paramList {
-					switch v := obj.(type) {
-					case *core.PdfObjectString:
-						if codemap != nil {
-							buf.WriteString(codemap.CharcodeBytesToUnicode([]byte(*v)))
-						} else {
-							buf.WriteString(string(*v))
-						}
-					case *core.PdfObjectFloat:
-						if *v < -100 {
-							buf.WriteString(" ")
-						}
-					case *core.PdfObjectInteger:
-						if *v < -100 {
-							buf.WriteString(" ")
-						}
-					}
-				}
-			case "Tj":
-				if !inText {
-					common.Log.Debug("Tj operand outside text")
-					return nil
-				}
-				if len(op.Params) < 1 {
-					return nil
-				}
-				param, ok := op.Params[0].(*core.PdfObjectString)
-				if !ok {
-					return fmt.Errorf("Invalid parameter type, not string (%T)", op.Params[0])
+		if tx > 0 {
+			x.buf.WriteString(" ")
+		}
+		if ty < 0 {
+			// TODO: More flexible space characters?
+			x.buf.WriteString("\n")
+		}
+	case "Tm":
+		if !x.inText {
+			common.Log.Debug("Tm operand outside text")
+			return nil
+		}
+
+		// Params: a,b,c,d,e,f as in Tm = [a b 0; c d 0; e f 1].
+		// The last two (e,f) represent translation.
+		if len(op.Params) != 6 {
+			return errors.New("Tm: Invalid number of inputs")
+		}
+		xfloat, ok := op.Params[4].(*core.PdfObjectFloat)
+		if !ok {
+			xint, ok := op.Params[4].(*core.PdfObjectInteger)
+			if !ok {
+				return nil
+			}
+			xfloat = core.MakeFloat(float64(*xint))
+		}
+		yfloat, ok := op.Params[5].(*core.PdfObjectFloat)
+		if !ok {
+			yint, ok := op.Params[5].(*core.PdfObjectInteger)
+			if !ok {
+				return nil
+			}
+			yfloat = core.MakeFloat(float64(*yint))
+		}
+		if x.yPos == -1 {
+			x.yPos = float64(*yfloat)
+		} else if x.yPos > float64(*yfloat) {
+			x.buf.WriteString("\n")
+			x.xPos = float64(*xfloat)
+			x.yPos = float64(*yfloat)
+			return nil
+		}
+		if x.xPos == -1 {
+			x.xPos = float64(*xfloat)
+		} else if x.xPos < float64(*xfloat) {
+			x.buf.WriteString("\t")
+			x.xPos = float64(*xfloat)
+		}
+	case "TJ":
+		if !x.inText {
+			common.Log.Debug("TJ operand outside text")
+			return nil
+		}
+		if len(op.Params) < 1 {
+			return nil
+		}
+		paramList, ok := op.Params[0].(*core.PdfObjectArray)
+		if !ok {
+			return fmt.Errorf("Invalid parameter type, no array (%T)", op.Params[0])
+		}
+		for _, obj := range *paramList {
+			switch v := obj.(type) {
+			case *core.PdfObjectString:
+				x.buf.WriteString(decodeText([]byte(*v), x.codemap, x.font))
+			case *core.PdfObjectFloat:
+				if *v < -100 {
+					x.buf.WriteString(" ")
 				}
-				if codemap != nil {
-					buf.WriteString(codemap.CharcodeBytesToUnicode([]byte(*param)))
-				} else {
-					buf.WriteString(string(*param))
+			case *core.PdfObjectInteger:
+				if *v < -100 {
+					x.buf.WriteString(" ")
 				}
 			}
-
+		}
+	case "Tj":
+		if !x.inText {
+			common.Log.Debug("Tj operand outside text")
+			return nil
+		}
+		if len(op.Params) < 1 {
 			return nil
-		})
+		}
+		param, ok := op.Params[0].(*core.PdfObjectString)
+		if !ok {
+			return fmt.Errorf("Invalid parameter type, not string (%T)", op.Params[0])
+		}
+		x.buf.WriteString(decodeText([]byte(*param), x.codemap, x.font))
+	}
 
-	err = processor.Process(e.resources)
-	if err != nil {
-		common.Log.Error("Error processing: %v", err)
-		return buf.String(), err
+	return nil
+}
+
+// appendBlock appends `text` without surrounding whitespace to `buf` on a new line.
+func appendBlock(buf *bytes.Buffer, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteString("\n")
 	}
+	buf.WriteString(text)
+}
 
-	procBuf(&buf)
+// encodingCMap returns the CMap of the Encoding of the Type0 font `fontDict`, giving the lengths
+// of its character codes and, unless Identity-H or Identity-V, their Unicode text.  Defaults to
+// Identity-H if missing or invalid.  Returns nil if not a Type0 font.
+func encodingCMap(fontDict *core.PdfObjectDictionary) *cmap.CMap {
+	if subtype, ok := core.TraceToDirectObject(fontDict.Get("Subtype")).(*core.PdfObjectName); !ok || *subtype != "Type0" {
+		return nil
+	}
 
-	return buf.String(), nil
+	var cm *cmap.CMap
+	var err error
+	switch t := core.TraceToDirectObject(fontDict.Get("Encoding")).(type) {
+	case *core.PdfObjectName:
+		cm, err = cmap.LoadPredefinedCmap(string(*t))
+	case *core.PdfObjectStream:
+		var decoded []byte
+		decoded, err = core.DecodeStream(t)
+		if err == nil {
+			cm, err = cmap.LoadCmapFromData(decoded)
+		}
+	default:
+		err = errors.New("Encoding missing")
+	}
+	if err != nil {
+		common.Log.Debug("Unable to load the encoding CMap, assuming Identity-H: %v", err)
+		cm, _ = cmap.LoadPredefinedCmap("Identity-H")
+	}
+	return cm
+}
+
+// decodeText decodes the character codes `data` of shown text to Unicode by the ToUnicode CMap
+// `codemap` or else the encoding of `font`.  The codes are returned as is if neither is given.
+func decodeText(data []byte, codemap *cmap.CMap, font *model.PdfFont) string {
+	if codemap != nil {
+		return codemap.CharcodeBytesToUnicode(data)
+	}
+	if font != nil {
+		if text, ok := font.CharcodeBytesToUnicode(data); ok {
+			return text
+		}
+	}
+	return string(data)
 }
@@ -7,7 +7,12 @@ package extractor
 
 import (
 	"flag"
+	"math"
+	"strings"
 	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
 )
 
 func init() {
@@ -41,3 +46,213 @@ func TestTextExtraction1(t *testing.T) {
 		return
 	}
 }
+
+func TestTextExtractionPredefinedCMap(t *testing.T) {
+	font := core.MakeDict()
+	font.Set("Type", core.MakeName("Font"))
+	font.Set("Subtype", core.MakeName("Type0"))
+	font.Set("BaseFont", core.MakeName("MS-Mincho"))
+	font.Set("Encoding", core.MakeName("90ms-RKSJ-H"))
+
+	e := Extractor{}
+	e.resources = model.NewPdfPageResources()
+	e.resources.SetFontByName("F1", font)
+	// Hiragana a, A and halfwidth katakana a in Shift-JIS.
+	e.contents = "BT\n/F1 12 Tf\n<82A041B1> Tj\nET\n"
+
+	s, err := e.ExtractText()
+	if err != nil {
+		t.Fatalf("Error extracting text: %v", err)
+	}
+	if !strings.HasPrefix(s, "あAｱ") {
+		t.Errorf("Text mismatch (%q)", s)
+	}
+}
+
+// newHelveticaExtractor returns an extractor of `contents` with the font Helvetica as F1.
+func newHelveticaExtractor(contents string) *Extractor {
+	font := core.MakeDict()
+	font.Set("Type", core.MakeName("Font"))
+	font.Set("Subtype", core.MakeName("Type1"))
+	font.Set("BaseFont", core.MakeName("Helvetica"))
+
+	e := &Extractor{contents: contents, resources: model.NewPdfPageResources()}
+	e.resources.SetFontByName("F1", font)
+	return e
+}
+
+// equalRects returns true if the coordinates of `a` and `b` are equal up to rounding.
+func equalRects(a, b model.PdfRectangle) bool {
+	return math.Abs(a.Llx-b.Llx) < 1e-6 && math.Abs(a.Lly-b.Lly) < 1e-6 &&
+		math.Abs(a.Urx-b.Urx) < 1e-6 && math.Abs(a.Ury-b.Ury) < 1e-6
+}
+
+func TestTextMarks(t *testing.T) {
+	e := newHelveticaExtractor("BT\n/F1 10 Tf\n1 0 0 1 100 700 Tm\n(Hello World) Tj\n0 -12 Td\n(Second line) Tj\nET\n")
+
+	marks, err := e.ExtractTextMarks()
+	if err != nil {
+		t.Fatalf("Error extracting text marks: %v", err)
+	}
+	if len(marks) != 22 {
+		t.Fatalf("Incorrect number of marks %d", len(marks))
+	}
+
+	h := marks[0]
+	if h.Text != "H" || h.FontName != "Helvetica" || h.FontSize != 10 || h.RenderMode != 0 {
+		t.Errorf("Incorrect mark %+v", h)
+	}
+	// Helvetica H is 722 units wide, and the default ascent and descent are 0.8 and -0.2.
+	if !equalRects(h.BBox, model.PdfRectangle{Llx: 100, Lly: 698, Urx: 107.22, Ury: 708}) {
+		t.Errorf("Incorrect bbox of H %+v", h.BBox)
+	}
+	if e := marks[1]; e.Text != "e" || !equalRects(e.BBox, model.PdfRectangle{Llx: 107.22, Lly: 698, Urx: 112.78, Ury: 708}) {
+		t.Errorf("Incorrect mark %+v", e)
+	}
+	if s := marks[11]; s.Text != "S" || !equalRects(s.BBox, model.PdfRectangle{Llx: 100, Lly: 686, Urx: 106.67, Ury: 696}) {
+		t.Errorf("Incorrect mark %+v", s)
+	}
+
+	words := GroupWords(marks)
+	if len(words) != 4 || words[0].Text != "Hello" || words[3].Text != "line" {
+		t.Fatalf("Incorrect words %+v", words)
+	}
+	lines := GroupLines(words)
+	if len(lines) != 2 || lines[0].Text != "Hello World" || lines[1].Text != "Second line" {
+		t.Fatalf("Incorrect lines %+v", lines)
+	}
+	blocks := GroupBlocks(lines)
+	if len(blocks) != 1 || blocks[0].Text != "Hello World\nSecond line" {
+		t.Fatalf("Incorrect blocks %+v", blocks)
+	}
+	if !equalRects(blocks[0].BBox, model.PdfRectangle{Llx: 100, Lly: 686, Urx: 152.25, Ury: 708}) {
+		t.Errorf("Incorrect block bbox %+v", blocks[0].BBox)
+	}
+}
+
+func TestTextMarksState(t *testing.T) {
+	// Scaled by 2 and translated by (10, 20), with horizontal scaling 50% and character spacing 1.
+	e := newHelveticaExtractor("q 2 0 0 2 10 20 cm\nBT\n/F1 10 Tf\n50 Tz 1 Tc 3 Tr\n1 0 0 rg\n(AB) Tj\nET\nQ\n")
+
+	marks, err := e.ExtractTextMarks()
+	if err != nil {
+		t.Fatalf("Error extracting text marks: %v", err)
+	}
+	if len(marks) != 2 {
+		t.Fatalf("Incorrect number of marks %d", len(marks))
+	}
+
+	a, b := marks[0], marks[1]
+	if a.RenderMode != 3 {
+		t.Errorf("Incorrect render mode %d", a.RenderMode)
+	}
+	color, ok := a.FillColor.(*model.PdfColorDeviceRGB)
+	if !ok || color.R() != 1 || color.G() != 0 || color.B() != 0 {
+		t.Errorf("Incorrect fill color %v", a.FillColor)
+	}
+	// Helvetica A is 667 units wide.
+	if !equalRects(a.BBox, model.PdfRectangle{Llx: 10, Lly: 16, Urx: 16.67, Ury: 36}) {
+		t.Errorf("Incorrect bbox of A %+v", a.BBox)
+	}
+	if math.Abs(b.BBox.Llx-(10+2*(6.67+1)*0.5)) > 1e-6 {
+		t.Errorf("Incorrect bbox of B %+v", b.BBox)
+	}
+}
+
+// newTestForm returns a form XObject stream with the bounding box `bbox` and the content
+// `content` painted with the resources `resources`.
+func newTestForm(t *testing.T, bbox []float64, matrix []float64, resources *model.PdfPageResources, content string) *core.PdfObjectStream {
+	form := model.NewXObjectForm()
+	form.BBox = core.MakeArrayFromFloats(bbox)
+	if matrix != nil {
+		form.Matrix = core.MakeArrayFromFloats(matrix)
+	}
+	form.Resources = resources
+	if err := form.SetContentStream([]byte(content), nil); err != nil {
+		t.Fatalf("Error setting form content: %v", err)
+	}
+	return form.ToPdfObject().(*core.PdfObjectStream)
+}
+
+// newFormTextExtractor returns an extractor of a page showing Page and painting the form
+// XObject Fm1 translated by (50, 50) showing Header, which paints itself, with a free text
+// annotation showing Note and a text field widget showing Stale of value Jane Doe.
+func newFormTextExtractor(t *testing.T, options Options) *Extractor {
+	e := newHelveticaExtractor("BT\n/F1 10 Tf\n1 0 0 1 100 700 Tm\n(Page) Tj\nET\n/Fm1 Do\n")
+	e.options = options
+
+	formResources := model.NewPdfPageResources()
+	font, _ := e.resources.GetFontByName("F1")
+	formResources.SetFontByName("F1", font)
+	form := newTestForm(t, []float64{0, 0, 200, 50}, []float64{1, 0, 0, 1, 50, 50}, formResources,
+		"BT /F1 10 Tf 10 20 Td (Header) Tj ET /Fm1 Do")
+	formResources.SetXObjectByName("Fm1", form)
+	e.resources.SetXObjectByName("Fm1", form)
+
+	freeText := model.NewPdfAnnotationFreeText()
+	freeText.Rect = core.MakeArrayFromFloats([]float64{300, 400, 500, 440})
+	ap := core.MakeDict()
+	ap.Set("N", newTestForm(t, []float64{0, 0, 100, 20}, nil, formResources, "BT /F1 10 Tf 2 5 Td (Note) Tj ET"))
+	freeText.AP = ap
+
+	field := core.MakeDict()
+	field.Set("FT", core.MakeName("Tx"))
+	field.Set("V", core.MakeString("\xfe\xff\x00J\x00a\x00n\x00e\x00 \x00D\x00o\x00e"))
+	widget := model.NewPdfAnnotationWidget()
+	widget.Rect = core.MakeArrayFromFloats([]float64{300, 300, 400, 320})
+	widget.Parent = field
+	ap = core.MakeDict()
+	ap.Set("N", newTestForm(t, []float64{0, 0, 100, 20}, nil, formResources, "BT /F1 10 Tf 2 5 Td (Stale) Tj ET"))
+	widget.AP = ap
+	widget.ToPdfObject()
+	freeText.ToPdfObject()
+
+	e.annotations = []*model.PdfAnnotation{freeText.PdfAnnotation, widget.PdfAnnotation}
+	return e
+}
+
+func TestTextExtractionForms(t *testing.T) {
+	for _, test := range []struct {
+		options  Options
+		expected string
+	}{
+		{Options{}, "Page\nHeader"},
+		{Options{IncludeAnnotations: true}, "Page\nHeader\nNote\nStale"},
+		{Options{IncludeFormFieldValues: true}, "Page\nHeader\nJane Doe"},
+		{Options{IncludeAnnotations: true, IncludeFormFieldValues: true}, "Page\nHeader\nNote\nJane Doe"},
+	} {
+		e := newFormTextExtractor(t, test.options)
+		s, err := e.ExtractText()
+		if err != nil {
+			t.Fatalf("Error extracting text: %v", err)
+		}
+		// Unlicensed copies append a notice.
+		if s != test.expected && !strings.HasPrefix(s, test.expected+"- [Unlicensed") {
+			t.Errorf("Text mismatch with %+v (%q)", test.options, s)
+		}
+	}
+}
+
+func TestTextMarksForms(t *testing.T) {
+	e := newFormTextExtractor(t, Options{IncludeAnnotations: true})
+	marks, err := e.ExtractTextMarks()
+	if err != nil {
+		t.Fatalf("Error extracting text marks: %v", err)
+	}
+
+	var text string
+	for _, mark := range marks {
+		text += mark.Text
+	}
+	if text != "PageHeaderNoteStale" {
+		t.Fatalf("Incorrect marks %q", text)
+	}
+	// Header at (10, 20) in the form translated by (50, 50).
+	if h := marks[4]; !equalRects(h.BBox, model.PdfRectangle{Llx: 60, Lly: 68, Urx: 67.22, Ury: 78}) {
+		t.Errorf("Incorrect bbox of H %+v", h.BBox)
+	}
+	// Note at (2, 5) in the appearance box scaled by 2 to the annotation rectangle.
+	if n := marks[10]; !equalRects(n.BBox, model.PdfRectangle{Llx: 304, Lly: 406, Urx: 318.44, Ury: 426}) {
+		t.Errorf("Incorrect bbox of N %+v", n.BBox)
+	}
+}
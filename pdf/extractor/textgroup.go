@@ -0,0 +1,159 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// TextWord is a sequence of adjacent text marks without whitespace.
+type TextWord struct {
+	Text  string
+	BBox  model.PdfRectangle
+	Marks []TextMark
+}
+
+// TextLine is a sequence of words on a same baseline, ordered left to right.
+type TextLine struct {
+	Text  string // Words separated by spaces.
+	BBox  model.PdfRectangle
+	Words []TextWord
+}
+
+// TextBlock is a sequence of vertically adjacent lines, ordered top to bottom, such as a paragraph.
+type TextBlock struct {
+	Text  string // Lines separated by newlines.
+	BBox  model.PdfRectangle
+	Lines []TextLine
+}
+
+// GroupWords groups the text marks `marks`, in the order they are shown, into words.  Marks are
+// split into words at whitespace and at gaps larger than 15% of their height.
+func GroupWords(marks []TextMark) []TextWord {
+	var words []TextWord
+	var word *TextWord
+	for _, mark := range marks {
+		if strings.TrimFunc(mark.Text, unicode.IsSpace) == "" {
+			word = nil
+			continue
+		}
+		if word != nil {
+			last := word.Marks[len(word.Marks)-1].BBox
+			h := math.Max(height(last), height(mark.BBox))
+			gap := mark.BBox.Llx - last.Urx
+			if verticalOverlap(last, mark.BBox) < 0.5*h || gap < -0.5*h || gap > 0.15*h {
+				word = nil
+			}
+		}
+		if word == nil {
+			words = append(words, TextWord{BBox: mark.BBox})
+			word = &words[len(words)-1]
+		}
+		word.Text += mark.Text
+		word.BBox = union(word.BBox, mark.BBox)
+		word.Marks = append(word.Marks, mark)
+	}
+	return words
+}
+
+// GroupLines groups the words `words` into lines, ordered top to bottom.  Words are on a same line
+// if they overlap vertically by at least half their height and are less than twice their height
+// apart.
+func GroupLines(words []TextWord) []TextLine {
+	sorted := make([]TextWord, len(words))
+	copy(sorted, words)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].BBox.Llx < sorted[j].BBox.Llx
+	})
+
+	var lines []TextLine
+	for _, word := range sorted {
+		added := false
+		for i := range lines {
+			line := &lines[i]
+			h := math.Max(height(line.BBox), height(word.BBox))
+			if verticalOverlap(line.BBox, word.BBox) >= 0.5*h && word.BBox.Llx-line.BBox.Urx < 2*h {
+				line.Words = append(line.Words, word)
+				line.BBox = union(line.BBox, word.BBox)
+				added = true
+				break
+			}
+		}
+		if !added {
+			lines = append(lines, TextLine{BBox: word.BBox, Words: []TextWord{word}})
+		}
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].BBox.Ury > lines[j].BBox.Ury
+	})
+	for i := range lines {
+		texts := make([]string, len(lines[i].Words))
+		for j, word := range lines[i].Words {
+			texts[j] = word.Text
+		}
+		lines[i].Text = strings.Join(texts, " ")
+	}
+	return lines
+}
+
+// GroupBlocks groups the lines `lines`, ordered top to bottom, into blocks.  A line continues a
+// block if it overlaps the block horizontally and is less than its height below the last line.
+func GroupBlocks(lines []TextLine) []TextBlock {
+	var blocks []TextBlock
+	for _, line := range lines {
+		added := false
+		for i := range blocks {
+			block := &blocks[i]
+			last := block.Lines[len(block.Lines)-1].BBox
+			h := math.Max(height(last), height(line.BBox))
+			overlaps := line.BBox.Llx < block.BBox.Urx && block.BBox.Llx < line.BBox.Urx
+			if overlaps && last.Lly-line.BBox.Ury < h && line.BBox.Ury <= last.Ury {
+				block.Lines = append(block.Lines, line)
+				block.BBox = union(block.BBox, line.BBox)
+				added = true
+				break
+			}
+		}
+		if !added {
+			blocks = append(blocks, TextBlock{BBox: line.BBox, Lines: []TextLine{line}})
+		}
+	}
+
+	for i := range blocks {
+		texts := make([]string, len(blocks[i].Lines))
+		for j, line := range blocks[i].Lines {
+			texts[j] = line.Text
+		}
+		blocks[i].Text = strings.Join(texts, "\n")
+	}
+	return blocks
+}
+
+// height returns the height of `r`.
+func height(r model.PdfRectangle) float64 {
+	return r.Ury - r.Lly
+}
+
+// verticalOverlap returns the height of the overlap of `a` and `b`, negative if disjoint.
+func verticalOverlap(a, b model.PdfRectangle) float64 {
+	return math.Min(a.Ury, b.Ury) - math.Max(a.Lly, b.Lly)
+}
+
+// union returns the smallest rectangle containing `a` and `b`.
+func union(a, b model.PdfRectangle) model.PdfRectangle {
+	return model.PdfRectangle{
+		Llx: math.Min(a.Llx, b.Llx),
+		Lly: math.Min(a.Lly, b.Lly),
+		Urx: math.Max(a.Urx, b.Urx),
+		Ury: math.Max(a.Ury, b.Ury),
+	}
+}
@@ -0,0 +1,368 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/cmap"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Ascent and descent of glyphs in text space units of fonts without font descriptor metrics.
+const (
+	defaultAscent  = 0.8
+	defaultDescent = -0.2
+)
+
+// TextMark is a glyph of text shown on a page, with its Unicode text, style and the bounding box
+// of its advance width between the ascent and descent of the font.
+type TextMark struct {
+	Text       string             // Unicode text of the glyph, e.g. several runes for ligatures.
+	FontName   string             // BaseFont of the font, or its resource name if none.
+	FontSize   float64            // Font size set by Tf, in text space units.
+	FillColor  model.PdfColor     // Nonstroking color, nil for patterns.
+	RenderMode int                // Text rendering mode set by Tr, e.g. 3 for invisible text.
+	BBox       model.PdfRectangle // Bounding box in the default user space of the page.
+}
+
+// textState is the text state (9.3 "Text State Parameters and Operators") with the text matrices.
+type textState struct {
+	charSpacing  float64 // Tc
+	wordSpacing  float64 // Tw
+	horizScaling float64 // Tz / 100
+	leading      float64 // TL
+	rise         float64 // Ts
+	renderMode   int     // Tr
+	fontSize     float64 // Tf
+	font         *textFont
+
+	tm, tlm transform.Matrix // Text matrix and text line matrix.
+}
+
+// textFont is a font of shown text with the CMaps decoding its character codes.
+type textFont struct {
+	name string
+	font *model.PdfFont // nil if not loaded.
+
+	// CMap splitting the codes of composite fonts by its codespace ranges, nil for simple fonts.
+	encoding *cmap.CMap
+	// ToUnicode CMap, or the predefined CMap of composite fonts mapping to Unicode, if any.
+	toUnicode *cmap.CMap
+
+	ascent, descent float64 // In text space units.
+}
+
+// ExtractTextMarks returns the glyphs of the text shown by the content streams, in the order they
+// are shown, with their Unicode text, style and bounding boxes.  The marks can be grouped into
+// words, lines and blocks with GroupWords, GroupLines and GroupBlocks.
+// The text of form XObjects is included where they are painted, and the text of the appearance
+// streams of annotations after the text of the page if the IncludeAnnotations option is set.
+func (e *Extractor) ExtractTextMarks() ([]TextMark, error) {
+	x := textMarkExtractor{fonts: map[core.PdfObject]*textFont{}, state: textState{horizScaling: 1}}
+	w := newContentWalker(x.handle)
+	w.enterForm = x.enterForm
+
+	err := w.walk(e.contents, e.resources, transform.IdentityMatrix())
+	if err != nil {
+		return x.marks, err
+	}
+
+	if e.options.IncludeAnnotations {
+		for _, appearance := range e.annotationAppearances(false) {
+			form := appearance.form
+			w.walkNested(form.stream, form.content, form.resources, form.matrix.Mult(appearance.ctm))
+		}
+	}
+
+	return procMarks(x.marks), nil
+}
+
+// textMarkExtractor collects the text marks of content streams.
+type textMarkExtractor struct {
+	marks      []TextMark
+	fonts      map[core.PdfObject]*textFont // Fonts loaded by font dictionary.
+	state      textState
+	stateStack []textState
+}
+
+// enterForm saves the text state before a form XObject or annotation appearance is walked, as its
+// graphics state, and returns the function restoring it.
+func (x *textMarkExtractor) enterForm() func() {
+	saved, savedStack := x.state, x.stateStack
+	x.stateStack = nil
+	return func() {
+		x.state, x.stateStack = saved, savedStack
+	}
+}
+
+// handle collects the text marks shown by the operation `op`, and updates the text state.
+func (x *textMarkExtractor) handle(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState,
+	resources *model.PdfPageResources, base transform.Matrix) error {
+	// Text state parameters are part of the graphics state.
+	switch op.Operand {
+	case "q":
+		x.stateStack = append(x.stateStack, x.state)
+		return nil
+	case "Q":
+		if len(x.stateStack) > 0 {
+			x.state = x.stateStack[len(x.stateStack)-1]
+			x.stateStack = x.stateStack[:len(x.stateStack)-1]
+		}
+		return nil
+	case "BT":
+		x.state.tm = transform.IdentityMatrix()
+		x.state.tlm = transform.IdentityMatrix()
+		return nil
+	case "Tf":
+		if len(op.Params) != 2 {
+			common.Log.Debug("Error Tf should only get 2 input params, got %d", len(op.Params))
+			return errors.New("Incorrect parameter count")
+		}
+		name, ok := op.Params[0].(*core.PdfObjectName)
+		if !ok {
+			common.Log.Debug("Error Tf font input not a name")
+			return errors.New("Tf range error")
+		}
+		size, err := getNumberAsFloat(op.Params[1])
+		if err != nil {
+			return err
+		}
+		x.state.fontSize = size
+		x.state.font = loadTextFont(resources, *name, x.fonts)
+		return nil
+	case "TJ":
+		if len(op.Params) < 1 {
+			return nil
+		}
+		arr, ok := op.Params[0].(*core.PdfObjectArray)
+		if !ok {
+			return fmt.Errorf("Invalid parameter type, no array (%T)", op.Params[0])
+		}
+		for _, obj := range *arr {
+			if str, ok := obj.(*core.PdfObjectString); ok {
+				x.marks = x.state.showText([]byte(*str), gs, base, x.marks)
+				continue
+			}
+			adjustment, err := getNumberAsFloat(obj)
+			if err != nil {
+				common.Log.Debug("Invalid TJ element (%T)", obj)
+				continue
+			}
+			tx := -adjustment / 1000 * x.state.fontSize * x.state.horizScaling
+			x.state.tm = transform.TranslationMatrix(tx, 0).Mult(x.state.tm)
+		}
+		return nil
+	case "Tj", "'", "\"":
+		if len(op.Params) < 1 {
+			return nil
+		}
+		if op.Operand == "\"" {
+			if len(op.Params) != 3 {
+				return errors.New("Incorrect parameter count")
+			}
+			vals, err := getNumbersAsFloat(op.Params[:2])
+			if err != nil {
+				return err
+			}
+			x.state.wordSpacing, x.state.charSpacing = vals[0], vals[1]
+		}
+		if op.Operand != "Tj" {
+			x.state.nextLine()
+		}
+		str, ok := op.Params[len(op.Params)-1].(*core.PdfObjectString)
+		if !ok {
+			return fmt.Errorf("Invalid parameter type, not string (%T)", op.Params[len(op.Params)-1])
+		}
+		x.marks = x.state.showText([]byte(*str), gs, base, x.marks)
+		return nil
+	case "T*":
+		x.state.nextLine()
+		return nil
+	case "Tc", "Tw", "Tz", "TL", "Ts", "Tr", "Td", "TD", "Tm":
+	default:
+		return nil
+	}
+
+	// Operators with numeric operands.
+	vals, err := getNumbersAsFloat(op.Params)
+	if err != nil {
+		common.Log.Debug("Invalid %s operands: %v", op.Operand, err)
+		return nil
+	}
+	switch op.Operand {
+	case "Tc", "Tw", "Tz", "TL", "Ts", "Tr":
+		if len(vals) != 1 {
+			return errors.New("Incorrect parameter count")
+		}
+		switch op.Operand {
+		case "Tc":
+			x.state.charSpacing = vals[0]
+		case "Tw":
+			x.state.wordSpacing = vals[0]
+		case "Tz":
+			x.state.horizScaling = vals[0] / 100
+		case "TL":
+			x.state.leading = vals[0]
+		case "Ts":
+			x.state.rise = vals[0]
+		case "Tr":
+			x.state.renderMode = int(vals[0])
+		}
+	case "Td", "TD":
+		if len(vals) != 2 {
+			return errors.New("Incorrect parameter count")
+		}
+		if op.Operand == "TD" {
+			x.state.leading = -vals[1]
+		}
+		x.state.tlm = transform.TranslationMatrix(vals[0], vals[1]).Mult(x.state.tlm)
+		x.state.tm = x.state.tlm
+	case "Tm":
+		if len(vals) != 6 {
+			return errors.New("Tm: Invalid number of inputs")
+		}
+		x.state.tlm = transform.NewMatrix(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5])
+		x.state.tm = x.state.tlm
+	}
+	return nil
+}
+
+// nextLine moves to the start of the next line, as T*.
+func (state *textState) nextLine() {
+	state.tlm = transform.TranslationMatrix(0, -state.leading).Mult(state.tlm)
+	state.tm = state.tlm
+}
+
+// showText appends the marks of the glyphs of the character codes `data` shown with the graphics
+// state `gs` to `marks`, and advances the text matrix.  The user space is mapped to the default
+// user space of the page by `base`.
+func (state *textState) showText(data []byte, gs contentstream.GraphicsState, base transform.Matrix, marks []TextMark) []TextMark {
+	font := state.font
+	if font == nil {
+		common.Log.Debug("Text shown without font")
+		return marks
+	}
+
+	var codes [][]byte
+	if font.encoding != nil {
+		codes = font.encoding.SplitCharcodes(data)
+	} else {
+		for i := range data {
+			codes = append(codes, data[i:i+1])
+		}
+	}
+
+	for _, code := range codes {
+		var val uint64
+		for _, b := range code {
+			val = val<<8 | uint64(b)
+		}
+
+		var w0 float64
+		if font.font != nil {
+			if metrics, found := font.font.GetCharMetrics(val); found {
+				w0 = metrics.Wx / 1000
+			}
+		}
+
+		// Text rendering matrix (9.4.4 "Text Space Details").
+		trm := transform.NewMatrix(state.fontSize*state.horizScaling, 0, 0, state.fontSize, 0, state.rise).
+			Mult(state.tm).Mult(gs.CTM).Mult(base)
+		llx, lly, urx, ury := trm.TransformRect(0, font.descent, w0, font.ascent)
+
+		marks = append(marks, TextMark{
+			Text:       font.decode(code),
+			FontName:   font.name,
+			FontSize:   state.fontSize,
+			FillColor:  gs.ColorNonStroking,
+			RenderMode: state.renderMode,
+			BBox:       model.PdfRectangle{Llx: llx, Lly: lly, Urx: urx, Ury: ury},
+		})
+
+		spacing := state.charSpacing
+		if len(code) == 1 && code[0] == ' ' {
+			spacing += state.wordSpacing
+		}
+		tx := (w0*state.fontSize + spacing) * state.horizScaling
+		state.tm = transform.TranslationMatrix(tx, 0).Mult(state.tm)
+	}
+	return marks
+}
+
+// decode returns the Unicode text of the character code `code`.
+func (font *textFont) decode(code []byte) string {
+	var f *model.PdfFont
+	if font.toUnicode == nil {
+		f = font.font
+	}
+	return decodeText(code, font.toUnicode, f)
+}
+
+// loadTextFont returns the font `name` of `resources`, loaded once by font dictionary in `cache`.
+// Returns nil if not found.
+func loadTextFont(resources *model.PdfPageResources, name core.PdfObjectName, cache map[core.PdfObject]*textFont) *textFont {
+	if resources == nil {
+		return nil
+	}
+	fontObj, found := resources.GetFontByName(name)
+	if !found {
+		common.Log.Debug("Font %s not in resources", name)
+		return nil
+	}
+	if font, has := cache[fontObj]; has {
+		return font
+	}
+
+	font := &textFont{name: string(name), ascent: defaultAscent, descent: defaultDescent}
+	cache[fontObj] = font
+
+	fontDict, ok := core.TraceToDirectObject(fontObj).(*core.PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("Font %s not a dictionary (%T)", name, fontObj)
+		return font
+	}
+	if baseFont, ok := core.TraceToDirectObject(fontDict.Get("BaseFont")).(*core.PdfObjectName); ok {
+		font.name = string(*baseFont)
+	}
+
+	if stream, ok := core.TraceToDirectObject(fontDict.Get("ToUnicode")).(*core.PdfObjectStream); ok {
+		decoded, err := core.DecodeStream(stream)
+		if err == nil {
+			font.toUnicode, err = cmap.LoadCmapFromData(decoded)
+		}
+		if err != nil {
+			common.Log.Debug("Unable to load the ToUnicode CMap of %s: %v", name, err)
+			font.toUnicode = nil
+		}
+	}
+
+	font.encoding = encodingCMap(fontDict)
+	if font.toUnicode == nil && font.encoding != nil && font.encoding.HasUnicode() {
+		font.toUnicode = font.encoding
+	}
+
+	f, err := model.NewPdfFontFromPdfObject(fontObj)
+	if err != nil {
+		common.Log.Debug("Unable to load font %s: %v", name, err)
+		return font
+	}
+	font.font = f
+
+	if descriptor := f.GetFontDescriptor(); descriptor != nil {
+		ascent, err1 := getNumberAsFloat(core.TraceToDirectObject(descriptor.Ascent))
+		descent, err2 := getNumberAsFloat(core.TraceToDirectObject(descriptor.Descent))
+		if err1 == nil && err2 == nil && ascent > descent {
+			font.ascent, font.descent = ascent/1000, descent/1000
+		}
+	}
+	return font
+}
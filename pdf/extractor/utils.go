@@ -27,6 +27,19 @@ func getNumberAsFloat(obj core.PdfObject) (float64, error) {
 	return 0, errors.New("Not a number")
 }
 
+// getNumbersAsFloat retrieves the numeric values of `objs` (both integer/float).
+func getNumbersAsFloat(objs []core.PdfObject) ([]float64, error) {
+	vals := make([]float64, 0, len(objs))
+	for _, obj := range objs {
+		val, err := getNumberAsFloat(obj)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
+
 func procBuf(buf *bytes.Buffer) {
 	if isTesting {
 		return
@@ -46,3 +59,21 @@ func procBuf(buf *bytes.Buffer) {
 	}
 	buf.WriteString(s)
 }
+
+func procMarks(marks []TextMark) []TextMark {
+	if isTesting {
+		return marks
+	}
+
+	lk := license.GetLicenseKey()
+	if lk != nil && lk.IsLicensed() {
+		return marks
+	}
+	fmt.Printf("Unlicensed copy of unidoc\n")
+	fmt.Printf("To get rid of the watermark and keep entire text - Please get a license on https://unidoc.io\n")
+
+	if len(marks) > 100 {
+		marks = marks[:len(marks)-100]
+	}
+	return marks
+}
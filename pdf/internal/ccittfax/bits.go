@@ -0,0 +1,130 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package ccittfax
+
+import "bytes"
+
+// bitReader reads bits MSB first from a byte slice. Reading past the end of the data yields
+// zero bits, which allows fill and trailing padding to be handled uniformly.
+type bitReader struct {
+	data []byte
+	pos  int // Position in bits.
+}
+
+func newBitReader(data []byte) *bitReader {
+	return &bitReader{data: data}
+}
+
+// peek returns the next n bits (n <= 16) without consuming them.
+func (r *bitReader) peek(n uint) uint16 {
+	var v uint16
+	for i := uint(0); i < n; i++ {
+		v <<= 1
+		p := r.pos + int(i)
+		if p>>3 < len(r.data) && r.data[p>>3]&(0x80>>uint(p&7)) != 0 {
+			v |= 1
+		}
+	}
+	return v
+}
+
+// skip consumes n bits.
+func (r *bitReader) skip(n uint) {
+	r.pos += int(n)
+}
+
+// readBit reads a single bit.
+func (r *bitReader) readBit() uint16 {
+	v := r.peek(1)
+	r.pos++
+	return v
+}
+
+// align moves to the next byte boundary.
+func (r *bitReader) align() {
+	r.pos = (r.pos + 7) &^ 7
+}
+
+// exhausted returns true if there are no more set bits remaining in the data.
+func (r *bitReader) exhausted() bool {
+	if r.pos >= len(r.data)*8 {
+		return true
+	}
+	if r.data[r.pos>>3]&(0xFF>>uint(r.pos&7)) != 0 {
+		return false
+	}
+	for _, b := range r.data[r.pos>>3+1:] {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// overrun returns true if more bits were consumed than available.
+func (r *bitReader) overrun() bool {
+	return r.pos > len(r.data)*8
+}
+
+// readCode reads a code from the table. Returns false if no code matches.
+func (r *bitReader) readCode(table *codeTable) (int, bool) {
+	for n := uint(1); n <= maxCodeLen; n++ {
+		if table[n] == nil {
+			continue
+		}
+		if v, has := table[n][r.peek(n)]; has {
+			r.skip(n)
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// bitWriter writes bits MSB first.
+type bitWriter struct {
+	buf   bytes.Buffer
+	cur   byte
+	nbits uint
+}
+
+// writeBits writes the n low order bits of v.
+func (w *bitWriter) writeBits(v uint16, n uint) {
+	for i := int(n) - 1; i >= 0; i-- {
+		w.cur <<= 1
+		if v&(1<<uint(i)) != 0 {
+			w.cur |= 1
+		}
+		w.nbits++
+		if w.nbits == 8 {
+			w.buf.WriteByte(w.cur)
+			w.cur = 0
+			w.nbits = 0
+		}
+	}
+}
+
+// writeCode writes a code.
+func (w *bitWriter) writeCode(c code) {
+	w.writeBits(c.bits, c.nbits)
+}
+
+// align pads with zero bits up to the next byte boundary.
+func (w *bitWriter) align() {
+	if w.nbits > 0 {
+		w.writeBits(0, 8-w.nbits)
+	}
+}
+
+// pending returns the number of bits written since the last byte boundary.
+func (w *bitWriter) pending() uint {
+	return w.nbits
+}
+
+// bytes flushes any pending bits and returns the written data.
+func (w *bitWriter) bytes() []byte {
+	w.align()
+	return w.buf.Bytes()
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package ccittfax implements the CCITT Group 3 (ITU-T T.4) and Group 4 (ITU-T T.6) facsimile
+// compression schemes as used by the CCITTFaxDecode filter in PDF.
+//
+// Image data is represented as packed rows with 1 bit per pixel, where each row starts on a byte
+// boundary. By default 0 bits represent black pixels and 1 bits white pixels, unless BlackIs1 is set.
+package ccittfax
+
+import "errors"
+
+// Params represents the parameters of the CCITTFaxDecode filter (Table 11 in 7.4.6 PDF32000_2008).
+type Params struct {
+	// K selects the encoding scheme: K < 0 is pure two-dimensional (Group 4), K = 0 is pure
+	// one-dimensional (Group 3, 1-D) and K > 0 is mixed one- and two-dimensional (Group 3, 2-D) where
+	// at most K-1 lines are encoded two-dimensionally after each one-dimensionally encoded line.
+	K int
+
+	// Columns is the width of the image in pixels.
+	Columns int
+
+	// Rows is the height of the image in pixels. If 0, the height is determined by the encoded data.
+	Rows int
+
+	// EncodedByteAlign indicates whether each encoded line is expected to begin on a byte boundary.
+	EncodedByteAlign bool
+
+	// BlackIs1 indicates whether 1 bits represent black pixels (rather than 0 bits).
+	BlackIs1 bool
+
+	// EndOfLine indicates whether end-of-line bit patterns are present (required when encoding).
+	EndOfLine bool
+
+	// EndOfBlock indicates whether the data is terminated by an end-of-block pattern.
+	EndOfBlock bool
+
+	// DamagedRowsBeforeError is the number of damaged rows tolerated when decoding.
+	DamagedRowsBeforeError int
+}
+
+// DefaultParams returns the default CCITTFaxDecode parameters.
+func DefaultParams() Params {
+	return Params{
+		K:          0,
+		Columns:    1728,
+		EndOfBlock: true,
+	}
+}
+
+var (
+	// ErrInvalidCode is returned when an invalid code is encountered in the encoded data.
+	ErrInvalidCode = errors.New("ccittfax: invalid code")
+	// ErrUnsupportedMode is returned for the uncompressed mode extension, which is not supported.
+	ErrUnsupportedMode = errors.New("ccittfax: unsupported extension mode")
+	// ErrInvalidColumns is returned when the Columns parameter is not positive.
+	ErrInvalidColumns = errors.New("ccittfax: invalid number of columns")
+)
+
+// rowBytes returns the number of bytes in a packed row.
+func (p Params) rowBytes() int {
+	return (p.Columns + 7) / 8
+}
+
+// findB1B2 returns the changing elements b1 and b2 on the reference line `ref`, given the position
+// `a0` and color of the current changing element. `ref` lists the positions where the color changes,
+// starting with a white to black change. `hint` is an index into `ref` where the search may start and
+// is updated to the index of b1.
+func findB1B2(ref []int, a0 int, white bool, columns int, hint *int) (int, int) {
+	i := *hint - 2
+	if i < 0 {
+		i = 0
+	}
+	for ; i < len(ref); i++ {
+		// Changes at even indices are white to black, i.e. the changing element is black.
+		if ref[i] > a0 && (i%2 == 0) == white {
+			*hint = i
+			b2 := columns
+			if i+1 < len(ref) {
+				b2 = ref[i+1]
+			}
+			return ref[i], b2
+		}
+	}
+	*hint = len(ref)
+	return columns, columns
+}
+
+// addChange appends a changing element at `pos` to `changes`.  Elements at or beyond the row width
+// are dropped and two consecutive changes at the same position cancel out.
+func addChange(changes []int, pos, columns int) []int {
+	if pos >= columns {
+		return changes
+	}
+	if n := len(changes); n > 0 && changes[n-1] == pos {
+		return changes[:n-1]
+	}
+	return append(changes, pos)
+}
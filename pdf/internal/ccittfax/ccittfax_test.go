@@ -0,0 +1,148 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package ccittfax
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+// Check that the code tables are prefix free, i.e. that decoding is unambiguous.
+func TestCodeTablesPrefixFree(t *testing.T) {
+	tables := map[string][]code{
+		"white": append(append([]code{}, whiteCodes...), extendedCodes...),
+		"black": append(append([]code{}, blackCodes...), extendedCodes...),
+		"mode":  modeCodes,
+	}
+	for name, codes := range tables {
+		for i, c1 := range codes {
+			for j, c2 := range codes {
+				if i == j || c1.nbits > c2.nbits {
+					continue
+				}
+				if c2.bits>>(c2.nbits-c1.nbits) == c1.bits {
+					t.Errorf("%s: code for %d is a prefix of code for %d", name, c1.value, c2.value)
+				}
+			}
+		}
+	}
+}
+
+// Test encoding against hand encoded data.
+func TestEncodeKnown(t *testing.T) {
+	testcases := []struct {
+		k        int
+		data     []byte
+		expected []byte
+	}{
+		// All white row: white run of 8 (10011).
+		{0, []byte{0xFF}, []byte{0x98}},
+		// 4 black, 4 white: horizontal mode (001) with white run 0 (00110101), black run 4 (011),
+		// followed by V0 (1).
+		{-1, []byte{0x0F}, []byte{0x26, 0xAE}},
+	}
+
+	for _, tcase := range testcases {
+		params := DefaultParams()
+		params.K = tcase.k
+		params.Columns = 8
+		params.EndOfBlock = false
+
+		encoded, err := Encode(tcase.data, params)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if !bytes.Equal(encoded, tcase.expected) {
+			t.Errorf("K=%d: % x != % x", tcase.k, encoded, tcase.expected)
+		}
+
+		decoded, err := Decode(encoded, params)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if !bytes.Equal(decoded, tcase.data) {
+			t.Errorf("K=%d: decoded % x != % x", tcase.k, decoded, tcase.data)
+		}
+	}
+}
+
+// Test that encoding followed by decoding gives back the original data for all encoding schemes.
+func TestRoundTrip(t *testing.T) {
+	columns, rows := 203, 60
+
+	// Generate an image with horizontal runs of random lengths, similar to scanned text.
+	rng := rand.New(rand.NewSource(1))
+	rowBytes := (columns + 7) / 8
+	data := make([]byte, rowBytes*rows)
+	for y := 0; y < rows; y++ {
+		black := false
+		for x := 0; x < columns; {
+			run := 1 + rng.Intn(12)
+			if y > 0 && rng.Intn(3) > 0 {
+				// Mostly follow the previous row to exercise the vertical modes.
+				prev := data[(y-1)*rowBytes+x/8]&(0x80>>uint(x%8)) == 0
+				black = prev
+				run = 1
+			}
+			for ; run > 0 && x < columns; run-- {
+				if !black {
+					data[y*rowBytes+x/8] |= 0x80 >> uint(x%8)
+				}
+				x++
+			}
+			black = !black
+		}
+	}
+
+	for _, k := range []int{-1, 0, 1, 4} {
+		for _, byteAlign := range []bool{false, true} {
+			for _, eol := range []bool{false, true} {
+				params := DefaultParams()
+				params.K = k
+				params.Columns = columns
+				params.EncodedByteAlign = byteAlign
+				params.EndOfLine = eol
+
+				encoded, err := Encode(data, params)
+				if err != nil {
+					t.Fatalf("Error: %v", err)
+				}
+				decoded, err := Decode(encoded, params)
+				if err != nil {
+					t.Fatalf("K=%d align=%v eol=%v: %v", k, byteAlign, eol, err)
+				}
+				if !bytes.Equal(decoded, data) {
+					t.Errorf("K=%d align=%v eol=%v: round trip mismatch", k, byteAlign, eol)
+				}
+			}
+		}
+	}
+}
+
+// Test decoding with BlackIs1 and a fixed number of rows where the data ends early.
+func TestDecodeBlackIs1Rows(t *testing.T) {
+	params := DefaultParams()
+	params.K = -1
+	params.Columns = 8
+	params.BlackIs1 = true
+
+	encoded, err := Encode([]byte{0xF0}, params)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	params.Rows = 3
+	decoded, err := Decode(encoded, params)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	// The missing rows are filled with white.
+	expected := []byte{0xF0, 0x00, 0x00}
+	if !bytes.Equal(decoded, expected) {
+		t.Errorf("% x != % x", decoded, expected)
+	}
+}
@@ -0,0 +1,278 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package ccittfax
+
+import (
+	"bytes"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// Decode decodes CCITT Group 3 or Group 4 encoded data according to the parameters `p`.
+// Returns the packed image rows, 1 bit per pixel with each row padded to a byte boundary.
+func Decode(encoded []byte, p Params) ([]byte, error) {
+	decoded, _, err := DecodeN(encoded, p)
+	return decoded, err
+}
+
+// DecodeN decodes like Decode and additionally returns the number of bytes of `encoded` that were
+// consumed, including the end-of-block pattern if present.  This allows decoding several
+// consecutively encoded images from a single buffer.
+func DecodeN(encoded []byte, p Params) ([]byte, int, error) {
+	if p.Columns <= 0 {
+		return nil, 0, ErrInvalidColumns
+	}
+
+	r := newBitReader(encoded)
+	var out bytes.Buffer
+
+	var ref []int // Reference line changes, initially an all white line.
+	damaged := 0
+	rows := 0
+	for p.Rows <= 0 || rows < p.Rows {
+		if p.EncodedByteAlign && (p.K < 0 || !p.EndOfLine) {
+			r.align()
+		}
+
+		// Skip EOL codes.  Multiple consecutive EOLs indicate end of block (RTC for Group 3,
+		// EOFB for Group 4).
+		eols := 0
+		for r.skipEOL() {
+			eols++
+			if p.K > 0 && r.peek(1) == 1 && r.eolAt(r.pos+1) {
+				// Skip the 1D tag bit between EOLs in RTC.
+				r.skip(1)
+			}
+		}
+		if eols >= 2 || r.exhausted() {
+			break
+		}
+		if p.EncodedByteAlign && p.K == 0 && eols > 0 {
+			// Some encoders place the fill bits after the EOL rather than before it.
+			r.align()
+		}
+
+		twoDim := p.K < 0
+		if p.K > 0 {
+			twoDim = r.readBit() == 0
+		}
+
+		var changes []int
+		var err error
+		if twoDim {
+			changes, err = decodeRow2D(r, ref, p.Columns)
+		} else {
+			changes, err = decodeRow1D(r, p.Columns)
+		}
+		if err == nil && r.overrun() {
+			err = ErrInvalidCode
+		}
+		if err != nil {
+			damaged++
+			if damaged > p.DamagedRowsBeforeError || p.K < 0 || !r.seekEOL() {
+				if rows == 0 {
+					return nil, 0, err
+				}
+				// Data is often terminated with garbage, return what was decoded successfully.
+				common.Log.Debug("CCITTFax: decoding stopped at row %d: %v", rows, err)
+				break
+			}
+			// Damaged row: repeat the reference line and resynchronize on the next EOL.
+			changes = ref
+		}
+
+		out.Write(packRow(changes, p))
+		ref = changes
+		rows++
+	}
+
+	if p.EndOfBlock && p.Rows > 0 && rows == p.Rows {
+		// Consume the end-of-block pattern following the last row.
+		for i := 0; i < 2 && r.skipEOL(); i++ {
+		}
+	}
+	consumed := (r.pos + 7) / 8
+	if consumed > len(encoded) {
+		consumed = len(encoded)
+	}
+
+	// Pad with white rows if the data ended prematurely.
+	for p.Rows > 0 && rows < p.Rows {
+		out.Write(packRow(nil, p))
+		rows++
+	}
+
+	return out.Bytes(), consumed, nil
+}
+
+// decodeRow1D decodes a one-dimensionally (modified Huffman) encoded row.
+func decodeRow1D(r *bitReader, columns int) ([]int, error) {
+	var changes []int
+	a0 := 0
+	white := true
+	for a0 < columns {
+		run, err := readRun(r, white)
+		if err != nil {
+			return nil, err
+		}
+		a0 += run
+		changes = addChange(changes, a0, columns)
+		white = !white
+	}
+	return changes, nil
+}
+
+// decodeRow2D decodes a two-dimensionally encoded row with reference line `ref`.
+func decodeRow2D(r *bitReader, ref []int, columns int) ([]int, error) {
+	var changes []int
+	a0 := -1
+	white := true
+	hint := 0
+	for a0 < columns {
+		b1, b2 := findB1B2(ref, a0, white, columns, &hint)
+
+		mode, ok := r.readCode(modeDecodeTable)
+		if !ok {
+			return nil, ErrInvalidCode
+		}
+
+		switch mode {
+		case modePass:
+			a0 = b2
+		case modeHorizontal:
+			start := a0
+			if start < 0 {
+				start = 0
+			}
+			run1, err := readRun(r, white)
+			if err != nil {
+				return nil, err
+			}
+			run2, err := readRun(r, !white)
+			if err != nil {
+				return nil, err
+			}
+			a1 := start + run1
+			a2 := a1 + run2
+			changes = addChange(changes, a1, columns)
+			changes = addChange(changes, a2, columns)
+			a0 = a2
+		case modeExtension:
+			return nil, ErrUnsupportedMode
+		default:
+			a1 := b1 + verticalOffset(mode)
+			if a1 < 0 || a1 < a0 {
+				return nil, ErrInvalidCode
+			}
+			changes = addChange(changes, a1, columns)
+			a0 = a1
+			white = !white
+		}
+	}
+	return changes, nil
+}
+
+// verticalOffset returns the offset a1 - b1 for vertical mode `mode`.
+func verticalOffset(mode int) int {
+	switch mode {
+	case modeVR1:
+		return 1
+	case modeVR2:
+		return 2
+	case modeVR3:
+		return 3
+	case modeVL1:
+		return -1
+	case modeVL2:
+		return -2
+	case modeVL3:
+		return -3
+	}
+	return 0
+}
+
+// readRun reads a run length of the given color, consisting of zero or more make-up codes followed
+// by a terminating code.
+func readRun(r *bitReader, white bool) (int, error) {
+	table := blackDecodeTable
+	if white {
+		table = whiteDecodeTable
+	}
+
+	total := 0
+	for {
+		run, ok := r.readCode(table)
+		if !ok {
+			return 0, ErrInvalidCode
+		}
+		total += run
+		if run < 64 {
+			return total, nil
+		}
+	}
+}
+
+// skipEOL consumes an EOL code, including any preceding fill bits, if present at the current position.
+func (r *bitReader) skipEOL() bool {
+	if !r.eolAt(r.pos) {
+		return false
+	}
+	for r.readBit() == 0 {
+	}
+	return true
+}
+
+// eolAt checks whether an EOL code (at least 11 zero bits followed by a one bit) starts at bit
+// position `pos`.
+func (r *bitReader) eolAt(pos int) bool {
+	zeros := 0
+	for p := pos; p>>3 < len(r.data); p++ {
+		if r.data[p>>3]&(0x80>>uint(p&7)) != 0 {
+			return zeros >= 11
+		}
+		zeros++
+	}
+	return false
+}
+
+// seekEOL advances to the next EOL code. Returns false if there is none.
+func (r *bitReader) seekEOL() bool {
+	for ; r.pos>>3 < len(r.data); r.pos++ {
+		if r.eolAt(r.pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// packRow converts the changing elements of a row into packed bits.
+func packRow(changes []int, p Params) []byte {
+	row := make([]byte, p.rowBytes())
+
+	var whiteBits, blackBits byte = 0xFF, 0x00
+	if p.BlackIs1 {
+		whiteBits, blackBits = 0x00, 0xFF
+	}
+	for i := range row {
+		row[i] = whiteBits
+	}
+	if pad := uint(len(row)*8 - p.Columns); pad > 0 {
+		// Padding bits at the end of the row are 0.
+		row[len(row)-1] &= 0xFF << pad
+	}
+
+	for i := 0; i < len(changes); i += 2 {
+		end := p.Columns
+		if i+1 < len(changes) {
+			end = changes[i+1]
+		}
+		for x := changes[i]; x < end; x++ {
+			mask := byte(0x80) >> uint(x&7)
+			row[x>>3] = row[x>>3]&^mask | blackBits&mask
+		}
+	}
+	return row
+}
@@ -0,0 +1,184 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package ccittfax
+
+// Encode encodes packed image rows `data` (1 bit per pixel, each row padded to a byte boundary)
+// according to the parameters `p`.  K < 0 gives Group 4 encoding, K = 0 Group 3 one-dimensional
+// and K > 0 Group 3 two-dimensional encoding.
+func Encode(data []byte, p Params) ([]byte, error) {
+	if p.Columns <= 0 {
+		return nil, ErrInvalidColumns
+	}
+
+	rowBytes := p.rowBytes()
+	rows := len(data) / rowBytes
+	if p.Rows > 0 && p.Rows < rows {
+		rows = p.Rows
+	}
+
+	w := &bitWriter{}
+	var ref []int
+	for i := 0; i < rows; i++ {
+		changes := unpackRow(data[i*rowBytes:(i+1)*rowBytes], p)
+
+		if p.K < 0 {
+			if p.EncodedByteAlign {
+				w.align()
+			}
+			encodeRow2D(w, ref, changes, p.Columns)
+		} else {
+			if p.EncodedByteAlign {
+				if p.EndOfLine {
+					// Fill bits so that the EOL ends on a byte boundary.
+					w.writeBits(0, (8-(w.pending()+12)%8)%8)
+				} else {
+					w.align()
+				}
+			}
+			if p.EndOfLine {
+				w.writeBits(eolCode, 12)
+			}
+			if p.K > 0 && i%p.K != 0 {
+				w.writeBits(0, 1)
+				encodeRow2D(w, ref, changes, p.Columns)
+			} else {
+				if p.K > 0 {
+					w.writeBits(1, 1)
+				}
+				encodeRow1D(w, changes, p.Columns)
+			}
+		}
+		ref = changes
+	}
+
+	if p.EndOfBlock {
+		if p.K < 0 {
+			// EOFB.
+			w.writeBits(eolCode, 12)
+			w.writeBits(eolCode, 12)
+		} else {
+			// RTC.
+			for i := 0; i < 6; i++ {
+				w.writeBits(eolCode, 12)
+				if p.K > 0 {
+					w.writeBits(1, 1)
+				}
+			}
+		}
+	}
+
+	return w.bytes(), nil
+}
+
+// unpackRow returns the changing elements of a packed row.
+func unpackRow(row []byte, p Params) []int {
+	var changes []int
+	white := true
+	for x := 0; x < p.Columns; x++ {
+		bit := row[x>>3]&(0x80>>uint(x&7)) != 0
+		isWhite := bit != p.BlackIs1
+		if isWhite != white {
+			changes = append(changes, x)
+			white = isWhite
+		}
+	}
+	return changes
+}
+
+// encodeRow1D writes a one-dimensionally (modified Huffman) encoded row.
+func encodeRow1D(w *bitWriter, changes []int, columns int) {
+	a0 := 0
+	white := true
+	for i := 0; i <= len(changes); i++ {
+		a1 := columns
+		if i < len(changes) {
+			a1 = changes[i]
+		}
+		writeRun(w, a1-a0, white)
+		a0 = a1
+		white = !white
+	}
+}
+
+// encodeRow2D writes a two-dimensionally encoded row `changes` with reference line `ref`.
+func encodeRow2D(w *bitWriter, ref, changes []int, columns int) {
+	a0 := -1
+	white := true
+	hint := 0
+	idx := 0 // Index of a1 in changes.
+	for a0 < columns {
+		for idx < len(changes) && changes[idx] <= a0 {
+			idx++
+		}
+		a1, a2 := columns, columns
+		if idx < len(changes) {
+			a1 = changes[idx]
+		}
+		if idx+1 < len(changes) {
+			a2 = changes[idx+1]
+		}
+		b1, b2 := findB1B2(ref, a0, white, columns, &hint)
+
+		if b2 < a1 {
+			w.writeCode(modeEncodeTable[modePass])
+			a0 = b2
+			continue
+		}
+
+		if d := a1 - b1; d >= -3 && d <= 3 {
+			w.writeCode(modeEncodeTable[verticalMode(d)])
+			a0 = a1
+			white = !white
+			continue
+		}
+
+		start := a0
+		if start < 0 {
+			start = 0
+		}
+		w.writeCode(modeEncodeTable[modeHorizontal])
+		writeRun(w, a1-start, white)
+		writeRun(w, a2-a1, !white)
+		a0 = a2
+	}
+}
+
+// verticalMode returns the vertical mode for offset `d` = a1 - b1.
+func verticalMode(d int) int {
+	switch d {
+	case 1:
+		return modeVR1
+	case 2:
+		return modeVR2
+	case 3:
+		return modeVR3
+	case -1:
+		return modeVL1
+	case -2:
+		return modeVL2
+	case -3:
+		return modeVL3
+	}
+	return modeV0
+}
+
+// writeRun writes a run length of the given color as make-up codes followed by a terminating code.
+func writeRun(w *bitWriter, run int, white bool) {
+	table := blackEncodeTable
+	if white {
+		table = whiteEncodeTable
+	}
+
+	for run > 2560 {
+		w.writeCode(table[2560])
+		run -= 2560
+	}
+	if run >= 64 {
+		w.writeCode(table[run/64*64])
+		run %= 64
+	}
+	w.writeCode(table[run])
+}
@@ -8,6 +8,7 @@ package cmap
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
 
 	"github.com/unidoc/unidoc/common"
@@ -24,9 +25,39 @@ type CMap struct {
 	// map of character code to string (sequence of runes) for 1-4 byte codes separately.
 	codeMap [4]map[uint64]string
 
+	// CIDs of the 1-4 byte character codes given by cidchar sections, and of those given by
+	// cidrange sections.  Codes of notdefchar and notdefrange sections map to the notdef CIDs.
+	cidMap       [4]map[uint64]CID
+	cidRanges    []cidRange
+	notdefRanges []cidRange
+
 	name       string
 	ctype      int
+	wmode      int
+	systemInfo CIDSystemInfo
 	codespaces []codespace
+
+	// Name of the CMap given by usecmap, whose mappings apply to the codes not mapped by the CMap.
+	usecmap string
+	parent  *CMap
+}
+
+// CID is a character identifier of a CID-keyed font.
+type CID uint16
+
+// CIDSystemInfo identifies the character collection of a CMap.
+type CIDSystemInfo struct {
+	Registry   string
+	Ordering   string
+	Supplement int
+}
+
+// cidRange maps the `numBytes` byte codes from `low` to `high` to consecutive CIDs from `cid`, or
+// all to `cid` for notdef ranges.
+type cidRange struct {
+	numBytes  int
+	low, high uint64
+	cid       CID
 }
 
 // codespace represents a single codespace range used in the CMap.
@@ -46,10 +77,50 @@ func (cmap *CMap) Type() int {
 	return cmap.ctype
 }
 
+// WMode returns the writing mode of the CMap: 0 for horizontal and 1 for vertical.
+func (cmap *CMap) WMode() int {
+	return cmap.wmode
+}
+
+// CIDSystemInfo returns the character collection of the CMap.
+func (cmap *CMap) CIDSystemInfo() CIDSystemInfo {
+	return cmap.systemInfo
+}
+
+// UseCMap returns the name of the CMap whose mappings are used by the CMap, if any.
+func (cmap *CMap) UseCMap() string {
+	return cmap.usecmap
+}
+
 // CharcodeBytesToUnicode converts a byte array of charcodes to a unicode string representation.
+// The codes are split by the codespace ranges of the CMap if any.  Unmapped codes are skipped.
 func (cmap *CMap) CharcodeBytesToUnicode(src []byte) string {
 	var buf bytes.Buffer
 
+	if codespaces := cmap.allCodespaces(); len(codespaces) > 0 {
+		for i := 0; i < len(src); {
+			code, numBytes := cmap.nextCode(src[i:])
+			if tgt, has := cmap.lookupUnicode(code, numBytes); has {
+				buf.WriteString(tgt)
+			} else {
+				// Overlapping codespace ranges of different lengths are ambiguous: use a longer
+				// code if mapped.
+				for n := numBytes + 1; n <= 4 && i+n <= len(src); n++ {
+					if !matchCodespace(codespaces, src[i:i+n]) {
+						continue
+					}
+					if tgt, has := cmap.lookupUnicode(bytesToCode(src[i:i+n]), n); has {
+						buf.WriteString(tgt)
+						numBytes = n
+						break
+					}
+				}
+			}
+			i += numBytes
+		}
+		return buf.String()
+	}
+
 	// Maximum number of possible bytes per code.
 	maxLen := 4
 
@@ -63,7 +134,7 @@ func (cmap *CMap) CharcodeBytesToUnicode(src []byte) string {
 			code <<= 8
 			code |= uint64(b)
 
-			tgt, has := cmap.codeMap[j][code]
+			tgt, has := cmap.lookupUnicode(code, j+1)
 			if has {
 				buf.WriteString(tgt)
 				break
@@ -82,7 +153,7 @@ func (cmap *CMap) CharcodeBytesToUnicode(src []byte) string {
 func (cmap *CMap) CharcodeToUnicode(srcCode uint64) string {
 	// Search through different code lengths.
 	for numBytes := 1; numBytes <= 4; numBytes++ {
-		if c, has := cmap.codeMap[numBytes-1][srcCode]; has {
+		if c, has := cmap.lookupUnicode(srcCode, numBytes); has {
 			return c
 		}
 	}
@@ -91,6 +162,143 @@ func (cmap *CMap) CharcodeToUnicode(srcCode uint64) string {
 	return "?"
 }
 
+// lookupUnicode returns the string of the `numBytes` byte code `code`, also looked up in the CMap
+// given by usecmap.
+func (cmap *CMap) lookupUnicode(code uint64, numBytes int) (string, bool) {
+	for c := cmap; c != nil; c = c.parent {
+		if s, has := c.codeMap[numBytes-1][code]; has {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// HasUnicode returns true if the CMap, or the CMap given by usecmap, maps codes to Unicode.
+func (cmap *CMap) HasUnicode() bool {
+	for c := cmap; c != nil; c = c.parent {
+		for _, codes := range c.codeMap {
+			if len(codes) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SplitCharcodes splits the character codes `src` by the codespace ranges of the CMap, e.g. into
+// 2-byte codes with Identity-H.
+func (cmap *CMap) SplitCharcodes(src []byte) [][]byte {
+	var codes [][]byte
+	for i := 0; i < len(src); {
+		_, numBytes := cmap.nextCode(src[i:])
+		codes = append(codes, src[i:i+numBytes])
+		i += numBytes
+	}
+	return codes
+}
+
+// CharcodeBytesToCIDs converts a byte array of charcodes to their CIDs, split by the codespace
+// ranges of the CMap.  Unmapped codes map to their notdef CID, or else 0.
+func (cmap *CMap) CharcodeBytesToCIDs(src []byte) []CID {
+	var cids []CID
+	for i := 0; i < len(src); {
+		code, numBytes := cmap.nextCode(src[i:])
+		cid, _ := cmap.CharcodeToCID(code, numBytes)
+		cids = append(cids, cid)
+		i += numBytes
+	}
+	return cids
+}
+
+// CharcodeToCID returns the CID of the `numBytes` byte character code `code`.  The bool return
+// flag is false if the code is not mapped, and the notdef CID of the code, or else 0, is returned.
+func (cmap *CMap) CharcodeToCID(code uint64, numBytes int) (CID, bool) {
+	if numBytes <= 0 || numBytes > 4 {
+		return 0, false
+	}
+	for c := cmap; c != nil; c = c.parent {
+		if cid, has := c.cidMap[numBytes-1][code]; has {
+			return cid, true
+		}
+		if r, has := findCIDRange(c.cidRanges, code, numBytes); has {
+			return r.cid + CID(code-r.low), true
+		}
+	}
+	// All the codes of a notdef range map to its CID.
+	for c := cmap; c != nil; c = c.parent {
+		if r, has := findCIDRange(c.notdefRanges, code, numBytes); has {
+			return r.cid, false
+		}
+	}
+	return 0, false
+}
+
+// findCIDRange returns the first range of `ranges` containing the `numBytes` byte code `code`.
+func findCIDRange(ranges []cidRange, code uint64, numBytes int) (cidRange, bool) {
+	for _, r := range ranges {
+		if r.numBytes == numBytes && code >= r.low && code <= r.high {
+			return r, true
+		}
+	}
+	return cidRange{}, false
+}
+
+// allCodespaces returns the codespace ranges of the CMap, including those of the CMap given by
+// usecmap.
+func (cmap *CMap) allCodespaces() []codespace {
+	var codespaces []codespace
+	for c := cmap; c != nil; c = c.parent {
+		codespaces = append(codespaces, c.codespaces...)
+	}
+	return codespaces
+}
+
+// nextCode returns the character code at the start of `src` and its number of bytes, given by the
+// codespace range matching the shortest byte sequence (9.7.6.2 "CMap Mapping").  If none match, the
+// code has the length of the shortest range matching its first byte, or else is a single byte.
+func (cmap *CMap) nextCode(src []byte) (uint64, int) {
+	codespaces := cmap.allCodespaces()
+	for numBytes := 1; numBytes <= 4 && numBytes <= len(src); numBytes++ {
+		if matchCodespace(codespaces, src[:numBytes]) {
+			return bytesToCode(src[:numBytes]), numBytes
+		}
+	}
+
+	numBytes := 1
+	for _, cs := range codespaces {
+		if cs.contains(src[:1]) && (numBytes == 1 || cs.numBytes < numBytes) {
+			numBytes = cs.numBytes
+		}
+	}
+	if numBytes > len(src) {
+		numBytes = len(src)
+	}
+	return bytesToCode(src[:numBytes]), numBytes
+}
+
+// matchCodespace returns true if the code `b` is in one of the codespace ranges `codespaces` of
+// its length.
+func matchCodespace(codespaces []codespace, b []byte) bool {
+	for _, cs := range codespaces {
+		if cs.numBytes == len(b) && cs.contains(b) {
+			return true
+		}
+	}
+	return false
+}
+
+// contains returns true if the bytes `b` are within the bytes of the codespace range, from the
+// first byte.
+func (cs codespace) contains(b []byte) bool {
+	for i, v := range b {
+		shift := uint(8 * (cs.numBytes - 1 - i))
+		if v < byte(cs.low>>shift) || v > byte(cs.high>>shift) {
+			return false
+		}
+	}
+	return true
+}
+
 // newCMap returns an initialized CMap.
 func newCMap() *CMap {
 	cmap := &CMap{}
@@ -101,11 +309,15 @@ func newCMap() *CMap {
 	cmap.codeMap[1] = map[uint64]string{}
 	cmap.codeMap[2] = map[uint64]string{}
 	cmap.codeMap[3] = map[uint64]string{}
+	for i := range cmap.cidMap {
+		cmap.cidMap[i] = map[uint64]CID{}
+	}
 	return cmap
 }
 
 // LoadCmapFromData parses CMap data in memory through a byte vector and returns a CMap which
-// can be used for character code to unicode conversion.
+// can be used for character code to unicode conversion.  The CMap given by usecmap is loaded from
+// the predefined CMaps.
 func LoadCmapFromData(data []byte) (*CMap, error) {
 	cmap := newCMap()
 	cmap.cMapParser = newCMapParser(data)
@@ -115,11 +327,23 @@ func LoadCmapFromData(data []byte) (*CMap, error) {
 		return cmap, err
 	}
 
+	if cmap.usecmap != "" {
+		parent, err := LoadPredefinedCmap(cmap.usecmap)
+		if err != nil {
+			common.Log.Debug("Unable to load the CMap %s used by %s: %v", cmap.usecmap, cmap.name, err)
+		} else {
+			cmap.parent = parent
+		}
+	}
+
 	return cmap, nil
 }
 
 // parse parses the CMap file and loads into the CMap structure.
 func (cmap *CMap) parse() error {
+	// The last name parsed, which is the operand of usecmap.
+	var prevName string
+
 	for {
 		o, err := cmap.parseObject()
 		if err != nil {
@@ -131,31 +355,44 @@ func (cmap *CMap) parse() error {
 			return err
 		}
 
+		// The name preceding usecmap.
+		useName := prevName
+		prevName = ""
+
 		if op, isOp := o.(cmapOperand); isOp {
 			common.Log.Trace("Operand: %s", op.Operand)
 
-			if op.Operand == begincodespacerange {
-				err := cmap.parseCodespaceRange()
-				if err != nil {
-					return err
-				}
-			} else if op.Operand == beginbfchar {
-				err := cmap.parseBfchar()
-				if err != nil {
-					return err
-				}
-			} else if op.Operand == beginbfrange {
-				err := cmap.parseBfrange()
-				if err != nil {
-					return err
+			switch op.Operand {
+			case begincodespacerange:
+				err = cmap.parseCodespaceRange()
+			case beginbfchar:
+				err = cmap.parseBfchar()
+			case beginbfrange:
+				err = cmap.parseBfrange()
+			case begincidchar:
+				err = cmap.parseCIDSection(endcidchar, false, false)
+			case begincidrange:
+				err = cmap.parseCIDSection(endcidrange, true, false)
+			case beginnotdefchar:
+				err = cmap.parseCIDSection(endnotdefchar, false, true)
+			case beginnotdefrange:
+				err = cmap.parseCIDSection(endnotdefrange, true, true)
+			case usecmap:
+				if useName == "" {
+					return errors.New("usecmap without CMap name")
 				}
+				cmap.usecmap = useName
+			}
+			if err != nil {
+				return err
 			}
 		} else if n, isName := o.(cmapName); isName {
-			if n.Name == cmapname {
+			switch n.Name {
+			case cmapname:
 				o, err := cmap.parseObject()
 				if err != nil {
 					if err == io.EOF {
-						break
+						return nil
 					}
 					return err
 				}
@@ -164,19 +401,57 @@ func (cmap *CMap) parse() error {
 					return errors.New("CMap name not a name")
 				}
 				cmap.name = name.Name
-			} else if n.Name == cmaptype {
+			case cmaptype, cmapwmode, cmapsupplement:
 				o, err := cmap.parseObject()
 				if err != nil {
 					if err == io.EOF {
-						break
+						return nil
 					}
 					return err
 				}
-				typeInt, ok := o.(cmapInt)
+				val, ok := o.(cmapInt)
 				if !ok {
-					return errors.New("CMap type not an integer")
+					return fmt.Errorf("CMap %s not an integer", n.Name)
+				}
+				switch n.Name {
+				case cmaptype:
+					cmap.ctype = int(val.val)
+				case cmapwmode:
+					cmap.wmode = int(val.val)
+				case cmapsupplement:
+					cmap.systemInfo.Supplement = int(val.val)
 				}
-				cmap.ctype = int(typeInt.val)
+			case cmapregistry, cmapordering:
+				// Entries of the CIDSystemInfo dictionary defined with dict begin ... end.
+				o, err := cmap.parseObject()
+				if err != nil {
+					if err == io.EOF {
+						return nil
+					}
+					return err
+				}
+				str, ok := o.(cmapString)
+				if !ok {
+					return fmt.Errorf("CMap %s not a string", n.Name)
+				}
+				if n.Name == cmapregistry {
+					cmap.systemInfo.Registry = str.String
+				} else {
+					cmap.systemInfo.Ordering = str.String
+				}
+			case cmapsysteminfo:
+				o, err := cmap.parseObject()
+				if err != nil {
+					if err == io.EOF {
+						return nil
+					}
+					return err
+				}
+				if dict, ok := o.(cmapDict); ok {
+					cmap.systemInfo = parseCIDSystemInfo(dict)
+				}
+			default:
+				prevName = n.Name
 			}
 		} else {
 			common.Log.Trace("Unhandled object: %T %#v", o, o)
@@ -186,6 +461,21 @@ func (cmap *CMap) parse() error {
 	return nil
 }
 
+// parseCIDSystemInfo returns the character collection given by the CIDSystemInfo dictionary `dict`.
+func parseCIDSystemInfo(dict cmapDict) CIDSystemInfo {
+	info := CIDSystemInfo{}
+	if s, ok := dict.Dict[cmapregistry].(cmapString); ok {
+		info.Registry = s.String
+	}
+	if s, ok := dict.Dict[cmapordering].(cmapString); ok {
+		info.Ordering = s.String
+	}
+	if i, ok := dict.Dict[cmapsupplement].(cmapInt); ok {
+		info.Supplement = int(i.val)
+	}
+	return info
+}
+
 // parseCodespaceRange parses the codespace range section of a CMap.
 func (cmap *CMap) parseCodespaceRange() error {
 	for {
@@ -223,9 +513,12 @@ func (cmap *CMap) parseCodespaceRange() error {
 			return errors.New("Unequal number of bytes in range")
 		}
 
+		numBytes := hexLow.numBytes
+		if numBytes <= 0 || numBytes > 4 {
+			return errors.New("Invalid code length")
+		}
 		low := hexToUint64(hexLow)
 		high := hexToUint64(hexHigh)
-		numBytes := hexLow.numBytes
 
 		cspace := codespace{numBytes: numBytes, low: low, high: high}
 		cmap.codespaces = append(cmap.codespaces, cspace)
@@ -388,12 +681,25 @@ func (cmap *CMap) parseBfrange() error {
 			}
 		case cmapHexString:
 			// <srcCodeFrom> <srcCodeTo> <dstCode>, maps [from,to] to [dstCode,dstCode+to-from].
-			// in hex format.
+			// in hex format.  Destination strings of several UTF-16 code units, e.g. surrogate
+			// pairs, are incremented by their last rune.
+			if v.numBytes > 2 {
+				runes := []rune(hexToString(v))
+				if len(runes) == 0 {
+					return errors.New("Invalid destination string")
+				}
+				last := runes[len(runes)-1]
+				for sc := srcCodeFrom; sc <= srcCodeTo; sc++ {
+					runes[len(runes)-1] = last + rune(sc-srcCodeFrom)
+					cmap.codeMap[numBytes-1][sc] = string(runes)
+				}
+				break
+			}
 			target := hexToUint64(v)
 			i := uint64(0)
 			for sc := srcCodeFrom; sc <= srcCodeTo; sc++ {
 				r := target + i
-				cmap.codeMap[numBytes-1][sc] = string(r)
+				cmap.codeMap[numBytes-1][sc] = string(rune(r))
 				i++
 			}
 		default:
@@ -403,3 +709,74 @@ func (cmap *CMap) parseBfrange() error {
 
 	return nil
 }
+
+// parseCIDSection parses a cidchar or cidrange section, or a notdefchar or notdefrange section if
+// `notdef` is true, ending with the operator `end`.  The entries are <srcCode> CID, or
+// <srcCodeFrom> <srcCodeTo> CID if `isRange` is true.
+func (cmap *CMap) parseCIDSection(end string, isRange, notdef bool) error {
+	for {
+		// Source code(s), or end of section.
+		var codes [2]cmapHexString
+		numCodes := 1
+		if isRange {
+			numCodes = 2
+		}
+		for i := 0; i < numCodes; i++ {
+			o, err := cmap.parseObject()
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+
+			switch v := o.(type) {
+			case cmapOperand:
+				if i == 0 && v.Operand == end {
+					return nil
+				}
+				return errors.New("Unexpected operand")
+			case cmapHexString:
+				codes[i] = v
+			default:
+				return errors.New("Unexpected type")
+			}
+		}
+		if !isRange {
+			codes[1] = codes[0]
+		}
+
+		// Destination CID.
+		o, err := cmap.parseObject()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		cid, ok := o.(cmapInt)
+		if !ok {
+			return errors.New("CID not an integer")
+		}
+
+		numBytes := codes[0].numBytes
+		if numBytes <= 0 || numBytes > 4 || codes[1].numBytes != numBytes {
+			return errors.New("Invalid code length")
+		}
+		low := hexToUint64(codes[0])
+		high := hexToUint64(codes[1])
+		if high < low || cid.val < 0 || cid.val > 0xFFFF || (!notdef && cid.val+int64(high-low) > 0xFFFF) {
+			return errors.New("Invalid CID range")
+		}
+
+		r := cidRange{numBytes: numBytes, low: low, high: high, cid: CID(cid.val)}
+		switch {
+		case notdef:
+			cmap.notdefRanges = append(cmap.notdefRanges, r)
+		case low == high:
+			cmap.cidMap[numBytes-1][low] = r.cid
+		default:
+			cmap.cidRanges = append(cmap.cidRanges, r)
+		}
+	}
+}
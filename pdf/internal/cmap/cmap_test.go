@@ -324,3 +324,127 @@ func TestCMapParser3(t *testing.T) {
 		}
 	}
 }
+
+// cmapData4 is a CMap mapping codes to CIDs, using a predefined CMap for the Unicode mappings.
+const cmapData4 = `
+%!PS-Adobe-3.0 Resource-CMap
+/CIDInit /ProcSet findresource begin
+12 dict begin
+begincmap
+/CIDSystemInfo 3 dict dup begin
+/Registry (Adobe) def
+/Ordering (Japan1) def
+/Supplement 2 def
+end def
+/CMapName /Test-RKSJ-V def
+/CMapType 1 def
+/WMode 1 def
+/90ms-RKSJ-H usecmap
+2 begincodespacerange
+<00> <80>
+<8140> <9FFC>
+endcodespacerange
+1 beginnotdefrange
+<00> <1f> 231
+endnotdefrange
+2 begincidrange
+<20> <7e> 231
+<8140> <817e> 633
+endcidrange
+1 begincidchar
+<82a0> 842
+endcidchar
+endcmap
+CMapName currentdict /CMap defineresource pop
+end
+end
+`
+
+// TestCMapCIDMappings tests loading the CID mappings of a CMap using a predefined CMap.
+func TestCMapCIDMappings(t *testing.T) {
+	cmap, err := LoadCmapFromData([]byte(cmapData4))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if cmap.Name() != "Test-RKSJ-V" || cmap.Type() != 1 || cmap.WMode() != 1 {
+		t.Errorf("Incorrect CMap %s type %d wmode %d", cmap.Name(), cmap.Type(), cmap.WMode())
+	}
+	if info := cmap.CIDSystemInfo(); info != (CIDSystemInfo{Registry: "Adobe", Ordering: "Japan1", Supplement: 2}) {
+		t.Errorf("Incorrect CIDSystemInfo %+v", info)
+	}
+	if cmap.UseCMap() != "90ms-RKSJ-H" {
+		t.Errorf("Incorrect usecmap %s", cmap.UseCMap())
+	}
+
+	cids := cmap.CharcodeBytesToCIDs([]byte{'A', 0x81, 0x41, 0x82, 0xa0, 0x01, 0xfd})
+	expected := []CID{231 + 'A' - 0x20, 634, 842, 231, 0}
+	if len(cids) != len(expected) {
+		t.Fatalf("Incorrect CIDs %v", cids)
+	}
+	for i := range cids {
+		if cids[i] != expected[i] {
+			t.Errorf("Incorrect CIDs %v != %v", cids, expected)
+			break
+		}
+	}
+	if _, found := cmap.CharcodeToCID(0x01, 1); found {
+		t.Errorf("Notdef code should not be mapped")
+	}
+
+	// The Unicode mappings are those of the CMap given by usecmap.
+	if s := cmap.CharcodeBytesToUnicode([]byte{'A', 0x82, 0xa0}); s != "Aあ" {
+		t.Errorf("Incorrect Unicode mapping %q", s)
+	}
+}
+
+// TestCMapBytes tests writing CMaps and loading them back.
+func TestCMapBytes(t *testing.T) {
+	cmap, err := LoadCmapFromData([]byte(cmapData4))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	written, err := LoadCmapFromData(cmap.Bytes())
+	if err != nil {
+		t.Fatalf("Failed to load written CMap: %v", err)
+	}
+	if written.Name() != cmap.Name() || written.WMode() != 1 || written.UseCMap() != "90ms-RKSJ-H" ||
+		written.CIDSystemInfo() != cmap.CIDSystemInfo() {
+		t.Errorf("Incorrect written CMap %s", cmap.Bytes())
+	}
+	for _, code := range []uint64{0x01, 0x20, 0x7e, 0x8140, 0x817e, 0x82a0} {
+		numBytes := 1
+		if code > 0xff {
+			numBytes = 2
+		}
+		cid, found := cmap.CharcodeToCID(code, numBytes)
+		if wcid, wfound := written.CharcodeToCID(code, numBytes); wcid != cid || wfound != found {
+			t.Errorf("Incorrect written CID of %#x: %d != %d", code, wcid, cid)
+		}
+	}
+
+	toUnicode := NewToUnicodeCMap(map[uint16]rune{1: 'a', 2: 'b', 3: 'c', 9: 0x2000b})
+	written, err = LoadCmapFromData(toUnicode.Bytes())
+	if err != nil {
+		t.Fatalf("Failed to load written CMap: %v", err)
+	}
+	if s := written.CharcodeBytesToUnicode([]byte{0, 1, 0, 3, 0, 9}); s != "ac\U0002000b" {
+		t.Errorf("Incorrect written ToUnicode mapping %q", s)
+	}
+	if info := written.CIDSystemInfo(); info.Ordering != "UCS" {
+		t.Errorf("Incorrect written CIDSystemInfo %+v", info)
+	}
+}
+
+// TestCMapInvalidCodespace tests that CMaps with codespace ranges of invalid code lengths are
+// rejected.
+func TestCMapInvalidCodespace(t *testing.T) {
+	for _, cspace := range []string{"<> <>", "<0000000000> <FFFFFFFFFF>"} {
+		data := "/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+			"1 begincodespacerange\n" + cspace + "\nendcodespacerange\n" +
+			"1 beginbfchar\n<01> <0041>\nendbfchar\nendcmap\nend\nend\n"
+		if _, err := LoadCmapFromData([]byte(data)); err == nil {
+			t.Errorf("Codespace range %s accepted", cspace)
+		}
+	}
+}
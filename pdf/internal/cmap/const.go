@@ -15,9 +15,23 @@ const (
 	endbfchar           = "endbfchar"
 	beginbfrange        = "beginbfrange"
 	endbfrange          = "endbfrange"
+	begincidchar        = "begincidchar"
+	endcidchar          = "endcidchar"
+	begincidrange       = "begincidrange"
+	endcidrange         = "endcidrange"
+	beginnotdefchar     = "beginnotdefchar"
+	endnotdefchar       = "endnotdefchar"
+	beginnotdefrange    = "beginnotdefrange"
+	endnotdefrange      = "endnotdefrange"
+	usecmap             = "usecmap"
 
-	cmapname = "CMapName"
-	cmaptype = "CMapType"
+	cmapname       = "CMapName"
+	cmaptype       = "CMapType"
+	cmapwmode      = "WMode"
+	cmapsysteminfo = "CIDSystemInfo"
+	cmapregistry   = "Registry"
+	cmapordering   = "Ordering"
+	cmapsupplement = "Supplement"
 )
 
 var reNumeric = regexp.MustCompile(`^[\+-.]*([0-9.]+)`)
@@ -0,0 +1,117 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package cmap
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// Predefined CMaps which are loaded, by name.
+var (
+	predefinedCMaps   = map[string]*CMap{}
+	predefinedCMapsMu sync.Mutex
+)
+
+// IsPredefinedCmap returns true if `name` is the name of a predefined CMap (9.7.5.2 "Predefined
+// CMaps"), including Identity-H and Identity-V.
+func IsPredefinedCmap(name string) bool {
+	if name == "Identity-H" || name == "Identity-V" {
+		return true
+	}
+	_, has := predefinedResources[name]
+	return has
+}
+
+// LoadPredefinedCmap returns the predefined CMap `name`.  Identity-H and Identity-V map the 2-byte
+// character codes to the equal CIDs.  The other predefined CMaps map the character codes of the
+// Unicode encodings (Uni*-UCS2-*, Uni*-UTF16-*) or of the legacy CJK encodings (90ms-RKSJ-*,
+// GBK-EUC-*, ETen-B5-*, KSCms-UHC-* and others) to Unicode, so that text shown with fonts using
+// them can be extracted.
+func LoadPredefinedCmap(name string) (*CMap, error) {
+	predefinedCMapsMu.Lock()
+	defer predefinedCMapsMu.Unlock()
+
+	return loadPredefinedCmap(name, 0)
+}
+
+// loadPredefinedCmap loads the predefined CMap `name`, used by `depth` CMaps.
+// predefinedCMapsMu must be held.
+func loadPredefinedCmap(name string, depth int) (*CMap, error) {
+	if cmap, has := predefinedCMaps[name]; has {
+		return cmap, nil
+	}
+	if depth > 8 {
+		return nil, fmt.Errorf("usecmap nesting too deep at %s", name)
+	}
+
+	var cmap *CMap
+	if name == "Identity-H" || name == "Identity-V" {
+		cmap = newIdentityCMap(name)
+	} else {
+		resource, has := predefinedResources[name]
+		if !has {
+			common.Log.Debug("Unknown predefined CMap %s", name)
+			return nil, fmt.Errorf("unknown predefined CMap %s", name)
+		}
+		data, err := decodeResource(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		cmap = newCMap()
+		cmap.cMapParser = newCMapParser(data)
+		if err := cmap.parse(); err != nil {
+			return nil, err
+		}
+		cmap.cMapParser = nil
+
+		if cmap.usecmap != "" {
+			parent, err := loadPredefinedCmap(cmap.usecmap, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			cmap.parent = parent
+		}
+	}
+
+	predefinedCMaps[name] = cmap
+	return cmap, nil
+}
+
+// newIdentityCMap returns the Identity-H or Identity-V CMap `name`.
+func newIdentityCMap(name string) *CMap {
+	cmap := newCMap()
+	cmap.name = name
+	cmap.ctype = 1
+	if name == "Identity-V" {
+		cmap.wmode = 1
+	}
+	cmap.systemInfo = CIDSystemInfo{Registry: "Adobe", Ordering: "Identity"}
+	cmap.codespaces = []codespace{{numBytes: 2, low: 0, high: 0xFFFF}}
+	cmap.cidRanges = []cidRange{{numBytes: 2, low: 0, high: 0xFFFF, cid: 0}}
+	return cmap
+}
+
+// decodeResource returns the CMap file of the zlib compressed and base64 encoded `resource`.
+func decodeResource(resource string) ([]byte, error) {
+	compressed, err := base64.StdEncoding.DecodeString(resource)
+	if err != nil {
+		return nil, err
+	}
+	r, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
@@ -0,0 +1,5175 @@
+// Code generated from the character set mappings of the legacy CJK encodings. DO NOT EDIT.
+
+package cmap
+
+// predefinedResources contains the zlib compressed and base64 encoded CMap files of the
+// predefined CMaps by name, which map the character codes to Unicode.
+var predefinedResources = map[string]string{
+	"90ms-RKSJ-H": "" +
+		"eNqEncvOLrl1nue6is4ggDNQVCeeAMMAj4gdJDGs3IAstRwBUUuQ5IHvPvXzeT5HSIC9J917/fUVyUWyFl+u43/8D//4y5/X" +
+		"3/zhn3/8+f2fjx/+6cc//+Ff//TrH3/e/9uv/vizX/S/H3//0+/+8sMv/vFPf/j1L3/8yw+//d1Pv/mTv/nhn3/8l9/99LPz" +
+		"+uE3v/v1X6T2f3/9e1/+5b/9+S8//v7vf/rtH364+dVv/vWP/vIX//T+789/+dO//fA3u///9MNvfvztz37xP/70mx//9Luf" +
+		"/uWHv/mHX/3xVz+d/vmX//rHP/7vH3//409/+eHaf/nxp9/w5Guk//1Xv//xh1+U4/d//vk//ddf/sPP/8v/ffQ//+2PP/rK" +
+		"Q8+//sNvfvzzH3/16x//9Kuf/uXHn/3tcfzdD3+bj7/72d/m8/n6d1mrv1T9+vdY77/msf+++tff347/nxbO46Dlf/7tr//X" +
+		"r/7kmysv27y/qHV0qAdqQoWXuo/VoPJ+di6owi9PqP5Sx9EeqLGfPfYw97OaodZ+dtND2GNZ84Y6eUYPIe7eD5+ll3rmGFCN" +
+		"kTHq8NX7dZwBakDRe5iM0zbtnfcivQfGEr96v66LVuLFM3957zavCPVA+Uu4vZmlSH8344z0F3iW7I9nqTEyn3Uonw3mk9lN" +
+		"zOBIm8rHppYUc3byXr42DxHeM2t7wkNmbU+4zWn/8kPlTd2sX/5a2ys+F1SFss3GyKS+RnadrlGG28m8FLg9aLO4z3zmPuO9" +
+		"EqCY3RKhKlTa1EV/JbOX4L0wzs97jPPTw9c4r9CZ3dKh2AVlQMF7mVC2uaBoc38rV6isQz2hGEvdcx0aPNQbijmrDxSrUgMU" +
+		"61Dj3j3ul5r2Tj59b6/DWaQalD10fgnVWLGDr6oVKNpsk53MDLYFVTbVK2tED50ehlSHYsX6oE247bTpio1Kmzwbu5WrMvNj" +
+		"t3LL0bAVKVthrgcji8zu3HN9BX45b/YnPMyH/cl8zsAzqcgzel+7lfNiJtbJXDPOtVcsRnbBuqFoZT1Q9LcSu5xdsNg9c7/3" +
+		"PF/9vTJ3j/pJUAHK3cOcPe6eL95fuaz8/f/k8fs79hUy+HFfnbbBvjozFPvqfKDYV6fv7XkIl1SC8pd7t4RbqkD5y0rvcMCe" +
+		"C6zzU+Ec6flUvpvTZ3w3Z4OC83Ov7NPg/OKXDf5uKfhjhZ4Gf7cU/D1S8Mcue5r8MZYmf8xLkz97lz9/CX/slqfB322b8Ick" +
+		"fxr83bYJf0jBtE+O+372V5T2yXHfrEPaJ8d9X/xynxzvLwfUzS8z1MMvE9Q+X2/mOsV93t13hNpy4WZfpZihfK/wnj1U2vS9" +
+		"BmWbHapBDUZWoSb98Swxsg5HiZEh91Lil4lf7vPnlUP0vs+fl1pQe23Pk7FkeO/+8uHLpM28R3bdzGdmZGlC2Z/vMfNp79ZU" +
+		"/lpKpHL+lQRJhdNPbgsS5KLNggQ5/WX4K+mSSvwr+ZVK+ivZlgpyVv44ca4mVf9K8uT97T+z7HHm/e2HwEzk/YW/B8cN9TXO" +
+		"EtmfeX/h4ekH1Nc448lXlfcXHm92ed5feCjLNr/GWQ52Xd5f+CuOGMv+wvN9OpavcaYa7OFrh8SjVqi+e5gn1Nc6xHnZytc6" +
+		"xDAH1No9RHrfX3i+qtQXf6Ut3ttfeMycW3l/4SGx5/P+wqMnat5feLyS1OavFXjfX3gog1HvLzzH5LMv/kpeD9Tmb0RmYn/h" +
+		"abQJ1ffIstTm7/palW9J5Ly//dQLK7RP0D2gL6rvlW25QO2VnQ3O++b8Pnz2xXmMxfc25/lhLfsX50XUkfsX5+XrM9jUF+cx" +
+		"PfTe895X0x6+OH9ER3mf5s96fK9tqkh9cR6Ox7F8cR7iYo72aR7Kbe+LmWY2x8FeZU3G5q/L0dj8HYORjc3feUixcxPrNfbK" +
+		"Rs6KPL74iwVslscXf+lo8DC++EtBbkfZ1KeVvbKFG0gerGyFv40z0pi+N/Y9qdvf3HuVe0werF/k2cYZ7/5lJubeuUdgZHN/" +
+		"mUdiZBuDBCVk3hjk/b79ZdhUldo7NzkvM+2xNHvIu83OfM7NX3bUc6/fBC/k+cVfyMtf7i9zVlsZuxXOpjz3/qy3zzZ/Hymx" +
+		"+DIzo94IqHROzbwR0Asf6GHBn3O9EVB4wKx5hU0pF9bmL05/yZfpqqwv/sLi9pWXXyYjW1/8xTH9ZdvzMtlnG1XlcO3+Cnfb" +
+		"yDdcnj3qyMjKg7ysUvf+Vui9PIz6kNqjvtcNhTzJCYpdxx2g7Nvs24i9b3m5ur3X3d/MUHtV7uh7W54M7oVl33RTZSeXfdN9" +
+		"p+yEWnulkYJl33Q3lNzUiZyNUHtVIhihhL0qgXtTCfurapy2JYQ9Z5xbZd+QS2d/lsCqICdK2Kty1wJV9jpE+9tf1TMcS9uS" +
+		"KMPfvksH735l36XfPQ9H+y5djsf+1p4lVrpsRJRvvuKyEVEpINqyEVHwjCkR/sDWZSOi9xJ1fFsil42Vwkq2seVJ5IwuGyu9" +
+		"sJ6Z3lgpZudvY6XY+W7Kxkqxf365T8LBzahsrJQe7uplY6UXP/ke39szoTbnGTRdEt8bX21J55Y1tpK+OH/PbcaZ9s69OE/L" +
+		"RlxxICXKRlwpVN+L9M46p33Sl87Kpsz5zfylgjSz9y/+Xonqe6zsp4ctTwonYdkahnd/OJZ9HpyZNjfCC4kbXNkIL2QQc9kI" +
+		"L3x9dpvaa9ma1F7LuWxlnwAHUqnkvWKf/bi1Dy8A8r3NUQdVla19iOv02d6rh6Pe2od0fp7tFTuDbe4VOweztDFkum/b3N/i" +
+		"dJYKK5YY58aQ+Ro+2ysWOLPLxpDlcP02hiyXa7sxZBmn1JY1OTKWjSGjN/5S4C+wKoUTDoxVNoYM5/K9LWsKmLVsrUX42vqb" +
+		"2iu2smPZK7b8hjf2jJf9bewZw6K/jT1jvaT2Cd5AY2Vjz/hZv409083JUTb2TItzq2zsuUXOpvaOHJf97RNucNqWjT3L7La5" +
+		"+evVVvYX14ut7BPAe0XZ2PO99vre5q8razb2jKcyA+x5dqnNX3Y1N/ZM3gjKxp7PdIds7Pks56VxViT258ae8XD9NvZ85bNU" +
+		"5utnL4E9kzKkeYIzzsZZcTqWDnJjXjb2fBZIo2yE+agnLBthPstZ2gjzWYkeQJiXp19XljKWjTBDBJ2VjTBDOXy2+auuX+cs" +
+		"dAY3wgxNibwRZip+ARthxvh1an5TIm/sGRP3nbKx5wvPbX/Lmvb4bPB9y8/+Mo/IjG3smW5P3o09UwEHl409UwHJl409X8xD" +
+		"mxt7ps/ZvrFnvtCXlo093y+aHjb2fMUX+2pjz3fP+d6+VXxmc3BrGoxzY899Ld/Uxi5VWTo4Jd0fYM+OhruAPdf0vcWZCQ8b" +
+		"e75nhNSWPFFpvbHns/xuwJ5dDLKxZ/T2Wjb2TJe7emPPpAalgD1PUcDGnu+8MJ8be+a47H3z17l3lo093zPQ9zbKKaD3AvYs" +
+		"StYJyun2x84VkYA9C/r8srFneOEf1N65+fGXm7/sCbc8OWgT7LmmFF+m8mtjz3fDM0uLs1DUuLFnPLkxFrDn5Sm2sWe8Ps/2" +
+		"/gxoHyrYs4GK68aeMbLu9eEui767buwZB7unbuyZjuQzTnD2fH04wbOtbOw5uJ3Xh1WpUntV0nIsFTTWoFgVTr+6sed7AxxQ" +
+		"yMvlqLe8vJq/5EaHHKobe4aH769u7PlKAqm9KuPzS250MUM9IIYLap/noy6ozV/mS60be+aHPVE39nwxELO0sWeuyVY2f3f1" +
+		"2eavNvvroFRmMLDrqs/2VxUHs7SxZ3iFwaY29oxpMbLIV4V2v27sWa5Dau+6K/ve3nURGVJBmA9yom6EGebwl3vXXcjLCsL8" +
+		"UjNuau+6jFyqIMwM3qwgzNaYFxDm7UyAMDN3hyrCjP5y3+i+wMEXBcL8EoDflMgV7DlsA+ypjaWCPQ+wZ93Y870H+Gwjmcg3" +
+		"VRO6CE7Cmjgp/Bo29gxK8rqxZyzJNtEkFXvf3DUQet348sUgPNv4MtbEemXOb06OuvHlewLS5saXcQV/udfrcv42vgzBrwF8" +
+		"+fkWs+vFmoAvx23ve9QTG0Td+DJOzr668WX2DlA3vszltM0t5RfIt2bvelKLmxhztvHlewuFo40vYwEn1o0vY71ZWfDll2p0" +
+		"U2iILp9t/k5umnXjy1TRb9TC98ZpVDe+LOfwlxuf3Flq468oRxtfvhuJ3bnx5dufrQzOSaRSQYNyMy8bX6auxEK3qe2pVvBz" +
+		"99k+pR9Ov1rBX+h1awV/+fVV+APXVHSbDUtU3fjy7YGZ2PgyXMP+yqYm6we+HJwcdePLd0/43j6lP/MJviyfkU10A8zSxpeh" +
+		"FXpoaPjAD7Wh4XPXbXz5Uqz0xpfvXQyOmrpbdiu6zTP6LIJgmQnw5f3pYe/PEO1h78/kjmzIk2ArW55kEGxtoKzbNjfKap9f" +
+		"Tu4czNLGl+9VnR42voyLO07d+DKuh/XrrJ/n3caXSetT3fgyRU73uvFlSiDf2tmfyuCNL1MDz1bwZb+kCno7ZnCjyBdMsyob" +
+		"Rb6C3N7393f4hW8UmS8l0UaR+S7w3pGXyqWNIvfrmzpBZ4xzoOHzi9sosmi9qwOpWGwFDW3xWURiMoMbRZb4dTP7pkTe+PIV" +
+		"kLa4v8zi1we+vJzbjS+f6bkBvnyUlxtfvlLXZ3vnjg934Gfu1XXjyxTFIBtfpuTJtPHlexPzl3tlxVh148s8XMvJecDNoW58" +
+		"WfIptTnPgflDt5kDI5vqpv0lumlPXnSbd/JZ5zt1ZOimRR0bX4bkHp8gmYe9uvgyue9U8GUDMVfwZS/sHfDlRANVxZfKIfGl" +
+		"Mwi+vKfP0L0rn8GXYdh7wR7CHgBfJr928GUWAYEvMyNrD+cdp1h7kCfoUxr4siE9G/iyY0VtG1/GzvnaxJfJVvb3VrAmtMdT" +
+		"+obivnrYA9/bh9ryUr1n2/hyq0c2tb+3etomt5omD/t7E/m2jS/zZAYbuk19UNrGlyUzS23jy1LZBW3jy/cz9T006mg02saX" +
+		"4ZDbjS/D6ajVbfJlNnSbrdjKXpULDXcLoP5Pm3tVnukvlZcVaiMU79Vt48vk1942vkzuurbx5bshC9QJXm9QaPjQvLSNL3MD" +
+		"hbSNL+NdfbZvpTc3nhZBWfa+8eWLPe0hQ8HtxpdBzWATX/L9NfBl5ftrkfX79A6+vORhnweJG0hDgzmxzLYEXkm0mfiqkHQN" +
+		"FHlyv2sbReaIJbGBIg/8b1rCIuLu2SjymZwqLeG9hbWrbRT53hmZ640iw2detgYzPLetNMbC7tkIMyxupW0jzKgXVgNhhi9J" +
+		"/i2J3MCeCb1LA3t2tN1tY8/3e5PiW+S0a9m7HuPa2DN9Vihz9qGBaht7puqYN/ZM7fA9vkVnE91mX/4S61a0FW6ooJyGblPN" +
+		"Y9vY873wSy00ZcwD2HOgN2hgzwtU1cCeF1actrFnvE/2HNhzXj4LrDPj3NgzV7BgA3sOPPEa2PNa9ofu9vCXWO/QVDewZ/u0" +
+		"iW6s+h5aBO4OraL/8tvAdn6Ah1rVgsW8bHyZKki7bXwZzuQvwZefZ1ue3H434Mv78pfYSpSs4Mt7OpbK18c3DL7MINgGvuzT" +
+		"VtDP3o4M/Szajob+8lBGbXwZD78i9JcHfkgN2/l9+subXc0agS8rOtEGvuyc0m3jy3ShR2sbXyYtDQ39ZZq2ue/jhyu28WU+" +
+		"Qf1t48sssm8NCx26q9awQOJ32Da+LNp02saXxRt42/iyFLBn2/iyVNd948tngvAa+svTvYT+sl9SWJAzPXQsyM4S+sv+2ErG" +
+		"/gPv3VspHIEvb6z1DS1lduY3vkz908Nev8OTGAv5ha2yYSFP7jos5MPvduPLov9Nw0IeKu9hIW944zQs5E3ZM5Aht7/kPHcs" +
+		"WMiLpzQW8ua6YyEfyktRJLqIhpby9lzeKDJn9zVayiIKQUvZbt/b5/nyG0NLeX6o/f097kgs5J/zdapfZ5wbRYYDLN8m+vX5" +
+		"HYzcsJ3fnsqTnQviahtfprvYYmEfM2b0l9f02eZ8VkeJ/rL7DE0Stry28WUpykTw5Smu2fgyfk67hSYJ/+AGvgzcjNpC8iR/" +
+		"CeeeAODLBupvG1+Ww72zuDlkdtnCtwM7fgNfVm6FbePLcnoCbHwZOjeVtpA8oL8OvryL1Eb9Fd+VDr7sIKeu7ZyTvm98mRe2" +
+		"kg6+rPgFdfDlmaUctT1wn0Mv2B/0X2DWvvFlmmCQ/vi92cNelRv9Xkd/eS1bmeyyBrX3o5ingy8r537f+PJFY/Cw8WUWyfSg" +
+		"lov+Nr4MNydqx3ae5SGIn/1lQvfOWMCXB3f1HtA3nP4Se91j7+gbgiPreDo5ln1m35MZDNxqshxxq3FeNr4Mi/t/3/gyLG6o" +
+		"PeJxgzTrEf0s+6yDL8/IzEf0DaDUjoX8bra5bzUP31iP6htYB/SXesV28eXje3v9pjOP/jI4n+DLijTr6C87qLhvfJkG99C+" +
+		"8WUahZFtfJkm0qxvfJlWlLrRQTHzG19m/RV7ApO40glMwknVN77c6t1Nbf2zOsuOhXwu36vcZm0TfRj3n77x5StLbRN9X3cs" +
+		"W2o0cFXHQn6hOe6gSH0EOyjyASN0NZhYWfpGkSU6E1jIs3two8hwFKnEyeF7WJCRNl0LOVimgyKr37QoMvgeJ8AjtTmqX6fR" +
+		"tyRyz9wVnNuMXyM4qoMvD86NXsBml8+2hDywV/fC2Y7fZi/obsH1Hd3m5XphOw/YBPrGlyFhxewbX4Z82GbBpmmbFasRs7Lx" +
+		"ZTzd8eg2z2V/+P0t+9v8qYfv2M4LvpId23nFstc39kwXd9KObvOq/nKfADe2+o7tPKMP7tjOs9/Uxp5pJNtM6M1sZe/Vw68W" +
+		"v82ilNjYM7fTZ/uE69yrO7bzOaTAZs5nBZspMyp+Ou6djT1Lxgrd8dv8SNaNPcvkjtPx2/wKEPoZaoAvWeqpou28+QxbCXb8" +
+		"jt/mck+g25zKdXSbEx1Nb2Iz5gy/zRtU1RvefZ/e0f2BrfvGnln9Rsc7s7oLwJ7eUPvGnu8tlDaxnR9ouTrY8wbhdbDn427F" +
+		"dh4/beJ3Cx7q2s6j72WwoM/2/pyeWx1dCzfwDvb8fAFYyAO3to6FPIFe+saeceJr0bGQa7nsWMg76K+j28zoaPrGnu9eYiwb" +
+		"e+bPWYiF/F62gix1XrCQV6UZFnJvkB0N5mfm0WDGLoUs9fzZ2LN4O++D+11xZPqRsVsHvg/YePrGnuEWy0xslXitdrEnGu6O" +
+		"BrNyi+pYyBca/A721IOiT3yP3K0bYcasfNkIM9ZsmxmvL+ZT78xsK/v7q8Vf4gEg7tgIcys2NrW/v9N9rYU8+N7+/r6uJV/U" +
+		"RpjvvZdWNsJ8VurfkcgL3wD3B7rNQ7kg9sSC28Gep/OH3+bpSY/f5uWs4LfpzaEvUI5f38ae79fOrIA9bzQhHez5gIfGw3px" +
+		"Yg90m4Hb1tjYM6TgL/EDR6808NvUdjjw22ycaEO/zeAzvjfO6PHwveHfMDb2fKkEpS1IqiGVMtQe9eRUHmDPCX8D7Dmb7y20" +
+		"TLwXvKszavw2T3DNCOhTsKMMbOdaqUZQ9+ezgL9Bg8J78bBNvBeRdCN4l2Um0G0mbr0D3WZGazHQbeZP73j3dXvA44a73tjY" +
+		"Mw7sYGNjzziw7w5s55NvakT8UrG4jI09U+B2Mjb2TFrLR+S8Y2eNyN3htpV93nX0TCPi+8AJPjb2TMPZjdxl+VJGxI8fL54R" +
+		"8RY+fQ9bEBq3EfH2rvY38bf2vX0enO4lbOenuwcLuZFxA93m7dqCPbNrC/bM3I0G2LNxig2wZ3cngz378j29ve1hy8uTk3iA" +
+		"PY12GBt7puycbexZrk8rA2uTPOzzPOLlPzInuPOZ8bQFUQ6s59U9uLFn0e49tJ4HKRBYleIO7peT1UbbCghs2B/a9s8v945c" +
+		"rjTW804M3QB7arsfG3uW2Rwn/qaPvS+wIVTxBKAVEGYEvY+CDxh4dmA99+4+8M4c0WfbhiU+GljPb25tA+/MhbZ9bISZjVsY" +
+		"emee39EjD7Dn+oyLmJrhmPdaVnSbo6C7HXKHdxj2ggH2FEuM6sqyxyvYxZ2E3jNyfg9ihtLnvb2ymfvqQO+pvm1U/RoZy8ae" +
+		"8eT8HhVZA9IelXsg2vxR9WNhjvDbzH5hxAx95OzGnim4Pzb2TOO294XNlpE1/axYkwZ/6HxH44QDtw2w582ZOcCewZGBPQfY" +
+		"eoA9x/RZgrK/jF+QPeAJ7pcC9nzwsRxgz6xERu9ZP2PZO1cd1AB75mHv6AX9JZFBU5mPXd3YuyH2JE5ogD0PbhID7HniSzXQ" +
+		"e6bDNiPRYYwa7JmVl2DP0uyh4Nfle+xPpbzYUwkC9jS6b4A98+2zfVY052VjzziVBGBPLXQDvednL+GdqV5w4J2Zld2Ds4Ib" +
+		"wUDvqSZ3EBkUQGMDvee4bQXvTPxaBt6ZGX33QO/5+YbBntG9hPX88uvAep4ueeBu64phPV9KLCKD9FUeYM8TbD3Ansm9u7Fn" +
+		"iZ4jYM/sngd7liwVOZtsBcnabAUsI3ba2DMkT4CNPVMm2mjgnTmWbXa+FX+JHk15ifX84QY5NvaM4ueBdjM6Ext7xsSNdWyE" +
+		"+X4r9Kd2M/tsr1/jPjI2wkwDX5KBdvMWP2A9z45sI8zSiDEYG2EmNc5DhHlKNb4AeABheueYj94469sSeeK3eaGnmOg9H6xw" +
+		"E7u6XkkTu7p6urmxZ2z44c2NPXPgPj7Ve3Lzm+g9Iyh/buxZvhT3m9ry8gY9TPw278NnxCBy1k5ihiLRDnNjzxjBIPNB18Ku" +
+		"nvhtHuh1J36bekpP/DY7WGKCPY/pLzd/T/GXYE90AxPsmTmV58ae6XReAtp2TpwZjFPwvb0fG2hzbuyZZraHLS8je3Vu7LnN" +
+		"p5va8rJz+k1ihk4w+YzYifCvm2BPLW0TvWd0ztB7tiyF3wAnzozYnYlenBt7PotvcUb0ulhAJnb16/MMO99yLOxHtNEzotfl" +
+		"xjMj3t7ysLFnyFOKSMouhV4XaT2xqxesYlO7OpJnJtYPiTyT3ir0nvBW4eSYCW8VLCIzcZ6fvocf2eEz9LqX/XEe8C3OjT3f" +
+		"2fVZIz7FVrQ1MxPY1VPxGecBuumJ9byh6Zlaz8EPc2PPF2OxKht7psI9dGI9L8juifW8O/NYz8fpLzkB5D2rHbP3gq1SCssl" +
+		"Or0J9iyc0jN7gvP1q/eM9oD2KPpLtEdoESaRQRX8MIkM6pxwk8igPvwlJ0CXeoh6lEKigFBmwRPCvVvYkbe/xBOCk3+i3Tzd" +
+		"ISDMFuwdTwhOgKl2E53JRLt5Y3WfaDeN4JsiTO6ME+2mvvuTyKDepDjBOSsm2k2jQSfazeA3pnazfwcjT/Sexe+tEhWL3nOq" +
+		"9yQnxETveU5/uVf2Kfa2V3YVx9W52TJH6D2n37c2d/cj2LPhGzPBnp991bCROZsNG5L7EezZXJOGxt6vvSFrQOizeWtibsGe" +
+		"CVQ8G9js9pl6a3ZgY2W53U18Oo/sM6J+5QifTjPjTKLSAzh/ovfszplR6eh25sae2TwkE5/ONqXAnmi/J1HpCX3D7ETO+O2D" +
+		"PTt34LmxZw6P/e2zYhLJMjf2zHrZT7DnCdKeHcvlYQ9gT+4jE59OfV4m2FOvwIne8+TuMI0McncSGTSwOU71ntyIJ3pPI2Yn" +
+		"ek9vUROfzuPTZsSiZCvkU6j+EhsgNp051I75jJg29/Ug8gkb4CQq/UCPPAd3v9uR7Xu82qNJZNCJVXpic/e2MIkMutByTbBn" +
+		"8+RX7+nOIjLoIHpkGpV+2wr7U4Qy1aPZX+ZG4C/RM33arJy28LexZ7qmPRDD4F6a2ACxgcyNPfMZbXPvz4Fv9Fz4FIhzlloL" +
+		"RmZU+ukz7n6ed0alg9CnlnVPVD035QjsWcGXc6FnEk0Qle6daoI947BNtJvY+abaTfbnEnuCehbazc4sLbSbg+imtRHms6YU" +
+		"ltnDX5LlYvksEo1wQeHpgX1wgTBFE0vPzcdfYlkHMawHT+nhs478HN+WyAufTi2VC5/Op9g3WRKevSYLm3tBSqyNPbdz2aaQ" +
+		"l1gc18aeeYE6VsAn0Dna2LOo51nEq6uNXgFs3e2PGIbis4Luw/7McmEP3BXAGQub+/3YHz5YeDss9J5KpYXeM3GGLfSe3ffU" +
+		"e2IZWug9bxDzQu9prP7a2LOcWKHXxp7FeKIV/d7sAZ9VcM0CezZQ/8LmPoa94ycXbBNszTe1sLn34sjAnsln+zw4i23iyYKM" +
+		"Wug9jRtY6D31q1/JSEN/SaQhWpJFVHrEq3IRlZ74ihZR6XXaZmIv+Wyvn1h3gT2N0F34dK5qf2DP6XvoraPvEcPAWbjAngOE" +
+		"t8CeE5mxwJ6LO/4CexrZuMCe4/TZg06Iuc6cAGhkV2ZHotNeGd/2wx72ink3WlkvENYIveeFHnJt7BljsYfNkZFqK5NRB3+D" +
+		"lbU02CZeEn7DBS9jvzg8NyOYZBmVDnpfG3u+VzhGvbHndlXflHpdei/uSCl2JLqdhWV9ZtssfDlwtLFnNCvKwnNTX7iFdjM6" +
+		"80SlH82xoNdVvhT0unj3LaKGbjwaFl6dN15RqxrVzJ4wIxL3kYV2MyiX0G4G5QtenfG0FfhTdle1Y+wJvDqNHl54dTa0cauK" +
+		"UOwB3ypQ5MKrs5/2R1S6q0nU0ERLsogampyay6ih+Z28Fot4ooX2eeHveYH+Fv6e16d9ThFuoat59sGP8URowldTryulXtdf" +
+		"Vr5v1gTsGfEnWmDPpHQBe2buXot4ourKEk9UwTWLeKKOTnuh9xycyouMSGo0FhmRZvDZvlWcrgnxRKdylngifZsW8URmS1pg" +
+		"z+TpSjxRVnri71k+zxq6RsfZySbEXHewy5TasrS5zt14N/oDew6QxcLmfiiHwJ5irAX2VPu2wJ7h8T38zxwn2DMr27C5f2YX" +
+		"vednr6L3rNizFnrP6jc8tLgwavw9u4hkYJOuvrexp9FTC+xpvrUF9nywyS0yIkVPYvw9U/EZ/p7E5S0zIh0+0//fNrn1cntd" +
+		"YM9THIXe83LPq/fsvlfRpTJqMyJ1x7K/zIbmcaH3NGJqbewZ9d5d6j09D9B7JmdJvSfazIXec2AZWug9L+Uzes/gXKP31Mds" +
+		"bey5Ew9uKuENwMjQe56fZ8RjKvfEnsovsKe3r7WxZ8l71IWsvhEf7pciivSQImpo78GXutHi+WyPeu798lJ71x1bMrxUJPPJ" +
+		"BZWI1rcVYrm2ZaMcRqXvE+Cl0LZv+89OALRxcINCG73X4aXIcjH9JZ4ezVYWdhzGgnaz7nl5KbRjAx7QbrZ953gpbjw79vWl" +
+		"wNYPsxTMkMeo0W7io/tSWtYZJ5b1r9RZ35LI7+/wHy++RQarBHfY3PH2eSn8IW/HhR2sOeYtT4gOeyniiQJjJp7oM++Ru6yt" +
+		"EE90TqmbTAG0EpEnG0eVg3ii8qGM0ZBKeEPDw8aeecnRxp4vQmcPRLyMnfeNPd97EisU8eFptokPT4d39J7kg32pBS5lndF7" +
+		"5n2av5QZ5Ogdf08yWL0U2POmd2zun5XF5l4PfxnxxrQH4y/pHeyJl8RLYWe4/CXysjOD+Hvmg9XE5n5GZiKZIc82ibGp/BKb" +
+		"+3EyL1mvJFaajEgp0woZkaa9Z71AbIWIjZsvE73nkeEPvScy6qX0sGbOiBrCyl4O9J45STW8y+0djvz2sbnfp7/EK/B0LHgF" +
+		"ZuYF7Pm4PwtegZ1W8Ooc7gm8OvEeKQdRQ8GxoPfEw6ccRA0NeS9aUuCIjEh3tD+0D49tVvwxpYxoYD7Bntl12NjzvUHaO5ZZ" +
+		"pSd6T7yoy4He80nMNdizLUZWiUg5+RrRex4Xe1e9Z2BnkY0zKmvAnqezZMT6bSuccJOZAHuWwc4Ce67MSpuN84IjtJuXK0Y2" +
+		"TvLYvhTasWR/i/yKPCOiKPrMjEiL3s3GKX8gzOaXA8KcB7PbyCvj6UBEUfj0sPfnZ/2IKOqNuTaiKNgDJ/iCByKK8mIGsayX" +
+		"ZJvo5evxHYmsv2dxzGjsOyPpfpm0T6wR+bNfCuwSpIh9COwr9J54qpUD7EmU6ktF7Azw09m5zf64NV32V/D7YLd0M86wy7C5" +
+		"h8rcoveMJyukv2dhjsCeSckD9qwPPejv+aHwnb8ZJ9jzVEKCPXtlLGDPEekP7Dk8lcGeM7AK2NxzZSbQe15+7QNvn+EzbJxy" +
+		"hN7zUfIMdm7hmxp6mjtqcptk+MPm3vxqsblXRy32nHC0sWcaiXWYnIWHv9z8fWSG2NNxovfEOvJSxPo99A72vKO9YyOrPkOv" +
+		"e8AR2LP73YA9h+fkxJ/88hl6poc5E3uKQcCe+aQHsGf2BAB79s6oF7mFHmZ3kR1QSbeQrEoQ9J7Y/3dA3hf689RE73kmxmnE" +
+		"ut/KMlKAdd/YM2GdL8fC33rrRAuVJtJ8pIy4yVDsuuQzVmV7xhXqR2SyOhfqR7x4JUKBUJD5J9izd6mNUMb2PijUlijE2r5U" +
+		"hUpQ6CIYNXUnyrU1L4W6EyWwz6g7UYgdLtSdCHjAFOpOhMDpQN2JKII9N/Z8b6WMGuxJRqR9sO4oPca5sWeaQQqp0egdy/qH" +
+		"PyKKyMtQTiKKiIp6KTPk2UPjG2N28epEr1WobBHxhStUtogl+Eu9xJl5ECY5PQuVLRI+NoXKFq/okcJOi+ymskUq3/G1eH+H" +
+		"BbewJvh7DpD8qb/nw9yCPdFFvBR32dtn5FnlHnGCPQ8Q0LmxZ1mfNtEyTVsBe4I2qbERiHksp7HsnLwn2PM4mBVs7uLnE+y5" +
+		"7wNfFP7IDzOG3pN6A+VM5pFlnGRE6kjWk4xI9+kvOQ9A7yc292NJsbLBcWIHc6+i9yRbZaHeRyS+u1Dv49069IDeM3Azot7H" +
+		"uyOkwGb2DvYsn/fwSnLvkC2JnL2FWiCJnHGFWiDPzLaJF8inv4qelb1KrJHn8kk2zs798SRifXQpvAILvGNz/6x0MXMsPWBz" +
+		"vwM9oPd8Iu+h93yaFLehxSwRsZ7BiafYE1xKDZFyHPaHhy63hdNsSe7kjT1LOu2B7GQne4lsnIccoffMn/f2ip1+YQUPZKQ1" +
+		"NURyuBkZ2ZIGNxBqiJTDXU62JO+51BAJB5iOGiLBM+Y0mj35S3LiRUaGV+dnPvHqTMFnZLsC3VJfZDtbbqqDpPwl9/hhf+g9" +
+		"uR+cWNaJQSkn2DM4L1jWR/EZel0Q2NnwsEZrcTaj9aW4x8u7meDdBc2IG1Yay3o/7GHz96AXOdFuJiVrQ6L4xZmNc9gf2aBO" +
+		"W8GL55JaRNuxKh2EYptk4wwgsBOvzux3hGW9VCkts7aC9jbAH9HsqdsmlhR3CNHsxVOlE3HzfAcjU9elFG4j1HUp9WL+uv6C" +
+		"9s3OBd1S1+W9C8kPXyb3j5M4947W5yQTPJEC5RzorT0JiXP/7GMzwaN3OckEfynz8feMy1YS+Zh9j1ixwVjQex6eoOg9z+R7" +
+		"5CkYtkmUo/LZbJwN3tF7Hoc9kI3zok30nuTffKkTj3FaQe/ZPhR6TyUr/p63oybOvXCDoxpNan5hZEvCs/el0LCJAjb2LEPZ" +
+		"Pc1D5y8bt1DbxMbp+qH3DJ6v0zxmrAPY8/K7AXteBW6XuUBoRezJXZ1aOHFNZhe953P5DD0MSPTE3/MRK2FzX+7jhd7avbTI" +
+		"pvr5JT4hnuBkgv+cI9jciZQu1+MJXqCIkNrWn5dCXqLfuMCeC6R9GVEUbWWP+tx61pfCS5zT78LmXj/9oR3bltmXIpp9+Iwc" +
+		"qdsTtVzY3Ml18lJbXhKDX66NPSPR0C/FjfUzMrw6QcUX2NMT7kLvidd9udB7knO2XEYUcaZdn4iiCwqvzukvI/l2J1Qi6z68" +
+		"o/ecO+6jXHh1HuDnC6/ONKU4z9kFF9rNBlq60G6OZitE3MhtQGp0xkIm+Mid4yJbUi3wQDbO27GQjZN8cuUiG2fuzLX53pGz" +
+		"F9HswVGb773YXyaej7nGso5l76UqGlrbJILv0wPn+cVeIpo9Td8jWv+2TSPCGHUiIuw7ud92mM/WyTB/ie+Ne+eVyKu36Htj" +
+		"z1RPf0nebe5JF3rP8/PLfVIkdHiXsUbcGC9ijciB91L463K3vIg1CpyE18aeqS6fkamT8+1K+IRwjlwfvSejzuRj9svE5l7R" +
+		"tVyZyH1uTRc29+oOxN8zPbaCZQjdwJXJDLVsxcxe9oBNevoe2pXme1gubzjC5p6W/RH9Npkl/D0j6OECe2Zuk5f+ntxALvSe" +
+		"3qSvQqYT0PuFvyfVaF6KTDynrQSkNbwbawSWuMiWRLbKchFr9ASpgsaG762YSRweil4uttnRNTpOToAs5QnOLsDmrjbzwuY+" +
+		"sS1c6D0nmscLmzt59l9KrzJaAXsu5wzsuZq/JJOlKwb2vE/bxPKsjBJ7cje6wJ6ffV2RpcFfaklhzogoSh8KWZPsHWytLDUT" +
+		"PJqCq5GZDUR5NTOzMbtgz4qm7mpoxzg1r4Z2zJXe2HMvx6b2CR7cL40THA3RRSb4rsxA7/mR1s3bnu917k3wgGU9nf6STJ1Z" +
+		"HsyHzpyBPTuo+EK7eaPRuNBuxuQztEfYHS4iijL3rQvs6Y3/MpNS91nCM8j+MrcheMeyHsBAFwjzUErh1ZmDFPkOnRe0m7f7" +
+		"Ba9OqsW8FJm+0MZdg0ykoKVrYJkdzATazeWJg3Yz+I0RUTTsAa/OndvrmxLZKkTcsi+wJ54X5ULvmUQP+Hvmxerh79k+oyTD" +
+		"xofaK3v5tRNr9BxS+Ht6voE9P2OexoEz71OUwwrh7+m95Zr667KyYM/L+dvYcye02BSxYiIS9J7Z820qWRkZes/pl4nec3pG" +
+		"o/f895ERN+1MgD2pAftS2Nyz47QGBa2APW+/YbDnrfQEe2blHtizezqAPXOmP2zu1Cwp18L/DEvihd5zeDqAPb0fX2DP6n5c" +
+		"2ADFSmDPCX6+lrfePZ83meAP7g43VYhOTqob7HkOn+3zgOym5TZTJ5Lgxt/zCf4yEnt0QVEZJPlLsBly4cbfM2YpTnBQx/2o" +
+		"HStQZFLa/oPlBntqp73NpLTsD+yCVfommv2p9IBXJ9XDyo1X55pS1Kp5/KV3dTgiU2fmdLjJ1Fkue0A7xu31Ru850ZLcViFa" +
+		"voc/KzrKG+xJLctyE1GER9FL7RvddAapQkSFnZda1BKj90jmQPb1jVcnPpcvtXedPgw3ek/qQL4UXv6OLJpDgbXFq/M5/SVf" +
+		"FefkjVcnme5fCjsftoybiCIR301E0QIt3ZGIG+clch4E3yP7x+U4N3/UvSs3Xp03OrYbr87uiiXuRpznt/nel882f7crDcLM" +
+		"nBU3Xp1EL5Yb7eZVpVg/NPg3Xp36NNx4dZZkf9gx0ZXdZOrc1Lck8o3N/Qz2hveg80eOJTxtX4oqKNzjb2zuwTVB70lO1HKD" +
+		"PbNfH3HuxRXKVhiEc+Lc+4I7sOcAVd3EuR/uQLHn5z0sX8oFsWdivcCeGfl8k0kpf1pZ3C2ZP2zuEQ3bjb/nULqQqXNGf8nZ" +
+		"jv/GXchE+nmG11z3vc2fmp674DX3aRPf8mR/eEmgJbzJ1NmcwYJlCGx2F/W6zBLR7ENuwZ6XX1GxQiS9q/dUYqH3DJMeKthM" +
+		"6VmJ2Dh8trFZB/neG3vmecBtJZfeYQ/qdX2W8WKzP/RmyVbIVOOKVbRjjhq952eWqlkg/SX8DdYdf88HC+SNv6eY5256ucAR" +
+		"NvezMtfY3J/iMy17UtyNuLXdTQ9r+mtETHk6UAHzcZbAnocr3cgskX0PyyUn400FTP03bvSeWhpu9J5LOdQ8K2gTr0519nc3" +
+		"ExazS0RRcqXJEl+Us3h1Nlca7Ele/5ciS+m0TbweQRp3t26bbZILERvBbUQRmpcbr86afYYH+W1/+Otiubw39iyfLxzt5jX5" +
+		"jtBuknl7H6U/YHrc1ObvcI0GegpPv0GWUr8/tJsHOoXbaHb0N7faTfDRjVdnV9aRSel44MFMSqKQjTADWenLjWU9fnoY1DNh" +
+		"zsgFTxbwcqvdxJp9k0npdE9MM2GN70hksCeReOXG5h64j9/Y3Jt7nCpEh3KWHEt38r3EecNME2tU8LO6p3oK1os49+muRu/p" +
+		"bev+6D2lBq2wq4k1mspSciypSbrBnh2vnZscS9NVMEv8LcWt13NkkQ8T/cZNrNHt942/Zxdx4e9JzF65l7VppcDWaLhvssQH" +
+		"v+hFlv/kOLnVb+/k8qD3pNrOSxlrtKDwZMFW+RBrRLWkl0LviRb7ecxtP6DM7CXFjQetzGOsETrKB+zpGj1kUmpoLR6yxD/J" +
+		"94gAu+ydqnvNZ8Q3gAkesSeaiQfsSbW3XXJhy0RGrc2de+6zsWfq+JI8Zol/fBaY3Q5llnHbJAJz+AybO7qIB+xJnr3y4NW5" +
+		"Pv1xo+ObesCeIqcH7Hne/pIINyTdg95zNXpA71mQpY9ViEDaDxUwx5JCr+uKkcXzQQo+enVmW8FOlKSQJ9P+rGrDniCT0oP+" +
+		"7UHvqZ/JA/asj886cSasCpmUrk/v5MYFgT2Ruzp6kQfL+nTOktYE3kO7eYNun2TWakZNRFFCXj5gz/hphfMAi8iDdvPoUkQI" +
+		"c6t5yOK5uGU8ZFI6H3tvWPmlyMK6/OU+D+pnZOAxZOKDZZ14mJc6iThgztBuXu4CtJu9wZEIkxvWk7mDc9o+RBRF9AgPEUVk" +
+		"anupjH6KmcCy/lXa7ZsS+cnq6O2bb9F5yFj2LlskE4jyxCzxwZFwO1cuFDzisdk+YE/tuQ85lmLyl9TZK8wK2LODgx9s7vo8" +
+		"Ptjc9al50HtSX+Sl0Au6W8wSj7XwAXteh60Q/Xb6HhUiq5R5nJE1G3sW7yZPMfqNHjb2TE2pRBbPjF/jQ5x7447/gD0Pvzf8" +
+		"Pdvjs3221ylFlstom54A7NVKDevgM/RmnFQP/p5Exb4U/kvKWfw956cVMoNwhj5k8TyVJ2Tx1Gb1NO+59IDNXU3W08xKR5uN" +
+		"9cOv5WnW/WL9NvYsnfPuweb+6b2ZachnnHBgugfsGZs9EJ2prAF7XpeU0Zm2CfZ0D4I9qYH0Uuit3QVgzwcd20NEEbU5XopM" +
+		"NX5F6D27UhCbe3+k8OJxNcWe1fcSccyMpbt+jBq953zsjzzjn7E07jHMCxWKuqcKEUXUo3kp1q/LkdHs/HKQ6Yvb8jOsmMLI" +
+		"yCB/Y1t7sKzHz3v4IytdyKT0WRUyyGd0bM8wWh/ZNlw/5gXtphaKB+3m7d4Ve6JnfcikVLKtEHHjtwL23LkrvygqHHgWTizP" +
+		"oOlnclYkKfan3/TkrMAT9iGavWHXf6YVDujBOpefHvBHRhf+kMUzu0PI4lm40T0gzNHtj7hpT1SyeB5+Dxth5vw1sm9K5Kl1" +
+		"V37whkFP/RDnfrg7iXNP+M08G3uW7I5H76k280HvSZ3ql6IWDrj7IYvnZ4+TxXPJORWKkmuCv2eTc/w9H3RVD/6e6bBNUACa" +
+		"1YDec2EHC8QaJc6D8GDnw9YVyOJ5Y38JxBppiQobe5bKNxWwuS/uQgF/z7P4jMxe2OsCsUbkOSrBCkUg9ECskXEf4TED+QXF" +
+		"9/bYA54en/fQtURGjc29cBoFbO61SOFljCwNYM+YmJfAjYdvP2zs+YhnQ5A/Zgmbe+WkD9jc9RsIwaoojNrq61PKCEV4B3vm" +
+		"D4UXdbA/Mikle0Becv8JRLPfXQp/T+dMf09u4MGIIrROAb3njZ9QMJp9+Usi3DhHAhFF0bXd2LNQqaME9J4P53KIZp7zPbzg" +
+		"sxSZA4PU4JbtOOGvOjK+KrxVQkJeuj/BnoEzLRBR9HDHD8kIRUaWzBQFf2RSSiC1kNRb2+b+qqq7Fb1nQ/IEo9mrrWAtORwZ" +
+		"1hJnCa9OcvG+FF7iScr4DfgDewa5zdacZdfh1Tk+FDGJoKxAJqV2SeHlEuAo4yvAnSpkLM/IkAD2bM581vLse3i5TNvEK5A7" +
+		"XCCiKJ6+R8TN4zOi+6rjxO8duR5AmM35tMY6+pTw8epkvxjN7ndEJiUymb1UQKv27UxD7++w7KENC/h7ZtdLm3uy/c25d5NQ" +
+		"rFHHTsLmfkUpqk1xtgds7tSzeikz5LPLqI5JFbUXXGmT5j30ngXtW0DvWTKzQvV1vd6DNnflbNVf0GeRCGs4qlbTsr9MxXP7" +
+		"Iysd950A9hRbB+LcH78i4tyNGQroPTO6v0CsEbHDJRBrdLkfN/ZM1Eh5qROvK59dxK0xFrAn8cgvhc0WZB/AnuQzfSmzQMK7" +
+		"es/ge/gjK1kbNQa5vQZs7ueHwib92CaWS3SUAeyp5iWo9zzkgcwg4LZA9fXpF2YmJdBYwOZu1FAgoojaqi/FCW4PG3uWjDdH" +
+		"IJPSsfwl2Fp5CfaMwf6IZlcuoPe8sNQE9J6pSlGd6bQVzkJ3Fl6dhxKS6kWFkz8QUaSlNBBR1LoUZ6Ff39C/jlkiomiBzYKZ" +
+		"lLiBh0HWPfQiYWBD6vaXifOVwqfHeUHvmaqtNHyOpajG4feg3nM6FvyzlMFgz9tvZSp56I8amEEpv7FnzmiIAtpNPWDCxAuL" +
+		"eKaAV+fl+YplPePpHsggf376I5OSe4lMSuLSYCYlbjwB7KlOL4A99XMMWNaPyx6QrOi7AzWKqMxbwjI/MitNDcyMn2NAu0nW" +
+		"vZcik5mYi0xK+toGEGbnzhFAmMM1AmGSaeWl4K/ZQyFyM31HIqv3BLOGZb5B28diBvKNxLl3bCwR7ElNtRLBnmS3KpE490zk" +
+		"cjTO/bIV4tyRNdE4d6RZBHtqt4lgT2pCvhRx7odtVio+S+EvyD0pWn39kaLiBtqHCPakzt5LLeL497zHjT1LR4sWqY5JjfMS" +
+		"A7GnfKcxgF0qvGNzP7KtsB+REhHsmRyL1TE576I299v3rDphD9gS0L3HoB8SM0g8UeL+GIO2SjiiQpFxldGIdSzUMVp1grGA" +
+		"L8VKMWoxoE10m9pDIviyIzPixpfb/XBTmcgg+wNfXraiLc9WyN6FlIj6dLqXiBoy4ibi05k/IzNKjx6SVSGZFyoUXdwdYtLW" +
+		"xczjuakuNWJXJ0fQS7FG6DYjmTq7q0mmTuVzTFa1gSPw5UQLGrWrB8dCdjm5xXNzcY5Ered+KxtfJvJpvfcJNChIwYhuMyPX" +
+		"I1nitfZGssRP7q8R3WbqUljPm63gu49XeqRCUQJtRioUNbz1I56bz/Q9IrqHFIgZ6RnJ1Ener5cyLtZnRvDZA6c03uWx8FVh" +
+		"o45oMMmt8lJ8VX6bxcxsrAp1iG7s5ZGI9Uv+QJFJiUKWeOpGvxRe4mCEWEAh1f6wYUWpxnyyr61zCf6LZOo040AERVL/9qWs" +
+		"0U1/Ws+xCEY8N8lWXmJFA6ZcqtTUfb7jjxzBl3o5RnJ46gkR0W0aOxyJZW/uJDJ1GhMV8ek0MjSaqROrWMSns3KeRioUnUQ6" +
+		"RauvcxuJZInvyjbwJTmWSgRfJr928KVxjZF4okJkQgRfnsoh8OVFpFPUro6uJeLTmdy5+HSO6HtYJz2NzNSZbGV/i1RE3iHg" +
+		"+x7InIEvjTyK4MsnSZGDFUtpxKczgiUiPp0Lj7qIT6dx0xF82bETReOJwDyxyx89YFef3PFjN+LZZ2ThBn9F8OVwP36qr7Oa" +
+		"+HQm92N359pfJ2eCIyO+wbm2+rqnpvgSzX/Uru464NNpHE0EX96eOGSJp27nS+nTKcV5rlwf6iLgCHx5ejoQNUR+9xKxqxsJ" +
+		"Hgfxiug9o5k65dYs8ctxYlsAY0XwZb/kiPsP96ZIjfXuSUWmzo4VNWI9VzMR0W0eje8IfDncZ9RYD377ZuoEFcfJ/c4ZxHPz" +
+		"/LSCj5lfI7pNMlrvJLU7KsNfkgtEiaznJjeJOMk4gA4qii9dP/Dl4v4aF2f94TPz0NH7wqca22jEej79pje+LEm8QsS6GQ4i" +
+		"1vMARo5kS3qCzwqZM+29QrG2oMiutEaDSeb0kh4zNw8oPBvRpiYydZIX+6U4D9B3J6znJ7I0PZ7gCYpV4SxM1iHi60/UIZrJ" +
+		"/rQuH1CMOn/H+y1RHZPcYS8lKi5QnITDcXFSRMdFBR9wVApW6YGfYDw3I0F/Se2WkqiAWZE1KViXlB7Ixkn1wZcismT5nlW/" +
+		"Hqgt8xPrnMjGWU5/yWmX7YEYUmxIKVAVC5mYgnm3eYbtfIFSU8QegkY2YTs3YikRMzSxOiRihsgj+1Jkh222ib0OnUnCdn47" +
+		"n9jO7yVFDCn38UQFzDjsYa9RcZbQX2pZSPht+kUn/TabY8Heg900UQEzIKMS+LJiiUpWwMQbIFGFyHjFREYkqqGVREYkaoGU" +
+		"RGQQFYNLwnZOnu+XynjN2Sa+RtiFE7Zz7buJjEjl80vyiiPbkvpL94SZ4JG6CXzZsQwl9JfqDBO284X2NKG/XEsK7zeyfyQi" +
+		"g4wiTegvE+dPQn+ZuQMn8GWSB/SXN3fSlLkR3PZALRDO14R3plqnZFR69D1inbgpJTIineghU0ELhM9LogoRFRVfCm8OvzG8" +
+		"M/VeTEQGafdOWMgD9/+EhZzciy9F9jU0PYlsnBc38EQ2zge9ZCp6Ets7pxg2zkQmeG81Ce/MB31f2vgyPa4RWsqAzE/gy4wO" +
+		"MaGlzNwkElHpp+sHvoyf9/CUBhUkI4Ow/yTyvd9o8RJ1Ls3Tk0CRj6MGRd7Ky0qUnvyhpbyUDNYaehwZnu7FkcGfErPCn9IG" +
+		"FKmPesJC/lWg9NsSmQqYkVtaAl8aM5SwnS/lHpngl187+ktylb8UPv5gs6TtPNgKmY0/PRDzVX0PPxZllPHqftHgy8+aoL9s" +
+		"0/cWNlQ4x3aelQtm48RWn7r4i1XHb5NKASWBL9XFJTMinb5nRTdW1nj1aCtE/Q5bMXeL7+Gn4y4DX0a/om4uL3YSfpsXNrlE" +
+		"RiTyI5cEvjy4GSXw5e0pZswQXhIJ27m23gS+PNFoJPDlA1pJ4Mtz2grZ5UQBZIKP1V9i6wr2AL4scAS+fKI9qH9m1PhtDleF" +
+		"TPBDDDKMYWD9BvHB2PETkUELFJeMDPIL0zsTP6REZJCauURk0FB6WgHT9cN2TjXokrCdF+XlJHIGS1vCO9No/TQ5Kz79KVkd" +
+		"WSdXoP1RicF1oMY6Fa1filxl3MfTsoYIPaC/VMub0F9ezjzZOA/u6gl8mTttor+cnoXgywM/wERUOtU4Xypzr7bNQrYk36to" +
+		"ZFkHLOR66aWFbwC7PIMvH764TGSQ2tqMd+aNjiZjITcvVt74srRPK3gUDd8zA2CBMiOS75ERafoe+etvn2EVQIuXH737Tiis" +
+		"HtjuM3Uu1+Ev0fAx1xkUWfhSM96ZBbyS8c4cnIWZOpdayTIIUw+mTEYk6hbussLb93tAUfuKW00OWHXAFjmgVXi+42uRwZ5m" +
+		"gchWX4+2z30VrXwmXt1baMZ2fvDdZGKGbudP7Anuzug2D07lTPX1B2yd0W1WUEC2CpGzgm6zg4ozus0TPU/Gb/MYvoemjNMu" +
+		"o9sc3Hpz5DxASuTIeYBsy2RE6qfvUdOGEy3jtxm6PUxsqL7HyoIhM7rN6byDPc1bk6mAqSUj40O30JPnCgKyd6sRgvOzOROR" +
+		"l5m8NZMbSK7Y3JFfuVqRwjbx1wXnZ+2YfikVO1/wPeYF7/JcmZfsL/Gizo4FvbzzQt4asnC/1MSG5Hvyx0rjQzfxgMmNUeMt" +
+		"nK3zwq0+N0aNNSE3Rs3Jn7VOuj893bs9MGp3SGPU3fc43f3aG6PGKyM3bkrDNj0daFPrJLqkbH1rsG7u5PUHD+XuPZeZ2Kf7" +
+		"e6rAUQe9YLnMHetdsAcqFAXbpELRI6X1FR461nNue7ljvcuODM9Ud3LnJpjsD+tWdJx4GeO9mAenH3eOPDj9lJ4D/sBOecBf" +
+		"keIeD5rIA/6wVWatk87ZgD9XbMDf8D34Q1uVB/z5FX+sk8wn9a3XcNQgFGzieSpRaJM6gsblZWN7/XKM7UUrkznBqWPzUvDA" +
+		"CZ7Jp704ezOxvQfYMKMhOrg7ZDREZjDNEx7QmucJD54q0yg9W8EC+XV3+KZEntqW+RrQHR3uDzNtY5fNnO2H0mUZ1cyMWcMa" +
+		"38xsDWv3qt5vSgJskwcWs4xt0tyAmRrWR7ZNOC+2Unlm72Brz0wiL/RWLI+1OW8oRtZ9xsg4Kwr6Ia2MBf3QQWxHQT908J0W" +
+		"9ENmgivUqT6m7zEyrJqF81tdS+H8Vv9ViKA48ScqeLGdyOCCF9vJvirBGjoViqyF7I9i9O7tM6Jb8U0rWBLJwV9KULPaoDht" +
+		"0bCVT90Vf8k4g71zO4n+knyf3NwLJ6p3o4I252TFCtqcE7le0ObE4XuMk5O4oM05wUqFKFyqOpdCFO6JzChYC09QQcEiqCdL" +
+		"wSJ4gkRLNN6U3pPYmvew5Z2cAAVfsZPY9oKv2EkmkoIt7+w+s/IJ48SWdyJPCr5i57RNqiYG39OW53uMzL1kJCyW+0Ik7Ol+" +
+		"IRL25PsuRMJe4L1ixWf0U8WKz+4XImGpjVMKkbAX9pGS1UWwX6x8cvlLsCe3qIItz3yfJaNRR/tdjEbAV6xQ1fnixlPQp1zu" +
+		"HvQpF7iqkGH6Sj6DB86KYnUT7GCFaFeqZZRCdZMLvVZRn5Kl4KHYJjxgzS56fYHxCl5fF2daKd5KfUbt5uozon/Q3xSyqVzc" +
+		"s0v1ZsYaYXm7wGrFTH5ghILlTX/MYhZp5csni/T9bYlcyC99YYUuxrq6d9SmuOeqNg/mD5vc5U4inpWapS+FxgvNe2nqmKXw" +
+		"AQZHFfL4mWW8NKPmWBMr5CmxiCm4lVHk8bu5Q5WmT7rv4ZMOoizoRW73nDEFn7Fg43/snZiCRx6wa+CzV0RO3H5Kt/4M+4o8" +
+		"fsbHF+xupzKfXCq3O7fDn/sRu5t5zEu34hMjI1O08W6F6sxUaHkp+FPukUuFCtOldPjjtlDQi9xKrG5WejmCP7RHRb2IEots" +
+		"fTcotZCtz+yKBb1IBxWXgUYPi1kZ8AdWKp9sfezqYZ5Q+4O/DwV/6FOKMQXuQfQiatvL0G7K2pox5faX5K5wNad59ukd5PQo" +
+		"h0BOxrsVkJNRUQXfrYcsEOWTFcX3qMDsyQFyetBIFZDTk/1lJQrXX+I7qZQAOT2u5tT266jhQekCPnrcBfpuefotrfrwAD56" +
+		"uL8W8BG12V4K/rK/hD+sVAV89HiKLStMsw7go8f9Aj56sN4V8JGZvgr46Om+B39Iukpkqp69lcjUB3xUwU5mFKnqPsgLX8FO" +
+		"5mutYKdn2GamFsiCKuRzsBVGhv9UBR+peango2f6jJlnZ1Uzn0xHRrURzqZqxmd2VgU7URP5pbTaMk6wU2CfVbCTmdMq2Cl8" +
+		"z9ei4oOlvKzke7ZOSAVVWVOggqrU3VZQlVbhCqp6sBPVjapC4GZb8f8P6CErOozALqsgrsCNv4K4AjexCuIKxWdwV6XgDo1Q" +
+		"BXGZTbuCuKiL/VLwh6a/griCuwX//8A9qVpJGXRUQWNhOGrymLEDK2gsDHuHP3SildhT6s+WSlZnc+lXszpzB65kdY4g5kpW" +
+		"5wA6qmC64L5K1g5jzsB0AUxek/m6fW/zF7m5VDLrnfIApou3Y8Gn+rZ3KoqgdapguuieANOZla6C6aip9lKbI+tMVDBd5P5T" +
+		"wXTGl1YwnfaKCqaL7h4wndlNazYa2mdwVOyPmkTZ/uAImVHBdPqzVjBdbPYHR81W9opF5QJ4zxjBCt6LIKAK3jMGuIL3rOJR" +
+		"wXsROVTBe5G7egXvUY39peBv2Ar8gaMqeE+/jwreM0t2Be9RC/Sl4A8vkAreS+DnWvQy9r0FxcjIrKcPa8U/y3iAChZM+G5V" +
+		"sKA5GytYMLk/rWaHJK9gQT2tKlgwXf6y4vEJR6C6dNs7Ec/KNlBdAoVXMpgk8EpFA5acaxBfAutWEJ8VaCqeVkZZVhBf4vZV" +
+		"QXyJG1YF8andrCA+85lUayJ7OoD4Ev58FcSXmu/Bn3sCxJfQlVUQX/LrB9Vl7A6VusdfBpxvS2TwXuI+Xs3b7OqB9zIRBtXc" +
+		"eaCHCt4zdriC9zL36qoff/QZPp2PbZJBDkxQu37gUubO872OB7f9WeHTseCzig6jgvcy/g0VvJc9AcB72TMavJexBVXyl2Ru" +
+		"FRVNWW7+Ev7AC3XAn1/7ME6B3sF72e/NDHl+i+C97NenHWw4Tvjz3Afv5Wmb8KfMR4uWlflo0cyWVMGCxshWsGD23AcLZr9v" +
+		"EF7BN62C8PRvqB/vKdYBhFc8ATbCixmkXUF4Bc1cBcUVJTkorijJQXFUpn4poiWHv8SDjzt+BcXpm1NBccUvGhRnNYcKiivB" +
+		"HuDBfQaKMw9KBcUVPIMqKK54VoDiCt9+M78ImrMGitN7pIHiCpEC7eMhNaHQHLOzGiiuYKFraMAKerSGBqzw1TYQXpn2Zw2k" +
+		"DsWos/2Ro4G5bqA4aiGWBoqr6CibNeOQkM04TuRzA8WZRbeB4ioysYHiavS9wDPfIz6F2W1gtRptBf/PIoWlrdlKh2KWwGp6" +
+		"OzSwWu22CQ/oixpYjSq6LwUPfDkNrGamwgZWq+iEGlhNq1UDjzVsNc3qwsizBh5rp78klwt7t5kLBL11i1ZUhD/wWLt9Dx8D" +
+		"9nUDjzW0vg081rhZN/BYc67BYzuf8LckcgOptWAbZGaLUmb8h3OjMZPtw11yJHCXfK9BsefwZgpZCu6IA2zJvOm0CRprYN0G" +
+		"GmtIggYaM19EA4217i8fKFYPNGbO5WauOU6tBhprzrt5jqfP4MhVz+beYb1AYw3Z3UBjDenZQGMNX6OWzQNsD6wX9oIGGmvo" +
+		"fVoxVxmtgLg62KUZcXn6S/zC8cVuIK6O93UDcXWsHA1URT3tl6KG7mPv5uSCh2ItCXtYUPQAqupgl2adNu7cDVTV/YZBVR3N" +
+		"S9Pm6DcMqurcq5u+7ZyoDVRlDEpDw9aLPRQoVgXEZR3IZo1gpaA1gpsU/DX7gz+lBIjLzDENxNU5UZs545QgIK7uXgJxdc7Q" +
+		"BuIyU0cDcXXuMQ3E1TkLG4jLnJCtmdOQsYC4+vSXlbp+8ADiGqcjo9rV6VgG/m62MnnGGoHG9K9roLGhtAZzUYf7pfCac/eA" +
+		"uYbSGsw1QGANzDVAzA3MNbidNzDXCLYCf+4sMNd4HAv8uV/AXEOpAeayslgDcw1usw3MNbKjhj80dQ3MNaoU/HlqgrkG2pUG" +
+		"5hogqTb0zfGX8Idup4G5hjsEzKW/VgNzDSUKmGt0W4E/8FgDc1FL/KWsVgZ/YK4xvuNr0UBjQ3kCGtNPoYHGjFVuoLHp3gGN" +
+		"TdcSNGa8bkMzN90RaObM7tPAZmbibeYk9oQxJ7FnijmJXWdzEmOhbtYBDrZCBjK0dg3c9pEL4LbJLbuB2yaWywY2m54OeK9P" +
+		"VwFsNrkLNaMjue808NfkvtrAX1Mpv/TF2e918Jf1Z/pjffAbihkk8rWDscx328FYE9/MDo6axG90cNRcPqP3ZZtmbj6gmCV8" +
+		"m7p52rDQdTDWREvSwVgLG24HY+lT0MFYegp06/IiFzoYa7EnOhhrse7dXBkgko6V0VxeHfy1LtskPhhs3cFf67EVcoE8PiM+" +
+		"/7F3PJ0eeSC+Gw1KB3/p8d+jPNA7GGtFn5n3nrFYgwKZ0dGHmTmmg79W9hk8ICU6+GuxX7r5MNDDdPMAg0k6+Etvhw7+Wtke" +
+		"zM0JR9agQE/YwV96V3Tw12JHdvCXVqoOyjJSraMPW+zrjj5suSNBYMYWdhDYAqF0EJjxyB0Ettx1IDCjtrt5gLn/d/RhC+1K" +
+		"x59cr4WOP/nBPabjT26mtI4/uXUnOvkw9G/omagaLJcdf/IDS1HPej3CA/GK5hfpxCvqRdrxJ7dmQzfbL9roTrzigb28k+1X" +
+		"34SOP7l5Ozvxikf0GRy5Q4hXPJBEnXwYRlt04hW/ynF/UyJ38gAbZd/xND/QWnc8zfXD6EQyHsi2XvSHZD8W64Ox6uRiO9A8" +
+		"dnKxHX4N1N49uBV2PM2NQO54mluDrxPJeLj/iWTU86ITyXhwgnZq7x7gqE7OiwPrSK/wgA21k/NCn71OzotDuWeNM1B4p87E" +
+		"gVWzV6uwMhbqTOjp0cFtSymIN/nBOdzxJrduTSfnxYHNthOTeKC16NQ4M76uU19XP4xurl/spp06ZifaxY5fuBl8O37hB0it" +
+		"E3d4KmepY6bHRm9WDmQmqCVx+L0RW3hybnV8v09uaR3f7xNM3vH9PrHqd/Kmne5A8qadeOJ18qbpBdKJLTwvKXzb0XB3YgtP" +
+		"tFWdnL2n+4ycvefjL4mCUnpSDff02+iM2j1IFYgTi3/Ho/vE56vj0W2O1E42tJMbQbfOmF8YmXhPzyYslye3jE4mXvOEdCIG" +
+		"T2UpNW5PrK99GLvFOIkYNCN5x6PbmJ4+iOV1zw8rAErBn3KdjBQnd4dONrQTZNjJhqZffydi8MR7t5MNTV+Sjkf3iZ2jk2/X" +
+		"vN/djBTcSjsZKcw818lIcTbbhL/uWMyGZg/wB77s5Ns1tr1T68FaFh2P7pPbZcfr63RfU+vBikWdWg+nJwA5z8zS2Klxe2Jd" +
+		"7kv+mBc8us333okKNOtxJyrQSsCdqED9Wvqygvbmb5BbwrxDg9wSF9/YeMhDh+5jPORk/bL6fUsiDyo9mPF8kFH3emyRTBmP" +
+		"vTHmIMWYkd2DyL8rOErGHG2TqCt8eQc+2xc2uYHP9gXOGPhsGykzgln26I9qYRe7elAtzHp5g2phF7J7kLlM35hB5jL9UUaw" +
+		"/jP8BSswMWozS4BuB5kljKYdZJa4ur1vHp5P72YihT8yl13oBQcVG64uxQrxNQyz5qJTG2TNNVJzRPjjjBl4X5t/Zhj5d9gm" +
+		"/GEZGkT+XUjWQeTffdkm0ZmH/VGv9XDU8Ic2ehD5px/NiGajYTWTdVDoj8xl+pkMsuY+aDMHWXNvdB+DrLn6hIxEdcxsK+Ta" +
+		"zvYAf0iXkYhsTL5nnBUrTT3aO9sD/Ll7rEdbfGYFHziyHi3SeoDU9IAZILUbHcYAqZnTZoDUbqweA6R2uydAajfyZIDUblcs" +
+		"G6vJzGdjNX0PjrAZD5Dac/qMugy3z4hfP3w2+KX9sSMvn5ELBL3nAKkF7D8DpPagCRngsYfzdYDHjNIb4LEn+ktqwESfUQPG" +
+		"VQGP6ZEywGNBOQQee8jLMMBjD5a2AR7Tm2OAxx4s8gM89mRHBn/g/AEee7DID/DY4+xW+EMjNcgsoWfJAKs9fu9gNf03BlhN" +
+		"L5ABVnv8jsBqz7QV4ou4QY6K1OBEHeCxx10AHnv8qsBj4QuFfFMig9TM/zdAag+nzwCpWQtngNT0FhlUZQicMIOqDPqcDFBc" +
+		"QAM1zE7md2p0H9rT0Vw9+6PeOj5tAxRn7qlB1S9rEQ5QXHDPGcHHrXd0ooXRYw2z3z4+u8itzXvdTNuMBRQXlC6guBB8Dx48" +
+		"jUBx+qAMUFzAxjlAcSH53vZSbeCaQRaIoJylspc+KANMF5RDYLqAVmuA6QL4ZIDpgpIATGckywDT6RMywHTB88DqCu5cMJ3V" +
+		"yQaYLqAvHeC24H4cVmjxPaqoYxEcw0wdjgwewN0D3JZAsANspqfHAJuZF3GAzaKyG2xmlsQBNotYmwbYLIoYzBbmqoDNoquC" +
+		"PVKfkAH+isEeiKhDrzXAXxH7yAB/RU938FdULoC/oucI+Ct6AoC/rD41wF+x+J4VNxkZEXV6cwywWWy2Ag9KcrBZ9HRYZp/a" +
+		"czbBZmYVmGCzyP1ugs0imokJNovIrwkCi9z9JhF1kXvMpNZWnL7HqDmNJujMjIMTdBY5syfozJjxCTpLh22ye07bNGfW5miS" +
+		"2yvx3U4QWLp8RpYeR0Yt18T3PkFniV0wQWf/3gq5MpATE3SWHt8jCj7YA/UVwY0zWPsabs3tlaWI/Ev+kkxKnBwzustZFRCY" +
+		"dfAmCCxln8FD/o4eeYLNEvazCTZL5MGaVjTAo2EaGYeUmGCzhPyfYLPEPX6CzdJ0JKwQWqYJNkuus9W03ElgszRtBc6xpk2z" +
+		"fqG5mmAzc6JMsJkVNybYLLHHpxUNus/gD2k2wWZm659gs0q8+gSbZfcO2Cy7smCzfNkDvghovyfYLD+OkwpTt+OkogFYYoLN" +
+		"zOI5wWaZ82eCzczoPsFmOfrLzZFVUSfYLKO/nGAz/Uwm2CxnKThCuzjBZpmbxASb5eIzOCqOBY6yvZNbqMqDNbMcJ3no0A3M" +
+		"In/sHrN+ofecYDN9XibYrPgNg80ymqxZ5I/+zB2LP/Is5k6yFXNDsZeKtaGlyGqGPWuCzQqIcpqVYdomlWu4gUywWe6OxTx7" +
+		"8Ac2y+4esJnZWybYrChBwGZmKpxgs3LYCvwNW2H9wFgTbKZfy0SPpufMRI+Wlazo0fS4mVX+4B3cVsCsE9xWTinqfV72Rywq" +
+		"d+4JOst+K6CzgvZhgs6sTzZBZwXbwgSd6bsyQWeF+8hs5v6Fd9BZcW3N7RWlCjNof6yfOwsdW0GvNRv8+a2A3Ap3/glyK1GO" +
+		"4E+JjP6tcIJPkJs+NhPkZn3yCXLTc2aC3IpfgPVasSJN67WSv2uC3KyBMUFuXw7935bIYLrSbB/OlV9YRht+OtNqWu5/8F5R" +
+		"QlrJlfvABO8VMN0E75nNZIL3qicheK+gDZvgveJXC94rSkjwXuH2M63X6gkA3ivKbvCe3kUTHZ71Pic6vIoOaIIFC5qJCRZs" +
+		"oL8JFizu/2GlWluBP79FsGAF9U90eIXMCxMdXlWugxMLFvg5rRQND+BE89BPcGJ1LNMM8vQOTqyuMzix+k1N+ZPa/NXHHjpt" +
+		"Mp/o8GqyzUmNer4+MGT1mwJDVrTKEwypT9QEQ1a/BusWkA9zgiHrYyvkNxDJgCGrEnJ5FrLuy6rtzBIYsronwJAVJLrAiZWI" +
+		"9UVmr3hKMTJ25AInVnbkAifqg7XMvAAqWODE2vwlI+NOusCJlX22wIkVDdgCJ1Z8Ehc4seLPusCJdUgx81OKmV+Oc7EH915a" +
+		"G0NmPdYXGLJyR11gyMaeWGDI9vklmSWwfC0wZMMrY4EhG+uwwJANRLkC+abRuC0wZEMmrmDsGLyj4WuP7/HlYG9a4EvrRy7w" +
+		"ZUPntcCXjf2ywJdmG13WZEXzucCX5gpcn/wKcAQabO4C0KAeWgs02Ng9CzTYuKss0KCZLRdosGFFXaDBhj/yAvE14isWiK8h" +
+		"kReIzwpCC8TX3C8gvgZqXSC+xq1mmee1f8eyt5L5UpmHZN0O24c7dxlYsE3bh7vpe3C3fA/uOLHXxoL7uNoUeZVBMgu815GQ" +
+		"C7xntsMF3uvImgXe68FWqGuBvmGB9zpSYoH3OufbAu/17LMOxXqB93rxl9Y/Z6bBex2cuMB75lpc1qlCM77Ae+ZbX+C9jl1x" +
+		"gfc6smYV68jAH3ivoxtYZnl15sF7HfvgAu91cNQC7+m7tcB7ffhL+KuOE/7cO+A9vbwWeK+DUlc19zXcVnNfs37gPT3AFnhP" +
+		"361VrSHqL3fuEe8HC7w3kPILvDcue7BmnL+kHrNSArynD9YC7w1qxyyzcBHBtMB7Q6kE3hucOAu8N7hXrGYNUSmzyrAO4L2B" +
+		"XX2B9wZYcFkjVQkC3htoLRZ4b/h9N2sa2ib8ZZ9Zb9pWNn9mMFngPTPkLfDeaD6DP7SgC7w30Cks8N7wHLFGKnh2mWvLLxW8" +
+		"NzxHwHuj+wz+pq3AH3qtBd4b7jPw3vA8AO8N9wSobsg7qG4M32P93C+guqFcB9VNPG5WN7ekzxbPoEB1+nItUN0ETSxQnZ5W" +
+		"C1Q3b997aIV1B9VN8PoC1Zm3fYHqJprxBaqb7mRQ3XSXg+r0D1uguomPxgLV6R+2hvwxL6C6XY/zmxIZvDfR+y/w3vTbAO+Z" +
+		"f2aB96bnPnjPnD0LvGdeqgXem54U4L2prAHvTWUNeG96FoH3zByzwHvTkwlMZ930Baab2AQWmM6sMgtMZ66yBabTl2qB6fTP" +
+		"Wmbawpdqgen0MVtgOn3FFphOX7EFpjNv51pWs7d3qh6JT8B0U9mGXnDd/pLV29/GPMB7a++keaAXXBvrzgO8R76beYD3yAfz" +
+		"UmRe3B58LxWgMlTklycUo972s3mA98gx81KMeuOTeYD3qIA5D/Ae3mHzAO/h8zUP8B7V0F5q0oq9k9k7wxFWW3y+Xgr+Oq2Y" +
+		"aSvCEXiPeswvBX9VCv6qrcDfxjUvBX9bgzIPdIar2yb8VWbJOqgD3sF7a0uQl4K/CUfgPTLqzAO8R9aceYD38AebB3hvTbgF" +
+		"75FR56Wo53AwssiuO3wGf4uRRaoLn7ZJprRDKkExanAiGW5eqvCebVYo24S/BA/UQT1OVvNTiwqONk58KXvYmSWPm/1CLtfD" +
+		"/Uk+reNm/cjlegTGSa0A/LVeirrtBd6pg4of1EvBX6W/BH/O50aK7zPWIcFfY5zEoh7ukI0U3186MvhzbRP8deaFWgHHgPcM" +
+		"R4ORWQfVNcpW4PCXZFQ8GQu1qM7TX1KJPrJ7NopMZBabRyZzQGbUG0W+v/w2Rp4HFVLJKvNSxN0+9t1on1298eX7S59RkzjD" +
+		"+caX6VxwnsnLm9kDhVpGhVEWOK+Mkjqop/NezCWJ5KEO6ukXVuC8Me8FzgejLnDe/SWc+xUV+Ou+B3+TXUYd1NOvr8DftHf4" +
+		"W45a/vwl/C36ow4q3jcvRV2Lg7WkDup10l8lq5/fDXVQr4uZoFbAdfkedTQv+iPL6+W3YS0qvwbqmV4Pa0S21uuxh0U2e3og" +
+		"W+vll9msNc+8kHvkUs6Sk/VSkpvzPzMv5Py//KYa4yz03hhn9RnjrL631+FyNRs51Zu/3OtATY+X6lC2CX/dUcOfq0nO/8td" +
+		"gEX3mlLw4BqZ198zjdiHj2wj7yrVTeZhzdIDaUZe/9tvkbz+98nINhZ8KVaMulH3zTi79bvtYUA5lgnFzqJm6a2k23ivTGU+" +
+		"OUSozf5Sm4c7MjLqkt7RX8JDYoeQQ+T2XB7wkOhvwENih5Bb9fY8H/DQfQ8elHvkVr2d+QEPzVbgodM7ufvvSX/Uhro9qSa5" +
+		"cYfU5u9xv5C7/zl8L5D1lRkkt+rjnJFb9V6Mk7qkj9/DtEY3HJG7/1FC4on33LbZeSZFVufAGpG7/3n4AjbiS09gF2zEl6j6" +
+		"Mg9y9z/OPJGpj6cRiO9xxag+uv1TvimRF5z7ZS44z8z0xoLpKT6Dc78wsq4+3d7gvMMdWVcfZSJZ/akMOs8HfpCJ5wM/25Ix" +
+		"T2pD4WXyUvCzbYDzpDYUOUNeilFvXPpSjIwVOql7/yxbIRP1tqO8VIOyP2qPsgdOao+Gfet9qQllf2SiRs6eAW+Ok/cC9cjA" +
+		"s6e5+0/eI7Nq2DakeZJZNSBnTzKrhluKWrgP49x4L+H38VLUMt5+vi9FLeqHeaH2aADdntQeDY/9wV/wPfiLvgd/0f7gLzJn" +
+		"Ef4ibVp7FIx8gvfwJXkp+Ev0B6YLyPwTTEd2k5eCh0zvYLrACXCC6ULxPXgovgcPxd7hoTLzYLoAfj7BdAHcfZqfH3lyJuun" +
+		"SsFDoxUwXWj0AKYLoLETTBcaa5SsV8xOBtORCf6l4I/v4QTT4dfyUvAHGjvBdME9D6YLyKgT3BY4+U9wWwQjn+C2sPhywG0B" +
+		"bH2CzSK4+wSbRXcr2CyC/k4QWAQHnyAw6j+9FPWmLtYBXIV3zDzBVRFUcIKr4k1/YKforgM7kR19nmCnCCo4QT3RfQbqIQ/K" +
+		"S9F7sgd6T8wgqCe6z0A90T1YrW5PK6AeMqbME9SD58w8QT14zswT1BORdSeoBz+aeYJ6YrE/5qzYCjw0e4CHxiyBc6J7EJwT" +
+		"3SHgnPidvBbv7xizewcEFN0fIKA42REgoMjZd4KAotITBBQn+2MjoEz+mZdqVOmBcxAQWVDmCQJKh61QVUbpCQJKIIuzW9WJ" +
+		"Z1tTlpJyz8zzYM8TlJMe2gTlJKUSKCeBLE5QTlLyWB1T2QbKoV7EPEE5ZDOZJygnJXtnnIlxUh0zubJUx0yuOtWLEjf3EwRE" +
+		"3pN5goBS8T2qT7nqIKDU2B8gIHxJXgr+GiMD5aRmf/DQmQlQTnJ/gHLS8Bk8DGYJlJMGexyUk5QgoJzkyQjKSUoQUE72TAPl" +
+		"UKN+nqCc4tkEyskX/IFy8AaYJygnP8w8KCc7n6CcrMwH5eRsm4Nn9j7JU22bi1/yDJSTleSgnKx8BuVk9BsnKCdzrz5BOeQs" +
+		"mSdYBl+EeYJlMvfjEyyDV8ZLUV2e+90Jlvk/hZ1L0iU5cl7nXEXvQPfi7WaaAAHHTNpDW7Mk44DNthK5fyH9fFlBo2RZg65s" +
+		"/2884PEATnxwuA/18rAMOVL8C8sM9euwzOAbLsEyxHZ4gmUGdyzBMlSMvBatjhhWT7AMFYSvFa0m7sMTGeQNlSQV8vrzPZ6K" +
+		"7orOblgLK1ptEV/kqagGuSzyqFedb2MdLMdSq6l7DMEmOIcazNcK/wx6T3CO8f4l1Sga+g3/GLMTnEMcgSdqFB2+GROcY1P7" +
+		"4R9qR4JzbOns+MBXd4JlbKud+KD7AMuY7hEsY/SYCZYx3pwEy8wfPd+veuQE5UzG0wTlTN6UBOWQ28QTlGNDv4UHM+vcEwvP" +
+		"oZzJl1iCcmbGAyhnFrWS3Lu6e1AOmU48QTKzyYq6LkfXHZKZjKcJkpldW+JD15b4wLiYIBlm56+FD+gpCZKh3tS18IFRMkEy" +
+		"c+oM+PBoS3yg90xQzqT3TFDO5H1LUM7kCzxBOZO+NA0qiTOaJ9SpSV+aIKDJe5qGKtXgHwQ0Hx0Fj/TsQEDUb/CEzjRR0RLM" +
+		"Q+4WTzAP9cg8oSVNaCyhJa0P7zA8tPjiT/AQ8Sme4KH10TGjnStzdvSixRia0ItWpp2Q00K/SZATeV2uFVeXufprxROy9J5C" +
+		"TtQyuha1ofmuSJATOWY8QU4LNS5BTuSDuRY+dFn40GThQ9cZBr9xV6gfuZqOiQ8N/yCnpacVLWnpyUJLWsYVREsiNsATjLWM" +
+		"Y8JYi1ElwViL0T1BUlRO9wRJraFj4kPVMfEBJTdBUszke4Kk1sNbjJa0Hp0d//RkQVILFkyQFDU3r4V/erIgqcW3bYKkHvX5" +
+		"kNTz5SjU91l6BtGZyBbjSfXJ1ctT3+f5yKK+j8YYCIwcMJ4gMGIMPEFg62hL6qd8ZVHfWj0fBEYGGk8QGFEFniCwJ2m/g8Vd" +
+		"2fKPqwSBPerrILBHo5jy2P6Yzf5ljwybPXpWYbNH/SVs9lSuH2xG/plrDSyuEerUo/cGderhSyXBbY9GSbjtaTomnqNUJ7jt" +
+		"0dsAtz164n9WluQ3uO1R/wW3EdHgCW57mO9JcNszue5w24NyleA28tZcC/+YNUpw24PeneC2RyMo3PYsHQX/lvbDP428cBtV" +
+		"GT3BbURQeILbHtS3BLc96rvhNurdXAv/UBcT3PZs/YZ/8GyC2x7Xb40oKPyD24iguBb+HW2Jf6IqVf7R0wm3kUPHE9y26XUz" +
+		"3LbprTPctlFdM9xG1pxrZbacWNT7LPqtYi2sRjUox4pW76wzUK8VJTDDbcRhXGtyFLWMekURAXMtWl31G/Wmis5APa2uMxyq" +
+		"4MW1znDbpp/NcNvmDchwG9Ec18I/k4V/aDQZbtt8fWX0qT10FPyDHzLctk1b4t/imqFPkTXnWvg3dT78g/MzTEeMhmeYjvw6" +
+		"18I/nvkM01EP/Vr4x9uRYbrN90GG3DbPWW6qR8tVYj5ym7bEPwglQ3V7a0v82zqf6u0WLPzj+zw31ZtSO/HvqGX4B4lmqM7R" +
+		"HjNU53rO0K6ItbjWF4v9ID7nayGjXVGn51qF3/AI4nN0nwzxkd/HM8TnSUcJ/1zPIMTnTRaVsPOvV4h4hgVdTzUs6Fnn3hxf" +
+		"/uA5/X8eupe0BBYkBsQzLEiN1GvhKyN2ZqbS9W7Agl65C/Cew7p5yB8dU5W99Rut5gs1M+fozFZkWNDprfNQ/Tr8QxtzPf9G" +
+		"rVM943AiuYWuRTv5zs2woEMkGRZ0RoDM3KFD01kVyIeOGW0hh861HAuP4L2jXglSO1VWw+JZhdQUw5ChsaOrC40puiJDY4qE" +
+		"yNCYojIyNHZ0XaAxst94Xqp8yjGhsSPfobGDypuhMXLheIbG9OWSobED02VoTFESGRo7er+hscOYlqExRUlkaOznXYHGqO7o" +
+		"GRo76gmCxgZZ6Tw/qnXK2SEuRVBkiEsxExniOnqjIa4DqWWI60B4GeJS1EmGuM7Rb0Toqo96qPiskYq1mp+PzkcFNL3DVOz+" +
+		"qCcIqhofjWJBVeOjPmNTgZZvhxzsND4ajVSVm9E2UzWRnDbXor6b+oVgp/GpOp9h6ZgTS/vhQ9UZVHVP++GDRqqNDxrvgp3G" +
+		"R2NasNP48P2aqcr9QQHOjn96wx3/9DY6/un9c/wjOiA7/ulJdvwb3FuqJn40ijn+aWR0/NMTqarcU+ejHo3eYsc/Ygyy49/W" +
+		"MfEPes8H/5gVyAf/NMZQlfvDV3CmKjdVRK+Ff3+mI+ej+nU6Pp7DUZl63YpHyUFV44tam4Oqxjdpv4nFdQiqupaOEnf2CwGV" +
+		"oCqTnl6I6v/y7JRCVTrUjhJUNcjkcq2C9cXifqFflqCqQXaYa0WrqTt0LVrGd2cptGzomLSMXqkEOQ0yuXgJchrUK7rWwYpn" +
+		"rhC5/0UBLhUf0D5KxQcIvVR8oFcqVT4cLK686Td8QBcp1EykCtG1uPJLZ+DKo0oWqnJ/6aNKxT94qFT8e3QU/HvkA/7BX4XK" +
+		"iIpWKVTlJh/MtfAP9bRQGfG7tSX+QUCFyojkprkW/vE8loYPqG+l0U5itwpVDBOUU4JyrsWWQTnX4ihBOUORLIUqhopkKVTQ" +
+		"TkRCFCpoU1fZCxW0E9+PhQraiXGyUEGbykbXImtB0THjWqeqo0ws7bew1JYHS+3cWNzpjn98F5aBR/R0ZeARPV0ZajXXZdBq" +
+		"PdeDlnX9RstQO8qgZaZj0jI4uAxaBsGWQcuWfqNl9ENlcOUZqQq8klBBi9FOosqKceX5TivGlecLqxg+HO2HD3xhFSphZz3z" +
+		"VMLOH1n4BxUXwz9Gv2L4Bz+X0LwGFYuuhX+MjCUY6P6m/fBPTyuVmzL0WaiEreiYQiVsxdGU0LwGdUOvlbG4LqF5jawnhBj8" +
+		"DMOWiX9Vv+Ff0fnCv4i/+VWPXCY1zvWsUuUyDx0D7xhhClUu85SFd7rPC+/43ikL76DGsvCO3rosvIMhy8I72KwsvFOfuPAO" +
+		"Di4L79QrUe06dx0TH7p+m7SFq7K4e3w/loV/aBFl4Z+epEWd9q+OiX/OdXnwj9if8uAf8ygl2GwUPVcP/qmPCja7v+ko+Idq" +
+		"Vx78Q+MqVLsu6oeodp2JSCkP/vHNVoLN7pY6e/hXkn57sLQf/ql/flSHXi0L/6qe6o1/ehvgtkJ0RYHbCrpZgduoluQFblM0" +
+		"R4HbqC10rfCP6kXXwr+mM1CnfeoMxm9cXbitaPSD2xRrVOC2ohEcbitJbcE/4qUK3FagsQK3Fb2LcJvihArcVtRDwm1laT/u" +
+		"H1p/gduKnnm4rehth9uKRjG4rajfg9uKxia4rWzuEdxW9Ew4/qHPFritbFn4N7Ul9w+aLnBbIQKzwG1FzzVspgifAoHVr7Zs" +
+		"WFwXCIz6SF4gsCLqgcCq+iEIjMpG11o8BTxZEBiZZLxS0boSR1a1rhKVq0JgZKC5VuYoFYtWQ0QVAqtwToXAyLh+LVptOsPg" +
+		"t4lFq6e2VKs3Fq02HZNWm9pC3WPXUah7TC9VobOK1l+hs8pTXqEzaiJdC/+g8Aqd1R/U86seucJtFVaqcFtFza9wW+XprHBb" +
+		"3To+nvNVWOG2CrtXuK26fsPzLQ/w/OiYmzukszurRjPW4TeuEdxG1aNr4Tkjb4XbGr1LhdsafVSF29pXW4Z/iuGpoXgN8qx4" +
+		"DcVrNGaGalPlZnwIxWs0VITaiCWp2i/8U0RPpaJ1g2sqFa0VN1NhwQYxV5iOukNeYbrGV32F6cgj4xWma4+2pJ3wc4XpyE1z" +
+		"rWjnSDoD7YSVKkzXto5JO6GOCtORVcYrTNeYP64wXYOOKkzX+J6rgyvv+i086nyPV3iv667Ae4qxqTFXOahX5DXmKgcVdr3C" +
+		"gorNqbBgZ2aowoJUL7rWwuKOwYJNTxYs2PVkwYIdHqqwYNd9hwXJTeMVFqQK0bXCv04cbIUFFf1TYcGuJwsWJG/NtfBPTwgs" +
+		"2FGWqqlKPFcXFqQGkldYsPNFV2HBXtWyhzPoKPjXtB/+VbUM//i6rLBgb7LwDy2wwoKDr5MKC3a4qsKCnfGnwoJ96Df809MK" +
+		"C3ZmCyss2OGACvFRnela+Kc+JOZGjYxI18I/Pa3QYNcbAA2SG+da+IdOXqHBrrcDGlS0UYUGu55PaLBDNhUa7FBPhQbHR1s2" +
+		"LHyABrv6HohvfGRxj7aOGT4MPa0Q3/iqZY5V/6RHhgWH+i9YkOpMXmHBkWVx95gDr7DggNYrLDiYjayw4NBTDQsOIm0rLDiK" +
+		"zhd3jzpOXmHBoecKFhxozBUWpFKUV1hwNJ0Bz4faiecap2DBge5ZYcEBTVdYcKBjVVhQUVAVFqRqlVdYcOh5hAUHERsVFhym" +
+		"3/Bv6nz4xzx3hQWHnnhYkIw6XmHBIQqABYd6XVhwQGoVFhzMJFZ4T3FWFaYbGhlhuqE+CqYz8QlMNyCgCtMpXqrCdMN592G6" +
+		"oecYpiNPzrUeLB1lY/G2w3Sm8RWmM+YkKkxnRF5UmM7U78F0JqqC6UxPD0xnerJgOtMTCdNRU8orTGfq92A6YzVHhekUrVVh" +
+		"OvLPeIPpjNmYBtMZekOD6QzGajAd2Wi8wXQGoTeYjsw43mA68vJci1Z3nY9Wo6M1mM6gvwbTKcqrwXRm2pJWm7bkysPPDaYz" +
+		"FLAG09nEI5jO0CkaTGf0rA2mI6POtfAPVmuQm9HPNujMtn6jnShSDQIznqUGgRlrBRoENhl7GwQ2v2rZwYq70iAwsrBc68tv" +
+		"Cyth0RYIjNpX3iAwMrRcK+7D5FlqENisOkrHwltUtVm0n2HRaghsdrUl/Jt6CiAw6mJdC/+ajol/Qy3Dvx99yK965IZOR+4W" +
+		"b+h0E8pv6HRT9xmmmyYL71A0Gtw2ob8GtynKq8FtitZqcBtZXq6Fd7qzcNtEKWhw2zw6Jt7prsNtk1naBrdN9O4Gt62vfqPV" +
+		"jIQNbpvEIjS4bR4dE4+gxga3TViwwW1Lzwfctj7ab3I+fIDbpqstZOPUMwe3LT1zcNv66ijMUqE3NLhNkWMtuM1EDw1uW0T2" +
+		"NrhtJf1W2A8f4DZFlTW4benphNsUD9bgtkUUQYPbVtFR8I+YxAa3raqz45+ecbhtoWE0uG01/YZ/UGOD2xQ51uC2pd4MblPE" +
+		"WYPbFqNmg9uoAuYNbltDR8E/9LAGmy2UswZ/PcypNvjr0ZWAv56ssx9+45rBX4pEavDXo+sCfym+qMFfZFrxBn89ejPhL0UN" +
+		"Nfjr6TpDx6JlqHFkYfGGGveguDXY7NH7DZs96q3hLyphXQsf1INAWY/6bnJeJPVmUJYikRqU9aBLNihLkUENklIMUYOkqKDl" +
+		"DZJ6Hp2BdqLDNEjq0ZsKST1o0w2SevT2Q1JUyfIGESk2p0FEW28cRLTh2QYR7Y/2i3ZuvWNBRHZ0xyCiDSO0rXZqv8VvtBMi" +
+		"2npvIaLddHbH0n5xrTffxA11TBE+DVraQ7/Ftf6RMPrXPTK62VYPCWNtZo0ajKXomwZjbRSNBmNtXU0Yi4pS3mAsspl4g7G2" +
+		"+jYYaz+y8G7rmHin+wVHbSiuMW+5UcoarOS67rCSYlUarORQeIOVFEvSYCXP2m9hcRdgJWe+usNK1H/yDis5vVKHlZz3tMNK" +
+		"zpvZYSXnje6wklftR6shrg4redN+tDrp7LSaL80OK0lB6bASOVG8w0pkXbnWxlLL4uo6T0SHlRw1rMNKzvvdYSVnXO6wkjP7" +
+		"2mEl593vsJJDah2VyxmlOyqXm46Cf3wfdFQusqJ4R+Xyqf3wD27rMJbzfdBhLOebtMNYvrQf/vG0dhjLiR/sMBaVt7zDWM5T" +
+		"12Es37Lwj6euw1jOU9dhLD/aEv9cFv7xLdthrEMP0mGs81Fbwr/zlbWwuGMw1mFVRoexlM+kw1jnq7YcrHjKOyR1oMYOSR2I" +
+		"oUNShzG7Q1KH0b2jjp2s/SoWZ4eyFP3ToazDe9ShLOVd6VDW0RsAZR29HVDWKTo7/hXth3/oMB3KokKYd9jpmCzaiWbSYSfF" +
+		"7XTYiapV16KdU1vSzsUZYKdDL9Vhp4Oa2mGnA5112Om4tqSdrmM6awV0TO4D3wA92Mk+utPBR6a8JN1YY6D7HnxkEUfzqx65" +
+		"BzndI+JdkNM9BtcvyOlaXNsgJ1MMTw9yusenlUFO9sna8sFSSzYW9wtyonqX94kHaDJ94oHu7MQDPQOTdsJmfdLOqqPQzqot" +
+		"aWfTb7QT/uooV4rF6UFO97NTW9JO9W1Td0HW4Teu0uIuqKcLcrIP3NaDnOyj/mvhH5TTl1aB0JaFfzr7wj89Vwv/1PMs/ENP" +
+		"6Qv/9CQt/INBOpnEksaDxX1QzxOqln0/2i/8+6pfCKoyZU/pQVX2Zaa5B1VdC/+CquyrcSuoypT5pAdV2Vd3JbQr+2pUCe3K" +
+		"lJGlB3HZt+l84R/Vma5lWDp7+PfV6BDEZV9dzyAuUwRMf/BvysK/qf3wT1d3459Gh41/urpklviyEqJv/FvaEh/Q7PvGB/Xy" +
+		"0NgXhbRvfIBQ+saHrbPjA19RfeOD7tHGB40HQVyW9DY67YRJehCXUWnIe3CVKQ9KD666v+FRcNW1OF9wlSW9K8FVltRDBldZ" +
+		"Uo8cXGVJb2NwlSlepAdX2c/nLLQrS6b9woeU1U5nS/wL5rIkejn4pz44tCtTjpR+8E8j/8E/5t36wT++xTr1Man9cy38Y+6r" +
+		"H/xT/3zwz/Vb+Jc/2g//jo4Z/mW0sh6sZpkImEG0WOZdGcFq97eElfhtYmV+y1jR6vzjO+1XPfIIijNFhIyguGstrPAnM4aN" +
+		"oDjLMN0o+NP12+Q3tRl/eE9HwZ+l/eKeZDhqUEUgc/1GxQP0y0GWV2UsGZV2bv1GO8lTMCrtRD0dlXbyboxKOx/tRztdZ492" +
+		"Fl2/oLFr6beNpTPEc6V4ihE0ZoqLGEFjd0vOFzRmVNTxETRmBU1hBI2ZZvxH0Nj97YtV+Y22BI2ZMp2MoDErKF4jaMwKT8sI" +
+		"GrNSdYbwr+geNfxr2g//eBdHw7+m8/HeMGc1Gv4RUTo6/qG9j45/aKKj4x8j1WBdZeFNGR3/9IR0/EM9HR3/GNNGxz8iUUfQ" +
+		"mCnnxQgaM81zj6Ax00z6CBozzZaPoDGrvNGDHLCViJsRupZpzn2ErmWVlbYj2MwqxDVYO6lMFiPYzCrzRCPYzDTTPYLNrELo" +
+		"Y9Bq4jcG+WHPUVtotZ7WQasfnY9WPzpf3BXNlw/YrDJHMILNrNLLD9hM8+UDXavSyw/DP705cFtlBB9wW9NTB7c1dK0BgbWi" +
+		"LQ1LZ5hY3CMIrNHPDgis6ZmAwJTXYgSBWdNTF9qVUefFxyQSFiVrhHZlDQV/hHZlymsxIDdlwBiQWxs6Cj6gygzIrTE6DMit" +
+		"MeMzILfGSDwgt6anQHSmXgo6U7aKAZ1pDn5AYI24qwGBNcblAYFpnn1AYMprMZZmRP5kzd6AzZruLGym2esBmzU9H7CZ5rIH" +
+		"bKYcFCPYzDSvPmAzzasP2Kyrb4PN+kf+HH7j+YDNqAdzLdRTCH3AZl0jE2ymLBcDNut88Q/YrMN0AzbrWfuFD129GfxFzRcf" +
+		"8Fdn3B/wV9fTCX/1oqPgg545+Euz7AP+6rDEgL+6njL4S7Plg8rliukcGx9QXcfGB9SpAZspc8aAzajs4gM26+r3YLOung42" +
+		"62iUAzbrQ23Bv6Hz4QO0MmCzrv7LpWKzH2zW+X4c8FdXXwN/adZ7wF9dYyH8pfn4AX8pc8aAvzpruAf8dfTuw1+aHx+uleD6" +
+		"jfugfg/+6mTrG/CX5s4H/KWMGwP+GswBDvhr6FmCvzS3PeCvQRzGgL8GK9wG/DX09MBfQ62Gv4Z6LPhL89AGYw3urcFYytRh" +
+		"MNbga8FgLOXYMBhLOTYMktL8rkFSgz7DIKnx6Ji07NExadmjLZWH3rAWltoZV94+2m9jacu48sqOYVCWwTIGZRkZPi30MKMu" +
+		"iRuUZUlbVqwPVsN6sDqWY0U7jbfRICnjbTRIyiB7g6Ss6pi0k/tg0JJmew1aUlYNg4hsyoprZktbxjUznjqDemzrmLQFGjSo" +
+		"x+BGg3qMSH6DXuz8yZo9g2s0F2pwzfzoGBWLlsA11Mpwg2uMkdfgmkl/aXDNhAwNrlFeC4Nrpq4fXDN1pUNluhZXM1QmUwYM" +
+		"g3mUK8NgnqmrCfNM4tYM5tGMqsE8ypxhMM9kVDaYRzOjBvPMpmPiET2rDTziK9tgnqk3DOaZ0KbBPHOqLXjEyGswz9R9hnkm" +
+		"cUEG8yg/hcE8U/cSdlEOCoNdpmtL2qK3CHaZ5AEy2GWieBnsovlPg100q2mwC5Us3GAX5acw2GXpDYNdNI9psAv1Kdzgk8Ws" +
+		"t8EZC+3D4IzFWGioQEv3FhVI84oGZyzWAhmcsVBCDKVHs34GTSzTUeL+UUviWoPfdBTaMnUU2rJ0FNqi+wAVLFjXoIIFFRtU" +
+		"oGwOBhUo74Mx8i/UaEOVWXo7UGUWX2YGFawta/Ib7YQKNANpUME6Ogo+6P5BBeuo1eHDg05hUIGyRxhU8KgvhQqUBcJQZR5G" +
+		"KoMKHt13qIDaEtdq7EdboIIH1dygguej84V/j/pSqICaFG5QwaMnBCp41D+j2Dzqu2P+7F4J/IMYHmZ1DGJ49GRBDA8jo0EM" +
+		"j/oJ1JyHrzZDzVGuDIMmnqZj4l/XUfCP2VeDJh7XlvhHbKhBE4/uAzSxda2hia0riJrzozj3r3tkOGNrJIQzlLXA4IwNexqc" +
+		"sZN+C8+16t3gjI1iYnDG1nsKZ2y9i3CGsh0YnLF1n+GMzbe6wRnKYWBwxtaVRudRDgND59nQ7YQzqBDhE87YvN8TztiMoBPO" +
+		"oEKETzhD+Q0mnKHMBBPO2Hw7TzhDuQEmnLFRqiecseHSCWdsk7XZTy3jyk+dgStP5NGEMzaUM+GMzez1rPjHl/RE6VGGgQmD" +
+		"aC52wiBUlrgW/tGDTBhEM7oTpYeaED5RejYZiiZ8so/OgH+uY+IfdDvhk+06A/4xdz7hE0cTnfCJo4RM1Bytnp+oOVrpPmEX" +
+		"zU5O1BwnOmzCLlrbPmEXNx1zYWm/B0vHjHZS7+BajqWzHyrecAYUG81HThQbZ7SdXV88nAGyUf2BCa84ve6EV3zrN1pNTM+E" +
+		"V3zrmLSayKAJrzjqw4RX3NUyWq1rrTo9jL0TJnG+Zqfyb6KlTmhCs4wTmjj0ghOaOFDqhCY0lzehicP8wYQmDjrahCa05n9C" +
+		"E4f5g8nM1EFvmLDFgV4mbHFQOyZscfT+wRaHHnLCFkf3FrbQHOCELQ761Ay2qIP1wpPaOEbk8oQtPnrfp3IZ0+pgi/rVPSIX" +
+		"1ZdZ6U32Fs1tb0VEMt+6pRzT92x6mzRksb5oa8sfT3I5f7ZCZJPX5cP7vVV/lgjkTZ2J8ej4KP1HrVT9We0XFfk0S7ujHyqe" +
+		"tN8Oy3VMD+uolSdayUqBHf3QtTLWNyz0hh39UDlo0zv6oXJ4F3f0Q+XoilU8N1kNq2B1rsrGGpG5vOjsFlbSMWdsyTzKjn6o" +
+		"asZzRz9UlTdgRz9UtY5/Rz9UP0VHiefjo7OH4lw/R9Y3LL5wdvRRVTNmu/F8NG1ZWIFPq6OPqlpFvaOPqsq6v0NxrprT2fHt" +
+		"VTVzsqP/qgn1YUf/VRNkuKP/qokvsR39V826K9F/XatjhX85qWXhX+Yt2tF/Va2R3dF/1bz0W/gnrX9H/3VfInyIL7Na1M74" +
+		"MqtFdzO+zCo1468V/mlt2o6erlZ0mB09XdUqiR09XVU21R09Xe1TZwj/pKfs6Omq1hvs6OmOvgt39HRHMbI7vsyqYtt3fJnV" +
+		"If+iF6zGvPeOL7OqzJI7vsyqVf3WsLgS8WVWrWnL8MgYs3f0pVURwTv60qq4wz2ogan7R2aXmtTq8GjpyYq+tC49IdGX1kdP" +
+		"cvSl9dEzEX1pfXS+UKPrgwq0Q42um5n8HWp03fIv1Oh7lXjDI1agbr0B0SNXxYrt6HXrZo5nG71G0n5xVw6RTzt63arsLTt6" +
+		"3aoIik2vexjTNr3uR9eTimTKebGpSKZ59k1Fss/P/QqWtozqNF8UlE1Fsg9fJ5uKZF/5R0WyL9/8m4pkmvfeVCT7omlvKpIl" +
+		"vkc2Fcni/ftlj0ytsqzrTq0yzQxtapUV9QTUKiso2ptaZbXIoh653mFqlTX1C9QqU3brTa0y5c/e1CprSUdhPNDTQq0y5c/e" +
+		"1CpresOWKsFzVYgwaHoXQ8U+1An1Ta2yhjK3qVXWNPZRq6wxum5qlTW9YY/qrfOMU6usMRO7qVUmLXyHin0HB45JrTIpx5ta" +
+		"ZVpBtKlVpvUwm1plWs20qVU20Is2tcq0qmZTq2w8aov8wyNVoEWb3g/9yVf7UaFVz4cq0PIlvVWBVk8Stcqkw2xqlSlaflOr" +
+		"bOoKUqtsMkO3qVU2fx6TOqF8nW9qla2to1ArkC+eTa2yByV+b9VC1JbUCmzaklqBxC/trVp6OgO15vhy2VQk07fKpiLZZp5v" +
+		"U5Fsq0d21ZrTltSa4xtgU5FMWcY2FckUgblVkUyMoIpk8kEVydRjqSJZ1m/UYtOz5KqLpfNRN4pIj021sqO3keyAiqzaZAf8" +
+		"aGQkO6CyEG2yA/7sh1StjG+4TXbAr8YYsgN+dQWPqhDRzvgqvU+PjkK9Hb7MNhkAlYlkkwEwoWI7DJlgBCcDoMZsD4Y8WgXi" +
+		"ZADMULGTAVCr/J0MgJlZMYcU89Z+VA1BE3IyAGZmYFwVKj46JlVRuIJOBsCSdBSqhvDN72QALEnno2pIkg9UDUHPdDIAFr6X" +
+		"nQyAWkvvqlDBe+uqUNH0m/Ll6yjknv/BHb/qkZ3cgI1x38kN2IgycdWmZQT1qvuF5+QG1KyYw5BpqiVk2k5qJZnEi85AJnHo" +
+		"z8kNOPjGd+V7ppfwpkzUbNk0DtMyMv6Z2qk8zqiuTsY/rSXxplY/WGQMdu1HBlj53tTT8Xwo4x9fI07Gv111FDIaMmI7Gf82" +
+		"/bqT8U+R2a6Mf/TBTsa/U7Qf+ciIyfUu/2gLkaFZ95nI0KznoyunB9eayND86Jjk9Dg6OzkT5AORoQX11IkMrVCOExlaGdOc" +
+		"9TeqIeJBhkdZsp31NxVl3IfWnnJ24kSH3gatsWFkdOJENZvgxIkqo7UTJzqnfmONjd5v4kQ1l+dBf4ca1u6ssZnyVmts5JHW" +
+		"2OgqsTZaap+zxka5R501NlLOnDU2m69lF/0lHZN45EfHVJQlrVY0KPzlQX93Px2FCDd6Oueb+5u0H1F6jDiOnq+6Zo6e/9Ux" +
+		"iSlIKIqOZq8sS05MgfIxOTEFKctSFJu2JMqLscKnoth0BqLY9BQwD5DQ+5x5gJT1G1Fs8p15ANXvcuYBkt4/5gGSPGIeQJl/" +
+		"nHmABDF78Ni9ZtoSHyBYJ6ogubbEB1Q1J6pAVbJ8KVKNVjN/oOxFvuAVRjFn/iDrjWP+IPH15cwfqGKXM3+QiRt15g9UQcuJ" +
+		"KlDlLSeqIPON448iwrhjjyLC/E96ZGYdVLPKmXUoqBbOrENBi3BmHX6+349ijdTKeFMMxcRFahp9mHVokLYz66D8Da5YBKLX" +
+		"XbEI0LQz69CLfiMWoegozPEXHYU5fj07ikVg1s+ZdRi6Ysw6jKbfmBnVM7D1tuMDMwum3pqZhaknl5mFqefKNQvHfswsKP+5" +
+		"M7Ow+Kp3ZhaUV9yZWVjiDGYWFjTtmlngm8OZWdh6BphZ2Oovg8Zuq7kuzCxQs9edmQXXSAUBKSLeg4AOtZPdg4DOUc8aBHSt" +
+		"H1setGntd9Cmtd9Bm9Z+B2363e9Hq3OKZ+mgTadvsNJBm07fpN9+nD2liAo8aNNS7Q7atFS7I20a1e5MohBR7c5kTQiq3UG3" +
+		"RrX7YaVXmzvo1tLmDrq1tLkj3Rpt7qBbS5u7Vn+1uWuNV5s7aNrS5g6atrS5g6Ytbe6gaUubO2ja0uYOmra0uYOmLW3uoGlL" +
+		"mzto2tLmDpq2tLmDpi1t7lrl1eauVV9t7lrt1eYO6re0uYP6LW3uoH5Lmzuo39LmDuq3tLmD+i1t7qB+S5s7qN/S5g7qt7S5" +
+		"a31fbe5MNCG0uYMyLm3uTDQhtLkz0YTQ5q7VXm3uWv3V5s5EE0KbO+jr0uYO+rq0uYO+Lm3uoK9Lmzvo69LmDvq6tLmDvi5t" +
+		"7qCvS5s76Otoc7/oke926VXtrpVf1e5a5VXtzgxekGp3JmoRqt1Bv5dqd9Dvpdod9Hupdgf9XqrdQaOXMnfQ6KXMHWn0KHMH" +
+		"jV7K3EGjlzJ30OilzB1p9ChzB41eyty16qvMXau9ytyZwRlS5s6kXgTK3JnBGVLmrjVfZe5a61XmrvW8ytyZwRlS5q7lrzJ3" +
+		"rfMqc2cGZ0iZu9b3VeaulV5l7lr5VebOXO1V5q7VX2XuWuNV5q5lrzJ3rfkqc9darzJ3JjXqP7qe6D58EZ+J7vPR84Lu89F+" +
+		"6D4oc9f6vsrcmeg+KHPXyq8ydyY16lHmzkT3QZm7VnuVuTPRfVDmzkT3+fn2oftkXV10H/S3a61Xf7vW8+pvZ6L7oL9dy1/9" +
+		"7Ux0n6Z3H90H/e1MdB/0t2ulV387c+dXf7tWefW3M9F9mt4VdB/0t2v1V3+71nj1tzPRfdDfzkT3QX87E90H/e1MdJ+m9wjd" +
+		"B/3tTHQf9Lcz0X26+owgDelvZ6L7oL+die7TNaah+6C/nYnu0/XmoPugv52J7oP+dia6D/rbtcarv13LXv3tWvPV385E9zE9" +
+		"Beg+JkZA95l6XtB90N/OpEr91KhClfqpK0iVevS3a6VXfzvz5Fd/O5Mq9ehv16qv/nYmVerR386kSv2jPpIq9ehv17I/9Ldf" +
+		"9sjUr0eZO5P69Shz13peZe4s6tejzJ1F/XqUuWulV5k7i/r1KHNnlfIqc2eV+ipz12qvMnet/ipz1xqvMncW9etR5q41X2Xu" +
+		"WutV5s5S/fpQ5s5S/fojy19l7qxyXmXuLNQilLmzUIvUDy3UIpS5s1CLUOauVV5l7lr1VebOquNV5q5lrzJ3FroPytxZ1IRA" +
+		"mbvW8ypzZ9X9KnPX8leZOwvdB2XuLHQflLlrfV9l7lrpVeaulV9l7lrlVebOoiZE0XVBIVJPt1CIUOauNV5l7lr2KnPXmq8y" +
+		"dxYKEcrcWdSEQJm71n6VubNUz5Q3eqmeqZ5BFCKUubNQiBp0tFCI0N+ulV/97SwUIvS3s34qRPhHTQj0t2v1V3+71nj1t2vZ" +
+		"q7+dRU0I9LdrrVd/u9bz6m9n/Zwf5DeqO6DGXSu9atxZVHcwtZrqDqhx16qvGnet9qpx1+qvGnet8apx17JXjbvWfNW4s6j8" +
+		"sPW0judV486i8gNq3Fk/Kz9wlVT5gR55USEMNe4sKj+gxp1l6VXjzkIhyrq3KERZzwRZWFDjzlIm5UfH7K8adxZZWIp8IAsL" +
+		"atxZZGFBjbvWetW4s8jCghp3lu1XjTuLtcSocWeRaWXoDVCGPL0BZFrROLlmedW4a9VXjbtWe9W4a/U/1Li3Rx79v3TIi9R5" +
+		"yHTXslemu9Z8ZbprrVemu9bzynTX2q9Mdy1/ZbprnVemO2t9XpnuLKFg4pikbnF161pkDNItLTIGxtZPyUlHGa9MdxaS01cd" +
+		"+ZqvTHcWkhMy3bWeV6Y7C+kIme5a6ZXprpVfme5a5ZXprlVfme4spKOkgQPpKOkVRiBKejwQiJDpznrmK9OdhUCU5DsCUdKN" +
+		"RQRKejERgZI8QgRCprtWemW6a+VXpjtrl1emu1Z9Zbqzdntlumv1V6Y7CxEoq4va9sp015qvTHet9cp013peme4sQk+zOgkE" +
+		"ImS6a51XpjsLgQiZ7iwEImS6sxCIkOnOQiDKupsIRFlQ4PUV487y9opx1+qvGHet8YpxZwW2SYy71nzFuGutV4w7y59XjLvW" +
+		"fsW4a/krxl3rvGLcWQSYIsadRYApYtxZBJh2PSEEmCLGXau8Yty16ivGXWu8Yty17BXjrjVfMe5a6xXjrvW8Ytx5yucV487D" +
+		"QhbEuGulV4w7DyFfiHHXKq8Yd636inHnIcAUMe48CjDtssYrxl3LXjHuWvMV4661/hDj/r+w+ftf//6/f/un//6h1sf9z+cT" +
+		"Z4xuNh6vcyJmcXzJXvMNfzJbRf2M+CMaXIyj46vKF3HuTMGS8VV5iiC0/Ik4z/H9WZeisqX+SMGGoLAMEY6vajoE8qRPBPwP" +
+		"SrgParcnassMarcPiraf89GWlH0IurmDXIk/kuGE8uf3RGzJdA8Vy1OKr55rqV7HjhNFcPegyPj9J6FNckHI2vWdSI3GH5dK" +
+		"ZaA4Do5JNlIKWqc0OCZpqag6nVI8loN6yoNCyik1LjLJkqiEnKgvMb6qqXK4SvHJPSiaO6hee+8Rf0S3pHzqQSgYSSUhjEtX" +
+		"+CN5zyielz/8kRJ1g1pZ+TP5I3NqlI3KHy5IVumDGNo+2dhSNQJipPrkWEI3VOYoP2y52FLZ6DdbxtKtQSmXQS2WDyvAh6qv" +
+		"UELk/jEusop4UIXjUzJbMtdIcYwPS8aHimNQbeL+8cdrPpSxiXRFCRFhKF0ReYPSV38kkw5Jc3IO8B1KmkO2nEydg6G8M6SR" +
+		"ySn656E0MuSPkXo9ujIMBxwlFomPqfSDxP6O6AzHUt46IzgzPoasEZhNtsl6QrMycjQZyZlup/vDd9PSWlbRFo8OzwYDz9iI" +
+		"w/EJbFqyx1q9+onvF/u5Iq4gswZCmhEkbkRlfuMr2Qzt3JokWHbXMjJiGKlmY0ZktRGESHEa+7kgDG35uzgRscYsWaqkoDAt" +
+		"E2J9UKXKiGmdDwt8aorxzrR8g3UblfoBpuh+wvpriZBqU0g88w21hlpo6IMmYZA01YZUZmhklezDhjpiCCF1BAcYooWhVlRW" +
+		"/BlfzcbncrUYY2xx9sXZZ8xaGN+DxodgnSHmGJ9UxrdUJZehQfsG5lfKrhqAbpB5fWIVqGnIZ6yvzhOi8ZWBtRKJbxo8GTWr" +
+		"x8ezaYRjaKseMGkavhi3KkHpsdYlFrnEPWL2wR6irh8F/hl/ZLr1Ie7tc/RHVuggS35jobY98N+DekeOGntYN/Igw6To521r" +
+		"OQc6R4ou3bZWS8T73ihqY4QImmIDS3RrRoScERrXakirRpCZKbqMTApGnJYpQKtqd+hMUVSNu7n9j/URIQzGPSKMxoifaT0w" +
+		"3ohFMYJQGsnDjek0Yx6tzciQY4f1EScelLZi0dC1KHIew2fjw+RaROlHj9lWLGKww/LFE1+/LVZmBUaADf8vR9jRmmWkOS1p" +
+		"+MQVvP/EF25ipvUTH0n+ocyfEsl84svi/hOKgebCPzEG3H/os5gOhTwc8uiFqIdveOvAQldg8Tcmg+4/qBfEW30HW/LpXglO" +
+		"+0b36wzzvRFLyvjuX0poNwJbvvHZ618jeohVHd94ne4/iz/GlDUk4F8qTStO8hs9kX8pVdhYPvJ9OBEV/ZTV/hufTPcfpBBC" +
+		"S76bE1ERuLNmM0U/f/9ZlKWnOC4XJHFB5lfVzuN6JkSaSeMTvieUBs0yp+hb7z8UdVeR+nD6/kNdZh2TW5y4xUtF8QKiPKOB" +
+		"KGNh5npmrqcW52SuZ+Z6KhNgjgvpmdLirsJ30bN7Zj2GssXl+PL3zEKMw4KtHF3z/Ydqhqr2x+3I3I6jMn8Px6Sy9XlUS/HE" +
+		"HwEQiNNL9GD3HzGm6oaFm0U0qkJVgaFeyNP3VRWq4E8vpORLH5XXigtSyFUH3l2LYxJglFQ4h8YX5IVMlFo57E4yy/pVoYto" +
+		"fAV/2lTliC9/pCzEUBL+8KiSqVUZBOpmd5KBasF8jc7q/kOm96284fyRs1tSgut4aBu5G20pW/bgj6TsJnChxVh1/0GdYY1V" +
+		"Cz3GG3qLyqM3fG+SVkwZZeN6NlL5P0OJXjk7efRVT7pxNxt3U2WXW/Qo9x/ymhLB2Zxjkpx9k7yvHbak7qGzarIdGg90+1ep" +
+		"A+NZ6sSQHeLZelDl/Yc/HiXJiyZ1Vi1/ivKGxTE7KoMK6PVgsPsPkVPK6xDU5YPxIROIO4LrfDCOKdZz0AEOFoFVJWwJSvfB" +
+		"iivFiw8u8lDpB4JERvCSDyKg1FmNkGt8oNN0JYLYnAiRog/lpoh3c4A/KhIxYqzywWf0ILrTotVuLFJU8nk40uFIs6w/RpPg" +
+		"SDMtxw7h3I0Rz8g9Y7ybRion09L3ELL9J2OyHtHoFSFOs59L0WkSuXwm0VgW6uj9hwX7WwvaORHXU/HbNtmSsLaV9MfOH1ld" +
+		"TRyQMRoa4WlLC5SDy93IdbOUQyDeVDfUOqWHtsXuMNEyrfvm7Khua2tRa7g5ESl21VrMuJ4Es5izgJ7AEyfixJS5lbgOJ4TD" +
+		"/NFyv2gn0RMXQFiFxwhLaIQp3SmhEU5MhEUEz3/iifr9LzjhhCU4EQimPpwIBCf0IGAmFpXEc7KJMtAK1x3k55uy7Cr26QGc" +
+		"7g2Rj47do/d1j0afmRREGbu7MVek+OvJ7ry2Xx4eZwByMiR9Fd/E7XNun/pwj0HbfSt6kvjFELruf+Nj/ovK84k7da9RPKwI" +
+		"LufDjMUnXhynSO5BmjlfbRk8cL5sKdD5oDvCO+fL7l+Omdg9sfs3GPEkdk/xgl9SCYEshS5xKFfvid3RAg5agCeiDNACTtaW" +
+		"TLXy3X6oaeoJCZyapoevcVdgDF/jh/phnom+oH7YqdqSCU4KIB3q/Tg1+g71fg6lKrxwTEpVHPLwR1nQH39kd9JsR93EH38M" +
+		"vjpkb/bK7mgBhwSzXpnyo7c/XVsSa0LuwEO6Oof4D+nqDp2wK66AbGmHbvA+l+Em39jHtCVzaOQYOepQKPB06FcOK9q9K1aB" +
+		"3Vl2/vNZYtn5Yf23NIszFcFrz7tk7szFGxBTCnqPDh+fh49PvUdnxbBy//H3PTorBrWz5ud9j86K77ezdGjNli52V4pSR9Vd" +
+		"7K7cpMy7L+I8F2klkiZUD006/Y/36D/1JPf//u1f//qPf3r+x1//8T//+q+//eVv//H777/9/d//+V/+9u9/+W8//vqXf/7t" +
+		"f/3L33/7/bf/82//8fvffvvLP/7tHz/2iv/9X9LflkM=",
+	"90ms-RKSJ-V": "" +
+		"eNpFULtuwzAM3P0V7BAgHVTFydSxSIc6QdrAKtrZkehAQEUJegz++zq0kAwkQfLueODq6azEm/EXFLuXDfSYfIkaxf40hEbu" +
+		"u/eObAZ5jl4rzDBaMrFi4IJXS027BWN1rh1n7SpZTSmj62j0sFtQpoSKlP1cUo4TrPn+MxgcG/kVDUZLV1gfhjBQW8eqhPCH" +
+		"DinDlidIZtncnH4ODkG+blwS/VEdxM9j9T0FrBT5e/IGoV2aB/oDSkL2PItyvWvqEuN8k62z3I1rCe8/CJ5ZHP9KaGyU",
+	"90msp-RKSJ-H": "" +
+		"eNpFj7uOAjEMRfv5ClMgsUU2PKotERQMiAVN9geGxIMibZwoj2L+fgcT2CKxYp97fTOfXZXYGn9DsflcQofJl6hR7M59aOSu" +
+		"3bdkM8hr9FphhsGSiZWBG94tNas1GKtzffGtXRWrMWV0LQ0eNk/KlFBJ2U0l5TjCgvd/gMGhkZdoMFq6w+LYh55Wta1KCL/o" +
+		"kDKsuYNknpNH0u/eIcivpUtBdCd1FIf/2c8YsGqYeAElIeecjLi+fXSJcdrDcdnhobWE738Hzyo+f4sVaUE=",
+	"90msp-RKSJ-V": "" +
+		"eNpNULtuwzAM3P0V7BAgHVTFydSxSIc6QdrAKtrZkehAQEUJegz++zq0kHYgCZJ3xwNXD2clXoy/oNg9baDH5EvUKPanITRy" +
+		"3712ZDPIc/RaYYbRkokVAxe8WmraLRirc+04a1fJakoZXUejh92CMiVUpOznknKcYM33H8Hg2MiPaDBausL6MISB2jpWJYQf" +
+		"dEgZtjxBMsvm5vR9cAjyeeNSEP1RHcTX3+5zClg58vvkDUK7NP/gb1ASsutZlutdVZcY56tsnvVuZEt4/0LwzOL4BTnIbXQ=",
+	"B5pc-H": "" +
+		"eNpFj00OwiAQhfc9xbgw0QXiTzyArSZ24U/ECyhMDYkdCIVFb2+dEl3AZN68b3hMJ1cldsY9UWwWS7hh51LQKKrTwxeyqvc1" +
+		"2QjyGpxWGKGxZEL2wBNflorVGozVMXd86zbDqu8itjU1DjajyySfnfI2lC6GHmb8/hwMNoW8BIPB0gtm1VmtsqiS929skSIs" +
+		"WUEy4+Sb8/xoEWS59Voc/+q99wjrsT/ckUS5HcapQ043LOD643UKYdjPIZn/kpbw91vvmOLzAVnYZcM=",
+	"B5pc-V": "" +
+		"eNpFT0EOgjAQvPOK9WCiByxgfIDiQQ4osUbP2i6kibRNaQ/8XlwaPbSbmc7MTpeLhqd7aV6YbjcZXHEwwQlMy/ppE1ZWx0or" +
+		"D6xxRnD00CotXdTACzulk7wAqYSPiG7RRzMfB499pVsD21klg41Kdp3G4N0IK9q/Boltwi5OolO6g1V55nkkebD2jT1qDxkx" +
+		"qOX88u15fvYI7LCzIr3/2dtoEYoZP2ojEfIZkPAEYUDqOUXR/CWJ4Ny0iepS0temNP7+bQ256HwAP5JowA==",
+	"ETen-B5-H": "" +
+		"eNqEncnOJstxnve8iuOFAXtBs6acAEFAjrAWHmD6BiiyJROwDgmKWujuXX8+z2cbNvCfTXdHV305VWZGxPtGRv7bf/Nff/vr" +
+		"+oc//f2PX9//4fjpv/345z/9y19+/+PX/T/97s+/+k3/u/F3P//xrz/95r/+5U+//+2Pv/70D3/8+Q9/8Z2f/v7HP/7x51+d" +
+		"109/+OPv/6q0//z9P/nj3/7rP//1xz/93c//8Kefbt76w7/82Td/89/ev/75r3/515/+3a7/3//0hx//8Kvf/Je//OHHX/74" +
+		"8z/+9O/6f/7t6X/+9l/+/Of/+eOffvz815+O/T8/fv4DT77a+Z9/908/fvrN/O8/fv51C7/+j//nwX//1z//+Ona8kWtv//T" +
+		"H378859/9/sff/ndz//441d/cxx/+9Pf5ONvf/U39Xy+/r3mmn/7Vfz/8+Z5HJTw9//w+//xu7/87/fv4/DX59ev19GRHqSJ" +
+		"FF7pOq6EFPezsyElpIqUkRZSoZQTqe723dbXKDMidaSwpfDVsuNo1BfCLiXQshD3m+eNlCiTGkLm2YNUeOabX7VfIfms8Uyp" +
+		"b+mh1ZGRCPQvXkhjS5VW37Sz0ur7Qhr0lvrqRKJ/dSExSu3gd9TQzt3bSm/bxVehlHbvVnfffJBoZ9tfJTTfjEi0pSXe5Pu1" +
+		"PS7RtrSCRB8a49KtvSH55u5fqIxuG0h8vzapj3FpC4lv1PfMuiot61/9u07r6xe9pQ/93uMyfZNZd/vsq3/H1amhO4KMxDiQ" +
+		"eDYYwUEfBjUspZu5RB/GHsHL2TqYWSetHs5rxmw4r61hj9I7aEiNUnxzj9IVGPkxeJP6Jv0LvDn3N7oqIzH3N7ouypyZMun7" +
+		"3N/oakpftd/3oIbZkKyB2i/GZVL7ZX37G13O1rmQmPNrz8H4UPs6kahhXfvNwu/WjVSQ9giehdpXQOL7rYhkmQnJMiuSpTSk" +
+		"jGQfrM8+WKbraI/ExR637o50MmYBaa/UGTMSI388SMys6Zthr7HzQmLPOnzGnsVXuR5azWq82LPOoyAtvgO/C/eWWHFXeLbE" +
+		"yrn2LvU+e/bO7A78/+/I196/3rlK3Xv/Cl/b2JYqUkNqSNbWkQbS2FK07olED8JXm0OYe218Teef/iY9kxr2vpe6PYjsC5lx" +
+		"j+wL2WfsC5n6IvtC5ptE9oVMOyP7Qt7r7UoHEvWl/fWC3zJdSHyvxL53+Yx97/IZ+97pM/a94jP2PXTalTIS3zkV3uR7Jfp3" +
+		"WAr9O/0d/Tstk/6dzKtE/yJ9r/QhMi6VPiRKqfQhMoKVPgSf0Qe/X6UPkVGq9MHvV2mnMxf9EyYt6/u7389+895793GNgjTZ" +
+		"PRPSQtpf5Z671UfdfX/2mnomY/bsNfVMZt2z19T77ES6kW6kZ0uPUuB3AyluKUekhGR9eUutIjHL2fOfh1keAxKzPDYkZvm0" +
+		"nXsmX4Vn22IINzvBE87/a1yecG0J++HZ6/SddBmJPrCin0AfDsukD4dv0gc0+BPow+WbZUu3b9KHae2NUbJlHck3B2NG3/dK" +
+		"faWJtBgzpMg3Yj959koNJ3v3sy2UcPlV4u7ftWhnfBgJnwX6Tm9j3NL02Vf/QkL/PPGrf+9yp39xf6Pi6O6dIRSswWfvDKEk" +
+		"S9m7TQtK+xu1RW/36g+dnfzZqz/060LafeiO4F79r0lCb/fqD2Mws/bqD4P18Ow1HcZilPaafjc3y9wtm59ne/ZoPzx7TYf1" +
+		"ZUV+tyM/e7XH66Huyto4aVdlbbBnPNW14ZvMq0uJeXX7JvPq5itU5tVDu2pmHHzGvMqMyt4J3s3a2plX7GZPZV6ha5/KvGJP" +
+		"fCrzqvqMedUppTGvOi1r9K/z9Rp9wP56Gu3slLktyncG+iZr2PXWXMPMj6Y2YvU1tFHx2Z4fX2bDl9SduYxEZ3aePtuz83Zc" +
+		"emZWMy69IFFmr0iMS2+sfeaA+2WmD32wGqxvIrFutrX5SvR9W5vvaqAP29p85z9t2dbmK1HKtjZfiVK2tflKlhJYG9S+rc3Q" +
+		"nUvb2nxXg8+cBZay+9fRI8+2RN8Z75vM8WTtnRnvm7sPy685jz2T3c22BojXzejOi1lOKdtmfSXGbH71Ib6fBSlsKTPW256N" +
+		"wa+57dkYKi3b9mwMjVHa9mxUiz3bno1xMeu2PRvTp2V9S5fS1zeK7fLNvRpbZCZveza2RA3bno3Nr7nt2dgGbdn27Cv5bPfv" +
+		"sw9tezb22ze/+pcONc62Z9Pl19z2bPqM2cpIvlm25Bzctm661KHb1k1X8M2+JXXvtnXTnX3GSj3374J6mRUQ1MvswUG9jIUS" +
+		"1MtYKEG9TMuCepkdLKiX8XSDepkvHdTL0xrYewZlBtqCbRECbRn8Dv16suIC+vULQNjSnuUnvQ1atMsyK3qrIu2ZfH15l9/t" +
+		"yAFb9yr0AHv2Pve4h6gVQF+jreRNtOT9UBta8n7oj1oSiyuoJfH1gloyWgr7EPZeQEt+GV5bYh+avsk+hKUW0JL3upHYh9hP" +
+		"QmQfmpbJPjRtGf2bjBga9F78Dg16O5po0HvxFdCgN/o7oEHvyUxCg96TkUj0b11I9G8xEon+sU5Dsn/WYP8Y62T/rG/3Lw6l" +
+		"gWR9E1uCtlT3Uvpe2UtBc0JlLwWxCZW9NPk791LasjVqaJnRreyl+BUBjTocwYq9gP8TKnup47K15ruXWspEshT2UuzgsLXm" +
+		"az1Q5taar0SZG795d11a1thLneMbo4kiBWFr1FeyTPbL6u/cL2nZ1qjvnsgoNfZLfKOwNeor8R0aeyLeXujuiTzbOExU44SN" +
+		"w7wS37azJ2J/hc6e+PhmQPLNyJu0pbMnguaErZeT+jVsvfxKzOStl9OXitpSw/Okf1svJz2ssPXyK1Hm1qFJfzJsHZpiokdb" +
+		"h6ZYacvWoSk2f7fbEl1VW4em5IzcOjSlyXfYOjQl1+bWoamge8NGc1JplvI11qk6I7d+TRWNEya7bqS3k123KLHrohnDZNet" +
+		"9H2y6/rdJ7suGjxsPflqNkvZFt9SO2w9+Ygohq0n32eWMnnGKG1d+IighIXmcL0vNAcWWFj0wVW86MP6pR150TvHb9G7Zd2Z" +
+		"uplJW0s+2uBhYSe6By98PTRoWPh6j+3ChsTeC0tEY0txa8lw4ivEB88IZCI+aCbwlPhgX7JO49aEr/aZSIlnESmji24k9r3D" +
+		"+tj3Lutj37usYbfs4XtFPNSHcY94qA94Q8RDffh6EQ36sBYjGvRhzOL2UF+pIQWkBynyO99MPKPv20N9JVqN5n3wESOa97Hv" +
+		"aN4HdDiiXx+wsQiWFKctm+zk/m4hUR+6N4G2RzzUhMcT0b3adBHd644V0b3uZhHdm7A2I7o3YWlHPU12l4gOLckyB8+sHR2T" +
+		"rGEhUQo6tGT6hw4tmRFM6pGAlJD8XcaX5YuhC7vzBV3YPzU0/FVGCV04Hd2kjlFSx9AjdKHrNKILZVkiunBhf0V04RqUsnVh" +
+		"/Mzkre/i5Vza+i5e6IO49V3UEo3bg4xfoOOWtt0dbssc6B/rm0i8iU5LzvLtJcZ0M9abk3gleoS+S+jziL5LoNERfddcR+i7" +
+		"Fv1dxbKnnei7z1hvTiL2YH0DiTWNLuzY3VF9B+IW1XdYDFF9h8UX1XeOCzrtSP4OOx+MJqLTLmtHp319xi1tPVKXtW890rEm" +
+		"IjqtY6vF7Wu+CocebV8zLTDSuH3NtOBV4vY18+FesH3NvLmh73bkuL3Q9z3W1NagWbQ7bg2aD9fp1qD5SPRua9B8OOO3lszn" +
+		"9M2GZCv7lpbPBpL1ffUuX5/a15acx9sLzbIccXuh+cKajtsLzRfoTdxeaL4c261dc4yWsvuQsUji1q6vRH1bu+bsjN/aNRf7" +
+		"t7Vric45tOu8rQ88pfKF1K54TVHt+lgK2hVNFbcX+vqNSNsLfeR7oprX1YDmlVeMaN7lfETzLvdLdOhyTalDsUiiOhRLLS4s" +
+		"BHCYuLAQgvXRB+zguOwDcw5PczFK6bHVHYlW4/8kPM3FjpzwNBceY3psdUQKlFKQItJAokdo8ISnuUJFEj2ydq2ChqRVYClY" +
+		"BSkggQDDGiX06wWCktChFxonoUO/wPMtoevxSRN68oZtSujJOymh661PPYl3mdSTl9LgGeOCnnxuJewAmO6EnnwcQfTkA5aa" +
+		"0JPP45vYAXzbhJ58biXsAMcTPfnA3iV81IcVl/BRH3Rowkd98IITPupz+4z+3f6O/t3WQP/wQBI69MHqSejQh3mWkn3wGX1A" +
+		"AyT80AesLOGHPuwFCd37XJaSsDtoJ7pXvCiheyOedUL3xmmZuw/J/qF7U7D2wTNLmUh8P3RvYk0ndG/yG6F7k18T3ZvQKgk/" +
+		"9GsqfrsjJzzU5NjioWaQgoSHWj7lg7UX2wXWDq6bKhYQe1SqWEDF32EBYbMmvNeC/5HwXkthXjUtIGrAey34JgnvtcDnpoYH" +
+		"jmZKDQ8cTyU1PXBLwXJyBwEPblmpItH3rc1fib5vbf76or658Nx51uELHBfQ4Q6mnbbGfv1NftflBKgB5HgePttjPa0P5HiC" +
+		"XyaQ42n/QI7n8lnDGrM+2APnKsjxij6bSHwjkOOVKAXkeGEZJpDjBaaWhkgB7RxaeP4OCw/PPYEcL1CZBHK87C3I8VqWmXlG" +
+		"jwbWH5o/Day/01Kw/rCm09bn8SrWPpCYLwMOhCijtPV5vLBgk6gyFkMSVYYbTaLKjq6oMnhKAlVWuydQ5ctRAlW+3INBlS9X" +
+		"AKjyBRqXQJUvoggSqLLWRAJVNoYhTftnmfaPkZj2zx4t7GDaAqocXLegysE9EVQ5+FVAlSO4fAJVTliNaevsmFwdCzQnUN8C" +
+		"zcFiSAv0+7H2geSbID3o5bx1dkwwbXnr7JiwQvID3g2LmrfOfu3mhSS2cyGB7VB7fsB22DXy1tmv/XsiVSTLxCaHfc0PNjn8" +
+		"dX6wyZMSNnmx1YtnSFtnR1dxDtjr+PU5YK8zB3PAXmcW5EAf8O9yoA/4fjnQhy8r8rsdOQd6xwzM2wp4pQepUKJ10/OkRM+z" +
+		"ddPz4u/oebEH9Dzb5oVXQSlb078Sb25Nny52kLw1fTKCKG9Nn4w8yhFGAhYuR9C37u9A3xyxremTERR5a/pX4nttbf5aBv5u" +
+		"gpQxP7a3nBJWf96aPhXYwrw1/SvRlq3pUwFvy1vTv5qN2remf/dC6tuaPmnr5oTPYTsTPkexvozE6G5N/0qM0tb0r2SZDYlZ" +
+		"tjV9Poh+y1vTZ3eJnPBHnDsVnwNtlCs+h19za/pcPs+2z1GGv9s+RyVKIm99nvvNetv6PHfWad76POtp5q3Pc7dHW5/ngebI" +
+		"W5/n4WrY+jwP4vny1ud5Vtuy+zBhJ/PW53k5Eluf5wXKlbc+z8s5sfX5K9Gyrc+z2HTe+vyVaEtTh/Kltz4vR1FKW6qWmZFo" +
+		"y9b1r2QNFYk+bF1fRPjy1vXl/LRlIDE/t+de4m0NCz+NtbntgPcZbdle/fuM2rdX/0qU0kEzsbRzB80MSqCZaJXcjU9hBDuY" +
+		"pePZQWSLteNTOZc6PpVfs+NTOXs6PtWnBvxCPM888LBgwfPAw7K+gYdlWwYelnvPwMNybxt4WM6XgYeFd5IHHhaxAnngYYFG" +
+		"54HPmKwBn9H1N+if++Cgf9lW0z9nwbB/jDzs8omvmScoLMxGnqCwroAJCvvVlm935AlziOWUt4XwSrRyWwivRO8mrCIYSd4W" +
+		"QjiXEtgtGjtvC+GV/B2Mo6t2wjjiV+cJr4gHnreFEIyjzNtCCDK/eeJ3wo7khd8JQ5C3hfBK1L6MPGJUFpycI7bg5NTfC04u" +
+		"WKacI/WBk99DCcR5WApMIlZjBid/nIHg5A/zo4CTP3z1Ak7+MJ4FnPwpvonPhr1XHnw25k558Nn4RgUM/cGGLGDoD/tsAUN/" +
+		"svXhd2L9FXz8p1gDficIfsHHf5K/o0fZ39Ej9vwivo7WLOLrWA9FfJ01VcTXsReK+DqRVQVsIILrFvD1xHor4OvJ3oIbpOib" +
+		"GakgFST6AG6Q+H4F3CCx5xdwg+RIgBuUUwmvjf2ygA0UmPQCNlCCEp4Zs66ADRRmXQEbKMM3I88sM+HD+Ts8TyKJi1Fe7M/F" +
+		"KK/BNxJ7xx8pYu/d+ujDYMzE3tFURewdfrCIvaPhitg7+rWAGxR0UwE3aMHf7f6JDhdwAznOImaP51LE7J0hYvbYDwXcoBXG" +
+		"GtygYesWcIPGjlzADfppKbt//bGUiUQ7wQ3U/AXcoHNuoYAbdGL2CrhBb5QCbtArtYMOdHR9kb9u/o5YIMcMdMBIjwI6MG0n" +
+		"6IDxIgV0wHiRIrd9WgO8w5cn+N2OXMANprsEuMF0bMENpuMObjAdW2wJWcVCrNgCgSr4/+rFgv+/XA3Eii0YpUKs2IJ7KmAD" +
+		"i12+gA0soh1Kx6+GNS0g/cehtD0Hz4QUkP7j8tn2HDzNUWC2D8dv2wTvm/R22wTvM98sPOMLbdwgejqmbHvhlejtthdeiXHf" +
+		"9sJbihJ+56c+/GprGPjVICFlGK3Fqh1GazEuQ7/aN/GrifAsA78aG6sM/GrsvTLwq5u/w6/GaiziBu4Z4gauU3EDmPQibuDM" +
+		"FRtwXxAbINK2iA1UJfqABi9iA5weKWID7jxiAzBfRWyAqOYiNuCuJDbQGEGxAaJOitiAa0psYNgWvoP7Hv6/6HBZ9oGR//j/" +
+		"Svj/nNsp+P+egCn4/0GtsrV7jM7Brd1fiRW9sf4YD3+XkWgLuEEkfr2AG8TD2hsSowRuEJ3z4AbRuQRuEOGaK7hBPBISuAGc" +
+		"cQU3SHDNFdwgRZ+BaGSfwfNhMVRwg4QGr+AGib2gbjsgJmyS+oCEJGsHCclKICHF+kBCis8Wv9v9q+AGCdSpghskLNgKbpCw" +
+		"LSq4QcIGquAGiR2sBvtAy4J9oNWgA+r6GuzDhQRXefm7Dldpy0AATp+BALDeawD7wLOuEewDTrVGsI/1C8xejaAizOMaQUVY" +
+		"3zWCihCfVSOoSLM28I3mm+AbxL/UCL7BGq4RfGNYCvjGsBR612wzvWM/qZHesWfURO/ADWqid1gdNdEHbNaa7AP1Jfqw/J19" +
+		"YGwTyA5WTk3gN9M36d/wGf3DBqnJ/tH3RP+mZdo/n9E/LO1K7HdH/9St6dPhjK/gN9iQdWv69xnjuTX9+4zaKxGl2Bm1GjXK" +
+		"CFajRpWIGiVuulaiRqO/I2q025aBZO0bv3GHrFtnvxKlbJ2d3Evr1tnpxuqoW2e/ErVvbCDdxFXWjQ28zxjPrc9fib5vbCAF" +
+		"2Mm6sYEUXLUNRhyPoG5dnyJapW5dn+JSAoNavjlBnawBDApdXzsYFLtu3fo8FTRc3fo8FXRM3fo8VTzUuvV5qsk3E+y8zzL4" +
+		"lGUW8CnaAqu/8DkqrL7eee3gTJwVq1uf56NZ5sZoDkdp6/MsZlIH53Rdt7L6VQlW37GWu/cbyd03WiZ3D/JS5e6xrSvc/ekI" +
+		"bn2eT/u39Xm+3OXh9S93Bnj9y+8Hr+9Zvwqvf1WfgaM1JXh954u8vqtfXt/eyuu7/uT1HSV4feMHK7z+5f4Cr28UVoXXv0C5" +
+		"Kry+Xned9A+7u25dn+Pjs92/qG7Sy4fDrdvLzzlanxgb32GCsfX5CzvyAn3D26oL9A2Pqm4LoRxYJHVbCK9EbdtCKJ8ZsS2E" +
+		"V2Ln2RbC6xTSn20hlPNT5kbfghp0WwivL8qobAvh9RsZ6W0hFE941m0hvH4jI70thFeirwv0DU+zPeBtMATtAW/jy7YHvA2t" +
+		"1baF8PpjvvkgTSQjIBcSeBS7dSO2fDEu7QGPqr4JHtWUwKPYg9sDHjUsBTyq+yZ4G75DI17dsw+NePXF6LYA3ob11wJ4G1Hi" +
+		"jfNgC83ROA8mJ9c4D7bQYo3zYIudtXEeTCy1cR5MDLYF+jcsk/51S6F/Q4n+NesjzjEogbehYxpRBJ7CbZ4H42R28zwY8795" +
+		"HowoggZScMGPN5ACTzc1kIKLOIwGUuCZkAZS4PmGBlKgFd5ACvQ5GkjB5ciDFFzddhLpflgfke72z0h3cKZmpDuWWjPSffgM" +
+		"NIf9pBlhgGZsRhig05oRBo61EQa2E6Tgab4JWgWK3UAKHnbIBlLw2FuQgsdvC1LwsCc2kIKn2mrQKvsOUvA4Q0AKHvRWAyl4" +
+		"XAHVPtBbkIKnWQp9aD6jD+iKVu0D7az2wTKJklhKIFLwPw2kQMu+gRQk5xJIQWIPbuABCZ3WwAOSowsekFwd4AHa1s04AvDg" +
+		"ZhwBWGcDKyjEVjXiCIpzt4FWEWvRmmhV/35HbkQfFNc30QfFnoM+lGm7wLHczUAf6uEzcKxpm8Gx4NUb6ENxRYM+FGLZ20Yf" +
+		"3lLoHVELBR6sdXEsvhdRC82xJWqhOQOJWmhEZTSiFprjTtRCQ4M2ohb6pz6xKuZjF6uydqIrsBMbkQkdr74RmTCIfWhEJky0" +
+		"ZCMyYRJn1YhMmERCNCITZvJNIi+cO0QmGOfeiEyY2F+NM22L6OTGmbZ1WQoIUbQ+EKLsmyBEsNAN1mHhPzZYB+22Buuw8BHb" +
+		"FAWityAMh/0DYTiIZmogDGYiaSAMBz53A2E4iCprIAyHYw3CYNRoA2E4om+CAkVrBwUCM2ygD4f7M+jDAU/UQB88jdZAHw58" +
+		"0gb6cICJtuWZPWbBEkGxlN0/T1I20Ieb6N0G+nAt3wRB4UxPA324nVmgDxfnKRrow7V8s/Mm3wH04Vr+DnSFmdVBHy68rw76" +
+		"cHEOqoM+XNisHfThwj/ooA83sSsd9OEGF+mgD+G2TE4hgs/2x1M1DYlTNezWHfQhTEsBT7n8HXjK5ZvgKcyQTkRDvOzRQqJ2" +
+		"kIl4Kp08y0i7f/KYHWQiEq/YQSaioyQysSwFZAIfvItMLEsBmRiWArqCTdKJW0iOLnELCRuog1qkag3EmYCmdlCLROx3B7VI" +
+		"fjFQi4QW66AWX2FN3+7IHdQiNX8FJsPu2UEt9Pw6qEVCK/dIz7t103NspQ6i4amdDqKR8A46iEZip+sgGp726SAaCTuxg2ik" +
+		"4TPPl9oyzlLBBXUQjQaf1UE0GhZsT54vpRQQjYbV2EU0nBEiGtggHURjgLd1EI1xW8ru3wB/7iAag/XdQTSGcxVEY4AgdhCN" +
+		"4SwD0RjEpXYQjcEJmL4tkvdNat9WxyvRP84UjNNnIC/Oj2o76UO1nZZJO8E3erWdvkk74VR7tZ2WSTsPn9HOy9qJnHENg2jo" +
+		"c3cQjQNLrYNomNeig2joH3cQjQNftoNo6It1EI0D26WDaBzo3t5EbJhZDcQGJLA3ERtrALFJ/g7ExlUEonFj2XcQDc+a9w4q" +
+		"g03XO6gMkSwdtMNz6B2048bW7aAdt30H7QinZYLKuH+BdgRsrA7aEbDzO2hHwOLqoB3hsS2cy3Pkt42QImxQ9yReV+IkHhh6" +
+		"9yQePkD3JB4IX/ckHlqlcxIvcmaicxIvLsvsvOmzgWRbiFlSV3ASL6LF+rYK3jcZ620VpDR8tkc3qzm2VZAyuHzfmj8V9HLf" +
+		"mj/Jxfat+VMBqetb86fyWMMEg6Jlk1OB4MF9a/BUiaHrW4O/m67PLiS+0dbgyZi2vjX4u5HTTk6lD1Cnzqn0AfLfOZU+HCVO" +
+		"pY+vvn+7I3NefRDd2jmvPmAdOufVPWHQOa++QDo759X1zvsC8aKU8YBxcfJoPJ5cGUhgXOjv8YBxsdeMB4xrKIFxoQ/GA8aF" +
+		"rhgP8WDg3eMhHmxaJvFg+IjjAafDNxkPON1QotXTVoPToQ/G1t/5xOoYARyLHCUjgGNxdnhs/Z1vvIWx9fcr0eoAjjV9Bo5l" +
+		"ywI4Ft7C2Pr7/Z31gWPZ962/s1bO2Po7a7eNAE43fAZON2wZOB0Wwtj6O2vFjQhOx2oYkf5hPYxI/4ZvgtM5ZhGcDhtkRPoH" +
+		"ZjIi/ZtKifro39bSOeLBja2lcwSRHRGcDo9/RHE6vvTW0jljW48oTuebkyg927mI0qMPW0vnil8xtibOnUiusTVx7ngnY2vi" +
+		"PJK/i0j0j5jE0XyWwQVpCzGJ0zlITOJalrn7sPy2xCQu7I5BTKKR2YOYRPORDWISF3b3ICbR+O5BTOJythKTuPBYRwU1RI+M" +
+		"Ssyec74Sswf6MCoxe+zIY2vp4hmbsbV0OR9LIWbP3m4tXQJIwaighn7NrXtLwqsZW/cW42FGAxlkTxwNZND+bd1btNwGkYbZ" +
+		"+UmkYQa/GUQaZlcOkYbZWUekoWcaBpGGnncYjWw4wVLIhoOeHPjux6UEMujcxXc3J+XAd9cTHEQTGmc/8N1l+Qe+u5EDA9/9" +
+		"cAXgux/3L6AWA6/+OG1JReKbcBZB73VwFuGzlxqDuHxzUjdfCI/f2Icx7Dk9MAbRXWLYcyV6jq4dePwH584HHv9xWgqYqN/S" +
+		"GER8xIHHf2CDDDx+4ymGMYjLN+nfZX30z50Oj/+Elx3GGYKEDOMMnavGGboyjSbEIhlGEzofiSa8gs/APYO/A/eECxpGEwZ/" +
+		"B+4ZrM+T4PSIaMIbvT/gGWTTBtGEd/F34J7OY6IJH1c00YTmoRtEEwbXBtGEgejFQTThg80ziCZ83E+IJnxg2gbRhMH5uMAM" +
+		"nS/bXghmuhsLzBCbdRB3+LhqiTt81LbEHQa/kXGHfnfjDtnppnGH7ImTuMOAtzCJOwyHkj0aSA/PFhKxd6Cuk9hCvd5JbKF7" +
+		"zSS2MLHTTWILM+2cxBaWT5lgepxCnMQPVlbVJH6wMssn8YMVXmUSP1hZqZP4wYqnNIkfrPD/k/jBMv3d7kMFRZjED9bLNwsS" +
+		"7SR+sLLzTOIH62EpHck3B4iitYP3BVtN9jV84glj0NgFJ4xBAw2YMAYNfTdhDBre0IQx0NOdMAYNNmjCGDS8qAlj0A9LIW4N" +
+		"lHfCGBgTP2EMPJk9YQw682zCGHRnAYyB5wgmjEF3ZsEYGEExzS6HJp5mlyMCbcIYDNbRNLvc+oVsnNO8c8zqCZdgRrcJl2D+" +
+		"v5lEOpVAOqtvErEHKj/hEtZtS0A64XSmGeqKz0A6i/0BJeRs7awigXzLKhLI2FaQTscWr/5w3PHqDyJ6Jl79ES0TpBNfduLV" +
+		"H/D4E6/+KD4D6YSbmXj1R7ZMkM7i70A6QWQnXv2RbQtIJ/p7NnBBPKrZwAXRKnNbFtH8G9OMOvhQk+wDN97rJPvAfSuBdF6+" +
+		"uft3OxJkH7jhOCfZB+7HtlQkf9eQGCWyD9zEjE+yD9yONdkHbuIiJtkHbrTtJCbxfnx2IzEu3Sxq1EDcYcBum8QdBnznSdyh" +
+		"zOwk7jDaMuIOo60m7jCCKk/iDmPwd+CXn9rBL8FLJ3GHEZxpEneoPz6JLUygR5PYwnz55u5Dvn1z9yE/ShmJ0SW2MBP3Pokt" +
+		"zGBJk9jCbP+ILcxo4smZxOwOyZnE7G5G3GG2ZcQdmg9gEneY3YeIO8x+I1iBhLc3YQWyXxNWwBwDc9o/y7R/1A4rkLEDJqxA" +
+		"djxhBbL6AFYgq1VgBbJjbaa7bCkgkbAX00x3oEcTVqCrOcxmh806zWYHqjxB/j97Isj/AN2cIP+DeKYJ8j9gWSbI/8jWB4oX" +
+		"rQEUL/kmKF7yTVA8dymQ/wEHuED+B8zGAvkf9G+B/H85dt/uyAtOYKCxF5yAHtyCExjREulrtjb6mv0dfU2+aV8Dkn1Voq/F" +
+		"GjYSore1NqaQDljGtTGFJKawHhBL8OcVQCxBylYAsQTTXtuySNftm5wDtGWBc4BYeCtwDnD5jHOAaMkVOAe4rAHEEi5hBbNw" +
+		"RaSN9Ji1bQWycB2W2Xk2kchMSOTF2pZFMmfc4izjDTawyCIdWKeLLNIBG3lxzjFgWy/OOQa/H+ccA7Nlcc4xNMsEzWTfW5xz" +
+		"NEf98ixjswYQy2YNIJbNMskkhtW4OOcYsFYW5xwjrPfinGPEVlqcc4x+ac4ymoVobV2fzGW0tq5PciVr6/qUb0vZ7czYumvr" +
+		"+uQOubauTxkGcm1d/z5jBLeufyV+R9xhxhZcxB1mrJBF3GHGolzEHWbs0kXcYYZNWBVsM1gK2Gbwd2CbUQlsM1hKRWL2EJNY" +
+		"iPBZxCQWvzQxiSXYajBRvzQIfnF1gODLwS8Q/AKqtkDwC77RAsGXWV8g+MWxBsGX5V8g+DVbA7F+2We7f9XVCILfDsvc/WtY" +
+		"/QsEv7srgeCPy2e7f3Ini3hFmZsFuj+IsF6g+8OvAro/wBAX6P7A31qg++P0TTBforYX6P44LRPMFw9kdTFfRh50f+CfL9B9" +
+		"mZtlLONtKSC7flvQ/eXuRoYiz4YuMxT1X2D2llGOjqZRjui3ZZSj/THK0VYa5ehoGuWIVl6fDEW00ihHcIpllCOY6CLK8UC/" +
+		"LaIcvWdiDdFhywQdRtMvsxd9Wg067O5JlOOB7bmIcjyxPRdRjoe7LlGOp1+dKMfD3ZooxwNcaRHleNoWohzP6ZugpyA2yyhH" +
+		"ZwSRjGaxWUQyXpe/AwGGNVpEMt6X0u6D5w4XkYyeO1zEK5oHdRGveLsrbYQha8+uBcKNfbkWCDd83dqWxStZZuRNertAgN15" +
+		"Fgi3Gm5bFq/EyC8QbmfBAuF+rL3zJt992T/bSf+2/dUOeIe4+/dKu0dx6/pX2j2KVWn3KBZ/R06p6u8CUkGKlNKREs/8HZh2" +
+		"8dnuUd5xH69UkRISuPXmdF5pIlnD/iogDO2Ad6gnpcA71M2GvtKFRH3wDnWvqld6eEb/4B3qSe3wDmARr5SQIlLmdwup8OxE" +
+		"qpxmt2X7q5DN4ZU6En2AWxj2Fm5hVtoJtzA3Q9EOuIU5GGu4hdmpHW5hNWqPYNqHEpj2QavhFtayPjDtgzGDWwAHbUcUl7e+" +
+		"xpuMBNwCJ8nasW2EQkT3K4HLL/q+bYRCzH87to1Q4JteaaPf8EavRKTvpLcbYSichn6lB3zdNwMSo5SI9B3Ul4z09c2MRG8T" +
+		"mP0vRL+974HmD77QtjpKyPQ8Eecb6UElsndjm+2oRPYG6t6WRYmR8duWRYmBVsI6xMDshHWIzVLA7x0HWIfijIB1qNVSdjur" +
+		"PYd1aBcjDevQbksZSJZCjuxk7SDczk4iEg/XGxGJR+B3RCQeD6NJROLhDkJE4uE3ISLxCJYJtv8wnkQkgrW0g4hEcnq8Egj3" +
+		"Q8uIOwR5eSUQ7qQEwp0YXeIOD1cRcYfHw9og7vBwncpdRCX6F+kt3MVpb+EuTmcucYfnYqXAXVyR/sFdXJ8yQb9dtXAXl3MJ" +
+		"huLyi8FQXMMaQL/dv8yz/2k16PdJKbAQYDSvRGRv5ztwshEe/5V2H8LD6MJCBFsNCxGiz0C4H8aTuMPg/ISFCM55WIjgdyfu" +
+		"MDyW2cDXfROEO1jD4E16tG2E9xl9JxMCGfLbAUMRXDkwFGQMagcMRbhoGQxF8IvBUJCvpR3T/tFOGIpwK9G/h3bCUITA7IGh" +
+		"IJf+K4GFH6xNGAriKV6JqN9hqwc4OT2CochqFRiK7B4MQ5EmXxqGIk16C0OR3INhKIi1aAcMRb6pAYYiuZPDUKRFy2AoymUN" +
+		"RP1GSwEnv20LOLkjAUNRXakwFNUZAkNRnT0wFHVbGo178N4yOxLIP/WdMBSVveCEoajMSO7IC1+HGL/dkbk9L7RkbWDm25dt" +
+		"3J4X2ra6G7fnhbaj6xq354XWbQnn1XfkReP2vNC6LQEz58ue5EzozIGTnAn9sD4w80hbgrg4z+A1iAJpJ7wGEVmNO/gCXkXj" +
+		"Dr5X8s3dBzycxh1877MTiXzrFy2D18D7adzBF/BbGnfwvW/6bCDZssmbSgtcnvrgNbh7p3F33yvRMngNbgBsJ7zGvCkF7mI2" +
+		"3wTBb3wHuIvZ6Dvcxbp9JoJvKSD4QQkEPzKTolni6F80SxwjCHexCr+Du1iFN+EuVqF2uItV6B/cxaqMLgzFqnxNGIqFXXoS" +
+		"W0hm13YSW0hennYm0X3rA93/lAK6X60PdL/TamILOY32SqD7kxGUodioTDtlKJzXMhTYCKcMhWMtQzFotQxFU6IPaJxThmL4" +
+		"O/rgCpChYDc7ZSiav6MPgzUsQ+H6g4XgBGbjXsZ4OxKwEHflTVmIxKyDhSDXVjtlIarPYCEq7ZSFSJYCCxGVYCH8trIQ+Cqn" +
+		"LETiS8tC4J2cshB+P1kIbLxTFqIw1twHcDvruv2jPhmKzNeUocg+s3/U0O0fben2j28Ee3EX+g57cWe+A+zFnRlP2AtO+LwS" +
+		"/XN1wF7c0drpH1bdCXsBovhK5KJGL3MrZAyBlsFebETx2x0ZXkOdyX2RIQTaTD4FNT33Rb5v0i44D/U+90VGcM/GfZExuPrg" +
+		"PIJfAc4jOGJwHtzi2bgvMnJT5yvBzdhXeI2o9oHX0CY/4TWiIwavEdF23DoZtdDPaWw5I23sA14FN1LG5L4ur5EZTXmNSjvl" +
+		"NZzj8hp40qe8hitaXsOdB14jJ1smb2MN8DauMHiN7J7IaYfcmDucdsjOcTiPrJ6E88iVMYPzyJn64DxypmVwHrn4O/pX/J39" +
+		"YzyX/aMPcB7Z+Q/nkTMjAeehr8JtlbHt6OTGjZSxb1SmXbIXO5KyXbIX7HSX7MVGnV4J9gK7+5K92BHB7ZK9wAu9ZC+6pcBe" +
+		"YI1dshfL2mFqli3brZ7YexcnGgZ7IvdaxsGef3GiYTALLk40jEHtnGgYlR5xooG8940bMONg1l2caBjsyBcnGjht1LgdM45F" +
+		"WzjRMLoS/cMf4Q7MSL6bxh2YcTTfpH/DZzBR0z7Qv2Ht9G/yJicaxqJHnGiA828X5xa4O6ZdnFsYaJyLcwuDOXhxbmFUy6R/" +
+		"k68S/X6MYPT7WcpGaw9HCfYCfLFxr2YCQ2zcq5mu22ec8sfT5V7NdLFuuVcz3Se1k6XxZoVzr2biLFDjXs1XYtaRpVE/jXs1" +
+		"0535RrAej/MF1oPbKxr3aibulmjcq5m4TaJxr2a61/fMXuPGzcTdFY0bN9ODj8GNm+lZlAgf8jh34EOCfYUPCVii3KqZQlSC" +
+		"Q8IKv+A8uN20catmCs4keI3Q6V2VQ6Jl8BrRNQyvEUG1LniN6FyF1+AOpFfa7UxYjRe8BhmlXwl+Cf/jgtdIWLAXvEbCur3g" +
+		"NdJNy+A1iIptF7wGnPQrwT091gBvA2pxwWuUaJnwNqAIF7xGcc+A16jo9gteo4IpXPAa3MzWLniNdlEmvEZzzsFrNDzbC16j" +
+		"XfQPXqM55+A12kmr4TXapbT71z9tqUiMJ7xG/7QM3uaxBngbxwVeY2BVXdy8MKyBmxc419IuTjsMv8qAt8FjvAa8za0Eb4OX" +
+		"cXEuYoBFXJyLGGADF+cixkMfOBfBeZ92cS6CczvtGvI2fBXORegRXEPehlkw5G1sp7yNb3KzhDNS9sK1AntxgH1csheOGezF" +
+		"aatlL9wzZC8uegt7carhYC9OvL2LHA2no0SOhhP/7pLZOGwZzAYe1iWz4VqB2bhcDzAbl99IZsPVKLPhKMlsYFtfMhtJCWYD" +
+		"W+aS2cCWv2Q28GMumY1K/2Q2nIMyGzANl8wGFtEls4GFfsls4FdcMht4BDfsBfx8u2Ev9Dlu2QvszRv0YYFh3LIX3TdhL/bJ" +
+		"oHbLXjTLhL1AU92yF1973Xc78g2vwYmedsNrRGyCm7MWESvu5qxFxEK44TziPuHWbjmPHe3TbjiPzBe64TwyHscN55EZ6RvO" +
+		"IzPSN5xH5gvdcB55R5y1G84jZ3+3e07++nbDeRR2+VvO42L8PpyHpVSe+buGxLjDeVRW5s1Zi3r5u0kp1iCnQ1vgQyra6IYP" +
+		"6aBTN3xIx7K/4UPIBvhKnlPoSAHJMsk7jJV6w4f0ainkHXbuwIeMqGTeYUaQsxYDfOOO5h22FPIOD+sjJwp+9S0fwm59y4eg" +
+		"s2/5EGyzWz4ExOaGDzkcXfiQwzGDDzn8DvAhnCFqN3wIDHK74UMOtMMNH8Kp7XbDeoiT37AehyO/bYtCdP4rwff4jWBEiJBv" +
+		"N4zIgb13VzkdWgYjom12w4iQm6PdMCInu+4NI3Jiz94wIif23g0jwp067fYcxrQUmJtPfZzDmIz15xyGEucw3AkqrE6yPnIn" +
+		"J2po5HJh37s5sRGxgW5ObERYiJtTGRHs6uZUxmc/4VQGt3G8UkaiLdgddfpmJdaC70CO5+YsJ8cz+ckaN5KXaavJ8TzxGW9y" +
+		"PHMn3ivtdk7Qo9tTGezdN8zG4V7gqYzs72BuwDBuT2UUVrGnMkAmbs9euL949gLNcXv2Am17g0ykw99xNmFZJlmCQYhumA3y" +
+		"d70SOVnwjW6YjQue74bZ2OfDvt2RhzmCaQmchyjTLecBtnLLedg7OQ/3BTmPxHeW88iWCedRbBech+Mg55HtHZwHaMct5+E+" +
+		"JOeBl33LeeBH3HIe7tZyHnj1t5xHU4LzwLe85Tzwx285j9tn9A//+IbzCH4vOI/g3IHzEIe54TyCWgzOIyTLpH+uN09luAd7" +
+		"KsN5JefhLijngeV0y3moceA8iPJ6pcgzpYJEW+A1snsivEZ2p4O7IFqrPXIX2BKP3AV9f+QuWFOP3AUa9ZG7AJ96YChqtRQY" +
+		"CnaQR4YCi/mRoRiWIkPRkWAohr+DoRi2ExbithQQfPjWh5MXI/AmJy8Go/TIUMDaPjIU7MEPDMXckbavRHz+7TPQ/ccyQffx" +
+		"AR4YChnkB4aCzPqvRNbcbu0g+LftFMGnBpiGhSZ+QAoOcJEHpMBIgQekgLsJXkmEOyKBcLN7PiAFJxb6A1Kgpf2Q4eAAv3nI" +
+		"cHCiGR8yHBAH9Uog3PhwDxkOiHx6pY2EcHfmKxGDz/71kOHghPF5yHBw4HU/ZDg4YZgeMhyc7IIPGQ7Ok/6R4YAb3toDC3E9" +
+		"/g50GBbiSaLD9BYW4u7+DnS4WwPo8LQU0OGuBPrdbSfoN976AwtxO1thIW507wMLcTs/YSFuvxgsxM64/t2O/MBB3GCbDxzE" +
+		"7UjDQRAx2x44CDJYtQcO4gm2ix4M36QHIEJPFd/mm8BPyHo/cBABjfbAQYRCf+AgAjb5AwcRo6WQcTb5JhlnsQIeOIhoj+Ag" +
+		"YrQUThh86uOEARj9AwcRXVNwENFdCZ4hOSPgGRJ72wPPkJ3V8AwZhueBZ8iuDXiGDIb3wDPkYQ27D+XwdyC5w/pAcpfPQHLB" +
+		"9x60eZ6WAlLtyMMzZOcOPENeSPAM2ZkEm5DxjR5iGLIzEDahHEq7f8WVAptQXG+eoHBWe4LCOQGbUPx+nqBwfcMmtNtnDcnf" +
+		"kZvWPRGmgWzorwRSjc3zDE8YsBZhGhrI4zM9YeCz3Yd5+Wz3Qfb1gU2YMCIPbMJEpz2wCdy22B7YhIl38sAmTLCIBzZhou8e" +
+		"2ASyT7UHNmG6J8ImkNX8lRa1I8EmcAb4lU5+R8tgE7gZ5JVu2sIILvvHmMEmcKNIe2ATpusBNmGqqWAT5uPv6J/fATZhOoKw" +
+		"CR9tBJswXQ+wCdOvCZtARsoWOEExsUkCJyjmqUSPwPvCY49uJHoEIhVgGiZWanjskaXQI5iwsPV5Oul72Po8ieYEzkKcfNvA" +
+		"WYjz9s2NQZ3RtmwMSp0WOAtBjsEWOAvBHWSvxFkIrNbAWYjbMjkLcV+/gFoETkkQl9oCpyRu8KjAKYmHbxI4JfFcSmDYYHiB" +
+		"UxIP/mPwlAQzMHAS4vnUsHjGiHESgpsXW+AkxOP4cRLiASENnnaIvilSTVs87UB8Q+BWpzB8k9wt7DzBkxDwRMGTEMMaQLHx" +
+		"OIInIdhLg6cdWPvB0w5gJgG+IMLxB/iCCDsfPAkBNxrgCzjV/0q7DzkoJbBpfydS7e/wGA/fxGMkWiXAF1QilgKsQGVfD7AC" +
+		"3MDUAqxAZTcLsAIVPDjAClT7BytQ8TsDpySqI8gpiYZ9EmAMWvB3oNGPEmg0VmrglERDgwdOSTT2vQCb0NgJAmxCe+gRbEJz" +
+		"BGETGux1gE3o+DgBNqHj3wXYhIEdEGATtJgDbMLApguwCSP4O9Bo8NkAmzBcmbAJw50ANmEQJxfw6sWLAl79iL7Z+J21g7bj" +
+		"LQRiGYMruoM4+zU7iLNj1kGc8dVDB3G21R3E2fHsIM6OYAdxdsw6iLP7Xhdx5mt2EGe/X+c249s3uc3YfYiszicoZSCr85ms" +
+		"HcT5tNXeZszvOO+gXRo47yAPFjjvcBNJHDjvIL8VOO9wuxo573DDeAbOOzyOGecd7k5vOe9wo5cD5x3u5TMQZ6zPwHmHB3wq" +
+		"cN5Bvi5w3uFe1j55Zo9A1PHoAozBjb8VYAxubKAAY7BzWH27I8MlcI/sKz3UxleAS+A2+1cCa3evgUt4iE0LcAncetQCXAIZ" +
+		"FF4JbBq9H8z3/HkGNu0OCZcQ3bHgEuKnzIVEKXAJ3DHbAlxCxFcIcAnRnRUuITvS8AXGN4Ql/syMgC8o7krwBfW2PvDnaA3g" +
+		"z64G+ILq2uckhNGKgZMQRkBGuITKuolwCZVxiXAJlT0jchLCSMYIl1Bvf8cpAvRkhEsw4jLCJdSgBOIcrZ2b7kCIIoxBxwOJ" +
+		"MAbcP9AijAF3E7zSQBpIu0fcHNAijAE5/1uEMeh4hRHGoKOpIowBd++8Eog6XnaEMeAegRZhDLjF4JXI/AMCFmEMOA3aIozB" +
+		"gFePMAYDlDfCGAwwvAhjYMxEhDEwFiHCGBgzEWEMjNiInqBg1kUYAyMMoicols84QcF+Ej1BQaR09ARFUQJRh9WMnqCAW4sR" +
+		"RB0cJnqC4rZMEHUs9BhB1P3unKDg3FWLEUR9WSY3+YENxAiivqx9o7wnlmiEMTjhoSOMwYnHH2EFrss3dx8uy4QVuED+I6wA" +
+		"2exbhBXgxFSLsAIXu0aEFeDG2xY9CwEuGZOIurUPJMYaVkDPOsIKBOyjCCsQwNEirECAgYyek3AueU7i8wxE3RpgBSLxGxHk" +
+		"P2Yl8rYnayBvOxo1ivy7OkT+0RxR5L9/n9eiRXF/tEgkUxO3MrRopiZnp9mYsM2i2ZjwlqPZmMA3ItmY8qfMhsTX497H/Klh" +
+		"8Iy+wglwH9krLSSeyQlwNiDKCXSfwQm4prz30Z1HTuBTCpwAsR1R3N8RE/eHB4vi/qDDUdzf/cScS+5D4P6He5s5l7pvcjsg" +
+		"qGvktMMFEhLlBPzqH07AN+EE4IminAD6J4Luk8nilUC/P78D/YaziqL7YLBRdP9TJui3+5foPto2iu7bB9F9R1cE328rgu/e" +
+		"JoI/fROUHks7itKDaESQ+IwWiyDxZGVo0dMH+OrR0wdo1OjpA1iPCBKf1XAg8UbARJB48j68Ekg8aG0UiX8sBSQeezaKxAcl" +
+		"7mZ/LNPTB7TF0weuPk8f2D9PH7hyPH3gzPL0gRrO0wfowiSCjy5MIvjE/CcR/KYE+o2vnkDwBz5VAsGfj2WCfiffBP3uSqDf" +
+		"OxtBSyD467QGYtuDbxLbXi2T2HZWaiJ+8ITtTcQPnodt2TjMSUx1In7wBP1LQVSZ3hI/eOIvJ+IHjYVLxA+enANMxA9yDrcl" +
+		"4ge1+hPxg9ryifjBE7QjET/o2btE/KA+QCJ+8Lx9s4O2JyT6d/g7+xeR6B/xKQnk/yGGLoH8P9YA8v+cvxD9luAExCISnMAD" +
+		"ApXgBJ5bKfOMvsIJPI47nMBz+qwh0Ts4Af2PBCfwoNsTuL8RewncP+C5J3B/ebeUvOPsQCKuGJ87gftH0KkEti/CkMD21WEJ" +
+		"bD/iuSewfXVfSvKrjCbYfin+boKC8ibYPhkGWgLbL+yzCWxfjiyB7XNz5iuBusI7J04YlNs391gX9EgC3S+PNRTKpNWg+4Wd" +
+		"J4HukxfhlToSfQDdL65F0P1CtE8C3SeDQkug+wVbKTX7Rw2cPmjwYAnkv2EhJJD/hlWcQP49J5RA/uXkEsh/W9YHYlktBcSy" +
+		"+jsQy8/vQCyxphPI/3SPAvmfxdpBLIs1gMiiVRKnDya6N8EKyPEnWIHpnIAVmO6QsAKT+JQEK0CurVcCv0RTJViB6ayDFeBO" +
+		"yleifzB7qds/JfpXrYH+VVtN/5pvgm268xA/eLpfEj94uj8TP3gSrZ+IH+Qm7JaIHzzRKon4QU9uJuIHT9cf8YMnOEwifvCz" +
+		"0xE/qDWdiB88nYPED4p9JOIHZf0S8YNn8Bl3ieHtJeIHL3RhIn7wdqcjr/IN35rIq3zfzBfyKnuqM5FX+VE3bRvh1VDUsH3+" +
+		"9ARLiUi+CUJrH8i5/ODbpilC65sgtI78BN2EtUrkYw7EvCTyMXNPYkvkY/5y77/fkcnUHNU+ZGoOWLCJTM1h+YwsNjBYiUzN" +
+		"YdFKMjUHMJlEpuag7iPncnJ9k3M5uZ+Qczn51cm5nByVxU1p7BL5Idc1u0t+yHUNjpwfYo6ZSfkh5jgogeQyd/IDksv8yA8x" +
+		"x1hH+SHmGMYsPyC5WYmYY/RBhhPI2RrIaZNtGTltks/2N8n4VxlOwLMkGU7A0yMZTqDAMmY4gfJ5Rj6YbClgSWjGDPJfsLgy" +
+		"yD/5G1sG+a+nZYJGwzZlkP+K1swg/0b3ZfIjVbzQDPLf0DgZ5N89OIP8t6hEbDSoayYHUov+bveB+xxfKSAxErACHc8lwwoM" +
+		"RwlWYPjdYQWG4wkrIGqRYQUGfm6GFRjMugwrsGwZrMBCq2RYgUVMYoYVMNohwwosMmdk8iof7CeZvMpH8E2yt2SfJSR/B1pL" +
+		"ZEkmr/IJqpzJq8x9768EWutsJa/y+Wm1aC3jQl7lk3WUyZ3s7pnJnXzBJuRKDms0aq6grmj+XMEe/bbbDshaYHnbAa9Eq7cd" +
+		"kLljveUK6vqpD9T105bdzgcNkCuoq2tz2wHvKuTNbQe8NfCsgSqD3+RtB+QHxC03sFX3gga2iv7JjfWAtZQb/UMf5EZEMDtK" +
+		"bubotj7iftmRcwNbXZa5+5dO69v986x53nZAJuv+K00kvmYDWwUTyrACEVwrg/yn4xdiLTKcQALDznACmf0/wwnI+Gc4gYxu" +
+		"z3ACefgm2VrwejOcgDEFGU4gg+hlOIGMZ5vhBHK3dqKaiVPIcAJGCmQ4gYLVkeEEyHXVMpxAdUaA+3tCPYP7V78euH91NYD7" +
+		"V2LoMrh/dS8dIsfWAHJc/R3IsSsM3L+CbWZw/+r8APf31HsG96+uMHD/6lwF96/uPKD7lbjDPMWR/Z39Y3RB9/unBiKXQcoy" +
+		"6H5XV4Dud8caBJ9bZlueYsWWIlZM30HwO3ZpXmLF9BYE31NzGQR/OF9A8D3llTkNMKbPyBKPzs6g+56hy6D7niPLoPuevcug" +
+		"+57yyqD701aD7q/DMslGA9KZQfenKwV0fzHnC+j+whcroPuLMgv3Mh6gFoV7GT3bUbiX8cAfKQ/o8OPvQIfxjcojOtyRQIex" +
+		"1MoDOgx/UB7QYXbP8hBv/dgy0GFs5PIQb40HXh7QYXCf8hBPznwpgXhy7L0SQIdBJsq2A16J+gL5dZiRJRCLjdVTArHYrKMS" +
+		"vElTCeT4thSQ46AEcgwWXgLIMXt+2XZA4cb1V+o8s9WDUhj5jRSUy++wbYRy4QOUbSOUy75vG+EtxWcXv6Od20Yol2MWQcax" +
+		"uwvovidgCug+98S3Arp/YWkX0H3jdwvofvxIoMrdGkSVaTUo/b6L6rsduYDfu48XIv7VFCWJKkckbwZVIv8//GoB20/JZ6DK" +
+		"aJgCfm8ekgJ+n50R4PcZ3rKA3+elNJGoAYy+YFUVMPqCxVXA6Evzzd2WOn2WiFenR0TuizEXIvcXPGYBv1+2Gvx+YVEWblc8" +
+		"QM0Ltyse09+BHIOXFnMZDUbCXEbg5MVcRqCuxVxG6J9iLiN4lGIuIzziYi6jpUREPAhwMZeR/SOX0cn+XMhldLLvFXIZXVmJ" +
+		"Gz4dCXIZkaetFfj/u9pqUOVlfbt/emKFXEYR7K+Qyyi6vsllFMApChH/EZu8gO5H0KoCuh/ZPQvofliWUvidb3IDJrZ8Ad2P" +
+		"2C4FdD+etgxk3LkLup9O3+QGTMsE3c9YfwV0PxMDWUD3PbFeQPf1cQrofkb7FeL6zQtXiOv3xHoB+TeDXAH5z44LyH+5fJN7" +
+		"LolZKiD/hXi3AvJf3CFB/os7JMj/Z86D/Ff0ViGuvzpDiOuvxFwWWIHqbIUVMJtXgRUwxqZM89/QTuL6G+x5gTForg4Yg2Zb" +
+		"YAzatMyORP9gDBratsAYNPR5gTHoYJ0FxkC7o8AYDDyQAmNAnuNXIj+MOzmMgTF0BcZgqgthDKaaA8bAqMcCYyByVmAMpqME" +
+		"Y/DR5zAG6/EZ+DoWX4ExWI2RJw7wK1nQtztyJULwRBtUIgSNm6lECJ74NJUowDP4Jng6eqMSBXjiwVXyDZx8vUq+gevyTRDn" +
+		"bJkgzp/fGeP8IIE4V6Xdnyf55uJN2gJf8ODxV/iCB51Z4QsefOcaxNopE75Af6fCFzzYpRW+IODZVviCUC2TTB3DMsnUwfeq" +
+		"8AWx+juQanzgCl8Qi/WJVHckUN7Pm6C8nE6u5BsocEiVfAPynxUuoTB3KlwC94q9Eih2UQLFhp2sMAal+QwUuyqBYqPhKoxB" +
+		"IZKlwhgUrIAKYyD7WmEMCnxWhTEolXHhFEHptpP+EdVfvScRS6Z6TyKIZYVN6Ldv7v6tj7T7t9jlK2yCdnDlFIH6tXKKYC7f" +
+		"3P1bl/UVyvR3lWf0FqZhXbZl92/BnVSYhkV8Q4VpWOylFaZhgulVmIY5legD2qjCNHC7wysFJGYBTMO6LTNRAz2CaVic5qgw" +
+		"DYuYvVrtg/XRh2yZ9MHVCNMwyTpRYRrWYVtA4kEwaxOJ581m/5hLxBaecC6V2MKTs06V2MITbVSJLTzRYpXYwjP7O5DqYn3e" +
+		"ochX8Q7FzzPuUASzr8QW3o+lkJEcn6OSqeCJPiMOl/25kqngQcNVMhU87KWVTAWfnYFMBYGYs0qmAiM9KpkKuNn1lbjh8PB3" +
+		"3HBIXF4lU8G2O77dkclhEJ255DCI09q4+3D6DATY8SOHQXKkyWGQwD1rFwG2FLBU95MhlsqbcBDm16lwENyZ8ErkDgffq3AQ" +
+		"2TUMz1Dg5Co8QyWeqMIzeBq6wjNUmJMKz1A/ZYKXTkshetd1A8/Q3IPhGZo7JDxDI1KmwjNoPVR4hgbnWOEZmnswPENz5sIz" +
+		"NHDPCs/ATTWvRGSvegueoTny8AwdzVsneKk7KzzDAO+u8AzDPR/GYIC8VBiDAYZRYQyGe7B3O+K5V+92xB+p3u3Y/B2Yr/rH" +
+		"ux1h9qo3OIIOV29wtH+wCcOdHDbBHDoVNsFcPxU2YcDxt0cE+EAC5cWqat7SCGbYvKURi6F5SyPj2YgRPIOlgPJmJVDebJmg" +
+		"vOxmjRhB95NGjOCZfBOUF5+jESPouelGjOAJKtOIETQCrHmDI1qleYMjCH4jRlBfvREj+IAUNNkExroRI/gQl9eIEXy6ZYIO" +
+		"F38HOlysAXSYL92IEXyYL40YwadbO+gw368RI/jAJjRiBB+sgkaM4APf2ogRfGBKGzGCD75fI0bwYQ42YgQTtmEjq0A6/V3k" +
+		"GW0hq0BC+zWyCiS8jEZWgYTN1cgqoG/UyCqQgm+CDoO1NLIKcIdCa2QVyMsecYMjurdxg6O+e0tkhQBVaImsEMRANm531Btq" +
+		"sBBfUOK3O3KDnyiwPw1+wri1Bj9RsXJaEnVljOAnql8WfqKyXzb4iZptCagre1uDn6jFN0FdsYMb9z66ezbufaww4g3uwmzJ" +
+		"De7C0w4N7qKitRrchad+W7UPjHs1QtdnRujSTriLbv/gLrqzDH5igFQ3+InhHIef4OaYVwJZBXlp8BNacQ1+wuiiBj+hldPg" +
+		"J7TpGvzEcjyJOzzgz1oTh6TvTRzS+sAho78Dhwy2ExzSHYu4wyPaFnFIRoK4wwPPrxF3eHKyqnVxSGrv4pCU2cEh0U2tg0Mm" +
+		"3wSHxN9qHRwSzKt1cLrPm+CQ7pAdHDJbJjik372DQ2IrtQ4OmWw1OKTzrINDutd0cEjn0gCHhD9oAxzSuTSIbnXubmSiBL/K" +
+		"RiZKcH5uG6EEZ/IgJ4QzZIg1WgPRrXgubRDd2n0GDoluattGKLJGbdsIJX3aufuQHJdtI5QERtO2HVDMVNi2HVCMwW8ThA+0" +
+		"vW07oHhStG074JWoYZKtHCypbTug6Ke1bQcUcZG2EYbS3aM2wlC6bdl2QOnubcvcFdSw7YCy3Ge3HVAWKH0DYTgPy4xIjAQI" +
+		"gye6GwiD0dcNhMGouQbCYJx2A0U47S1xhyfcVyea8GIv6EQTXujeTjThxQmRTjQhN5K/0m5LxBrsRBNGetSJJoxg051owvjl" +
+		"8Xy3I3fiDCOaqRNnGNEwnVzG8bLE3YMIk9HJIhDhGTpZBCKYTCeLgKeaO1kEIvFunUwBmfXWyRSgxdzJFGCGok6mAC3mTqaA" +
+		"zKzu5DLO1TeJbsXy7WQRyNX6xNsYabIItMM3wdv4st07Gg8l8Da+c/eORuZq945G8MvuHY2gyt07GqdvgreB4fUo3mYN5GPG" +
+		"fu7kMua2q1ciEwL7ZSeXsSdDO7mMJx5IJ5fxBJnr5DKeINWdXMbmD+rkMp4wrJ1cxhP/p5PLeHImqpvLmBjSzm2LCx6zc9vi" +
+		"Avvo3La4sIM7/v8Jm9Dx/42z6vj/xg10/P8z2BbiPYOlgLdhbXb8f26gfSVxNNqJ/2+8Qcf/P0GAO/7/iSbu+P9n8XdgbNXf" +
+		"gbFlf0cfqvXRB7R7x/8/2XU7/r++esf/P52R+P+X6xT/39iAjv9vfFbH/3+Sz8DYXAFEGmrBdiINH+z1TqShlmgn0vBpSkSt" +
+		"ois6kYYPuqkTafgUn4EhunKINHyK9YEh2qMmhsgXI9LwcQSJNHwcJSINH0eQSEMt9E6k4YNP1bv9o2VEGgb0eSeaMFo70YTR" +
+		"+ryJEY+uexMjGqcTTWgusU40oTFEnWjCAqbdyTFQD3+3eJP6yDFQsUI6OQYKtm4nx0Dl9HUnx4D3vHRyDHgjTDfHwPJZ5Nkv" +
+		"7chkH/DcbTf7wLTuQm3WTfYBfL1u9gF3ZLMPOFfJMLDc5ckwsFxT5DJeeOCdXMYLzLeTfWARJdHJPrCCb4KwZZ+BsLnPkn1g" +
+		"YdN1sg8sLK5O9gHjnjrZB5arj+wDC/y5k31Aj7iTfWBFawdhA4voZB9YWKnd7AMwdJ3sA8v1RvaBhXXbyT6w3FnBBk61EdjA" +
+		"ZycAGzidZWADZ7U+EDYY1r5E2Og72MDtVwEbuNWTYAOffQFs4Mn+DoQNBms8YmoTCUwNHTqINAz46gPcIF4+E1MrSGBqp2+C" +
+		"qcFSDSINI+flB9kHIit6kH0gNqUOcubvQMeyLQMdA4sYRBomfJxBpGHC1h1EGiZ6O4g0NIvHINIwEz0yiDTM1k6OAWOBBpGG" +
+		"mZMeg0hDI4MGkYa5WTvRkmDvw0hD1sMw0vD2TZCzxzdBzohFGOQfqPjVgyhET5EOohArdvAgCrGyfw2jENk9h1GIzKVBFKL3" +
+		"TgyiELkNug0iDVv3Tc69g3INIg3N/zSINDRuZxhpOH0T1GlZJqjTtJ1kYcUGGkQhmqt5EIVoJuVBFKJnLgdRiMbtDHITDKyJ" +
+		"QW4CT2AOchOYmXqQm2BgEQ1yE4ziM1C14TNQNVDzQW4CM1oPchMsdMUgN8HC2xvkJlh+B3ITLOvDyz+c5fjuZ/2FeOSBV68V" +
+		"MPDqT7zCgVcf4M4HXn1wtuDVh9PfgTlhJw68+oDeGHj1gX194NWHy2cXks9u3uRbGnXoF8KrD6BFw6hDmJNB1CH30LdB1GHC" +
+		"xhpEHRoTMog6NCZkGHXIfjmMOow+A1diXx9GHUalBR7FuBN1mNj3BrkIErzUIBdBwq8Y3RPdzEfiDs1rMbroEW0h7rAclll4" +
+		"Rt+JLSzo4UFsoWe+BrGFFa93EFtYu6WAELkWiS005/IgtrC6RxFbWEHDhrGFWGqDfAOeTRvkG/BU2TDuEGxzGHeI3TaMO4T/" +
+		"HMYdwg8O4w6bz0DAmjXQv2HLiNlzdIk77OifQdxhjz4jZs9dgrjD7jol7rDjcw/iDrt7vvGD+LLD+EEi8Ybxg7AsY5r5lD6Q" +
+		"HWCgXwexhQN8YxBbyJ3ubRBbOP3SxBaapWcQWzhBSAexhRMmcRBbONV3xBZO9R2xhWZEGsQWTuy9QWyhp1wGsYXrU19GomXE" +
+		"Fq5Pmdx096mP2EJ3F2MLL8sc/I4xM7aQ2qexhazb+ZAHFatgGlsYfZPYQtbYNLaQ0Z3GFrILTmILzRg0iS08L98kD+rlm+RB" +
+		"hVOaxBae2WecPM+2pSM1JE6eY6FMYguvbh/AvGBuJrGFFz7jJLbwYr5MYgsvrKxJbOE+efvdjjyJOrzQi5Oowws/YhJ1eDVL" +
+		"BO1jtU+jDrstAe0DaZlGHTbf5Lw6GnsSdWi2yhlEyvZqmEQWerPXJLLQ/XISWZjAeSaRhdo8k8jCBBsziSw0B+UksjBfvslJ" +
+		"cObOJLJQXGQSWehON8kbUE6fNSR6RNRhAUudRB2WYcs4CT5sNSfBwQ0mEYmF3WUSd1hgOSZxhwWLaxJ3WBxr4g4L2nwSd1id" +
+		"A+QNaM5A4g7bYykVidEl7tCTHpO4wxZt2e6Dd2ZN4g6H34G8AQPkcZI3YFaf3eCCrCliEmdXAp/Csp/EJC5OZM1tBwSjYmcl" +
+		"Zs/xrMTsoZdnBQlk/5rbDgjmi5zEJMrJTWIST1iIWUUJ+SrEJIoOT2ISxZgnMYnXUgJjQ9tOYhIjuMgkJjEmnxGz5+5CTKK3" +
+		"2kxiEs1XPIlJNM/xJCbRG28mMYmRMyGTmMSYbCcYot+ImEQzG09iEiO41jQmEUtjEpMolz6JSdSyn8QkZtctMYn6B5OYRPPz" +
+		"TWISG3v3JAtxA8+c3Wyq9IF4xebqJ16xH5ZCrBjo8ARTON0ZuriP7RT3YTy72JW/46wyGnyCKZxo8AmmcNo/MIUT632CKZyc" +
+		"Ep9gCpc7OZjCY9/BFB7HDORAVnOCDjzDMsF2sGwm6MDjeJKb8MFCmeQmfD6tBtv5sle+3ZHBFB7XPpjCwymQCabw2FcwhZB8" +
+		"xjlm2N0JpmD++gmmEJsSiJA9AFMwA/+cIkKMGJiCPtsEU6i3z0CEOHE5wRSq6wZMwRMiE0yhgilMMIUKuj7BFKp7FJiC5zcm" +
+		"mEIFn51kNKzw3JOMhpXI/UlGw8rpoklGwwoWMcloWB9rLyA91g7u455IRsN+WAqYid+SjIbe7juJZPT+ukW84iKObBGv6Kmy" +
+		"Rbzi6r4JSgKWushouJq/AwWCLV/EMho5uYhlXMz4RSzjqkqgQNXfgQI1a+fELPNlme2wWybZDoe/40Qws26Z7ZB1s8AbTnTT" +
+		"Am84bRl4gznjF3iDt/Yu8AaZmgWm4N1oy7yFxWecisWCXeYtLL5J3kJs3QWmIK67gjfs0GpwAzmXBW4Qma0L3CAyWxe4QSTy" +
+		"YoEbROLXF7hBcjzBDVL1GdjHtExupxmW2XhGj8AN8qcGbqfxi4ENmLVqgQ1UvIwFNuAsX2ADFWt6gQ00RxdsoOPpLrCB5rcF" +
+		"GzCueIENtGGZYB/T34F9TH9Hzr9py/acmFhEC/9/Wh+5CSfMzSI3oRlMF7kJzW66yE2of7DITagHsshNOLE0FrkJ9VUWuQlX" +
+		"8hk3yaA5FvkH17RM7rq/bAtxNK4q8AC1ygIPEG1c4AFf16J9uyMvkILLvoIGeNvuEg0Ilp955pugFo8t6Ui+ub2YgMex8PjN" +
+		"nLnw+M2WvPT48Q6WHr+rQY/f1eAZRDiB5UlDLOblSUP20qXHj0e1OGlYbn9Hfjd8qCUagP28RAMuS9n9K9VSOhJzRzTAmSQa" +
+		"sHwTNAD2bokGcLZjgQY0GOMlGuAOIhrgmhINcA6IBiwlPP7lm3j8IP9Ljx97b+nxw3ovTxou68Pjj7ZTj99S8PixIZceP7jg" +
+		"wuM3U+3C4594hYuThmbbXZw0nJxiW5w0nGjGxUnDiU5bIAVy7gukwGjaBVJghO4CKTAeeYEUeOJ5gRR8NMckliRZA7EkyZYR" +
+		"S4KFt4gNOIg8X8QGHLAei9gA7y5fxAaYjWYRG2D87No6+51mfNuts4uZcdfCu1zWl3lGH7bOLuaNWltnv5JlEkviF9s6+33G" +
+		"KG2dXe7Dtuz+3RtD7MfW2YWbvvsBUnBvvKEfIAX3nuX9ACm4t8/xSg/PJhI92uxWP0AKuKWhH6ABsVof0SPDUhqerqXsVqfT" +
+		"+vCJT9tC9MiO3u0HaAAnl/sR9JcXEj5x5Hf49Wnr0H7g1+eb3uLX59tn+MQ3rcavzxuX7wd+fb6oHb8+3/QWv56bH/oR9Imt" +
+		"AZ/4sgZ84pPecpqwnNSHz18ca3z+HdX2zY7cD9AAuLx+gAaUbQW8Et7yskS9ZVoCGgB++Uq750Qt9AM0oH7KxJO2B6ABrVjm" +
+		"7vlwRoAGjItvAhownEmgAeNTyuJ3tBM0YDgOoAFz8GYyLxxjCxqwMi0DDSBLYj+IMDgvZhkRBmSp6gcRBqdjS4TBeVt7weul" +
+		"DwkvNPOFEl5o5lsmvNCihBea/R1eaGa2VKJVMrVXPE1nfMXTHIxS5eTYoGUiBZ3aq54m9YEUNPsHUtCccyAFLfmscjeHbWmc" +
+		"l2I8QQqG3x2kgOjkV+JW7sboghQM5wtIwYx8I5CC9VAKSMHyd01Pk7VBhMHZGWsiDM5Oy4gw4CalfhBhcHZryHio/o5TSje9" +
+		"NcLAUTLCoNMHIwycPUYYTEbQCIOlhBdq7UYYTHpkhIErxwgDV5URBq4O8hWRj7YfRhgMy8TfcvYQYVALfSDCoGYl/MlqDfiT" +
+		"zmvQAJD4fhhhUJk9RhgkRsIIg8LoggZUZ6sRBlWJfFOBGkADWrUU8k0NxhM0gLvnXwnvy9EljmA5SqABy3aCBqxJ/0AD1vQZ" +
+		"PuNkdRhH4CgZR+B3MI5gUoNxBJOxNo7AVWUcwaQPxhH4pTkrQHR5PzgrcA5q56zA+fkdOZecE+QkOqc14EF+ffdvd2ROGJyf" +
+		"HuBbLsaBEwan85FMRtyS2I9lJn3q5vTBnXiT0wfcVd4PTh8QYdMPIgyI0+kHEQZPtRSyMVW+FxEG8VMDJ1key+Qkiyt6eZKF" +
+		"OWAuo8acM5eR2txcRp/a4eo38t5PcxltH/+VYOc3+v1K5DJiDpxEGOTlm7Dz20Z+Jdh5RvAkwiB/fse5lh1v009yGZVPfQXJ" +
+		"UmDng89g55ul7B71w2cDyXZyrmVb06/EuRbbCRrQD3oLGtD4tidoQP9Iu3+wW/0EDYAV6yfRB53d5ST6oDM7T5CCzu5yghT0" +
+		"QctACuZlKbt/3DPxSrt/ky99ghTM21YPJOvDs92eWT+JPoAx6ycoAjcwvRJe781YgyJwV1M/QRHm4zO8XnbrExQBW76f5ECa" +
+		"2y/sJzmQsOz7CcIwb/oe7Z8SXu9jmdyfumg1CMNajC6nDw5WzsnpgyNSCqcPjsTvOH1wdkaJ0wdn9xkZ69GMJ2cM1GInZwzO" +
+		"bg0w+c4lcyChC09zIKELT3MgNb6ROZAmI8EZA/Ij95MzBldiPDljEJzJnDEgI/8rweSjAU7OGHCLWz85Y8BZuFeCyS/+Dt+9" +
+		"0mqiEbjdvp9EI3DDWz+NRmAPOY1GyLaF3OzRN2HyHRfyI6Vh7TD57CEnyEQajCfIRPI7gEykSltAJlJTwq/3qxCp8JUW8Nsd" +
+		"+SSGIXXLwOO/lfT4rRuP31lGDEPBOziJYSiuhqbHz0iDaDS8mBNEo52MCogGNyL3E0SDm5T7CaLRDvoDosENzP0E0YB16CeI" +
+		"RjtoGYhGO32T/OeuPhCN7ioC0ejOeBCN7s4KotGXNXB6xFUEotEXfQfR6MtWk//cGQGiMR/6B6IxH94E0fis9iEaQO0gGp8V" +
+		"DaKx3L9ANBa66QTRWO41xDcs+8ephSMpcbIk0SNOLRyuFE4mcENkPzmZcLorcTLhxKo6OZlwFtrCyYTTNTXxjx9qmPjHmXZy" +
+		"auHGxzk5tXAnWs2phdu+c2rhjj5LPGPMOLVwn5aCx+/6nnj80bZs7+sO/g6P330PRIO7JfsJonEHRgKcInRqAKcIrj5wCiJf" +
+		"+wlOAQfySmQ8H76Z8MBpCzhFwrM9wSlAjvsJTpHweE5wiqxuAqfITQlvWX0ATkE0bb/AKcqOPXql/R20WS9wisaecYFTNPbL" +
+		"C5yCOLl+gVOM2zcjkmUmMsL4jPww3WcFP9T6OpJvDk6B+GxyCsRn24u60e6XZxp29E+/PNNgH4JeYkHSS8xInAe4lYilD/TI" +
+		"3Mk7Tq5f5EK4biXitLGdLnIhPMu24EUta9/WO7Fcr6SnlJC4s64hke+AG4Reibvg8PIv8h3s3Nff7cgXmRBqtQz4LDTvRSaE" +
+		"yo5/kQlB1OIiEwJxT69krDJfiGwHMLGvZKyyz7bNc6BdL6IVucXmlfA/HAcYicNxINvBYr+8YCQO0KkLRoIbil8JH+NTCj4G" +
+		"u+cF68D94K9UkJgDsA5P9RkMT7NMbnw6fXP3IQZbNpGoAUYiBsYFRiJhg1wwEskyYR24o+6VsJ+dgTAL3FnyStjB2IIXNxv1" +
+		"i3Z6sxFYxOXNRqz9i5uNOijQRfaBDvJykX2g44ldZBiYwd9hCwbLxBaM/g5b0P6RYWAGviYZBjhZ0q+mLegz2BH01oUmPvwO" +
+		"TTuR8UQTw6v3C018uvbRxBda+kITh88zbCx8sQtNHD6lYGMNxhNNTPb8V8LGaj7DxmrWgI3VfBPupNpObKxp7dhY+JoXmji5" +
+		"vtHECQ/kMothphSzGDbeRBMXdwk0sXjfhSZu6MkLTdwu6kMTN2cIkYY9WB/Z8w7mLrzD54vBO5CJp1/wDjPaso5kW4jgc4XD" +
+		"O5Czp1/wDhNr84J3WFghF7zDQktfaGlOs78S8W6Z2tHSR6a3aOLTL6YmxmK+1MRYvpeauNBqNTH4zaUmbtaAJnaNqYnd+eAW" +
+		"bmcB3MINUn3BLdyF+tDZN9jOpc4GFb3Q2eS46Rc6+zPr0NlfV1J9vyMvbwSnB2jzzx6PNhfjupb4PV8dbR7Vi2jzdNku8Hus" +
+		"qgttnpjHN9pcNOBGmydW7Y02z4/POAu6cxH2G23OnRD9RpvnHYn6SqD5O+r3lUDzWVM3rIPW+w3rUC5/B2K/me1+o83r4TNs" +
+		"kB218Er7e7WDGmAdGrriJgax2U5iEJstg5Fo9ghGooFY3jAS8NWvtPtARvd+w0g0LLUbRoKcxK+0v8oItAxGYgR/By4ffRNr" +
+		"ZSphraBVbhgJcui80gIZp3+cXoyOCycUMzPk9oTi8lkAiacGTiiSt+aVwHWXv+NkHJbFTfaiczGC3oH8KVOLxDI7EqNL9qJo" +
+		"j8heFMFFbvQ5twP2m+xF1Wfo8wq2eZO9qGKX3upzPLObCIMD/uAmwgBevd/o+uO0lIRkDRnJ2guSvyMzjvMFXc+tR6/UOe/j" +
+		"m+RwZz+50fU3ls1dRQapHV3/oOtvdL1Y+E30wYNleBN9oFd/YwcE9MGNHUCO4FcCGUT33kQfpO7vdh8K3uzNzYjkXuw3NyMS" +
+		"69Rv7Afy3/ZbGwEL9tZGcDVqI4BP3doID9+WDEXyDjcZimb0d9gPyRqIkoCPubUR8HtvbQS8jFsbofk7bIRmy8hEMpSIPwBx" +
+		"vrERgnMJGyGAkd7YCAE0+tYOcJZjBySskNtsxscv7Mi3eY6dH1gInx0SCyFhbd5GH7jajT7wW2IhFKzGGwuBey36jYXQwA1u" +
+		"LAQ5gdvoA+cjFgI5wF4JCwFL7dYKwJ+7sQKIKnslrQBLIYfu4e+wApL1YQWAcN9aAc5VrYBkDVgB0d9hBbgasALmZu77rRUQ" +
+		"lMQb6DvRB0v9gz9+Oo+1AqpvYgVMn2EFDCWsANDaWysAP+bWCnDvVtOjo281vXupmh6r8VbTOwPR9Dc8yo2mvwfjYhTB9E01" +
+		"PeOiPm/+Dn3uTqB3jvV+651flgkf79pXn3/KRJ87j9Hn3P/UH/Q5Nz71538Rdi/ptqrKFkar5AvQpALWv0jXPVqfN7lOks8H" +
+		"oghBxE9087kdN1/JfC7meJjPL96cw3x+6VmH+fwW8T/M53bMfiVzof/hsDqXS7IfVueyTn4lMeqRu6C9R55FjPrMPcWotfYw" +
+		"19NP/Upi1D8SqR/m+qHXHeb6eTnTXD/ZZoe5fv54mH6Y66mN9KMkD4OnlqlA/sF+yFRQn9wFKW3EOmQqqH93EaPO+0ymgplj" +
+		"smoaPQ+ZCp4jzyJTAe/YYa5/zL2HuX6wQg6r+qxfD6t6+Zj6Ye1e1hyzw3pVu7V7yRezdq8zNTSeAu1jB9xshIOvX97ofvD1" +
+		"L+LeB18/FcN+8PVTB+yHdf3efFvr+sPK7LALERfejygk87gdSMOL1+Kwrv8xYP8akQ8WwsVWOlgIeLB+sBDs+fpKv5npzrtl" +
+		"Idg91Q8WQs83YSF0luHRQiV5D6yAme8VBtF4eYRB5Hk8WAGJxx+iAAvL6RAFWNk8R1QSrLaORAH80UeiAOaYQxSg8nYciQKk" +
+		"D4gCyNnbj0QBltyFQqto2iEKUI35B0//ZXV3PFmF5lhWofoVT//Dm3nw9D/5+/jzn7SBP9/+ja+U+Sel0zFP9mT+yTH7yDKe" +
+		"8OfPjAtP5h9t58+fLIuDhfD35mUaWvNnJtOQSMMh09CRP0WmIWrCX8m4zs4/ZBqiutcPmYZa+r9MQ2dPDTLc8IQcMg1dJdfZ" +
+		"t5PxRKahO/1TpqH7756y37C4DpmGMOP9kGmor3lOmXHylmQaisV1yDQ0H9fJNDQzIg+kjujdMZA6+SqyEL3W/4csRG/GYDmQ" +
+		"6WH2Qw7kasVzyIFcef+O5EDOyCMHck8PkQN59Dy1PRMiGwfCIJ66A2FQWO8HwqCsOdNIx1t7ZFcBW/BAGISYOuwqSHTmsKvg" +
+		"zr9iV8HD13IgDJb8qQiDbc1drAj4Ag8cwZFZDEdg90M/cAR23Hwle+KtYw4cQfwwx5txwpvHEcxT7TiCN/OIvYwydn+l3z9W" +
+		"8W6HvYzViuewl/HcUh87ddM+exntOftK9DjW1B47NXdhp+aL2ctI36cf9jL+lwLunyNyscvRroWvxGY1Xhb6yEM/LvSRJ+9N" +
+		"kftwnjkTbcrvX+Q+TF8tch/a+d1Ldjk+ucvvfzt4EQqr49hT36NUlLpSrhtKp9JU2pRe9/QsrI7CTizhGnNmuEbxkBKuUSS2" +
+		"hGs025WSMSPH0H1Hjl1KnpoXQZ6Cr5QxQ/t4Ee6e+n5teNjWhReB7v1XMmboOwXX2Df14RpH3id6UR7Nr/Rr3/B/F/Ri+LPC" +
+		"w0CH7itVY03u0ng0LqXTWNOV7JPbU7JPTvSnyIFUe2pA8Fk7FzmQ7BrqRQ6kR5SxyIE0/ItFDiTZOL/SjozzzuRAXvON5EAu" +
+		"W67LmKE+OZAfo2CJFyHvM14EtnXhRdjFMUsiBjP3FDFIL0/EoOZZMmZ4E+wHGgpfyQrcCrLwIvT8AeyHRQSysB8SYS3JdjBy" +
+		"plg6+7mwH66aY2Lpb2r/jQtP2sBGmDiFghSI/VyQApkdymN+5esvj/lVBLk8dCesvoqMg63nOiwvO6DIOHjxIxcZB++/kj7P" +
+		"HisyDt75tjIOPkbBIuNgt3Ip7ICOjinsgJ4/hx3Q0+vYAcNoXdgBw4qusANe5EzpmSe9s555Uu3sgPfKmVYLPWdeemRV+nnV" +
+		"NtZgQRrKA/GVeNUy2iANy5YaeNVm7vmbQ3/EwT9HZPmK7RDsRb7iO//USC/zzPIVd1ZAka+4Z8SimfjXB2gmUoHsRS7jmZbz" +
+		"MKwZIXkYakZWHgZ56L4SNah8IR6GJ38KGmDhvyxogOXMdUbkPCca4MifggY40iJxBtkqvhK/dfq/OMOVUYmHITRa4UW4+RQK" +
+		"L0LPyMqL0P/OHErehFjC4OksPAzzUh8Pg5zqvVBJqKkhusosmWJvYbGqKWhCOTZ7QQy29E7E4MuyKG/8bVqbmR5HU96869RA" +
+		"ectKrJjpn3zbzPRW0jXzuS9dzee0tnv9m88fJbsrWIaVF0G28K/Efj5yT+OCMaPyIjRjRjWfhzyq5vOz5Z4ZF14lXoQt97Rn" +
+		"vOSe+SrO5A14rX+qOftNG8zZFPm+Eg0KFFsVx3/4PiryTx7UXkP+GQkq8q8b12vJm/de5CS+8l7kJJ6/3ZlfCfPSU2J3/D2L" +
+		"Xp62m7NP/FnNXgR9opqzZRT5SiIbxpNas+/dcyL/Fn9HRf41I0OVk/i5UjrV7i3ZcdDMvdWOAzthe7Xj4DZOVN4AirBfKT0r" +
+		"JfPInRqs07rr7Co4rKWrPALy5feK7sv/V3/z8nembyvHwDQDVPPyJipQW54zx/QXo02VY2Cm78oxYB/gVxJZz3f4zcv7YnVS" +
+		"f3PvvoiiUgleDjwM5drlp6TxrxGZythhZ1WnMnZM4xCVsZKIC5Wxkn1BVMZK2CYqY9/aqSlVpdylqSHHzl+JbX0lg+jIsf/e" +
+		"0Xv/XZedCVMpeim70lDDqySHwZm7vI65rmjflpL2GV2ojB2UjTuVsYNueqcydtBN71TGigxMncpYsZeqUxkroa6ojJX9yV1+" +
+		"7WuYPSpjRW7aTmWsxF9EZeyzBXKmnRcsQypjNet/KmP1YZtRGatycnUqY9/y+FDaEOTuUrVPn6My9pVyne9ndU5l7Jgjx3w/" +
+		"8wGVsWPmWX7/4iHDZ79+/+IhF2i/2NZbvibbel9TohtlnUuB7Fur+37s54tvgM7YZ6Gpj/0sd3+nM/a9M9/PDp/YUXTGSm85" +
+		"syl5Z3b4zCXHaPawNmmQlfhvaJCVN31CDtFQuDTI6lZy3fTm/StyiHbz3SWHaHx69MlqCEz6ZPXvqUXhNh4++mRtE/+nT9ZC" +
+		"GNAna5f1D32yc/k7Jga/5i7/teF48z/8/G/fuj/X/f6jxHfpkx0z//vPtj7e9KXHf2Q3B32y756e7PEfGSHpk33H1PDzzR0y" +
+		"G3X6ZAfdlU6f7Cup72eTH1RYOn2yQ77wTp/sOzPPop+9KWlfeoFcIInVRJ9s+7vL1CN9P7lA4iuOPtmGP4g+2Z5nkQtk56eI" +
+		"PlkYz+iT/X0VuUCOjINygdT8K3KB1PH+jxGZtd4yYvWMILn/r3fKx9+janblH6ZqdqUfUzW7Wo5Nx7xpqmYXv3hUzS4ruKia" +
+		"XebaqJpRYO5RNXvYl1E1++vxVM2yjo+qWU/tvHb9rwZZiEfu+WvDbLlOFuL837x2f72F1+7N/EO57M3/Tbksu32iXPaOHLP/" +
+		"jGeCctn313oyXruN7UK5rNJv7JTL6mZtSbmsxjNOuaxurI5LLpBNVPOSC2SzBqBqVmk0dqpmdat5TiuqmSfLKJ9n+Y3yzRqR" +
+		"qtlXUoNcIM06hqpZbRlB2OuNL4mqWW0Za+QCaekvcoE0HimqZt/4lXueRiytlQuk8ypTNas9bzC5QI7U0JVS+3Cm9ykXSEe+" +
+		"RrssUQ/aZW1hUdIua/kqtMu+xqaETPA/3Mkhqn/SLmu3vkS7rMkr22mXnXLwd9plp5z4nXbZKSN/p112bnwYtMu+UmqgbOzf" +
+		"p1125s+hXfatEi8l5Jg9E/TJvtKhhJQ2utAnu2RF7fTJLtlUO32yr7Qr/ca91/9Hn+yw073TJzvemnsa1488y3Rd7mJc18vp" +
+		"kx2hk+mTfcea0uY692Q/xPNJn+x40QH0yQ7ZcDt9sm8k95bYD/bZd/pkR/h8+mRH/Df0yQq1mH7HN8eSok/2jda+WM24nqc2" +
+		"rvMq0yAru1GeBlnZrZRokJXslKMzVo79f3gtKJB953krrIcjPZD1QPeuUyArB+8pBbJyrHmSrqQF9gcfW86cjnl/9gdTIO8U" +
+		"yMrhD6NAVo68d/uDjy3XmX3Sk+wPPtjdFMi+ucgbsz9YXpdOgazI69IpkJX2V8OjlGNdSRvsD27pZfYHt/zf9ge39B37gy8R" +
+		"QQpk5eJHpkBW5MPoFMjKxdKmQPYdy5nFMU8tk9iVcUEmsetOfaczcx3lzPQrmcSyD5ACWbmsgSmQffZl7ik+fuWe0wyXJ6M6" +
+		"mV7AeqC00imQfSX1sR46TwgFsq/kLbEeKGF3CmSlpz7WA83sToHsK3lLbARZNfqdTGIiUXTGiky8nc5YkZum0xkrWRvRGSvz" +
+		"71lk+f97Fln+xYLojH0lbWAjZA1HZ6zEiqMzVmLv0Rkrb0ZdNsKL1LnZCC/vKQ2y8vJB0SArL0qVBll5Z+4pU9rMPVno6dfs" +
+		"h+z8u2M/zNyT/cAXfsd+wLzcsR/E5O7YD+blO/YD65YGWQkzToOsJpZOg6y87NI79sOee7IfztTHfsjbjY2Q2SE2wpn6rDIy" +
+		"M7IRaMD0m42woZtuNsLGR0Of7LuL69gIW74fGyGeM/pk9W++YyNsGSHZCBsriz5ZLRkH2QjlTX2UNNN2NkLJd2AjlDf35FW2" +
+		"yqBPVv/GFzZC+x/Kp51yWZXrtz8yiVGi+krsIWMUVbMa/x5Vs+/MocQeOlJiD7HUqJrVjGZUzeqjP1Iuq73lnmwecyblsioX" +
+		"TqdcVjt7lnJZzb9PuaxmlKBcVrvvTLms9ppjVoziuZTLaucbo1xWZdvplMu+e3oWu30yLlAu+0rei90+nT1LuaxmfUy5rMbb" +
+		"Trms9pan1j4jJOWyJhdOp1zWZLjplMvaZualXNY2+0yeeCbEcymXta3nzEPJk/ESyrvaKZe17UkNzTHPaX/wntZihkLEUyf7" +
+		"SpvSL/JV9txlKnm72J/qv3nC/piJo0C2WH1FgWxJffYAx/qLzlgiBtEZW1K7PcBLvoM9wNvfmazU9Al7gGlD9eiMbXnz2QN8" +
+		"5TlZqeaD6IxR9+nREvvrrSJ08WhES+zNV6GAvlwp2S1pZqQldi1ob1pi35mehQL6YkZ9bjspzdnPTWt+T2myZ/Msr5L6RO/C" +
+		"FNAZOyarkc7Y8bIKnngYWO9PPAx5S/EwtNyFJcqyf+JhyN8RD4PZ6ImHIT2Zh+E1Fz7xMOQvfljaLXeJpZ1nYWmn1z2xtH2H" +
+		"ztLOf9tZ2lgZOmNfDd51T/u0tmtfekjXvvSern1mDjpjX8lX6dqXXte170xJ+9J3u/ZlzOpZSeQussX+d89/jsish/XJc9kp" +
+		"YC87BbKSTBYPCyGZLB4WwsYrSYGsbLkLK2DfU/pZAX//MCsgu2+ojJUjX5YVEK88lbHvWGpgaee/YQUceSusAHo3/WEFHHkr" +
+		"rIAjYxsrgDpGp0BWjoyXrICDP5gCWTnSr+ifHxl16Z8f7CgKZOXImD+tJKyMHtroiStSJytH/gba6IlAPrTRj8xGtNETgaRc" +
+		"VipL5knUD89Huay01E4bvbECKJd9xzwn5bKW90K5rGXeolzW2CBRLmv5wyiXtfyLlMuatfpDG71ljqFc1qxl+2+uL4mb9t9c" +
+		"XyjlfiVrBz7maJyFHY7G2fWkZO1g5ojG2cUnFI2zS8agaJxl91s0zuhi92icUYnrUTK7ULhRMruenMn/Zk6Lktk1cib/Gx9l" +
+		"lMzCNkXJLBG6KJldohDdrl/a3r3b9XthSqNy9uSdiVA8emRUzhJTjcoZ9d0elbN+5J5yQx25pwgMb3RUzvqdMxF1d57T2ijv" +
+		"GjvcUTxROeu4w6icdTvconLWr5xpbWRHWFTOOgY/Kmfd+i4qZ130Lipn3fzTa9ZGuU77zFtRQOv5YjwMnX89Cmj9zjFrozX3" +
+		"tDbiC/9TQLOujwLaa06Lrll2Ffzpmhlf/l/XzPuMrll6cnTNRo5lxeOp6ZotW858lOq/R+Qoni1/T/mz28JJR/Ese5yjeBa7" +
+		"JopnixVjFM+WvAfRisWsHMWz7HSK4tnCJxPFs0VkIYpnC49XFM8W1GgUzxZ++CieLVvukjakPm3AHEfxbMm/T/Ese7ijeLbw" +
+		"tUTxbMvXo3i2semieLall8lHtuUPk49ssyaN4tlmTovi2ZYemHxk6VfJR9ZzjD84f5F8ZJQDehTPtvwp8pHRMOhRPNvutMha" +
+		"L31VPrJN9CeKZyUtko8sGVmieJZci1E8q3lLHXEmjtmTq0yM5U8NTVTlTw1tyT2z1ss9qaGJakYNLbR31NBqvjSCqPIURA2t" +
+		"vDn2qs+7lquspk/IVVbx8lFDa+mfcpXRCe1RQ2vm3qihUYrqUUNr7IdonmVuiuZZ5p9oniUnZDTP2pkzHyVvSR4zOja9y2Mm" +
+		"k2zv8pjRourRQ2vsh+ihtYx7KGM6VT16aM1KInpostr26KH9zX4z7ct12sAiiubZw78YzbMQddE8ezIO8Tc8GXn4G/qaGqxf" +
+		"M3PwN3TMVzTPuqxt0Tzr/DDRPEtmqmie/Y26/A1ddIbmWf0b5fkbkgGgJybxpGT9ag3eE5MQu++JSWR2T0wic29iEj21a99M" +
+		"fdrHMzESkxgpaZHYNs2z2o0MNM9qfHM0z+pvxvnXiEwN7btqcZW2eu/U0GrvOfZr69hTSlvzJNpq3h/xRTw5U1vZdCO+CFbO" +
+		"iC9i5phvaWyLUlriE1FKW/T/KKUt/rAopS16RJTSFv9GlNKSUSdKacuVGhBLV85EsJoXo5S2WBlFKS078KOUttUcs79n5C72" +
+		"wdpPEaW0beSpZc+zzo1S2oZoiFLalu8cX4T+GKW0DcMw/nwRuY4vIu+ML2K/c53o/J1jfBF6dVTUdpZFVNSSDSAqajs7Kipq" +
+		"2f8fFbX9yl3sX0p/tH/p4AWNUloRxYlSWjHyRCmt5GvaiVTNOFFKq/x0UUqrYvxRSqv8e1FKq7x2UUprb+4pA+Cb+mQATB9E" +
+		"GWdXYJTSGj9rlNKa9QiltHYhkQbK+BInGvYqXzw9AyVxWx/TVGu3sZtyWsvOC8pprbdch4wz40Q5bdlz7EFQpL6fr2UxZw8E" +
+		"8sIuHcmi/qR2eUJYvlFVW/Ld7WDaxPKiqrblSydXev53O5g2GYqiqrblf7CDacv3s4OJ1mOPqtr2VztfEjs4qmqxO6KqlpV1" +
+		"VNW2K8f4kp7Ux5eUkc4eZ1riPapqW3q5Pc6xUKKqthmRo6q25e+QByUevqiqxVqKqlp2sw/7n6sV5LDLmbJLj6pa+NaoqiV+" +
+		"EFW1N2OBncz/JUL/94hsj/ObkdUe5zejiz3OYQqit/Yi1eitXYs1Ir21a8kbs39pyR9m/9LC3hvJR5bROirqPI8jKup7ngXD" +
+		"w/cxoqLOYzmion7knjxQf23ggcqfMnig0OT01o43Tz15oDJCTh6ovL/JA2WdOyYPVEbIyQOVsXTyQI3chQfqyV14oNL2yQM1" +
+		"cowHKrPY5IHKm588UOmPM+3zzqb2PalP+1gkdNrKZi1Lp61sopN02srf/8a/8ddX+Tf2vHn+jb3lntTQefTotJXkdaHT9pVS" +
+		"Q/xT/hT+jSNt4N848t+8/DcZJai2007udNpKdqnSaSuH/4ZO27mzpum0lYN1RKet0E7udNpKdofRaSvHkxKvk3GIFlsJ2UuL" +
+		"rRxGCVpshXZyp8VWaOp0WmwlfDAttnKMHON10stpsZVmjKK3VppxgapaiY1MVa0075qq2lfSWj6MZtyjqlZi685Qlt48VbVC" +
+		"5axTVSuxpqmqFSolnarad+xW4nW6cuzXhuSVpbhWrrx5PowLT0FxrVyijBTXypU3wYdxzZR+7btFCymuleRfp7hWqKN1imvl" +
+		"Mk9SXCvUoDvFtZK9MhTXymVtRHGtXDNPzQfFuqW4Vi6zLcW1QmOtzxCYbMMZApNVQHGtXGZ+imvlEl2muFYuPDLFtZJMUZO3" +
+		"I7nnJ2/Hf0GFf47IdNpKuCA6bSU2P5220ll4dNpK7zmT98b4TKet9J7W8U6lreiK2N102kpn79FpK92YSKetxNKOThu9206n" +
+		"rfT8YQiKWNrRYuu8dtFiy/ojWmy9p3bPOXKd53xznQzr7KgorE22RBTWJtIjCmsz/xsuYuYPw0XM/MO4iJm/CBcx2TVRWJv5" +
+		"N3AR2acQhbWXVyYKa+GQorBGlbtTWKuLGDGFta+kDfwUC98YhbW6ZAThp8jOEgprdeHJpbBWl/xv/BTLkRr4mY7UwM8kswSF" +
+		"tZrdtBTW6oI6icLacqR2fiZsUxTWlrxdforlSEn7kE5RWFv486OwRjmz/6mopU/wRfyN3XwROy9JVNSiMTD5IvYld7mUtJ0v" +
+		"Ypu5ji8pPYQvYl9S31DSdr6IZJaIwtqWMYMvYs+oFD4yIw9/QzQ9opW2sa2jlUbLskcrraCGopVG0adHKy0ZiqOVVsQco5VW" +
+		"9zwLf9GWu/AX8VlGK63ueZb4i7SPL4KWeI9WWvi6aKVVPstopVV72qKVFrsqWmmV7yNaaVSyerTSYqtFK63mf+BvyG7C6KEl" +
+		"z1700FrGgvANrILooSV/SvTQkss4emhZYUUPrY2UeIHYMtFDa+yA6KE19l/00NrMPXmIzA7RQ2v/i0eOUlrmb0pp3/2Hkray" +
+		"8Cil1aznKKV9parE/2UmpJT2lVyHfWisMUpp3z0vJS3vuU7L+Yde7EPTA1/sQ3tyFy03S1JRq9nJRUXtu4s2YB9az5nad6c+" +
+		"7Zu5TvvMI29J+3zLZDplqVFR+67LsbTBdXKiJIMiFbX68IS8cqJkf8MrJ8rDbqOwVpO3hsJafdIjkuvUrExFrY6/GiZfUu75" +
+		"Oqbt8p8NZDuFte9Mb0L+s2S/pbBWo8lCYa0mVxOFtZo5lMLad503Yefy4FWmsPbdxbf9ze7fmbnudiylR2lV6u6ifWIZY00b" +
+		"tM9ITn3tO9N1YhkDV0l97atP77nTBs8plpHsH9TX6jBXUF/7juU6bdhyTBvEOaiv1WS0esUyxpYnSxu0/U4bchccTf5h+VPD" +
+		"YVBmawu7mzJbW8QOKbO1rCfpr7UQG/TX2mJ1Tn+tbUeOySzBT05/rYUco7/WNiwQ/bW21dTOx8YGor/WtownnY+NR4r+WstM" +
+		"RX+tbewc+mtty9/R+djyj3U+towMnY+N75H+Wtvzp3Y+towanY+tp3Y+toyCnY8tI0rnY8tf1fnYMrp1ufSetOjXvj1tsHd5" +
+		"N2/RX2s725D+WtvzjexdTqYv+mttz9hj7/LOe/Tau7xnZLB3+acy888RedCEzFj6sxBa2VKyx//OcyGdzLyU2Rpd3k6ZrVE3" +
+		"7ZTZWkvP/c37LTEWemst3AC9ta/kmWd8hjmT0iPOl25aO8V76Ka1Mz1eVpIz/5usJCebh27aV/KcspKc+U9lJTnXlPgF7Teg" +
+		"m9Yu9h7dtHYh+OimtbABdNNaMvnTTWs0pTvdtHZlhqNuElKAblpLHJ9uWgs3QDet0fMZdNMa7Z1BN63Zwz1oo7X+iwUNimfN" +
+		"ODQonjUjyKB41oxmg+JZQ9kPimftvXLstd96/Er8+e+j9kLX4jd+DYpn5/KLhg6KZyd7fVA8O5ehhoJb6zmGW5vaV+w4S4vs" +
+		"Ml56ruNL/f2Zg+LZuYxcx5f6+zMHxbNzmdpe+FJ/vqRB8excftbRoHh2Lt07+82v5zLcpeLyeq7Tvt8XG3TNzmVoQ9W+31gz" +
+		"6JqdS/fOqlwBb67jS920r/KlXt5Z5Uv9e06+1Ne7tsuYTT7omp173jztESPkoGt2srsHXbNzS4toj+y71uIOjaWDrtkpBjLo" +
+		"mp3b68lwh9v0znCH29QG3OH2+irhDt/Ud3uWXKd9b+pL+1Jf2pen5iuenjPc4fQstEe29BdZx6wrBl2zk5b4WJJ1bPoOmMTa" +
+		"vLM7/uDckz+4eU67HmvLXex0P7SWP1/u30HX7JT/ZtA1+0p5lqnki/HnX8M9+fPv5d+ZhgbFs+889+fplylwUDw73/Rxnv43" +
+		"34SnH70+KJ6db96YHCXLmzPRmI93JEfJ8uircpT8/Q1ylPz9YXKULBkJ5Cj5+6PlKFm67yxHyd8/nFxlw3uXo2TNW5GjpJYc" +
+		"23mjPYscJbV4ajlKasl19j0d3rscJTVfyI4G9MighlbsbB/U0Mrf+GVHAyZkUEMryJJBDa3IDTiooRU5ewc1tONNjx984flv" +
+		"7GjAtQxqaOVvTMQrIlkGxbOChxkUzwrOZFA8+85UO15x2XKmNuypXRs27wyvuOzeGV5xWbwJvOJavDO84ppxCK+4Xp4ar7hm" +
+		"hMQkrvmamETq04PiWbGzcVA8K1tqwCRuxfvEHW7FU+MO7XoctMvKltETd7jnqXGH+6XEL182Iwi/PL28QbuslLxPfvmy5hgv" +
+		"9swxXuyMGfzyR8YafvmyagO//JGRnF++LDnzVvJe4pfPyMovX1bvhV++pA/GL+9vpF32HbuVtMh4ucb37i+mT/adOZWq0q7k" +
+		"qZdcx2/9s24HDbJy/nwfgwZZaf5wGmSFPtKgQVb4FAYNsmKlO9Y/3/tQwk4+asAWtp8lOtb45Z9cxy9vfFnjlzdqrPHLm8/X" +
+		"+OV/tvVY45fv3hK//JW2J/uBv58GWZG9ddAgKzK7DjpjX0lr+d7vxbPwvd/rv3eIDApk31Vq45W/jS4UyMrtb6dAVmSPHBTI" +
+		"ynNra43X2vvjlX/unMnnm28ZsrBoQchCI+QasnDmOj7fN+3h8515MuRkehmvfH89S/KKsReokxWkx6BOVuaWUrzDviyv/LxT" +
+		"indYfS3eYW8peRHSvjCIeUt87++e2nmHa54s3lNvKaRhyT15T3NmSENz9BrS8NaPQxqenjOkIWt6DWl45Uwe4DN34QG+1B7S" +
+		"8PTUIQ0vPSmk4eV/C2l45cm04UxJGy5vIqTh6Z0hDbf0XKQhmn2sIQ3ZWNTJKu3kQZ2s7ukhSMPdrEJnrNJ4HnTG6n5oA5pQ" +
+		"judBZ+w7M7U/ztQiNOGePwxNiJ4fdMa++nLd60xvkJd+39XAS79bZdAZq3t6ck8b3KWnDd48L/1+eIO89Ht6Dy/9nj7BS7+X" +
+		"1K59e+rTvpK7pH3eYE/7cqbd82ltaMJ8B176klEQMVisR2iJ1VghtMSq3PaDllitaQMvfWwZemFVtsqx8sSfa+7ye87z8F54" +
+		"2+VcGvTCvmNKvO30Kga9sO8unhP5JwfZoBf2HfP9eOJP9gO9sHpuuWdV8h144s8tNZzq03d54s/D1+SJP/PdeeLPNXfpSt4Z" +
+		"T/y55kzt2/LU2red/2NE5qM/N0/CR0/FY9Ac+57E2+SjPzdt5aM/WVU0x+qT/4aP/klb+eifvAc++idvEy/4pK14wafmzLA/" +
+		"2sp//2Qc4r9/rBhpjtXHv0hzrMoyPmiO1YedT3OsPqwcmmNVBvKxhRfUPppjdfAGbCH/flzqoCtWZSH+SryL7LaNJ35cqYF3" +
+		"seRZeBdrjvGQ1tyFB/jH1AyaY99d3JMnfvAi0ByrY+ZM3tOWEu/p6Tl54scvejeiOTaM+dEcozYyojk2rhzTvpZj2md1F82x" +
+		"UVKf9vlGG0/8uLSWJ35sOTPtc0x+pPHbczmiRzZ4ZaJHNswxUR0bp2/LSz/8mVEdG2aVqI6NlntqX/POar6fb8tLP67UgGzk" +
+		"0diSuXzknl3Jd4gi2cg9eYd76uPpMQP8KZKln9m9GG/HnyLZyDHe4aHtUTBhpW5RMLHai1pZ/CLRIFuG/on1W3ru4qmtZqNB" +
+		"th2pj6+4+Q5YP3uORzTItvRyrF88IRvWL56CLRmRrNOiTxZfS/TJ4pnYsH7xKWxYv/gwol2286NtdEn2vHm6JDtvTnTN9un7" +
+		"8cvTZRjRNdvZz9E12/NP88vv+fv55ffpnvzye747zZI9X4VmyT48J5/9nu/AZ7+nF9A82/Md+Oz3jD1874W3ij5Z+y9B7z9H" +
+		"ZMplreQP45WnOTYol7XSc0fqMI+n5JUv3VPyypee605n5p5801YVlMsaHbNBuazVM6XOG+2N8co3cxHlsq+U2uWzZlVRLvtK" +
+		"3juvfDNrUS5rbcsxSndWd5TLvpLvzPPetlx3Kvmy/Ovn3114uM0/1Mna+Xfdrw2Zo6mTtczD1MnaySanTtYyF1Ina5nNqZO1" +
+		"M99y8n6z26iTfTV4Fl76c/MGeeljPWy89Be7jXJZu0pqiPfbk8k5LvfooFzWsE2DclmTT35QLmvyyQ/qZO3KCMKDj3Qa1Mka" +
+		"9mdQJ2sXLyF1snblb4gHP6NSPPjp8Tz4d+YYHvz79CZ48O/Tu+alv9OXeOlvtjydsXbzadMZa7RxB52xJjP7oDP2HduVNseK" +
+		"0q70Kh1Kp1JRupWq0lRqSpfSqYZNCY98pvbfm+9mYhpkzQ6DsUeD/MfJjT0a5GdqlyHSnE2D7Ct5anGAYd1Lg6yJmw4aZE2E" +
+		"ddAg+xb1OfNwTA24/syFNMjayFvC9YsgDxpkbaR9uP6Rd1YS59AGXL883IMGWZMHaNAga2/Js4hzsB9okDXqpoMGWXv16x3X" +
+		"/16eE9dv//OgT9be7qlx/W/PsaKkhsQI0trECHbPIkaAZxr0yc7FTEWf7Fze3JNN+eZMMZD336pOg3LZuZqnKJedy5vWvY55" +
+		"StGDLWcmemCsoVz2lfQB0YOsxCiXnVmzUS47d+sBymXfAOKZRQ+ySqNc9l2Xe16OeUeJHuyp/XHPXNddlzOHGnx10QNZ9gbl" +
+		"snM/UhIBsW6hXPZd503caYP3Lnqw518UPciql3LZKVffoFz23cWziB7sm7aLHuxWGZTLvrs0pbRB+0QPspLeRQ+y6t1FD/bD" +
+		"WxI9kOVyUDU7dyMrVbPvWbRPjCDeAKpmZ1b1VM2+Y2oQI8gaeLcboP7dU3TEaE3x7Kx3SrJd37mOJkv+FLsBakYQuwFqxiG7" +
+		"AeqV55T/zMgaxbN6pbQ65p52A1R29243QL18MbsBasYouwHoCI6oodXL281ugNuzZDfAnfq078p12mdFEM2zymMTzbPKQxTN" +
+		"s8omj+ZZ5VGPrtlppbRTNz3FcXbqpqfVQnTN5EAe0TU7rY2iaya394iu2WntEF2z0wokumZnS+2iVNY/0TW7M5bSNevpZ3TN" +
+		"5P0e0TWToWVE18w+7RFdM3uqR3TNsrrcf/bDOVjo+89+OMfjff7sh3M8qaEp5bpf+6a1Cs2zr5Qafu2jlzxonp2JuUTzbFm0" +
+		"aNqxwQ+6Tzs2MtbJVr5kXn7ty3i9l9e+DFZ/9NCWkZJ9GWySaJ4luhzNs2Woj+bZf4GRf4/I1NASEY8a2sJajxpaVltRQ8vq" +
+		"Lmpoy0wLKMj/csWOKJ6tvPlRPJOjfkTxjB7TiOJZNUtG8Sy+qiie0fkaUTw7tTWKZ/fImRTPtpR+ey1mS0nsqeXJxJ5qahc/" +
+		"MytTPCsI3UHxrCxmZYpnBaE7KJ6VxfhF8azEO0zxrKB+RxTPFqNZFM+WI2eKrZlHoniG7B1RPFv48KJ4ttTcM/FBb1dkAUk8" +
+		"oni25A2WtO9S0r6qhpo2VCVt4IekalZwvoOqWVlEoamalcWfSdWsUOoYVM0K/eBB1axsf/XdStqe3MliEkdyH/7VJ+428mRy" +
+		"H47ULu5mdqdqVnZebPpkpewp/dpQ8q7FCEr6pxhB4SujT1Zk3Bz0yb4ztUiMoOTNixEUXuxDjKBYDdEu+0qpQWyt5LrpWZyJ" +
+		"zy/sRNpl33XOxOeXmjPTBu8Fny+P06BdVkp61p02aK3sh4V/lnZZKfm22P2Svpvshyz7I9kPxaGPZD98c+avDfGQHsl+mH9a" +
+		"9kMk7KBrVlC/g67Zd8x1KP+TjUfXrOBUB12z78yUqjO1D+WPFh50zb5jngzl3/hF6JqVho6ha/adqUUof4zuoGtWWkYUlD+q" +
+		"edA1+475DrIf0hkfdM2+kh4i+yGya9A1K6jcQdesIMIGXbPyY3v/NSJTPPvumPs3pdxR/PHNMS3nhTmSO/lNbWm5t4kiOM03" +
+		"R3InZ9RFEdwZ6WQ2uvlZKZ4V6jCD4lm5+T0pnpU74yWK4N5zXSKQuc6+oPz7CIM7/yLC4M6IhTC40/8RBndGCYTBnTEYYXBX" +
+		"LUIY3BmVEAZUnUbU0O6MzyPt850RBrf1ATW0cmcMlhHpzh8mI9LN4qKGVmhDDWpo5c5Ygz6485+iD242MjW0cmc2mmlfztQ+" +
+		"FhA1tHJnHJppnz9spn1pQ9qXGuhaZv5BJjwZWZEJD58XNbRCT3tQQytd5IsaWsm6mhpaGfnfkAlZZVNDK6jmQQ2tYIcHNbSS" +
+		"FTE1tILaHtTQSjz/1NC+Y9qHTBjWYtTQSlbZNM+KzAGD5lnJepzm2XfdrrQ7luu0iEeK5lmZe0pixuYDmmdl/gjkQfOsUDAd" +
+		"NM/KRAOUaDaYD+iafaVTya4hsVi6ZiUrd7pmX8mTiUnIbT+KmITMeoPmWZV/YNA8q2GdaJ5VbPSgeVaXJ3cRF+6pQVyYrUbX" +
+		"rIZCpGtW44mna1bDSNE1q0vehLhDbEO6ZhXRPeia1dCLdM1q6C26ZnXhmaBrVuN7p2tWF32QrlndzYV0zepuLKBrVve8JXEH" +
+		"6hxfSUy15Z5iqk9KYqrWdzTPqrwFg+ZZ3f9HXotBDa3aIzuoodX99h7sKZC9e1BDq7uVETW071iuE03Ol7WnYK+5TsvvXKfl" +
+		"YizU0GqsHGpodTeWUkOr+527aHnLmVpunUQN7Xu3eeq0XH2oBQrrgxpaxaQPamhfSR+404aUtMG6mhpa3Y1t1NBqMVpTQ6sl" +
+		"Xx21UPNlUQv01r+S/VLG4IJaqEYlSmk1qwVKaZXC7aCUVhuPHqW0bxnvOtTCme+AWjjzHVALZ96uHEhn3idq4cyXRi3QEh9U" +
+		"1GrWwFTUvjO1FrVw3rmnSPOdZxFpzrdFLWR9TEWtno8WoRbOUw2ohVN8iYra99Q5Uxvy3VEL1N4GFbWaNT4Vte9Z/EWohaz4" +
+		"qajVrPipqNWs46OidmY0Qy2cGc1QC+eZY9p3557ax6cdFbUzPXLkG/liI+1zl5H2eRZEw8k7TCvtK2nDSPv0LETDkxHZvsOH" +
+		"Z5AeWn3+7ilHUN4uouH5q0HuGCsCmmd1ZIxCNIz0CUTDyOyAaBiP+hANM98W0TDSJxANg+VGD60O1nRBNMQzURANg21NK63S" +
+		"Xhy00upc8mQiyBm7EQ2yZA9aaZUmxaCVVinkDVppdWRWwS1QVRv00OrImI9NGGz5gk0YGfOxCSOjLjZhzJTSBs+CTRiZqewt" +
+		"HCP1pQ3b/xiRUQsj8xtqgXr3oKJWh1UoFbU62PVU1Cr1wUFFrQ4rDipqlRbhqKEWeo7le+W6tPVRkuuHl5yKWluXXCe2/Oae" +
+		"v5jAuqYktuxtUlH7rutKIuJv2vA65szkMrLeqWIeqzVGTS4j64+aXEY8UNTXvrtUpeK63KU6lrvIZWS1FfW15c11aZ+2i3ms" +
+		"PF7U177SVMo+q5TspeKVobDWdjYkhbWv5L2Ia2RPAYW179iqJLZslI/C2m71GoW1+Mlrcien7clXtKRkLxXrtiZf0ZnrxJ3T" +
+		"2uQryptIviLevpp8RfkqyVdk1VZRC3t6FmrhSItQC0feNWphT+9BLez5fqiFPW1ALRzpIXIZHfkOchnt6UtyGR15E/iGUnPs" +
+		"UfLdo5iKdqDM1orIfZTZin+sYhgKnyhltlbyj2EY6pkz7Z8y5lcMQ80fh2Gg8/KVLnHoXCfnEh9GxSlQAvpKIuR77iJCvjsT" +
+		"p9CsFipOgVrMVxIh33NMdNmKruIUTivBilMI4VZxCqG3Kk7h5I2rOIXTiq4+iZ7rL/YdnkfqEz230q32HV75DvYdXtbSFd9w" +
+		"5avgGy4zeEUxXOyjimK4MvagGK70HhTDld7aE0H2dpEKtzhARSrcbKCKVHjylpAKz5EnG479jx0iFcPw5CtgGB6rtIphoB45" +
+		"KobhEZeqGIbM0RXD8OT/xjA85uFqZ+GTMcPOwtBvFd/wIAwqviGcXMU3xDtc8Q3PnmdJy1Oflq+pT8u3PJkodN4fvqGLxlR8" +
+		"Q2cPVXxDIhIV3zDybvENIz0X3zBSO75h7LmLKDRisOIbRvoVvsGe3FHxDUO8ruIbBq9yxTeMfEs7FEf6Mb5h5E/BN2R1XvEN" +
+		"b/rjm0iz2jEMWdnWqKJmnsQpyHr0lUS9M4LgFN7M0pRPF6vzRvk0u2OaPEDZ19Ion8qH8ZXshePbaZTM419vlMzXNSXqzHpk" +
+		"o2S+iv22w164vxouZw6l27E82eNYV+qO5TlFs5EsjZLSuqY+0WxRzUZJadV3m/2Kq/+hFdFsM1wr9pHpE81exs3f0exlpLjx" +
+		"leyFM0I2exm3Pfe0F67lmEiwv73Zy7jfuadI8J36RILPPKdIcEt9IsEt14kEs+WbvYx76rOXcTfyNHsZs4Js9jJSMfhKIsGi" +
+		"oc1exqx7G05BBvSvJNJ950yRblZ/wynsIpANp7Cz5RtOQY7B0XAKu77b0Ai71VDLXsYzx7SP9dKyl1EMt6ERslpv2cvIfmih" +
+		"EfgKWmgEPpoWGqHlOu37ZU38Stp35jrtu1O79rWcqX3/xZr/NSI3nELWzg2nUEvuIUb85EnEiK04Gk6hYkcaTqHyGTacQrX3" +
+		"ruEUKku+4RRq2ppdjlYjLbsc+c1adjlaJ7Xscnxypji3XUINp1CtrxoWofIkNbxB5u+GN8hKk1bsV/CceIMzfRxvcN65Tpw7" +
+		"fQBvcF4piXNbMTa8wXmnJM7Ng9LwBueVGsS5xfEb3uBMH8cbZCXd8AZnegveIOvjhjeIF6H1tC+ltM+bwBucfFANb3DxjTW8" +
+		"gR3do+ENLr6/hjewL3w0vMFtzm54gzvjF97gziiIN8huo4Y36HvuKY5vVml4g57/dCRWr76RWH2OJVaf68Tq+W/aSKxe7ViE" +
+		"kf6JRRjpWViEkX6NRRjpu1iEKdLdsAgTl9ewCHRevxLt5JH6XiVvgsq53EmjUTmXZ3w0KudLRvIpTyIWqE2x+iUlsfrMHDOx" +
+		"ek89ReTRhI06elaCjTp61oyNAnqo5vbS5+NTaLiBNf87bmAV1WmvGLzdKu03n18rT13DFKwiPg1TsIp8NUzBmtqRA6tYVEMO" +
+		"rG/u8iilvu4uuY4O95vnRA5o7XnYlWu/4nnYlWv1fIYqQASff1TBVPq1qLTc5deiwmt+ogrKmetQBXxlJ6qg8qaeqIJaU7sM" +
+		"lfxhJwV0mTrHSQE94+dJAf2/ieefI/L5sxCuROHOn4VwnTwt589CuGi/fSW8gVHiDG9w5UyxevPiGd6Af/sMb3DmOrF6M/0Z" +
+		"3uBMCW9gPDnDG5y5C97AnHmGN7Df4AxvcOU6vAE/5Bne4EwJb2BGO+1yXMX5Trscs/v6rNGfdV2NDrf3jkWInXFiEfY79xQD" +
+		"v3KmGLhR/sQiFGPNiUUo/P4nFqG03OXXvnrkTHH8O88ijm+cPbEIfz0Ji1BYOScWoaCLTnsZC5vntJex5DuEU8g7C6eQ9oVT" +
+		"MCae4RTyjcIpsBfOcAottWtfekg4hTu14xTybVti/Lnnr32hr0+cwokKPHEKJ4v5xCmcxvwTp3BuOVOc20rpxCmEBD9xCo3P" +
+		"5MQpZAV+4hSyD+rEKWQ/2IlTOFno553dwqlBNBuPeeIUTuumM5zClueU5dLcdOIUTrb1iVPIrrITpxDu/QynYBY7o9JoBX7i" +
+		"FG7W34lTyLr6xCncV44l2mtUwinc6Vk4hTv/LU5BxuevJNqbL41TuDNi4RTu9Gucws1GOHEKd3oBTuFmQ544hTvftiea7alx" +
+		"CqHLT5zCnZEIjXBn1EAj3Blf0Ag3m/xEI8hT/ZW0Lz0ZjXCnJ6MR7owhaIT4Kc7QCPnjQiNkJEIjPJkrRqLZOZZottqj5Dz+" +
+		"h9fixCk8vFonTuHpub+d0nxjJ06h57/BKXS20olTGHm30X+2Qj1xCiNzCk4hq/MTpzCOHBPnTq/GKcRvcOIUBhrgxCkMXNCJ" +
+		"UxglZ4p650/BKcSLcOIUBov5xCnEF3HiFMaeGsTxrR1OnIIMZF9J+/JP4RTGkZL25Q/DKci39pXSvtwl7dPHcQrykX2ltE+L" +
+		"cAoTo3HiFGbe4Bs1GvXhFN78NziFN38KTuEVtznFJ5JR5xSfWJ6URMutQE7xiew/O8UnsjvsfBMtz5P9ohArQuoShQgtf4lC" +
+		"rDzVlyjEitO+RCFW+zAuUYjlTak4c1cS42dVXaIQ8fVf9lUuuNTrSPx/VbrVkLs87pm7dKWhNJQWJfF//ufLvspEIS77KhMH" +
+		"uDAMG8/ShWHIyv3CMOwoggvDkBXxhWHYvc8Lw5C9aZd9ldkBdtlXubORL3yDDGRf6de+wyhx4Rv2N8dEwXueTBR8pnZRcL7+" +
+		"C9+wWw1d+IY93w/fEM//hW+gXTYufMMutnbVtE+L8A3Z4XaFb7CuuMI36D1X+Aarkyt8A6/5Fb6h51m0j4/tCt/Qc0/tMwpe" +
+		"4Rt67ql9VhJXKAarmisUg3XhhWIIk3ihGErNmYeS94liSGzhQjEU8fkLxVDYjReK4Uc2/mtEvvANsXkuDEMxh10YhkQdLgxD" +
+		"ohUXhiF2/YVhqDUlO+LNTBeGoYp6XxiGWPkXhkEGuXFhGGraimGoLfXJsbnkut8XotP2lcTH8/UwDFf+GwzDmT6AYbi21CA+" +
+		"brV1hWEw211hGKy2LgzDJZ51hWGwxr9kXjitmi4aT6cV1RW+wUrsCt+Q/h++IWMNvuHKSBC+If9U+IaZZ0n7Up/2pT/iGy6R" +
+		"qAvfcCFErvANfDsXvuFi9V/hG/K3h2+Q7eDCN1wZZ8M3ZEwM35AeEr5BFO4K35DxMnxDRpee7+ct4RuujNb4hkt08grfkBEr" +
+		"fEP+sDAM9tJfYRislq8wDCyNC8NAL3xcYRhGzsw30gcxDBev8iV38rWlpA0ZCcI37LkO38CCveROftgWl9zJj3n5CvuQ0Rr7" +
+		"8LD+LuzDw3d1YR8o630lGQBE/C/sw5PRBd8Q++gK35A3j2GY/OtXGIYjNfyekw73V3odUx9OYeJ9LpzCFPG55FeYaZ/8CrER" +
+		"LgzDxH9eGIbJi31hGKY1x4VhoMk6LjbCPFLDpaRFbIRZUoM25F2zEWZmWzbCtB652AgTM3sfadGppEVGz5uNMM0xNxth7ilp" +
+		"0ZbrtAi/e7MRZsuZWsT6vNkIUw+52Qhz+x+sxc16mHvur628RTfrYSK57iNtbUra2vKUvqWZ4mY9TCvwu6Tl7sJ6mMbgm/Uw" +
+		"2aw362H+8pGPm4Uw+bFunMKKPb3DKViv3uEUxABvnEL2Hd44hXXLmTiMvGl7M1cr2xvDkIjSjWFIFOfGMKx7Spva1YdhWPO9" +
+		"MAwrm/yu4TA8S/Sfj5QwDGkfTmHj1bpxCrSTx41T2M5ch1NY8yz2+C85Zo9/vmY4hbQBp7Cn7TiF7Ku8wynk24ZTSGvDKVhJ" +
+		"3ziF7Ii8cQp73mA4BauaG6eQrIw3TuHId8ApHFuO/dp3/D31cEyLcAoHa/qWh+EQw73lYTj4WW8Mw5HnxDAcaS2G4eDXvTEM" +
+		"R54aw3CsuU52Wj6TG8NwpNdhGI68F3kYkjftloehpA/iG0pNCWvBXrnxDUXmhRvfUOyYuvENxdx74xuKGed+wmh4zieMhhrw" +
+		"DcXMf+MbykgNGA3j+o1vqPxTN76h/tVO++rIXTAaR+rDaBy5C0bDiuDGNzQr1hvD0EZKMhWwem4Mw5nRDMNwZkzEMGT34o1h" +
+		"CK94YxiS8/LGMJw1NchU0HJPmQpQ9zdS4cwYjFQ4Mz4jFU4zzo1UuMQ4b6TCZR65kQpXy5k4jNSHVLiXHLPLn21xIxXuJXfB" +
+		"aCz9f4zIGIZ7yZPIDWCOvjEMd3oEhuHOV8cwPPkmI4yG3olhePJlMQwPP92NYXgQLzeG4cm3xDA85sUbw/CUXIfRSI/AMFC4" +
+		"/UqUy67cRaaAO9fJFMB/eYdh+LunjMH5b2RgHjiFO3xD5qbwDSIgd/gGq5gb3zDSW/ANQ76IW/6GZBO65W8Y6YHYh3hzbvkb" +
+		"4k+55W8YMlLcuIiRXiYDc/w3twzMyfxzv2E0vIk32QB+7+yRv+E13z3yN7ze4CNHA8Wu8WAfshPvwTfEG/AcyWV8KOEb+Kee" +
+		"8A36xBO+oaQGfIP38oRvOFIfvoH36AnfcKSEbzDDPfiGVY988A2J2zyhFvTkB5mwseIeZMKGBnhCJuitT8iEnjNF7kWUHmRC" +
+		"xu4nZAJ69wmZwOp/kAmHefIJmWDUfUIm+HOekAks2CdkgnXTg0w41tQgct9zTOS+pz6Re2uxJ2TCzHNqn4jSg0wITfiETDCu" +
+		"PyETeEmekAn8Bk/IBB6bJ2SCueIJmcA38IRMsI55QiZYQT4hE97UgEzgK3uQCceS0qWU+rRv5J6P95LauzNTQ76fN588CVaX" +
+		"T/Ik8MM8yZOAcHuSJ8GK50meBGuqJ3kSZs6sas8904aUfCMzzpM8CemRyZPAK/MkT8L7PzINPcmgkH8xGRT4DB/UwsHCe5JB" +
+		"AVH9JINC/lNEQ823RDTU3AXRUPMXIRpCVT6IhpqehGjIjP0kg0LeSjIopCclg0L6YzIo8Ew8yaCQd5QMCtakTzIopLXJoJB/" +
+		"KhkU8k1CNJhHnhANRuQnRAOr/wnRgCV5QjSkBkTDyT/7IBrOJ/dEbOT/RjScvG8PouF8Uh9iA0XwIBrOJzUgNqxlH0RD9mE8" +
+		"iIYr/yJFxTN/A0XFi+X0oB0uFMiDdkhE90E7JPPPg2i4jfkPoiH7Bx9EQ/YPPoiGu6SEyjDbPsiE7GV8ZEnIfsVHloTsH3xk" +
+		"SbgzysuSkD2JjywJd0Z5WRJ6Sen3nP3MXZqS2mVJ6PlisiT0vHlZEqj2fiW6lvl+vxn87CKljywJyUr3yJIw/mp4lVwnS8LI" +
+		"3yFLQkj+55WRXCzoeZElGSVeZEnGthdZkl7+Iksyj7zIkow8L7JkpHZkSUbyF1mS/im/4ui5Dlkyc09kyZszkSVGs456nOat" +
+		"jnqc/vCOepxGpY56nMb8jnqcb0pNKdfJXeGf7mb+yWvYzfzTP93N/JN/qh956jyL7A5WSh23sOw5U+4K65iOW8iugl4QG1Zt" +
+		"vSA2zO69YGXeXIeVeXMmBoWfsBesTN5LSZ54LSoYlP/+xn+NyL2gU1D2vaBT+Ch7QaeIuPSCTmGD94JO4XfpBZ1S04IXG6MF" +
+		"VcutDnrVcuuW/rMsrmQv6j/L4iv56j/L4kr2ov6zLL5jav9ZFte25szmTO/2Z1l8Z6b2S8n7+1kWXym1P+6SJ9O+vPca+saX" +
+		"/VkW33Wp4VXS2p9l8dXunf0si++Yezbt4zfrTfu2XKd9uIHetG/NXbQvb75pn5V7/1kWV+Gl7z/L4ipI1P6zLK5EBfrPsrgK" +
+		"nrU3LM6ZGgZqx3/zsyy+Y/rVz7K4sjOh37L8i2z3W5Z/I0+/Zflnn/RblpKeu8hS0nMdnih/2I0nenOXCxfkqX+WxdVajj2O" +
+		"ec6f/XAlT0H/2Q9Xdgz2GzWUP/pnP1w9o8TPfrj+RpCfjXDMJ8eQQea0jlrIntyOWlh6zkQGibJ01EKimh21kJ29HbVACfgr" +
+		"IYPyXlALUUXpqIXsTu6ohcQjO2ohe5U7aiG7jDtqIblAO2ohcdqOWljSvp72qR21sBqRO2pha6mBAkDLmeiYfD9kQhmpD/0j" +
+		"etGRCYk3dWRC+Xsy9E/eCzIhnpeOTEjGyI5MKFZfHZmQ3JIdf1Ay6uIPkluy4w8S3+r4g7//AX+QvTkdf1DMWx1/UGauS/u8" +
+		"s5H2eRP4g/LmzLQvNWif2a/jD5JhuuMPmghMxx/8h6X9e0RGJsTT0pEJZ0ZWZEKyRndkQnbf9BlmKE+CGao5EzPEhuzIhOSC" +
+		"7siEs+QumKGSu2CGaq7DDNlz3GeYIdchE04eho5MiM+pIxMSUerIhJvV0ZEJd74zMuFO30Em3On/yIQ7/VgGhTt/gwwKN1up" +
+		"y6Bw55+SQeFOb5FB4c6XlUHhflIfpsbfPmRQuFm+QwaFW18dMijcevWQQeH2tw8ZFO4nd0mLhlJadCmFgso9qQqMXEc5gGd8" +
+		"yKDQ+ZiHDArD2x0yKMS3M2RQyN6VIYPC8P0GWjJ6NAMtOYy6Ay2ZzM0DLZncyQMtGZ/QQEsmp/RAS2bP6kBLTv1zoCXnkXvS" +
+		"lf17skfJe0FLJoY00JKz56mnUp7zVfKW0JJTfxloyalnDbTkaw0w0JIvGm2gJV+c40h2hTfX/aI/65Z7/qI/K9/cQBise2pH" +
+		"iPBiD4RB4iMDYbAeORPzsqV2hEjeEsJg5ScfCIM1T40w2LaUsLz5KgiDjf9mIAxiLQ2EQSyNkTwJeZ/Jk2AmHsmTYNYc8iTE" +
+		"RzPkSYjfZ2AM4tEYGIPj78mmkvowBkd6K8bgOFLa3VPbMQaHqO3AGByIm4ExOKzvBsbgwI4NHEH2eA4cwcH+GziCxEDGnefM" +
+		"PT2niOfAERz7/2AtBsLgyNdDGBys1IEwSBxlIAyOfD2EwZFvgjA42IkDYZB9qSO6DzPHTvfMsct1+hXCIJGagTA4lhxDgdQ8" +
+		"JwpEfGI8oUB8BYRBcjQOhEFlNQ6EQaIcA2GQLIkDYZBsjqOHENF2hEEyNg6EQfIpDoRBZVkMhEFL30EYXDXPgjCwdhghDPZc" +
+		"hzDIeIIwuDIOIQyujIkyKFw8pEMGhSv/MPrg4tMe6IMrvQx9cCH4RugD2ZIG+uAqObOqL3fRhj01aENG1pE2ePMIg8usOUba" +
+		"kOtQEhlLRygQbUcYXNaWY6YNngVhcKXXIQyu9ALZFSgcjiG7wmWH1EAfXCU1ICjyb8iukCjOkF2BouJX0j4+5oFMuDJ2z7TP" +
+		"O5NdIRm0huwKyTc9UAvJIj3etM+bRy0k3/RALVz5/1ALl/XPQC1cGI0RVYiMulGFyHePKkTGoahC5EtHFYJPaEQVIm8CtfBc" +
+		"qe+/9r23vAUzyg/WFTP0gTNn6APz+Qx9wO6YoQ+wvDP0Adtihj64cgxHcOcYjoDVM8MRPKkdR9BTH46AVTzDEVjjzHAEVhkz" +
+		"HIEI0wxHYCUxwxGwgSYKkb78V9KGnntqA6Z0ohAnu3uiEOeTZ8FJPKlP+6wyJgrxt6PoXyPyxCdmj9LEJ84rz6zlxr2JT5yo" +
+		"/olPnFYHE5847SmY+MQpmjZrWq4FNS33lPjEab0za77erZSv542xHqKyNFkP885dZIE4cia6ouRZ0BUlbUBX1FyHruBBmciE" +
+		"VV+dyISVLT+RCfG8TGTC2lJCVxhdJjJhNSZOZEJ8OxOZsB65Dj1Sch16xD81kQmrkWAiE9aW50z7vM+W9uWY9olHTmTCasSa" +
+		"yIREzCYyITuyZhQijCczChH5tlGIMKfNKETYATOjEGFOm1GISA+JQsSe62S5SNujEGHcm1GI2PMs6JEj90SPsHVnFCKszCYy" +
+		"4Uj70AdHvjT64DAmTvTBYf6Z6IPYFlMGhcNcOGVQOPKc6IPDKDjRB0feIPrgSD9DHxwiXxN9EL28KbvCIeYyZVc4+MNmNCLW" +
+		"lBAUSKQZjQjM0mQHXLxHMxoRMzUgKJaUEBRLzpSN481dZOP4exaEiPXkRC2UjMGohYJznKiFyp6dqIXCQzpRC4U/eI4QIlqE" +
+		"Wig8xxO1UNJ21ELsnIlaqE/OTBaPnCmLR8mxR8k3wh+0ktoRIunX+INWUwNCxOprRp853w9/0NgkE3/QROQn/qBhuGc0Is7c" +
+		"BT1i/+7EH5xX7imLR+Ym/MF5/o8dIhOZkJ3EE32QPcAz+s/pA9F/Tm+J/nPeZvSfeRdn9J/li5jRfzbvz6hH4Jhn1CPsqJtR" +
+		"j7CnZkb/OfVFPUK0dSIMklt7Rj2CD3ZGPcLaZEY9YsuZNJ5FTd9oPLPX32g863Mv9Yj7zpm70qZ0IFKmUsGg5C6YEFbxG/UI" +
+		"XqA36hG+8yuT0tNyTN4OfeeVSSlZLl+ZlB7W5iuT0tPyZJgX9vMrk9Jj7H5lUnrMB69MSo+Z45VJ6WFRvjIpPWaqVyalbt5/" +
+		"ZVLqvCSvTErdevyNesSZe2JCztwTE3LmWTAhRvkXoRgtqhehOLB+L0IxelovQnEY5V+EYnZRvwjFqDq9CMWoT70IxXGmhHnx" +
+		"p7wIxcTkXoRi9mm/CMWocr0IxTdfTJalt6Z2uUBqapALhB31sgOyN+dlB7x2db4YhuyNfjEMicC8GIbYCC+GYW25TvYPNvmL" +
+		"YVjTXzAMKw/fi2GI/fBiGGI/vDwF7a92dAzv0YthWNMnMAxrTe3oGKuTF8OQuf690wb3jFK0OeaNUrQ59I1S9N91MnyYGV8M" +
+		"Q9SnXgzDZg3wYhii3vpiGI49z4LDMCK/SIUjbUAqHC21IxXOXIejSY9EKhz5x5AKmc9fpEJm8BepcKSXIxWOvCWkQnwYL1Ih" +
+		"M/iLVPgvdfY/R+QXw3CYU14Mw2GmeDEMR8YMDMORkQDDcJTU9mt5WfJcWp7vjGGIT+bFMBzsoRfDcFivvhiGI/8ihiEeoRfD" +
+		"cGQ86Wm5r9fTcm8TwxCi9MUwZC/Oi2E42J4vhiEemxfDEOvoxTCEbn0xDEfJmdqXca+nfWrAMMTiejEMhxXxi2GIFfdiGI70" +
+		"cRkbDt63V8aGI+MQvuFgUb74hsOK4MU3HHyNL74hPrUX33DwBL4j3y/31L78GzI2hAF+ZWw4Mi7gImILvjM9111meq4zcRGN" +
+		"t+rFRbQl12FsWGovLiL7pl9cRBXHf3ER1Yr4xUVUNtaLiwgj++IiKivuxUW0JU/WlXwVXET2F724iPqmhtcx98RFNDHVFxeR" +
+		"vUAvLuLkR36xDyc+68U+nCPHcDSooRf7kF08L/Yhu2pe7MOV2Qj7cF25S0e56CHYh2vmTBzNj6ibC/bh+lnoc8E+0Nr6SsiZ" +
+		"XwRrLkfIma50OFaVfi26W0qomjMlVM1vDp0LLuKuqS+5TnIMVfObR+aCi7jP1C7XyZmnplnyYxK/0nRsV0LjnGpARN7NdYhI" +
+		"O8G/kjwouxYhIvvvD/9Kh1LORONU9cn41GvuicapWivjU0/bsZO9pwZUzZUnC1XjGAaS3fGV0DGX74Bz/M31/xiR54KAFCuZ" +
+		"CwJy5FsiIMdMbbiZ15MgIMePcflKHVOT63BBv8joXBCQ8m9+JS1I/0BAjunrsR7k+5wL62FM75b1IH/pV/q96XfxxlgP89Uj" +
+		"WA/v4jlZD+/iyVgP7+KbsBDeJfV1pdSHoik5FopGDViE5fAsWITl0FoswrL7JlgEe3K/UkiZHJOtZck9ZWtZPTUWwS7jr4SH" +
+		"WVNfeBjPeYeH8R3wBtvPZ/iVMCFpA95g2z3LgwnZ9dUHE5L++GBCdl/swYSkHz+YkKK+BxOSv+gJ85JjmJf8DQ/m5UgNmJfq" +
+		"TTyYl6qf/eb6iwdlLr+5/tpqnlr7qv7yaF91Zk/71NC1L2NG177mLj3tyzHtK95u176qRV37Sq7TvuZ99rRPa7v2lVynfYcW" +
+		"de0reTLt23OXgYdJG6ZSrnuV1PCb67+S7z4wLxkXBubl8l5GmJdch3l5fYcR5sWbH2Fe9Jchh87rS48wL1o05MlJ20ey4Xjq" +
+		"37x8yXY1F1mW7EydiyxL5+vvkGXpunPd7zmvxz1lWboe71qWpevxLLIs3benlmXpvr0JWZaektKDsdFaqk595rqh5C3JwDTP" +
+		"1PeicZyJHFgyUyEHMPhzQQ5QVpoLcmCZ3iByQH7fuSAHZN2fC3LglxvqnyMypkBW/7lgCpbMvJgCurxzwRQsGZ8xBUtGckyB" +
+		"vFRfCdGTMRhTQIlqrpiC9ee3nituYDN2r7iBzRi84gbsP5srbmD31VfcQP1FEr/S76nrz0/xlX5PXZeceTu2KWFc3hzrznyV" +
+		"hjNzbDq2Kr2O/Vq0YgqqkXzFFBQ9fsUU1N+aba6YgvqzE+eKKShmjhVTwGs3V0yBrHRfCcnSPAumQB76r4RkubxBTMFpNl8x" +
+		"BSfLYsUU0GKbK6ZABvm5YgrkYp8rpuBkdayYAp6yuWIK5LabK6ZABrm5YgpOVtWKKTjZQ6sMTOfpOWVg4ruaqwxM/G1zlYGJ" +
+		"v22uMjCdb2q4ZdTJdUgWFt4qA9M9cyaSZeoFMjDdIyXZYfynqwxM90gJ1+KvXWVgutMHZWC6/WGrDEw3a2KVgelOv5aB6R65" +
+		"DtfiX1llYLqH55SB6R6pD6kzfHcZmG7/9yoDE+2yrzTotfgqMjA9qV0GJorrX+nXhl5TwrXkq8jAxJf0lXAtzbPIwDTSl2Rg" +
+		"GukTMjCN9CwZmEaeRQamceZMeV7Sk2VgGukFd7gdb0IGppFeLgNT7MtVBiZ5/b5SuB1vAq9oZ/FXotCSEQWvSJHvK1FoMXqu" +
+		"eMVpplrxijN9Ca8401/wijNfBa8okviVsDnG5xWv+B4pUW85PBle8WUxrHjF/1wZ/xyRV7wBMvoryfmyaR3eQKzrK8n5wjpa" +
+		"xRnWlmNNKU+C2jGfrpiCtaYG1E56BKZgzSiBKRCT+0qonfQITAFP2VdC7bDbVkzBuueYNtRcpw1spXWkDfrcCLXjvctosGWU" +
+		"l9Fg23LPMCEpYUIOfQdvcPw9GRqm5BgaJu3DGxwZvzAFR/4GTMFhBbdiCo6aM3Etd0q4FrbZiik48r9hCo58I0zBUbx5TAGP" +
+		"zVwxBUd6NaaAn2KuM21IKW3QrzAFfHhfCdGTb4spODJ6Ygp4wL5SctNoH6bgyB+NKSjpPZiCcqsdU0CJdK6Ygvp3TOYT9uX6" +
+		"JvNJauAvzVyIKaAz/pUwE0/uiZl4vF2ZEK5fPH5ueINLfZtMCJc/epMJ4fpFS74SSuLJmSgJ9sOGRbielETozHAbFuG6Uh8K" +
+		"5EwNKJCf/3lu8h1cRqwNp3DduS4tynVaxBLdcArXkxIK5Md/zg2ncD3uiVO47hzTvpFS2qc+nML1i8F/Je27UtK+rj6cwvWL" +
+		"5H8l7btSSvtynfb50htO4bI+2HAK8sN+Je2zItjQCBd7ZStp3+9LbzXtK0ppX0ra130/NII9yF/pUPJeaiiXRwmfwmLYUIgZ" +
+		"5TcUoh0wc0Mh2uUyNxTiu/ybR54bPvHd3AOfOK1iNnziu2u5DEjz9Vz4xNcKdcMnvos3hk98jxy7nJm7/N77u6aGRw25Z3fM" +
+		"28QgTrbLhkF8F28Mg0hz7Ct5Tn/0duc5PQsG0Q6ir+Q5N/e885y+JQbx3VM6lfxF8hy9u7eET7S76Ctpw6J9+MR3SX1DDXmy" +
+		"tCF3QbJYJ20oRPuXvlLapw0oxJf1vj1pX0q+A+/DhkJ8V38DCvFdUoP2rZ4MhfguvsOTb6TvoA9WNvKGPljT49EH65knQ3qc" +
+		"OYb0OLUPfbCaKzb0wcoO3tAHK4t5Qx+sbLMNfbCyxjb0wZq/D30gMvSVUCBmla2HAsmZoUA8C/pgy0iAPtjzVXooEG8JfUAP" +
+		"/SuhQHgfNvTBUdMidEV6HfrgYKFs6IPMrxv64GChbOgDsaCvhBCpvgr6oPCxbQgDeVC/0q8NJd9B9oPKr7WhD2q+Jvogq8QN" +
+		"fZAV5IY+qGueE0GRvwp9UHnHNvSBjORfCX2QrxL6gHW7oQ9avjv6oOW7ow9a2oc+aOkv6IPGhtwwBq3lTDoo/GGb7Af2on6l" +
+		"8Ad5avzBk6fGH/BvbOEPbm0If5Aawh/wfWzhDzID4A/OjN34gzPzMv4gPpoNfyCP+ldCV2ROk/3ATtiv9Cj9Dxt5QyZc6bnI" +
+		"hOvKHafS763syITMTDsy4bIa2ZEJ1y9CPXdkQmatPWQC+3IPmWAu2pEJ8p/PHZlw/yJtc0cm3FtqkKfjx2XPHZlw3zmWjBtT" +
+		"CX3w92ToA565PfSB9dUe+oC1soc+yFOHPmAB7eiDh32yow8ol30ldAX/3o4+eFg5O/rg0Vd39MHjC+38DZsox44+kPXrK6Er" +
+		"7hxDV+jjO/qgP6mPKgoLaEcfdNbYjj6wW/groQ9YXHvogycl9AHLYg99cPvuoQ/u3AVd0b0z9AGdtq+EruhaK3fSuLwzZMJg" +
+		"S+zIhMFS25EJg7WyoxAHC29HIco1/5W0IV+lpQ1ai0IcV65LG3Jm2qB2FOK4c09tYJvtLW3IsV8bMtfvSMOXX2RHGr58Efsd" +
+		"gkKL0Af0yeeOPlisVXb0Qea0HX2Q2WhHH6zGoR19kJlxRx+s6YPogzV9EH2w8kHt6IPNGLyHPsh/G/ogPSv0Qdoe+iBvMPRB" +
+		"ek/og3y/0AfpL6EPjFF76IO869AHxr099EG+ZugDY+kexiA9K4xB19owBumDYQyulLTvyXXaJ6K1hzFIHwxjkD8gjEH+ozAG" +
+		"RtY9jIFxcA9j0HNdYvBaG8Ygf38Yg/SsMAbpyRiD/4TC/zki76EPhh4R+iBjRuiDJ8e0vOf++bJaHvpg5Jm13HpnD33AC7OH" +
+		"PuBv20MfWOvtoQ8yQqIPqIbOHX1AH2zu6IO2pdSUPDX6oNXUkOh8aridqbegD9qakuj8lvpE51fvFn3Q1txTdJ6NtaMPGr/P" +
+		"jj5oPD176INdDaEPRLd29IG9JHMPfSDes8+0T5+baZ9+hT5oe+rTvjXXad+e67Rvz7NoHzt/D31wuC6Ewe4NIgxaRheEQVvd" +
+		"U+aFljlU5oXG4tpDH7x6QeiDmRLC4M1dZOqw8tsRBmdmVISBPZdzRxgkTrQjDE7rtANhYNfJPBAGcqvOA2FgT/U8Qhj4M48Q" +
+		"BsahA2FwGYcOhEFWywfC4BLnOBAG1989L6VTCWFw5xjC4Mp1IQxyDGHAejn+CIPUjjAw4xwIgy5KfIQi4Ok8QhH4G49QBP6/" +
+		"oySqn7vIHmH9eqAIphjIQTdqvLmL7BFL6hO7f1OSPYI1fcjOJAPmPNAHc0nt1FRE3Q/ZmaZ14VETu/d2kQnUTb+SDBj8TEdN" +
+		"fD5nJj7vWeRJkLPxKyXu7bvLkyBH5DzkSZDBcR7Jk2AFeSRPwpp7ygOhlx/yJKz+gEOeBCpg85AnQT7HeciTEN/qkX2OVkOH" +
+		"PAkrX/ghT8LPs/uvEflIBgX2+ZEMCneeRDSZ3/OQQWG78iSiyX9PIppsfD5kUNiu3EW0/M6ZouVm+gO1sLFkDtTCZu47UAtb" +
+		"+jhqYUvrUAtZoR6oBVqg87jTBt8LtbD52w/Ugj0M80AtbGzPA7WQde4hg8J255g2tDx12uBfDNGQ/ztEAyv8CNHAAjpCNLBr" +
+		"jhAN+WtDNJi/jxAN1lfHH9GQY2lfrtO+jDUhGvLvh2jYcoziTMYaREP9e2pZLvJnIhpa0T5EQ0vbEQ0t3x3RkJXmgWjISvNA" +
+		"NGSFeiAaspY9EA2N1+JANDSrhQPRkPXxgWho+baIhtZTHxqg58l+7Tut4w/UwpWRB7Vw5d9HLVwZQX42wvU3Wv9shK/kGyEa" +
+		"rq5FiIb4Uo8RUkAbRkgB7wXREG/mgWiIt/ZANFw9tf/aYH/+PH42wnXP1NeVnIloeIo3gVroPJYHamGm98h3sORrynewZvSU" +
+		"72A1ox7yHVAA+Eri82a/Q06DJaNn6IOMz+iDNeMzwmDjLz0QBpso/4EwqOifA0dQ2QEHjqDyCR04gspiOHAE9cg9o6mT+kTk" +
+		"0U0HjqCycw4cQdV3C46g/tjer7Q6c1ESg2fjFYxBNfsVjEFlZRWMQWVXFYxB3VNDGIPUoEV7Spe8FimJwd//zv32nSc6f+VJ" +
+		"ROf1j4I+iP+koA+oxH8l0XkWeUEfxLdS0AfxwhT0gV0nX0l0/s11otd/JdFrhFtBH9z6QEEf3Oyvgj649Z2CPnj0ucIbcOtl" +
+		"BX3w+DML+uDmYy7og2fJk4leL6nhVdIG9MGDyijog84TWNAHw8ha0AfjyjGR7SvXiWyzjgr6YNwpiWzzEBX0wbhSu8h2vgP6" +
+		"YBgzCvoga+6CPhjmtII+GMbggj6YNc8pY4N1dUEfyML9lWRssMou8RTgDQr6YFrjFPQBHbqvJOqNii3og9d/U9AHL09gke0g" +
+		"q+wi0pC1emmJC+c6ceH0SJGG9clzigvnDYo0xBtQRBpW9kMRaVjzdkUa1vRdkYaV57GINKz5YiINa76YSMPKtigiDWve/J02" +
+		"5C7aIDJb7rQhtXcx45wpbsrPWpIJAaVXkgmBrVuS7yC9IPkO+MNK8h2k1yXfQXpI8h2wwkvyHeTNJ98B+6GINBxnrhMXzv+e" +
+		"fAd5L8l3UP1jyXeQbyTfQdbZJfkOWtog30FLfa+SLybfgQxasySngZhcSU6DfPfkNLhzndhvelZyGvDGFTkNar6YnAY13x1/" +
+		"cC+556PkOfEH9pzNkpwGM88ikpjxBX9gP9osyWlgbirJaYBcK/iD+z/r/Z8jcrIdZExMtgMrv5JsB2ifgkyww20WZMLN5i/R" +
+		"WjCfFmQCPcxZkgnhzV3EUGfOTMvzzFqeUReZcM2UtDzzm0wIt0hGSSYE9GBJJgQr24JasFd/lmRCsCIuyYQwc6b2Wd0V1IK8" +
+		"0V/p9JypL+3zFWa+rDeYTAiZK1AL8X4X1MKdMT+ZEDL/zHxZZ6IWHvGlglp4MsOhFp78KaiFJ6MSauG5c5df+3pmHNRCTw9M" +
+		"JoR8d9RCz7yFWniPXCcWmzagFl5WcUEtvKz3glp4e84UxzSaVdTCa8SqqIXX6FlRC6+RoKIW3prrRC7RyRW18BqHKmrhZQFV" +
+		"1MJr/KqohbelpEU1NWjRmRq0qOSeWtRyTItKSlqEQamohfe3M3tW1MJrXVhRC68ZoKIWXrNfRS2EkK+ohZdvrqIW7AqcFbXw" +
+		"tlynfTX1ad+Vu2hfSX1pX+6ifWbiWtI+7xO1QK38K2lfzV20Dw9TUQsvgqmiFl6jZ42CgzGxRsHBuFej4HDlTDkGjKxVhGK9" +
+		"U5Jj4EoNcgwYdasIxWp8riIUKyuyJofCnWMiz6yQmhwK1vw1ORTMoTU5FIzWNTkUzGI1ORSsOWpyKJhRq+jFZsapohdZ19eo" +
+		"O5Qco+6Q3iMm8V9Q6J8jcpUzYcduVTkT9vQPkYw9PV4kY0czVTkT9vw3UXPwZ9YoNlir1yg2WIXWKDakl0WxIX9YFBt4A2oU" +
+		"G8RzaxQbWmq3H3/JmfbjL6ndfnxjRpUXoRo965NosmPyIlS+4kqxISRxpdgQOrnKmVB5rqqcCdVqpMqZUHmxaxQbzFsVp1DT" +
+		"H6PRkFEpGg1slxqNhoxR0WjIm8ApnFbnFadwWi1XnMLJn1hxCnaHfSUxYmxmjX6DFX/FKVA2+kpixD310W8Q3ao9MWKtxSLE" +
+		"B1uxCKHDak8kOLW/jukhI3FhT816eDJeYhHkVZ4Vi3Dn/8YiyHT3lcSF82/IhPCwh6pMCHQmZ8UpPHiwilN4rOEqTuHJm8Ap" +
+		"PBlBcArPk2cRU0V51ag0ZFzAKXRxzIpT6HmDOIXs8qo4hZ4nwykMdkfFKYyRY+KRuOmKU8j+qYpTkNl4VpzCsB6pOIX4dStO" +
+		"Yby5i5gqe6VGpeFNSUz1zV3sWOeXr1FpYDvVqDRgoytO4W++wym8ebs4hTejLk5B3rtZcQrvTKkqaTtO4U3bcQrvzD0vpdQn" +
+		"3sqDX0UhFnvvqijEknlLFGJlvVRRiKx/mihExu4mCrGam5ooRFZfTRRitQpuohChf5oohEy5sx3ZF54a7As3TzZRiN9+rX+N" +
+		"yE18IruSmvhE9iE18YmNpdbEJwpvdxOfKLi1Jj5RljzJq+Se4hMF9dvsgCw89s0OyLLk2K40lH4tL/zwTeyi8Fw1sYvCCm9i" +
+		"F8Xo2cQuqO3MJnZRtpRELt/c5XHPU0n7ltxF5JIF28QuypbrRC5Z/U3soli3NLGLw//WoiyhzzWxi8P6v1GWONjrzY7Lsuae" +
+		"YrZ6Z4uyhPhSs6+ypA/U7Pj3Pu2rLGaHZl+lLDaz1Xy/nJl976lPZPbNmb6feavZV3n4h5t9lUW0sNlXWbYcE3f2R7eWNvh+" +
+		"9k4e/EWtpQ2es+Ub5Tpt2FPKN/IG7assa+6iDVtqsDeajdCwD/LPfCURSPZewzc0o1nDN2RvWsM3xIfe8A2y0XwlUVTWS8M3" +
+		"yHfzlURRa+4pisqWafiGlv8I39Aw4w3fIKfNbPiGxhJtT9rgTeAbWv5NfEPjVW74hlZyF+2rOaZ91gcN35A4QHsSBc89076c" +
+		"qX0I8oZvaBlr8A2JAzR8Q2s5U/vyHZ60z5vvaZ/v96cD4VnwDRc/YcM3XPkb8Q20Hr+SWGz6BDaBhuJXsis9fwc2gZrkV7Ir" +
+		"PWMPNuHK2BN1h4zB2ISQ2S3qDsb1FnUHs0qLukP6fNQdzCMNm3Czlho24Zd9958jcjQhUIctmhAjddu9zsZqyATZfb+S+LEZ" +
+		"uyETbhZJQybI7vuVxI/ZEi16EXbYtOhFiJy06EWIjrToRYiHtOhFYMRb9CL42lv0Io6cGb0I7yF6ETO104sYOUYv4u+edq/P" +
+		"PBm9iJFnGUqpYTpT26MXwU5s0YtgJ7boRbAoW/Qi2IkNtTAzDqEWJiu8oRYmy76hFmbmQjkTZkZWORNm5gNEw1xTg9h5eidb" +
+		"YqZ3Ri/C2HZGE0KPP6MJYXY4owlh9DyjCWH34hlNCHPhyV6YvG8ne2FaSZzshXfLmXazb6nv99TvXw23Uq6z091bOlkF75ba" +
+		"aUIYS0+aEAsf7EkTYjEqnTQhYjmd0YTgGzhpQsgmPM9oQmw5JqqPgDmj7mD+OaPu4DucUXDgkT2j0iBWeUalQYzzjErDSEkM" +
+		"t6ckhqtHnlFiwDqdUWLwf59RYmDdnlFiGDkmRu0fOxEG2Rd7Igw2/+0ZJQb9+owSA8v+jBKDGfWMEoNY5RklBv/KGSUGK4kz" +
+		"Sgw9x9I+bykcAVv3DEdg7XeGI8CSnOEIrNPOcAR2KJ7hCEbumfZpUTgCY9YZjsB4doYjsMY5wxGYl89wBDxuZzgCK7MTR1Cs" +
+		"1k8cQblTakr6C46AcvQ8cQTFauHEEdT737nf5okwqOaGE2FQ/1pgL/uSFojAsyhPhEFjY50Ig9gEJ8KgpXc+icB7yicReHdB" +
+		"GLT0ToRBS59DGJxrrmtKvh7C4My/gTA4WeEnwuDcUoMIfP5ohMFpxDoRBidL+0QYhEI/n0Tn3QVhEHbrRBhk5j0RBpm/TxQB" +
+		"LeN5ogiS1+XsicBrA4oge5tOpED2g51yH2Tn2Cn3wYVQPEMR5H8LRWBFfIYiyP8WiiB/GIogK/4TRZB9vieKIHuHTxRB9g6f" +
+		"KILbiuBEEdwZCVAEcvZ+pUsp18k3kFEQRSDT3TxRBDLWfaXhzNxlOpbSq+R9/ubz68moG/pAfOmUT0Geva+0K2nfH5mQUlHy" +
+		"NeVT6NYHp3wKnX/9lE+hW/Gc8imMJaVbKc8in4I1wJl8CtZpp3wKI31JPoWRv0o+hSFecb60TsQ4z998fs38jYiGUF4nomHN" +
+		"zIhoWDMXIhpCcp2IhmRMORENa0YzRMO+p/ZflHjPGBWiIe86RENNCdHAtr5CNJgZrxANvuYVoqHmGKLBiuAK0cBnf4VoKLkn" +
+		"osGq5kI0NG2/EA1hUS9EQzxuV4iGJzXgFvxH1x+38CrhFth/V7iFnmfBLSDdr3ALRusLt/B41xdu4WHZXLiFJ0+GW3hQcxdu" +
+		"4Vn/x4h8IRoeX/ZCNDwy41yIhmfNkyAajHQXouHx1a8QDVuu6455tyEazLUXomHcaev/FXY3SdPjynWA516FduAq/jPCE/wQ" +
+		"M3sPCunaoYFlxbW9f7PxnBrIDncPut8PVSwSIEEg85zMPHLZsbS3iIZE7t8iGpLRcCeiwf52J6LB7nMnosHOdCeiAWJ//yIa" +
+		"9DoRDVbdOxENMK77F9Ggn4losEveohaezLJf1EKOFLXABrkTtWDHvhO1wCa41Ux4WBZ3ohZY77eohSFT8xa1MDIjzjD++nmG" +
+		"8c9ZMP53roDxbzknxj9jSNRC5lWiFsTj34laaLmeqIXM1UQtZB4naoFPdSdqASd3R6PBfnBHo8E+ckejwd57R6Oh5ZxY/bw3" +
+		"oha2vFPRb7jyHVafT3VHvwEGeydqYeQ7UQs9v8Pqi3S6a1h9Vxe1EAvoFrWws1BuUQtH3vYaxt88E7VwsG5vkQlHRiQy4cgY" +
+		"RCYcvMtbZMKRuysy4cidF5mQOPtbZEKxXt4iE0rmoMiEIpLlpsRQ7H63ygiFZX+LWij2rVvUQuFd3qIWCrTjFrVQsl6KWkgl" +
+		"uFvUQoHY3KIWaCE+d6IWsjIkaoF1didqYc3vcNty8G9RC2XLWTI+vxO1UOyvN96hwG/uRC1AUG6xCWXPkcaXd1NsQoGS3IlN" +
+		"OHIW4xPXf/eMz31JbALM6xabUHC/t/iDsuW7Bwef74aWvjzh5x35hJ8//2JFFn1QM+dEH1SxkvcTdj7nP7XMlifsfK6G683a" +
+		"JvpgsNtu0Qej5He43t8VcL1ZeUQfDHbiLfpgpGeiD0bumOiDIefrFn0wMv9FH4w7R+KyeVS36IOR1Qyz8Mn4RB+oafvcog9G" +
+		"VvlEH+QtSvRBdpVEH2QHSPRB3sxEH2Q1S/SB1awk+sC7XxJ9YFUqiT7gC5VEH7CtS6IP7Bwl0Qc1Z8HO26mKHMisz0UO5Lfl" +
+		"yLDXOQv2+tcz7LW1tMiB/Lb0BUONESxyIL/ubpED+fVsixzIrztR5EB+PaMiBzL1psoeBr5pYeDtHEUu4+qdKvIV1yNHqmYP" +
+		"6SzyFVdYRDnCPOvZkTxmfRENkJyvIhogUWVFNECytYpogE28SBENsN25HlYaIlVEAwTvLqIB9ivnxO8uubocZ9FvRTTAseYs" +
+		"cpzXfIeVto8U0QCHFaSIBkj0bhENcFiDi2iAAxJfRANkPyiiAegQP0U0wJk7IZfxtPMXuYxnxieX8bxzdTnOd86Jo859EQFw" +
+		"4sGKCIATD1ZEAFxQkkI14XpyJB6ahVJEB1yYlFLCQz9aeGhR20V0gDqoTxEBcD25Aq4ZHlyw/EUOfsHy09t9Cpa/bPqJ5aeV" +
+		"9pQarjlHhmvWzxqu2V3C8tNmewqWv6bXFdf8/EWGSKlY6CfnwELnjokUqD3XxkLnjokUqHDWIlKgPumzzGUeXBEpUPMOixSo" +
+		"udMiBar4uiJSoPLxi0iBypMuIgVq3mGRAhVuVkQKtJ6zXFq53q1lfCIFgpAWkQIPr6KIFAgKWkQKPJiFIhog6GkRDfBgCIpo" +
+		"gGCpRTRAcN0iGiBYahEN8MggKqIBnrzDPWy5EYkGCAJcRAMkl6r0jMH4esaQ6xnDku/mGFJVo2D8B06nPGHEjR3jP/gxBUr/" +
+		"WXLkoeVpQuk/WROh9N/fWWT2ir0rUPqvPa1A6b81Z1EbPbP6CSPuTkDpEyFVoPRfu1+B0sfqL1D6RF0VKP03bwOUPvFLBUr/" +
+		"HTkSr8h2KbD3fdMX2PuedR32vmMoCux9x50XPP6Oyyt4/B0jWOHyu1jNCpffWVUVLr+z/ipcfmcnVrj8jhWrcPl9y5HY7D1H" +
+		"YrOtIBUuv8MGKlx+ZwdXuPzOuq1w+f3MWYxoy5EZUVpGBC+qYevZgjVsPZu8hq1ne9aw9fymGraeZV/D1rPJa9h6FmUNW3/k" +
+		"CsZ35CyqZGd82Prjd6Qq2bmf2PrsFRVbn3imiq3P7lCx9eedc2Ig7b0VWx9stWLrk7NUsfUnr7Ri6887R4ZBvrUwrCLQKrb+" +
+		"LH+xIlc8fqqEVFx9dsmKq08uVcXVn3daGZ07dmR0RcvoeJoVV3/DMCqu/oYAV1z9bTevuPobrltx9TfroeLqb6tZxdXfS66A" +
+		"beXrVVw95bK3hW3FKFVc/f3Nd9jWkSvgI7EHFVefyotVnYJgqRVXn3qKFVdfrZAVV1+etMKNuh6uvoxcQd4tH6Di6lOxseLq" +
+		"C0Sv4upTo7Hi6pNVU3H1xVpacfUFflnx8U3MasXHJ8ur4uOb2KaKj2+5E/j4ln7i4xs0oOLje94UfHzPO4yP7yVXUDPbmljx" +
+		"8c+as8i7zZqBj39kQVV8/JPVDB//WC8rPv5RYari3J+sZmoKPJlLago8/OOqpgAttreFSfydBZOIoavY+dSUqtj5wfas2PnU" +
+		"f6rY+dThqtj5AWGoPSyjK8DzP3lP4fkfcQoVnv+5cmSU571H8Pxv7hnMPlkuFS6fjJQKl08dpwqXX/MeweXXvH9w+RWmXeHy" +
+		"a94HuPzK761w+fWT72Y/V/t5hcuveWJwefpdb+twTuODy695N+HyK4uowuVX8fIVLr9m34LLrzyJCpdf2UAVLr9+8h32ruV6" +
+		"xodvqnD5JfcTLr+yXuowPlZWHZg9VmQdmD38QR0Zn2c7jA+DVkfGl++wk3k3h/FllRrG98fs+dMVeRh5ZuAw8iUjMPKsNcOT" +
+		"ZTW2zbNk4bUNiykGq215lreWZ4kBaVvGmt8ZK1uwbVjMmitgMfnqbcNi1lyB7n3N75rW0FIDnP3VNjXAa84y8Jh6hp0/WNMN" +
+		"O39mRNj51Ito2PkTvt6w8/HLGnY+O2/bZQTfOaeM4JHfyQiW7dMw95c73/bwkcaw4yPXXB3Pt+SceL5vjsTzLbk6ns8caBj/" +
+		"1GhoGP/k5jSMf+J02iFbmP/TDtnCdpUmGiDxPU00wM1jbKIBbh5PEw2QKhBNNEAyfNqRbOF8J1s4T0w0QGKOm2iAxPQ00QDJ" +
+		"zmyiAYo3rIkGSAZmEw2QrM4mGqBazZpogApFaKIBUv+piQaoV3534A7dF9EAVWx7Ew1QraVNNEDqPzXRAPXO9fCYd77DY965" +
+		"Oh7zzBjwmDz+JhqgitZvogFSM6uJBuhLjpxj6Dy6Jhqg43GaaIBuDW6iAbq3uIkG6JkhogH6N9fDVX5zvepIY8D597wBOP/+" +
+		"zVlwlZmROP8nvcbr08d8W6uW6+HunzxN3H0qPzbc/VhzJD6SHdfUMv7mTqhlnNoVTS3jVKRoahmnykVTy/ibsatlvIhvbWoZ" +
+		"H3l+DQNpn2wNA3nlSAzklSPDQBpfk1OdOdHkVMPKWpMl/oel/Wcrcmu4yZ5r4yazRjXcZMsZcZM9V5NzbA9rTc5x7lGXc5z1" +
+		"pOMm7ZKt4ybZrK3jJrOe9HCT+tJxk1mjOgaSJdM6BjLvRg8Ll7OoNn3kCqpNwxPbtB72kXW9qzaddx8q/8UaNah8/Or2hN1y" +
+		"TlmB36w1sgK/bOsGl/9mbYPLf7O2weW/bN0Glw/r3VLLmK3bUss4a1tqGWcVTC1jaE5LLePfFbBbvyvIWeVtNbh8sM0Gl//t" +
+		"aXD5HQbV4PJHnjRcvrDGGlw+tXAaXD7aO01WYGEjN7h84Sk1uHwRnd/g8oVv2eDypeQsmKHsI3D5kvUSLl/y3sDliyieDpcv" +
+		"1rYOl49GUIfLp1Zgh8sX6GLfMqKvlhF5i/qWER1aRsQT7LICy5XrGRFfvcsKTDWhLiswKhddVmC1KnVZgY310pMV2HIWTAr7" +
+		"oScr0ArSkxXY08I7mCE9WYHQv56sQMhqT1aguduTFQhV7skKNCN7sgL5hT1ZgT1nwaQ8+Q6TYtZ1dQu//LuubuF3pIWT+B2J" +
+		"k8gV8AB5Vzoe4MsO6MkKzPXwAKvYjv7D+o0oWH/NkcH63XlY/3bnnDLQ8D8d1r/lqcD6kxfeYf2bed1h/ano1iH4ycToEPzd" +
+		"2t2D4EMNO5T+j9TSP12RO/z+yOyE3x9Wgh783qrUZfedGZ3svjMjD7ZvTezB9r3tPdg+C6jD9s+MFbZ/5k2B7cei7MH2ecs9" +
+		"2L4YpQ7bTx2ZLrvvypOF7SfSvMvui6pMl92XmmM9uP8nPUuOmfHB/e/0LLi/2Pke3D9vWHB/qGQP7r/nd3B/SFkP7o+F7nD/" +
+		"xHB3CH5LryH4Lc85CH7GB8FPNnQPgj9yZBB8PYPgN75eD4KfNxqC3z45EoKfew3BT051h+D/1gwIfvjdHgSf39kh+JUP0CH4" +
+		"NU+sZXzuEgS/sbg6BL/J/ukQ/MSFd7l+j52/qzv88I970H11a3rQfVZx5/9fW84C3V/Tgu7zBHvQfVELPeg+zKQH3YfW9qD7" +
+		"S46E7m/pC3R/ze+g+yIMetB9KEmH7j/Q4S7X71Gbpsv1GzCa/kP+Pdsg/6yxHuSfNd3l+v1Wa7l+v7U0yD8bqP+Qfz0L8t/y" +
+		"O8h/9oMg/y09g/y39Azyj8PtP+Q/v4P8Zw2B/C95VyD/S94AyP+Stwryv2cPhfzHfuji83eoTBefv8PDuvj8PTtxOIKS30HU" +
+		"r1wdol5yhSDq+Q6innUwHEH23nAEbIQnHIG4vCccAZ/qCUfACnnCEbA0nnAE51+oOj1hD6ai6NsKe5CrGeuVnhgr6+gJe3Cl" +
+		"ZazsqCfswZ1+Geud74z1Si+NVbzBE/aAzf+EPeD9PGEPWCRP2API3BP2AGr3hD248zvjsy8+YQ9Yag/24DhypPwlK+SDPTjt" +
+		"oA/24LSDPmEPWvoCX6+5AnwdE/WEPajpJ3zdDveEPTCPn7AHvLsn7IHIiyfsAev9CXsgZuIJe9ByFuyBnfEJR2A3f+T6nd6+" +
+		"R67f6X17wh/UfGd8dtQn/EHNWYwP7/yEP4AJPeEP2FjPmfHpZ/gDGM3z4w+MiIbibZ19aCjea86CW4AVP+EW9pwTtwDJfcIt" +
+		"bPkOt5AnFm4hdyLcQu41/iCxmg/+oPLcH/xB4j8fdY6ryN5HHmBqUT24hWqHe8It8KQf3ELqVD24hfrNd7dWzlIcaUS4hQov" +
+		"fXAL9ZOeda30LGNwB3ELiWh9cAuJU33kATaRY488wCbm+AnvID74Ce+AA3zCOxz5Hd7BKviEd4DUPT/ewdz98Q75nZwlTOKD" +
+		"d3jwpg/eIVpNT3iHPT3DO9j9nvAO9skH7xD++sE7RIXikSvwyaqhGuHHnvaoRvi1Sz/yCFJV45FHkCzqRx5BanM8LXi+8alG" +
+		"mMzsRzXCVM545BgkavWRYzCrePzpiiz7YP2dA1Z8ZXRQ8sxjbMWK03ywFSvL8MFWrLlHPSyA+8daSfWNB1ux7vkOSp61VPbB" +
+		"ylp5ZBisGNwHk7GKlXwwGeuVnhlDnleYDDjFEyYja3eYjOw4YTLwx0+YjLztYTIyc8NkZHaGycguGSbDXvuEyThyJCZDzN4T" +
+		"JgNj/ITJOHIW42MTPGEyMnPDZOwZA2S85XfUMUXFPpiMPSs5JmPPSo7J2LOPYDL2rN2YjCNrFCYjlbYeTMaRPQaTkarODybj" +
+		"hCA+mIwzqxkm44RRPviK1Jt+8BVhgh98ReKsHnxFOOOBrzjZJwNfEeZ54CtOsUADX3Hy4we+4uQtD3zFtaUl92jLkXKPVHoc" +
+		"+IrLHBwbrH/LFWD93v2By7iXfBc8P2eB59txBr7ihuINfEWY54GvuPkAA18RNnvgK8J7D3xFFCkGviKZvQNfkWojA19RPrke" +
+		"PB9aO/AVqW818BWp7TXCV1hLR/iKkesZHzx/hK/gi41wEvJvBk6i8KIGTiKe4DiSe5RWco+MHSeRSowDJ1F+50zukb4cweyN" +
+		"CCdRS74LZp8rwOzv9BNmD4MaOImae4aTSDWvgZOIYsQIJ8H6HOEkrNYjnASbfISTsGIOnETPLMBJ/AGn/emKPLAV3bowsBV9" +
+		"zbWh+ZkfGIluTxkYiZ7ZgpHomblhHVg5I6wDS2aEddjyO72E3w+RhWPkLPqSuYp1eOQBDqzDwzsYWIfnypGzn0/NFSjOimgY" +
+		"YR3gpUOmYXSqhkzDKFoPjETyfAdGItm7Q6bhYAENmYZjz5FzfCNPiPLiN3cQI3HkncJIHFl5MBJREx4YiSNzDiNxZB3CSBz2" +
+		"6IGRuFk5g7piZW0OjEQUPka0EMUPDhH/X1EgI1qIWb+ihYivG9FCHDmL7BEo9ogWIhZiRAuRvzpE9W+5n6L6dwjRENWfOhpD" +
+		"VP+Wd19U/543U1T/nvdbVH9qgQxR/fudc96wcD1TVfDIaBPVbzcaovrDMo5UFWTnj1QVhIIO/EERGz3wB8nyHziCYmccqRzI" +
+		"yxipHFhyzguO7H3AEVS75sARBD0aqgJ9Pjnno5W+DC1jUPnng68bKv984FMj8fm58yr/fKBcI/H5mfMq/3wwNwMPMHJ3E5+f" +
+		"1Vrlnw9kaaj8s6RnKv98f9cTzZ51nUbRkuc3giq7LzSK2GPjQ6Nom97X26KLNefu2xJPPr3LtyWefGY3jQ+Nom2u+W9LPHnN" +
+		"OWHME6N5WzDm6V2+LZpZ8w6Oj/j8ffoVb4tm1rQb35Z48iNHiief9tHbEk++p5/iyfecRTz5tP/GR+z+rNX8Jyvye5xI82IE" +
+		"ovrlGr0tkeaPfonqPzMeUf3nnJ1vKyjv0ArKm98VLSMQ8X9/jQALcH9zTpXEvu70nuju9CUor3NiAcruelEv+uY7COnieliA" +
+		"9k0LQrq4umyA9s1ZIKSL62EI2jctMc6Lu4QhaIu5gyFoizFgCNpi7mAI2prrQRfznDEET56lbIBndwXZAM/hycoGeHbXwyU8" +
+		"h+vhEp7cCVzCs7uDsgFUPR4f2QBP5gee4cncwSaMzHhswqj5TjWt03e8+m93zhIk0NV59cvu3eDV79U85tXvzfh49XveN149" +
+		"W/5tQccyz3j1e/rCq9/TT179ntnKq+c7vC3oWMvVoWM1/YSONe8pr35ves2r35t7zavnV7wt6Fh1d2vGZ0S8+r3rNa/+WFyd" +
+		"V79nreHV807eVsaXqye21mxNdZ8nR0KPnlwPevTketCjnhFBjx6tVPd5vKm8+vMxItGEZ3dfVPc5h3utus/5GINIQx7I24KO" +
+		"dTNZpOHZ8zvo2OOpiDQ8u7GLNDyfHGl8Jec0vm60Ig3P7k6INLw+7rxIw3P4Xc/4XEEdoLO7euoAZZanDtDmvqgDdG/G3oOO" +
+		"GZFqP/fmXtMhujd9oUNUF/dTtR+xCW8LsrTkepClNS3I0vr5ixVZHaC6ekLqANXN+dUBEu3wtmBqqz6rA1RXM0kdoLq6YzIM" +
+		"alYlGQZ1zTlhalnb8Ax1TQumtuScGbn7jmeoq7uJZ6ir+/5k5O5R6gBt5iqeoWU9wTO0273FM7Tbs8QztFs/8Qxt6AueQfWB" +
+		"t7VruQKeQX2DtyUONqtu6gBl9UwdoKyQqQOUFTJ1gLIipw5Q1jY8w3NkDDC16dWMb2oETeZkfFMjaDInb0v07kRQ3pYaQTM6" +
+		"/22pEeTd/6ZG0LTz35Z43S3nTEWdS0tFHc/9y1eX0f22aOFMz298+epyNN6WajvTn3tbtHCKfvLV15rfQYhm1MLbghDNqIXx" +
+		"5auvNeeEEN36yVdfb+Pjq6+X8fHV119fghAZA199/fUFQnTnOwgYe+XLV19v/RRbuF7GLrZwNbO+/Pj1Nlp+/HrpGT9+ta5/" +
+		"j4wvrYzPaPnxqxXry49fc6/58WvNdxnfqmV8eQ589b3nCuI/7XDfM/Gf7gtf/ejuhJx/2ovjy1c/rN1fvjoP5G1BuayCX766" +
+		"TPC3FZQrZ4FyWRm+PHKcy/iKH8SkjK/4wTOj5a2LKnhbQbmMnbd+5vnx1s/ieuIHz9yJElzLaEtq6nhiPPmze9I8+ewj35IY" +
+		"1lxBZGrNd5Cezd3ln2NExpd/fq/5ncjNP9iEP1uRvzz38jFWnruq7ePLc1ftfXxr8CH3gede2LNfnnv5uDbPvXzcB3GG6rSP" +
+		"bw3GZeRqBKkSN75qBBU771eNIEzG24IBLekZDMg+8lUHiLL3+KoDVO12X9h+zeoC25clPr6w/cpu+8L2K0vtC9uvrKovbL/y" +
+		"k76w/ZoVBLZf84Rg+7XnLMZQcwUYF7vtC8FXifdtGR977wvB79b1LwS/2+m/PQiRnkHwe8YOwe9ZnyH4PWOH4Hfe1heC3+0A" +
+		"3x6MK9eDZNkrvuoH9d0ch+73rN3Q/b6nL1AutvwXut+zO0D3n6yCPTGrrgfdf/K2Q/cHe+gL3Vff/W1Bj756/QQ98hyg+2Mx" +
+		"Wuj+YMl8ofsjswe6P7LHQPdHxg7dV7/+bU30aLlzvUcEq/sy9/r9tyZSPTqyZlA9Ovh3XzWCjqwZagQdWYfUCLqyCqoRdGVt" +
+		"UyNIfvD4qhEUf/Kr8k/PbFX555nY1VhU/pG7/7ZEbk6c4m0FPTq1Jnq0f/O7zXddK+jRrRX0qGmdWo8W9GjGfL2toEf5rmjl" +
+		"nGp6/L4T2fikL5ClJ32BLLVcD7JkV1nEAaoDOZY9yJLxiQOUkzUWcYBZURZxgIV/t4gDVF3rbUGdWn4HdWp6LQ6wpNfiAOuR" +
+		"s8zx1ZpWhUHleg2ylH52CNGfR7+NBTbwWXJGWJUZv8AGPub4wuOXTfu2IFBfd5PHT4n3bZ2+0y8ev5zctxUEKt8VrXxXtXLO" +
+		"IFCLVteab8rCq6cH+La+WqvWouXpncGcjIFXv7WcJZiT6/HqVbF5WydcyZ1IjGCeQuIAW64uDnDkekFePEtxgPfX1cUB3osj" +
+		"xQHeuUviAO/cT3GA8hzHIg7wzlOHtje+0KLKf1tzFgjK6hmVICj5LghK+iL+7Ml34s94tos4wAe+t4gDfHgZizjAZ8bmvC0I" +
+		"SlYCcYDPaQziANVjGos4wOd0BXGAz+nZigN8TvdT/v9z5AoQFLvKIkZwbDnLHN+43AkxguNKr+lG59ny3D/pdQvyku9kX3fj" +
+		"47kvMMOF577wMhae+wJLWnjue95onvueWcBz33nuC899zwrCc9+fHAl5edIzyIv1eeG5779+Ql7yxHju+3AHee77cBae+97T" +
+		"grxkjeK573CDhee+Z8XqQV70jOe+D89Wnd4dZrL0jC/nzPjM1p7xpWdUbT85C2SCtbnw6q+PvvDPr+wA/PMr6wT//GI3Lvzz" +
+		"i1W3REF45Ejqu+y4JQrC0NSFf35lteGfX1lf+Of3kZbcXjbJwge/Mwv44Pee66mkzApZ+OA3P3Thg99soIUPfl/XX6zIvPM7" +
+		"M5d3frPpFt45fvVtQR/gngvvHPv5tqAPeVN453XLkdAHFt7CO1cP6W1BH1hcC+889uzCO1dVaay8czpHbwueAplYeed1Twue" +
+		"4m6uvPM2+fG3JYbnzHdieKw865YYnqIlP9j8WLfkBzet5AfnyK7VtR6tqiVO5/CdeL6sZqt4vufUsz0Ig3OK58tqtorne07n" +
+		"FM/38CZX8XwjYxfPN3KXxOzR+hmrmL3Bplv56l/PaE113zXXg0XUfBcswhjiq3v71vjqfI41vjo7Y42vnn7GV7fWrPHVWStr" +
+		"fPXhXsdXt/Ks8dVbWnz1niPjq6fFV7dmrDj3teV6sAgo6MqPX5+MARZhjVrPjM+sOzM+vzszPr87Mz5jODM+o+XHr6y/lR+/" +
+		"n+4gX/2wIq/x1dk5a/xx1vsafxzCt8Yfhzes8ce9t2v88ZojRY9YM1ZZepd1YcWXX9aMFV9+5V35+eOeH39cXcu3pXItjmwt" +
+		"qVzrTvDHb2vByuu+IZ8rr/vO+/fzrD2VeNZf9zqe9ZIWz5pls8azZvGt8azZOWs8a7jIyrMuLMxV9V36cm9LNuiSvsgGZfWs" +
+		"vO7CPlp53dTm3hbkIDM5XrfdYY3XbZVf43XzHdZ43Z+ck9e9/oWNvMbn5iusP587Z+RzP+kXnzszPj73k17yuUfOAlPI/Odz" +
+		"Vzv9yueued/43GoLvS2jy/vG51bZaKx87po3hc9dM6v53DVve3zurLrxua8cyee+ciSf+0o/+dxwwTU+95nfiSy5jI/P3bOy" +
+		"8rk722zlV/e8ffzqfnh6fOfOolyfRLkYbWrvXsaX2rtZrUXG9azIT/rpzqf2btbu1N49c87003cj/XRfRMZ1lugqMu653QmR" +
+		"cQ9scxUZ92TlERn3sEjWEWzAaEf8/7S62ru5nngRCOmWirpwny0Vda01m4q6Knu9LVmdW45UX9TavfGBd37FxgeOV7PxgSm7" +
+		"vC3Zi9+cU/Yiq3HjAx+s1C1+LnZri59rXm8/P7do8XN/v+Pnpmfxc1nMGzb7w4/Z4stC3LafL5u+8Fjdsy0e66ynMLZ4rLia" +
+		"DZv9YZNs2OzPqi/Y7I/9dYtvC83c4tvyorb4tvblLb4tL2qLb8sX2/i2ny1X57+WXI/6mzm/HYmgMFqM9SYCYEv9OvbKlvp1" +
+		"Jd8lquDRSlSBc56JKnDO1Khr+U6NOt7ChpUWk/i2RBVYg7f4r9b1Lf6rvXCL/5r5yX8th77wUeXnD8r3r4Oc34kAsBtt8V9Z" +
+		"plv81y2/47/+MZP/bEXe4tk+ubbMI+/+xrN9znwnNsDatsWztUZtPNuR+86zFX0zNny1OitvS00u2NiGr/72fMcr/OQ79Z7s" +
+		"fVs46d85cdKwRrr3146Fo3t/UbobdO/fI9NPnh+rn+79+50nxLM9WMV079+WJ8uzPex2dO+vfZgRPNvrm9/xjLJK8F6vrAS8" +
+		"1ytrRvRnjnwna+HId7ymmnOmIlLOyXeAoNC9vyrbmu79VVlAdO8vFd0H3fur5nq814otpHt/qS4/6N5f9chZMJcYF7r312P/" +
+		"oXt/qWEw6N6/Lfezx0I3Bh5q8NkN7zz2/E6VHojzZof74ME2qPKXjbVBlb9ZF+x+qr2Nze73zSpv99syl+x+W55D4sJHvpu7" +
+		"38aq2ux+28c8s/ttbKUtceGZZ3a/LWu+3W+DyG52v42tu9n9tswQu98Grd3sfutwFrvfltmaCjd57na/7ZNWxqcvI+PzVOyM" +
+		"G8R5S4UbSME2Mr70hScx0s/uuxzJk/DG7aljYyfexYVvn7T4Dt6qXVz4hmHat4wo31HjYPHtqWPDGtxTx6bnenwHb8Au9vtk" +
+		"oe/45MsbsOOML1zGnhjuPd+J4d5cLzHcZuSeGG78AXX7O3EYe2K4eZfU7W/1pgd1+1vV6kHd/m0Z7R4rPOdkhcNrqdu/R/65" +
+		"qtOge//+Si95oUW0A9379xyXFmaPPbQn8pu/uifym4+4J/IbY7Yn8ttcpXt/y1gaeyK/8/R4oWIzB937t5Wrs9BHrtd9t2vN" +
+		"kbffOVnoZhnd+7uxAujev79zFl6oLONB9/5umTu80PbNkVQnvJl07295xWNP5DdefU98953vWL4lv2P5igage/+29Dqx33d6" +
+		"zfK902uWr5iCPbHfxXe818663VON5jYjEhfOBtkTFy6WZE9cOLR9T1z4nSONAV+9Jy6cPbSnGo1YhD3VaCD4e6rR8Hr3VKOB" +
+		"KeypRoMj2xP7nbuUajQZX6rRpC/8XHX9B237++G17alUAxugbX9TBxj7r1KNu8TPHZnXNexdrjfHMPJ+83PV4R607W8q0oO2" +
+		"/Z09hrb9PXgStO1fI1yvxYV/WaL73M/3Jc9WFZslzyFVbLAXtO33YIG07fdEPtG23ztGhLb93iFEtO33Z6Qv4slZDNG237/5" +
+		"nQrr0NOftn3WWfHkFa4bbfuS90g8ebVXUKk/P2xBavPnAmGgNv+28h1r2m5Ebf5c2XjU5s/4ONTmz4NtQW3+pC40qM2fd9Ye" +
+		"2eV3VkHZ5TJuBrX5s8FBqc2fLSur7PLGmqY2f8pwGBTl35a7JC48dgdF+VO+w6Aof8YTpCh/juwVUOVPZl1qx2I1Kcq/luJf" +
+		"4Mi05l9LVL+gygfkitb8ew5jhSrTYx605i9azYPW/HXkvo9Yt+40VPnI2wBVPrBbtObfVkbAus3uAFWmGz1ozV80pQet+YuO" +
+		"06A1f1F8GrTmL8pNg9b8RdVp0Jp/W5sWFsAYaM2/3+V3bF1zjtb8dR+5QliAXD227qMVWzdnYeuOnAVOfuZ3bF3YB635K945" +
+		"PflLpa1BT/5SvWvQk79onYwjqLIYBnryV2IYjh+qnO9o21mH6MlfWWuOaMaJvzyCKrNPaMbf25rrsQV5tjTj35bf2c+3PBX7" +
+		"+SZCkWb829Iz+/lm5tKMf1v5HVvQjKQZ/353arEFl5yFLbjlLGxd7ynN+Hu3ktOMv3crOc34O+wWzfj7XHMktTIrK834Wwb5" +
+		"oBl/U0wdNONfS8057ef0gwbN+JsO0KAZf19wH5rxd7igw35+7zkSXpo7YT8vuYP285JnZD9XfX3QkL8LDIOG/E2bY9CQf89i" +
+		"RPbzkqdpP09MwWE/l9E9aMjfMsgHDfm7/s7CrsJe0JC/W94ce3Yi+WnI38kVoCF/h2umIf+2PAf4c8u7ac9u1gk68bdaYoNO" +
+		"/N2h5nTi716d057dW37H7mj5HbuD3XjYs7OnHfbsDjM57NldBABF+TtxLRTl724Npih/0zYaFOXvjmmgGn+HfaIaf/9R/OVP" +
+		"V2R68jddpUFP/g5LRU/+PUfOaHTQPnryd8+zFA9GLeltzdGFV6c1/9o8OYtsOww1rfn7eTIC2XZPzskiycoKm6aOOWjN39Qx" +
+		"B6351x4yBtj04KnQmr8H75zW/D3yFsGmByuV1vz2ZB1SXe7IO9XV1csT6urqsVZoze/3mauLSuo5koZVz3f0n+zR9OTftdd3" +
+		"ssP27Byyw2g8D3rybyu/o/rCkzh+yi7uWXK+8qbYvxdWatTeV6hy1N43fHzU3rf0mto7RaQRtXdaTSNq70f2JjlfifKK2vud" +
+		"tY3a+41JjKJ723MWWBnuiaL72fZcTxRIdg55XQ2iQdH9bHIDKLqfqjoPiu5n0G+K7ucQI0jR/Rww9Ci6q7o3foru9tBos9Pt" +
+		"GNFmP6wg0Wan1DGizX7YjaLNfv2OlNEAtYg2O1XIEW32y44TbfaLpRFt9sylaLPf9oNos9/WjGizy2Me0WZXNXREm71Cj6LN" +
+		"rmroiDa7jOARbXZ1SUe02euZ6+Gvv/lOTPydlpj4O1fAX/Nqos3+eKuizf7w9qK/Hn8k+uvjyJFqSfAIzuzEMIUzO/Ge30Fe" +
+		"8mztttvvd5AXlg0d9bdltNlR7UZ01O9kq5zZUVnoZ3ZUNtf521Hd69+Oauy/HTXf2VGtPedv1zSG3675F/HI528/NXeyn7IM" +
+		"z+ynMOwz+6m3/bSfltwj/nEi/aiv39QAx5m91g56/vbatLLXumP22kRXnNlrczez16af2Wtzb7PXsheor9+JAKO+fidW7Mw+" +
+		"zHo4sw+z26ivvy1P1j7coEXU19+WsduHG9uF+vrb0s/sw3DyM/twz+/sw1kJsg/jI8/fPpzf2YdzP7MP9/TTPtwzBuwd9I36" +
+		"+t0fY88e3dOyR1uDz+zEIuLP7MTQzDM7cU/LTsz3Ou3ET83vLjyfftqJVXsfdNrfljtvJx65g3bisaUFG8iamJ047352YggK" +
+		"nfb9mzuvzusC+6DTvl+YNjrt+wXRoNO+32fOos7rlrNQYsx9gZOrUz2ose+/dV2ettzhQY393SdzddhAVlZ52qpyDWrsx8EK" +
+		"obh+qDs0KK4fld1Gcf385P3mc3+yG/G5F/syxfVzzTo7cfK3pWcTJz9VpxkU108KpoPi+pncO4rrZ8MfUFw/Gy6D4vr5W4P5" +
+		"48thDvLHDxZzFNcPvkoU14+8f/G6847F62Y7RXH9yFoQr1vuURTXrzXnxMdk9vC6ryVHFt8ZLa/7Fj8VxfVbXOXJ677PHCmK" +
+		"DSsQNfbKu4wae73yHQYGohE1dlWrx/WL5dq15I5Z+X5q7HeO5LEef658On467fgeOu23unqDTvu92cPotN/bnmvbmXi9l0yu" +
+		"jQV0Qd433nJ02hORGJ12tStHdNoL6+iCtTdrfpTZG+8uyuxtz1myWhetrNb6AmtvMPqotrf0mgfeftezWtvDouje7G9RdO9s" +
+		"kCi6dyj2xQPvojEvHni310btvT/5ndUMFhe19853jtp7x4dE7b3ziKP23p/0xWr95EirNUs7au/dmhi19/7kO6u1uJaovXf2" +
+		"ZdTeu5iCqL13q1nU3jtfJWrvibaL2vtjr4ii+4C9R9F9fHO9rMg5Z1Zkd5C9QMVjRNFd9a4RRfesglF0H6zGKLoPqwvV9p1y" +
+		"+qDavh+8Nqrte83TnDv/3o58J4dhSUu94m/OIvZhz9XFPhy5utiHzHls9oe9R3/9XS+dpcbLMAvEaW8QFPrrp/pIg/7661e4" +
+		"S+K0E4tKf/1MLCr99bOx4uivv+tljhSnLUOR/vq1ZLRhunN3Md3X73ps+TwVTDcNhUF//bryHkWhBQcSVfUvez2q6jQUx5Vq" +
+		"aJkvLWtIztJF/uUK8CI2V1TVz/STF3yyfKOqfra0Yt06S1TVs9pEVT13NxFaed9FaLWsdSK0shtFVT27UVTVW1Y+EVrtdwWr" +
+		"TZ67CK3nkyPzNuZIWVGfXEFW1Gf9ixU5+VJ5pzDbD8YsmuoPBiua6h33Gk31jj2IpvojaiGa6g/uKZrqAx4cTfWRpyALiorH" +
+		"uFJT3d53/WqqmzvqptPkHpTSDxUGx5V6Kbl/I7aEK/Cdl5IjRcPYv6/4zkdasRfMnfjOWS9H7IV5FmroZ/Y+auhneKn75zvf" +
+		"WjBmkUB3MOYzRwZjHlo8P/medyKXMYJ3IpfxZ/TI7w1uTY/83rx99MjvTSwcPfJ7s5pRHb+3M2eRGcr/oTp+X2zI+8c05yzm" +
+		"o4iNO7vf7+p2v9/VoU7wFKrjN3XAQXX8fiDjVMdvqoKD6vhN/XBQHX9ben1EdSItM5ItT3X83Q+Mwe43vMNUx+8Bpac6/rb0" +
+		"zO5HcXBQHb/HmbNUyvPGJ8rr4z2lSH623N0jq5lnqxJpcDuK5Ncj+5oi+U0LYVAkvzd2G0Xye0s/7X7bmd/l+Rktb5mS8qBI" +
+		"frffWawnebZ2xpbnbmdsv7NADb/5nfXkm995fiNXtzOKvLizM2ZGig77ZE7IbvpABimSn431QpH8Cl9Hkfy9Z+5uiR1shpTY" +
+		"we5uqpTeObLIFXCv1TN5oHEUya+npSWi2z5Cdfy+4K53YqPtr1THb/XQx52MZHjmXePXp2V1g99QHb/pcY47nDHfParjyXGL" +
+		"6njNXJLBtPz7nL3j/16Q7wSHbekyeKjk9EgcjhHJ8ZvQ9iA5fpc8kgRGW0pJjr9dzpFZINNCAeaGCQ6rwhRIjr/XMz1aJlJ+" +
+		"ZyIxHkiOn0cmmY23HDknMzivW8tC4KH3SMy40YGYgW93IOYsUT+I2fiUOL84hWTF76XmSBMJQUBWfE8gfWTFA+veSOimuMJN" +
+		"3IRA0SA5vhCBHCTHlz0LwdxcF0WrB8nxZc+LObfQhUDk21q03MHpEC87p5fo+LLn+c3tdSFBOYiOvy39nNvrsmdyzu11IXk5" +
+		"iI4vO3OB6Piy53WbDvGyZ8JPh3ghBTqIji+kMgfR8YUY5yA6vhAUHUTHlz2v9zA+LhXR8WXPVjGML899ZHzGMDI+ox3Gl9k6" +
+		"jA+ITXR8ISg6iI6/LXd3GMOZVsaQqxvD/f8zef7+j//63/72H/7TR1zo+7+P8mtFuZGizsiqsltRL6QoDTKUnyqKgRSVPoaq" +
+		"I0XljaLkxhgzFKkouVHU2hjPtHKLehpFIY33w9OHuw+PefWvc879qyid8R55+/D2YXGkLs0d6/3THFl9qEtzj1rVhysqaBSl" +
+		"M94j+/zwNKK5K71H6vypn2f6OXyon6d+zji7okpGUR5jEFgsymMUdTFW9e+KuhhFQYzxTMO7KIhRVMIYz26YRZdKbrIRFVef" +
+		"O8jy+epncfW5dSxSkt+Wq5fcJcOci15RfGEQQyiKLxRVF4aq0EXVhaLcwng2V5/rW1FZYagiVtQ2KIoaLMtcG4riBEWtgaF+" +
+		"TlFdoCgrsFh5i5T8Ihd/+U48rciNL5Lix5hhiEVye5HHPlS+KTLXiyT1dZ1bY5GIXmSgr+rElGVaVEVy9qIQfpFoXGQYL/I5" +
+		"iyziImF4IcZbJAwXmbtjmHUyd4v02uV7+HDuQUXW6/pZ/HxuBkWC675OILtIcC2yNMfIkY9zDu+RuSQRscgyHGMuuUWWYZGo" +
+		"91GCpkjUK/LuPirKFHl3RXraR+maIj2tyCj7rM2Rp3POqbh+pwtUJIEVOU/r19hlORXpTZ9lGh5FukWRZ7EvFhZZAkV6wC6V" +
+		"s2zzBSqi9vc+ObciIr0IRd+eSZYWwedlq/jUiaMWkeVli1jaNL2LQPGyJYbs4+fTCCgb0TMCj0XQc9mi1mW1Edla9jmG7en5" +
+		"8PHhmB9OxKmIZS2CWPfvhACKsNUiXnWXm1LEnRYBp/vqfgoFLGIAd35sEb1XhO0dy+5CbrLYuuOcHF8Rl1YEpJ37JAWLgLT3" +
+		"D7WgiaoWkWFFSNj2TBOsCAkrYsG2Z3f1udkVYVhbpreAoyLSaF+mL1JEBRXhQPt6OtJCLcJlv6eJVUS4FKEtO7mAIvCkiDjZ" +
+		"5csWESdFqMlOyLAI4ShiNw7VFN7W7Kdwi0OieBFuUcRZHMdE6d6WI+ecOJrFSghCEXtwQC8LNr6g4a/PBIELKrrgoLdh0mJl" +
+		"Czp2/06QqKBcC651z7KGTy2I1B1lXs65Eb1/Jm+/2Q3xSQWRtNdpFRWEUMEE7SJyC7anoHl2MsUFxF7g58c5sbcCYS6g5aNN" +
+		"ILsAW8uVjKHpjRc4ZwFwvtNm3nlwUoEjbX/IITAlmA7/ry1R4AvlUlBFOfjC2S283F1mZuFhlHtO9h31UDgahYexj4lZFD5F" +
+		"4UzsY6azFPZoYYgey0QhC5OusOWOZRJepXhURcbXOY2jUqYXX4qiItVeVabJ8f4R+TARtVJMviKtZ5k0fCnzGZUCB6SzUoqn" +
+		"Ukgt3R4VOfhCB36jFlloJRciyTvQu5AbLnSG32HOx083sxDMPJSgLqQgCw3Ic7EpUlAspBNPwjaFdGKhmXhSeSlUEgtBxJMw" +
+		"ZCEtWGgKnioRFNqAhSjgKdWlEAUs1ADPe3KThXpdIVt3fWwhnS1FxexSe71Q4SoEt25+ViFrVehZ7bGl6CgVAkr714jI5RQ6" +
+		"Oa/du/qw+3C+JAoZFCIVhTrFQQe40JwoxCaOfbqphVRDodFwnPYASgGFRMDRZhBoUYK9qL1+7hPdKGqhl5EEtQmBF2XPi3rn" +
+		"JzWAosZyUVz5WmaXqsK9VcXeiwtX1bStitlerfhw2orvHzjutKWqGpdVcct9nbZpVbKvMs930TCVmV3Z1ztPtSp0VlU4O8Qf" +
+		"V7Wn6je05ySqq9JHNTWP6uPDuaTXFLP5TuipKuhSU8mFo1RZVjVFW/a5p1YlF2pqLdCLqOtcGWqStY8J/lSJ1TUZ1epYVHnF" +
+		"VULxvkzwp65zCaprKjzN/a+u01GqSUElD1cliFamyk6PrG5zZahSNPdnBuBViZdVxuVOM7HK+6sS/o51EjZVYl1lvxycvSqL" +
+		"rbJKjmNaHlXaVJUv9U6w+eE+X6D3z5xg37l9VgkslQVx2i2qzJIqpeRERldJHVU2x3m4nyLwq9D7k6hjFRRdEw19GaYtuSYI" +
+		"dJkvQhUkWRMdecyInmrbrQlvFMxWBS3WRCteH0fOHbYK69s/85WpAuSqyLh9eXz4OOe8L7uw1no8jpwAwK6ibj3mC/T+KcDJ" +
+		"+XPhQVVc0LFMG7raaKuN9pB8VQXaVBE2r6UyXwRseQ1Nvk0vq9pMKxb6VFurYmYrSva8JglUUZ0Vx3lSIq0YwYoKPMd0VCpS" +
+		"rWLTru/pw+bDFNY1vZErFatyldOHj6sro9omKVOBthVaex8zeqpCZOudQpHTLq+A0hqE9JrmXYVmVjDmTXSxwsXqHR3gjw/d" +
+		"ebjKLhW/lumM1KLG2decLx5xgUzd0/WqReeLDWXusH9qT7xHzjenCCrsc0uudS5BteIWOIW1Thu6VgWc29yWanUHK+hsn7Bh" +
+		"rV68KlFRGujbKj4UWTWj0t9W96FiBptzTpqk0pffvxMhqDTkK7n41xCf5yS1Xmmsv0vBPCdl8UpSfG8Tw6qEmCsF5oPWXKUn" +
+		"XAkJH9XUt8NWirCn/INKpbRGnlTRxUpss9LVvGgnVYqYtaew9bRmKlnHSs/xUgWv0h+shAcvpbMq4cFKcfBq3lsaa5W42n1O" +
+		"Z6sSCqsUwu4yufT3ds7OP4JrFLyvRFwq9ZYTK1Bpq1SiKqfSeJU4SqWKctK/qRRMKumSs0xDvhIFqdRAzjb5yEqPoxLiOMck" +
+		"7SuZjDqS1zzBwLd1+1Bxp8OH3dV7tMT+uPNNtf6mTP99TOS0KY7fVMW/z7mUNHWx2yeWx8SsmxLRTW3oY8zIrgasasCq6zNR" +
+		"h6Y4ZEtVyM/lw/l2tO9P1OiPDawpC9jUA7yfGUnW1MtrCuUd23DknIoNEHKUmT3cVI1rKRentGGzo7fs6HI8G8ikpShbmahq" +
+		"U4atpf5amZVrmupo7VcWbffhnEtN1ar7O3mHts4b2VahMCTomjI/TX2f/eo+nFO4Kf5ythw5HYe2/qqq3fPDCcM0xVEuyRNN" +
+		"MZG2Jo968/PHh6jdzzTBm4IaLZU0VvdTHYH3T1Lz54OTft/k3b+G3LyQbOwmDXv/Tr+qSYhte2bIjAdo8jpbEjrH9EkahKBB" +
+		"CK7+9eG0LlqS/raJDDewQUu23zldhSaXq0niuknRtn2aoe8feRnTd2osgSYh6jzMOsk9TVbP3afZ1I5pXL5/0JTTfWxSTFpy" +
+		"S7ZZ+aExJFrSSJS9apI1miyN9XM7cs6sJrR7VVTibV0+hNpOI7iJ1G7CV9fPXNKb8NUm5nP9zEjAJuazCfb8SBBvwjubiMwP" +
+		"g6CJyGyCEz/bDCNpwhGbeLuP3KAm3q4JfFu2ifM1gW9NBNqCyWx232b33ZVNbpiZhpLZUUDN9tlsn+cyw9pbmVPj/UMxbxoZ" +
+		"rcyTtXKyd6cz+bY2H06zHsbZin7ab4/Fh3W+Va2qmULbotU53d4/cyavU/z8bc0LVdU5aNe06sHVyDVcjpzBIe8fG9+M2ntb" +
+		"hw9PWNccpg2zVSb4ObnZ1uZ8aQ0IRKyokbJv0bA/JxfcugnGpzz36ec0osON2vA1cwf+1J5o/MZGWHVXGrORNm00TXf7SqMZ" +
+		"2Z4EL3lJSDk2qo37M3ffxn1scR+XGejaCOk1CnrH+vXzaUc2MnDvGzPHYI9rY5qvB8XRRsGrke46CI80e1WzV50fE2XMta7Z" +
+		"ss5l83O7xUDo7RNxaXSC2pD6yXVuXNLGJT1pRjQKP+8fYtoT7uvkbXp0beS0dko2PRI2bT6qblPsUatpM+q/2w07cZgLCdKJ" +
+		"KXQqCtuYTHunT9AJE+zEijtJgU5LYKcV0hFVHVG1b5Nif1uXD28Iwew8TqrjpHbp+F1p7a6m9gGK7ypCd6WgD+IiXRHnjuE4" +
+		"OByde9wVYz4ErHaERU8V3z6Jzq6+6vtHyZw56zrKoKfK5zqzYLq6nj0FPbe51b2tx4cq58wYwq5+ZVe48iQi2BVv7Ko2nvf0" +
+		"0bt6iF0hxLNMVLNznTvX+aLf11Xf6spuXddkr7tyTz11nj4zwryr19QVarq/c/vs3OOuEtH+MXY1fbpiPvvunAD2rmbE/kyn" +
+		"sIPNO9j8+Mw3rtvVesDwa8L7fZ/Poe/sSIIUXdJ0ly191MuRc6HuEoIPYfVdYmiXEXou00vuMgq73emEeXRpf12+37tkzbkk" +
+		"O66DlU/ps52b27m5p5qV/ZgvUD9AZaTmurSnnnyncevS8HPhWWNacm/r9qH4whn12eVDdIkQ1zFNsS71odv/LjpM3QbWBdJf" +
+		"QKB+Tie7nyqOPo9z6qeg8PszYfMuDLyL/74/5pLomS5s5iAp3cUw9FsmvArbHZ3f8fgHMfuOq++8yOOZUW8dXNtx7gex99dr" +
+		"mdO7qKmFL+5lGqy9pKzyRLN7mS/Q+0e0u3PCYnuwWOEvvXhGxTOS8drL3JZ6EeA2PCOu5ftHrteM3Oug3A7KvT4zRLbX6dz1" +
+		"Kk/qawmqcwr3KiVqMSJOaK9RjJm5IG+r+nCad2X38zmFO0D4khvbm5W2JdB8LtS9TRPn/bNMdHluyb1NP+79c0PF5tg5jL1v" +
+		"HPeZAdH5jZ3feHzn3tH73PF6F6e7zS25dwtgtwAqtdjJpnd66UedrkKnkN5Jox9Kj3Ri5Z1K+fFMI6PbRfuTAN0ZTt6JF3eq" +
+		"xadMrM5h7ORvz3P34dwKOpXY85q4VKe+2m20r1U4+wmu7TQyzzFDPDqHsRO5PMcMFu5k6Dr9ueuPgNk/tyeez3zED7Gyq83E" +
+		"uIc82QPDvVTUe2iPPUTHrk7SnczYQ1/s6vO+PHS03j+KM5HZI1P10Ke6v4efz03qIVf0LkSLD6sPKW1Q5KUO9JAFui+C1kRm" +
+		"nu8PtJjae5QpHpIUO/GwR2TAIzLg+NIDpjTwkBg4NqLViqM/XMsjmqM8zAdYfJCBeGyKj2rjx6CCazt7FGB+/X7nHH6eaFXq" +
+		"mmriPorhnmW6j4/Sqo+aqqcMq2c9Hal0+ZigxcOde1REvNQ4fUDHz5oUFEKT27QO3z9UhTxNNfSeLYmGE0Z7lAR71AK7L7qK" +
+		"COYHwXyTWXqU03pSR0udsAcS/KRkVsvV5yx/1Lzav9TG1bV6FLR6P5zDVLTqUa3qfZrzQzVVHsVUDoTFI1n/kZd/RomZP/b+" +
+		"UTSeaKnk8kce+RkVUDnZj2Ts8/I0pTk+8huvbS5PjzzCRwLhdRJ5lmT2nD+0yZHzDX//zDlfCBZL4nmSvfN4RrJdHmkuF7mK" +
+		"55rL75Mclu9kXh+ZKU9SUr4kyAG0T7JPvhNHeeSUPEkmWYzoaj4UOnjnQnPQTwLr7+5Id15M9j2ozMNiHyGzO5GnB7/54DeP" +
+		"Y27zj7C7R7zdAcF6RMo9d3LGZrz2U+ZkfzCa55dkapmDfv9MzONLNbZYl9Cc50KUtViXCh5hnQDD6+bMq5cU9ifhW4qfI2su" +
+		"OqPFElQkaVxEWcu8E09pgJCuS9MSeEoXCk4Kuzw+VJVG7PFTplX5FClgC9nxOheP9w8NuElvP9UEqyZYxKH5ok+lGVgoONe5" +
+		"Kb5/IgM2O189zQoEYmQ8/NSHn3p/V1c3Fau8g6+rt7mBPU1S1k6UtU1z62mpy0QLuU2D9f2TVJ15zuY1bAj7Y2aePW1upu+f" +
+		"uU9X73u3sHRVOVfrfJ/r2fPzfa02fTr87x+ADR1om/dj8z4vAqrdW9ztqRSBnj6f2NNFqirU+zxzFrx/Us2Q7rt+2oTvdSfR" +
+		"aioOuWf3EjXpeUOGAPY7AqpzH3tGKnct0Vue03uk9NYWkeJ5Ia7s/o2mcnOkAlCSE97W7sPDy5Ujbx8qCftEttfVe9RRooo7" +
+		"J8NQ8KDUCOcaEWP981MhnRFZtvnzjvDqHNH7hyLDiDJm9+GjBAABvTmz3j/Wuv3z7+yJ6/y/zInxndbd+CYvUFbId+507x/r" +
+		"WESoxDd+UwOmRyVr9vb7K6sd6SdHyiyu0Zh6nPNBYqwR2plBel87SLRdv3PXff80os2K/c8nPJbUlY3Gx9x83z9DiklkR2Yw" +
+		"4aLuKnRjLJM5ff+ckONIaPh5aEqZNEv3YY90Y0QQZpcWFNi9pw7+HDvk+OoSeFaPb02VIFlt69z+3j+XW+fn82GONfpkS+qM" +
+		"z5DL9ScilmLg8y5hqe+7pPq1c0a+SkHgdS517x/5fVeqNe8+lDB0psCyn6sROVIV351HXd9jTxnj2aVNJFZv+fDw4US1UvRk" +
+		"c0M2OMhoqVg8u8SYOY6UVJ63Z2C+j5KfT2NtbJGpSVnOueaMLVENLZV5qw+J8i4pbjsDFDfmRBH+LXJvbIRNlxQ9bc4px+1K" +
+		"dc7unPyi39jdui2MgdwSjPnAmL8PLmUrmw9lP6Smm9DlnZzhdaRU5OLD1D1KZT9HilHpHvGun7s1J1rHouzGLpbms6UI3OFD" +
+		"1ZNTl67rZ0/ZZKX5BHxC4s9HFRH4+oCvXzXFbqYxM46fboLaJ+bn8SvC4OeThRhHqiGoFAKsGEeKBvxK0TinQP/oXiDcB8L9" +
+		"iETcoZ8I9/Mj1B66MYJuLNI6YPbjiAOWTHThu6dl7UihhUnnjPOgNZH8+bnnv38kLqY4hYUFiX9vv/oVjkw5KbH57MdxWu3p" +
+		"eQ+QxwB5HCmbeZkMl8kQdaNLHDtr8k6iyjUn+/tH1ksyf03vq6TsTxJdiw/tNcngnVv/CLNfFTZhW75/VD27k8lZfSi529tx" +
+		"CZy+OAVP8rit3he8ZlhDruFCApbqN3mClw/Buz0pevPqt3z2r9qu91xRxr0l/0U+g5frTqW4pLrMt2rcKfyWDBSz7jbrrmRe" +
+		"TVvo/aPQabJF5hx8/2CKVKeGHY2bxfhJDogF8B5J2/j33vj7z3/67//4b/+h/ed//Lf/8o///W//8E//++9//9u//q9//pd/" +
+		"+l//8B//+PQf/vlv//Vf/vVvf//b//wf//vv//S3f/i3//Fvf/xq/vd/AK0dWhA=",
+	"ETen-B5-V": "" +
+		"eNpFT0GOwjAMvPcV5rASe8iGgngAdJG2B1hEEJxp4qJI1InS5NDfb3GzcIitsWfGk4/ZUYmNcQ2K1dcCTti7FDSKan/zhazq" +
+		"75psBHkMTiuM0FoyIXOgwbulolyCsTpmxFV3WayGPmJXU+tgNbFM8pkpT2PrYxhgzvc/wWBbyN9gMFi6w7w6qDIPVfL+gR1S" +
+		"hAVPkMy0eeY83DoEuTsjie1aXN6L8+ARlhO+7p1BKCfwz/2B1COnHQ25v/x0CmG8x6HZ7Km0hK/fe8cqfn+NM2pM",
+	"EUC-H": "" +
+		"eNqE3d/O9TpyJvZzX0XnIEBy4HjpHykBgwEkUkImQJLBOLmAnu7tiYG43Wh7Dubuo5e/ZzlAAnx9sr9dr5ZIFkk9LFYVH/73" +
+		"/91//Pu/Pf/4z//5t79d/qfP7/7Tb//yz//1L3/47W/b//r7P//N37X/0P/Dn/7xX3/3d//xL//8h7//7V9/9w//+Kc//iW/" +
+		"+d1//u2//OOf/maaf/fHf/zDv0Ya//3DP+Xlv/9v//Kvv/3Tf/jTP/zz7xa/+uN//XN++Xf/6f3nX/71L//td//DqP9//N0f" +
+		"f/uHv/m7//0vf/ztL//4p//yu//hf/n9n3//pyl//vv/+uc//9+//dNvf/rX303jL7/96Y+e/LT0f/v9P/32u7+7/8/2t//z" +
+		"//vH/+O//fm3381DXtT5h3/+42//8uff/+G3v/z+T//lt7/5d5/Pv//dv9s///5v/t1+n+P/7/680jmd0ys993P/+5+K/j/v" +
+		"TZ+P8v7zP/zh//r9X8bv15/fP5/m7Y10k8orLZ/nIh3j2ZR6Tr+cSP2VPp9rJd3j2fohPePZuQ/p+oxnixqu0drnXkizZ2q4" +
+		"6qj9k2f7K61376SmZVp9/dQ+f6aNdJPUfj3aqcyW2r3XplHKFGn2XiEt45dbnq3j2ZxnG0l9jbaLXmrqW7Szq2/zrNN2y7Om" +
+		"ZXk2dJinmXTrT73b9WCvQ7o/Q3oiKXNS5j10mAvdbzpEv9tIT3r+ruqLtA9pMX73z0jPZdWW+ySlzEvLIo2+njJGN91v/fLQ" +
+		"/aPMR1s+eZZZ570ns07vPoV0kuqQZvU9u7lE90c7v+9p57eGn3bOW9O7TyOZBU8n0f25SaPMeXw/r1RIoz+3cyItpA9pzInt" +
+		"WkgbaSYVUidVUiONvv6YL/P4qt45mPeGDtMRqZNSw+2XpGv8cv7spIukzDHL53k+SRPpIDU9qC1NDV19zWj2lfQok7ZdmUZs" +
+		"HnP3LTPPzN1zI41SlmjUUwrpTin6+tayonczd7f8cjM/6XAX81N/fudupMxdtT+jlGnWE2PWvY3WzjHr5lIe0kZSyph1r6S+" +
+		"MbPeWW4WZIbc4706RvOzQr7aSduQ9swefbZn9tB9z+z5QdoXmYPA/39E3jOvppRhXk07ybyaVpJ55fvezattjnSQ8ssxW7Yl" +
+		"km9jyS99G59o4NswzvtJc+i5DyR/6/NsIPkrXSSaT5VE8zm/pPkSiX5z3qPfEol+ayT6mWX7Ff205Yp++uWKfqk9+uWX9DNb" +
+		"dmvFtqRM+kHy/aLfokxf0TZQ8PqMkV3vo5F+9Nu2sVa80o9+LywspB/9jjJqf6Uf/ba1fUg/+pVp9Nn1GeNXlqHD9Rnjtx1P" +
+		"yvzR7/jMqf1Hv32tG+lHv32Z0pYf/eq5pYYf/crPC0O6Rw33RPrRr9yzUsb4le3upGnUUNQ+xm//gbEh/eh3XE/e+9Gv7Adt" +
+		"x/htda6koR+8fKWh31wjDf2ug+5j/Laja/UYv73UPPvR79iflTT060VPjPGr/bpJ92jZHmnoN2dUxvjVdhiHgYL7z5Qa0hi/" +
+		"az9IY/zui35jrS/LJ89+9CvlyHtDv301Yu1Hv8PK8Uo/+h1115/tR79S19R+jtlzp4Yf/VYr3Cu1Ia15rw/piPSj3/ZZ05Yf" +
+		"/bby6ImByNuxqH3YFm9/6rM+m5F6vg/9WjTqQ79P17I+9Js+kczPalQGtpUyGen+o185PnToP/rVn4Eb0o9+dYu2/RrSt5Qx" +
+		"fsdjbLvxO+k31ora77z3DKu2qW+sFfv6Mba38St5Nubn9dETw855G6Zlw845PlXLxjryQo/axzryfsX5ZR3Sz7r1C0R+fzdm" +
+		"bk2PDeto/1yp+xy1NT09rKO6R597jOz90Q/3j+bb/uSX48u8z5TyjFIW82pYTvVcPHuG5l+UeHyZO33G+na0WX1jfXsXBzU8" +
+		"NM8ojPXtBVb9PqyqbQ0uDKtqK3d+6cvMeA2ransmPfbky0zLfvQr/c4v++iX2wwca+a+zaM+u5Kj+IangZ5H0bJpoOdRzkhj" +
+		"9Krap6DnJ9LQYXkWUvUNV9I+5uOw8C57lbeQ1D7Q5WmpfehQ7p3URpkl7/3ocPRh9V/2MfU0x+1j3i4bfWYfU4OC9jH79Hhv" +
+		"oOeLs4U0vr4yqW+g57YNq/iVxtd3Tdo50HPfJvUN9DyamWv/sx0QZIKey3mQzjEOJfWNObj2tKUNjNrpN9BzY9lfdkrv1xCN" +
+		"zMFVfQM9j2CNndK++L7tlI7jowbomTXGTmnbLm0Z6PmayMZ2oOf21PwSusx5NtClZGyh555eGuhZmq9jGuhZ2veX5uCsJwZ6" +
+		"1nWLDmP8Sst7zxjNdeCJndmxL2oY6LnPvs1poOe+p5SBnq/lpZ0DPY/ZqjlBzw4LpoGedTvzXlW70RzoeRzN+A30LB/r6zTQ" +
+		"80Wz1D5W977nPeP3rWGs7sceHW6zIG0Zq8O0K3Og51Yn7w303PaMw0DP7VjpPtBzu65IY/zuJ6UM/T6wZxroWb6z7ma9PHlv" +
+		"jF+7zJCBkeWZ8mysDp+0GkZO32djdZi2lDnG76dbf4nI9p11WVLbGNk7/TfQc58rDaDn3PNsjOxmNbcnPT4Z2cfIZtQHeh59" +
+		"ijRm7l60cqBnsZ+77ElL24zXQM/KorzsSbefPw7pGv0+64eBnttR8svxZT572jJG9vEN25OW+Yo00GV7TtKPDuWcI421/WKN" +
+		"2ZOWjKw9aV2sHPak9bFu2ZPux1ZIw/bsc+obK1y3Ds/Q824pc+jQzpQyZmc7UspYAZaa+sxOX/Q80HNrsGYe6FkmmDGzPacW" +
+		"aei310g/+lU7xlfaBn6ZO/NAz/VJvwz0fFe/nTTG6FPyywM+RzrhwkO6rD8LKSt42jnGaJnSFuh565eBnuvDBrEDX3mBLjvw" +
+		"9UkvDfRcn6oG6Dlb/WboubAfZuhZ2G0z9Dw+eTb0OzN+jW2dHhzouV13ng39jh5poGexavIGlGpXwxvwGuEpZVgo15pnj+9b" +
+		"qwd61k/RLwM962J9nQd61oMdPA/0rAd7fR7o+Vo2yhzoWbOCzwM99/nSzoGe73ebGsb8XDJ7Bnq+MyvvDfT89hn0XHra+aPf" +
+		"O3loNNDz3f2mneMbK5kFbM92mi9sz+f23kDPd2Wkw0DPo06RxupQoPU80HN98nWwPdudMovdCf0GetY5c3egZ7ULfqWh32St" +
+		"nwd67jNbZh7ouZcntQ/9mt3lPNDzKC3vjb3RwXqfWZhH92xg5Ho39T3mJ7uDB2U/bu89rJd+/xqR+Va2fU0ZQ/O9RSrWlNQ2" +
+		"Zu5zR/JlBr8e6+Kh/wZ6vl+t8RroWSY7xpntyV/6SmPmzt9nY+Zuy+jbhe15sYoX6FnMiAV67sOb+UoDPbt5tQz0rJ+aZ9Z2" +
+		"X8MCPcueUsbodbvzZaDnzhv2SmP06pO2XKyxizTW9mJdXAZ67s3ObxnouU1PWj3Qc/YVLdDzgEPLQM/Xsl9IdrZfaYxe//5y" +
+		"6DeVnbSxJWbSmJ39fEhDv903vAz03FezZRno+VpHesnO/awpZei3nHk29Duv1NdZqXrQzv0482x8faXrpcZ2Oekw0LPUR8sG" +
+		"eu6l6YmBnsf8iTTm4LznPegJXRbouUKQBXrePb8cc3CGlwvbc73SljEHd4i1QM+dJbpAz+vSL2zPJT3B9tztHZbYnvByGei5" +
+		"v8qThoVydO+xPfv3lwNd+Mlfyc6W7bkM9Dy29NJAz6P4cpaBnke13i3d6pA5P9BzC5IvAz3LUVPmGKPlSO1Dh4uFvgyEfC0N" +
+		"zwZClrMalYGQZbdyLBCyfZQ5ELI8W35pjNJLEHLLnIeQ3y+OffkDcUMaY9SX1G4F/xjbgZDltsItEDJ7gAVCHlPKHN/Yw/Jd" +
+		"biv4FOmx39JnAyHfvSaNBkKWgzW4DIQs52L8WJF1V8rDOzbn2dBvsp9cBg7Wk39jGTi4r1ajZeDgMfX8clhgyx5pWJElGtmD" +
+		"9x+b/FeIvEDIfU753QoKlezO12X02DoQsjaItfJtiiy80piBc8uzsX6v1sX1ZH/Vm8T+8vWtducTu2bl27zM8XUg5FvDSRra" +
+		"/SzxQxo28s/yOKRhX3YrxzoQ8p0teW8gZHp6hZBHWgYhL/23QsjrUEMQkmWxQsjbfFztzu96kOx+fGHrFd/tQ6qwNM92Fqye" +
+		"YF8u3xrG7NxKahgIUs3V9TJGW0oZX9/Ogl0v9teSMsfXd+WXAyHfhV4vQcjHSK8DIctz5JmvbzV+zfhZ79aBkFVs4ZXG7Cx3" +
+		"ahgrQGX5rgMh6wmD14GQ9WLPruzLNke6ePT04EDI15g2KhDyeVL7mIMf3/46EHKfYdQKIZeD7kFIiLVCyLMZWwjZ7LnXIKRv" +
+		"cYWQYjOvNBByO1IK3+2RZzss1YMDIY9iZ7YOhHwBMu+Nr++wI17Zl3N6cKDnei+Rxvxc57Tsgbqe2Z336HCzn+2r14Getax5" +
+		"NuznukRa7bfyyzF+saTWgZ57z4gN9HxtQfoN9Dz2KdLQb9/0Eg/mvqVl8U3nl3zT1teVB3OpeXb7GtMyvmm2xQo9a2bywze9" +
+		"mpGP78+uZmVfXizmlRXZDjMEet78TGusyKBNrMj0ICtyufOM731J7b6/ntovUQ8jDSNrvmlW5M7OWVmRu5Zt9uCnVWyDkTd/" +
+		"ysaKvNpf8Vps7Ms2YmSvNNClWXm32Jc15Y+v7xBn2IKesGbj2zw/qXt8ff0rDfSM33M7rX17yrT7mVLm+PraFe3G1xfLd7tk" +
+		"lOjbjW9ThsG1DfQ8dv23iQyd5sc20PP9TPOe1YFHYxvouX2ird35lFbHt+nL3Pg2ryOljNGbebg36Dl/yxyjt975ZYeXJ2nY" +
+		"LtlXbwM9a772TWQo83Eb6PlO1YM0s9cvEt8fz8s20HO/2CebyNBy5tn4+hY7nk1kqKZ2u/P1kxoOEm0Hem7xGW6xL32ZG/vy" +
+		"9GVuzfh9a2dfztFhrA7VDmTj27ybvhYZWqoyRYYuSLdBz8nObxvouReRxA16fkZ2xSuJnGT2dJGTNfXJzREH2wZ6vt+8vh7o" +
+		"uX37ZaDnti4ppWmL2dN5wOxXt4GeRY7NKw39Nki+sT0r78rG9mx82ttAz/erijS+zGZN2wZ6vjs6tUPP7zjcbDN+ps3u/EzL" +
+		"7M6vT94b319Pn/Fttie/tPqVlNJgjX7h24x/cYOeyxXJ6pD+hJ6d32Bje85sp43tOYvVbAM9yzKZWWzPe86zzWhq50DP/WTx" +
+		"bWzP3vUg2/NnKz4kvttPfsl7xFO9sT2vb5m8Y/ZwRYT8sXcoJy+XL6CIkH9YPeVMnKqSNrZFIQ2cmGp+CSe+z8Z3tPg6City" +
+		"mfNL8wx+Fjj4sxX4JSIX9uXmGy7sy50FW9iX7U75Y4Qe0dbCvnx4Owr/5QdGFdGfj6+o8F9+RpbJK4ktT/nlZlYfpGFfnnyi" +
+		"hX3ZrN9lIGSdedjKQMiaGEThv6x3yhy7n4+xLAMh94nVXwZC7rHsyyVCx6tVEv156Cf6k5hOGQh5ZAdeEv3Z8ksrgBlRBkK+" +
+		"tgDd7cAns6zYgbc5kujPnhrEltNLELKtKeUUGaI7hDwgXWFfLuL4hf9yT89DyPatYYzfxz6miJ3PYpUFQtbMRwjZfdFlIOSx" +
+		"iOUVsfOfhXxI/Ouz+SJ2fkGlIvqzLvnlzieqLWLnx5VfjvG7Mu5i5x1eltiXfBGF/3KxLpeBkPueGc9/ebBPCv/lZS9bxM6f" +
+		"fH1259NXGl/mmhkpdp71tdzxr2vnbQVny5ebf52PsoiQL9beAiFnFleBkMuR9y6zNS2j351nQ7/7TFt4iFqe8V+K5ZWBkEd8" +
+		"JoV9ObFeCoTMmlYe/tnNaD6JTtLvgTw1v4yFoj725cXqLxDykxlid/5ONJLcjiVtGfPztPcrAyGP6c57A1+aVayI8TQ2XmVf" +
+		"LkeksQc45a5U9mVjH9VEyK3ndaDn/oiIVFbkKftHplqd9ki7VqeGw9cxk3i5WKZ1oGe9WRr1zB41NQwf88KLV2Hk/KSUx1wa" +
+		"uldWZCybyoo8f1b3XyFyHej5WmO0G+i5x5KpV/xfWiLzaLHWVrHzPdpdsZ/zy51XXivZlx979XrxPkz55WVlSu1WB3vuKvpT" +
+		"57RFBPLWtwM9t8qvW/kvj/TYQM93/VW7zKPHDrXanX+gWZV5NJmBlX05FWMi82hipVbouVwpc6Dn6uur/JdbRijoKb+hxr5c" +
+		"894Y2ZvFVe3Ot/Qn9DyhWeW/bKziKvrT7VCr6E8/tEz0517zy7F7fUqklQ9Kzw/03BdraBX9KRlp0Z/TSlVFf077nyr6E29m" +
+		"Ff25n7wnP2VKmePr6/Y/FXrOV8q85YSkLQNdLhZXtTufeY4r+3KGkJV9ubIRanyb4i8Vepb0hNj5njkY9DwiVStH3hNBhkM1" +
+		"sXNWToWeZ7722Jdb3hvoua+R7A/mtGzYzzU9eMtdYUdV9uXH6lChp9z2Vxrz88P3Ue3Op2qkoefMeq9253NGBXpuPP8Velax" +
+		"yiq/aP+kzEPkMmWeYkN0T4wn89rufHpSnxjPk/pkNrLz5d2WQ0akvNvXAj1IYw7Odp6ya+t85pdjBVhE5GXX1p0/WHZt3X05" +
+		"+zfGkzIP3rGUMubg58yzy677Io0xuqY8E+O50+rbyY1ILDD9Kbv2WCCD7NqjmCF79uBbnrHAprw35uBtjyO79sWzPJM/ZVXZ" +
+		"EyG//goiy7t9NzH0gZ6P2SLv9kWQPBujd/PeyLt9bTO9KW9zYVXJu33xIO3i+2Nby7vd49/YZR6d5sfO9swOdR/o+e5ClSn6" +
+		"8+H/2tmeCwtvT/THPN5Ff8q3TN4H9tCe2HnJeydbMM/G6nCfqaHpibTa7PRt7GLnm/3cnt05u2Yf6PnaVnoQeiZyuUPPxvrb" +
+		"7c53Ppoden54XXfombVwh57Lk1IGepb0S9ATmu3QM3vLnW/z2/PQs7RIAz33Emms+9md7z2ZY2kZdDnM41vug+jPLvNoYeXs" +
+		"Mo9W+ax7bE8e7p1v87SL2kWGHh78HXomg2KHnp/MY+i5Q54dep57yjzlg+nPZGfuKWV8meeRX0JPdsc+0PP9QlLm+DKnzOvE" +
+		"zlmU+yO63Gg00PPdEStloOf6ZNY9cgMyC6DnJ7gQ21OcdoeeU3oJek4lpYidRHfomf3BLr9ozjcmv2gRLdnZngtPyM72XFk9" +
+		"xymCbF0+kttuT3XYudctv1xFZmfS0CGxw8PO/bJuHcnO3PKMB9NKfNi5d3uHw869s5EPO/f+RGqwZycNHW5r78H2vOl3sD1v" +
+		"+d1H9ue8VUf255tW259PrJfD/nw+8t7K15L34uHLM7ntIpCH/Xn5pEzZi/DsEP8pMuMOGFntbQ8YufNaHIn/fGuX3df+itfi" +
+		"EBlq9nqHyFAXBztEhrrI7yEydPumDpGhW8TlGOhZN/uWQ2Qo0fJDZGg35w6RoXNJKWNdbDxQh8hQt7YfLb4x/Scy1H0pRyJD" +
+		"8nuOgZ67M3uvJHeYL+5IZOhMfbLC857I0JRZJjI0ZV6JDM3pCei5ZNSh555Rh557SZnFGm1koWfLHIee7cl7TmW01DDQc7JG" +
+		"H9Azpx0OWe97+gx6zt9SbtGm6DDQpcj/P255qelPmUcHi/Kwcz8zO2W9JyJ+JK6+RWKbnZGSN21s7dzXT0phm/XUd7Cx8ssx" +
+		"c5+MtLh6K3SHnonqHzKP7ivtlLuypna5K2kn23Nl9R9sz8J6Px67XvbsIa6eXf0hO7OXPBu+6VhOh7j6Ytd2yM58eNuPgZ57" +
+		"TjQcyc4M7iW3/Vv75VxEWiZ3hW/zePhue3SwdxAVONmesRhOue27mXyeLBTz5YSexSp9Qs/6fU+GqV3pCT3jVTvPZC/upDFG" +
+		"k1X6tHMvLO3zlN3HZ39m587OP2Vn7r6j08mg4Ox5io+YBafc9m4/edq5z0XL2J6XHeR50Y/P97yscKyzM/tzK+PJ9tzSMrZn" +
+		"Z1ufLMx+51lWh9QHPZ/UIPf7SJnjG1tlUp4szP2Tlo0xOr9tGd9YfFAnC3N3nuO0P6/5pf35DfNP+/Nl/iuIfMb2dILoZHs6" +
+		"Y/lKPNpyqU479/pJbSJD6SO25w4vT7bnIUJwsj2vK++xPUveY3uuqcHOiK10sj13+/iT7Xmlx7rVARKcbM9E6E5+z+8sk7cZ" +
+		"v+Apb3OH3aes992O4OT3jCf3dGZoY42d/J59SSnyNmW8nPI2d/7uk98z3/AJPUtmmbj6nO9GXL2Kx5+JDGUsxdUfiHU6M5Rc" +
+		"5ZPtObGtT+hZM6sHeh5lznu+zHwNbM9jj7Rbm1KKyN6VUtg1rKpzoOdWrQCnrPfdOaQTevYnZd6+ovwSesLLM+hpb3mKDMV+" +
+		"Pvk9S3pCZKjay54iQ9UJvjN+zz3Pxvhd9iPnQM/a5ZKc/J4L++EUGdrTsoGer3VjpAd61nicz9ieU6TuC4gOxs+e42J7Xr7F" +
+		"y8595qe4+D1XUbhL9Dy5R5foebxxl3OVl2y7a6DnvtmPX/F72t9ddu6FLX/Jbb96yhzosrARLtmZyyfPOm0vkpMlV1o9vrHC" +
+		"0rgu+wNz95J79OHXveQeJVP6knvUWAwX2/Nz55dDv/XIL9mefAMX23O39l5iQ1P6RWwo8dZLbMg53OtyMuhibV7Q895Tw7DA" +
+		"ihl5Qc9rz3tyo61+l9jQxCa/xIaSX3exMBNPu6BnSZ/xbl57JLEFK87VRJedXrwGRq6PL+5qvLfiHBeMnH+e/QqRL+i5PGml" +
+		"eCA/9QU9N3uhqzkT9dVOVtkdSVaZjJArJy6h9ZUTl6JiV+LqkOfKiUuIfHVZZVamq8tqmVOmna1YyeXE5WfKe7JaPnnGrzun" +
+		"vlN9errLajnzTNz5SildFFpPiKvXI89klfFNX3dWBy1LXJ1lcclKWng0LllJhx3qJa5+wO5LXL2l58XV+5RfBl1SA/Q8U7us" +
+		"9/SSrPeJt+9iex7W7ys7d+vklZ17SQ3W/ZJfPnwfesnJoJNlcTkZ1Kxwl6z31vNLZxJbpNWpx0iifmyX6zFzM6ufzNz80sy1" +
+		"8l927lNmyJPxS+1WdyvAFb8nn8nF77mIx1/8njnB12J72k026Jms/pao0RXJCm6taPyeOQ3aknvk62vxe7aUKXLpW2xy2z/8" +
+		"ni1+z3Gu/2r8ntOdX44xWo+UKe/2SO3Zv35ID4/l6M+Wnfsn0hijSwZMY3tm9rSBnjWewTbQc7+f/NK5Qz3fLrnRd96Tu8JC" +
+		"b2zPhYevsT0rq7iJDR1Lnl381gcpuSv0s3P/7HnmbO9Xo8d+ZMzIJvdoY+c33s2WPsupdL6dJvcIl8QrjfG77kgsTN7vJjZU" +
+		"+Rtac3LGF95YmM1Otw303Lc19Y3V4Z7Slkt2QKSh38TSbjCyfVIDC9N+pNmfj5yXXyFyY3smX7Dxe052FS1nhjI7RY26aGSL" +
+		"39OOuNm558Rss3PP/qrJ6fx8y9xFlFKKnfuZX578bXq6x2+WZ860ZcZDzyoG2GTEf2p0eHxTWmbnHr9Ss3OfxKubnfuSmevM" +
+		"0HznvVUGpLGM3zNzLhnxTo+0nFdfUoqZy3ZpbM9rT32y5tb8Us7xt0ynFlhjLVlJd2pIVNMsc67yFB1p0HMSy2vQs8uNbo+c" +
+		"AhZQg55B65ZT6VOe2RVa71pOpbPQW2LuVtSWnM5oxPY82ZdNVlJnZzRZSdlTNbZn6SmT37NFB35PM7fH9mQPdX7Pppc6v2fv" +
+		"N8nO9o4UzoT80rmuJ8+qcwozCXqKD3a2Z6yJnszNNb8Uc2cxdJweT8+zDlnz7JZz/JAeOKsGMfd9LSR501CiO1d5fX8psi6u" +
+		"2KHnw7bol8y/9AT0jDenO5Ueb3S/2NYt9R385HkmLyK6X/KK19QAXVgTPecq19Tn7Ktshx5OD6jUeTerlaq3ZP55L95N8Z/O" +
+		"u7mwmDv0zIn8PtDzmMSa+0DPI6eGutjQ8q1BbIj10hMbYvV3kfXeU/sYv2NLmWzrOa3umEjSMhZmzbPxjU0wv7MwJ0jUeTdz" +
+		"OqDzbiZ7vnexhZZfypvmJenyksr8V/KRu5179RV1O/fzTm27WZZnY2Rj63bomRO6HXo+Z1qSnMe8x6Nd8p4TDVbJzvbsLLzO" +
+		"9rxhRmd7Pvb4PRnx+cLurA55tvIWGQU79+RT9NvM5dPuMuL3T2oQVbkj2VVYVfoXPfU09CxHahj65aRaH+hZbvkGnd/zvlLm" +
+		"GNmebxinx5Nv0c69sFZ6TqWz3ruMpY0N0gd67uf3l/Hrqh16LmkL9Dz4drqo0b2nTFHbfPs5Vyl+3KFncuE6v2dJz0PPz5W2" +
+		"8OtCnht6zrL7bui5yGi4oeciX+o+c6q5kXB62I/c/J4bxLr5PTfIc4salSml4CUpeS/esZvENltSA9uMN+4+Y7ukBllXn+gg" +
+		"75Y/+M6pdKN527nfvCS3nfu955lTQ9bJ26mhp0basB6pwc59/pbiTJu95n1lhdPqnBri776v+HUjxa+bX5qDfB8327PIGrrZ" +
+		"nhW63NBzt8O6oeeZ8YOeJ+vlhp6NT/u2c+/W3tvOPR6NW17SveXZwJApPS82NMHZW2woGUy32FDYkm62Z7WG3tBzh5439Dy+" +
+		"zzqPYtp5YxPS1zlXmXGQl3RlNHtOtamPhdnZD7fY0Afa3MlL6pGGhRkf283C3Na8VyGydsLIHYLdIuvf3uXd/M5I3s3zJ2r1" +
+		"K0S++T3PmhJli5To48QGi+QWNZrsP262Z05P3WzPJXOA7bmKyd2iRsUafctYqkee8ck4l3eHK+mTZ2zPKWXK+Ld7vaHndEQS" +
+		"k87XEL9ny3uNLzWtlm/d0pbxZV48jze/Z85S3QM9S7J37/g9rQc3v2dNL8XvyZt583t2kaGb33O+8iwnbvQ1v2dyzG5Ro/VK" +
+		"7TLOYPfN7zl9nznxHNyL7Rn8gp7Zl90DPY9dq59werDpHjv34xPJeSKz87Fzn76/HDrc4p/PQM/9AzMefs+crX3C6XGnFGe+" +
+		"+FmfnEq3Ajx27o/I0BNOD7uM58RHZhyek2/6zi9xXsDZB3puEOuBnqd+eb5nLukAPS97jifo6VTsAz0nuSsP9Iz/+bnCu5Iy" +
+		"E3PXTui533nPqeYjzy7n+ukAPZPT84ga4SZ8pdvuPC0b6JLTYU9ODfE6PU4NfXu3BV2UYuc+3ZFWfAApZbPWn6QxfsdXkvW4" +
+		"RNplQ9PBzj2nK56Gdcwu4xnoeSTP/hno+e6GjAM+uftKmfJ2+HYe3k2cntfD9tzZTg/v5m7Nfng3G9v6sT8PT9UT21OE6YGe" +
+		"3/GDnucnv6xyLlODvUNJ7YcT6ylFTvycX0JP3F5P8pLg0iM2NPFgPuH0eFLmgx/JL2HkhzXxyEtaf9aDXyHyI2Op8pI/okZh" +
+		"UHxupzm+5TvPB9sefs8Pe+Hh9wxGPc4TNSvMI1s+UfaH33OvkS5556ldNnm+fVGjZcovb1LaIqeA9fewPdfMXBlLp3ONj3zP" +
+		"ntki3zPZIw/0DI/fw++Z3J/HeaIe3R+nTdk1T3buJfWFCTFl+jKnSNhaMiPYnslCf56wmaR2px0GejZsnEUWdcPGWdaRHdnC" +
+		"xnkNi7lh43zXrULC6TGYjdonfs+xmrewcZaBNS1snFNLDYk6pBQr3MjibNg4t2OcFGjYOLdn5Ji1LxvnyBFsYeOcx4i1sHFi" +
+		"KW1fNs6qPhlLZcozkb3vM5wlj9rDxhn9widXtAV63sNz1rBxVqtDw8b5fsOpQVRsjF/Dxlnbpa9zomhLDVbwhw6iRvujB0WN" +
+		"jpoy+d5Pv0xW56Flyeps6pPVKerRsHEeOJAbNs6jbpE20kESFdsjWcE/Wg09p49WNyc2rtQn53hOfcn7MCdaeGXMJZH17dSD" +
+		"/J5lMg7J6jzox/bEG9WwcdZzVUOyOr/SQM970U625/RRH/Rsp7ZAz17UBz3tdBs2zv3e9LXI+n7qCftzLMsNG2eZe541mEUj" +
+		"+/N11bv25/Phy3Gi6Ewv3Tgpd/qJrF+N7iLrZ1odC/OmkdhQr8ZhYOQ+f/LLoR9kaF82zv5rRG5h4xQdadg4351m2iXqV9Iu" +
+		"UZUzz9g1H7qyPVu+G7an85ANG2eZZ8/YnvOqN2N7Xmpge+6TGtierNuGjbO0Rjvo2Vf9/mDbmVKmnJAgCPQU/2/YOKv1tGHj" +
+		"rFPVzpxlz1f04AHO1ydjSXS+YeOsffhEGzbOeq+RcuJmJ7Fdap6xXUZmXMPGuc9jD9Wwce7bOBnasHHu58D8ho1zby2SnOOR" +
+		"fdCwcR5O4bawcbJgGzbOY9bqsHHOw/PSsHEemxmIjfNwdrhh49xkwDRsnNtmdQgbJwu2YeN896tazfbEiNSwcb6bIe0c6Pla" +
+		"2pGGftOlduj51U/UCGNDw8a5OS/VsHFuLb175evTu9m5PykFujzRYczBY/NLtme+YWycFadnw8ZZ5dg0bJwvKEUSmYXd2Djr" +
+		"0fNe4ZXU8y2R2Q9JVueqB9mefBENG+drpeaZnPHFDGF7fgrdm5zxb5m4jO+Uwvasepft6WRjm3Ji3fqKjXP7fOgusr6lB9me" +
+		"rP6GjXM7VvrxbuKMb9g4N9kHDRvn1iArNs5tmfJL3rHbSEPPzxPJ+G1pp8hsZiT0xEnZsHEW57sbNs6yNjXwbm43jZwoKk8k" +
+		"FlhqZ2Ee3/cwdmWGiA1h5m3YOCvOuDZlf76nTJ6Xb30nb6oZKSc+63LYOFtmiNjQj4v6l4gcNs5+6BUx9+8cEDVaYHzYOJdN" +
+		"3fyea/Eev+d6RVp5QfWfs+x7o11sz2pesT0/n9TnpGbRR+FRyhy3c69TaricmzHL7NzxB7ewce7f98bITr4wbJz7BK2xce7b" +
+		"8KI1bJx7H3uTho3z+Jj/2DhX+9yGjfPdLK+kCr8qSWSv5pc48YYPvWHjLOlPbJylbnnmPB/rFhvn3q+UcrOx8ks7966++D03" +
+		"+l3JizhJMnr0CzbOd6TzjF+XbYaNc9+H16Jh49ycZWzYOMsS3cMEbxbM4fsYccWGjfNdpVPD0G8dfpGGjbM4i9CwcR4f3+KX" +
+		"jbOnPnk7rLg55zHnSKJGZg82zv1Mmdg4N7ZZ2DjlObawcR5npERmUwq/7kY/5zFrS5n4ADNDRI2OLRL0XFNKc8bZnICe56yX" +
+		"WrICU8PjRB1toedlHcHGubv/ps3ZuV/a0rN/NZrQ87HeYeMs39kaJvjVqMhLcj9Mw8b5IlZKOfAx571wsaUt9g4tEjaCmvec" +
+		"x+wp0/5uT30yzi66Z+f+UUPYOGdl8m7i32zYOI/bPgYb53F9Jd5NyDp/c+JTptiJfVrYOK98R9iS5O+2OWxJa57hYoPd2DiL" +
+		"O4oaNs76+ZaJ7yPjx7u5WV+xcb5rk3EI30e+jvB9HLRlYf4M4y8ReY7tefoy2Z7Prd/5Pdc5z9htLFFsnAd+lhY2zifz+LHu" +
+		"Z5Zl5/79pXjunVLkEj55RnP7AWyc22ItWsISMqI/DRvn+0WvJDFplvaSs0YlpYwVYBp+1oaNc+vWxbBxnt/6+M1GzLYtOefe" +
+		"88yJhpGJ2rBxViwoDRvn4XR+w8ZZnIZu2Dj3My1LvierOGycWeHCxikfv4WNExttCxsnZr22fM8azSQ72zu/dFrYHgAbZ8k+" +
+		"cAmX8Tj30bBx1g/7GRtnrXck+wOzABtnvdhRS85jXinFCh5t7dw/TVvs3Is9R9g4z4MOdu5L2gI98cm1sHHuTV+HCR7OYuMs" +
+		"W1odJvgj9eU8n76GniJ7DRtn3TJ+ThSd3xpELmdzSdSo3nlPzviizJ6zYlotJ97554aNszh327Bxllby3rCtt0cNcuLPKb8s" +
+		"Ms/pZ+c+fX859kZ1MkOSl7SmvoEumO7aggvE2cK2OFG0We8wddbzyTNMnVYxTJ37ah1Zvn5Prb7jt/beHZ4Js05e0mJvFKbO" +
+		"M/NMVmddU4rYEN/Akp37k1Jyz0tqEHm+857I+pX3RDUXGkHP+qQ+XOK3XpLVWdgImDr33Z5xSVanHQimziP7ZUydhzPjLUyd" +
+		"7pxpYeoM8mHqrE7BtzB1LiyGMHXipGxLYkNbpIPH5q/YyEtYQlipODy3KbgX9Mw3xfbEEdwwdW7u3mmYOrd4M9cvC91Csrbz" +
+		"PGLq3DDwN0yd7/eWUnx9ejNMnc+VX2KyNJaYOssypUwx6TPP2J52TZg6S2Y8ps7qLq+GqfP9Tk+SqEokGUuxg9fcowFL1zDB" +
+		"8xRg6nwHne4ylk64jqlzP/nwMHXu3XqKqXOXZ9IwdR7zkdrH17eZSZg6DyeIGqbOo90pZYxR0DpMncuW95wGeKIDvyCr8cvU" +
+		"udOhhSldn0HPxipeg548Gpg6j1LzjPdoTplOpNhvYeos2fGvYVJqeWb1e1LfaTdE96An62jNzn3KM/ufLRImxPRLy86WtrI6" +
+		"3RbTMHW+67lWQ8+HHYWps9SuJ3pW97wnKubrw9RZ+7eGalWJRD+7bEydReZFw9T5rq9aLS9pf4xRuOa+bbGz/Uq4Aue8h8XG" +
+		"DmRNVqdVDFPn8W3ZndPeeveWkQV5wtSZ3Qmmzk1eUsPU+e72UqbzpkfecyKM3YGpc9vn1CdnPOPHu3nn++PddLNRC1Pnv7VM" +
+		"XlJ6gu3pHs+2JrK+a2dsT+srps6y5EsN1xz0XMNzfKYUsa81z5wI29Unsu7Okoaps54f48672e+8h+l+zXuifpl1j9WdRYSp" +
+		"c7/Zz5g6DzcjNkydx0+A45eIjMPzmKxhODyPyezE1HngPW1fpk5IgKmzrFt+WZ1KmknOu9X8km0GF8LUWfZI1nZWB6bOw/0U" +
+		"bQvH0sgsbJg6iwhu28KxBJ+3ZMtf3hM1Wk81QE/3ijVMnftzR8LzteaXWELgbJg6d6tDmDqPOTXwm9m9hqnz5iUJUyeu5oap" +
+		"s8o/a5g6q5sKG6bOXa5Rw9S53+lBJzXdvdPC1PmJfrlHw4zH1FlkY7YtPMf2uZg6q1v+GqbOo6RlOY/pO8XUua1Tfuk0tHVy" +
+		"+57HpF/OY4pyYOrcYgtuOY/JjtoSNUq/QM+65b3cwaedokbuvWuYOveF9w1T594yYs5jfqznW3iOnzwb+i0Z6e95TDrI6nR6" +
+		"sWHq3OYzkvHjwQ9Tp2yHFqbOo6a+5lwS/TB1/pskK3BLme55SS9hUpJp2zB1bm4UbZg6ty09z++J+bRh6tz2fGPQ88g4QM8L" +
+		"0mHq3NpDB+jZ2U6YOssn8yy25/c9nqV8/bE9q1Fhe+7wGVNn2b+lyKjLGImsF360MHX2YAj0vEt+KaPu0LIHE+n32bA9nRVo" +
+		"mDq3eHowddbtW6Z88pr68HDzBYap80oP5jwmCwxTZ228Y5vYUI+23/OYYxyweO6rCHKJdxMuYfHct5Ep2rB47om3YvHcr0+e" +
+		"DQussW+xeO7356/YyPg9DywQreQGzJGp2fB7HuuUlvCb1ZQvb8BYYvE8zq8+okb6LyyeH/MxLJ7zOIPYSu7REJvE4rnF5ik5" +
+		"qRldc4/GyCxs5XtSM88S2Ysk78N+Liye5536nKWyOmDx3Nf0H9vzs+U9UbE974lcWjOxeB7yNxoWzy0xCCyeGyb4VsJDl7ZA" +
+		"z/jssXjWuepd6FkzBxI1ulKm08KZA2xPvP4Ni2d1Y0PD4rmfbJCweO4ZWzH3U4yg5KwRzwsWz3rueXaz91JfeHIH1mDxPPLt" +
+		"l9zhNk55tbB4Xuz1sHhi1GlYPOsnYyRqVKx+WDxfXKdtzmPyKZScZi+pj9+T5YTFc3cLWMPi+e7q6ZCMJfYJFs8NK33D4lnK" +
+		"t4bHfSb6DHpiAW8lfk/RbCye25Q5cYcJK++JavKAYfHcN/txLJ7vd0p3UaPPlhrk7dS8J3bCA4zFsxzFSPN7HuxgLJ71ztzl" +
+		"98yeqnz9npFENa2aWDxzc33D4nnEXxQWzyY3B4vnaxvr67DEL5HsbaeUWdg5tJUTv+QrtnNv7Cosnrszew2L517yBdif309a" +
+		"ljMN+vqRUVfTTqsffxEWz82dOq1+TxQ9JPeEiFXW5CWxSWq8m7zYNftzKFUTWd8jyQ7glQmL58ETicWzZoyweG4Xr0XNecya" +
+		"97Cx/RVei4bfs05X3pKTyyaosT15JvB7Fre9NSyeLybSJzF3+1wsnrXJMqlhiV/zLDdFNVJYxlNmbmHNMzF3vggsngUDX8Pi" +
+		"WZ5vfe4P9k1h8dxjOWHxfD/M/PIR4zde/J7PpQZ+zwOW1txCxNLG4rn1JxK/bsbSzn2FgjX5nntKkfdRI2GBvFPf4RyZ2SJb" +
+		"fuWZq8mWX1LfQM9zzTN3bNiBYPHc52/tOHTZZlg814dfBIvndqfP7Nyd7WhYPMvCuq3hQPZFY/F8F2J9xvYs31JknK2pz2nv" +
+		"Fim36Ool6PnYZYTFc1pTu8hsxq8npzq/HF/f+W0Z2wwmYvEszsM0LJ51EvHH4lnnzAJ+z3bRKLanHRYWzz2rLRbPwy3xDYvn" +
+		"gcOtYfHcq+gWFs963KkvHN2pwQ1F0TZ3bMx5r2M3iQ5Bz9QnLym4YOdeRWaxeJZEbbF4vvuK/HLB+6AtT74/NTzJ6TF7oGcy" +
+		"Z7B47u4XaVg8S8mcSGxItBCLZ50/KcXeb8p7A3mWM1IX44Qodu7ZgWDx3CajgsWzXrAnLJ677MWweF7ig1g8XySvJBbKmmfj" +
+		"GzvvSE5FlZSZFaCRcGVsecY7ZqXC4rk5+9r25CWVtMX+/FvKo+fHOITF08mIFhbPRKaweK5Zt7B41uHJ+hUi74kasdvwe766" +
+		"PiQ33IhQ77k/2EoYFs9/a1d2tnlm7TNXsXge5UoNZueSZ2bnHMnsXFMm29PsxOK5uQOpYfF8d4PGme258r5h8Szu5mhYPEu+" +
+		"IiyeL5zcpMLLFCnoYmRje555DwvKnbZkZLW65aRt6sNA/m1Lt8PRL9DTbTsNi2d1U03D4nk4A9n272l2v+w4wOyPsXge7gRv" +
+		"WDzLIra256Tm9z35yNBlz/3BGRUM8jvvW1g8N6smFs+9sCyweG6JXWDxfBGEtrE957T6ZnWkFKt7viK2J+7KhsWzuPO8YfEs" +
+		"M2sai2fJ3gGLZ8E817B4vmOr1TKWLhH/sHi2lhrkI39rkI/MFx4Wzz0zRMbSYUeHxbP2lvqy+kWH8Aj6HhI1SssSNeJZ2sMF" +
+		"cuWZfOTMwdzvJjtmDxdI5jW/Z7yZWDyre6pbWDw/R2rgOctMznnM6CcnvqbnZXVe0c/OfeWRwuJZ6ydlDuvs4VnF4nk84mBY" +
+		"PEu1HmDxrIl1hcVzEWXB4lkTb8LieZy+nLB4PnY8WDz36cgzt7CK1x3hAmHVhcUTD0oLi6dzHw2LZ3UfWsPi+SJYasCBnPfk" +
+		"xGOgbVg8t8NqhMXzXfkj5Uzwh4TLsuoXsaF4/o/vLcHackU/vSSyflrPw+I5sgN+hcjh93zsMY7cvn5HcpJRfumRbPmvFIa6" +
+		"tETGi68Ii+eWnVFYPJcWSb5nejP5nnbgR84a8Udh8TwWGURHzrk/+aXbB60jWDz3klGXLe+mjobFc1ut2Fg83x7Le3ZGeyQ3" +
+		"fG6RcGVMaWfu19UyLJ5NDh0WzxeGjCXbc7OmYfGsqz0+Fs/1Zgti8VxvXwoWz62y1LB4bsecMsfXd2Ye83tekOfIafYzpdj5" +
+		"fdKyRFXyS6c5ppQiW75GcpoD8mDxLFu0hZ5FbBSLZ+lfieeF/YXF8909RXKWaqORnftkT4XFc5+hCxbP/UrP34lJ5z2eiTtl" +
+		"ul3eHg6L51GmvOfEzZpnMs7OtPOxj9GfbM8r/Zk71vlTjm9Wp/mS0+z5wp7c4KCv7dzbJ78sMuP0vKzOPaOSmHtNKVY/OxAs" +
+		"nivGoHaEA7lEgjxWcCyem1urGhbPUs0lLJ7VXWkNi+c++RqxeO4HHxsWz3cPV0gis/AkLJ7PlF8mKzDP7O/YrFg817ulPvZz" +
+		"SX1uWbKrweK5xbbG4vku/BfJeTejicVz23n4sHgWJ4QbFs+CxbNh8azuSGlYPLfj+8z+gF8Ei2d16rhh8XxxVu0sTHymDYvn" +
+		"iwV0j3dzy3uyH6aUiQvEHvUMF8hXEnleU6bIZYlG8T/8ley3M35PEdwz9wez2/B7rphA2hmOJdYYFs8j54nORI1Eos5ky9tN" +
+		"YvE8djM3LJ6fJ79kW7c8E/XbUp+dO1wIi+csUoPFs9YzktuZppTC9syck7H0gZBneOjYBGHxTAwVi2e9WiRncXx9WDz3xw4c" +
+		"i+f+sM3OMCnZgWPxXJNDh8Vzm1vqO53zjWR1SL/we9YzpThJ3COFxSYt4/e0M8LiuVUYjMWzLPmKoOeVLwV6blAei+e+8xBh" +
+		"8dyTAXPmfjcnnbB4brP19QwH8pH68Ot+6xM1ylxKxhJMPMOkZMeDxbPG24fFc0+eY1g8P7MannhejAMOZDfzNiye28oewuK5" +
+		"7fIcsXhuWPcaFs/tYo1h8dyShYvFc2v2HFg8t54xehK1TQ3JyEoNGDBZXGf8nmxWLJ7F2eh2ftFz9DwWz9JEUsLi6ea0hsWz" +
+		"4rBqWDzrPk4utyun2eeU4jQ7rAmL5wzNsHjuic5g8dzd/NiunGb/pExcgVMkeQN2Q1fu31gjyRvgfcDiebhnr2HxPHKyEYvn" +
+		"0fjKrvDQsYGucCD7GrF4rvdJd5H1z55S5H3AAiyeW01bcgem9e5KZH3Je84kLqnBqSG+9yt8HxD5Su6RXSKmzrJHI3vwnKu8" +
+		"cmJdHBpT544drWHq3GMRYercr0/KFNlj8WHq3H8o7n6JyDg8j5lFfuU0ZuZA7Ms55bvBZ0754ZGtJHHnzLIW31h+ae1Lm2Ul" +
+		"YeJtmDpL5cO4gpDmOKbOmpxcTJ01vlRMnQeOoBamzplvM0ydLeMsMhR8xtS57ekxCHnzgl6Jq29pi7hztO25H9lYJq6erwjX" +
+		"HKatFqbOFQpi6qw7XMfUeSQOfCUj3s72Cs9xiyTqcKWU4vyzfrE7r6xNTJ3lksd/hef4znsiQz0S+xl6hqkTI1jD1PmWmWe3" +
+		"E3ypwcyVXY6p892/GD++Tawr7fresaEncseGqBGmzm0RSQ9T5xz92Jc1WPNk/CLtmAn02eMG0zP1YUkskS796VvMDZgswzB1" +
+		"4iJoYep0/20LU+ej9pa4uoggps4Nj3nD1Pm28yRt+NY3UhFpyC8rv26e8TDw7mPq3C7zJUydOfmEqbPk/OeXqVNUDFNnPa2a" +
+		"mDr3yXmmljvW7Tkwdb52YidZAdgrmDpr9U1j6txyehFT57szm0lyH+a858ye80wt0XO+FkydRzU/MXW+GJz3zMFPfokNqqYU" +
+		"8RH51pg6t4mVhalzy/kiTJ3vOJCgZxEpxdRZC4sBU+f+yKjD1Hnk3DSmzqOJBoWp82DZYOp89UsNFV+rtrSca84zfCasrDB1" +
+		"9iu15451oxm+D7MOU+cxssV+hcjh8Fxr2vy4MUtv5vZ162mLfSkm0L48x1oiMpQTNpg66zJF2mTFppTkdEaqzijpP5GhNfMK" +
+		"ek5Lfsk3xoeBqfO4M5NkxMfv+WXqTD+EJf5JO0W+2FiYOrfGi91yv5sdFabO185X5jcyZB5Dz3gmMHWWz+ULC89xZqDI0JZv" +
+		"P0ydrGJMnWtO7mPq3KZvKVaHfKd8m7PcLUydu/s3G6bOY2ppp5xOOwlMnbXyQbXYlxk/9uVjZ4up8211njlzGQQRGTrFRjF1" +
+		"vkuM2Wl3jmWphakz3Actu3M2MqbOY93yzP1un9SO5zjoAj3blWdyqq0AmDr3eCUxde450R2mTrzY7cvUyd+NqXObYCmmzm22" +
+		"G8LUuS3Wwp4biuACps53p5n6El3+kOiwp2VOBMuswtRZ4p/F1FlqT+0y/9gdmDpLZ0dh46x47xo2zneZVF/OVbJ6sHHuJ6wJ" +
+		"G+cHkoeN0x2DLWycnyfvndhUVtKwkavRDBvnMeWX8hv21JBzhzS63PQFE3s4PeyXsXG+o2IcWmIgdBAhz7kkbJxv79I9CGk/" +
+		"go1zu66UWTEqaosI+ZL+hJDLE+l0wpru8otKTw1jjI70Ei9l4gfYOEu+257sTF8jNs46iZti43z3mt5jRZ7iTT33XIr59y9j" +
+		"Md3DJ7f+FV4LbJy7u0AaNs7djcEtbJx4vhs2zh1PW8PGeeTEUs8dbpm5duDH95fsE9jWv2wfKRMTPNQNG2cTGQobZ3yGYeN8" +
+		"eE/Dxvk8kfCt4QXBxllzvrSH7YO/ARvnvtsDY+N8sTu/FFmwJ+05cbmkBvpZecPGGX9Uz3n1kveG7bLaQ/Xc4cYP2XOHm2yY" +
+		"HoTkgephRMrXJ28zeY3YOEsi4tg4983+HxvnjpWxYePcD56esHHOduBh41x5LLFxbi26Q8gqxomNs2RXg42zrjyB2Dirm0gb" +
+		"Ns66wXxsnPvOu4iNc9/tJLBxHpPxw8Z5lO978htYBWHjfESGsHHWhX8PG2cNgw82zneO3yReBHiJjfNdKjaSCDLMuHML0ZqW" +
+		"+frsFrBxvtr65UW/LdLsfjK1Q89mZ4uN8yj2Ytg4a04GYeM8MPI3bJzrc+WXTj7xgoaNM1GWOxHyLaVcEDLv2QOceS971JPk" +
+		"VLrvFhvn9u15XsqL9RI2zmTphY3TTYXty8Z55b1YWcZWdqb7AFrYOONxCxvnOeU9nCVyUO6cSi8pxdnenlLCyZL3sI5lLrEv" +
+		"i28FG+cLUuZLOD3E5MLGiR+5YePcPnOeYYu1it05GSRLAhtnSUQXG2edeDSwcdaVTRI2zulOKfZw1npsnEc588vTfjI1sCKP" +
+		"v+K1wMZZ15K645mOPmZuxsvuvLNBwsbpHqeGjXO9xfGxca4PK+7OmaF8YcnblId05w43KIiNc+vQ884NmBlZsXO3Qbc7mUdL" +
+		"njlTI9KGjfPIOf470Z9vfUb2SctuLIKpzx0NGSG7czdat7BxFvvxsHFe1gpsnFu8vHfuD86Y5MSlvTo2zn1vKdO6by3Exlk+" +
+		"8gCxcRa3cTZsnO8mI2Ve2JLynqx3/ss7We9PWiY3wPx/kvXuW8TGecRbi43zWPhosHFuYcx6sjv/llLc/Z735NZCuufLiJT3" +
+		"cgtr3sNLsuSZCBb/Xtg4T7PuyQ2YovrYOLfnk1/m3OHoa2yc5fANY+MsB0sGG2fp1kJsnCXxM2ycNblN2DirewsbNs56scKx" +
+		"ce7hXcHGuX/YFtg492rEsHEeYYF4csd6SSnNzNJnTqVnr4mNc//sKcUdbuml2J7sbmycL7oo0z0aK9s6bJyntf7JLUTRnW+z" +
+		"sYrDxjnx5mDjLJ+e91go1jRsnEd/0hbRLViAjfOVdtLQr015L3fV0CgxnpYaHpFS7+VcJRvyyU1t6V22Z9hpsHHu4hUdM+I7" +
+		"JxqJr2zU3j+5jXDKL3mqB152zIjrPXYgHTPiKxVS7p1ImbiFhp3fP4ljju+hY0Zc3W3cMSOumKI7ZsTVfVMdM+IrpS0yrcqv" +
+		"85E7zsQVC3fHmbjPkxKvaN5JNB+5MR0T4nqf+SV9Lr1y0WfEGTomxNUOoH++dxikFPq01ECfMXf6J7umlvfCs5r6tHpkZXRM" +
+		"iCuGlI4Jcb1vZSY62fVt7rd+8p6d7bCHOibEd1+mJ9yf9Sw0yv1ZI3LZP7k/a0sNfJtbyhQ7XyMlLkuHJvNvN3p4ZJ89LbMr" +
+		"PPIMm1BVX/KKi3bmRHDVsm71O8yy3GFQlCm349nVLrfjOSI5szepr9Ov6/lEJ9NnnX4ZsU6/nvfo19NO+vW0JdFJ/el+66dr" +
+		"tXNrzkZ3bIcv1ijTuTXn8vonp37zTeXU72yOW8HdY9PDdvhMvil8Xc9DW/71z6xlPESfWX/yEGEw7Z/kDj+0velw6wm5w58p" +
+		"pYhAftTwJIJsztv/fDILwqf9yTP6BV2enGrWL7nDetUTucM6MzLZb0EC+59P0Wr7HwyAHafh9tlTJv2OlCL3OyjxvY1AXzt5" +
+		"ISex4y3cPr5ivIXbp+WZdo61ok9h3vL94S3cPuNsR8dbuH18jXgLN0xwHW/h9rnznnaOqGbHW7jxtXS8hRsvVw834TSpHUpN" +
+		"MBg34SutJHdSmmdfbkKzYMoZ3SXPfA+D+aTjJtxw8HfchK90kay2m5743ruSX2rnltpzkiW/xKz//PoUdcc+uNk1deyD22Qs" +
+		"sQ9uPGUd++BWfIvT91ZULeHnmXY9Fk84rME+uE0wA/vgKxmTFm8+DUQEp1NPt5xEVXvPfZ/eC55YAcINOF3GRK7YdJkD8Ua3" +
+		"PMvNJ9opljfBE9yA23SnTBxIW95LLC/vaVlmWc7IPt67kwPvvZzy8n2HG3D+0DY3Pn/Unt1CZlIYBj55T7bDbLbc8UWYSbn5" +
+		"ZM4vMccuegnW4PvsuAG3eTUqOaewqg+ezJtS4MmcecWfMhf1ZUdQ84wO1oopt5tUtcMT92h0LH+vpM/iT9kj0eFImXQ4jHvy" +
+		"warZA09ma1pY/ubMrNzqPHwtPSx/z/DfdEx+2zx24B2T34Yloc9fr/JE0k42Qpj85GP2OSzSkGf+skgvJO0cseY+50TrnVK0" +
+		"08zC5LfhtOmY/LbZfJlzvyiUx9b3SjS6ciY+knu4WEth68Ml3rH1vc8ekujFlBrClXmS8O3O2nIl8zzvid2zKOfc8bTkl2L3" +
+		"37Y4ObCqPScHVjqw7N072efYR5P+bLll5iCJ/a40gjZTTyn0Mz/n3PFU8h79Sp65ZYalMecWUZbNnFtEa2qnH3TD1re5YbrP" +
+		"yU04o1F46D0L1+lJo3CdnmqPXwQuha1vufJeYtu/zn7rePxqYxXj8TvcV9Tx+G0YBvq/8fiZ1TikpzstoflXovmtV3KmILOT" +
+		"X4QfvuPx29aPUQ+XyuKXd+5+px00W61auPq2FQ7h6tucd+vh6nNeqoerb51SZvhS8h7PqpUDV9+2Vj0NzdY9v2xO4eaXsiqh" +
+		"RLj61ozznaiwVucGZuiCq+99Zn4kd2vPLxPVpwOkW8fOtmPk29za1ufcgrfnl/Rraod065n6csO0cYB0a2YSpFtP/QnpcIB1" +
+		"jHzb2vIe/SBdGPlk9naMfNvKclqSmWB9XeL7GLzwPYx8+Fo7Rr5t7SnzcBfIQzoxPaQU7RyZVR3r3sbz0pdkt955pp1m1hLm" +
+		"k1vLctuItSmse5uZFdY9tyX3sO5tbB6se9tmnmHd23Cq9SV31MMazHpb8BKz3uaekI5Zb3NzQMest/Hddsx6m9hvX5JhcOoJ" +
+		"SLfZv2LWe9/zDNJt5hJmvVfSzvCS2m+FWW878owOZyQ6VBpBOpzZHbPe5l7sjllvk6/bMettW+YEpNt62kk/NlCY9baeVt+e" +
+		"GVtIt3W1Qzp8ER2z3uaW2Y5ZbyuZE2F1ttPFrPc+KyT6sYEw621bZg88K5M+Y7ltbHLMeltZ897Qr9i5hFlvig49t/akLe6D" +
+		"XlK7DOvFrINnJXMCnpW/5rXAube5U63j3NvcM9Fx7r3P9BgUdL6049zbRDI6zr1XogEULJlJULCceUbzI/XRfE99l18aSyhY" +
+		"MnegYLlSH82vlELz4AIULPn2oWBhAeHc25wB7jj3Nrd4dJx7W4FDOPe2Yq+Oc29zT3vHubeVnlLox47CubeVYAYULBl1KOgu" +
+		"0B5mPUy1Pcx6lf0cZj2x+h5mvcpWCrOe7NYeZj0nBXqY9erI3eph1sPm2MOsV83cMOu58axj1tvkYHXMelud88smF/QmaeeS" +
+		"2rUTtmHPe6WhEfa8rbJksOdtVV9jz9sqW3f9co9OJDrUlEKHPaXQwQ5rTZbqmTLpcOUZHawO2PO2OvL5Ova8rV55j34t79GP" +
+		"rwx73uZOz44h7wVPpUDByorDkLdVFvMaduaMUfhFJzqEIY+NgCFvc3a4r+F4sq9ek61f8gx3/5oyMchZ+THklSu9+2XIy3vu" +
+		"9FxSn1zUqi25V4kPY829SleeYZmxAmDI25xQ7Gu47S89AQV3ewcMedt+5Zf0YxVgyNv2M7/MaYTUTj9f1ZchzxcXhrzdN7Ym" +
+		"2tXTTvr11EA/PhMMeZus+44hb9thPoa8DVtSX8PxdEfKOXv6wbrdVxzeu+OjdyHYkTnxzZEyDndyV/REmJvOX99F3cOId/DM" +
+		"Yb3bDhiP9e6V6Aq/3Ezdsd5VcdKO9W7D5d2x3m2ydvqa2zjzRcMvtzl0rHevlBpolxkIvzCkdKx3r2RM4NdhrcB690qjx7Yw" +
+		"j/Cp4bbbZI903HavedJJyZC6Sfys5hxuu+0YEbqO2247eNhw222Hr3bLbZx36qODObDlRqQ99eFo0Nfhr3NLYg9/3clHueXO" +
+		"OAi55YQnfMZft+HX7fjrXk0+pM3pvrxXPMt7h2d0gFFnSSnyRI9IIm1XSukkvQSjZDt0HHXbaS3EUbed/EU46ja36HYcddvp" +
+		"m8JRt+Ew7DjqtpNPCEfdJp7Vw0N3ieKEh+6CdOGhu6b8EsvLkrbQgd8aD93bj/SDUdeS9x4S/cIgzx+Mh26Tc9y3ZHymr2EU" +
+		"puGOh+6V8kt3bJRIdMj8zJnLmlLoUFMfHWrew9SZmSxnadsj0aHSCA5dLKJwzV1s3XDNXfbc4ZrDF9HDNXe1/DL3rxsjOIRz" +
+		"uX+55qxN4Zq70rvhOb7zjH4Z2zB12qtsuYcIduOa2y7oiWtuu25jmzswn9RAP1GBLSzx/D645t65p5QwIrFQtpyrnPJLJ4Nm" +
+		"ZeZk0KxlOZUuloEzbnOfdscZt+Fw6zjjNtwLPZxxbWR1dpxxr/QhYT1ioZTcxvazs/4VImOT25pvGJvcK90k0TvfMDa516gq" +
+		"JBpYa3HGbU6ndJxx79czkWjHKsYZt7khspfcEXxGot0VCecYqziccbgrezjjMMf0cMY1a+2XMw6C4IzbWssv6Wd1xRm3Yero" +
+		"OOO2ZodTwrhplcQZt+GE7Djjth5tc9PQnV829/rRAZr1KS1z29WUtjzy3ZQCzTp8Dmec/LqOM+6V9GdYj+zgcMa9WGGMoFmH" +
+		"1jjjXol+rLHOYsYZ9+JIaj+0JaXQz5zDGfdKaQv9Ml8gXYcnOOO2nhkC6bp9Ls64V9JqSNf58MIZ189I9LNq4ozbOu9KOOM6" +
+		"Gyuccf3KL+nHt4MzbuuZIVCwZ/ZAwQ5rcMZtvaUU+rHUwhnnLvGOM27r1gqccVsXhQhnXL8j0e/xHhR0VrmX3GWZGQIF74wY" +
+		"FLwzYlDwzrhDwTtz4s7pJv2Z0z/WkRJOYitHCSdxRjOcxOLQJbf9ihjghdtkyHe8cNv362ed3SW/pIPIJe63F6XpIEf9Tl/n" +
+		"PvSaMsN8qmWQ7rYrLTkl3vNMO0f2Ww+/m1tmevjd3CjSa/jXx/nWHg43rLYdh9t2jwzMjqdtu8f5jR6etvvJM215Uqa2WA+w" +
+		"sW34hPuXjW1k0Pawsd28JPWbC6EUuJTMAWxs28gH+BUi19zLCxfwtL1lXKScc9fmsGiwSMLThsurh6ftmfUKxJK53/G0vWtX" +
+		"SnFWf82zTkrtMp3WaJfTDsYkp71ZJOFik6/ecbG9K2Ce0aFoS+6ggBm42DbMMR0X27s65hkdoAQutveXqZ0O/DDhYkvOBC62" +
+		"LdkOuNjeZ6mBDnx4NXdQ8CCGiy3ZFbjYtsdcxcW2JUpVc4PPSSOo9JjxuNi2J3MVKjlb2HGxbQ/bBRfb9mQmQaUn8zEnujOr" +
+		"wwNs/x8utod3BRdbSdZCuNg+9jHhYsOU1mvYhKY8m72XZyMb7SNyiYutfMSQak7cQB5cbAW/SK/JiJzzTMYg70oNDzBvNC62" +
+		"t0wj5kR3shZwsZVPZqTd5KfkGf0yQ5xX/MCocLE5h9HDxfZhOeFiK87Sd1xsb5l6UD558jBwsb3PjIrziu7+7bjYSjIowsX2" +
+		"KbSVEfnJnJcR+bH3w8VWnEDuuNiKO/g6Lrb3WWqnn90QLrb32UqiA2sJ39orzSTtFCkN35qcvY5vrXzg3p6bzFjhe07ViGri" +
+		"Wysf6x2+tZJMD3xr2/Pkl9pptcW3VtxO0/GtlY/ILL618uG1wLdWnK/r+NZK8jDCqua22I457ZXUJy8cT2/HnFY+7DHMae8v" +
+		"tRrvZDI2MKcVrBAdc1r5+Kqwo71t0Wdyv6efvdivEDm8aRNrPbxp05wyaGAGhjdtkqMX3rTkh+BNe9+LJOud7xtv2ivpI2dq" +
+		"JjMQb9or5ZfObkFPvGmvpC2yFbHldWxo7zPvyfWe5HyFDQ17ag8b2iQKt+cGsi2/1GoRF2xor6R2TLwYRDo2tDLBUmxor2Rk" +
+		"4de0p51azabDhlac9unY0MqUrwF+TXsk+sH1sKFN9g5hQ5tYhmFDk/Hfw4Y2tZRCP9Zf2NAmERBsaAUjeN/DSGG/ig2tTJnx" +
+		"8Gu6Uib9WtoSNrTUQD/2JTa04mx7x4ZW3HLRsaGV6ckz+mXGw68pXwP8mqwAYUPD39ixoZVJdBkbWkkmCza0MucLg1FYjzvG" +
+		"s+KO4I7xrCSvBeNZcR9tx3hW8A71MJ7Nvj6MZyVZLhjPSrJcMJ4VjOcdq1mZ17x3kPJL7BhbJO2E3VjN3mdpi3aWlKmdI5e3" +
+		"YzUrs5hcWM1m1sQRXh6+d6xmBU9Cx1xWZnMXc1lxX17HXPa+py0wKhkwYS5L1kn4yWZeXvxkZba3PcIswbrFT1acme34ycrc" +
+		"Ujv+p9QOv2bzM/xkM+8ffrIyt0jGwZw/wo3Lc4afrDiP2fGTFadH+pEbFX0dR07+fVIm/cR/8JOVGbLiJyvLnDKdzvykPrf2" +
+		"fNJq+vFG4ycryaPBT1aW7ddsnB1zWVl40cJclgwUzGVl5c08wvvIK4K5rCRbBHNZWfaU4q5MXi3MZQV7asdcVpaa99wFyluL" +
+		"uex9LzXQPPMqN9Ueeeam2iu6us8HWoe5LLkxYS5b+DDCXIbTpmMuK4t4COaysmS2QLoFnmAuK0vGEtIt9rJHOMefvEc/0WTM" +
+		"ZW8P5pkbG5Y8a24GzTP3mE+pz9n2Oc/MXH7PMJdtIkNHbvPmCcFc9r5nfkA6p/Q65rKylvwSq3PJMzfCZFQgXTJSMJeVLTjE" +
+		"UlsPPc9SW0XaMJeVZHNgLiurWD3msrLuaRn92PmYy8oqVo+5rKx6F3NZWXmkMJeVZJaEuWyFBGdutWErhbksWSBhLlt9YZjL" +
+		"ynqnFOeL7C3PoOCZlmmnWYCdrKy+t7CTbayQsJNh+etn2MOtMdjJihtvOnaykpwQ7GRls45gJyvJLMFO9o40jcJONqU+p/t4" +
+		"T7GTvW1JfW5Vl9OGnaxgmOoYyIobBzuesVfS6pzgs7c9w9LIW3WG43bNszBo5z068KHjGXt/SYfcjLjlPTpYjfCMleSg4Bkr" +
+		"mxgnnrFXynsjs/FiveASKxucxSVWkoOCS6xsZ55hYuS7wiVWNlbIGRZw3zsuseIkS8clVpITgkusbNaDM3coZH7CM3eQdVxi" +
+		"ZeMVPeP5z6yDWfVHv18hMiaxUsQKMYm9kjbn3m0WeZjEas0zTLzQ5QwXTmYLxCqwG1tYwZLYwxZWRJvCFlbYEl+2sIwXxCoZ" +
+		"L4iVnBCMYO8zNeREHY8XRrBSRE4wgpVi3ccI9krGGSoV6whGsFfSMqjk9ql+hsv7yHt04OkMI1iyOcIIVq6UQocWKUxUqc9N" +
+		"FlApjGD4BnoYwYqdH0awUngmMIKVAr8wgpViV4gR7B2Vh0SHO+/RwWqEEazgIuxXbiq0mmMEK86Md4xgb3emTIwNU8oMm9bQ" +
+		"KKxf1ReN9avUOc+cWU3LoFKFBFi/SjULsH6VfysFrxIEwfr1/jLvHW6KSg2YT1iU15Wbvmkb1q89kpN/ZkhYv6qV4wr7IdsT" +
+		"61dxQ17H+vWWkmd0sHPH+lWq+BnWr1JPGuXeAhkNV07GQQncXqVCedxepdrH4/Yq9U59xoEvCbdXqRnN3JmV+QKxKm9VuL2q" +
+		"aNqX24t/CrdXwXzSr5wrvvNL+pnJV+4taHlGP2gWbi9s/T3cXueh1RBrzwyBWHvGjwW2z6nB2X3eb9xer5R2ulNqSTvdW8Bi" +
+		"CLfXnn6BWbv1B7dX2TMLWGB7yS/dyGcPjtvrlYwDPEueSbi99j3SLmdCy+DZbicRbq/9yDP6HWkL/fa/ko8c1q/zjHa5TSsa" +
+		"0JxvAOtX2dmlX9Yvfk+sXyXZMFi/ypFvOHcR8nGF9Wu3hwrr1yEfOaxfe0sp7pK0H8D6VZLjgvWrYKnqWL9eyTiHleFOmfhE" +
+		"7E3C+rXbZYf1a7drwvpVdvMK61fB69LD+nVAEKxfBYdhx/r11pBS6NBTitFjY2H9KslrabntWk5NWL92yIr1qyQXB+vX+95O" +
+		"cucSmxXr19sWUs4Vs47C+nXYc2P9KruvCOtXOXgfsH4VN5d1rF/lEFvA+lWSu4L1qxz2I1i/Cr68jvXr/eVNyp1EkYzRkvpw" +
+		"vtS8Rz9+Laxfb5n5Jf14A8L6dYgYYP16f6kG6HlYwbF+leTYYP0q7ifvWL9KMmewfpXjTCn066mPfpdxgJ5ux+hYv8rBJ4r1" +
+		"qxxXSqFfi5QsAj2YO7Na2kI/CNlyrpjVj9urHGw63F4FZ0kPt9dpvcPtVQ4+L9xe7y/pAD0PCInb65XMwdzXagXA7VWOnjLp" +
+		"l1ZDT/d9dtxe5ZzSTuPHM4Hbq1xsPNxe5cgsz60vdgvh9jryxUHPk9WP26scF43u3AatZdDzEGfH7VVw4nXcXgUPfcft9Zap" +
+		"LdDzzHcLPc+MJvQ88+Xc0S8SjFxTg9vmIQpur3Ky7HF7lTM4ASPPfDkw8lx/zVjfsX6V5ES13IOdryE3GpSUT/M15btLZYlE" +
+		"8zml0FzUG+tXcWdVD+vXmdkCPU+WKGav971Kcq/SFEk7zVXMXuU0VzF7leRg9TAvsBd67ny58kvttCfF7FVOMzDMXiffWJi9" +
+		"TtmKYfY65bOG2evskYzQHckI2VeE2esyy7obrZPL3iHkafeK9atcZgvWr3J9f4lZQuQL69f7TMsg5GUcsH69kj673JzBF4f1" +
+		"6312kHCrsoewfpVrzXudpM8gpJslO9avcsnvwfr1Sp5BSGyjvedOVj5RrF8FV2DvX+YFGkG6K7MA0iV3K+xdl9kT9q7LXiXs" +
+		"XTgve9i7LlHUsHdd8pExdJXLyYsepkKIjKGruFuoY+gqV+YLNLvYs3i4ymVX08PzaoXDw1Uu3nY8XOXqKSW3eKR2OtwphQ53" +
+		"3qPDk/foYF3Gw3XcMD88XI29Eq6tBiFxbRWchh3X1ispMzetbCkFDz1/A66t0mABrq1XMj8hVtvzrJGMCsRqR355e6Y/IVZj" +
+		"J+LaKhgVe889VTzjPbf82YOHawuPUw/XVoMoPTdM20/i2iqNb6CHyzU9/4RnXzvhUmMt4doqydAK11br+SX9zrQzbISpgX7G" +
+		"4Q7bNVv0Dts1HwaurYJ5u+PaKiPP61eIfH9vmE6JuUM0ZWBKs3PAwlU6/MfCVfqcut3AvOSXF2knhSntILmpY9wq078sXM42" +
+		"hYWrQ6U7PIXWIixc78bdL8Mjc0TCUsWjgYWrdHF1LFylswXv3JG6RaqYEPUtNOu+77BwJU/hzg3Te57R70kpQ79wm2DhKhjy" +
+		"Ohau0q88ox8vKBau0vkUwsLVrSNh4eotz+jnG8bCVbp1BAtX6S3P6HenFPrxeGHhKt0MvHPLn/UAC1fp/F9YuEqP7lCw97xn" +
+		"/DJfwmF4py25YdosYO/dbOQ7PDJzJDcUZ05AyJudERau5GBh4Sr3kveKUow7hLzZ61i4yp0ZCSFvnnEsXOXOTIaQd2Y5hEzm" +
+		"GK6ttz76QchkjuHaemvQL+w9N3V2XFtvmWpg7935Ath74Z/BtVVuqzuurYKzp+PaKtineri2busBrq1ywxpcW+W+Uh/9zrSF" +
+		"fi3tpN+V2ulgB45Pq9w8/+HTCndM+LTuI7/E1cSzdIeNcIqET0vGFD6tkhwzfFoluWLh00quWPi08Hb28GndT2p3k+aSGugQ" +
+		"bIOQz5JfGiNfwBO2a/MFn9bbzoVkHHjj8GmVsL7g0yqPDL7waT2sW3xa7y8nkntQRcnwaZUwyeDTKuG/wadV3HPZ8WmVkQP2" +
+		"K0TGtFWS84Vpq7gNrYdp6+GVD9OWW7h6mLaS8xWmrcdYfpm22NaYtor7mDumrfKckWh+phSas2swbZWHB+UJQraUSXOz+sk9" +
+		"qGwQTFuvtJHoZ35g2iph1MG0VcKag2mrJB8M09b7Hm0hZBh1MG3Vj1gQpq1XyjP6QTpMWzXcNJi2KiaxjmmrJlsL01YJww2m" +
+		"rZoMMExbNbw8mLbeMaKD21Q+kOD53kUVjR6SGvAUfuz/MW29kvowbX3McUxb9SPT8Mkt0tbCx6l7t731B09hMqQeN/l9ztRH" +
+		"v/SnU/cfu5On08/68zilmmy7Bxvh50zL6Jex7fRjiT7YCD/smuemn1XlyT2oGSNcrm7D7Q8u10nU43EX1TTll3gYZeo82K7D" +
+		"Ofa4RXoShXiwEU6Z5dgIwyrzuMkv/DOPm/ymLb9sfplnXZn0u91DbIf1DPR8nxnpJyy92vLQT37k4x7UZPg82K4ntuDjLNeU" +
+		"7wh6Tiy8x01+kzX7eejX8kv65Vt56NfyHv342J6wEeYbw0Y43amdfk9aHf1+fnnjcKvTsGxuHG5Vjs2Nw63KlblxuNV5ZFLe" +
+		"ONyq7Jgbh1udh0/vxuH2SnnPbZlz6jvwjKcUd1GNOX9jXKvz8HDfGNdeSQ04WedNDThZMbvcnys3yt8k7dyVGVbBgeT3J5z/" +
+		"+6+9FjfWtorX5cbaVuXG3Fjb6nzmGQ2GH+vG2lbdAnFjbavzlV+OUXCnx421rcp4ubG21bnRp9G80cdtAO6KubG21fmOFM5E" +
+		"9YXx/9EWWcaw7cbMVt1ucn9yZ+mwWW/MbO8zIwTplknLBtK9krGEdMuSdt5u9k4ND0lbIN0yvMM3ZrYqd+XGvna4S+/Gvlbd" +
+		"2n5jX6tL0TJotpT8kg7V3IFmS80zOtTUR4dq7kAzd83f2Neqeztu7Gt1uYwKNFvS89BsuZQCzeSg3JjZ6nKrD5phMLkxs9Wl" +
+		"Rxr6rZkv0Gz95L2K9VUPQrM1fQbNlkc779zKnVLc/LjQCJqtW97D3LykTMzNW6SHZIyg2br6NqDZupkF0MytLzdmtrqm56HZ" +
+		"WvQZNFszYtBMfsqNme2V9AQ0W3f9Cc3WI8/ol+8Imq0tZdKv0SHM1N0chGZuBr0naLYOTLwnaCYn5MbaVuWS3Fjbqntlbqxt" +
+		"FTPIjbWtygK5MbPV1ThgZqvrk1Lc3DyiJfcU9ulP6nP3qJGe3D26jb3tKz0k9UG6Dc5OYZ+evAfp3N5yT+Hun/IelqURKbqx" +
+		"tlVZLjfWtrotkaoMEe2EZ/I+7gmebSOb957g2bbqF3i2Dev2xuj2Pkt99NvyHv1K3qNfUR8824o+g2dbUWZyV/6KjXxje6uy" +
+		"TG5sb6+kJWy6raY22u3aBencs3dPuZ3Z2jBBuu3Ie7Q78h7tDn0E6dx2fWN7q9tpTCDd1pQZ5v4rz3J/aiQ6XEph022XGqDg" +
+		"dtEvtzNfRg8Kbpc5DgUxwd844+rmS8EZV2W83DjjqhyXG2dc3fI1QMENRuGFqxubAC9cLR/1Qbrt8U3lFrxHfdCsfIwlNCuZ" +
+		"x9CsfNSeW5aHHXzjfqvuf7onGFVm4wCjZMfc2N5eSe0wqizqg0Ml8xEO4U2/Mbq9kv6EICUzEILgQbmn3P4B23CxvdJMyt32" +
+		"hbSQdlJu/m0kte8pRe3Dx3zjaasyZ248ba90kfTL2NHdeNpqOVLfRUop2nmlBr00Yl039rWKMeUO+1oxQ7CvVRkwN/a1Wnp+" +
+		"qWVmAfa1Wkas+Z7j3bfCYV+rBXpiX6tl+IRu7Gs7lpkb+1otD/3gAq6TG/vaKykFLuBIubGv1cp+wL72Snnm5jS4N4d5nu2J" +
+		"Ra3WNWWOdlaohEWtVvYDFrVXynvuwNwiaWehrS8cZ8mNRe2V1O4Lr1U7feE145cb3jK2vvB66E9fOHaTG1fa+yzvuX0qY+sL" +
+		"r5cR84XLJblxpdV6pWV0uFIfHZqeYMvUzAJfeO2e+cLdFnPPvvDazWRf+GA++RUiY1GrMl5uLGq1PiljaLd/Ig3t3FF/Y1Gr" +
+		"8htuLGp1n2kOF2QD3FjU6r4aE1bOnp5m5cjmuLGovc9S5uOZ2iHIDtexqL2/zDPs1pAci1qVE3JjUav7mfc2kn6HLthMbixq" +
+		"VS7CjUWt7s2YsHJkZdxY1N4a6MDK2a+0hX4ttdOvpXb6jd3djUWtyu24sajV3VhiUavukryX3IA5sltvLGrV3cI3FrUq7+OV" +
+		"KmkiGaOxH78xrL2jktozRhdp6HCMzKMbw1rFKHJjWKvHlvpu0kN6SFrNAsJucmNYq25nvjGs1aPml+4g92Uuub1ozzP6WbMx" +
+		"rFUZBq80Tug+dpMLpDvOvEe/4e24sa/V40rtdGh6ApodPe2kQ8YBmh0ZI2h2wFIMa/Ww9mJYe4FgJ+F5sWouuUfD94BFrWIw" +
+		"ubGovdrm2WjnuaSGi0Q/SHfab2FRe6W083bvZNqCezdjBOnwmdyY0upZIo1d25PehWanVRNT2ivll3So+SUdrH6Y0qoY/I0p" +
+		"7ZX0J3vFfVP/T2H3snM7bpxheO6r8B1knSQWgUy0xOIsuQfD6QQe2G50nPsPN59yEATI9qD737UokSyJ+sST3kqktGWdLD5c" +
+		"VQIf7jqSD9QTKa1d1BMPrV2eKjy0dhln46G1i5bioXVfKCYeWrto6bsi+noa8dDaRVnx0Np1Vy78qxZCz0RpSMyzdg3n0SWE" +
+		"lsQ1a6KOJa5Zu/S5cM3Wy+Hn+5ET8WwdpyYUy86VRDxbVpUmmuNbvajS19sV1Wyl8YAqfc3soJo1XJdENWvW6hPVrOGlJKpZ" +
+		"E8soUc3a14gD1axhzCSq2Uq7WepJ8z8VdbKVta805kuimjXRP5bFBz08VLNlVZ7qudelEtWsiWWcqGbNin+imi1rsvgQdSQf" +
+		"ujwpz9dbBdVsHcmjil1h9gjVbKVVnnw4Kk8+7JncRDVr1vgT1ax9946eRDVr37tK599defJPX/BDlewbyA9V+mpziGfta9SL" +
+		"eNbE0UzEs2XJhSphzCTiWcORyU/FJ6fyiGftfpQl8o93DOJZQ4dJxLNm90EinrXvrCPFT3mWJb71o2rNv1l12f7Zb5CIZ8ty" +
+		"nr7Zrf+AeLbydJWo2f2uI0We9xb7FMf2rTxqdleLpGb3p8rj3+EqUTP8mUQ8W5YrQc3uejqo2W08gni2rDovXc/Kk38Hb6nZ" +
+		"XW2emt3Vrv8eP7LS+Ee/EM+aHQ2JeNbuSwnU7L54S81u81MfaoZOk9hoTeThxEZr98UHvbP7W+Xx71u58O9b5/HP+/Wjdyb2" +
+		"Yn6ooB0UiY7WbnNs6GjNDopER2ui2mTR0W5ziOho7R6Vxj/9WXS0dmelhb1O/NM7s4Mi0dHaPetI/uk7fSq+T7VBOrhJOT9T" +
+		"ZNy0NqgubtqyXixRsc264qY11JzETVtHXqzt3fhUmuifejK4aW3sVfbETWvjXSV01mSJ6/quXMR1/VTNtneYNssSveioND58" +
+		"qgTRp4yPMdXaML7CVFuWI6nn8GxgqjW7ORJTrdnNkZhqbZijwVRbpfOPeo6oXPinZ4G3tnKpIyturWtGPVFzEm+tjavK459+" +
+		"Pt5as0cj8dYavk7irbXhacBbayKl51FRzT03eGttGB/grbWhBeKtNTs98qCeo9eR/NN3wWJrY9SR/BtVHv/2Ho08Ki6vkTsW" +
+		"Wxuz6lnRp6pm/NMTxWJraVYSi61ltTPqaa9FYrE18eQSi62l0QIWWxPBZ1mHNB5RzzTvg9PWkH8Sp23lWbls/7La4KjY12V9" +
+		"lVDn3ayq9WBVCSmXqjX/qDxO27KUl3X/eEs9xUhNnLaW3ss4bS3rCaCeebjWFDL1dbHYVi6VZ/lQaXwwDsViWxaPKGRSayy2" +
+		"ZsdNYrG1rFa+FTJmtWQKiS2UWGwrFyVQwdTvwGJblpZF6VJv+qg441F5+nb/rdXRrOkq4a21SXvw0No8ygrWh9VZwZKLq4tr" +
+		"1uyuSFyzZr9D4po1uzIS16yh4SSuWcO4SVyzhmqTuGbLulnb2/ljLvdniox41rBwEvGs1ZgG8WxZPKcndkkk4lmbnm/Esza/" +
+		"5YHr542GeNbqfiGeNXEfE/GsTUqAeBabV/fD4p0xBqpZs4MiUc2aPROJatZm1nl8yCqBD3p4qGbNfpRENWtzVpq4DzQK1SzE" +
+		"7ElUs2VVeZO1Wy6qWTwoAXJZiA6YZ0WjpRnIZSF6USKXxcPb6Kyo3O/KU0Q3axDIZYFpk8hl8TiqvC+r8rxZdR4fjiqhou7V" +
+		"eXzwpkIui4f3HXLZshy5dWFZDxb/zAAjly3LFUz+eU6Ry5aldDFnMW2Wxb9q42LOijSUqGaBd5OoZsuqmvGvWmRF5b6qPHFe" +
+		"etWTf5f7QDMeQ56Tf3rvqGZhj0aimoX9G8vin/HxKeas+KKJeBYiGyXiWdjbkYhn8dCPQjwLu06Wtf17Pqsu27/nq867Wbzd" +
+		"OrSsymXfv6d+Dhpar1lzNLSVdrJEpTPb0XbfKfBalnWwniw+mKVESgsMmGVtH0QXSqS0QJJJpLR4RuWpnlQJKS3wWhIpLUQl" +
+		"SqS0EJUokdLieVUaH8x9IKWF6EKJhxZP2oOHFug0iYcWohIlHlo8zYvgoYVYQ4mHFmINJR5a2HWSeGjxpER4aCEOUeKhxfOu" +
+		"XPh3lw/808vCQwu7VZbFP/Mi7ebf+DmNM1HU4jkqD57rAaGoBTZNtoqZOKps3pl9Q0MLzJdEPIuXXg7i2bIcuXs5y5LL7uVE" +
+		"7XFBPIva44J4tiytjJqJq5yIZ8vSHqnZyxsU8SxENkrEs3h9Kk8Mg6Ny+bLqvJtVdRmsqmeytAFq9jJiRDwLcTQT8SzwYBLV" +
+		"LNBoEtUsXtXiqVLtm0EuC3GOslUs1155qqd+MHLZSlMXqoTykshlgcqQyGXx8qZCLgtRiBK5LF5DLlSpdrkgl8XL2Au5LEQh" +
+		"SuSyeFmBRC6Ldz0NVOn9KIt/esXIZVF7ZZDLQnykRC4LsYwSuSwwZhK5bKXVefzTWpHL4q1filwWtTsmqFLtowmqJKJoopqF" +
+		"eEWJahZiEiWq2UqrErYP76PSRCv/VHnbh9p/g2q2rC9r1/MddeR0lfY1Qy6L91UWH9xN5LJ4G9UglwV2TCKXhQhMGVQJSWZZ" +
+		"fNA3QzVbVpXAB/1gVLNAhElUs5VWefKhVdqtLnynSigzGVTpbS4iqNJbe0E8C4SWRDyLd7ouVKl2+OCaxdtqCa5ZfB51Hv8o" +
+		"Ea7ZSqtc+GduDtcs3maycM3WkWXxz44UXLN4G5nhmq0jq/Tt3+dVaSLKv+o8/tHnoGcfaoOAFoe2i4AWb20eAS0+Z53Hv9c/" +
+		"mLXARgvRkhIbLWqfBzZaiC2U2GghelFio63Sqmxx2q8q+yvNdad0H+9FbLSovUbYaPH5lD9TndWF0tV+KWy0Vbrz9Ns+9Szq" +
+		"t9UOImy0dZ7yqODnW+e5s9/Kk3/1NFDITz3tFPLj/YaNFpg2iY0WyDiJjRaf4e5RyE+1Fgr5MT+LjRYfvSpstPiYb8NGi+NV" +
+		"Fv/szcRGi0+1eCpYe3/wz+J41pHBcl2ooPhIGVTwM+u87cNROkQFRTZKbLRAh0lstMCYSWy0ZT1Zvqs0y4WNFtg0iY0W+DOJ" +
+		"jRaiFy2LD3pAnQpisSduWhy9SuADdcFNC9GEEjctDi0eNy1qhw9uWqDRJG5aHFm5iIhMv3DT1pE3i3+t0l6sZPFPL7xTyEN/" +
+		"CFMtDn0lTLU4zNljqsXxrSP5NyoX/hn7YarFoYeOqRZHVhr/RtWTf7PyTPehSp++Dd13E1Mtat8OplqIepSYalE7fDDV1pEn" +
+		"62Onh3tLIc9nHbn9q506mGqBppKYanFa/8FU+2S1Agp5mkXAVAt0msRUi9q3g6kWp94LplrU7hhMtTj1mBHQQnShRECL09gd" +
+		"AS3QYhIBLc67jlRP/WcEtDjrbtKzeFUJ6qlHhIAW56g81VPfAgEtsGMSAS1Oq8QIaPFjcPpTRcZGi9N4DhstTqMtbLRoxuPY" +
+		"aNHqflG62n2DjRbiFSU2Woi9m9hoUbt2sNFWnq40pRO9KLHRQoSixEaLs9ocpWvV5ihd0x/CRotWLYLSYdMkNlqIQpTYaNHs" +
+		"g8VGi9oXhI22aqYEfUHcmsRGi1Zthwo2c07YaNGs6WCjhRhIiY22jlRPKtiOqtmthMqFf2edxz99eWy0Zb1ZfDjL4oNZQmy0" +
+		"CKMTbLRoelzYaNG8f7DRokWl8eGqND5YLSw2WtNDuKig6Ex5UcE2yhqiNJ4sPlyVi3vk2cBNC9ScxE2L2l2EmxYoNombFrUP" +
+		"CTctcHkSNy2aPg9uWjT9Idy0dSXqyGDxgQo2qoSNttLKch9G5bl9CK0VN21ZakbpwtsPN209w86jdKIzJW5axLss9+hduRws" +
+		"d5rShTVH3LSVxndKF3ba4qYtq8qjIEdZX1blyT9zzBelEykqi6kWZ5XAP180YKoFAk1iqkX0svinN42ptixXl0LWXidMtRC1" +
+		"KjHVVgll8c+ODUy1ZVUa/64qj39Wsy8KGdWuKSRuTuKtRXjX461FUF1UtQj9MVS1CCuJF62r3VTIaRHejMhpgUCTyGkhqlMi" +
+		"p0Xo5yCnRe2KQk6L2F/QJnJa/Ohs/VSRMdUCJ2dZyar8J8vTTum6Ny/eWvRPWS9prh+lQ9RJTLV1JM+pWa92Rc16tTlq1qut" +
+		"UjMxpRJTbZ2nZtSs+5oDUy1qtxamWuDPJKbaSjtZ6mm+AVNtWYP1YV2sgzVZfGh1Hh/0ozDVAl8nMdWim2HDVFtWsPgQVQIf" +
+		"eh3Jh15H8kH/+UvNurmxLzXrF4+oWTdPgbcWnbLirQWiTuKtRdeLw1uLTmcx1aKPSlNPM1JfitW1si/F6r4iwFSLy7sXUy0u" +
+		"ioWptqwH6+lI9aRYSDWJqbYsdaFYYl8lplpgtySm2nrcXBeKdR2VS7B4S7GuT513sdSaYl2t6rL9u6oVUCxxsRJTLVBfElMt" +
+		"LnMRmGrLkkaxEFoSUy0ufXlMtbjqblKsq5fFBzMauGlx6f3hpkXt8sJNi9qthZsWWC6JmxZX3T+qdJkpwE2La1aefKh7S5Uu" +
+		"a7G4aXGZ78ZNW4JVaXzwvsNNi8teBNy0uGbl6R7pNeKmxaXHh5sW32oF1Oz7qPMu5fGBml1ZdbmluZs061sti2Z9n5XL9u9r" +
+		"vgEdLWp/GDparz4COto6Up406/uqtI/z+KB3VnvH0NHiW22QntWuL3S0ZXn+6Nn3U7nw7/NzrkXipq0XWtWL59XGKd3XHAZu" +
+		"Wny9T3HTAjkmcdOido7hpi2r0nhHzXDTljVYvDOWxU1bVuXCOzNl2GjxNaeGfxa31Vb8sxAVK/HPVprSKdbtWcQ/i9qjhH8W" +
+		"ImYl/lnUziP8s8BgSfyzwGBJ/LOo/UT4Z3G3KqGz1MwoFJ8l8c+W1Vk3q0oYLOdRM5GwEuNs5ak8anbT7mKcvagZxlnUHiWM" +
+		"s7ivypMP30pTT30QHLMQQStxzOK+qwT1NA+DYxZ3Vs3cB3PTOGZx0wUcsxAlK/HIovbm4JEtq7Eayx2jSuNR5+16Dk8fHlmf" +
+		"dceo0tBHwCOL+67zhjT1pErDE41HFsP4GI9sWc6jSsNoGY8saocPHlmMqLR9rYd+Gx5ZDAqJRxbDqhEeWdQeGzyyGGY08Mhi" +
+		"1DWjSuJGJR5ZYJYkHlmIIpV4ZDGMYzDHYlgVwByLUXeF8gy9OPSwZdWRuy5ZV5eC1I4UhLBIvXCEsKi9JAhhy6rzBsu1phNp" +
+		"VRo9LMR/yqKHpZ3S6GHLuln7eqbnDz1spQXrlFbn7espwlSihy2rzuPDq0rng/EkeljUDAp6WCCfJHpYYKskeljUHhT0sNXV" +
+		"3fcdPWxZPKIv6SlGD1uWmtGXtPqKHrasfzCPjCsWqaeGK7asKpvnvfLnuRaIKxZ4KYkrFnnVeTzXb8MVizRywBWLNCbFFYv8" +
+		"1nk8145xxSLtLMQVCzG5ElcsUnvEFYscZfFcexxUKbXHQZVy1pH8y7L4N6v07d+kIHhky6q6bP/ms6yb5V5SrOmrDDyyQDpJ" +
+		"PLJlqQvFmtr/0Meaeo1YZau8OnL7N73Nscpieu9jlS2rzjtZSqd0tS8Iq2xZ6knpEFkSqyxmPRuUbtZzQ+nmp0rn36fO4595" +
+		"GKyyECEsB/2avSy1NmeCTha1bwedLEStSnSyqF086GQxv0qgX5N+oZPFNM+KThZTvw2dLGbWkWqdlWf6iqDyVGtjAHSy/qg7" +
+		"vXtOHZckEcj6o+771rZe+2gQyNZ5fBBr7mHMiEC2LFdw616vnToIZCsXddm61x/vOvJmVXmD5a7QPTG6Ei+si5KVeGFr2NtZ" +
+		"6vKuNHXRA8ML67VTBy9sWXWkupyVpi56WXhhvXbc4IX186ojp7R9H/DC1rUu6yltsF7SnizXWu8ML2xZF4t/+jJ4YctSF18N" +
+		"Par0L/+uSuPft0rgn/kUvLD+yKoZ//Q0ihf2+lSe2gt9wQvrT7MWeGH9+SzryVLPrVL9+a60N+vnMUQSSayL3JRIYv1Z92vr" +
+		"17LUa+tXf3rfIIl1RJZEEltW1WR7LjpTIoktq+q1PX96byCJLavOS1YdyXOzRUhiXZyjRBLrz7rug+feDkhivfa4IIn1py8h" +
+		"kMR67VVBC+u1VwUtrItelGhh/WmGFC2sP/Vd0MKWVaXzwfgKLaw/6+4NPngfIIJ1EYMSEawXWQURrIs0lIhgvQgpiGArjUdb" +
+		"sZalvK1Y/VVP0VasZTXW9uH1qfO+LN5uxeq1XyS3YvW/t0CK9ep13vbhZcyBFtaLwYIWtspzlSb/aDBaWC9GClrYsrQJaiba" +
+		"TqKF9drXghbWRQVKtLBe/BS0sP6iz2hh/ZWVtv17P+o8/s3Kc/v3NleGFtbfdsCghfW3pwgtbKW9WG9pF+sj7c3aPrzflXay" +
+		"DlZjfVnbh7c3FVpYf+vToYX12gmBFrbSqma3804WH/RscL/6W28J96u/XSVsr/42f4nt1YtLgu3Vaw8Dtld/oxFge/W32VNs" +
+		"r/4eVZ563nWeemaVvuv5qau01WxZlZasKmG3pdpPge3Va18Ettc6UnnUTNycxPbqH3MK2F691vWxvVbak3VKUxeaVTwTbK/+" +
+		"Ma+F7bXS1JNmfY4qYfv3qXtEs2qvALbXOtI9olnFOsH26rUjDNurFyMF22tZx88VGfWr1w4K1K9luSfU7ONJQf1aadrO4LnZ" +
+		"U9Sv/vG2Q/1aaZO1PS/mBepXr3Vu1K9eK+moX71Wy1G/luWeoMMe9uIge/Vac0f26ocvbZG9+qHHhezVi2SB7NUP60TIXr3W" +
+		"wJG9+qGHjuzVi2uB7HXOWXXhQ7VjSlc8DGSvLgJMInv1WklH9uqH1QNkr35QeWSvXivpyF79oPLIXr3W3JG9+uHdjuzVz2qP" +
+		"lO4044Xe1c9PHdlZVcLFco+o2Uln0bv6WW2CmhXXAr2ri/MyH7Urdu8YnOhd3dr5RO/q557Bn+hdHddiond1BIyJ3tXPqFz2" +
+		"fRCvZaJ3Letg8WGvBU30rn7uN/Gy+HBVmnpu/ZrIXh2tYiJ7davzE72rnzePqNm538sTvatbgZ/oXR3XYmJ0Rf+UpZ6DR9TM" +
+		"GvXE6Orn4AM1s2I9Mbo6BsXE6OpWzydGV7d6PjG6etvaNjG6VppaUzPr5ROjq4v6sqy3NHlSs/ZUOjVDuZj4XctyPalZe7uC" +
+		"1Ky967ztQ/vUeXz4VAl8+Kg1xWof951itY9cKFY7XDOKZS19WXw4lUCXWrUlumRNfOJ3HXZuTvyujrEx8bt6a64LXULOmPhd" +
+		"XWSXid/VxY6Z+F0rrc7jX3Nd6FKLqgv/Qnl0qXW50KV2qSdd2lFmfqLIE72rt8t9pkrtLosHt7L1v9pd+fOgWi5VatUeqVJL" +
+		"7ZEqzY/2QZWsj88idOHCT4Su3qbyah5+umJUKaqVUSXEjYnQ1eOtZlQpqpXpf1n1nghd60hPLcWKt3pSrKh2RbGiak2x4sNb" +
+		"imWFeqJw9XDXUbg6UsdE4VrWm6WeFASFq2NsTBSubuV3WepJM55UKe7KUz3vylM97zqyOPSdpZ5Z9dz3oT/qvGTVkftJQceY" +
+		"CF3LUp7+V9+Ez4nQ1cUlmShcy6ojT9aD1Vg3K1jJ2vXsnyrvZqkLVeq7Zz+Rtno/Kk/1dB/QtLrV3omZ1VE15pO69Kusfc36" +
+		"t47c16xrdbhYvY/KU112P3HiYvU++E5d+uA7lejTeVTCiufEt+rXo448WcqjEmJlTHyr3tOVoBIXvcS36terStj1xLWY+Fb9" +
+		"qqtEJa66nlTi+rhmxmkIGBP7qmNlTOyrdZHkQkEu7xjsq27ddFkfltL1bC7vXlysbv1z4mItq/IMlhZCQzA2Ji7WsqoE/p2e" +
+		"DhpyXVUX/nm/Imj1q+6mns11S6Mh+BQTQatfdcc87xgU8+l5v7KOVJd6VjzvV7pKnvdr8kEPxfrnxNPqVjUnnlYXr2LiaXUU" +
+		"iomn1b8/nqOfKTLSVrfCOZG2uvgUE02rf/eq90TF6t89KzJfntqvt+TLUyvqxHx5aq04zldFwNhfCU2MrGUFa989q34TI6t/" +
+		"e+Wyr5hYEhMja6VVLuri7YN11TEaJtZV/7pDWFf9e/PPU/vdveL50l9Ac1iWerqXL32CbyrdE/313OBg9W9WefvufUdZtzT1" +
+		"9ERbgZw4WP07Kxc+uLM4WP071Vp/4X668voL6BETB6vftBQHq6NATByslXazThb/KIGoExMHa52nLpTgfqgnJbgfVd7276al" +
+		"L0ogWsXEyFppVc9k1XmTxT9KcOtxYWT1+6MdU4K7WhYluL0ZkbBWmtIpwX2oJyXAyphIWMuqPPnXKhf+Nb5TgjvrSP6la0YJ" +
+		"7roPlGDUtaYEo64gJbg9w0hYK819oASjrrzexND3RMJaVqVt/3zbPpGwVlrlsv0b9TTqTYx64qjLqBZCXUbdTeoyPq48dUEq" +
+		"mEhYy3I9qcuoa01dht4t2lUXB2KiXfXhKUa7WtaDpZ573W2iXXUUg4l21fEHJtrVshpLPfdM5ES7WuVNlnrql6Jd9dHLUs+r" +
+		"ajZZSqBL46t0ujT0ZdCu+rj5YGw0vko3NsIRmGhX3VrsRLvq4kfMol1ZmZ1oV92K7kS76iI/TLSrPjahaL71Qn4sKvwvRT4+" +
+		"/0eQ37onAllMiKw+dG4hsvrIKprjUxqhyz0lOmGweu7tiRMGq/usflkHq87bzlm2nBBZy3IZCJ2P3icM1rIqz5tV5w1W5bnr" +
+		"KRDChMHqWTdoVCgcJRA6y5HzTejSa/j994GREgpsvCcfJvBVT6ILfNVzVJpaD1eCfOWoPNU6NVzylame5CuzaqbWda0rgI+X" +
+		"MrhVT8PcwlKdX42auFhknG/iMongm7hMndR3DVU8im/iMj+uBHGZhytPXMAAJpxVn6dmRRam6YY3WZi6NW+yMJuakYVZDyZZ" +
+		"mAQSiqrPurdkwRLghKLqc09PTSiqI/aHcROK6ux74/KEouoPQlAoqg8BgaI6nu5Roaie/f/bePTbH/7yH7/87p8fvulf/3s8" +
+		"9tb6yNoqsG/PnLtxXEZFlwHQ25GXYc1lPDPn7uFcxiyXwcrbh+EX9O+F+ftG0b+MNi7DjHVk/dj9+FXQsX/cj9AFlfsSnOCC" +
+		"yr2MBV6+4b+MBS6DgDkfdeTtx7Hz3G/5C9320g1fBTkyVWm/916vPWNw6bteOq0vIQ4uXdH157Uv3V75uTBPr9f2/eXj9gtg" +
+		"9EIWfb32yP9CFr10s15w75duz6W/83rtlc/L2/ryml5VuvzY/Xg5cl9k76/Li+v12MO2i1xfdHPdIz/uHtzlOZ6enQts7tKI" +
+		"p8gpl8Z4wRe9H36EArowOt6Pvbfhwtq4ADEe7+7H/UK4kCoe7z0rcSFHXOdw5NeRbgeWwsMs/+W7+cuH7w/T7ZdP3S/faz/E" +
+		"vL98F335sPnxeTtyOHI6cs/Pf8nVt3RKsKgviMgXPeST+315iyt82xh7CPJx22hy22HS3qJLmQUcJvw+KcgaHPvAYf9UZCbP" +
+		"0TCtcDwESjB4H0btx1N0Dw/CgLQ+noLOgFEPFOrDGubQ2IdWflR4B2DnoZUfvrAfRrXDcPZ4Ctll+DWMuw6rZcNYZxjkHBWm" +
+		"3pBkGIscLzFy9DSHLubhU8ehizL0TY7Plv/hvT680I8K6g59t/7si1zRnlHgBvzb4UOJAfw1ML6OEOECj2sAcR0mJwYg1ECC" +
+		"Orp4HlhMA4TpuAT0gjMaOEZHRRtEBBpQQIdtl+PYD/r6sy8IQtzApRmANMctfBJcxsDJOFILwaYYoBSHXsMAlxioEkeKfokA" +
+		"MaAfjhRGUZsf7ZKneFmegAFVcEzX04fbwxfb56P7cTfM4TPn8zHrx/TjjmHz3LPNw5e7w0e6p+X04bPL4XvL8yWqni8eh08d" +
+		"T0Ewhg8Khy8JT9/fD9/zDB/ynPD2w0cww9cv51HR5HcTHj4gOS36DJ9iDN9gnEedvlVxXIJTne6mDxmGLxhO390N++iHDfRn" +
+		"q8DEW5OHfe2n75yG/XPDxrnzugTX2325YX/U6oFUbMXmR0EOW4Uu3E+H/Urnt6Jh7sdi2PJzGkQOS9jD2vWZFQtgX6V8gJm9" +
+		"3hWaZPhRvI2KirC7nmkyvr2yAkq89o9IV+9PxQL4Uc/0gm6fgqBvj9I7tR2oLuaY0uRSq48pTRKll2I7/uerEl2H/v4/XYk0" +
+		"5ZNelO18FfV8V+wJgHd+CmKuYmjD54nVvptZmqxZPxZw/Mf1WH/kaY8dIUrTIO38FiB719YsQ6vv7177TqXJhlYf7Blwp5F2" +
+		"a1b3jB7S6KB1y3p68qkLv95GRWx97h8hxi6V1w9NHdB2+eZalzPfNS1eON3tdOo6tq886d/6A5RpGfbYirf+oJDZdUmIkhA1" +
+		"44hlNT+iexWZbl/IPMD/shA9W9jXH7wm63nnFpv1B6jJFolzK3OeWHuzuERux+l2zAIS3fJEkZv2JXq9p/d66LMlAVt/0Fzs" +
+		"yGnuUbPNsLaqtN0XymZH4bN4GVsYstk8+HoUCGSX3uyq00Fa1ulHaJP6xF/lwx7TiiYTW5nXH587+7HvZzm7vYfnVV+/vvzo" +
+		"o+KozwV3PS87O6115LXfiXnZtmxqP2nV+uNrtVFfOPlR6YUO/26dzm+tEX7ru57uRx8XWer97lfV+uPrCxEexDZPQc2jQK7C" +
+		"mKf45VHMVvG9U2DvELA0hd1O8bajmJjiX6fA13HP2j7+9qMd2L4mE5M5BWMOgRdTIOUUQTnSF71iJqdgySEUYQrkmnQzpn0M" +
+		"YqGmIKhRCDDjlBQ5tD8+tcNp5ynUYi8IkNCHKeZhF0kqRapLIer625ZcYehS/Ln+rtV12ihYWD96LY/vgkS86uerVrR/XOT5" +
+		"qI9ULf4I/jRFferEaophNAUv6q2WrAy59Bh7i1phO/xoafJrKW+/qqb4MT3M6BhkTYOs7jO5qRs5dSO7uO5TN3LqRvZuyklY" +
+		"kun10LtVckOuaazVe03f7/mGqYvZxf6eYodMHc7e/z6d/to/mpFDkp4iX0whL7pPhqaQF9OLoV81D7wFaQpB0b+v+rH70Tzw" +
+		"q2bqhx9NANdUqgsiokTHb56iP0yjsu5DlmlwNo3KOoLyFKlheoX076iJue0mbn0fR03H7OtpWNXTVD/8+cQ973afT+TxCTLe" +
+		"866ZCXmapU3rpYjeE7y725g9wbsnanefj5p22BcZQLsT6gmgPZGz+46k+L/e3euff/zzH3793f0vf/j1X//w519+/8f/+u23" +
+		"X/7yt3/70x//9vt/+vHr7//tl3//019++e2X//zrf/32x19+/+tff/1x1v7vvwGtLQYE",
+	"EUC-V": "" +
+		"eNpFT7sOwjAM3PsVZkCCoYTCF6CCRJF4iPKY28RFkagTpcnQv6e4EQyO5fPd+TKdXMp0o0yN6XqxhCt2JjiJaX6sbCLyYluQ" +
+		"9iAuzsgSPTSalIscqPGlKclWoLT0ceJXtlFc9p3HtqDGwHpkqWAjU1yH1nnXw4zvz0Fhk4izU+g0vWB2qGxFWYTLYO0bWyQP" +
+		"GSNIatx8k56qFkHs7nn6+IO33iKsxvl5NAqjknl7CB1yzsGI+89HBueGOxyXjb4qTfj7tzWs4voAbRBo7Q==",
+	"GB-EUC-H": "" +
+		"eNpFT9sOgjAMfecr6oMJPsyJfoGgQWK8RPQDZCtmiWzL2B74e7Es+tA2PT2nPZ3PrjXbStMg2yxXcMPeBCeQFaenTXhR7Sqt" +
+		"PPCrM6JGD63S0kUONPhSOsnWIJXwsaMsuiiuh95jV+nWwGZiyWAjk9/G0ns3QEr3FyCxTfjFSXRKvyAt8yxidbD2jR1qDytC" +
+		"UMtp8rV5fnYIvMzZ/lGwwx+/DxZhPfVlfozj0CPZG1dQ/W0QwbnxArkk/VepNP7etYZUFB+DD2V/",
+	"GB-EUC-V": "" +
+		"eNpFT8sOwiAQvPcr1oOJHpBWv0CrqT34iPVxrrA1JBYIhUP/3roleoDNDDOzw3Ryrthamiey1SKFC3YmOIEsP9Q24Xm5LbXy" +
+		"wM/OiAo9NEpLFzXwxJfSSbYEqYSPiG7RRnPVdx7bUjcGVqNKBhuV/DKMzrseZrR/DhKbhJ+cRKf0C2bFJotcFax9Y4vaQ0oM" +
+		"ajm+fGse6xaBFxu2u+Xs/uevvUVYjvhxMBIhG0GU7iF0SFWHOJq/NBGcG7ZRY8r6GpXG39etIRedDyp1aPc=",
+	"GBK-EUC-H": "" +
+		"eNqE3cvOJT12Jua5rqI8MNAeyNpxIoNAo4EIRkS7YdhutOwLUFf9aguwSkJJGujuHR+fd8uADWdNMnMlY/PMxXV8+d//d//5" +
+		"r//y+MM//Nff/nL5Hz+/+y+//dM//Muffv/bX/b/5W/+8S/+qv+n6z/98e/++Xd/9Z//9A+//+vf/vl3f/t3f/zDn/LN7/7r" +
+		"b//t7/74F9P8uz/83e//OdT48/d/nx//9b/+0z//9vf/6Y9/+w+/W3z1h3/5x3z5V//l/euf/vlP//q7fzfa/x9+94ff/vYv" +
+		"/up/+9MffvvT3/3xv/3u3/3Hc8r//fW//OM//l+//f1vf/zn383jf3774x+U/HTzf/2bv//td3/1H8//+S/v/6P/5f/0/xT8" +
+		"7//6j7/lB7NGf/8Pf/jtn/7xb37/25/+5o//7be/+Pefz3/43b/fP//hL/79Pq0//37u5/4PP9X/v76cPh81/Ne//f3/+Td/" +
+		"+rfv1/sz+/WKelDboKaUFVRFHYOaF9SJKqiOaqhnUMs0qE17i99tE2pDzSgtbAuqo+qg3g4Oakdpb2uoA6VnW9rTsy0t6Nmm" +
+		"haKsGl+9BrUrqzdKnbteN3O861kzvl3PDiPa9ezI77R36nUzu31Hmd1ufM3vLn1pfndrr+nZrezQl1vrhzofoz3U+WjvMC+P" +
+		"Fg7z8rMzfqgx9uejhXO098xqOS+UEfUx2ifr11dUyjaU2e0FZQZ7Reln31HnoC5fbkZ0+XLT68uXm7W99LOE0s9iBm89q1q/" +
+		"/e5dxkGNXfdk/Z4Pykw8E8pMPGPXPVnb50CNL2en4zlWlN8dJ8rvjtHeXHx5NZQvL18WX1rNuei11ZyLmbgraqzY8+RLfXny" +
+		"pRE9WqhqecZJncf+3D4f7e0T6katg5oW1I7Kl+egZu3tD0pfmjpnX44duX2WUBfK2A91rqhzHtTmy/seVGbw1sIxVnN+lJ1j" +
+		"rpeCsneXcf626TNWejmXQa0zakVV1IY6UAWlznO0N+nL0sdop/Kgxogmp3/pF0otl7Km9UtZU3aP2Z2OCTWjFtQxKOd9efS6" +
+		"byi9/jn9L2cOB/7/cuTlMZ6utWfM7XSNFVpXNV4dZY7uUMZ6j1VfN/0ym+vmd09F6cmTL39O3zZ/GmrUMk/qLKP1eT5QDZWy" +
+		"A/Wg/A63XusHpYWqhfVEjbmdceR18NKXulBacNrXXQtO+9rG6s1Vnfbj7NZb7bnZqV273+Gzq1Wfzed66dk1oVaUeXlG68sn" +
+		"1I0a7W3jZtyWaUf5ch57Z9t8uX5QD0rZ4AsvtaImVEGNlV42vxuc4KXypRbs3M1cL2ZpG/fWtpQN1VF6Vi7UGO1mHRZ7fKta" +
+		"r1qvM6qhtLcvKHXintu44bbFfbdZlaWZl117zve2m4ljzOB2aK8b36HOS68Pv7u04HwvuODmfC/27uZ8L0++HDtydW9tZ0MZ" +
+		"33mgtHCeqNTZUeZz3HDbOmmhT6iOGvOyZm3HDfdSWh833LYuWh833EuZM7tuXfKl1nHIDa9Zl3FWtkt7+Nd2aW81u5c6SS/b" +
+		"ZbSbOq8xu2vR3vWgjPb+oNR5m5dqfHjwuqsTD16z0o/f7WrBe9Zdrx+jbX736FnL74zWiSurWo4ZZXzHhjK+40aNlV7PD2pF" +
+		"5XfqPFOnGTzzOzPYF5T1w1nLpvWroLR+7Situ4lL0foTSuuRlf9/OXJxTjd7rjiLm5upOCkbyansM0r9eNvLXlCjtZdpoPxu" +
+		"SdkYz4aDFKdoW/PlgzJWd+0PC/ihzjHWjcxTcMGtPihfkmtK/6DMph24Zd7twM2tVS69Ps0mDvlz7AZlJs4DVVDW6/al813c" +
+		"hD9bdlBjLTcSULmNnQRUbmN39ou9upFuixt0wwmKG/RnWw5KCySgaieVz5iJuo06i5NZ8edCSq14cHFOK8mpOFPV3V7cr9Xd" +
+		"Xu6UNZQyZ6PiNdXZqHjN7ratdsGuL9XZ3/WlrmO/7HZWdTPu7tDqRO94d3Wid7y7lo6aUWN2d2Oozvfe1On07U0/jx2ln7ST" +
+		"fegcL6VON2o9x2h3PLheoRpKe3hiJWns9tL+0TqOtX/UeY/WX6n6h2oVVUONvuz7jPK7fax0c0vv94XSwu13OF37LKgddaPG" +
+		"DDbSbbNGjVbarFGbd5TfkWWam7/h683N38i6h53V1gWlFtLmgWM1N/iBK7Vt7N3DLmhlzMuB8zS6yoHz/EzBoMbpaHvKOkot" +
+		"dcx1I4kepOJGfj6se3PbHmT55mwezZf0kaOZF1zwONR5j7k+TiNyvx5uzQOvO9yah/v1GJrge6uHGnUes1quMROHE3dcanHi" +
+		"TrfDYSbOfbR+4DanXXfYZye94vjhIb/iyKfRHeS203gO/OQ89OQe4zlxl1O/+jpW/aR3dlLcSdPs1vLcbtQY3YnPdmt5kqM6" +
+		"+fl0u3bn+9xTdqPUSRY8yVHdaT+b35H3zubLUlFjj3en/TwrSpm7r1v1kwTbSWMnztqbnjkp3Sx1a9lJYx3H6u6RPqXsRGmP" +
+		"pNanhrpQZsId09kGOrmtO2HdOf0Z9KB8WcyLW6UXY7c/etX6PcbXyfIdl+9t9POydzqOdTmL3b11DfvUS62ogiooLZAeLtJD" +
+		"J4lebvDuxrmcvu7GuZywy5xd5vrC2y738jXpWRu1XHOoEzXO6eWkXFtHjV1wkclvq3m5Y27yw2W33mmP/n/T1S833E03uj8p" +
+		"O1Ar6kSNEd1ttB5L3X2MXt/41+0+v/Gh231+40O3+/ymEdwk0Zu+dVuxG4+63ZN3m1EzakdpvZ0o7bXByW/3eexhz6ZOM/84" +
+		"HfezoUavH1rp47577NZn2D5eakatKHXil880dsFjPh+r8pDHnvlG+R2e/5DHYp968MuHFP4caqGZPU7OQ2J4ho1te9zZj5Pz" +
+		"4BrPoRZc43FWnqov7teHveEhqz1k8sdt++AhD13lIYU/5LgH13hYLX468UNZv8cJeMz8043WzD+kl+fxuyfUz1yXz9g97TPO" +
+		"UWEveqmGOn7JkdtnU8eQYNunqGNYYV5KHWM222fs//IZsstL9UFVbQ8b7EulTJ27OndfNv3afTm4RPu0CaW9NqPU0rR3aGGc" +
+		"2pdK2TmoUwtNC6cWTr8bN+hL7agTZUSXWoaVqXzGGW6frvVHz/oYw5S5HeemTINnNLb3wo71UsqGHtimoTWVaUiGL7WgrkEV" +
+		"1NDjG0t1mcwEK2+ZulpOLYzbtU1DYyyzvkxDjirsPo0ttcxTqDH2edgFG8vqS6ll3KgvpWdDXn8pPRsyeZmH5tIm+2oeslKb" +
+		"N+0NO8VLjRmch+bySmjaGzdjm4v2hr3hpcaqzMPC1uY6Vmw+1Wn3zIPLN1bQMndfDivoS52oGfWgjHbcoY2F9KU2lJ7d+mLX" +
+		"zYMjv5SxD4vsSxmflZ4H1y3Lx5dDJinLsCk0FtKyzEY07BSFTeilLpQRdWVFLXbPkjm7ULteW7FlN75x2l/KfN5ab/myoca6" +
+		"z8P6/ao/yob1u7DfvJQ6h3z5Uuo8tPCo8zCDVno5jeg5Ufmyo0ZfFnt3Gbr6S+mLmV82LVwPSgtDjmtL0c9nQemnuV6cgGXY" +
+		"M9tivyzDntmWOnq2Tlq3X9ZJC3bI6vwt1n21kxdcY13UYjVX/GwZWlRho2nLkKvKj1H1hxpcvqzWaOnq3JUNTemlxnyyFZfV" +
+		"DC7O2HqmbEdpwYqt5nNx4tZuzh6/+9nJv+LILMdlNdMrrr4OXbatqzruMQ+rfbwNv81LPaixH9chkZdtaDiv1rOglA3f00uN" +
+		"Pb4OW0T5MTIOakZpz67ehrzwUtob8kJbu/aaOi+/O7Q35JOXKqiC8rshn7xUfrej9OVqqLGWq5n+MewNqqPG/ljt41dY+qG2" +
+		"ITWW8kENS9JLnagZdaFGLWUaLbDrljLk4MaS+1IpG62XIWe81INSS1Unfrnh5GUb49vs1TK0yZcaM1HwhW1IhqUMqeOlGmqs" +
+		"32Yfl6rMDVfsxw2XKMPq2lj7SnmM1n4pj9ad6PKMfrLTlTq0+sZOV+qQsV7qRqXsQY2xl23MRB0epZeqqIbaUflyjKGaJZa5" +
+		"wkrSihms7kn2t5fSsyG9v4MdM1iGZFjqpszZZyVpLHWl2p+FpFGHDvdSxuBGZal7KbWY+UoKKbvW9x2lhaHftdL8rqml+Z1b" +
+		"rOAgdUjFL6UWXLdYo3rkd/p55Hf6aV8XnKfa12VodO8o1eLmr3Y56987Su2RVyo+W9w/1Z4vOFZ1p5WuPbyUZbDsHz0bOtxL" +
+		"mZehSZR9Mi9O6j6pxT2yL9pzj+xZP7tuJ9mUYaN5KXXibfua340x7NaWha/sQ4tqddhaym6XVzuLLeml/G7YklqtKHNW7Ynd" +
+		"nNXd73DWig/uXQv44D788602tZAGq7lmkWoV59t/rMq/4sh1eDJKIyHU80apnwzZPmocUn5pk345tc3NVElVbWjZbXfCGplg" +
+		"Nw/Nad/NA7tSYzN8d95ob7dzG+loJ+U0PHEn5TSyEktgaXYEa185SIasfS/VUON3hzO86+fhrmjO6bGPOhtp7MC72dTKuYwz" +
+		"deC654qyemf1pVN7GhFr2Ev5Er88h4+/sXiV0+16HFoY+v9LKbOyBxn5HHrSS42de17qNPYTvzy6vgxrdGPjKv3jd8NjXLr9" +
+		"f5gJ9pR2kPq7OTtIASwoL7WgjMhN1UesxUvtKF86RT3z8mhhMz5z3d0c56ovON3ppHQ38Ylbd+fmtHu6k3LaPX1EwLSTxNXd" +
+		"y6c7rbuXTxy5D3/PS+mne/mk7XX77BxW7NLJ66fV7Hbdaf26uT5x3Y5HsRKWTgo/3XdsNC9l5nGs097tT7402idfauFJnWPd" +
+		"2XbaSS+8cLPTfrkmfSGvX+7z0365Fi3YIRf56BzxBi8v1Bc647XmS7WQGk88+KITn3jwRa46nf5rMy9O/+UWO4d38qWMgQ53" +
+		"kfNPe+kiV532y0ULPsmQV9bo1t7wCjR20HIN++lLddSD0gLZkK3zpS6U8Zn5Puw3L7Wj1DLsyK3bBTfZqbuzxZW91Fije9LC" +
+		"sN+Ue1anHXLPaqlqGVb6l1ILiaHjBSLJXupGqRN/uX9ij37Fkbs9IDrspbS9mRV62e1sdPfb7Y7uTvvNbtCd9pvdoNPS7lOd" +
+		"zv59GsHwT5T78rvhey1ivl7Kl5cW3IQ3eb27Ce9hsWzXsI2Vx6xcJEpWrXY5b6xaLzWhRgvXPvbqYwdepJXHLrvI8g8ucdn/" +
+		"z7AEvlRDjbW8jOhxZ17k58feuYf3oMa2c499XGPbuYecUT+kqntIFjXWHFbCGjvMPbTXl5pRDTV2xP2ok33jHnyvfvDnZ9wj" +
+		"9WPnPmOP11hlnlUtw2fVnnHH1I+d+wxuVqdhQWzP4GYvNXrNglgn++oZWmGNVeYZM1hjlXmOMaJpC6UWEvozbv46mc/nVsuQ" +
+		"QY7P4MivwFwGNbhZnceNerByVbaW46O9eXCe4zPkyyri5qUK6h7UkE/qvPlyWPdfypeHFrZ8qYWhVxyfIclUNprjM+Ib6rz7" +
+		"3bj96rz7nTHMRyhfjj1/fB59GfLDSykbeuhLaX3w/EP04ucz/ByH6MXPPNb2pQoqZXV8eeR3P31ZPsMHeIhzfKkN9TP2+TNW" +
+		"8xDn+DxGO42xv2UFdQ1qRo3d+vmZgkFNg7q0Pmx6n88T6mfs87zkd2VQU35XBzU43SHqcRY/dfwY5QZlfEMnfr80ohEv8taZ" +
+		"Fro687vRz/kwvqE9v7WEelBj/abhN52XIYW81DTKmvbGiZuzl6bLGGbzMmT5eS7GMHjdPA8+cYjVfCn9vIxv6K+HWM23n6GM" +
+		"r6Qv+jmlBf1cfu3ZO1gl3++s3uA1M/34pWaU+scO/OAZxzQ8zfOUHTH24/N8Ustu74QaM/1Z8ruuzLwPS8u7lFZ22Dbf4YR6" +
+		"UOZ98KF562Zz8O6XsurDpvZSRj50oXnLPh5S3PwTSDSoMdPbYd7HuZnZPo5pnJt5O41o3ACziI2XasaQ1sdOmlrKjKHld/c4" +
+		"G8OafyzjvD3P4JDHMsqeZ9wxRxm9fsRpH9V5Wz+oCzUs+Mc+bNOfeehCL7U4UwtqRW2ozQkLVfQ6VLVioXZU6rTjv7VkJz2o" +
+		"7HjtbfbL0BEPkRfvFvdls3tSZ7P/mzoHJ3/nxe+GJv2ZjHY/nPY71Nhnk9Xcw7GGv/WlBseaMnYc63NrzwxOw2/6UvbgvaPa" +
+		"KJtTdii7UOcou9KC/Tn8Ni91jbI1X964Ulp//M6ITtzsCWV8RXtnuNmBMr5rRRnfYwyn8Q2Z5KUKyhhO47tSy47SzyHrfj5P" +
+		"qIwvX47xzdlLZ0aU1m+UFnDkcKU2bvCFH+Clfka7sGIfbeyC5cf4OqgJdaPWQQ3r/kttdvkHVZyAGYVr3KllnLFpTgvHaH0J" +
+		"lXtELSPGc8lo2/BjvrfRmLM27uWFb+04xsl5b78H5e411/Teyn/wUjNq9OwYLdTFnSZepC7D53IcQ6qri/kU91GXIYEd5zgP" +
+		"dRka8kutqLEO5/Cr1OX4tWfv/e4Z37kXzyGR16Wjdr0007Stypp/nGSlhVxzkl0W+78PO2td8ec+rPl1HVbCow/b30s11IY6" +
+		"UQV1oSpqtNd31NCIX2pHaW9YSF9q/O5atTfikA4xBXUdtqOXulDKNr9rfmdE6xXK74z2Io2teMZFGmNfP+7h56urc3oPP99L" +
+		"KRv7sW7DivBS43ebfXWP/fhSM6qjxkzcZmIjWdxmYnOb3yTYzR16D03spQrqQDXUhRpjuKs6cZd78MvKgn/cwwdet2EbOJ7V" +
+		"lyO28IjcvblxIndv+Gzkbrb+41mNYXhmX0rrQ0M9Hnt1G/kUh9iAmnvyKfpyacF+2chmj9OwmevHrhY/eDzD2leLU/QMC/BL" +
+		"jXl57F3W/eMZdt1a3PzP7nfkr2fYDWrBCZ6RF/FS+mJPlKGLvdQYEev+IW6glsHbzo/RlqFrnh+jLWNHnh+9/jFR/1DV74YV" +
+		"76UmlN8N/biWcdefn7R++jLt9VBiPIdf8aV+RtvKWOmXEqH4/ZIXbkRJvJQY4HGmz88hxnPcoefnEAM87Gjn50hfJlQzWmMY" +
+		"d9p7H4ca8yli8KSB7CL5z2gg+24mxp1W8dmXekavm56NO63VfHnGqpayWaSVfp7JjjF2VrwlY2CFLcMb+lKJGkotdWTSfX/H" +
+		"5rumrI2yQ6/Zb+ysl7JfMmei5qYn7cWDVlC0vcO8DLtPfR6jHffdPuzWv+DI73fye05ti1dvxf7oYgO6EbDtP9kfwxu5z4f5" +
+		"G3pLWbJenQd+S527ePx8KZp8NmNDb6nnx3i+OTUnyuk77HjRKdcTirf8CvUz8v3qfndZ2apONuZ5N4aht+yfyWyKI6s5N0Nv" +
+		"aXWxQkNvqU8xhisaccqqE5bf8Y50p+Gyc7MfL/7jNX2Rf1bt+GH32cV8vZRogMsMDn2nHpNZuuLVtLK3+zvzyYKyzn7HhjdX" +
+		"azS0mn3+lo3x7Xtq4VGqen0bX3ZnIjaKMSRio6cWWWWLmR+WwMoT/FJyScIlRGbPLbWMk3lnLw1tqMndeqkR28QWd35YsvZd" +
+		"GUtWa1ofesVIoRzU4Fj3ZUTxDF1WbGhDde9md2hDI1B+UD/jGy7ZQck4CycY2tDeZ+Mb2tA+X+nZOXbPl5KD8kkLP+N7tYS0" +
+		"fss2GmVic2oZEuUpNuelKsrNMewUpyzZWsbddMqSreVSC/mhjOiRU2ZqLY8vyXQ8rC+lhWGpfqnRQv34ndu9fg7UjhrzMrkP" +
+		"6ribXqqj9JPtqtpL04iHqfytp6zVWhdjIC3VoU+eslZrHVr3Kd+01k0t41bZt9285FbB6aYD172MaNwq256ejVultRoqsa/5" +
+		"0t49U2bvHmaQ7bGcaW+sba9p72dtW99TC8sSvsS2U2oxn26VvaUF8Vr4pxipjfz8UnKWnOJp3Cr7s/86Z+/9bpzaj/M2jftm" +
+		"vyb9GvdNW4/UX+RmpoxtbGSqvVRi2dO2GLPbmoz7pk644DTum30/UiZifDNH474ZqZaD4jHOHnDfnO5MFq/tmtU5bpV6P1rv" +
+		"JK5ZP90q/bEm41bZ72Km3SrPkzrL4BLufdaw9mRfxX/mBmANa98R5VaZUnaKjkwtY2X3Iz0b45Ptc7KG7fJoTtawtwX9vHj2" +
+		"Li24Vc78TnTy/bEqbhW53ydrWN2yq8et0ja8e0oMyhaqilJ1MuU+PD2tD670NOsgPp6n+ZTVvE/dDhy3yrvs+fIaZ2ozZ+NW" +
+		"afVIe+PWfE49c6uIdT3Zyl5Was5yq6zaS6b0qQWRoctlxe5kIeIL4+bYn+yscXM0mb0vxeud/enmeIqxj5tjlzF7sqO910Go" +
+		"MYaemecDmXYtyD9jlTzZ0V5pWp2PqN9iRGIKtj0UycbNwY5W7lt78j7O04gSL7WmTD5ttUbyPqYTn30SQWEPPtABsj/ZrVt4" +
+		"/rg5Xm6WvvBCkLJm+mQdke4vtaHGnM3Dc1MrXjCT7MV9vBSePyzcp/zulzpR4x4R6XHO4evDp3TO4etGO4db374c3LpuduR8" +
+		"2POkrPmgn59aGNx6X4Zd60z04mrXzYNbl9nszoNbt/VMnfHMzqhkrR6o5OGal0Mk3rCMv9TY82vP74akITPinAe3Xp85X5I0" +
+		"Jv0c3HoftvdfceR58PGdJfeUk/7KIPrFg3uTF2baQe8pG6f9II3NvLsLnW2WKbO4Cedkoj75XbPj8+UhQ8psnnYSnj/j1vcn" +
+		"LYho+P5u7I9OS5sHt26fzDuv8Ccj4hVe71DsMJOeDW69h9fMie/Z9EVE4uxEz4NbvzzDKvD3yDE758Gtdyge5xxuTQISpfqe" +
+		"zJQNbrZc+d3gBDIiX8pJmdK6/OCsOh2gNGMPt8YXZtyaTfSlxFRnJga3ruGX8+DW+9zzJR2H/jjDmbjJZvOVqAVjGNx6Z7M/" +
+		"ZxGD67eWcRt1N8cs0qM9KRP596RscLplDWV83xG5bWl+8x0sCa0HRQA3m+PHX41dBkUnf4ns3X9MNYMSI3ulTM44rjQPHWBf" +
+		"5tQ5xrcsaV0E2K1ndIA9/OR2Mpe00OUXmRf5Uz0nU3bmRY+H5rCftxE9ib7WHh2g0v1EGe+9Ois4uRzu8xtlfKZsrF/NfA5O" +
+		"3lr2xODku6ialxoy6zOnlrE/18e8QPG4M9eDk7eSPTg4eduP9Oyix5sznHxxxpY1vDQUPmsPLnSAOmzap6jmWslOC6l/H16B" +
+		"cyH178OXcS7sWrtbZWHT2/G2ZWMJGR6zU/xz3XEp8c8vpXX2ot2KLax4ou3OxT2y01+XkW1Rxde91I7Kl/pJ8l3Yi+piRO6K" +
+		"ToZd2ItuujQvUpNn/FJDfhin8VcceRm3yP4Z3q1zYUlahtX1pUTvkkEWliQZz+fiFtmW9DKIJaHo43u+pI/TTZZxi7S1pc7E" +
+		"pRoPmb8sWmdJeujOC0vSdadMxviihVOcQksZyZccDHmkzDSORSTQapctLEnLYUckv6dadZakoWP8UGOvQkF5KVLVnd9dpD8z" +
+		"eMrum/I7ccUkriVZQuxKi7uis40tnc625svFDObL1S3WUaSAlrKh2Ta67AL7oLJ4LST7Y0sLMiimfCmr/9te8JhCjX18fMyE" +
+		"2FP+s3NhL3qymu4KkXjn4q6YswPHXdE+OTfjrmgHnrG4K+S6vpSMf3fvwl60Za5J9guNYCHZLzmn7EW9GFEk+yutuwtzhsdd" +
+		"8coP+XKsHz/tCUemPd9ek3Ky7tAcek7fuCv2iwwJcaZGr0gWwXaEchee9vUdZJyUQZKh5y43ewpLneyD7SLLyz7YzpxNd8X8" +
+		"rfMYN3/2xM3aXtOzMb77k7LBh8SGvlQyn8zn7S6kuS/wmG7SxPJE8zQTD86aM/3A2prUMu6KfSIVBClnzpkm9U/ksWRJHDW/" +
+		"Y8880sJYv2h7y7gr2vYxotwVpIJFJsvdUwvNLKvyjcMd7UHmqbvVXPlAdnxi5fXYRxzGCafn1WbHLK3Dc1n3w5d8gLmbYPjU" +
+		"nX6wssrsrEcrD5NY4nMl5+9rKAhMx5+xWsjmqHX4Uc5kc6xT6hi8rbn7VvaaZo5W9poFV1rlQ16sPivefdjjqzjptqSMLXVO" +
+		"C7wALFCrLGN+onOlAdz48zp4d5XNca6Dd9eD/V7WyXaz+QYHCGrbSy00d32RcTk7pytUmTWtD95dP9/fGR89aR28u23u4TVI" +
+		"CHva4wVgD15PcuKS9oacMe3pp/F9Qt1iJc0gq8x9+F28AGTyFe+eF1+S8288eO38YDSxFe+OF2ftsaVOqGJXd1RlmTCfeDef" +
+		"+0vxeJJk5O3sJ6kYktKrq5gJmDai0c6VVUY865kMn3NO64N3f1gKIDDtq5O5yhmXg3LK/tkWNrz1cvbdMbCa1uiBMoO26crv" +
+		"xvr17InBu1ssDDKDas/MX2zhdNJ18O79zm4dvPtVpFOnu4kVe2Xrh6/zUskENxNXUM18iXdPGQPeLaPopcb6dfabVez3ded3" +
+		"Q7aAEneusfVv+R2LhltTPtN2PqHwGp6UNZljOSt3cuGMlpw/PP4/lCy9Ob8bvG2b0jMZbqx/6x2rkzpFYJ6sjevXYuN3sgnn" +
+		"TXtyjhtr9CqvP36VlcUm98GKd3+O/I49eEkLO09DftdoiaHI+eFEbP3XkvY6b5rxJePZrQLbq33YwmFt1XApWFs1p1i+Vm0j" +
+		"d+XcRsxxhelxytd6qdG6fK2XWlETqqBw6/JrpKETflZtZgV+VoHOd24HP1h6KRNvJhNsQYFc8rtY9FLGImu3bOHdZ6id3Vov" +
+		"2dq3O9TBv2qOwrvXlMmUvlN22cdmZfDuV1JLmRz49Iz1ptirG9792C2bjMEFzwgi2OR2hQi2L5l3Htw9886iPrsJt/Dubxkb" +
+		"Bt4WtDAxX2fQwnpLX04zb53ZaM4R0XMGLWxe0gI/35LfxZfgy8G72ycjYlEv7tfgij1nKPn4LdTKx2/vsKjfJXW6m+gVMMdq" +
+		"oTtsYnJP2vKGd0P3OYNHNj3pC72ihep8M2kP3udqxfBuGKLnhnc/9NyN3L3xGcMxqyeODMeszqynm1yx6wiFt5GYtyt4LVaT" +
+		"3C12+Az+2d1CQSjKzJO7Req8FI/BnL7AkiALbvAbnyntXfSD/O5mNU/PHtYALciJj1a6JXaflLrh3TsJZfvK3WY+cndN2bAh" +
+		"Vhb87Q4CUyixMvTJLZilq1W5E6mTWiB8ZIfg3Z+cTTaaVtJr56+Hcvey9UN7e+VS/WRtX7KvB+9u8VVurO1TzibePbu3ttho" +
+		"vrWQnXg9oMS1NTsrNppPqJ2XP+01HvnUwi7CPyLfdE8EwIZ3P+T8jZ+2f0KxsbknIbrV5vzJG32pB7Wx2c8ofJ2dV97oS/Vf" +
+		"c2RZo6/+OOaohP+zAZXwf2ejhP+7zUv4Pw4pT/TVnVOGg5hbeaIvpeyAiJwyOQWFBFuOoCSqkxVmtSaFf3U3fwXPfz75ku/c" +
+		"bR4EubPp9eD5+0n/KMEInkPFA5KeiWo5QvHC4bPloOtlpvH8mbZV5NftdHyIdWv4HsS68ikpw/PPUDKPaPUlKK9s7yW2lma0" +
+		"eP72rXOnpZlBdvm+5ssDJ8iXdNkr/eSFY+Utg+e/29H4yOsnq2shryc+BbJenbPug+fv52MMeP7utBfyenW7y7vd5YO9VOS9" +
+		"UGP94AGehbz+yYqJzZGJdJaeDCmjxfMbvbMMnl+P7B62lupMyfNt25J+hmekn2JXeH/KxcuYvfRFe9M6eX0ipcoPfoUj7bG1" +
+		"nOzkQQ7cj1CQJekqZfD8HQ73S7EFlrTQ7OvUecDgW1EnzpN+iqF70k88f03Pgpxh3YOcSeopwZObzC67fFtCwdkjIxSxOTd7" +
+		"CrzDUrO2g+fvst9eqtBtUxb8W30Rm/OhwxWogrEOQ03cv2O4g0xllgbPb/eaL93ZbluoiW3PTMQu724qeP5BRy3s8p/wCTx/" +
+		"IXcka3vm1ZS1/c6gmR88v03i3Urs8lmVJ4g+aY9MGX4WD+uSLw8yrFP1BHUsrXd1bn+GI7PYX+zB5QlO+2itstgfpIDKYn/Y" +
+		"j1Vs7eF2lTH+UgdqR415r2JBDz5ieeD1IBNU0Z8HPaI2LdhXVez1Qa+uImUusktlk4F78lKDEzyk2zo4+SvF6ScMd1hXZz14" +
+		"sL5lg5OXT8pYJd3fleVluVIm/pIttR7BBysoUXolrV9iEE+UrO1Pyh5+DSMSf7niLrLe621XV5aX6Q5FOiKt1OD5siLUWM1F" +
+		"gdTByfeFvFDFXyYipZ4yzthF6uDkrZXUQju59BpqYRXFVlnN5zN1ir88U+fNC5EvISLZnbLuW7wCVZRlc4vV7m2CJ18uTlio" +
+		"sX43D1bFybfsJR7W5UmdlWSYsmC5mjMe1v1KmZPCylvFwyxzWujuGDMhynKlJVbxMJ9vX/jOyVj1Si62EV3Zn+ZFlOXsdq84" +
+		"ebSvGqt5VkUmLDzAl4r2ZaVFWd50nBrpfUvrTQRT6rR+Yi4ry0s8FJX03ta04PWDjJbl5ck6iLKc6DFVPEzP2t55W8K84OSd" +
+		"XFVZXiKTw1fYK95WWc37k9+5iT/5cqzfziJVWV627M/kqaafrOafOXWKkv1+2Wkg+fKyl0LB7aTR1TuY7saXVwzoFfWBc8wu" +
+		"UnHyNqdMrAxttrKay397KSiU4W7wN+aaWlheyHEVJx8vI/yKI1c8PjdTFUXTw3UfNrVvL73f8EmZO4z8FVyLSsqXY1YPHtxd" +
+		"NMxhHvaKP5ODd5GMxxNKeyI84Vq8lFpk057iwGWHvVzsg6qoA5VotAd1G4NaWmLo1CLe5iRbyyMrF0+iPLJXZlEnnt92dYq3" +
+		"2cU8yiN79TEtHMntOFHBRkst1sT9I49sfe7UyVMjDkMeWdkyg6zt05wvx56L3LYHIbLld48y88Jic/H3yCPb4uOUR/YqxAXF" +
+		"k+jUBj+4ZTVJ75/0+kxUxo5yp9Ey9vD8JXUO6V3e9CmPbL+yKng+FI+XwhNZEGWVtWReyCrbmlt6T8x9VpqndG1Gi+cXp2En" +
+		"vReS7x6eTxPcRdXIozkhIpdGRth7NOKUsdhcqVMm/54yJ6fmd3JzeHR31va65ndQgcgPe+dpwwX38HzS357I+owBz58yZyw2" +
+		"raeMJ5HvZL+sH41nx/Mn/qz9iqfNnF3Gd+R3Y3zPki9JfyTmHc+PxLxfsbhZB1E1d3akqJrKogFJpiZyc2exKXvqHBabI7sn" +
+		"1vacqnhKRavssbaTj3Y8f6VP7iLrt+xyiAPHklpoJ6SJnfS+Zt1J7yetbY+13a2ys7bL6H6poZ3c7LU7T6lczXPnKd2yQ/D8" +
+		"Obtg8PxWSdP7w1uC5++k9335M1aLXbxN9KT9oZell+T6kjki16+01/1xMskZexD/P/lyF7mffjU9CeVksu/tibmn0+xP4qVS" +
+		"y4XXpAW2OLJZw/NP8leTVXYuKWPppK/KBX2pMbeyP18ps6DwdTbfxod6WkvZn/Vkn5X9+VIbSnsD/eOltCeiQS7oS3WUW4Xc" +
+		"1sTinM5bE4tzsgQ28Z7Qi84m3vOkNbWBQVnhP58t0Tdu3va19fuSrX93bpq4evgsLxVp07zw084tv4teZpbGzdEeM99yc7T8" +
+		"jh+M9NeO5PSEYgs/8iU/Co9SOxIPZpbE2HTSbWP3mXn22pno5FC0hRpqwU/UEj/tk1pImxkRbUH+7EsFWcK8nEGzN4NBPKPZ" +
+		"ttwcbunm5mhTamFrdPs1MTZLT51ezmCNbomel2/QOmmMfNkSY3OmTDYaX1Bj6294dxs3R/vOICz95c6XYjTYKBtt4aCBNPGY" +
+		"nXer9WSjpU4ohiSwxta/k60bW395UqebMeMTjwkF4mzj5qgr+SEY/DMOGQz++Eabm0N28kuNm6N9Kb4oUY+NtjBfqQUuMP7S" +
+		"xGPu6dmVyGzzcsFmZ21vbo7pSC2Dv8Su29h9zswEu09kkhZb/3cMwem17rQFGMUvlf2ZMvuTFN6CaJ1z6+bwgtbZ3Bw/Dx79" +
+		"kiM3FqHGXtPcKTX7MR7crB6LkLfRzkaPSBZBc6dsmTF3itfQXmqM/FwyHl64rBcvQAlXSraW+NKWSM2supsDHvlLkQnYsRoP" +
+		"7hyuK1LTC2untxbKfYUKrl6+FEHxSS1yCu6Useg9ocg8OYvx4LIgNl6AJzuXF2CVOXOw+5y8oUduB9baQ75UtOWDr/ckOR2k" +
+		"90TaHoMHv9xPnUfOcEPxZ9GoDrEycO/PI6/u0dWP5DaxPkCea7EQHXjwVFI2eNR0pD0a45GejZWud1r3+g2N4Ii/9Ux7XhzI" +
+		"aNnep4xWnONmjQ4Wm8d9dwweXL01dwb3zts7Z3DvljW1uGNw8oPtfRINcAQle8+XUI8+aQ8SnBv1iMXGmco7E815887EK/2l" +
+		"FhlMc2oZ49uv9HqMr+xaFyvTWbIOPPiUMXWIc/yIS8rbFa2GohHTt47w4CcURLese954oi0fsdi01DJ48JI9wWKziBw7SO87" +
+		"qfhge2+Zs1hs2CyPLg4wq8Jis5IhD7b3krVlsYmV5BDnmAzkg7+1Z9fB5ryyk8XEX7wsx4UT0XsPsTK3m+ogvcO2eykv3pBC" +
+		"vPDRkrkJ/bBuWZX4W/e0B9fiTgtQ3EvKjI8n7BATf+cE3MGW1PrgwftOygpO4gdXPFhskpV73MlYNwZ5sT8pvr/kyLAW90K3" +
+		"hLX4cnpjDXbetyfe2NhTJu9pSp+72K30S5RXVui2sjnRePAiquXIu1u49cGWs5DNDracZDwfPLErLfRIFE3N73CeNWXw/3P2" +
+		"n+BhpE7v6zw4lgjINTsiVnl3xfH1xKafwesy04MH1+TKBHUyN/3J/9ntgZMNvZNuzxbL3NidJ1n3YU85ybrFGM4jb2Z1FGR2" +
+		"lqszfJb9+TyCSHmgEjOUOncW4Aklf/1Kmffrvu3BUllCife88rugY5wostKU1r3yJ1PgZCU58ZpTPHkzZydZN/dk0CM/n5QF" +
+		"XVHrZ16DelBFDJ32yLrQ188gS+70GMiSe+xokCVr5MtTTOJSUou4naxDYhJZT09xLdudfj44q9/xcU40ui8iZWqJrMsfefbI" +
+		"SuYsMYlbatlEGlpbVpLbqT1ZSSILnmTd6uY4xbX07BdWkrKHoqu4xU5Wks+Tvlzw+dIedO01vWYZTxkrSfIcz/BZJ+4MUiFe" +
+		"c+KzC2/aKffocd5PVpLnTC1k3cwgH2fh9TjJuuVKC2LaxGqeLOOfli/H+k3iDk98NtlG55V4+fTM+PhN4YS+nNx+Iesmg+lk" +
+		"GY8FDIZoTdT9GTxa8W4nPhtZ5uTjPPkyTnw2VrwzuUfukSCRlk9aH+dvIAH/iiPDKH31v9QPXZSN8ryDLpp+iQhZUyaaNuc0" +
+		"L//inmfwInG6c/DZfeGfOBOtmDPMZv7J2WcliTX6JOte2YHwDRtp+mQlmZ60kIglK8syXrOrk1+6hEokfWoRbbqkvcSZmD9W" +
+		"kpMW2mWUdja8Dm+gywDrbCbwdU9IrhW+7nukBhUN3GtQ+4cM0uXxB0OkDx68e2n4pWTaii7q4sLPI186w986vSR2p072oju1" +
+		"NFEEofJSZ6igqKeWDhNCr1mqvX7zUvIjndMedJjMRPL43U39TOykfpJ1N/KXV6teFXhG8U7SOzsefGQ+xYUnj6wnthC/7OwN" +
+		"k9PXY6n+pHVvQ5GYu2x9SFEv5UWRPb/ztvCXIsuX9MVOxod6T3ypsbNUe2nlpcTRkPe6bP1rze/EYeCznax79vyuWE39FGdy" +
+		"yi/qLNVeaTw7S/XD2t4HD26Fxtrx4CYCrJN1YdW+FAwRt3RnbyhZd3Em1Wns7A2Nvc9bYuuTVRFnAs37zFtiM2tAv3LHaEG2" +
+		"/r6Hkt/qNPYrsXdGNHhwW7MnyLqfNX3hYSKdea1sDTJCsH/rty+XfJ/U4l06nLyzN5xktX5HllcmzqSm1/FO4kRdbOG5pIyl" +
+		"mv20szdMWVuW6unbgjghdrQu/3N2b3U8ODyry/8c+Ji/4sj9ltmL73U8eA8nEHVYc9pvCBtnRifqkGYUnONZlHZwjhPL22X7" +
+		"nNn/T95Gt8djb6Dd9e+72E4RdBhvJ59BS/a+4Qktuc0k2E7WvWBX9Fiql7QeS1KoLk7O3OLB55G+iDMhWVwk2A9+eZFgEx98" +
+		"HXnRekKtOOuDglMw5Us2kz21wNbGayAwb7ebAwLz5k2p88I9G4vNlbf7PumZe3INFZT4tE7C21P2iKYdc3YlSo+WdvHzNZL9" +
+		"xc938uJceatZvME1uGeLJfeSPV/w54u1diOtXLhn0HYuloL2ye/EBsxpjz6SuU72PB3gSpQeLn+J7bic2mtwz3bs+dL7lHy/" +
+		"Fz9fYd+4kj3PInWxFKxkyCtReqwI1+Cerw6XLzeoAloXmT3Tmy7cs9HVr8E9W6v50u1H97tIsDfp78r75KyLFwl243e4WGuD" +
+		"VnHx8z3fL0VmQ4m7cM8lvebnO2hfV6y1TtUVCTYjuhJ5ni9FzMoVuHDPnRR+sRSUJV/mTTDjwz3nI61DYOINvUiwu3yRK9wT" +
+		"V7pIsLGgXKy1taQWcVfptcjsK/uFny95/RdLQbtDxdquBVF60douEuzmdr8SpZeZSGQ2jeCSEfnhI7ggaN3kqgv33L6/O1la" +
+		"U+alSf6m6w4CmpXGI881XybK0rwMHtlGPtOvOPL1iGrJ/ifBBo/sYq1tOe2De9bIrBc/35YTFj9fz+/EbIvGvBL1gZderLXV" +
+		"PRy095IRkGC9PfJSrNFXqItWYTZZCi63z52XSMm6NzvrjLvcZM8F/7r5uqaSsk2s2I0qcKlOlFeWan43Tl8inW6+rni3bnkt" +
+		"4SA3/d87oectMi5WtPsI/tmDcgOQwm/c86Rt3bhnweXvwT1bckHvwT1bdaJv3HN3am/6/8bTduOe3tN6KdYc3Oym/2fn3mKc" +
+		"65bW5VxeaZ3sgrtA5N8md/Y9uGeNjfkW43y09FOMLP3/5us6Mp9kz5vEdctrWVrKjM85vbucPZLhv2H+a6Gz32z5sogxs7bs" +
+		"rAvZ5Y7s6Q69e/KSKmpwl4kF8YY9Mn/SHjs56/DNzuo1lfPubj82trvzsmdEySenc9y4J3zrMy8VbLT6O+/YZnzwB4+aL+3P" +
+		"7CyRcX1NC+zIeyh4a3d+Z3+SUu9Exh2h+NK31El3cMfc4Z4lX8YXmzHwA+Csd/LJ2ae83rrfIiG83vpyBnsiSFE0l7zeuvA1" +
+		"e5ehfLIOsbPmVCXGuaQM3gHpzAsOzQu0L+V95intBc/BXmJNrUtquWhmoW56hbHjnivZyasQJRFT9xP8SLObFw6zy1lTvQ5w" +
+		"3k9eVEgtcp5zxpJP/sMLfsWRb9zzzAn7RkmY6ej/5JP7iYfAjMk0X4+Uya5oGQEPwRQK0gMLQ16kuGkHj3yRp4cSNecmfOKz" +
+		"2lLGb0laeeSLeI/ppaC82p1PUF6d77x3G3k97916w/ClYB988ju2RrvaW7glGXWPuIHpzu/ki5A2807uTHd+6PH7HIqe62Q+" +
+		"bKmV9uNF3eo9stOLuq9gYZbo8YlEfeKzSl/w0k5z9/Zumbf8DvZBy5fBkZlRXjdxAzziBrxBcXqlt8xPfgcRjFf/ORPRoz2S" +
+		"aE1f5IvMbtuHHl8zS/T4ndT/4KXxgz2dnpt+BpvD3fvgpUEKePis1jMUhK5vC17UIZc+saWu6Uvyu0N55TwzQRI9yTVP8ruz" +
+		"J0iim9P+iBsIxtkTHKeasmC56vU3ythqkkQP8vMTWyp+8uClyUB+rmCrmAm21NgGHnEDQSp4SKJzCSWuped3eWcvY+BdvtMX" +
+		"dyEf/IOXzmLbn8FL23dnfV/C1oJ8kT27AC9tPIIPn5WX/F4q2COhRMHf+VJEXVaMHh/L+EMSPb+ty9F1hz4k0Ydc+uCliTJ+" +
+		"kiO4pD2+DFa855vfbQbldyci+MFLgy3kTela+dIfvLQuKYvPWM++vNTeHRxzvcN7cMxYxh8cs58pI8sc+R072p/LEHmexI8b" +
+		"HdSOc83obpGoP6Prn/DSsQovRasYqFgvJap/xG32z3iPrN53ytgFh3T7UqLeh730peBSDW72UjS/Ke3FN5P2TlphRYkKX9QC" +
+		"XV5maIfl3dqQKDss7zLNfofL33u+HLsM+mCH5V28YNJheReydQ+WN2za/gnqakYkMmEu+oLL07Y6LO9WprTQ4WGkDBfs+d3t" +
+		"Vkk/B5eAVNthee/TapZEhz2HWnB5GWAdlvfu/YEeLO86LC8dlvfLEvNlYWe9UGN8R0kLPGbDI9Hzfrcz3GF51/O2KmfGl/bc" +
+		"y9Us4fJixTos770vKXu8Qqx1kQmtWzH2Bm9zdIjd7w7ROnvDp6fMa2i38UHxYKfoQexuzczndaZHX5IV2I1WLsmnG1HPe63W" +
+		"QWTClXlhb4D+2YPYLXOmQ+yuc0nPcPmPeblEvxWt4/ItvRaZMKWFoPVd9kQiE5pZSlxx5iVofZv5jL3h0WvW2q3kd8Omt1W9" +
+		"Zq3dM3aRCSK5OsTubcquG1x+qy3tQbnIzPOYfYY9rAexu9dQovtuLUBg2h918pi1zGAywU+9DrZqswtw+f0Ilde1Uov4g6zY" +
+		"nSillHnN68nvaN1nejbWj7e3B7F7zc7C5UlLHWJ3m7oVY2/oh72Ey7clZcOeItu7Q+zely1fBkHL7OYt15+d9QuO3D+xNyxW" +
+		"7wn2W+rf7TljlSO+L/osOmzZjUe+oPezerC8P+GJySXJ6rHWPsMG1IPl/eASwfJ+hj7eg9f9FF+KHeZ16JNs8tQ5ySZ/RqRT" +
+		"n6DsPYff1cQxjzEEWxteSg+2Nv9Zn0RCPF2dLche6gxa69Bw+hRZPl/KHuktZeLPxr3Yg61dTuP7xgDnd6JA8GfY2tt6G/s3" +
+		"eyQUq6t1hq29L2fai23HiA65w3jilOyRnlrEWI5sjg5b+z3tDwpW04ic6VNeCN/0E5eflpTJbX+0J/7ss+ZLeueZFty93fhY" +
+		"lenOHYL2NqVn4s9g6XcI2rVnxZIxWNJPGWezmcDlH3sQgnbjhegQtIuXAzoE7TXSBATtvePdQdAW49yDoP1x+0HQ3rxi2CFo" +
+		"V9nsHYJ29fpUh6C9yUHpELR38QYdgnaBRtin4Oyl17g85Iw+Jfe7mDM+ucg50/c1dv1MDLCbA4L2vuOXU+Ii3HDT1yenTlZl" +
+		"8myHoF3XJWV5CTt1Ju4jFLvWpC9fu4h1EH/2wfcgaI/HNgfF65GzgstPV6hbXpKVTvzZZM7uYJaqhVX5u+dZlRc8GIL2eK56" +
+		"UGK4dz2D97FmDw4uvz5LvsQLmrVlVQ4PnpIxWK2m6IfypXh1jvQTZmlWM3aROxS75J2+eGG0/TpDpMPdrjzwfcqLDSRfuNt1" +
+		"L+ZP9oi8tQ53u27hnl8EPntAXETJuXnyFq7584JpfcxRssLd5nC325M9l+yRnHZcfv2W8cm5p4K7fQxdoc/h8uZhFgkhM67P" +
+		"skeeEbnS5w1a/ycUvkfem7fkrox+zkWUBOlhLsle97viS7sTsvcO977PhSzhFptrbHjqHDrHS40RQehu/C89CN2yFvocjCf3" +
+		"AYTuVoYtqQehGwJ5h9C9HZ/8DvZoxicO4yS9z3nL1f0Nobux53cI3ft8pC9ijUpquezADZWMwQ/qgZ5nRHj+Qoac854CfSQ4" +
+		"3GXVuryPjiPD4W505w6Hu+678Q2e32pmPlniWVsYT15g7nC4m1j9PgdblWw2B+Mps0uyF3fb52/GoLGzhcuL6MHhPqrfJWNw" +
+		"8rvB81t0Djjca/QYONwF+nqHw731EkqWcTF2PP/51ilWbEuZLH/y7Jw4DDIJHO4K16LD4S7hBHPiMJ78ji0cz4DDXZYptTyi" +
+		"TgaPCg73nS9J9guuBId7vauefT2JdkFeTcj4kvdx5MsiU8F8Dp6/PnNa2GWD5su8Hp4yntIzFE/bmX6OOzucPDjc9VuWvA/z" +
+		"ImMQCmuHw9222Rrh+ad7GQ53O9Lr2G/I3cHhhpLdg8N9ZQZliR85xXj+fdm7eH77M2+I9DlW8jM1WtkWCmr0J/3qXsax4+V9" +
+		"HCWtxQeeMtHy6WVijt3mELq32lOWlbWWeP6dVSDzL1soeR/ufQjdu3c7O4TuGv0KQndrX6rJSbQHeBLv24jw/C1c95sxaETw" +
+		"PqbwoSdvX49eQ+jeP+SvZcXX8ahlJWPRD5YRNbdD+OzLmozZisKt3THLps6mzs0N4EQvm1qGz6MvG0TrES/1UqJvznzJOmw1" +
+		"IXvvH5xgyT1y6VnuCudtqVo3S0vV+uN3VVYgWWmpRsvqtFSjfbQ39JF3VVB7ECn1es/LCOrcvYxgTyw7KXxSZ7O27BtL43dj" +
+		"rVoOdY740g47/BUM/S7xMNZ9iUc3KwbrpDwpC5aEOXOLPVdqEVFKuoUQXv+tFpkXZGsI4W2brC0vxHnlSx6lL3WR1My1W2wp" +
+		"GcOjTj3jhZgyhmguzj6E8L27m5bkvdNcIISvEAc7hPC6HFo/I/nqp3iY3KgQwttU9cwtVp2qJW//uEMhhJeOR0EIr//25eDy" +
+		"x7fXNxSpfCkajeYCIfzVZu0z9qnCorgkopv2vCRz5tSCW+zIGHrexjHaoFZlf3ZWXhZFCOH7mj1Ic4lUByG8HDlVUKvKlX6K" +
+		"ycoeFE2YeysI4fCR+5JbbPO7K29fhWKlT6/Zp2r5daxFD3Z4x8eXxHpndHy9fUqZnTtbSzpNZ0WAHV5bTjvLVQsniK+3pxaZ" +
+		"F986458wOjrNTcqBHV52mi3s8Hes5ojl6g7PyDsTLVTyGu1jkTKx1gY7/MgZFmf45IS53yoeDDt8l3fbl/h6s6/oNJFgYYfv" +
+		"W3ZSLFdXapFrsViTO3nhaR1iw5d63A728Tei2xhoLv1bJqKbRgA7vJYvJdZiDcWmcITKzk2dYklor7DD99rypVua9BDscH6i" +
+		"Djt8Wz+haC41lIj12dq6xdYRF9HXFZ8l2a9Dc9nlnr6Ul6JGlERfR1b6PtHx103McVGLO2bCzdYCKZ1svbpxJhL66saZSO9r" +
+		"bodD67kdyEM/B3S8UzXOG5Txt9fKkrdj5mGJl2MOpXV2cljidcclYIm/gnZqGVZevry+xgtxpkwO95zWec+PUCc8udQJG+0K" +
+		"Jerx+2UkBqPla4ZO22GJN2+Wdljie3drwhKvM34CS7xUOxmWeJm7OcPlvVPd16CbZAZ5ISBudFjiBZ52D5b4TuP5Yokf6RnE" +
+		"LtoJLPFN3nuHJV6mb1leeDN2cTuRsmCJ15r9Im5HjEYPlvixpoyuSVuAJb6t7N2wxLeVXT5Y4usRqspaNQb2qQMHC5b4faYs" +
+		"uEBpj31qTRmPXf31q049KOPe3e5QxrfmroUyvi1kfijjdVtC0bJZZIMyLsu+r9+MHnXi8lN2NS3mQ8uGMt68C9ehjJeL3QzK" +
+		"+LbikFDGW8vpS/a6mx7K+HbcqSW4IHYELt+yB/L2G+noizI+6zXLVWcJhDK+ddaHNW+/ZbckooeFe01Ez5Iy8ay8HFDGm5fL" +
+		"O5TxAtWsQxnft5zFvBBBB1iDVEs/XqOrZK5xea+FdSjjZfm2FyyvlInXZUtaEw9Jj4cy3u4plGj5jEHezrKGimXVOnyxrswu" +
+		"L/RGC13zoigb7Joc9TVfyv/MitFVPuE1yVG/zec3PzJ1xvKYWmRz2AXblrcrxti3guta942daXL6tkJ+HpElHR75PtuRG6vT" +
+		"bE9sNAIvYPSt5u3YD0r8v/t8G2/97LFobFWd7Ckb+xQk/w7VfIeJ1yGX716Z7dvu5jdnW9MeS9ZGI4jPONjl84gV67DLS6y1" +
+		"sMuriJu+JcfTfQ67vMGv77DL632mzM2Ysbsrri0Ui6IbZ/vGJa0o+Sk1FF56GIO7AjZ0h13+fpk6vakz4qD6F7scF4RdXjdS" +
+		"wb9hl6vTXTHd+d0q2ihfJocuX0Ia+n5ZZXyaMxrBTaaEXV5OFlrY5bvs6w67/OXPofjFaurMXZjf8ciXtC5HMHUGx5aEArt8" +
+		"u6dfx1r0LZlHtEmo5nVl+4ZqXrzM1qGal4VuElTzeU1r3tHkJ92CkeVugGpe5PD0LUgnH6vA4vVs+R0E2CuUV7GufAlD6kl7" +
+		"XhQhdWzxcsyhWLxojFssXtlleSeUbQCqedm6k0kjCH+Gar5/3A5QzUt8ckE1X/eUiZ13fwfV/JP9TyPwNnrf8vr0lfas7B1K" +
+		"xFJNLWwKPEpQzbfq3oJqvu+P0fJyXJmJeDmyP3g5oi1ANV+fnG++7J4Rif7ceL2hmld5eT2o5jsNbgtGFl4K1bx+vj3jy6aT" +
+		"QjXfnvAFeCZwqjpU87JnfDSC3S0N1bye9LQtr0+nPXeFGMEO1bzBVu1QzctS8rviFtNrHmuvCXeo5nUPz0iEvLgdqOZlrqFE" +
+		"nB1pD+fZ0xe++sXJeYIwPVovbFczO+tPOs3Ipxh7sLBIiW59KTcAW0Th2ZhJ9oVnw2vevWyx7qcMlyf1l+2RLz9GVHLH0AtL" +
+		"4Rt1jkrRF/u6sFbN7rvCWhV/RcmNI76o5FZhS4WVvs+4YNkT1+/L3buFtKiys/RMKesorbdkpxlfU2aflQbHyQ1XBoLjSxlt" +
+		"Y+lhDy7urYVtB/76yyFXlN2D98Bfr1tmKZasI2UiPeyCEgTHK2VV9lba28WFW9vgr7Pewl+vYhJ7yVt39mfJ+9brn4m1gMze" +
+		"vI/UIbM32bQdMnt52Lggs9c163XmxRJfutFWngXI7LWKNwgyeyKPILM3b2t3yOz7RU6EzL6tPWUiGbe0wLu7pXUvpuI8kNn3" +
+		"GV+AzL5Hr4bM/nIcp8GN5kWwHmT2lZcWMvsu87VDZt96ZpONq9LZyvd9ayOC0LVn7LSf6EmQ2V9uljppr2somTMkvBLUApaX" +
+		"ILPPW2rBF7a07i3cnAavcdw9X9q5eyg5e+m1l5TEW3fI7Pua3UnHqZee0XFqei3StpIvIbOXQiqGzP7+qT2oBTIoepDZS047" +
+		"bMf4TSGzvxzA7Iq0lcHaIbOXPafWvQVLskNm3xLNBJl9vdMz99aU9RODlag5yOylsiWVoBbwUZfvaxz5UowSGwZk9s17Ph0y" +
+		"+7tnQ8HzzdhF2l6sQEFmn8KH4HDBFeuQ2csW3i0GK1opZPaXMktisI6clSfYqn7HH9N5bkr8MTyXJXmxn/wukdJGS8dp2Uu5" +
+		"t9hZIbNvJWeaDx7eeofMvj+Z3ScSs5l3b3V6E2T20u+UwSt2p8Fff6W6MROVJWsx9rriyGSn6qZaRvbPS/EK0JcrzWURFVj5" +
+		"MhaydXVXQD7pdQ+Cw4ZSp91Tc3M4OXV3O1j3H5fUeKPY72guixWrjacBt655dYknrAbD1+0Q3PaZN7R+NZc/Y7Wo0Wn21M9+" +
+		"0lOHWDiybg26L34ZRPez5ndyEWj1EN13CGQdovursefLizVsRXkH9UydD0p7vBxeg+4176CO/LMO0b19Sr4MpoBee4+p4ycQ" +
+		"3bcdJ4DovnnXotevTmM2Y/9i26zBaKSJQXTfIJ12iO77tKUFsdF76rzYzVLL4JCx9EB0f+U8dYrPmg+7jJfjcPYhujcIvr3G" +
+		"V1/z5QrVTOs9700ZuxugHqmFlyP97N7zIW1CdN+uO18OW3FtKfPmMp8HRPcKwafXr6/eqgQfjH0dovu7T5RFc/lowQ2w0Hpr" +
+		"NJcpFHQTPAOi+1uLtb2ic9tLonCfnDC+egi+vcaXcedLbwVmF7ByzXcoNzhLLkT3sl6pBYc8M4bg14WCIcpmAtG9VTICRPfd" +
+		"u7kdovt7j6dMXhdJrcbKxXoE0f29TYyI5rL2fJm8Ub3my0hEN0T3Eq9tEN1hGvSarN8nlDfd3dI1UbiscRDd3+2SL70nF66U" +
+		"d1DZvCC6t09Oqigsb9X2ILpDKuwQ3bdC/6l5m2NNnTSz9Fp22rWnTC5XTkc88uGXye190sIJEdYMPslqTj+DeZF+QgFzwvc1" +
+		"sloorwSRN3de90Sz724A7412SO37SjaEzb7HmgqbfV/JhntLFkjK/M767aT+tf4ZGRmi+x5PBtT2baHTQG3fjiXUItLvRHmz" +
+		"h7U7qO25faC2v1KVEfBr7LgE1PZ3k+V3jTcyv4sPMH0J9m5q8VIHS/We7IontYjOx0H25NDxQu/h6+7TPbiPVh1qe4Uv1aG2" +
+		"1/L9Xc5Nyjb6cX4n+4COD7W97qTGPW8uiXEOavvOj78Hj+xInUPjnzK7+PokcnL/YuHkd/waV8pI9rhLUNv3LzX4Otz0/kVt" +
+		"Tz+D2s4bA7W9NZJhUNshpHSo7fUmIUBtL7FMQG2vMEt7UNt3tgio7as3gvsevwarOdT2LfHdUNtbvdOzIIrYBXnfOrP05evG" +
+		"nuwKsStQ2+tN/oLa/moS+Z3og7RHst/4CPa8s0eehdr+ar3mDO7jmj3IR+0NxQ61fa976rR+2T34euKzoLZv3h3qQW2/8fw9" +
+		"+ci00j3v7KW9Owi06mSRSjQh1PY9ejXU9t1rSX3P+9bsMEFth7Xdg9oOkb9Dbd8Tn78HzYEGGdT2Ti7d81JHSQuDu7Q5FCwq" +
+		"ViCo7a2wPuzxUeesJLqW9A61/RXQ7bOH3PGEyjuJZpD3Ys6Kkewf2hds9uL1nb4nujZj56P2nlaHzf6qW2l93Fs9Z4yPev/2" +
+		"jEWRzAWb/dW+zHXe2bOvIa7vqz34ygqoMWctnJxk04ovSZiNr3mgLP2KIzeWpBUHaTWIWTMKtyatN9ailbTeyPzhCy3Z0PLr" +
+		"oKiXxLl/UdTpSUFR366UQSZgeW/xUE+pE4Kv2JEWD7XYaCjq2yEiHor6u+oPigRUUyZCHR+Col72O/2Uk3inddYp2gIU9VcH" +
+		"UgsJva5mSZ6c9xR7C6pZC5U3iYw272+4qaCob4nUDIr6tKW94N7nyyEBlS2ti0l0G7XYaO58CddlTesk2JrWgzKeXg9OPtEt" +
+		"W167znyKpoVB2aGot8Kv0ZJB0UO5iVlCWnB5sud6stmtH07ev7VYPzpACy6PMwxFffNqe4eivj5zaumymgvKa9dsSe2LLNlR" +
+		"sWEYA07ep1CxveunbGhvv/cWP7RsrRYJnc0Linr97hC+hTmnTzZ0bKktGRQsYEFRT3RMUNRXcRhQ1NuHnB8U9dwcLX7okp4l" +
+		"gyItPKRGfYn/gM2k5Y0N9jBI6Vv/hIL+mfNHCr9weXjor1Zu7Lj1eYaK/yDtwd6RSwIPfVtpBPDQVy+RdHjoe+7JFozIJ63z" +
+		"NWdH4tZ3dlZy4XIeRBRBte3w0Dd4eT146DBSOjz0mihEeOj7J2PAraesLTvMLeYSHvq2P+lL3oCxs4IROadnLMDhKHzNS3ay" +
+		"XIjbzjrI3Zvb4RCZuhntQZr+cRb/kiNDQ6+VzenIS6S8YkcwzviCoKHvH3Fy0NBfRVpPSMU3qfgIQuSSOr31gwsGDR3OSoeG" +
+		"XhLHDw19j3ckaOh9SZ0XHOfU6dZaQ+X9On35vkihPbx0c1Kgoa+3+wcaetvZWqCh72VPmZiCllogKPbUwppDhwoaer1DybbL" +
+		"iPL69JpaThpVahENQ0eEhr4lYwMaevU+ZQ8a+o4HQ0N/tUm1kIpl5/cj79ft+TKZJeYaLz3EIUFDb58pZbIJz9RCW55DQUPP" +
+		"upOKSwklW8u9dUQqJltDQ1+TSXkE48ytAg29NTI5NPRXrdAeXnqnzoudjk51BCGS/HzkRYqsipieM61HKl5SRiqmOwcNvbWU" +
+		"ybZz9oOGfmY+2buXb9kJSdlqBqU3u5W1o8ypk7/C7QcNfYd23aGhFy+Y9KCh7+40aOjlJH8FDT0R1l809Kxfco5J00cwzsIJ" +
+		"7sQsOR2xd7OaH5GKcXKY5/uV/YnPnlkVKD1Ty+/wmiOUu7ClBREUNEGY5/XBv2Ce75WlAOZ5y/0D87wlZi+Y596V6TDPSyJ7" +
+		"YZ7vXjHoR96dcN8dyTnb8qWYpU9aCEqW0UYqFu8D83yLVgPzvCTbNZjnkcnP8WLRDlGxn7yoGxnvJAdvA4OsQ0ffN6tyxsZM" +
+		"JjmDgLb8GasFbPT9YwTBRl+31DgkoF0vz+8LcnqC68b+BRv9naMFZVae1MJGKX4PNvoGr6if8T8uaT1vcpsHMZaxB5/humQz" +
+		"2OivJHOj8mphqJktVQvhujQ42Ojvzk1Z4ma0IAd4z/yxMdcpZXT1ntbdKnYnbPTiPZ8OG32DaNi/2Oj4Hmz02r61uFV4r2Gj" +
+		"r3e+ZGM+ySCw0fdPdkQi6clmwUbf+FFgo2+5UWGjr7HRwEZ/KWPnZWzODWz07XpSCxs6y/+ZV0PdjLDRS3gwbPRXXwrlfeSs" +
+		"X0+kWvqS94O1zst4itKDjf7qRikTfcBqF2z0aGaw0bfEVMNG32dWbNjoLVr2mXww/BI2er2cfdjoDQ5jh41evQbVYaNvn8wE" +
+		"rhv9LtjosV+esTGfoYyP9QE2+rtiavni+RgR6XYVVXYGl5cNI9joBSeHjd5KZlAkZcFZz+SD0epho7fjSnukW9oeBPQt1m8I" +
+		"6PXY0h7kUxZgCOitHCkjFWxpL5kQxgcbbUlZomNEwEBA31f3QRDQdznHENC3jb8VAvqrKaXOcf5iu4WA/q6Ynn1f+0md+Mua" +
+		"L2lYNCUI6GsyzyGgv3vQvJBuW0173oDB6zrOWlg7IJnXRZwQJPOysAAEyfx2u0My32/SRJDMg5ECyXyf8eee1ySOP5NF3YON" +
+		"5vaBcV76l/Ia55b6897NgcJrzFFPvHrN724YZxkPnDu6V89rnKmFXbeItYBxXuCtdxjnG3TMDuO8HCRYGOc1FigY5yVxVjDO" +
+		"9z6H8uYY6R3G+ZZochjn5RTXGIzz6M4wzmtkFxjnZTlSFl6afsodtudgnJcu+iYY5zvuEozzNWPHS2MXgXFedjG5PdYA9vUe" +
+		"a8CUMrk59hyM83erpoXmffe0cMAU6KgxviBZBOP8c6cF49tTRtP8ti4fP/tKVHpya3siNj4pC7aWOfMCM3S53oOawz8YjHNY" +
+		"pz0Y5+eRMhoWngHjvCYarQc15057IhrcHD3v+eD5MM73g7QJ47zseGkwzmNxhnG+385+T24t+QvGefEieYdx3nZWQhjn78lU" +
+		"C0vBJoKvJ9JQ3ACM81LI3TDOt3BWGOflQ7LvebWnheKPzHwGT4F9CpL5expDQZFmiYdkvsUiFSTz5J9BMn+1c/2Ue/QpoRKL" +
+		"bURQcyb2IkjmL1cyL5FgSygSLC2xJ4OWHADJfM9d/0UyX9KC3KOeWuSLTGmPBkKe7UGTnNNP2ITswZcM2uL0XzJavQ36biw3" +
+		"HAvKJSqjsJJcdUPtKDo4f+TFXlvohRfJN1FDFz9fwTWups4n1EYjmFDwwsznJUpvIIP8iiNDZi9QVztk9g1yfw8y+0d8A2T2" +
+		"9cZ5ILNv/0ZBSrMKkNn32Awhs78SScq8g7qlPTyf5R0ye6244JU33D5pXSZq5gjPf0rGGuRrMx1kdqcdMntZ1pRBz2efhcxe" +
+		"EyECmb1u5MsrFmBeaMjsr/6YWmKV0Tpf3ueTWsTVk3whs9eWvcOXd5BWILNvhXXlitWipWe3OUsLD9ufMr68Lj8FMvuWODLI" +
+		"7FvkDMjsW2JCriCluSchs2/lTp3WD0+EzN6SZwWZfU9mCWT2d1+ZCfJzbmnI7M1bTf0KtjDJ4kqMRkkt8q17vnzk31ixYAu7" +
+		"xSCzv8qXOZOJeosBhszeSvZusIWzl/D8A4eEzF6aW/oKzz9SCws3+TLI7NuSWlgtpvSM9ZQlFzL7Hl09yOzXkva8a/FJnfHF" +
+		"qoX8vJORIbOXYElAZt9a2sPz+5ovVzmzZjAxGm4jyOwVGnSHzP7qhUZ7w4ug41zB0MkJ/77UlhZkIrWUQbpbUsvFs5F+yg5o" +
+		"6ScMK/Y3yOzb2UJNokH1LFF64m+Cv568PPjrpWcmyM/LnlqSI2gXsFrUPXXCqtlSFrwPe0KMhvc/+5VMpCl18jWTEa7Iz3wg" +
+		"8Nff9Rt13mt4aUOxw6jlZtGodNsbakLlA7llKUUyvUnhdfszuBa3XKPEzt/uhiqC7pZPlGzh291QrV5Q4i8WoaDEP6KtocRv" +
+		"C5svlPhW7cegxD9XfgdzD7+8v5iX2iO9Q77uUOLL5wrFq+8GgBL//ix1BlkltYiId4qgxO9e6+tQ4rdG+oMSvz54G5T49cFr" +
+		"oMRv3Tm9w8nZRYISX79fesNgCiWDiC/7ixK/pgXx1j1lOAGuCyW+nd8v+boyPpaQew7F0jOlFljUfMRQ4kvQMaHEbwvJKSjx" +
+		"JaMlvd8kLijxeyFfQokvwQm9gynwpJZkgOV34smXlO1e6dEzvrzrkzphQvAtQInfNrkyd6LteijRWt8vg4xj7D0yiJ7h5JdT" +
+		"e3/f2PDllbfEUiaa8PulqJqSWuSZpC84eaf1BiX+olfcyRMir0CJb6s7DUr86v26DiW+BE0ISvx+3ilzU62p8xYTYsVI7zPe" +
+		"DSW+JGYJSvw71+YFJ0+sH5T4cuKQUOKLtxA6lPj1bvkymLN23Z1oV2PIC0VTWuAfYd2EEr9+zzvpvW6hoOBnpWMJeVKWV/DS" +
+		"+iPj2e7ByZPjDCV+C0JRUOI/LWWihu5QY3xBvIUS/zLP1BlMD/Mp2q7V/E6eEOkMFnyt7GF3ou1ycp5YIs1nkAPccLDg2zan" +
+		"n6K+2Eif8Gez9IjR+AnO/SVHfgay8R7P0APlZTe6h5y9895Bl68HCQi6/Kvv+B0efJNnocuXRO1Al3818Asl5p6M9eRV+iu/" +
+		"82Lwntbd5nda4AMUHxx0+b6lL6zRe6iLfSO1DB4cO1bQ5cPNoMu/oovfsUYfJPSgy29Ow5NX6e+U0cBxAujyFSJ/hy7fih0B" +
+		"Xf7dncbAGp1sDujytbL2QZffgx4JXX5bMvPeOapTyvIKXmqxx/f05REtPPbHk1fpZXk9yfjn53viA2T7gy6/LSKPoMtvLb1O" +
+		"xj+e/0SazlyTpjfWt6DLT2soPiQyJHT51jOfcF2849SDLr+e+Z14ijll1m8LhUfx/0OXbxsPK3T5V5MwS3yAwRULuvzBfgNd" +
+		"vpQlX7Kg4AvQ5esi1w+6fE1WGXT5bWE5hi7/6g52JETKRsuGLl8f/OSLLp+965W4UjMGkWPZyaTpOy3klTj6FnT5LZazJy91" +
+		"0AShy7dgpECXr6d4H+jye2NdCbr8vaVOEd0lX5I2r3zZ5IAZER/geac93gS2iKDLr6Qz6PJrYi2gy7/yenr28FcYO2v0kdbx" +
+		"4A+vAHT5d9eljLbw/RL62pxaIIqI2YMu3yo7GnT5LTnq0OW33O5Bly/id6HLt+QSQ5d/95JZijVadAwM+XLlFPMBlmGfuj7y" +
+		"81kprw8ryT6k/uvDSrL/WFd+wZGvn2Tt8d3jV5UvbxqUHJd9nL7rA6HL+0EXrPkdSuL1gdC1H8ryytJHnXnNfszYFcz4fdZC" +
+		"4jcGd7lgxm/eJb1gxtdzypcVhk7KdsjXK8rZH3rSBTO+nMNmcsGM3yFRXzDjW93zZeJY0s/IX6FYvxezCa3lc4QSv9GMHSYX" +
+		"r98VzPg+mTNRzW7sC2Z8kdl7wYx/NQkjYv2WKX0FM37qKWPd70ab1+wfYziTjTOjxKds6Uv84+l18m6ND7Lk9jGGeBJn68eT" +
+		"+GQ+yc9beo13P2kd7356fsd6emvdC5/0n+uT+I3MbiwhJWUN3zN21u/Po9d5GaQae14GyZ5gCfmuZo8ly0x4ZelzoHgSeX+u" +
+		"zxeTK2UsdVmH5CtmT+Ddn9leghnfLqOFvDWtenblLV47WVTz50mdXj75hLJ+1WjFwpWsrVi4aTf2C7J4RnvJxrntZLz7aajE" +
+		"byzOGN4N/fP6hHendZ7E1q1moppz/u68NGF8opr3ZibuyBZmkCdRBMUFM35n0bhgxm9lSnsnjmyN8jJIy+/k8535kv6amQhm" +
+		"/Gbmyc/LZc5Yv7fbaPFub3pcMOOrl8wumPGv3KhOvPsq5gwmy1Hzu3hKjSFvKX1bkK9Y0voY33akZ+JTwvmevHKe1sVvdGMn" +
+		"Py/7r2XkC2b8vqtjgua423OTmOZ9yCDXFB4/5PprCicfsvU1sX23oS2/1ChrdtLEvt2G7e+ayN1tSDkXJPj2ye8Se1e0Lgex" +
+		"zinLKyUHavNa34ry/iYOAgl+o6VdkODr8f1y6JblTi3ukWGHvCDBb3NPLTkbxod3P0d+F93SnOWFvNssyUg5cSxI8O1w30GC" +
+		"3y4cCxJ8OXfjI3f3Lb/L69PmOrF3R2qBRPXRlzOZlGaX7YP17QoS/Hzqp9i7ezYv5G7awgUJflszE6eMjTm1BDPBl0FP2fQa" +
+		"7y7uJkjw2zVpge1j/35Jr8gY2D5Kes1zyTt/Tck0bMbXEyObOr2fmv0ijnn99gxv+5bxXF5pL56vlLGCXkabOOZZX3guaYlX" +
+		"kODrZG3x7n6oJRgpeDAk+J0GeU15nTnzIgoEzt4VJPjPHkom3qmfeDd59ppixf62x8uypE4ndTV2Vuzn22t3b3WOZKTAJbkg" +
+		"wdfpMtrI3eQqSPDv2M18MFJIGlM8l59QMjZyxlixp28Lzt+pL2wf/Vtng9qb9shOZLwgwbfZvNzZn+YlueY3rpH3Pkbu2BUk" +
+		"+Lmo88l7LX4nCoR96gre++O+m5KR8rHLYaSURV94LrubeIoV+zE+vHu77AlyN2SxC9572cPd2D6exYi8gzdeZPolR5arwtZ4" +
+		"TbFvuzODBL8Ozeia2Zhho18z+bmRn2fys7ygaw6iISk16OsZHfT15o3nC/p6lbl/QV9/5b0Fxb6BB895Pc/NAX19n+3/Obi1" +
+		"VgH6+g4l7gr6+uf7pdfzllBjtIX8DH19/yxGy1tIP76grzfvRVxBX59wiTkxzpvWv9F2xofPetPzgr6+3nbnHBn5SRkZ8jF2" +
+		"fFbu6QV9fWuTseOz12mu845zxkdG3nEe6OsbRJYr6OvyMa+gr6+PWYp9Y1iWLujrq7e2LujrrxalZ934SDlBX8/9OifGeQvl" +
+		"9Tx30/zFrU2dkSFTS4P6lb6QIbOXyMgn3jYH0XDkWlxBX3+29HqMr33MPPtGyS4Q4/zJzMv8u0gaX/R1Nwf09VrJiXMy/251" +
+		"JtquppZ4e9NCdID8jrf3WyZfpKYFNmY8GPr69nzLnMZujdg3xB1e0Nd32fMX9PW6HWb3Zn87/C5YVJcvxThPNNb5a2M2ongL" +
+		"015szKu+4LPwHK85uIV0jjm5JJklMvJ5OB3sGz27Jxndbts5MvIntVzej7C2vIVPD8V+kxP3BE1Ir8U484hcMNa3cttL+Ox5" +
+		"2oP4bM2pktFdFvNCRv7uT/aNlQ4XjPU1ZzO4hXe+5A3NDOKzraSfyaEwg7ip9wcuGOuttD/DkRfxzqIBrqXkxbMLldfQT9SO" +
+		"Stnlve4xuiXZ1/j6FzvcaV+CKruESr5Nfic7+fs7eSYtZV7/GDbKC3b4vgwf0rXkZaPhjbngg+9eaL3gg++PtYQPvl90Wfjg" +
+		"VcTLFXzwh41myYvPuCd88Lq7eeGDlzLrJ1vESv6CD17ke17wwd+lNJ9sEVNmlx15+7beRKiH8opHZp4tQv7ZBR98L/hs8MHX" +
+		"Pa2L2n7SQqIy9DqReE508ME/tLTgg/N4XvDB65a5xmfvqi+xRUwp84rnYSaC+XenFqhYs/ULn826J5fEqYUP3mSKXvDB65y1" +
+		"Jc/2LwWZ4DsGuiyeAR+8wE2/lmD+0XHgg29L5uWCvcOaAwW8eVPqCgr4md3KFrFkjdgiblIIFPD96fbSlXNqtOGzJF8o4LV8" +
+		"vyQH7HaWVy7Kli+9ouuuWOLL+/gSn/XK2QUFfPV+1wUFvMHKuKCAt55+8uVFk1gSlTFZzdiRSdNQwFvZ7d1EZezGDjvpe27z" +
+		"stGdssgBaR0fys6KPJv1uxOVbofc0Sf1JbkkZygxwHRGKOBbzRljR57pflDAW+yEUMC39RMK/m14wRNMw/zO/iQVLInKOPK7" +
+		"w5wZ3xPsK+uXnL3sENhJnzNltyjL0euga1+42xp7LYsDdO19vP37K44Md7vte+ogpbp94G6/OveFqiKPUr+MRDxjDZbqkVqg" +
+		"F6/5kif2CEXbIrusseSyVQV3e3lSy8OaOXYL3O2tWMs1OSEs6Ov/Tdi960oPK2F6zn01fRBPgBMdqPtwYEw2ge8/MJeerzcw" +
+		"NubfyVqgWqJIiiySxaq3EuNZP8bdbnjFV7jb4g5d4W5HuuBu9/s5V7xwt/vHbB7u9vuXC0uPT57TO0l53O0qKuqFu92yWsHd" +
+		"7pd9Eu52v8jnLavUXf1i03b4zSncmfZMjGe9JdztV96AT1FfyYWFeurHJ2Raqf2426RnuNsIihfu9kD9unC3y3vLc4dWyp0n" +
+		"X9CUjCeeVeoWr+aSsohhfUtZpR75LdKTlEDXbp3U3X6aXK1Lk9u/udPoI0vRtZtYLteWGM/03VukZ8tz+mdPCjkwXzM2bYfa" +
+		"kp78YC907SpuzrXFq7nkfVi46eUzEVP0lxkajZ4V6ZlxNBMjInc6abiTYqlj3YauXX8jxyq1THVnxxx5skWTm/5ilTozxmgD" +
+		"EFqvLT4h1hbo2u1XP6tUHOALXbu9rTS2kOfsX9G16ydlIT0/76RCRlRbp3CsAq8tMRRI8tC1+0iKx3NPCjnwToodc1XqEFO3" +
+		"/MZSJyPnjiVSUmJ0f1IWcRmt4/Cmx0aHiDc9Cs1/+WlMk9IHjbGSOGp7fqNxM1fgTW8sTC+86fY6cqedxPlv67cLibpGtiFR" +
+		"1/LLPx4vKcnFmnaTwoRw8lUStcAMikTdmpkJiboeqblzsN0uDYm6nSPP0aU+PukXEnWrz9nrhURd+S5eSNRLWmvbg8eZdkei" +
+		"LngKV0jU+EEXEvVq26QSQe4lFe5jfkPFuvI+XhK0vCFRT32uhNpmv1MStcAequQcrCW10St9pejiPvktNgynFD/YfPUzfqJV" +
+		"yjlm6kB6zndy4QXyyZ2oe5dWSmyCM3fSpdrB4U2P6INLLILtjfCm1/SjtrEjIwXxput55Dmjj8YGb7q90/+vxLHVnjkH25Qz" +
+		"52A9d9KFz+RpbV3zPlzS9Bc2DBvtQ8na08yIN93FbLx+vOmMqZ8XiPeRnv9Jfcw/nrPHv0pSoobax+BNV4T1C2+6tfQCHnXl" +
+		"pXXZMPRP3sBGg9Qt8WN+q59zsM0qtYQXYe7Fm65tpA6TP0xKFo9Bo1FsgvbyPtLzSyNbQvfZ8htdMW1AiQ1DJEh402RbeNPN" +
+		"LB3e9N2T4sWTlr/1T6vG8KZZ1l/hTWc+x5uun5mSXaz08j5nNVZEoR7j31yox62cSdGDWkmhHvfjTIqeiTYO9Xi1YFJmuE/e" +
+		"wA7jm+didd+ldpqsIkUPSn+Derxm4qTs77bk4jyG7hHbuP2ZiP5TIqMe95dT6FCPWQRfoR6PlxrkJMqu/kc9Nt5q/Cm+ycXO" +
+		"vScXtHCnRqjH9fvKczj+Vpuox/0ikWsswOwxUI+r2FoX6nHLjj/UYx6zV03keftA1OP6pVdCNm4iL141u3P62ZCNT2OxhvRA" +
+		"q1xjKdCSZ7iPufPZne+/96mD2RXZuImldyEbN17vF7JxnVfKQvtgFqvhWrIGQDbecgqHbNxyKhay8W0GQDYeGymBbNwxGi9k" +
+		"4zbtx5GNW/RTIRu/jjwn+trvNyPsTllYor6TZ+Jv5DkSsuYNz/7nSv3szq9vcokE0fKJLm+3UH9cS60U/hkZjGxcookP2XhL" +
+		"K7EUqNa6IRsfaflwLdM/43O85w0sPdKCTpv6J284eIH4KixtW0at9eX3d+ek+0g51S/PkZDi9FzIxm2ng0I2bq/fnV+zmDa7" +
+		"EzFCOVkKtD3PVTayynJbm32TCwoYfT6ycW137uQH+MtF/c6UDP/sk7fzyUqNnCkd9D5tRHN8SrGMO/Jb4pIeUqyvSc+GSCmW" +
+		"3kppMyO8sQBgS381FgCnmaqFZMYCJhTikywIhXjYp6EQj91JG9bwmsG/UqJs+GItXs13nqOxeedOfujOqLGG19paba1aN3sx" +
+		"rOG1b0qeIjKfeU5cupoasbz9b7YWKMS9G98oxCUarxYOvV0FCnHBTbtQiNvnyJ1W8q/8JlbTJ3laz6bmiRhsTd5+Xs1qF1rl" +
+		"Kyl2Xa+84cQjT4rXL31iS8TgK6nEotK2JHLsnlCI14b4I4VLTQOFQjzevzudLTv1RiFumVVCIRaZ6mpZz26+SWxy7QFCIeY3" +
+		"cLVI61+ezpbtV1GI66QFbbENOHMnXVzJ+24e/+6MtCblUYgbdviFQlwnPRYK8cpFHRJrK6PhCqU3z5FmRjQK8TheyQXBxFk9" +
+		"CnGf6eO0ARgNFwpxm85tQiEWB/gKhXgeyYWHovVeizbAvgKFuIyMxXg1mytQiNu48ptYoHavPwpx8aWznj1zp9nIngOFuOek" +
+		"BoV41JSTtJ75DnSpW2QND7fZ8wYemN/cSZtjndhiG5B+TRtw0yKgEJecg/0oxHYZKMQVa+FCIS7XVOpHWm93vma8ms1+KMTl" +
+		"FSlBWt/pPXSp7fecswyWXCEGIw1fnf0U+trVsd9Pu2fU3rUOvqRi235K8RQ1Vno8fd9J8fTV50Pt5U979ez430mRkGbiHvtW" +
+		"8hm1d0TLG2qvKB4Xam9hXX6h9q61THJB6ngYAxdq75rFlIVW9Ju38w+bdhKovYXH5xVq70cP+VF7zaGovTW2r6i9RTTVC7W3" +
+		"/xkc/lMih+c7Rt5tzWoMh+eLOHPh+Y6sjvB8V32qlJqPvHu6M+VScz2ih1yWFnOqLxL8hefbP+kDZySImkdCpt3jA0xr16Mv" +
+		"NW7wfMs3vSX60pGUHfGe9+1SvgIJ+XX2hOc7Ws1v9MElueBaWKn1RCOkLw3P90tvEJ4vD4Or57TpmxQJknb5eY7lOWQvOzg8" +
+		"3/KxM+qhQNJa4PkWrN+r/yJMKWc47d/kcrGWz/vCdshzmNKPBf6F2bt+c2d0ouzWMHvLh8zviStLKmH2rlRyKcjUeoE1a1Yr" +
+		"Yfa+f8/FD9b3C52M3MPs3eaZ55wo0SNj9m7zTC76oJUTZu8m6viF2btNp+U9LHbyC7N3rfrzG+niRLAnGocZvEcKsm7F7N1m" +
+		"vjsLKXGBrx4paJeN2bvdVn+YvZu45hdm75KXKQvvzJrn7Atp3MLsvclZzN4tdiY/Gi57HzTclWpS6kB+oeGuVJ5zaktzjIa7" +
+		"ZXeChrvdTiHQcNeCeUipA7s1NNyVytvVgdRAw92Q/C803C1WpGi467dT6vabVDxonfKPeNCajdBwt9uXRsPdbmsLNNwt1qBo" +
+		"uNt95Tf1YyGChrvlFAkNd7vpPkYIjmQpGu5233kfFhWr3JEYfFtyUb87b6DZ/eQ3UT3/ZNa/JDJO7npK+6EkvIxonNztvvMb" +
+		"yoU9Pk7uyj8pWgv9MZzcnIPh5Jacv4yQcZyH4OQWvLornNzXlucSpSrPqXnN+y55+s4k5GtPLmpu3g8n92WXhpNbXmZJnNwi" +
+		"lt6Fk1ver6TUz0kiTm550W3i5JZX+gcJ+bJ3wMldueTtTpTYPI6cx1/Jk99hxsal59o7jPgGmA9GfAPOvOHR+fLEu0bitdLI" +
+		"jpk1sn6cqNzmbwzdctmrYOiOyDYM3TUvezvpKcbzNUI8fydPM8Cd5+L7kDx3Nrnq7kRJhLULQ7eIUnph6K48kwt2ZXpBpGfq" +
+		"Zw35tp7F0N1E/r0wdHtP/ZzHd7oIDN0tq3AM3f5OHZBxOotSDN0WO04M3XJ8c6eIA6+8zx6OrgxDt7zZn2Holredexi6b1YE" +
+		"GLrlTdu+b/YAzg/2kjiJTSqz9C0VFnWRsrs0AnYr2Is2c+/OHJ3/76JcXPYcuwhFlxluH9lJXFKieJBfOw0DMsK10zBcNAyo" +
+		"veVN+4DaW940mKH2vlMW1lRvoxG1d63tkgurORL5R989kicdhl3iHit/uxqE3X4YAeHmHsYtNm4/SFb8236wgMG47cfMc9Es" +
+		"KQs7+4P03BPTjb0bxm0/9HKM237Y7e2J/GCdH8btaf+DcbtSWoKPFE71hXG7Uv9Fj4x+u+7L24ZU3kazY9zg1q6UFmM7f9J9" +
+		"49aulG+J5XWSz3uir1kvoMOu1Z88Y6FOP4sOO2pyiYW69Qk67Eq9pWJZmBQL/E9yYdHzTS7ZhaofC/W6JU82E1vKwsPASg0d" +
+		"dtSMjXjn19SBZWHGBu+i2uRJZygSz4UOO6pVf+iw1eo9dNhqd7LHQp12Ch12vH6/sejhAYMOu1LJJZav6iCKZ3yG0GHHy3n8" +
+		"Hgv1IyVz5k62ocOOFx0UOuzIvHXwIbroKY7NiNb/DxEULhr1P4XIw/napMgFffyI5NGr/xw4H+u+p0aHuGnxSDn4nkZrfvBL" +
+		"mizIDzbx0y4jNNrsOw/7avF2lzRCdCOtD1rJyc7xiF8SyYpbO15a/sg5EUmATjvETrrQaQca+oVOO15XnvNV7NOO0LXIL3Ta" +
+		"8bLzDJ028zk67XjxL0KnHa+0LrrW60ouvgqNMzrtwO67jviUOiNDpx0vKyl02vEmF0KnfRtV6LTj/c6dIjA5y0OnHRhPFzrt" +
+		"+i1vcNLA7haddsS2Cp125NQ2dFq84usIE3zL2+3aSHIE2vJJ61obbuReCLSftLW1ITLVFQJtzhURaMv3ynN8V2byRKUz44RA" +
+		"O9NbzxDCfOlYG6W3stU8Undrw++Rt4drrpWclxf7GATacuWrWBv+hRP8p0TGpi05LzgSAdMYxqYt3eo2bNpupsemLdE/Y9OW" +
+		"fuU5Z3mf5Elz9clzNMek5xE7JPvqsGlbvrN9dSt57vabHpjozPmy5Hoj5fFnS2Mhgj+7fstzonjSk+PPlmbXhD+7Urkz0YuN" +
+		"WivDxn4Jf3bdmVzUgYfUEerKnjtPKV99xus371O/I3VQv0gCK0Px8i782dLI0iOWmvb/+LOlWYOEP9siPVlqtksdaBebHdyR" +
+		"6Mx070e0i1aN+LOlRWI5C2rpuVaGLb3MyrDduVP9Zu5UPzuCc4tM/EqRnvSlJwbLtEo9aTPFhVurN5LV/INbWzpJjlu7UvlN" +
+		"S9xJaYmZlN5qJYNbWzo5hFu7UkOqo+1uUuJMfPIcEq81a+i0sVhHpy2x6kSnXankiY7JCxGdtoRNgE47YkuFTrt2Ap5zjhPL" +
+		"OHTajkt6nSF90x6h065U7kysk6RYdbLADJ3283ufeNPO4NFpV0q7JFYNrQU67Uo1KfyukbLEP99X+fl4eoO1Z+QsOm3/mP3O" +
+		"aCnP3Kl+7EXQafuHhiF02tjYoNP2j7U1Om3/7HmDOrC1CIE255gItP1jHCHQdiyjC4G2f/a8PYwnLWgtmhPWEGg/1utnaN57" +
+		"flMHujIE2v6lrTrjX3T/lzVy2LRiQl5nYo69UpLE+VZzpzgf83fYtKj015kzdyM6bNoPSYBNu3LJc4ky7uvFIt7qAZu2Y7Be" +
+		"2LQ92lps2pWLccpu8+N8/EwEBXIIf3Y95328i74lefIsoYPFmO1fZ/UYs/37zXOJR65+M/HI5RIPImvWMx5ENIFhzMbWKIzZ" +
+		"kDMwZrt4MBfGbBdX9gpjFgP8wphdv6mfs/PvO8/FQzG/7d5nZDo7R0W9MGZXKm9QP5pqjNmVetoMk7V/zT+YrOt9h1TiAA8p" +
+		"dSCHMFlXapeKF89LSjlb8lSWPb8py57nlMUccyW6Fks8bNWOk3thq64881tiC1cp5TT6sFXXnZeUcpIS2KrrN/Ujsb4jv6mD" +
+		"02xs1Y5SemGr9q+9A7Zq/x4pi/qRBNiqPV4L2Kr9O1MH9TM6wlbtxhi2at/e+Q373fyDrdq/Zjhs1f69k1I/q0Zs1S4CwIWt" +
+		"2rHKrrBVEXyusFW3V55TP6fE2Ko9q01s1XVnUqmfL/aLcq5dEuPWWg0/tX9Zc+Cn9s0pyxWJdec3dSDN8FM7st6Fn7pS+S22" +
+		"JHmfOrBQxE/taIsXRupK5U7kjJ47xb1L/yR7RIS9rpydWOdfiTGQEeAEOZ7MGKkrzzwn8p05DSO1x6IOI3Xl8l9IQ+ipfUtP" +
+		"YiuJ4nShp/bNXgE9taO8XuipPUwB9NS+kazXjxmiHWYi/vmWpNmWXk2abSTrlegDZq3QUzfasOtnK6m3kGabGRs9tW/p8aQZ" +
+		"TtuFntpD1EFPbcWqCj215+QEPbVv6R+kmWjQF3pqF4XrQk9dvz11CAU1Z+ezsme1Apr1K3VKJS7PRwqX1Opv8oHMmcds8Tv8" +
+		"SrGtZWcyxTsU//maPV4u8uyYKNbB4afmJOPvYPxJNSl50oz/YVqelDyHvbM2myORQaZUyM3yHPbOTqjnHiuvLsXOlwYYr3W1" +
+		"2SHl21r94bX2zR4xvNZihM0wS7bkKVZZTcq3ddKG17qeU04WS8VYnCFv2x/jta481cHsINL3FV5raSlnIvh4g9mhkM94rR2l" +
+		"9MJr7WInXXitKxd1MDsUq7gfr9WMg9e6ntOCZodYGYfXWo68IfGD9Cyzg3iVF15rL0YxXmsXh+sKr7Wkrc0O9ZM8+Zqz08Zr" +
+		"Xb95e5glLMDwWnu0f3itZdKLzPCmrHPwWsv43clXmYUuXmt/8brHay2j5O1YAeQsXutKJU9nEuYDvNYSutYMefuTXPBoP3k7" +
+		"FqJ1VXitOdvGa62fjNvY2VsVh9f6oXvHa61ZvYfX+skYiy89zRJeaxXB7sJrrd+U80pkpX8T668ZmgnJE5Lrd0tJMKvTc6/E" +
+		"0SBBrvjX+V7ipiMiXyG5Rjs8Y7Fk3kByrV+SNSRX0VOukFy/tKBIruu55MKDwjyF5FpjF4HkWrNaQXKtouBdSK5V7KQLybXG" +
+		"9jQk12/6Kgv875mShQKRN6ifnQqSa91YVs1Y4Ede3qlf7kxESr2FfemW3sliKSugkFy3d1JNtBHl5L+0RUKyL806IyTX7ZM8" +
+		"2UpGKjmr3+xJkVzrlrZmgb85m7m3yNIhRQ9pjXUXvxlFd6FnpaW/S3xWv1KktbPYu8a343n73ch1GrC7udMe/y+8+aML/0iZ" +
+		"D5yy391zKWf3nPXs3c0HtAF/QLEnpdQjGvVDKhp1pR7RqHtf5h9y7w6TRd8NV3azO8eVrVlN3InTa/UXrmxWL7iyVczgC1e2" +
+		"ihJ8hSu70RyHK1teeQPrCtIlXFlx/a47URr0l3BlMxPjyraS78eGNcy4cGWz+8KVLd87v7HwsdLGlS3fmVzYprFouH+xJb2d" +
+		"T9b2ztvZeJLduLIl6+5wZbctz918bf1mf7Cl90RzrCffib3gxDO02KyDQ4vdPnmOHydbkjvUqp4UWkX6khlga8lTqVvep9Tp" +
+		"rWdIBUlNd6bU6mCviQi73qAF6YB/vYAOeEuvowP+c8D9p0TGii1Ze97xUs13ph3eaDOxYsu2pyRq0HKndmdVFh7sRouAB1ti" +
+		"xYkHW7JixoMtG0+BO9ztKyllsT7BdS2bPRuua9msE3Fdi1hsF65rKWQbrmvZrAxxXYvosNeP65r+SMtb3nmfcjqJDbu1mKPD" +
+		"bi3p8TS5xarjTiQxenLs1rVMzG+J1Zxc4nOvlWhyi1PGsFsL6XmH/7flfepQ8pxYzd/kqQ6sTbFb129/7TlfmxOsZ+aYfwYt" +
+		"zznR50mVeFi7s/jtmennqzrdeuatlXLC8+xX56sjMD1nsSuFOvGcws2XFTNa5QyhtTxyfYbQav08cVeLOMcTd7WUXy7apSjZ" +
+		"Hq7/IaVdUgd63dKTi3Z5bB9muKvWnhN3dd3ZpW4pd7JL5Yk3cVdLaVoizOxLqck2fKQZ7qq19cRdLeVKLuowtERiEwxlIb9E" +
+		"AZ/YqqXcWsk5WDlzJy7WO3mqw+XtpBnfo4mfWspUzkRivHyjWJGeuVM5pzwTPezZa87XL46AVkpM8Du/ac+ZXOIfmdStnNqM" +
+		"xKovuZBY9eVrklj1pQ4kVv3IhVyqH1+TXCp38mTn+9JDyCWr/omDWniYznBQa8nbcUK/2pPMqiXPsWP+pGRhkCWXycZZPyOz" +
+		"apGLE62a/ulES3T0iYNa2ApMHNS1vfv3GnkipBZU/4mQWkR8niGk1qqXkXSoJPNHQR1aLL7zuy8ba/n0D5KuXmpO0tX0D+dZ" +
+		"dXqf86yar04K1untITzd2oEUrJESOc96eV/Os176eM6zXnnOedZbi5GCohbOV6zl37kz3snJMzHAUk5RbB/90MQzXZKnSDnL" +
+		"Ln4rSLxa8F2cej9ydqXc+Wi8Jg7qeD/nWfNd4mHQpabUU6O39eX7kKf1JZLySsnlzG9yeWbs+Y4nKpn4Tvwu8vKdiAMlKb6M" +
+		"RS6x6n/W3fMdGlRVP9LzfLQyE/m0nE05Sc+zqy3peY6URcy4obaJOp7akpAnSRe66TnkSULi3s/QTc9Dy5OQ56OZmOim5Ty8" +
+		"nYQ89zxXpdTP6u888j512H2VeOSf+U0dDi1IeqJWz3fI1Ffebic985uddL7KL6qAUodgSp4gmBZe8BPBtPChmwimBcl/hmDK" +
+		"+3oimJbrledQBd7eZy3IJ2uGYHp989whlTxPKW1G6rK2mwimK5fkyYrgoS1OBNPCWn++Q9ZLnyd1ry0p9dt8TVL3eva9E8G0" +
+		"oPRMBNMiktl8J3rLyJ3qN/I+9mEZR6TulW9E6rLumwima7rLnawkWu60A/m9zw6k+43UZYs9QzC90+dJXfSdiWBa7pQzZL30" +
+		"1hBM939zLSa2abl3tbP2vNPHrT3vIzmyzTRTYJuWOyOT/9Lnl0tYXkYfb1MnnBO/tH1OrcKj9JMez6PUKebEL22fU543H8H0" +
+		"eB6lH/M3fmnjHz/DL0VBme+fz32eG1Ipyy6l3R+p23jwzfeP95Ry8ph9JSV2y8NUn9ijzVnlxB5tTuEm9uhKfaVwEZ592cQe" +
+		"XXkmF7ErjDDs0YbEM7FHG3+iiT3aaKcm9mijdZrYo+u33HlKnVLq8GjNJ/Zoc1Y5wx79PmvriT3avlYI2KPrNzXCxHOuOD+h" +
+		"Ohm12KMNFXVij7av9eUn5/+kxCeRClNOtlR95g30iSQP9mgZL7U9wj7Uno80G8MYDid0GGE4oSulDjihbI4nTugQU21+EpW7" +
+		"5zkeU2Y/nNCVSi7xg5ULOYTjNEP4nFbvn1DwzTifkJR3tSWH2FtPhM8iLtZE+CysqOcn5LlDC5JDbLEnwme/SlLWD0ZHCJ/z" +
+		"SsmsH66U7JFD/GgmwmfBSJ0In/19SEUOmXE+kUPW65/IIbuFT+TQ6bvTPYp4Oz+RQ/ZbCJ/rt+QZcqByRtrM/IbMduc5sjTl" +
+		"tMa7p69ijcd/Y34S88+6CsWzvr7aM3FezXefRIpKj6RfFC1zfkK6f3lD+Eif5KkOnzwnClJGMf3i65tcwidTv9Ds/3ZR/5LI" +
+		"GJ7rvuT4aBdFC58Ym/X16L7nN3Gc9PEwNun35jdxnEbuVIM9d6rBSEoN7C0xNisN4vwmlp7Z50vfxgp34m/W7Ku/iYR95Dd1" +
+		"sMrB36yvK+/zhZ6T7Ym/WbNzx9+sr0up2Rqx153hb74f/cbE31ypPFcQFItU9Zsa4Ry/7uSCIPdKLkNqSuH/+bL4m/W9pSyn" +
+		"CHl5w1O/txVe+JvvkTeIpfdolmb4m+8jqad+b3sV/M3K4nJ+w0BKK4UgZ7R/E0vPnIa/OYq9yjdxnG5tTX6VO88dUsrJ6zE7" +
+		"zXA0q1UcjubIXvabuEpGwzd897c+wXNmmlWQLNvnmzt5z+c7OMs4jb5vPGes+pEsV0ouzjLOtLWzjKyKQ7I8e3LhFWtlH5Ll" +
+		"mZZ3lpH9AVpl5Zsz0SpXSm2dV5w9KTSaXes6rzivvEEd8sXIkzPj6MdU0/KJE2rn+Q1h/dBmZMY55UlmiF0+ESnraTWBSFnP" +
+		"fFtnEifpGSJl9g6IlPW8tBKpcVoDIVLW05f+ESmtERApa/YAiJT1orXAnazoeRN3cqW6VHVnnkMqIMnDnbzeeQMq6itvRw83" +
+		"qnAnV56X1Om33JlYtUUKd+Wx7Jq4k+u5p364k/V6zo0m7uRKKQupcdG44U7Wyywd7mR2EriT67f5b4mMSFkvMzYiZcUbnFvY" +
+		"6PpxqJOIeBN1sl4tv6ldTbkwbVO7MHtPbUtmZB+BOlnRaGaokzNtizp5HeqTGBR6GepkjRYBdbLy+J9bOBNkDepkvS5f71nz" +
+		"VBHIJ+pkvexeUSfrTG/Bmbj2lAUVKN85zN6a33DhNinnudOeDXWyTiNlC2eiqJHzXHa3E1myzm/uxAe3hkSWrNEwIEtWEbQn" +
+		"smSdxg2yZEUDmCFLzrRZ4kzQsCFL1jnzPnWwikOWrGgHE1myTvt/ZMnKx3kiS9Z55E7k0ysp38gMF7LktI4KWVIknhmyJG/o" +
+		"iSxZeeRPZMkqVtNEj1x1SJ5T5IyUWh3otdAjq8jNM/TI+6utrY5uO+nQI/kOT/TIKuLtDD2S//NEj1y/JfXUIXvZ0CNvq2n0" +
+		"yJqdbeiRd/NVSLq7qRFJd2cckXR3y9vVz1q30MvjtE8syZo1K5ZkvX0xLMl6H/lNjR4bwRmWJMbzxJKsWaViSdasZ7EkKx7z" +
+		"xJKsiFYzxEi2ORMxsokHMBEj28sKJcTIrEQRI9vrm5LdUs/3CzHyRQoiRrYXSYQYWQ67E8TIldqkNjSV5FKkvJ1+6tjzW3it" +
+		"XQqt/JP3iW4y8j6nr9+8ATljJoWImVZiySIGxkSMLLwCJ2JkR8eYJV6B/8Vnb5bEVMv3OsMiVB8WkPelPiwg75kUG6yUiwVk" +
+		"dhxYkv22xsKS7HzSJ5Zk5/k9sSQ7D/VZftHl84bYYCXP2GD5sqcz90/yjA2WfmX9lR0HluT6zZ2iy2cfgRc5+IxPvMiV8oXw" +
+		"dV6k4I8JaVePCTleVakv5SxJKScJUsLQoX3DhKw1IwUT8kUSYEKO7EBKiLq0WpiQ40XPigk5ssvAhFwpb4gOKuXMrpC+oWRX" +
+		"6DStZFeYUtNBnd/kknPapBK7L3nSkJbUQQ907oAJufqCkoWhU72PfioaMEzIErmHCVki6TAhS2YOTMj+SSs5Fbi/yQUDKaUO" +
+		"j9w8iQlZRJKZmJArlZJhPPW84anDsEYO9xHnZeI+lvHYPU3cxzK0C+7junNKsfl6zqFn/cW/+UjR0RxJsfly5oL7WMaV9ynn" +
+		"kTz5mltbYDsuudylxFGfSk3bPuwEa3hh5iYEx/XcKSUG7Cu/iR9hHRCC404OITguyf+Weuqwf/K+7reksO0f6/mJ4LhkvRqR" +
+		"Zrv9CILjmhWK1OV9KZn61ZTlFtnCnaRZdsjVOea+y5MmfreXRndcz3n7mfgYuVP90ro08fvMc+qXb0QTv+f70cTvdi7ojutO" +
+		"bU0Tj7470R3Lnq9CE7/b1aA7lj+H5n9KZNzHspOXuI/rKW+jG9sv7UA3duRbhiRGX4r7uFLJxTyV7xyS2Dd3mqfy9ejGTquc" +
+		"mug377wPSaxozSuEp9xpnqKjD/cRKXDiPq5UfkNuzncmBX/fmaT7fa8Zjp+RQtLtJOSP39jzXKKFaSW+2HtPLoe5Vv34W+90" +
+		"OxiNK+U58mQ3fyMorpRWou/OOhFBsc1d/e7EcVa/O4T8JxfUwjbtc1EL26TPD7UwWkkswoZrMfEG27xypzfogXiDLSvf8Aaj" +
+		"lUQObNFKIgc2PJ+JANjEk58IgE1UuhnKH3vWicG37rylElXA+/jB8mKbOHsrdUohFFl/4eytlFwuDArrrxbNqi+NQ9dKy52h" +
+		"hsrl4gfr5AuHbrysv3DoGjvOiUPXvnao4dCxx5w4dE3c6NnCVfY1ceja90zK+cGZO50fXMnT+YEdSDh0X5olHLrG82KGQ/fN" +
+		"13SOIw7KbKGGztTB+cGVO28xyLSnM56NTrQlpod1FQ5d+9L149A1tqETh67xnJk4dI316cSha1u+rTOe7ZM7n/pt1is4dI1V" +
+		"50rtUkqtz28fNdLnN+vS5oxn+6QsItHRcvVR0GnfUlWqSjWpLiWOk518ZzvJlmt29pEb7WZnn88vY3b2+ZtTpM4+v+if3a6G" +
+		"ZdfEy2uPfeu/JDKS3rrP24xaFrMTSa/xm5lIeo2F4ETSW6kipaWtnJD0Gru/iaTX2MFOJL3125TS0iNv0NI9v91ScjH2sfon" +
+		"Wl7DCZ2IeCul5k6U2OxNRLzGnm8i4jUWpRPNrja2Kmh2tZHkaHYrlTfgKdJxodlVXtsTza42MhHNrraUOtE/aOLR7Cp/64lm" +
+		"V1t6BD1MS6npYVpLns1vuRO/PnWnh+G1PdHsaksr0cO0fAd6GD7jE82uNvsrNLvaX6mR+pmX0ezWb+pAD9OMUzS72sh8NLt1" +
+		"pzewq+enPdHsanNmhWZXW/o4HU0ja9DsKu/riWZX+T9PNLvaztypfkfKqX70MEh36zmtREfTnCAj3VX+1hOxrnZaJ8S6mvNr" +
+		"xLrKp3oi1q3fksIlte5GrKv9nTxRljM6aJW73QJi3cpTWehhuhUlYl0Vi3ci1tVudkCsW79pM3qYvmldepj+Scp3SFvTw3Qa" +
+		"IsS62u05EOtqTw+hh+l2/Ih1q2T6UmJ6mjkQ66rochOxrnbnB6h0VazHObZIpSb17ARj5zjYYxa2HYOVEr+d+Rf89Ul1KScb" +
+		"9Exjc7KhdcfmZMPKbbDqLHSr4/HPX6mnnAh5NWfGCHm1GwEIeXXYgyPkrdRbCmuY5EPIq906GCGvjndyedpstDw3RPXUEnva" +
+		"7N9x9iZ2XsX+mCORQdmxYOfVnElj560cU+bbb0pC940dNrHzVrmSwr23F8LOq+OxQJ74eHWwrsDHW3mqgVOxoR/j49WcsuPj" +
+		"rTfkTnWwb8HHq4Nsw8er48hv6rAnpQ6kGQpeHVaGKHh10M+i4NVxJpU66AMkK0rvRMFbZdFbSNZhT4OCV7NbRsGr+MgTBW/t" +
+		"kpNSv5k7nzrsNXmqgzUI0t3aQSs16bkbw0h3ayfs7aTnXpIS/dD6BOluPecNpOfek8tThz1tTXruGUWk527tgnRX95LU7jlf" +
+		"mvQU43Yi3dXsJJDuVjnzhuk334/03PPFskq13wrpDgN5otlVEd0mml2NrhGxbqWUmoQ87DlQ6Soe2USlW5Iq70scIM/xG40+" +
+		"ODy5WE9hxvXog8OMQ6ScuHD9Tln4f8ayaiTSkDl0ZwMpyvlKXc5Gbyl8K2dde3FSSm+wVyelj7fkSuFb2TvsNfyuKRV+19Py" +
+		"e0sEZr81J6zmir05YSUh/9QAT+pppb37jdzbu9+Mzb/N4pPy9hHylt+sKKu6o8Qt0a3Ue3xYvYGvpsincw9dnrxEkKufd3Lh" +
+		"C5fakntiKEx0ufphl7STbh86y510+1iFI8+t1CYlvrWVdshzb/uDPatBOvSd7dHmJH9ne7Tli1kp8mJYqcKL5/1vibxbQ5Z8" +
+		"WTKxfJO/CHJWRztJV+0f96whnU/g3K07kwt9KV0Ezl2NPTfOXXv1/IbJzf4M565iv02cu1pTFpIO7W3i3K1UlUqEiOQpRtAr" +
+		"vw2x37QKSReb7d0a8tcDrSHrljtFWCvJRf1q7lQ/cyYmXq0tKTGQjD5MvJXyZUnBuudO9WODspOClU5hJ+mqndFO0lXr0h01" +
+		"5Erdee5fdKl7IsF9k2ciwWl5HviXcwb0vJ5TaPS8tY7VgjzwxTKa6Hl92M/tsV606thjvWg/vsd60Xy+x3rRjLrHerHmThaK" +
+		"aaVYKEYqxUKxJxcWij3PsbJkm4NfV1FKJn5d/X7zG59Lq3f8upHvcGwh8j11OCKjnCAflayx48eaG7GJPzqOIDurg7V3bOKP" +
+		"kUhKRep5runJR/wAWYhgzdXNDgRrrue0BGuuiqoxseYqXuTEmqvHnTyblPeRUeiRE2uunikLGXWS5FhzVRyIiTW3UsmTBYVx" +
+		"iyBXT/ILQW6lvMG6SozbeSQaG13EEWq773AkGts3eZIvJamn1IXsRpBrHz0SQW71peTiuxuNCHLtQ2uBINfjv3HQRn/euVM0" +
+		"L/3ziDbajhxdbqW0rhO015Hf7GZJPnS5/nK2fSSWpbUaulznGzqPeInvuZMkcraNLtcf39B/SWT8uI7xN/Hj+sveBD+uIwxO" +
+		"lLj+sgtFiesv1sIocR03cB7Zr1rJo8T1WKOhxPVX+niiCdF9H4m3dud9ajDz3Om3lEzMOPukI3vS9KRYVbKeQonrpz0NSlx/" +
+		"k2YocR2LcKLE9bd5ESVu/WbckF/v9DLy673lN/Gmtzz31OFN93Ekppo9IhLcSqmt1djbOgMJbqW0Lt5HfEnQ3rqIdTO0t56e" +
+		"6xxst0M9wgGm4UZ767jwE+2tv61S0d7qvPKcctJNo731t1Uj2lt/29WgvdVG24f21t9n7lQH+xEMtyEG0vw7cPtL+Q5ne0s9" +
+		"I+Xk+ZfZ/ex+o7H5MzX7S5GlJ20f7t1Egltv9wbkjPfjUzqR4OpBZpyRdNbWZySd3eQZSbclT5JuSy4kXcmdzwpW/JSJ4VYP" +
+		"upYzcc3NfmdiSxpHGG593LnzKz5f7nzqJ0r2xHBbv+VO0oyUOCPNWG6ekWbGJoZbLWee26XyHD9tq80z0dGulIyf9pU8WUL0" +
+		"lIwlBPmF4dZi7XpmXWU1geHWYouK4dbEc5wYbo2P+sRwa5FfZ2LxkG0Ybu3lTOJ0RvDSs05nBO938tyl1FbktPc7JXukNWro" +
+		"xHdr7/RBrMz4ZOG7tTedLL5bezutx3dr8frCd1vPabNL5C27BXy39VueE1uy5Tcs0JHfnvr9OTL9UyLju7X3kXer+Z481Nyu" +
+		"F9+tvWdSam6Pj+/Wsq7Hd2tvMza+W/tYL+C7tY99Lr5bw6Gf+G4tUvCciTmmpZ2OvNkpYL/Vj90W9ttK5Q12OBlhTkCGNST2" +
+		"WxsZN05ARkZfoprTzOG7tUGy4ru1EXliLTjMyvhuLVomfLc2yFl8t5XSP5xyjJo7kdLz9awTh70DvluLnwK+WxsjKXXYk4s6" +
+		"RC7woo69FL5bEUV6/rlN/UkzVs3XJkaKk6hrEzdaLn+OUn8pY+OyMkTjnFds1J2PX7FRtyq+YqOula7YqKvtFRt1+5ErNuok" +
+		"3RUbdbveKzbqRi2CXBVHYKUSwz3vY8P9ezsb7j15suEe+Y0N95k82XBrJXS5tSP2BvygSKXQ5V7OAK+sDMnLKyvDO8+xNrVG" +
+		"QJdr7fcbLq+1Nbpci2YcXa5Fg48g1/o7JSMv0xLkZWeDgv227lQykq7nq5B03X4c+22l8luisKoDSRf9M/Zbi0Yd+631mvfl" +
+		"7WpEmnUaMOy39ZwvTZqJozavxBl3koL91jr9IvZbi94a+61hlk7st9Z78lSHkTzVgdYJ+631PbmoA5uJi8zqpMZFZnUnPrhw" +
+		"rZNguHCtX7lT/ZyU4sK1TpeEC9e6teGVyGL2I7hwrWeskFnDzIEL1x4t9r8k8pWYY9aJiHEtOl9cuHGn/VgYxN4T+61Vq1vs" +
+		"txY/Zuy3lfItxRWL/zO+W2tOEvHd1uJI7Uiz9s6dYtulByYWbnoEadbshfDdVkqPJ7Fa+geJ1chnDLeWlRqGW8NAnlhlK/WR" +
+		"Uk6625lo4UYmVllr9tVYZeu3pJTTiMYqa1nhYZW1ZpX645EduVM5j7xPOY/kmfP/Xeppz2JWmWHMGEV4ZC0nIHhkLacjeGSt" +
+		"lPxGx2Xen9Fx0RXPaLKcWeGRtdjJ4ZG1+GrikTXRhGZ4ZPvvfYc7k3rqF6tfPLKVSi5TKm8Xh47dGh5Zi0YWj6xVOzM8slZZ" +
+		"EeCR9W4fgzI2chKMMrZSWpAlJf7MRAsbOIITLWyl5Mk+MnaAOF9js3fA+Ro4MhPna2zmO8SusZELiF0DO2biaQ1kl4mnNTZr" +
+		"XWSqsZELyFQj+g1kqrHZzeJNDbyiiRs1NtqO28lXV/fbyVeno7mdfHV6n9vJV1fb+4k/MDof4NsMHkl30zhHfuExjY0e7cb8" +
+		"3+x7UZbGRhOJsjS2O3dqCZoeJKVR6DqRlFZKyXhnFquz+6BfpytGKBqF1EAhWilv4OtUzD93/JnIszseTN/85n1bcmElQaKg" +
+		"Ag2UmYn9s1KeY9GacXSzWi1WBdg4o1iBoeGMjNs7km/+F63FHZlI/t+JZGFuuLPCs0u7s8KjRbizwnPieGeFl29ihbdbdYST" +
+		"E/0lTs76Lc+dUlqFvNzNDjg5K/XXmveLrcqoSfF1fdbP94utynh2I/eLrcp4mC/3i63KeEb0HfqNs8Mb/abt36Q+Uk3qaZf9" +
+		"2U2uVGIg3VJ8cp+59sbJafsTqfPGyWmihtw4OY3G8sbJaVggN07OSuXtT7vUXf1idXLlOfY9M28gn58+d+PkjPnxG/75fPrq" +
+		"/RIFgtfJ/RIFAo3jRr8Z4iTe6DcrpdSiQMy0xCM9h7gd98to4K1yv/R43jErJc+0GQ/kWbTSKc8iT6MBK+PGphmzaheSTryP" +
+		"GzlmzGeldiPHjJkvbT1UhvpZD+H53K9YuA31sx4qu1aKhdtInma4XXtaD5XdG6x5yqFdrHloCm4MmIZldGPANKzMlWruTC58" +
+		"EU7lZMVGU3Djw7RyKos9XJl5Th1m3q4OM8+pw0xZ1GHq8/Z3ZWpr+ztnPDc+TONzeePDNF6WNz5Mc5J448O0+lYjo99p0I0P" +
+		"01A8b3yYhh9048Os5/J2vfzRyN74MGvRk+es6t7qZ/Q7fbpfRr9TqzvsmPGsbFbqLfX0HryW5hzzxmtpqKE3XksTh+jGa2lO" +
+		"NW+8lla3pNTvWf/d74zib35Tv+cc4H5nFD+y+35nFD8rvvudUfxNydTvm9/Ub/s3H3ndp+YleVjPPidfN8pLwzW6UV6as64b" +
+		"5aU5Ub1RXlZKma2/7kcHdKO8NBbPN5JL27u6WmPtPb/tUl2K7B5qbo2177mT7N6TC9m9p5y3lFyssfZdS1tj7Yc2OhOnTW2z" +
+		"xqr6AG1+b74lbX5vyUWctqa2tPm9584u2ltSWCCnUvOH6Y9O7UZ56WKy3Cgv3T7pRnnpfSaX6TffiK6f9c2N8tL71Ep0/d14" +
+		"Q3np4+070PWzUbpRXno32lFeer+9ga6fXdCN8rIWVcnTOV9GCl2/fdmN8rJS+oQoBuOVt598m7S1c4DxyZ3Tb1rJOQCi7o3y" +
+		"0jP3orz0zLYoLw0J7kZ56a+0BOlZDy1IetbD20nPdmkJ5wCvd+50lvHOnc4y3nn7LpXnnvq9Mr6dEbwy9lHAkRBu9JYu7uSN" +
+		"3tJfZkb0ls7z6UZv6a+MdqcCr4x2pwKvTVmcCrw2PZKlx6vk7UqdnuxU4NXyPl+lJ0+a8ZaSXVbTamTdzbrvRm8ZoqLcCC2D" +
+		"Rd2N0LKWOZsUArOSIab0SBTElC4e2o2YslJTCoHZqEJMWaldapPKc0XqkHrapRrviCld5LQbMWWlcudwZ3J52sXJ+o2Y0p26" +
+		"r9Qppe45O9xTFvUb+U39du0SwvTjb3d/Qpg2g39CmP7bBf9LIuOz9Gq047N00d5ufJZeDyUhh6o1AT5LR3u78Vm6tfWNz9Lr" +
+		"md/U/Ei51HzmTrWbWoWsqbe6kjX1Uhaypr3zm9pZQyK5rOe8gawRk/tGcun18r3ImnqpA1nTXsmzS/kmZI1z9RvJZf2W1CGl" +
+		"XcgaOpobyaVjzd1ILkus5w1P/USmupFcVo3UgaxpVg9ILiuV377u9D5nju2b3576sZS+kVzWb1qCrHG2diO5dLG2biSX9Zva" +
+		"kjXNKuBD1jRzDMpLp1m6kVx6y2ggeVr1jUie1pJSh03/J3laRh/J0zJSSB6W4DeSy7ozKXXIWCR5mjkUyWU9p0YkDz3T/SF5" +
+		"WsYN+7OW3uo8smUMO48U0er+4vPtLSk+RKTSt/AhIpW+JX63SfETsmL4stjYD78hmO7Pydf9ZVW253109ny+Vop/0bNjXalT" +
+		"6mmzL/+G/SFS3JgzvZnrMWdWe3Ypbf2cSdyYM53F+o05s9qlSWnr1E88gLYrp3gAbSYXbU2aYc50OrYbc6Y7hbgxZ1bJkou2" +
+		"vvKbtj5SFn3JaMScWc9piSP1U1vSul3yJK3bzG/qlxYkrVvajLTuZlTMmZVSFtK63bkzMW6Tp7XTO++zdvqknNZOdtaYM50G" +
+		"//5G//bN++jfNl86Maze/7ZHvhFn2u87Oy/Yby3mvOAgh1Bl2mFX8XUmcKQGzgQOax7EmfXbLfWsbo9NH3D6eXyT5/Rccrm9" +
+		"QV2dFxyk0td5wUG6fJ0XHNYnX+cFB2n2dV7glP1GsVnP+QrOC45v8mzel5T6FSVzXsBjcKXUb8sb1K8llfr5JvbHR9GC9sdH" +
+		"TTnVj1TCwlm5aDPnBXwLbyyc9VtS6meVg4XTjiFP+2O++vfX/vjIuLE/PqwCsHDasedOdciotT9mk3tj4aznkos67CmLOmTU" +
+		"2h8fZtuv/fGREWZ/fJxJqcMpT/vj41Iy++Pj8nZ74CPjzR5YZN4bC6cdGUX2wGIE31g47Uyvswc+MxbtgU8r0c0e+DD3buw+" +
+		"WFesVJGaUo8UPJ4TrBtDZ+V5Sz31Y/N1Y+islFzsj0+9HEOnnVb9GDrt3JInCxg1wsnpn+c85sbJ6W/yGSen81a50W/6xy4b" +
+		"/WallIz8cnp+b4nPZ2xuic+XkiU+3ze/icFndGyJwfd7n5I9mtV7Swy+Lc+J4rbl7eLXGTlbYvCV/CaKW/V2K0pxPG+Mm7XX" +
+		"LFL2rz132t9dSdnfWXNh3PRRkgsfT31+i4/nyJ3OQKyDcWzabReFY9NOM384NiW1JaXwYW8cm5XyBlIKH/bGsVkpZSGl8GFv" +
+		"HJv20KD+JZERbhoC7I1w085Tmcmh89QO5NB5ajFyCHvqRrFp58ydvkJNuVjvkgQoNu0+tBE5JO79jWLTcDRuFJuGo3Gj2DRM" +
+		"lBvFpmFl3Cg2DUfjRrFpiBs3ik3D0bhRbNp5pyxP/a6mDmTUtSfPUyq58B3ecufk46w/8kSdZCLCzcDXuRFu+kXviXCzulV+" +
+		"E9V5JMVaOF/dqvE6kmItXL3dqvGiWUW46WdKZtV4pifdiQpsbIhF/Y50sSd9W23i1qzURyoc57dUOM4vKdGL7Yhxa1bqlhKh" +
+		"uCUXEYpb3qAsLc8pC70gNs1KFSlU55H3hQ19SWHia6WSKMvmkZLYydZtJbGTfYeS2MlH7tRKJXfScJMLqDL98jVRZfpFPxuq" +
+		"zHzlOXGxrdB/VJm0C1lzpSxkDWbWHXbMRVsVdsyVkpE1l31u+DDXld+6lHLaa/L9vjFg+mXFjAHTL5Ic56VftFw4L/26lfNK" +
+		"hHC52GtedNPFXnO+cqceaa2GAdPRw28MmJXKb11Kbe01sftufJiVUgd7zflJyU6pPKetv+pOokyzGD7M+k3JZiKSa2t7zflV" +
+		"FnvNnJ3gw6xc8pxyGh04L32ml9szzqY97RknaY3zsr60crJhnenlbFhnerL9JJ7WjfPS5/i3rcWNALPuUxIyY5L4CDB9kvgI" +
+		"MP2kcwoB5rSzRYBZKbUjM86UKzJj5DmjgRxCgKmFbCvRcTnzKHaaIz3QTnOkd9ppDppAdJg+yG50mO6U8UaHWalb6qkDn7Yb" +
+		"HaYf1jXoMCN7Z3SYEe0KOsyIPgUdZtRfnjvfgOTCxuzxv7wrWRNtB3LMSuU5FrqvlIX9mRUXcsxgZ3Ijx4zmnAE5ZkRrgRwz" +
+		"xPW7a6xb7UJRXgYC2Y3yMrAkbpSXIUbQjfIyMChulJeBiHQjuYzotZBcRrXnRnJpjaxBchnV/hHJZYhsfCO5DBGDbySXIQrx" +
+		"jeQyvqmRM0DRVG8kl/E9k3rq8O15H5uJ33PsDZxs4LWMk1YSr2Wkt+KuNNScG3dl/eY554pnSo1Zgm1/N3bTZ0vq686XVJc6" +
+		"pYbULSUXGpQm0krWWI1nyWlW+TPDeVJyGR8puQzv81Wa3nNaJza953Tu0NJ7SJCW3mM0Nj2kGX/tWSOPaHOQSEa0Fkgko9Gl" +
+		"NnOayOkrNaSSC42N9UPT63aaz6bX7c5OWk6J7UObXrfTuja9bjc3NSfIu3mrPXPa2K0bcVDGTr/R9MjdSrGxtdidouKgjP3K" +
+		"c93+J3cOKfXT67KTb3rdQXY3ve6gGWx63UFP3vQ6TJ276XU5nWl63TCqGubZ+PwvNM5W/j8CuYGhCT54N0Yfgxq+MfrY89EZ" +
+		"Au0WqU03znFd0433mTtBc4jE5nh8v5Py8WYK6eOZsAFgRpQkADAjqg8AmHFQAQUAc5iGAWDGYYAF8jJtxEBexjQUgVwGrPgN" +
+		"5DLmyG/EgukAyGXMDBv4IOCfO3gWkMu7ZVGcVmLwMjM0ngluTGIdnmVMR+DwLGOaRuBZBnT4Dc8yZtoTkuj+JE8miRYd8CwD" +
+		"dPKGZxn3N+8jkL95H6FrE3pw152fpAyUBz6wUgwhLGQO7rrTdHBw150U1XF3y9LlEP5p6qpHVaOS32J4UaVieDGktC5Fz9G8" +
+		"gTr4jyX1LGA/UkpGCB4E5HzM5O6DmjXLh3NzpwF9Fm9wJHGm1Kb6M6XWr08q36lfn1UPMcBOKoxJwXdyK54WjX/rlCf1fIfz" +
+		"CU67Jjhv53IMpHSflMM3A4OTmL2pKC/4hptK4WLEdlsmXozYYO/u6zEouscnqetJ2YBcj8PgzZF/pe4npdddj7HR2sU/75sP" +
+		"POKGd7vn84aV+t8Fufh//q//+T/+7//j/3zZ5a4/r9djetEZP3Txc9YueHMxnlPjufiM1ZXiUvR8nk0X6+/CG+sJ2rjpTV0Y" +
+		"nS5+ztoT7y7y/nocw7f5bOlWimvX8yHXxeYiX6mnp62LHq9x0SrPxaacjR9VA+JuytnfP+eqv4uPxc5KKWdXzmfZulKcq54T" +
+		"gG3ueVzhn+l2m4fHhzyHVjo8Prz9MSXbGHOtlDyHPC+FH/yvnu+56aH9HSepXZ6PWO3sRjoTkW0+CoHO8KOzyFg7eU1Ho8UU" +
+		"Y7M860wxOquLtcvPnfvP++u5qPBHXMKeIt0fL7I/ZDaxkVM9Jg4sFrb767s7RWSqsJFMPaYKbA22e/M17bSYDmxMb3vMA9gF" +
+		"bHc+cfwDnzlhgz/oMQhgCbCxue3v+AA+c8IGhtBzlO/UfrPO7Tm1f08vepY2PUfyzuI30ax7zuIdwm8Cy/Qcwjtv3zADek7Y" +
+		"Ha2vixokDne3Fz329v2z0TpuyvksdFaKgrGopg72KXSXz+HXdp/uNLg+xeNn7uw/zeJz8XDx+CkY/y4+a+7+Ea37U32OZ/u8" +
+		"Uu2nfHwuerweP83Y38XbnY1ytWn5251Nno80L5Ehn07V+qxzy+udi7SqD6ahCLC9UtSpzzp3XbxcpDnt8vwovBH3ecZRQXVd" +
+		"KRo8kE/BJbpAM53FQkF87SwWuvAx6+Jw0dtxOdFge6wZmCqsixo56mIsTD47PRYETADKq7qTEsX5+7roTufvHzjJ17MR7h/q" +
+		"D+fv6+LpIl0wFKTgtT2H64mP8upeRNOR4CevrkYGV+KAvJ69SM8JsaPh8no2DD1Hw056C8/knpNeh7Pl9Rwm9u9zMNC/xePP" +
+		"uWF3Rtu/RXs+s/FKdRe10qNzXamnlb5Pryvcl7vD2f6tWumZlPv3EfD9W739WTH1v5g5fxebvvR4Ba3U5qK3P0cdK+XtTxTg" +
+		"Io5F/3aFf+aAdXG4qPC64vvl8e7xB2hXuCx2B77d2Wd564o5w3RcWd7PoUjPcaVzyvIuufN0cbqoQQhVp4qFB1Z3qtgFsCjv" +
+		"6u0Ua84YC2++7oyxO1wsFJzdkWF3VljeTZGIX4eE5d3lSZXmdLCIc9edDnbHgkWAu+5YsDvzWxd/uw9Lh///WqI7D+wOAst7" +
+		"5H1KJmYOn7Lu7K47piscgbtjuu58rrz33Kn36NDvQ0eZyVNtD7XVyx2wFa7GXXiJLq5EeZ/ypEly/FXehKPjr/XP48+Zykpt" +
+		"LmqXZ5W5UtVFec483l1Uzmcn0LciTyPn/SzU+h8+8Ln4fGmHWivl8ceeoSDMrNTu4uHi7eLpYngGTyNv1Yse5M66qJzPyqpv" +
+		"j9QveDMr5e2P1C+wM30j7rdnSVU+39x5uehFX+V8jgW7E8DiFGylFL4pvPHwF936uajwxsPW5Gm2+BgPmyG6PYNyXRwuavln" +
+		"0igOylZKyz8jtXwsGbeu8GaLT9V0j6qkb8+irXwebWnfTCHbE6h7XdR0Q5FMIR+DxClmF7yjfMhWwTu608jyGVrJaaSjxnVR" +
+		"g5gtRMconz0Xm4taaddKpIaTvCISXM9JnkO78jH3O7TrYlCUz5k7dQbD/vMcI3fHbd3JWvlcamS2cFRWPpcaUWw7Byuf6UVW" +
+		"XY6gyic9maLZOVH5pCebQhwJFWHeeo6EnPcUMeO6E54uQMG6+Ly9GHFlEy382bisVHFRYPDH+GOlmovy1L3L49/Ty+PYU77P" +
+		"7reXxzW3l2dPsi42F58aFcPw+5GnCayUPH676PGinM/+rBfjqBhH383jlk2lKtKjlurlGSu9PFNWEfutl2fmWv/UqHm8ebx5" +
+		"3Nq0GDLFkPl2hTdkiiGDjNuLIVO6Ig2PW2CV7kX2OcWsVp511rro7YZMedZZ5bvnca00hHK3SihD4YfH7YjKUM6hlQ7ltBQT" +
+		"Z6J87Yic0HWBIsr3cVfoAkV0527le6qRM34HbuVLJjtU62I7lO8lT6f6CerwnboNI6PyiyAvT1uakhDwb9/IQVb5xXfXbZxZ" +
+		"lYRr/2o667OSyOybF1mflYRkJwBzIFUSOX1TTkuxkkDopvkc+4Tdv5m8c4rzw/S3XNQVTXzYsT1nLkHqb+khjklCyOf513Mi" +
+		"Ehg+aGyvBlc1c23Pqd9KsRvdPP4oiVcqJr2J1P50hlrYeFrzcRfq1cxVzVy85laKvWkRat1Krpb/AHmeGOvbf1tPVIvEapHI" +
+		"la5Xc1U1xspLyWxNahMQ2za3tpgXiyxuw1HNVdUYgxFeKSUzxopJsRpj1cqx2BBXY6z2RCvfXPyPzfFz0Z3D48Pj9r51KNIQ" +
+		"cpsoqYMZr2mpPPrglVLNoZpmyrq/fkbKz8XLRba2e4LOq/tef8bNz0VF2mMNrZrVV4m5diKg63w1ttgJb26XXGOEbYyVruli" +
+		"YZ2A5rbONabV4gGUkcd1KYEAyvDhfgbT8iSJaiyZjbGyK7zD21Dqy6HwzmIDpAdc6YD0HYm+lPRdAw+QvkCsdAj6jjZfiv0K" +
+		"hnyvwl0Uaphq4FWLy3Irp6kOSr4Uuhnw+PVPfG2dtpnq2pZA6NXFGOeKoa0nt638LHafcOovFxlwmtX4LK0Uy80iWLee3KgN" +
+		"WklUdY9TG7QiqLju3Ur9Ge4+F7uLbEpLwqJPF++fgfETR/1ppWZSbCZF7lCP3W+Mj5946N7eYpHs7Y851Ep5u7FZiyIZm60l" +
+		"fLsGMSk2Y7Pq841Kz0FaqdXjPQbN8qy5s/+snJ+A6j4HBYPztILQ152nPVa5z0WPD48Pb++5U4NYMlazb4v5r3FULQRbLGiP" +
+		"hGXPxRjniqROCeQsqoPxl2qf4xCqO8op1c7bUU5vCV1P39Ni8p6Y9ZcGia17gtXb0rQYspuBeJv3FtvzhI+fvnuMzhM3/tZ0" +
+		"tl7tFyJeT47R+S82vCLF2vwXBl417/8Y/D4Xnzu70dHNQI307pZ33fIOMa/3LdZ+Hte9u4HQnwXduvg0ctfnuz7P/H6lmCLT" +
+		"T7DQXymWxfp805O7Pt/NVXgLK/X9eY89F7uL8qzytM/pJqluQ9VK7mSmbEOFzNC71WE3Opjtr1TczZqLyml09OZFdEi9HT9j" +
+		"weeiVuqxGVROnbabubqFINrDSmlk2yRHuyvl8eFxqnG8/t6p3xz7dqD+jslfWKJ3TP4OiF+a9QQgfse3L83uBd++A9uXZvfS" +
+		"mWl2cW0axRK+fQe2L+051+s9puRi1zx26f9eT/RYlAta06wce4zHrQdZ2vcefz/rQcD43uPMR4nX7tx5/Tz1nos6dBz2TGCs" +
+		"2ztufIeIXwt4XSpueGK29JePauUI9V7g3DvUe0d1Lz2D5Ipn4NtF7cKYCNV9XdxdbD/vv7+LFFk9gcuNcMybjsfe4dXXRTW6" +
+		"40r4fICe4URbCH6+Lj5vBz/v6OUFx72jl69/JyBecZFhjjUmck4fVCbDXq0bTsNebRRNZ7YYJRauw8XhIofEIk9qg1HjUehx" +
+		"arRhrhoGHlJPH238vA3/LlKOjcacyBjrlGPD6nAYY91wGsbYMMZ4x/VhjA2bLfiePhwqDWOsW/eM4XG7pW4HBrbekdRLNwfg" +
+		"pXdo9AJQ36HRO2J54YHaEcs7OHnp9lXg5B3tu3TDCe27Q3kX7qV9xKHUDMTLtON0d4DuAtjTAbr7SE9+eBl9xHfUHqjfahTn" +
+		"T3GDholhxM9T+GxIoD5mTLDE2zI6hk4LmF1A6HuA2UjZ66K30wgOSothChl3EH/ie5lCBv3ESCCxr1aKGRmlxXjs9vque+8W" +
+		"beMx31up4qIoYVvu7C4KCGZTuD/T2fqn8Lr3brO1m8DGg+JYKQZnJjDE+ZV6GhlMu3CR7buevFPpYaKtlLebluApVupyUSt1" +
+		"L6Jg35s8KRh20xIydxl6MhZ3363PINX6bn2207qPx6xiyf7na+5UemNX9y5PQ2ZY9+yGDFx3gWJbqdNFeR55XCuNPO5Fhsxu" +
+		"0YZF3HeLtt1cBfK/UvIcicHmG5mr9oR+o98N9HtPlDeHs2jffU9At8cKpAN7d6zsglzSd/4Cux3RcDYBi933xESz1sfD7nsC" +
+		"nr08Tvm3J37ZW8vbEe0JVWYDAFPd98Qoe+dxL0pwMkpfKOqOOr0uqhGlBYj0uqhIZpndkhGrpO/G5m7vtKfPO3JFgS477chu" +
+		"bO7CD+5bHlfO8E9tend7J4jnsjtlg3juGM1FVICOyrz+edxAOIy4w4jb7W8PasLDhLLTBB8mlMOEsls2HSaUw+DaW+58vvth" +
+		"HD28gn+vJw5j7DCcducdh+F0NF/F6umgYGDzUoQR6Gxe+mE84BWslEL0hK+rLnqRKYRHY8eZXv/kaTwclHiHQbJTuB0GCaua" +
+		"stsQH7QOh0GyOxk57GyAqctOSwxM3TGoy3650yCBmy67s2S46Y4zXfbHTKUjS69/CYOnQSz9jkQMtLfAaO7QyeUwMQSdfPxi" +
+		"4ym8vgvTWw6avYB5D6dCB3l9mAMgdQsHuw6p21Fwy6HvniT7uSV8XnfxcNGLHo+1jpfb2SWVg5HKWYIvVk7ridMa5Xx0ZOV4" +
+		"zLT7WcYPdPxcPFz0opKwe48kOi1cTsc64kas1MfFBK5VJF3xJO6PXZHsQgB9C/e3lboZY79Es1V3qrKTuD8eQ8qVUniK68Mx" +
+		"BOOoflohHadWskI6de9DX0IHXv+eznA8qK1+0jwznCqHs7NTTz715IPAPfVkRlXlIHBPPfnUaQ/LkZNkP0l2HPSV8jh19EkN" +
+		"E/Aw4nBBPu+Iwx1OuHCG66f9ykncn+88/nQwyOB10TfiOob5W056FMzfDui7LiqSVReSb8FB70i+HbR3XXw6LWhvR+stp90n" +
+		"Wm+H6V0XtZJjY3zeclpk4PN2KN5yWmRA8XbU3XUxj2t5GxXo7Q6U2xFsy5nubWKAmS0nPQrMbMeQLWd6smPcM2FmHagBxK5/" +
+		"Iso6UMOJ7QCxheF0B4jtyLDlpFA84zNA08YAukO79pBaT8qVy2xxWZ+dTtnY261/akR+XkbxZRSf1tBgrmtXppEfy+An/MFz" +
+		"0ePWE5cBe9GKnU7Zrvr+eUA9F3cXOcRQY7O0Xqnz5x31XDxcFKaBbvukhrnoti+rrlOfv6y6Lquu0yLjMuIgaMvlZPmy6kKi" +
+		"XRe7i8NFgRmdLF9G3OUA6LKGvsZ/vL+ei15kGF5OhS7mgMwYO4vFcllPsFjs6LbrojuNOAjacunzELQdJ3ZddKfBBRBbLjIZ" +
+		"IHbth18uPp3hiu+SISNERb9+bkqKVLRn/IxYRXBP61ccjGxpLmoYHNUOU7ouKqc+j9JZLt37snAB6yyXlTFYZ8flLH/t8l/W" +
+		"E9MMNK1KLiZj06pkWvLz9+qTJmrqUtyp+qz/cQZ6Lj61neT11Huux7K5z5Y7FffxuFqpeAgprnl66lIzXYrOY+pS0xrlovee" +
+		"1uyTEOeN9bgUxUfoufi09RxxHHrynGTrHLy4SPZJtk5dahLik2xFNu2QpoUbVoc07QilBZGuI5Suf8NFRXLQiElaUOc6CmmH" +
+		"ES2TbIUR7cifhSl0R/7sQJ6FDXQH8uwInuuipiOvJ3k9qSImeT3tpxHp+ownGoHLBrrPuJnZOs+u7vEvszyfjmBAN/u0Ep/p" +
+		"NvETo9qZzlBm3L6odibhiMu5/mk6c/+0QkLiLNPi8tY/byYyU6+7mTQica6Lh4siTxG4wtx2CM71T55OW24C9y6586nR7Rj+" +
+		"tsQR7XalxKQnW2MJe5Ott3XPvHPn8YuX9Yfwpku4rXtuR4TMuVeq/qJn/V20r7pbgmjtLubO4xdL6wlm/jTybXTcPdHPvd2O" +
+		"9nbicOuKd0+4rdvFpy/dThxuEvO2DAUjXf9ETSfI7kSl2hMAfrq4u+hF5mkU0g4/ui6602r7dmSOWrtS8rRwuamObypShNJy" +
+		"91wUHczJ3W2fc+vztz4vXlZHL+235Qh38w5buv7Jc9cgDNaASsttR3QTzTcpfFMy3w7+bqPjJgBvlis3HdLNAuzmYQliWm7K" +
+		"gJs29LZ5RajrtyFzUyzdJu/bwuW2eb2v3OlFVjP3lRdpZNqm24x+Oze8nYxwB++gqR0ttdzsHO9EcKOCup2Ow592pNNy2xHd" +
+		"ieAGcXo7bYE47dim5X4cbsYLveG1Qcw/0/xKfVzEln+WtuMlyuRrA5V/9Gcr1VzEj3/m/pXaXTxcnC6eLj5UeMa5KyWKc/H2" +
+		"x058vGB8XgWv/utFxYuKPB+d8UrJ8zEQWBcvF+VZA7/fnot8Ol5VOTdF4oLyqvJ83DDHq8qzKmfxoirPZ0lVX0WDQF28GuZ+" +
+		"kSfixasp/HPCOF48Q17PZFrx1VbK25u3N+Xkz/LqGqS7E2fo1dWoy5NHyavj+D/a5ZXyoi7PrkjP1DpeQ+FH+y/rifHikPIa" +
+		"KrYrhIDNr6Fiu+Li3QLd1teuS0Hbvp4lXH0diotw+0oog8OdHPHxZysL4YE/O6Bm6+vUpTiyvkRdeZ06Cr/Ul6AAr0uX4mCI" +
+		"Mbsu+n78BV/Cyr2mGnH4e4mVKULRSqm7YHGv29u5mSLH1tft8/Pfe4FbvF/ezh3vJWrb++WrcLB7CUX5zsjhL4cVW8U2GVix" +
+		"Awh2XfSihDp/pFR9f5RzylPYyPfHuIXRRkGt7wwnzmLApfX9HK6Pt8H8Npjfz+H6SiEMGMxMkQffnfHexG8wHt6P0mK8H3OB" +
+		"+tb1ufCMd/H2Z42yUsgExdsfw6XBhWdw4amiJQ2+O4MrThVuZaVgDpoiPQuXB7LwXNTyXeGNHI459T2UU2zHd1f4oZwcYLni" +
+		"VHSFlcJaGMJbPEq1lQJu0GkZEY936BFCbbwPbw8oIpEzTnUPBSKhL3RacNSBL1rfjz55cJQZvGCqkC6DF8zA0azvW424UnJ4" +
+		"qZ+Xrwm/zbelsu0dfFvGO4Fa3t7Ow5E3S/185MmZEW6ywt4Mvi2Db0v9fJWTiyI3lgp7M94Bb4iXx7Z3fMwrH/MKmM1KfVyU" +
+		"53Met1LFReV8Fi6Dp8v4bPJ8Vsbjw6Hvoyt+qhc9c8f4FIXXl7i/DH4v9dNypxeZVz6P9cbgEzM4w6yLu4u7i1qpJ89HBH2q" +
+		"0CWk8Eew0k+V53MyslLDReE7h8LXPO7DPabr42Ne4SFTP+Tnx7zCQ6Z+dMWPeeXTBNp5nKJXilN7EzX0kGfzouZFj/ptcKJZ" +
+		"/5TzkqfR8enqPnOnuptCPlOD8CD/DI38rKHHR+Cuz6MQrt/nYHqlFMk4+hKAH+PoM0RUfOkMYhrywanftxoZXHxw1kXfCCeB" +
+		"D0796rR8cNY/LyLrPugHHHPq19KBY87girMuKievdIDQddGH446O/lnRAQZvnQH0Wb+bInEk57RTv0UPMYq58KyLysmxm9NO" +
+		"/abTmiaAMSvwwOC7Mzjt1G/VShyw4S7rt2kl3tWcduqXqMS5HCCW66IGMXdgUtZvV/epmsIKf0cuelzk9O/QFTkhc++p30M1" +
+		"TSgIjvX7H0rp/3Y9wS1ofH4hNJ+K8Q5a/5TscbtYqc1FX8Xc/zUtfU1LuAwrhdmw+SrPSnylnhchP9bv5fHi8WdM1+9z4jA4" +
+		"F61/Hn92iivlcSP8+6jRxte0hANZv1bN/I8Gx6O66fpfPswcj9bF5/N/uTJ/m5CdjyZjfB+1+eCbVLe3uost8bVI3B6z0/Ft" +
+		"8BMG82bVzClpfLvHP6pphH+7In0UHpPya/7bCPGv+e87EkVU3Q1mXkx127xIcIOvcbttufPpUt8EISXEQSwHJmXdCHFMygEc" +
+		"uS767lARPKPqpuujQg5uT3WrCg/dgKlYt+btGB/8lur2qDcG96XBb6luOjT3pcFvqTJvHtyXBhelug1vtxTjf7QuKicSAcpg" +
+		"3XbVhBmAF6xCoAxOSeufPA0SfMABBVg3S1v+R4P/Ud2sEvgfDR5HdTt1Gz79KHx1S/c2jvgfVeFRRvB7/I+quCgrJYKKcbQ9" +
+		"2rvB1Wjw+ambRQbXn/VPsMHH7WJsuvdmWmIrPUL2g/SrAjyPIP045qyLimQpxjGnClCyUopkv1IIcR4565/ghoT4ZgbaOmoc" +
+		"Ic4jZ3DFqYKXrJSQK/YkYgE9IWyei2q0eZFQHFx4atnUaIhPMwRMfBR1YzMtbbu6WwRvifQi9Bqr6gFEODAH10WNnLgvFoKF" +
+		"uOf68wTJeS7mcS8SCq1UbzcQcAVreVTHA1dwcPaphbjn7DM4+9TSc1HdzUCly9MM9KPwDUWyuOTzU4WcGFx/1j9v39XdvAJl" +
+		"V4WVGFB2A8OulkN7JqbPTFDL6WL/hcV5YlSquwgxQHO1XD6cZShfoVr0eb5CA/itlks1rTg3o0NUisFJaAC/1WLhwldo8BWq" +
+		"5dGOrFQi2ejJVjM8hwbvoFrs8bgFjWIZWp+TkcFJaJSSOORPjYoVZynCbtvjFVNPsbis9ngFe5gD0bqonCUhc+Rpj8eBaP2T" +
+		"5wN/HjyH1j8xvL9qRJPBj6gytR7F1FNMPaJEDF5Fo5hlqsU6B6LBgajisa+UurcELlcjUw8HonVRK1FFFENb4OSVkmdPpHOF" +
+		"t44sRnG1WOcrNAp1w5+jxX9ZTxSqiGLhCe6+UtrFCMeGXyl1MIFVa3YeRwMTsIqIM8IEBPyroPCD/9HgcVTrUDGDmY/RupjH" +
+		"VUwE12qjGSpfSaB7q3uQvYGZVzHYB2be+peg7cppqiuJOG+MFVoHqLcqyPhKaRCTDbL6KInCZIxV4h6ybXAEqjWDxCoPRK3W" +
+		"29vNQOGl1Tt3ak9jDHJs1ARD3xLjenMRmcuuDnJscCAaXIbW18/jh4uXi097VgqGajgx2B68g0Y1cpquz1do/btdfMZYhaip" +
+		"z+RWQdsHB6L1Tzmt7itEDZeh2oyHaoxxIFoXdxe9qCpnyZ0Cuj8LunXxaeRqJVdpMhhsD+5Egx/RuqicNBnVBq5V5ewJLq+c" +
+		"xH01xjgQVXj3URNA3vSJt/UEpX8uahAqE15Fow55Di1vpqy0d826h+fQ4DJUG3GPcDc4AlW22SPgOc4+tZ3ebq7i7FPblYu5" +
+		"U2Rp8jo8OH4966IGoZPj3lNRwsd/eHAK/5jLjR/67ZSnThsiWzWr9fRPnCqOQLW/fThbKI5A66JGxpviCLQu6iHWfNWsxgJ6" +
+		"1BlY3+WiD2d5xwNofT4vQoPiAbQuqpHBxdmndjtFzj6DX0/tdB78ega/ntprLibUtiLpITxyBnebKojnSkGx6fP9MfoZnG9G" +
+		"0+eZG68U+Jo1XyfWWqJ426gIgjea7s0Hp3ZijfPN4HVTu27TTCF8cGqnnwjTrlFFCBM6ArNr1nzdyjikOhi6KqjNw5F7Lj6S" +
+		"oV+5E0fOjMAweaUUifDvVsY8cgZXnNppghvldNO9uy1iC1fRok3o0REIHm+d2unkWviIBkJ/DInGD20nivegHv4Pxe5y0eNQ" +
+		"c5hvVYScEeYbvFsVUmcE7wa1ti4WFzUdZcCw8QtjjbPPGvV5XJ4UyQyTR6M75N6zLu4u6nX6vNDNI+Q0KLJ1UTnvRCr2drvB" +
+		"MMjAx9ZFfcnc0agJWSsPLjzr3zNgxwP1WamPi4pkDd0t2vrm7dbQPXEcTSjD6OgJ4Oj46YkD/e/1BO+g0S3oBh1Lp1fkHVRZ" +
+		"R6/U18Xi4uWi8JGmJfGjB7eg0c1ArKMHt6DBH2iJ3eqiilnlDYoX3kHrn89ved4t6LoF3TDwOAINjkB17F5kW8YRqA7aQo5A" +
+		"65/GssjgFrT+6ShU3J0KkXdQZUf9BPt5IvloEKv7blfHH6iO04scOPEOquPyUYEmu70aO+qVar9Ym89FLxqKZCk2DNFuKcYf" +
+		"qA66mW4pxudnTVxPj+Tzs/49hd9pyDvUI1ecdVHnszHiblNZRw/uNoNnzZr31IjWnS/NuuhxPMJuOO2WI1xqBreZddEntgfq" +
+		"1me7lTjvmcFtpu6bBrE+4y9TxZAd/GVGN3KEfhwjoefo0nezBe+Z9c/brTyGQcIZZl18ijRKMI9PewqZMnjBjKEn7/r80JOH" +
+		"E5xdn+coM4buvdOQD9170IrtliOjJuylVrKGHuaVYTG0m2w40Qz+MnV37MhfZv3zosdAdQy9jiNJZQE9OJIMDhqVFfBK5eLT" +
+		"IGJarJQGMfsezs44aKx/HxdvF5E+rWKFvRgcNNa/3cXDRRhLx9vHN48DjBIsh8Xlnig1lJSHxeWe8DRWsUifY7eK5aBRBc8Y" +
+		"HDTWv+fDHVXh7f924/2wjuRvsf49ve7w4XhYDM4U9SCXOFOM3ZR8WBDsTgd2i8uD/oy/xeBTUcW0WCnVNLSFthhcK9Y/d+6K" +
+		"ZKLlKVGF2RhcJNY/LzL3c5hY/zxOK7Y7O9vpDg9qg9143yntDzJkDyDWOpK98Nh/FFd1n+60juQ3UY9bnkitPCXWRW8HZeUi" +
+		"sS76HFSPPCWqUBoP/fW5+HTFU1fcg0t14HuytNjJEH4Ta9WrSPQou9n3JG24SAwuEmsprEjz+EUZei4OFxXJMvS0z9ktQ/fn" +
+		"VK8yDR6cKQb3iXrS7+4m751y5SRtjgT5ME+f9LuHefowT5+kDX+LwcNiXSwuNhcPFw8X7184kL+LzuP4W6x/8iSCDqOD20VF" +
+		"WB6Hk+WDBAMCHwcJdpBgp218QKcIp1XgiRHC6UGCnUM5YUFxS6swFCOkUojS+rCR/72eOIg8Hh31fOA840hgFKd6p2O5I/FR" +
+		"jNtTLz+M24M25jzVwVKaQ0g9zamhnx6E40khfLAWOWwfT+OBe8jgHlJPm60DM/oweZ/Gw2HRfVDRXNatB0XrYSV+vTSWlTj3" +
+		"kMpCeKV8FWoYFsKDs8j693TTywr3IAv4jFQWwoPPyOAeUlkID34h49jlSV5zDxkHM5Hrk8cBg0kNZsODz8j690iNy8g5nP8d" +
+		"zv+YDa+UPC0dLovZg9Q47D4vS9SD9vWgsLksUTmgDJ4nVTiLcQQBbeVxpeuH9kyUMBteKeOBKGE2PA6ihONKvZpP7FDwYBlw" +
+		"keyHNcpBvjAwXikvsnC5uroHq8yG4MrICUGZmvdiVHE42jisZi4L1oPQ4URTr13LW83wpanXoUYkEV+aeh2KRBIdNsSXTeHB" +
+		"2oCDzbqomnbJh23E5YjwsI3gg7MuPp2BD844SaLLkDlJIo459WIedDJn4Z9ThZ4YHHMGx5x6mS045qx/8qQ/45gzTjvv6zHB" +
+		"G9x0Bsecej1+rSslTzO6uBKDY87gg1OnieGkguKKsy5WFw8Xn0Zm7Dx45AyuOOuiurcw4t8uHi4Cudu4M4seHHMGanGdjmB4" +
+		"5AyuOBXi+UHMPzR5eRoy5w8/v7voRU4YT5YB0yo2ROOTZJiMP07b8ZNkwH0ePHIGH5zKAnqc9ujnrkGqIhECPGvWRXcSAqe5" +
+		"X8iKlQK/p20Cih78ZdY/TUc7wntmcI1ZF3UGC4LT3D/NFtxf1r/posKH+G/A4oSP04A9HTvO3dsdrPCQqZMe5UwYADsNptYr" +
+		"pS85i5yWTecV2L4XWTZxqVn/5Gk/dtq486Wp01rqtHQ4HcHMDJmpRgbsZJzEiWZwoqnTacuJUn7ak0x6FC41gxNNxSwfl2HI" +
+		"iaZOa6nLMORLsy4WF7uLvvudx6eLzze6jY7LMLzsXm5H7ZfdC3ebdbG5WFwcLsrTguBy0H+/k+czNi8KsNu5DOebwd2mstRe" +
+		"KY9T+t72D5fBdRlcNz3fRSvGwaYCrq/U4eJ08emfl8HF3ab+2cH/l/UEV5z1r3igy0VxbbJvFq2XTTbnm8oIfPC6Wf+aiwph" +
+		"nr6Mxpux3mU0AoRXluHjcvbJFafextjl7PMyT9/mqsua/aIcYxk+Lmt2/jkVOHnwzxn8c9ZFn4rGjCtOvc1AF3vMyzEny/CV" +
+		"UndDlGX4Sj0dmvPNuqiVHO1fZsrbUuwyU15myvtQTTPlZYzdTlu48IzL/HcbTpfd/GXksAwfl6mOQ09lGT749QyePJW99+Ct" +
+		"M5DWqxBfK4Udz3byNnKmeWU+E0PDSB4zFP1H6jexqFdKyIFHsjdhp8cMj/7pfO318G0ebv4DzB8uHi5i8T8nBy3G1bNfP5j+" +
+		"c/Gp5nQ6wLOmiSk9eNaMKUA8HPLgGjOmSO0vvW4mIozgmjGE5gUz+L20lyUOh5cxBZmLIfS0LZtizeEZj+m7T2HlXkzwJtE8" +
+		"RZB76Uu8Z8YU0vJlRzvFtJjixL1YhMwrIQAUyXHenPUXCeDvor40EyRCcLXXkTvPX+yGv4unOxMWQijF1+PwMrjGDK4x7WWx" +
+		"zjVm/VMkdnl8YsZdtLwectMl3EWNiNGbHLyLx21Jb3aO97McaSDH47Yc4S+zLiqS5Qi3mfZ2SHfrdfcj8loMk28ij4tLe7ND" +
+		"um1UOLy0N60Yh5f1LxfdSW1wP0uH9maocRNWvFlaDJNvIdq4sbR3yUVFepb8LZbF/FcGx5UmbttKefuhmqxBb9KGG8u6qEZO" +
+		"bO9Dnk3TJVTRqZwkGI+VwWOlvfU6HivjFr9VhLdxW+vfQrW+Rx6Xp5iGb73u1j9vwVLfFGD8V9a/00XVtILno9Jirfz/FnY3" +
+		"ydbzSlaA+4yiZsDe9rYlNfXbgzlUFJcIGkBFAfNHbz76CKBxb+OEjjO2LVn/Xplaa4ENFrXAr421oyrFIZNEIK44a1KcEklf" +
+		"/XMd4RDint9QIaiOh9TPzy/DsVKd76ifnzcKgLY6ylE/uuI3Tqzsq4fRM6dnPn55el102uq4RnUkI4lrrk5m1I++dMUyXx2R" +
+		"qI5IpCu6YnVEojr4kBAZVwcfqoMPibjnvvoyRk++on/WT6yN1cmFdP3kXtxurqPVWR1gqB+SpDiL95XC659XHCTZV5mxMCp8" +
+		"9Uz983r9sil8rIbbqEKif+7EM5MiNc9snpnO7Zoj5tZtfP/BfqJ+onvvZLlBDXbV2lVrVi9dyfRyMc/VMYjq/EO6ipJ1TWUa" +
+		"vYqSDdWqm17VL6Ob7sQvm94zvRjlyaupgqmjEMK9utyXIlG5vbpWWW4nYYu6eF95TR36ik+9Kpa/Esu4ran7ajL+ye/6xHdV" +
+		"/UYbVfH6lzW1ks6oNDPuT/iSK+mMShfjdoZqX3XG4Zcvo4yi5m9nqOq3uz1WvOuKabSSudhJC2NERO4rz4zRfwmAr+L86zd2" +
+		"/tdHhxbnv5M/T1lryiiqp9KbuExPVfz1TmoY9TMiBvWKkl2iLOsVBayCj69fTKNVDHIVYHz9XrdPv4zWvK+LcXnmyd0vRZFW" +
+		"qud7Wvoyxu3CHZdzmFUIXqUqen/i0GMVw1MFsNxOKlXifJWG3ecujBrujQH0ueMLrAozqMIFPsKb6zv8cvhl98vYFlZsoIsW" +
+		"477yy9glLOeE99WP8WFcjC9jYhyMmbGF0RuJIajIRJeP7CqioAol2MbEKKN1Mopy4pbcyZ/8diV9GCMjfuZtjK5IHKwS8fr8" +
+		"br8cfrn8MjaClY+28tF+rtjvVi7XynV6OW5T+Uwrn+l1vccY5eSLvJ7YHVa+yMrDeD2xvas8jDVnv9TrcvbL2KJej4klxzy/" +
+		"k8j9CQdlzbFhrdyO1xO77YqNcCePERc1n/VkNHb3VwfjUKu8Zfcnvv/2VWWMoY1Fu6Kj20l07ztQ4sq9VvnV7nu4PdDlyjF2" +
+		"f4LDrZYAmStaufsTyFAtsd2qJT5C7085xnh33rJF17OWJKOk3SMOaV+5PepzAVcq31nFNbcoJVVcczvJjFHJJZazWqLXXY9Z" +
+		"kUes1p/pN/amlUes8oGlSyXjKqs8YsmZkcojVlGWpSu++yv/WOURS5d35wrbSTzzjgCyfeWZgeRvY2PsjIsxBldVyTWqNd1m" +
+		"7xq1W3GcbeP55csYa+odYnGVQ61yoSWnS/aVjOK7P2F5r/jPKuKzdJtpa1JL0Ubb6DW1UU1yv1WINqp2M7dNRtVGOM62sTAq" +
+		"fHa7TQZ/XOWBS7dNRjUQqj00Ovlai/q0m7kDsd5XsZjywKXbPM+tU/llktMl+yrenV9mG/+Rv6Py2VTkWOm2S+CsqcixtrEz" +
+		"JkYtHZ9llQen8tKkuyhEQDSV1ly641utctbUdlrawCM5V9tpaZuMpqX5bNKZ7tt7nqkBqtu1X0sKH191leRc5azZxszojZLC" +
+		"t3N7ZfRMqwW/TOWX2cbG6Jm+vDHVV76XipMr3fYoyLhqO41qf42Mq6LfSrcxxttS+VeSEyu1Fe13WjqiIiqEvALDtzEzZsbo" +
+		"Uj/DHmdVhYlvY2KMrt+jpbcx3ghnVYV7J8dYKkKqCunexqglTFQVBVX6GXgA751Ehfxut8cwrJDubZR7jLgK1N7GmDVg2xV5" +
+		"VHJipQKuK7m9bZyMnlkY7Ty6LX+PGkyOnFQYdQVHp19SJN8BKKHSL6LtKqhzJ4pkD027r4I60y900Cuos45Tyfnc3hg7Y2eM" +
+		"jIbR8bNxAWBWDEHJ4ZR9FTU/TDrOoVSwZB2nQpoiqRDEPenX5a5CMPYkp0sqfLCO8+7h76hYeeo47x6oQ53x0hVmlX760rQG" +
+		"TG90lpAZnahOH4WPhRastZPotI899HzOLyfjYIx2x1qTHvvIabxP491Bkn3VGOUex0P2VRQeOJYeG6ypliYkw/GQOpNnmq8f" +
+		"szA0rSK9SWfjgvumHlDtebyRSkZsk/Dr12njAltLDn3Uab5GZbON0UbTfD2L3JNnRm/didvDEV7R3NSpjZ6sSNoINJcevQ6w" +
+		"VA+wdPZSgKWdyEivAyxVLCuJMHZFtlLhS+mJ06oVzFThS+lpx/gyendfbksTY2dJSPcrdpadKLxZcT0y0r3x61eAVF2nicOv" +
+		"XQFSFY9LemxH8LhUPC7pGTIyzy8Q6TP9MnrBTrxRhHNWeFZF55IeexSsLhWdS3rgEyuc8nWdzmDjstLJKDoDev66dAaQ1zaq" +
+		"eZM/jpdtdHv2Rsbma/5cWS2BytDz1wW0OFDZ6xNx2dpCzBLS/X3lmZaJ16S6LBOryMi2fhXtHqvFNqpkfWnpS28MmQYE2snL" +
+		"+DAmRrfHTNuwezQMHukNzKOBhBoGj/QnUP/v7yf2L7MbVEF85jaoUfvoKG/4//bVxejFYlPTYEgNeJTewJPbJ/pEQ+SRHA9p" +
+		"n+R2c8Gb/TIprrngzTKKHrIT75DP7YUx+gTq/obPo32SeimqIMn9dJSA+xogq2Hw2EYZZe8Os3qruo7m34lqrXIvymkucGak" +
+		"waza57RfRJk0mqwN50PC/N9IszZkD+mNMdaQPexE1cUY21c9jPaDtNb31ZfR7eHF2FeNUZFiODXUDw3nQ3KQpNFrbTgfUooo" +
+		"k0avtX0hz+nj9leRtJGDJO2rjbBDpPT1Run8MgqfYpPRkES0r5ondt9IujZcEYnKfSPi2oi4buNgdHvsyJLjIQ2BRKPXmvD5" +
+		"76vM6JePN4rv/kZfNPNJNdQIjabn/uVgfBk9M9DsRuGzYTPYRr80nNAYpBQLQ0Nj0K7YSqcU39MNjUEj5plSuEsa/oKGuCAl" +
+		"PfkyjnAUpBQf2Q05QUNOkKiyN6wE7TJjpqpISe5mTGdGGlaCdp2ab3JX8yQ6U9I/sQu069RnrAENkcBOYnCloepi/duJNwoa" +
+		"g3br3o6JpxQgc7t1b4fGU4qYjObQeHNMPKU4y91uc5ZD4wm//r76MT6MlfFlzIyT0e32uzn2KPtKkXTvrNdRsmwkLLfR7a9y" +
+		"msEQ5DfnypsD5dv4MMrIbiabmh0h34ln6oq3mr8NhPx4o5iQmpPk2+iXpiAHylOOmPxG5XIng1E5s9wtNvlVyUWFWFecQ9lX" +
+		"crdlzOEFbk4pN6eUU449SqOS2JxSTgjyG5XERiVxGxdjYfRG5dw+GL1RkVE0YyOBmLIRRwmx0T5M2VT5s6BQO0y5yV3D/U7D" +
+		"NblrONqHCWt+c2K6/ew8sOY3goiN9mHKEVSxr2R0Gi6QoX11McpouD3J6LTRUCRt5Px0cjilkTlshA1TDpbb5mx1I2yY8lQh" +
+		"ZjAyh3u4e3cjzqHqbVRLVpnfaeI4Aducpm7OTyfHWJpj1M0Z6eQYS3PUtjlVu42D8WWMAVtir9+cnN3Jj3Exxrs/9vrOtjTa" +
+		"ec2Z1+TESntUssOuqfz58Pv7+wkHYRvRvFQi4ro5AdscfU3lUTKLt6OvycGV9hgPZPJSMTU77Noeg6TYeTje2hxoTSU+Cptz" +
+		"rc2h1VTCsbKv1MupVlOzs6vNadWEZr85rdqeU9cB2jdHLpvTldt4M0aRXpuvEhv5Rnut0VBLxXh4zVkORKYSmEdzBHIn0ctL" +
+		"P8bMqJxxers5Cdne0wDma8JqjaJaKkORos83xyNT0ctfc9Z7msPOw0nIRl8tFZuMV8075pgI1jenHXei4UziDj229zTHUiTN" +
+		"4exjqh9vpJe/0Srb6I00Bym1bVyMjTE6Qw1Cjn01GCej+iwy0kbVdoRMV3OcL2HUb8mUl7RRjZiMRnWr0dna6+wxFkYZBVrY" +
+		"SGq1ZDzgyW/JDilZvKudR7JaOHqXqp7s6F1z9C5VPZnu1U6iiWtSzphDduJ2PTkVb6QnV9tlR+8awatUAyFvjt7tRH3qn0l9" +
+		"Oo+3jed2b3SqLjw4jceh8Ths4814M8o9HOH76mWUUZx+ak5+tXzqU/em4dSydbraeTie1YgwpWq+JsLUiDDtHU5ijHZ3BCtV" +
+		"U7OTWDvRmlPh7ZAcs0rI7Bu9pZZPcyy5aw56S6nao9Bbavm0kVmYE6RxgiRnW5pjVo2/YxsVXhtlU1CL8KDGCdKcvdrGl9Ez" +
+		"TUHte25XTm3UdFqekZ18GRfjzeiZOi13SSPNk5yCaVwijQpPcralOWrUHDVKzSajxCzVOEFSC+944wRpxRTU7DycMdqJ29P5" +
+		"ZfQlR41SCwakxl3SuEtSszPmLmkUelIz/RafCnwoydGYxofSSsxEqRW3W32LL7cWx5caj0orp43Kub0xqk89uWi4chpOpy3m" +
+		"peIbr8WRvVaKqjPimo1LMeLKaaOIld5XnmkcNXsUrpudqPkA/xrRmXY8OEj3G9dN455JSPeb40vtOGtaBH626sOPzya14Bpo" +
+		"ji81MjPJcZt9lRg1nIFQtTtHTmrzPHMwyt0OnpOnHbdOW55pQ3A8OM3Gheum8cQkx20ah0wjSJMct2l0adrxy3R7FH6ZVi09" +
+		"/fuP9DuaE1XtOHL614vpKFw321gZ1bU+0X0c8Nk0YjUJ83+jWdOq0ejMz77yYj53HO9pfDY78cs4ydOa9juujQ6NcQClHS9G" +
+		"D1yx8WK047DoyS/jA6A5HpKcpWnUQ9pxWHSTeFP447DoJnFaIo3fIvVwwTQnSJpTItv4Msrdp16P8ObGi7ETGYE3nCBpzowk" +
+		"h2ganZF2XBvdnp1rYydfxsV4McrdeODvaJwZqdu4iPNvx7WBe79xbTTB/9s4GH+MWtN0z9/Rjr+j24nzdzQejtRN9xwdjYcj" +
+		"9XV+eW5XpCDkb/wdjWZGGjp0t0M6/o4RLpjG39FIYKTxVST4hCj8NAAh3RpwnCAO0TROkMbtkRyiaSL02/F3DOgIf0cbPjRH" +
+		"+GX2VWKUu6mZx6Edl8HwUSjotR3vwNBteAfaVMkjznY2voJ2APahMwDY2wk7dWqjgbh3Ek08rJSw7XYgWScsGiy2HfR1hneg" +
+		"QV/bMjZn+I8a9u22ZDTje7rDP/vHTDRjrerC1zq4L804btOhfv3gfDMgvS42bSduD7/Mvhph1EbrZozR0YF4aYVvosPy+glD" +
+		"W7G969/o3h1/6zY2xsQYRRLT3aF3O/HM9/wynnkgPTHd/ft4pm2TmO7+jSl9J4WxMlZGGaVzu4zU0srK+bpdnxfTva8aY0wX" +
+		"q8goZu/+9ZW8inLG7N3BfUmg9756GF/Gc7t3jzU8Qdo6TtiduL2pkOx2GyyB3h341w/4t7qMsjcyra34xO+IYvvXOFrdGxW5" +
+		"2wSvcX7pmac14zunwwf38qDmY6XsWFI7etS0YqXs8MEOGEwrHL79ilm/o0BNK7aM/Ypm7ChQt3Ew/hgDevx8PDOgqw4m3MbJ" +
+		"2BmDnPzzlVGsxR1amMXNdDBhv6hIfGJi6ZeBgPQ0iyjfV55JOO4TK1eHJHb8pvkTnzT7yjMJx6Hr7lDGDmXMn5/Ca2K4Ysbh" +
+		"va9kFCMuf8If16+sPvMxKlI0Y8damj8GwmXEXfTcPhEA0W/NgZoyiz3v4MWdNMbB2Bm9ZuBS/TaOQIj5U/9RPGYHKO5EDcYu" +
+		"r+Or7Igq8ydCEDqwsd+knHBjd2Bjv08D6JGww52EIsJnHONgVC8632043aeu49N5XyXGoLYXLt5RU3a44jbejKqACshXP7tj" +
+		"xO0knilcfF95JmWY71eRil9ShvleqrWcX8YbfW8ZFW9EGearS8EVOwgxI7fuiA53UhkHY2eMd8djva+iTwAN81dH+Rk5PyPn" +
+		"G+EzHaC4k85YGSejIuk9+BU7JDF/TaMgxI5YMX+tFtDCDi3MYsg70sUOCszf4pnRODtRpOLdk9ckooXcel8NRs8MmvaOibGj" +
+		"WczfKqPsjUitfJtfFrmfNooYl32lQk4b9fNLRaKZ+NUVAYMdMLiNUfOAwf6cmo9viw4R7A/d8e9kNHKe0xwRQ94Bgx1t3jZO" +
+		"xsqo8IFqdthhf+LjIAsX77DDji9vG91uekKNl6+v2006j0q+LkVK55fRva/YOvTHuvLQsxEu3nHidTBhvn6emeVOy/X6nduj" +
+		"3Z9YSfL1uF0lI7Xbxsbodn3+isiVDvzr+NaycPGOb20n0RyXXodobSdyDxSn41vbSXSbK59nRk9Gu5YFeu+rH6MixQfAvsqM" +
+		"6rPI3RICZcyXdRoJW0fCli/TGsyxv7FoZIHeHQlbh0Bu42JMjN6on2d6I5JFQsI7ILJDIPNloYU57kRzWFNRsnXQ4zZ2xsoY" +
+		"09q1ZKTd39PuOthrXXkJxl/L7dkzY3bLGK87dHIn8Uzk1h06uZPJmBljILzG0W2hRcnW4ZH5DubHjh9sJ1Gf2Kk7lLFDGfMd" +
+		"XqEOZey4w/JtTU12h8kCdgcKvq+iSMkwvC2fSbsnmshCBfdVY+yMivS43Vx3J0WyIUjkZe84atuRjXVYZb6z3KMZd+KZdodw" +
+		"zI6ILN/WVERkO7kY5W7lShrurt5dwwE+syC//SEVM0PScHdTThvBdBpOBwOD7sS7B2TSk40gdrJtHIzR6xJVqnuoJaMYKLqN" +
+		"ymkUp9Oall1oaIeG5jsc6B07WYeGZrTUHRra82n3+Y/0wDr+rA4bzQIAO+KsLm57GytjZVSD1v5sahbFnQUAdlHcHdfVLsrF" +
+		"6HZ94hcESvsq2g/X1TZ6pqkZ0LrL55fWP5hq/t2eqaWPvv3PMg9T7Ue1/qfr46zqMNX8s0mEqXbwaf7p5SLKu6jx/PMJJVy8" +
+		"g0+3MRoVfNohpfkXoScdUtrxUmWhgp14fT+q9QIAO6S0I2XKv/B9dqRMXWB5/ln7RZT3o1D+0/VBpP0olGOn3lcvo5ovnqnm" +
+		"RY3nnwkXbtoBpvkXHAwdUtrRMOWfWbgYY8XOQ6hgF2beAab5ZzwUX2AA0210u90h+qb86+f2wqiWAlzpENYOWs0/az9MtUNR" +
+		"tzEzek2j8RfgSqeI3o/quZjEDj7t5TTcVCFF4YsmXm4vcjfGfuv80u0aTqBiL1ORKBZfgaV3AfD9CHI/9rti3buw9m0cjPFM" +
+		"SGkW0tghpR3f0zZmxpvxZWyM0cHwPWWE2V1YewdubqOMDJmjvf0YCIDPfrS3H9M9qLMDKbdRRmr+yGzjse7UtvtR1H6CZqrj" +
+		"guqQy4zcukMuO6wyP8Ht03FBdexP+QmnUiel3UlpZyGNnZR2F5een6SSNcdR1H5gCTDOfiScH1gCnqEO+MxCGjvgs2MI2rNT" +
+		"tKbY844FKCO37uLLO96f/IANxJfvRBvZGYsv70emGeN1xwLUhYtncY4dqU/H35MfQ0aAeMfYk59+fjkY5T48037iaDc/YAMg" +
+		"bD8yzc9QeJUMU83PVHiTVTtVByGAhvZ+qi5Qxg4N7dDQ/Ni4dPMSRpT8GghiwTuoMwtp7FhPOp6T/Jr8yRH3oyD8BgregZsd" +
+		"xcheAhRJhXQzg0jDjnekgzO3MV4TnNmP2q/ww070tx+139fsDfHseEfya+MC8exH7Rc7dYd4doHe+dWTIZ4dxplfUBkN2C6m" +
+		"O79QMWQWO1FLvvEEc3fcFPk1pQ/j/ai+vuE/2leJMTrDG+ekOv6JflRfsUV3Md07iVEs8m9fPYwv42D0zFPzTZHsjAcNwzdI" +
+		"DTq12H6kYF/zvKjxjr4i/zkD9w/2E0DfPk6jhpe0D41KXja/QyFspYeu/w7voJcP0734wY7MopOXze88v/QOp/l1aGKz/ajM" +
+		"CirsaC/6UZkVVNiHlkZtkRFRd2KzHdHFNqoCmy/R7VlQYZ9aehokCT4xwT5i3XOKaPMu1r1Ds7Ogwg7N3olnXuf22CVMHQVl" +
+		"9b76Ml6MD6Pc9Z4U0kqdiGtHC7GNnTEzysjXp1D5Pi0hKKs78dcuVH4bFclOHKtExmPdacD2aV1J1gAcE138fE6AEPHznTBs" +
+		"xmPdRcX3IwWbkl8azEf1NQW/2776Mb6MiuQrGSlFFqjYBc53EfM5RbRBR0rRjxRsMsYowvYjBZt8MYim7yLmcyrq0wrEj5BT" +
+		"OKo6P0IXKr+ND+PN6JkRbdAxMvR1OkM7t1dG7x5xjl38fD8qpcnAw92wE4X3FSLEvgujzylIrrpo+r7s+RJwRTR9X6fdDUOE" +
+		"Dn2dJrbBEirfkTbkNPxSEwuOz8kGa/l0XqeJfYWIke/oHbZR4X1scaxkUZZdrHtfp4kNQ26WnUSnzdaVpYmPnmk2NgW5dxHs" +
+		"2ygj4J/w9JytK8uAFZe+jX/eaPDLDDwEOQdgM4Sgjw8MKQd2OISgD3QEGQn3IDA5RJtv42AsjNFtRFkOOpND7HnO4ZcZYs8H" +
+		"ZcltnIyZMWo+x7I0RKIPepE5P3JPbjcL59czk9t95+Sk8Enual6U5RA8PrAg5ByfH/vKa9rJ5eyZ2Wue5ohxtK+8JvHlnBU+" +
+		"Gmd8zLS5yL3IyODKEc8+OKoG3ccs9HJ8itc0uHKMo/GNNXUIQd/GyfhjVPOxgA2x54O/ahszYxSJo2obIyOx54O/KiMLH7QI" +
+		"BxHCbUyMD6OMYis2+LLG9zRx4OVDzPoQrJ7FYw4x64NLK4vHHGLWh2D1nGM5G/xcO1HJ0+06w9dXliDNwc81vr6yxGMOfq7B" +
+		"z7WNMor1dtA+3J+pMkoKrzOUj3fPKkS7lxhHg/bh4NLKQi8Hl9YQwb6NMY4oIe4kXrNcmqN4TXhkubxR8UYm1XKd288zFen+" +
+		"R/uJIQB+iG7fN0RL82ztJIZ9RHX+uYoudRxOJTDVweE0jsOpBCg6rpjdBvG7jB58XAYJd1BG172v3H4qK0KRB2G8cfxAJTCr" +
+		"QaJscLrsr/8o0h2TwLhPkSLOcQj0HkK7t9HtUcBxXDAlXDCD72WI4s5l+qW5gALYNnbGh1EDRAzB4JAZ92n+8P8NUdyD02Ub" +
+		"G6PbfcDVYFYY9MCG2OxtjJq/q3LWg83EMzlWxnFt1EBxBtfGEPqc8VgPPo1xPA41zs4NQctD2HCuj1/GlD6OdwC99OAd2Em8" +
+		"JibpfZUZ5R6ekSFseG+oZRTL/ADFj+c801wAIR+EQnIN58KAUQ8AdBZDN4iB7A31ZIwKIcAxoL17dnJ7fCUPMZ7bGA2HQGIQ" +
+		"qMjfQNoGnHaI1czNakGWYcBGc9M/QaTjYJUtzncMQZoDaJibrggDHPju94yXGQtjdLBuyGTrHxAv99gIDtT2O0mMgzEzRi31" +
+		"8IgNWN4QGJl7fEINWN5O5B5fS/tK7raMPQ5kDVjeOOhdD46QAb0bqOZzt1bB8gYQL/fsmTF8x4H0ugUM4/wQA5lx+o5sATuQ" +
+		"HvregQ9iHExOcNLIQ0bBnfJcOi2gbpSTkWUJLjWE4OUewNIQgjcOXCQWZ0CGBthnG+N26M8A++QRxMn76mGMmh9BtzCExA2x" +
+		"cNt4bm+MUSEjndujQtAYZME0A43BEASXR6AjA43BABBlTK8DjcEAEG1jtJHIuIGjII/idiuQ0LY81GfVHAdDGtW7W2wOCDTC" +
+		"wzhQgI9qXUHqOqol5CBDo7vdEnKQoWGdBgKNAwKhah1YwkcNVqXcqmearNCD59bkXr0mDXvBjwNZ+E6iK7bzzKY5glwpt6E+" +
+		"myIFq9I2LkbNETQ+uU2/DM6jgRc896826nKnTN8ttHWoz2CX20bPHDIKzpzc47txIATfiWdeOsPwzGD62eNd4YdaGkZcfDcO" +
+		"GNnAxZ2HN0LCPZBw52GPgoR7HPxszHN7Y9Tu+nyz60LQnYelByX3OEjbCF/BgLQNFA5ZuNVAwj0Abhmh7YC77WQxysisCHDL" +
+		"8+t2iynuhm38R/qiA9/2QO+Q53WeIj+9fJrEWyAZA0KXp2rFtz3wbWdktwN6N8B2GQ/PQK09MDlkZLf7SnFNTzNcvEPQ5ADb" +
+		"5RlHsIb4yCGycRuj+XE3DKQNe+OYGDWALdV8/bJ5oyCPeq4qIx0FpfOT9B5w306i+Wd8eQ/UD0MMZJ42Q+C+gfMhT1OJ+Mhx" +
+		"MMAZQOuAAe4kRiNa3AER3IlymjWEQo5+2i8YCwYi452ozyp3bSTcMc/4nh74jHei8BG0NQCK46CFYugGtHCgd8jIbgfscBxk" +
+		"b9rd966cndO6uz14mwYC3Xwbt7hyd8JXZ/0T/DhQMeRp5AzzteDHjK12CH4cgh/z0uuGiQxZar4t88hSd8L15jVHzCE7Wfxx" +
+		"UfOYSXfCg2NDIKByHLxn6YqgnXGgnWW+Rhg6UCNs448xMjrQztKXQDsDC0JePsumIYMMISNLHahFB2qELIZuAIF2IqPw4g8g" +
+		"0AD75BV0bQNRwgD7ZOFyA94zDt6DsmxMX58H71nh1hnwnnHwHkFwA96zE+X0CTUtNtM2dJmzUCoMyFARGTegPwPeU4S2DcGk" +
+		"41Bvfq7zy8YY7JWfCI8dMJ1x+DjFuw18CePwcX7sPEA7A0NC+Wg4QM9AjbCNmTGmC9QI5RNA3b5STkIFn0dGZsxD0vkJFu2x" +
+		"oA6LGMEHwAD9GagRyud1uw0BhKd8XuXU7ofO88/59z9GrQnvKR/fTssECPYpHwCD6NohurZ87DzQGOzE7YF7DzQG4xB/fvRP" +
+		"NAYDcUH5VDVvVsRRUD7tPFMtURP46HWrqiWcxT+ddsVSPtB5PrzAAzvnTmJ3yOU6lnUaEeeDH2Ws6d3DRfhQ391XN+MvjPUY" +
+		"FSlY2x7uvAmsmp+f1uzHeDPqdRFtMD/R6yaChYIodmLg3IlOGzjfRL4wQV7beG5fYSSk8YkDGhMjw4SDlU8o8E1w2ISDbWNh" +
+		"lBHNDDyz+2ow6vMBWux13Rudrrjc/nom/SqBgxMqNsFaBd/aBGtNsFb5RtjphGdNsdJFjOAEa010nuV7efcid8pveGYnHs8p" +
+		"ZHobPbOq5GAVfMIX+Xf3ExP15kS9+TwBOk1smRNN5vNMrdLlF8vLg1Rk4sec+DGfJ3Da+Zl+GZx/D83SiaFyYqh8bDImxGyK" +
+		"8C7fCGuYELMpwrt8Yz8x4WcTcFa+ccZvX1VGdf2cZ3bGwbgYZxgRrH9jgpjwsynsu3zjG31fJUbPjJCxCRybXxzC34AQ5/dV" +
+		"eFzq36Kcmv/7yj2i4idUbEK+ikDFCQCbIryLQMUp0HtCvsq3yT15TUplyG6neO+dVEYZZRnRI/rGd8AEZE1h2OUbTtb5je+H" +
+		"iQ306UV9ds+ML/2HHsr8Ts/EqnvF/DLBWlNwdfkGBjhxMEzxyQWv7YRLTWhTueKs87xiepoXNmwI1ry623EIO/27r84vJ2NU" +
+		"8jX9Esn/xxi7FiMFjU98Pk6w1hQMXK7AJyZcat6nnOGwn4ClKXC3XNkvY9We9yl8RK7MW5+/jzqtCeIOyuN5H1HOwConWfad" +
+		"0EiNcLlJgX3eiNnfr9vjw2iSZd/GmF/uKfdJ9ef1RsszSYr0FVUHwZpCg8sVJwImdoEpcHcbo/BArvk77x57lAnBmsJcy2Vy" +
+		"dBp+QrDKrTV/8fm4k0IkJV6ThvZOHsIWjNE4OyFGoOYBYPMIM4sfnGJRJ6yr3LF1mLCuKRq0iOCbT3xo7sTMHh+F84kFbD7j" +
+		"bAi+YQzm1fnEpPOQD5ygsnm0ce9AcSZUbL4nd70OKjYdgC4C66Zz0PM9FWKtIgy7k1hTq66IOnXiTA2lsjC+jEdqTEZRPZO2" +
+		"aqEYN1GgTjKqpRYZLeUkUFHjS2MiRN0Jua16bh+MDuboDIC6KTCy3BGxNJNJVfhhuQPKncIPJ6CuIMqbgLp5xEN/H7cHk+ZE" +
+		"wJpm98vqmaigp1oiJbqTmEPm9Mugqd2Js0LTM5tn4oeesQmehEUnRdFEQWOmAC0mfdGETnemJiN0z8QyZjKt4YTdRkUKUvOd" +
+		"OC/zdbspiPZooosxscfOhEp/XXKPzj4pkW5jNAfkcoIly+8vY4y4Iz75070hgjOfStYVxfNNJ5jLL2JRp0i86bBysT/bnw/R" +
+		"xJkKwU9fyuqTQmT6afes6jKdGCLDk4zjpN/4ZvuJPN1OHDW/nmkcHTnGoK/4+/sJp6KnkL/yBCg6kcjOo8P4GA8CACdYsuB3" +
+		"m2DJCYEsYpRm0ScKBQ0Oi1limdgJnckvo3co3sEB6H31MlK9M8ZKTKo7IWwYe+GJE3aW5QssuX15Zoy/7KjYpLU4aS3mFRHC" +
+		"+8q7L191cgefTohnOTskR3vnkTJ84wtsgjMnHtZtbIyTkVK1LgXjnHDM8sYBxX2VGa0r4aOd1dYB1FlekyOoc4I6tzGmZuFy" +
+		"U4BceW2wULXOo5n4PgpvUSSWGKLiYVR4OgdvfPfPGt9AUxBcyIGHMSr5yCMiIpu1+mVVydnt+i59w/cxQZAWnJWS0DvUkuXz" +
+		"4IpDhyY0OKs4x/FTTj2kRg/J46dIOgOFwVzCdbqvOuNilLsJty6E7hEEPuGR86gBvgEX7auHkSB3wEUTLLkTgtx6HZByopQt" +
+		"bziYJwRyHo2/12AW5DePxt/bZOR75Wj8vYEST9DjbEcb3qYNpexsRxu+yV1XbEcb3rdae5XzaMP380vlPJ02gsAn5HIeGcDX" +
+		"9ASdnBDI0CT/Y0yeaRf7ToXX646O3xvewAlz3Mnzl8R4GF9GYuTLa2ZFOjLwFgZA5IRAFnxrUzThpOq3jX5ZvPtR1P7KyL7n" +
+		"iPulr4yKjPTkZLZxQnsecT9BVJMe3yTE9/avhrOutO7s3HS7Tku2Ln8/2t3Wlu5c/gbmMWnLTdpy+RvBpFPw44RxlmStEuA4" +
+		"QZ3b2BgbI1HqwE0n4HM6/F1SxI5MZ8AnybdtrIxRIY50b2O8puDHeaTbRFZNwOcEfG5jYozCH+m2ZA5Bbzvx2oZkexh/jO9f" +
+		"ku1hlLvJKr2KlN1O6VG41b7yS5/OKUDffSX30+629YImpxPhRWTVFCY5j0pbMmB78UanicPLNsmzzSPIhuRqXw1GQsnDL+3g" +
+		"Aa3vZbYhQ7YTPFG+WsGuU1hmKLeH8WJU+Dj0MWmMzXGa2MckcbF5VMVEa00hm3Ocqotw+Am1nQIV9+LrdiPu6GwlM60IxXl0" +
+		"tpIvDTy6E+hbBDzNETP0TpBxGR0DiDA63q0Iv5gj9mCT0lXKf7DDv7+foHQ1Rz8EbdF+wyAZxBnnUoN2zYOCRrL1o1G1E8x6" +
+		"cfxswpOnY/f7HaJDA2/nPC9m+Zxaela323TP2DLuJDrfL86PTXJNO7HLCwxwEm+aR7XJwYc5h4xob0PhJrmmSa5p76FuRs+k" +
+		"ivOr5/Zo6Ul7+wcMmFY1Gk7bKHf7niPQhA55X53bl+2kClF10wfHb3gjW5xJQPoHW5u2OJPY10/vmTaJ1J6KkwtTpOEEKxck" +
+		"evsqfgmELRjrphC8uY64e/fLgEXmOuLulhBstRM/bcnWfjS1c6X/o1D+x6g1jxZTDi/GXGaNA7SKUZqIYufBVEUeTWDqXFVz" +
+		"GA+rKlK4NsovAiD2ldzNBb+INt9X0fUpM5Xno/Dho50rdunbKPcmI7rOTlhMck1z2bM/EYI+6TTNRdwdafSueM8k2/r4NFld" +
+		"OfXPJ2gW91Vm9MxbkQwuck3FCYvdiqpO/3TCYl95JhnH53d+qTl8eT+PclrVjmrTY8CuoULorj6P23VasHJ5XuUMdHknnVHu" +
+		"Zswj5fTEvnwBmdfBk0uEsyx48kLfu42TMaRIDnhbvm6Pfc86kGyJjfUSabhEDBbsefsqhGUO+lrijdanHGP7S9w9jJORYHx4" +
+		"GPfnZihaUDzaHyh+GVPl+hyNuPChrE9zuyYmkrKIFK0PEd75k3v3mpp4xoGXRZZoJ7TsHoUf3kjDzdftpF2OVtET/rgFhF3g" +
+		"01JiXlpQ1AU+3baXMQRbvqfqAlxZ8Mh1UEZMd+sbPXknCbVavPt3eqaN9ScQwQVQXJfposRObuGDWAdQrDE2F0BxHUCxqror" +
+		"nCA7MYfE9Luv3G7AZm90BT6xE3NILLSLItBOrOix0K4rMJ2dDEbl7Ix2cu/yS29EAyjbeSzQ47r5JqrmQLK6sKuWmv0yNlgL" +
+		"NUIR7LWQISwheAUF3EKGsLCrhjZ8GDMjLfPmme+5/ei434xRTgF52xitKSBvCcHbRrcnRQJa1KFIyTNPc2hiuOlCq1rqcHvs" +
+		"JxZOhFKncmbPhFgji1uYVxdOhIIsbuFEWNhVQ509jHK3BRfTtq9iyByAtv0Jtf67+4l1d8U9WNDPi8U42gmmrY/Komp00+4S" +
+		"krPu5ZfLL2Nd2Q+J4QRv3YUojImRrnN8Wyw0Det3NN7j22JfPYx04y+/jM6wMLYWcXkLz8LC2Fra7Zev248Qe2zk91VUK4KF" +
+		"bYxWwcO68LCWZnbDs7AQLJQWk/i+KozzLy36P0YtfQDhFvvW9TOcfvVQDnwZ5V4dUr8YzQW/5oT9KWeXEX21FVucBU9e8OTU" +
+		"YzezfkNGALfeGWPnsX52Ht9YlhaQeR08GTXegiev5xQ+girWY4J4TBBf4/ZpfklI8RuY6npind7Jcdb4pVn46SKPYo+yrwqj" +
+		"eLeuSOEW2MkJMoqu/8RSt57oZ/lM989UJCzaMwCbBbheoOoQOP9jtIAd4BoT3AJcr4NR9zhyud4o9XrtJ8jSrtdAENVZXpVM" +
+		"HWwnVJDPL9X8axvai4ziq3W9g7KyOeuNRX8n8y/16zDGakErLPSu/xgNrveoNZtbjzrYe9SaVTJofB3cuze5EwJ77U1xo+2r" +
+		"wtj/EroO47ldkQLJWMDwdSDuHkeNVooZbCdHTXww/hhJTUfk5sIpuhym38bC2BjnX3Lgf4zF7eWUczIWRvUZ+/LliPxOFD6+" +
+		"KZcD8ysdjfCh8LG7WAeK77HfXbTQVqpHz9sb6d4Q+dDlDqPcj5p4YP77qjPScdfB4PMLPl9SUSR9Ptl51ID3V7KfSPwd0wqU" +
+		"NHE6SrJZRlNGsVIW+torGcXJKF4mK1j6EqNbenjxl/P268DmAlQX2HyJhC0jQhCWoNd10OURzIELurwOuiyYdAFo10FDR3x6" +
+		"LWjoOsjlsICJ3FwHuRQAuBzfXaC5bYzbIW3rIG2c3YtO0zqg2jTTAs4W+sIyw1u9HLVd4vnKjJjZhdpwHfxsBrK+nKpd4vIK" +
+		"ZrYFOFsQs5A/DmNi7H/JH4cx6hNTYZlGMQBsidIrVN0X5GsdrGtGkMoCQa0DQRFwXyCoBXTaneFh7IwKHzye+yp6MtAphJL/" +
+		"GGNtXECnkEYOY2YkQGwU98czBRLNcHYv4XlLXF4IHv8xao5+msPGpVs3+/v+JX8cRoV/+19Kx//3fuK6///tBKhrdZsnKvAL" +
+		"e+E6kJVouSWAbyeEa+ObcIGsdkKK15dJz27PtHRtEpwIXs4Ab6MaML0Aqcq6vEI5v5R7nKXfV6pa8y07B5jTOqL1K1DzRcJp" +
+		"UWsqZNwXeGmNI6wcUPqi3bQOvISsb4GXliOvhWNkDRPmgZc4RpbjsMux1gLy31c/RoLFET6xqDWto9i+7M6BOMtB1v313hkJ" +
+		"BfpuqN+bkfyfvVcNmHbN31GijD5Ur/PLwbgY0//T+vvff/mv//yv/67/h3/+1//4z//1b//0L//r3/7tb//tf/6n//Iv//Of" +
+		"/v0f6z/9p7/95//y3/72b3/7H//9f/3bv/ztn/71v//rn7vi738DWN5bCA==",
+	"GBK-EUC-V": "" +
+		"eNpFT7sOwjAM3PsVZkCCIYTCF0BBUCEeojzmkrgoEk2iNBn69xQ3wGBbtu/O5+HgVLCFNA9k88kUztiY4ASybF/ahGf5KtfK" +
+		"Az85Iwr0UCktXcTAA59KJ+kMpBI+dpRFHclF23isc10ZmPcoGWxE8nNXGu9aGNH9MUisEn50Ep3STxhtlmmcFcHaF9aoPcxo" +
+		"glr2m4/NQ1kj8M1yx9bXjN3+i0trMRL4fW8kQto3X+wWQoNkthOk+tMTwbnuHnkmsQ9Tafw9bw2xKN72dWmP",
+	"GBpc-EUC-H": "" +
+		"eNpFT80OgjAMvvMU9WCihzmVJxA0yMGfiD4AbMUskW0Z24G3F8uCh7Zpv+9rvy4X94odpGmQpZstPLA3wQlk+aW2Cc/LY6mV" +
+		"B353RlTooVVausiBBt9KJ7s9SCV87CiLLoqroffYlbo1kE4sGWxk8sdYeu8GWNH9NUhsE35zEp3Sb1gV2S7OqmDtBzvUHrY0" +
+		"QS0n5GfzWncIvMisYKdXzs5/5DlYhP3UF1lEQ4/kb9xBdV4hgnPjCbJJ8p9QaZz/tYZUFF//Q2YH",
+	"GBpc-EUC-V": "" +
+		"eNpFT7sOwjAM3PsVZkCCIaSFL4CCoEMBUR4zJC6KRJMoTYb+PcWNyhBbZ9+dL9PJuWJraV7IVosULtia4ASyvHzahOfFttDK" +
+		"Az87Iyr0UCstXeTAC99KJ9kSpBI+IqqiieKqaz02ha4NrAaWDDYy+aVvrXcdzOj+HCTWCT85iU7pN8z2myzOqmDtBxvUHlKa" +
+		"oJbD5hfz+GwQ+H5jBdvdcnb/b66dRVgO+FEaiZANYCQfILRIcXtL6qOjCM71Fyk1uf2kSuP4fWtIRe8L8YtqnQ==",
+	"KSC-EUC-H": "" +
+		"eNpFT0luwzAMvPsVzKFAclCV5QWBWyBG0DaIkgc4Eh0IqBZQ0sG/r00L6UEkyFk4eltdlDia8EBxeN/CFVMopFG0X31sZNt9" +
+		"dN5mkBcKWmGGwXpDlQMPfFrf7PZgrM514qpdFasxZXSdHwIcFpYpsTLldWop0whrvr8Bg0Mjf8ggWf+E9TkQ9ru6ViXGX3To" +
+		"M2x5g94syJz0u3cI8qxa8XlvxekfuI0RYb/ME+ySuJ9mQknIIScX7i8TXYimI5yVHWat9fj6dAys4vcHRvloMA==",
+	"KSC-EUC-V": "" +
+		"eNpFT8uOwjAMvPcrzAEJDtlQ+ALUXWkrxC4iPM4lcVEkmkRucujfb3GzcIitsWfGk/nsoMTW+BuKzccKjtj7RBpFtW9CIav6" +
+		"s3Y2gjyQ1wojtNYZyhy44d26olyDsTpmxFV3WayGPmJXu9bDZmKZFDJTHsfWRxpgwfeXYLAt5C8ZJOvusNh5wqbMY5VCeGCH" +
+		"LsKKJ+jMtHkm/Wk6BLlTlfg6V+LyXpyGgLCe8HXvDUI5gX/uN6QeOe9oyP3lpxPReI9js9lTaR2+/h88q/j9AYt2axA=",
+	"KSCms-UHC-H": "" +
+		"eNqEvcvutbuR3jfXVWwPAjgDRe+BR6DRAFlFwg3DjmElF6CWdncERAdI6kHffbjq9ywbcIBPk/3t+i++PBaLxTo8/N/+w3/7" +
+		"9S/H7/70zz//8v0/rp/++89//dO//eW3P//S/stv/vyLX9k/+T/98fd/++lX/+0vf/rtr3/+20//8vs//u4vKvPTP//8r7//" +
+		"4y/u56ff/f63fxMV//3tH/Txr//9r3/7+Q//9Md/+dNPL6V+929/Vslf/ffzz1//9pd//+k/Rvv/+0+/+/lffvGr//Mvv/v5" +
+		"L7//47/+9B//85/+8vNvbv351//25z//vz//4ec//u2nO/7y8x9/xy+fnv7X3/zh559+9Z9/bX/46y//7/9kv/xP//On/+vf" +
+		"//zzT0/QDy3/9k+/+/mvf/7Nb3/+y2/++K8//+Ifrusff/qHdv3jL/6h3ek+/7/XXv/4aeB/KXlfFzX887/89v/5zV+i/Djl" +
+		"h90tvs41qGcFVd+gkkFRMuegugdVe1CDku0JakJ1apl8N2ZQloI6f/xQ3oLyEtTKQS3qXAWK784fP9SmhUWdOwW14zu/6Nme" +
+		"ULS+Lag7Zucp0WtPBWpBxdifFi14iRaeHi14XVDUyRw/Ti0eJd8Uv61rQBlUzNlbY3zrjRG9Lca+Et+1DJWgOtSEGlAbKvq5" +
+		"mPm3U0uOnr0jZmlVWl9PUJPvVvRzWZRMifZW9CyVD5+MfcVMpBZj2O8NZVBvULS3X2rpUctOMRM5UQvt5RSj3TagOlS0l+en" +
+		"znmlDuVQE2pBeVD2BpULVIKKNcr7CaotKEp2+rIHVNRZEt+NAZWDmhdUgYp1KJlarEBRC2tbYpYOFTNR+md882alS48R3XfM" +
+		"WRk3FL/FDpj3S52Lko2SjOFulGQMdzOomInP5jpUjR03b491r61Cxdhr7L957wHFdztqqfMjD+ZzF6gbKmawMaKnbajo2TMe" +
+		"KIcKjmyVkuzb1mPOHr+h+M4HFN95tN5GjO9ZL9QLVaF6UDt61mZQ703rO8b3Zkqymm+OmejBZ/MtBeqBalAv1IAqUDG+ztq+" +
+		"yKXO2r6NOpndd0DFDp+viTIoahkxny9SqrPSr9M6o32Zic5oX2aihwSb6YnfRg7uSR/5ciSzJPD/XyKP3CmX+GpARdtjxDqn" +
+		"KWpDRU+OjAtqZagKtaBoewf/D7gs3zHWmaJkfqLOSesZGTVpPSMvJxyRkTxzxozlETt6WsxRZk9Nj1nJSB6fMQ/1jXnwFXNU" +
+		"6wOVoGK0Dg9U9vdi1WvfUNGzyo5ehTotxr5Yvbr5bgQ/NvbiYs7OyROURZ2NnbmYpZajL4t9evYrFLXUmLPdotfNHqgEFb3e" +
+		"PXrW2Jk7To7ZFt+pL+zTrb6wT/eOVen5U7J/xN+HCj4+1IKyoFiHXj2oEr3urUBRS2tB0XqfCyrmpRstjARFLYOSTnuDkk57" +
+		"9LOvJyhWum/aY2eO9JEEHX3jUBXqgRpQL1TUeedofQQn97sGvwzGcCNdxuA7xjAmLbD7xuQ3dt+Y1Bl6wJxx1h8q+jnjrO8P" +
+		"PTuiDuqBGlCUfKPXD1JiBpcfKkHxHbv2bKSgkJ4zv1CxYjM0hv6wq+yKXr/IIYuz4lAVakFFnUY/3xx1Wuy/Qz1QDSrGZ28O" +
+		"qkDF3uwva2Qhzfo7o9fWqWVmKGox+jL5zqnF6AurabtCUeeOOhO99tARDtWgos5k/NYfKH7rCcqgPidHz4zBd8xLLiGz1hUl" +
+		"c4vv1h19+QiPDxV6wKH4LfSAQ3WoaF06wiodit9CovTssbYrZEgvodnMfcVcF3hph2Q4B0DM/P7s1B9I5M4ZdpYy6m+xi+yK" +
+		"U/JQEypaa5OS7L5mOSh2WAsZbBe7qHmHivEcofGh7idDLaiopYfuYje82oM/7GaH9RKt3+yp3q+g4mw/VPTz7hlqQFFn57sR" +
+		"s9IdKnTr3nfU8sCrfd9BwauDvjzsohE8fiiDqlALKkb7vPquQ8W+GXHyHoaPXo8eI3oq3/WYwafx3eC7Tgshh+yZfBca5aFi" +
+		"Bsei5KKWRQubWtaGihkcGl9I5D6DI+ytwZ2Tmec07xYS5FATKubzXVHSCr/tmGsPrdjSDn702A2W47w7VIeKMXicjJZD8z1U" +
+		"zFmGx511yJk6F7XA1R771DJc7ZuSofl2zslDRS0rU5JZWvQzzwpF68jSVWjBJhT95HRYhVpCA+qr0k/Og1X5bsUOWHGPORQl" +
+		"G7Xs4LoVeumZK9pjr5SHkoPfXvoZusyhYjVXaLCH4rs4s61kWmfOSmXsrHRplFy0ENp0X6xmGdHCZpbKiBY2+6jEeX6oFypB" +
+		"UScScjODhZnY7Jx6U2foiYeiTsZQX+pkDPWlztDCD8V3xm9wwWZ8lTNmW/SsohXs0Jasck7uVaHoC2Ov7PDN2GsPHtzs2xoy" +
+		"a1zs2xpyaaBbWI2VPhS9jvGNK+SltasH1aOWdlMLO7XdKSjkYHuoc0QLLfb7uBbfVWqh161RC3zdGrXA161TC3zdxo8l8kB7" +
+		"OEffZ1YORWuhkQ/sFNZCDx5oD9ZDmh3q0+frit1wqBrU4Lvx6dd7XS9U5zeH+tT5XLfq/PTyeR5RHx7YO/b3oTx+ExU329Pe" +
+		"DbWDcloPHriuLeqO78oFlYK66XWcI6fOBFWoUyU//TzimV5b9PN+JpRHzzQT9unLU0J7ONSGoha/GJEo+jL4LiTWwy3tUG/8" +
+		"dominxdjcPpZGK2XoLJKaq5VssV8vvQzzsIzdFYz9JNDqfWYa2TUoQxqQzkUs+QLSi1sKOpcMb486Flw56EYbdhMnjyZwdhv" +
+		"D/eYQyUouCDsU0+erG3c7p67q+QM7rlVy2L9BtRm/ahlM9e3KOb6ZbRb8wlHbubzYuyxUx7O3oGV61AdarHS0Rc0X+txZo+H" +
+		"s6kXfgud57ld1AOVoLS2fDfUlxcqw3UqWaK9uAkeKtb2Yeaf2EfXw2582Ecfk1lQA2pATagOZbSg75zfJhT7iPV7BvsorE7j" +
+		"CTl7StLryXwilZ7QKM9c893U+PQdsmAwotCYzz6iL3FjPUNXnRVeUi0NSr91eIKxh63z4ew9VKxRKWqB/VcyFPuv8F3IgkMx" +
+		"15IFnb4Y/GnMi8GfQyWjnxkefJAFeagkfRlqgX006Jmxj4ZacFrXd/RTsyQ5ca0fS+QHCVLilj0eJEhBfj0hQc560TZSAr30" +
+		"UNpF9DKkxKHoF1Li7voOSdfppaREga9cI1DrnxG8T2UEIQneRz1DEtw3dYYkeF9xLpLgfpjNkATnN1EpKHE1kuB+4AgkwTXU" +
+		"giRdjP1Fx/qo30F1qCj5xq3XunWo0CU6O/MNm9qhgndenWLshtc5cVaM4f3u/eCIFHdu66GlHipB6bcJFT37GJgONeKGOjL6" +
+		"0GBHYwW1YbFGJewNh+I3RjTYb4URDXqNFfTJSJCCdMmXfoNzwzJ+KCTrrZJI1tCjRhlwNXpGGey+W9+x+x5RcPyjknD8KwqO" +
+		"f1USjo/b+aE4OZBtZXBywPFlcHIgycvk5LgnFOO7KxTje1SS8b2iGN+j7xjfK4rxJVGMj7O3SLo89EXS5WFeJF1eta7xqSTj" +
+		"41Qpk/G9qpPxoa8UJE9+qRPJg73vUBqfKMaH9CySPJxpxbR+HUrrV6C0frRnjA8NrBjju6KfFS64mesaXPC+7PAaXHD2X6xm" +
+		"nfwWvoVDdagNZVB8JzkR9pRDbfYtVOz+UzJDaU9PKOrkpKqhWx/qgppQ9HOpPVEO1aEWJQ2K1tFCWuyO6/pSSEVawMZw4a8Y" +
+		"2BgujaHFTBzV4oFqUPvHEhlb+3WHne5Qg9YaVJy8d1aNOnnVtlNyQcXJi4XhUJs6KbmliToUGjPaEfb7C0vI6EiQuaL1rnlA" +
+		"fmFBv/CjjK55iJv7oTQPFSozcpXUrLxQFUotMEdJtWgmHih6tlVSM6FamAnfUMzEZgzfmVBfNBPBOx0d5A7v1ujfeREV/HiF" +
+		"hftQ3CQ4m2RPscehCpR+i1uaxR14jMJvYSkYg/uPoUsM7j9Gz2aGgpOw4JvRl8mt3uDAifXI2DfGyeHI7k9DH4rdbuEXPlSs" +
+		"rTHXCVlqaPYpB+WcMR429EPFmeZxVx+OpcDRQbzRAtqKc8I5O3PVOJs8bDSH4ruwmo/VYnwL2b2ZwcXe2Mzg4gTYNc6mFRb1" +
+		"Qw2oqGVjZ1pxrz5Ugoq+bPqyQguZF3atFTfpiX3dVljx5iW/d1gz5zXxX4ckn9fEf/3ot/DhWpxG8woZfKackiGDDzWhbiiH" +
+		"evC6U4vRXlhBD4UvPVOn4buvKomHPPzC83J61mnP6VmnpNOXQS2LWkLXndzVD0VJ+eCN1uWDN3038eRnKIOqULSwqGUzokXP" +
+		"NiNa1LJpfb9Q+O4ver3xumuuN777uDPOO3wntuPcOtSCipI3+sqOG8G80bJ24Tu0rB2a71QUg4ceMLEVDA+5dChiDG6VLFAG" +
+		"Fb3258dWi4mF4ZTrfMV4XtXIeOCPO/SMs30q1APFd0RXeNiHDkUv4Y8bDnT444YDvTCCSS/jjjEVlcHOnDdc5pWRw0ne6IvR" +
+		"666SREIM2jNWaNLPuCsMh1uwKQyHW7AbDHdad3rttAB3+qIFuNOXaiEqY6skvd70E85dcT+e3PEP5VAxg0s8sIiZeEVVqAGl" +
+		"WAt6ppiJxG+bWjTXcO7SXMO5+CcORfxGSOSpqBNkzaGoU3MN536MqId6kM87vDjzQUbtuJdN7uqnpCh6zapwdx4rbqGTu/Oh" +
+		"omfcbMdidrnZjrVoD55YEVUzudmOj6szKKJVtmphRFvfMUtbdTrRI/ouRrRv+oL82q+oB+qBeqFoIfTEQ20ook6Ya26QIcKD" +
+		"opam36ilUQu8tBv9jNPoCPQMRURKp9dOlMsURXuaQbhnG/OyaMFpAe7Zmk+k4NYswT1bs4TP+Lr0G3Etl74Lz9elWQor0KEo" +
+		"qUiWh1o28SlJVIUaUA2KOoPr5hWW40PRekRQTPkjrxwz+IrPFhT62A6Pz9QdVSN68YpdEXlxKOJomPl3EEfTVJK+hDX6UPSl" +
+		"31D0hZl/8d5hBz0Uv4Wl51D0k1WRr/Ji5l+8jGit88XLeCEnXrx+V1g3D0UEDLP7htSY92c+fySR5Qu9H9peROYk2iZO4WYV" +
+		"3kXsTxZF/VnfUUuhbXjgrvqNfmn+4IG7Me/fSKAJpUgg5p1Vx9pxKFrXbLLq99BvtG76bRIlRHubyCNmM4WX4xygBSpBRS1J" +
+		"8Uuh+R4qVv3mVE6s+h06+aEKVIein3tC0c/wHx8q+oI1c6ZvVFKFUlQSteD5xf58KGKGkBIJzzYW9UNFX56sOolKyvot+oIN" +
+		"/VDEKFX9tol7oj2jPTQgea+fsKkdil6H/jzly37QjpIR6TRpAZ57JrUQf/OwDokIGKySh2JEYSU5lGKpmDNiULCQHoq+LJWk" +
+		"dWR3gs8eTr8En73ImgSfvZrrsCPPF/0kwT0vc5bDn+VX2LsP9UAVKDiEnZlD73Zi4WZufEevc8Qk+hW2pJkVExU3kEMpPqtD" +
+		"KT6L7+Cst+g7Yr7QWTPc83IyZuIbXs67TKzTixaS4aWX9cvwy8t+yPDLy4pleALbwMyTmC+jdeJauONPRSG+6KwZfnmdMZgi" +
+		"wBiDKQLMoRgtUkmRCS/ai6IXX9Yvh5UkNmVQRIfd+o54rVslide6VZKIsEclDUp9CS5I7JUcOlds7aCICNO6xzk5E/pfDp3r" +
+		"UBmKvqCvZMWOIbOyYseaaqEvnbEjP9NQexNKJemn1sHp59m+P5TI2RXhxiq4ItxYhcXoOLHzYnToPBnZnbTOyO6klSXGLHE3" +
+		"yUuRcfqNESz4nz2Vwpd9KPoSkSszI7sTJ70iUbPWi5M3axUUX4dmX+pnbv2OGOBZ2EU3Ok9psfuwTMwSnhq/wwZ7KL5DmhG5" +
+		"ciiHIkov6zei9Fh1rJKx0YJ6oegLPI7vYmIJDCb9UHBn5qzFhncGbVDRXrlVckCpZKx6CevioQxK38W8lFffResl6btoHe/I" +
+		"LHAuPohDRa/LoJ9wbtGI4M6CLlHgzuL6jX5ufUc/t36jn1stRF/qRa/hpapeL8UrTihiEtEKCnpA1RjQA2rSdzH2qhWDl6rG" +
+		"By9VjW/TXn2hHij6El6/Wdl9BV2wonHViJfyO7yTh+pQ+m1BRa8rUreiMWBnPf9cUPQTKV/R1Gr4QA5FP8OaORU7XJnPCmdV" +
+		"5hOL7KE2VMxuQ9fFIjvbre82FD1DlrawVB+KuEo034q8bKxDhc8aVpIKnzXWocJnLaxOs8JnLax4s8JLjXWoSMHGOlR4qRV6" +
+		"jRRslVqQgo3zpyo2FP2hwmcN7Qwb82xDJennVHv0U6uCFGyaa+RZM/qJPGth8zoU/UR/qMizhgSryLMGl2O3PhR9WYwBza3C" +
+		"u20xIvizLdVJP7Waim/9aG4/kshYtE85tRYj6GgdlVtMRxJUuLpzTlV0kK7Vg6u7Vg+u7uybiq7bEyP4RsUyAvTZnlXSiIqN" +
+		"OhuyVPol1vXZ0TMUhd6Rlw0NtleVpHVWVjHpvQX/NzSLzlo2+L+zlg3Nooff+VDUiaWsEa3YWb0Gj+Ovi+WOaFpKctJ3dILG" +
+		"Sd9ZoQavDo0IHXKEH+VQ0d54VZKIWWa3wauDc6S5InRpHS4baAENLhvMZ4M/BvpXgz+GZol1H5oX1n2gjTXWdmiWWNuBVGqs" +
+		"30AO4RU4FOug2GFOnMbaDs5soh7nQDvqEZXkT2SdRIj0h2K0isCflygie+FIReBPLBP4Dw61oTLxwTdUgWpQFYrW0UTnoxY6" +
+		"VIci5hj9C6/AnJz1HZ6Y2O06t5OJ3a6jiU7suh1NdHJmd6TnzKrFiCumn1NRxi8U7aGpdSTrRDfrcN1kjTpa6kRj7mipkzO0" +
+		"w5Fz6Dtmaeg7xjBVkjGgj3V4d5rqZJZMJZklU52Mz1WS8WH96+gBUyuNHjA5Rzqy27S27AcLD/KhFEWtkoqiZpaQgvbQT6Sg" +
+		"acU4wfGyHEox1fSamHHT+iHBTCsGlxtaVofLDbtIh8tNMw8nW4NbFUUdMQ2TDA5/IpJsjsg58wepMcLP4U94NeeAP+2zRj+S" +
+		"yMr7MFZhILFkox9ILDNaUxw4mu+AHw0Nb8CPhlWL2NppW78ZlH7bRH4zHrjMI1LmUNEe+XWHeqDoC1zmj6gERZ1wmSd9R/x4" +
+		"0m/BSbJwD0WTF9XiUPQTbnG0I+W8eH+hFJPO2DmVHX1ocCrLij24mzjSbKAZOif2QF665oxTWXbrwans2NQGp7Jzug740dGV" +
+		"Bvzoml34cV0qSZz7Ra+RyAu9dKBRLs0uJ7Zs2sQxH2pDERGPXjrg3KX5hHMXVsKxFVfPasKri9md4T/zl/1GZpDLwjDDq3Ko" +
+		"aG9GvIi/nE0zsiQOFS1MLAwv6z7DV+kv1vaJ7F6s30R246E7lCL3LyjGztpOzt6FxJrw7nL9Fjt6caObSEjyHA+1if+P/Tbh" +
+		"rI0WMpFRmzmbcNbOjAgZtbtKUgscMuG6jd42uats128Jiu84s2U5npzZG01twq0brWAqO+BVSbIDsn4bUPSTKPErq2T4sy40" +
+		"oknc7aVeB7ceiu8W2QhYuWZwpF2DMWxyGjSi0McORQubnml8xFRf2Dc+DujP2iL3rMIFWM4svK8uO4yFZ8PfQclBP7Hd2qCf" +
+		"aESmbAvWzwb9jCjZief5UB0qenazj4xcgRtutUG+w6U6yXC4VdKgaJ2sAnI1D0XuBetgwT0WcQQ/ksgW56LJOmzE/8s6bEaN" +
+		"eH/MqBGNy5yMDTQui9PO7ka/nLwPzm8L3jmUvqO9Tp9duR0bSrkdNxRjHaqT1rUmi9bhaoN3bjQ1g3duThUjCv3mbLdFZomp" +
+		"FvrpKsnYl+pkTcQtxKvfWueQZnbj67Vv1gnfKetEawl3Erd5KHJQOPcN7iS6LxKXIgdlQi2oGJEnpBL2PU/wI3ZWFx9HpMeh" +
+		"Qgom+uLw6hNRGXFkRCZLgqIvSBCHH5+s38hIyfpNOS+qk0wWrG9OJsRT9RvZKnCIT2XH0PpUdswNRf4NHOKT/Bt2u0/6EjlY" +
+		"h1IeDTOhzBlkqZN98KDhOdkHDxY3J4PpYTXd6DXWMTdqwTrmxswTF6Esl1czCH++aNrKcpFt2uHBNzET8Nlb9BsZN5oX+Oyt" +
+		"rBi89HI6OLkPL9qYx6lpshw7fPaiLTl8RnzWoWhPXBC3Unvxhjo5WS9ntvJv0iUq2pPt1uNWaonzzuHB9KiFWNuEN5Q8Gk9Y" +
+		"v1dk+XtiNVdkbx1qQN1QUcuCB2XlXfBggusWMjEVUQ1K39FPvKgLuSe7LlmylrrqpJ9d3wUPklk8lY2T8NAtMlkSWs+a9AU9" +
+		"Z03lQem7AsWI4M+Enr8m+UWX6iSfiflc8Cfxn4dyKEqSORPxnz+SyMoZypr30D2N+MtDkbEU8fhHiSCbCQ1ocfJmzSYyMWvG" +
+		"kIkZnlvwauZ+vDhPM77QRcZLxhe6lMmFJqocpayZhlfJRZjkHBtx2XPBSRk5q2wmsh0m0UwumzYRS57Q1zcnaEYib7hFdt09" +
+		"lMn1QimTS7XQFzRYMpcPRS2sV2G9NutVWC9lLBVseDtujFZe/cZ3EbM6NxKkYAvfSJDCqmxOxoJutjkZZSverFFBem7WiGjv" +
+		"Q9ECFr2NdClY9LaTAVZVkhZYzc1qFryvyrMq2Pc2q1mQpcq6KqyYsq7KYETKOGPF9qI97s6bc6tg7ducWwUdi9xvK2hVG1kj" +
+		"K/ZW3lqck0fRizsisbVGRpbn0OWNjCzHD2AXUqLEzox01aAMivFtlWR8YdM7VLReL9rjpKqXvnMy3CoUmWM3LSinLeSeXZxU" +
+		"9aEWJEGN6EwjFu5QGYostpc64aya6BmchbXdLiRBjdgHI2ruUDsotKya6SdZltjejeg3w9p+KOoMy+ChGEPlN3gJxIFD0V6n" +
+		"dc6m2mkdXaZOWlDu3aTkZuxGXzhVqlEnXFCd7+CC6rTOiVOddUDvrkt1Mj5xARxStZqcOO1SSYdSyQUVvSY/35Fudid4qei3" +
+		"DBV13tzhckREGrFw1iIy24hcsxb2MCM37lDUidRoz4/jkY14N2sRvWvEux2qQW0oesJ5g93fiGmzlmkNvaZVUfSEtSSm7VCM" +
+		"jnOjNUbACdBYWTLqrIU+ZGTUHWpDka046CdchqXfiH4zcB+M6Ddrpt9oDx4g+s1aaJtGRp218CYb0W/WWGei36xFxqyRGWf9" +
+		"YuzIr37RM+RXv+kZ3Int3Yh3s/7QOrKtv7SA5tQ1g8i2nvUdGSmFWYKruzgC2dYrfeGk6pp5Tips6EZOm/Umir50voP/e2ii" +
+		"Rk7boZh5eLxrPtGqurF+8HEPm+FRsUMnz4E/cyiDGlAOFa0/aO85NNFDVagYLfF1XiJO1Iiosx7arZG3ZmBeGHlrh0pQzEug" +
+		"2BhZZdY37aGTD1aMqLlzv+U7OHKwRuSDndtuh1pk3Oi7DcV38OdAfhEnd+6+DkU2TlNJsnG6StKCUSf8ibXdyOQ6VPAS+Vo2" +
+		"NJ/w59Bcw59D8wJ/DiQP+Vo2NnVyD51IHqLtDPwNI1/rUCo5oFQyuGcmWoeTZ6IFOHlqXrgDzMzY4eSJNHvQq2bXd7Q3aQFO" +
+		"nq6Sk/wDUQbFasLJk1OTuDwzjQFONo0BGWzssYcbAVhphyKW/tV3it0Pnn+5axZOMfDCDhW1vHArfu9zZYn4m8Lavj14t0Ru" +
+		"tL2BPuX4xA3cL7PEd3CyqU4krZUfWy0MBJFTjtaQwRaR0afnyjCgfk56QxK88L+Fv9rAGjFjFV5OelMv4XGbqoUWJiXRGi38" +
+		"zvaGrfhQHSpW3TgzX3aDsZZECBrR5EaEoBE/bkQImm1918louKHIRbhU0slooCS7wW/Wi93g4aE+VPQTK7a9TpYEWs7LTsGm" +
+		"fSjyFF7GwE7xrN/IoChqr0GpTuVMbCjlTNBrdopXtRfz6Q2KneJdFP3UOizlWjC7S7kW9Ax9Fnu3EdloRG0bkY3mplqCx13r" +
+		"wC7ypfbop2aeXeSaeXaRb+aTXeRom0Q9GggwRtTjoaiTs2JpjTgrsHcfitwOdE9iIG1pVdiLS6uCPrTQPcmPPFRwa0IfKoFq" +
+		"dqg4OUpkPp1Lc1gsC7pZQgNarF8ayiXhu6FcEoeivarvaI+TkThHW3FLNOIcbUUUohHLaAudhFjGQ1ELO2eFn+jsa2ph5olQ" +
+		"PEzOd/D1vvTbJvchxp7g630zdvh63/QMvt7odEQoHoo6kd0b7YwoRNtZFDkTmhdk9y6MD47chfEhuzcaQ4Ijt2YJjiSi+1DE" +
+		"Izf6CUfuphboC/oYMZC2h2phJqZqoZ+m9pgJ5ESCI7dmEK7bm1mC6zYaWILrNqd7Cq7zi9M9hYbul2Y+tJcjXuhZcOSh1ELI" +
+		"/OuBQ4Ij/Xp/HI98yhFViR6c8XrXyPg/1A2l36L+iqzJgePnRCWZ4jZreDJMcZs1UGUOFTfGGmgAh3qhYjxEavoV3msjUtNB" +
+		"bDwUsaCcynkwK1nfMSvwB1GcjkfiUHHaXVWUQem78PFfYf8y0J8cNA4jptPJqQ4wm4hLpWeT9iY9m8w0q07EpRMjbkRcOt6K" +
+		"ALr5UOiCxFGeA8ChNnF5lHSi7eB/4igPxTo4UVeaidBr/NZMxN44FHPtRGQVxue0wIlKLOOhGN+ihSqKqEC0AGIZD6XviAoc" +
+		"+q1C6Tf6EpG9h5pQcMFitEg64hwPpfboJ1ojcY5+IwXzpp/oe3nTT81gSHIH/cNA4zwUq7LxbHO+ZvYNaFCW2TeP5jo0p0Mx" +
+		"Z3Gz9QfNkLhKf8TX7A6ivY/WEt/pdl64ERDvZuSh+wNfk2t+jmxRGapB0ZewzRlxlf58a5Hn/oKin0O10M+hkvQzYhKNnPFD" +
+		"8Ruc/ASOgJH77U/kmRi53/6g2ZD77SBnGLnfDqqmEbnpz1ZJomNYB/K0/Q0PspGnfShGZPhwWQeyrw9FLYaHDssS8Zj+Pnzn" +
+		"1Im0IR7TX9avwOVvE4VfWLMLf76aQfjz1Qwu+QAZO/wJ/qYRO+nvVC20gN5I7OShqBM+I2rbCnz2as42fpzFOiC738W8IJ/f" +
+		"re/oy/5xrEWYyKLc5quFZyjqBz/O8QkE1NKHQmsk/tITNgziLz1h1SLP3RN8TJ67p6KS8hCoZINSnR1qQ8WMpapaNv6C4DIi" +
+		"NT2FbfNQ9KVRy6QvaB1EcXpCM6xwZ0L7q3AnUdRW4c7k+o6+uErKGk3P4p7ribOWWFBPSyUdS7XqZD63SjIGtEbiRD2hNVbD" +
+		"jsXJW+H4rJmH4/ElHIr7PxbLCo9nrUPYBQ+lWrAbYE+sYRf0nFlbZH7WGrEbstaI3ZDDe2dEjR6KXjv2Bq0Yp0NG5yEy9FDU" +
+		"icwHw82I9/Q8qHNhmdM6LNk+VJIWnJVmT2WndfZUxp5I5KZnrQNyPWsdkN15M0vsqbzhAvZN0Vwju4vmmv0AzotV9kNBhyQC" +
+		"81AJ6oFyqBhRQbMhAtNBnzoULYQP0EAq8BLRiwZSwaFUS/BSYY1AIzhU9IzIzUPRwqQv6KWN/VDQS4nc9IIW0uD5gp2wwfMF" +
+		"TbTB14U1avB16fqOvmDJavB1wYbY4OuCztrga5DnjNhQL5wcDb4uaLANvi5oNsSNepnMBHxdTLXQT1Mt2Aaw5jTTPYZ+wvMF" +
+		"S2RDByquFhjDUuuMYek7xrDUHmNg3zb0o4LG3NgP9dJvCYpaHF0UHRkMzEPRazSpKu5xTvDnx/HI1jh9qvgKPaqiqRHdeijq" +
+		"Z0/Jl9DQoyrabVv0Eu22sadqVsmFVgxHsG8qu72hD1VxEvpQRYtr7KmKrtvYU1Vcxp6q6LpExR5K36HLi8vYYRV7KVGxXuGy" +
+		"ETvs3F5EPUFFFsGhPu1lae8jTpxMpIwRWZilyYzYffkNP/6hPmPPCWvOiBMngyJt4G8esadaPv3MgzvpiJ2ZZ2SOHeqzeqU0" +
+		"tf6Zs1LVQuzMIvseEYoVXCUbsTNr/lKfMVRinA/1GUMjT86IZWxEoxmxjK1l2otd2xo63YiTqo2XnsVJ1UAtsBE7+vCaevYZ" +
+		"w1lhtfAZQwNXyUbs6N6dmYgdnZ9XJXfMIGtLROQ5YkR9xlcufCxERJa76Lc3ZgJNhojI+qovcVdpIMgfKsbXp6gYH9hQRrRk" +
+		"BwPMiJZMu4mKNXoufRdr1AKFxYikzETBG5GUuWu0sdszGLphJA6KdY/dXm4sdeCMF+IpAvjps9KJWkISFHmpRkiCwxKMLyTB" +
+		"0cJUy2d85+JJz+LUPBuH2Q0pUeURGSElKjHxhxpBbdX5Gd/RBuDIkCDt0l4JORGPPAS1gqoquYNDFu2FDOm5M4MhQ5IsPUR8" +
+		"5qw6Q4aUwqlJxGcBVcCI+KxNqxJndh1L38X6paw6gz/B5zMiPlvVTMR53itnxYjzPOtGQDRo/h8lY/0a1oERMiu79ljIrHLf" +
+		"zNJm/cQFIbPKozkLmVUy9gCiSEt1/RbjG+nv6MjEl5aF9XSEpKtkxh0qVhZ0RSP2tJYlKlZWHtURUvDoVownboX9GhrBZ3Sd" +
+		"rH4jhjS/gbtlxJBm8EsOFRIkB96AzZCCjZzVQ3362ckiOFRh1RtUSMEnq2RIQXlUJ1IQbPRDDdq7oWKFHG2TmNW8pqhYoc2I" +
+		"ZtgFz71YFCtUKYmENOwGEwmZ8WNOSUj8shMJaZzYRMUenZOZCAl5tEx6FhKyyopGxGyTnWIiIcUDEwlJtIoRTRtPLgQVEnKy" +
+		"tjMkZH+26gwJKd2MuNtuSSPasYu45/I6QFrsmxkSMl+Xfov1uzfthYTMDZ18hoQsYF8fKkMtqIJkZY1CQpaMPWyGhCx9MYMh" +
+		"Icu+1ULwmTTtGRKyFs1uSMhaXNRnfOfeo9aRIN/vQoIkjS8k5JbXdoaE7C+2d95C6LmpZIyPiCIjWjjPAbeGhMwV7ZZo4VOn" +
+		"qNhHxj4iWrhu9ESihbssPUQLZ7AkjGjh/E61HvxpSSWDP2XPnyEhCznqhwr+JE/bZkjIs9DMZ0jIkvCfEWVcFlrcDAlZZbeb" +
+		"ISFr164KCXn0N/glJGQD+z2ccTFaWg8J2a+qkp19S6+RkLJ9zJCQpyuqxeAz1g8JeRV9h4TcKskJtxmRJOTFd0jIjm4xQ0K2" +
+		"m5NjhoRsRfsoJOTRJVUy5MuLJjVDDvbxMPaQg4lXe4yY6nxpx4UcLNIbZ8jBouiHGXIwg0tqM6xj+fnc5H8kkWfokPnpzGZI" +
+		"zzLgMmKva0UHIfa6y05H7HUnrt4MHZIXYA4Vu++KOFgjLruBIGLEZWewJIy47HJjUyAuuxBVacRll4SPn7jsorgP4rKLPNsW" +
+		"0rOAz3Ko2H2yglpIz3iK5UOF9Oy6l1lIz7PBMlRIzwdOMqSnrHaG9JRNwSQ90XkM6SkPjyE9L40I6dnZ0Yb0nFW1BHde3B2I" +
+		"LT8qpPoZ4wOD4lAxPhC6jLjzc6FSLTv6go3ZQnpWnSMW0vPcrmlB0hObgqFfgs9nhn450V0M/XLiP7OQnv2Bx4lf70TMGvHr" +
+		"aWmWpF+62kO6sGsN/RKUPzP0S+0iQ78k08mIgs+PqS/Sn+GlkJ5Z/E8UfFbcjoX0zAsrryE9N/vG0C/vSnvol7d6HdKzJGKw" +
+		"iJcvinSykJ6FiMFDBX/qlk0sfSFn3IilLw2dlVj64k1UnA4XlgIL6VmvrZ7F+iWsAcTZ18TJQZx9/e6AxenOfZw4+9rUT6Qn" +
+		"2btGnH0drjrRL5F0FtKzelILcTqQB2XE4Dewy40Y/Ha9qmVy52DsIT3b21Vn6M+ziIr9t7+17OByUSE9u6yuxO73hjXaQnp2" +
+		"xXlZSM/+SYkL6jO+DiqkEdefNjdBC+mZc1YtIV+adgDSs2tXhfTMUztuo51xH7GQngU8E7PN/rvVgiNnRcX+I4fbiPkvjZ75" +
+		"4ISLvJZDoWGiaZMPUEv9O549MgVqgx99sHrc431wtnMLJYug6v5BFkGVN5ksgqa7EFkEbSAFPaRnM1bWB7pZU3uhm8nHT4ZB" +
+		"BqMkwgCC6lCx+xIShOyDnDj7yD7IZeu3hFas3zKroDpj9RT/QmZCXkNU7D5e4zAyEwqYtoeK3ZfhYw/pWXgBw8haKIp/caRn" +
+		"5X7gSE9ysQ8VuotNZhfpmdFZyXaosuiR7VAr2oObrA/UifQkA8zIhGi8DnCownlAe9zOeXfFeP2jPdyref2jPVt9ifWTD4J8" +
+		"ipbV65CeTfGR5FM0UAQOtVhp5iWk59GV6EtIzwbG2aHQPYlKIg+jF27uHtKztyIqdt/gbukhPbviBjykZ06c2S7piUXKJT05" +
+		"+R3p2TR23c7RXcj0OByp9uLuoAgYR/eUPdFDemb5oT2kZwZ50cgQKbwOcCh25tRvsTMbpwrZI2Wh4ZE9UhN6MNkjjXcmDlWQ" +
+		"PLQX0rMo1ol3VzYIB+bonmPotxEySjPB7Vw+XLJOsvREsk6OBsa8oHuS7X0oxoeNjYyUIs+6b1mPGBG3c3JsjGyVonuFczvP" +
+		"2mMhPSt4RUYmSx3oFryq1gqWCQ/p2QxtkCyXXrV+IT0TaKpGlkueS60jPSV7kJ7yt5LlUuUNIlulSjKskJ4N7ORDhf4MqoCR" +
+		"ydJADjgU9wOk7uJ+DradkeXSX7wCZLn0hpRf3M/Xt4XB3e/vSGRyY1rGkkRuTL/geHJjDg9kqFg9XksycmPyxG5AbkyenNHk" +
+		"xpw7KT2ZcCfyck1sf9xeyZup4PgZeTOHV+kLumdytRCnw0TWrMnND25Z6J7gWdvSzd30G7sPqUtOTVeM7JJt81EL6GaPerbh" +
+		"R2YipGd5NL6Qnueay3oZ9ufG+Li5g4FsZNzkFwsDGTdZ8c8rpGdeWIEWN3f5IBY3d1BRDxWn39XVQkgXedIXN3eQvQ8VN3dF" +
+		"jS6kp7SxFdKzy/q2pHui1yxsmy87eoX0rLuIerizqST2dW7ui5t745xcrvExg+ie16PfYnxgWBvZRgVcOCPbqPQvFXcHRcUu" +
+		"bu6TnblCelbwZw/F+KZKoptllcTyQpzCCumZL+3FxemOjrxk2/xSMb55iYrxuXg+pGd2bvzkOpWs8SE9lZFCrlNpWveQnmVo" +
+		"1y7Wb+k3WV70XYxPHgPyoM79R7+FdNn4MlZIz/bdjSE927f1kJ6toCMvbu6bc3Jxc9/fknH6XWjhi5t7/5bk5k781Arp2af2" +
+		"0eZupHUI6ZlWUkmkp3bOxjLB2btCeqYtjuR+LusfmV354dzasm5yhm6sm4phILMrv5wcZHbl1PRdrBEYXYcq3KlUMjQwXoMy" +
+		"sr7ypGcb6albzca6uVythwbGu7JGfliRNZr8sCJ/OS9bFjClbH/9P7FivIRVPiAgP5TIvJFVtIc30nMGVvmhkJ7IoR3Ss/AS" +
+		"4qGwP3PP3UjPCy/HRno+eD830lM3nB3SsyZWnQy3WgPdx8hwq/9jBMZ5qu/gzqqSC81QJeFOzVhIz/ZwjvDOV5Nvhqy59sCr" +
+		"O6Rna9jhd0jPNolg3eie9q0TuxlSfnNzVwzixjMkubANuxlaFZl4ndfzjEy8Lo8SmXjxvF9QcTqYxo70dM1gSM8ubXPr5o4l" +
+		"d3NzN3Guc+6zwza6JwhkttE969B3sfsWuu6W7nnrt1g/5Tds2T2HSsbpp1OFPMC20AXJA+wXsmZj92yXWnf6qToXJ4CoTXus" +
+		"CtJzDnYY0lM6MvmDhydonZv7+JbEc6mdie4pewq5hfl6WIeF34Hzbof0zLJt7pCe52rEzIf0zMrSI++wPFt9iZ1JbvShYmdO" +
+		"8VlIz+KmkjE+5d7tzd2PewVvxlVeYA5w4I9kRSveSM+lHYD0zK7vYv/xvu+h4nQYai+kZ9nYdsh6bAPtlqzHtohh2CE9e+es" +
+		"2CE9jwam9rj7aSbQPad2eEjPZsE9Tg5kn2FRdHIgTy0VStJzQoX0JI7Aeb8u9zgdnPzIwrsdfoX0LH3rO2RI+Bac3MnaYuc4" +
+		"uZOVt2qdN3Urr+hEKGvc8tVCnOB43Z28ytbjrPcL6yZeHb+wbl4vJUNGdvAcnZzL/Khn3M+JcPBL1s0vFRrmjNuXk4/ZPikR" +
+		"P5LITqZm5x26gIWN2VTbnO1Z1ED7U0/wW34pWa3VE8cKw1i5ufN2n5PhWbpmxWTX7VB4jTSb2D2JxnTeRcjPEoVd0Jg/vEb5" +
+		"W7JgcVadWln6EtLzTB+jRfecGgM3d3ARnIzS9uUkbu49bjGHwm72bQHdLPSvQ214nN++XiNR+J0N3sHu2Ttzhl89OS1g9yw3" +
+		"dWL3HI1e41cHKcAvpGdvKhnj6xOOR3o+Q60zhsxMuO4/6svCpsBuQL/cF2NHQr7qNbfz62GNuJ3jcz/Ui72bMXA7530Rv7Bt" +
+		"eqGFkJCJTAjnRYpzy9Z3MYbq7G/ZNge91u38UnsTinn53s7VAvpXYzdwO8/fOuN+QEaWX0hIsED9CgmZ66bXISFz3XwXEjL3" +
+		"m5nfWMe0+zbSpcOR2DbBTXOyfjNvczhZv9nVa2ybvJ3svJWZl/iT2/k2tS7fgmpBN3O1HnuMnH8ns7fU0K2dVy5KCz+m88pF" +
+		"Ib/VeeXi7PANVbm/DqjQ8+1RnZ21dai4gVvEojqvZRYPbdPJFq6ctk5O8Ln3FiisCI9awIauWqZs6LT39ZBTizzklX7i42nw" +
+		"PO9j1Lb0W/AZvm0nl7j2SntogzNiDJxc4srbIwGqGp51lTQ862qdu9jFGKQNcqrw4me7wq/ivPjZyAZ1XvxsM9EztMHQSX4k" +
+		"kW/0RK/MZki6zi3byWru4G45Wc29LeYPPRGbufNOaB+FsaILrq6S3DTZ0WQ1p82+4Q3RnJBYvPdReHPZyXgu5Gk7Gc/9Ru7x" +
+		"hmjOAy7jJn2Lk9D3xs0c6ba8aM914jCb3JYfY8bw1ICG7uQ/90v8QbzN4Kwlc7mUTp0L2XZTJ3fLO/MbvouinbLRHh54QNY3" +
+		"rRfWt1e8w/5GczoUnnutChoQFjYn47mAYOq8GlJWVXuD71iH2N8Vy7jzFsjhR4cK/bIhSx/d/Fgx3ubM4jJeBkm8buKP7FqP" +
+		"asGuVdTCRmLxHXc2kH+dVzUbb1c4+c+nlgWF3GMMvD2SV3hDnbdHSur0jJtZVz/xCpB15bw9Uq6wpTpZ1OUx9WUw1xNKVkl6" +
+		"jW4xXRRW16EWFv3cUIxPPSNmb0cutj+K2buZT8Mal/WbcVOK1STHuWx0C14UafekBc7QjR5AjnPBBuXkODfeUXPeF8n4apx8" +
+		"5DwW7eF3G5yhvCFyFF/GjvbuWoeNF2KzmpwVvDDlZCcXW9TCWfGqPc6KhTTj1ZBzbokK+fVG7KTzFkha6DIv8aZr6rc3JEMS" +
+		"ldjhC4pIFniQXOXcOAHIVc4767uYT95Pdd4QKXnpt4Fmc0FNYiczFGOI2CMn47nWJWoRe7ehYs4MHZa85uouCnsRpx95za0h" +
+		"B9/g6x6v7v1IIr8TGZxVBxw/RcUJuqdaC21MMuPl9MmTOQqO72TUHWrg/WQe4PiqseIHI5PLeUulkJXh5FuXoplGm/a4A/uL" +
+		"JXepn3D8LtRJlCrxRE4udgUHyMnFbhcS5FWU6tR3BeubWiBKtakkdz31BT/YzPSa8+flDvDG+VOs67s4f/D8+qsYLM0L2vS6" +
+		"9B1e6ExfsEWAIeWvoggu1pLzp3FXIYf7MJl+S/g16KdLYrFiWHLbUp3EMnIWksNdzUURxTnpNZZc8nWd/O6EvdvJ78616TfH" +
+		"86X2QiL3R30JiWXI/BdN2yZzjS1iaZ9iySV/1nm5ppBf5y9RqklcgKb9qp/EYN3os+SFt9bgEKJUTfyJH6w0lWR8rpKyVKv1" +
+		"GN/WjsYWcX3bW0SPMPNo2mD+OTnjmRxBf6Vp34wPTbtxByZnPBP77eSMn1liBjmJragW/NBbtVQsnfQaTXsN/daJLKFnSE/e" +
+		"JHLe1Ckv9y3e1ClvY6+gaadbfeE2y15JiiIIu/WhHuwiD1SsEbiyzns7ZaEx8N5OIQrLeW+nkMfsvLdTbzQb3tuphZsLOeq1" +
+		"VrU3uO2pTmwR3GMSsQJErDv5660PfYc9BVmaZIvYjCikZwNl1slt7/jEndz2zotWzhs+Z2/qN6ztnFTkvfc3iQo9tbCreMOn" +
+		"636X0Aom996Ehh6a9o8kckJ3B3nRE7r7M1QH94+i3zg3inq5mLENFefG1LwTRbA68yfdnZOJ14Ry0/wRg3Uh9xK2iMy5n2SL" +
+		"0DxgiyicDglbhHFX4E2iet/6LUZ+m1oIW4QhS0ED6CA1OWgAibg1572iOrgL8V5RWq7fNl5N2kN63sZvSM8n7HsOUsDR+lWS" +
+		"GLPB+JCelTt+Qnq2zLxIejrziWbvGpH0qKo6O2ehKFniGREx/mOpL8T4b1YFP1jS+mGneJpKMr5MnUQRZOwp4BSU+v2NHAbO" +
+		"n4QlN2tnYqfQKQaGQXurSqLnax2QnkQeeVIE663vQno29G4wDI6MYl4Ug2XMGTFYT1edjg+c2Q3p2a/vd3H6PZwO4BvkrfEh" +
+		"Pb3rNyQP+izYBxUsVwf7oBb0S7APKoj1DvZBy9oBRBFMyYyQnh2vpoN90AeyG+yDDDJipHOGD4l5Cem5iQh2cBEqeXkO+kHV" +
+		"KcabUq2gs/KmVKtoPSAVtBZ2cgepoPMOnYNUsHkxwkEqKCC7OEgF9QqPgWdZctEFM9KT1zIPFXssRYSWg2Jw6hQVN4K7qC/4" +
+		"0re+486RVSe+WGwYWZFWWDezvF3MZ5a361ZJoqhfasGG8WgM5EGRV+kgI3SiqB1khPwkxk6c6uZOnCe2JPYmqAmFPC/PirR6" +
+		"9J3RlwJFHO5S67FGllTnRir+HTsy72e1jHTh/azWCyPH8iGrJO9nnZsfK4TuCRaBg+bQGlpjVhQBt0nezyrgFHpWFIFWCOlJ" +
+		"1sKhBl4/Rk4UATnVDiZEq1ov/GCX+APpuRuzgvTUfRy8iLwHcxvS85yutI70vNGHsiuGRyWx0WOH4U2utLhD8SZXll2JN7nO" +
+		"XZ15CemZdR7kbwwWHIhdZNyiyJD61okPYuo3J5dEPSO/QTOhDClOXtAq2hYHYmu5scOAVpE2t0LQKjIZG87LW/nhjp+JIkhJ" +
+		"dSoKhFUhiuBGD85EETzif6IIsqkWxufMLn6wvVUyxtcf/Rang+y6oFy0hsUSlIueB+tHBOvgRgDKxaGohQjWhWQF5aIvoz10" +
+		"T1CWD5W5fTET+MFm1XdInlvtYXl89F3cHfa3dTLANEuSnliH8zcGSxRWXo0I3XNDgYBRicY51IMtVVRIF94m8KIcKdYddIyW" +
+		"sOkVRfljny3EYF3fFhpa3IDqcLlDkYXIbgQ5I/O+ooOcUcDhcpAz6sVpVNA9GzpywSK14HJQNUpmbxZJT/iaN8/K1nfKkXr1" +
+		"G1mWSN0y2WOcaaBxnBOR8SE9y1Z72B6xfoPGkY3zp3A/9y9FlDEWzCINc6ov3F+R1qB41N31XUjPDS8VZCSvcx4K3frWb2Rp" +
+		"cKMD4aOXrZL40tHJecXtaIN/x47M+259c9qBEnJk24RCN0PDAyWkds00N3feHHPefutlqiR+PldJbI3cxHj7rfCKoIM1Uni7" +
+		"z3n7rYA+GEnskQlBLSE9K6hKDg5JJVvFeReuFuRXcUXoMqKQno2oUefNuAY2gPNmXHPsQ7wZ1zbnAW/G9fqyekQRTO0NRbBy" +
+		"WwYFpS/xFfH/ramFjWeB74j/J5bKeWuuveLqkJ6drGYvC+ny/S4heZgXdM+BvQGclSzdGpyVvL+1xPpVdDPeqKvXl+L0M33H" +
+		"6aA1Qve88C8V+ci4k4LBUskqO1SM70FilZCetXES87ZdM+1FogjM9dtLJAv9JIqAbFoHu+XoSoyPm/v1/a5i86VnurlrJrCh" +
+		"Z7zE4LrUW3sKG3pJ6gsxZsh8kF3aJfmF3RMEbQfZpV9VVKzRZAZBdum82O0gu2T5r0F2OfroDYX1gVkC2eXI5RcKDYXxgexy" +
+		"5nFDxRjAxfbKzd0f/RZjWK/6wgmHlKiKInjVl01WRsx1VY4U3mze9ctkIjloMQVEEQctplRu/KDFFF5Ac9Biimt8WDdD+/tQ" +
+		"nHAaA9ZNeYqqIq3gM9Bias76jTV61IKRAaN+4kt/1ELcfxqSAbSYzIuYXhWnik8CtJhe8A9Wxani/wEtJl+cvbw4eDQ2+sL9" +
+		"3IntAEmm8hKQV6yb+9FvDYubaunkXTEGI4OP0493C0/j948lMi8adjJRvSp7SmuC3fP6tsbI0Wvq9+YuSrmLzAPSU741cGuq" +
+		"T/2WyEVgLYnBKkgzMG3SFneiez74SngJMcuDy0uIOWnVyT3Nl1qY8L/qjHP/4lSpeEfIZT8UdrOhOrFb4wvilcTKmxcOak4l" +
+		"QspBzTlcxpxxc0/Yn3kl8cw7M4jdMy9RBbmnFoJzeWve61JurVogwlo7heyphvQEbafx1qLXxa1Q68DNnXe4HSSeXr6tx86c" +
+		"nAC8oZjBjXLeUDzyjnkJ6Vm/tXBzT5p5ck8zpxHvKzZylBwEn07Ev4Pg0w17Ke8rnlOFMSj3tOu3ga8ePkN6ple1xOnA6y0O" +
+		"DlCXfRYcoMq7nQ4OUB3UAg5Q5S09b+iehp21YfdM3GrACDpSYkCBI7BVMtaoTbUAQgR94QXH3od+I4oajwH4QX1N9UXewgUV" +
+		"e2xFnO+hpD+rn8RxItvAFmogl3mT3RNdBmyhtPAfNLyhsm3yYmQG8dnBHcrOngZ3KBOP7OAOFUW58JpkeV/VQoaiZmkqQ1E9" +
+		"m+S8bChjDOonujXWMTCJau1qj/x1rZju55xNYBK1xvkDJlHb399eIpiYM3RP+5bMeGBUZ5wOiphqup+rZ2iYpvnEN/ReKkkW" +
+		"g7gADbNWUVg3IxfCmxBMGiuNFlm5o/KSZnW0cF7SrFs8EXKwRXb0jyRyk4RUn9EvX244vL95ZoXZdG5G6CCgHOUq/kdCds0D" +
+		"EnKI/xXjz/4GAakU9IwmCcldoUlCYhtrSMiJHAIDqYIx42AgnT1PyYX+hYYOBlIzPFFgIHXwNw+VyT7Qd3iGiPVrsm3i/WnY" +
+		"NkGw9qYMqcQY5BkaqoXxZfUzxmfiJGL8X60X+mXRbke/JI7fwVxqsj+DuZRvJB2YS02RVWAu5Vu7j9v5TUQPr5RmWZbAYyrt" +
+		"S2F778wgt3MQrLwJpURyQSglUz1DN1vqWZwAVXsYCbmmWo/1W9gweMH07LcbCgvR0G8vJ6pDEeNfRWFh4HzlBdMCApl3RanC" +
+		"PbxgWiTlecG0likK+3pX68Qy3voOzxDae0e/rN9aFnbPDoX9mdOoo19Ks+/41Rt39Y6E3NwgOxLy7voNCworzfupuWNB4f3U" +
+		"vJCCXRISad0lBb91TnBrLihwBLi58H5qmaZaFh6lCcU9Bv7k/dRzq+E7MkVTEkWmKLo176fWqlrkIdcMYqUE/dN5P7WBxum8" +
+		"n9p4udZ5P/WcH6oTnKqm7wbyUr/JQ67vjH6qPWzo3I14W7VW/E28rXruKFAhITso4M7bqufqztjBcdK53PGQKxKpy8fzqBYs" +
+		"RF21gCVWVAt52uxGXmg9FzOVVJaeShLTY6JifPFO/I8kckdC8m76oRz8pQEVI5e07orjJy6oY798vpRyZJlbfOcPPoGO/dKx" +
+		"dnd857e4bIEwYPqN8w0rL2/MlsfUQuy+NFRSUS2sHvbLntUeUS1aLyTk/LYQK6sbeEdCvlstbO5QcDU38KV15gauqO2OhORN" +
+		"z0O9RPXTa3TIgZ7IC7fNJCXQIWVF6OiQ8o7w+m2Wvt7x/iwXJYQIlcT2J4kFfklCX+hEHoG/6V1RqniNQLorhld4yEbJrQY0" +
+		"u6ZYhCEcJ3yxoNn1gm1HaHaKERyKUuU8AM3uMHWHmsjZF4qIrK4WyBd51bqQtibURouLXQvSXZakA+mugN/tQ9mg2MlBuiu8" +
+		"u+Ig3R1OVi34/7lNgnR3TiP6yS3b1E9lgza1B46TZpBs0Hnptwlei37TSXVDcVJttbewdKok2aDqtQknx6HIdoXLhXSn1QTN" +
+		"rvA6s4NmV0yrYsRBdVF44TiJQbOrilkCze5sFXoG2ggZYT7kq0HbHIq6xzIoNLsnq59kfOKnFZodSCQuNDvdX4Vml9Aav2h2" +
+		"WF2FZjc4AYRmZ6Y6C2OgdeKEFEMkNLupMeDp/vIgnm6Q03womlB8jaf7wTYtNDvennOh2cknB5pdl91aaHZgIbjQ7LbGgKyT" +
+		"zR40u/xqJvDVvJxpoNmV5+9liIBzV3SPGMoF5XwD566AK+bg3JVO5CQ4d8U4tYa8OERsgHNXQQxycO6OpNMI0BM5mYb0RGxc" +
+		"4NxV3YzAuasNnQCcu9rIlAHnrnb1Gh+4Iz1Bs2vP97c4v+tSLdiAXO2RhY7PFjS7rkhz0OyOtslo8eI83++M0wEewIvDSxY+" +
+		"5MUhThuku8r7dQ7SXdPtHKS7fk+VTOT6PVChyw9mV0h3E5+tkO7kxRHS3YU1U0h3vLfmQrrrSHkh3dkjCqQ78hu+SHdVFEhi" +
+		"eO6FdJeRnkK6y9yGQLorIGA4SHcFtFGfskNi85qyQ3Ifn5KQaKlCumvo1iDdVX9UZyNDhBEhIZX3IaQ7Xot1Id09RJ2AdNfS" +
+		"UC3OiaPWQeN4VOdG8jB2cpYuosqm7JB4E0C6O6clrcsOiSwF6S7xIoWDdLd1OoB0lyv7G6S7vNG7hXQHSrwL6e7tag8dEt0M" +
+		"pLuiSHAh3d236kSHNP2GtdFUJzkM2AJBs2td37nuk4yI+7JiqkGz67qLgWbXS1Et+JuwaYNml8gR9Ck9EZkIml2pppLgMbH7" +
+		"Qaw7fJ2g8BZypgmxbqCdgVhXdXMBsa7yYrELsU4a0fxaDfVbRq9SnYp2ZZa4E+elOpEFWocFZhanEYh1VZkeINbV72ixGoJG" +
+		"6EKsu5faA5MV6yaIdeVjRPqhRBaWnbQVYdlZEoWWj4wHy64+ZE+BZVeVlQSWXeNNAQfLrhjxYGDZ1YTe/cWy26qz41mAO5GC" +
+		"TfsbKQiuhYNlVwaWRxDrahqikPLEPwuxrvEbiHWt3KJCCsquC2Jd51UUN0UCYX82oR6jQwqxTrliINa1yckLYl3jjRkHsa5t" +
+		"ZgnEun59S+LxfNQC0YRDlPAw1RfyprGngFiXeMvIhVgHqoaDWJdv7Dcg1mUjXsq+2RWMHSlY0PNBrDt3KHrGbbmymiDWFUlI" +
+		"Uxwlks7kjdFM4I3J35LEU5j6STQh8YMg1p3drr6Q2YiVBMS6PjRaQwfRd4qWRD6b8D5fUeBh4tkGsa4UYkJArDs3JXpmiibU" +
+		"d1g7sBKa8D6r2htoE8wE8T4ZmQ9iXQP7yk2YS+xhEOuOpGOWkIITD7IJc4lYVxDrkrx3Qqy72CtCrOP9DQex7vCgagEzi9sy" +
+		"iHV5czsXYl3ldDBlbja1wClWVRJMqUe/gWrz7RlZndimQaxrHQ+TSYfUXiHeZ3MzM+mQ4mTuy4aeY4qWRD8CsS6RF+4g1qWN" +
+		"dwbEuqxsVxDrsonP0AZfbBig0pXmamGCpcfY0Qbvod+UMah+hpxYXwqbNtY4UOla1a4ipud6RBFPofaI6THOXlDp0tLMyyuN" +
+		"zAKVLm10PKHSBQLgjySy8OoeSTNJyKI6QkIqAxK8upzQEMCry9I9TXk6GiuYIQO7AXh1WdkV4NXliQQBry5PTnPw6rJ8tv6V" +
+		"kKoFi0YVhS6PrHHl6QxRHQ/dghrg46k94q1f1QIuzyOKiDM0Q1DpqqJvQJ5rN3dukOcaWM0O8lx7sCSBPNfeIYq4CE4qkOca" +
+		"7w86yHPNllogo4GTCuS55uSDuXDhp1oXekSDwrOHXHChHt+i8Owl1YIUxJYE8lxXfB3Ic1l2Vlf+Omc7yHOHC/RdrFFRz4Sd" +
+		"hLQGeS7zlquDPJe71kE+aawdLp/0UEmyJb8lsbchlUCeK1b1G1Yn8sFAnquKaQN5rhreUJDnquFNc3lc0NtAnmsgrbpLT0QP" +
+		"EPIc2G8O8tzhQeZFnuesWjilNWfYEzcWS/9mgz5QRGRpBtETwSVxkOdKQ4MFea62qp4hQdDpQJ7r8jyDPHdOafiajBv5eFw3" +
+		"6e9vSHluBK6bNHc4lxTEKw3yXFakIchzmdcBHeS5ck39xvo11cn6JbXOfbKKMu7ZzCcZN/VbJ8iI2rfokLyK6sKTU4YPeHJn" +
+		"HUThiyXjRnhy8jWDJ1d17wVPrjZu+eDJVV5NcPDkanfVSbSRZmIrz5g1Qv/bS7VwA0GigCfXwP124cndrLvw5N4tKmETGj+W" +
+		"yEKak/8YpLk2uJOCNNcmPADSXDP8xyDNdd2aQJrryqQHT643V8mQGTpPwZPLvPniwpObcIvw5Lba+0Y5igrtfbD3hSc30USF" +
+		"J8f7og6eXAFfyoUnBz6rC09O1jDhyfHuli/JRPhDeHLmam+A3coYpiJR1QIy49V3xFJhvwFP7mh/KkkMPPfAJSR4bvXgySVl" +
+		"1QhPbsE74MklUI996X6MhRQ8uSRJAJ5c2i6KU3mLIlpF/GEg6qCfgCeXMzIYPLkslATw5HLRauJpbksUWXpFdW7yrRmtCw+Q" +
+		"kuTRTPYNeHJHA0pQeBrQOoQnJ01GeHIbWfPFkyNCRHhyymASnlzmPBeeXBaH6O6MNr0kE7daQCaKC1yIOvptYSdX66Cp4jsB" +
+		"T64srEfgyVWwrxw8ufriQV7CMkb/WkKC1x5ewmG4oMgLL6LALOGsAE+u8jqzgydXeW3BhSfnVS1MYqQYrfJo0OLAk2tvU+vE" +
+		"+rla2OQAUydao+IqhSfHG90uPLmh/Y4nZWpV8KQYOgJ4ck14JuDJta25xpMii9SSDXHrOyJZvq3jS+/6Tpg6+o48GiwaS2ic" +
+		"aP3gyW1FUAhP7sJeJDy559Fv3C7J3gJP7ly6Xygs3PhbwZOrwnkBT64q8hw8ucobKQ6eXJ3c67fyaP5ehghIc/2u6iX+CfKJ" +
+		"QJpLuvUKaS5h4xLS3ERvA2mu3qwQeHItoyfub5yOqJc5mlB4OJN+I4ttqRaiHOE58OQauK4OnlzjrRMHT661b514OLe+w8JN" +
+		"rMXWOxqcoODJ9TZUJ1l6l0a0QVinFlALLqxh4MllXnlx8ORyxooNnlzOcNmWRqkxoFFOdh94ctk408CTy7LogSd3RCLjQ3qu" +
+		"pjpBoxkqOYnVZ0RolBeyDTy50tCHwJMro4jaZKXTAhrlg8cfPLmqOwB4cvUxUS+2fn2HdME2AJ5cnVjGwZOrstmDJ9eaekYk" +
+		"eN/6LdZvVP1GlqX6KSxjdF3w5Lp8T8KTU1wqeHI1TY1hc+eAIoc7I2vAkzs8SC3Ck1N7SE/nvvrFk6uiMm8aiNJ7O3DB0s5k" +
+		"JoRlXNQ6O1MrTRaiIorAk8vyj4Anl32qZ8TJNbWAVRKPIHhyWTbt/cUypk69NKSdiu0RzH8HT65ktALw5EpBtxCeHG/UufDk" +
+		"vitNpE4j7hA8udK2WiDPq6kvRLprVdA9ZxGF7vmlwHN01cmtNGZ3gSfXrsiTW+DJNfbtAk+ucdou8OQaMf8LPLk2wrazLmEZ" +
+		"x1mxwJProJUt8OQ6uIULPLlOFOK65IG51fpE71BJPDCPKPLs4/xZ4MkVsEAXeHKlVPoiZJfPiv1AIi+Q5o78pyfonm70BN0T" +
+		"xNklpDkixpeQ5siGXkKaS3F/XMKTI4JigSfXidhY4Mml5fqOKII4b5bw5O7IcVnCk8MXusCTy/NSLejWQ30BoSi8VAs8uZLj" +
+		"nrvAkytZfcF7DfL1Ak+u3pW5BQmeV1/XJXSYROvEiKemFrCMT+YFz3YprMk3j4YRYZUk/n+BJ1eJRF3gyVXsz0t4cndVSd5B" +
+		"mYwPq+TzMmdYJctNSbIQeYttgSd3ZBstcB/3RS3cx0FMXeDJ9aczWvw26dF3oEgl5iWkZycHa4Enl9FL18V93LJqmcS8MGfo" +
+		"nmaignO5uS+w5soSTyA9n4sxEMUDDv0Ca671VxTj024gznForolzLEsUSPeFFeM+TszXAmuu8P7mAmuuzAlPID1Xh5OJBK+b" +
+		"saN7kg26Lvl0GrNLJHjJ+o0oF0kC7uPeaA//9b2oc+t0EAXWnIkiPstpYesVKWZp650exof0vDZjR3qSP7XAmjtSAm4lErxo" +
+		"TyM9X60K0vPdovRKFjOP9KzaHUhPbs/rlvQMy+e6kZ4XkhUcuiPIM5QikS4ofIddJcEDvFUL2QhLv7FG3zqxS4aes26hcV5q" +
+		"gROAvSIcOn/VnnLVNpQQRfWbXstgRET4lIeSemsILgeHLoNQtG55bi6VBKXkVknhadNrNMzQc34kkW98Oi98BXpdScj4WzHi" +
+		"8D/odQWb4QK9rtjUCPSKTYMi+mCol2R53aI2b3nRAtGRt9NnoiPzw5qY3qjjO+EHxW1rgVFXy828Iz2n5gHpORczjfQ09v6N" +
+		"9PRHv3Hz0+oRI06++rr1jgbnIvh1rVzwANJzvBoDCAqXSoIfxN4Hv65fWj3w655JLbyjwU16gV/Xx6vfsEy4KOHP0DNu7pU9" +
+		"JWw772oP/KCu35AuTd8RuyWOR/f0L8VLBVojdM9ZmDPXG2eMj5t70miRnsmYT+HlZdojC5FMlkPF+r2caTfWzNlogbggEPnX" +
+		"TfQP76csUPcK2FrrBj9oqJ/k0YzBGIgSJ1fzUFjOLvUFn6pmV2h9Vb2GP7XfiP5p4jO9QoR8BsmvNX2Hv+fWGm0sS0vfKe5J" +
+		"v5HDoBkkC7FwSoPkl0EHWLdiIL8lO2/j6rfBnLHHuLmT7bBuvDqmdcCrszhfb6J/buYFzL+CDWM94AeBqbse8INeWniURxP3" +
+		"kQUeYMnhV1ngARawMg9FFiL76JH0jBvdehQfyV558OqQGbce0Nf2q57hkwvPxgJVsD5LJYlT/bYHiie78RGKJycqqIK1IENA" +
+		"FazEJC5QBStvWSxQBevIqoWXsDjPhSponNmPon+QRKAKVudkBFWwrm8Lg1zsBUXs3aNa8Au/P87ZW+ANNvx1C7zBxiuJC7zB" +
+		"ltVLMmwa3PnoDTdOmIcMm6laQPjcL/NHhs2VmDGyuy9OgIccbt6fWQ8In8+3hYb8Yjwm9C54R5mGrpJkcl1qnRzLS63jz1Kd" +
+		"SEjytA8F0jba5oNt83V4B9tmQy482Da7OEleb3bmg9d7ojE/kpDOCgkjKKsFMJC6SnK7e1SSTDWNVhhBcbNd4DC2l336SEIu" +
+		"fcftFUkHRmPeaI2PsIw183i9M1oA+I0FD8h6iBKX9vAgIUdmlpCQEw3vkYR86Rn6JRbg9aBf8tLDocgBRr988IhX8TEe8YkW" +
+		"/uitDPQA8CJPx1QL+rPkArZNXpZYDxIybb77or3zHbZNvFSHQjczlUQ305wRJf7e9BPbZrvgF2yb5A4v8CmLdHnwKVvTLG1F" +
+		"J6tnWMfQDIVP6ZIg+L0d7RZ8yuxhBV2vUC44bV/pl2GVXK88Q9xOwKcsOzwN65Xfm7kGn7Ju9BzwKc8to0B17vj6jfc+bn0H" +
+		"yl9WCyHlr6V+KptXfVlE4eo37qjYDcCnLNJewKc8EovxCZ8SfVb4lCXT+kQLabSAfvne+o5TTGMA5aJz7wWFMg90vFcoF8ge" +
+		"oVASO7aEQmlJLWB9uJgJ7uC8sLuEQgli5BIKZZ58xx1c+rNQKMnPX0KhrJP2THno9Exvpd8/tiMv8ClrXaoRz0L4kBb4lHVj" +
+		"wxA+Zeqqv6MdqZfkICL3wKdsxFos8CkPxdxKv0SeCJ8Sm/kCn7LxwucSPuV3PPIMuSjwG7krgE9ZQGRZX3zKRc+IOwcReQmf" +
+		"cqqfSM+FTid8yrupBWx/4mr0y6p+ol9OcS7Sc2k3gHKBP2SBT9nb9zeQ4MUteMuxcK9Xceeal6V3BOE5vOUDzf6VfjlVi954" +
+		"Zgx6aWiqJLdz7WE8Q7y0ssCnrPmF55CeXXsD6enf38hjFlfjGbqXfgP9tqsFTnDOg1cvDXEDB5/yqJd8J8/QpZK8lYG+Bz5l" +
+		"4824BT7l0WeZF3mGxJHol/3Vb7zSo52pVy4XKy18Sk6V9/tOmyj0y0vtsTNN/cSzxxqBT7klzYRPCdb8Ap+y8kb3Ap+yZs56" +
+		"8CkbcaMryXvO3Qh8ysTrNAt8yiPpVGenvRdqoIV0KO6ol+okzxfdDHzKtDl/klCrs3q9QcqlJLdzsJMX+JTZwsu/wKcsDzIq" +
+		"6SXgTOtEFD3IKPAp28u6g0/ZdBKDT3nOLX2nO46+Q8t6GQPe82LqC+/t3Iwd/49fqsV5JVi1LJBr1ToYsNzkQaHMrn7i/yFP" +
+		"aIFCWXjxZgmF8sZyLBTK9/sbGgqyWyiUuoGAQtnv729YaLNaIMOBnQMKZbf771gthE9JbsACnzJLHwKfMhOBssCnLK9mBc9Q" +
+		"Scym0OWxAINPWStSIn1fuWR0emmIkxB8yt40Amybs6tOpMvQd8rNFNV4mwb+54V18ClXEsoFWlwSygX2bvApM76EBT5lJgd4" +
+		"gU9ZSmcmyFAki3qBT9leTgfwKTt+ogU+ZW7o3UnovuoLfvUHqQQ+ZfeHWdI7GmhV4FNmUNtXUqyR9huxRiDOLPApj86q9kJ6" +
+		"9qW+wLnc2cCnzG9XSV4H0N7HM1SRPMKnlA0DfMrCu8NL+JS6j4BPWVzShaj0ptVUbg73iiSUC6Q1+JS9ajcgPd8mCt2zMmdI" +
+		"T1kKhE8JUsACn7Iu7T4wgnjVZoFPefg/+gI+ZRnhMVvgU1byABf4lF1jED7lQnqCT3lWSr+Bc/SqFlD+wre2wKc89ybV2bHk" +
+		"dih5l1US+zrWTPAp6/OqPWJzXpUkNiBiCxf4lB20pCV8St3xhUHpFyUlIfGDCYMS1MSV5eOZjGEqL0Il2UesHxiUp/UKxXst" +
+		"31oUY6P28OOgQ+bvm5SMHR3y5Z6d5ccJH/zKykLEFig0SXEIaJKb9z4WaJKlJ1G8dcK9SWiSzukHmmSVtUpokrp1C00S7KQl" +
+		"NMlyqXWi56fqJHqeey9okqcvagEkOOwwWe8Cwa1Ck+zwNWiS5zdawI9DBPkCTbIHkuyPJHL+Yv2oDlBs2VNZHp5LPQHJQjyA" +
+		"h2cjL4UzKZkIzmTZmiNwJgs2BeFMdmx/wpkcXe1hYRj0hbhz4piXcCa3ZhopeKsvSMHvTuEGLp9V1g1cPCckC1NJosPEV7y3" +
+		"1rRveG9tF5UESYzTCJzJo+GpJHeArX5ig9V6oUOWW7WgI6skUlDaLTiTuXA/Bmcyf9f5+9YvreMfd+19bJQ+VGfB+sBo0SHv" +
+		"Rut6rRIrITiTR4aqFqGnwLmKGYIq0gw5AUCPLKB4LNAjj1rjUOwp5GwRgg/nHeiRtTMToEeekvqNV7K554Ie2Z6k38howEYJ" +
+		"emTbr1rnjY1v61iHm1pY6Fgx2qJ4H7iuKN6HHQYmZK7casCEzOA/raI8GjQ1cB8rL9cscB/r+pbk3lRUCxFZcB24jx301lUU" +
+		"EZlVCy9MDUaLTtewaID7mPtmDMT0yIYO7mOe8EuRNMP6De5jXpwHQnB88d0XZQVyxoDguDt7BQTHc3LQa0WCN/XF8Rupdd4R" +
+		"/LaH5xLJIwRH34xICI5YpIokFr48IThemnnidm7OJhAc6w1/Fsmlbwvg3pso7n6u9jhVutobvMinFiZ4cqJYo67vwOasohaZ" +
+		"52qBN9bREYTguLBaFMXtoMeB4NikFYDg2JbGt7DJ5h+/fLqK4nS4I4LY2E1rqRcUtG/0muOXUi9pTf7jycqS1ZK/FF4O5CwI" +
+		"iqWiUYKguLv4Ec/vpXXG83sjIYWgmL6Uo43RT2K2yalZVRKE3ScExYyGIARFEN1XlXbEaVSFcMNdDwTFTfT6qnr7AD0fBMWW" +
+		"ImZvgZKYW1ILWAm5V1RFWHPnBglxgxa2QEIsBVuEkBB5k3gJCbGz279IiEO/ER2J7UNIiGQQLZAQC29zLJAQz/yrdXLT0F2E" +
+		"hHihBwsJ8YLLhIR4Z/WMrAzXb/Kx6De4gLUFCbHy2vUCCXF/e42fltdiFkiItRhjwMI2kkqS+cruA+2w8Q79Au2wjULr2MpA" +
+		"sV1ftMOpkgZGsFpAWqNpg2nYKzbKqtcPsQRW5dA9zJIjuzlVwC3MvEO/wC3MutmCW1iK1gF7mHEy1u/LTaoTa/slCkQkbLBV" +
+		"Lw4U9YVIYs2gcBhcdXLWXyrJOqCTgFvYmuaaaJWBfR3cwjbF80Llwof7xS3E8wxuYScrfVW9OCC+RrrUW78JjYBVIQN5dbWu" +
+		"lxHVHi8jGjOPv/XSquhGpxXTje5l7NzowANcwi3M4mR0maY503stU78RrULEDbiF50xjtOgyvLKxqqJVkGfgFm6Q0xa4heXW" +
+		"+gn1lcgS4Rbmre/IbiqqU5Huap0zLf84Q2RV4TAw70I0JO52CdGQlzOWEA1f7FEgGlatLIiGjTd7V5OlDIseiIbdXn3XyA+u" +
+		"UNjhkWbt+56i+kIsTlItYGVgTwHRsOvmJ0RD2SGbPLF4VUA0rIr9AdGwyqYNomEl72mBaHh4nDrRnG58SEI0JK94gWhYEvIZ" +
+		"RMMKkvhqeu3qWyd4ZFW14In9fkfuXVdJfJXY5UE07IojA9HwnL8FivFhIRVq4c0ZA2phk60R1MJW8VCDWtjEj6AWnhu7SoI7" +
+		"TFQGqIXnDquSoO5zGwK1sHTuB6AWHg2GFeMeKF0e1MKabv2mNy/VAhENaBYgGlbHritEQzBfFoiG7UbXFaIh2Gjri1vIDUu4" +
+		"hYZlogmH4dFv+OuwiYJbWMjLW8It3E2UdhhcoFwScZ3i+Yp+A9evqZ+8+ERcEriFmVzNBW7h0bf5jlySKn7htqe7QxMqFzdB" +
+		"cAuziyf0ohX2y6ZoaLQ/sAmzonfBJsw3tyhhE7ZHJQ3rmOrkbtTVOvk+rn5uJBZ1Iul8sIu5tYEztsAfLElj2HpZjLEj6RJW" +
+		"5faVdKJYB2J5m7QztHDwB8+ti3XXK9lY3MAfrPdWSfgMKdh0v2OPdSG0ci8Ef7AOrFXgD1aQXRb4g+cUWlDCWL+guFlXUYyB" +
+		"CBjwB1tdqhO/aVPrRDc9+o79/tH6fySRQSbc4ActkAn71VQHN7+stuNucmPRAJnw3P/4DX2PrN/Vv5KOXiLpOpwEMmHbaDIg" +
+		"EybecV5CJrzQuEAmzDe7tuNRBQludTyq9Usp5r5D4beZovCocrYLtfBO6jVZnF09U9Ro8EfXu36crqAWFqLzl1ALb7QAoRYm" +
+		"zmhQC2vGVgxqYVVEnVALLzw1Qi18X5VE70Z7EGqh7KVd7/p924uV5X2dBWrh7qbfyOlp+o23VYjrArUwX9wruvCt0QVBLcwv" +
+		"OmtX1AlWeqEWvmh/oBYe2c28KOrE9Rs5Lw990Zuzt1pAz8AS0pVRt1VSL4upPU5irTs2r8TJCDZhLdoN3C171m+8DsANHGzC" +
+		"Ki0HbMLq2A3AJqwbfw/YhO1BsoJN2DJ3YLAJm6IBwCZsW62jJ17a0XqZaus7MlqJUgKbsJem3xS1oL4srGOqBa8+XmnwB0vB" +
+		"cgz+YJFk7cKwxisN/uC5AVInLwBckkpElsjzBf7g0WD1m6QLPUNCDu13dMHJeQf+YCEjZXXdX7XjwLDmNYnVdX/VjuP+yruT" +
+		"a+gFADSwwf21sP/G0PsbDxSYM1slMxlhAwrkLeZlyDdKz8AmrOR3L7AJu+69YBMeLUt94fVWtGJhE/Ke4xI2oe4Awiast3qG" +
+		"B4ZcCGETDrQXsAmzYnqGIpdNJYk/eH78hsgSauGNZU6ohfJgDd2I4SRQC4uid/8/wu4eaWIVaMNz/q3CS5jRL1Q5ASTtw4HL" +
+		"mQPvP7BermdOle3yOdEUPZJAiJ8Guu9GLTxu586ohcevVhK7b01KD/vloIexAkQtLIsRGbWwiIF0oxa+86/cw+SyXm1ZEdsP" +
+		"Ri2shzGxoVuLE3o3tneIBneIhtHlEQ1fjcnXi+2d3odouD12hBAN9w+NEtFwF1PqDtFwtTOBaLhjvtwtdGvrxxAN+VTfIRoO" +
+		"e9MhGmYeDtHwSH0iGsZLqCV+irkQ0fAdsbyf3TdRUW5Ew/JJi3A2un2U2tnoZp8c0bDs+UY/y5KkEPL35IBGo/eFaNg/KQvL" +
+		"bBpsiIYI2neIhvhZd4iGYmbfLdGnjBIhGn7T/umJ4rTdiIZPNRPjFu77kmeevCXVp1OBfuY+lhd2x7AJ95um1rI3x2sBm/DI" +
+		"3ib+4LGmhznj3M2h+IPHme8X8sLIf9pZvjs98aR7tt8omPusC3tS1hz2kVv8g0fuCyk3OUQXnLWLMXjEUqdnP3/SYu6e/fw9" +
+		"Kbr1mitTlkuKr5MRuSdmSct/LG7ouliBZVOWnohPZjGswHf16z/U/cbPBCuwNDMOVuC7LvhI2XWlLfVEG3WyhxVYxdK7sQLr" +
+		"58x/rB30TazAKqrajRVY1yP3DVYZ+c9O1pX8wpRepfDyzDg9UZ3sroT597UXiPm380G+Mf/2seY+u1W0d1y/88808V9HZMS/" +
+		"8rlzV7ySTikt4krKTsE3qZvH0my5ofp9zqQSH1k9XCFLaBF8ay8WWah+JZYXqH5PvXIlPVhPCdXvY78hVL8v/aRbTfaeFPu6" +
+		"ntwT4TNPwT2loffQWpweIP7tG80e8e9Adb4R/8puTOzxDjN6Iv7V+DIi/lWe4Dfi37HShxD/Diz9G/GvfmrKYv6+koP3S7uK" +
+		"/Zm9McS/mpM9xL93NMt99GC6RM/ZobUXrt/eq9o1unxZc+D6HVkH9qxC71wZ+0H9LTHqRlJ8h/MOoeDn/fjWopPduH5lSetk" +
+		"vftrPXSs6EO4fqUceYqzX2fGuH4lluC4fhVN+Mb12+IPg+u33dbcuH7bQ08csZLgL4Ldt1c2X9h9D57Cjd23X/S2sPvENLxH" +
+		"CKZ7UkZy1uUjtmJGkJF4cilLN6vocRh85543sgMm6uU9Ek/udx9vULWEwfdUe1cYfO8q27vzRfjYdR0hrZTk58zlTjnnyIM7" +
+		"dOPslWHtgLNXYkc24lVgbYSlVwvNDUuv1uTOouGT2rWTdZhV8PLO55fiNz1yH4sG1pnh5cVXILy8uyflnNYaILy8xQ7Rj5eX" +
+		"+gwpygwQXt6W90OKOu5cqU2kdo09aeV4ee93T6qzj8xThrW0L22dJoroPWKhxepr5Azw+Q9bC7S8ctj1GfFLNUKO7PQ7VRkZ" +
+		"eY78h77OUmZkN1/fH9nN31Muu/l2O9DydjHHb7S8I7Y4aHnHba8KLe+dF/WUeE+xtA0tL2tStLztTm0m+rrxBC1vz7k6Wt6O" +
+		"f3aP7Oab7XDvzuZUGPfuqc44ce/KSsPDvSuD7hnuXfxEw73L7veVccHXQ7PbEd1vNLu90yjR7MpiFw3NbhNh/Uaz27Nrjma3" +
+		"b7yvr/jcjzzTLuGTp7D7sKq/QnWyOrmsvfAi78selEg8N9LdEW92pLsjloZXIv9W+RlrREW9ke7OlbUD0t25PfLLWOOMDOnu" +
+		"xF29ke7Oa0kOdIRPruT3ZP1/xe9pSckSXS45WB+nJow1CNM30l0ZdOsr1GMjwZXoa6kJGtBiH/lHuuv5j9+aGeBKdPSUhe2+" +
+		"iHz3FYusnv9wH/TMK571xgWku7KUpJD8tjyFZ7bdU6S7iiZ0I929awA1GOtTNpBXImfQUpHujodGeYVLYn2HdPdWWZ7ibDvv" +
+		"56wyMw7S3Rnbjiu+oUueiStjzwTprmZ3GOnuHRlSFnbTV8rpLM8+K9LdXtbk8DihkzvPJ+zyO6Q7XJI7pLtBiwzp7jYbhXR3" +
+		"m41CunvyHdjuryVPiWe92k2ESqd3Id3FyiWkOzEp7pDu+idPEd9qzZX8Drc8xarm+Y+TPQy8M7YxF5uJzZ7ahRnf7vy3iJ3s" +
+		"zVn1IxTdaHkl5/FoeSX+kGh5ZTUmouW9q2Vv8CAGsTNEyyviG9xoeWUfeaa1Mx0SLa9c39wnulzLM2nv5gq0vHrQDO/EFtL+" +
+		"78QW0ofR8nYxZm5MvL2bAe74RG25D412z3+oK2v+cw5mhrvDAv39hzl+5b+hD+e/i03NVyqRf5PiOeNsDROvPNZemHj1sWuB" +
+		"iVdFUbvvaGo0e0y8s9NL73BCR/6L31qTwiO3AsHE2/55psjG9kww8fbNCgQTb69XUvYG7BJi4h1LT+rmKZ3Uw2ZPDnbwm3EW" +
+		"E+8Qsfu+wyVJOe3gi1Vz3/GJWvKfHXzj3h3PeiMkJt4Zv8M71hx38hN97ckz8br2/Eezf5Ji9WjEwsQrw6kRJl5BsrzveNaz" +
+		"7bh/nvXKcmV28H5s90OSCRNvseYIE08c9TtMvHha3fEbtcMdJt62JwdMvNS1HXzRTe47O/gjKednNJs7Vqsl/7HXTQ+wg9+/" +
+		"uRIHNf/FOsz6IEw8NMIbE6+s7Hwx8UpfksKMoz9j4pXLmgoTr8RPFROvxGYcE688d+5DK8/3i2f9kVROYPKUoV2r3ZtXzZmn" +
+		"OKft+S+xVdVnVp5nUmLH5t2dfy505Du6IQ9rTLx9klb/bUS+ozXan0XLe+eb5IZHRou7f5F/1Z99/09NCiF1zVNECjBeouUd" +
+		"8TIOLe+fHGLpMcv8/OKm31LspcygaHnnvibl/Iz+jJZ37nuecrBvWKTM7bSO0PLCU/jR8uyUoeWdItzcmHgn7taNiVfakZJd" +
+		"rGKTwvK2j/UkMptZEhOvLsZnTLy6Lkpm9ETTvp94lD5JxXOmSTmbOfLfwdJW7s4/Y8uLiXcgDN6YeIcocXeYeJc9mieRf7V4" +
+		"TLyzjdzHNpNuhol3Xk4Ew8RbtZcw8YYdojDxbmdymHhHPBQx8c6PVQ0m3jlSMp71J0sITLzaS555im+VFNta+8GYeMemF2Hi" +
+		"lTPlHNHN8sz4tOU+55/57nb3Q+N4fuefrnT+uVktYOLtOQXHxNs7Ggcm3n47gXxCFE0OV+yD1UtY805LMPGOz0h+iT2aZzr/" +
+		"TFuKFYjTc0y8Y6fnP4lemXdHdRKB+cbEO0JLwsQ7kIbvJ5E67Jdi4h3dSgkT7xh5Izb/V9oEm//rzlNEhKFDYuIdsVoIE++T" +
+		"1spv9EO3CBNvzZdm8x9WACbe28PVBK/70+yHiXcW8x0m3ll/TxF7jvcIJt7ZaD2YeOevtdIwY1ONiVdCK3vide98BBOvhHmB" +
+		"iVdGzX12sZfkYBd7y5XOdy+1hCi6/J3c/NuIjJZXl3xZ/vhZRzyJ/OuEGi2v0uQftLw3NaRQZeYbPJ/ETZ/7yA9a3o4S/3yy" +
+		"cp/rpActb9/PPEVMwXlW/6Dl7ff0uHzQ8vZnfvUHLe/4lNzX7dZ+pGIFmCsvVrhJ+XpHnvkg1nsK3fM7/Bei6Ny5ej7xx3/y" +
+		"n72dabn/YOIVvpkPJl6lhT+YeNW494SJd8xZ7AkT71xzZTwuU5ZG103useJUn3TP76Y++VLh7D1h4i1z7/b5xPtg9VWMnmz1" +
+		"H0y8/TncZ/TkB/tg4h1baoJHqeg3zyeROjbfLyv3J/dZ+T250sov3y82dDWpRIE4pXgmpHZ5Jti1ezDxjufw7nYev0fue7Bp" +
+		"XBmi6KIsbOi2vNGVmJ5JIc6kJYcouuc/vOK0F6ce/NcfTLyz1eQXqpOyJNrb7z47E2kTrEfuM08JsyT32Xl5fIdYGbfcZ+dl" +
+		"l7Ir2bocWBlfVV3TPe/iq8zRs35S6sT+TZu398gL/sHEq7xBH0y8elzej/XISO0msuVI7vP97BQ8PyZezzONISOp7JzpK3TP" +
+		"8VHXd6L1aZ8sS6x4Hky8c03reWIlLj+6Z0/N0z3RfZ4fE697d/uS37TyEEXzfixLrl9+lf6Xp+DJ/VLOOLeUE0+u6qls7+5V" +
+		"zTv1SH5h4t1aZJh49/rvMUQetLy6zl3eBy2vbvMk/fnmPGSuzh+0vP38pU68m0PK6Dl3NJ7Q8vA3n9DyniPlorus+S8rh0fK" +
+		"yqEkFd0l9z1WxPMLoeWVz1xtPaHlbcmBzcl2eAe0vP3xfqyMa/LriZNTpQ4e1rlSBIUrz2Q5uSd3se19hTDxziepRBLLfSxY" +
+		"75QFkdII+Y2V8ZYrHxxnOfC1QGd+wsRbbzX/Y+LlP3HvjeRh4l1mxjDxriX/OVNt+c++tRkOE68gLz7f+GHkG9E927S4ecLE" +
+		"u86UBdOjeIeRtcMqxfIitWTlbv3zhImHtfCEiYd8+oSJZ+/jwcQ7m1EeE+8cTVku7XPIne6JcfZg4u28M5/vL3K675Bzm9u7" +
+		"hyhqTPwmYvCe/MQSM2t+QxRNiwxRtCYH3+9W14mc/kkK0/CQX3jMxkRMvCLy3PPN6Ll4B6Mnff35ZvQ092LiVScGT5h4a949" +
+		"pzqLUjvVuZ/kYPfPfI6JV6OFfOOjcSaFp502cUe3lkosOPNymHjWKs83PhpGyG/OjEtSeMzpjUbPJd9BLJBPenjOjL/JgZX/" +
+		"0OaNnndaj93Nrfu2djf75vtld/OT/6yNtryDE5+p+T6YeLvIyg8m3o4T/2DivYuTpMwAtIkw8VLzYeKtn/xn98h3DxNvWgb9" +
+		"24gcWt5uzAgt7zhTSvbx9Bq0vOM0E6LlvSucRQqR5UiZxVqonuLU6DEuhJZ3mnlDyytLUnQXtRlaXt29nVMjHL8HLa98jC5o" +
+		"eQXt40HLK99HOdHsl7xDYmX2PLOLs+LdnRq1knKyjXmSun3ZTeqhE8x2tcQu75S70fN4XGn0bCn1iNWo3Eds0nMlm/Qz/1k7" +
+		"5Mvy39ivXMnbWz9d4hW7+Op0z21JWYwuqU+656E9LqHZ05zw8g6emw9e3tGL/OIVm290xTJbC6R7ir374OWdmQuX2OW1XJmd" +
+		"JTnQPcVPffDyzuPOlSw2vmqX7il+6hNeHguDJ7w8sRAevLwza6rw8r5P7nPql1qie/JPeZbonvRnvLwyLmWJ7nl7itHzoX8t" +
+		"GT3Nr3h5lWfqs2T0PLTB6J5nUtZG+baJsZm6ZnGzPsndvnzGDGfibXjb0OzTsoyemDYPXt6JVv7g5Z17Wivds1rx4OW9Wr93" +
+		"yNlQU04r9+P2RomqnrJYuV9D70g84d+VieWiru1unlY8y++8PFd2LDE1YXfzSX//0exzZaI0zmfi5VXUkGeNVfNIao6ezzyv" +
+		"ePDyjq/Z6MfLU9frj8eclLOTuY/2rIkn/E1+mOBqHi9v36/8xxrnSAo3Z54nP2vGSPMrXt47Ym7/PiIj6Z3FzIukd9obe5D0" +
+		"nmpGC0nv1r/XWA+OXJmVUa40b/yu5LWz5r/Z+zLfIOmdLXUU68HUu5X7diaFiHQkP9Gge55iZdtypZWtXrvGw62p6fj50nyR" +
+		"9E723A+S3ili44Okd94lKbpZTyp+vnnmgSeSZ57G7qSKd7ikRKr5KjW/j0q7xcvbUemfNcSnfHWj55Y3MnqeS57J3sb6Kry8" +
+		"j76x/vw+Vim6yykH5+pf+w3rj+qkLFmdX7lSnFe9FhOvoOc9mHi7WEYPJt4eDQ8Tb1/MHJh4OzvYBxNvb2vyE4XYGmANUfRM" +
+		"it3H6huFKLp7W3ubB00UE+9AbHzCxDvM2WHisbt9wsS7892dqz9rrrS3+c1TEEXzRvY2xad4fky8fDFMPGy058fEu/IUMTa7" +
+		"cjoZ+mTMcDJ0Pt72iU9BUlbntKU1q/NDDvTL1XyHifdqNvKjX16/HOjPV3J3ep43srf5pXti4tXvk/vsjln/rImW9PvPyZ59" +
+		"H0y8MrSeLafnahcTb6/TZuLBxDs+87z1CRPvmOdUzxaq0zdPYSltDRAm3rLnmSFuNqnGkj/PFOXvm/+GnZBVSj8yPmPiHaJI" +
+		"P5h4x22O2eLpa99nS5RNfXrLOHjkvxBFldM4eORte+Kg5j+xlP9OEv9tREbL23ejC1reXidj+UHL2zNPoeUd2/AGIdaPXIlY" +
+		"/6TM/Eur+jNCtjPlCu9WmVkl7c2XxVwet/tYR97GL7S8Gh0SLa+y5njQ8upOx0LLq+lFoeWdIzkU/qXelt/H7wsl1lzJlawO" +
+		"aXGYeMfWUjIzQMlTnHz1/Mevxey6JQrxpgazOl/VYAgKqUEnQ8NIgIl3OIN4MPGOi763Rb9MDRo9z7SB7G2uyc/epl1sTLzS" +
+		"zuSHljS8u9GzfJMfv5Zf7qIJ/Ur2iBGk5q3OF+vHLatzfRgT7zytc7fYVRoXtpyr96R2dZanHM7B9KJYJZ3e4cdjVvPO1T/p" +
+		"i+J9rMN3Dw1qJHfRoPb8N89HuvESE+/BjXrCxFs2ucdrLuMCf5HLPnKYePed/7DYzCpbTobMoZh49WPWDBPvsyd1InZ7v8Qh" +
+		"pudviUOcnpM4xMbnMPG+6WP0y6Ukxa7FfgMm3lHMTWHi1W9Si6gvTWq1R7NLbewccyXyjzEfE+8sdvEw8c5q5Y6J96ZuqUQb" +
+		"zVMS7yP3saKueSaKxzyPfMLEW66UxQxHx8PEK3tSdjAH3Wk3eqLvvCkW60f+W53xdCljSFPOeA/TXvbwmK2U8PLOLz0HL+88" +
+		"7Vrg5RVs9gcvr2BDPXu8h38p1uzXv5/sPSh7R9oqyl79tDwjkU68T7hU+XojkSy9eVijRqxQ9r7OGVD2js2cske/NGbsP7/i" +
+		"/IcUuOSZiPVFHeVcPfUXrzmzMsrejnj+oOwdvzpKpM6aK282WMnd6JK3tTr/tSSr85r2waazffPfyircl7U6P5wB7jkZGvnv" +
+		"sEuSFMs/8x2WXmnOSrD0yvUrC4aO1R2WXv2mF12xWc0zWf7l/fjDiC7/hKW32pUJS2+ly4ell51jLL3KC/fB0qsj/UYM99uO" +
+		"HpbeuTvR3ROpMz3TuToK3rMnUmdaVmLNmX/C0ruNUWHpbbSqsPT47TxYeuU+8x+rsrTxWKznbTN6pvUYPeuqdq3ON+Mslt6e" +
+		"9UFYemfKYvS8zRxYevt3yzNFn76TH3+fjCd0z9Psvv9o9uollptmsd3o+TGD7yGK9uQgYsT0dnhQ99711i6ViAqHFMtNGjrq" +
+		"3n5YSaDu7fmaqHtvn/xKsX0wa6LunXmjUPdychPq3veTp8Qu/JKyP9tSTm2wpCxh2z1SImirl4PP8cfXPDq/j5GU92vKEp9j" +
+		"Wgg+33Hb+QyfL5oGPt8W/eigYX6W/FfE8E2Kbm3th9233yknDfNzqGsaZqm5L9y7lAVx7Vdqp8u0F+y+M/uLR2IiPUktrJv+" +
+		"PYbIc2Rvk5Z//ChcypyTIWuaIydDqaPQ7I+UJHu3ybs6Mc5TjC5WI4h/9Rj5b/D08GVZxD80LsS/nT3Kg/i3LzR0xL9a0j7C" +
+		"KHWufsSjJ63a6HmaoxH/jietLCdDPc9k03nnSjbHNf/pffnqrJKuMymxQOisP+KfU+Ejo+eVcl5OUdWEvc0zXzZUhrQdumdL" +
+		"L2IRj73zIP5td3omi/ho7weL+N0O0cEivrSk5vvh7D3YgMfHahkB8B3M1Jlz9eWXg5PL9O87sfTyFHrNmhSPhi3PREXpeQek" +
+		"1dREVu7pYVbua3J/EknWGxk9z9QZX2V+6G/KyZBz/GOOng+LpTflVCVfM0wbc+iRvc1fWRAbRp6JJEvz/REHlzwF02ZNfrwJ" +
+		"p930gzhY8x/i4L6ZjRAH90OrO2P3fiTFa2HLfWwDzJqIg+9odEuxHNtzZWVHdknF66RLWR9ou2e8ho480wyw5ErMc/ozUuGJ" +
+		"MfCcOT2nOyEVlqGPIRXuuOZPSIXbkiuRClOW3+m5stA9M7v/SIX2YJEKH3SmB6mw7PZokAp3njMPUuG+artIhe+6PiXjE7/k" +
+		"PxGttpQl5yNqnt37oA2esXvPM42enQaGVFg+IyksaqMuUmG5Rp5p/dPzFJ6NegCK4ft+/7GPfEb3NOriGx5PS24dxSlPnPPG" +
+		"/sl/rK7SWoye2zclweKwcj+t3BvdE/vw+O75b+qe5fQ+2IdiGD7Yh+dOizsT59hofcYX+1QyZC++ww/24V7S/o2efPwf7MPy" +
+		"PZN7x0DKfWLN0a3PxAKhg5whexmDsQ/3nDliH+4Ymw/24bGkBdI9kecf7MPak599zy896vzFOZafk6HdmIh9uIsW9mAfvmvK" +
+		"/GdnyVyIffiuXpOD75dey59oe/KftdGZd3Aya/w6E6nTuI59eC5GT+zDd1SSO92Tn9WDfXg+eaaV+5LcEyU+X9PKvWQ0o3su" +
+		"S57CLoKtUdiHZ74Yq6Ql4xerpI3VwhlPSnoA9mF5WsrJl95IUOI15DuU7HsarREO99Vcj3D4qp5DygxulCix3NQfEA6PrMBL" +
+		"ztW1kJJYczU5sAv3RiEcLr8c0Oyf5M4r45un3LhiSfEacmJdQrM/kkK5YN8QwiGW/hPC4ddarIRq88mV0VCULNyvLSnRVFlX" +
+		"hHAYbSmEwz6SMjvcyaHTwpO7PralnKxrW1J062+u9H5dfVqfn2aVErt3J6XlF0npI7VaZXim0bPRH9APdx4OT+iH4k4+oR/e" +
+		"R57Jdkx/QD/c+eA/oR+KePugH5ZPz38DHya5X0gT3ojt0fEffOQHF3HLHI2LuK/GxJKTobxdiGBGF1zEfTPflPgT6e24iLsI" +
+		"Pg8u4n7/Ulpu6oHuuaStGj0Xc0q4iGtqkz9R++S+WJXlSlZJR/67nS3nPyeXzm1wEQtG0IOL+OoL3si5+vL7z5c1CuIiFtEV" +
+		"H1zEInLGg4tYsjNXQgT7eCPn6osTaszEGqtmzMTaa3LoPAy8rdETv/7BTDyOK/clkmzum++3pF7m6PnWkr7xiBRlZyk8xcWu" +
+		"K57inpPS8BS/tNTwFM9fSvyG9BS650MTxVM8o4niKZ68D56Slfsv1UXsylNmzyzpw+zei9UCnmJtdhhqrJJosD+eorkeT/HV" +
+		"13Ol3ufkPjzFwbYQT7EW4xCeYkVufvAU94cWjqd4LKyg8BTP3XfHUzyP332z95XflaiJZ0qm9225z+6f2b3+YoHIj+WmOFUP" +
+		"auIxRv7Dv/2laCj2RFETz92eKGriq50eUiwbncijJtZq7K6JBW+cRU2sn19Z4lWTZ7JM3VMylnEtOeB2fvIUJ7NsC0NNPFiO" +
+		"hZqIjvGEmrjlmUbPnEnUsGP1YtTEIpbrU6N77kmFzuz97G6Ob55ZRfTRXuxu9jMl604S85SBnLFJocX0pBAHrKxRE98ljqfY" +
+		"3fzo/aiJx846BjXxGGZi1MR3zecpWZ+3/zjZqz8OkJZL9/x9BadGojk+Nf7qeR97m8jzT/2dqydv+ol1J2bi/n3yBtjTVk2Y" +
+		"iXtsCTETd7ErH8zEPedEmIlH67mPz5D9BszE87ryFHu3+bIh99tnDTPxSBswQooC8WAm7tsnT+lGgqQSKd372ds87DDgKda9" +
+		"JfXQbqXQOA67rniKG27Hg6e48YZ+atja7GZq2NpOhmrihGSsCY1jz1PoLjX/8cqgK4WnWOg14SmOkmfy6DYb4Sme/Oue8BT5" +
+		"Yz7hKcbzIsxEsSufFm5HS2pnQZ5UuC6rFD2/JuUEec8z2QfTPcNM7L/7wlwqUsPu/illJv6lzFRL7nvs63qmFXilTbcQyLRW" +
+		"zMTSnBJjJtZPyX/z9Aep5kFGrE1rRUbcFzuIISOWNf/FeuqQqnS6/GcXyJiIjFjEEH2QEUv8hJARN9zvBxnxXYd+pRJ7RO72" +
+		"L1ftBRmxihn3hIx4Op0MGbFqdSEjZrWHjPiO3fnP6Zb5DhnxVffyFPbrbBnbz35dyeiQX7MYMuIhquDTQtA+c58zgitXsg5L" +
+		"TbBfP+yStEQsTjnjO5l2xgJzsbZFRny1QDmIpHSmZWWXUt9sYcc+STkjcHLZwo51XoGMePzelqY47qRwlfKNrlDc80wWDj25" +
+		"i/XwN0/+24iMmXieTpswE8/TqqKFKktbwUw8L3M0ZuK7Ks9/VqgsbVt0yD1XZn9P3fJJz/q4RYfckjsd0r5SM0LmZKjF8siZ" +
+		"AJ7i23J9BfuX5ZMrWVbRLFoiKTm7aPYvRSZ8sBb3anWOtbgPM0dYi+vvPzOAsRtr8VzS29lmfjNGPbGNVhOxPDIGN16Vi7M8" +
+		"HMYqitSDw7jdtLgWy6Mt5UTUTV9k2R4LXRzGV9ddpKJjJeUE0v5Xz9m5cobRGK0jjMYP+8GeiCk1Tyl6e5Xi23Qkxb6BJorf" +
+		"WM6W+3wjq4weHdJ8h99YP78rnUA6J8JvrFVN4DfuWEYPfuMem9We0x/ftmcFfuQ/1m89zzxQepWaDhnfnB+/Me/OAvNXu2LN" +
+		"3Vty941SL/YvN7p1Txw6tgE90Tif5GCXy+qk/zzPXRnfSecO3Qo8/q24j0f2aHAfDxEVnh7fybwt38lulOiJQ5dvxHfy0uow" +
+		"IY97Tw64K09ysIfuPKvHdzK1G/uitAL2RZ89b2Sdxqen853cnLD2+E6ae7vRc8szQz0yS/ef76T6pCmiXDwhRoqZ/YQYKWbp" +
+		"gxhZFto7YuS7tlUTV7xHvLtdyiNfk+9k7NZCjDyNL4iRpZvrESOLSAVPzwm5XUrEyHfs8UZ2KYvzQcTI40Nf74k29/0PW4uw" +
+		"JL/J2+lPS/3F8sjapP8sj3KlL2vEwpI8tzVXdixcLZdle+wGemi0V3Jg1/jLgf5s9RqW5MNSAEvyXQ/Ij+XRoKGHJXnR4rAk" +
+		"z8+V++jPGYeMnoeRFUuyNPasWJLvSsO3ZHkUu9Qey6OSK1m1/J4Zv67kPufFT1oEu02UlwdL8hR97cGSPGNjhiVZsmODJVlW" +
+		"50tYkq9Ol//YP29JmeGMXziTNe0KZ/Lh4//gTB7bk/9wEZb8x0LxSOrhV+mZVtm706bRo598pNjP6g0YlK8u6ClGyI9xdsRG" +
+		"/cp/RhCjNQblO7qoJf6RZc992pk9EwzK8/u70j5yT1l4t35S6sRe8LbZozzylDkDDGPGsMrOdx+JTaAX4VOemZd/fMrUPP1y" +
+		"N2YMe5Rf68lBv7zputiV+5NvZITMXuoYiZiqBqNfrnlKmLN5Ci+ellLzwrKbg115xG5gxIvH6fkI+82cjV15/p4ZL56a1I4t" +
+		"dErZCfnlcKK95T+axp5nsjPZ85+dkD33OclPm3dWc7MxGz/2W0p9szhT17FRZ0cwYqNuvYxreXzyRmzUP+aRkZibRqkRG3Xr" +
+		"ZfTKI7up6JVHy7sb6+LbMWKj3pJyiuoMcIS/caQsvJR+qXBzc9+FxpmS8ZC//uNkD/OytLSWJ9RCzwjXyB425uW74lAPP99y" +
+		"70qH/PVoFkTbJ0+xes27OsU5Su7DFTfXjkSw2lKW7KBrgYlglVaWCFbWUHiYx5Wv/sQ6ZeZwGQUX/e3iWx7Lo8sK/Gu8RM6s" +
+		"6FbPlVMcmtP1s16vUqfoA02q2O24pdg425kLOTN7+yFn7iP5YaOtyQFtt+Q+XiBrysLCRrtCzjxXu8rImTWaL3LmHktU5Mx9" +
+		"YdmLnLkXNkPXL06VUtuHFEn2CTnzy5s95Mx4v105AzcKhpzZvsnBN2KREnLm3pLiIcLPHjnzwBV7rlgQ0VlDztysgZEz91ii" +
+		"Ime+s0NSK1ZzruRBsec/NgzsHEPOXLXBK/yNfBXkTKzt58oK/MkzYyeUp8zRBen+Qc7cnm+u5H2w5h3okGwgkTPPPd/PSXe0" +
+		"25AzP/kqYljFugI5s8b6OuTMXzmvzAB5Ju+DT65MbIn8N0fPz+8pdurMOMiZx2F/Aznz6Na9yJnnp+a+yzl7rrSD8smVYi/Q" +
+		"c5AzT4z150qUP5oNcuYhztGDnPkuY9Su0TN74SFnxnYl5MyMiSFnXr/7WPClZGzUl9TEndgSeWbHIFe7NMXdiIycuccaBzlz" +
+		"F2PtwcfcKw95fMy3A3hbY+T9yX+o42mf8SBPDRojl/X/Yf1W/l/jMW7mIWrNg5t5HHajcDPPf57ulDs9yil3/aWQw2ju2Jjn" +
+		"Yx/pio3Q+f/Haf6//rf/8//43//nf/3Mz/j35h/WDuUP0j9jv/+9ZBvzKKn8RWSZwo1wJ0T/3CphJ2RAuY0pnOudN5V48s8U" +
+		"TieS8hfhcwZvOwg9c2fPt7cpXOV+KNKxErryQCI9dsJGaCvjKIQXYWXp4Y02uZ9uPxV+U84io+LKucR8U969yGiOPOUv0PSf" +
+		"sMpol1GVUVX4U+GrCqkq5FT4Co3SZHSqOho6rEkTjLh8E2ysyajIiAUrWEmD2S3fhJvonlllhMcECdJGU6TAj7siNc9EPQbs" +
+		"aKN7poMeNI7Gc6aExgHD0WzVlGA4EDfaGHIfeabvPlSdoREBo40hd/oeMEUbc6FVvjkCvr3RLSNnv1AUDZCnwE0UnIk2bs+8" +
+		"Y8sr90d9Un4wIdp4vNGz/KxIX+H18e7ZFps9sBnpyjd2zLN7tWseK5dFl1n0Dvb5M6h3qGZTWAkt9TfPnDPOm8Jq3udrXnrH" +
+		"n0XkFHrmXIe+Kc/UOziMlkXvWA63z/ngTbld7xCAaJ7xTOEglPvBd+yU0SajU0anwu+KdCr8Kfe5nVwWXWYphVBGBTWhzkqG" +
+		"9nxTjvDrSqjw1b5rVc5TRlU5Z3dqzivKUhOPdraQa6qrBRaj4GE0UYnKknWvfkRhLNAXBVuiXboMtkQBh3iFipQD2uGNmtek" +
+		"MUAbNAGWC7RBwTRol46AaVDgC9qlIyxxkLjUvI4QxgAQQLsutZTjOC2Zl2BZMt/NBt3AUcsSE9g0RR1h1RRXozfXwbIavde0" +
+		"z7lr8qb2387nK7yN86uBetUU76ntllVTXLU64VHLqtWt50romQbVdbaedhu91/P4HSRPodtP9F1tCW6prNrSWjxT+1xLqNKz" +
+		"QoRnLH9RNqfwmkLtcy3iQGhg9+7K6jWr3A9FqtaaGtg9VbayVlvBc7xuVl9vavxiik6hIlUZaWC3RosHUDj0v0JVx+iV3327" +
+		"i4zYXXGLfoWeeUfomcMzNQZuyu3Wln6OyY/XvLwRCzsuxQ0WqqwBcmsht7a0aSGbFnJPpaNsWsimhdxzj/RNHYQq5P4dxFAd" +
+		"/r+6xHvlcIP8pmXLm+I5vXvbx6NnA3t/5KdFbka3TTt7qCPb4UoDmdPfGYJrCk/Cm7D8MD9/wqmxl02T2rSeZ5oVvilbrMWV" +
+		"htGtiM+k9YC5vykZaShO6N+UPdnZChosYdmaBU3zTC2SJ3PhivwKZWTQ4XvbHo2P723hPNvAicsWKLU5FbW08JMtXGJfoXKa" +
+		"U3mxvkK1FD+syzPnpn+JwyqP01cod5ZXnCGbMDyFM2ThBdmerkjmP+6PzcKr7LMtvT8+h7a7b6juGsMzN+/LrjHsu685tfmy" +
+		"7znbkLtmuhtf9sMbmfv3g+ecCQwI+k2BBh4yenKljM4voYxO5Tw1Bk1xNxLts9n0j3Fw12z+IGZT6PaCYV9ypXKWBAG6plCr" +
+		"28v949tPoduNRPscSjoqa9kNJRwe+2dRJAtuno79M9dKhZdi4YLYmWIWboaFR2Hn0VR4FBYubJ1LbOHCVviu9c8ZYa70RhpY" +
+		"3NR4lr1ChTe+cP/q3FdLHL54er1CFfKE4eg151ZtOQw6xxxm+secesz28v6UKZzmneXYuPBs3n2eBb+pnHR4d63u2OFmdkWa" +
+		"e6blMFwch5rXwI4DpODwzMszD8+cDaU7iil/0bDmZr4KMTgeGsNRXPm4srpyzitddKo3pfBzCnmFylmZv8wBon9ph7zcCqe1" +
+		"V3gTqpCp63dewSXeahzTXqHC0/X5knUE3MJ7rHD/6l8rIu5fhd9XF1e38PsqXLw6t7/CxavwvepiwxW+V4XTVQfEK3G64m3V" +
+		"v5ZJ8bbiWPUKc7t3n4NVZ99ejuyN3N79UJ80eP5A/WsA5A9UuP50eIhyxJxI+/xSBI+whx7lLOrT4TU/m/61IuJnU7jUdMvs" +
+		"cmqf55Yrb0KnwTthc6Vx6Zx6T/9qn+e5/fjXf0JLmvMUFu5US3Mvppza0llcObcs3pSg0RrYd54tllMDO6vXNNaddOjTwMJL" +
+		"sZwGlrOqee3zNLCcaXXWOXyFCkegzgSkcAQqPIA6W9XCA6hw/XmFXlNT5K3zCuXOHohLTQftKFxqCh+SvljS8CEp3D768g/6" +
+		"8/9Xn+ASUngrvDfIz+fnmNDBWgrHhMIH4RW6Ep2K20BftDNuA4UXQMfvL8XnL1Ml7ss035gMwimcjW+ZfinT1CiWIVM4MyoU" +
+		"5LK7nZZXzGpF6+GGUYrhqczVWV8Mo8VORjl8AM20mOqKMYsN9psSLWSOUt2m0JvyzNNHtZovFnDl9FWs5osFXDG6idAxQ8dO" +
+		"tplnWtmUGqHXNLaW6jWr19TKS1VOQx5KSym2NzhXdEbbhQNF4TnRFzomz4lS0s7mUVfhFlF4QPRlEvUKD4jC9aGv5um4PvBy" +
+		"6CzjSwmferbBVygj4yBfgu4MtvAlKIyoO7xuYTZd2Ev3VQOLhTTz5w7BX+pUl9+fnXAQnoRytwlUzWpVW1ptAlVtqWpL69xY" +
+		"fVOeaVYT86pUg049XGl0q5b49ZC7FW2lGVctZNWW6ul2wxNbnlINT9XwtBrdqsZQa66UkU9cqzcaimSqq1MX7khnpZrqalXJ" +
+		"hryYfrP57qKiFzbfhXl3X+9c6ZmmOpEGSyy5GTV3RjyFUXNhv9xx6wv75cLot29GN0a/RXTzzhqwiG5eGOW+Qs9MmN65Kn+F" +
+		"nslygsls3wxkjGQLS9ZX6Ls7Y2P+2eFo3iZvY9x337YIcSPnWrtv5tS22bCdm6KdDfabcvt+Es5yNo2h+e7bPGF4U4f9Xq9p" +
+		"ndqotk1/36h3zSduxe2mz2aR3eZH7Zv1Q7POab7mZlJs1jkig/eNJsfKtTBo7Zu2xKC1sDrtPM9KLEuZhvbNooJpaGEF2nkT" +
+		"FFaghcFnB0gqMfhko9nFcypsNAsDzL5pdQwwCwvKvploY0HJdLJvmiLTycJmsosvXlhJFkaPfbtVsmbT0kKsNFq45GkhFhVM" +
+		"Bgt7ub5riuzlCkO5vhuCGMoVFnKvcN7eNZs+Z6BXeBOCuM3W01lZvKnrd5b0J7Tj0q2y+mw9r3BWXbfk7nOD/RXKSFvq1OXd" +
+		"5kqnLnfz0W5rvJuP+uGNrG/70X6RTKdQRuajrtXxiHjHYhn57vuh8L47a7a+G5dYsxWGax3aqzBVK+zQOsZdYXlWGJL1P7rM" +
+		"f+gTPZg/yiy+ZWFdVhiS9V3jSwhhtl/dWW5JfGDmWn238RJzLfZJfbe2SKxbpjp9p6YNH3Xo4Yc2MfYIZ4M+7CcP+sSgTzi3" +
+		"K4M+MegTh4YyfL8xP84rvAkL4WxSh2XEoDoMWsJhxTBoCcOnOjSU4VMNK1o0/Dd1/2yC/oQWB4yBCrufztamiC9bmF68QlfG" +
+		"AO327oaSEXLq3Pnqx8TglRGI1dT8++Hzs48ojB/6YSiJ8QNLgC7CXrlU8qXpH9Mo5E0thF7TovBSddep5o0F18kc+lTzVOnL" +
+		"BuZlbD3sJVzG1itVp9tf5R9O0hR2QrdPJf/tKspZwsFphPMbMR0obAbebtQIL8Kb8CIMUHhmhPRenOgXx+6vcBC60kx5moGc" +
+		"sBeH6a9Q4X8O5Z1QRvxwHER34U6Kg+jizLnzRS6J1uh4+RXKyJzqRLizKy9XIn7ZdRA0qFwJm0DXP+1POK8tjmb7aQJzNFuc" +
+		"mr5CGVHaHJd2bhMloQSdk75C9Wl/wgFph6qeEdSnQa030hRvO1j3JnfK0O3U8tbATpsWtwZ2a2CnReGtgd3m6dPpwG0YvTWb" +
+		"k2J9azZ3USRN8babfdPLz5uQXn5PTbxj+r8pMVeoYvCObwpeyPpPLJpyJwaDybt85G7yFgfxFaoQk7fQhe/gLSOtTnzBjmtX" +
+		"El9QYMEOX1nuGCjbiig2LcQQLML+vUIZBUhoTC42LRKpT1C+ziGnJCifCHi9aMli3hWB6TosRBGYrohI9wqV80dmVniNNsHn" +
+		"xIvrrF7L/aMvzUouNHhB3YoobR2BtYjSVoRSe4XzjR5D0KMtFe3zsQB4tJBCg3+0kOdw5SQClcdp9WNfqtClHvtSj8EK1+FN" +
+		"oUsbrIrl3GOwejSwoik+GthTcrvcC0Cg7cxCrX9sZz4G/2KN9xj8H02R996b8kyrAmbjb8rtaXV2bcWWK09anZ0MQeXKk1Zn" +
+		"ihQ/rjxpdZq3sG5FPLde7GSI51YEa+vVZCpYWxG3rIv1XsQtK0KN9WrefAKUNMtUar0oWEX4qz6dKv5dnxAaq4iC1euWp3j0" +
+		"PInpVTNN4CoRq16ht30SwdDtcx1QPxtTrzkgdR5fb4qXlYUmDFn9bNwu6YN1Nuj62bDCdvUyD1nrZ4dKnBpEr3PAfVN8oS00" +
+		"62zQ9XPI/ciVbp8t8v2ZDaVOx4L6mRsT9XNG+ExhcbvWU2uEXCXsbvHrrZ+KgW+DAarnTXlNq5A6G/Sbwgmwl1CHWoLwFlur" +
+		"16GWmtdsbp9bEVUArCryVRfJqIp1VQW56uDw9RMju+6ZtwoJFZs2yrGtfoIuMJCJolnFkKqCQHUwoCoIVBVx6RV6JosvoXo6" +
+		"o6n6Cc7S+MLyu4rRU4Xj6cJ8VAF4qug6nSd2ZZlTv3Pz4RU+hAmJ5vY5ec8AaYkFPYWVENpsKp6vcGb01WzY4vQ2t8oqW5wJ" +
+		"f/wTzsGxssypbHFe4U54ED6EMjrdTg1t04hw2mZOoYyK3LWlr90mwOY3JeSbFtKaKytuoHVq667UQsQB6m0OeZUVTRXyp7eh" +
+		"nM3t5iq0/CpqThVupbfLu/+gWmr+kTv6IFuV3qftSGWyUkU66az66y+aiY3r/lV1jys1hv6Vu8YgyMgrlFEC3PnuGPF1MTIw" +
+		"KOmCt9Vlftu62GDoc6n3pnBPrDT6NEitDEreHxnNo8y67Ly8fHfua5V5yfujnHOpV5mXVAYlr1BGh4xMS5Ne/5c6CD1z7k9U" +
+		"tiOVuUfv2tJS44Gs6opn1vZzS55Ct8/dpip4SIdqqEvCfjoZ6U05eakKCdJ780zh6ETx6CKRVlE8qvAdvffcLrCori22cBVj" +
+		"ozIM6cxTq3AaVeSM3jUwkTMqw5AODVkFu6jMRHq/3H4pkv7eL1WX4IW2DfrjG2E0CHrQu6bI+KOy7Ohj2m3WdUPk10Kg8d+U" +
+		"ALkGgaHVMf6oqwXqmOablfFHXa1FRWZ/U6JIazZjHqC/qUI4C890rzITeX8WwoPQM49cKXcTymrVOrZcCfRpDMGLqOvJf2kO" +
+		"JZ3pXl2nalQZlPSh0TIoqQxK+jCZ/nmbTvcnue9un0NCFZyhc/qubDkqI45XqJzx9TNNDBPfmrDapgl0n5p4BwId9Gl796/6" +
+		"RBUEoYp+0EWRqqIfVAELOqRRFaKgijHQkXGqGANVcIHOWK8KLlDFEeh8O6o4AlUggM5Yr0L/V5T/Pi7fL6RV+vW4ZBQHMqs6" +
+		"nMnKgKWC0/dxaZHmKuT5PuYqubJAqSxQOhO8ygKlsjnp11To3lQlvAkb4XwjBiVvx5lvxKCksh15hfONNo2PtcgrlNFcgdVN" +
+		"OxOk5k3dgjy4fS5NKtuRujmru0zJW3E7Fefa5E7F2ag4bOjqRsXZqDjX5plUHAYlnWFdZVBScfa7+F11S6w7x3LXoZzgKFjw" +
+		"nX9kxYKvIPD9mq74FQS+or935OaK/l7B3F9hJ1TJ1v3XtAiprEwqgPorVHhjFnZ5ZxlXWZJUuPF+0eTgxiszkX7NVUjdfXfW" +
+		"Iv3SwHbffZ/6S780sH3DnqfNCEpYd9rMTkdhGfemeEwZdK65tqhsR+pu0MFkrbtBZ6ejXHOVXJmXVAYlr1BGB1bTbBNdGOO6" +
+		"G3R2s9r9UU6z2u6UjblcZV5SY1CCOFgZlNQYlLChmzSoicKYud8GXIzuCs7db+MgOHfF4e7it9SQt4G0O2hMZTtS8a77PX1R" +
+		"a3jXTEg6I7gKdF1jUHJrYPvPA60QdkLPNLDc1g+o0hVOurN3q3DSFTm6i8BZkaMr2PMrlBFdCp+580iu4TMDHve7+pqgIDEo" +
+		"uQ2ADErqYTf7NiUzBXl/ZKSBHRoDw5BXuBMK+WAGujWw40BsceR6z4OAykykHiYb5nL1MNnETOS2KmAm8v4QPq4sbneKD8z0" +
+		"ptxOYcUSq0dIdD7HY7ACya3ouP3Z3I47EZuMZ/NGQDvYtV1E9HqECmHwh7etMLUVRfQVznIygJgs/z/hPBV6U1AY6vOZh4mV" +
+		"AUQ9bUUINFqZQ7w/rjQIsHV4fwZhJQR6Myo+Q+5GxZg1CO1bmTW8P16ThnRW3qiMqBiQVbYONbYODMgqEmqF3uwMyCr0ZkUL" +
+		"HDweKlpgZRIwYEcrk4BaZoWMz+Q0VMf+7885heqz0Hsc9I8PJbioJQf9g5t5ddD//rjSit0Jf3UoPz5WWQ7la5ma8fichDTj" +
+		"MqtgTBusf9cnSoWjqN7W9yvW01CH41MV19LZwfv4TN/b6vy9OngfHx/V+Xt1xj5EAK3YgRUmcHys0WECq2P48fGlSyI7dxlZ" +
+		"uAMDVgzA8Rkqy5gF2ze4oFegvoq0N5DiKtJehdgbjLZqEHuYeeNjNY+ZV2HuhhBLFcquYtGND/0ai67Czg1BOyvsXEWYG3gZ" +
+		"FWGugsK9QhkF6DGHrvE1ByC+VdYGr1A5LfEZHbzaea5sPxbdFM7RrWqRjA7Gl35dbQIxOhhsvirbg/enEV6EHbtmNugvxaXS" +
+		"7usud6o024NaNWjmXZUJQmV0MJh3vSk8nCNXysiIyehgfDXoasSsp8KfcrfuZ4kweGjWavqs+sPXtgG7hPenEg5C0bem1jW+" +
+		"dPZaXVmV06Kw1n9IPVN4EirnHErGt6lPQ0ltPpyOAHNX8e3Gt3kjfqXMGsbX8jEoO9y48dVlcOMq8NvgyFt/4Dcd4UsFx3ir" +
+		"cG7ja6XI8qEisA3GZbWGPTS0JeNg0GtoaeNrVkNLq8Bo42tWYyNRMdCGmOEVA60Cl42vLsMqouKJDZz1iidWazoCDR5IrGKG" +
+		"vatHGSHiMI54hTKy54HFNRZLUiyuCsI12JFVEK6KvjVEcKnoW5WlxWBcVllavD8b4UbYcJDmG4GFV/YT78/8mgsFq1EI2iF3" +
+		"02ejELRD7ob7pnm3Q+57njmbTZsNejBKe1MHoWca7plfVOYXr/AkdGVRIXZt2zwwfH8U/szts4W02cqHwGxvilOz5r1YALDe" +
+		"qKw3BvuzynqjMs0Yi96BNVZBxsZiRoAVq7hgr1CRbK4Agg3AkgoIVrG/xkI/+4f9pfAtt1/C8qi6rkjWD8BNg61YBW6qiE1j" +
+		"0WgRm2pLA9NoYZUqZtJY6Pq4SBUCaUDoVgikygpjiIhbGWNUVhivUJEgL3GKXuEsEpuM92c+U+iRN7UR7oQH4UFYCCthJXwI" +
+		"Z5H63GqpDDXGuridktE12tWOC0ONyrZirDOE9ZsCkTZUrloyS4vaa4QKb4u0V69pTO72z7oW8rfx8h/6BFRTRV4a66kQhjzI" +
+		"pbFSVSCXKmbSEL29hpkEjzRW6wB4pIqE9ApVFn0COmastvugYyoayhCR6h0UZ2WNfBWq37B8HLPKxzopdnUYC8amCqwUhy0v" +
+		"5heD53hlfvH+zAYtaGhljFFHvoqV4rApOowaLM7qMGqMuWB8ha60783SYjBDqywt6jCriePwptxe3W5/YpjVhlkN7/5NbYSz" +
+		"lrjSv6mT8CLM7bPmgV2GMJ41YBc8krEZ8vBIKhDJ2A7vbrLBHBnIWXWE7Dmnl8HirEKIVLSQsVkpooVUuI8hDHeF+6gsOwbb" +
+		"tIp8USEp3s+n8GYLdh6vcGbEzuP9ITQlX3aGGH+MzYKD8Udl/DG27nbd6crnoJuy3qhXPsc0w66Xz3FRMjbN5rK1cxmFGYK9" +
+		"KRlVVWeD4Zof5/3xTEdFl6EZmWLsFoUhU4BQvELlNDTjTQzRxiujkQoR8QplpB+hM7xCtzuogmV4G7nC0ycQGF6hK51egSYM" +
+		"IT4rMEJFNhhie1Rkgwpp8PYRVReIwaXwtiLYh1T4gbHb1mIRUpEGBuuwijRQQQXGbisCVKDiAAzxGCrP/8p1f+waGPuQyjDk" +
+		"FSqnOYB7/tg1MO75lf/9QB2pLEnqRfPYnUkxGqnMRMZuf4KZyPuzEVZCoZSo4Lv2yZKksh0Z+zQoqWxH6r3LSKO9aR4MQ8Y+" +
+		"QV/1tii8p64xmJrV277UfXgmne+2L3Wfap7Od58htap5KyJWJu+Pwk8me71pHvfUNQZQ7ptqhL7RkyvnN2KPMg6Nlj1KZY/y" +
+		"CuVuXLqNS4eWfBuXbuPSoSXftG2mJ6/QlSaU22DFfK3eBqvbAvVYZGSBeptlDo321hEYqbzCQegbWaAiP1dGKpWRyitUn/bP" +
+		"GKkMhm6VkUplpDIOKyJGKvUebneOwGSl3pfc6Xz3lWBbcjdUslypt45wOPy6dQTmLOMwVDJnqQxYXqHbKUP3LXfN+3bCeBs/" +
+		"j6JIxk/mLO9UpfA6AnOWcaQlW4veZthDS360+UfzBhitjFsqq5bB9q4+ZtjHDPsXveo/9IlnS9glxZ2s4je1EXq0ofkx0T6H" +
+		"arUZ8Dh9fKjnzPre1CD0DtTJx/Y+g5lx2At6ThnR2Q8jO/OZ96cQqgLdiU3NYBVY2dRUVjTjmPidN+V23emwl/DY5mVoMw6r" +
+		"JYY2lWnNEMXvTc3+wNBmsAqsDG3en5WwEio8Le/Uxx4KHUObV+OQu3XqM6eXcS7e3X7Po4+dlIxHH3uaIuljD+2eac04J6nl" +
+		"TXlNK9rTxPBY0T5U/tMGw5OY33MXbjA/rA8l4xmeabX02NphrvMKvZGzCeY6g6VhfcxAjxmIUeGbUs55pPUKB6EWOU+2BkvD" +
+		"N6WFWIWcZ25X8/qYECNvSiVTQxE135TX1PFOEwNLoPqYgU7nf4+Ox/ZnnNNbpzIBen+8poURS6D3RzltGzxPnqmc8yi6MQ96" +
+		"f3zNue/dWAK9P26f22+NXVD7zD42WBq2z5yBGkugcc5+1FgCtQ8N6ZxTSGMJ1FgCDTaJb8qV9N3zVqSp774/gzC3T8985kHj" +
+		"nP2oMQ9qH13mnMrQm1J4a1+Gim+qEDZCGRVvpHeUj9v5a3+q22d8lPaBZWFINBgqvinltAYqi3evykkVK4uMmiLNw+AhOkVj" +
+		"SPT+3IRecy6PG9OhV+g1eft/bHyyXmwfBBaGRK/Q55i9ozEkeoXKOdSSRlt2RUKO+Jgt2CS2z2zC7UNtKqcr0VI+Gm051dIt" +
+		"99szi2YDK/AxW7BJfFNupwyV6plPrvTuc6usAQU1aKBR5mlgCyEI1GewXnxTJ6F3nxtgjSFRY0g0ikYbxA9DolGGZ/IBB/UZ" +
+		"ZXjmbIONddFgqNiC+PnaUCzTaudNFUJvdMtIU/xS68vUkNp3tqzG5GiUJ0K5p4E9Cq+BIf0MMXobO6TGDukVzqb4bTKaDWx1" +
+		"4tCC+MH2WT5a3Xe2rPadTWr5TEZp+0IHfGcDWwRWb0H8fLtnTo/ihvTTYHxWmOL2Ha6cA+6yHK5E84HxWT6a93co/NxLfzBz" +
+		"G6xOg9VZ/qpnCtX8XLm9wpVwEF4yUvPa0veR+/RYabA6jW3TwlmysW16fyqhIml1kDvv7ed/6BNtwbSA3BlivjTIncaaabD/" +
+		"bJg5baF017kGelOuNGbVuXRubJQavs2ok1r4piqhK3cZzRn9/bkIc/v8qDA3o+5y16TYL43qqywQI/g2r1CRIJpYJS3HdDts" +
+		"y1yct2VOn8t+RXgSzk8lukJjctTYGi1C07XlUs45oiwcNBrToYYUM+rcbGwBxjAdGtX4wnSoMQgakNCNQVBjEDTq3GxsDILa" +
+		"mpovrlTza2q+ysgUsqbm52ZjY7zTVovsOhmNb2ohVE6jxlqVU7+tRo0QX+BchkDFLRyXNV10Rptuqy7K/uWdwhVpjrSN5crz" +
+		"GNlZrjSWK6OaFLe5/mssV0Y1KW5YGFuqbi62Gj5KY7ky6tw2aCxX3h9vNFXGFjDKZhitj9wNo2xchlCUjY3L+7MSyh0tZTty" +
+		"pXJOPbkxfBntmytnhTB8GcIINYYvbSueOVdgb0qRtM+2KKeqY+exfucKrLHzaIw4RptOZW2nozDpGM1Ux6SjQYWMtuf2Wc5d" +
+		"LYnX1Vh9vD+zG7a5bdBYfbQ9FaIlM/5oWB8DrPRNuZ1a3065Y7/tefciI+++a3WtKLxWt+fdq4y8u+jry3dSC5u46Q3PY/nq" +
+		"sIKIN8YRH566TaztJjz2Bzq+MYdo2BuftbvSMCoQ82edPk2NAUQDwRjIrm9qJ5zNhtHrmzoJ5/zX5r5pYxXRDupdm6usxgCi" +
+		"HWkh0/zwTc0Wclg7sYR9Uxuhqrs9U9UdNhiaNn/A5h1zcByNIsgqoh12CNrcKmtQGu1wHtfNlGwkGnjGgGNswBMNcWLhHtnE" +
+		"9234E8tOvcOfaKwiXqHXhM6BoXiFnnkp/Pwqi12xJoZvE7x3sSvWBO9tovYuvCubqL1NuN6Fd2UTrreJ07uIBNfE6W0C9C77" +
+		"5jWNNqLoLvu06W5sMtqpH3X67qkfnQ4X+txHaScEI2DFK9wJZyUz4hgsi9upHzHpGH2uBhuTjgZYMcSkfFOF0JWbK33i08jQ" +
+		"NxkZGU5qPSPihnDRoC1Gn9Zvb0o5aV0sixvQRWMRMvrudmo9c49XeBO6PY3hkLtuKNTtyuO9wVc00WnXdC7RaZswsesf/Os/" +
+		"9AlBYxuYxeoYookI24SCXVeqtOCvTdTXdZ1Lvcb0pAm/uq4TptsEWW1ipr63Rzj7mKCn69pVwaVaL7dTvkQtbcKVruuI0Je+" +
+		"5G72FX20CTS6rk3h4cXEDV05/TemLq2k9dA8gC5arFr63IlqrFreQdHnL243FsSApVe3ayiF3tOpI8gRreT7dbd3V85+uy5G" +
+		"TLYcjW3FslFxRO9rAuAtW658IqSiTsJ7Q2toaA2vcPYc9hPvj8ZHS6gWxLF16Beh12TdMDpdGGehASyMfufK2UlirNDnHlJj" +
+		"rPD+qCWTd9VJYqwwjG5V02ez8ApvwoVw5j70cBYM708nlDumaCwYsFYbC4YWC4YxMb+NQUKrqeTkrpJrKnkichu2Qaup5En9" +
+		"b07qmyP6z2du9zWH8s0x/AIe1RzDvz+L3HfCldAbGV8aDQkFYYw1t8/CN8rQmE4Kb2ojnOMLy/DWaJyNQjDmPm1rFIKc9w+D" +
+		"jvP+hpfwCj3TTobD/MEy/E1dhL7RoUjWlMgKY1AynOk3ZIUxqMuO9t8f7z6PR9+Ud8/nOBXemOV8ev3YIXDA3HpqabLnm5Pl" +
+		"5kj5FTbCk7ASXoRzZHCkPEbzTMplp5ePudvUIABapxAwHm8OmN8fhTcEdctxTv9jdBmhr3X2PeKbNAfT74/C21jqOJA5nx46" +
+		"l/Pp1p2yjQkxf1MKb2sH1LV1+NBO1wd1fVOzMfTU5yMj9SkUx/rx7vzt20hTtHAfIRirustuk6Ph96cTNsLxIwNP4UWIJasp" +
+		"sj1/U4EAN0IZqc9BLwd1fVNyN4awPW+c91sOkS/qiEPkxkF/MEhv44RKVp8M0ptD5JYD30uXceDbhlq6drerJcE+PgJ0txGw" +
+		"8FRRX+FsDM5vm3ANn2115eVKuqmoz805bLusGy9d5tr+YclO4U54IcTOz3HZwbqoIzmcFZ2qOZx9f2ajvQwsl5GWu/64TD3c" +
+		"9d+f2eqYrjfO+xMbO4W5MrfLSJdx4Pv+uH36374pzNupoo5rnndMiOwkxM6RgZF7u737rW/OUAT/rk84Imx3Gp89qzsA1lSB" +
+		"nsN3fJJJ/4T2rBwRvj9ut9F6ayg5Kbx0EieF7TZPXzqJI8L3R73oD7d6yUnhbWK4S3ClG+FOCC1qn/amid+hsjrFuHUSnuvt" +
+		"ptCxdW93qKwUunueTbwpAFbbaDdt9A6AVYO+l9yOKapB3zoJT+uJ5ZzCQYhCqT5vzZSndXMONMTNalysG9/qcZsDnhAjtbNb" +
+		"z3Fw1HIqxH6+OQBqjnxe4U0od2ovU/nmAKg58hniZ7bHGuhJLdlHeUzzOda5aXKcqt+f+YmZyjdnPe/PQ/hXS93pQHceMNjP" +
+		"vyl8RRPtPY223tT9I0ZO4T2Fh9u1unuex3V7/j07+ffccel28vsn3/3yzAASDeJM5d/U90eMnMJKiANpf579fP9AiH3yiR+F" +
+		"5/b7CSh9ts83he5IyR9zo/VNeU078uPrSh6+vHgbP7f+4TBhf76N2Wz6JwROO/Jjxo/pHH0ncHLi5MsU8sTi6NvGKSMOE58w" +
+		"0U9F4lLzCRO95EqkUCTpMS3t+4eF/ofKz9PsTSlnxt2qnHy2nA68QuW8lPPKqK+cYCufO6O+K/lsfe5Q3nPl9sNdTqHvDrby" +
+		"uT3zVvjn82NgTqHX5LvKdbmNW0aPCnlUyKN9wnPwYG5jgt26c4Qu4MDgItGdKnQhBl7hToia6IAZC7gLKvCueecg8MwJrHNd" +
+		"7g4ehsgeXWyBnmOIZ07J3TFE/5psnkVG/EK+hotnVSROiLyUX6HcSyiUsxvyxejOEfqXWs/Don95PHyp9QKY9Hg3Ozl4hcrJ" +
+		"ueGrIwgC3p0j9EQMuD6qjg8jf+Z2LRGiZbZQ3jdChQ/+fCME3EpwgOtwe0ihHVZ8arFvyu02sq55qNSdKrw/ZvuiPoMP1Tuu" +
+		"uTzu35BCh3JqyYIDvD8ymkvZicmcYEyveUWI6qkjiJTS45rNJ7uZud6UZ+oI98eV2rzAAe1eXclf7avN3zPuZ/+GPuqE495y" +
+		"pYwC4c4nxnwQTaAJytXjxP0NhHuPUKN1wiGcVv8GSfqENT7LKYxA59I9nv+0x+wCDvQlnWSqVH3RSRx7jGdu2vcFAmCx9n3m" +
+		"DNQXjr4iDQx46S7gQOcDPp55PNMdl/TlzJVztlgCnHSu9jS56w+LSfGZ2tObQqE03PMO6ly6e847hFjvQP7vDy64draEGOlU" +
+		"9p7hhN4UYqSmf/dBeBH6/CPC58fAnML5AeIfzjG83ZcPoOnn0OUxr3D+7svA8NbHHMF0RzBNtPjuCKYvYXhvXjMIVLuTzyYj" +
+		"TX/R9J89t8vIxPAcXpN/+BKw99wEelO+pr2gxyC+aPpLwN5D4bnXLc6En6lxdgEHukgD7bndDgyduANimHcBB7pIA93hZV/w" +
+		"CxasVUeSb0qFoJ58jC881/sSPvTcm+lOmvqa8do3inu5Y6TBWavHvXxNU5yLwu786P3JlY0QxDLj9Ty47XEa5yY+xIrs3MS7" +
+		"QAXXZ56hdPEKuuOnC+27O37qwg+8wk6oSFN3uz5f5eQLvM6WfAkfNimbU0jTOb1mC1fTxF4ihNBsoWgXQrjLHu1pNlphBHpc" +
+		"zz+m+RUCZ+1Rf5STI/r601/UfNinsCWfGf6tr0FoGss/ms2qecfn/GMUXkdQn4Fby50H+pqxPLU0wv8M8VotGdm5nr9Ct3MU" +
+		"XbXy79ftCE/rFQy2whvuV5rO16wWX/V4p38N4rzTu/AKr3qg8FScNaP+HqGM7vOnQUxh6KMyOpWT3rP+mNMRemYG+Oo1ubqL" +
+		"uTBnp//5A39Ckm7a0uQldEeE70+ZwrlQ6Tzeu5gLr7ASjh8pdApnSxYtoTsNvD5zSdOdBnangZd4iW9qI5T7PFDr3OC708CL" +
+		"12J3Gti3U5EOtyPEbukdh8LrHY4Ir8/pyiKj4kpTz1YAPIuMztwuo3SZed7/psIUpVYYlzYqjrgKfZmx697U+DFFp7AT5pmG" +
+		"0a8rwxTtYUB7pt6xZbg/cyVOKfjCYpbhRd/jRb8YfkVumEjSKfTM0EczB7RcqfCZA5oK0TuEbOh/B/Z/wkvV/Yb7CN1+ZRRW" +
+		"JG1++43s3p2Ks90ZhVdCLQSEZnn+y37ivdKn0vRXw/3GGXtLD7Cu2nSEDSJt/crvCQL1/A3w/wOIOoUGj11xsUyElniF2oSe" +
+		"s/0Qy/N2Z8ld3IiLC2vf988PQvontKLdgW0AAa7PPNroAk30PZ1kHoL0PbTTOWlcn7nV+abaj0z6J7TMDRcACeD60FGEluh7" +
+		"ppDhdmzlPZ3EgnjHW9vTSaa5wJuSe+aVeXTawwXY00lMdbv1tCgS1+dRJCuGvSrn4zVxcZxPX4IRTHTqFBrkzgjxWzPnlAjB" +
+		"S3Wn1ZI0rIE9c44Gvfd/WKv/i2liCgFR9bF1Wlq8KUXqGaFd+YOs+vzTr7UHK7CnWxianY73gASc9fREtBDK4u0Ig1DuWvl2" +
+		"K3xwrFrydrtSE05gi90AsWvC4lu8QoXXkncj+77kSt9IS95n1Nq+B+arJe/WFs7mu8AWFz/hLrBFByd4hbMxOIbvwk5c38Xt" +
+		"Z4RzuOfo252/vz87oWcCTziGf4Wz8AfazVHkPo9L+lHkbmjm6NsdyvdDq/uaLQ5D86HVfc0WCXNxzHZ2fQ9FqnCscwvq+s6T" +
+		"kQ5j0MW36I6339T6o7lO4Up4EPp+m8KHJWuA32nGRwv3VX+whDps7RxpptqnEBj9SDO1/Di01iOY4Z4r0VyDGaYhJUrGodHu" +
+		"NkIOc8AxwgJWJJiGgBl2i4oQGaAY+mGoDHyBYcGswf/BoJ3CVIGM6DZsATqT8Dc1X/qg1Jy6zGG4P5BzT5tVjAfenzBhcyU+" +
+		"rf5wGpMPQ/qhP5xGhkNHEEjjFSqnNi+QRj9LhNpSsKpF7kbv44dVna2OBcP7c2shNyGe6q4lz43PSZadQo122uL0E9XlTJex" +
+		"K8ZY4S2g26dRdk/kDRYMF1/uzoKhn+kdZl8WDO9PrvRMzI4zHaErvOFXAI7r25XTLs6ZkXYa03QmCB1xop8UAmE1esJqnC3C" +
+		"U8qL+e5nIMCa/jnDFvcE2Th78K+z5k+aPwuGfj4RykHzLpoNm4XOWOEVnoRaFhWnUMHPkIE16GKsO0egtWina4QKT6kp63/q" +
+		"E8wa3p/AWRVXR2Dr0MsR4fVD307sqHeglzNW6IU+eKYl30GEElqLCvjRq+V44n4I+NH/EAdTKKMwN+mDDCDeH43PcqcYr0sa" +
+		"37SK7wwg3p+pOnwpX3ge81X+hLY6C74UU4nra55OjA6mEpdj+F5CZdUil3n62Ev5hwn7J5wHjW9Kndn+rvTWokklgkYtESqS" +
+		"JlX1nMTTKEFhTnOWnngaAmn0OsnqHd3j/VGfPUKNQTur9NYSwq+WVSe5pZc0MOvNSpkt2lnRzuold+MnrEevJu+SBkZZqI83" +
+		"ojOUYFxnMM83pWVRpVvqMy2LRt2Mg+UKphbkcYvQM427YoK/KR/OuNtsWuCAdACQ3uimJShh6kg7FEmjLRptM7aW8IVtqTcK" +
+		"FjhIRwXpLPjelIy05GaASHwSpi699VyZHuCZKpmNy/szVYdlWlq8KZ9x7ha+wpswfFqtbppf9AQtqcZrvISOGPL+TIV1mYZg" +
+		"b+r88WmncDYwdjPvz0M46zOhSAQfuYTm6ZAfvaYjHDIyNAs38go9E18YuuNiGd4TdYT9yyt0O3Wkpsto84klUltQmBuhhhlG" +
+		"JLWp/ho7wKUdLJSO9weQcdqOdFY0nflM74YgcUY6okYfnwj1VNO8CMld8JHZAyZ7UUZGvhqkHjUUdKOjbfRhaMbX6MAafdA4" +
+		"q+Gw3oEnRpi+gudI68LeeH+U08Z11cCY+fRhDEHi6BAcr1CRtLoaoN80UumMfjozn2sxsLD2eX98d7sj7cen9eFoCQmEwvbn" +
+		"WqrbrfGaPY9lmnT09uPTagxmdPY9HcHjgpqYXXQKXdnlfoRk2wkbod5vI2QxzbcftNYzp8ltR/foLY2WIsjopzP6uZZLRlRw" +
+		"kI9ruZQTRxDW41pMKI1e3op3Nyo250ctbd5BKp7H+6PwVoPNwq9VHdbUk4AtLR3B1MPkqCduy6gRZpjRwOjQ4CAdFaSPacvY" +
+		"wUHeH62u50pFaqFQqiVdrbVQKNWSroYR0gd1OfFeWqCZf+P8v+sT+CET5jtxkBpfgMuUk8vI3kYIv5iGe4S4vSOcRO9APW96" +
+		"6lW8LfU80V+umivrjwU8hWow0wT1XAzfNyV3o76o8R2h5P3BtrNt3jL465S3QaeFAa033nuEcrfevO3XwZb0BI+5rZLZkXWW" +
+		"Y9cyvew6GEkXLuZap2dpByPp8CPXSsno+hiTsVd4EhoADffrNNTobMU6I7ELvaQnlIwYMhd6SU8omT5teF7hReiZZovVDMSq" +
+		"7P05CG9CeGIdD+eksyPrDMGu1f5n16C7Bn2bffFKOkJJv51oIpS8P2h9Tpp6pomwFy13evjCYS9a7vTMHSaNm8qf6DbC2vSb" +
+		"HimeTe89qEH1aaHZQ2mk93QLTWFt+mMK6fSlbs/x+UaokjX9x2yRsDbi2fTHHCCQzfuDwWdzJWFt+hUGn9c0H3VusR+acde8" +
+		"Ow/Dj47QTUv9RmZrEcodnOdDj+zhX9/Byqk63aJDOORsousWnQPtx6jRQ8rmuf7pbrc3w9ZvfKihPcRkbJ/PFWH/wZGncNYS" +
+		"q8CO+HIh4XTEl474csHV9ITfGekIjkfZ+vWRjkDzYOvXWfddTMI7I7/3Z47Xq/lvmGyE5rlWBjpC83TAmFc4e8cI3diKdjX/" +
+		"sfV7f3Qu9TnMK8O8snZFMq+MIvdpav2m5F69u6kOU6aDyVyiu70p7169pj3OwUBnpMddivSbO3b0vI2QehAMmrFu6DID6SfH" +
+		"OiMzAvdyIU8nz3gKAdOW3K7mA0yz5zHCVg4wja4/9CMmjeO7RSj3UNTsNo1AlUNRM9KOESqyjI4IvXvQanR9YJv3xzMNF8wk" +
+		"JxV5suYiDFQ5rDlFsnAYACdfIwMEzvsDJ+gcVqijPlDUvtb9uDjvD8jVJ0LP/KHVZBQms96xfFWd3jGQrxYbS1fYvHPte610" +
+		"lGvLLKrZOIa4guFNl5nkpDcFrpsuM+0O5+wbrPEUzvbJxvP9cTsdmsVnvyykN5ZAV0DNBn84pc5yswutdG0mKZab/dI+t+9/" +
+		"xe94r/S2nL+zBroCXIZGWIyYCckkFtNYbFoA8PQrNLQzQoqEBr0YCy4qTuA8S1ODgTh35LKW2yknPMIXe0GXwV+4prHY974C" +
+		"ccYkWUwhl8EfuGfkcOii9wTjs6Sur2gsCk9LuIJ71qDXT67cf2jmKZT79Q+aeeLM5G4hHbbPar1yhRYNabKmnTm9EhdqrEeE" +
+		"3ugOSSxCbwS3sKaS9Qfcn7Ea7gF/Ju55Ch/C7Yd7noCvXKnwGAyrMYs1bwfuuTZDCVPd92cOoxuTjttxLIvdS1DHzmK331Sc" +
+		"zVByhy9sDtisfZnq9tuh0uaQFdSns9G9Ngtixrn9TtOnzcD4dOa4r3AlpHLYQ4LY6oxz359KKHd7/mx0L+EfO1PdjtFzbex7" +
+		"mOr2O93J0VtCR4kZ9S4pByEoNnTVZsS8LRVC7OFI8k79bjcHbEa3uwfiDIv0iZAOBhyyazZ3WNUD0ynfSEcInGenQ98BWOsI" +
+		"+5YrvbtBfLf2vUO1xsfc7ffcNKQbH3PfT8Lth5CeQvV5HT+9blKqFD6oawiHPR9O74D4GXs+HAXrDvlK806MKsGpXo0jQvVJ" +
+		"l9qrIukIt46wGy5uC/5QgHbbRXeWGCFfOdpINKvbHLA7zmNV/f5otNMZu4P69Cdt3sEKq+rOOvrCW+uwOv1JS2Zy9NDggXQu" +
+		"ITo7A+r+ZBB3ZsqAujOgvjYLYgbUHRfnQmbrT5jaVe4si58gpKtnsix+gpCeG0TXZlp6gpB2eiVuZxeqqoegw7W+J0bVEzJb" +
+		"cte8H4yQ3f48dE4XsWrs7JASuOrR5neTdwJXBasjGGSH1Zmk7CncCYPPDjirEMpI7zgsAB56z0PvOQy/TxYOWDsHXSoRsp6g" +
+		"qyZNsj/2swTKenVZRQo6/MKe2iNcf/TtKXS7jvBcYWTJSJt/fpArhbdYfu5QqiJUIdr8YWMJa6eHtXO0CD3z9kxbEaJu9Sfk" +
+		"K20egOf9gX1yqgDH03F4xmHx89jkCoDnuCKUUXBYf9/9X/WJwfx+iN11bdPDYojdNdjkX0a3N4VATc/a55ceH/4ywD2vsBJa" +
+		"vUx164IFHAJ6Ddb71z7bxGC9PxjqX7yiB0P992fqWUbMEQAP8s6F6zcC4PmYLfY51Y0AeJj0X/umnByyQHaufdIvRmz5QXYu" +
+		"sL/x+ZHHL0KF567Izv/aDxm1LCZD01JOOhjL/nd5tBH23wpzQq4iBO+GRDwXldyzbsRKWiO0QNXHBMkcn5DHqWLnRkgHE8Jr" +
+		"nLtyzoX0YMT/Ls7kTrP6BEh1ul2XYdI/zjnRvqn9t0CdwpOw/RaoEx6lnFdWrchXc6d0sOUfn1CqmiuhmQP8OZuM7iDOg5lS" +
+		"JPgf3J9x9giDTZfRXGSPT9bhutM5fI6srnUSB40jZvvs9a99AkMHQ/25yprCm9CSxhYUdONEjs+ll8YwDZPHN4xyy4h9Ki4T" +
+		"OT6FGth0jRms9+cibQoHocUkK7Z94hZG8D/f9I4md73jm6Y4l2WDpf1gaf+uuL2m9gnc8wp3Qq/Zwp6SO4XnG3jUQvgDgnvm" +
+		"JqOshLXPsuVKz7T2LafcLRy+HbqqRKjqAo8qipRFLw2pzE37wfx+ML8fxXdnd//+ICDNncvB7v79cfsto+v4LVAnAcntWd9q" +
+		"tPWT231ibbd+XBl0+B0Ejmdm0at91iNCRdIUa1FL9HkQoVFrhIpkEK/D7ZhVbOtHnaasA0RogAiNOmf0wdJ+4AVd+9zeGLG7" +
+		"Z03/Ci9Cy6u05BlTcAilNpaMydOuazCcHyKqXZibbwpom1nDPnWUEdv6JS35lpGWLKLatU8nmoFI9P7oMo9yhjxu3xSI8119" +
+		"WjCyGaI6jNjrs9C/0DkHC/3BQv9dhitnyOMGfyDOEbzRwtj5mP4yI3gjQKNXKCPkHQijV6hCQh7XuY5V4VvQ4SHouD0LcH2s" +
+		"Xsqpq7H6H3VOyW+q/xbLE6sToSKZA2qqrmcFHdZOIfSNaF3tE6FvZGJo85Bu8AEYwEuj6ZtLVuUjZJoIMcotztsudz2O8f9o" +
+		"PVcqp473F5n+P/SJRW9EcRqOeAd804BvGt1UtwSpbrboxpflR0/HIdgj9Lb6Xz8jdPsdOIHi6niLjje+EapBy4iRNqE3LtbT" +
+		"Y1rGjSUbS0/8+2dGjP8HzNTbJi5C2w2Oog+axxp6Og3pmHv+Yw282/n0Ma3Y3pRdAFPIQR1Zg1S3Z4XYOsSyG5wNrmOeoYz4" +
+		"HKBUvUJF0htXvfE4PJOP2Gp76phbx0O8usH14IJxHVwPRhwKjmkIPTgUvD+KVLwmH9s1Ha8op4632ryFcR18Dt4fGdXcLiPL" +
+		"naPKiCq2pjc2RQrlPb2xeXe9kZPC+40GoSK18BI2Qp8DiHrsESpnC0Rhfvc1JPcejIHvbjrjpDA4KI6VDiZM3hjT1mFwWRhC" +
+		"4Y3RZaTj8VUYY0Qo9xGvfc/UuXggjItmvGaX6oo/utfMhpSV91U9U1/hLTCuFqFn6h3cz8Zq6lm1ebG7hnB37w9PXRpnaGEb" +
+		"8wvB6wdo2GDZfx3TJWpsoZmnfRo1wgAD/7rwcN9UIdTAJhJxMP4fzP2vwwQGBTZi/H/M8+nB+P/9KYQ7oZ2azCsT9DS2UOwz" +
+		"r9zKaV7ZmOAdt9sRqOMmcJjquAm8P7n9JnR7ZqDHM81AWyabx2uabLa0+Se3Q7Gzyzs/budfvFlpnOa/rYYOP9v8+fXu2jxn" +
+		"g3EbFQXbG4LtDU56Ywsuv8XrO1d2Qp/YAoDPwdjMOTzU3pTX1BFuav2mI3BLGHeLEB1eR7hp8JuOwC1h3DR4Mf4Gt4Rx3zIK" +
+		"wZ/Od89N3zfljaz77ydX+kYYu/c0ohqcFAYnhfFQ68X/e394VFGCOSkMTgqDK9zYaIebJc2zqDpdjefCeAwX4gYOAQMHB7fB" +
+		"c2FsOt5D392sSTYdD4xybM8/tP3pzxWhz/FwyJr2PYPTwBBa8P3uOyHuOfUOHHmg0g0culd4EdYfxf5PaI7jXjC3AqfwJoRN" +
+		"Z49yTheeAUA3RB98hTLCAYnPwWni43Mw4nMAjjxEHxz4da/Q7XpcfA5Oc9we5rse92cl+x/6xB70u453WqtxPZjY+ymUXwkL" +
+		"X8kOxTUD7XrjeaoXvZFbwnWa6nZgt90OwXmqLNPSblo6i3eoAeTrolZ1yHrvD38nM9De/qHmTzekQaiyGgcNK+89gRF63EPc" +
+		"rhtyYLg+PULfr/MEsYzYE0Kha1LWvntCKHQZXblS4YeMLA7EU3x/4vShSDrePvcSrs8dodyHN6K37tmcHkymTSG8IQZviItL" +
+		"2xBW8f05COWefegrxv8yyj70xUCV3rrfiQPAjtpIxJlicKa4vp0we8YPSy7dfk+0hIcxFNWB48P7o+NNQ7A3Bdq+aaYUl8O2" +
+		"1kE/O+cm8+A0MHgLXItlLjeB94dVYI3QJmVjNjW3+wZXgEmjn8KHcPvR6KfRVq5sP+L8NLDaCMOtZwhmfDkSB8An5hI1WP1P" +
+		"Nv0UenfjJwzhtX4j9Exfc/0qkqMGjgGXE7FxJGLA5Wh/jdC7+5rrNFQcqIIDVfBa9Q5UwcGF4HJMNo6EEbgd2NsdObLterPa" +
+		"KYqkMfAduFZK23EHgu8wuEXomY9nUsWObLs+TohTydlhfRRemz+yw2q8Xu0lMIAfDOCvzZrk1LVPXXubRPZxZoNP197sEJw+" +
+		"B5v1i1/POLNtN6y8tc9zhJBuh3WL0M7XZROIQnBm287n2I8Iy4+QPvelZGQhxoL92kuEdhLV/G6fD4rv/bFZpZbO7NCp5P2K" +
+		"cPyw6XMzwDMDl591fe1PhMr5UMW0zzNw+Ser+QhVspo/5ontKOGeNyqjVseUfMK75yorQuRxn+MwozMQH8I/XofJOwEfWYZf" +
+		"h1aXMI7FNzo0G+EY3x/aocZQtHkxGt8pWe70CQbbFy+YNwVHfpmnzf0Jzsgo+50UFUklM8O+TqvBRGxklP3Oca5UnxiDV7Zy" +
+		"GV4PjMHr1OpKtkHVJ6ePwfhxMH48zulIMhJcLFHFtrmRfNn0vWz6ljKnyMvW42Xr8Ti/hN0APzXHs06c7gXefdnZqwi/1/fi" +
+		"szUb7fN/d3UGy23DMBC99yv8B53UFSUcZWJxa//B46gzOSTxuMn/l9qnQ6aHjMIdW5QoEoDgXTCcyUgydPnkqThAPim0ZR6l" +
+		"Ci+ZJBmX1LsoV8r+Ek+06b9wIsnTJTUwxuc5cx0qCBk0yydJ9yR5ngE2QCz6Snd+pkk2JcmmVNj9jRaep/PJM+fs2GnPkwGG" +
+		"QZYmlREqbBuT5EiSHKn4Se82g0mOpKjrbU9gkI4ce9kTHOTaHXTslT+Ki/eUKMQliTghUSVM5bWcUMgTCnnDuCU03IR/O1Nx" +
+		"LldChtX33iZTMvKCWYKytyxe9aPVAfchmNkRJuERjcPe30QZnkw8JFSVBd17slFSwpQY/m4fEJGrEoMczbRfEUMJEWhRAVwE" +
+		"SCJAqvAdiUBACCEHuPcu/LNwzBU2g0KHJwR4A7wY9CMWCrqKha/7cYwDs9RLTCjaxoGOzK8SDkg4oAoHM6M1AXJO50GE9Eq4" +
+		"nAEugB0wAVeDXNJ8XJLJdsJZCGdRYfKiFgcJQuEyr86tCCGIMD0VJpsLzYfQfBTCGoWnm+DwRzNHRXCIBYd4kg2RIA9r9Vka" +
+		"JRhGawH0BAv/nqaLJ5jgw85nv5mMVgBy7xbr6OJYSDBSiwS54KAK8mmFI1tBN9XlGBBnDUbLlwSXtND6iBKDYr+5QuujzmTo" +
+		"x1zyS53g6gmuXoULSglanqDlVTiVIGh5gpZX4bdcwasTvLoK5xfEQlA/Rt5MecGIG4cD3NeR0vZWedglvw8XNSnqbGPanry0" +
+		"fWO+FXZsdxBbcOqLxVykk6t7LhUUyHnxc6/OtgrQB1tzrF7wj4p9x+a9CtNXezv+vb1e79/6r+v99/V1O90+H4/t7eP55fZx" +
+		"+r6jp+ftz8vb9tj+vn8+btvp/n7fv+W/f8JgDB0=",
+	"KSCms-UHC-HW-H": "" +
+		"eNpNT7tuwzAM3P0V7BAgHVTVyRcUzmAjSBtELTo7Eh0IqB6gpMF/X4cW0g4kQfLueNw8nZV4M+GKYv/yChdMoZBG0Z3G2Mhu" +
+		"OAzeZpBnClphhsl6QxUDV7xZ37Q7MFbn2nHWrpLVnDK6wU8B9ivKlFiR8rKUlGmGLd9/BoNTIz/IIFl/g+0xEI5tHasS4w86" +
+		"9BlanqA36+bu9H10CPKoOpfEV9+J/lv0f9vPOSLs1v4fBkpCdrpIcX0o6UK0XGLDrHDnWo+Pz2NgFscvWRxp4A==",
+	"KSCms-UHC-HW-V": "" +
+		"eNpVUE1PwzAMvfdXmAPSOITQ7Regcmg1DaYF2LlL3CkS+ZCTHPrv6dxoiINt2X7v+ePx4ajEqwkXFLvnFzhhCoU0iu4wxkZ2" +
+		"w9vgbQZ5pKAVZpisN1QxcMGr9U27BWN1rhl77SpZzSmjG/wUYLeiTIkVKU9LSJlm2PD8JzA4NfKDDJL1V9jsA+HY1rIqMf6g" +
+		"Q5+h5Qp6s3Zum76PDkHuVeeS+Oo70Z/F91/3c44I2zU/H4LBKvGf0ENJyJsv0hzvyroQLZP5AFa80a3H+ydiYBbbL9FGbm8=",
+	"KSCms-UHC-V": "" +
+		"eNpNULtuwzAM3P0V7BAgHVTVyRcUzhAjSBpEfcyORAcCqgcoafDf16GFtANJkLw7Hrh6OivxZsIVxfblFS6YQiGNojsOsZFd" +
+		"v+u9zSDPFLTCDKP1hioGrnizvmk3YKzOteOsXSWrKWV0vR8DbBeUKbEi5WUuKdMEa77/DAbHRr6TQbL+ButDIBzaOlYlxh90" +
+		"6DO0PEFvls3d6WlwCPKgOpfE574TX3+rjykibJb++xgMVv4/9B5KQvY8i3J9aOpCNN9k6yx351qPjx/EwCyOX2hjbNc=",
+	"UniCNS-UCS2-H": "" +
+		"eNpl2N1qHMkZBuBzX8XkILA5cKz666qCZaG6upv4IJtllb0AWxobQSwLWT7w3UfSo6yXzYAk6p2uno/nHURTf/3LL5evx/Xn" +
+		"9+fX6e8Xp1/PXz5/vb86v57/fHf36s18u729vXk4vfnl/vPV5fnh9OHm9vr+5ZrT+/PHm9tXIZ6ub64eXlbPv68+vWy+/Pbl" +
+		"4fzp7e2Hz6fkquuvdy9Xvvn18c+Xh/tvpx+eP/9vp+vzh1dv/nV/fb6/uf14+mH+fBlewsuvd3f/OX863z483ecxOd9ee+dp" +
+		"zp/ffTqf3vx2e/O44/Vv8zK+/sf3N//97e58is/r4JOvPl+fv9y9uzrfv7v9eH7148Xj66fTj8fj66enG//p/XBxYd/7D983" +
+		"hMcNFxePG05Wj2F4vstFED6tHsMojMIoTMIkTMIszMIsLMIiLMJFuAgXYRVWYRU2YRM2YRd2YRcO4RAO4SpchatwCqdwCjfh" +
+		"JtyEu3AX7sJDeAiP5zAoIPB8Xj2GPAPPwDPwDDwDz8Az8Aw8A8/AM/AMPAPPwDPwDDwDz8Az8Aw8A8/AM/AMPAPPwDPwDDwD" +
+		"z8Az8Aw8A8/AM/AMPAPPwDPwDDwDz8Az8Aw8I8/IM/KMPCPPyDPyjDwjz8gz8ow8I8/IM/KMPCPPyDPyjDwjz8gz8ow8I8/I" +
+		"M/KMPCPPyDPyjDwjz8gz8ow8I8/IM/KMPCPPyDPyjDwjz8gz8ow8E8/EM/FMPBPPxDPxTDwTz8Qz8Uw8E8/EM/FMPBPPxDPx" +
+		"TDwTz8Qz8Uw8E8/EM/FMPBPPxDPxTDwTz8Qz8Uw8E8/EM/FMPBPPxDPxTDwTz8Qz8Uw8M8/MM/PMPDPPzDPzzDwzz8wz88w8" +
+		"M8/MM/PMPDPPzDPzzDwzz8wz88w8M8/MM/PMPDPPzDPzzDwzz8wz88w8M8/MM/PMPDPPzDPzzDwzz8wz88w8C8/Cs/AsPAvP" +
+		"wrPwLDwLz8Kz8Cw8C8/Cs/AsPAvPwrPwLDwLz8Kz8Cw8C8/Cs/AsPAvPwrPwLDwLz8Kz8Cw8C8/Cs/AsPAvPwrPwLDwLz8Kz" +
+		"8Cw8F54Lz4XnwnPhufBceC48F54Lz4Xn8+rpseJ/jw///zyxsF5YL6wX1gvrhfXCemG9sF5YL6wX1gvrhfXCemG9sF5YL6wX" +
+		"1gvrhfXCemG9sF5YL6wX1gvrhfXCemG9sF5YL6wX1pV1ZV1ZV9aVdWVdWVfWlXVlXVlX393Ks/KsPCvPyrPyrDwrz8qz8qw8" +
+		"K8/Ks/KsPCvPyrPyrDwrz8qz8qw8K8/Ks/KsPCvPyrPyrDwrz8qz8qw8K8/Gs/FsPBvPxrPxbDwbz8az8Ww8G8/Gs/FsPBvP" +
+		"xrPxbDwbz8az8Ww8G8/Gs/FsPBvPxrPxbDwbz8az8Ww8G8/Gs/FsPBvPxrPxbDwbz8az8Ww8G8/Os/PsPDvPzrPz7Dw7z86z" +
+		"8+w8O8/Os/PsPDvPzrPz7Dw7z86z8+w8O8/Os/PsPDvPzrPz7Dw7z86z8+w8O8/Os/PsPDvPzrPz7Dw7z86z8+w8O8/Bc/Ac" +
+		"PAfPwXPwHDwHz8Fz8Bw8B8/Bc/AcPAfPwXPwHDwHz8Fz8Bw8B8/Bc/AcPAfPwXPwHDwHz8Fz8Bw8B8/Bc/AcPAfPwXPwHDwH" +
+		"z8Fz8Bw8B8+V58pz5bnyXHmuPFeeK8+V58pz5bnyXHmuPFeeK8+V58pz5bnyXHmuPFeeK8+V58pz5bnyXHmuPFeeK8+V58pz" +
+		"5bnyXHmuPFeeK8+V58pz5bnyXHmuPFeeK8/Jc/KcPCfPyXPynDwnz8lz8pw8J8/Jc/KcPCfPyXPynDwnz8lz8pw8n1d/fJ7I" +
+		"7U+PExP1RD1RT9QT9UQ9UU/UE/VEPVFP1BP1RD1RT9QT9UQ9UU/UE/VEPVFP1BvqDfWGekO9od5Qb6g31BvqDfWGekO9od5Q" +
+		"b6g31BvqDfWGekO9od5Qb6g3X93dnLs5d3Pu5tzNuZtzN+duzt2cuzl3c+7m3M25m3M3527O3Zy7OXdz7ubczbmbczfn/jKn" +
+		"3ne973rf9b7rfdf7rvdd77ved73vet/1vut91/uu913vu953ve963/W+633X+673Xe/Hy3kbz4PnwfPgefA8eB48D54Hz4Pn" +
+		"wfPgefA8eB48D54Hz4PnwfPgefA8eB48D54Hz4PnwfPgefA8eB48D54Hz4PnwfPgefA8eB48D54Hz4PnwfPgeRzfjypPVn/8" +
+		"V/B0ePl09Pr7oejV1/v78+3D88nr82no0znoze359yPcu893T7uef/4LfjbNUQ==",
+	"UniCNS-UCS2-V": "" +
+		"eNpNULuOwjAQ7PMVewUSFMYkfMEpFKTgIQxHDfYmskTWlmMX+Xscx4IrvKvZnZkdefFzFuxXmSey7XoDFxxMcBJZfXjYgtfN" +
+		"riHtgZ+dkQI9tJqUyxx4YqepKCtQWvqMUpV9Fotx8Ng31BrYziwVbGbyS2yDdyMs0/0VKGwLfnIKnaYOlvVRlHkogrUv7JH8" +
+		"5BMnSGreTDmPjx6B30hHBbvVomJ/3+V1tAjVjO8HoxDKGfzn7yEMmFJH49Q/vjI4F++m8MlwUmvCzy9Yk1TpvQHke2zj",
+	"UniCNS-UTF16-H": "" +
+		"eNqM2M3uYDli3ue9rqKzCOAsFDW/ScAwQPKQiBdxDMu+AFnTEgREI2EkLXz3GTdL8yAJ4PYseurPZlW9+J1ePOf8r//Lf/zL" +
+		"P5+/+4f/+sufp//955/+0y//9A//8oe//uXP9//5V//4Z3+x//3373//d//801/8xz/8w1//5S///NPf/N3vf/eHH3d++q+/" +
+		"/O3f/f7PQvzpd3/31//846df//nXf//jN//lf/unf/7l7//97//mH35K79bv/uUff9z8i//0x//7p3/+w3/76d/8+vf/bz/9" +
+		"7pe/+bO/+L/+8Ltf/vB3v//bn/7N/g9/GX4c/uW//OM//t+//P0vv//nn/KvJ7/8/nfv3/z3nf/hr/7+l5/+4r/8/u/++Dv+" +
+		"/L/85xvqn/8f/u1//m//+MtP8def0/ur//offvfLP/3jX/31L3/4q9//7S9/9m9//uP//t1P//Zr9/67P/u3X//552+/k3Xv" +
+		"d389Pe/O/fWnP/7t/58/I/z88/uz/+vf+EPDH3/Dzz//8Tf89H7642H49U/5ObzD//7THw/jO4zvML7D9A7TO0zvML/D/A7z" +
+		"OyzvsLzD8g7rO6zvsL7D9g7bO2zvsL/D/g77OxzvcLzD8Q7nO5zvcL7D9Q7XO1zv8OX7eb/D/Q6/d/i9w+8dnnd43uF5h/cd" +
+		"3nd4fz0M7wGE1/PXn/54+HqG1zO8nuH1DK9neD3D6xlez/B6htczvJ7h9QyvZ3g9w+sZXs/weobXM7ye4fUMr2d4PcPrGV7P" +
+		"8HqG1zO8nuH1DK9neD3D6xlez/B6htczvJ7h9QyvZ3g9w+sZXs/weobXM7ye4fUMr2d8PePrGV/P+HrG1zO+nvH1jK9nfD3j" +
+		"6xlfz/h6xtczvp7x9YyvZ3w94+sZX8/4esbXM76e8fWMr2d8PePrGV/P+HrG1zO+nvH1jK9nfD3j6xlfz/h6xtczvp7x9Yyv" +
+		"Z3w94+sZX8/4esbXM76e8fWMr2d6PdPrmV7P9Hqm1zO9nun1TK9nej3T65lez/R6ptczvZ7p9UyvZ3o90+uZXs/0eqbXM72e" +
+		"6fVMr2d6PdPrmV7P9Hqm1zO9nun1TK9nej3T65lez/R6ptczvZ7p9UyvZ3o90+uZXs/0eqbXM72e6fVMr2d+PfPrmV/P/Hrm" +
+		"1zO/nvn1zK9nfj3z65lfz/x65tczv5759cyvZ3498+uZX8/8eubXM7+e+fXMr2d+PfPrmV/P/Hrm1zO/nvn1zK9nfj3z65lf" +
+		"z/x65tczv5759cyvZ3498+uZX8/8eubXM7+e+fXMr2d5PcvrWV7P8nqW17O8nuX1LK9neT3L61lez/J6ltezvJ7l9SyvZ3k9" +
+		"y+tZXs/yepbXs7ye5fUsr2d5PcvrWV7P8nqW17O8nuX1LK9neT3L61lez/J6ltezvJ7l9SyvZ3k9y+tZXs/yepbXs7ye5fUs" +
+		"r2d9PevrWV/P+nrW17O+nvX1rK9nfT3r61lfz19/+u+s+Fc+/P89UV/r+lrX17q+1vW1rq91fa3ra11f6/pa19e6vtb1ta6v" +
+		"dX2t62tdX+v6WtfXur7W9bWur3V9retrXV/r+lrX17q+1vW1rq91fa3ra11f6/pa19e6vtbttW6vdXut22vdXuv2WrfXur3W" +
+		"7bVur3V7rdv7b7e9nu31bK9nez3b69lez/Z6ttezvZ7t9WyvZ3s92+vZXs/2erbXs72e7fVsr2d7Pdvr2V7P9nq217O9nu31" +
+		"bK9nez3b69lez/Z6ttezvZ7t9WyvZ3s9++vZX8/+evbXs7+e/fXsr2d/Pfvr2V/P/nr217O/nv317K9nfz3769lfz/569tez" +
+		"v5799eyvZ389++vZX8/+evbXs7+e/fXsr2d/Pfvr2V/P/nr217O/nv317K9nfz3769lfz/569tezv5799eyvZ389x+s5Xs/x" +
+		"eo7Xc7ye4/Ucr+d4PcfrOV7P8XqO13O8nuP1HK/neD3H6zlez/F6jtdzvJ7j9Ryv53g9x+s5Xs/xeo7Xc7ye4/Ucr+d4Pcfr" +
+		"OV7P8XqO13O8nuP1HK/neD3H6zlez/F6jtdzvJ7j9Ryv53g95+s5X8/5es7Xc76e8/Wcr+d8PefrOV/P+XrO13O+nvP1nK/n" +
+		"fD3n6zlfz/l6ztdzvp7z9Zyv53w95+s5X8/5es7Xc76e8/Wcr+d8PefrOV/P+XrO13O+nvP1nK/nfD3n6zlfz/l6ztdzvp7z" +
+		"9Zyv53w91+u5Xs/1eq7Xc72e6/Vcr+d6PdfruV7P9Xqu13O9nuv1XK/nej3X67lez/V6rtdzvZ7r9Vyv53o91+u5Xs/1eq7X" +
+		"c72e6/Vcr+d6PdfruV7P9Xqu13O9nuv1XK/nej3X67lez/V6rtdzvZ7r9Vyv53o99+u5X8/9eu7Xc7+e+/Xcr+d+PffruV/P" +
+		"/Xru13O/nvv13K/nfj3367lfz/167tdzv5779dyv568//Y89sV/r/Vrv13q/1vu13q/1fq33a71f6/1a79d6v9b7td6v9X6t" +
+		"92u9X+v9Wu/Xer/W+7Xer/V+rfdr/f34OPNaf6/191p/r/X3Wn+v9fdaf6/191p/r/X3Wn+v9fdaf6/191p/r/X3Wn+v9fda" +
+		"f6/113wv+un99KcPROftPG/neTvP23nezvN2nrfzvJ3n7Txv53k7z9t53s7zdp6387yd5+08b+d5O8/bed7O83aeHzvfcz/v" +
+		"uZ/33M977uc99/Oe+3nP/bznft5zP++5n/fcz3vu5z338577ec/9vOd+3nM/77mf99zPe+7nPffznvt5z/28535/fHB7Pe/r" +
+		"eV/P+3re1/O+nvf1vK/nfT3v63lfz/t63tfzvp739byv53097+t5X8/7et7X876e9/W8r+d9Pe/reV/P+3re1/O+nvf1vK/n" +
+		"fT3v63lfz/t63tfzvp739byv53097+t5X8/7et7rW+VP76f/92fNX3/5/uP919MfFz4XPhc+F44Lx4XjwnXhuvCnDcGGYEOw" +
+		"IdgQbAg2BBuCDcGGYEOwIdgQbYg2RBuiDdGGaEO0IdoQbYg2RBuiDcmGZEOyIdmQbEg2JBuSDcmGZEOyIdmQbcg2ZBuyDdmG" +
+		"bEO2IduQbcg2ZBuyDcWGYkOxodhQbCg2FBuKDcWGYkOxodhQbag2VBuqDdWGakO1odpQbag2VBuqDc2GZkOzodnQbGg2NBua" +
+		"Dc2GZkOzodnQbeg2dBu6Dd2GbkO3odvQbeg2dBu6DcOGYcOwYdgwbBg2DBuGDcOGYcOwYdgwbZg2TBumDdOGacO0YdowbZg2" +
+		"TBumDcuGZcOyYdmwbFg2LBuWDcuGZcOyYdmwbdg2bBu2DduGbcO2Yduwbdg2bBve6f/Yr3+89tn32ffZ99n32ffZ99n32ffZ" +
+		"99n32fdpdGw4Nhwbjg3HhmPDseHYcGw4Nhwbjg3XhmvDteHacG24Nlwbrg3XhmvDteH+aUNgi8AWgS0CWwS2CGwR2CKwRWCL" +
+		"wBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEt" +
+		"AlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2" +
+		"CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHY" +
+		"IrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVg" +
+		"i8AWgS0CWwS2CGwR2CKwRWCLwBaBLX6c/nqBHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHw" +
+		"Q+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfI" +
+		"D5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8i" +
+		"P0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efvhx+lvvP5EtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKy" +
+		"RWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvI" +
+		"FpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0i" +
+		"W0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaI" +
+		"bBHZIrJFZIvIFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgi" +
+		"sUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKL" +
+		"xBaJLRJbJLZIbJHYIrFFYovk20Tih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPi" +
+		"h8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+J" +
+		"HxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8k" +
+		"fkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JH36c/tb7T2KLxBaJLRJbJLZIbJHYIrFFYovEFoktEltktshskdkis0Vm" +
+		"i8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZ" +
+		"LTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltk" +
+		"tshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR" +
+		"2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNF" +
+		"ZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wW" +
+		"mS0yW2S2yGyR2SL7NpH5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfC" +
+		"D4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8K" +
+		"PxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o" +
+		"/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhhx+nv/X+U9iisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvC" +
+		"FoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0K" +
+		"WxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYo" +
+		"bFHYorBFYYvCFoUtClsUtihsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmi" +
+		"skVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWL" +
+		"yhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUt" +
+		"KltUtqi+TVR+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+V" +
+		"Hyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9U" +
+		"fqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qPzR+aPzQ" +
+		"+KHxQ+OHxg+NHxo/NH5o/ND44cfpb73/NLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaN" +
+		"LRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0" +
+		"tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR" +
+		"2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFF" +
+		"Y4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YW" +
+		"jS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVni84WnS06W3S26GzR2aKzRWeLzhadLbpv" +
+		"E50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86" +
+		"P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o" +
+		"/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh" +
+		"80Pnh84PnR86P3R++HH6W+8/nS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06" +
+		"W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbo" +
+		"bNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84Wgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhi" +
+		"sMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCL" +
+		"wRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMt" +
+		"BlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi+HbxOCHwQ+D" +
+		"HwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8M" +
+		"fhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww" +
+		"+GHww+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD" +
+		"5IfJD5Mffpz+1vvPZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltM" +
+		"tphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx" +
+		"2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLF" +
+		"ZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kW" +
+		"ky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mWyy2WGyx2GKxxWKLxRaLLRZb" +
+		"LLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYvk2sfhh8cPih8UPix8W" +
+		"Pyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y" +
+		"/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh" +
+		"8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KH" +
+		"H6e/9f6z2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZY" +
+		"bLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdli" +
+		"s8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaL" +
+		"zRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpst" +
+		"Nltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y2" +
+		"2Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22L5NbH7Y/LD5YfPD5ofND5sfNj9s" +
+		"ftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw" +
+		"+WHzw+aHzQ+bHzY/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPH" +
+		"Dx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPH" +
+		"Dz9Of+v952OLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOL" +
+		"jy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOL" +
+		"jy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOL" +
+		"jy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOL" +
+		"jy0+tvjY4mOLjy0+tvjY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvD" +
+		"FoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWx7eJ" +
+		"ww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cf" +
+		"Dj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+" +
+		"OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD4" +
+		"4fDD4YfDD4cfDj/8OP2t95/DFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvLFpct" +
+		"LltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2" +
+		"uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ" +
+		"4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVl" +
+		"i8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaX" +
+		"LS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLF9W3i8sPlh8sP" +
+		"lx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cP/kh/Hzn/zw6y/fhX89/XHhc+Fz4XPhuHBcOC5c" +
+		"F64Lf9oQbAg2BBuCDcGGYEOwIdgQbAg2BBuCDdGGaEO0IdoQbYg2RBuiDdGGaEO0IdqQbEg2JBuSDcmGZEOyIdmQbEg2JBuS" +
+		"DdmGbEO2IduQbcg2ZBuyDdmGbEO2IdtQbCg2FBuKDcWGYkOxodhQbCg2FBuKDdWGakO1odpQbag2VBuqDdWGakO1of7PvP+M" +
+		"n5t9zb5mX7Ov2dfsa/Y1+5p9zb5mX9Oo29Bt6DZ0G7oN3YZuQ7eh29Bt6DZ0G4YNw4Zhw7Bh2DBsGDYMG4YNw4Zhw7Bh2jBt" +
+		"mDZMG6YN04Zpw7Rh2jBtmDZMG5YNy4Zlw7Jh2bBsWDYsG5YNy4Zlw7Jh27Bt2DZsG7YN24Ztw7Zh27Bt2DZsGz4bPhs+Gz4b" +
+		"Phs+Gz4bPhs+Gz4bPhs+G44Nx4Zjw7Hh2HBsODYcG44Nx4Zjw7Hh2nBtuDZcG64N14Zrw7Xh2nBtuDawRWCLwBaBLQJbBLYI" +
+		"bBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgi" +
+		"sEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCL" +
+		"wBaBLQJbBLYIbBHYIrBFYIvAFoEtQrWBHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CH" +
+		"wA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4Ef" +
+		"Aj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+" +
+		"CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efvhx+lvvP5EtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSL" +
+		"yBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEt" +
+		"IltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2" +
+		"iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZ" +
+		"IrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVk" +
+		"i8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaR" +
+		"LSJbRLaIbBHZIrJFZIvo20Tih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QP" +
+		"iR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/" +
+		"JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8" +
+		"kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JH36c/tb7T2KLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QW" +
+		"iS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJb" +
+		"JLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktEltktshs" +
+		"kdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKz" +
+		"RWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovM" +
+		"FpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0y" +
+		"W2S2yGyR2SL7NpH5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kf" +
+		"Mj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+" +
+		"yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4" +
+		"ofBD4YfCD4UfCj8Ufij8UPih8EPhhx+nv/X+U9iisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUt" +
+		"ClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2" +
+		"KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHY" +
+		"orBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVh" +
+		"i8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaF" +
+		"LQpbFLYobFHYorBFYYvCFoUtClsUtihsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltU" +
+		"tqi+TVR+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/" +
+		"VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8" +
+		"UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHy" +
+		"Q+WHyg+VHyo/VH6o/FD54cfpb73/VLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpb" +
+		"VLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhs" +
+		"UdmiskVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKx" +
+		"RWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vG" +
+		"Fo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0a" +
+		"WzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLZpvE40f" +
+		"Gj80fmj80Pih8UPjh8YPjR8aPzR+aPzQ+KHxQ+OHxg+NHxo/NH5o/ND4ofFD44fGD40fGj80fmj80Pih8UPjh8YPjR8aPzR+" +
+		"aPzQ+KHxQ+OHxg+NHxo/NH5o/ND4ofFD44fGD40fGj80fmj80Pih8UPjh8YPjR8aPzR+aPzQ+aHzQ+eHzg+dHzo/dH7o/ND5" +
+		"ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pn" +
+		"h84PnR86P3R++HH6W+8/nS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S2" +
+		"6GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZ" +
+		"orNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vn" +
+		"i84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhad" +
+		"LTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84Wgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsM" +
+		"thhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi+HbxOCHwQ+DHwY/" +
+		"DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8" +
+		"MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHw" +
+		"w+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfB" +
+		"D4Mffpz+1vvPYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhs" +
+		"MdhisMVgi8EWgy0GW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKy" +
+		"xWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJ" +
+		"FpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0m" +
+		"W0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaY" +
+		"bDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYvo2Mflh8sPkh8kPkx8mP0x+" +
+		"mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+LHxY/LH5Y/LD4" +
+		"YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPi" +
+		"h8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHH6e/" +
+		"9f6z2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHY" +
+		"YrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVi" +
+		"i8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaL" +
+		"LRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNlts" +
+		"tthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx" +
+		"2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22L5NbH7Y/LD5YfPD5ofND5sfNj9sftj8" +
+		"sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHz" +
+		"w+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofN" +
+		"D5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPnhx+lvvf9stths" +
+		"sdlis8Vmi80Wmy02W2y22Gyx2WKzxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8" +
+		"bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8" +
+		"bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8" +
+		"bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8" +
+		"bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8" +
+		"bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPH5NvHxw8cP" +
+		"Hz98/PDxw8cPHz98/PDxw8cPHz98/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44" +
+		"/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh" +
+		"8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GH" +
+		"ww+HHw4/HH74cfpb7z+HLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4" +
+		"bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdji" +
+		"sMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGL" +
+		"wxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpct" +
+		"LltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2" +
+		"uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWL69vE5YfLD5cfLj9c" +
+		"frj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw" +
+		"+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD" +
+		"5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54f7JD/PnP/nh11++C/96+uPC58LnwufC" +
+		"ceG4cFy4LlwX/ifef+bPwb5gX7Av2BfsC/YF+4J9wb5gX7AvaBRtiDZEG6IN0YZoQ7Qh2hBtiDZEG6INyYZkQ7Ih2ZBsSDYk" +
+		"G5INyYZkQ7Ih2ZBtyDZkG7IN2YZsQ7Yh25BtyDZkG7INxYZiQ7Gh2FBsKDYUG4oNxYZiQ7Gh2FBtqDZUG6oN1YZqQ7Wh2lBt" +
+		"qDZUG6oNzYZmQ7Oh2dBsaDY0G5oNzYZmQ7Oh2dBt6DZ0G7oN3YZuQ7eh29Bt6DZ0G7oNw4Zhw7Bh2DBsGDYMG4YNw4Zhw7Bh" +
+		"2DBtmDZMG6YN04Zpw7Rh2jBtmDZMG6YNy4Zlw7Jh2bBsWDYsG5YNy4Zlw7Jh2bBt2DZsG7YN24Ztw7Zh27Bt2DZsG7YNnw2f" +
+		"DZ8Nnw2fDZ8Nnw2fDZ8Nnw2fDZ8Nx4Zjw7Hh2HBsODYcG44Nx4Zjw7Hh2HBtuDZcG64N14Zrw7Xh2nBtuDZcG9gisEVgi8AW" +
+		"gS0CWwS2CGwR2CKwRWCLwBbhZxv4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4" +
+		"IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPg" +
+		"h8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+B" +
+		"HwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPghx+nv/X+E9gisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHY" +
+		"IrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVg" +
+		"i8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaR" +
+		"LSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltE" +
+		"tohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR" +
+		"2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJF" +
+		"ZIvIFpEtIltEtoi+TUR+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHy" +
+		"Q+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfI" +
+		"D5EfIj9Efoj8EPkh8kPkh8gPkR8iPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8S" +
+		"PyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD44cfpb73/JLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKx" +
+		"RWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovE" +
+		"FoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0S" +
+		"WyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZI" +
+		"bJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgi" +
+		"sUVmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaL" +
+		"zBaZLbJvE5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pm" +
+		"h8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+Z" +
+		"HzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9k" +
+		"fsj8kPkh80Pmh8wPmR8yP2R++HH6W+8/mS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vm" +
+		"i8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWhS0KWxS2KGxR2KKwRWGLwhaF" +
+		"LQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsU" +
+		"tihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR" +
+		"2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBF" +
+		"YYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi+Lb" +
+		"ROGHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfC" +
+		"D4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8q" +
+		"P1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oP/08hdpMzbW8UYHTOKrIDuP3voe2yZ7CH" +
+		"KPmQGABRgP3z95ATicE7aKlVckvXqHWqGj80fmj80Pih8UPjh8YPjR8aPzR+aPzQ+KHxQ+OHxg+NHxo//Ex/tf80tmhs0dii" +
+		"sUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOL" +
+		"xhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0t" +
+		"Gls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2" +
+		"aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWeLzhadLTpbdLbobNHZ" +
+		"orNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vn" +
+		"i84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tuttE54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ" +
+		"+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND" +
+		"54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84P" +
+		"nR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR9+pr/afzpbdLbobNHZorNFZ4vO" +
+		"Fp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0G" +
+		"Wwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYY" +
+		"bDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhi" +
+		"sMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCL" +
+		"wRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMt" +
+		"BlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRbDbWLww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPg" +
+		"h8EPgx8GPwx+GPww+GHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+T" +
+		"HyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9M" +
+		"fpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJDz/TX+0/ky0mW0y2mGwx2WKyxWSLyRaTLSZb" +
+		"TLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphs" +
+		"MdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKy" +
+		"xWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbLHYYrHFYovF" +
+		"FostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0W" +
+		"Wyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZY" +
+		"bLHYYrHFYovFFostFlsstlhssdhiscVii+U2sfhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sf" +
+		"Fj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+" +
+		"WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4" +
+		"YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHn+mv9p/NFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx" +
+		"2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPF" +
+		"ZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80W" +
+		"my02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZb" +
+		"bLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstths" +
+		"sdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKz" +
+		"xWaLzRabLTZbbLbYbLHZYrPFdps4/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44" +
+		"/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh" +
+		"8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GH" +
+		"ww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPP9Ff7z2GLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVh" +
+		"i8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaH" +
+		"LQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsE" +
+		"WwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsE" +
+		"WwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsE" +
+		"WwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbhNtE" +
+		"8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD" +
+		"8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD" +
+		"8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/DD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD5" +
+		"4Wf6q/3nssVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx" +
+		"2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLF" +
+		"ZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sW" +
+		"ly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5b" +
+		"XLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhs" +
+		"cdniscVji8cWjy0eWzy2eGzx2OKxxWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8dwmHj88fnj88Pjh8cPjh8cPjx8e" +
+		"Pzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54" +
+		"/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh" +
+		"8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzwM/3V/vPY" +
+		"4rHFY4vHFo8tHls8tnhs8djiscVji8cWjy0eWzy2eGzx2OKxxWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8djiscVj" +
+		"i8cWjy0eWzy2eGzx2OKxxWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8djiscVji/cXW+y/+4st/ufr/z74v+nPg/Ag" +
+		"PAgPrgfXg+vB8+B58JeGT8On4dPwafg0fBo+DZ+GT8On4dPwaUgakoakIWlIGpKGpCFpSBqShqQhacgasoasIWvIGrKGrCFr" +
+		"yBqyhqwhaygaioaioWgoGoqGoqFoKBqKhqKhaKgaqoaqoWqoGqqGqqFqqBqqhqqhamgamoamoWloGpqGpqFpaBqahqahaega" +
+		"uoauoWvoGrqGrqFr6Bq6hq6haxgahoahYWgYGoaGoWFoGBqGhqFhaJgapoapYWqYGqaGqWFqmBqmhqlhalgaloalYWlYGpaG" +
+		"pWFpWBqWhqVhadgatoatYWvYGraGrWFr2Bq2hq1hazgajoaj4Wg4Go6Go+FoOBqOhqPhaAgNoSE0hIbQEBpCQ2gIDaEhNISG" +
+		"q+FquBquhqvhargaroar4Wq4Gq6Gp+FpeBqehqfhaXganoan4Wl4Gvjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44eP" +
+		"Hz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44eP" +
+		"Hz5++Jn+Yv/ZH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccW" +
+		"H1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccW" +
+		"H1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccW" +
+		"H1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccW" +
+		"H1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHY" +
+		"IrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVi" +
+		"i+Q2kfgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ" +
+		"+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD" +
+		"4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QP" +
+		"iR8SPyR+SPyQ+CHxQ+KHn+mv9p/EFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovE" +
+		"FoktElsktkhskdgisUVii8QWiS0SWyS2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0y" +
+		"W2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbI" +
+		"bJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdki" +
+		"s0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaL" +
+		"zBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFdpvI/JD5" +
+		"IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pm" +
+		"h8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+F" +
+		"Hwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8U" +
+		"fij8UPih8MPP9Ff7T2GLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpb" +
+		"FLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihs" +
+		"UdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKw" +
+		"RWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWWLyhaVLSpbVLaobFHZorJFZYvK" +
+		"FpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0q" +
+		"W1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aK6TVR+qPxQ+aHyQ+WH" +
+		"yg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5Uf" +
+		"Kj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+" +
+		"qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5" +
+		"4Wf6q/2nskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR" +
+		"2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFF" +
+		"Y4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YW" +
+		"jS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpb" +
+		"NLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs" +
+		"0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0dwmGj80fmj80Pih8UPjh8YPjR8a" +
+		"PzR+aPzQ+KHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o" +
+		"/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh" +
+		"80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzwM/3V/tPZ" +
+		"orNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vn" +
+		"i84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhad" +
+		"LTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobDHYYrDFYIvBFoMtBlsM" +
+		"thhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx" +
+		"2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDF" +
+		"YIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbhODHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8" +
+		"MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHw" +
+		"w+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfB" +
+		"D4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9MfviZ/mr/mWwx2WKyxWSL" +
+		"yRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMt" +
+		"JltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2" +
+		"mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZ" +
+		"YrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVk" +
+		"i8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaT" +
+		"LSZbTLaYbDHZYrLFZIvFFostFlsstlhssdhiscVii8UWiy0WWyy3icUPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD" +
+		"4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UP" +
+		"ix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/" +
+		"LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY//Ex/tf8stlhssdhiscVii8UWiy0W" +
+		"Wyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZY" +
+		"bLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFltstthssdli" +
+		"s8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaL" +
+		"zRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpst" +
+		"Nltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y2" +
+		"2Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstttvE5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+b" +
+		"HzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9s" +
+		"ftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw" +
+		"+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx9+pr/afw5bHLY4bHHY4rDFYYvDFoctDlsctjhs" +
+		"cdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKw" +
+		"xWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvD" +
+		"FoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0O" +
+		"Wxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4" +
+		"bHHY4rDFYYvDFoctDlsctjhscdgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLY" +
+		"Itgi2CLYItgi2CLYItgi2CLYItwmgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+C" +
+		"H4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+C" +
+		"H4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+C" +
+		"H4Ifgh+CH4Ifgh+CH4Ifgh+CH36mv9p/gi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYIt" +
+		"gi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItLltctrhscdnissVli8sWly0uW1y2uGxx" +
+		"2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLF" +
+		"ZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sW" +
+		"ly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5b" +
+		"XLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFtdt4vLD5YfL" +
+		"D5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8u" +
+		"P1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54" +
+		"/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh" +
+		"8cPjh8cPP9O/3n9K+X/rz0OLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYP" +
+		"LR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8" +
+		"tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx" +
+		"0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHF" +
+		"Q4uHFg8tfqZ//Tf+X1//8M+//9PfnL///Z/+4ff//Nvv/vAff/7zb//y73/8pz/8++/+9r+nv/vjb//4T//y259/+7d//Y8/" +
+		"/+G33/3pX//037/6n89/Ao83PRU=",
+	"UniCNS-UTF16-V": "" +
+		"eNpVUMuOwjAMvPcrvAckOIRQWO0ddbWiB1hEeJwhcVEk6kRpcujfb3Ej0B5ia+yZ8SiTj70Sa+NuKFbzBRywcyloFNX26gtZ" +
+		"1d812QhyH5xWGKGxZELmwA3vlopyCcbqmBFX3Wax6ruIbU2Ng9XIMslnpjwMrYuhhynfn4HBppC/wWCwdIdptVNlHqrk/QNb" +
+		"pAifPEEy4+aZc3dtEeSJ7KAQp+NP+SXO7+2x9wjLEV+2ziCUI/gn2EDqkHMP1txfzjqFMFzm+Oz4lFvC1z94xyp+f6mZbVY=",
+	"UniGB-UCS2-H": "" +
+		"eNpl2M1uHMcZBdC9nmKyCOAsFLH+uqsAw0BVdbfjRRzDih9AIkcCgYgkKGqhtw/Jw1iGMwBJ1O2umQ/nDohG/fUvv7x93a9u" +
+		"359fp79fnH49f779cn95fj3/+e7u1Zv50/bTzfXD6c0v97eXb88Ppw/XN1f3L/ec3p8/Xt+8CvF0dX358LJ6/n356WXz26+f" +
+		"H86ffrr5cHtK7rr6cvdy55tfH/98frj/evru+fP/dro6f3j15l/3V+f765uPp+9+HOEle/vl7u4/50/nm4dTfk7ON1euPI35" +
+		"87tP59Ob326ufxyvf5tv4+t/fLv2769351N8Xgefe3l7df589+7yfP/u5uP51fcXj68fTt8fj68fnt73T9fDxYV97z982xAe" +
+		"N1xcPG44WT2G4fldLoLwafUYRmEURmESJmESZmEWZmERFmERLsJFuAhX4SpchVVYhVXYhE3YhF3YhV04hEM4hFM4hVO4CTfh" +
+		"JtyFu3AXHsJDeDyHQQGB5/PqMeQZeAaegWfgGXgGnoFn4Bl4Bp6BZ+AZeAaegWfgGXgGnoFn4Bl4Bp6BZ+AZeAaegWfgGXgG" +
+		"noFn4Bl4Bp6BZ+AZeAaegWfgGXgGnoFn4Bl5Rp6RZ+QZeUaekWfkGXlGnpFn5Bl5Rp6RZ+QZeUaekWfkGXlGnpFn5Bl5Rp6R" +
+		"Z+QZeUaekWfkGXlGnpFn5Bl5Rp6RZ+QZeUaekWfkGXlGnpFn5Jl4Jp6JZ+KZeCaeiWfimXgmnoln4pl4Jp6JZ+KZeCaeiWfi" +
+		"mXgmnoln4pl4Jp6JZ+KZeCaeiWfimXgmnoln4pl4Jp6JZ+KZeCaeiWfimXgmnoln4pl5Zp6ZZ+aZeWaemWfmmXlmnpln5pl5" +
+		"Zp6ZZ+aZeWaemWfmmXlmnpln5pl5Zp6ZZ+aZeWaemWfmmXlmnpln5pl5Zp6ZZ+aZeWaemWfmmXlmnpln5ll4Fp6FZ+FZeBae" +
+		"hWfhWXgWnoVn4Vl4Fp6FZ+FZeBaehWfhWXgWnoVn4Vl4Fp6FZ+FZeBaehWfhWXgWnoVn4Vl4Fp6FZ+FZeBaehWfhWXgWnoVn" +
+		"4bnwXHguPBeeC8+F58Jz4bnwXHguPJ9XT48V/3t8+P/niYX1wnphvbBeWC+sF9YL64X1wnphvbBeWC+sF9YL64X1wnphvbBe" +
+		"WC+sF9YL64X1wnphvbBeWC+sF9YL64X1wnphvbBeWa+sV9Yr65X1ynplvbJeWa+sV9ar7+7Kc+W58lx5rjxXnivPlefKc+W5" +
+		"8lx5rjxXnivPlefKc+W58lx5rjxXnivPlefKc+W58lx5rjxXnivPlefKc+W58lx5Vp6VZ+VZeVaelWflWXlWnpVn5Vl5Vp6V" +
+		"Z+VZeVaelWflWXlWnpVn5Vl5Vp6VZ+VZeVaelWflWXlWnpVn5Vl5Vp6VZ+VZeVaelWflWXlWnpVn5Vl5Np6NZ+PZeDaejWfj" +
+		"2Xg2no1n49l4Np6NZ+PZeDaejWfj2Xg2no1n49l4Np6NZ+PZeDaejWfj2Xg2no1n49l4Np6NZ+PZeDaejWfj2Xg2no1n49l4" +
+		"dp6dZ+fZeXaenWfn2Xl2np1n59l5dp6dZ+fZeXaenWfn2Xl2np1n59l5dp6dZ+fZeXaenWfn2Xl2np1n59l5dp6dZ+fZeXae" +
+		"nWfn2Xl2np1n59l5Dp6D5+A5eA6eg+fgOXgOnoPn4Dl4Dp6D5+A5eA6eg+fgOXgOnoPn4Dl4Dp6D5+A5eA6eg+fgOXgOnoPn" +
+		"4Dl4Dp6D5+A5eA6eg+fgOXgOnoPn4Dl4Tp6T5+Q5eU6ek+fkOXlOnpPn5Dl5Tp6T5+Q5eU6ek+fkOXlOnpPn5Pm8+uPzRK5/" +
+		"epyYqCfqiXqinqgn6ol6op6oJ+qJeqKeqCfqiXqinqgn6ol6op6oJ+qJeqLeUG+oN9Qb6g31hnpDvaHeUG+oN9Qb6g31hnpD" +
+		"vaHeUG+oN9Qb6g31hnpDvfnq7ubczbmbczfnbs7dnLs5d3Pu5tzNuZtzN+duzt2cuzl3c+7m3M25m3M3527O3Zy7OfeXOfW+" +
+		"633X+673Xe+73ne973rf9b7rfdf7rvdd77ved73vet/1vut91/uu913vu953ve96P17O23gePA+eB8+D58Hz4HnwPHgePA+e" +
+		"B8+D58Hz4HnwPHgePA+eB8+D58Hz4HnwPHgePA+eB8+D58Hz4HnwPHgePA+eB8+D58Hz4HnwPHgePA+eB8/j+HZUebL647+C" +
+		"p8PLp4PX389EL7/c359vHp7PXZ9PQ5/OQa9vzr8f4N7d3j3tev75L/EdzJw=",
+	"UniGB-UCS2-V": "" +
+		"eNpNUMtuwjAQvOcrtgckenBNQn+gpBLkwEMY2jPYG2SJrC3HPuTvGzYW5eBdze7M7Mizt4MSX8ZdUSw/FnDE3qWgUdTbiy9k" +
+		"3Xw3ZCPIQ3BaYYTWkgmZA1e8WSrKCozVMSOuustiNfQRu4ZaB8uJZZLPTHkcWx/DAHO+/w4G20Lug8Fg6Qbz9arMM5W8v2OH" +
+		"FOGTJ0hm2jxi7i4dgjyTXa/EuVaV+PnfnQaPUE34d+sMQjmBF/oGUo8cebTl/nTVKYTxKidnv4fYEj6/wDtW8fsDlPhr0w==",
+	"UniGB-UTF16-H": "" +
+		"eNqM2M3uYDli3ue9rqKzCOAsFDW/ScAwQPKQjhdxDMu+AEnTMgREI2EkL3z3GTdL8yAJ4PYseurPZlW9+J1ePOf8r//Lf/jL" +
+		"P5+/+4e//uXP0//+80//8Zd/+of/+oe/+eXP9//5V//4Z3+x/933737/d//801/8hz/8w9/85S///NPf/t3vf/eHH3d++utf" +
+		"/svf/f7PQvzpd3/3N//846df//k3f//jN//lf/unf/7l7//d7//2H35K79bv/us//rj5F//xj//3T//8h//207/69e//3376" +
+		"3S9/+2d/8X/94Xe//OHvfv9ffvpX/3aFH2d/+V//8R//71/+/pff//NP+deTX37/u/dv/vvMf/9Xf//LT3/xn3//d/92/fl/" +
+		"/k831D//P/zL//Tf/vGXn+KvP6f3F//NP/zul3/6x7/6m1/+8Fe//y+//Nm//vmP//s3P/3rr937b/7sX3/955+//U7Wvd/9" +
+		"9fS8O/fXn/74l/9//ozw88/vz/7rv/WHhj/+hp9//uNv+On99MfD8Ouf8nN4h//9pz8exncY32F8h+kdpneY3mF+h/kd5ndY" +
+		"3mF5h+Ud1ndY32F9h+0dtnfY3mF/h/0d9nc43uF4h+Mdznc43+F8h+sdrne43uHL9/N+h/sdfu/we4ffOzzv8LzD8w7vO7zv" +
+		"8P56GN4DCK/nrz/98fD1DK9neD3D6xlez/B6htczvJ7h9QyvZ3g9w+sZXs/weobXM7ye4fUMr2d4PcPrGV7P8HqG1zO8nuH1" +
+		"DK9neD3D6xlez/B6htczvJ7h9QyvZ3g9w+sZXs/weobXM7ye4fUMr2d4PcPrGV7P+HrG1zO+nvH1jK9nfD3j6xlfz/h6xtcz" +
+		"vp7x9YyvZ3w94+sZX8/4esbXM76e8fWMr2d8PePrGV/P+HrG1zO+nvH1jK9nfD3j6xlfz/h6xtczvp7x9YyvZ3w94+sZX8/4" +
+		"esbXM76e8fWMr2d8PePrGV/P9Hqm1zO9nun1TK9nej3T65lez/R6ptczvZ7p9UyvZ3o90+uZXs/0eqbXM72e6fVMr2d6PdPr" +
+		"mV7P9Hqm1zO9nun1TK9nej3T65lez/R6ptczvZ7p9UyvZ3o90+uZXs/0eqbXM72e6fVMr2d6PdPrmV7P/Hrm1zO/nvn1zK9n" +
+		"fj3z65lfz/x65tczv5759cyvZ3498+uZX8/8eubXM7+e+fXMr2d+PfPrmV/P/Hrm1zO/nvn1zK9nfj3z65lfz/x65tczv575" +
+		"9cyvZ3498+uZX8/8eubXM7+e+fXMr2d+PfPrmV/P8nqW17O8nuX1LK9neT3L61lez/J6ltezvJ7l9SyvZ3k9y+tZXs/yepbX" +
+		"s7ye5fUsr2d5PcvrWV7P8nqW17O8nuX1LK9neT3L61lez/J6ltezvJ7l9SyvZ3k9y+tZXs/yepbXs7ye5fUsr2d5PcvrWV7P" +
+		"+nrW17O+nvX1rK9nfT3r61lfz/p61tezvp6//vTfWfEvfPj/e6K+1vW1rq91fa3ra11f6/pa19e6vtb1ta6vdX2t62tdX+v6" +
+		"WtfXur7W9bWur3V9retrXV/r+lrX17q+1vW1rq91fa3ra11f6/pa19e6vtb1ta6vdX2t22vdXuv2WrfXur3W7bVur3V7rdtr" +
+		"3V7r9lq3999uez3b69lez/Z6ttezvZ7t9WyvZ3s92+vZXs/2erbXs72e7fVsr2d7Pdvr2V7P9nq217O9nu31bK9nez3b69le" +
+		"z/Z6ttezvZ7t9WyvZ3s92+vZXs/2evbXs7+e/fXsr2d/Pfvr2V/P/nr217O/nv317K9nfz3769lfz/569tezv5799eyvZ389" +
+		"++vZX8/+evbXs7+e/fXsr2d/Pfvr2V/P/nr217O/nv317K9nfz3769lfz/569tezv5799eyvZ389++vZX8/+eo7Xc7ye4/Uc" +
+		"r+d4PcfrOV7P8XqO13O8nuP1HK/neD3H6zlez/F6jtdzvJ7j9Ryv53g9x+s5Xs/xeo7Xc7ye4/Ucr+d4PcfrOV7P8XqO13O8" +
+		"nuP1HK/neD3H6zlez/F6jtdzvJ7j9Ryv53g9x+s5Xs/xes7Xc76e8/Wcr+d8PefrOV/P+XrO13O+nvP1nK/nfD3n6zlfz/l6" +
+		"ztdzvp7z9Zyv53w95+s5X8/5es7Xc76e8/Wcr+d8PefrOV/P+XrO13O+nvP1nK/nfD3n6zlfz/l6ztdzvp7z9Zyv53w95+s5" +
+		"X8/5eq7Xc72e6/Vcr+d6PdfruV7P9Xqu13O9nuv1XK/nej3X67lez/V6rtdzvZ7r9Vyv53o91+u5Xs/1eq7Xc72e6/Vcr+d6" +
+		"PdfruV7P9Xqu13O9nuv1XK/nej3X67lez/V6rtdzvZ7r9Vyv53o91+u5Xs/1eu7Xc7+e+/Xcr+d+PffruV/P/Xru13O/nvv1" +
+		"3K/nfj3367lfz/167tdzv5779dyv53499+u5X89ff/ofe2K/1vu13q/1fq33a71f6/1a79d6v9b7td6v9X6t92u9X+v9Wu/X" +
+		"er/W+7Xer/V+rfdrvV/r/Vrv1/r78XHmtf5e6++1/l7r77X+Xuvvtf5e6++1/l7r77X+Xuvvtf5e6++1/l7r77X+Xuvvtf5e" +
+		"66/5XvTT++lPH4jO23nezvN2nrfzvJ3n7Txv53k7z9t53s7zdp6387yd5+08b+d5O8/bed7O83aet/O8neftPD92vud+3nM/" +
+		"77mf99zPe+7nPffznvt5z/28537ecz/vuZ/33M977uc99/Oe+3nP/bznft5zP++5n/fcz3vu5z338577ec/9/vjg9nre1/O+" +
+		"nvf1vK/nfT3v63lfz/t63tfzvp739byv53097+t5X8/7et7X876e9/W8r+d9Pe/reV/P+3re1/O+nvf1vK/nfT3v63lfz/t6" +
+		"3tfzvp739byv53097+t5X8/7et7X876e9/W817fKn95P/+/Pmr/+8v3H+y+nPy58LnwufC4cF44Lx4XrwnXhTxuCDcGGYEOw" +
+		"IdgQbAg2BBuCDcGGYEOwIdoQbYg2RBuiDdGGaEO0IdoQbYg2RBuSDcmGZEOyIdmQbEg2JBuSDcmGZEOyIduQbcg2ZBuyDdmG" +
+		"bEO2IduQbcg2ZBuKDcWGYkOxodhQbCg2FBuKDcWGYkOxodpQbag2VBuqDdWGakO1odpQbag2VBuaDc2GZkOzodnQbGg2NBua" +
+		"Dc2GZkOzodvQbeg2dBu6Dd2GbkO3odvQbeg2dBuGDcOGYcOwYdgwbBg2DBuGDcOGYcOwYdowbZg2TBumDdOGacO0YdowbZg2" +
+		"TBuWDcuGZcOyYdmwbFg2LBuWDcuGZcOyYduwbdg2bBu2DduGbcO2Yduwbdg2vNP/sV//eO2z77Pvs++z77Pvs++z77Pvs++z" +
+		"77Pv0+jYcGw4Nhwbjg3HhmPDseHYcGw4Nhwbrg3XhmvDteHacG24Nlwbrg3XhmvD/dOGwBaBLQJbBLYIbBHYIrBFYIvAFoEt" +
+		"AlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2" +
+		"CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHY" +
+		"IrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVg" +
+		"i8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaB" +
+		"LQJbBLYIbBHYIrBFYIvAFoEtAlv8OP31Aj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfA" +
+		"D4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8i" +
+		"P0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I" +
+		"/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPzw4/S33n8iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvI" +
+		"FpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0i" +
+		"W0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaI" +
+		"bBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdki" +
+		"skVki8gWkS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKL" +
+		"xBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFokt" +
+		"ElsktkhskdgisUVii8QWybeJxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+J" +
+		"HxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8k" +
+		"fkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ" +
+		"+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj/8OP2t95/EFoktElsktkhskdgisUVii8QWiS0SWyS2yGyR2SKzRWaLzBaZ" +
+		"LTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltk" +
+		"tshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR" +
+		"2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNF" +
+		"ZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wW" +
+		"mS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJb" +
+		"ZLbIbJHZIrNF9m0i80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8K" +
+		"PxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o" +
+		"/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih" +
+		"8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg8/Tn/r/aewRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0K" +
+		"WxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYo" +
+		"bFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdii" +
+		"sEVhi8IWhS0KWxS2KGxR2KKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWL" +
+		"yhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUt" +
+		"KltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2" +
+		"qGxRfZuo/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9U" +
+		"fqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ" +
+		"+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH5o/ND4ofFD" +
+		"44fGD40fGj80fmj80Pih8cOP0996/2ls0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0" +
+		"tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR" +
+		"2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFF" +
+		"Y4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YW" +
+		"jS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpb" +
+		"NLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt03yY6" +
+		"P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o" +
+		"/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh" +
+		"80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eH" +
+		"zg+dHzo/dH7o/PDj9LfefzpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbo" +
+		"bNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmi" +
+		"s0Vni84WnS06W3S26GzR2aKzRWeLzhadLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCL" +
+		"wRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMt" +
+		"BlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2" +
+		"GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRbDt4nBD4MfBj8M" +
+		"fhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww" +
+		"+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD" +
+		"4IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kP" +
+		"kx8mP/w4/a33n8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx" +
+		"2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLF" +
+		"ZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kW" +
+		"ky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZb" +
+		"TLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLZYbLHYYrHFYovFFostFlsstlhs" +
+		"sdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscXybWLxw+KHxQ+LHxY/LH5Y" +
+		"/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh" +
+		"8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KH" +
+		"xQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFDz9O" +
+		"f+v9Z7HFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhi" +
+		"scVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaL" +
+		"zRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpst" +
+		"Nltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y2" +
+		"2Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZ" +
+		"YrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLF9m9j8sPlh88Pmh80Pmx82P2x+2Pyw" +
+		"+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD" +
+		"5ofND5sfNj9sfvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx8+" +
+		"fvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx8+fvj44eOHjx9+" +
+		"nP7W+8/HFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9b" +
+		"fGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9b" +
+		"fGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9b" +
+		"fGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9bfGzxscXHFh9b" +
+		"fGzxscXHFh9bfGzxscVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0O" +
+		"Wxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLY5vE4cf" +
+		"Dj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+" +
+		"OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD4" +
+		"4fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPh" +
+		"h8MPhx8OPxx++HH6W+8/hy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWly0uW1y2" +
+		"uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ" +
+		"4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVl" +
+		"i8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaX" +
+		"LS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltc" +
+		"trhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli+vbxOWHyw+XHy4/" +
+		"XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eH+yQ/j5z/54ddfvgv/cvrjwufC58LnwnHhuHBcuC5c" +
+		"F/60IdgQbAg2BBuCDcGGYEOwIdgQbAg2BBuiDdGGaEO0IdoQbYg2RBuiDdGGaEO0IdmQbEg2JBuSDcmGZEOyIdmQbEg2JBuy" +
+		"DdmGbEO2IduQbcg2ZBuyDdmGbEO2odhQbCg2FBuKDcWGYkOxodhQbCg2FBuqDdWGakO1odpQbag2VBuqDdWGakP9n3n/GT83" +
+		"+5p9zb5mX7Ov2dfsa/Y1+5p9zb6mUbeh29Bt6DZ0G7oN3YZuQ7eh29Bt6DYMG4YNw4Zhw7Bh2DBsGDYMG4YNw4Zhw7Rh2jBt" +
+		"mDZMG6YN04Zpw7Rh2jBtmDYsG5YNy4Zlw7Jh2bBsWDYsG5YNy4Zlw7Zh27Bt2DZsG7YN24Ztw7Zh27Bt2DZ8Nnw2fDZ8Nnw2" +
+		"fDZ8Nnw2fDZ8Nnw2fDYcG44Nx4Zjw7Hh2HBsODYcG44Nx4Zjw7Xh2nBtuDZcG64N14Zrw7Xh2nBtYIvAFoEtAlsEtghsEdgi" +
+		"sEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCL" +
+		"wBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEt" +
+		"AlsEtghsEdgisEVgi8AWgS0CW4RqAz8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4Ef" +
+		"Aj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+" +
+		"CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4" +
+		"IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPzw4/S33n8iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEt" +
+		"IltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2" +
+		"iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZ" +
+		"IrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVk" +
+		"i8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaR" +
+		"LSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltE" +
+		"tohsEdkiskVki8gW0beJxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/" +
+		"JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8" +
+		"kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHx" +
+		"Q+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj/8OP2t95/EFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJb" +
+		"JLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhs" +
+		"kdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2yGyR2SKz" +
+		"RWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovM" +
+		"FpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0y" +
+		"W2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbI" +
+		"bJHZIrNF9m0i80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+" +
+		"yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5" +
+		"IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPh" +
+		"h8IPhR8KPxR+KPxQ+KHwQ+GHwg8/Tn/r/aewRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2" +
+		"KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHY" +
+		"orBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVh" +
+		"i8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaF" +
+		"LQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsU" +
+		"tihsUdiisEVhi8IWhS0KWxS2KGxR2KKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR" +
+		"fZuo/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8" +
+		"UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHy" +
+		"Q+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfK" +
+		"D5UfKj9Ufqj8UPmh8sOP0996/6lsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhs" +
+		"UdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKy" +
+		"RWWLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vG" +
+		"Fo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0a" +
+		"WzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZo" +
+		"bNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls03yYaPzR+" +
+		"aPzQ+KHxQ+OHxg+NHxo/NH5o/ND4ofFD44fGD40fGj80fmj80Pih8UPjh8YPjR8aPzR+aPzQ+KHxQ+OHxg+NHxo/NH5o/ND4" +
+		"ofFD44fGD40fGj80fmj80Pih8UPjh8YPjR8aPzR+aPzQ+KHxQ+OHxg+NHxo/NH5o/ND4ofND54fOD50fOj90fuj80Pmh80Pn" +
+		"h84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+d" +
+		"Hzo/dH7o/PDj9LfefzpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZ" +
+		"orNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vn" +
+		"i84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhad" +
+		"LTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0" +
+		"tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx" +
+		"2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRbDt4nBD4MfBj8Mfhj8" +
+		"MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHw" +
+		"w+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfB" +
+		"D4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8G" +
+		"P/w4/a33n8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKw" +
+		"xWCLwRaDLQZbDLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJ" +
+		"FpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0m" +
+		"W0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaY" +
+		"bDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdli" +
+		"ssVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissX0bWLyw+SHyQ+THyY/TH6Y/DD5" +
+		"YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfFj8sflj8sPhh8cPi" +
+		"h8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+L" +
+		"HxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFDz9Of+v9" +
+		"Z7HFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVi" +
+		"i8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaL" +
+		"LRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlss" +
+		"tlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx" +
+		"2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPF" +
+		"ZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLF9m9j8sPlh88Pmh80Pmx82P2x+2Pyw+WHz" +
+		"w+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofN" +
+		"D5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82" +
+		"P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw4/T33r/2Wyx2WKz" +
+		"xWaLzRabLTZbbLbYbLHZYrPFZouPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+Nji" +
+		"Y4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+Nji" +
+		"Y4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+Nji" +
+		"Y4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+Nji" +
+		"Y4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+Nji" +
+		"Y4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+Nji823i44ePHz5+" +
+		"+Pjh44ePHz5++Pjh44ePHz5++Pjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh" +
+		"8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GH" +
+		"ww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cf" +
+		"Dj8cfjj88OP0t95/DlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdji" +
+		"sMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGL" +
+		"wxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoct" +
+		"LltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2" +
+		"uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ" +
+		"4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFte3icsPlx8uP1x+uPxw" +
+		"+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD" +
+		"5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sP" +
+		"lx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sP9kx/mz3/yw6+/fBf+5fTHhc+Fz4XPhePC" +
+		"ceG4cF24LvxPvP/Mn4N9wb5gX7Av2BfsC/YF+4J9wb5gX9Ao2hBtiDZEG6IN0YZoQ7Qh2hBtiDZEG5INyYZkQ7Ih2ZBsSDYk" +
+		"G5INyYZkQ7Ih25BtyDZkG7IN2YZsQ7Yh25BtyDZkG4oNxYZiQ7Gh2FBsKDYUG4oNxYZiQ7Gh2lBtqDZUG6oN1YZqQ7Wh2lBt" +
+		"qDZUG5oNzYZmQ7Oh2dBsaDY0G5oNzYZmQ7Oh29Bt6DZ0G7oN3YZuQ7eh29Bt6DZ0G4YNw4Zhw7Bh2DBsGDYMG4YNw4Zhw7Bh" +
+		"2jBtmDZMG6YN04Zpw7Rh2jBtmDZMG5YNy4Zlw7Jh2bBsWDYsG5YNy4Zlw7Jh27Bt2DZsG7YN24Ztw7Zh27Bt2DZsGz4bPhs+" +
+		"Gz4bPhs+Gz4bPhs+Gz4bPhs+G44Nx4Zjw7Hh2HBsODYcG44Nx4Zjw7Hh2nBtuDZcG64N14Zrw7Xh2nBtuDawRWCLwBaBLQJb" +
+		"BLYIbBHYIrBFYIvAFoEtws828EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPg" +
+		"h8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+B" +
+		"HwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8E" +
+		"fgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA8/Tn/r/SewRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVg" +
+		"i8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaB" +
+		"LQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltE" +
+		"tohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR" +
+		"2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJF" +
+		"ZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gW" +
+		"kS0iW0S2iGwRfZuI/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfI" +
+		"D5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8i" +
+		"P0R+iPwQ+SHyQ+SHyA+RHyI/RH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I" +
+		"/JD4IfFD4ofED4kfEj8kfkj8kPgh8cOP0996/0lskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovE" +
+		"FoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0S" +
+		"WyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZI" +
+		"bJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgi" +
+		"sUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKL" +
+		"zBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpkt" +
+		"Mltk3yYyP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+Z" +
+		"HzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9k" +
+		"fsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ" +
+		"+SHzQ+aHzA+ZHzI/ZH7I/PDj9LfefzJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZ" +
+		"LTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLQpbFLYobFHYorBFYYvCFoUtClsU" +
+		"tihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR" +
+		"2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBF" +
+		"YYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IW" +
+		"hS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhbFt4nC" +
+		"D4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8K" +
+		"PxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o" +
+		"/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VH/6fQuwmZ9reKMDonFVkB3D730PbZc9gD1Hy" +
+		"ITEAogD75+8hJxKDd9BSq+SWrlHrVDV+aPzQ+KHxQ+OHxg+NHxo/NH5o/ND4ofFD44fGD40fGj80fviZ/mr/aWzR2KKxRWOL" +
+		"xhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0t" +
+		"Gls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2" +
+		"aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHY" +
+		"orFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vOFp0tOlt0tuhs0dmis0Vn" +
+		"i84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhad" +
+		"LTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S3ic4PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND" +
+		"54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84P" +
+		"nR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/" +
+		"dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo//Ex/tf90tuhs0dmis0Vni84WnS06" +
+		"W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYY" +
+		"bDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhi" +
+		"sMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCL" +
+		"wRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMt" +
+		"BlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2" +
+		"GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMthtvE4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+D" +
+		"HwY/DH4Y/DD4YfDD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9M" +
+		"fpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww" +
+		"+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx9+pr/afyZbTLaYbDHZYrLFZIvJFpMtJltMtphs" +
+		"MdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKy" +
+		"xWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJ" +
+		"FpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdliscVii8UWiy0W" +
+		"Wyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZY" +
+		"bLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhi" +
+		"scVii8UWiy0WWyy2WGyx2GKxxWKLxRbLbWLxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+" +
+		"WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4" +
+		"YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPi" +
+		"h8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFDz/TX+0/my02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPF" +
+		"ZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80W" +
+		"my02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZb" +
+		"bLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstths" +
+		"sdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKz" +
+		"xWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovN" +
+		"FpstNltstthssdlis8Vmi+02cfjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh" +
+		"8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GH" +
+		"ww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cf" +
+		"Dj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHn+mv9p/DFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaH" +
+		"LQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsc" +
+		"tjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2CLYI" +
+		"tgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYI" +
+		"tgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYI" +
+		"tgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi3ieCH" +
+		"4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH" +
+		"4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH" +
+		"4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPP" +
+		"9Ff7z2WLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLF" +
+		"ZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sW" +
+		"ly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5b" +
+		"XLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhs" +
+		"cdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKy" +
+		"xWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8djiscVji8cWjy0eWzy2eGzx2OK5TTx+ePzw+OHxw+OHxw+PHx4/PH54" +
+		"/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh" +
+		"8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OH" +
+		"xw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44Wf6q/3nscVj" +
+		"i8cWjy0eWzy2eGzx2OKxxWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8djiscVji8cWjy0eWzy2eGzx2OKxxWOLxxaP" +
+		"LR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8djiscVji8cWjy0eWzy2eGzx2OKxxWOLxxbvL7bYf/cXW/zP1/998H/TnwfhQXgQ" +
+		"HlwPrgfXg+fB8+AvDZ+GT8On4dPwafg0fBo+DZ+GT8On4dOQNCQNSUPSkDQkDUlD0pA0JA1JQ9KQNWQNWUPWkDVkDVlD1pA1" +
+		"ZA1ZQ9ZQNBQNRUPRUDQUDUVD0VA0FA1FQ9FQNVQNVUPVUDVUDVVD1VA1VA1VQ9XQNDQNTUPT0DQ0DU1D09A0NA1NQ9PQNXQN" +
+		"XUPX0DV0DV1D19A1dA1dQ9cwNAwNQ8PQMDQMDUPD0DA0DA1Dw9AwNUwNU8PUMDVMDVPD1DA1TA1Tw9SwNCwNS8PSsDQsDUvD" +
+		"0rA0LA1Lw9KwNWwNW8PWsDVsDVvD1rA1bA1bw9ZwNBwNR8PRcDQcDUfD0XA0HA1Hw9EQGkJDaAgNoSE0hIbQEBpCQ2gIDVfD" +
+		"1XA1XA1Xw9VwNVwNV8PVcDVcDU/D0/A0PA1Pw9PwNDwNT8PT8DTww8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98" +
+		"/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98" +
+		"/PAz/cX+sz+2+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62" +
+		"+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62" +
+		"+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62" +
+		"+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62" +
+		"+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVi" +
+		"i8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBbJ" +
+		"bSLxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD" +
+		"4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QP" +
+		"iR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/" +
+		"JH5I/JD4IfFD4ofEDz/TX+0/iS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0S" +
+		"WyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbI" +
+		"bJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdki" +
+		"s0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaL" +
+		"zBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpkt" +
+		"Mltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi+w2kfkh80Pm" +
+		"h8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+F" +
+		"Hwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8U" +
+		"fij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ" +
+		"+KHwQ+GHn+mv9p/CFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihs" +
+		"UdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKw" +
+		"RWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvC" +
+		"FoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvKFpUtKltUtqhsUdmiskVli8oWlS0q" +
+		"W1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLao" +
+		"bFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFdZuo/FD5ofJD5YfKD5Uf" +
+		"Kj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+" +
+		"qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5" +
+		"ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8sPP" +
+		"9Ff7T2WLyhaVLSpbVLaobFHZorJFZYvKFpUtKls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFF" +
+		"Y4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YW" +
+		"jS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpb" +
+		"NLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs" +
+		"0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKx" +
+		"RWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KK5TTR+aPzQ+KHxQ+OHxg+NHxo/NH5o" +
+		"/ND4ofFD54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh" +
+		"80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eH" +
+		"zg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND54Wf6q/2ns0Vn" +
+		"i84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhad" +
+		"LTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0" +
+		"tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dlisMVgi8EWgy0GWwy2GGwx" +
+		"2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDF" +
+		"YIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EW" +
+		"gy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdwmBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHw" +
+		"w+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfB" +
+		"D4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8G" +
+		"Pwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPzwM/3V/jPZYrLFZIvJFpMt" +
+		"JltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2" +
+		"mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZ" +
+		"YrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVk" +
+		"i8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaT" +
+		"LSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltM" +
+		"tphsMdlissVki8kWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbhOLHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UP" +
+		"ix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/" +
+		"LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8" +
+		"sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sfviZ/mr/WWyx2GKxxWKLxRaLLRZbLLZY" +
+		"bLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhi" +
+		"scVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy22Gyx2WKzxWaL" +
+		"zRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpst" +
+		"Nltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y2" +
+		"2Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZ" +
+		"YrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y3ic0Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9s" +
+		"ftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw" +
+		"+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD" +
+		"4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4//Ex/tf8ctjhscdjisMVhi8MWhy0OWxy2OGxx2OKw" +
+		"xWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvD" +
+		"FoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0O" +
+		"Wxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4" +
+		"bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdji" +
+		"sMVhi8MWhy0OWxy2OGxx2OKwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWw" +
+		"RbBFsEWwRbBFsEWwRbBFsEW4TQQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8E" +
+		"PwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8E" +
+		"PwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8E" +
+		"PwQ/BD8EPwQ/BD8EPwQ/BD/8TH+1/wRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRb" +
+		"BFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLF" +
+		"ZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sW" +
+		"ly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5b" +
+		"XLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhs" +
+		"cdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly2u28Tlh8sPlx8u" +
+		"P1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64" +
+		"/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh" +
+		"8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OH" +
+		"xw+PH36mf73/lPL/1p+HFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8" +
+		"tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx" +
+		"0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHF" +
+		"Q4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cW" +
+		"Dy0eWvxM//pv/L++/uGff/+nvzl///s//cPv//m33/3hP/7859/+5d//+E9/+Pff/e1/T3/3x9/+8Z/+5bc///Zv//off/7D" +
+		"b7/707/+6b9/9T+f/wQsKDxf",
+	"UniGB-UTF16-V": "" +
+		"eNpNUMuOwjAMvPcrvAck9hCyhdXeeQjogYcoj3NJXBRp60RpcujfU9yI3UNsjT0zHmX0cSzFXNs7itnkC07Y2ugViuWucplc" +
+		"FquCTAB59FaVGKA2pH3iwB0fhrJ8CtqokBBX1SRx2bUBm4JqC7OBpaNLTHnqWxt8B2O+/wka60wevEZv6AHjzSJPszI694sN" +
+		"UoBvniDpYfOKua8aBHkhs1mIy3md/4jr3/LcOYTpgG87qxHyAfznbyG2yKF7Y+5vXxW97+9ydjZ8qQ3h+xOcZRW/J1d5bEU=",
+	"UniJIS-UCS2-H": "" +
+		"eNpl2F9rHccdBuB7f4rTi0J64Vrzb3cGQmBmdpc60DREzQewpWMjqGUhyxf59pX0qHFID0hi3t0558fzHsQyf/3Lz5ev+/Xn" +
+		"9+fX6e8Xp1/OXz5/vb86v57/fHf36s18u729vXk4vfn5/vPV5fnh9OHm9vr+5Z7T+/PHm9tXIZ6ub64eXlbPv68+vWy+/O3L" +
+		"w/nT29sPn0/JXddf717ufPPL458vD/e/nb57/vy/na7PH169+df99fn+5vbj6bsf3929uw0v8eXXu7v/nD+dbx9O+Tk53167" +
+		"8jTpT+8+nU9vfr29+fHt5etf52V8/Y9vF//92935FJ/XwWdffb4+f7l7d3W+f3f78fzq+4vH1w+n74/H1w9Pb/yn6+Hiwr73" +
+		"H75tCI8bLi4eN5ysHsPw/C4XQfi0egyjMAqjMAmTMAmzMAuzsAiLsAgX4SJchKtwFa7CKqzCKmzCJmzCLuzCLhzCIRzCKZzC" +
+		"KdyEm3AT7sJduAsP4SE8nsOggMDzefUY8gw8A8/AM/AMPAPPwDPwDDwDz8Az8Aw8A8/AM/AMPAPPwDPwDDwDz8Az8Aw8A8/A" +
+		"M/AMPAPPwDPwDDwDz8Az8Aw8A8/AM/AMPAPPwDPwjDwjz8gz8ow8I8/IM/KMPCPPyDPyjDwjz8gz8ow8I8/IM/KMPCPPyDPy" +
+		"jDwjz8gz8ow8I8/IM/KMPCPPyDPyjDwjz8gz8ow8I8/IM/KMPCPPyDPyTDwTz8Qz8Uw8E8/EM/FMPBPPxDPxTDwTz8Qz8Uw8" +
+		"E8/EM/FMPBPPxDPxTDwTz8Qz8Uw8E8/EM/FMPBPPxDPxTDwTz8Qz8Uw8E8/EM/FMPBPPxDPxzDwzz8wz88w8M8/MM/PMPDPP" +
+		"zDPzzDwzz8wz88w8M8/MM/PMPDPPzDPzzDwzz8wz88w8M8/MM/PMPDPPzDPzzDwzz8wz88w8M8/MM/PMPDPPzDPzLDwLz8Kz" +
+		"8Cw8C8/Cs/AsPAvPwrPwLDwLz8Kz8Cw8C8/Cs/AsPAvPwrPwLDwLz8Kz8Cw8C8/Cs/AsPAvPwrPwLDwLz8Kz8Cw8C8/Cs/As" +
+		"PAvPwrPwXHguPBeeC8+F58Jz4bnwXHguPBeez6unx4r/PT78//PEwnphvbBeWC+sF9YL64X1wnphvbBeWC+sF9YL64X1wnph" +
+		"vbBeWC+sF9YL64X1wnphvbBeWC+sF9YL64X1wnphvbBeWK+sV9Yr65X1ynplvbJeWa+sV9Yr69V3d+W58lx5rjxXnivPlefK" +
+		"c+W58lx5rjxXnivPlefKc+W58lx5rjxXnivPlefKc+W58lx5rjxXnivPlefKc+W58lx5rjwrz8qz8qw8K8/Ks/KsPCvPyrPy" +
+		"rDwrz8qz8qw8K8/Ks/KsPCvPyrPyrDwrz8qz8qw8K8/Ks/KsPCvPyrPyrDwrz8qz8qw8K8/Ks/KsPCvPyrPyrDwbz8az8Ww8" +
+		"G8/Gs/FsPBvPxrPxbDwbz8az8Ww8G8/Gs/FsPBvPxrPxbDwbz8az8Ww8G8/Gs/FsPBvPxrPxbDwbz8az8Ww8G8/Gs/FsPBvP" +
+		"xrPxbDw7z86z8+w8O8/Os/PsPDvPzrPz7Dw7z86z8+w8O8/Os/PsPDvPzrPz7Dw7z86z8+w8O8/Os/PsPDvPzrPz7Dw7z86z" +
+		"8+w8O8/Os/PsPDvPzrPz7DwHz8Fz8Bw8B8/Bc/AcPAfPwXPwHDwHz8Fz8Bw8B8/Bc/AcPAfPwXPwHDwHz8Fz8Bw8B8/Bc/Ac" +
+		"PAfPwXPwHDwHz8Fz8Bw8B8/Bc/AcPAfPwXPwHDwnz8lz8pw8J8/Jc/KcPCfPyXPynDwnz8lz8pw8J8/Jc/KcPCfPyXPyfF79" +
+		"8Xki1z89TkzUE/VEPVFP1BP1RD1RT9QT9UQ9UU/UE/VEPVFP1BP1RD1RT9QT9UQ9UW+oN9Qb6g31hnpDvaHeUG+oN9Qb6g31" +
+		"hnpDvaHeUG+oN9Qb6g31hnpDvaHefHV3c+7m3M25m3M3527O3Zy7OXdz7ubczbmbczfnbs7dnLs5d3Pu5tzNuZtzN+duzt2c" +
+		"+8ucet/1vut91/uu913vu953ve963/W+633X+673Xe+73ne973rf9b7rfdf7rvdd77ved70fL+dtPA+eB8+D58Hz4HnwPHge" +
+		"PA+eB8+D58Hz4HnwPHgePA+eB8+D58Hz4HnwPHgePA+eB8+D58Hz4HnwPHgePA+eB8+D58Hz4HnwPHgePA+eB8+D53F8O6o8" +
+		"Wf3xX8HT4eXT4evvh6JXX+/vz7cPz2evz6ehT+egN7fn3w9x7z7fPe16/vkvF1DOWg==",
+	"UniJIS-UCS2-HW-H": "" +
+		"eNpVT8FuwjAMvfcrvAMSHLJA2Q9M5UCRYIiAdi6JW0VanShNDv37FTcCcbAt2+89Py8+zkp8G3dHsf1cwwUHl4JGUR0bX8iq" +
+		"3tVkI8hzcFphhNaSCRkDd+wsFZsSjNUxd5x1n8lqHCL2NbUOtjPKJJ+R8jKVIYYRlnx/BQbbQv4Eg8FSB8tD4xva5LFK3v9h" +
+		"jxThiydIZt48nJ6aHkHeyB5qJW6VKsX+V+xf++voEcq5f0NBGpDdTnJcn2o6hTBdY9Os8WBbwuf33jGL4x/qvmr3",
+	"UniJIS-UCS2-HW-V": "" +
+		"eNpdUMFuwjAMvfcrzGESO2RZy34AdQeKxEBkjHNJXBRpdaI0OfTvKW4EEofYevZ7z3beFgcl1sZdUKw+PuGIg0tBo6h3rS9k" +
+		"3Xw3ZCPIQ3BaYYTOkgmZAxe8WirKCozVMSOOus9iNQ4R+4Y6B6uZZZLPTHmc0hDDCEue/w4Gu0Lug8Fg6QrLbetbKnNZJe//" +
+		"sUeK8MUVJDN37pv+tD2CPJHdNkqcalWJzVn8Pfu/o0eoZnzeOYNQzuBFsoE0IG8/2XN+uOsUwjSdj2DPu4ElfPyGd6zidwN0" +
+		"t2+G",
+	"UniJIS-UCS2-V": "" +
+		"eNpNULtuwzAM3P0VzBAgHVTFTn4gcIc6QB6ImmZ2JDoQUFOCLA3++zq0kHYQiSPvjgctF2cldsbdUWze13DBwaWgUdSH1hey" +
+		"bj4ashHkOTitMEJnyYTMgTs+LBVlBcbqmBFX3WexGoeIfUOdg83MMslnprxMbYhhhBXffwODXSFPwWCw9IDVvvUtlXmskvc/" +
+		"2CNF2PIEycybZ9Jj2yPIK9l9o8S1VpX4/lt+jR6hmvHt4AxCOYP//E9IA3LuyZj7y1enEKa7HJ8Nn2pL+PoH71jF7xcFXG3u",
+	"UniJIS-UTF16-H": "" +
+		"eNqM2M3uYDli3ue9rqKzCOAsFDW/ScAwQPKQiAzEMSz7AmRNyxAQjQaSvPDdZ9wszYMkgNuz6Kk/m1X14nd68Zzzv/4v//6v" +
+		"/nz+7h/+8y9/nv73n3/6D7/80z/813/8m1/+fP+ff/2HP/uL/ZffX/7+7/75p7/49//4D3/zV7/8809/+3e//90//rjz03/+" +
+		"5b/83e//LMSffvd3f/PPP3769Z9/8/c/fvNf/bd/+udf/v4vf/+3//BTerd+91//8OPmX/yHP/7fP/3zP/63n/7Vr3////bT" +
+		"73752z/7i//rH3/3yz/+3e//y0//6t/+9R/++vfhx/Ff/dc//OH//uXvf/n9P/9Ufz355fe/e//mvy/9d3/997/89Bf/6fd/" +
+		"92//8q/+/D/9xxvqn/8f/u1//G9/+OWn+OvP6f3lf/MPv/vln/7w13/zyz/+9e//yy9/9q9//uP//s1P//pr9/6bP/vXX//5" +
+		"52+/k3Xvd389Pe/O/fWnP/7t/58/I/z88/uz//Pf+kPDH3/Dzz//8Tf89H7642H49U/5ObzD//7THw/jO4zvML7D9A7TO0zv" +
+		"ML/D/A7zOyzvsLzD8g7rO6zvsL7D9g7bO2zvsL/D/g77OxzvcLzD8Q7nO5zvcL7D9Q7XO1zv8OX7eb/D/Q6/d/i9w+8dnnd4" +
+		"3uF5h/cd3nd4fz0M7wGE1/PXn/54+HqG1zO8nuH1DK9neD3D6xlez/B6htczvJ7h9QyvZ3g9w+sZXs/weobXM7ye4fUMr2d4" +
+		"PcPrGV7P8HqG1zO8nuH1DK9neD3D6xlez/B6htczvJ7h9QyvZ3g9w+sZXs/weobXM7ye4fUMr2d8PePrGV/P+HrG1zO+nvH1" +
+		"jK9nfD3j6xlfz/h6xtczvp7x9YyvZ3w94+sZX8/4esbXM76e8fWMr2d8PePrGV/P+HrG1zO+nvH1jK9nfD3j6xlfz/h6xtcz" +
+		"vp7x9YyvZ3w94+sZX8/4esbXM76e8fWMr2d6PdPrmV7P9Hqm1zO9nun1TK9nej3T65lez/R6ptczvZ7p9UyvZ3o90+uZXs/0" +
+		"eqbXM72e6fVMr2d6PdPrmV7P9Hqm1zO9nun1TK9nej3T65lez/R6ptczvZ7p9UyvZ3o90+uZXs/0eqbXM72e6fVMr2d+PfPr" +
+		"mV/P/Hrm1zO/nvn1zK9nfj3z65lfz/x65tczv5759cyvZ3498+uZX8/8eubXM7+e+fXMr2d+PfPrmV/P/Hrm1zO/nvn1zK9n" +
+		"fj3z65lfz/x65tczv5759cyvZ3498+uZX8/8eubXM7+e+fXMr2d5PcvrWV7P8nqW17O8nuX1LK9neT3L61lez/J6ltezvJ7l" +
+		"9SyvZ3k9y+tZXs/yepbXs7ye5fUsr2d5PcvrWV7P8nqW17O8nuX1LK9neT3L61lez/J6ltezvJ7l9SyvZ3k9y+tZXs/yepbX" +
+		"s7ye5fUsr2d9PevrWV/P+nrW17O+nvX1rK9nfT3r61lfz19/+u+s+Bc+/P89UV/r+lrX17q+1vW1rq91fa3ra11f6/pa19e6" +
+		"vtb1ta6vdX2t62tdX+v6WtfXur7W9bWur3V9retrXV/r+lrX17q+1vW1rq91fa3ra11f6/pa19e6vtbttW6vdXut22vdXuv2" +
+		"WrfXur3W7bVur3V7rdv7b7e9nu31bK9nez3b69lez/Z6ttezvZ7t9WyvZ3s92+vZXs/2erbXs72e7fVsr2d7Pdvr2V7P9nq2" +
+		"17O9nu31bK9nez3b69lez/Z6ttezvZ7t9WyvZ3s9++vZX8/+evbXs7+e/fXsr2d/Pfvr2V/P/nr217O/nv317K9nfz3769lf" +
+		"z/569tezv5799eyvZ389++vZX8/+evbXs7+e/fXsr2d/Pfvr2V/P/nr217O/nv317K9nfz3769lfz/569tezv5799eyvZ389" +
+		"x+s5Xs/xeo7Xc7ye4/Ucr+d4PcfrOV7P8XqO13O8nuP1HK/neD3H6zlez/F6jtdzvJ7j9Ryv53g9x+s5Xs/xeo7Xc7ye4/Uc" +
+		"r+d4PcfrOV7P8XqO13O8nuP1HK/neD3H6zlez/F6jtdzvJ7j9Ryv53g95+s5X8/5es7Xc76e8/Wcr+d8PefrOV/P+XrO13O+" +
+		"nvP1nK/nfD3n6zlfz/l6ztdzvp7z9Zyv53w95+s5X8/5es7Xc76e8/Wcr+d8PefrOV/P+XrO13O+nvP1nK/nfD3n6zlfz/l6" +
+		"ztdzvp7z9Zyv53w91+u5Xs/1eq7Xc72e6/Vcr+d6PdfruV7P9Xqu13O9nuv1XK/nej3X67lez/V6rtdzvZ7r9Vyv53o91+u5" +
+		"Xs/1eq7Xc72e6/Vcr+d6PdfruV7P9Xqu13O9nuv1XK/nej3X67lez/V6rtdzvZ7r9Vyv53o99+u5X8/9eu7Xc7+e+/Xcr+d+" +
+		"PffruV/P/Xru13O/nvv13K/nfj3367lfz/167tdzv5779dyv568//Y89sV/r/Vrv13q/1vu13q/1fq33a71f6/1a79d6v9b7" +
+		"td6v9X6t92u9X+v9Wu/Xer/W+7Xer/V+rfdr/f34OPNaf6/191p/r/X3Wn+v9fdaf6/191p/r/X3Wn+v9fdaf6/191p/r/X3" +
+		"Wn+v9fdaf6/113wv+un99KcPROftPG/neTvP23nezvN2nrfzvJ3n7Txv53k7z9t53s7zdp6387yd5+08b+d5O8/bed7O83ae" +
+		"Hzvfcz/vuZ/33M977uc99/Oe+3nP/bznft5zP++5n/fcz3vu5z338577ec/9vOd+3nM/77mf99zPe+7nPffznvt5z/28535/" +
+		"fHB7Pe/reV/P+3re1/O+nvf1vK/nfT3v63lfz/t63tfzvp739byv53097+t5X8/7et7X876e9/W8r+d9Pe/reV/P+3re1/O+" +
+		"nvf1vK/nfT3v63lfz/t63tfzvp739byv53097+t5X8/7et7rW+VP76f/92fNX3/5/uP9l9MfFz4XPhc+F44Lx4XjwnXhuvCn" +
+		"DcGGYEOwIdgQbAg2BBuCDcGGYEOwIdgQbYg2RBuiDdGGaEO0IdoQbYg2RBuiDcmGZEOyIdmQbEg2JBuSDcmGZEOyIdmQbcg2" +
+		"ZBuyDdmGbEO2IduQbcg2ZBuyDcWGYkOxodhQbCg2FBuKDcWGYkOxodhQbag2VBuqDdWGakO1odpQbag2VBuqDc2GZkOzodnQ" +
+		"bGg2NBuaDc2GZkOzodnQbeg2dBu6Dd2GbkO3odvQbeg2dBu6DcOGYcOwYdgwbBg2DBuGDcOGYcOwYdgwbZg2TBumDdOGacO0" +
+		"YdowbZg2TBumDcuGZcOyYdmwbFg2LBuWDcuGZcOyYdmwbdg2bBu2DduGbcO2Yduwbdg2bBve6f/Yr3+89tn32ffZ99n32ffZ" +
+		"99n32ffZ99n32fdpdGw4Nhwbjg3HhmPDseHYcGw4Nhwbjg3XhmvDteHacG24Nlwbrg3XhmvDteH+aUNgi8AWgS0CWwS2CGwR" +
+		"2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBF" +
+		"YIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AW" +
+		"gS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJb" +
+		"BLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghs" +
+		"EdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLX6c/nqBHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+" +
+		"CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5" +
+		"IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPk" +
+		"h8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efvhx+lvvP5EtIltEtohsEdkiskVki8gWkS0iW0S2" +
+		"iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZ" +
+		"IrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVk" +
+		"i8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaR" +
+		"LSJbRLaIbBHZIrJFZIvIFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsk" +
+		"tkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR" +
+		"2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovk20Tih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8" +
+		"kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHx" +
+		"Q+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofE" +
+		"D4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JH36c/tb7T2KLxBaJLRJbJLZIbJHYIrFFYovEFoktEltktshs" +
+		"kdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKz" +
+		"RWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovM" +
+		"FpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0y" +
+		"W2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbI" +
+		"bJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdki" +
+		"s0Vmi8wWmS0yW2S2yGyR2SL7NpH5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4" +
+		"ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPh" +
+		"h8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+F" +
+		"Hwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhhx+nv/X+U9iisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHY" +
+		"orBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVh" +
+		"i8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaF" +
+		"LQpbFLYobFHYorBFYYvCFoUtClsUtihsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltU" +
+		"tqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR" +
+		"2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJF" +
+		"ZYvKFpUtKltUtqi+TVR+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHy" +
+		"Q+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfK" +
+		"D5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8q" +
+		"PzR+aPzQ+KHxQ+OHxg+NHxo/NH5o/ND44cfpb73/NLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKx" +
+		"RWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vG" +
+		"Fo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0a" +
+		"WzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZo" +
+		"bNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0dii" +
+		"sUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVni84WnS06W3S26GzR2aKzRWeL" +
+		"zhadLbpvE50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pn" +
+		"h84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+d" +
+		"Hzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90" +
+		"fuj80Pmh80Pnh84PnR86P3R++HH6W+8/nS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vn" +
+		"i84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhad" +
+		"LTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84Wgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsM" +
+		"thhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx" +
+		"2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDF" +
+		"YIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi+Hb" +
+		"xOCHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfB" +
+		"D4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8G" +
+		"Pwx+GPww+GHww+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y" +
+		"/DD5YfLD5IfJD5Mffpz+1vvPZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJ" +
+		"FpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0m" +
+		"W0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaY" +
+		"bDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdli" +
+		"ssVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mWyy2WGyx2GKxxWKL" +
+		"xRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYvk2sfhh8cPi" +
+		"h8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+L" +
+		"HxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8s" +
+		"flj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw" +
+		"+GHxw+KHH6e/9f6z2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaL" +
+		"LRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNlts" +
+		"tthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx" +
+		"2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPF" +
+		"ZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80W" +
+		"my02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22L5NbH7Y/LD5YfPD5ofN" +
+		"D5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82" +
+		"P2x+2Pyw+WHzw+aHzQ+bHzY/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/" +
+		"fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/fPzw8cPHDx8/" +
+		"fPzw8cPHDz9Of+v952OLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+" +
+		"tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+" +
+		"tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+" +
+		"tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+tvjY4mOLjy0+" +
+		"tvjY4mOLjy0+tvjY4mOLjy0+tvjY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY" +
+		"4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVh" +
+		"i8MWx7eJww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD" +
+		"4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MP" +
+		"hx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/" +
+		"HH44/HD44fDD4YfDD4cfDj/8OP2t95/DFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDF" +
+		"YYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sW" +
+		"ly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5b" +
+		"XLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhs" +
+		"cdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKy" +
+		"xWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLF9W3i" +
+		"8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cP/kh/Hzn/zw6y/fhX85/XHhc+Fz4XPh" +
+		"uHBcOC5cF64Lf9oQbAg2BBuCDcGGYEOwIdgQbAg2BBuCDdGGaEO0IdoQbYg2RBuiDdGGaEO0IdqQbEg2JBuSDcmGZEOyIdmQ" +
+		"bEg2JBuSDdmGbEO2IduQbcg2ZBuyDdmGbEO2IdtQbCg2FBuKDcWGYkOxodhQbCg2FBuKDdWGakO1odpQbag2VBuqDdWGakO1" +
+		"of7PvP+Mn5t9zb5mX7Ov2dfsa/Y1+5p9zb5mX9Oo29Bt6DZ0G7oN3YZuQ7eh29Bt6DZ0G4YNw4Zhw7Bh2DBsGDYMG4YNw4Zh" +
+		"w7Bh2jBtmDZMG6YN04Zpw7Rh2jBtmDZMG5YNy4Zlw7Jh2bBsWDYsG5YNy4Zlw7Jh27Bt2DZsG7YN24Ztw7Zh27Bt2DZsGz4b" +
+		"Phs+Gz4bPhs+Gz4bPhs+Gz4bPhs+G44Nx4Zjw7Hh2HBsODYcG44Nx4Zjw7Hh2nBtuDZcG64N14Zrw7Xh2nBtuDawRWCLwBaB" +
+		"LQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsE" +
+		"tghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR" +
+		"2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtQrWBHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ" +
+		"+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD" +
+		"4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8AP" +
+		"gR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efvhx+lvvP5EtIltEtohsEdkiskVki8gWkS0iW0S2iGwR" +
+		"2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJF" +
+		"ZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gW" +
+		"kS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJb" +
+		"RLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohs" +
+		"EdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKy" +
+		"RWSLyBaRLSJbRLaIbBHZIrJFZIvo20Tih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh" +
+		"8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KH" +
+		"xA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kf" +
+		"Ej8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JH36c/tb7T2KLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgi" +
+		"sUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKL" +
+		"xBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFokt" +
+		"Eltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2" +
+		"yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZ" +
+		"IrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vm" +
+		"i8wWmS0yW2S2yGyR2SL7NpH5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND" +
+		"5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wP" +
+		"mR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/" +
+		"FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhhx+nv/X+U9iisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBF" +
+		"YYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IW" +
+		"hS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpb" +
+		"FLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihs" +
+		"UdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKw" +
+		"RWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvK" +
+		"FpUtKltUtqi+TVR+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WH" +
+		"yg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5Uf" +
+		"Kj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+" +
+		"qPxQ+aHyQ+WHyg+VHyo/VH6o/FD54cfpb73/VLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWL" +
+		"yhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUt" +
+		"KltUtqhsUdmiskVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2" +
+		"aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHY" +
+		"orFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVj" +
+		"i8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaN" +
+		"LZpvE40fGj80fmj80Pih8UPjh8YPjR8aPzR+aPzQ+KHxQ+OHxg+NHxo/NH5o/ND4ofFD44fGD40fGj80fmj80Pih8UPjh8YP" +
+		"jR8aPzR+aPzQ+KHxQ+OHxg+NHxo/NH5o/ND4ofFD44fGD40fGj80fmj80Pih8UPjh8YPjR8aPzR+aPzQ+aHzQ+eHzg+dHzo/" +
+		"dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj8" +
+		"0Pmh80Pnh84PnR86P3R++HH6W+8/nS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84W" +
+		"nS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpb" +
+		"dLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs" +
+		"0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKz" +
+		"RWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84Wgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvB" +
+		"FoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi+HbxOCH" +
+		"wQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4Mf" +
+		"Bj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+" +
+		"GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4" +
+		"YfDD4IfBD4Mffpz+1vvPYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMt" +
+		"BlsMthhsMdhisMVgi8EWgy0GW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2" +
+		"mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZ" +
+		"YrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVk" +
+		"i8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaT" +
+		"LSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYvo2Mflh8sPkh8kP" +
+		"kx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+LHxY/" +
+		"LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8" +
+		"sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHx" +
+		"w+KHH6e/9f6z2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZb" +
+		"LLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhs" +
+		"sdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKx" +
+		"xWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovN" +
+		"FpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02" +
+		"W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22L5NbH7Y/LD5YfPD5ofND5sf" +
+		"Nj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+" +
+		"2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5" +
+		"YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPnhx+lv" +
+		"vf9stthssdlis8Vmi80Wmy02W2y22Gyx2WKzxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGx" +
+		"xccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGx" +
+		"xccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGx" +
+		"xccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGx" +
+		"xccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGx" +
+		"xccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPH5" +
+		"NvHxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+H" +
+		"Hw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8c" +
+		"fjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw" +
+		"+OHww+GHww+HHw4/HH74cfpb7z+HLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaH" +
+		"LQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsc" +
+		"tjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx" +
+		"2OKwxWGLwxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLF" +
+		"ZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sW" +
+		"ly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWL69vE5YfL" +
+		"D5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8u" +
+		"P1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64" +
+		"/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54f7JD/PnP/nh11++C/9y+uPC" +
+		"58LnwufCceG4cFy4LlwX/ifef+bPwb5gX7Av2BfsC/YF+4J9wb5gX7AvaBRtiDZEG6IN0YZoQ7Qh2hBtiDZEG6INyYZkQ7Ih" +
+		"2ZBsSDYkG5INyYZkQ7Ih2ZBtyDZkG7IN2YZsQ7Yh25BtyDZkG7INxYZiQ7Gh2FBsKDYUG4oNxYZiQ7Gh2FBtqDZUG6oN1YZq" +
+		"Q7Wh2lBtqDZUG6oNzYZmQ7Oh2dBsaDY0G5oNzYZmQ7Oh2dBt6DZ0G7oN3YZuQ7eh29Bt6DZ0G7oNw4Zhw7Bh2DBsGDYMG4YN" +
+		"w4Zhw7Bh2DBtmDZMG6YN04Zpw7Rh2jBtmDZMG6YNy4Zlw7Jh2bBsWDYsG5YNy4Zlw7Jh2bBt2DZsG7YN24Ztw7Zh27Bt2DZs" +
+		"G7YNnw2fDZ8Nnw2fDZ8Nnw2fDZ8Nnw2fDZ8Nx4Zjw7Hh2HBsODYcG44Nx4Zjw7Hh2HBtuDZcG64N14Zrw7Xh2nBtuDZcG9gi" +
+		"sEVgi8AWgS0CWwS2CGwR2CKwRWCLwBbhZxv4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/" +
+		"BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8" +
+		"EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHw" +
+		"Q+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPghx+nv/X+E9gisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJb" +
+		"BLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghs" +
+		"EdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdkiskVki8gWkS0iW0S2iGwR2SKy" +
+		"RWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvI" +
+		"FpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0i" +
+		"W0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaI" +
+		"bBHZIrJFZIvIFpEtIltEtoi+TUR+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+" +
+		"iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5" +
+		"IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPi" +
+		"h8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD44cfpb73/JLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2" +
+		"SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHY" +
+		"IrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVi" +
+		"i8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJ" +
+		"LRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsk" +
+		"tkhskdgisUVmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR" +
+		"2SKzRWaLzBaZLbJvE5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8" +
+		"kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHz" +
+		"Q+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofM" +
+		"D5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R++HH6W+8/mS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshs" +
+		"kdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWhS0KWxS2KGxR2KKw" +
+		"RWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvC" +
+		"FoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0K" +
+		"WxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYo" +
+		"bFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdii" +
+		"sEVhi+LbROGHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4" +
+		"ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPl" +
+		"h8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8sP/U4jd5EzbGwUYnbOK7ABu/3tou+wZ" +
+		"7CFKPiQGQBRg//w95ERi8A5aapXc0jVqnarGD40fGj80fmj80Pih8UPjh8YPjR8aPzR+aPzQ+KHxQ+OHxg+NHxo//Ex/tf80" +
+		"tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR" +
+		"2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFF" +
+		"Y4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YW" +
+		"jS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWeLzhadLTpb" +
+		"dLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs" +
+		"0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tuttE54fOD50fOj90fuj80Pmh80Pnh84PnR86" +
+		"P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o" +
+		"/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh" +
+		"80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR9+pr/afzpbdLbobNHZ" +
+		"orNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVg" +
+		"i8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaD" +
+		"LQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsM" +
+		"thhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx" +
+		"2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDF" +
+		"YIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRbDbWLww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8" +
+		"MPhh8MPgh8EPgx8GPwx+GPww+GHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHy" +
+		"w+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJ" +
+		"D5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJDz/TX+0/ky0mW0y2mGwx2WKyxWSL" +
+		"yRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMt" +
+		"JltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2" +
+		"mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbLHY" +
+		"YrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVi" +
+		"i8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaL" +
+		"LRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii+U2sfhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD" +
+		"4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UP" +
+		"ix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/" +
+		"LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHn+mv9p/NFpstNltstthssdlis8Vmi80Wmy02" +
+		"W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbY" +
+		"bLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdli" +
+		"s8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaL" +
+		"zRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpst" +
+		"Nltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y2" +
+		"2Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFdps4/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+H" +
+		"Hw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8c" +
+		"fjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw" +
+		"+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPP9Ff7z2GLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhs" +
+		"cdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKw" +
+		"xWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvD" +
+		"FoctDlsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsE" +
+		"WwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsE" +
+		"WwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsE" +
+		"WwRbhNtE8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD" +
+		"8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD" +
+		"8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/DD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/" +
+		"XH64/HD54Wf6q/3nssVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0u" +
+		"W1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4" +
+		"bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdni" +
+		"ssVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWL" +
+		"yxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpct" +
+		"LltctrhscdniscVji8cWjy0eWzy2eGzx2OKxxWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8dwmHj88fnj88Pjh8cPj" +
+		"h8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+P" +
+		"Hx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88" +
+		"fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw" +
+		"M/3V/vPY4rHFY4vHFo8tHls8tnhs8djiscVji8cWjy0eWzy2eGzx2OKxxWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs" +
+		"8djiscVji8cWjy0eWzy2eGzx2OKxxWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8djiscVji/cXW+y/+4st/ufr/z74" +
+		"v+nPg/AgPAgPrgfXg+vB8+B58JeGT8On4dPwafg0fBo+DZ+GT8On4dPwaUgakoakIWlIGpKGpCFpSBqShqQhacgasoasIWvI" +
+		"GrKGrCFryBqyhqwhaygaioaioWgoGoqGoqFoKBqKhqKhaKgaqoaqoWqoGqqGqqFqqBqqhqqhamgamoamoWloGpqGpqFpaBqa" +
+		"hqahaegauoauoWvoGrqGrqFr6Bq6hq6haxgahoahYWgYGoaGoWFoGBqGhqFhaJgapoapYWqYGqaGqWFqmBqmhqlhalgaloal" +
+		"YWlYGpaGpWFpWBqWhqVhadgatoatYWvYGraGrWFr2Bq2hq1hazgajoaj4Wg4Go6Go+FoOBqOhqPhaAgNoSE0hIbQEBpCQ2gI" +
+		"DaEhNISGq+FquBquhqvhargaroar4Wq4Gq6Gp+FpeBqehqfhaXganoan4Wl4Gvjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5+" +
+		"+Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5++Pjh44ePHz5+" +
+		"+Pjh44ePHz5++Jn+Yv/ZH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8" +
+		"bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8" +
+		"bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8" +
+		"bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8" +
+		"bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWH1t8bPGxxccWiS0SWyS2SGyR2CKxRWKLxBaJLRJb" +
+		"JLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhs" +
+		"kdgisUVii+Q2kfgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8S" +
+		"PyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I" +
+		"/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh" +
+		"8UPih8QPiR8SPyR+SPyQ+CHxQ+KHn+mv9p/EFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHY" +
+		"IrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vm" +
+		"i8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZ" +
+		"LTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltk" +
+		"tshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR" +
+		"2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNF" +
+		"dpvI/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8" +
+		"kPkh80Pmh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHw" +
+		"Q+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfC" +
+		"D4UfCj8Ufij8UPih8MPP9Ff7T2GLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGL" +
+		"whaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUt" +
+		"ClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2" +
+		"KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWWLyhaVLSpbVLaobFHZ" +
+		"orJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVl" +
+		"i8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aK6TVR+qPxQ" +
+		"+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD" +
+		"5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oP" +
+		"lR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/" +
+		"VH6o/FD54Wf6q/2nskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0a" +
+		"WzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZo" +
+		"bNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0dii" +
+		"sUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOL" +
+		"xhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0t" +
+		"Gls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0dwmGj80fmj80Pih8UPj" +
+		"h8YPjR8aPzR+aPzQ+KHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+d" +
+		"Hzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90" +
+		"fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6Pzw" +
+		"M/3V/tPZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs" +
+		"0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKz" +
+		"RWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobDHYYrDFYIvB" +
+		"FoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0G" +
+		"Wwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYY" +
+		"bDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbhODHwY/DH4Y/DD4YfDD4IfBD4Mf" +
+		"Bj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+" +
+		"GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4" +
+		"YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9MfviZ/mr/mWwx" +
+		"2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLF" +
+		"ZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kW" +
+		"ky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZb" +
+		"TLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphs" +
+		"MdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKy" +
+		"xWSLyRaTLSZbTLaYbDHZYrLFZIvFFostFlsstlhssdhiscVii8UWiy0WWyy3icUPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y" +
+		"/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh" +
+		"8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KH" +
+		"xQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY//Ex/tf8stlhssdhiscVi" +
+		"i8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaL" +
+		"LRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlts" +
+		"tthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx" +
+		"2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPF" +
+		"ZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80W" +
+		"my02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstttvE5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHz" +
+		"w+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofN" +
+		"D5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8O" +
+		"Pxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx9+pr/afw5bHLY4bHHY4rDFYYvDFoct" +
+		"DlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2" +
+		"OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY" +
+		"4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVh" +
+		"i8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaH" +
+		"LQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLY" +
+		"Itgi2CLYItgi2CLYItgi2CLYItgi2CLYItwmgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+C" +
+		"H4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+C" +
+		"H4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+C" +
+		"H4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH36mv9p/gi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYIt" +
+		"gi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItLltctrhscdnissVli8sWly0u" +
+		"W1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4" +
+		"bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdni" +
+		"ssVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWL" +
+		"yxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFtdt" +
+		"4vLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPl" +
+		"h8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+P" +
+		"Hx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88" +
+		"fnj88Pjh8cPjh8cPP9O/3n9K+X/rz0OLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKh" +
+		"xUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uH" +
+		"Fg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0e" +
+		"Wjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4" +
+		"aPHQ4qHFQ4uHFg8tfqZ//Tf+X1//8M+//9PfnL///Z/+4ff//Nvv/vAff/7zb//y73/8pz/8++/+9r+nv/vjb//4T//y259/" +
+		"+7d//Y8//+G33/3pX//037/6n89/As1cPh8=",
+	"UniJIS-UTF16-V": "" +
+		"eNpVUE1vwjAMvfdXeAckdshCQeI+gSaKxIYIH+eSuCgSdaI0OfTfr7gRiENsPfu9ZzuTj70S38ZdUSy+ZnDAzqWgUax2tS/k" +
+		"qlpXZCPIfXBaYYTGkgmZA1e8WSrKORirY0YcdZvFqu8ithU1DhYjyySfmfIwpC6GHqY8/xMMNoX8CwaDpRtMt7Wvqcxllby/" +
+		"Y4sUYckVJDN2Hpv+1i2CPJHdVkqcjj/lUpxf3WPvEeYjvuycQShH8CbYQOqQNx+sOT+ddQphmMwHsONDbgmfP+Edq/j9A80e" +
+		"bmI=",
+	"UniKS-UCS2-H": "" +
+		"eNpl2F9rHccdBuB7f4rTi0J64Vrzb2cWQmBmdpeG0DTEzQewpWMjqCUhyxf+9pX0qHFID0hi3t0558fzHsQyf/3LL29f96vb" +
+		"9+fX6e8Xp1/Pn2+/3F+eX89/vrt79Wb+uP14c/1wevPL/e3l2/PD6cP1zdX9yz2n9+eP1zevQjxdXV8+vKyef19+etn89uvn" +
+		"h/OnH28+3J6Su66+3L3c+ebXxz+fH+6/nr57/vy/na7OH169+df91fn++ubj6bufbu/P78JL/PbL3d1/zp/ONw+n8Jycb65c" +
+		"eZr053efzqc3v91c//T29W/zbXz9j2/X/v317nyKz+vgoy9vr86f795dnu/f3Xw8v/r+4vH1w+n74/H1w9P7/ul6uLiw7/2H" +
+		"bxvC44aLi8cNJ6vHMDy/y0UQPq0ewyiMwihMwiRMwizMwiwswiIswkW4CBdhFVZhFTZhEzbhKlyFq7ALu7ALh3AIh3AKp3AK" +
+		"N+Em3IS7cBfuwkN4CI/nMCgg8HxePYY8A8/AM/AMPAPPwDPwDDwDz8Az8Aw8A8/AM/AMPAPPwDPwDDwDz8Az8Aw8A8/AM/AM" +
+		"PAPPwDPwDDwDz8Az8Aw8A8/AM/AMPAPPwDPwDDwjz8gz8ow8I8/IM/KMPCPPyDPyjDwjz8gz8ow8I8/IM/KMPCPPyDPyjDwj" +
+		"z8gz8ow8I8/IM/KMPCPPyDPyjDwjz8gz8ow8I8/IM/KMPCPPyDPyjDwTz8Qz8Uw8E8/EM/FMPBPPxDPxTDwTz8Qz8Uw8E8/E" +
+		"M/FMPBPPxDPxTDwTz8Qz8Uw8E8/EM/FMPBPPxDPxTDwTz8Qz8Uw8E8/EM/FMPBPPxDPxTDwzz8wz88w8M8/MM/PMPDPPzDPz" +
+		"zDwzz8wz88w8M8/MM/PMPDPPzDPzzDwzz8wz88w8M8/MM/PMPDPPzDPzzDwzz8wz88w8M8/MM/PMPDPPzDPzzDwLz8Kz8Cw8" +
+		"C8/Cs/AsPAvPwrPwLDwLz8Kz8Cw8C8/Cs/AsPAvPwrPwLDwLz8Kz8Cw8C8/Cs/AsPAvPwrPwLDwLz8Kz8Cw8C8/Cs/AsPAvP" +
+		"wrPwLDwXngvPhefCc+G58Fx4LjwXngvPhefz6umx4n+PD///PLGwXlgvrBfWC+uF9cJ6Yb2wXlgvrBfWC+uF9cJ6Yb2wXlgv" +
+		"rBfWC+uF9cJ6Yb2wXlgvrBfWC+uF9cJ6Yb2wXlgvrBfWlXVlXVlX1pV1ZV1ZV9aVdWVdWVff3cqz8qw8K8/Ks/KsPCvPyrPy" +
+		"rDwrz8qz8qw8K8/Ks/KsPCvPyrPyrDwrz8qz8qw8K8/Ks/KsPCvPyrPyrDwrz8az8Ww8G8/Gs/FsPBvPxrPxbDwbz8az8Ww8" +
+		"G8/Gs/FsPBvPxrPxbDwbz8az8Ww8G8/Gs/FsPBvPxrPxbDwbz8az8Ww8G8/Gs/FsPBvPxrPxbDwbz5XnynPlufJcea48V54r" +
+		"z5XnynPlufJcea48V54rz5XnynPlufJcea48V54rz5XnynPlufJcea48V54rz5XnynPlufJcea48V54rz5XnynPlufJcea48" +
+		"V54rz86z8+w8O8/Os/PsPDvPzrPz7Dw7z86z8+w8O8/Os/PsPDvPzrPz7Dw7z86z8+w8O8/Os/PsPDvPzrPz7Dw7z86z8+w8" +
+		"O8/Os/PsPDvPzrPz7Dw7z8Fz8Bw8B8/Bc/AcPAfPwXPwHDwHz8Fz8Bw8B8/Bc/AcPAfPwXPwHDwHz8Fz8Bw8B8/Bc/AcPAfP" +
+		"wXPwHDwHz8Fz8Bw8B8/Bc/AcPAfPwXPwHDwHz8lz8pw8J8/Jc/KcPCfPyXPynDwnz8lz8pw8J8/Jc/KcPCfPyXPynDyfV398" +
+		"nsjtT48TE/VEPVFP1BP1RD1RT9QT9UQ9UU/UE/VEPVFP1BP1RD1RT9QT9UQ9UU/UG+oN9YZ6Q72h3lBvqDfUG+oN9YZ6Q72h" +
+		"3lBvqDfUG+oN9YZ6Q72h3lBvqDdf3d2cuzl3c+7m3M25m3M3527O3Zy7OXdz7ubczbmbczfnbs7dnLs5d3Pu5tzNuZtzN+f+" +
+		"Mqfed73vet/1vut91/uu913vu953ve963/W+633X+673Xe+73ne973rf9b7rfdf7rvdd78fLeRvPg+fB8+B58Dx4HjwPngfP" +
+		"g+fB8+B58Dx4HjwPngfPg+fB8+B58Dx4HjwPngfPg+fB8+B58Dx4HjwPngfPg+fB8+B58Dx4HjwPngfPg+fB8+B5HN+OKk9W" +
+		"f/xX8HR4+XT2+vuZ6OWX+/vzzcPz0evzaejTOej1zfn3M9y727unXc8//wVFCM4X",
+	"UniKS-UCS2-V": "" +
+		"eNpNUMFOwzAMvfcrzAFpHEJo9wWoO1BNg2lhcO4Sd4pEnchNDv17OjcaHGzL9nvPT358OBr16sIF1fb5BU44hcwWVXvoY6Xb" +
+		"bteRT6CPHKzBBIMnxwUDF7x6quoGnLepdJLtWMhmnhKOHQ0BtivK5ViQ+rSUKfEMG7n/BA6HSn+wQ/Z0hc0+MPZ1GZsc4w+O" +
+		"SAlqmSC5dXNz+t6PCPpMfm/UuTWN+vrbfc4RoVn770NwWAT+w98gTyiuF1mpd1WbmZerYl70bmRPeP9CDMKS+AU4R21j",
+	"UniKS-UTF16-H": "" +
+		"eNqM2M3uYDli3ue9rqKzCOAsFDW/ScAwQPKQiGHEMSz7AiRNyxAQjYSRvPDdZ9wszYMkgNuz6Kk/m1X14nd68Zzzv/4v/+Ev" +
+		"/3z+7h/++pc/T//7zz/9x1/+6R/+6x/+5pc/3//nX/3jn/3F/rffv/393/3zT3/xH/7wD3/zl7/8809/+3e//90fftz56a9/" +
+		"+S9/9/s/C/Gn3/3d3/zzj59+/eff/P2P3/yX/+2f/vmXv/+3v//bf/gpvVu/+6//+OPmX/zHP/7fP/3zH/7bT//q17//f/vp" +
+		"d7/87Z/9xf/1h9/98oe/+/1/+elf/bt/+MMvfxV+HP/lf/3Hf/y/f/n7X37/zz+FX09++f3v3r/570v//V/9/S8//cV//v3f" +
+		"/bu//PP//J9uqH/+f/iX/+m//eMvP8Vff07v7/6bf/jdL//0j3/1N7/84a9+/19++bN//fMf//dvfvrXX7v33/zZv/76zz9/" +
+		"+52se7/76+l5d+6vP/3xL////Bnh55/fn/3Xf+sPDX/8DT///Mff8NP76Y+H4dc/5efwDv/7T388jO8wvsP4DtM7TO8wvcP8" +
+		"DvM7zO+wvMPyDss7rO+wvsP6Dts7bO+wvcP+Dvs77O9wvMPxDsc7nO9wvsP5Dtc7XO9wvcOX7+f9Dvc7/N7h9w6/d3je4XmH" +
+		"5x3ed3jf4f31MLwHEF7PX3/64+HrGV7P8HqG1zO8nuH1DK9neD3D6xlez/B6htczvJ7h9QyvZ3g9w+sZXs/weobXM7ye4fUM" +
+		"r2d4PcPrGV7P8HqG1zO8nuH1DK9neD3D6xlez/B6htczvJ7h9QyvZ3g9w+sZXs/weobXM7ye8fWMr2d8PePrGV/P+HrG1zO+" +
+		"nvH1jK9nfD3j6xlfz/h6xtczvp7x9YyvZ3w94+sZX8/4esbXM76e8fWMr2d8PePrGV/P+HrG1zO+nvH1jK9nfD3j6xlfz/h6" +
+		"xtczvp7x9YyvZ3w94+sZX8/4esbXM76e6fVMr2d6PdPrmV7P9Hqm1zO9nun1TK9nej3T65lez/R6ptczvZ7p9UyvZ3o90+uZ" +
+		"Xs/0eqbXM72e6fVMr2d6PdPrmV7P9Hqm1zO9nun1TK9nej3T65lez/R6ptczvZ7p9UyvZ3o90+uZXs/0eqbXM72e+fXMr2d+" +
+		"PfPrmV/P/Hrm1zO/nvn1zK9nfj3z65lfz/x65tczv5759cyvZ3498+uZX8/8eubXM7+e+fXMr2d+PfPrmV/P/Hrm1zO/nvn1" +
+		"zK9nfj3z65lfz/x65tczv5759cyvZ3498+uZX8/8eubXM7+e5fUsr2d5PcvrWV7P8nqW17O8nuX1LK9neT3L61lez/J6ltez" +
+		"vJ7l9SyvZ3k9y+tZXs/yepbXs7ye5fUsr2d5PcvrWV7P8nqW17O8nuX1LK9neT3L61lez/J6ltezvJ7l9SyvZ3k9y+tZXs/y" +
+		"epbXs7ye9fWsr2d9PevrWV/P+nrW17O+nvX1rK9nfT1//em/s+Jf+PD/90R9retrXV/r+lrX17q+1vW1rq91fa3ra11f6/pa" +
+		"19e6vtb1ta6vdX2t62tdX+v6WtfXur7W9bWur3V9retrXV/r+lrX17q+1vW1rq91fa3ra11f6/pat9e6vdbttW6vdXut22vd" +
+		"Xuv2WrfXur3W7bVu77/d9nq217O9nu31bK9nez3b69lez/Z6ttezvZ7t9WyvZ3s92+vZXs/2erbXs72e7fVsr2d7Pdvr2V7P" +
+		"9nq217O9nu31bK9nez3b69lez/Z6ttezvZ7t9eyvZ389++vZX8/+evbXs7+e/fXsr2d/Pfvr2V/P/nr217O/nv317K9nfz37" +
+		"69lfz/569tezv5799eyvZ389++vZX8/+evbXs7+e/fXsr2d/Pfvr2V/P/nr217O/nv317K9nfz3769lfz/569tezv5799Ryv" +
+		"53g9x+s5Xs/xeo7Xc7ye4/Ucr+d4PcfrOV7P8XqO13O8nuP1HK/neD3H6zlez/F6jtdzvJ7j9Ryv53g9x+s5Xs/xeo7Xc7ye" +
+		"4/Ucr+d4PcfrOV7P8XqO13O8nuP1HK/neD3H6zlez/F6jtdzvJ7j9Zyv53w95+s5X8/5es7Xc76e8/Wcr+d8PefrOV/P+XrO" +
+		"13O+nvP1nK/nfD3n6zlfz/l6ztdzvp7z9Zyv53w95+s5X8/5es7Xc76e8/Wcr+d8PefrOV/P+XrO13O+nvP1nK/nfD3n6zlf" +
+		"z/l6ztdzvp7z9Vyv53o91+u5Xs/1eq7Xc72e6/Vcr+d6PdfruV7P9Xqu13O9nuv1XK/nej3X67lez/V6rtdzvZ7r9Vyv53o9" +
+		"1+u5Xs/1eq7Xc72e6/Vcr+d6PdfruV7P9Xqu13O9nuv1XK/nej3X67lez/V6rtdzvZ7r9dyv53499+u5X8/9eu7Xc7+e+/Xc" +
+		"r+d+PffruV/P/Xru13O/nvv13K/nfj3367lfz/167tdzv56//vQ/9sR+rfdrvV/r/Vrv13q/1vu13q/1fq33a71f6/1a79d6" +
+		"v9b7td6v9X6t92u9X+v9Wu/Xer/W+7Xer/X34+PMa/291t9r/b3W32v9vdbfa/291t9r/b3W32v9vdbfa/291t9r/b3W32v9" +
+		"vdbfa/291l/zvein99OfPhCdt/O8neftPG/neTvP23nezvN2nrfzvJ3n7Txv53k7z9t53s7zdp6387yd5+08b+d5O8/beX7s" +
+		"fM/9vOd+3nM/77mf99zPe+7nPffznvt5z/28537ecz/vuZ/33M977uc99/Oe+3nP/bznft5zP++5n/fcz3vu5z338577/fHB" +
+		"7fW8r+d9Pe/reV/P+3re1/O+nvf1vK/nfT3v63lfz/t63tfzvp739byv53097+t5X8/7et7X876e9/W8r+d9Pe/reV/P+3re" +
+		"1/O+nvf1vK/nfT3v63lfz/t63tfzvp739byv53097+t5r2+VP72f/t+fNX/95fuP919Of1z4XPhc+Fw4LhwXjgvXhevCnzYE" +
+		"G4INwYZgQ7Ah2BBsCDYEG4INwYZgQ7Qh2hBtiDZEG6IN0YZoQ7Qh2hBtiDYkG5INyYZkQ7Ih2ZBsSDYkG5INyYZkQ7Yh25Bt" +
+		"yDZkG7IN2YZsQ7Yh25BtyDYUG4oNxYZiQ7Gh2FBsKDYUG4oNxYZiQ7Wh2lBtqDZUG6oN1YZqQ7Wh2lBtqDY0G5oNzYZmQ7Oh" +
+		"2dBsaDY0G5oNzYZmQ7eh29Bt6DZ0G7oN3YZuQ7eh29Bt6DYMG4YNw4Zhw7Bh2DBsGDYMG4YNw4Zhw7Rh2jBtmDZMG6YN04Zp" +
+		"w7Rh2jBtmDYsG5YNy4Zlw7Jh2bBsWDYsG5YNy4Zlw7Zh27Bt2DZsG7YN24Ztw7Zh27BteKf/Y7/+8dpn32ffZ99n32ffZ99n" +
+		"32ffZ99n32ffp9Gx4dhwbDg2HBuODceGY8Ox4dhwbDg2XBuuDdeGa8O14dpwbbg2XBuuDdeG+6cNgS0CWwS2CGwR2CKwRWCL" +
+		"wBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEt" +
+		"AlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2" +
+		"CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHY" +
+		"IrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVg" +
+		"i8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLb4cfrrBX4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHw" +
+		"Q+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfI" +
+		"D5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8i" +
+		"P0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPnhx+lvvf9EtohsEdkiskVki8gWkS0iW0S2iGwR2SKy" +
+		"RWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvI" +
+		"FpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0i" +
+		"W0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaI" +
+		"bBHZIrJFZIvIFpEtIlsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgi" +
+		"sUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKL" +
+		"xBaJLRJbJLZIbJHYIrFFYovEFoktkm8TiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPi" +
+		"h8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+J" +
+		"HxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8k" +
+		"fkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH74cfpb7z+JLRJbJLZIbJHYIrFFYovEFoktElsktkhskdkis0Vm" +
+		"i8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZ" +
+		"LTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltk" +
+		"tshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR" +
+		"2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNF" +
+		"ZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wW" +
+		"mS0yW2S2yGyR2SKzRWaL7NtE5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfC" +
+		"D4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8K" +
+		"PxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o" +
+		"/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR9+nP7W+09hi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvC" +
+		"FoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0K" +
+		"WxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYo" +
+		"bFHYorBFYYvCFoUtClsUtihsUdiisEVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmi" +
+		"skVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWL" +
+		"yhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUt" +
+		"KltUtqhsUdmi+jZR+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+V" +
+		"Hyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9U" +
+		"fqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPzQ" +
+		"+KHxQ+OHxg+NHxo/NH5o/ND4ofFD44cfp7/1/tPYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaN" +
+		"LRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0" +
+		"tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR" +
+		"2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFF" +
+		"Y4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YW" +
+		"jS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWnS06W3S26GzR2aKzRWeLzhadLTpb" +
+		"dLbovk10fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86" +
+		"P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o" +
+		"/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh" +
+		"80Pnh84PnR86P3R+6PzQ+eHH6W+9/3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06" +
+		"W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbo" +
+		"bNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06Wwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhi" +
+		"sMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCL" +
+		"wRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMt" +
+		"BlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy2GbxOD" +
+		"HwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8M" +
+		"fhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww" +
+		"+GHww+CHwQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD" +
+		"5IfJD5MfJj9Mfvhx+lvvP5MtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltM" +
+		"tphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx" +
+		"2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLF" +
+		"ZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kW" +
+		"ky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGyx2GKxxWKLxRaLLRZb" +
+		"LLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovl28Tih8UPix8W" +
+		"Pyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y" +
+		"/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh" +
+		"8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KH" +
+		"xQ+LH36c/tb7z2KLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZY" +
+		"bLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdli" +
+		"s8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaL" +
+		"zRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpst" +
+		"Nltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y2" +
+		"2Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WL7NrH5YfPD5ofND5sfNj9s" +
+		"ftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw" +
+		"+WHzw+aHzQ+bHzY/bH7Y/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDx" +
+		"w8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDxw8cPHz98/PDx" +
+		"w8cPHz/8OP2t95+PLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+Nji" +
+		"Y4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+Nji" +
+		"Y4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+Nji" +
+		"Y4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+NjiY4uPLT62+Nji" +
+		"Y4uPLT62+NjiY4uPLT62+NjiY4vDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvD" +
+		"FoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0O" +
+		"WxzfJg4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cf" +
+		"Dj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+" +
+		"OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD4" +
+		"4fDD4YfDD4cfDj8cfjj88OP0t95/DlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoct" +
+		"LltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2" +
+		"uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ" +
+		"4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVl" +
+		"i8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaX" +
+		"LS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFte3icsP" +
+		"lx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sP9kx/Gz3/yw6+/fBf+5fTHhc+Fz4XPhePC" +
+		"ceG4cF24LvxpQ7Ah2BBsCDYEG4INwYZgQ7Ah2BBsCDZEG6IN0YZoQ7Qh2hBtiDZEG6IN0YZoQ7Ih2ZBsSDYkG5INyYZkQ7Ih" +
+		"2ZBsSDZkG7IN2YZsQ7Yh25BtyDZkG7IN2YZsQ7Gh2FBsKDYUG4oNxYZiQ7Gh2FBsKDZUG6oN1YZqQ7Wh2lBtqDZUG6oN1Yb6" +
+		"P/P+M35u9jX7mn3NvmZfs6/Z1+xr9jX7mn1No25Dt6Hb0G3oNnQbug3dhm5Dt6Hb0G0YNgwbhg3DhmHDsGHYMGwYNgwbhg3D" +
+		"hmnDtGHaMG2YNkwbpg3ThmnDtGHaMG1YNiwblg3LhmXDsmHZsGxYNiwblg3Lhm3DtmHbsG3YNmwbtg3bhm3DtmHbsG34bPhs" +
+		"+Gz4bPhs+Gz4bPhs+Gz4bPhs+Gw4Nhwbjg3HhmPDseHYcGw4Nhwbjg3HhmvDteHacG24Nlwbrg3XhmvDteHawBaBLQJbBLYI" +
+		"bBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgi" +
+		"sEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCL" +
+		"wBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtgjVBn4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CH" +
+		"wA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4Ef" +
+		"Aj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+" +
+		"CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPjhx+lvvf9EtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSL" +
+		"yBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEt" +
+		"IltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2" +
+		"iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZ" +
+		"IrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVk" +
+		"i8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaR" +
+		"LSJbRLaIbBHZIrJFZIvIFpEtom8TiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QP" +
+		"iR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/" +
+		"JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8" +
+		"kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH74cfpb7z+JLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QW" +
+		"iS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJb" +
+		"JLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhs" +
+		"kdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKz" +
+		"RWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovM" +
+		"FpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0y" +
+		"W2S2yGyR2SKzRWaL7NtE5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kf" +
+		"Mj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+" +
+		"yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4" +
+		"ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR9+nP7W+09hi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUt" +
+		"ClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2" +
+		"KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHY" +
+		"orBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVh" +
+		"i8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaF" +
+		"LQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltU" +
+		"tqhsUdmi+jZR+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/" +
+		"VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8" +
+		"UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHy" +
+		"Q+WHyg+VHyo/VH6o/FD5ofJD5Ycfp7/1/lPZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpb" +
+		"VLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhs" +
+		"UdmiskVli8oWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKx" +
+		"RWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vG" +
+		"Fo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0a" +
+		"WzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZo" +
+		"vk00fmj80Pih8UPjh8YPjR8aPzR+aPzQ+KHxQ+OHxg+NHxo/NH5o/ND4ofFD44fGD40fGj80fmj80Pih8UPjh8YPjR8aPzR+" +
+		"aPzQ+KHxQ+OHxg+NHxo/NH5o/ND4ofFD44fGD40fGj80fmj80Pih8UPjh8YPjR8aPzR+aPzQ+KHxQ+eHzg+dHzo/dH7o/ND5" +
+		"ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pn" +
+		"h84PnR86P3R+6PzQ+eHH6W+9/3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S2" +
+		"6GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZ" +
+		"orNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vn" +
+		"i84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhad" +
+		"LTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06Wwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsM" +
+		"thhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy2GbxODHwY/" +
+		"DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8" +
+		"MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHw" +
+		"w+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfB" +
+		"D4MfBj8Mfvhx+lvvP4MtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhs" +
+		"MdhisMVgi8EWgy0GWwy2GGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKy" +
+		"xWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJ" +
+		"FpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0m" +
+		"W0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaY" +
+		"bDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvp28Tkh8kPkx8mP0x+" +
+		"mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/LH5Y/LD4" +
+		"YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPi" +
+		"h8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+L" +
+		"H36c/tb7z2KLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHY" +
+		"YrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVi" +
+		"i8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaL" +
+		"LRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNlts" +
+		"tthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx" +
+		"2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WL7NrH5YfPD5ofND5sfNj9sftj8" +
+		"sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHz" +
+		"w+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofN" +
+		"D5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmhx+nv/X+" +
+		"s9lis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYf" +
+		"W3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYf" +
+		"W3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYf" +
+		"W3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYf" +
+		"W3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYf" +
+		"W3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHF59vE" +
+		"xw8fP3z88PHDxw8fP3z88PHDxw8fP3z88PHD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44" +
+		"/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh" +
+		"8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GH" +
+		"ww+HHw4/HH44/HD44cfpb73/HLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4" +
+		"bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdji" +
+		"sMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGL" +
+		"wxaHLQ5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpct" +
+		"LltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2" +
+		"uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLa5vE5cfLj9c" +
+		"frj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw" +
+		"+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD" +
+		"5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5Yf7Jz/Mn//kh19/+S78y+mPC58L" +
+		"nwufC8eF48Jx4bpwXfifeP+ZPwf7gn3BvmBfsC/YF+wL9gX7gn3BvqBRtCHaEG2INkQbog3RhmhDtCHaEG2INiQbkg3JhmRD" +
+		"siHZkGxINiQbkg3JhmRDtiHbkG3INmQbsg3ZhmxDtiHbkG3INhQbig3FhmJDsaHYUGwoNhQbig3FhmJDtaHaUG2oNlQbqg3V" +
+		"hmpDtaHaUG2oNjQbmg3NhmZDs6HZ0GxoNjQbmg3NhmZDt6Hb0G3oNnQbug3dhm5Dt6Hb0G3oNgwbhg3DhmHDsGHYMGwYNgwb" +
+		"hg3DhmHDtGHaMG2YNkwbpg3ThmnDtGHaMG2YNiwblg3LhmXDsmHZsGxYNiwblg3LhmXDtmHbsG3YNmwbtg3bhm3DtmHbsG3Y" +
+		"Nnw2fDZ8Nnw2fDZ8Nnw2fDZ8Nnw2fDZ8Nhwbjg3HhmPDseHYcGw4Nhwbjg3HhmPDteHacG24Nlwbrg3XhmvDteHacG1gi8AW" +
+		"gS0CWwS2CGwR2CKwRWCLwBaBLQJbhJ9t4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4" +
+		"IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPg" +
+		"h8APgR8CPwR+CPwQ+CHwQ+CHwA+BHwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR8CPwR+CPwQ+CHwQ+CHwA+B" +
+		"HwI/BH4I/BD4IfBD4IfAD4EfAj8Efgj8EPgh8EPgh8APgR9+nP7W+09gi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHY" +
+		"IrBFYIvAFoEtAlsEtghsEdgisEVgi8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVg" +
+		"i8AWgS0CWwS2CGwR2CKwRWCLwBaBLQJbBLYIbBHYIrBFYIvAFoEtAlsEtghsEdgisEVki8gWkS0iW0S2iGwR2SKyRWSLyBaR" +
+		"LSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltE" +
+		"tohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR" +
+		"2SKyRWSLyBaRLSJbRLaIbBHZIrJFZIvIFpEtIltEtohsEdkiskVki8gWkS0iW0S2iGwR2SKyRWSLyBaRLSJbRLaIbBHZIrJF" +
+		"ZIvIFpEtIltEtohsEdki+jYR+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHy" +
+		"Q+SHyA+RHyI/RH6I/BD5IfJD5IfID5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPwQ+SHyQ+SHyA+RHyI/RH6I/BD5IfJD5IfI" +
+		"D5EfIj9Efoj8EPkh8kPkh8gPkR8iP0R+iPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8S" +
+		"PyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ocfp7/1/pPYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKx" +
+		"RWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovE" +
+		"FoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0S" +
+		"WyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZI" +
+		"bJHYIrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgi" +
+		"sUVii8QWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaL" +
+		"zBaZLTJbZLbIvk1kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pm" +
+		"h8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+Z" +
+		"HzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9k" +
+		"fsj8kPkh80Pmh8wPmR8yP2R+yPyQ+eHH6W+9/2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vm" +
+		"i8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yWxS2KGxR2KKwRWGLwhaF" +
+		"LQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsU" +
+		"tihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR" +
+		"2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBF" +
+		"YYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IW" +
+		"hS2KbxOFHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfC" +
+		"D4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8q" +
+		"P1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oP9f8pxG5ypu2NAozOWUV2ALf/PbRd9gz2" +
+		"ECUfEgMgCrB//h5yIjF4By21Sm7pGrVOFT80fmj80Pih8UPjh8YPjR8aPzR+aPzQ+KHxQ+OHxg+NHxo/NH5o/PAz/dX+09ii" +
+		"sUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOL" +
+		"xhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0t" +
+		"Gls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2" +
+		"aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhadLTpbdLbobNHZ" +
+		"orNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vn" +
+		"i84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhuE50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ" +
+		"+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND" +
+		"54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84P" +
+		"nR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R++Jn+av/pbNHZorNFZ4vO" +
+		"Fp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0G" +
+		"Wwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYY" +
+		"bDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhi" +
+		"sMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCL" +
+		"wRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMt" +
+		"BlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLeJwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPg" +
+		"h8EPgx8GPwx+GPww+GHww+CHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+T" +
+		"HyY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj9M" +
+		"fpj8MPlh8sPkh8kPkx8mP0x+mPww+WHyw+SHyQ+THyY/TH6Y/DD5YfLD5IfJD5MfJj/8TH+1/0y2mGwx2WKyxWSLyRaTLSZb" +
+		"TLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphs" +
+		"MdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKy" +
+		"xWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFYovF" +
+		"FostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0W" +
+		"Wyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZY" +
+		"bLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy2W28Tih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sf" +
+		"Fj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+" +
+		"WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4" +
+		"YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LH36mv9p/Nltstthssdlis8Vmi80Wmy02W2y22Gyx" +
+		"2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPF" +
+		"ZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80W" +
+		"my02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZb" +
+		"bLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstths" +
+		"sdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKz" +
+		"xWaLzRabLTZbbLbYbLHZYrPFZovNFttt4vDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44" +
+		"/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh" +
+		"8MPhh8MPhx8OPxx+OPxw+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw+OHww+GH" +
+		"ww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPP9Nf7T+HLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVh" +
+		"i8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaH" +
+		"LQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsc" +
+		"tjhsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwR" +
+		"bBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwR" +
+		"bBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwRbBFsEWwR" +
+		"bBFuE8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EP" +
+		"wQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EP" +
+		"wQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD8EPwQ/BD5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD5" +
+		"4fLD5Yef6a/2n8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx" +
+		"2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLF" +
+		"ZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sW" +
+		"ly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5b" +
+		"XLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhs" +
+		"cdnissVli8cWjy0eWzy2eGzx2OKxxWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8djiscVzm3j88Pjh8cPjh8cPjx8e" +
+		"Pzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54" +
+		"/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh" +
+		"8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw8/0" +
+		"V/vPY4vHFo8tHls8tnhs8djiscVji8cWjy0eWzy2eGzx2OKxxWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8djiscVj" +
+		"i8cWjy0eWzy2eGzx2OKxxWOLxxaPLR5bPLZ4bPHY4rHFY4vHFo8tHls8tnhs8djiscVji8cWjy3eX2yx/+4vtvifr//74P+m" +
+		"Pw/Cg/AgPLgeXA+uB8+D58FfGj4Nn4ZPw6fh0/Bp+DR8Gj4Nn4ZPw6chaUgakoakIWlIGpKGpCFpSBqShqQha8gasoasIWvI" +
+		"GrKGrCFryBqyhqyhaCgaioaioWgoGoqGoqFoKBqKhqKhaqgaqoaqoWqoGqqGqqFqqBqqhqqhaWgamoamoWloGpqGpqFpaBqa" +
+		"hqaha+gauoauoWvoGrqGrqFr6Bq6hq5haBgahoahYWgYGoaGoWFoGBqGhqFhapgapoapYWqYGqaGqWFqmBqmhqlhaVgaloal" +
+		"YWlYGpaGpWFpWBqWhqVha9gatoatYWvYGraGrWFr2Bq2hq3haDgajoaj4Wg4Go6Go+FoOBqOhqMhNISG0BAaQkNoCA2hITSE" +
+		"htAQGq6Gq+FquBquhqvhargaroar4Wq4Gp6Gp+FpeBqehqfhaXganoan4Wngh48fPn74+OHjh48fPn74+OHjh48fPn74+OHj" +
+		"h48fPn74+OHjh48fPn74+OHjh48fPn74+OHjh48fPn74+OHjh48fPn74+OHjh48fPn74+OHjh48fPn74+OHjh48fPn74+OHj" +
+		"h48fPn74+OFn+ov9Z39s8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHF" +
+		"xxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHF" +
+		"xxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHF" +
+		"xxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHF" +
+		"xxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfW3xs8bHFxxYfWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHY" +
+		"IrFFYovEFoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovEFoktElsktkhskdgisUVi" +
+		"i8QWiS2S20Tih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ" +
+		"+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD" +
+		"4ofED4kfEj8kfkj8kPgh8UPih8QPiR8SPyR+SPyQ+CHxQ+KHxA+JHxI/JH5I/JD4IfFD4ofED4kfEj8kfkj8kPgh8UPih8QP" +
+		"iR8SPyR+SPyQ+CHxQ+KHxA+JH36mv9p/ElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKxRWKLxBaJLRJbJLZIbJHYIrFFYovE" +
+		"FoktElsktkhskdgisUVii8QWiS0SWyS2SGyR2CKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0y" +
+		"W2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbI" +
+		"bJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdki" +
+		"s0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaL" +
+		"zBaZLTJbZLbIbJHZIrNFZovMFpktMltktshskdkis0Vmi8wWmS0yW2S2yGyR2SKzRWaLzBaZLTJbZLbIbJHZIrNFZovMFtlt" +
+		"IvND5ofMD5kfMj9kfsj8kPkh80Pmh8wPmR8yP2R+yPyQ+SHzQ+aHzA+ZHzI/ZH7I/JD5IfND5ofMD5kfMj9kfsj8kPkh80Pm" +
+		"h8wPmR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+F" +
+		"Hwo/FH4o/FD4ofBD4YfCD4UfCj8Ufij8UPih8EPhh8IPhR8KPxR+KPxQ+KHwQ+GHwg+FHwo/FH4o/FD4ofBD4YfCD4UfCj8U" +
+		"fij8UPih8EPhh8IPP9Nf7T+FLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpb" +
+		"FLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihs" +
+		"UdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKw" +
+		"RWGLwhaFLQpbFLYobFHYorBFYYvCFoUtClsUtihsUdiisEVhi8IWhS0KWxS2KGxR2KKwRWGLwhaVLSpbVLaobFHZorJFZYvK" +
+		"FpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0q" +
+		"W1S2qGxR2aKyRWWLyhaVLSpbVLaobFHZorJFZYvKFpUtKltUtqhsUdmiskVli8oWlS0qW1S2qGxR2aKyRWWL6jZR+aHyQ+WH" +
+		"yg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5Uf" +
+		"Kj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+" +
+		"qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5ofJD5YfKD5UfKj9Ufqj8UPmh8kPlh8oPlR8qP1R+qPxQ+aHyQ+WHyg+VHyo/VH6o/FD5" +
+		"ofJD5Yef6a/2n8oWlS0qW1S2qGxR2aKyRWWLyhaVLSpbVLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR" +
+		"2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFF" +
+		"Y4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YW" +
+		"jS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpb" +
+		"NLZobNHYorFFY4vGFo0tGls0tmhs0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs" +
+		"0diisUVji8YWjS0aWzS2aGzR2KKxRWOLxhaNLRpbNLZobNHYorFFY4vGFo0tGls0tmhs0diisUVzm2j80Pih8UPjh8YPjR8a" +
+		"PzR+aPzQ+KHxQ+OHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o" +
+		"/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh" +
+		"80Pnh84PnR86P3R+6PzQ+aHzQ+eHzg+dHzo/dH7o/ND5ofND54fOD50fOj90fuj80Pmh80Pnh84PnR86P3R+6PzQ+aHzw8/0" +
+		"V/tPZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vn" +
+		"i84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhad" +
+		"LTpbdLbobNHZorNFZ4vOFp0tOlt0tuhs0dmis0Vni84WnS06W3S26GzR2aKzRWeLzhadLTpbdLbobNHZorPFYIvBFoMtBlsM" +
+		"thhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx" +
+		"2GKwxWCLwRaDLQZbDLYYbDHYYrDFYIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrDF" +
+		"YIvBFoMtBlsMthhsMdhisMVgi8EWgy0GWwy2GGwx2GKwxWCLwRaDLQZbDLYYbDHYYrhNDH4Y/DD4YfDD4IfBD4MfBj8Mfhj8" +
+		"MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHw" +
+		"w+CHwQ+DHwY/DH4Y/DD4YfDD4IfBD4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/DH4Y/DD4YfDD4IfB" +
+		"D4MfBj8Mfhj8MPhh8MPgh8EPgx8GPwx+GPww+GHww+CHwQ+DHwY/TH6Y/DD5YfLD5IfJD5MfJj9Mfpj8MPnhZ/qr/WeyxWSL" +
+		"yRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMt" +
+		"JltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2" +
+		"mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZ" +
+		"YrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVk" +
+		"i8kWky0mW0y2mGwx2WKyxWSLyRaTLSZbTLaYbDHZYrLFZIvJFpMtJltMtphsMdlissVki8kWky0mW0y2mGwx2WKyxWSLyRaT" +
+		"LSZbTLaYbDHZYrLFZIvJFpMtFlsstlhssdhiscVii8UWiy0WWyy2WGyx3CYWPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD" +
+		"4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UP" +
+		"ix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/" +
+		"LH5Y/LD4YfHD4ofFD4sfFj8sflj8sPhh8cPih8UPix8WPyx+WPyw+GHxw+KHxQ+LHxY/LH5Y/PAz/dX+s9hiscVii8UWiy0W" +
+		"Wyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZY" +
+		"bLHYYrHFYovFFostFlsstlhssdhiscVii8UWiy0WWyy2WGyx2GKxxWKLxRaLLRZbLLZYbLHYYrHFYovFFostFlsstlhssdli" +
+		"s8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaL" +
+		"zRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpst" +
+		"Nltstthssdlis8Vmi80Wmy02W2y22Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthssdlis8Vmi80Wmy02W2y2" +
+		"2Gyx2WKzxWaLzRabLTZbbLbYbLHZYrPFZovNFpstNltstthuE5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+b" +
+		"HzY/bH7Y/LD5YfPD5ofND5sfNj9sftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND5sfNj9s" +
+		"ftj8sPlh88Pmh80Pmx82P2x+2Pyw+WHzw+aHzQ+bHzY/bH7Y/LD5YfPD5ofND4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx+OPxw" +
+		"+OHww+GHww+HHw4/HH44/HD44fDD4YfDD4cfDj8cfjj8cPjh8MPhh8MPhx8OPxx++Jn+av85bHHY4rDFYYvDFoctDlsctjhs" +
+		"cdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKw" +
+		"xWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvD" +
+		"FoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0O" +
+		"Wxy2OGxx2OKwxWGLwxaHLQ5bHLY4bHHY4rDFYYvDFoctDlsctjhscdjisMVhi8MWhy0OWxy2OGxx2OKwxWGLwxaHLQ5bHLY4" +
+		"bHHY4rDFYYvDFoctDlsctjhscdjisMVhi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItg" +
+		"i2CLYItgi2CLYItgi2CLYItgi2CLYItwmwh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4I" +
+		"fgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4I" +
+		"fgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH4I" +
+		"fgh+CH4Ifgh+CH4Ifgh+CH4Ifgh+CH74mf5q/wm2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2" +
+		"CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItgi2CLYItrhscdnissVli8sWly0uW1y2uGxx" +
+		"2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLF" +
+		"ZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sW" +
+		"ly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5b" +
+		"XLa4bHHZ4rLFZYvLFpctLltctrhscdnissVli8sWly0uW1y2uGxx2eKyxWWLyxaXLS5bXLa4bHHZ4rLFZYvLFpctLltct4nL" +
+		"D5cfLj9cfrj8cPnh8sPlh8sPlx8uP1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfLD5cfLj9cfrj8cPnh8sPlh8sPlx8u" +
+		"P1x+uPxw+eHyw+WHyw+XHy4/XH64/HD54fLD5YfHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54" +
+		"/PD44fHD44fHD48fHj88fnj88Pjh8cPjh8cPjx8ePzx+ePzw+OHxw+OHxw+PHx4/PH54/PD44fHD44fHD48fHj88fnj88Pjh" +
+		"8cPjh8cPjx8eP/xM/3r/KeX/rT8PLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYP" +
+		"LR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8" +
+		"tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx" +
+		"0OKhxUOLhxYPLR5aPLR4aPHQ4qHFQ4uHFg8tHlo8tHho8dDiocVDi4cWDy0eWjy0eGjx0OKhxUOLhxYPLR5aPLR4aPHQ4qHF" +
+		"Q4uHFg8tHlo8tPiZ/vXf+H99/cM///5Pf3P+/vd/+off//Nvv/vDf/z5z7/9y7//8Z/+8O+/+9v/nv7uj7/94z/9y29//u3f" +
+		"/vU//vyH3373p3/903//6n8+/wk4mj3a",
+	"UniKS-UTF16-V": "" +
+		"eNpVUMFqwzAMvecrtEOhO3heWth9dIyF0q3U7XZObaUYGtko9iF/v1QxHTtIQtJ7Tw8tHvZGvbpwRrV+eoYDDiGzRbXZtbHS" +
+		"m+atIZ9A7zlYgwk6T44LBs548VTVK3DeptJJtn0hm3FI2DfUBVjPKJdjQerDVIbEIyzl/iM47Cr9xQ7Z0wWW28DY1mVscoxX" +
+		"7JES1DJBcvPm5vSz7RH0ifzWqNPxvX5R33/L4xgRVnP/swsOi8I//AfkAcX3JCz1rmsz83RX7Ivgje0J73+IQVgSv/3EbdU=",
+}
@@ -0,0 +1,67 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package cmap
+
+import (
+	"testing"
+)
+
+func TestPredefinedCMaps(t *testing.T) {
+	for name := range predefinedResources {
+		cmap, err := LoadPredefinedCmap(name)
+		if err != nil {
+			t.Fatalf("Error loading %s: %v", name, err)
+		}
+		if cmap.Name() != name {
+			t.Errorf("CMap name incorrect (%s != %s)", cmap.Name(), name)
+		}
+		if !cmap.HasUnicode() {
+			t.Errorf("CMap %s has no Unicode mappings", name)
+		}
+		if wmode := name[len(name)-1:]; (wmode == "V") != (cmap.WMode() == 1) {
+			t.Errorf("Incorrect WMode of %s (%d)", name, cmap.WMode())
+		}
+	}
+
+	testcases := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{"90ms-RKSJ-H", "\x82\xa0A\xb1\x88\x9f", "あAｱ亜"},
+		{"90ms-RKSJ-V", "\x82\xa0A", "あA"},
+		{"EUC-H", "\xa4\xa2\x8e\xb1", "あｱ"},
+		{"GBK-EUC-H", "\xc4\xe3\xba\xc3!", "你好!"},
+		{"GB-EUC-V", "\xc4\xe3", "你"},
+		{"ETen-B5-H", "\xa4\xa4\xa4\xe5", "中文"},
+		{"KSCms-UHC-H", "\xc7\xd1\xb1\xdb", "한글"},
+		{"UniJIS-UCS2-H", "\x30\x42\x00A", "あA"},
+		{"UniGB-UTF16-H", "\x4f\x60\xd8\x40\xdc\x0b", "你\U0002000b"},
+	}
+	for _, tc := range testcases {
+		cmap, err := LoadPredefinedCmap(tc.name)
+		if err != nil {
+			t.Fatalf("Error loading %s: %v", tc.name, err)
+		}
+		if s := cmap.CharcodeBytesToUnicode([]byte(tc.data)); s != tc.expected {
+			t.Errorf("Incorrect %s mapping % X -> %q (got %q)", tc.name, tc.data, tc.expected, s)
+		}
+	}
+
+	if !IsPredefinedCmap("Identity-V") || IsPredefinedCmap("Unknown-H") {
+		t.Errorf("Incorrect predefined CMap names")
+	}
+	identity, err := LoadPredefinedCmap("Identity-H")
+	if err != nil {
+		t.Fatalf("Error loading Identity-H: %v", err)
+	}
+	if cids := identity.CharcodeBytesToCIDs([]byte{0x01, 0x02, 0xff, 0xfe}); len(cids) != 2 || cids[0] != 0x102 || cids[1] != 0xfffe {
+		t.Errorf("Incorrect Identity-H CIDs %v", cids)
+	}
+	if _, err := LoadPredefinedCmap("Unknown-H"); err == nil {
+		t.Errorf("Unknown CMap should fail")
+	}
+}
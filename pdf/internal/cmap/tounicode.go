@@ -0,0 +1,191 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package cmap
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"unicode/utf16"
+)
+
+// Maximum number of entries in a section of mappings, e.g. bfchar or cidrange.
+const maxSectionEntries = 100
+
+// NewToUnicodeCMap returns a ToUnicode CMap mapping the 2-byte character codes of `codeToUnicode`
+// to their runes.
+func NewToUnicodeCMap(codeToUnicode map[uint16]rune) *CMap {
+	cmap := newCMap()
+	cmap.name = "Adobe-Identity-UCS"
+	cmap.ctype = 2
+	cmap.systemInfo = CIDSystemInfo{Registry: "Adobe", Ordering: "UCS"}
+	cmap.codespaces = []codespace{{numBytes: 2, low: 0, high: 0xFFFF}}
+	for code, r := range codeToUnicode {
+		cmap.codeMap[1][uint64(code)] = string(r)
+	}
+	return cmap
+}
+
+// RuneToCharcodeMap returns the character codes of the CMap by the rune they map to.  Codes mapped
+// to several runes are skipped, and the lowest code is returned for runes mapped by several codes.
+func (cmap *CMap) RuneToCharcodeMap() map[rune]uint64 {
+	result := map[rune]uint64{}
+	for _, codes := range cmap.codeMap {
+		for code, s := range codes {
+			runes := []rune(s)
+			if len(runes) != 1 {
+				continue
+			}
+			if c, has := result[runes[0]]; !has || code < c {
+				result[runes[0]] = code
+			}
+		}
+	}
+	return result
+}
+
+// bfEntry is a bfchar entry, or a bfrange entry of consecutive codes mapped to consecutive runes.
+type bfEntry struct {
+	low, high uint64
+	dst       string
+}
+
+// Bytes returns the CMap file data with the character collection, codespace ranges and mappings of
+// the CMap, e.g. for writing ToUnicode CMaps.  The mappings of the CMap given by usecmap are not
+// included.
+func (cmap *CMap) Bytes() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("/CIDInit /ProcSet findresource begin\n")
+	buf.WriteString("12 dict begin\n")
+	buf.WriteString("begincmap\n")
+	info := cmap.systemInfo
+	fmt.Fprintf(&buf, "/CIDSystemInfo << /Registry (%s) /Ordering (%s) /Supplement %d >> def\n",
+		info.Registry, info.Ordering, info.Supplement)
+	fmt.Fprintf(&buf, "/CMapName /%s def\n", cmap.name)
+	fmt.Fprintf(&buf, "/CMapType %d def\n", cmap.ctype)
+	if cmap.wmode != 0 {
+		fmt.Fprintf(&buf, "/WMode %d def\n", cmap.wmode)
+	}
+	if cmap.usecmap != "" {
+		fmt.Fprintf(&buf, "/%s usecmap\n", cmap.usecmap)
+	}
+
+	var codespaces []string
+	for _, cs := range cmap.codespaces {
+		codespaces = append(codespaces, hexCode(cs.low, cs.numBytes)+" "+hexCode(cs.high, cs.numBytes))
+	}
+	writeSections(&buf, "codespacerange", codespaces)
+
+	var cidChars, cidRanges, notdefChars, notdefRanges []string
+	for i, codes := range cmap.cidMap {
+		keys := make([]uint64, 0, len(codes))
+		for code := range codes {
+			keys = append(keys, code)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, code := range keys {
+			cidChars = append(cidChars, fmt.Sprintf("%s %d", hexCode(code, i+1), codes[code]))
+		}
+	}
+	for _, r := range cmap.cidRanges {
+		cidRanges = append(cidRanges, fmt.Sprintf("%s %s %d", hexCode(r.low, r.numBytes),
+			hexCode(r.high, r.numBytes), r.cid))
+	}
+	for _, r := range cmap.notdefRanges {
+		if r.low == r.high {
+			notdefChars = append(notdefChars, fmt.Sprintf("%s %d", hexCode(r.low, r.numBytes), r.cid))
+		} else {
+			notdefRanges = append(notdefRanges, fmt.Sprintf("%s %s %d", hexCode(r.low, r.numBytes),
+				hexCode(r.high, r.numBytes), r.cid))
+		}
+	}
+	writeSections(&buf, "cidchar", cidChars)
+	writeSections(&buf, "cidrange", cidRanges)
+	writeSections(&buf, "notdefchar", notdefChars)
+	writeSections(&buf, "notdefrange", notdefRanges)
+
+	for i, codes := range cmap.codeMap {
+		numBytes := i + 1
+		var chars, ranges []string
+		for _, e := range bfEntries(codes) {
+			if e.low == e.high {
+				chars = append(chars, hexCode(e.low, numBytes)+" "+hexString(e.dst))
+			} else {
+				ranges = append(ranges, hexCode(e.low, numBytes)+" "+hexCode(e.high, numBytes)+" "+
+					hexString(e.dst))
+			}
+		}
+		writeSections(&buf, "bfchar", chars)
+		writeSections(&buf, "bfrange", ranges)
+	}
+
+	buf.WriteString("endcmap\n")
+	buf.WriteString("CMapName currentdict /CMap defineresource pop\n")
+	buf.WriteString("end\n")
+	buf.WriteString("end\n")
+	return buf.Bytes()
+}
+
+// writeSections writes the entries `entries` in `kind` sections, e.g. begincidrange ...
+// endcidrange, of at most maxSectionEntries entries.
+func writeSections(buf *bytes.Buffer, kind string, entries []string) {
+	for len(entries) > 0 {
+		n := len(entries)
+		if n > maxSectionEntries {
+			n = maxSectionEntries
+		}
+		fmt.Fprintf(buf, "%d begin%s\n", n, kind)
+		for _, e := range entries[:n] {
+			buf.WriteString(e)
+			buf.WriteByte('\n')
+		}
+		fmt.Fprintf(buf, "end%s\n", kind)
+		entries = entries[n:]
+	}
+}
+
+// bfEntries groups the mappings `codes` into bfchar and bfrange entries.  Ranges do not cross a
+// change of the first bytes of the codes or of the destination runes.
+func bfEntries(codes map[uint64]string) []bfEntry {
+	keys := make([]uint64, 0, len(codes))
+	for code := range codes {
+		keys = append(keys, code)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	var entries []bfEntry
+	for _, code := range keys {
+		dst := codes[code]
+		if n := len(entries); n > 0 {
+			last := &entries[n-1]
+			prev := []rune(last.dst)
+			cur := []rune(dst)
+			if code == last.high+1 && code&0xFF != 0 && len(prev) == 1 && len(cur) == 1 &&
+				cur[0] <= 0xFFFF && cur[0] == prev[0]+rune(code-last.low) && cur[0]&0xFF != 0 {
+				last.high = code
+				continue
+			}
+		}
+		entries = append(entries, bfEntry{low: code, high: code, dst: dst})
+	}
+	return entries
+}
+
+// hexCode returns the hexadecimal string of the `numBytes` byte code `code`.
+func hexCode(code uint64, numBytes int) string {
+	return fmt.Sprintf("<%0*X>", 2*numBytes, code)
+}
+
+// hexString returns the hexadecimal string of the UTF-16BE encoding of `s`.
+func hexString(s string) string {
+	var buf bytes.Buffer
+	buf.WriteByte('<')
+	for _, v := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&buf, "%04X", v)
+	}
+	buf.WriteByte('>')
+	return buf.String()
+}
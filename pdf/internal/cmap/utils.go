@@ -5,7 +5,7 @@
 
 package cmap
 
-import "bytes"
+import "unicode/utf16"
 
 func hexToUint64(shex cmapHexString) uint64 {
 	val := uint64(0)
@@ -18,17 +18,16 @@ func hexToUint64(shex cmapHexString) uint64 {
 	return val
 }
 
-func hexToString(shex cmapHexString) string {
-	var buf bytes.Buffer
+// bytesToCode returns the character code of the big-endian bytes `b`.
+func bytesToCode(b []byte) uint64 {
+	return hexToUint64(cmapHexString{numBytes: len(b), b: b})
+}
 
-	// Assumes unicode in format <HHLL> with 2 bytes HH and LL representing a rune.
+// hexToString returns the string of the UTF-16BE encoded `shex`.
+func hexToString(shex cmapHexString) string {
+	units := make([]uint16, 0, len(shex.b)/2)
 	for i := 0; i < len(shex.b)-1; i += 2 {
-		b1 := uint64(shex.b[i])
-		b2 := uint64(shex.b[i+1])
-		r := rune((b1 << 8) | b2)
-
-		buf.WriteRune(r)
+		units = append(units, uint16(shex.b[i])<<8|uint16(shex.b[i+1]))
 	}
-
-	return buf.String()
+	return string(utf16.Decode(units))
 }
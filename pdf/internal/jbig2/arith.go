@@ -0,0 +1,111 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jbig2
+
+import (
+	"github.com/unidoc/unidoc/pdf/internal/mq"
+)
+
+// intDecoder is an arithmetic integer decoder (IAx) with its own set of contexts (Annex A.2).
+type intDecoder struct {
+	cx [512]mq.Context
+}
+
+// decode decodes an integer.  Returns false for the out-of-band value (OOB).
+func (d *intDecoder) decode(ad *mq.Decoder) (int, bool) {
+	prev := 1
+	readBit := func() int {
+		bit := ad.DecodeBit(&d.cx[prev])
+		if prev < 256 {
+			prev = (prev << 1) | bit
+		} else {
+			prev = (((prev << 1) | bit) & 511) | 256
+		}
+		return bit
+	}
+	readBits := func(n int) int {
+		v := 0
+		for i := 0; i < n; i++ {
+			v = (v << 1) | readBit()
+		}
+		return v
+	}
+
+	s := readBit()
+	var n, offset int
+	switch {
+	case readBit() == 0:
+		n, offset = 2, 0
+	case readBit() == 0:
+		n, offset = 4, 4
+	case readBit() == 0:
+		n, offset = 6, 20
+	case readBit() == 0:
+		n, offset = 8, 84
+	case readBit() == 0:
+		n, offset = 12, 340
+	default:
+		n, offset = 32, 4436
+	}
+	v := readBits(n) + offset
+
+	if s == 1 {
+		if v == 0 {
+			return 0, false
+		}
+		return -v, true
+	}
+	return v, true
+}
+
+// idDecoder is the arithmetic symbol ID decoder (IAID, Annex A.3).
+type idDecoder struct {
+	codeLen uint
+	cx      []mq.Context
+}
+
+func newIDDecoder(codeLen uint) *idDecoder {
+	return &idDecoder{codeLen: codeLen, cx: make([]mq.Context, 1<<codeLen)}
+}
+
+// decode decodes a symbol ID.
+func (d *idDecoder) decode(ad *mq.Decoder) int {
+	prev := 1
+	for i := uint(0); i < d.codeLen; i++ {
+		prev = (prev << 1) | ad.DecodeBit(&d.cx[prev])
+	}
+	return prev - (1 << d.codeLen)
+}
+
+// arithState holds the arithmetic decoder and the adaptive contexts of a segment, which persist
+// across the bitmaps and integers decoded in it.
+type arithState struct {
+	ad *mq.Decoder
+
+	iadh, iadw, iaex, iaai       intDecoder // Symbol dictionary.
+	iadt, iafs, iads, iait, iari intDecoder // Text region.
+	iardw, iardh, iardx, iardy   intDecoder // Refinement.
+	iaid                         *idDecoder
+	gb                           []mq.Context // Generic region contexts.
+	gr                           []mq.Context // Generic refinement region contexts.
+}
+
+func newArithState(data []byte) *arithState {
+	return &arithState{
+		ad: mq.NewDecoder(data),
+		gb: make([]mq.Context, 1<<16),
+		gr: make([]mq.Context, 1<<13),
+	}
+}
+
+// log2Ceil returns the number of bits needed to represent `n` distinct values.
+func log2Ceil(n int) uint {
+	bits := uint(0)
+	for (1 << bits) < n {
+		bits++
+	}
+	return bits
+}
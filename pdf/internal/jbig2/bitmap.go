@@ -0,0 +1,147 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jbig2
+
+// Combination operators (7.4.1.5 and 7.4.8.5).
+const (
+	combineOr = iota
+	combineAnd
+	combineXor
+	combineXnor
+	combineReplace
+)
+
+// bitmap is a bi-level image with one byte per pixel, 1 represents black (foreground).
+type bitmap struct {
+	width  int
+	height int
+	data   []byte
+}
+
+// newBitmap returns a new bitmap filled with `value`.
+func newBitmap(width, height int, value byte) *bitmap {
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	bm := &bitmap{width: width, height: height, data: make([]byte, width*height)}
+	if value != 0 {
+		for i := range bm.data {
+			bm.data[i] = value
+		}
+	}
+	return bm
+}
+
+// get returns the pixel at (x, y), pixels outside the bitmap are 0.
+func (bm *bitmap) get(x, y int) int {
+	if x < 0 || y < 0 || x >= bm.width || y >= bm.height {
+		return 0
+	}
+	return int(bm.data[y*bm.width+x])
+}
+
+// set sets the pixel at (x, y), ignoring pixels outside of the bitmap.
+func (bm *bitmap) set(x, y int, value byte) {
+	if x < 0 || y < 0 || x >= bm.width || y >= bm.height {
+		return
+	}
+	bm.data[y*bm.width+x] = value
+}
+
+// row returns the pixels of row `y`.
+func (bm *bitmap) row(y int) []byte {
+	return bm.data[y*bm.width : (y+1)*bm.width]
+}
+
+// subBitmap returns a copy of the `width` columns starting at column `x0`.
+func (bm *bitmap) subBitmap(x0, width int) *bitmap {
+	sub := newBitmap(width, bm.height, 0)
+	for y := 0; y < bm.height; y++ {
+		copy(sub.row(y), bm.row(y)[x0:x0+width])
+	}
+	return sub
+}
+
+// grow extends the bitmap to `height` rows, filling new rows with `value`.
+func (bm *bitmap) grow(height int, value byte) {
+	if height <= bm.height {
+		return
+	}
+	data := make([]byte, bm.width*height)
+	copy(data, bm.data)
+	if value != 0 {
+		for i := len(bm.data); i < len(data); i++ {
+			data[i] = value
+		}
+	}
+	bm.data = data
+	bm.height = height
+}
+
+// combine draws `src` onto the bitmap at (x, y) with combination operator `op`.
+func (bm *bitmap) combine(src *bitmap, x, y int, op int) {
+	for sy := 0; sy < src.height; sy++ {
+		dy := y + sy
+		if dy < 0 || dy >= bm.height {
+			continue
+		}
+		srow := src.row(sy)
+		drow := bm.row(dy)
+		for sx, s := range srow {
+			dx := x + sx
+			if dx < 0 || dx >= bm.width {
+				continue
+			}
+			d := drow[dx]
+			switch op {
+			case combineOr:
+				d |= s
+			case combineAnd:
+				d &= s
+			case combineXor:
+				d ^= s
+			case combineXnor:
+				d = 1 ^ (d ^ s)
+			case combineReplace:
+				d = s
+			}
+			drow[dx] = d
+		}
+	}
+}
+
+// pack returns the bitmap as packed rows, 1 bit per pixel with each row padded to a byte
+// boundary.  If `invert` is true, the pixel values are inverted.
+func (bm *bitmap) pack(invert bool) []byte {
+	rowBytes := (bm.width + 7) / 8
+	packed := make([]byte, rowBytes*bm.height)
+	for y := 0; y < bm.height; y++ {
+		prow := packed[y*rowBytes : (y+1)*rowBytes]
+		for x, v := range bm.row(y) {
+			if (v != 0) != invert {
+				prow[x>>3] |= 0x80 >> uint(x&7)
+			}
+		}
+	}
+	return packed
+}
+
+// unpackBitmap converts packed rows (1 is black) into a bitmap.
+func unpackBitmap(packed []byte, width, height int) *bitmap {
+	bm := newBitmap(width, height, 0)
+	rowBytes := (width + 7) / 8
+	for y := 0; y < height && (y+1)*rowBytes <= len(packed); y++ {
+		prow := packed[y*rowBytes : (y+1)*rowBytes]
+		row := bm.row(y)
+		for x := range row {
+			row[x] = (prow[x>>3] >> uint(7-x&7)) & 1
+		}
+	}
+	return bm
+}
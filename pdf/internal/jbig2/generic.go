@@ -0,0 +1,225 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jbig2
+
+import (
+	"github.com/unidoc/unidoc/pdf/internal/ccittfax"
+)
+
+// point is a pixel offset.
+type point struct {
+	x, y int
+}
+
+// templatePixel is a pixel of a context template: its offset relative to the pixel being decoded
+// and its bit position in the context.
+type templatePixel struct {
+	x, y int
+	bit  uint
+}
+
+// genericTemplates are the fixed pixels of the generic region templates 0-3 (6.2.5.3).
+var genericTemplates = [4][]templatePixel{
+	{
+		{-1, 0, 0}, {-2, 0, 1}, {-3, 0, 2}, {-4, 0, 3},
+		{2, -1, 5}, {1, -1, 6}, {0, -1, 7}, {-1, -1, 8}, {-2, -1, 9},
+		{1, -2, 12}, {0, -2, 13}, {-1, -2, 14},
+	},
+	{
+		{-1, 0, 0}, {-2, 0, 1}, {-3, 0, 2},
+		{2, -1, 4}, {1, -1, 5}, {0, -1, 6}, {-1, -1, 7}, {-2, -1, 8},
+		{2, -2, 9}, {1, -2, 10}, {0, -2, 11}, {-1, -2, 12},
+	},
+	{
+		{-1, 0, 0}, {-2, 0, 1},
+		{1, -1, 3}, {0, -1, 4}, {-1, -1, 5}, {-2, -1, 6},
+		{1, -2, 7}, {0, -2, 8}, {-1, -2, 9},
+	},
+	{
+		{-1, 0, 0}, {-2, 0, 1}, {-3, 0, 2}, {-4, 0, 3},
+		{1, -1, 5}, {0, -1, 6}, {-1, -1, 7}, {-2, -1, 8}, {-3, -1, 9},
+	},
+}
+
+// genericATBits are the context bit positions of the adaptive template pixels.
+var genericATBits = [4][]uint{
+	{4, 10, 11, 15},
+	{3},
+	{2},
+	{4},
+}
+
+// genericTPContexts are the contexts used for decoding the typical prediction bit SLTP (6.2.5.7).
+var genericTPContexts = [4]int{0x9B25, 0x0795, 0x00E5, 0x0195}
+
+// defaultGenericAT returns the nominal adaptive template pixel positions for `template`.
+func defaultGenericAT(template int) []point {
+	switch template {
+	case 0:
+		return []point{{3, -1}, {-3, -1}, {2, -2}, {-2, -2}}
+	case 1:
+		return []point{{3, -1}}
+	}
+	return []point{{2, -1}}
+}
+
+// genericParams are the parameters of the generic region decoding procedure (6.2.2).
+type genericParams struct {
+	mmr      bool
+	width    int
+	height   int
+	template int
+	tpgdon   bool
+	skip     *bitmap // Pixels to skip (USESKIP), nil if not used.
+	at       []point
+}
+
+// decodeGeneric decodes an arithmetically coded generic region (6.2.5).
+func decodeGeneric(st *arithState, p *genericParams) *bitmap {
+	bm := newBitmap(p.width, p.height, 0)
+
+	pixels := append([]templatePixel{}, genericTemplates[p.template]...)
+	for i, bit := range genericATBits[p.template] {
+		if i < len(p.at) {
+			pixels = append(pixels, templatePixel{p.at[i].x, p.at[i].y, bit})
+		}
+	}
+
+	ltp := 0
+	for y := 0; y < bm.height; y++ {
+		if p.tpgdon {
+			ltp ^= st.ad.DecodeBit(&st.gb[genericTPContexts[p.template]])
+			if ltp == 1 {
+				if y > 0 {
+					copy(bm.row(y), bm.row(y-1))
+				}
+				continue
+			}
+		}
+		row := bm.row(y)
+		for x := range row {
+			if p.skip != nil && p.skip.get(x, y) == 1 {
+				continue
+			}
+			cx := 0
+			for _, px := range pixels {
+				cx |= bm.get(x+px.x, y+px.y) << px.bit
+			}
+			row[x] = byte(st.ad.DecodeBit(&st.gb[cx]))
+		}
+	}
+	return bm
+}
+
+// decodeGenericMMR decodes an MMR coded generic region (6.2.6).  Returns the bitmap and the
+// number of bytes consumed.
+func decodeGenericMMR(data []byte, width, height int) (*bitmap, int, error) {
+	if width <= 0 || height <= 0 {
+		return newBitmap(width, height, 0), 0, nil
+	}
+	params := ccittfax.Params{
+		K:          -1,
+		Columns:    width,
+		Rows:       height,
+		BlackIs1:   true,
+		EndOfBlock: true,
+	}
+	packed, n, err := ccittfax.DecodeN(data, params)
+	if err != nil {
+		return nil, 0, err
+	}
+	return unpackBitmap(packed, width, height), n, nil
+}
+
+// refinementTemplates are the pixels of the refinement templates 0-1 (6.3.5.3), split into the
+// pixels of the region being decoded and those of the reference bitmap.
+var refinementTemplates = [2]struct {
+	coding    []templatePixel
+	reference []templatePixel
+	atBits    [2]uint
+	tpContext int
+}{
+	{
+		coding: []templatePixel{{-1, 0, 0}, {1, -1, 1}, {0, -1, 2}},
+		reference: []templatePixel{
+			{1, 1, 4}, {0, 1, 5}, {-1, 1, 6},
+			{1, 0, 7}, {0, 0, 8}, {-1, 0, 9},
+			{1, -1, 10}, {0, -1, 11},
+		},
+		atBits:    [2]uint{3, 12},
+		tpContext: 0x100,
+	},
+	{
+		coding: []templatePixel{{-1, 0, 0}, {1, -1, 1}, {0, -1, 2}, {-1, -1, 3}},
+		reference: []templatePixel{
+			{1, 1, 4}, {0, 1, 5},
+			{1, 0, 6}, {0, 0, 7}, {-1, 0, 8},
+			{0, -1, 9},
+		},
+		tpContext: 0x80,
+	},
+}
+
+// refinementParams are the parameters of the generic refinement region decoding procedure (6.3.2).
+type refinementParams struct {
+	width     int
+	height    int
+	template  int
+	reference *bitmap
+	dx, dy    int // Offset of the reference bitmap.
+	tpgron    bool
+	at        [2]point
+}
+
+// decodeRefinement decodes a generic refinement region (6.3.5).
+func decodeRefinement(st *arithState, p *refinementParams) *bitmap {
+	bm := newBitmap(p.width, p.height, 0)
+	ref := p.reference
+	tmpl := &refinementTemplates[p.template]
+
+	ltp := 0
+	for y := 0; y < bm.height; y++ {
+		if p.tpgron {
+			ltp ^= st.ad.DecodeBit(&st.gr[tmpl.tpContext])
+		}
+		row := bm.row(y)
+		ry := y - p.dy
+		for x := range row {
+			rx := x - p.dx
+			if ltp == 1 {
+				// Typical prediction: the pixel equals the reference if its neighbourhood is uniform.
+				v := ref.get(rx, ry)
+				uniform := true
+				for j := -1; j <= 1 && uniform; j++ {
+					for i := -1; i <= 1; i++ {
+						if ref.get(rx+i, ry+j) != v {
+							uniform = false
+							break
+						}
+					}
+				}
+				if uniform {
+					row[x] = byte(v)
+					continue
+				}
+			}
+
+			cx := 0
+			for _, px := range tmpl.coding {
+				cx |= bm.get(x+px.x, y+px.y) << px.bit
+			}
+			for _, px := range tmpl.reference {
+				cx |= ref.get(rx+px.x, ry+px.y) << px.bit
+			}
+			if p.template == 0 {
+				cx |= bm.get(x+p.at[0].x, y+p.at[0].y) << tmpl.atBits[0]
+				cx |= ref.get(rx+p.at[1].x, ry+p.at[1].y) << tmpl.atBits[1]
+			}
+			row[x] = byte(st.ad.DecodeBit(&st.gr[cx]))
+		}
+	}
+	return bm
+}
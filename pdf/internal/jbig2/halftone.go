@@ -0,0 +1,162 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jbig2
+
+// decodePatternDictionary decodes a pattern dictionary segment (6.7 and 7.4.4).  Returns the patterns.
+func (d *decoder) decodePatternDictionary(seg *segment) ([]*bitmap, error) {
+	r := &byteReader{data: seg.data}
+	flags := r.u8()
+	mmr := flags&1 != 0
+	template := (flags >> 1) & 3
+	width := r.u8()
+	height := r.u8()
+	grayMax := r.u32()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if width == 0 || height == 0 || grayMax < 0 {
+		return nil, ErrInvalidData
+	}
+	if err := checkSize((grayMax+1)*width, height); err != nil {
+		return nil, err
+	}
+
+	// The patterns are decoded as a single collective bitmap.
+	numPatterns := grayMax + 1
+	data := seg.data[r.pos:]
+	var collective *bitmap
+	if mmr {
+		var err error
+		collective, _, err = decodeGenericMMR(data, numPatterns*width, height)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		at := []point{{-width, 0}, {-3, -1}, {2, -2}, {-2, -2}}
+		collective = decodeGeneric(newArithState(data), &genericParams{
+			width:    numPatterns * width,
+			height:   height,
+			template: template,
+			at:       at[:len(defaultGenericAT(template))],
+		})
+	}
+
+	patterns := make([]*bitmap, numPatterns)
+	for i := range patterns {
+		patterns[i] = collective.subBitmap(i*width, width)
+	}
+	return patterns, nil
+}
+
+// decodeHalftoneRegion decodes a halftone region segment (6.6 and 7.4.5).
+func (d *decoder) decodeHalftoneRegion(seg *segment) (*region, error) {
+	r := &byteReader{data: seg.data}
+	info := r.regionInfo()
+	flags := r.u8()
+	mmr := flags&1 != 0
+	template := (flags >> 1) & 3
+	enableSkip := flags&8 != 0
+	combOp := (flags >> 4) & 7
+	defPixel := byte((flags >> 7) & 1)
+	gridWidth := r.u32()
+	gridHeight := r.u32()
+	gridX := int(int32(r.u32()))
+	gridY := int(int32(r.u32()))
+	stepX := r.u16()
+	stepY := r.u16()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if err := checkSize(info.width, info.height); err != nil {
+		return nil, err
+	}
+	if err := checkSize(gridWidth, gridHeight); err != nil {
+		return nil, err
+	}
+
+	var patterns []*bitmap
+	for _, ref := range seg.refs {
+		if res, has := d.results[ref]; has && res.patterns != nil {
+			patterns = res.patterns
+			break
+		}
+	}
+	if len(patterns) == 0 {
+		return nil, ErrMissingSegment
+	}
+	patWidth, patHeight := patterns[0].width, patterns[0].height
+
+	bm := newBitmap(info.width, info.height, defPixel)
+	position := func(mg, ng int) (int, int) {
+		x := (gridX + mg*stepY + ng*stepX) >> 8
+		y := (gridY + mg*stepX - ng*stepY) >> 8
+		return x, y
+	}
+
+	// Skip grid cells whose patterns lie entirely outside of the region (6.6.5.1).
+	var skip *bitmap
+	if enableSkip {
+		skip = newBitmap(gridWidth, gridHeight, 0)
+		for mg := 0; mg < gridHeight; mg++ {
+			for ng := 0; ng < gridWidth; ng++ {
+				x, y := position(mg, ng)
+				if x+patWidth <= 0 || x >= info.width || y+patHeight <= 0 || y >= info.height {
+					skip.set(ng, mg, 1)
+				}
+			}
+		}
+	}
+
+	// Gray-scale image decoding (Annex C.5): the bitplanes are gray coded, most significant first.
+	bpp := log2Ceil(len(patterns))
+	data := seg.data[r.pos:]
+	st := newArithState(data)
+	gp := &genericParams{
+		width:    gridWidth,
+		height:   gridHeight,
+		template: template,
+		skip:     skip,
+		at:       defaultGenericAT(template),
+	}
+	values := make([]int, gridWidth*gridHeight)
+	var prev *bitmap
+	for j := int(bpp) - 1; j >= 0; j-- {
+		var plane *bitmap
+		if mmr {
+			var n int
+			var err error
+			plane, n, err = decodeGenericMMR(data, gridWidth, gridHeight)
+			if err != nil {
+				return nil, err
+			}
+			data = data[n:]
+		} else {
+			plane = decodeGeneric(st, gp)
+		}
+		if prev != nil {
+			for i := range plane.data {
+				plane.data[i] ^= prev.data[i]
+			}
+		}
+		for i, v := range plane.data {
+			values[i] |= int(v) << uint(j)
+		}
+		prev = plane
+	}
+
+	// Render the patterns on the grid (6.6.5.2).
+	for mg := 0; mg < gridHeight; mg++ {
+		for ng := 0; ng < gridWidth; ng++ {
+			v := values[mg*gridWidth+ng]
+			if v >= len(patterns) {
+				v = len(patterns) - 1
+			}
+			x, y := position(mg, ng)
+			bm.combine(patterns[v], x, y, combOp)
+		}
+	}
+	return &region{info: info, bitmap: bm}, nil
+}
@@ -0,0 +1,465 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jbig2
+
+// bitReader reads bits MSB first from a byte slice.
+type bitReader struct {
+	data []byte
+	pos  int // Position in bits.
+}
+
+// readBit reads a single bit.
+func (r *bitReader) readBit() (int, error) {
+	if r.pos>>3 >= len(r.data) {
+		return 0, ErrUnexpectedEOF
+	}
+	bit := int(r.data[r.pos>>3]>>uint(7-r.pos&7)) & 1
+	r.pos++
+	return bit, nil
+}
+
+// readBits reads an unsigned integer of `n` bits (n <= 32).
+func (r *bitReader) readBits(n int) (int, error) {
+	v := 0
+	for i := 0; i < n; i++ {
+		bit, err := r.readBit()
+		if err != nil {
+			return 0, err
+		}
+		v = (v << 1) | bit
+	}
+	return v, nil
+}
+
+// align moves to the next byte boundary.
+func (r *bitReader) align() {
+	r.pos = (r.pos + 7) &^ 7
+}
+
+// bytePos returns the current byte position, the reader must be aligned.
+func (r *bitReader) bytePos() int {
+	return r.pos >> 3
+}
+
+// Kinds of Huffman table lines.
+const (
+	lineNormal = iota
+	lineLower  // Lower range line: values below the range low value.
+	lineUpper  // Upper range line: values from the range low value upwards.
+	lineOOB    // Out-of-band value.
+)
+
+// huffLine is a line of a Huffman table (Annex B.2).
+type huffLine struct {
+	rangeLow int
+	prefLen  int
+	rangeLen int
+	kind     int
+}
+
+// huffNode is a node of the Huffman decoding tree.  Leaves have a non-nil line.
+type huffNode struct {
+	child [2]*huffNode
+	line  *huffLine
+}
+
+// huffTable is a Huffman decoding table.
+type huffTable struct {
+	root huffNode
+}
+
+// newHuffTable assigns prefix codes to the table lines (Annex B.3) and builds the decoding table.
+func newHuffTable(lines []huffLine) (*huffTable, error) {
+	maxLen := 0
+	for _, l := range lines {
+		if l.prefLen > maxLen {
+			maxLen = l.prefLen
+		}
+	}
+	lenCount := make([]int, maxLen+1)
+	for _, l := range lines {
+		lenCount[l.prefLen]++
+	}
+	lenCount[0] = 0
+
+	table := &huffTable{}
+	firstCode := 0
+	for curLen := 1; curLen <= maxLen; curLen++ {
+		firstCode = (firstCode + lenCount[curLen-1]) << 1
+		curCode := firstCode
+		for i := range lines {
+			if lines[i].prefLen != curLen {
+				continue
+			}
+			if err := table.insert(&lines[i], curCode); err != nil {
+				return nil, err
+			}
+			curCode++
+		}
+	}
+	return table, nil
+}
+
+// insert adds the prefix code `code` for `line` to the decoding tree.
+func (t *huffTable) insert(line *huffLine, code int) error {
+	node := &t.root
+	for i := line.prefLen - 1; i >= 0; i-- {
+		if node.line != nil {
+			return ErrInvalidHuffmanTable
+		}
+		bit := (code >> uint(i)) & 1
+		if node.child[bit] == nil {
+			node.child[bit] = &huffNode{}
+		}
+		node = node.child[bit]
+	}
+	if node.line != nil || node.child[0] != nil || node.child[1] != nil {
+		return ErrInvalidHuffmanTable
+	}
+	node.line = line
+	return nil
+}
+
+// decode decodes a value with the table.  Returns false for the out-of-band value (OOB).
+func (t *huffTable) decode(r *bitReader) (int, bool, error) {
+	node := &t.root
+	for node.line == nil {
+		bit, err := r.readBit()
+		if err != nil {
+			return 0, false, err
+		}
+		node = node.child[bit]
+		if node == nil {
+			return 0, false, ErrInvalidHuffmanCode
+		}
+	}
+
+	line := node.line
+	if line.kind == lineOOB {
+		return 0, false, nil
+	}
+	offset, err := r.readBits(line.rangeLen)
+	if err != nil {
+		return 0, false, err
+	}
+	if line.kind == lineLower {
+		return line.rangeLow - offset, true, nil
+	}
+	return line.rangeLow + offset, true, nil
+}
+
+// decodeValue decodes a value, treating the out-of-band value as an error.
+func (t *huffTable) decodeValue(r *bitReader) (int, error) {
+	v, ok, err := t.decode(r)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ErrInvalidData
+	}
+	return v, nil
+}
+
+// parseHuffTable parses the data of a tables segment (7.4.13), i.e. a custom Huffman table
+// (Annex B.2).
+func parseHuffTable(data []byte) (*huffTable, error) {
+	if len(data) < 9 {
+		return nil, ErrUnexpectedEOF
+	}
+	flags := data[0]
+	htoob := flags&1 != 0
+	htps := int((flags>>1)&7) + 1
+	htrs := int((flags>>4)&7) + 1
+	low := int(int32(be32(data[1:])))
+	high := int(int32(be32(data[5:])))
+
+	r := &bitReader{data: data[9:]}
+	var lines []huffLine
+	for cur := low; cur < high; {
+		prefLen, err := r.readBits(htps)
+		if err != nil {
+			return nil, err
+		}
+		rangeLen, err := r.readBits(htrs)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, huffLine{rangeLow: cur, prefLen: prefLen, rangeLen: rangeLen})
+		cur += 1 << uint(rangeLen)
+	}
+
+	prefLen, err := r.readBits(htps)
+	if err != nil {
+		return nil, err
+	}
+	lines = append(lines, huffLine{rangeLow: low - 1, prefLen: prefLen, rangeLen: 32, kind: lineLower})
+
+	prefLen, err = r.readBits(htps)
+	if err != nil {
+		return nil, err
+	}
+	lines = append(lines, huffLine{rangeLow: high, prefLen: prefLen, rangeLen: 32, kind: lineUpper})
+
+	if htoob {
+		prefLen, err = r.readBits(htps)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, huffLine{prefLen: prefLen, kind: lineOOB})
+	}
+	return newHuffTable(lines)
+}
+
+// standardTableLines are the lines of the standard Huffman tables B.1 to B.15 (Annex B.5).
+var standardTableLines = [15][]huffLine{
+	// B.1
+	{
+		{0, 1, 4, lineNormal},
+		{16, 2, 8, lineNormal},
+		{272, 3, 16, lineNormal},
+		{65808, 3, 32, lineUpper},
+	},
+	// B.2
+	{
+		{0, 1, 0, lineNormal},
+		{1, 2, 0, lineNormal},
+		{2, 3, 0, lineNormal},
+		{3, 4, 3, lineNormal},
+		{11, 5, 6, lineNormal},
+		{75, 6, 32, lineUpper},
+		{0, 6, 0, lineOOB},
+	},
+	// B.3
+	{
+		{-256, 8, 8, lineNormal},
+		{0, 1, 0, lineNormal},
+		{1, 2, 0, lineNormal},
+		{2, 3, 0, lineNormal},
+		{3, 4, 3, lineNormal},
+		{11, 5, 6, lineNormal},
+		{-257, 8, 32, lineLower},
+		{75, 7, 32, lineUpper},
+		{0, 6, 0, lineOOB},
+	},
+	// B.4
+	{
+		{1, 1, 0, lineNormal},
+		{2, 2, 0, lineNormal},
+		{3, 3, 0, lineNormal},
+		{4, 4, 3, lineNormal},
+		{12, 5, 6, lineNormal},
+		{76, 5, 32, lineUpper},
+	},
+	// B.5
+	{
+		{-255, 7, 8, lineNormal},
+		{1, 1, 0, lineNormal},
+		{2, 2, 0, lineNormal},
+		{3, 3, 0, lineNormal},
+		{4, 4, 3, lineNormal},
+		{12, 5, 6, lineNormal},
+		{-256, 7, 32, lineLower},
+		{76, 6, 32, lineUpper},
+	},
+	// B.6
+	{
+		{-2048, 5, 10, lineNormal},
+		{-1024, 4, 9, lineNormal},
+		{-512, 4, 8, lineNormal},
+		{-256, 4, 7, lineNormal},
+		{-128, 5, 6, lineNormal},
+		{-64, 5, 5, lineNormal},
+		{-32, 4, 5, lineNormal},
+		{0, 2, 7, lineNormal},
+		{128, 3, 7, lineNormal},
+		{256, 3, 8, lineNormal},
+		{512, 4, 9, lineNormal},
+		{1024, 4, 10, lineNormal},
+		{-2049, 6, 32, lineLower},
+		{2048, 6, 32, lineUpper},
+	},
+	// B.7
+	{
+		{-1024, 4, 9, lineNormal},
+		{-512, 3, 8, lineNormal},
+		{-256, 4, 7, lineNormal},
+		{-128, 5, 6, lineNormal},
+		{-64, 5, 5, lineNormal},
+		{-32, 4, 5, lineNormal},
+		{0, 4, 5, lineNormal},
+		{32, 5, 5, lineNormal},
+		{64, 5, 6, lineNormal},
+		{128, 4, 7, lineNormal},
+		{256, 3, 8, lineNormal},
+		{512, 3, 9, lineNormal},
+		{1024, 3, 10, lineNormal},
+		{-1025, 5, 32, lineLower},
+		{2048, 5, 32, lineUpper},
+	},
+	// B.8
+	{
+		{-15, 8, 3, lineNormal},
+		{-7, 9, 1, lineNormal},
+		{-5, 8, 1, lineNormal},
+		{-3, 9, 0, lineNormal},
+		{-2, 7, 0, lineNormal},
+		{-1, 4, 0, lineNormal},
+		{0, 2, 1, lineNormal},
+		{2, 5, 0, lineNormal},
+		{3, 6, 0, lineNormal},
+		{4, 3, 4, lineNormal},
+		{20, 6, 1, lineNormal},
+		{22, 4, 4, lineNormal},
+		{38, 4, 5, lineNormal},
+		{70, 5, 6, lineNormal},
+		{134, 5, 7, lineNormal},
+		{262, 6, 7, lineNormal},
+		{390, 7, 8, lineNormal},
+		{646, 6, 10, lineNormal},
+		{-16, 9, 32, lineLower},
+		{1670, 9, 32, lineUpper},
+		{0, 2, 0, lineOOB},
+	},
+	// B.9
+	{
+		{-31, 8, 4, lineNormal},
+		{-15, 9, 2, lineNormal},
+		{-11, 8, 2, lineNormal},
+		{-7, 9, 1, lineNormal},
+		{-5, 7, 1, lineNormal},
+		{-3, 4, 1, lineNormal},
+		{-1, 3, 1, lineNormal},
+		{1, 3, 1, lineNormal},
+		{3, 5, 1, lineNormal},
+		{5, 6, 1, lineNormal},
+		{7, 3, 5, lineNormal},
+		{39, 6, 2, lineNormal},
+		{43, 4, 5, lineNormal},
+		{75, 4, 6, lineNormal},
+		{139, 5, 7, lineNormal},
+		{267, 5, 8, lineNormal},
+		{523, 6, 8, lineNormal},
+		{779, 7, 9, lineNormal},
+		{1291, 6, 11, lineNormal},
+		{-32, 9, 32, lineLower},
+		{3339, 9, 32, lineUpper},
+		{0, 2, 0, lineOOB},
+	},
+	// B.10
+	{
+		{-21, 7, 4, lineNormal},
+		{-5, 8, 0, lineNormal},
+		{-4, 7, 0, lineNormal},
+		{-3, 5, 0, lineNormal},
+		{-2, 2, 2, lineNormal},
+		{2, 5, 0, lineNormal},
+		{3, 6, 0, lineNormal},
+		{4, 7, 0, lineNormal},
+		{5, 8, 0, lineNormal},
+		{6, 2, 6, lineNormal},
+		{70, 5, 5, lineNormal},
+		{102, 6, 5, lineNormal},
+		{134, 6, 6, lineNormal},
+		{198, 6, 7, lineNormal},
+		{326, 6, 8, lineNormal},
+		{582, 6, 9, lineNormal},
+		{1094, 6, 10, lineNormal},
+		{2118, 7, 11, lineNormal},
+		{-22, 8, 32, lineLower},
+		{4166, 8, 32, lineUpper},
+		{0, 2, 0, lineOOB},
+	},
+	// B.11
+	{
+		{1, 1, 0, lineNormal},
+		{2, 2, 1, lineNormal},
+		{4, 4, 0, lineNormal},
+		{5, 4, 1, lineNormal},
+		{7, 5, 1, lineNormal},
+		{9, 5, 2, lineNormal},
+		{13, 6, 2, lineNormal},
+		{17, 7, 2, lineNormal},
+		{21, 7, 3, lineNormal},
+		{29, 7, 4, lineNormal},
+		{45, 7, 5, lineNormal},
+		{77, 7, 6, lineNormal},
+		{141, 7, 32, lineUpper},
+	},
+	// B.12
+	{
+		{1, 1, 0, lineNormal},
+		{2, 2, 0, lineNormal},
+		{3, 3, 1, lineNormal},
+		{5, 5, 0, lineNormal},
+		{6, 5, 1, lineNormal},
+		{8, 6, 1, lineNormal},
+		{10, 7, 0, lineNormal},
+		{11, 7, 1, lineNormal},
+		{13, 7, 2, lineNormal},
+		{17, 7, 3, lineNormal},
+		{25, 7, 4, lineNormal},
+		{41, 8, 5, lineNormal},
+		{73, 8, 32, lineUpper},
+	},
+	// B.13
+	{
+		{1, 1, 0, lineNormal},
+		{2, 3, 0, lineNormal},
+		{3, 4, 0, lineNormal},
+		{4, 5, 0, lineNormal},
+		{5, 4, 1, lineNormal},
+		{7, 3, 3, lineNormal},
+		{15, 6, 1, lineNormal},
+		{17, 6, 2, lineNormal},
+		{21, 6, 3, lineNormal},
+		{29, 6, 4, lineNormal},
+		{45, 6, 5, lineNormal},
+		{77, 7, 6, lineNormal},
+		{141, 7, 32, lineUpper},
+	},
+	// B.14
+	{
+		{-2, 3, 0, lineNormal},
+		{-1, 3, 0, lineNormal},
+		{0, 1, 0, lineNormal},
+		{1, 3, 0, lineNormal},
+		{2, 3, 0, lineNormal},
+	},
+	// B.15
+	{
+		{-24, 7, 4, lineNormal},
+		{-8, 6, 2, lineNormal},
+		{-4, 5, 1, lineNormal},
+		{-2, 4, 0, lineNormal},
+		{-1, 3, 0, lineNormal},
+		{0, 1, 0, lineNormal},
+		{1, 3, 0, lineNormal},
+		{2, 4, 0, lineNormal},
+		{3, 5, 1, lineNormal},
+		{5, 6, 2, lineNormal},
+		{9, 7, 4, lineNormal},
+		{-25, 7, 32, lineLower},
+		{25, 7, 32, lineUpper},
+	},
+}
+
+// standardTables are the decoding tables built from standardTableLines.
+var standardTables = func() (tables [15]*huffTable) {
+	for i, lines := range standardTableLines {
+		table, err := newHuffTable(lines)
+		if err != nil {
+			// Cannot happen, the standard tables are valid.
+			panic(err)
+		}
+		tables[i] = table
+	}
+	return tables
+}()
+
+// standardTable returns standard Huffman table B.n.
+func standardTable(n int) *huffTable {
+	return standardTables[n-1]
+}
@@ -0,0 +1,473 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package jbig2 implements a decoder for JBIG2 (ITU-T T.88) encoded bi-level images in the embedded
+// stream format used by the JBIG2Decode filter in PDF.
+//
+// Generic, generic refinement, text and halftone regions are supported, with both arithmetic and
+// Huffman/MMR coding, as well as symbol and pattern dictionaries and custom Huffman tables, which
+// may be shared between images through a global segments stream (JBIG2Globals).
+package jbig2
+
+import (
+	"errors"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+var (
+	// ErrInvalidData is returned when the encoded data is invalid or inconsistent.
+	ErrInvalidData = errors.New("jbig2: invalid data")
+	// ErrUnexpectedEOF is returned when the encoded data ends prematurely.
+	ErrUnexpectedEOF = errors.New("jbig2: unexpected end of data")
+	// ErrInvalidHuffmanTable is returned for invalid or missing Huffman tables.
+	ErrInvalidHuffmanTable = errors.New("jbig2: invalid huffman table")
+	// ErrInvalidHuffmanCode is returned when the data contains an invalid Huffman code.
+	ErrInvalidHuffmanCode = errors.New("jbig2: invalid huffman code")
+	// ErrMissingSegment is returned when a segment refers to a segment that is not present.
+	ErrMissingSegment = errors.New("jbig2: missing referred-to segment")
+	// ErrNoPage is returned when the data does not contain a page.
+	ErrNoPage = errors.New("jbig2: no page information")
+	// ErrTooLarge is returned when a page or region exceeds maxPixels pixels.
+	ErrTooLarge = errors.New("jbig2: image too large")
+)
+
+// maxPixels limits the size of the bitmaps, with one byte per pixel, to guard against corrupt
+// headers.
+const maxPixels = 1 << 28
+
+// checkSize returns an error if a bitmap of `width` by `height` pixels has a negative size or
+// more than maxPixels pixels.
+func checkSize(width, height int) error {
+	if width < 0 || height < 0 {
+		return ErrInvalidData
+	}
+	if int64(width)*int64(height) > maxPixels {
+		common.Log.Debug("JBIG2: bitmap too large (%dx%d)", width, height)
+		return ErrTooLarge
+	}
+	return nil
+}
+
+// Image is a decoded JBIG2 page.
+type Image struct {
+	Width  int
+	Height int
+
+	// Data holds the packed image rows, 1 bit per pixel with each row padded to a byte boundary.
+	// As in PDF, 0 bits represent black pixels and 1 bits white pixels.
+	Data []byte
+}
+
+// Decode decodes the first page of the JBIG2 embedded stream `data`.  `globals` holds the global
+// segments shared between images (JBIG2Globals) and may be nil.
+func Decode(data, globals []byte) (*Image, error) {
+	d := &decoder{results: map[uint32]*result{}}
+
+	if len(globals) > 0 {
+		segments, err := parseSegments(globals)
+		if err != nil {
+			common.Log.Debug("JBIG2: invalid globals: %v", err)
+			return nil, err
+		}
+		if err := d.process(segments); err != nil {
+			return nil, err
+		}
+	}
+
+	segments, err := parseSegments(data)
+	if err != nil {
+		common.Log.Debug("JBIG2: invalid segments: %v", err)
+		return nil, err
+	}
+	if err := d.process(segments); err != nil {
+		return nil, err
+	}
+
+	if d.page == nil {
+		return nil, ErrNoPage
+	}
+	img := &Image{
+		Width:  d.page.width,
+		Height: d.page.height,
+		Data:   d.page.pack(true),
+	}
+	return img, nil
+}
+
+// result is the decoded result of a segment that may be referred to by later segments.
+type result struct {
+	symbols  []*bitmap  // Symbol dictionary.
+	patterns []*bitmap  // Pattern dictionary.
+	table    *huffTable // Custom Huffman table.
+	region   *region    // Intermediate region.
+}
+
+// decoder holds the state of decoding a page.
+type decoder struct {
+	results map[uint32]*result
+
+	page        *bitmap
+	pageNumber  uint32
+	pageDefault byte // Default pixel value.
+	pageStriped bool // Page height is unknown and determined by the end of stripe segments.
+	pageDone    bool
+}
+
+// process decodes `segments` in order.
+func (d *decoder) process(segments []*segment) error {
+	for _, seg := range segments {
+		if d.pageDone {
+			break
+		}
+		if seg.page != 0 && d.page != nil && seg.page != d.pageNumber {
+			// Only the first page is decoded.
+			continue
+		}
+		if err := d.processSegment(seg); err != nil {
+			common.Log.Debug("JBIG2: error decoding segment %d (type %d): %v", seg.number, seg.kind, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// processSegment decodes a single segment.
+func (d *decoder) processSegment(seg *segment) error {
+	switch seg.kind {
+	case segSymbolDictionary:
+		symbols, err := d.decodeSymbolDictionary(seg)
+		if err != nil {
+			return err
+		}
+		d.results[seg.number] = &result{symbols: symbols}
+
+	case segPatternDictionary:
+		patterns, err := d.decodePatternDictionary(seg)
+		if err != nil {
+			return err
+		}
+		d.results[seg.number] = &result{patterns: patterns}
+
+	case segTables:
+		table, err := parseHuffTable(seg.data)
+		if err != nil {
+			return err
+		}
+		d.results[seg.number] = &result{table: table}
+
+	case segIntermediateText, segImmediateText, segImmediateLosslessText:
+		reg, err := d.decodeTextRegion(seg)
+		if err != nil {
+			return err
+		}
+		return d.storeRegion(seg, reg, seg.kind == segIntermediateText)
+
+	case segIntermediateHalftone, segImmediateHalftone, segImmediateLosslessHalftone:
+		reg, err := d.decodeHalftoneRegion(seg)
+		if err != nil {
+			return err
+		}
+		return d.storeRegion(seg, reg, seg.kind == segIntermediateHalftone)
+
+	case segIntermediateGeneric, segImmediateGeneric, segImmediateLosslessGeneric:
+		reg, err := d.decodeGenericRegion(seg)
+		if err != nil {
+			return err
+		}
+		return d.storeRegion(seg, reg, seg.kind == segIntermediateGeneric)
+
+	case segIntermediateRefinement, segImmediateRefinement, segImmediateLosslessRefinement:
+		reg, err := d.decodeRefinementRegion(seg)
+		if err != nil {
+			return err
+		}
+		return d.storeRegion(seg, reg, seg.kind == segIntermediateRefinement)
+
+	case segPageInformation:
+		if d.page != nil {
+			// Start of the next page.
+			d.pageDone = true
+			return nil
+		}
+		return d.startPage(seg)
+
+	case segEndOfStripe:
+		r := &byteReader{data: seg.data}
+		endRow := r.u32()
+		if r.err != nil {
+			return r.err
+		}
+		if d.page != nil && d.pageStriped {
+			if err := checkSize(d.page.width, endRow+1); err != nil {
+				return err
+			}
+			d.page.grow(endRow+1, d.pageDefault)
+		}
+
+	case segEndOfPage, segEndOfFile:
+		d.pageDone = d.page != nil
+
+	default:
+		common.Log.Trace("JBIG2: skipping segment %d of type %d", seg.number, seg.kind)
+	}
+	return nil
+}
+
+// startPage processes a page information segment (7.4.8).
+func (d *decoder) startPage(seg *segment) error {
+	r := &byteReader{data: seg.data}
+	width := r.u32()
+	height := r.u32()
+	r.next(8) // Resolution.
+	flags := r.u8()
+	if r.err != nil {
+		return r.err
+	}
+
+	d.pageStriped = height == unknownLength
+	if d.pageStriped {
+		height = 0
+	}
+	if width <= 0 || width > 1<<24 || height < 0 || height > 1<<24 {
+		return ErrInvalidData
+	}
+	if err := checkSize(width, height); err != nil {
+		return err
+	}
+	d.pageDefault = byte((flags >> 2) & 1)
+	d.page = newBitmap(width, height, d.pageDefault)
+	d.pageNumber = seg.page
+	return nil
+}
+
+// storeRegion keeps an intermediate region for later reference, or draws an immediate region on the
+// page.
+func (d *decoder) storeRegion(seg *segment, reg *region, intermediate bool) error {
+	if intermediate {
+		d.results[seg.number] = &result{region: reg}
+		return nil
+	}
+	if d.page == nil {
+		return ErrNoPage
+	}
+	info := reg.info
+	if d.pageStriped {
+		if err := checkSize(d.page.width, info.y+reg.bitmap.height); err != nil {
+			return err
+		}
+		d.page.grow(info.y+reg.bitmap.height, d.pageDefault)
+	}
+	d.page.combine(reg.bitmap, info.x, info.y, info.combOp)
+	return nil
+}
+
+// referredSymbolsAndTables returns the symbols exported by the symbol dictionaries and the custom
+// Huffman tables that `seg` refers to, in order.
+func (d *decoder) referredSymbolsAndTables(seg *segment) ([]*bitmap, []*huffTable) {
+	var symbols []*bitmap
+	var tables []*huffTable
+	for _, ref := range seg.refs {
+		res, has := d.results[ref]
+		if !has {
+			continue
+		}
+		symbols = append(symbols, res.symbols...)
+		if res.table != nil {
+			tables = append(tables, res.table)
+		}
+	}
+	return symbols, tables
+}
+
+// decodeGenericRegion decodes a generic region segment (7.4.6).
+func (d *decoder) decodeGenericRegion(seg *segment) (*region, error) {
+	r := &byteReader{data: seg.data}
+	info := r.regionInfo()
+	flags := r.u8()
+	mmr := flags&1 != 0
+	template := (flags >> 1) & 3
+	tpgdon := flags&8 != 0
+	var at []point
+	if !mmr {
+		for range defaultGenericAT(template) {
+			at = append(at, point{r.s8(), r.s8()})
+		}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	data := seg.data[r.pos:]
+	if seg.unknownLength {
+		// The data is followed by the actual row count.
+		if len(data) < 4 {
+			return nil, ErrUnexpectedEOF
+		}
+		info.height = int(be32(data[len(data)-4:]))
+		data = data[:len(data)-4]
+	}
+	if info.width <= 0 || info.height < 0 || info.width > 1<<24 || info.height > 1<<24 {
+		return nil, ErrInvalidData
+	}
+	if err := checkSize(info.width, info.height); err != nil {
+		return nil, err
+	}
+
+	var bm *bitmap
+	if mmr {
+		var err error
+		bm, _, err = decodeGenericMMR(data, info.width, info.height)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		bm = decodeGeneric(newArithState(data), &genericParams{
+			width:    info.width,
+			height:   info.height,
+			template: template,
+			tpgdon:   tpgdon,
+			at:       at,
+		})
+	}
+	return &region{info: info, bitmap: bm}, nil
+}
+
+// decodeRefinementRegion decodes a generic refinement region segment (7.4.7).
+func (d *decoder) decodeRefinementRegion(seg *segment) (*region, error) {
+	r := &byteReader{data: seg.data}
+	info := r.regionInfo()
+	flags := r.u8()
+	template := flags & 1
+	tpgron := flags&2 != 0
+	at := [2]point{{-1, -1}, {-1, -1}}
+	if template == 0 {
+		at = [2]point{{r.s8(), r.s8()}, {r.s8(), r.s8()}}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if err := checkSize(info.width, info.height); err != nil {
+		return nil, err
+	}
+
+	// The reference is an intermediate region, or otherwise the corresponding area of the page.
+	var reference *bitmap
+	for _, ref := range seg.refs {
+		if res, has := d.results[ref]; has && res.region != nil {
+			reference = res.region.bitmap
+			break
+		}
+	}
+	if reference == nil {
+		if d.page == nil {
+			return nil, ErrMissingSegment
+		}
+		reference = newBitmap(info.width, info.height, 0)
+		reference.combine(d.page, -info.x, -info.y, combineReplace)
+	}
+
+	bm := decodeRefinement(newArithState(seg.data[r.pos:]), &refinementParams{
+		width:     info.width,
+		height:    info.height,
+		template:  template,
+		reference: reference,
+		tpgron:    tpgron,
+		at:        at,
+	})
+	return &region{info: info, bitmap: bm}, nil
+}
+
+// decodeTextRegion decodes a text region segment (7.4.3).
+func (d *decoder) decodeTextRegion(seg *segment) (*region, error) {
+	r := &byteReader{data: seg.data}
+	info := r.regionInfo()
+	flags := r.u16()
+
+	p := &textParams{
+		huff:       flags&1 != 0,
+		refine:     flags&2 != 0,
+		width:      info.width,
+		height:     info.height,
+		logStrips:  uint((flags >> 2) & 3),
+		refCorner:  (flags >> 4) & 3,
+		transposed: flags&0x40 != 0,
+		combOp:     (flags >> 7) & 3,
+		defPixel:   byte((flags >> 9) & 1),
+		dsOffset:   (flags >> 10) & 0x1F,
+		rtemplate:  (flags >> 15) & 1,
+		rat:        [2]point{{-1, -1}, {-1, -1}},
+	}
+	if p.dsOffset > 15 {
+		p.dsOffset -= 32
+	}
+	var huffFlags int
+	if p.huff {
+		huffFlags = r.u16()
+	}
+	if p.refine && p.rtemplate == 0 {
+		p.rat = [2]point{{r.s8(), r.s8()}, {r.s8(), r.s8()}}
+	}
+	p.numInstances = r.u32()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if info.width < 0 || info.height < 0 || info.width > 1<<24 || info.height > 1<<24 || p.numInstances < 0 {
+		return nil, ErrInvalidData
+	}
+	if err := checkSize(info.width, info.height); err != nil {
+		return nil, err
+	}
+
+	symbols, custom := d.referredSymbolsAndTables(seg)
+	p.symbols = symbols
+	data := seg.data[r.pos:]
+	hr := &bitReader{data: data}
+	st := newArithState(data)
+
+	if p.huff {
+		// Table selection (7.4.3.1.2).
+		selections := []struct {
+			table    **huffTable
+			shift    uint
+			standard []int
+		}{
+			{&p.fs, 0, []int{6, 7, -1}},
+			{&p.ds, 2, []int{8, 9, 10}},
+			{&p.dt, 4, []int{11, 12, 13}},
+			{&p.rdw, 6, []int{14, 15, -1}},
+			{&p.rdh, 8, []int{14, 15, -1}},
+			{&p.rdx, 10, []int{14, 15, -1}},
+			{&p.rdy, 12, []int{14, 15, -1}},
+			{&p.rsize, 14, []int{1}},
+		}
+		for _, sel := range selections {
+			mask := 3
+			if sel.shift == 14 {
+				mask = 1
+			}
+			table, err := selectTable((huffFlags>>sel.shift)&mask, sel.standard, &custom)
+			if err != nil {
+				return nil, err
+			}
+			*sel.table = table
+		}
+
+		codes, err := parseSymbolCodes(hr, len(symbols))
+		if err != nil {
+			return nil, err
+		}
+		p.symCodes = codes
+	} else {
+		p.symCodeLen = log2Ceil(len(symbols))
+		st.iaid = newIDDecoder(p.symCodeLen)
+	}
+
+	bm, err := decodeText(st, hr, p)
+	if err != nil {
+		return nil, err
+	}
+	return &region{info: info, bitmap: bm}, nil
+}
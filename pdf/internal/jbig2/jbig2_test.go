@@ -0,0 +1,386 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jbig2
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/internal/ccittfax"
+	"github.com/unidoc/unidoc/pdf/internal/mq/mqtest"
+)
+
+// mqEncoder is an MQ arithmetic encoder with the encoding procedures of the JBIG2 decoding
+// procedures, used for generating test data.
+type mqEncoder struct {
+	*mqtest.Encoder
+}
+
+func newMQEncoder() *mqEncoder {
+	return &mqEncoder{mqtest.NewEncoder()}
+}
+
+// flush terminates the coded data, appending the 0xFF 0xAC end marker.
+func (e *mqEncoder) flush() []byte {
+	return append(e.Flush(), 0xFF, 0xAC)
+}
+
+// encodeInt encodes an integer with the IAx procedure (Annex A.2), `oob` encodes the out-of-band value.
+func (e *mqEncoder) encodeInt(cx []mqtest.Context, v int, oob bool) {
+	prev := 1
+	encodeBit := func(bit int) {
+		e.EncodeBit(&cx[prev], bit)
+		if prev < 256 {
+			prev = (prev << 1) | bit
+		} else {
+			prev = (((prev << 1) | bit) & 511) | 256
+		}
+	}
+	encodeBits := func(v, n int) {
+		for i := n - 1; i >= 0; i-- {
+			encodeBit((v >> uint(i)) & 1)
+		}
+	}
+
+	if oob {
+		// Negative zero.
+		encodeBits(0x2, 2)
+		encodeBits(0, 2)
+		return
+	}
+	s := 0
+	if v < 0 {
+		s, v = 1, -v
+	}
+	encodeBit(s)
+	switch {
+	case v < 4:
+		encodeBits(0, 1)
+		encodeBits(v, 2)
+	case v < 20:
+		encodeBits(0x2, 2)
+		encodeBits(v-4, 4)
+	case v < 84:
+		encodeBits(0x6, 3)
+		encodeBits(v-20, 6)
+	case v < 340:
+		encodeBits(0xE, 4)
+		encodeBits(v-84, 8)
+	case v < 4436:
+		encodeBits(0x1E, 5)
+		encodeBits(v-340, 12)
+	default:
+		encodeBits(0x1F, 5)
+		encodeBits(v-4436, 32)
+	}
+}
+
+// encodeID encodes a symbol ID with the IAID procedure (Annex A.3).
+func (e *mqEncoder) encodeID(cx []mqtest.Context, id int, codeLen uint) {
+	prev := 1
+	for i := int(codeLen) - 1; i >= 0; i-- {
+		bit := (id >> uint(i)) & 1
+		e.EncodeBit(&cx[prev], bit)
+		prev = (prev << 1) | bit
+	}
+}
+
+// encodeGeneric encodes `bm` as a generic region with nominal adaptive template pixels.
+func (e *mqEncoder) encodeGeneric(cx []mqtest.Context, bm *bitmap, template int, tpgdon bool) {
+	pixels := append([]templatePixel{}, genericTemplates[template]...)
+	for i, at := range defaultGenericAT(template) {
+		pixels = append(pixels, templatePixel{at.x, at.y, genericATBits[template][i]})
+	}
+
+	ltp := 0
+	for y := 0; y < bm.height; y++ {
+		if tpgdon {
+			typical := 0
+			if y > 0 && bytes.Equal(bm.row(y), bm.row(y-1)) || y == 0 && bytes.Count(bm.row(0), []byte{0}) == bm.width {
+				typical = 1
+			}
+			e.EncodeBit(&cx[genericTPContexts[template]], typical^ltp)
+			ltp = typical
+			if ltp == 1 {
+				continue
+			}
+		}
+		for x := 0; x < bm.width; x++ {
+			c := 0
+			for _, px := range pixels {
+				c |= bm.get(x+px.x, y+px.y) << px.bit
+			}
+			e.EncodeBit(&cx[c], bm.get(x, y))
+		}
+	}
+}
+
+// segmentBytes returns a segment with a short form header.
+func segmentBytes(number int, kind byte, refs []int, page byte, data []byte) []byte {
+	var b bytes.Buffer
+	b.Write(be32Bytes(number))
+	b.WriteByte(kind)
+	b.WriteByte(byte(len(refs) << 5))
+	for _, ref := range refs {
+		b.WriteByte(byte(ref))
+	}
+	b.WriteByte(page)
+	b.Write(be32Bytes(len(data)))
+	b.Write(data)
+	return b.Bytes()
+}
+
+func be32Bytes(v int) []byte {
+	return []byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+// pageInfo returns page information segment data.
+func pageInfo(width, height int) []byte {
+	data := append(be32Bytes(width), be32Bytes(height)...)
+	data = append(data, make([]byte, 8)...)
+	return append(data, 0, 0, 0)
+}
+
+// regionInfoBytes returns region segment information field data.
+func regionInfoBytes(width, height, x, y int, op byte) []byte {
+	data := append(be32Bytes(width), be32Bytes(height)...)
+	data = append(data, be32Bytes(x)...)
+	data = append(data, be32Bytes(y)...)
+	return append(data, op)
+}
+
+// randomBitmap returns a bitmap with some structure, resembling scanned text.
+func randomBitmap(width, height int, seed int64) *bitmap {
+	rng := rand.New(rand.NewSource(seed))
+	bm := newBitmap(width, height, 0)
+	for i := 0; i < 40; i++ {
+		x0, y0 := rng.Intn(width), rng.Intn(height)
+		w, h := 1+rng.Intn(10), 1+rng.Intn(6)
+		for y := y0; y < y0+h; y++ {
+			for x := x0; x < x0+w; x++ {
+				bm.set(x, y, 1)
+			}
+		}
+	}
+	// Some identical rows for typical prediction.
+	if height > 10 {
+		copy(bm.row(5), bm.row(4))
+		for x := 0; x < width; x++ {
+			bm.set(x, 8, 0)
+			bm.set(x, 9, 0)
+		}
+	}
+	return bm
+}
+
+// Check that the standard Huffman tables are complete prefix codes.
+func TestStandardTables(t *testing.T) {
+	for i, lines := range standardTableLines {
+		// Sum of 2^-prefLen scaled by 2^32.
+		var sum uint64
+		for _, l := range lines {
+			sum += 1 << uint(32-l.prefLen)
+		}
+		if sum != 1<<32 {
+			t.Errorf("Table B.%d is not complete", i+1)
+		}
+	}
+
+	// Decode values from table B.3: -1 is coded as 11111110 11111111 (range -256..-1, offset 255),
+	// 0 as 0, OOB as 111110 and 80 as 1111110 followed by the 32 bit offset 5.
+	r := &bitReader{data: []byte{0xFE, 0xFF, 0x7D, 0xF8, 0, 0, 0, 0x14}}
+	table := standardTable(3)
+	for _, expected := range []int{-1, 0} {
+		v, ok, err := table.decode(r)
+		if err != nil || !ok || v != expected {
+			t.Errorf("B.3: %d %v %v != %d", v, ok, err, expected)
+		}
+	}
+	if _, ok, err := table.decode(r); err != nil || ok {
+		t.Errorf("B.3: expected OOB: %v", err)
+	}
+	if v, ok, err := table.decode(r); err != nil || !ok || v != 80 {
+		t.Errorf("B.3: %d %v %v != 80", v, ok, err)
+	}
+}
+
+// Test decoding arithmetically coded generic regions for all templates.
+func TestGenericRegion(t *testing.T) {
+	width, height := 67, 23
+	bm := randomBitmap(width, height, 1)
+
+	for template := 0; template < 4; template++ {
+		for _, tpgdon := range []bool{false, true} {
+			enc := newMQEncoder()
+			enc.encodeGeneric(make([]mqtest.Context, 1<<16), bm, template, tpgdon)
+			coded := enc.flush()
+
+			flags := byte(template << 1)
+			if tpgdon {
+				flags |= 8
+			}
+			region := append(regionInfoBytes(width, height, 0, 0, combineOr), flags)
+			for _, at := range defaultGenericAT(template) {
+				region = append(region, byte(int8(at.x)), byte(int8(at.y)))
+			}
+			region = append(region, coded...)
+
+			data := segmentBytes(0, segPageInformation, nil, 1, pageInfo(width, height))
+			data = append(data, segmentBytes(1, segImmediateLosslessGeneric, nil, 1, region)...)
+			data = append(data, segmentBytes(2, segEndOfPage, nil, 1, nil)...)
+
+			img, err := Decode(data, nil)
+			if err != nil {
+				t.Fatalf("Template %d: %v", template, err)
+			}
+			if img.Width != width || img.Height != height {
+				t.Fatalf("Template %d: size %dx%d", template, img.Width, img.Height)
+			}
+			if !bytes.Equal(img.Data, bm.pack(true)) {
+				t.Errorf("Template %d, TPGDON %v: decoded image mismatch", template, tpgdon)
+			}
+		}
+	}
+}
+
+// Test decoding an MMR coded generic region of unknown length on a striped page.
+func TestGenericRegionMMR(t *testing.T) {
+	width, height := 45, 17
+	bm := randomBitmap(width, height, 2)
+
+	params := ccittfax.Params{K: -1, Columns: width, BlackIs1: true, EndOfBlock: false}
+	coded, err := ccittfax.Encode(bm.pack(false), params)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	region := append(regionInfoBytes(width, 0xFFFFFFFF, 0, 0, combineOr), 1)
+	region = append(region, coded...)
+	region = append(region, 0, 0)
+	region = append(region, be32Bytes(height)...)
+
+	data := segmentBytes(0, segPageInformation, nil, 1, pageInfo(width, 0xFFFFFFFF))
+	segment := segmentBytes(1, segImmediateGeneric, nil, 1, region)
+	// Unknown data length.
+	copy(segment[7:11], []byte{0xFF, 0xFF, 0xFF, 0xFF})
+	data = append(data, segment...)
+	data = append(data, segmentBytes(2, segEndOfStripe, nil, 1, be32Bytes(height-1))...)
+
+	img, err := Decode(data, nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if img.Height != height {
+		t.Fatalf("Height %d != %d", img.Height, height)
+	}
+	if !bytes.Equal(img.Data, bm.pack(true)) {
+		t.Errorf("Decoded image mismatch")
+	}
+}
+
+// Test decoding a text region using a symbol dictionary from the globals.
+func TestTextRegion(t *testing.T) {
+	sym0 := randomBitmap(5, 7, 3)
+	sym1 := randomBitmap(6, 7, 4)
+
+	// Symbol dictionary: one height class with two symbols, both exported.
+	enc := newMQEncoder()
+	newCX := func() []mqtest.Context { return make([]mqtest.Context, 512) }
+	iadh, iadw, iaex := newCX(), newCX(), newCX()
+	gb := make([]mqtest.Context, 1<<16)
+	enc.encodeInt(iadh, 7, false)
+	enc.encodeInt(iadw, 5, false)
+	enc.encodeGeneric(gb, sym0, 0, false)
+	enc.encodeInt(iadw, 1, false)
+	enc.encodeGeneric(gb, sym1, 0, false)
+	enc.encodeInt(iadw, 0, true)
+	enc.encodeInt(iaex, 0, false)
+	enc.encodeInt(iaex, 2, false)
+	dict := []byte{0x00, 0x00}
+	for _, at := range defaultGenericAT(0) {
+		dict = append(dict, byte(int8(at.x)), byte(int8(at.y)))
+	}
+	dict = append(dict, be32Bytes(2)...)
+	dict = append(dict, be32Bytes(2)...)
+	dict = append(dict, enc.flush()...)
+	globals := segmentBytes(0, segSymbolDictionary, nil, 0, dict)
+
+	// Text region with two strips, placing three symbol instances by their top left corners.
+	width, height := 20, 25
+	enc = newMQEncoder()
+	iadt, iafs, iads, iaid := newCX(), newCX(), newCX(), newCX()
+	enc.encodeInt(iadt, 0, false)
+	// Strip at T = 2: symbols 0 and 1 at S = 1 and 7.
+	enc.encodeInt(iadt, 2, false)
+	enc.encodeInt(iafs, 1, false)
+	enc.encodeID(iaid, 0, 1)
+	enc.encodeInt(iads, 2, false)
+	enc.encodeID(iaid, 1, 1)
+	enc.encodeInt(iads, 0, true)
+	// Strip at T = 12: symbol 1 at S = 4.
+	enc.encodeInt(iadt, 10, false)
+	enc.encodeInt(iafs, 3, false)
+	enc.encodeID(iaid, 1, 1)
+	enc.encodeInt(iads, 0, true)
+
+	textFlags := cornerTopLeft << 4
+	text := regionInfoBytes(width, height, 0, 0, combineOr)
+	text = append(text, byte(textFlags>>8), byte(textFlags))
+	text = append(text, be32Bytes(3)...)
+	text = append(text, enc.flush()...)
+
+	data := segmentBytes(1, segPageInformation, nil, 1, pageInfo(width, height))
+	data = append(data, segmentBytes(2, segImmediateText, []int{0}, 1, text)...)
+	data = append(data, segmentBytes(3, segEndOfPage, nil, 1, nil)...)
+
+	img, err := Decode(data, globals)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	expected := newBitmap(width, height, 0)
+	expected.combine(sym0, 1, 2, combineOr)
+	expected.combine(sym1, 7, 2, combineOr)
+	expected.combine(sym1, 4, 12, combineOr)
+	if !bytes.Equal(img.Data, expected.pack(true)) {
+		t.Errorf("Decoded image mismatch")
+	}
+
+	// Without the globals the symbols are missing.
+	if _, err := Decode(data, nil); err == nil {
+		t.Errorf("Expected an error without globals")
+	}
+}
+
+// Test that pages and regions too large to allocate are rejected.
+func TestImageTooLarge(t *testing.T) {
+	huge := 1 << 24
+	onPage := func(kind byte, region []byte) []byte {
+		data := segmentBytes(0, segPageInformation, nil, 1, pageInfo(16, 16))
+		return append(data, segmentBytes(1, kind, nil, 1, region)...)
+	}
+	halftone := append(regionInfoBytes(16, 16, 0, 0, combineOr), 0)
+	halftone = append(halftone, be32Bytes(huge)...)
+	halftone = append(halftone, be32Bytes(huge)...)
+	halftone = append(halftone, make([]byte, 12)...)
+	patterns := append([]byte{0, 255, 255}, be32Bytes(huge)...)
+
+	tests := map[string][]byte{
+		"Page": segmentBytes(0, segPageInformation, nil, 1, pageInfo(huge, huge)),
+		"Generic region": onPage(segImmediateLosslessGeneric,
+			append(regionInfoBytes(huge, huge, 0, 0, combineOr), 0, 3, 255, 253, 1, 2, 254, 254, 253)),
+		"Refinement region": onPage(segImmediateLosslessRefinement,
+			append(regionInfoBytes(huge, huge, 0, 0, combineOr), 1)),
+		"Halftone grid":      onPage(segImmediateLosslessHalftone, halftone),
+		"Pattern dictionary": onPage(segPatternDictionary, patterns),
+	}
+	for name, data := range tests {
+		if _, err := Decode(data, nil); err != ErrTooLarge {
+			t.Errorf("%s: got error %v", name, err)
+		}
+	}
+}
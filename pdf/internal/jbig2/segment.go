@@ -0,0 +1,212 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jbig2
+
+import (
+	"bytes"
+)
+
+// Segment types (7.3).
+const (
+	segSymbolDictionary            = 0
+	segIntermediateText            = 4
+	segImmediateText               = 6
+	segImmediateLosslessText       = 7
+	segPatternDictionary           = 16
+	segIntermediateHalftone        = 20
+	segImmediateHalftone           = 22
+	segImmediateLosslessHalftone   = 23
+	segIntermediateGeneric         = 36
+	segImmediateGeneric            = 38
+	segImmediateLosslessGeneric    = 39
+	segIntermediateRefinement      = 40
+	segImmediateRefinement         = 42
+	segImmediateLosslessRefinement = 43
+	segPageInformation             = 48
+	segEndOfPage                   = 49
+	segEndOfStripe                 = 50
+	segEndOfFile                   = 51
+	segProfiles                    = 52
+	segTables                      = 53
+	segExtension                   = 62
+)
+
+// unknownLength is the segment data length value indicating that the length is not known in advance.
+const unknownLength = 0xFFFFFFFF
+
+// segment is a JBIG2 segment (7.2).
+type segment struct {
+	number        uint32
+	kind          int
+	refs          []uint32 // Referred-to segment numbers.
+	page          uint32   // Page association.
+	data          []byte
+	unknownLength bool
+}
+
+// regionInfo is the region segment information field (7.4.1).
+type regionInfo struct {
+	width  int
+	height int
+	x, y   int
+	combOp int
+}
+
+// region is a decoded region bitmap with its position.
+type region struct {
+	info   regionInfo
+	bitmap *bitmap
+}
+
+// byteReader reads big-endian values from a byte slice.  Reading past the end sets err and yields 0.
+type byteReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+// next returns the next `n` bytes.
+func (r *byteReader) next(n int) []byte {
+	if r.err != nil || r.pos+n > len(r.data) {
+		r.err = ErrUnexpectedEOF
+		return make([]byte, n)
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *byteReader) u8() int {
+	return int(r.next(1)[0])
+}
+
+func (r *byteReader) s8() int {
+	return int(int8(r.next(1)[0]))
+}
+
+func (r *byteReader) u16() int {
+	b := r.next(2)
+	return int(b[0])<<8 | int(b[1])
+}
+
+func (r *byteReader) u32() int {
+	return int(be32(r.next(4)))
+}
+
+// regionInfo reads a region segment information field.
+func (r *byteReader) regionInfo() regionInfo {
+	var info regionInfo
+	info.width = r.u32()
+	info.height = r.u32()
+	info.x = int(int32(r.u32()))
+	info.y = int(int32(r.u32()))
+	info.combOp = r.u8() & 7
+	return info
+}
+
+func be32(b []byte) uint32 {
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+// parseSegments parses the segments of the embedded (sequential) organisation used in PDF.
+func parseSegments(data []byte) ([]*segment, error) {
+	var segments []*segment
+	r := &byteReader{data: data}
+	for r.pos < len(data) {
+		seg := &segment{}
+		seg.number = uint32(r.u32())
+		flags := r.u8()
+		seg.kind = flags & 0x3F
+
+		// Referred-to segment count and retention flags (7.2.4).
+		countByte := r.u8()
+		numRefs := countByte >> 5
+		if numRefs == 7 {
+			r.pos--
+			numRefs = r.u32() & 0x1FFFFFFF
+			r.next((numRefs + 8) / 8)
+		} else if numRefs > 4 {
+			return nil, ErrInvalidData
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+		if numRefs > len(data) {
+			return nil, ErrInvalidData
+		}
+
+		for i := 0; i < numRefs; i++ {
+			var ref int
+			switch {
+			case seg.number <= 256:
+				ref = r.u8()
+			case seg.number <= 65536:
+				ref = r.u16()
+			default:
+				ref = r.u32()
+			}
+			seg.refs = append(seg.refs, uint32(ref))
+		}
+		if flags&0x40 != 0 {
+			seg.page = uint32(r.u32())
+		} else {
+			seg.page = uint32(r.u8())
+		}
+		length := uint32(r.u32())
+		if r.err != nil {
+			return nil, r.err
+		}
+
+		if length == unknownLength {
+			if seg.kind != segImmediateGeneric {
+				return nil, ErrInvalidData
+			}
+			n, err := genericRegionLength(data[r.pos:])
+			if err != nil {
+				return nil, err
+			}
+			length = uint32(n)
+			seg.unknownLength = true
+		}
+		if uint64(r.pos)+uint64(length) > uint64(len(data)) {
+			return nil, ErrUnexpectedEOF
+		}
+		seg.data = r.next(int(length))
+		segments = append(segments, seg)
+
+		if seg.kind == segEndOfFile {
+			break
+		}
+	}
+	return segments, nil
+}
+
+// genericRegionLength determines the length of immediate generic region segment data of unknown
+// length, which is terminated by an end marker followed by the row count (7.2.7).
+func genericRegionLength(data []byte) (int, error) {
+	if len(data) < 18 {
+		return 0, ErrUnexpectedEOF
+	}
+	flags := data[17]
+	headerLen := 18
+	marker := []byte{0x00, 0x00}
+	if flags&1 == 0 {
+		marker = []byte{0xFF, 0xAC}
+		if (flags>>1)&3 == 0 {
+			headerLen += 8
+		} else {
+			headerLen += 2
+		}
+	}
+	if headerLen > len(data) {
+		return 0, ErrUnexpectedEOF
+	}
+	i := bytes.Index(data[headerLen:], marker)
+	if i < 0 || headerLen+i+6 > len(data) {
+		return 0, ErrUnexpectedEOF
+	}
+	return headerLen + i + 6, nil
+}
@@ -0,0 +1,327 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jbig2
+
+import (
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/internal/mq"
+)
+
+// decodeSymbolDictionary decodes a symbol dictionary segment (6.5 and 7.4.2).  Returns the exported
+// symbols.
+func (d *decoder) decodeSymbolDictionary(seg *segment) ([]*bitmap, error) {
+	r := &byteReader{data: seg.data}
+	flags := r.u16()
+	huff := flags&1 != 0
+	refAgg := flags&2 != 0
+	selDH := (flags >> 2) & 3
+	selDW := (flags >> 4) & 3
+	selBMSize := (flags >> 6) & 1
+	selAggInst := (flags >> 7) & 1
+	template := (flags >> 10) & 3
+	rtemplate := (flags >> 12) & 1
+
+	var at []point
+	if !huff {
+		n := 1
+		if template == 0 {
+			n = 4
+		}
+		for i := 0; i < n; i++ {
+			at = append(at, point{r.s8(), r.s8()})
+		}
+	}
+	rat := [2]point{{-1, -1}, {-1, -1}}
+	if refAgg && rtemplate == 0 {
+		rat = [2]point{{r.s8(), r.s8()}, {r.s8(), r.s8()}}
+	}
+	numExported := r.u32()
+	numNew := r.u32()
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	inputs, custom := d.referredSymbolsAndTables(seg)
+	numIn := len(inputs)
+	if numNew < 0 || numExported < 0 || numExported > numIn+numNew {
+		return nil, ErrInvalidData
+	}
+
+	// Huffman tables (7.4.2.1.6).
+	var tableDH, tableDW, tableBMSize, tableAggInst *huffTable
+	if huff {
+		var err error
+		if tableDH, err = selectTable(selDH, []int{4, 5, -1}, &custom); err != nil {
+			return nil, err
+		}
+		if tableDW, err = selectTable(selDW, []int{2, 3, -1}, &custom); err != nil {
+			return nil, err
+		}
+		if tableBMSize, err = selectTable(selBMSize, []int{1}, &custom); err != nil {
+			return nil, err
+		}
+		if tableAggInst, err = selectTable(selAggInst, []int{1}, &custom); err != nil {
+			return nil, err
+		}
+	}
+
+	data := seg.data[r.pos:]
+	st := newArithState(data)
+	hr := &bitReader{data: data}
+	symCodeLen := log2Ceil(numIn + numNew)
+	if !huff {
+		st.iaid = newIDDecoder(symCodeLen)
+	}
+
+	decodeInt := func(ia *intDecoder, table *huffTable) (int, bool, error) {
+		if huff {
+			return table.decode(hr)
+		}
+		v, ok := ia.decode(st.ad)
+		return v, ok, nil
+	}
+
+	// Decode the new symbols, by height classes (6.5.5).
+	newSyms := make([]*bitmap, 0, numNew)
+	height := 0
+	for len(newSyms) < numNew {
+		dh, ok, err := decodeInt(&st.iadh, tableDH)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrInvalidData
+		}
+		height += dh
+		if height < 0 {
+			return nil, ErrInvalidData
+		}
+
+		width, totalWidth := 0, 0
+		firstSym := len(newSyms)
+		var widths []int
+		for {
+			dw, ok, err := decodeInt(&st.iadw, tableDW)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			if len(newSyms) >= numNew {
+				return nil, ErrInvalidData
+			}
+			width += dw
+			if width < 0 {
+				return nil, ErrInvalidData
+			}
+			if err := checkSize(width, height); err != nil {
+				return nil, err
+			}
+			totalWidth += width
+
+			if huff && !refAgg {
+				// Decoded later as part of the height class collective bitmap.
+				widths = append(widths, width)
+				newSyms = append(newSyms, nil)
+				continue
+			}
+
+			var sym *bitmap
+			if !refAgg {
+				sym = decodeGeneric(st, &genericParams{
+					width:    width,
+					height:   height,
+					template: template,
+					at:       at,
+				})
+			} else {
+				numInst, ok, err := decodeInt(&st.iaai, tableAggInst)
+				if err != nil {
+					return nil, err
+				}
+				if !ok || numInst <= 0 {
+					return nil, ErrInvalidData
+				}
+				symbols := append(append([]*bitmap{}, inputs...), newSyms...)
+				if numInst == 1 {
+					sym, err = decodeRefinedSymbol(st, hr, huff, symbols, symCodeLen, width, height, rtemplate, rat)
+				} else {
+					// Refinement/aggregate coding as a text region (6.5.8.2.1).
+					p := &textParams{
+						huff:         huff,
+						refine:       true,
+						width:        width,
+						height:       height,
+						numInstances: numInst,
+						symbols:      symbols,
+						symCodeLen:   symCodeLen,
+						combOp:       combineOr,
+						refCorner:    cornerTopLeft,
+						fs:           standardTable(6),
+						ds:           standardTable(8),
+						dt:           standardTable(11),
+						rdw:          standardTable(15),
+						rdh:          standardTable(15),
+						rdx:          standardTable(15),
+						rdy:          standardTable(15),
+						rsize:        standardTable(1),
+						rtemplate:    rtemplate,
+						rat:          rat,
+					}
+					sym, err = decodeText(st, hr, p)
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+			newSyms = append(newSyms, sym)
+		}
+
+		if huff && !refAgg {
+			// Height class collective bitmap (6.5.9).
+			bmSize, err := tableBMSize.decodeValue(hr)
+			if err != nil {
+				return nil, err
+			}
+			hr.align()
+			start := hr.bytePos()
+			if err := checkSize(totalWidth, height); err != nil {
+				return nil, err
+			}
+			var collective *bitmap
+			if bmSize == 0 {
+				// Uncompressed.
+				rowBytes := (totalWidth + 7) / 8
+				bmSize = rowBytes * height
+				if start+bmSize > len(data) {
+					return nil, ErrUnexpectedEOF
+				}
+				collective = unpackBitmap(data[start:start+bmSize], totalWidth, height)
+			} else {
+				if bmSize < 0 || start+bmSize > len(data) {
+					return nil, ErrUnexpectedEOF
+				}
+				collective, _, err = decodeGenericMMR(data[start:start+bmSize], totalWidth, height)
+				if err != nil {
+					return nil, err
+				}
+			}
+			hr.pos = (start + bmSize) * 8
+
+			x := 0
+			for i, w := range widths {
+				newSyms[firstSym+i] = collective.subBitmap(x, w)
+				x += w
+			}
+		}
+	}
+
+	// Exported symbols (6.5.10).
+	all := append(append([]*bitmap{}, inputs...), newSyms...)
+	var exported []*bitmap
+	exFlag := false
+	for i, iter := 0, 0; i < len(all); iter++ {
+		if iter > 2*len(all)+2 {
+			return nil, ErrInvalidData
+		}
+		var run int
+		var ok bool
+		var err error
+		if huff {
+			run, ok, err = standardTable(1).decode(hr)
+		} else {
+			run, ok = st.iaex.decode(st.ad)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !ok || run < 0 || i+run > len(all) {
+			return nil, ErrInvalidData
+		}
+		if exFlag {
+			exported = append(exported, all[i:i+run]...)
+		}
+		i += run
+		exFlag = !exFlag
+	}
+	if len(exported) != numExported {
+		common.Log.Debug("JBIG2: symbol dictionary exports %d symbols, expected %d", len(exported), numExported)
+	}
+	return exported, nil
+}
+
+// decodeRefinedSymbol decodes a symbol that is coded as the refinement of a single symbol
+// (6.5.8.2.2).
+func decodeRefinedSymbol(st *arithState, hr *bitReader, huff bool, symbols []*bitmap, symCodeLen uint,
+	width, height int, rtemplate int, rat [2]point) (*bitmap, error) {
+	var id, rdx, rdy int
+	var err error
+	if huff {
+		if id, err = hr.readBits(int(symCodeLen)); err != nil {
+			return nil, err
+		}
+		if rdx, err = standardTable(15).decodeValue(hr); err != nil {
+			return nil, err
+		}
+		if rdy, err = standardTable(15).decodeValue(hr); err != nil {
+			return nil, err
+		}
+	} else {
+		id = st.iaid.decode(st.ad)
+		var ok1, ok2 bool
+		rdx, ok1 = st.iardx.decode(st.ad)
+		rdy, ok2 = st.iardy.decode(st.ad)
+		if !ok1 || !ok2 {
+			return nil, ErrInvalidData
+		}
+	}
+	if id < 0 || id >= len(symbols) {
+		return nil, ErrInvalidData
+	}
+
+	var start, size int
+	if huff {
+		if size, err = standardTable(1).decodeValue(hr); err != nil {
+			return nil, err
+		}
+		hr.align()
+		start = hr.bytePos()
+		if size < 0 || start+size > len(hr.data) {
+			return nil, ErrUnexpectedEOF
+		}
+		st.ad = mq.NewDecoder(hr.data[start : start+size])
+	}
+
+	sym := decodeRefinement(st, &refinementParams{
+		width:     width,
+		height:    height,
+		template:  rtemplate,
+		reference: symbols[id],
+		dx:        rdx,
+		dy:        rdy,
+		at:        rat,
+	})
+
+	if huff {
+		hr.pos = (start + size) * 8
+	}
+	return sym, nil
+}
+
+// selectTable returns the Huffman table selected by `sel`, which is an index into `standard`.  A
+// standard table number of -1 or an index beyond `standard` selects the next custom table.
+func selectTable(sel int, standard []int, custom *[]*huffTable) (*huffTable, error) {
+	if sel < len(standard) && standard[sel] > 0 {
+		return standardTable(standard[sel]), nil
+	}
+	if len(*custom) == 0 {
+		return nil, ErrInvalidHuffmanTable
+	}
+	table := (*custom)[0]
+	*custom = (*custom)[1:]
+	return table, nil
+}
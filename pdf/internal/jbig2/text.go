@@ -0,0 +1,291 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jbig2
+
+import (
+	"github.com/unidoc/unidoc/pdf/internal/mq"
+)
+
+// Reference corners of symbol instances in text regions (7.4.3.1.1).
+const (
+	cornerBottomLeft = iota
+	cornerTopLeft
+	cornerBottomRight
+	cornerTopRight
+)
+
+// textParams are the parameters of the text region decoding procedure (6.4.2).
+type textParams struct {
+	huff         bool
+	refine       bool
+	width        int
+	height       int
+	numInstances int
+	logStrips    uint
+	symbols      []*bitmap
+	symCodeLen   uint       // Length of symbol IDs (IAID, or fixed length codes when huffman).
+	symCodes     *huffTable // Symbol ID codes when huffman, nil for fixed length codes.
+	defPixel     byte
+	combOp       int
+	transposed   bool
+	refCorner    int
+	dsOffset     int
+
+	// Huffman tables.
+	fs, ds, dt, rdw, rdh, rdx, rdy, rsize *huffTable
+
+	rtemplate int
+	rat       [2]point
+}
+
+// decodeText decodes a text region (6.4.5).  Arithmetic decoding uses `st`, huffman decoding reads
+// from `hr`.
+func decodeText(st *arithState, hr *bitReader, p *textParams) (*bitmap, error) {
+	bm := newBitmap(p.width, p.height, p.defPixel)
+	strips := 1 << p.logStrips
+
+	decodeInt := func(ia *intDecoder, table *huffTable) (int, bool, error) {
+		if p.huff {
+			return table.decode(hr)
+		}
+		v, ok := ia.decode(st.ad)
+		return v, ok, nil
+	}
+	decodeValue := func(ia *intDecoder, table *huffTable) (int, error) {
+		v, ok, err := decodeInt(ia, table)
+		if err == nil && !ok {
+			err = ErrInvalidData
+		}
+		return v, err
+	}
+
+	stripT, err := decodeValue(&st.iadt, p.dt)
+	if err != nil {
+		return nil, err
+	}
+	stripT *= -strips
+	firstS := 0
+
+	for n := 0; n < p.numInstances; {
+		dt, err := decodeValue(&st.iadt, p.dt)
+		if err != nil {
+			return nil, err
+		}
+		stripT += dt * strips
+
+		var curS int
+		for first := true; ; first = false {
+			if first {
+				dfs, err := decodeValue(&st.iafs, p.fs)
+				if err != nil {
+					return nil, err
+				}
+				firstS += dfs
+				curS = firstS
+			} else {
+				ids, ok, err := decodeInt(&st.iads, p.ds)
+				if err != nil {
+					return nil, err
+				}
+				if !ok || n >= p.numInstances {
+					break
+				}
+				curS += ids + p.dsOffset
+			}
+
+			curT := 0
+			if strips > 1 {
+				if p.huff {
+					curT, err = hr.readBits(int(p.logStrips))
+				} else {
+					curT, err = decodeValue(&st.iait, nil)
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+			t := stripT + curT
+
+			var id int
+			switch {
+			case !p.huff:
+				id = st.iaid.decode(st.ad)
+			case p.symCodes != nil:
+				id, err = p.symCodes.decodeValue(hr)
+			default:
+				id, err = hr.readBits(int(p.symCodeLen))
+			}
+			if err != nil {
+				return nil, err
+			}
+			if id < 0 || id >= len(p.symbols) {
+				return nil, ErrInvalidData
+			}
+			ib := p.symbols[id]
+
+			ri := 0
+			if p.refine {
+				if p.huff {
+					ri, err = hr.readBit()
+				} else {
+					ri, err = decodeValue(&st.iari, nil)
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+			if ri != 0 {
+				ib, err = decodeSymbolRefinement(st, hr, p, ib)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			placeSymbol(bm, ib, curS, t, p)
+			if !p.transposed && (p.refCorner == cornerTopLeft || p.refCorner == cornerBottomLeft) {
+				curS += ib.width - 1
+			} else if p.transposed && (p.refCorner == cornerTopLeft || p.refCorner == cornerTopRight) {
+				curS += ib.height - 1
+			}
+			n++
+		}
+	}
+	return bm, nil
+}
+
+// placeSymbol draws symbol `ib` at position (s, t) in the text region (6.4.5 3c).  Note that the
+// position is adjusted for right or bottom reference corners before drawing.
+func placeSymbol(bm, ib *bitmap, s, t int, p *textParams) {
+	wi, hi := ib.width, ib.height
+	if !p.transposed && (p.refCorner == cornerTopRight || p.refCorner == cornerBottomRight) {
+		s += wi - 1
+	} else if p.transposed && (p.refCorner == cornerBottomLeft || p.refCorner == cornerBottomRight) {
+		s += hi - 1
+	}
+
+	x, y := s, t
+	if p.transposed {
+		x, y = t, s
+	}
+	switch p.refCorner {
+	case cornerTopRight:
+		x -= wi - 1
+	case cornerBottomLeft:
+		y -= hi - 1
+	case cornerBottomRight:
+		x -= wi - 1
+		y -= hi - 1
+	}
+	bm.combine(ib, x, y, p.combOp)
+}
+
+// decodeSymbolRefinement decodes the refinement of symbol instance bitmap `ib` (6.4.11).
+func decodeSymbolRefinement(st *arithState, hr *bitReader, p *textParams, ib *bitmap) (*bitmap, error) {
+	var vals [4]int
+	decoders := []*intDecoder{&st.iardw, &st.iardh, &st.iardx, &st.iardy}
+	tables := []*huffTable{p.rdw, p.rdh, p.rdx, p.rdy}
+	for i := range vals {
+		var ok bool
+		var err error
+		if p.huff {
+			vals[i], ok, err = tables[i].decode(hr)
+		} else {
+			vals[i], ok = decoders[i].decode(st.ad)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrInvalidData
+		}
+	}
+	rdw, rdh, rdx, rdy := vals[0], vals[1], vals[2], vals[3]
+	if err := checkSize(ib.width+rdw, ib.height+rdh); err != nil {
+		return nil, err
+	}
+
+	var start, size int
+	if p.huff {
+		var err error
+		size, err = p.rsize.decodeValue(hr)
+		if err != nil {
+			return nil, err
+		}
+		hr.align()
+		start = hr.bytePos()
+		if size < 0 || start+size > len(hr.data) {
+			return nil, ErrUnexpectedEOF
+		}
+		// The refinement bitmap is arithmetically coded in the following RSIZE bytes.
+		st.ad = mq.NewDecoder(hr.data[start : start+size])
+	}
+
+	refined := decodeRefinement(st, &refinementParams{
+		width:     ib.width + rdw,
+		height:    ib.height + rdh,
+		template:  p.rtemplate,
+		reference: ib,
+		dx:        (rdw >> 1) + rdx,
+		dy:        (rdh >> 1) + rdy,
+		at:        p.rat,
+	})
+
+	if p.huff {
+		hr.pos = (start + size) * 8
+	}
+	return refined, nil
+}
+
+// parseSymbolCodes parses the symbol ID huffman decoding table of a text region (7.4.3.1.7).
+func parseSymbolCodes(hr *bitReader, numSyms int) (*huffTable, error) {
+	runCodeLines := make([]huffLine, 35)
+	for i := range runCodeLines {
+		prefLen, err := hr.readBits(4)
+		if err != nil {
+			return nil, err
+		}
+		runCodeLines[i] = huffLine{rangeLow: i, prefLen: prefLen}
+	}
+	runCodes, err := newHuffTable(runCodeLines)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]huffLine, 0, numSyms)
+	for len(lines) < numSyms {
+		rc, err := runCodes.decodeValue(hr)
+		if err != nil {
+			return nil, err
+		}
+		length, repeat := rc, 1
+		var extra int
+		switch rc {
+		case 32:
+			if len(lines) == 0 {
+				return nil, ErrInvalidData
+			}
+			length = lines[len(lines)-1].prefLen
+			extra, err = hr.readBits(2)
+			repeat = 3 + extra
+		case 33:
+			length = 0
+			extra, err = hr.readBits(3)
+			repeat = 3 + extra
+		case 34:
+			length = 0
+			extra, err = hr.readBits(7)
+			repeat = 11 + extra
+		}
+		if err != nil {
+			return nil, err
+		}
+		for ; repeat > 0 && len(lines) < numSyms; repeat-- {
+			lines = append(lines, huffLine{rangeLow: len(lines), prefLen: length})
+		}
+	}
+	hr.align()
+	return newHuffTable(lines)
+}
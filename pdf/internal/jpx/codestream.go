@@ -0,0 +1,550 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jpx
+
+import (
+	"errors"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// Markers (Table A.2).
+const (
+	markerSOC = 0xFF4F
+	markerSIZ = 0xFF51
+	markerCOD = 0xFF52
+	markerCOC = 0xFF53
+	markerQCD = 0xFF5C
+	markerQCC = 0xFF5D
+	markerRGN = 0xFF5E
+	markerPOC = 0xFF5F
+	markerPPM = 0xFF60
+	markerPPT = 0xFF61
+	markerSOT = 0xFF90
+	markerSOP = 0xFF91
+	markerEPH = 0xFF92
+	markerSOD = 0xFF93
+	markerEOC = 0xFFD9
+)
+
+// maxSamples limits the image size to guard against corrupt headers.
+const maxSamples = 1 << 30
+
+// Code-block style flags (Table A.19).
+const (
+	cbBypass      = 0x01 // Selective arithmetic coding bypass.
+	cbReset       = 0x02 // Reset context probabilities on coding pass boundaries.
+	cbTermAll     = 0x04 // Termination on each coding pass.
+	cbVCausal     = 0x08 // Vertically causal context.
+	cbPredictable = 0x10 // Predictable termination.
+	cbSegSymbol   = 0x20 // Segmentation symbols are used.
+)
+
+// Quantization styles (Table A.28).
+const (
+	quantNone            = 0
+	quantScalarDerived   = 1
+	quantScalarExpounded = 2
+)
+
+// siz holds the image and tile size parameters (A.5.1).
+type siz struct {
+	xsiz, ysiz     int // Size of the reference grid.
+	xosiz, yosiz   int // Image offset.
+	xtsiz, ytsiz   int // Tile size.
+	xtosiz, ytosiz int // Tile offset.
+	components     []component
+}
+
+// component holds the parameters of an image component.
+type component struct {
+	precision int
+	signed    bool
+	dx, dy    int // Subsampling factors.
+}
+
+// codingStyle holds the coding style parameters of a component (SPcod or SPcoc, A.6.1 and A.6.2).
+type codingStyle struct {
+	levels          int // Number of decomposition levels.
+	xcb, ycb        int // Code-block width and height exponents.
+	cbStyle         int
+	reversible      bool // 5-3 reversible filter, otherwise 9-7 irreversible.
+	customPrecincts bool
+	precincts       [][2]int // Precinct width and height exponents per resolution level.
+}
+
+// cod holds the coding style default parameters (A.6.1).
+type cod struct {
+	sop, eph    bool
+	progression int
+	layers      int
+	mct         int
+	style       *codingStyle
+}
+
+// stepSize is a quantization step size.
+type stepSize struct {
+	epsilon, mu int
+}
+
+// quantization holds the quantization parameters of a component (A.6.4 and A.6.5).
+type quantization struct {
+	style     int
+	guardBits int
+	steps     []stepSize
+}
+
+// codestream is the state of a codestream being decoded.
+type codestream struct {
+	data []byte
+	pos  int // Position of the first tile-part after parsing the main header.
+
+	siz *siz
+	cod *cod
+	coc map[int]*codingStyle
+	qcd *quantization
+	qcc map[int]*quantization
+
+	numXTiles, numYTiles int
+	tiles                []*tile
+
+	planes [][]byte // Decoded 8 bit samples of the components at full image size.
+}
+
+// readMarker reads the marker at `pos` and returns it with its segment content and the position
+// following the segment.
+func (cs *codestream) readMarker(pos int) (int, []byte, int, error) {
+	if pos+2 > len(cs.data) {
+		return 0, nil, 0, ErrUnexpectedEOF
+	}
+	marker := be16(cs.data[pos:])
+	if marker>>8 != 0xFF {
+		common.Log.Debug("JPX: expected marker at %d, got 0x%04x", pos, marker)
+		return 0, nil, 0, ErrInvalidData
+	}
+	switch marker {
+	case markerSOC, markerSOD, markerEOC:
+		return marker, nil, pos + 2, nil
+	}
+	if pos+4 > len(cs.data) {
+		return 0, nil, 0, ErrUnexpectedEOF
+	}
+	length := be16(cs.data[pos+2:])
+	if length < 2 {
+		return 0, nil, 0, ErrInvalidData
+	}
+	if pos+2+length > len(cs.data) {
+		return 0, nil, 0, ErrUnexpectedEOF
+	}
+	return marker, cs.data[pos+4 : pos+2+length], pos + 2 + length, nil
+}
+
+// parseMainHeader parses the main header up to the first tile-part (A.3).
+func (cs *codestream) parseMainHeader() error {
+	marker, _, pos, err := cs.readMarker(0)
+	if err != nil {
+		return err
+	}
+	if marker != markerSOC {
+		common.Log.Debug("JPX: missing SOC marker")
+		return ErrInvalidData
+	}
+	cs.coc = map[int]*codingStyle{}
+	cs.qcc = map[int]*quantization{}
+
+	for {
+		marker, content, next, err := cs.readMarker(pos)
+		if err != nil {
+			return err
+		}
+		if marker != markerSIZ && cs.siz == nil {
+			common.Log.Debug("JPX: missing SIZ marker")
+			return ErrInvalidData
+		}
+
+		switch marker {
+		case markerSIZ:
+			if cs.siz, err = parseSIZ(content); err != nil {
+				return err
+			}
+		case markerCOD:
+			if cs.cod, err = parseCOD(content); err != nil {
+				return err
+			}
+		case markerCOC:
+			c, style, err := cs.parseCOC(content)
+			if err != nil {
+				return err
+			}
+			cs.coc[c] = style
+		case markerQCD:
+			if cs.qcd, err = parseQuantization(content); err != nil {
+				return err
+			}
+		case markerQCC:
+			c, content, err := cs.componentIndex(content)
+			if err != nil {
+				return err
+			}
+			if cs.qcc[c], err = parseQuantization(content); err != nil {
+				return err
+			}
+		case markerPOC, markerPPM:
+			common.Log.Debug("JPX: marker 0x%04x is not supported", marker)
+			return ErrUnsupported
+		case markerRGN:
+			common.Log.Debug("JPX: region of interest is not supported, ignoring")
+		case markerSOT:
+			if cs.cod == nil || cs.qcd == nil {
+				common.Log.Debug("JPX: missing COD or QCD marker")
+				return ErrInvalidData
+			}
+			cs.pos = pos
+			return nil
+		}
+		pos = next
+	}
+}
+
+// parseSIZ parses the image and tile size marker segment (A.5.1).
+func parseSIZ(content []byte) (*siz, error) {
+	if len(content) < 38 {
+		return nil, ErrInvalidData
+	}
+	s := &siz{
+		xsiz:   int(be32(content[2:])),
+		ysiz:   int(be32(content[6:])),
+		xosiz:  int(be32(content[10:])),
+		yosiz:  int(be32(content[14:])),
+		xtsiz:  int(be32(content[18:])),
+		ytsiz:  int(be32(content[22:])),
+		xtosiz: int(be32(content[26:])),
+		ytosiz: int(be32(content[30:])),
+	}
+	numComps := be16(content[34:])
+	if numComps == 0 || len(content) < 36+3*numComps {
+		return nil, ErrInvalidData
+	}
+	for i := 0; i < numComps; i++ {
+		b := content[36+3*i:]
+		comp := component{
+			precision: int(b[0]&0x7F) + 1,
+			signed:    b[0]&0x80 != 0,
+			dx:        int(b[1]),
+			dy:        int(b[2]),
+		}
+		if comp.dx == 0 || comp.dy == 0 || comp.precision > 38 {
+			return nil, ErrInvalidData
+		}
+		s.components = append(s.components, comp)
+	}
+
+	width, height := s.xsiz-s.xosiz, s.ysiz-s.yosiz
+	if width <= 0 || height <= 0 || s.xtsiz <= 0 || s.ytsiz <= 0 ||
+		s.xtosiz > s.xosiz || s.ytosiz > s.yosiz ||
+		s.xtosiz+s.xtsiz <= s.xosiz || s.ytosiz+s.ytsiz <= s.yosiz {
+		common.Log.Debug("JPX: invalid image or tile size")
+		return nil, ErrInvalidData
+	}
+	if int64(width)*int64(height)*int64(numComps) > maxSamples {
+		common.Log.Debug("JPX: image too large (%dx%d, %d components)", width, height, numComps)
+		return nil, ErrUnsupported
+	}
+	return s, nil
+}
+
+// componentIndex reads the component index at the start of a COC, QCC or RGN marker segment and
+// returns it with the remaining content.
+func (cs *codestream) componentIndex(content []byte) (int, []byte, error) {
+	if len(cs.siz.components) < 257 {
+		if len(content) < 1 {
+			return 0, nil, ErrInvalidData
+		}
+		c := int(content[0])
+		if c >= len(cs.siz.components) {
+			return 0, nil, ErrInvalidData
+		}
+		return c, content[1:], nil
+	}
+	if len(content) < 2 {
+		return 0, nil, ErrInvalidData
+	}
+	c := be16(content)
+	if c >= len(cs.siz.components) {
+		return 0, nil, ErrInvalidData
+	}
+	return c, content[2:], nil
+}
+
+// parseCOD parses the coding style default marker segment (A.6.1).
+func parseCOD(content []byte) (*cod, error) {
+	if len(content) < 5 {
+		return nil, ErrInvalidData
+	}
+	scod := content[0]
+	c := &cod{
+		sop:         scod&0x02 != 0,
+		eph:         scod&0x04 != 0,
+		progression: int(content[1]),
+		layers:      be16(content[2:]),
+		mct:         int(content[4]),
+	}
+	if c.progression > 4 || c.layers == 0 {
+		return nil, ErrInvalidData
+	}
+	style, err := parseCodingStyle(content[5:], scod&0x01 != 0)
+	if err != nil {
+		return nil, err
+	}
+	c.style = style
+	return c, nil
+}
+
+// parseCOC parses the coding style component marker segment (A.6.2).
+func (cs *codestream) parseCOC(content []byte) (int, *codingStyle, error) {
+	c, content, err := cs.componentIndex(content)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(content) < 1 {
+		return 0, nil, ErrInvalidData
+	}
+	style, err := parseCodingStyle(content[1:], content[0]&0x01 != 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	return c, style, nil
+}
+
+// parseCodingStyle parses the SPcod or SPcoc parameters (Table A.15).
+func parseCodingStyle(b []byte, customPrecincts bool) (*codingStyle, error) {
+	if len(b) < 5 {
+		return nil, ErrInvalidData
+	}
+	style := &codingStyle{
+		levels:          int(b[0]),
+		xcb:             int(b[1]&0x0F) + 2,
+		ycb:             int(b[2]&0x0F) + 2,
+		cbStyle:         int(b[3]),
+		reversible:      b[4] == 1,
+		customPrecincts: customPrecincts,
+	}
+	if style.levels > 32 || style.xcb+style.ycb > 12 {
+		return nil, ErrInvalidData
+	}
+	if customPrecincts {
+		if len(b) < 5+style.levels+1 {
+			return nil, ErrInvalidData
+		}
+		for r := 0; r <= style.levels; r++ {
+			v := int(b[5+r])
+			style.precincts = append(style.precincts, [2]int{v & 0x0F, v >> 4})
+		}
+	}
+	return style, nil
+}
+
+// parseQuantization parses the quantization parameters of a QCD or QCC marker segment (A.6.4).
+func parseQuantization(content []byte) (*quantization, error) {
+	if len(content) < 1 {
+		return nil, ErrInvalidData
+	}
+	q := &quantization{
+		style:     int(content[0] & 0x1F),
+		guardBits: int(content[0] >> 5),
+	}
+	b := content[1:]
+	switch q.style {
+	case quantNone:
+		for _, v := range b {
+			q.steps = append(q.steps, stepSize{epsilon: int(v >> 3)})
+		}
+	case quantScalarDerived, quantScalarExpounded:
+		for i := 0; i+1 < len(b); i += 2 {
+			v := be16(b[i:])
+			q.steps = append(q.steps, stepSize{epsilon: v >> 11, mu: v & 0x7FF})
+		}
+	default:
+		return nil, ErrInvalidData
+	}
+	if len(q.steps) == 0 {
+		return nil, ErrInvalidData
+	}
+	return q, nil
+}
+
+// decodeTiles reads the tile-parts and decodes the tiles into the component planes.
+func (cs *codestream) decodeTiles() error {
+	s := cs.siz
+	cs.numXTiles = ceilDiv(s.xsiz-s.xtosiz, s.xtsiz)
+	cs.numYTiles = ceilDiv(s.ysiz-s.ytosiz, s.ytsiz)
+	if cs.numXTiles*cs.numYTiles > 65535 {
+		common.Log.Debug("JPX: too many tiles")
+		return ErrInvalidData
+	}
+	cs.tiles = make([]*tile, cs.numXTiles*cs.numYTiles)
+
+	numParts := 0
+	for pos := cs.pos; pos < len(cs.data); {
+		next, err := cs.readTilePart(pos)
+		if err == errEndOfCodestream {
+			break
+		}
+		if err != nil {
+			if numParts == 0 {
+				return err
+			}
+			common.Log.Debug("JPX: error reading tile-part, decoding available data: %v", err)
+			break
+		}
+		numParts++
+		pos = next
+	}
+
+	width, height := s.xsiz-s.xosiz, s.ysiz-s.yosiz
+	cs.planes = make([][]byte, len(s.components))
+	for c := range cs.planes {
+		cs.planes[c] = make([]byte, width*height)
+	}
+	for _, t := range cs.tiles {
+		if t != nil {
+			cs.decodeTile(t)
+		}
+	}
+	return nil
+}
+
+// errEndOfCodestream is returned by readTilePart at the EOC marker.
+var errEndOfCodestream = errors.New("jpx: end of codestream")
+
+// readTilePart reads the tile-part starting at `pos` (A.4.2) and returns the position of the next one.
+func (cs *codestream) readTilePart(pos int) (int, error) {
+	marker, content, next, err := cs.readMarker(pos)
+	if err != nil {
+		return 0, err
+	}
+	if marker == markerEOC {
+		return 0, errEndOfCodestream
+	}
+	if marker != markerSOT || len(content) < 8 {
+		common.Log.Debug("JPX: expected SOT marker segment, got 0x%04x", marker)
+		return 0, ErrInvalidData
+	}
+	index := be16(content)
+	length := int(be32(content[2:]))
+	if index >= len(cs.tiles) {
+		return 0, ErrInvalidData
+	}
+	end := pos + length
+	if length == 0 {
+		// The last tile-part extends to the EOC marker.
+		end = len(cs.data)
+		if end >= 2 && be16(cs.data[end-2:]) == markerEOC {
+			end -= 2
+		}
+	}
+	if end > len(cs.data) {
+		common.Log.Debug("JPX: truncated tile-part")
+		end = len(cs.data)
+	}
+
+	t := cs.tiles[index]
+	if t == nil {
+		t = cs.newTile(index)
+		cs.tiles[index] = t
+	}
+
+	// Tile-part header.
+	for pos = next; ; pos = next {
+		marker, content, next, err = cs.readMarker(pos)
+		if err != nil {
+			return 0, err
+		}
+		switch marker {
+		case markerSOD:
+		case markerCOD:
+			if t.cod, err = parseCOD(content); err != nil {
+				return 0, err
+			}
+		case markerCOC:
+			c, style, err := cs.parseCOC(content)
+			if err != nil {
+				return 0, err
+			}
+			t.coc[c] = style
+		case markerQCD:
+			if t.qcd, err = parseQuantization(content); err != nil {
+				return 0, err
+			}
+		case markerQCC:
+			c, content, err := cs.componentIndex(content)
+			if err != nil {
+				return 0, err
+			}
+			if t.qcc[c], err = parseQuantization(content); err != nil {
+				return 0, err
+			}
+		case markerPOC, markerPPT:
+			common.Log.Debug("JPX: marker 0x%04x is not supported", marker)
+			return 0, ErrUnsupported
+		case markerRGN:
+			common.Log.Debug("JPX: region of interest is not supported, ignoring")
+		}
+		if marker == markerSOD {
+			break
+		}
+	}
+	if next > end {
+		return 0, ErrInvalidData
+	}
+
+	if !t.initialized {
+		cs.initTile(t)
+	}
+	t.readPackets(cs.data[next:end])
+	return end, nil
+}
+
+// newTile returns the tile with index `index` of the tile grid (B.3).
+func (cs *codestream) newTile(index int) *tile {
+	s := cs.siz
+	p, q := index%cs.numXTiles, index/cs.numXTiles
+	return &tile{
+		index: index,
+		x0:    maxInt(s.xtosiz+p*s.xtsiz, s.xosiz),
+		y0:    maxInt(s.ytosiz+q*s.ytsiz, s.yosiz),
+		x1:    minInt(s.xtosiz+(p+1)*s.xtsiz, s.xsiz),
+		y1:    minInt(s.ytosiz+(q+1)*s.ytsiz, s.ysiz),
+		coc:   map[int]*codingStyle{},
+		qcc:   map[int]*quantization{},
+	}
+}
+
+func ceilDiv(a, b int) int {
+	return -floorDiv(-a, b)
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,241 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jpx
+
+import (
+	"math"
+)
+
+// dwtPad is the number of samples by which signals are extended at each end for the inverse
+// transforms.
+const dwtPad = 4
+
+// Lifting parameters of the 9-7 irreversible filter (Table F.4).
+const (
+	liftAlpha = -1.586134342059924
+	liftBeta  = -0.052980118572961
+	liftGamma = 0.882911075530934
+	liftDelta = 0.443506852043971
+	liftK     = 1.230174104914001
+)
+
+// decodeTile decodes the code-blocks of tile `t`, applies the inverse transforms and writes the
+// samples to the component planes.
+func (cs *codestream) decodeTile(t *tile) {
+	if !t.initialized {
+		return
+	}
+	d := &t1Decoder{}
+	samples := make([][]float32, len(t.comps))
+	for c, tc := range t.comps {
+		for _, res := range tc.resolutions {
+			for _, sb := range res.subbands {
+				sb.coeffs = make([]float32, (sb.x1-sb.x0)*(sb.y1-sb.y0))
+				for _, cb := range sb.codeblocks {
+					d.decode(cb, sb, tc.style)
+				}
+			}
+		}
+		samples[c] = tc.inverseDWT()
+	}
+
+	if t.params.mct == 1 && len(t.comps) >= 3 {
+		if t.comps[0].sameSize(t.comps[1]) && t.comps[0].sameSize(t.comps[2]) {
+			inverseMCT(samples, t.comps[0].style.reversible)
+		}
+	}
+
+	for c, tc := range t.comps {
+		cs.writeTileComponent(t, c, tc, samples[c])
+	}
+}
+
+func (tc *tileComponent) sameSize(other *tileComponent) bool {
+	return tc.x0 == other.x0 && tc.y0 == other.y0 && tc.x1 == other.x1 && tc.y1 == other.y1
+}
+
+// inverseDWT reconstructs the tile-component samples from its subbands (F.3.1).
+func (tc *tileComponent) inverseDWT() []float32 {
+	reversible := tc.style.reversible
+	ll := tc.resolutions[0].subbands[0].coeffs
+	var buf []float32
+	for r := 1; r < len(tc.resolutions); r++ {
+		prev, res := tc.resolutions[r-1], tc.resolutions[r]
+		w, h := res.x1-res.x0, res.y1-res.y0
+		a := interleave(ll, prev, res, w, h)
+
+		if n := maxInt(w, h) + 2*dwtPad; len(buf) < n {
+			buf = make([]float32, n)
+		}
+		// Horizontal filtering (HOR_SR).
+		for y := 0; y < h; y++ {
+			copy(buf[dwtPad:], a[y*w:(y+1)*w])
+			synthesize1D(buf, res.x0, w, reversible)
+			copy(a[y*w:(y+1)*w], buf[dwtPad:dwtPad+w])
+		}
+		// Vertical filtering (VER_SR).
+		for x := 0; x < w; x++ {
+			for y := 0; y < h; y++ {
+				buf[dwtPad+y] = a[y*w+x]
+			}
+			synthesize1D(buf, res.y0, h, reversible)
+			for y := 0; y < h; y++ {
+				a[y*w+x] = buf[dwtPad+y]
+			}
+		}
+		ll = a
+	}
+	return ll
+}
+
+// interleave interleaves the LL band `ll` of resolution level `prev` with the HL, LH and HH
+// subbands of resolution level `res` (2D_INTERLEAVE, F.3.3).
+func interleave(ll []float32, prev, res *resolution, w, h int) []float32 {
+	a := make([]float32, w*h)
+	for y := 0; y < h; y++ {
+		v := res.y0 + y
+		for x := 0; x < w; x++ {
+			u := res.x0 + x
+			var band []float32
+			var bx0, by0, bw int
+			switch {
+			case u&1 == 0 && v&1 == 0:
+				band, bx0, by0, bw = ll, prev.x0, prev.y0, prev.x1-prev.x0
+			default:
+				sb := res.subbands[(u&1|(v&1)<<1)-1]
+				band, bx0, by0, bw = sb.coeffs, sb.x0, sb.y0, sb.x1-sb.x0
+			}
+			i := (v>>1-by0)*bw + u>>1 - bx0
+			if i >= 0 && i < len(band) {
+				a[y*w+x] = band[i]
+			}
+		}
+	}
+	return a
+}
+
+// synthesize1D applies the one-dimensional inverse transform (1D_SR, F.3.6) to the `n` samples in
+// buf[dwtPad:dwtPad+n], where the first sample has coordinate `i0`.
+func synthesize1D(buf []float32, i0, n int, reversible bool) {
+	if n <= 0 {
+		return
+	}
+	if n == 1 {
+		if i0&1 == 1 {
+			buf[dwtPad] /= 2
+		}
+		return
+	}
+
+	// Periodic symmetric extension (F.3.7).
+	period := 2 * (n - 1)
+	mirror := func(i int) int {
+		i %= period
+		if i < 0 {
+			i += period
+		}
+		if i >= n {
+			i = period - i
+		}
+		return i
+	}
+	for k := 1; k <= dwtPad; k++ {
+		buf[dwtPad-k] = buf[dwtPad+mirror(-k)]
+		buf[dwtPad+n-1+k] = buf[dwtPad+mirror(n-1+k)]
+	}
+
+	// Samples at even coordinates are low-pass, odd ones high-pass.
+	x := buf[:n+2*dwtPad]
+	even := func(j int) bool {
+		return (i0+j-dwtPad)&1 == 0
+	}
+	if reversible {
+		// 5-3 reversible filter (F-5 and F-6).
+		for j := 1; j < len(x)-1; j++ {
+			if even(j) {
+				x[j] -= float32(math.Floor(float64(x[j-1]+x[j+1]+2) / 4))
+			}
+		}
+		for j := 2; j < len(x)-2; j++ {
+			if !even(j) {
+				x[j] += float32(math.Floor(float64(x[j-1]+x[j+1]) / 2))
+			}
+		}
+		return
+	}
+
+	// 9-7 irreversible filter (F-7).
+	for j := range x {
+		if even(j) {
+			x[j] *= liftK
+		} else {
+			x[j] *= 1 / liftK
+		}
+	}
+	steps := []struct {
+		even   bool
+		factor float32
+	}{{true, liftDelta}, {false, liftGamma}, {true, liftBeta}, {false, liftAlpha}}
+	for s, step := range steps {
+		for j := s + 1; j < len(x)-s-1; j++ {
+			if even(j) == step.even {
+				x[j] -= step.factor * (x[j-1] + x[j+1])
+			}
+		}
+	}
+}
+
+// inverseMCT applies the inverse reversible (RCT) or irreversible (ICT) component transform to the
+// first three components (G.2 and G.3).
+func inverseMCT(samples [][]float32, reversible bool) {
+	y0, y1, y2 := samples[0], samples[1], samples[2]
+	for i := range y0 {
+		if reversible {
+			g := y0[i] - float32(math.Floor(float64(y1[i]+y2[i])/4))
+			y0[i], y1[i], y2[i] = y2[i]+g, g, y1[i]+g
+		} else {
+			y, cb, cr := y0[i], y1[i], y2[i]
+			y0[i] = y + 1.402*cr
+			y1[i] = y - 0.34413*cb - 0.71414*cr
+			y2[i] = y + 1.772*cb
+		}
+	}
+}
+
+// writeTileComponent applies the DC level shift to the samples of tile-component `tc`, scales them
+// to 8 bits and writes them to the area of the tile in the component plane.
+func (cs *codestream) writeTileComponent(t *tile, c int, tc *tileComponent, samples []float32) {
+	s := cs.siz
+	comp := s.components[c]
+	plane := cs.planes[c]
+	width := s.xsiz - s.xosiz
+
+	// Signed samples are shifted like unsigned ones, as required by PDF.
+	offset := float32(math.Ldexp(1, comp.precision-1))
+	scale := 255 / float32(math.Ldexp(1, comp.precision)-1)
+	tw, th := tc.x1-tc.x0, tc.y1-tc.y0
+	if tw <= 0 || th <= 0 {
+		return
+	}
+
+	for y := t.y0; y < t.y1; y++ {
+		cy := minInt(maxInt(y/comp.dy-tc.y0, 0), th-1)
+		row := samples[cy*tw:]
+		out := plane[(y-s.yosiz)*width:]
+		for x := t.x0; x < t.x1; x++ {
+			cx := minInt(maxInt(x/comp.dx-tc.x0, 0), tw-1)
+			v := (row[cx]+offset)*scale + 0.5
+			switch {
+			case v <= 0:
+				out[x-s.xosiz] = 0
+			case v >= 255:
+				out[x-s.xosiz] = 255
+			default:
+				out[x-s.xosiz] = byte(v)
+			}
+		}
+	}
+}
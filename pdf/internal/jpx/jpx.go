@@ -0,0 +1,338 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package jpx implements a decoder for JPEG 2000 (ITU-T T.800) images, either as a raw codestream
+// or in the JP2 file format, as used by the JPXDecode filter in PDF.
+//
+// The decoded image data has 8 bits per component.  Components with a different precision are
+// scaled, signed components are shifted to unsigned values and subsampled components are upsampled
+// to the full image size.
+package jpx
+
+import (
+	"errors"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+var (
+	// ErrInvalidData is returned when the data is not a valid JPEG 2000 image.
+	ErrInvalidData = errors.New("jpx: invalid data")
+	// ErrUnexpectedEOF is returned when the data ends prematurely.
+	ErrUnexpectedEOF = errors.New("jpx: unexpected end of data")
+	// ErrUnsupported is returned for JPEG 2000 features that are not supported.
+	ErrUnsupported = errors.New("jpx: unsupported feature")
+)
+
+// ColorSpace is the colour space of an image as specified by the JP2 header.
+type ColorSpace int
+
+// Colour spaces.
+const (
+	ColorSpaceUnknown ColorSpace = iota // Not specified, e.g. for raw codestreams.
+	ColorSpaceGray
+	ColorSpaceRGB // sRGB, or sYCC which is converted to sRGB when decoding.
+	ColorSpaceCMYK
+)
+
+// Config holds the image parameters that can be determined from the headers.
+type Config struct {
+	Width  int
+	Height int
+
+	// Components is the number of components in the codestream, including the alpha channel if any.
+	Components int
+
+	// Precision is the bit depth of the first component in the codestream.
+	Precision int
+
+	// ColorSpace is the colour space specified in the JP2 header.
+	ColorSpace ColorSpace
+
+	// AlphaChannel is the index of the opacity component or -1 if there is none.  The opacity
+	// channel is identified by the channel definition box of the JP2 header, or otherwise assumed
+	// to be the last component if there is one more component than required by the colour space.
+	AlphaChannel int
+}
+
+// ColorComponents returns the number of colour components, i.e. excluding the alpha channel.
+func (cfg *Config) ColorComponents() int {
+	if cfg.AlphaChannel >= 0 {
+		return cfg.Components - 1
+	}
+	return cfg.Components
+}
+
+// Image is a decoded image.
+type Image struct {
+	Config
+
+	// Data holds the colour components, 8 bits per component, interleaved.
+	Data []byte
+
+	// Alpha holds the alpha channel with 8 bits per sample, nil if there is no alpha channel.
+	Alpha []byte
+}
+
+// DecodeConfig returns the image parameters without decoding the image data.
+func DecodeConfig(data []byte) (*Config, error) {
+	file, err := parseFile(data)
+	if err != nil {
+		return nil, err
+	}
+	cs := &codestream{data: file.codestream}
+	if err := cs.parseMainHeader(); err != nil {
+		return nil, err
+	}
+	return file.config(cs.siz), nil
+}
+
+// Decode decodes the JPEG 2000 image `data`.
+func Decode(data []byte) (*Image, error) {
+	file, err := parseFile(data)
+	if err != nil {
+		return nil, err
+	}
+	cs := &codestream{data: file.codestream}
+	if err := cs.parseMainHeader(); err != nil {
+		return nil, err
+	}
+	if err := cs.decodeTiles(); err != nil {
+		return nil, err
+	}
+
+	cfg := file.config(cs.siz)
+	comps := cs.planes
+
+	if file.colorSpace == 18 && len(comps) >= 3 {
+		// sYCC.
+		convertYCCToRGB(comps)
+	}
+
+	img := &Image{Config: *cfg}
+	n := cfg.Width * cfg.Height
+	numColors := cfg.ColorComponents()
+	img.Data = make([]byte, n*numColors)
+	c := 0
+	for i := range comps {
+		if i == cfg.AlphaChannel {
+			img.Alpha = comps[i]
+			continue
+		}
+		if c >= numColors {
+			break
+		}
+		for j, v := range comps[i] {
+			img.Data[j*numColors+c] = v
+		}
+		c++
+	}
+	return img, nil
+}
+
+// jp2File holds the information from the JP2 boxes.
+type jp2File struct {
+	codestream []byte
+	colorSpace int   // Enumerated colour space of the colour specification box, 0 if not specified.
+	channels   []int // Channel types from the channel definition box, indexed by component.
+}
+
+// config returns the image configuration from the JP2 header and the SIZ marker segment.
+func (f *jp2File) config(s *siz) *Config {
+	cfg := &Config{
+		Width:        s.xsiz - s.xosiz,
+		Height:       s.ysiz - s.yosiz,
+		Components:   len(s.components),
+		Precision:    s.components[0].precision,
+		AlphaChannel: -1,
+	}
+
+	switch f.colorSpace {
+	case 16, 18, 20, 21:
+		cfg.ColorSpace = ColorSpaceRGB
+	case 17:
+		cfg.ColorSpace = ColorSpaceGray
+	case 12:
+		cfg.ColorSpace = ColorSpaceCMYK
+	}
+
+	for i, typ := range f.channels {
+		if (typ == 1 || typ == 2) && i < cfg.Components {
+			cfg.AlphaChannel = i
+			return cfg
+		}
+	}
+	if f.channels == nil {
+		numColors := 0
+		switch cfg.ColorSpace {
+		case ColorSpaceGray:
+			numColors = 1
+		case ColorSpaceRGB:
+			numColors = 3
+		case ColorSpaceCMYK:
+			numColors = 4
+		default:
+			if cfg.Components == 2 {
+				numColors = 1
+			}
+		}
+		if numColors > 0 && cfg.Components == numColors+1 {
+			cfg.AlphaChannel = cfg.Components - 1
+		}
+	}
+	return cfg
+}
+
+// parseFile parses the JP2 file format boxes (Annex I), or accepts a raw codestream.
+func parseFile(data []byte) (*jp2File, error) {
+	if len(data) >= 2 && data[0] == 0xFF && data[1] == 0x4F {
+		return &jp2File{codestream: data}, nil
+	}
+
+	f := &jp2File{}
+	if err := f.parseBoxes(data); err != nil {
+		return nil, err
+	}
+	if f.codestream == nil {
+		common.Log.Debug("JPX: no codestream found")
+		return nil, ErrInvalidData
+	}
+	return f, nil
+}
+
+// parseBoxes parses a sequence of boxes.
+func (f *jp2File) parseBoxes(data []byte) error {
+	for pos := 0; pos < len(data); {
+		if pos+8 > len(data) {
+			return ErrUnexpectedEOF
+		}
+		length := int(be32(data[pos:]))
+		typ := string(data[pos+4 : pos+8])
+		headerLen := 8
+		switch length {
+		case 0:
+			// The box extends to the end of the data.
+			length = len(data) - pos
+		case 1:
+			if pos+16 > len(data) {
+				return ErrUnexpectedEOF
+			}
+			xl := uint64(be32(data[pos+8:]))<<32 | uint64(be32(data[pos+12:]))
+			if xl > uint64(len(data)-pos) {
+				return ErrUnexpectedEOF
+			}
+			length = int(xl)
+			headerLen = 16
+		}
+		if length < headerLen || pos+length > len(data) {
+			if typ == "jp2c" && length >= headerLen {
+				// Truncated codestream, decode what is available.
+				length = len(data) - pos
+			} else {
+				return ErrInvalidData
+			}
+		}
+		content := data[pos+headerLen : pos+length]
+
+		switch typ {
+		case "jp2h":
+			if err := f.parseBoxes(content); err != nil {
+				return err
+			}
+		case "colr":
+			if f.colorSpace == 0 {
+				f.colorSpace = colorSpecification(content)
+			}
+		case "cdef":
+			f.parseChannelDefinitions(content)
+		case "pclr":
+			common.Log.Debug("JPX: palette is not supported, decoding palette indices")
+		case "jp2c":
+			if f.codestream == nil {
+				f.codestream = content
+			}
+		}
+		pos += length
+	}
+	return nil
+}
+
+// colorSpecification returns the enumerated colour space of a colour specification box (I.5.3.3).
+// For ICC profiles, the enumerated colour space corresponding to the profile's colour space is
+// returned.
+func colorSpecification(content []byte) int {
+	if len(content) < 7 {
+		return 0
+	}
+	if content[0] == 1 {
+		return int(be32(content[3:]))
+	}
+	if len(content) < 3+20 {
+		return 0
+	}
+	switch string(content[3+16 : 3+20]) {
+	case "RGB ":
+		return 16
+	case "GRAY":
+		return 17
+	case "CMYK":
+		return 12
+	}
+	return 0
+}
+
+// parseChannelDefinitions parses a channel definition box (I.5.3.6).
+func (f *jp2File) parseChannelDefinitions(content []byte) {
+	if len(content) < 2 {
+		return
+	}
+	n := int(content[0])<<8 | int(content[1])
+	for i := 0; i < n && 2+6*i+6 <= len(content); i++ {
+		entry := content[2+6*i:]
+		cn := int(entry[0])<<8 | int(entry[1])
+		typ := int(entry[2])<<8 | int(entry[3])
+		if cn > 16384 {
+			continue
+		}
+		for len(f.channels) <= cn {
+			f.channels = append(f.channels, 0)
+		}
+		f.channels[cn] = typ
+	}
+}
+
+// convertYCCToRGB converts the first three components from sYCC to sRGB.
+func convertYCCToRGB(comps [][]byte) {
+	y, cb, cr := comps[0], comps[1], comps[2]
+	for i := range y {
+		if i >= len(cb) || i >= len(cr) {
+			break
+		}
+		yv := float64(y[i])
+		cbv := float64(cb[i]) - 128
+		crv := float64(cr[i]) - 128
+		y[i] = clampByte(yv + 1.402*crv)
+		cb[i] = clampByte(yv - 0.344136*cbv - 0.714136*crv)
+		cr[i] = clampByte(yv + 1.772*cbv)
+	}
+}
+
+func clampByte(v float64) byte {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 255 {
+		return 255
+	}
+	return byte(v + 0.5)
+}
+
+func be32(b []byte) uint32 {
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+func be16(b []byte) int {
+	return int(b[0])<<8 | int(b[1])
+}
@@ -0,0 +1,898 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jpx
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/internal/mq/mqtest"
+)
+
+// bitWriter writes bits with bit stuffing after 0xFF bytes, for packet headers and raw passes.
+type bitWriter struct {
+	out      []byte
+	cur      byte
+	n        uint
+	capacity uint
+}
+
+func newBitWriter() *bitWriter {
+	return &bitWriter{capacity: 8}
+}
+
+func (w *bitWriter) writeBit(bit int) {
+	w.cur = w.cur<<1 | byte(bit)
+	w.n++
+	if w.n == w.capacity {
+		w.out = append(w.out, w.cur)
+		w.capacity = 8
+		if w.cur == 0xFF {
+			w.capacity = 7
+		}
+		w.cur, w.n = 0, 0
+	}
+}
+
+func (w *bitWriter) writeBits(v, n int) {
+	for i := n - 1; i >= 0; i-- {
+		w.writeBit((v >> uint(i)) & 1)
+	}
+}
+
+// flush pads the last byte with zeros.  A packet header ending with 0xFF is followed by a zero byte.
+func (w *bitWriter) flush(header bool) []byte {
+	if w.n > 0 {
+		w.out = append(w.out, w.cur<<(w.capacity-w.n))
+	} else if header && w.capacity == 7 {
+		w.out = append(w.out, 0)
+	}
+	return w.out
+}
+
+// t1Encoder encodes code-blocks, using the state of a t1Decoder to mirror the context modelling.
+type t1Encoder struct {
+	t1Decoder
+	values []int // Coefficient magnitudes.
+	signs  []uint8
+	cxs    [numContexts]mqtest.Context
+	mqe    *mqtest.Encoder
+	rawe   *bitWriter
+}
+
+type testSegment struct {
+	passes int
+	data   []byte
+}
+
+func (e *t1Encoder) resetEncoderContexts() {
+	for i := range e.cxs {
+		e.cxs[i] = mqtest.Context{}
+	}
+	e.cxs[0].SetState(4, 0)
+	e.cxs[ctxUniform].SetState(46, 0)
+	e.cxs[ctxRunLength].SetState(3, 0)
+}
+
+func (e *t1Encoder) encodeBit(cx, bit int) {
+	if e.rawe != nil {
+		e.rawe.writeBit(bit)
+		return
+	}
+	e.mqe.EncodeBit(&e.cxs[cx], bit)
+}
+
+func (e *t1Encoder) setSignificant(i, x, y int) {
+	if e.rawe != nil {
+		e.rawe.writeBit(int(e.signs[i]))
+	} else {
+		cx, xor := e.signContext(i, x, y)
+		e.mqe.EncodeBit(&e.cxs[cx], int(e.signs[i])^xor)
+	}
+	e.sign[i] = e.signs[i]
+	e.mag[i] = 1
+	e.t1Decoder.setSignificant(i, x, y)
+	e.flags[i] |= flagFirstRefinement
+}
+
+func (e *t1Encoder) significancePass(p uint) {
+	w, h := e.width, e.height
+	for y0 := 0; y0 < h; y0 += 4 {
+		for x := 0; x < w; x++ {
+			for y := y0; y < y0+4 && y < h; y++ {
+				i := y*w + x
+				e.flags[i] &^= flagVisited
+				if e.mag[i] != 0 || e.ns[i] == 0 {
+					continue
+				}
+				bit := (e.values[i] >> p) & 1
+				e.encodeBit(int(e.labels[e.ns[i]&0x7F]), bit)
+				if bit == 1 {
+					e.setSignificant(i, x, y)
+				}
+				e.flags[i] |= flagVisited
+			}
+		}
+	}
+}
+
+func (e *t1Encoder) refinementPass(p uint) {
+	w, h := e.width, e.height
+	for y0 := 0; y0 < h; y0 += 4 {
+		for x := 0; x < w; x++ {
+			for y := y0; y < y0+4 && y < h; y++ {
+				i := y*w + x
+				if e.mag[i] == 0 || e.flags[i]&flagVisited != 0 {
+					continue
+				}
+				cx := ctxRefinement + 2
+				if e.flags[i]&flagFirstRefinement != 0 {
+					e.flags[i] &^= flagFirstRefinement
+					cx = ctxRefinement + 1
+					if e.ns[i]&0x7F != 0 {
+						cx = ctxRefinement
+					}
+				}
+				e.encodeBit(cx, (e.values[i]>>p)&1)
+			}
+		}
+	}
+}
+
+func (e *t1Encoder) cleanupPass(p uint) {
+	w, h := e.width, e.height
+	for y0 := 0; y0 < h; y0 += 4 {
+		for x := 0; x < w; x++ {
+			y := y0
+			if y0+4 <= h && e.runLengthMode(x, y0) {
+				k := 0
+				for k < 4 && (e.values[(y0+k)*w+x]>>p)&1 == 0 {
+					k++
+				}
+				if k == 4 {
+					e.encodeBit(ctxRunLength, 0)
+					continue
+				}
+				e.encodeBit(ctxRunLength, 1)
+				e.encodeBit(ctxUniform, k>>1)
+				e.encodeBit(ctxUniform, k&1)
+				e.setSignificant((y0+k)*w+x, x, y0+k)
+				y = y0 + k + 1
+			}
+			for ; y < y0+4 && y < h; y++ {
+				i := y*w + x
+				if e.mag[i] != 0 || e.flags[i]&flagVisited != 0 {
+					continue
+				}
+				bit := (e.values[i] >> p) & 1
+				e.encodeBit(int(e.labels[e.ns[i]&0x7F]), bit)
+				if bit == 1 {
+					e.setSignificant(i, x, y)
+				}
+			}
+		}
+	}
+}
+
+// encodeCodeblock encodes the coefficients `coeffs` of a `w`x`h` code-block and returns the number
+// of zero bit planes and the codeword segments.
+func encodeCodeblock(coeffs []int, w, h, mb, orientation int, style *codingStyle) (int, []testSegment, error) {
+	e := &t1Encoder{}
+	e.values = make([]int, len(coeffs))
+	e.signs = make([]uint8, len(coeffs))
+	max := 0
+	for i, v := range coeffs {
+		if v < 0 {
+			e.signs[i], v = 1, -v
+		}
+		e.values[i] = v
+		if v > max {
+			max = v
+		}
+	}
+	planes := 0
+	for max>>uint(planes) != 0 {
+		planes++
+	}
+	if planes > mb {
+		return 0, nil, fmt.Errorf("%d bit planes exceed Mb=%d", planes, mb)
+	}
+	if planes == 0 {
+		return mb, nil, nil
+	}
+
+	e.reset(w, h, 0, orientation, style.cbStyle&cbVCausal != 0)
+	e.resetEncoderContexts()
+	total := 3*planes - 2
+	var segments []testSegment
+	for pass := 0; pass < total; {
+		n := minInt(segmentPasses(style, pass), total-pass)
+		raw := style.cbStyle&cbBypass != 0 && pass >= 10 && passType(pass) != passCleanup
+		e.mqe, e.rawe = nil, nil
+		if raw {
+			e.rawe = newBitWriter()
+		} else {
+			e.mqe = mqtest.NewEncoder()
+		}
+		for i := 0; i < n; i++ {
+			p := uint(planes - 1 - (pass+2)/3)
+			switch passType(pass) {
+			case passSignificance:
+				e.significancePass(p)
+			case passRefinement:
+				e.refinementPass(p)
+			case passCleanup:
+				e.cleanupPass(p)
+				if style.cbStyle&cbSegSymbol != 0 {
+					for _, bit := range []int{1, 0, 1, 0} {
+						e.encodeBit(ctxUniform, bit)
+					}
+				}
+			}
+			if style.cbStyle&cbReset != 0 {
+				e.resetEncoderContexts()
+			}
+			pass++
+		}
+		var data []byte
+		if raw {
+			data = e.rawe.flush(false)
+		} else {
+			data = e.mqe.Flush()
+		}
+		segments = append(segments, testSegment{passes: n, data: data})
+	}
+	return mb - planes, segments, nil
+}
+
+// analyze1D applies the forward 5-3 reversible transform to the samples `x`, where the first sample
+// has coordinate `i0`.
+func analyze1D(x []int, i0 int) {
+	n := len(x)
+	if n == 1 {
+		if i0&1 == 1 {
+			x[0] *= 2
+		}
+		return
+	}
+	buf := make([]int, n+2*dwtPad)
+	period := 2 * (n - 1)
+	for j := range buf {
+		k := ((j-dwtPad)%period + period) % period
+		if k >= n {
+			k = period - k
+		}
+		buf[j] = x[k]
+	}
+	even := func(j int) bool {
+		return (i0+j-dwtPad)&1 == 0
+	}
+	for j := 1; j < len(buf)-1; j++ {
+		if !even(j) {
+			buf[j] -= floorDiv(buf[j-1]+buf[j+1], 2)
+		}
+	}
+	for j := 2; j < len(buf)-2; j++ {
+		if even(j) {
+			buf[j] += floorDiv(buf[j-1]+buf[j+1]+2, 4)
+		}
+	}
+	copy(x, buf[dwtPad:])
+}
+
+// forwardDWT decomposes the tile-component samples `a` into the subbands of `tc`.
+func forwardDWT(tc *tileComponent, a []int) map[*subband][]int {
+	bands := map[*subband][]int{}
+	for r := len(tc.resolutions) - 1; r >= 1; r-- {
+		res, prev := tc.resolutions[r], tc.resolutions[r-1]
+		w, h := res.x1-res.x0, res.y1-res.y0
+		// Vertical filtering (VER_SD) precedes horizontal filtering (HOR_SD).
+		col := make([]int, h)
+		for x := 0; x < w; x++ {
+			for y := range col {
+				col[y] = a[y*w+x]
+			}
+			analyze1D(col, res.y0)
+			for y := range col {
+				a[y*w+x] = col[y]
+			}
+		}
+		for y := 0; y < h; y++ {
+			analyze1D(a[y*w:(y+1)*w], res.x0)
+		}
+
+		ll := make([]int, (prev.x1-prev.x0)*(prev.y1-prev.y0))
+		for _, sb := range res.subbands {
+			bands[sb] = make([]int, (sb.x1-sb.x0)*(sb.y1-sb.y0))
+		}
+		for y := 0; y < h; y++ {
+			v := res.y0 + y
+			for x := 0; x < w; x++ {
+				u := res.x0 + x
+				if u&1 == 0 && v&1 == 0 {
+					ll[(v>>1-prev.y0)*(prev.x1-prev.x0)+u>>1-prev.x0] = a[y*w+x]
+				} else {
+					sb := res.subbands[(u&1|(v&1)<<1)-1]
+					bands[sb][(v>>1-sb.y0)*(sb.x1-sb.x0)+u>>1-sb.x0] = a[y*w+x]
+				}
+			}
+		}
+		a = ll
+	}
+	bands[tc.resolutions[0].subbands[0]] = a
+	return bands
+}
+
+// testParams are the parameters of a test image.
+type testParams struct {
+	width, height  int
+	xosiz, yosiz   int
+	tileW, tileH   int
+	xtosiz, ytosiz int
+	comps          []component
+	levels         int
+	progression    int
+	layers         int
+	cbStyle        int
+	xcb, ycb       int
+	precincts      [][2]int
+	mct            bool
+	sop, eph       bool
+	tileParts      int
+}
+
+// testSample returns the sample of component `c` at (x, y) on the component's grid.
+func testSample(p *testParams, c, x, y int) int {
+	comp := p.comps[c]
+	maxV := 1<<uint(comp.precision) - 1
+	noise := int(uint32(x*73856093^y*19349663^c*83492791)>>7&31) - 16
+	v := ((x*3+y*2+c*37)&0xFF)*maxV/255 + noise
+	if x%7 == 3 && y%5 == 1 {
+		v = maxV - v
+	}
+	if v < 0 {
+		v = 0
+	}
+	if v > maxV {
+		v = maxV
+	}
+	if comp.signed {
+		v -= 1 << uint(comp.precision-1)
+	}
+	return v
+}
+
+func u16(v int) []byte {
+	return []byte{byte(v >> 8), byte(v)}
+}
+
+func u32(v int) []byte {
+	return []byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+func markerSegment(marker int, content ...[]byte) []byte {
+	b := bytes.Join(content, nil)
+	return append(append(u16(marker), u16(len(b)+2)...), b...)
+}
+
+// encodeTestImage encodes the test image losslessly with the 5-3 filter.
+func encodeTestImage(p *testParams) ([]byte, error) {
+	if p.tileW == 0 {
+		p.tileW, p.tileH = p.xosiz+p.width, p.yosiz+p.height
+	}
+	var sizComps []byte
+	prec := 0
+	for _, comp := range p.comps {
+		s := byte(comp.precision - 1)
+		if comp.signed {
+			s |= 0x80
+		}
+		sizComps = append(sizComps, s, byte(comp.dx), byte(comp.dy))
+		prec = maxInt(prec, comp.precision)
+	}
+	siz := markerSegment(markerSIZ, u16(0), u32(p.xosiz+p.width), u32(p.yosiz+p.height),
+		u32(p.xosiz), u32(p.yosiz), u32(p.tileW), u32(p.tileH), u32(p.xtosiz), u32(p.ytosiz),
+		u16(len(p.comps)), sizComps)
+
+	scod := 0
+	if p.precincts != nil {
+		scod |= 1
+	}
+	if p.sop {
+		scod |= 2
+	}
+	if p.eph {
+		scod |= 4
+	}
+	mct := 0
+	if p.mct {
+		mct = 1
+	}
+	var precincts []byte
+	for _, pp := range p.precincts {
+		precincts = append(precincts, byte(pp[1]<<4|pp[0]))
+	}
+	cod := markerSegment(markerCOD, []byte{byte(scod), byte(p.progression)}, u16(p.layers),
+		[]byte{byte(mct), byte(p.levels), byte(p.xcb - 2), byte(p.ycb - 2), byte(p.cbStyle), 1}, precincts)
+
+	// No quantization, with enough bit planes for the dynamic range of the subbands.
+	steps := []byte{byte(prec+2) << 3}
+	for i := 0; i < p.levels; i++ {
+		steps = append(steps, byte(prec+3)<<3, byte(prec+3)<<3, byte(prec+4)<<3)
+	}
+	qcd := markerSegment(markerQCD, []byte{2 << 5}, steps)
+
+	header := bytes.Join([][]byte{u16(markerSOC), siz, cod, qcd}, nil)
+
+	// The code-block partition and packet order are determined as by the decoder.
+	cs := &codestream{data: append(append([]byte{}, header...), markerSegment(markerSOT, make([]byte, 8))...)}
+	if err := cs.parseMainHeader(); err != nil {
+		return nil, err
+	}
+	s := cs.siz
+	cs.numXTiles = ceilDiv(s.xsiz-s.xtosiz, s.xtsiz)
+	cs.numYTiles = ceilDiv(s.ysiz-s.ytosiz, s.ytsiz)
+
+	out := header
+	for index := 0; index < cs.numXTiles*cs.numYTiles; index++ {
+		t := cs.newTile(index)
+		cs.initTile(t)
+		packets, err := encodeTile(p, t)
+		if err != nil {
+			return nil, err
+		}
+
+		parts := maxInt(p.tileParts, 1)
+		for part := 0; part < parts; part++ {
+			var data []byte
+			for _, pkt := range packets[part*len(packets)/parts : (part+1)*len(packets)/parts] {
+				data = append(data, pkt...)
+			}
+			out = append(out, markerSegment(markerSOT, u16(index), u32(12+2+len(data)), []byte{byte(part), byte(parts)})...)
+			out = append(out, u16(markerSOD)...)
+			out = append(out, data...)
+		}
+	}
+	return append(out, u16(markerEOC)...), nil
+}
+
+// encodedBlock is an encoded code-block and the state of its inclusion in packets.
+type encodedBlock struct {
+	zeroBitPlanes int
+	segments      []testSegment
+	layerEnd      []int // Number of coding passes included up to each layer.
+	sent          int
+	segmentSent   []int
+	included      bool
+	lblock        int
+}
+
+// encodeTile returns the packets of tile `t`.
+func encodeTile(p *testParams, t *tile) ([][]byte, error) {
+	comps := make([][]int, len(t.comps))
+	for c, tc := range t.comps {
+		w, h := tc.x1-tc.x0, tc.y1-tc.y0
+		comps[c] = make([]int, w*h)
+		shift := 0
+		if !p.comps[c].signed {
+			shift = 1 << uint(p.comps[c].precision-1)
+		}
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				comps[c][y*w+x] = testSample(p, c, tc.x0+x, tc.y0+y) - shift
+			}
+		}
+	}
+	if p.mct {
+		for i := range comps[0] {
+			r, g, b := comps[0][i], comps[1][i], comps[2][i]
+			comps[0][i], comps[1][i], comps[2][i] = floorDiv(r+2*g+b, 4), b-g, r-g
+		}
+	}
+
+	blocks := map[*codeblock]*encodedBlock{}
+	for c, tc := range t.comps {
+		bands := forwardDWT(tc, comps[c])
+		for _, res := range tc.resolutions {
+			for _, sb := range res.subbands {
+				for _, cb := range sb.codeblocks {
+					w, h := cb.x1-cb.x0, cb.y1-cb.y0
+					coeffs := make([]int, w*h)
+					for y := 0; y < h; y++ {
+						for x := 0; x < w; x++ {
+							coeffs[y*w+x] = bands[sb][(cb.y0-sb.y0+y)*(sb.x1-sb.x0)+cb.x0-sb.x0+x]
+						}
+					}
+					zbp, segments, err := encodeCodeblock(coeffs, w, h, sb.mb, sb.orientation, tc.style)
+					if err != nil {
+						return nil, err
+					}
+					b := &encodedBlock{zeroBitPlanes: zbp, segments: segments, lblock: 3}
+					b.segmentSent = make([]int, len(segments))
+					total := 0
+					for _, seg := range segments {
+						total += seg.passes
+					}
+					for l := 0; l < p.layers; l++ {
+						b.layerEnd = append(b.layerEnd, total*(l+1)/p.layers)
+					}
+					blocks[cb] = b
+				}
+			}
+		}
+	}
+
+	type trees struct{ inclusion, zeroBitPlanes *tagTreeEncoder }
+	precinctTrees := map[*precinct]*trees{}
+	var packets [][]byte
+	for n, pkt := range t.packets {
+		res := t.comps[pkt.comp].resolutions[pkt.res]
+		w := newBitWriter()
+		var body []byte
+		var precincts []*precinct
+		empty := true
+		for _, sb := range res.subbands {
+			if pkt.precinct < len(sb.precincts) && sb.precincts[pkt.precinct] != nil {
+				prc := sb.precincts[pkt.precinct]
+				precincts = append(precincts, prc)
+				for _, cb := range prc.codeblocks {
+					b := blocks[cb]
+					if b.layerEnd[pkt.layer] > b.sent {
+						empty = false
+					}
+				}
+			}
+		}
+
+		if empty {
+			w.writeBit(0)
+		} else {
+			w.writeBit(1)
+			for _, prc := range precincts {
+				tr := precinctTrees[prc]
+				if tr == nil {
+					tr = &trees{
+						inclusion:     newTagTreeEncoder(prc.cbx1-prc.cbx0+1, prc.cby1-prc.cby0+1),
+						zeroBitPlanes: newTagTreeEncoder(prc.cbx1-prc.cbx0+1, prc.cby1-prc.cby0+1),
+					}
+					for _, cb := range prc.codeblocks {
+						b := blocks[cb]
+						first := p.layers
+						for l := p.layers - 1; l >= 0; l-- {
+							if b.layerEnd[l] > 0 {
+								first = l
+							}
+						}
+						tr.inclusion.set(cb.cbx-prc.cbx0, cb.cby-prc.cby0, first)
+						tr.zeroBitPlanes.set(cb.cbx-prc.cbx0, cb.cby-prc.cby0, b.zeroBitPlanes)
+					}
+					tr.inclusion.build()
+					tr.zeroBitPlanes.build()
+					precinctTrees[prc] = tr
+				}
+
+				for _, cb := range prc.codeblocks {
+					b := blocks[cb]
+					x, y := cb.cbx-prc.cbx0, cb.cby-prc.cby0
+					passes := b.layerEnd[pkt.layer] - b.sent
+					if b.included {
+						if passes == 0 {
+							w.writeBit(0)
+							continue
+						}
+						w.writeBit(1)
+					} else {
+						tr.inclusion.encode(w, x, y, pkt.layer+1)
+						if passes == 0 {
+							continue
+						}
+						b.included = true
+						tr.zeroBitPlanes.encode(w, x, y, b.zeroBitPlanes+1)
+					}
+
+					// Number of coding passes (Table B.4).
+					switch {
+					case passes == 1:
+						w.writeBits(0, 1)
+					case passes == 2:
+						w.writeBits(2, 2)
+					case passes <= 5:
+						w.writeBits(0xC|(passes-3), 4)
+					case passes <= 36:
+						w.writeBits(0x1E0|(passes-6), 9)
+					default:
+						w.writeBits(0xFF80|(passes-37), 16)
+					}
+
+					type part struct {
+						passes int
+						data   []byte
+					}
+					var parts []part
+					for passes > 0 {
+						i, start := 0, 0
+						for start+b.segments[i].passes <= b.sent {
+							start += b.segments[i].passes
+							i++
+						}
+						seg := b.segments[i]
+						k := minInt(passes, start+seg.passes-b.sent)
+						cut := len(seg.data)
+						if b.sent+k < start+seg.passes {
+							cut = len(seg.data) * (b.sent + k - start) / seg.passes
+						}
+						parts = append(parts, part{k, seg.data[b.segmentSent[i]:cut]})
+						b.segmentSent[i] = cut
+						b.sent += k
+						passes -= k
+					}
+
+					increment := 0
+					for _, pt := range parts {
+						bits := 0
+						for len(pt.data)>>uint(bits) != 0 {
+							bits++
+						}
+						increment = maxInt(increment, bits-floorLog2(pt.passes)-b.lblock)
+					}
+					for i := 0; i < increment; i++ {
+						w.writeBit(1)
+					}
+					w.writeBit(0)
+					b.lblock += increment
+					for _, pt := range parts {
+						w.writeBits(len(pt.data), b.lblock+floorLog2(pt.passes))
+						body = append(body, pt.data...)
+					}
+				}
+			}
+		}
+
+		var packet []byte
+		if p.sop {
+			packet = append(packet, markerSegment(markerSOP, u16(n&0xFFFF))...)
+		}
+		packet = append(packet, w.flush(true)...)
+		if p.eph {
+			packet = append(packet, u16(markerEPH)...)
+		}
+		packets = append(packets, append(packet, body...))
+	}
+	return packets, nil
+}
+
+// tagTreeEncoder is a tag tree encoder (B.10.2).
+type tagTreeEncoder struct {
+	widths  []int
+	heights []int
+	levels  [][]tagEncoderNode
+}
+
+type tagEncoderNode struct {
+	value, low int
+	known      bool
+}
+
+func newTagTreeEncoder(w, h int) *tagTreeEncoder {
+	t := &tagTreeEncoder{}
+	for {
+		t.widths = append(t.widths, w)
+		t.heights = append(t.heights, h)
+		t.levels = append(t.levels, make([]tagEncoderNode, w*h))
+		if w == 1 && h == 1 {
+			break
+		}
+		w, h = (w+1)/2, (h+1)/2
+	}
+	return t
+}
+
+func (t *tagTreeEncoder) set(x, y, v int) {
+	t.levels[0][y*t.widths[0]+x].value = v
+}
+
+// build sets the values of the internal nodes to the minimum of their children.
+func (t *tagTreeEncoder) build() {
+	for lvl := 1; lvl < len(t.levels); lvl++ {
+		for i := range t.levels[lvl] {
+			t.levels[lvl][i].value = 1 << 30
+		}
+		for y := 0; y < t.heights[lvl-1]; y++ {
+			for x := 0; x < t.widths[lvl-1]; x++ {
+				parent := &t.levels[lvl][(y/2)*t.widths[lvl]+x/2]
+				parent.value = minInt(parent.value, t.levels[lvl-1][y*t.widths[lvl-1]+x].value)
+			}
+		}
+	}
+}
+
+func (t *tagTreeEncoder) encode(w *bitWriter, x, y, threshold int) {
+	low := 0
+	for lvl := len(t.levels) - 1; lvl >= 0; lvl-- {
+		n := &t.levels[lvl][(y>>uint(lvl))*t.widths[lvl]+x>>uint(lvl)]
+		if low > n.low {
+			n.low = low
+		} else {
+			low = n.low
+		}
+		for low < threshold {
+			if low >= n.value {
+				if !n.known {
+					w.writeBit(1)
+					n.known = true
+				}
+				break
+			}
+			w.writeBit(0)
+			low++
+		}
+		n.low = low
+	}
+}
+
+// expectedImage returns the expected decoded image data of the test image.
+func expectedImage(p *testParams) [][]byte {
+	planes := make([][]byte, len(p.comps))
+	for c, comp := range p.comps {
+		planes[c] = make([]byte, p.width*p.height)
+		offset := 0
+		if comp.signed {
+			offset = 1 << uint(comp.precision-1)
+		}
+		scale := 255 / float32(int(1)<<uint(comp.precision)-1)
+		for y := 0; y < p.height; y++ {
+			for x := 0; x < p.width; x++ {
+				v := testSample(p, c, (p.xosiz+x)/comp.dx, (p.yosiz+y)/comp.dy) + offset
+				planes[c][y*p.width+x] = byte(float32(v)*scale + 0.5)
+			}
+		}
+	}
+	return planes
+}
+
+func TestDecodeLossless(t *testing.T) {
+	gray := []component{{precision: 8, dx: 1, dy: 1}}
+	rgb := []component{{precision: 8, dx: 1, dy: 1}, {precision: 8, dx: 1, dy: 1}, {precision: 8, dx: 1, dy: 1}}
+	gray12 := []component{{precision: 12, dx: 1, dy: 1}}
+
+	cases := []struct {
+		name string
+		p    testParams
+	}{
+		{"LRCP", testParams{width: 33, height: 27, comps: gray, levels: 2, layers: 1, xcb: 4, ycb: 4}},
+		{"no decomposition", testParams{width: 20, height: 9, comps: gray, levels: 0, layers: 1, xcb: 3, ycb: 4}},
+		{"RCT RLCP layers", testParams{width: 40, height: 30, comps: rgb, levels: 3, layers: 3,
+			progression: progressionRLCP, xcb: 3, ycb: 3, mct: true}},
+		{"tiles RPCL precincts", testParams{width: 37, height: 29, xosiz: 3, yosiz: 5, tileW: 16, tileH: 12,
+			xtosiz: 1, ytosiz: 2, comps: rgb, levels: 2, layers: 2, progression: progressionRPCL,
+			xcb: 3, ycb: 2, precincts: [][2]int{{2, 2}, {3, 3}, {4, 3}}}},
+		{"PCRL subsampled", testParams{width: 45, height: 31, comps: []component{{precision: 8, dx: 1, dy: 1},
+			{precision: 8, dx: 2, dy: 2}, {precision: 8, dx: 2, dy: 1}}, levels: 3, layers: 2,
+			progression: progressionPCRL, xcb: 2, ycb: 3, precincts: [][2]int{{3, 3}, {3, 3}, {4, 4}, {4, 4}}}},
+		{"CPRL code-block styles", testParams{width: 30, height: 26, tileW: 17, tileH: 14, comps: gray12,
+			levels: 2, layers: 2, progression: progressionCPRL, xcb: 3, ycb: 3,
+			cbStyle: cbBypass | cbReset | cbTermAll | cbVCausal | cbSegSymbol, sop: true, eph: true, tileParts: 2}},
+		{"bypass", testParams{width: 24, height: 21, comps: gray12, levels: 1, layers: 3, xcb: 3, ycb: 3,
+			cbStyle: cbBypass}},
+		{"signed", testParams{width: 19, height: 14, comps: []component{{precision: 8, signed: true, dx: 1, dy: 1}},
+			levels: 1, layers: 1, xcb: 4, ycb: 4, sop: true}},
+	}
+
+	for _, tc := range cases {
+		p := tc.p
+		data, err := encodeTestImage(&p)
+		if err != nil {
+			t.Fatalf("%s: encoding failed: %v", tc.name, err)
+		}
+		img, err := Decode(data)
+		if err != nil {
+			t.Fatalf("%s: decoding failed: %v", tc.name, err)
+		}
+		if img.Width != p.width || img.Height != p.height || img.Components != len(p.comps) {
+			t.Fatalf("%s: wrong image parameters %+v", tc.name, img.Config)
+		}
+		want := expectedImage(&p)
+		n := len(p.comps)
+	compare:
+		for c := range want {
+			for i, v := range want[c] {
+				if got := img.Data[i*n+c]; got != v {
+					t.Errorf("%s: component %d sample (%d,%d) = %d, want %d", tc.name, c, i%p.width, i/p.width, got, v)
+					break compare
+				}
+			}
+		}
+
+		// Truncated data is decoded as far as possible.
+		if _, err := Decode(data[:len(data)*2/3]); err != nil {
+			t.Errorf("%s: decoding truncated data failed: %v", tc.name, err)
+		}
+	}
+}
+
+// box returns a JP2 box.
+func box(typ string, content ...[]byte) []byte {
+	b := bytes.Join(content, nil)
+	return append(append(u32(len(b)+8), typ...), b...)
+}
+
+func TestDecodeJP2(t *testing.T) {
+	rgba := testParams{width: 12, height: 10, levels: 1, layers: 1, xcb: 4, ycb: 4,
+		comps: []component{{precision: 8, dx: 1, dy: 1}, {precision: 8, dx: 1, dy: 1},
+			{precision: 8, dx: 1, dy: 1}, {precision: 8, dx: 1, dy: 1}}}
+	codestream, err := encodeTestImage(&rgba)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := expectedImage(&rgba)
+
+	signature := box("jP  ", []byte{0x0D, 0x0A, 0x87, 0x0A})
+	ftyp := box("ftyp", []byte("jp2 \x00\x00\x00\x00jp2 "))
+	ihdr := box("ihdr", u32(10), u32(12), u16(4), []byte{7, 7, 0, 0})
+	colr := func(cs int) []byte {
+		return box("colr", []byte{1, 0, 0}, u32(cs))
+	}
+	// The alpha channel is the first component.
+	cdef := box("cdef", u16(4), u16(0), u16(1), u16(0), u16(1), u16(0), u16(1),
+		u16(2), u16(0), u16(2), u16(3), u16(0), u16(3))
+
+	cases := []struct {
+		name       string
+		data       []byte
+		colorSpace ColorSpace
+		alpha      int
+	}{
+		{"raw codestream", codestream, ColorSpaceUnknown, -1},
+		{"CMYK", bytes.Join([][]byte{signature, ftyp, box("jp2h", ihdr, colr(12)), box("jp2c", codestream)}, nil),
+			ColorSpaceCMYK, -1},
+		{"RGBA", bytes.Join([][]byte{signature, ftyp, box("jp2h", ihdr, colr(16)), box("jp2c", codestream)}, nil),
+			ColorSpaceRGB, 3},
+		{"channel definition", bytes.Join([][]byte{signature, ftyp, box("jp2h", ihdr, colr(16), cdef),
+			box("jp2c", codestream)}, nil), ColorSpaceRGB, 0},
+	}
+	for _, tc := range cases {
+		cfg, err := DecodeConfig(tc.data)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if cfg.Width != 12 || cfg.Height != 10 || cfg.Components != 4 || cfg.Precision != 8 ||
+			cfg.ColorSpace != tc.colorSpace || cfg.AlphaChannel != tc.alpha {
+			t.Fatalf("%s: wrong configuration %+v", tc.name, *cfg)
+		}
+
+		img, err := Decode(tc.data)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if img.Config != *cfg {
+			t.Fatalf("%s: configuration %+v differs from %+v", tc.name, img.Config, *cfg)
+		}
+		numColors := cfg.ColorComponents()
+		if len(img.Data) != 12*10*numColors {
+			t.Fatalf("%s: wrong data length %d", tc.name, len(img.Data))
+		}
+		if tc.alpha >= 0 && !bytes.Equal(img.Alpha, want[tc.alpha]) {
+			t.Errorf("%s: wrong alpha channel", tc.name)
+		}
+		c := 0
+		for i := range want {
+			if i == tc.alpha {
+				continue
+			}
+			for j, v := range want[i] {
+				if img.Data[j*numColors+c] != v {
+					t.Fatalf("%s: wrong sample %d of component %d", tc.name, j, i)
+				}
+			}
+			c++
+		}
+	}
+
+	if _, err := Decode([]byte("not an image")); err == nil {
+		t.Errorf("invalid data decoded without error")
+	}
+}
@@ -0,0 +1,710 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jpx
+
+import (
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+)
+
+// Progression orders (Table A.16).
+const (
+	progressionLRCP = 0
+	progressionRLCP = 1
+	progressionRPCL = 2
+	progressionPCRL = 3
+	progressionCPRL = 4
+)
+
+// Subband orientations.
+const (
+	bandLL = 0
+	bandHL = 1
+	bandLH = 2
+	bandHH = 3
+)
+
+// tile is a tile of the image with the coding parameters from the tile-part headers.
+type tile struct {
+	index          int
+	x0, y0, x1, y1 int
+
+	cod *cod
+	coc map[int]*codingStyle
+	qcd *quantization
+	qcc map[int]*quantization
+
+	initialized bool
+	params      *cod // Effective coding style default parameters.
+	comps       []*tileComponent
+	packets     []packet // Packets in progression order.
+	nextPacket  int
+}
+
+// tileComponent is a component of a tile (B.3).
+type tileComponent struct {
+	x0, y0, x1, y1 int
+	style          *codingStyle
+	quant          *quantization
+	resolutions    []*resolution
+}
+
+// resolution is a resolution level of a tile-component (B.5).
+type resolution struct {
+	x0, y0, x1, y1 int
+	ppx, ppy       int // Precinct size exponents.
+	precinctsWide  int
+	precinctsHigh  int
+	subbands       []*subband
+}
+
+// subband is a subband of a resolution level, partitioned into code-blocks.
+type subband struct {
+	orientation    int
+	x0, y0, x1, y1 int
+	precincts      []*precinct // Indexed by precinct number, nil for precincts without code-blocks.
+	codeblocks     []*codeblock
+
+	// Quantization.
+	delta float32 // Quantization step size.
+	mb    int     // Number of magnitude bit planes.
+
+	coeffs []float32 // Dequantized coefficients.
+}
+
+// precinct is the part of a subband contained in a precinct of its resolution level (B.6).
+type precinct struct {
+	cbx0, cby0, cbx1, cby1 int // Range of code-block indices, inclusive.
+	codeblocks             []*codeblock
+	inclusion              *tagTree
+	zeroBitPlanes          *tagTree
+}
+
+// codeblock is a code-block and the coded data read for it so far (B.7).
+type codeblock struct {
+	cbx, cby       int // Code-block indices in the subband.
+	x0, y0, x1, y1 int
+
+	included      bool
+	zeroBitPlanes int
+	lblock        int
+	passes        int // Total number of coding passes read.
+	segments      []*codewordSegment
+}
+
+// codewordSegment is a terminated segment of coding passes.
+type codewordSegment struct {
+	passes    int
+	maxPasses int
+	data      []byte
+}
+
+// packet identifies a packet by its layer, component, resolution level and precinct number.
+type packet struct {
+	layer, comp, res, precinct int
+}
+
+// initTile determines the coding parameters and code-block partition of the tile and the order of
+// its packets.
+func (cs *codestream) initTile(t *tile) {
+	t.params = cs.cod
+	if t.cod != nil {
+		t.params = t.cod
+	}
+
+	for c, comp := range cs.siz.components {
+		tc := &tileComponent{
+			x0: ceilDiv(t.x0, comp.dx),
+			y0: ceilDiv(t.y0, comp.dy),
+			x1: ceilDiv(t.x1, comp.dx),
+			y1: ceilDiv(t.y1, comp.dy),
+		}
+
+		// Tile-part COC > tile-part COD > main COC > main COD, likewise for quantization.
+		switch {
+		case t.coc[c] != nil:
+			tc.style = t.coc[c]
+		case t.cod != nil:
+			tc.style = t.cod.style
+		case cs.coc[c] != nil:
+			tc.style = cs.coc[c]
+		default:
+			tc.style = cs.cod.style
+		}
+		switch {
+		case t.qcc[c] != nil:
+			tc.quant = t.qcc[c]
+		case t.qcd != nil:
+			tc.quant = t.qcd
+		case cs.qcc[c] != nil:
+			tc.quant = cs.qcc[c]
+		default:
+			tc.quant = cs.qcd
+		}
+
+		tc.build(comp.precision)
+		t.comps = append(t.comps, tc)
+	}
+
+	t.buildPacketOrder(cs.siz)
+	t.initialized = true
+}
+
+// build partitions the tile-component into resolution levels, subbands, precincts and code-blocks.
+func (tc *tileComponent) build(precision int) {
+	style := tc.style
+	nl := style.levels
+	band := 0
+	for r := 0; r <= nl; r++ {
+		scale := uint(nl - r)
+		res := &resolution{
+			x0:  ceilDiv(tc.x0, 1<<scale),
+			y0:  ceilDiv(tc.y0, 1<<scale),
+			x1:  ceilDiv(tc.x1, 1<<scale),
+			y1:  ceilDiv(tc.y1, 1<<scale),
+			ppx: 15,
+			ppy: 15,
+		}
+		if style.customPrecincts && r < len(style.precincts) {
+			res.ppx, res.ppy = style.precincts[r][0], style.precincts[r][1]
+		}
+		if res.x1 > res.x0 && res.y1 > res.y0 {
+			res.precinctsWide = ceilDiv(res.x1, 1<<uint(res.ppx)) - res.x0>>uint(res.ppx)
+			res.precinctsHigh = ceilDiv(res.y1, 1<<uint(res.ppy)) - res.y0>>uint(res.ppy)
+		}
+
+		// Precinct and code-block size exponents in the subbands.
+		pxs, pys := res.ppx, res.ppy
+		if r > 0 {
+			pxs, pys = maxInt(pxs-1, 0), maxInt(pys-1, 0)
+		}
+		xcb, ycb := minInt(style.xcb, pxs), minInt(style.ycb, pys)
+
+		if r == 0 {
+			sb := &subband{orientation: bandLL, x0: res.x0, y0: res.y0, x1: res.x1, y1: res.y1}
+			res.subbands = append(res.subbands, sb)
+		} else {
+			// Subband coordinates (B-15).
+			n := scale + 1
+			for _, o := range []int{bandHL, bandLH, bandHH} {
+				xo, yo := o&1, o>>1
+				sb := &subband{
+					orientation: o,
+					x0:          ceilDiv(tc.x0-xo<<(n-1), 1<<n),
+					y0:          ceilDiv(tc.y0-yo<<(n-1), 1<<n),
+					x1:          ceilDiv(tc.x1-xo<<(n-1), 1<<n),
+					y1:          ceilDiv(tc.y1-yo<<(n-1), 1<<n),
+				}
+				res.subbands = append(res.subbands, sb)
+			}
+		}
+
+		for _, sb := range res.subbands {
+			sb.setQuantization(tc.quant, style, precision, r, band)
+			sb.build(res, xcb, ycb, pxs, pys)
+			band++
+		}
+		tc.resolutions = append(tc.resolutions, res)
+	}
+}
+
+// setQuantization sets the quantization step size of subband number `band` of resolution level `r`
+// (E.1.1).
+func (sb *subband) setQuantization(q *quantization, style *codingStyle, precision, r, band int) {
+	var step stepSize
+	switch {
+	case q.style == quantScalarDerived:
+		// Derived from the LL step size (E-5).
+		step = q.steps[0]
+		if r > 0 {
+			step.epsilon += 1 - r
+		}
+	case band < len(q.steps):
+		step = q.steps[band]
+	default:
+		step = q.steps[len(q.steps)-1]
+	}
+
+	gain := 0
+	switch sb.orientation {
+	case bandHL, bandLH:
+		gain = 1
+	case bandHH:
+		gain = 2
+	}
+	sb.mb = q.guardBits + step.epsilon - 1
+	sb.delta = 1
+	if !style.reversible {
+		sb.delta = float32(math.Ldexp(1+float64(step.mu)/2048, precision+gain-step.epsilon))
+	}
+}
+
+// build partitions the subband into code-blocks and groups them by precinct.
+func (sb *subband) build(res *resolution, xcb, ycb, pxs, pys int) {
+	if sb.x1 <= sb.x0 || sb.y1 <= sb.y0 || res.precinctsWide == 0 || res.precinctsHigh == 0 {
+		return
+	}
+	cbw, cbh := 1<<uint(xcb), 1<<uint(ycb)
+	prcX0, prcY0 := res.x0>>uint(res.ppx), res.y0>>uint(res.ppy)
+	sb.precincts = make([]*precinct, res.precinctsWide*res.precinctsHigh)
+
+	for j := floorDiv(sb.y0, cbh); j*cbh < sb.y1; j++ {
+		for i := floorDiv(sb.x0, cbw); i*cbw < sb.x1; i++ {
+			cb := &codeblock{
+				cbx:    i,
+				cby:    j,
+				x0:     maxInt(i*cbw, sb.x0),
+				y0:     maxInt(j*cbh, sb.y0),
+				x1:     minInt((i+1)*cbw, sb.x1),
+				y1:     minInt((j+1)*cbh, sb.y1),
+				lblock: 3,
+			}
+			px := (i*cbw)>>uint(pxs) - prcX0
+			py := (j*cbh)>>uint(pys) - prcY0
+			if px < 0 || px >= res.precinctsWide || py < 0 || py >= res.precinctsHigh {
+				common.Log.Debug("JPX: code-block (%d,%d) outside of precincts", i, j)
+				continue
+			}
+			k := px + py*res.precinctsWide
+			prc := sb.precincts[k]
+			if prc == nil {
+				prc = &precinct{cbx0: i, cby0: j}
+				sb.precincts[k] = prc
+			}
+			prc.cbx1, prc.cby1 = maxInt(prc.cbx1, i), maxInt(prc.cby1, j)
+			prc.codeblocks = append(prc.codeblocks, cb)
+			sb.codeblocks = append(sb.codeblocks, cb)
+		}
+	}
+	for _, prc := range sb.precincts {
+		if prc != nil {
+			w, h := prc.cbx1-prc.cbx0+1, prc.cby1-prc.cby0+1
+			prc.inclusion = newTagTree(w, h)
+			prc.zeroBitPlanes = newTagTree(w, h)
+		}
+	}
+}
+
+// buildPacketOrder determines the sequence of packets of the tile according to the progression
+// order (B.12.1).
+func (t *tile) buildPacketOrder(s *siz) {
+	layers := t.params.layers
+	maxRes := 0
+	for _, tc := range t.comps {
+		maxRes = maxInt(maxRes, len(tc.resolutions))
+	}
+	add := func(l, c, r, k int) {
+		t.packets = append(t.packets, packet{layer: l, comp: c, res: r, precinct: k})
+	}
+	allPrecincts := func(l, c, r int) {
+		if r >= len(t.comps[c].resolutions) {
+			return
+		}
+		res := t.comps[c].resolutions[r]
+		for k := 0; k < res.precinctsWide*res.precinctsHigh; k++ {
+			add(l, c, r, k)
+		}
+	}
+	allLayers := func(c, r, x, y int) {
+		if k, ok := t.precinctAt(s, c, r, x, y); ok {
+			for l := 0; l < layers; l++ {
+				add(l, c, r, k)
+			}
+		}
+	}
+
+	switch t.params.progression {
+	case progressionLRCP:
+		for l := 0; l < layers; l++ {
+			for r := 0; r < maxRes; r++ {
+				for c := range t.comps {
+					allPrecincts(l, c, r)
+				}
+			}
+		}
+	case progressionRLCP:
+		for r := 0; r < maxRes; r++ {
+			for l := 0; l < layers; l++ {
+				for c := range t.comps {
+					allPrecincts(l, c, r)
+				}
+			}
+		}
+	case progressionRPCL:
+		for r := 0; r < maxRes; r++ {
+			t.forEachPosition(s, func(x, y int) {
+				for c := range t.comps {
+					allLayers(c, r, x, y)
+				}
+			})
+		}
+	case progressionPCRL:
+		t.forEachPosition(s, func(x, y int) {
+			for c := range t.comps {
+				for r := 0; r < len(t.comps[c].resolutions); r++ {
+					allLayers(c, r, x, y)
+				}
+			}
+		})
+	case progressionCPRL:
+		for c := range t.comps {
+			t.forEachPosition(s, func(x, y int) {
+				for r := 0; r < len(t.comps[c].resolutions); r++ {
+					allLayers(c, r, x, y)
+				}
+			})
+		}
+	}
+}
+
+// forEachPosition calls `fn` for the positions on the reference grid of the tile where a precinct
+// of some tile-component can start, in raster order.
+func (t *tile) forEachPosition(s *siz, fn func(x, y int)) {
+	stepX, stepY := math.MaxInt32, math.MaxInt32
+	for c, tc := range t.comps {
+		nl := len(tc.resolutions) - 1
+		for r, res := range tc.resolutions {
+			stepX = minInt(stepX, s.components[c].dx<<uint(res.ppx+nl-r))
+			stepY = minInt(stepY, s.components[c].dy<<uint(res.ppy+nl-r))
+		}
+	}
+	for y := t.y0; y < t.y1; y += stepY - y%stepY {
+		for x := t.x0; x < t.x1; x += stepX - x%stepX {
+			fn(x, y)
+		}
+	}
+}
+
+// precinctAt returns the number of the precinct of resolution level `r` of component `c` that
+// starts at position (x, y) of the reference grid, if any.
+func (t *tile) precinctAt(s *siz, c, r, x, y int) (int, bool) {
+	tc := t.comps[c]
+	if r >= len(tc.resolutions) {
+		return 0, false
+	}
+	res := tc.resolutions[r]
+	if res.precinctsWide == 0 || res.precinctsHigh == 0 {
+		return 0, false
+	}
+	comp := s.components[c]
+	levelno := uint(len(tc.resolutions) - 1 - r)
+	rpx, rpy := uint(res.ppx)+levelno, uint(res.ppy)+levelno
+	if !(y%(comp.dy<<rpy) == 0 || (y == t.y0 && (res.y0<<levelno)%(1<<rpy) != 0)) {
+		return 0, false
+	}
+	if !(x%(comp.dx<<rpx) == 0 || (x == t.x0 && (res.x0<<levelno)%(1<<rpx) != 0)) {
+		return 0, false
+	}
+	px := ceilDiv(x, comp.dx<<levelno)>>uint(res.ppx) - res.x0>>uint(res.ppx)
+	py := ceilDiv(y, comp.dy<<levelno)>>uint(res.ppy) - res.y0>>uint(res.ppy)
+	if px < 0 || px >= res.precinctsWide || py < 0 || py >= res.precinctsHigh {
+		return 0, false
+	}
+	return px + py*res.precinctsWide, true
+}
+
+// readPackets reads the packets contained in the data of a tile-part.
+func (t *tile) readPackets(data []byte) {
+	r := &headerReader{data: data}
+	for r.pos < len(data) && t.nextPacket < len(t.packets) {
+		p := t.packets[t.nextPacket]
+		t.nextPacket++
+		if err := t.readPacket(p, r); err != nil {
+			common.Log.Debug("JPX: error reading packet %d of tile %d: %v", t.nextPacket-1, t.index, err)
+			return
+		}
+	}
+}
+
+// contribution is the length of the data of a code-block contained in a packet body.
+type contribution struct {
+	segment *codewordSegment
+	length  int
+}
+
+// readPacket reads a packet header and body (B.9 and B.10).
+func (t *tile) readPacket(p packet, r *headerReader) error {
+	if t.params.sop && r.hasMarker(markerSOP) {
+		r.pos += 6
+	}
+
+	tc := t.comps[p.comp]
+	style := tc.style
+	res := tc.resolutions[p.res]
+	var contributions []contribution
+
+	present, err := r.readBit()
+	if err != nil {
+		return err
+	}
+	if present == 1 {
+		for _, sb := range res.subbands {
+			if p.precinct >= len(sb.precincts) || sb.precincts[p.precinct] == nil {
+				continue
+			}
+			prc := sb.precincts[p.precinct]
+			for _, cb := range prc.codeblocks {
+				contributions, err = cb.readHeader(r, prc, p.layer, style, contributions)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	r.align()
+	if t.params.eph && r.hasMarker(markerEPH) {
+		r.pos += 2
+	}
+
+	for _, c := range contributions {
+		end := r.pos + c.length
+		if end > len(r.data) {
+			c.segment.data = append(c.segment.data, r.data[r.pos:]...)
+			r.pos = len(r.data)
+			return ErrUnexpectedEOF
+		}
+		c.segment.data = append(c.segment.data, r.data[r.pos:end]...)
+		r.pos = end
+	}
+	return nil
+}
+
+// readHeader reads the part of a packet header for code-block `cb` of precinct `prc` in layer
+// `layer` (B.10.2 to B.10.7) and appends the lengths of its contributions to the packet body.
+func (cb *codeblock) readHeader(r *headerReader, prc *precinct, layer int, style *codingStyle,
+	contributions []contribution) ([]contribution, error) {
+	x, y := cb.cbx-prc.cbx0, cb.cby-prc.cby0
+
+	// Inclusion.
+	if cb.included {
+		bit, err := r.readBit()
+		if err != nil || bit == 0 {
+			return contributions, err
+		}
+	} else {
+		included, err := prc.inclusion.decode(r, x, y, layer+1)
+		if err != nil || !included {
+			return contributions, err
+		}
+		cb.included = true
+
+		// Number of zero bit planes on first inclusion.
+		for threshold := 1; ; threshold++ {
+			known, err := prc.zeroBitPlanes.decode(r, x, y, threshold)
+			if err != nil {
+				return contributions, err
+			}
+			if known {
+				break
+			}
+			if threshold > 74 {
+				return contributions, ErrInvalidData
+			}
+		}
+		cb.zeroBitPlanes = prc.zeroBitPlanes.value(x, y)
+	}
+
+	// Number of coding passes (Table B.4).
+	passes, err := r.readPasses()
+	if err != nil {
+		return contributions, err
+	}
+
+	// Lblock increments.
+	for {
+		bit, err := r.readBit()
+		if err != nil {
+			return contributions, err
+		}
+		if bit == 0 {
+			break
+		}
+		cb.lblock++
+	}
+
+	// Lengths of the codeword segments (B.10.7).
+	for passes > 0 {
+		n := len(cb.segments)
+		if n == 0 || cb.segments[n-1].passes == cb.segments[n-1].maxPasses {
+			cb.segments = append(cb.segments, &codewordSegment{maxPasses: segmentPasses(style, cb.passes)})
+			n++
+		}
+		seg := cb.segments[n-1]
+		k := minInt(passes, seg.maxPasses-seg.passes)
+		bits := cb.lblock + floorLog2(k)
+		if bits > 32 {
+			return contributions, ErrInvalidData
+		}
+		length, err := r.readBits(bits)
+		if err != nil {
+			return contributions, err
+		}
+		seg.passes += k
+		cb.passes += k
+		passes -= k
+		contributions = append(contributions, contribution{segment: seg, length: length})
+	}
+	return contributions, nil
+}
+
+// segmentPasses returns the maximum number of coding passes in the codeword segment that starts
+// with pass number `pass`.
+func segmentPasses(style *codingStyle, pass int) int {
+	switch {
+	case style.cbStyle&cbTermAll != 0:
+		return 1
+	case style.cbStyle&cbBypass != 0:
+		// The first four bit planes are arithmetically coded, followed by alternating raw
+		// segments of significance propagation and magnitude refinement passes and
+		// arithmetically coded cleanup passes.
+		if pass < 10 {
+			return 10 - pass
+		}
+		if passType(pass) == passSignificance {
+			return 2
+		}
+		return 1
+	}
+	return math.MaxInt32
+}
+
+func floorLog2(n int) int {
+	k := 0
+	for n > 1 {
+		n >>= 1
+		k++
+	}
+	return k
+}
+
+// headerReader reads packet headers, which are bit-stuffed after 0xFF bytes (B.10.1).
+type headerReader struct {
+	data   []byte
+	pos    int
+	cur    byte
+	bits   uint
+	lastFF bool
+}
+
+func (r *headerReader) readBit() (int, error) {
+	if r.bits == 0 {
+		if r.pos >= len(r.data) {
+			return 0, ErrUnexpectedEOF
+		}
+		r.cur = r.data[r.pos]
+		r.pos++
+		r.bits = 8
+		if r.lastFF {
+			r.bits = 7
+		}
+		r.lastFF = r.cur == 0xFF
+	}
+	r.bits--
+	return int(r.cur>>r.bits) & 1, nil
+}
+
+func (r *headerReader) readBits(n int) (int, error) {
+	v := 0
+	for i := 0; i < n; i++ {
+		bit, err := r.readBit()
+		if err != nil {
+			return 0, err
+		}
+		v = v<<1 | bit
+	}
+	return v, nil
+}
+
+// readPasses reads the number of coding passes (Table B.4).
+func (r *headerReader) readPasses() (int, error) {
+	for _, code := range []struct{ bits, max, offset int }{{1, 1, 1}, {1, 1, 2}, {2, 3, 3}, {5, 31, 6}} {
+		v, err := r.readBits(code.bits)
+		if err != nil {
+			return 0, err
+		}
+		if v < code.max {
+			return v + code.offset, nil
+		}
+	}
+	v, err := r.readBits(7)
+	return v + 37, err
+}
+
+// align skips to the end of the packet header, including a stuffed byte after a final 0xFF.
+func (r *headerReader) align() {
+	r.bits = 0
+	if r.lastFF {
+		r.pos++
+		r.lastFF = false
+	}
+}
+
+// hasMarker returns true if the marker `marker` is at the current byte position.
+func (r *headerReader) hasMarker(marker int) bool {
+	return r.pos+2 <= len(r.data) && be16(r.data[r.pos:]) == marker
+}
+
+// tagTree is a tag tree (B.10.2).
+type tagTree struct {
+	widths []int
+	levels [][]tagNode
+}
+
+type tagNode struct {
+	value int // Decoded value, math.MaxInt32 while unknown.
+	low   int // Lower bound of the value.
+}
+
+func newTagTree(w, h int) *tagTree {
+	t := &tagTree{}
+	for {
+		level := make([]tagNode, w*h)
+		for i := range level {
+			level[i].value = math.MaxInt32
+		}
+		t.widths = append(t.widths, w)
+		t.levels = append(t.levels, level)
+		if w == 1 && h == 1 {
+			break
+		}
+		w, h = (w+1)/2, (h+1)/2
+	}
+	return t
+}
+
+// decode decodes the value of leaf (x, y) as far as required to determine whether it is below
+// `threshold`, which it returns.
+func (t *tagTree) decode(r *headerReader, x, y, threshold int) (bool, error) {
+	low := 0
+	var n *tagNode
+	for lvl := len(t.levels) - 1; lvl >= 0; lvl-- {
+		n = &t.levels[lvl][(y>>uint(lvl))*t.widths[lvl]+x>>uint(lvl)]
+		if low > n.low {
+			n.low = low
+		} else {
+			low = n.low
+		}
+		for low < threshold && low < n.value {
+			bit, err := r.readBit()
+			if err != nil {
+				return false, err
+			}
+			if bit == 1 {
+				n.value = low
+			} else {
+				low++
+			}
+		}
+		n.low = low
+	}
+	return n.value < threshold, nil
+}
+
+// value returns the value of leaf (x, y).
+func (t *tagTree) value(x, y int) int {
+	return t.levels[0][y*t.widths[0]+x].value
+}
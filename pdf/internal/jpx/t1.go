@@ -0,0 +1,468 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package jpx
+
+import (
+	"math"
+
+	"github.com/unidoc/unidoc/pdf/internal/mq"
+)
+
+// Coding pass types.
+const (
+	passSignificance = iota
+	passRefinement
+	passCleanup
+)
+
+// passType returns the type of coding pass number `pass`, the first pass being a cleanup pass.
+func passType(pass int) int {
+	if pass == 0 {
+		return passCleanup
+	}
+	return (pass - 1) % 3
+}
+
+// Context labels (D.3).
+const (
+	ctxSign       = 9  // 9 to 13.
+	ctxRefinement = 14 // 14 to 16.
+	ctxUniform    = 17
+	ctxRunLength  = 18
+	numContexts   = 19
+)
+
+// Neighbourhood significance flags: the counts of significant horizontal (bits 0-1), vertical
+// (bits 2-3) and diagonal (bits 4-6) neighbours and the significance of the coefficient itself.
+const (
+	nsHorizontal  = 0x01
+	nsVertical    = 0x04
+	nsDiagonal    = 0x10
+	nsSignificant = 0x80
+)
+
+// Coefficient state flags.
+const (
+	flagVisited         = 0x01 // Coded in the current significance propagation pass.
+	flagFirstRefinement = 0x02 // Not yet refined since becoming significant.
+)
+
+// significanceContexts maps the neighbourhood significance to the significance context label for
+// the LL and LH, HL and HH subbands (Table D.1).
+var significanceContexts [3][128]uint8
+
+func init() {
+	for i := range significanceContexts[0] {
+		h, v, d := i&3, (i>>2)&3, (i>>4)&7
+		significanceContexts[0][i] = significanceContext(h, v, d)
+		significanceContexts[1][i] = significanceContext(v, h, d)
+
+		hv := h + v
+		var cx uint8
+		switch {
+		case d >= 3:
+			cx = 8
+		case d == 2 && hv >= 1:
+			cx = 7
+		case d == 2:
+			cx = 6
+		case d == 1 && hv >= 2:
+			cx = 5
+		case d == 1 && hv == 1:
+			cx = 4
+		case d == 1:
+			cx = 3
+		case hv >= 2:
+			cx = 2
+		case hv == 1:
+			cx = 1
+		}
+		significanceContexts[2][i] = cx
+	}
+}
+
+// significanceContext returns the significance context label for the LL and LH subbands.
+func significanceContext(h, v, d int) uint8 {
+	switch {
+	case h == 2:
+		return 8
+	case h == 1 && v >= 1:
+		return 7
+	case h == 1 && d >= 1:
+		return 6
+	case h == 1:
+		return 5
+	case v == 2:
+		return 4
+	case v == 1:
+		return 3
+	case d >= 2:
+		return 2
+	case d == 1:
+		return 1
+	}
+	return 0
+}
+
+// t1Decoder decodes code-blocks (Annex D).  It is reused for the code-blocks of a tile.
+type t1Decoder struct {
+	width, height int
+	labels        *[128]uint8
+	vcausal       bool
+
+	ns    []uint8  // Neighbourhood significance.
+	sign  []uint8  // 1 for negative coefficients.
+	mag   []uint32 // Magnitude bits decoded so far.
+	bits  []uint8  // Number of bit planes decoded, including the zero bit planes.
+	flags []uint8
+
+	cx  [numContexts]mq.Context
+	mq  *mq.Decoder
+	raw *rawDecoder
+}
+
+// decode decodes code-block `cb` of subband `sb` and stores the dequantized coefficients.
+func (d *t1Decoder) decode(cb *codeblock, sb *subband, style *codingStyle) {
+	w, h := cb.x1-cb.x0, cb.y1-cb.y0
+	planes := sb.mb - cb.zeroBitPlanes
+	if w <= 0 || h <= 0 || cb.passes == 0 || planes <= 0 {
+		return
+	}
+	maxPasses := 3*minInt(planes, 31) - 2
+
+	d.reset(w, h, cb.zeroBitPlanes, sb.orientation, style.cbStyle&cbVCausal != 0)
+	pass := 0
+	for _, seg := range cb.segments {
+		if style.cbStyle&cbBypass != 0 && pass >= 10 && passType(pass) != passCleanup {
+			d.raw = &rawDecoder{data: seg.data}
+			d.mq = nil
+		} else {
+			d.mq = mq.NewDecoder(seg.data)
+			d.raw = nil
+		}
+		for i := 0; i < seg.passes && pass < maxPasses; i++ {
+			switch passType(pass) {
+			case passSignificance:
+				d.significancePass()
+			case passRefinement:
+				d.refinementPass()
+			case passCleanup:
+				d.cleanupPass()
+				if style.cbStyle&cbSegSymbol != 0 {
+					for j := 0; j < 4; j++ {
+						d.decodeBit(ctxUniform)
+					}
+				}
+			}
+			if style.cbStyle&cbReset != 0 {
+				d.resetContexts()
+			}
+			pass++
+		}
+	}
+
+	// Dequantization (E.1.1).
+	correction := float32(0.5)
+	if style.reversible {
+		correction = 0
+	}
+	sw := sb.x1 - sb.x0
+	for y := 0; y < h; y++ {
+		out := sb.coeffs[(cb.y0-sb.y0+y)*sw+cb.x0-sb.x0:]
+		for x := 0; x < w; x++ {
+			i := y*w + x
+			m := d.mag[i]
+			if m == 0 {
+				continue
+			}
+			shift := sb.mb - int(d.bits[i])
+			var v float32
+			if style.reversible && shift <= 0 {
+				v = float32(m) * sb.delta
+			} else {
+				v = float32(math.Ldexp(float64((float32(m)+correction)*sb.delta), shift))
+			}
+			if d.sign[i] != 0 {
+				v = -v
+			}
+			out[x] = v
+		}
+	}
+}
+
+// reset prepares the decoder for a code-block of size `w`x`h`.
+func (d *t1Decoder) reset(w, h, zeroBitPlanes, orientation int, vcausal bool) {
+	d.width, d.height = w, h
+	d.vcausal = vcausal
+	switch orientation {
+	case bandHL:
+		d.labels = &significanceContexts[1]
+	case bandHH:
+		d.labels = &significanceContexts[2]
+	default:
+		d.labels = &significanceContexts[0]
+	}
+
+	n := w * h
+	if cap(d.ns) < n {
+		d.ns = make([]uint8, n)
+		d.sign = make([]uint8, n)
+		d.mag = make([]uint32, n)
+		d.bits = make([]uint8, n)
+		d.flags = make([]uint8, n)
+	}
+	d.ns, d.sign, d.mag, d.bits, d.flags = d.ns[:n], d.sign[:n], d.mag[:n], d.bits[:n], d.flags[:n]
+	for i := 0; i < n; i++ {
+		d.ns[i], d.sign[i], d.mag[i], d.flags[i] = 0, 0, 0, 0
+		d.bits[i] = uint8(zeroBitPlanes)
+	}
+	d.resetContexts()
+}
+
+// resetContexts sets the contexts to their initial states (Table D.7).
+func (d *t1Decoder) resetContexts() {
+	for i := range d.cx {
+		d.cx[i].SetState(0, 0)
+	}
+	d.cx[0].SetState(4, 0)
+	d.cx[ctxUniform].SetState(46, 0)
+	d.cx[ctxRunLength].SetState(3, 0)
+}
+
+// decodeBit decodes a bit with context `cx`, or reads a raw bit in bypass mode.
+func (d *t1Decoder) decodeBit(cx int) int {
+	if d.raw != nil {
+		return d.raw.readBit()
+	}
+	return d.mq.DecodeBit(&d.cx[cx])
+}
+
+// setSignificant marks the coefficient at `i`, row `y`, column `x` as significant and updates the
+// neighbourhood significance of its neighbours.
+func (d *t1Decoder) setSignificant(i, x, y int) {
+	w := d.width
+	left, right := x > 0, x+1 < w
+	// In vertically causal mode, coefficients in the next stripe are treated as insignificant.
+	if y > 0 && !(d.vcausal && y%4 == 0) {
+		j := i - w
+		d.ns[j] += nsVertical
+		if left {
+			d.ns[j-1] += nsDiagonal
+		}
+		if right {
+			d.ns[j+1] += nsDiagonal
+		}
+	}
+	if y+1 < d.height {
+		j := i + w
+		d.ns[j] += nsVertical
+		if left {
+			d.ns[j-1] += nsDiagonal
+		}
+		if right {
+			d.ns[j+1] += nsDiagonal
+		}
+	}
+	if left {
+		d.ns[i-1] += nsHorizontal
+	}
+	if right {
+		d.ns[i+1] += nsHorizontal
+	}
+	d.ns[i] |= nsSignificant
+}
+
+// decodeSign decodes the sign of the coefficient at `i` (D.3.2).
+func (d *t1Decoder) decodeSign(i, x, y int) uint8 {
+	if d.raw != nil {
+		return uint8(d.raw.readBit())
+	}
+	cx, xor := d.signContext(i, x, y)
+	return uint8(d.decodeBit(cx) ^ xor)
+}
+
+// signContext returns the context label and the XOR bit for decoding the sign of the coefficient
+// at `i`, row `y`, column `x` (Table D.3).
+func (d *t1Decoder) signContext(i, x, y int) (int, int) {
+	contribution := func(j int) int {
+		if d.mag[j] == 0 {
+			return 0
+		}
+		if d.sign[j] != 0 {
+			return -1
+		}
+		return 1
+	}
+	clamp := func(v int) int {
+		if v > 1 {
+			return 1
+		}
+		if v < -1 {
+			return -1
+		}
+		return v
+	}
+
+	hc, vc := 0, 0
+	if x > 0 {
+		hc += contribution(i - 1)
+	}
+	if x+1 < d.width {
+		hc += contribution(i + 1)
+	}
+	if y > 0 {
+		vc += contribution(i - d.width)
+	}
+	if y+1 < d.height && !(d.vcausal && y%4 == 3) {
+		vc += contribution(i + d.width)
+	}
+
+	c := 3*clamp(hc) + clamp(vc)
+	if c < 0 {
+		return ctxSign - c, 1
+	}
+	return ctxSign + c, 0
+}
+
+// significancePass runs a significance propagation pass (D.3.1).
+func (d *t1Decoder) significancePass() {
+	w, h := d.width, d.height
+	for y0 := 0; y0 < h; y0 += 4 {
+		for x := 0; x < w; x++ {
+			for y := y0; y < y0+4 && y < h; y++ {
+				i := y*w + x
+				d.flags[i] &^= flagVisited
+				if d.mag[i] != 0 || d.ns[i] == 0 {
+					continue
+				}
+				if d.decodeBit(int(d.labels[d.ns[i]&0x7F])) == 1 {
+					d.sign[i] = d.decodeSign(i, x, y)
+					d.mag[i] = 1
+					d.setSignificant(i, x, y)
+					d.flags[i] |= flagFirstRefinement
+				}
+				d.bits[i]++
+				d.flags[i] |= flagVisited
+			}
+		}
+	}
+}
+
+// refinementPass runs a magnitude refinement pass (D.3.3).
+func (d *t1Decoder) refinementPass() {
+	w, h := d.width, d.height
+	for y0 := 0; y0 < h; y0 += 4 {
+		for x := 0; x < w; x++ {
+			for y := y0; y < y0+4 && y < h; y++ {
+				i := y*w + x
+				if d.mag[i] == 0 || d.flags[i]&flagVisited != 0 {
+					continue
+				}
+				cx := ctxRefinement + 2
+				if d.flags[i]&flagFirstRefinement != 0 {
+					d.flags[i] &^= flagFirstRefinement
+					cx = ctxRefinement + 1
+					if d.ns[i]&0x7F != 0 {
+						cx = ctxRefinement
+					}
+				}
+				d.mag[i] = d.mag[i]<<1 | uint32(d.decodeBit(cx))
+				d.bits[i]++
+			}
+		}
+	}
+}
+
+// cleanupPass runs a cleanup pass (D.3.4).
+func (d *t1Decoder) cleanupPass() {
+	w, h := d.width, d.height
+	for y0 := 0; y0 < h; y0 += 4 {
+		for x := 0; x < w; x++ {
+			y := y0
+			if y0+4 <= h && d.runLengthMode(x, y0) {
+				if d.decodeBit(ctxRunLength) == 0 {
+					for k := 0; k < 4; k++ {
+						d.bits[(y0+k)*w+x]++
+					}
+					continue
+				}
+				k := d.decodeBit(ctxUniform)<<1 | d.decodeBit(ctxUniform)
+				for j := 0; j < k; j++ {
+					d.bits[(y0+j)*w+x]++
+				}
+				y = y0 + k
+				i := y*w + x
+				d.sign[i] = d.decodeSign(i, x, y)
+				d.mag[i] = 1
+				d.setSignificant(i, x, y)
+				d.flags[i] |= flagFirstRefinement
+				d.bits[i]++
+				y++
+			}
+			for ; y < y0+4 && y < h; y++ {
+				i := y*w + x
+				if d.mag[i] != 0 || d.flags[i]&flagVisited != 0 {
+					continue
+				}
+				if d.decodeBit(int(d.labels[d.ns[i]&0x7F])) == 1 {
+					d.sign[i] = d.decodeSign(i, x, y)
+					d.mag[i] = 1
+					d.setSignificant(i, x, y)
+					d.flags[i] |= flagFirstRefinement
+				}
+				d.bits[i]++
+			}
+		}
+	}
+}
+
+// runLengthMode returns true if the four coefficients of the stripe column at (x, y0) are
+// insignificant, have insignificant neighbourhoods and were not coded in this bit plane.
+func (d *t1Decoder) runLengthMode(x, y0 int) bool {
+	for y := y0; y < y0+4; y++ {
+		i := y*d.width + x
+		if d.ns[i] != 0 || d.flags[i]&flagVisited != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// rawDecoder reads the raw bits of passes in arithmetic coding bypass mode (D.6).
+type rawDecoder struct {
+	data []byte
+	pos  int
+	c    byte
+	ct   uint
+}
+
+func (d *rawDecoder) byteAt(i int) byte {
+	if i < len(d.data) {
+		return d.data[i]
+	}
+	return 0xFF
+}
+
+func (d *rawDecoder) readBit() int {
+	if d.ct == 0 {
+		if d.c == 0xFF {
+			if d.byteAt(d.pos) > 0x8F {
+				d.c = 0xFF
+				d.ct = 8
+			} else {
+				d.c = d.byteAt(d.pos)
+				d.pos++
+				d.ct = 7
+			}
+		} else {
+			d.c = d.byteAt(d.pos)
+			d.pos++
+			d.ct = 8
+		}
+	}
+	d.ct--
+	return int(d.c>>d.ct) & 1
+}
@@ -0,0 +1,136 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package mq implements the MQ adaptive binary arithmetic decoder, as used by JBIG2 (ITU-T T.88
+// Annex E) and JPEG 2000 (ITU-T T.800 Annex C).
+package mq
+
+import "github.com/unidoc/unidoc/pdf/internal/mq/internal/qetable"
+
+// Context is the adaptive state of a single decoding context: the index into the probability
+// estimation table and the sense of the more probable symbol.
+type Context struct {
+	index uint8
+	mps   uint8
+}
+
+// SetState sets the context to state `index` with more probable symbol `mps`.
+func (cx *Context) SetState(index, mps int) {
+	cx.index = uint8(index)
+	cx.mps = uint8(mps)
+}
+
+// Decoder is an MQ arithmetic decoder reading from a byte slice.
+type Decoder struct {
+	data []byte
+	bp   int // Position of the current byte.
+
+	chigh uint32 // High 16 bits of the C register.
+	clow  uint32 // Low 16 bits of the C register.
+	a     uint32 // Interval register.
+	ct    int    // Bit counter.
+}
+
+// NewDecoder returns a new decoder for the arithmetically coded `data` (INITDEC procedure).
+func NewDecoder(data []byte) *Decoder {
+	d := &Decoder{data: data}
+	d.chigh = uint32(d.byteAt(0))
+	d.byteIn()
+	d.chigh = ((d.chigh << 7) & 0xFFFF) | ((d.clow >> 9) & 0x7F)
+	d.clow = (d.clow << 7) & 0xFFFF
+	d.ct -= 7
+	d.a = 0x8000
+	return d
+}
+
+// byteAt returns the byte at position `i`, or 0xFF past the end of the data.
+func (d *Decoder) byteAt(i int) uint32 {
+	if i < len(d.data) {
+		return uint32(d.data[i])
+	}
+	return 0xFF
+}
+
+// byteIn reads the next byte into the C register (BYTEIN procedure).
+func (d *Decoder) byteIn() {
+	if d.byteAt(d.bp) == 0xFF {
+		if d.byteAt(d.bp+1) > 0x8F {
+			// Marker: feed 1 bits.
+			d.clow += 0xFF00
+			d.ct = 8
+		} else {
+			d.bp++
+			d.clow += d.byteAt(d.bp) << 9
+			d.ct = 7
+		}
+	} else {
+		d.bp++
+		d.clow += d.byteAt(d.bp) << 8
+		d.ct = 8
+	}
+	if d.clow > 0xFFFF {
+		d.chigh += d.clow >> 16
+		d.clow &= 0xFFFF
+	}
+}
+
+// DecodeBit decodes a single bit with context `cx` (DECODE procedure).
+func (d *Decoder) DecodeBit(cx *Context) int {
+	entry := &qetable.Table[cx.index]
+	qe := entry.Qe
+	mps := int(cx.mps)
+
+	var bit int
+	a := d.a - qe
+	if d.chigh < qe {
+		// LPS exchange.
+		if a < qe {
+			a = qe
+			bit = mps
+			cx.index = entry.NMPS
+		} else {
+			a = qe
+			bit = 1 ^ mps
+			if entry.Switch {
+				cx.mps = uint8(bit)
+			}
+			cx.index = entry.NLPS
+		}
+	} else {
+		d.chigh -= qe
+		if a&0x8000 != 0 {
+			d.a = a
+			return mps
+		}
+		// MPS exchange.
+		if a < qe {
+			bit = 1 ^ mps
+			if entry.Switch {
+				cx.mps = uint8(bit)
+			}
+			cx.index = entry.NLPS
+		} else {
+			bit = mps
+			cx.index = entry.NMPS
+		}
+	}
+
+	// Renormalization (RENORMD procedure).
+	for {
+		if d.ct == 0 {
+			d.byteIn()
+		}
+		a <<= 1
+		d.chigh = ((d.chigh << 1) & 0xFFFF) | ((d.clow >> 15) & 1)
+		d.clow = (d.clow << 1) & 0xFFFF
+		d.ct--
+		if a&0x8000 != 0 {
+			break
+		}
+	}
+	d.a = a
+
+	return bit
+}
@@ -0,0 +1,125 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package mqtest implements the MQ arithmetic encoder, the counterpart of the decoder of package
+// mq, for generating the coded data of tests.
+package mqtest
+
+import "github.com/unidoc/unidoc/pdf/internal/mq/internal/qetable"
+
+// Context is the adaptive state of a single encoding context: the index into the probability
+// estimation table and the sense of the more probable symbol.
+type Context struct {
+	index uint8
+	mps   uint8
+}
+
+// SetState sets the context to state `index` with more probable symbol `mps`.
+func (cx *Context) SetState(index, mps int) {
+	cx.index = uint8(index)
+	cx.mps = uint8(mps)
+}
+
+// Encoder is an MQ arithmetic encoder (T.88 Annex E.2, T.800 Annex C.2).
+type Encoder struct {
+	a   uint32 // Interval register.
+	c   uint32 // Code register.
+	ct  int    // Bit counter.
+	out []byte // The first byte is a placeholder preceding the coded data.
+}
+
+// NewEncoder returns a new encoder (INITENC procedure).
+func NewEncoder() *Encoder {
+	return &Encoder{a: 0x8000, ct: 12, out: []byte{0}}
+}
+
+// EncodeBit encodes a single bit with context `cx` (ENCODE procedure).
+func (e *Encoder) EncodeBit(cx *Context, bit int) {
+	entry := &qetable.Table[cx.index]
+	e.a -= entry.Qe
+	if bit == int(cx.mps) {
+		// CODEMPS.
+		if e.a&0x8000 != 0 {
+			e.c += entry.Qe
+			return
+		}
+		if e.a < entry.Qe {
+			e.a = entry.Qe
+		} else {
+			e.c += entry.Qe
+		}
+		cx.index = entry.NMPS
+	} else {
+		// CODELPS.
+		if e.a < entry.Qe {
+			e.c += entry.Qe
+		} else {
+			e.a = entry.Qe
+		}
+		if entry.Switch {
+			cx.mps = 1 - cx.mps
+		}
+		cx.index = entry.NLPS
+	}
+
+	// Renormalization (RENORME procedure).
+	for {
+		e.a <<= 1
+		e.c <<= 1
+		e.ct--
+		if e.ct == 0 {
+			e.byteOut()
+		}
+		if e.a&0x8000 != 0 {
+			break
+		}
+	}
+}
+
+// byteOut writes the next byte of the C register (BYTEOUT procedure).
+func (e *Encoder) byteOut() {
+	b := &e.out[len(e.out)-1]
+	if *b == 0xFF {
+		e.out = append(e.out, byte(e.c>>20))
+		e.c &= 0xFFFFF
+		e.ct = 7
+		return
+	}
+	if e.c < 0x8000000 {
+		e.out = append(e.out, byte(e.c>>19))
+		e.c &= 0x7FFFF
+		e.ct = 8
+		return
+	}
+	*b++
+	if *b == 0xFF {
+		e.c &= 0x7FFFFFF
+		e.out = append(e.out, byte(e.c>>20))
+		e.c &= 0xFFFFF
+		e.ct = 7
+		return
+	}
+	e.out = append(e.out, byte(e.c>>19))
+	e.c &= 0x7FFFF
+	e.ct = 8
+}
+
+// Flush terminates the coded data (FLUSH procedure) and returns it, without a final 0xFF byte.
+// JBIG2 coded data is followed by the 0xFF 0xAC end marker.
+func (e *Encoder) Flush() []byte {
+	tempC := e.c + e.a
+	e.c |= 0xFFFF
+	if e.c >= tempC {
+		e.c -= 0x8000
+	}
+	e.c <<= uint(e.ct)
+	e.byteOut()
+	e.c <<= uint(e.ct)
+	e.byteOut()
+	if e.out[len(e.out)-1] == 0xFF {
+		e.out = e.out[:len(e.out)-1]
+	}
+	return e.out[1:]
+}
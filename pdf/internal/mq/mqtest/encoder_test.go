@@ -0,0 +1,38 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package mqtest
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Test encoding of the arithmetic coder test sequence in T.88 Annex H.2, which uses a single
+// context.
+func TestEncoderTestSequence(t *testing.T) {
+	data := []byte{
+		0x00, 0x02, 0x00, 0x51, 0x00, 0x00, 0x00, 0xC0, 0x03, 0x52, 0x87, 0x2A, 0xAA, 0xAA, 0xAA, 0xAA,
+		0x82, 0xC0, 0x20, 0x00, 0xFC, 0xD7, 0x9E, 0xF6, 0xBF, 0x7F, 0xED, 0x90, 0x4F, 0x46, 0xA3, 0xBF,
+	}
+	expected := []byte{
+		0x84, 0xC7, 0x3B, 0xFC, 0xE1, 0xA1, 0x43, 0x04, 0x02, 0x20, 0x00, 0x00, 0x41, 0x0D, 0xBB, 0x86,
+		0xF4, 0x31, 0x7F, 0xFF, 0x88, 0xFF, 0x37, 0x47, 0x1A, 0xDB, 0x6A, 0xDF,
+	}
+
+	encoder := NewEncoder()
+	cx := &Context{}
+	for _, b := range data {
+		for j := 7; j >= 0; j-- {
+			encoder.EncodeBit(cx, int(b>>uint(j))&1)
+		}
+	}
+	encoded := encoder.Flush()
+
+	if !bytes.Equal(encoded, expected) {
+		t.Errorf("Encoded % X", encoded)
+		t.Errorf("Expected % X", expected)
+	}
+}
@@ -0,0 +1,241 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package pkcs7
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+)
+
+// Object identifiers of enveloped data.
+var (
+	OIDEnvelopedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 3}
+
+	oidAES128CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidDESEDE3CBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+)
+
+// ErrNotRecipient is returned by Decrypt when the certificate is not one of the recipients.
+var ErrNotRecipient = errors.New("pkcs7: not a recipient")
+
+// ASN.1 structures (RFC 5652 section 6).
+
+type envelopedData struct {
+	Version              int
+	OriginatorInfo       asn1.RawValue   `asn1:"optional,tag:0"`
+	RecipientInfos       []asn1.RawValue `asn1:"set"`
+	EncryptedContentInfo encryptedContentInfo
+	UnprotectedAttrs     asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type keyTransRecipientInfo struct {
+	Version                int
+	RID                    asn1.RawValue
+	KeyEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedKey           []byte
+}
+
+type encryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           asn1.RawValue `asn1:"optional,tag:0"`
+}
+
+// Encrypt creates a DER encoded ContentInfo containing an EnvelopedData of `content` for
+// `recipients`, whose public keys must be RSA keys.  The content is encrypted with AES-256-CBC and
+// the content-encryption key is transported with RSAES-PKCS1-v1_5.
+func Encrypt(content []byte, recipients []*x509.Certificate) ([]byte, error) {
+	if len(recipients) == 0 {
+		return nil, errors.New("pkcs7: no recipients")
+	}
+
+	key := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	n := aes.BlockSize - len(content)%aes.BlockSize
+	encrypted := append(append([]byte{}, content...), bytes.Repeat([]byte{byte(n)}, n)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	ivParam, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	ed := envelopedData{
+		EncryptedContentInfo: encryptedContentInfo{
+			ContentType: OIDData,
+			ContentEncryptionAlgorithm: pkix.AlgorithmIdentifier{
+				Algorithm:  oidAES256CBC,
+				Parameters: asn1.RawValue{FullBytes: ivParam},
+			},
+			EncryptedContent: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, Bytes: encrypted},
+		},
+	}
+	for _, cert := range recipients {
+		pub, ok := cert.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("pkcs7: unsupported recipient key %T", cert.PublicKey)
+		}
+		encryptedKey, err := rsa.EncryptPKCS1v15(rand.Reader, pub, key)
+		if err != nil {
+			return nil, err
+		}
+		rid, err := asn1.Marshal(issuerAndSerialNumber{
+			Issuer:       asn1.RawValue{FullBytes: cert.RawIssuer},
+			SerialNumber: cert.SerialNumber,
+		})
+		if err != nil {
+			return nil, err
+		}
+		ri, err := asn1.Marshal(keyTransRecipientInfo{
+			RID: asn1.RawValue{FullBytes: rid},
+			KeyEncryptionAlgorithm: pkix.AlgorithmIdentifier{
+				Algorithm:  oidRSAEncryption,
+				Parameters: asn1.NullRawValue,
+			},
+			EncryptedKey: encryptedKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		ed.RecipientInfos = append(ed.RecipientInfos, asn1.RawValue{FullBytes: ri})
+	}
+
+	data, err := asn1.Marshal(ed)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(contentInfo{
+		ContentType: OIDEnvelopedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: data},
+	})
+}
+
+// Decrypt decrypts the content of the DER encoded ContentInfo containing an EnvelopedData `der`
+// with the private key `key` of the recipient `cert`.  Recipients are identified by issuer and
+// serial number, the content-encryption key must be transported with RSA.  Returns ErrNotRecipient
+// if `cert` is not one of the recipients.
+func Decrypt(der []byte, cert *x509.Certificate, key crypto.Decrypter) ([]byte, error) {
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil {
+		return nil, fmt.Errorf("pkcs7: %v", err)
+	}
+	if !ci.ContentType.Equal(OIDEnvelopedData) {
+		return nil, fmt.Errorf("pkcs7: content type %v is not EnvelopedData", ci.ContentType)
+	}
+	var ed envelopedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &ed); err != nil {
+		return nil, fmt.Errorf("pkcs7: %v", err)
+	}
+
+	var encryptedKey []byte
+	for _, raw := range ed.RecipientInfos {
+		// Other recipient info types are tagged.
+		if raw.Class != asn1.ClassUniversal || raw.Tag != asn1.TagSequence {
+			continue
+		}
+		var ri keyTransRecipientInfo
+		if _, err := asn1.Unmarshal(raw.FullBytes, &ri); err != nil {
+			return nil, fmt.Errorf("pkcs7: %v", err)
+		}
+		var ias issuerAndSerialNumber
+		if _, err := asn1.Unmarshal(ri.RID.FullBytes, &ias); err != nil {
+			// Subject key identifier.
+			continue
+		}
+		if bytes.Equal(ias.Issuer.FullBytes, cert.RawIssuer) && ias.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			if !ri.KeyEncryptionAlgorithm.Algorithm.Equal(oidRSAEncryption) {
+				return nil, fmt.Errorf("pkcs7: unsupported key encryption algorithm %v",
+					ri.KeyEncryptionAlgorithm.Algorithm)
+			}
+			encryptedKey = ri.EncryptedKey
+			break
+		}
+	}
+	if encryptedKey == nil {
+		return nil, ErrNotRecipient
+	}
+	contentKey, err := key.Decrypt(rand.Reader, encryptedKey, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	eci := ed.EncryptedContentInfo
+	var block cipher.Block
+	switch alg := eci.ContentEncryptionAlgorithm.Algorithm; {
+	case alg.Equal(oidAES128CBC), alg.Equal(oidAES192CBC), alg.Equal(oidAES256CBC):
+		block, err = aes.NewCipher(contentKey)
+	case alg.Equal(oidDESEDE3CBC):
+		block, err = des.NewTripleDESCipher(contentKey)
+	default:
+		return nil, fmt.Errorf("pkcs7: unsupported content encryption algorithm %v", alg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(eci.ContentEncryptionAlgorithm.Parameters.FullBytes, &iv); err != nil {
+		return nil, fmt.Errorf("pkcs7: %v", err)
+	}
+	if len(iv) != block.BlockSize() {
+		return nil, errors.New("pkcs7: invalid initialization vector")
+	}
+
+	encrypted, err := octets(eci.EncryptedContent)
+	if err != nil {
+		return nil, err
+	}
+	if len(encrypted) == 0 || len(encrypted)%block.BlockSize() != 0 {
+		return nil, errors.New("pkcs7: invalid encrypted content length")
+	}
+	content := make([]byte, len(encrypted))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(content, encrypted)
+
+	n := int(content[len(content)-1])
+	if n == 0 || n > block.BlockSize() || !bytes.Equal(content[len(content)-n:], bytes.Repeat([]byte{byte(n)}, n)) {
+		return nil, errors.New("pkcs7: invalid padding")
+	}
+	return content[:len(content)-n], nil
+}
+
+// octets returns the content of the implicitly tagged OCTET STRING `raw`, which may use the
+// constructed encoding.
+func octets(raw asn1.RawValue) ([]byte, error) {
+	if !raw.IsCompound {
+		return raw.Bytes, nil
+	}
+	var result []byte
+	for rest := raw.Bytes; len(rest) > 0; {
+		var part []byte
+		var err error
+		rest, err = asn1.Unmarshal(rest, &part)
+		if err != nil {
+			return nil, fmt.Errorf("pkcs7: %v", err)
+		}
+		result = append(result, part...)
+	}
+	return result, nil
+}
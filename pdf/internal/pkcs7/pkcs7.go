@@ -0,0 +1,526 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package pkcs7 implements the parts of the Cryptographic Message Syntax (RFC 5652) used by PDF
+// signatures and public-key encryption: detached SignedData creation and parsing, and EnvelopedData
+// creation and decryption.
+package pkcs7
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// Object identifiers.
+var (
+	OIDData                 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	OIDSignedData           = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	OIDAttributeContentType = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	OIDAttributeDigest      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	OIDAttributeSigningTime = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+	// ESS signing-certificate-v2 attribute (RFC 5035).
+	OIDAttributeSigningCertificateV2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 2, 47}
+
+	oidSHA1   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+
+	oidRSAEncryption   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidRSAPSS          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 10}
+	oidECDSAWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 1}
+	oidECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+	oidECDSAWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
+)
+
+// ASN.1 structures (RFC 5652).
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapsulatedContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+type encapsulatedContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type signerInfo struct {
+	Version            int
+	SID                issuerAndSerialNumber
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue `asn1:"set"`
+}
+
+// ESS structures (RFC 5035).
+
+type signingCertificateV2 struct {
+	Certs []essCertIDv2
+}
+
+type essCertIDv2 struct {
+	HashAlgorithm pkix.AlgorithmIdentifier `asn1:"optional"`
+	CertHash      []byte
+	IssuerSerial  issuerSerial `asn1:"optional"`
+}
+
+type issuerSerial struct {
+	Issuer       []asn1.RawValue
+	SerialNumber *big.Int
+}
+
+// hashOIDs maps the supported digest algorithms to their object identifiers.
+var hashOIDs = map[crypto.Hash]asn1.ObjectIdentifier{
+	crypto.SHA1:   oidSHA1,
+	crypto.SHA256: oidSHA256,
+	crypto.SHA384: oidSHA384,
+	crypto.SHA512: oidSHA512,
+}
+
+// hashFromOID returns the digest algorithm identified by `oid`.
+func hashFromOID(oid asn1.ObjectIdentifier) (crypto.Hash, error) {
+	for hash, hashOID := range hashOIDs {
+		if oid.Equal(hashOID) {
+			return hash, nil
+		}
+	}
+	return 0, fmt.Errorf("pkcs7: unsupported digest algorithm %v", oid)
+}
+
+// SignOptions are the options of Sign.
+type SignOptions struct {
+	// Digest algorithm, SHA-256 if 0.
+	Hash crypto.Hash
+	// Signing time attribute, omitted if zero.
+	SigningTime time.Time
+	// Add an ESS signing-certificate-v2 attribute identifying the signing certificate, as required by
+	// CAdES and PAdES.
+	SigningCertificateV2 bool
+}
+
+// Sign creates a DER encoded ContentInfo with a SignedData for detached content of digest
+// `digest`.  The signature is made with `signer`, whose certificate is the first of `certs`.  All
+// of `certs` are included in the SignedData.
+func Sign(digest []byte, signer crypto.Signer, certs []*x509.Certificate, opts *SignOptions) ([]byte, error) {
+	if len(certs) == 0 {
+		return nil, errors.New("pkcs7: no signing certificate")
+	}
+	if opts == nil {
+		opts = &SignOptions{}
+	}
+	hash := opts.Hash
+	if hash == 0 {
+		hash = crypto.SHA256
+	}
+	hashOID, ok := hashOIDs[hash]
+	if !ok {
+		return nil, fmt.Errorf("pkcs7: unsupported digest algorithm %v", hash)
+	}
+	if len(digest) != hash.Size() {
+		return nil, errors.New("pkcs7: invalid digest length")
+	}
+	cert := certs[0]
+
+	// Signed attributes.
+	attrs := []attribute{}
+	addAttr := func(oid asn1.ObjectIdentifier, val interface{}) error {
+		der, err := asn1.Marshal(val)
+		if err != nil {
+			return err
+		}
+		attrs = append(attrs, attribute{Type: oid, Values: asn1.RawValue{Class: asn1.ClassUniversal,
+			Tag: asn1.TagSet, IsCompound: true, Bytes: der}})
+		return nil
+	}
+	if err := addAttr(OIDAttributeContentType, OIDData); err != nil {
+		return nil, err
+	}
+	if !opts.SigningTime.IsZero() {
+		if err := addAttr(OIDAttributeSigningTime, opts.SigningTime.UTC()); err != nil {
+			return nil, err
+		}
+	}
+	if err := addAttr(OIDAttributeDigest, digest); err != nil {
+		return nil, err
+	}
+	if opts.SigningCertificateV2 {
+		h := hash.New()
+		h.Write(cert.Raw)
+		certID := essCertIDv2{
+			CertHash: h.Sum(nil),
+			IssuerSerial: issuerSerial{
+				// GeneralName directoryName [4].
+				Issuer:       []asn1.RawValue{{Class: asn1.ClassContextSpecific, Tag: 4, IsCompound: true, Bytes: cert.RawIssuer}},
+				SerialNumber: cert.SerialNumber,
+			},
+		}
+		// The hash algorithm defaults to SHA-256.
+		if hash != crypto.SHA256 {
+			certID.HashAlgorithm = pkix.AlgorithmIdentifier{Algorithm: hashOID}
+		}
+		if err := addAttr(OIDAttributeSigningCertificateV2, signingCertificateV2{Certs: []essCertIDv2{certID}}); err != nil {
+			return nil, err
+		}
+	}
+	signedAttrs, err := marshalSet(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The signature is computed over the DER encoding of the signed attributes with the SET tag.
+	h := hash.New()
+	h.Write(signedAttrs)
+	signature, err := signer.Sign(rand.Reader, h.Sum(nil), hash)
+	if err != nil {
+		return nil, err
+	}
+	sigAlg, err := signatureAlgorithm(signer.Public(), hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// The signed attributes are [0] IMPLICIT in SignerInfo.
+	_, content, err := parseTagAndContent(signedAttrs)
+	if err != nil {
+		return nil, err
+	}
+	si := signerInfo{
+		Version:            1,
+		SID:                issuerAndSerialNumber{Issuer: asn1.RawValue{FullBytes: cert.RawIssuer}, SerialNumber: cert.SerialNumber},
+		DigestAlgorithm:    pkix.AlgorithmIdentifier{Algorithm: hashOID},
+		SignedAttrs:        asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: content},
+		SignatureAlgorithm: sigAlg,
+		Signature:          signature,
+	}
+
+	var rawCerts []byte
+	for _, c := range certs {
+		rawCerts = append(rawCerts, c.Raw...)
+	}
+	sd := signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: hashOID}},
+		EncapContentInfo: encapsulatedContentInfo{EContentType: OIDData},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: rawCerts},
+		SignerInfos:      []signerInfo{si},
+	}
+	sdDER, err := asn1.Marshal(sd)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(contentInfo{
+		ContentType: OIDSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: sdDER},
+	})
+}
+
+// signatureAlgorithm returns the signature algorithm identifier for public key `pub` and digest
+// algorithm `hash`.
+func signatureAlgorithm(pub crypto.PublicKey, hash crypto.Hash) (pkix.AlgorithmIdentifier, error) {
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		return pkix.AlgorithmIdentifier{Algorithm: oidRSAEncryption, Parameters: asn1.NullRawValue}, nil
+	case *ecdsa.PublicKey:
+		oid, ok := map[crypto.Hash]asn1.ObjectIdentifier{
+			crypto.SHA1:   oidECDSAWithSHA1,
+			crypto.SHA256: oidECDSAWithSHA256,
+			crypto.SHA384: oidECDSAWithSHA384,
+			crypto.SHA512: oidECDSAWithSHA512,
+		}[hash]
+		if ok {
+			return pkix.AlgorithmIdentifier{Algorithm: oid}, nil
+		}
+	}
+	return pkix.AlgorithmIdentifier{}, fmt.Errorf("pkcs7: unsupported key type %T", pub)
+}
+
+// marshalSet returns the DER encoding of `attrs` as a SET OF, sorted by their encodings.
+func marshalSet(attrs []attribute) ([]byte, error) {
+	var encoded [][]byte
+	for _, attr := range attrs {
+		der, err := asn1.Marshal(attr)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, der)
+	}
+	sort.Slice(encoded, func(i, j int) bool {
+		return bytes.Compare(encoded[i], encoded[j]) < 0
+	})
+	return asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true,
+		Bytes: bytes.Join(encoded, nil)})
+}
+
+// parseTagAndContent splits the DER encoding `der` into its tag and length octets and content.
+func parseTagAndContent(der []byte) ([]byte, []byte, error) {
+	var raw asn1.RawValue
+	rest, err := asn1.Unmarshal(der, &raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rest) > 0 {
+		return nil, nil, errors.New("pkcs7: trailing data")
+	}
+	return raw.FullBytes[:len(raw.FullBytes)-len(raw.Bytes)], raw.Bytes, nil
+}
+
+// SignedData is a parsed SignedData.
+type SignedData struct {
+	// Certificates included in the SignedData.
+	Certificates []*x509.Certificate
+	// Signers.
+	Signers []*SignerInfo
+	// Encapsulated content, nil if detached.
+	Content []byte
+}
+
+// SignerInfo is a parsed SignerInfo.
+type SignerInfo struct {
+	// Certificate of the signer, nil if not included in the SignedData.
+	Certificate *x509.Certificate
+	// Digest algorithm.
+	Hash crypto.Hash
+	// Signed attributes, by type.  The values are the DER encodings of the first attribute values.
+	SignedAttributes map[string][]byte
+	// Signature value.
+	Signature []byte
+
+	issuer       []byte
+	serialNumber *big.Int
+	signatureAlg pkix.AlgorithmIdentifier
+	// Type of the encapsulated content.
+	contentType asn1.ObjectIdentifier
+	// DER encoding of the signed attributes, with the SET tag.
+	signedAttrs []byte
+}
+
+// Parse parses a DER encoded ContentInfo containing a SignedData.  Trailing zero bytes, as left by
+// the padding of PDF signature Contents, are ignored.
+func Parse(der []byte) (*SignedData, error) {
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil {
+		return nil, fmt.Errorf("pkcs7: %v", err)
+	}
+	if !ci.ContentType.Equal(OIDSignedData) {
+		return nil, fmt.Errorf("pkcs7: content type %v is not SignedData", ci.ContentType)
+	}
+	var sd signedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("pkcs7: %v", err)
+	}
+
+	result := &SignedData{}
+	if len(sd.Certificates.Bytes) > 0 {
+		certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		result.Certificates = certs
+	}
+	if len(sd.EncapContentInfo.EContent.Bytes) > 0 {
+		var content []byte
+		if _, err := asn1.Unmarshal(sd.EncapContentInfo.EContent.Bytes, &content); err != nil {
+			return nil, fmt.Errorf("pkcs7: %v", err)
+		}
+		result.Content = content
+	}
+
+	for _, si := range sd.SignerInfos {
+		hash, err := hashFromOID(si.DigestAlgorithm.Algorithm)
+		if err != nil {
+			return nil, err
+		}
+		signer := &SignerInfo{
+			Hash:             hash,
+			Signature:        si.Signature,
+			SignedAttributes: map[string][]byte{},
+			issuer:           si.SID.Issuer.FullBytes,
+			serialNumber:     si.SID.SerialNumber,
+			signatureAlg:     si.SignatureAlgorithm,
+			contentType:      sd.EncapContentInfo.EContentType,
+		}
+		if len(si.SignedAttrs.FullBytes) > 0 {
+			// Replace the [0] IMPLICIT tag by the SET tag.
+			signer.signedAttrs = append([]byte{0x31}, si.SignedAttrs.FullBytes[1:]...)
+			var attrs []attribute
+			if _, err := asn1.UnmarshalWithParams(signer.signedAttrs, &attrs, "set"); err != nil {
+				return nil, fmt.Errorf("pkcs7: %v", err)
+			}
+			for _, attr := range attrs {
+				var val asn1.RawValue
+				if _, err := asn1.Unmarshal(attr.Values.Bytes, &val); err != nil {
+					return nil, fmt.Errorf("pkcs7: %v", err)
+				}
+				signer.SignedAttributes[attr.Type.String()] = val.FullBytes
+			}
+		}
+		for _, cert := range result.Certificates {
+			if bytes.Equal(cert.RawIssuer, signer.issuer) && cert.SerialNumber.Cmp(signer.serialNumber) == 0 {
+				signer.Certificate = cert
+				break
+			}
+		}
+		result.Signers = append(result.Signers, signer)
+	}
+	return result, nil
+}
+
+// MessageDigest returns the value of the message digest signed attribute.
+func (si *SignerInfo) MessageDigest() ([]byte, error) {
+	der, ok := si.SignedAttributes[OIDAttributeDigest.String()]
+	if !ok {
+		return nil, errors.New("pkcs7: no message digest attribute")
+	}
+	var digest []byte
+	if _, err := asn1.Unmarshal(der, &digest); err != nil {
+		return nil, fmt.Errorf("pkcs7: %v", err)
+	}
+	return digest, nil
+}
+
+// SigningTime returns the value of the signing time signed attribute, if present.
+func (si *SignerInfo) SigningTime() (time.Time, bool) {
+	der, ok := si.SignedAttributes[OIDAttributeSigningTime.String()]
+	if !ok {
+		return time.Time{}, false
+	}
+	var t time.Time
+	if _, err := asn1.Unmarshal(der, &t); err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// checkContentType checks that the content type signed attribute is data.
+func (si *SignerInfo) checkContentType() error {
+	der, ok := si.SignedAttributes[OIDAttributeContentType.String()]
+	if !ok {
+		return errors.New("pkcs7: no content type attribute")
+	}
+	var contentType asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(der, &contentType); err != nil {
+		return fmt.Errorf("pkcs7: %v", err)
+	}
+	if !contentType.Equal(OIDData) {
+		return fmt.Errorf("pkcs7: content type attribute %v is not data", contentType)
+	}
+	return nil
+}
+
+// checkSigningCertificate checks that the signing certificate v2 signed attribute, if present,
+// identifies the certificate of the signer.
+func (si *SignerInfo) checkSigningCertificate() error {
+	der, ok := si.SignedAttributes[OIDAttributeSigningCertificateV2.String()]
+	if !ok {
+		return nil
+	}
+	var attr signingCertificateV2
+	if _, err := asn1.Unmarshal(der, &attr); err != nil {
+		return fmt.Errorf("pkcs7: %v", err)
+	}
+	if len(attr.Certs) == 0 {
+		return errors.New("pkcs7: empty signing certificate attribute")
+	}
+	// The first certificate identifies the signer.
+	certID := attr.Certs[0]
+	hash := crypto.SHA256
+	if len(certID.HashAlgorithm.Algorithm) > 0 {
+		var err error
+		if hash, err = hashFromOID(certID.HashAlgorithm.Algorithm); err != nil {
+			return err
+		}
+	}
+	h := hash.New()
+	h.Write(si.Certificate.Raw)
+	if !bytes.Equal(h.Sum(nil), certID.CertHash) {
+		return errors.New("pkcs7: signing certificate attribute does not match the signer certificate")
+	}
+	if serial := certID.IssuerSerial.SerialNumber; serial != nil && serial.Cmp(si.Certificate.SerialNumber) != 0 {
+		return errors.New("pkcs7: signing certificate attribute serial number mismatch")
+	}
+	return nil
+}
+
+// Verify checks that the signature of `si` is valid for content with digest `digest`, computed
+// with the digest algorithm of `si`, and that the signed attributes match the content type and the
+// certificate of the signer.  The certificate of the signer is not verified.
+func (si *SignerInfo) Verify(digest []byte) error {
+	if si.Certificate == nil {
+		return errors.New("pkcs7: signer certificate not found")
+	}
+	if !si.contentType.Equal(OIDData) {
+		return fmt.Errorf("pkcs7: content type %v is not data", si.contentType)
+	}
+	signed := digest
+	if si.signedAttrs != nil {
+		md, err := si.MessageDigest()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(md, digest) {
+			return errors.New("pkcs7: message digest mismatch")
+		}
+		if err := si.checkContentType(); err != nil {
+			return err
+		}
+		if err := si.checkSigningCertificate(); err != nil {
+			return err
+		}
+		h := si.Hash.New()
+		h.Write(si.signedAttrs)
+		signed = h.Sum(nil)
+	}
+
+	switch pub := si.Certificate.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if si.signatureAlg.Algorithm.Equal(oidRSAPSS) {
+			return rsa.VerifyPSS(pub, si.Hash, signed, si.Signature, nil)
+		}
+		return rsa.VerifyPKCS1v15(pub, si.Hash, signed, si.Signature)
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(pub, signed, si.Signature) {
+			return errors.New("pkcs7: invalid ECDSA signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("pkcs7: unsupported key type %T", si.Certificate.PublicKey)
+}
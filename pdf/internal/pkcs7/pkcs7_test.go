@@ -0,0 +1,189 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package pkcs7
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// makeTestCertificate creates a self-signed certificate for `key`.
+func makeTestCertificate(t *testing.T, key crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject:      pkix.Name{CommonName: "Test Signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+	return cert
+}
+
+func TestSignDetached(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	content := []byte("Signed content")
+	digest := sha256.Sum256(content)
+
+	tests := []struct {
+		key  crypto.Signer
+		alg  x509.SignatureAlgorithm
+		opts *SignOptions
+	}{
+		{rsaKey, x509.SHA256WithRSA, &SignOptions{SigningTime: time.Now()}},
+		{ecKey, x509.ECDSAWithSHA256, &SignOptions{SigningCertificateV2: true}},
+	}
+	for i, test := range tests {
+		cert := makeTestCertificate(t, test.key)
+		der, err := Sign(digest[:], test.key, []*x509.Certificate{cert}, test.opts)
+		if err != nil {
+			t.Fatalf("%d: Failed to sign: %v", i, err)
+		}
+
+		// Trailing zeros are ignored.
+		sd, err := Parse(append(der, make([]byte, 100)...))
+		if err != nil {
+			t.Fatalf("%d: Failed to parse: %v", i, err)
+		}
+		if len(sd.Certificates) != 1 || len(sd.Signers) != 1 || sd.Content != nil {
+			t.Fatalf("%d: Wrong SignedData %+v", i, sd)
+		}
+		signer := sd.Signers[0]
+		if signer.Certificate == nil || !signer.Certificate.Equal(cert) {
+			t.Errorf("%d: Signing certificate not found", i)
+		}
+		if signer.Hash != crypto.SHA256 {
+			t.Errorf("%d: Wrong digest algorithm %v", i, signer.Hash)
+		}
+		if md, err := signer.MessageDigest(); err != nil || !bytes.Equal(md, digest[:]) {
+			t.Errorf("%d: Wrong message digest %x (%v)", i, md, err)
+		}
+		if _, ok := signer.SigningTime(); ok != !test.opts.SigningTime.IsZero() {
+			t.Errorf("%d: Wrong signing time attribute", i)
+		}
+		_, ok := signer.SignedAttributes[OIDAttributeSigningCertificateV2.String()]
+		if ok != test.opts.SigningCertificateV2 {
+			t.Errorf("%d: Wrong signing certificate attribute", i)
+		}
+		if err := cert.CheckSignature(test.alg, signer.signedAttrs, signer.Signature); err != nil {
+			t.Errorf("%d: Invalid signature: %v", i, err)
+		}
+		if err := signer.Verify(digest[:]); err != nil {
+			t.Errorf("%d: Verification failed: %v", i, err)
+		}
+		other := sha256.Sum256([]byte("Other content"))
+		if err := signer.Verify(other[:]); err == nil {
+			t.Errorf("%d: Other content verified", i)
+		}
+		if test.opts.SigningCertificateV2 {
+			// Another certificate of the same key.
+			signer.Certificate = makeTestCertificate(t, test.key)
+			if err := signer.Verify(digest[:]); err == nil {
+				t.Errorf("%d: Certificate not matching the signing certificate attribute verified", i)
+			}
+			signer.Certificate = cert
+		}
+		signer.Signature[0] ^= 1
+		if err := signer.Verify(digest[:]); err == nil {
+			t.Errorf("%d: Modified signature verified", i)
+		}
+	}
+}
+
+func TestVerifyContentType(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	cert := makeTestCertificate(t, key)
+	digest := sha256.Sum256([]byte("Signed content"))
+	der, err := Sign(digest[:], key, []*x509.Certificate{cert}, nil)
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+	sd, err := Parse(der)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	signer := sd.Signers[0]
+
+	signer.contentType = OIDSignedData
+	if err := signer.Verify(digest[:]); err == nil {
+		t.Errorf("Encapsulated content type other than data verified")
+	}
+	signer.contentType = OIDData
+
+	// Content type attribute other than data, signed again.
+	data, _ := asn1.Marshal(OIDData)
+	other, _ := asn1.Marshal(OIDSignedData)
+	signer.signedAttrs = bytes.Replace(signer.signedAttrs, data, other, 1)
+	signer.SignedAttributes[OIDAttributeContentType.String()] = other
+	h := sha256.Sum256(signer.signedAttrs)
+	if signer.Signature, err = key.Sign(rand.Reader, h[:], crypto.SHA256); err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+	if err := signer.Verify(digest[:]); err == nil {
+		t.Errorf("Content type attribute other than data verified")
+	}
+}
+
+func TestEnvelope(t *testing.T) {
+	var keys []*rsa.PrivateKey
+	var certs []*x509.Certificate
+	for i := 0; i < 3; i++ {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("Failed to generate key: %v", err)
+		}
+		keys = append(keys, key)
+		certs = append(certs, makeTestCertificate(t, key))
+		certs[i].SerialNumber = big.NewInt(int64(i))
+	}
+
+	content := []byte("Enveloped content")
+	der, err := Encrypt(content, certs[:2])
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		decrypted, err := Decrypt(der, certs[i], keys[i])
+		if err != nil {
+			t.Fatalf("%d: Failed to decrypt: %v", i, err)
+		}
+		if !bytes.Equal(decrypted, content) {
+			t.Errorf("%d: Wrong content %q", i, decrypted)
+		}
+	}
+	if _, err := Decrypt(der, certs[2], keys[2]); err != ErrNotRecipient {
+		t.Errorf("Decrypted for other certificate: %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package transform implements the affine transformation matrices of PDF graphics (8.3.4
+// "Transformation Matrices").
+package transform
+
+import "math"
+
+// Matrix is the affine transformation [a b c d e f] representing the 3x3 matrix
+//
+//	| a b 0 |
+//	| c d 0 |
+//	| e f 1 |
+//
+// which maps the row vector [x y 1] to [a*x+c*y+e b*x+d*y+f 1].
+type Matrix [6]float64
+
+// IdentityMatrix returns the identity transformation.
+func IdentityMatrix() Matrix {
+	return Matrix{1, 0, 0, 1, 0, 0}
+}
+
+// NewMatrix returns the transformation [a b c d e f], e.g. the operands of cm or Tm.
+func NewMatrix(a, b, c, d, e, f float64) Matrix {
+	return Matrix{a, b, c, d, e, f}
+}
+
+// TranslationMatrix returns the translation by `tx`, `ty`.
+func TranslationMatrix(tx, ty float64) Matrix {
+	return Matrix{1, 0, 0, 1, tx, ty}
+}
+
+// ScaleMatrix returns the scaling by `sx`, `sy`.
+func ScaleMatrix(sx, sy float64) Matrix {
+	return Matrix{sx, 0, 0, sy, 0, 0}
+}
+
+// Mult returns the product m × `n`: the transformation m followed by `n`.  For example the cm
+// operator sets the CTM to cm × CTM.
+func (m Matrix) Mult(n Matrix) Matrix {
+	return Matrix{
+		m[0]*n[0] + m[1]*n[2],
+		m[0]*n[1] + m[1]*n[3],
+		m[2]*n[0] + m[3]*n[2],
+		m[2]*n[1] + m[3]*n[3],
+		m[4]*n[0] + m[5]*n[2] + n[4],
+		m[4]*n[1] + m[5]*n[3] + n[5],
+	}
+}
+
+// Transform returns the point `x`, `y` transformed by m.
+func (m Matrix) Transform(x, y float64) (float64, float64) {
+	return m[0]*x + m[2]*y + m[4], m[1]*x + m[3]*y + m[5]
+}
+
+// TransformVector returns the vector `dx`, `dy` transformed by m, without the translation.
+func (m Matrix) TransformVector(dx, dy float64) (float64, float64) {
+	return m[0]*dx + m[2]*dy, m[1]*dx + m[3]*dy
+}
+
+// Inverse returns the inverse of m.  The bool return flag is false if m is not invertible.
+func (m Matrix) Inverse() (Matrix, bool) {
+	det := m[0]*m[3] - m[1]*m[2]
+	if det == 0 || math.IsNaN(det) || math.IsInf(det, 0) {
+		return Matrix{}, false
+	}
+	return Matrix{
+		m[3] / det,
+		-m[1] / det,
+		-m[2] / det,
+		m[0] / det,
+		(m[2]*m[5] - m[3]*m[4]) / det,
+		(m[1]*m[4] - m[0]*m[5]) / det,
+	}, true
+}
+
+// ScalingFactorX returns the length of the unit vector along x transformed by m.
+func (m Matrix) ScalingFactorX() float64 {
+	return math.Hypot(m[0], m[1])
+}
+
+// ScalingFactorY returns the length of the unit vector along y transformed by m.
+func (m Matrix) ScalingFactorY() float64 {
+	return math.Hypot(m[2], m[3])
+}
+
+// TransformRect returns the bounding box of the rectangle from `llx`, `lly` to `urx`, `ury`
+// transformed by m.
+func (m Matrix) TransformRect(llx, lly, urx, ury float64) (float64, float64, float64, float64) {
+	x0, y0 := m.Transform(llx, lly)
+	minX, minY, maxX, maxY := x0, y0, x0, y0
+	for _, p := range [][2]float64{{urx, lly}, {urx, ury}, {llx, ury}} {
+		x, y := m.Transform(p[0], p[1])
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+	}
+	return minX, minY, maxX, maxY
+}
@@ -0,0 +1,376 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// PdfAppender writes incremental updates of PDF documents (section 7.5.6).  The changed and new
+// objects are appended to the original file data, followed by a cross-reference section and a
+// trailer referring to the previous ones.  The original data is left unchanged, so that existing
+// digital signatures remain valid.
+type PdfAppender struct {
+	reader *PdfReader
+
+	// Changed and new objects, in the order they were added.
+	objects    []PdfObject
+	objectsMap map[PdfObject]bool
+
+	// Type of the cross-reference section of the update.
+	xrefType XrefType
+
+	// Trailer entries set for the update.
+	trailerEntries *PdfObjectDictionary
+
+	// Signature created when writing the update.
+	signature *PdfSignature
+}
+
+// NewPdfAppender creates a new appender for updating the document read by `reader`.  The objects
+// to update are obtained from `reader`, which has to be decrypted if the document is encrypted.
+// The cross-reference section of the update has the same type as the last one of the document.
+func NewPdfAppender(reader *PdfReader) (*PdfAppender, error) {
+	if reader.rs == nil || reader.parser.GetTrailer() == nil {
+		return nil, errors.New("Reader has no document loaded")
+	}
+	if reader.parser.GetCrypter() != nil && !reader.parser.IsAuthenticated() {
+		return nil, errors.New("Document needs to be decrypted first")
+	}
+
+	appender := &PdfAppender{}
+	appender.reader = reader
+	appender.objectsMap = map[PdfObject]bool{}
+	appender.xrefType = reader.parser.GetXrefType()
+	appender.trailerEntries = MakeDict()
+	return appender, nil
+}
+
+// SetXrefType sets the type of the cross-reference section written for the update.  Cross-reference
+// streams require PDF 1.5.
+func (this *PdfAppender) SetXrefType(xrefType XrefType) {
+	this.xrefType = xrefType
+}
+
+// SetTrailerEntry sets the entry `key` of the trailer of the update, such as Info or Root.  The
+// other entries are copied from the trailer of the document.
+func (this *PdfAppender) SetTrailerEntry(key PdfObjectName, val PdfObject) error {
+	if err := this.UpdateObject(val); err != nil {
+		return err
+	}
+	this.trailerEntries.Set(key, val)
+	return nil
+}
+
+// UpdateObject adds the changed or new object `obj` to the update.  `obj` is an indirect or stream
+// object, either loaded from the document or new.  The new objects it refers to, which have no
+// object number yet, are added as well.  Other object types are ignored, except for the new
+// objects they contain.
+func (this *PdfAppender) UpdateObject(obj PdfObject) error {
+	switch obj.(type) {
+	case *PdfIndirectObject, *PdfObjectStream:
+		if this.objectsMap[obj] {
+			return nil
+		}
+		this.objectsMap[obj] = true
+		this.objects = append(this.objects, obj)
+	case *PdfObjectReference:
+		common.Log.Debug("ERROR: Cannot update a reference")
+		return errors.New("Reference not allowed")
+	}
+
+	var err error
+	forEachReferencedObject(obj, func(ref PdfObject) {
+		if err == nil && objectNumber(ref) == 0 {
+			err = this.UpdateObject(ref)
+		}
+	})
+	return err
+}
+
+// objectNumber returns the object number of the indirect or stream object `obj`, 0 if it has none.
+func objectNumber(obj PdfObject) int64 {
+	switch t := obj.(type) {
+	case *PdfIndirectObject:
+		return t.ObjectNumber
+	case *PdfObjectStream:
+		return t.ObjectNumber
+	}
+	return 0
+}
+
+// forEachReferencedObject calls `f` for each indirect and stream object referred to by the direct
+// contents of `obj`.
+func forEachReferencedObject(obj PdfObject, f func(PdfObject)) {
+	var visit func(obj PdfObject, top bool)
+	visit = func(obj PdfObject, top bool) {
+		switch t := obj.(type) {
+		case *PdfIndirectObject:
+			if !top {
+				f(t)
+				return
+			}
+			visit(t.PdfObject, false)
+		case *PdfObjectStream:
+			if !top {
+				f(t)
+				return
+			}
+			visit(t.PdfObjectDictionary, false)
+		case *PdfObjectDictionary:
+			for _, key := range t.Keys() {
+				visit(t.Get(key), false)
+			}
+		case *PdfObjectArray:
+			for _, o := range *t {
+				visit(o, false)
+			}
+		}
+	}
+	visit(obj, true)
+}
+
+// Write writes the original document followed by the incremental update to `w`.  The original
+// document is copied from the reader.  The objects of the update are not changed by writing, so
+// that it can be written again.
+func (this *PdfAppender) Write(w io.Writer) error {
+	parser := this.reader.parser
+	rs := this.reader.rs
+
+	originalSize, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	endsWithEOL := true
+	if originalSize > 0 {
+		last := make([]byte, 1)
+		if _, err := rs.Seek(originalSize-1, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err := io.ReadFull(rs, last); err != nil {
+			return err
+		}
+		endsWithEOL = last[0] == '\n' || last[0] == '\r'
+	}
+
+	// Object numbers of the new objects follow those of the document, and those of the new
+	// objects numbered by a previous Write.
+	trailer := parser.GetTrailer()
+	nextObjNum := trailerSize(trailer)
+	for _, objNum := range parser.GetObjectNums() {
+		if int64(objNum) >= nextObjNum {
+			nextObjNum = int64(objNum) + 1
+		}
+	}
+	for _, obj := range this.objects {
+		if objNum := objectNumber(obj); objNum >= nextObjNum {
+			nextObjNum = objNum + 1
+		}
+	}
+	for _, obj := range this.objects {
+		if objectNumber(obj) != 0 {
+			continue
+		}
+		switch t := obj.(type) {
+		case *PdfIndirectObject:
+			t.ObjectNumber, t.GenerationNumber = nextObjNum, 0
+		case *PdfObjectStream:
+			t.ObjectNumber, t.GenerationNumber = nextObjNum, 0
+		}
+		nextObjNum++
+	}
+	sorted := make([]PdfObject, len(this.objects))
+	copy(sorted, this.objects)
+	sort.Slice(sorted, func(i, j int) bool {
+		return objectNumber(sorted[i]) < objectNumber(sorted[j])
+	})
+
+	// The objects are encrypted as copies, leaving those of the update unchanged.
+	written := sorted
+	if crypter := parser.GetCrypter(); crypter != nil {
+		if written, err = this.encrypt(crypter, sorted); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	if !endsWithEOL {
+		bw.WriteString("\n")
+	}
+
+	var entries []xrefEntry
+	sigOffset := int64(-1)
+	for i, obj := range sorted {
+		bw.Flush()
+		var gen int64
+		switch t := obj.(type) {
+		case *PdfIndirectObject:
+			gen = t.GenerationNumber
+		case *PdfObjectStream:
+			gen = t.GenerationNumber
+		}
+		objNum := objectNumber(obj)
+		entries = append(entries, xrefEntry{
+			objNum: int(objNum),
+			typ:    xrefTypeInUse,
+			offset: originalSize + int64(buf.Len()),
+			gen:    int(gen),
+		})
+		if this.signature != nil && obj == this.signature.container {
+			sigOffset = int64(buf.Len())
+		}
+		writeObject(bw, objNum, gen, written[i])
+	}
+	bw.Flush()
+	xrefOffset := originalSize + int64(buf.Len())
+
+	// The trailer has the entries of the previous one, except those describing the previous
+	// cross-reference section.
+	newTrailer := MakeDict()
+	for _, key := range trailer.Keys() {
+		switch key {
+		case "Prev", "XRefStm", "Type", "W", "Index", "Filter", "DecodeParms", "Length", "DL":
+			continue
+		}
+		newTrailer.Set(key, trailer.Get(key))
+	}
+	for _, key := range this.trailerEntries.Keys() {
+		newTrailer.Set(key, this.trailerEntries.Get(key))
+	}
+	newTrailer.Set("Prev", MakeInteger(parser.GetXrefOffset()))
+
+	if this.xrefType == XrefTypeStream {
+		// The cross-reference stream is the last new object.
+		entries = append(entries, xrefEntry{objNum: int(nextObjNum), typ: xrefTypeInUse, offset: xrefOffset})
+		newTrailer.Set("Size", MakeInteger(nextObjNum+1))
+		xrefStream, err := makeXrefStream(entries, newTrailer)
+		if err != nil {
+			return err
+		}
+		writeObject(bw, nextObjNum, 0, xrefStream)
+	} else {
+		newTrailer.Set("Size", MakeInteger(nextObjNum))
+		writeXrefTable(bw, entries)
+		bw.WriteString("trailer\n")
+		bw.WriteString(newTrailer.DefaultWriteString())
+		bw.WriteString("\n")
+	}
+	bw.WriteString(fmt.Sprintf("startxref\n%d\n", xrefOffset))
+	bw.WriteString("%%EOF\n")
+	bw.Flush()
+
+	update := buf.Bytes()
+	if this.signature != nil {
+		if sigOffset < 0 {
+			return errors.New("Signature not written")
+		}
+		if err := this.signUpdate(update, originalSize, sigOffset); err != nil {
+			return err
+		}
+	}
+
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(w, rs, originalSize); err != nil {
+		return err
+	}
+	_, err = w.Write(update)
+	return err
+}
+
+// encrypt returns encrypted copies of the objects `objects` of the update, encrypted with the
+// encryption parameters of the document.
+func (this *PdfAppender) encrypt(crypter *PdfCrypt, objects []PdfObject) ([]PdfObject, error) {
+	if crypter.EncryptedObjects == nil {
+		crypter.EncryptedObjects = map[PdfObject]bool{}
+	}
+
+	// The objects referred to by the copies are written as references, they are marked as
+	// encrypted for the time of encrypting the copies so that they are left unchanged.
+	var marked []PdfObject
+	copies := make([]PdfObject, len(objects))
+	for i, obj := range objects {
+		copies[i] = copyObject(obj)
+		forEachReferencedObject(copies[i], func(ref PdfObject) {
+			if !crypter.EncryptedObjects[ref] {
+				crypter.EncryptedObjects[ref] = true
+				marked = append(marked, ref)
+			}
+		})
+	}
+	defer func() {
+		for _, obj := range marked {
+			delete(crypter.EncryptedObjects, obj)
+		}
+		for _, obj := range copies {
+			delete(crypter.EncryptedObjects, obj)
+		}
+	}()
+
+	for _, obj := range copies {
+		var gen int64
+		switch t := obj.(type) {
+		case *PdfIndirectObject:
+			gen = t.GenerationNumber
+		case *PdfObjectStream:
+			gen = t.GenerationNumber
+		}
+		if err := crypter.Encrypt(obj, objectNumber(obj), gen); err != nil {
+			common.Log.Debug("ERROR: Failed encrypting (%s)", err)
+			return nil, err
+		}
+	}
+	return copies, nil
+}
+
+// copyObject returns a copy of the indirect or stream object `obj` and of its direct contents,
+// which refers to the same indirect and stream objects as `obj`.
+func copyObject(obj PdfObject) PdfObject {
+	var copyDirect func(obj PdfObject) PdfObject
+	copyDirect = func(obj PdfObject) PdfObject {
+		switch t := obj.(type) {
+		case *PdfObjectDictionary:
+			dict := MakeDict()
+			for _, key := range t.Keys() {
+				dict.Set(key, copyDirect(t.Get(key)))
+			}
+			return dict
+		case *PdfObjectArray:
+			arr := make(PdfObjectArray, len(*t))
+			for i, o := range *t {
+				arr[i] = copyDirect(o)
+			}
+			return &arr
+		case *PdfObjectString:
+			str := *t
+			return &str
+		}
+		return obj
+	}
+
+	switch t := obj.(type) {
+	case *PdfIndirectObject:
+		indirect := *t
+		indirect.PdfObject = copyDirect(t.PdfObject)
+		return &indirect
+	case *PdfObjectStream:
+		stream := *t
+		stream.PdfObjectDictionary = copyDirect(t.PdfObjectDictionary).(*PdfObjectDictionary)
+		stream.Stream = append([]byte{}, t.Stream...)
+		return &stream
+	}
+	return copyDirect(obj)
+}
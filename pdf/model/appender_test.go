@@ -0,0 +1,122 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// appendTestContent adds a content stream to page 1 of document `data` with an incremental update.
+func appendTestContent(t *testing.T, data []byte, password []byte, xrefType *XrefType) []byte {
+	reader := readTestDocument(t, data, password)
+	page, err := reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Failed to get page: %v", err)
+	}
+	page.AddContentStreamByString("BT (Appended) Tj ET")
+
+	appender, err := NewPdfAppender(reader)
+	if err != nil {
+		t.Fatalf("Failed to create appender: %v", err)
+	}
+	if xrefType != nil {
+		appender.SetXrefType(*xrefType)
+	}
+	if err := appender.UpdateObject(page.ToPdfObject()); err != nil {
+		t.Fatalf("Failed to update page: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := appender.Write(&buf); err != nil {
+		t.Fatalf("Failed to write update: %v", err)
+	}
+
+	// Writing leaves the updated objects unchanged, so that the update can be written again.
+	content, err := page.GetAllContentStreams()
+	if err != nil || !strings.Contains(content, "(Appended) Tj") {
+		t.Errorf("Page changed by writing: %q (%v)", content, err)
+	}
+	var again bytes.Buffer
+	if err := appender.Write(&again); err != nil {
+		t.Fatalf("Failed to write update again: %v", err)
+	}
+	if again.Len() != buf.Len() {
+		t.Errorf("Update written again differs (%d != %d bytes)", again.Len(), buf.Len())
+	}
+
+	out := buf.Bytes()
+	if !bytes.HasPrefix(out, data) {
+		t.Fatalf("Original data changed")
+	}
+	if !bytes.Contains(out[len(data):], []byte("/Prev")) {
+		t.Errorf("No /Prev in trailer")
+	}
+	return out
+}
+
+// checkAppendedContent checks that page 1 of `data` has the content added by appendTestContent.
+func checkAppendedContent(t *testing.T, data []byte, password []byte) {
+	reader := readTestDocument(t, data, password)
+	page, err := reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Failed to get page: %v", err)
+	}
+	content, err := page.GetAllContentStreams()
+	if err != nil {
+		t.Fatalf("Failed to get content: %v", err)
+	}
+	if !strings.Contains(content, "(Appended) Tj") {
+		t.Errorf("Appended content missing: %q", content)
+	}
+}
+
+func TestAppendIncrementalUpdate(t *testing.T) {
+	table, stream := XrefTypeTable, XrefTypeStream
+
+	// Cross-reference table, then both types of cross-reference sections following a stream.
+	data := writeTestDocument(t, 3, nil)
+	updated := appendTestContent(t, data, nil, nil)
+	if !bytes.Contains(updated[len(data):], []byte("trailer")) {
+		t.Errorf("No cross-reference table")
+	}
+	checkTestDocument(t, updated, 3, nil)
+	checkAppendedContent(t, updated, nil)
+
+	data = writeTestDocument(t, 3, func(w *PdfWriter) {
+		w.SetObjectStreams(true)
+	})
+	for _, xrefType := range []*XrefType{nil, &table} {
+		updated := appendTestContent(t, data, nil, xrefType)
+		isTable := bytes.Contains(updated[len(data):], []byte("trailer"))
+		if isTable != (xrefType == &table) {
+			t.Errorf("Wrong cross-reference section type")
+		}
+		checkTestDocument(t, updated, 3, nil)
+		checkAppendedContent(t, updated, nil)
+	}
+
+	// Updates of updates.
+	updated = appendTestContent(t, updated, nil, &stream)
+	checkTestDocument(t, updated, 3, nil)
+
+	// Updated objects are encrypted like the original ones.
+	for _, algorithm := range []EncryptionAlgorithm{RC4_128bit, AES_256bit} {
+		data := writeTestDocument(t, 2, func(w *PdfWriter) {
+			if err := w.Encrypt([]byte("user"), []byte("owner"), &EncryptOptions{Algorithm: algorithm}); err != nil {
+				t.Fatalf("Failed to set encryption: %v", err)
+			}
+		})
+		updated := appendTestContent(t, data, []byte("user"), nil)
+		if bytes.Contains(updated, []byte("(Appended)")) {
+			t.Errorf("Content not encrypted")
+		}
+		checkTestDocument(t, updated, 2, []byte("user"))
+		checkAppendedContent(t, updated, []byte("user"))
+	}
+}
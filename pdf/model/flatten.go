@@ -0,0 +1,211 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// Annotation flag of annotations that are neither shown nor printed (section 12.5.3).
+const annotationFlagHidden = 1 << 1
+
+// FlattenFields flattens the form of the document, so that the values of its fields cannot be
+// edited: the appearances of the widget annotations of the fields are drawn in the content of their
+// pages, and the widget annotations are removed with the form (AcroForm).  Appearances are
+// generated first for the widget annotations without normal appearance, and for those of all text
+// and choice fields if the form sets NeedAppearances.  The other annotations are flattened as well
+// if `allAnnotations`.  The pages of the reader are updated and are then written by PdfWriter.
+func (this *PdfReader) FlattenFields(allAnnotations bool) error {
+	if form := this.AcroForm; form != nil {
+		needAppearances := form.NeedAppearances != nil && bool(*form.NeedAppearances)
+		for _, field := range form.GetTerminalFields() {
+			for _, widget := range field.GetWidgets() {
+				if _, ok := widget.getNormalAppearance(); ok && !needAppearances {
+					continue
+				}
+				if err := field.generateAppearance(widget); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, page := range this.PageList {
+		if err := page.FlattenAnnotations(allAnnotations); err != nil {
+			return err
+		}
+	}
+	this.AcroForm = nil
+	if this.catalog != nil {
+		this.catalog.Remove("AcroForm")
+	}
+	return nil
+}
+
+// FlattenAnnotations draws the normal appearances of the widget annotations of the page in its
+// content and removes them, and does the same for all its other annotations if `allAnnotations`.
+// Each appearance is drawn as a form XObject, with the matrix mapping its bounding box to the
+// rectangle of its annotation (section 12.5.5).  Hidden annotations and annotations without normal
+// appearance are removed without being drawn.  PdfReader.FlattenFields generates the missing
+// appearances of fields before flattening their widget annotations.
+func (this *PdfPage) FlattenAnnotations(allAnnotations bool) error {
+	var content bytes.Buffer
+	var kept []*PdfAnnotation
+	names := map[*PdfObjectStream]PdfObjectName{} // XObject names of the appearances drawn.
+	for _, annot := range this.Annotations {
+		if _, isWidget := annot.GetContext().(*PdfAnnotationWidget); !isWidget && !allAnnotations {
+			kept = append(kept, annot)
+			continue
+		}
+		if flags, ok := TraceToDirectObject(annot.F).(*PdfObjectInteger); ok && *flags&annotationFlagHidden != 0 {
+			continue
+		}
+		stream, ok := annot.getNormalAppearance()
+		if !ok {
+			common.Log.Debug("Annotation without normal appearance removed")
+			continue
+		}
+		matrix, ok := appearanceMatrix(annot.Rect, stream)
+		if !ok {
+			continue
+		}
+
+		if this.Resources == nil {
+			resources, err := this.getResources()
+			if err != nil {
+				return err
+			}
+			if resources == nil {
+				resources = NewPdfPageResources()
+			}
+			this.Resources = resources
+		}
+		name, added := names[stream]
+		if !added {
+			// Find available XObject name for this page.
+			i := 0
+			name = PdfObjectName(fmt.Sprintf("Fma%d", i))
+			for this.Resources.HasXObjectByName(name) {
+				i++
+				name = PdfObjectName(fmt.Sprintf("Fma%d", i))
+			}
+			// Appearance streams are form XObjects, whose Type and Subtype are optional.
+			stream.Set("Type", MakeName("XObject"))
+			stream.Set("Subtype", MakeName("Form"))
+			if err := this.Resources.SetXObjectByName(name, stream); err != nil {
+				return err
+			}
+			names[stream] = name
+		}
+
+		fmt.Fprintf(&content, "q\n")
+		for _, v := range matrix {
+			fmt.Fprintf(&content, "%.4f ", v)
+		}
+		fmt.Fprintf(&content, "cm\n/%s Do\nQ\n", name)
+	}
+
+	this.Annotations = kept
+	if kept == nil {
+		this.pageDict.Remove("Annots")
+	}
+	if content.Len() == 0 {
+		return nil
+	}
+
+	// The graphics state of the content of the page is restored before drawing the appearances.
+	if this.Contents != nil {
+		save, err := MakeStream([]byte("q\n"), nil)
+		if err != nil {
+			return err
+		}
+		contents := PdfObjectArray{save}
+		if arr, isArray := TraceToDirectObject(this.Contents).(*PdfObjectArray); isArray {
+			contents = append(contents, *arr...)
+		} else {
+			contents = append(contents, this.Contents)
+		}
+		this.Contents = &contents
+		this.AddContentStreamByString("Q\n" + content.String())
+	} else {
+		this.AddContentStreamByString(content.String())
+	}
+	return nil
+}
+
+// getNormalAppearance returns the normal appearance of the annotation, that of its appearance
+// state (AS) if it has several.
+func (this *PdfAnnotation) getNormalAppearance() (*PdfObjectStream, bool) {
+	ap, ok := TraceToDirectObject(this.AP).(*PdfObjectDictionary)
+	if !ok {
+		return nil, false
+	}
+	switch n := TraceToDirectObject(ap.Get("N")).(type) {
+	case *PdfObjectStream:
+		return n, true
+	case *PdfObjectDictionary:
+		as, ok := TraceToDirectObject(this.AS).(*PdfObjectName)
+		if !ok {
+			return nil, false
+		}
+		stream, ok := TraceToDirectObject(n.Get(*as)).(*PdfObjectStream)
+		return stream, ok
+	}
+	return nil, false
+}
+
+// appearanceMatrix returns the matrix mapping the bounding box of the appearance stream `stream`,
+// transformed by its matrix, to the annotation rectangle `rect` (section 12.5.5).  The bool return
+// flag is false if the rectangle or the bounding box are invalid or empty.
+func appearanceMatrix(rect PdfObject, stream *PdfObjectStream) ([6]float64, bool) {
+	var matrix [6]float64
+	rectArr, ok := TraceToDirectObject(rect).(*PdfObjectArray)
+	if !ok {
+		return matrix, false
+	}
+	r, err := NewPdfRectangle(*rectArr)
+	if err != nil {
+		return matrix, false
+	}
+	bboxArr, ok := TraceToDirectObject(stream.Get("BBox")).(*PdfObjectArray)
+	if !ok {
+		common.Log.Debug("ERROR: Appearance stream without BBox")
+		return matrix, false
+	}
+	bbox, err := NewPdfRectangle(*bboxArr)
+	if err != nil {
+		return matrix, false
+	}
+	m := [6]float64{1, 0, 0, 1, 0, 0}
+	if arr, ok := TraceToDirectObject(stream.Get("Matrix")).(*PdfObjectArray); ok {
+		vals, err := arr.ToFloat64Array()
+		if err == nil && len(vals) == 6 {
+			copy(m[:], vals)
+		}
+	}
+
+	// Bounds of the bounding box transformed by the matrix of the appearance.
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, p := range [][2]float64{{bbox.Llx, bbox.Lly}, {bbox.Urx, bbox.Lly}, {bbox.Urx, bbox.Ury}, {bbox.Llx, bbox.Ury}} {
+		x := m[0]*p[0] + m[2]*p[1] + m[4]
+		y := m[1]*p[0] + m[3]*p[1] + m[5]
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+	}
+	if maxX-minX <= 0 || maxY-minY <= 0 {
+		return matrix, false
+	}
+	llx, lly := math.Min(r.Llx, r.Urx), math.Min(r.Lly, r.Ury)
+	sx := math.Abs(r.Urx-r.Llx) / (maxX - minX)
+	sy := math.Abs(r.Ury-r.Lly) / (maxY - minY)
+	return [6]float64{sx, 0, 0, sy, llx - minX*sx, lly - minY*sy}, true
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// flattenTestForm fills and flattens the form written by writeTestForm, and returns the page of the
+// flattened document and its content.
+func flattenTestForm(t *testing.T, allAnnotations bool) (*PdfPage, string) {
+	reader, form := readTestForm(t, writeTestForm(t))
+	if err := getTestField(t, form, "person.name").SetText("Flat"); err != nil {
+		t.Fatalf("Failed to set text: %v", err)
+	}
+	if err := reader.FlattenFields(allAnnotations); err != nil {
+		t.Fatalf("Failed to flatten: %v", err)
+	}
+	if reader.AcroForm != nil {
+		t.Errorf("Form not removed")
+	}
+
+	page, err := reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Failed to get page: %v", err)
+	}
+	reader = readTestDocument(t, writeTestPages(t, []*PdfPage{page}, nil), nil)
+	if reader.AcroForm != nil {
+		t.Errorf("Form written")
+	}
+	page, err = reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Failed to get page: %v", err)
+	}
+	content, err := page.GetAllContentStreams()
+	if err != nil {
+		t.Fatalf("Failed to get content: %v", err)
+	}
+	return page, content
+}
+
+func TestFlattenFields(t *testing.T) {
+	page, content := flattenTestForm(t, false)
+	if len(page.Annotations) != 1 {
+		t.Fatalf("Wrong number of annotations %d", len(page.Annotations))
+	}
+	if _, isSquare := page.Annotations[0].GetContext().(*PdfAnnotationSquare); !isSquare {
+		t.Errorf("Wrong annotation kept %T", page.Annotations[0].GetContext())
+	}
+	// The text field is drawn at its rectangle, and the unchecked check box in its Off state.
+	checkContains(t, "Page content", content,
+		"q\n1.0000 0.0000 0.0000 1.0000 100.0000 700.0000 cm\n/Fma0 Do\nQ\n",
+		"1.0000 0.0000 0.0000 1.0000 100.0000 520.0000 cm\n")
+	// The radio buttons, whose appearances are null, are removed without being drawn.
+	if n := strings.Count(content, " Do\n"); n != 6 {
+		t.Errorf("Wrong number of appearances drawn %d:\n%s", n, content)
+	}
+
+	xobj, err := page.Resources.GetXObjectFormByName("Fma0")
+	if err != nil || xobj == nil {
+		t.Fatalf("Appearance XObject not found: %v", err)
+	}
+	decoded, err := DecodeStream(xobj.ToPdfObject().(*PdfObjectStream))
+	if err != nil {
+		t.Fatalf("Failed to decode appearance: %v", err)
+	}
+	checkContains(t, "Appearance", string(decoded), "(Flat) Tj")
+}
+
+func TestFlattenAllAnnotations(t *testing.T) {
+	page, content := flattenTestForm(t, true)
+	if len(page.Annotations) != 0 {
+		t.Errorf("Annotations not removed: %d", len(page.Annotations))
+	}
+	// The appearance of the square annotation is scaled to its rectangle.
+	checkContains(t, "Page content", content, "2.0000 0.0000 0.0000 2.0000 400.0000 400.0000 cm\n")
+}
+
+func TestAppearanceMatrix(t *testing.T) {
+	stream, err := MakeStream(nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create stream: %v", err)
+	}
+	stream.Set("BBox", MakeArrayFromFloats([]float64{0, 0, 20, 10}))
+	stream.Set("Matrix", MakeArrayFromFloats([]float64{0, 1, -1, 0, 10, 0}))
+	m, ok := appearanceMatrix(MakeArrayFromFloats([]float64{100, 200, 110, 220}), stream)
+	if !ok {
+		t.Fatalf("No matrix")
+	}
+	// The rotated bounding box [0 0 10 20] is mapped to the rectangle.
+	if m != [6]float64{1, 0, 0, 1, 100, 200} {
+		t.Errorf("Wrong matrix %v", m)
+	}
+}
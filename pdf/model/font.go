@@ -25,24 +25,149 @@ type PdfFont struct {
 	context interface{} // The underlying font: Type0, Type1, Truetype, etc..
 }
 
-// Set the encoding for the underlying font.
+// Set the encoding for the underlying font.  The encoding of composite fonts is given by their CMap
+// and that of Type3 fonts by their glyph names, and cannot be changed.
 func (font PdfFont) SetEncoder(encoder textencoding.TextEncoder) {
 	switch t := font.context.(type) {
 	case *pdfFontTrueType:
 		t.SetEncoder(encoder)
+	case *pdfFontType1:
+		t.SetEncoder(encoder)
+	}
+}
+
+// Encoder returns the encoder of composite and Type3 fonts, which must be used to encode text shown
+// with the font, or nil for other simple fonts.
+func (font PdfFont) Encoder() textencoding.TextEncoder {
+	switch t := font.context.(type) {
+	case *pdfFontType0:
+		return t.Encoder
+	case *pdfFontType3:
+		return t.Encoder
 	}
+	return nil
 }
 
 func (font PdfFont) GetGlyphCharMetrics(glyph string) (fonts.CharMetrics, bool) {
 	switch t := font.context.(type) {
 	case *pdfFontTrueType:
 		return t.GetGlyphCharMetrics(glyph)
+	case *pdfFontType1:
+		return t.GetGlyphCharMetrics(glyph)
+	case *pdfFontType3:
+		return t.GetGlyphCharMetrics(glyph)
+	case *pdfFontType0:
+		return t.GetGlyphCharMetrics(glyph)
 	}
 
 	return fonts.CharMetrics{}, false
 }
 
-func newPdfFontFromPdfObject(obj core.PdfObject) (*PdfFont, error) {
+// CharcodeBytesToUnicode decodes the character codes `data` of text shown with a simple font to
+// Unicode, by the glyph names of its encoding.  The bool return flag is false for composite fonts,
+// whose codes can only be decoded by their ToUnicode CMap.
+func (font PdfFont) CharcodeBytesToUnicode(data []byte) (string, bool) {
+	var encoder textencoding.TextEncoder
+	switch t := font.context.(type) {
+	case *pdfFontTrueType:
+		encoder = t.Encoder
+	case *pdfFontType1:
+		encoder = t.Encoder
+	case *pdfFontType3:
+		encoder = t.Encoder
+	}
+	if encoder == nil {
+		return "", false
+	}
+
+	runes := make([]rune, 0, len(data))
+	for _, code := range data {
+		r, found := encoder.CharcodeToRune(code)
+		if !found {
+			r = rune(code)
+		}
+		runes = append(runes, r)
+	}
+	return string(runes), true
+}
+
+// GetCharMetrics returns the metrics of the glyph of the character code `code` of text shown with
+// the font, with the width in thousandths of text space units.  The CIDs of the codes of composite
+// fonts are given by their embedded CMap, or else are the codes.
+func (font PdfFont) GetCharMetrics(code uint64) (fonts.CharMetrics, bool) {
+	switch t := font.context.(type) {
+	case *pdfFontTrueType:
+		if w, ok := simpleCharWidth(code, t.firstChar, t.charWidths); ok {
+			return fonts.CharMetrics{Wx: w}, true
+		}
+	case *pdfFontType1:
+		if w, ok := simpleCharWidth(code, t.firstChar, t.charWidths); ok {
+			return fonts.CharMetrics{Wx: w}, true
+		}
+		if glyph, found := t.Encoder.CharcodeToGlyph(byte(code)); found && t.program != nil && code <= 0xFF {
+			return t.program.GetGlyphCharMetrics(glyph)
+		}
+	case *pdfFontType3:
+		if w, ok := simpleCharWidth(code, t.firstChar, t.charWidths); ok {
+			return fonts.CharMetrics{Wx: 1000 * w * t.fontMatrix[0]}, true
+		}
+	case *pdfFontType0:
+		if t.DescendantFont == nil {
+			return fonts.CharMetrics{}, false
+		}
+		cidfont, ok := t.DescendantFont.context.(*pdfCIDFont)
+		if !ok {
+			return fonts.CharMetrics{}, false
+		}
+		return cidfont.GetCharMetrics(t.charcodeToCID(code)), true
+	}
+	return fonts.CharMetrics{}, false
+}
+
+// simpleCharWidth returns the width of `code` in the Widths array `widths` of a simple font
+// starting at `firstChar`.
+func simpleCharWidth(code uint64, firstChar int, widths []float64) (float64, bool) {
+	if code > 0xFF || int(code) < firstChar || int(code)-firstChar >= len(widths) {
+		return 0, false
+	}
+	return widths[int(code)-firstChar], true
+}
+
+// GetFontDescriptor returns the font descriptor of the font, or of the descendant font of composite
+// fonts.  Returns nil if none, e.g. for the standard 14 fonts.
+func (font PdfFont) GetFontDescriptor() *PdfFontDescriptor {
+	switch t := font.context.(type) {
+	case *pdfFontTrueType:
+		return t.FontDescriptor
+	case *pdfFontType1:
+		return t.FontDescriptor
+	case *pdfFontType3:
+		return t.FontDescriptor
+	case *pdfFontType0:
+		if t.DescendantFont != nil {
+			return t.DescendantFont.GetFontDescriptor()
+		}
+	case *pdfCIDFont:
+		return t.FontDescriptor
+	}
+	return nil
+}
+
+// subsettableProgram returns the TrueType font program embedded by NewPdfFontFromTTFFile or
+// NewCompositePdfFontFromTTFFile, which can be subset by the writer.  Returns nil for other fonts.
+func (font PdfFont) subsettableProgram() *core.PdfObjectStream {
+	descriptor := font.GetFontDescriptor()
+	if descriptor == nil || !descriptor.subsettable {
+		return nil
+	}
+	program, _ := descriptor.FontFile2.(*core.PdfObjectStream)
+	return program
+}
+
+// NewPdfFontFromPdfObject loads a font from a font dictionary, either a *PdfIndirectObject or a
+// *PdfObjectDictionary.  Supported are Type1 fonts, with Type 1 or CFF font programs, TrueType
+// fonts, Type3 fonts and Type0 fonts with CIDFontType0 or CIDFontType2 descendant fonts.
+func NewPdfFontFromPdfObject(obj core.PdfObject) (*PdfFont, error) {
 	font := &PdfFont{}
 
 	dictObj := obj
@@ -67,27 +192,59 @@ func newPdfFontFromPdfObject(obj core.PdfObject) (*PdfFont, error) {
 		return nil, errors.New("Required attribute missing")
 	}
 
-	obj = d.Get("Subtype")
-	if obj == nil {
+	subtypeObj := d.Get("Subtype")
+	if subtypeObj == nil {
 		common.Log.Debug("Incompatibility ERROR: Subtype (Required) missing")
 		return nil, errors.New("Required attribute missing")
 	}
 
-	subtype, ok := core.TraceToDirectObject(obj).(*core.PdfObjectName)
+	subtype, ok := core.TraceToDirectObject(subtypeObj).(*core.PdfObjectName)
 	if !ok {
-		common.Log.Debug("Incompatibility ERROR: subtype not a name (%T) ", obj)
+		common.Log.Debug("Incompatibility ERROR: subtype not a name (%T) ", subtypeObj)
 		return nil, errors.New("Type check error")
 	}
 
 	switch subtype.String() {
+	case "Type1", "MMType1":
+		type1font, err := newPdfFontType1FromPdfObject(obj)
+		if err != nil {
+			common.Log.Debug("Error loading Type1 font: %v", err)
+			return nil, err
+		}
+
+		font.context = type1font
 	case "TrueType":
 		truefont, err := newPdfFontTrueTypeFromPdfObject(obj)
 		if err != nil {
-			common.Log.Debug("Error loading truetype font: %v", truefont)
+			common.Log.Debug("Error loading truetype font: %v", err)
 			return nil, err
 		}
 
 		font.context = truefont
+	case "Type3":
+		type3font, err := newPdfFontType3FromPdfObject(obj)
+		if err != nil {
+			common.Log.Debug("Error loading Type3 font: %v", err)
+			return nil, err
+		}
+
+		font.context = type3font
+	case "Type0":
+		type0font, err := newPdfFontType0FromPdfObject(obj)
+		if err != nil {
+			common.Log.Debug("Error loading Type0 font: %v", err)
+			return nil, err
+		}
+
+		font.context = type0font
+	case "CIDFontType0", "CIDFontType2":
+		cidfont, err := newPdfCIDFontFromPdfObject(obj)
+		if err != nil {
+			common.Log.Debug("Error loading CID font: %v", err)
+			return nil, err
+		}
+
+		font.context = cidfont
 	default:
 		common.Log.Debug("Unsupported font type: %s", subtype.String())
 		return nil, errors.New("Unsupported font type")
@@ -100,6 +257,14 @@ func (font PdfFont) ToPdfObject() core.PdfObject {
 	switch f := font.context.(type) {
 	case *pdfFontTrueType:
 		return f.ToPdfObject()
+	case *pdfFontType1:
+		return f.ToPdfObject()
+	case *pdfFontType3:
+		return f.ToPdfObject()
+	case *pdfFontType0:
+		return f.ToPdfObject()
+	case *pdfCIDFont:
+		return f.ToPdfObject()
 	}
 
 	// If not supported, return null..
@@ -125,6 +290,12 @@ type pdfFontTrueType struct {
 	Encoding       core.PdfObject
 	ToUnicode      core.PdfObject
 
+	// Glyph IDs by character code in the embedded font program, loaded when first used to get
+	// glyph outlines.
+	program   *fonts.TtfGlyphs
+	codeToGID map[byte]int
+	loaded    bool
+
 	container *core.PdfIndirectObject
 }
 
@@ -259,6 +430,14 @@ func newPdfFontTrueTypeFromPdfObject(obj core.PdfObject) (*pdfFontTrueType, erro
 
 	font.Encoding = d.Get("Encoding")
 	font.ToUnicode = d.Get("ToUnicode")
+	// Differences without BaseEncoding apply to WinAnsiEncoding, as for fonts without Encoding.
+	winAnsi, _ := textencoding.NewSimpleTextEncoder("WinAnsiEncoding", nil)
+	encoder, err := newSimpleFontEncoder(font.Encoding, winAnsi)
+	if err != nil {
+		common.Log.Debug("Unsupported font encoding, using WinAnsiEncoding: %v", err)
+		encoder = textencoding.NewWinAnsiTextEncoder()
+	}
+	font.Encoder = encoder
 
 	return font, nil
 }
@@ -358,7 +537,27 @@ func NewPdfFontFromTTFFile(filePath string) (*PdfFont, error) {
 
 	truefont.Encoding = core.MakeName("WinAnsiEncoding")
 
+	descriptor, err := newPdfFontDescriptorFromTTFFile(filePath, ttf, 1<<5)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build Font.
+	truefont.FontDescriptor = descriptor
+
+	font := &PdfFont{}
+	font.context = truefont
+
+	return font, nil
+}
+
+// newPdfFontDescriptorFromTTFFile creates the font descriptor of the TrueType font `ttf` parsed from
+// the file `filePath`, which is embedded.  `flags` are the Symbolic or Nonsymbolic flags.
+func newPdfFontDescriptorFromTTFFile(filePath string, ttf fonts.TtfType, flags int) (*PdfFontDescriptor, error) {
+	k := 1000.0 / float64(ttf.UnitsPerEm)
+
 	descriptor := &PdfFontDescriptor{}
+	descriptor.FontName = core.MakeName(ttf.PostScriptName)
 	descriptor.Ascent = core.MakeFloat(k * float64(ttf.TypoAscender))
 	descriptor.Descent = core.MakeFloat(k * float64(ttf.TypoDescender))
 	descriptor.CapHeight = core.MakeFloat(k * float64(ttf.CapHeight))
@@ -380,6 +579,7 @@ func NewPdfFontFromTTFFile(filePath string) (*PdfFont, error) {
 	}
 	stream.PdfObjectDictionary.Set("Length1", core.MakeInteger(int64(len(ttfBytes))))
 	descriptor.FontFile2 = stream
+	descriptor.subsettable = true
 
 	if ttf.Bold {
 		descriptor.StemV = core.MakeInteger(120)
@@ -388,7 +588,6 @@ func NewPdfFontFromTTFFile(filePath string) (*PdfFont, error) {
 	}
 
 	// Flags.
-	flags := 1 << 5
 	if ttf.IsFixedPitch {
 		flags |= 1
 	}
@@ -397,13 +596,7 @@ func NewPdfFontFromTTFFile(filePath string) (*PdfFont, error) {
 	}
 	descriptor.Flags = core.MakeInteger(int64(flags))
 
-	// Build Font.
-	truefont.FontDescriptor = descriptor
-
-	font := &PdfFont{}
-	font.context = truefont
-
-	return font, nil
+	return descriptor, nil
 }
 
 // Font descriptors specifies metrics and other attributes of a font.
@@ -436,6 +629,9 @@ type PdfFontDescriptor struct {
 	FD     core.PdfObject
 	CIDSet core.PdfObject
 
+	// Set if FontFile2 is embedded from a TrueType file, and can be subset by the writer.
+	subsettable bool
+
 	// Container.
 	container *core.PdfIndirectObject
 }
@@ -594,7 +790,7 @@ func (this *PdfFontDescriptor) ToPdfObject() core.PdfObject {
 	}
 
 	if this.Style != nil {
-		d.Set("FontName", this.FontName)
+		d.Set("Style", this.Style)
 	}
 
 	if this.Lang != nil {
@@ -611,3 +807,72 @@ func (this *PdfFontDescriptor) ToPdfObject() core.PdfObject {
 
 	return this.container
 }
+
+// newSimpleFontEncoder returns the encoder given by the Encoding entry `obj` of a simple font: the
+// name of a base encoding, or an encoding dictionary with Differences from the base encoding given
+// by BaseEncoding, or else from `builtin`, the built-in encoding of the font program or the
+// default encoding of the font type.  Returns `builtin` if `obj` is nil.
+func newSimpleFontEncoder(obj core.PdfObject, builtin textencoding.SimpleEncoder) (textencoding.TextEncoder, error) {
+	switch t := core.TraceToDirectObject(obj).(type) {
+	case nil:
+		return builtin, nil
+	case *core.PdfObjectName:
+		switch *t {
+		case "WinAnsiEncoding":
+			return textencoding.NewWinAnsiTextEncoder(), nil
+		case "StandardEncoding":
+			return textencoding.NewStandardTextEncoder(), nil
+		}
+		return textencoding.NewSimpleTextEncoder(string(*t), nil)
+	case *core.PdfObjectDictionary:
+		differences, err := parseEncodingDifferences(t.Get("Differences"))
+		if err != nil {
+			return nil, err
+		}
+		if base := t.Get("BaseEncoding"); base != nil {
+			name, ok := core.TraceToDirectObject(base).(*core.PdfObjectName)
+			if !ok {
+				common.Log.Debug("Invalid BaseEncoding type (%T)", base)
+				return nil, errors.New("Type check error")
+			}
+			return textencoding.NewSimpleTextEncoder(string(*name), differences)
+		}
+		return builtin.ApplyDifferences(differences), nil
+	}
+	common.Log.Debug("Invalid Encoding type (%T)", obj)
+	return nil, errors.New("Type check error")
+}
+
+// parseEncodingDifferences returns the glyph names by code of the Differences array `obj` of an
+// encoding dictionary: codes followed by the names of consecutive codes.
+func parseEncodingDifferences(obj core.PdfObject) (map[byte]string, error) {
+	encoding := map[byte]string{}
+	if obj == nil {
+		return encoding, nil
+	}
+
+	arr, ok := core.TraceToDirectObject(obj).(*core.PdfObjectArray)
+	if !ok {
+		common.Log.Debug("Invalid Differences type (%T)", obj)
+		return nil, errors.New("Type check error")
+	}
+
+	code := -1
+	for _, obj := range *arr {
+		switch t := core.TraceToDirectObject(obj).(type) {
+		case *core.PdfObjectInteger:
+			code = int(*t)
+		case *core.PdfObjectName:
+			if code < 0 || code > 255 {
+				common.Log.Debug("Invalid Differences code %d", code)
+				return nil, errors.New("Range check error")
+			}
+			encoding[byte(code)] = string(*t)
+			code++
+		default:
+			common.Log.Debug("Invalid Differences entry (%T)", obj)
+			return nil, errors.New("Type check error")
+		}
+	}
+	return encoding, nil
+}
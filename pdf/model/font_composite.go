@@ -0,0 +1,472 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/cmap"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// pdfFontType0 represents a Type0 (composite) font, whose glyphs are obtained from a descendant
+// CIDFont (section 9.7).  The character codes are mapped to CIDs by the Encoding CMap.
+type pdfFontType0 struct {
+	// Encoder of text shown with the font.  The character codes are Unicode code points, which holds
+	// for fonts created by NewCompositePdfFontFromTTFFile.
+	Encoder textencoding.TextEncoder
+
+	BaseFont       core.PdfObject
+	Encoding       core.PdfObject
+	DescendantFont *PdfFont // CIDFontType0 or CIDFontType2 font.
+	ToUnicode      core.PdfObject
+
+	// Character codes by rune from the ToUnicode CMap of loaded fonts.  If nil, the character
+	// codes are the Unicode code points.
+	runeToCode map[rune]uint64
+
+	// CMap of the embedded Encoding, mapping the character codes to CIDs.  If nil, the CIDs are
+	// the character codes.
+	cidCMap *cmap.CMap
+
+	container *core.PdfIndirectObject
+}
+
+// charcodeToCID returns the CID of the character code `code`.
+func (font pdfFontType0) charcodeToCID(code uint64) uint64 {
+	if font.cidCMap == nil {
+		return code
+	}
+	for numBytes := 1; numBytes <= 4; numBytes++ {
+		if code>>uint(8*numBytes) != 0 {
+			continue
+		}
+		if cid, found := font.cidCMap.CharcodeToCID(code, numBytes); found {
+			return uint64(cid)
+		}
+	}
+	return code
+}
+
+// GetGlyphCharMetrics returns the metrics of `glyph`, whose CID is that of its character code
+// with the Identity-H or Identity-V encodings.
+func (font pdfFontType0) GetGlyphCharMetrics(glyph string) (fonts.CharMetrics, bool) {
+	metrics := fonts.CharMetrics{}
+
+	r, found := font.Encoder.GlyphToRune(glyph)
+	if !found {
+		return metrics, false
+	}
+	code := uint64(r)
+	if font.runeToCode != nil {
+		code, found = font.runeToCode[r]
+		if !found {
+			common.Log.Debug("Glyph not in ToUnicode map: %s", glyph)
+			return metrics, false
+		}
+	}
+
+	if font.DescendantFont == nil {
+		return metrics, false
+	}
+	cidfont, ok := font.DescendantFont.context.(*pdfCIDFont)
+	if !ok {
+		return metrics, false
+	}
+	metrics = cidfont.GetCharMetrics(code)
+	metrics.GlyphName = glyph
+	return metrics, true
+}
+
+func newPdfFontType0FromPdfObject(obj core.PdfObject) (*pdfFontType0, error) {
+	font := &pdfFontType0{}
+
+	if ind, is := obj.(*core.PdfIndirectObject); is {
+		font.container = ind
+		obj = ind.PdfObject
+	}
+
+	d, ok := obj.(*core.PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("Font object invalid, not a dictionary (%T)", obj)
+		return nil, errors.New("Type check error")
+	}
+
+	font.BaseFont = d.Get("BaseFont")
+	font.Encoding = d.Get("Encoding")
+	font.ToUnicode = d.Get("ToUnicode")
+
+	encoding := "Identity-H"
+	switch t := core.TraceToDirectObject(font.Encoding).(type) {
+	case *core.PdfObjectName:
+		encoding = string(*t)
+		// Text is encoded as with Identity-H, the predefined CMap gives the CIDs.
+		if encoding != "Identity-H" && encoding != "Identity-V" && cmap.IsPredefinedCmap(encoding) {
+			cm, err := cmap.LoadPredefinedCmap(encoding)
+			if err != nil {
+				common.Log.Debug("Unable to load the predefined CMap %s: %v", encoding, err)
+			} else {
+				font.cidCMap = cm
+			}
+		}
+	case *core.PdfObjectStream:
+		// Text is encoded as with Identity-H, the embedded CMap gives the CIDs for the widths.
+		data, err := core.DecodeStream(t)
+		if err == nil {
+			font.cidCMap, err = cmap.LoadCmapFromData(data)
+		}
+		if err != nil {
+			common.Log.Debug("Unable to load the Encoding CMap, assuming Identity-H: %v", err)
+			font.cidCMap = nil
+		}
+	}
+	font.Encoder = textencoding.NewIdentityTextEncoder(encoding)
+
+	arr, ok := core.TraceToDirectObject(d.Get("DescendantFonts")).(*core.PdfObjectArray)
+	if !ok || len(*arr) != 1 {
+		common.Log.Debug("Invalid DescendantFonts (%T)", d.Get("DescendantFonts"))
+		return nil, errors.New("Range check error")
+	}
+	descendant, err := NewPdfFontFromPdfObject((*arr)[0])
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := descendant.context.(*pdfCIDFont); !ok {
+		common.Log.Debug("Descendant font not a CIDFont (%T)", descendant.context)
+		return nil, errors.New("Type check error")
+	}
+	font.DescendantFont = descendant
+
+	if stream, ok := core.TraceToDirectObject(font.ToUnicode).(*core.PdfObjectStream); ok {
+		data, err := core.DecodeStream(stream)
+		if err != nil {
+			return nil, err
+		}
+		cm, err := cmap.LoadCmapFromData(data)
+		if err != nil {
+			common.Log.Debug("Error loading ToUnicode CMap: %v", err)
+			return nil, err
+		}
+		font.runeToCode = cm.RuneToCharcodeMap()
+	}
+
+	return font, nil
+}
+
+func (this *pdfFontType0) ToPdfObject() core.PdfObject {
+	if this.container == nil {
+		this.container = &core.PdfIndirectObject{}
+	}
+	d := core.MakeDict()
+	this.container.PdfObject = d
+
+	d.Set("Type", core.MakeName("Font"))
+	d.Set("Subtype", core.MakeName("Type0"))
+
+	if this.BaseFont != nil {
+		d.Set("BaseFont", this.BaseFont)
+	}
+	if this.Encoding != nil {
+		d.Set("Encoding", this.Encoding)
+	}
+	if this.DescendantFont != nil {
+		d.Set("DescendantFonts", core.MakeArray(this.DescendantFont.ToPdfObject()))
+	}
+	if this.ToUnicode != nil {
+		d.Set("ToUnicode", this.ToUnicode)
+	}
+
+	return this.container
+}
+
+// pdfCIDFont represents a CIDFont, the descendant font of a Type0 font (section 9.7.4), with glyph
+// descriptions either in CFF format (CIDFontType0) or in TrueType format (CIDFontType2).
+type pdfCIDFont struct {
+	// Subtype: CIDFontType0 or CIDFontType2.
+	subtype string
+
+	// Widths by CID, and default width.
+	widths       map[uint64]float64
+	defaultWidth float64
+
+	BaseFont       core.PdfObject
+	CIDSystemInfo  core.PdfObject
+	FontDescriptor *PdfFontDescriptor
+	DW             core.PdfObject
+	W              core.PdfObject
+	DW2            core.PdfObject
+	W2             core.PdfObject
+	CIDToGIDMap    core.PdfObject // CIDFontType2 only.
+
+	// The embedded font program, *fonts.TtfGlyphs or *fonts.CFFFont, and the glyph IDs by CID of
+	// CIDFontType2 fonts (nil for Identity), loaded when first used to get glyph outlines.
+	program  interface{}
+	cidToGID []uint16
+	loaded   bool
+
+	container *core.PdfIndirectObject
+}
+
+// GetCharMetrics returns the metrics of the glyph of `cid`.
+func (font pdfCIDFont) GetCharMetrics(cid uint64) fonts.CharMetrics {
+	metrics := fonts.CharMetrics{}
+	if w, has := font.widths[cid]; has {
+		metrics.Wx = w
+	} else {
+		metrics.Wx = font.defaultWidth
+	}
+	return metrics
+}
+
+func newPdfCIDFontFromPdfObject(obj core.PdfObject) (*pdfCIDFont, error) {
+	font := &pdfCIDFont{}
+
+	if ind, is := obj.(*core.PdfIndirectObject); is {
+		font.container = ind
+		obj = ind.PdfObject
+	}
+
+	d, ok := obj.(*core.PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("Font object invalid, not a dictionary (%T)", obj)
+		return nil, errors.New("Type check error")
+	}
+
+	subtype, ok := core.TraceToDirectObject(d.Get("Subtype")).(*core.PdfObjectName)
+	if !ok {
+		return nil, errors.New("Type check error")
+	}
+	font.subtype = string(*subtype)
+
+	font.BaseFont = d.Get("BaseFont")
+	font.CIDSystemInfo = d.Get("CIDSystemInfo")
+	font.DW = d.Get("DW")
+	font.W = d.Get("W")
+	font.DW2 = d.Get("DW2")
+	font.W2 = d.Get("W2")
+	font.CIDToGIDMap = d.Get("CIDToGIDMap")
+
+	if obj := d.Get("FontDescriptor"); obj != nil {
+		descriptor, err := newPdfFontDescriptorFromPdfObject(obj)
+		if err != nil {
+			common.Log.Debug("Error loading font descriptor: %v", err)
+			return nil, err
+		}
+		font.FontDescriptor = descriptor
+	}
+
+	font.defaultWidth = 1000
+	if font.DW != nil {
+		dw, err := getNumberAsFloat(core.TraceToDirectObject(font.DW))
+		if err != nil {
+			common.Log.Debug("Invalid DW (%T)", font.DW)
+			return nil, err
+		}
+		font.defaultWidth = dw
+	}
+
+	font.widths = map[uint64]float64{}
+	if font.W != nil {
+		arr, ok := core.TraceToDirectObject(font.W).(*core.PdfObjectArray)
+		if !ok {
+			common.Log.Debug("W attribute != array (%T)", font.W)
+			return nil, errors.New("Type check error")
+		}
+		widths, err := parseCIDFontWidths(arr)
+		if err != nil {
+			return nil, err
+		}
+		font.widths = widths
+	}
+
+	return font, nil
+}
+
+// parseCIDFontWidths parses the W array of a CIDFont, whose elements are either `c [w1 w2 ...]`,
+// giving the widths of consecutive CIDs starting at c, or `cfirst clast w` giving the same width to
+// the CIDs cfirst to clast.
+func parseCIDFontWidths(arr *core.PdfObjectArray) (map[uint64]float64, error) {
+	widths := map[uint64]float64{}
+	for i := 0; i < len(*arr); {
+		first, ok := core.TraceToDirectObject((*arr)[i]).(*core.PdfObjectInteger)
+		if !ok || i+1 >= len(*arr) {
+			common.Log.Debug("Invalid W array element %d", i)
+			return nil, errors.New("Range check error")
+		}
+		switch t := core.TraceToDirectObject((*arr)[i+1]).(type) {
+		case *core.PdfObjectArray:
+			vals, err := t.ToFloat64Array()
+			if err != nil {
+				return nil, err
+			}
+			for j, w := range vals {
+				widths[uint64(*first)+uint64(j)] = w
+			}
+			i += 2
+		case *core.PdfObjectInteger:
+			if i+2 >= len(*arr) {
+				return nil, errors.New("Range check error")
+			}
+			w, err := getNumberAsFloat(core.TraceToDirectObject((*arr)[i+2]))
+			if err != nil {
+				return nil, err
+			}
+			for cid := int64(*first); cid <= int64(*t); cid++ {
+				widths[uint64(cid)] = w
+			}
+			i += 3
+		default:
+			common.Log.Debug("Invalid W array element %d (%T)", i+1, t)
+			return nil, errors.New("Type check error")
+		}
+	}
+	return widths, nil
+}
+
+func (this *pdfCIDFont) ToPdfObject() core.PdfObject {
+	if this.container == nil {
+		this.container = &core.PdfIndirectObject{}
+	}
+	d := core.MakeDict()
+	this.container.PdfObject = d
+
+	d.Set("Type", core.MakeName("Font"))
+	d.Set("Subtype", core.MakeName(this.subtype))
+
+	if this.BaseFont != nil {
+		d.Set("BaseFont", this.BaseFont)
+	}
+	if this.CIDSystemInfo != nil {
+		d.Set("CIDSystemInfo", this.CIDSystemInfo)
+	}
+	if this.FontDescriptor != nil {
+		d.Set("FontDescriptor", this.FontDescriptor.ToPdfObject())
+	}
+	if this.DW != nil {
+		d.Set("DW", this.DW)
+	}
+	if this.W != nil {
+		d.Set("W", this.W)
+	}
+	if this.DW2 != nil {
+		d.Set("DW2", this.DW2)
+	}
+	if this.W2 != nil {
+		d.Set("W2", this.W2)
+	}
+	if this.CIDToGIDMap != nil {
+		d.Set("CIDToGIDMap", this.CIDToGIDMap)
+	}
+
+	return this.container
+}
+
+// NewCompositePdfFontFromTTFFile loads a TrueType font from `filePath` as a Type0 font with a
+// CIDFontType2 descendant font and the Identity-H encoding, which can show all the characters of
+// the Basic Multilingual Plane the font has glyphs for.  The character codes and CIDs are the
+// Unicode code points, which are mapped to glyphs by a CIDToGIDMap.  The font is embedded.
+func NewCompositePdfFontFromTTFFile(filePath string) (*PdfFont, error) {
+	ttf, err := fonts.TtfParse(filePath)
+	if err != nil {
+		common.Log.Debug("Error loading ttf font: %v", err)
+		return nil, err
+	}
+	if len(ttf.Widths) <= 0 {
+		return nil, errors.New("Missing required attribute (Widths)")
+	}
+
+	k := 1000.0 / float64(ttf.UnitsPerEm)
+
+	runes := make([]int, 0, len(ttf.Chars))
+	for r := range ttf.Chars {
+		runes = append(runes, int(r))
+	}
+	sort.Ints(runes)
+
+	cidfont := &pdfCIDFont{subtype: "CIDFontType2"}
+	cidfont.BaseFont = core.MakeName(ttf.PostScriptName)
+
+	sysInfo := core.MakeDict()
+	sysInfo.Set("Registry", core.MakeString("Adobe"))
+	sysInfo.Set("Ordering", core.MakeString("Identity"))
+	sysInfo.Set("Supplement", core.MakeInteger(0))
+	cidfont.CIDSystemInfo = sysInfo
+
+	// Glyphs missing from the font are shown with the .notdef glyph 0.
+	cidfont.defaultWidth = k * float64(ttf.Widths[0])
+	cidfont.DW = core.MakeFloat(cidfont.defaultWidth)
+
+	// The widths, in runs of consecutive CIDs.
+	cidfont.widths = map[uint64]float64{}
+	w := core.PdfObjectArray{}
+	var run []float64
+	toUnicode := map[uint16]rune{}
+	maxCID := 0
+	if len(runes) > 0 {
+		maxCID = runes[len(runes)-1]
+	}
+	cidToGID := make([]byte, 2*(maxCID+1))
+	for i, r := range runes {
+		gid := ttf.Chars[uint16(r)]
+		width := cidfont.defaultWidth
+		if int(gid) < len(ttf.Widths) {
+			width = k * float64(ttf.Widths[gid])
+		}
+		cidfont.widths[uint64(r)] = width
+		toUnicode[uint16(r)] = rune(r)
+		cidToGID[2*r] = byte(gid >> 8)
+		cidToGID[2*r+1] = byte(gid)
+
+		if i > 0 && runes[i-1] == r-1 {
+			run = append(run, width)
+			continue
+		}
+		if len(run) > 0 {
+			w = append(w, core.MakeArrayFromFloats(run))
+		}
+		w = append(w, core.MakeInteger(int64(r)))
+		run = []float64{width}
+	}
+	if len(run) > 0 {
+		w = append(w, core.MakeArrayFromFloats(run))
+	}
+	cidfont.W = &core.PdfIndirectObject{PdfObject: &w}
+
+	cidToGIDMap, err := core.MakeStream(cidToGID, core.NewFlateEncoder())
+	if err != nil {
+		common.Log.Debug("Unable to make stream: %v", err)
+		return nil, err
+	}
+	cidfont.CIDToGIDMap = cidToGIDMap
+
+	descriptor, err := newPdfFontDescriptorFromTTFFile(filePath, ttf, 1<<2)
+	if err != nil {
+		return nil, err
+	}
+	cidfont.FontDescriptor = descriptor
+
+	type0 := &pdfFontType0{}
+	type0.Encoder = textencoding.NewIdentityTextEncoder("Identity-H")
+	type0.BaseFont = core.MakeName(ttf.PostScriptName + "-Identity-H")
+	type0.Encoding = core.MakeName("Identity-H")
+	type0.DescendantFont = &PdfFont{context: cidfont}
+
+	toUnicodeStream, err := core.MakeStream(cmap.NewToUnicodeCMap(toUnicode).Bytes(), core.NewFlateEncoder())
+	if err != nil {
+		common.Log.Debug("Unable to make stream: %v", err)
+		return nil, err
+	}
+	type0.ToUnicode = toUnicodeStream
+
+	font := &PdfFont{}
+	font.context = type0
+
+	return font, nil
+}
@@ -0,0 +1,262 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+)
+
+// Font descriptor flag (9.8.2 "Font Descriptor Flags") of fonts with glyphs outside the standard
+// Latin character set.
+const fontFlagSymbolic = 1 << 2
+
+// GetGlyphOutline returns the outline of the glyph of the character code `code` in text space
+// units, from the font program embedded in the font descriptor.  The CIDs of the codes of
+// composite fonts are given by their embedded CMap, or else are the codes.  Returns false for
+// glyphs not found and for fonts without embedded font program, such as the standard 14 fonts, or
+// whose glyphs are not outlines, such as Type3 fonts (see GetType3Glyph).
+func (font PdfFont) GetGlyphOutline(code uint64) (fonts.GlyphOutline, bool) {
+	switch t := font.context.(type) {
+	case *pdfFontTrueType:
+		return t.glyphOutline(code)
+	case *pdfFontType1:
+		if code > 0xFF {
+			return nil, false
+		}
+		glyph, found := t.Encoder.CharcodeToGlyph(byte(code))
+		if !found {
+			return nil, false
+		}
+		switch program := t.program.(type) {
+		case *fonts.Type1Font:
+			outline, ok := program.GlyphOutline(glyph)
+			return outline.Transform(program.FontMatrix), ok
+		case *fonts.CFFFont:
+			gid, found := program.GlyphID(glyph)
+			if !found {
+				return nil, false
+			}
+			outline, ok := program.GlyphOutline(gid)
+			return outline.Transform(program.FontMatrix), ok
+		}
+	case *pdfFontType0:
+		if t.DescendantFont == nil {
+			return nil, false
+		}
+		if cidfont, ok := t.DescendantFont.context.(*pdfCIDFont); ok {
+			return cidfont.glyphOutline(t.charcodeToCID(code))
+		}
+	}
+	return nil, false
+}
+
+// GetType3Glyph returns the glyph description of the character code `code` of a Type3 font, a
+// content stream painted in the glyph space mapped to text space by `fontMatrix`, with the
+// resources of the font or nil if it has none.  Returns false for other fonts and for codes
+// without glyph description.
+func (font PdfFont) GetType3Glyph(code uint64) (content []byte, fontMatrix [6]float64, resources *PdfPageResources, ok bool) {
+	t, isType3 := font.context.(*pdfFontType3)
+	if !isType3 || code > 0xFF {
+		return nil, fontMatrix, nil, false
+	}
+	glyph, found := t.Encoder.CharcodeToGlyph(byte(code))
+	if !found {
+		return nil, fontMatrix, nil, false
+	}
+	stream := t.CharProc(glyph)
+	if stream == nil {
+		return nil, fontMatrix, nil, false
+	}
+	content, err := core.DecodeStream(stream)
+	if err != nil {
+		common.Log.Debug("Unable to decode glyph description of %s: %v", glyph, err)
+		return nil, fontMatrix, nil, false
+	}
+	if dict, isDict := core.TraceToDirectObject(t.Resources).(*core.PdfObjectDictionary); isDict {
+		resources, err = NewPdfPageResourcesFromDict(dict)
+		if err != nil {
+			common.Log.Debug("Invalid Type3 font resources: %v", err)
+			resources = nil
+		}
+	}
+	return content, t.fontMatrix, resources, true
+}
+
+// glyphOutline returns the outline of the glyph of `code` in text space units.  The glyph is
+// selected as described in 9.6.6.4 "Encodings for TrueType Fonts".
+func (font *pdfFontTrueType) glyphOutline(code uint64) (fonts.GlyphOutline, bool) {
+	if !font.loaded {
+		font.loaded = true
+		if err := font.loadProgram(); err != nil {
+			common.Log.Debug("Unable to load TrueType font program: %v", err)
+		}
+	}
+	if font.program == nil || code > 0xFF {
+		return nil, false
+	}
+	gid, found := font.codeToGID[byte(code)]
+	if !found {
+		gid = int(code)
+	}
+	return trueTypeOutline(font.program, gid)
+}
+
+// loadProgram loads the embedded font program and the glyph IDs of the character codes from its
+// (3,1), (3,0) or (1,0) cmap subtables.
+func (font *pdfFontTrueType) loadProgram() error {
+	if font.FontDescriptor == nil {
+		return nil
+	}
+	program, err := loadTrueTypeGlyphs(font.FontDescriptor)
+	if err != nil || program == nil {
+		return err
+	}
+	font.program = program
+
+	symbolic := false
+	if flags, ok := core.TraceToDirectObject(font.FontDescriptor.Flags).(*core.PdfObjectInteger); ok {
+		symbolic = *flags&fontFlagSymbolic != 0
+	}
+	unicodeCmap, hasUnicode := program.CmapSubtable(3, 1)
+	symbolCmap, hasSymbol := program.CmapSubtable(3, 0)
+	macCmap, hasMac := program.CmapSubtable(1, 0)
+
+	font.codeToGID = map[byte]int{}
+	for c := 0; c <= 0xFF; c++ {
+		code := byte(c)
+		var gid uint16
+		if hasUnicode && !symbolic && font.Encoder != nil {
+			if r, found := font.Encoder.CharcodeToRune(code); found && r <= 0xFFFF {
+				gid = unicodeCmap[uint16(r)]
+			}
+		}
+		if gid == 0 && hasSymbol {
+			for _, base := range []uint16{0x0000, 0xF000, 0xF100, 0xF200} {
+				if gid = symbolCmap[base|uint16(code)]; gid != 0 {
+					break
+				}
+			}
+		}
+		if gid == 0 && hasMac {
+			gid = macCmap[uint16(code)]
+		}
+		if gid == 0 && hasUnicode && symbolic && font.Encoder != nil {
+			if r, found := font.Encoder.CharcodeToRune(code); found && r <= 0xFFFF {
+				gid = unicodeCmap[uint16(r)]
+			}
+		}
+		if gid != 0 {
+			font.codeToGID[code] = int(gid)
+		}
+	}
+	return nil
+}
+
+// glyphOutline returns the outline of the glyph of `cid` in text space units.
+func (font *pdfCIDFont) glyphOutline(cid uint64) (fonts.GlyphOutline, bool) {
+	if !font.loaded {
+		font.loaded = true
+		if err := font.loadProgram(); err != nil {
+			common.Log.Debug("Unable to load CIDFont program: %v", err)
+		}
+	}
+	switch program := font.program.(type) {
+	case *fonts.TtfGlyphs:
+		gid := int(cid)
+		if font.cidToGID != nil {
+			if cid >= uint64(len(font.cidToGID)) {
+				return nil, false
+			}
+			gid = int(font.cidToGID[cid])
+		}
+		return trueTypeOutline(program, gid)
+	case *fonts.CFFFont:
+		gid := int(cid)
+		if program.IsCIDKeyed() {
+			var found bool
+			if gid, found = program.CIDToGlyphID(uint16(cid)); !found {
+				return nil, false
+			}
+		}
+		outline, ok := program.GlyphOutline(gid)
+		return outline.Transform(program.FontMatrix), ok
+	}
+	return nil, false
+}
+
+// loadProgram loads the embedded TrueType (FontFile2) or CFF (FontFile3) font program, and the
+// CIDToGIDMap of CIDFontType2 fonts.
+func (font *pdfCIDFont) loadProgram() error {
+	if font.FontDescriptor == nil {
+		return nil
+	}
+	if font.subtype == "CIDFontType2" {
+		program, err := loadTrueTypeGlyphs(font.FontDescriptor)
+		if err != nil || program == nil {
+			return err
+		}
+		font.program = program
+		if stream, ok := core.TraceToDirectObject(font.CIDToGIDMap).(*core.PdfObjectStream); ok {
+			data, err := core.DecodeStream(stream)
+			if err != nil {
+				return err
+			}
+			font.cidToGID = make([]uint16, len(data)/2)
+			for i := range font.cidToGID {
+				font.cidToGID[i] = uint16(data[2*i])<<8 | uint16(data[2*i+1])
+			}
+		}
+		return nil
+	}
+
+	stream, ok := core.TraceToDirectObject(font.FontDescriptor.FontFile3).(*core.PdfObjectStream)
+	if !ok {
+		return nil
+	}
+	subtype, _ := core.TraceToDirectObject(stream.Get("Subtype")).(*core.PdfObjectName)
+	if subtype == nil || (*subtype != "CIDFontType0C" && *subtype != "Type1C") {
+		common.Log.Debug("Unsupported FontFile3 subtype (%v)", stream.Get("Subtype"))
+		return errors.New("Unsupported font file")
+	}
+	data, err := core.DecodeStream(stream)
+	if err != nil {
+		return err
+	}
+	program, err := fonts.NewCFFFontFromBytes(data)
+	if err != nil {
+		return err
+	}
+	font.program = program
+	return nil
+}
+
+// loadTrueTypeGlyphs loads the glyphs of the TrueType font program embedded by the font descriptor
+// `descriptor` (FontFile2), or returns nil if not embedded.
+func loadTrueTypeGlyphs(descriptor *PdfFontDescriptor) (*fonts.TtfGlyphs, error) {
+	stream, ok := core.TraceToDirectObject(descriptor.FontFile2).(*core.PdfObjectStream)
+	if !ok {
+		return nil, nil
+	}
+	data, err := core.DecodeStream(stream)
+	if err != nil {
+		return nil, err
+	}
+	return fonts.NewTtfGlyphsFromBytes(data)
+}
+
+// trueTypeOutline returns the outline of the glyph `gid` of `program` in text space units.
+func trueTypeOutline(program *fonts.TtfGlyphs, gid int) (fonts.GlyphOutline, bool) {
+	outline, ok := program.GlyphOutline(gid)
+	if !ok {
+		return nil, false
+	}
+	scale := 1 / float64(program.UnitsPerEm())
+	return outline.Transform([6]float64{scale, 0, 0, scale, 0, 0}), true
+}
@@ -0,0 +1,769 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// Subset tag prefixed to the names of subset fonts, e.g. ABCDEF+Roboto-Regular.
+var reSubsetTag = regexp.MustCompile(`^[A-Z]{6}\+`)
+
+// subsetFont is an embedded TrueType font shown on the written pages, with the character codes
+// shown with it.
+type subsetFont struct {
+	dict  *PdfObjectDictionary
+	codes map[uint16]bool
+	// The font program embedded from a TrueType file, nil if the font cannot be subset.
+	program *PdfObjectStream
+	// Set if the font is used in content which could not be parsed, or by the forms.
+	keep bool
+}
+
+// fontSubsetter collects the fonts shown on the written pages.
+type fontSubsetter struct {
+	fonts    map[*PdfObjectDictionary]*subsetFont
+	programs map[*PdfObjectDictionary]*PdfObjectStream
+	order    []*subsetFont
+	visited  map[*PdfObjectStream]bool
+
+	// Undo functions of the changes made to the written objects.
+	undo []func()
+}
+
+// subsetFonts replaces the font programs of the fonts created by NewPdfFontFromTTFFile and
+// NewCompositePdfFontFromTTFFile, and set with PdfPageResources.SetFont, by subsets with the glyphs shown on the written pages and their
+// annotations.  Other fonts, such as those loaded from documents, are not subset.  Fonts of the
+// interactive form are not subset, as form fields can be edited, nor are fonts showing character
+// codes not mapped to glyphs.  The objects are changed in place for writing and the returned
+// function restores them.
+func (this *PdfWriter) subsetFonts() func() {
+	subsetter := &fontSubsetter{
+		fonts:    map[*PdfObjectDictionary]*subsetFont{},
+		programs: map[*PdfObjectDictionary]*PdfObjectStream{},
+		visited:  map[*PdfObjectStream]bool{},
+	}
+	for obj, program := range this.subsettableFonts {
+		if dict, ok := TraceToDirectObject(obj).(*PdfObjectDictionary); ok {
+			subsetter.programs[dict] = program
+		}
+	}
+	restore := func() {
+		for i := len(subsetter.undo) - 1; i >= 0; i-- {
+			subsetter.undo[i]()
+		}
+	}
+
+	pagesDict, ok := this.pages.PdfObject.(*PdfObjectDictionary)
+	if !ok {
+		return restore
+	}
+	kids, ok := TraceToDirectObject(pagesDict.Get("Kids")).(*PdfObjectArray)
+	if !ok {
+		return restore
+	}
+	for _, kid := range *kids {
+		pageDict, ok := TraceToDirectObject(kid).(*PdfObjectDictionary)
+		if !ok {
+			continue
+		}
+		resources, _ := TraceToDirectObject(pageDict.Get("Resources")).(*PdfObjectDictionary)
+		var content []byte
+		complete := true
+		switch contents := TraceToDirectObject(pageDict.Get("Contents")).(type) {
+		case *PdfObjectStream:
+			content, complete = decodeContentStream(contents)
+		case *PdfObjectArray:
+			for _, obj := range *contents {
+				stream, ok := TraceToDirectObject(obj).(*PdfObjectStream)
+				if !ok {
+					complete = false
+					continue
+				}
+				data, ok := decodeContentStream(stream)
+				complete = complete && ok
+				content = append(content, data...)
+				content = append(content, '\n')
+			}
+		}
+		subsetter.scan(content, resources, complete)
+
+		if annots, ok := TraceToDirectObject(pageDict.Get("Annots")).(*PdfObjectArray); ok {
+			for _, annot := range *annots {
+				subsetter.scanAnnotation(annot)
+			}
+		}
+	}
+
+	// Fonts of the interactive form are kept in full.
+	if acroForm, ok := TraceToDirectObject(this.catalog.Get("AcroForm")).(*PdfObjectDictionary); ok {
+		if dr, ok := TraceToDirectObject(acroForm.Get("DR")).(*PdfObjectDictionary); ok {
+			subsetter.keepFonts(dr)
+		}
+	}
+
+	for _, font := range subsetter.order {
+		if font.keep || len(font.codes) == 0 {
+			continue
+		}
+		if err := subsetter.subset(this, font); err != nil {
+			common.Log.Debug("Font not subset: %v", err)
+		}
+	}
+
+	return restore
+}
+
+// font returns the font of the font dictionary `obj`.
+func (subsetter *fontSubsetter) font(obj PdfObject) *subsetFont {
+	dict, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		// Not shown, nothing to collect.
+		return &subsetFont{}
+	}
+	font, has := subsetter.fonts[dict]
+	if !has {
+		font = &subsetFont{dict: dict, codes: map[uint16]bool{}, program: subsetter.programs[dict]}
+		subsetter.fonts[dict] = font
+		subsetter.order = append(subsetter.order, font)
+	}
+	return font
+}
+
+// scanAnnotation collects the fonts shown by the normal, rollover and down appearances of the
+// annotation `obj`.
+func (subsetter *fontSubsetter) scanAnnotation(obj PdfObject) {
+	annot, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	if !ok {
+		return
+	}
+	ap, ok := TraceToDirectObject(annot.Get("AP")).(*PdfObjectDictionary)
+	if !ok {
+		return
+	}
+	for _, name := range []PdfObjectName{"N", "R", "D"} {
+		switch appearance := TraceToDirectObject(ap.Get(name)).(type) {
+		case *PdfObjectStream:
+			subsetter.scanForm(appearance, nil)
+		case *PdfObjectDictionary:
+			// Appearance states.
+			for _, key := range appearance.Keys() {
+				if stream, ok := TraceToDirectObject(appearance.Get(key)).(*PdfObjectStream); ok {
+					subsetter.scanForm(stream, nil)
+				}
+			}
+		}
+	}
+}
+
+// scanForm collects the fonts shown by the form XObject `stream`, with the resources `resources`
+// of the content it is drawn from if it has none.
+func (subsetter *fontSubsetter) scanForm(stream *PdfObjectStream, resources *PdfObjectDictionary) {
+	if subsetter.visited[stream] {
+		return
+	}
+	subsetter.visited[stream] = true
+	if res, ok := TraceToDirectObject(stream.Get("Resources")).(*PdfObjectDictionary); ok {
+		resources = res
+	}
+	content, complete := decodeContentStream(stream)
+	subsetter.scan(content, resources, complete)
+}
+
+// scan collects the character codes shown by the content stream `content` with the fonts of
+// `resources`.  The fonts are kept in full if the content is not `complete` or cannot be parsed.
+func (subsetter *fontSubsetter) scan(content []byte, resources *PdfObjectDictionary, complete bool) {
+	if resources == nil {
+		return
+	}
+	fontsDict, _ := TraceToDirectObject(resources.Get("Font")).(*PdfObjectDictionary)
+	xobjects, _ := TraceToDirectObject(resources.Get("XObject")).(*PdfObjectDictionary)
+
+	// Tiling patterns can show text, and the glyphs of Type3 fonts are not scanned.
+	if patterns, ok := TraceToDirectObject(resources.Get("Pattern")).(*PdfObjectDictionary); ok {
+		for _, key := range patterns.Keys() {
+			if stream, ok := TraceToDirectObject(patterns.Get(key)).(*PdfObjectStream); ok {
+				subsetter.scanForm(stream, nil)
+			}
+		}
+	}
+	if fontsDict != nil {
+		for _, key := range fontsDict.Keys() {
+			font, ok := TraceToDirectObject(fontsDict.Get(key)).(*PdfObjectDictionary)
+			if !ok {
+				continue
+			}
+			if subtype, ok := TraceToDirectObject(font.Get("Subtype")).(*PdfObjectName); !ok || *subtype != "Type3" {
+				continue
+			}
+			if res, ok := TraceToDirectObject(font.Get("Resources")).(*PdfObjectDictionary); ok {
+				subsetter.keepFonts(res)
+			}
+		}
+	}
+
+	show := func(fontName PdfObjectName, str []byte) {
+		if fontsDict == nil {
+			return
+		}
+		font := subsetter.font(fontsDict.Get(fontName))
+		if font.dict == nil {
+			return
+		}
+		if isIdentityEncoded(font.dict) {
+			for i := 0; i+1 < len(str); i += 2 {
+				font.codes[uint16(str[i])<<8|uint16(str[i+1])] = true
+			}
+		} else {
+			for _, b := range str {
+				font.codes[uint16(b)] = true
+			}
+		}
+	}
+	do := func(name PdfObjectName) {
+		if xobjects == nil {
+			return
+		}
+		stream, ok := TraceToDirectObject(xobjects.Get(name)).(*PdfObjectStream)
+		if !ok {
+			return
+		}
+		if subtype, ok := TraceToDirectObject(stream.Get("Subtype")).(*PdfObjectName); ok && *subtype == "Form" {
+			subsetter.scanForm(stream, resources)
+		}
+	}
+
+	if err := scanContentText(content, show, do); err != nil || !complete {
+		common.Log.Debug("Fonts used by unparsed content kept in full: %v", err)
+		subsetter.keepFonts(resources)
+	}
+}
+
+// keepFonts marks the fonts of `resources` to be kept in full.
+func (subsetter *fontSubsetter) keepFonts(resources *PdfObjectDictionary) {
+	fontsDict, ok := TraceToDirectObject(resources.Get("Font")).(*PdfObjectDictionary)
+	if !ok {
+		return
+	}
+	for _, key := range fontsDict.Keys() {
+		subsetter.font(fontsDict.Get(key)).keep = true
+	}
+}
+
+// decodeContentStream returns the decoded data of the content stream `stream`, and false if it
+// cannot be decoded.
+func decodeContentStream(stream *PdfObjectStream) ([]byte, bool) {
+	data, err := DecodeStream(stream)
+	if err != nil {
+		common.Log.Debug("Unable to decode content stream: %v", err)
+		return nil, false
+	}
+	return data, true
+}
+
+// isIdentityEncoded returns true if the font dictionary `dict` is a Type0 font with the Identity-H
+// or Identity-V encoding.
+func isIdentityEncoded(dict *PdfObjectDictionary) bool {
+	subtype, ok := TraceToDirectObject(dict.Get("Subtype")).(*PdfObjectName)
+	if !ok || *subtype != "Type0" {
+		return false
+	}
+	encoding, ok := TraceToDirectObject(dict.Get("Encoding")).(*PdfObjectName)
+	return ok && (*encoding == "Identity-H" || *encoding == "Identity-V")
+}
+
+// subset replaces the font program of `font` by the subset with the glyphs of its character codes.
+func (subsetter *fontSubsetter) subset(writer *PdfWriter, font *subsetFont) error {
+	subtype, _ := TraceToDirectObject(font.dict.Get("Subtype")).(*PdfObjectName)
+	if subtype == nil {
+		return errors.New("font Subtype missing")
+	}
+
+	// The font dictionary with the font descriptor.
+	fontDict := font.dict
+	var cidToGIDMap PdfObject
+	var encoder textencoding.TextEncoder
+	var err error
+	switch *subtype {
+	case "TrueType":
+		if fontDict.Get("Encoding") == nil {
+			return errors.New("TrueType font encoding missing")
+		}
+		winAnsi, _ := textencoding.NewSimpleTextEncoder("WinAnsiEncoding", nil)
+		if encoder, err = newSimpleFontEncoder(fontDict.Get("Encoding"), winAnsi); err != nil {
+			return err
+		}
+	case "Type0":
+		if !isIdentityEncoded(fontDict) {
+			return errors.New("unsupported Type0 font encoding")
+		}
+		descendants, ok := TraceToDirectObject(fontDict.Get("DescendantFonts")).(*PdfObjectArray)
+		if !ok || len(*descendants) != 1 {
+			return errors.New("invalid DescendantFonts")
+		}
+		fontDict, ok = TraceToDirectObject((*descendants)[0]).(*PdfObjectDictionary)
+		if !ok {
+			return errors.New("invalid descendant font")
+		}
+		if subtype, ok := TraceToDirectObject(fontDict.Get("Subtype")).(*PdfObjectName); !ok || *subtype != "CIDFontType2" {
+			return errors.New("unsupported descendant font")
+		}
+		cidToGIDMap = TraceToDirectObject(fontDict.Get("CIDToGIDMap"))
+	default:
+		return errors.New("not a TrueType font")
+	}
+
+	baseFont, ok := TraceToDirectObject(fontDict.Get("BaseFont")).(*PdfObjectName)
+	if !ok {
+		return errors.New("BaseFont missing")
+	}
+	if reSubsetTag.MatchString(string(*baseFont)) {
+		return errors.New("already subset")
+	}
+	descriptor, ok := TraceToDirectObject(fontDict.Get("FontDescriptor")).(*PdfObjectDictionary)
+	if !ok {
+		return errors.New("FontDescriptor missing")
+	}
+	fontFile, ok := TraceToDirectObject(descriptor.Get("FontFile2")).(*PdfObjectStream)
+	if !ok {
+		return errors.New("font not embedded")
+	}
+	if fontFile != font.program {
+		return errors.New("font program not embedded from a TrueType file")
+	}
+	data, err := DecodeStream(fontFile)
+	if err != nil {
+		return err
+	}
+
+	codes := make([]int, 0, len(font.codes))
+	for code := range font.codes {
+		codes = append(codes, int(code))
+	}
+	sort.Ints(codes)
+
+	// Glyphs of the codes.  The font is kept in full if a code is not mapped to a glyph, so that
+	// the glyph shown for it is not changed by subsetting.
+	var gids []uint16
+	var cidGIDs map[uint16]uint16
+	if *subtype == "TrueType" {
+		ttf, err := fonts.TtfParseBytes(data)
+		if err != nil {
+			return err
+		}
+		for _, code := range codes {
+			r, found := encoder.CharcodeToRune(byte(code))
+			if !found {
+				return fmt.Errorf("code %d not mapped to a rune", code)
+			}
+			gid, has := ttf.Chars[uint16(r)]
+			if !has {
+				return fmt.Errorf("code %d not mapped to a glyph", code)
+			}
+			gids = append(gids, gid)
+		}
+	} else {
+		cidGIDs = map[uint16]uint16{}
+		var gidMap []byte
+		switch t := cidToGIDMap.(type) {
+		case *PdfObjectStream:
+			if gidMap, err = DecodeStream(t); err != nil {
+				return err
+			}
+		case *PdfObjectName:
+			if *t != "Identity" {
+				return errors.New("invalid CIDToGIDMap")
+			}
+		case nil:
+		default:
+			return errors.New("invalid CIDToGIDMap")
+		}
+		for _, code := range codes {
+			gid := uint16(code)
+			if gidMap != nil {
+				gid = 0
+				if 2*code+1 < len(gidMap) {
+					gid = uint16(gidMap[2*code])<<8 | uint16(gidMap[2*code+1])
+				}
+			}
+			if gid == 0 {
+				return fmt.Errorf("code %d not mapped to a glyph", code)
+			}
+			cidGIDs[uint16(code)] = gid
+			gids = append(gids, gid)
+		}
+	}
+
+	subsetData, newGIDs, err := fonts.TtfSubset(data, gids)
+	if err != nil {
+		return err
+	}
+	fontStream, err := MakeStream(subsetData, NewFlateEncoder())
+	if err != nil {
+		return err
+	}
+	fontStream.Set("Length1", MakeInteger(int64(len(subsetData))))
+
+	tag := subsetTag(newGIDs)
+	subsetter.replaceStream(fontFile, fontStream)
+	subsetter.set(fontDict, "BaseFont", MakeName(tag+string(*baseFont)))
+	if fontName, ok := TraceToDirectObject(descriptor.Get("FontName")).(*PdfObjectName); ok {
+		subsetter.set(descriptor, "FontName", MakeName(tag+string(*fontName)))
+	}
+	// The CIDSet of the full font does not apply to the subset.
+	subsetter.set(descriptor, "CIDSet", nil)
+
+	if *subtype == "Type0" {
+		if name, ok := TraceToDirectObject(font.dict.Get("BaseFont")).(*PdfObjectName); ok {
+			subsetter.set(font.dict, "BaseFont", MakeName(tag+string(*name)))
+		}
+
+		maxCID := 0
+		for cid := range cidGIDs {
+			if int(cid) > maxCID {
+				maxCID = int(cid)
+			}
+		}
+		gidMap := make([]byte, 2*(maxCID+1))
+		for cid, gid := range cidGIDs {
+			newGID := newGIDs[gid]
+			gidMap[2*int(cid)] = byte(newGID >> 8)
+			gidMap[2*int(cid)+1] = byte(newGID)
+		}
+		mapStream, err := MakeStream(gidMap, NewFlateEncoder())
+		if err != nil {
+			return err
+		}
+		if stream, ok := cidToGIDMap.(*PdfObjectStream); ok {
+			subsetter.replaceStream(stream, mapStream)
+		} else {
+			writer.addObject(mapStream)
+			subsetter.undo = append(subsetter.undo, func() {
+				for i, obj := range writer.objects {
+					if obj == mapStream {
+						writer.objects = append(writer.objects[:i], writer.objects[i+1:]...)
+						break
+					}
+				}
+			})
+			subsetter.set(fontDict, "CIDToGIDMap", mapStream)
+		}
+	}
+
+	return nil
+}
+
+// replaceStream replaces the dictionary and data of `stream` by those of `replacement`.
+func (subsetter *fontSubsetter) replaceStream(stream, replacement *PdfObjectStream) {
+	dict, data := stream.PdfObjectDictionary, stream.Stream
+	subsetter.undo = append(subsetter.undo, func() {
+		stream.PdfObjectDictionary, stream.Stream = dict, data
+	})
+	stream.PdfObjectDictionary, stream.Stream = replacement.PdfObjectDictionary, replacement.Stream
+}
+
+// set sets `key` of `dict` to `val`, or removes it if `val` is nil.
+func (subsetter *fontSubsetter) set(dict *PdfObjectDictionary, key PdfObjectName, val PdfObject) {
+	old := dict.Get(key)
+	if old == nil && val == nil {
+		return
+	}
+	subsetter.undo = append(subsetter.undo, func() {
+		if old == nil {
+			dict.Remove(key)
+		} else {
+			dict.Set(key, old)
+		}
+	})
+	if val == nil {
+		dict.Remove(key)
+	} else {
+		dict.Set(key, val)
+	}
+}
+
+// subsetTag returns the subset tag of the subset with the glyphs `newGIDs`, six uppercase letters
+// followed by a plus sign, derived from the original glyph IDs.
+func subsetTag(newGIDs map[uint16]uint16) string {
+	gids := make([]int, 0, len(newGIDs))
+	for gid := range newGIDs {
+		gids = append(gids, int(gid))
+	}
+	sort.Ints(gids)
+	h := md5.New()
+	for _, gid := range gids {
+		h.Write([]byte{byte(gid >> 8), byte(gid)})
+	}
+	sum := h.Sum(nil)
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = 'A' + sum[i]%26
+	}
+	return string(tag) + "+"
+}
+
+// scanContentText parses the content stream `content`, calling `show` with the name of the
+// current font and each string shown by the text showing operators, and `do` with the name of
+// each XObject drawn.
+func scanContentText(content []byte, show func(font PdfObjectName, str []byte), do func(name PdfObjectName)) error {
+	var operands []interface{}
+	var arrays [][]interface{}
+	var font PdfObjectName
+	var fontStack []PdfObjectName
+	dictDepth := 0
+
+	push := func(operand interface{}) {
+		if n := len(arrays); n > 0 {
+			arrays[n-1] = append(arrays[n-1], operand)
+		} else if dictDepth == 0 {
+			operands = append(operands, operand)
+		}
+	}
+	lastString := func() ([]byte, bool) {
+		if len(operands) == 0 {
+			return nil, false
+		}
+		str, ok := operands[len(operands)-1].([]byte)
+		return str, ok
+	}
+
+	i := 0
+	for i < len(content) {
+		c := content[i]
+		switch {
+		case isContentWhitespace(c):
+			i++
+		case c == '%':
+			for i < len(content) && content[i] != '\r' && content[i] != '\n' {
+				i++
+			}
+		case c == '(':
+			str, n, err := parseContentLiteralString(content[i:])
+			if err != nil {
+				return err
+			}
+			push(str)
+			i += n
+		case c == '<' && i+1 < len(content) && content[i+1] == '<':
+			dictDepth++
+			i += 2
+		case c == '>' && i+1 < len(content) && content[i+1] == '>':
+			if dictDepth == 0 {
+				return errors.New("unbalanced dictionary")
+			}
+			dictDepth--
+			if dictDepth == 0 {
+				push(nil)
+			}
+			i += 2
+		case c == '<':
+			end := bytes.IndexByte(content[i:], '>')
+			if end < 0 {
+				return errors.New("unterminated hexadecimal string")
+			}
+			str, err := decodeHexContentString(content[i+1 : i+end])
+			if err != nil {
+				return err
+			}
+			push(str)
+			i += end + 1
+		case c == '[':
+			arrays = append(arrays, nil)
+			i++
+		case c == ']':
+			n := len(arrays)
+			if n == 0 {
+				return errors.New("unbalanced array")
+			}
+			arr := arrays[n-1]
+			arrays = arrays[:n-1]
+			push(arr)
+			i++
+		case c == '{' || c == '}':
+			i++
+		default:
+			start := i
+			if c == '/' {
+				i++
+			}
+			for i < len(content) && !isContentWhitespace(content[i]) && !isContentDelimiter(content[i]) {
+				i++
+			}
+			if i == start {
+				return errors.New("unexpected delimiter")
+			}
+			token := string(content[start:i])
+			if c == '/' {
+				push(PdfObjectName(token[1:]))
+				continue
+			}
+			if strings.IndexByte("0123456789+-.", c) >= 0 || token == "true" || token == "false" || token == "null" {
+				push(nil)
+				continue
+			}
+			if len(arrays) > 0 || dictDepth > 0 {
+				return errors.New("operator inside array or dictionary")
+			}
+
+			switch token {
+			case "q":
+				fontStack = append(fontStack, font)
+			case "Q":
+				if n := len(fontStack); n > 0 {
+					font = fontStack[n-1]
+					fontStack = fontStack[:n-1]
+				}
+			case "Tf":
+				if len(operands) >= 2 {
+					if name, ok := operands[len(operands)-2].(PdfObjectName); ok {
+						font = name
+					}
+				}
+			case "Tj", "'", "\"":
+				if str, ok := lastString(); ok {
+					show(font, str)
+				}
+			case "TJ":
+				if len(operands) > 0 {
+					if arr, ok := operands[len(operands)-1].([]interface{}); ok {
+						for _, elem := range arr {
+							if str, ok := elem.([]byte); ok {
+								show(font, str)
+							}
+						}
+					}
+				}
+			case "Do":
+				if len(operands) > 0 {
+					if name, ok := operands[len(operands)-1].(PdfObjectName); ok {
+						do(name)
+					}
+				}
+			case "BI":
+				// Skip the inline image up to the EI operator.
+				end := bytes.Index(content[i:], []byte("ID"))
+				if end < 0 {
+					return errors.New("inline image data missing")
+				}
+				i += end + 2
+				for {
+					end = bytes.Index(content[i:], []byte("EI"))
+					if end < 0 {
+						return errors.New("inline image not terminated")
+					}
+					i += end + 2
+					if isContentWhitespace(content[i-3]) && (i == len(content) || isContentWhitespace(content[i])) {
+						break
+					}
+				}
+			}
+			operands = operands[:0]
+		}
+	}
+	return nil
+}
+
+// parseContentLiteralString parses the literal string at the start of `data`, returning the
+// string and the number of bytes parsed.
+func parseContentLiteralString(data []byte) ([]byte, int, error) {
+	var str []byte
+	depth := 0
+	i := 0
+	for i < len(data) {
+		c := data[i]
+		i++
+		switch c {
+		case '(':
+			if depth > 0 {
+				str = append(str, c)
+			}
+			depth++
+			continue
+		case ')':
+			depth--
+			if depth == 0 {
+				return str, i, nil
+			}
+			str = append(str, c)
+			continue
+		case '\\':
+		default:
+			str = append(str, c)
+			continue
+		}
+
+		// Escape sequence.
+		if i >= len(data) {
+			break
+		}
+		c = data[i]
+		i++
+		switch c {
+		case 'n':
+			str = append(str, '\n')
+		case 'r':
+			str = append(str, '\r')
+		case 't':
+			str = append(str, '\t')
+		case 'b':
+			str = append(str, '\b')
+		case 'f':
+			str = append(str, '\f')
+		case '\r':
+			if i < len(data) && data[i] == '\n' {
+				i++
+			}
+		case '\n':
+		default:
+			if c >= '0' && c <= '7' {
+				val := int(c - '0')
+				for j := 0; j < 2 && i < len(data) && data[i] >= '0' && data[i] <= '7'; j++ {
+					val = 8*val + int(data[i]-'0')
+					i++
+				}
+				str = append(str, byte(val))
+			} else {
+				str = append(str, c)
+			}
+		}
+	}
+	return nil, i, errors.New("unterminated literal string")
+}
+
+// decodeHexContentString returns the string of the hexadecimal digits `digits`, ignoring
+// whitespace.  A missing final digit is taken as 0.
+func decodeHexContentString(digits []byte) ([]byte, error) {
+	var clean []byte
+	for _, c := range digits {
+		if !isContentWhitespace(c) {
+			clean = append(clean, c)
+		}
+	}
+	if len(clean)%2 == 1 {
+		clean = append(clean, '0')
+	}
+	return hex.DecodeString(string(clean))
+}
+
+func isContentWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+func isContentDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
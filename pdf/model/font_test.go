@@ -0,0 +1,469 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+)
+
+const testRobotoTTFFile = "../../testfiles/roboto/Roboto-Regular.ttf"
+
+func TestCompositeFontFromTTFFile(t *testing.T) {
+	font, err := NewCompositePdfFontFromTTFFile(testRobotoTTFFile)
+	if err != nil {
+		t.Fatalf("Failed to load font: %v", err)
+	}
+	encoder := font.Encoder()
+	if encoder == nil {
+		t.Fatalf("No encoder")
+	}
+	text := "Счёт Ωμέγα"
+	if encoded := encoder.Encode(text); len(encoded) != 2*len([]rune(text)) || encoded[:2] != "\x04\x21" {
+		t.Errorf("Wrong encoding % x", encoded)
+	}
+
+	glyphs := []string{"space", "A", "afii10017", "Omega", "uni4E00"}
+	var widths []float64
+	for _, glyph := range glyphs {
+		metrics, found := font.GetGlyphCharMetrics(glyph)
+		if !found {
+			t.Fatalf("No metrics for %s", glyph)
+		}
+		widths = append(widths, metrics.Wx)
+	}
+	if widths[0] <= 0 || widths[1] <= widths[0] {
+		t.Errorf("Wrong widths %v", widths)
+	}
+
+	// Write and read back.
+	page := newTestPage()
+	if err := page.Resources.SetFontByName("F1", font.ToPdfObject()); err != nil {
+		t.Fatalf("Failed to set font: %v", err)
+	}
+	page.AddContentStreamByString(fmt.Sprintf("BT /F1 12 Tf <%X> Tj ET", encoder.Encode(text)))
+	reader := readTestDocument(t, writeTestPages(t, []*PdfPage{page}, nil), nil)
+	page, err = reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Failed to get page: %v", err)
+	}
+	obj, found := page.Resources.GetFontByName("F1")
+	if !found {
+		t.Fatalf("Font not found")
+	}
+	loaded, err := NewPdfFontFromPdfObject(obj)
+	if err != nil {
+		t.Fatalf("Failed to load font: %v", err)
+	}
+	type0, ok := loaded.context.(*pdfFontType0)
+	if !ok {
+		t.Fatalf("Wrong font type %T", loaded.context)
+	}
+	if type0.runeToCode['Щ'] != 'Щ' {
+		t.Errorf("Wrong ToUnicode mapping %x", type0.runeToCode['Щ'])
+	}
+	cidfont := type0.DescendantFont.context.(*pdfCIDFont)
+	if cidfont.subtype != "CIDFontType2" || cidfont.CIDToGIDMap == nil {
+		t.Errorf("Wrong descendant font %s", cidfont.subtype)
+	}
+	for i, glyph := range glyphs {
+		metrics, found := loaded.GetGlyphCharMetrics(glyph)
+		if i == len(glyphs)-1 {
+			// Not in the font, hence not in the ToUnicode CMap.
+			if found {
+				t.Errorf("Metrics for glyph %s not in the font", glyph)
+			}
+			continue
+		}
+		if !found || math.Abs(metrics.Wx-widths[i]) > 0.001 {
+			t.Errorf("Wrong metrics for %s: %v (%v)", glyph, metrics.Wx, widths[i])
+		}
+	}
+}
+
+func TestCIDFontWidths(t *testing.T) {
+	arr := MakeArray(MakeInteger(1), MakeArrayFromFloats([]float64{500, 600}), MakeInteger(10),
+		MakeInteger(12), MakeInteger(300))
+	widths, err := parseCIDFontWidths(arr)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	expected := map[uint64]float64{1: 500, 2: 600, 10: 300, 11: 300, 12: 300}
+	if len(widths) != len(expected) {
+		t.Errorf("Wrong widths %v", widths)
+	}
+	for cid, w := range expected {
+		if widths[cid] != w {
+			t.Errorf("Wrong width of %d: %v", cid, widths[cid])
+		}
+	}
+	if _, err := parseCIDFontWidths(MakeArray(MakeInteger(1), MakeInteger(2))); err == nil {
+		t.Errorf("Truncated W array accepted")
+	}
+}
+
+func TestType1FontFromPdfObject(t *testing.T) {
+	dict := MakeDict()
+	dict.Set("Type", MakeName("Font"))
+	dict.Set("Subtype", MakeName("Type1"))
+	dict.Set("BaseFont", MakeName("Times-Roman"))
+	dict.Set("Encoding", MakeName("StandardEncoding"))
+	dict.Set("FirstChar", MakeInteger(39))
+	dict.Set("LastChar", MakeInteger(41))
+	dict.Set("Widths", MakeArrayFromFloats([]float64{333, 333, 333}))
+
+	font, err := NewPdfFontFromPdfObject(MakeIndirectObject(dict))
+	if err != nil {
+		t.Fatalf("Failed to load font: %v", err)
+	}
+
+	// Codes 39 and 96 are quoteright and quoteleft in the standard encoding.
+	text, ok := font.CharcodeBytesToUnicode([]byte("`A'"))
+	if !ok || text != "\u2018A\u2019" {
+		t.Errorf("Wrong text %q", text)
+	}
+	if metrics, found := font.GetGlyphCharMetrics("quoteright"); !found || metrics.Wx != 333 {
+		t.Errorf("Wrong metrics %+v", metrics)
+	}
+	// Glyphs without widths have the metrics of the standard 14 font.
+	if metrics, found := font.GetGlyphCharMetrics("A"); !found || metrics.Wx != 722 {
+		t.Errorf("Wrong standard metrics %+v", metrics)
+	}
+	if metrics, found := font.GetCharMetrics('A'); !found || metrics.Wx != 722 {
+		t.Errorf("Wrong standard metrics %+v", metrics)
+	}
+
+	obj, ok := font.ToPdfObject().(*PdfIndirectObject)
+	if !ok {
+		t.Fatalf("Font not an indirect object")
+	}
+	written := obj.PdfObject.(*PdfObjectDictionary)
+	if written.Get("Subtype").String() != "Type1" || written.Get("Encoding").String() != "StandardEncoding" {
+		t.Errorf("Wrong font dictionary %s", written.String())
+	}
+}
+
+func TestSimpleFontEncodingDifferences(t *testing.T) {
+	testcases := []struct {
+		subtype  string
+		encoding PdfObject
+		text     string
+	}{
+		// Differences applied to the base encoding.
+		{"TrueType", MakeDict(), "\u00c4A\u03b1"},
+		// Differences applied to the standard encoding of Type1 fonts without base encoding.
+		{"Type1", MakeDict(), "\u00c5A\u03b1"},
+		{"TrueType", MakeName("MacRomanEncoding"), "\u00c4AB"},
+	}
+	differences := MakeArray(MakeInteger(66), MakeName("alpha"))
+	testcases[0].encoding.(*PdfObjectDictionary).Set("BaseEncoding", MakeName("MacRomanEncoding"))
+	testcases[0].encoding.(*PdfObjectDictionary).Set("Differences", differences)
+	testcases[1].encoding.(*PdfObjectDictionary).Set("Differences", MakeArray(MakeInteger(128), MakeName("Aring"), MakeInteger(66), MakeName("alpha")))
+
+	for _, tc := range testcases {
+		dict := MakeDict()
+		dict.Set("Type", MakeName("Font"))
+		dict.Set("Subtype", MakeName(tc.subtype))
+		dict.Set("BaseFont", MakeName("Test"))
+		dict.Set("Encoding", tc.encoding)
+		dict.Set("FirstChar", MakeInteger(65))
+		dict.Set("LastChar", MakeInteger(66))
+		dict.Set("Widths", MakeArrayFromFloats([]float64{600, 700}))
+
+		font, err := NewPdfFontFromPdfObject(MakeIndirectObject(dict))
+		if err != nil {
+			t.Fatalf("Failed to load %s font: %v", tc.subtype, err)
+		}
+		text, ok := font.CharcodeBytesToUnicode([]byte("\x80AB"))
+		if !ok || text != tc.text {
+			t.Errorf("Wrong %s text %q != %q", tc.subtype, text, tc.text)
+		}
+	}
+}
+
+func TestType3FontFromPdfObject(t *testing.T) {
+	charProcs := MakeDict()
+	for _, glyph := range []string{"square", "bullet"} {
+		stream, err := MakeStream([]byte("1000 0 0 0 750 750 d1 0 0 750 750 re f"), NewRawEncoder())
+		if err != nil {
+			t.Fatalf("Failed to make stream: %v", err)
+		}
+		charProcs.Set(PdfObjectName(glyph), stream)
+	}
+	encoding := MakeDict()
+	encoding.Set("Type", MakeName("Encoding"))
+	encoding.Set("Differences", MakeArray(MakeInteger(97), MakeName("square"), MakeName("bullet")))
+
+	dict := MakeDict()
+	dict.Set("Type", MakeName("Font"))
+	dict.Set("Subtype", MakeName("Type3"))
+	dict.Set("FontBBox", MakeArrayFromFloats([]float64{0, 0, 750, 750}))
+	dict.Set("FontMatrix", MakeArrayFromFloats([]float64{0.0005, 0, 0, 0.0005, 0, 0}))
+	dict.Set("CharProcs", charProcs)
+	dict.Set("Encoding", encoding)
+	dict.Set("FirstChar", MakeInteger(97))
+	dict.Set("LastChar", MakeInteger(98))
+	dict.Set("Widths", MakeArrayFromFloats([]float64{1000, 1200}))
+
+	font, err := NewPdfFontFromPdfObject(dict)
+	if err != nil {
+		t.Fatalf("Failed to load font: %v", err)
+	}
+	for glyph, wx := range map[string]float64{"square": 500, "bullet": 600} {
+		if metrics, found := font.GetGlyphCharMetrics(glyph); !found || metrics.Wx != wx {
+			t.Errorf("Wrong metrics of %s: %+v", glyph, metrics)
+		}
+	}
+	if text, ok := font.CharcodeBytesToUnicode([]byte("b")); !ok || text != "\u2022" {
+		t.Errorf("Wrong text %q", text)
+	}
+	if font.context.(*pdfFontType3).CharProc("square") == nil {
+		t.Errorf("CharProc missing")
+	}
+	content, fontMatrix, _, ok := font.GetType3Glyph('a')
+	if !ok || string(content) != "1000 0 0 0 750 750 d1 0 0 750 750 re f" || fontMatrix[0] != 0.0005 {
+		t.Errorf("Wrong glyph description %q %v", content, fontMatrix)
+	}
+	if _, _, _, ok := font.GetType3Glyph('c'); ok {
+		t.Errorf("Glyph description of undefined code found")
+	}
+
+	dict.Remove("CharProcs")
+	if _, err := NewPdfFontFromPdfObject(dict); err == nil {
+		t.Errorf("Font without CharProcs loaded")
+	}
+}
+
+func TestGlyphOutlines(t *testing.T) {
+	composite, err := NewCompositePdfFontFromTTFFile(testRobotoTTFFile)
+	if err != nil {
+		t.Fatalf("Failed to load font: %v", err)
+	}
+	simple, err := NewPdfFontFromTTFFile(testRobotoTTFFile)
+	if err != nil {
+		t.Fatalf("Failed to load font: %v", err)
+	}
+
+	for _, font := range []*PdfFont{composite, simple} {
+		for code, contours := range map[uint64]int{' ': 0, 'o': 2, 'B': 3} {
+			outline, ok := font.GetGlyphOutline(code)
+			if !ok {
+				t.Errorf("Outline of %q not found (%T)", rune(code), font.context)
+				continue
+			}
+			moves := 0
+			for _, seg := range outline {
+				if seg.Op == fonts.OutlineMoveTo {
+					moves++
+				}
+				// Text space units: within the em square.
+				for _, p := range seg.Points {
+					if p.X < -0.5 || p.X > 1.5 || p.Y < -0.5 || p.Y > 1.5 {
+						t.Errorf("Point of %q out of em: %v", rune(code), p)
+					}
+				}
+			}
+			if moves != contours {
+				t.Errorf("Wrong number of contours of %q: %d (%T)", rune(code), moves, font.context)
+			}
+		}
+	}
+
+	dict := MakeDict()
+	dict.Set("Type", MakeName("Font"))
+	dict.Set("Subtype", MakeName("Type1"))
+	dict.Set("BaseFont", MakeName("Helvetica"))
+	helvetica, err := NewPdfFontFromPdfObject(dict)
+	if err != nil {
+		t.Fatalf("Failed to load font: %v", err)
+	}
+	if _, ok := helvetica.GetGlyphOutline('o'); ok {
+		t.Errorf("Outline of standard 14 font found")
+	}
+}
+
+func TestSubsetFonts(t *testing.T) {
+	composite, err := NewCompositePdfFontFromTTFFile(testRobotoTTFFile)
+	if err != nil {
+		t.Fatalf("Failed to load font: %v", err)
+	}
+	simple, err := NewPdfFontFromTTFFile(testRobotoTTFFile)
+	if err != nil {
+		t.Fatalf("Failed to load font: %v", err)
+	}
+	full, err := ioutil.ReadFile(testRobotoTTFFile)
+	if err != nil {
+		t.Fatalf("Failed to read font: %v", err)
+	}
+	ttf, err := fonts.TtfParseBytes(full)
+	if err != nil {
+		t.Fatalf("Failed to parse font: %v", err)
+	}
+
+	page := newTestPage()
+	page.Resources.SetFont("F1", composite)
+	page.Resources.SetFont("F2", simple)
+	page.AddContentStreamByString(fmt.Sprintf("BT /F1 12 Tf <%X> Tj /F2 12 Tf [(Hello) -20 (\\(\\101\\))] TJ ET",
+		composite.Encoder().Encode("Ωмегаé")))
+	data := writeTestPages(t, []*PdfPage{page}, nil)
+	if len(data) > len(full) {
+		t.Errorf("Fonts not subset: %d bytes", len(data))
+	}
+
+	// The fonts are restored after writing.
+	if name := composite.context.(*pdfFontType0).BaseFont.(*PdfObjectName); *name != "Roboto-Regular-Identity-H" {
+		t.Errorf("Font not restored: %s", *name)
+	}
+
+	reader := readTestDocument(t, data, nil)
+	page, err = reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Failed to get page: %v", err)
+	}
+
+	// Glyph widths of the subset fonts by rune.
+	subsetWidths := func(fontName PdfObjectName) (map[rune]uint16, *PdfObjectDictionary) {
+		obj, _ := page.Resources.GetFontByName(fontName)
+		dict := TraceToDirectObject(obj).(*PdfObjectDictionary)
+		fontDict := dict
+		if arr, ok := TraceToDirectObject(dict.Get("DescendantFonts")).(*PdfObjectArray); ok {
+			fontDict = TraceToDirectObject((*arr)[0]).(*PdfObjectDictionary)
+		}
+		descriptor := TraceToDirectObject(fontDict.Get("FontDescriptor")).(*PdfObjectDictionary)
+		fontFile, err := DecodeStream(TraceToDirectObject(descriptor.Get("FontFile2")).(*PdfObjectStream))
+		if err != nil {
+			t.Fatalf("Failed to decode font: %v", err)
+		}
+		subset, err := fonts.TtfParseBytes(fontFile)
+		if err != nil {
+			t.Fatalf("Failed to parse subset: %v", err)
+		}
+		widths := map[rune]uint16{}
+		if mapObj, ok := TraceToDirectObject(fontDict.Get("CIDToGIDMap")).(*PdfObjectStream); ok {
+			gidMap, err := DecodeStream(mapObj)
+			if err != nil {
+				t.Fatalf("Failed to decode CIDToGIDMap: %v", err)
+			}
+			for cid := 0; 2*cid+1 < len(gidMap); cid++ {
+				if gid := int(gidMap[2*cid])<<8 | int(gidMap[2*cid+1]); gid != 0 {
+					widths[rune(cid)] = subset.Widths[gid]
+				}
+			}
+		} else {
+			for r, gid := range subset.Chars {
+				widths[rune(r)] = subset.Widths[gid]
+			}
+		}
+		return widths, dict
+	}
+
+	for fontName, text := range map[PdfObjectName]string{"F1": "Ωмегаé", "F2": "Hello(A)"} {
+		widths, dict := subsetWidths(fontName)
+		baseFont := TraceToDirectObject(dict.Get("BaseFont")).(*PdfObjectName)
+		if !reSubsetTag.MatchString(string(*baseFont)) {
+			t.Errorf("No subset tag in %s", *baseFont)
+		}
+		runes := map[rune]bool{}
+		for _, r := range text {
+			runes[r] = true
+			if w, has := widths[r]; !has || w != ttf.Widths[ttf.Chars[uint16(r)]] {
+				t.Errorf("Wrong subset glyph for %c in %s: %d", r, *baseFont, w)
+			}
+		}
+		if len(widths) != len(runes) {
+			t.Errorf("Wrong number of glyphs in %s: %d", *baseFont, len(widths))
+		}
+	}
+}
+
+func TestSubsetFontsKeptInFull(t *testing.T) {
+	full, err := ioutil.ReadFile(testRobotoTTFFile)
+	if err != nil {
+		t.Fatalf("Failed to read font: %v", err)
+	}
+	newFont := func(composite bool) *PdfFont {
+		var font *PdfFont
+		var err error
+		if composite {
+			font, err = NewCompositePdfFontFromTTFFile(testRobotoTTFFile)
+		} else {
+			font, err = NewPdfFontFromTTFFile(testRobotoTTFFile)
+		}
+		if err != nil {
+			t.Fatalf("Failed to load font: %v", err)
+		}
+		return font
+	}
+
+	// A document embedding the TrueType file in full, as the font is not set with SetFont.
+	page := newTestPage()
+	page.Resources.SetFontByName("F4", newFont(false).ToPdfObject())
+	page.AddContentStreamByString("BT /F4 12 Tf (A) Tj ET")
+	reader := readTestDocument(t, writeTestPages(t, []*PdfPage{page}, nil), nil)
+	page, err = reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Failed to get page: %v", err)
+	}
+	obj, _ := page.Resources.GetFontByName("F4")
+	loaded, err := NewPdfFontFromPdfObject(obj)
+	if err != nil {
+		t.Fatalf("Failed to load font: %v", err)
+	}
+
+	// F1 shows a code not mapped to a glyph, F2 has a font program not created from a TrueType
+	// file, F3 is shown by the down appearance of an annotation only, F4 is loaded from the document.
+	fonts := map[PdfObjectName]*PdfFont{"F1": newFont(false), "F2": newFont(false), "F3": newFont(true), "F4": loaded}
+	page = newTestPage()
+	for name, font := range fonts {
+		page.Resources.SetFont(name, font)
+	}
+	program, err := MakeStream(append(append([]byte{}, full...), 0, 0, 0, 0), NewFlateEncoder())
+	if err != nil {
+		t.Fatalf("Failed to make stream: %v", err)
+	}
+	descriptor := TraceToDirectObject(fonts["F2"].GetFontDescriptor().ToPdfObject()).(*PdfObjectDictionary)
+	descriptor.Set("FontFile2", program)
+
+	page.AddContentStreamByString("BT /F1 12 Tf (A\\001) Tj /F2 12 Tf (A) Tj /F4 12 Tf (A) Tj ET")
+	resources := MakeDict()
+	resources.Set("Font", MakeDict())
+	resources.Get("Font").(*PdfObjectDictionary).Set("F3", fonts["F3"].ToPdfObject())
+	down, err := MakeStream([]byte("BT /F3 12 Tf <00E9> Tj ET"), nil)
+	if err != nil {
+		t.Fatalf("Failed to make stream: %v", err)
+	}
+	down.Set("BBox", MakeArrayFromFloats([]float64{0, 0, 100, 20}))
+	down.Set("Resources", resources)
+	normal, err := MakeStream(nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to make stream: %v", err)
+	}
+	normal.Set("BBox", MakeArrayFromFloats([]float64{0, 0, 100, 20}))
+	ap := MakeDict()
+	ap.Set("N", normal)
+	ap.Set("D", down)
+	annot := NewPdfAnnotationWidget()
+	annot.Rect = MakeArrayFromFloats([]float64{100, 100, 200, 120})
+	annot.AP = ap
+	page.Annotations = append(page.Annotations, annot.PdfAnnotation)
+	reader = readTestDocument(t, writeTestPages(t, []*PdfPage{page}, nil), nil)
+	page, err = reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Failed to get page: %v", err)
+	}
+	for name, subset := range map[PdfObjectName]bool{"F1": false, "F2": false, "F3": true, "F4": false} {
+		obj, _ := page.Resources.GetFontByName(name)
+		baseFont := TraceToDirectObject(TraceToDirectObject(obj).(*PdfObjectDictionary).Get("BaseFont")).(*PdfObjectName)
+		if reSubsetTag.MatchString(string(*baseFont)) != subset {
+			t.Errorf("%s: Wrong subsetting of %s", name, *baseFont)
+		}
+	}
+}
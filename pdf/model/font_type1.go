@@ -0,0 +1,272 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// pdfFontType1 represents a Type1 (or MMType1) simple font (section 9.6.2).  The font program may
+// be embedded as a Type 1 font program (FontFile) or a CFF font program (FontFile3 with Subtype
+// Type1C), whose built-in encoding and metrics are used when not given by the font dictionary.
+type pdfFontType1 struct {
+	Encoder textencoding.TextEncoder
+
+	firstChar  int
+	lastChar   int
+	charWidths []float64
+
+	// The embedded font program, *fonts.Type1Font or *fonts.CFFFont.  If not embedded, the
+	// metrics of the standard 14 font of BaseFont if any, or else nil.
+	program fonts.Font
+
+	Subtype        core.PdfObject
+	BaseFont       core.PdfObject
+	FirstChar      core.PdfObject
+	LastChar       core.PdfObject
+	Widths         core.PdfObject
+	FontDescriptor *PdfFontDescriptor
+	Encoding       core.PdfObject
+	ToUnicode      core.PdfObject
+
+	container *core.PdfIndirectObject
+}
+
+func (font pdfFontType1) SetEncoder(encoder textencoding.TextEncoder) {
+	font.Encoder = encoder
+}
+
+// GetGlyphCharMetrics returns the metrics of `glyph` from the Widths of the font dictionary, or
+// from the embedded font program if the glyph is not covered by Widths.
+func (font pdfFontType1) GetGlyphCharMetrics(glyph string) (fonts.CharMetrics, bool) {
+	metrics := fonts.CharMetrics{GlyphName: glyph}
+
+	if code, found := font.Encoder.GlyphToCharcode(glyph); found {
+		index := int(code) - font.firstChar
+		if index >= 0 && index < len(font.charWidths) {
+			metrics.Wx = font.charWidths[index]
+			return metrics, true
+		}
+	}
+
+	if font.program != nil {
+		return font.program.GetGlyphCharMetrics(glyph)
+	}
+	return metrics, false
+}
+
+func newPdfFontType1FromPdfObject(obj core.PdfObject) (*pdfFontType1, error) {
+	font := &pdfFontType1{}
+
+	if ind, is := obj.(*core.PdfIndirectObject); is {
+		font.container = ind
+		obj = ind.PdfObject
+	}
+
+	d, ok := obj.(*core.PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("Font object invalid, not a dictionary (%T)", obj)
+		return nil, errors.New("Type check error")
+	}
+
+	font.Subtype = d.Get("Subtype")
+	font.BaseFont = d.Get("BaseFont")
+
+	// FirstChar, LastChar and Widths are optional for the standard 14 fonts.
+	if obj := d.Get("FirstChar"); obj != nil {
+		font.FirstChar = obj
+
+		intVal, ok := core.TraceToDirectObject(obj).(*core.PdfObjectInteger)
+		if !ok {
+			common.Log.Debug("Invalid FirstChar type (%T)", obj)
+			return nil, errors.New("Type check error")
+		}
+		font.firstChar = int(*intVal)
+	}
+
+	if obj := d.Get("LastChar"); obj != nil {
+		font.LastChar = obj
+
+		intVal, ok := core.TraceToDirectObject(obj).(*core.PdfObjectInteger)
+		if !ok {
+			common.Log.Debug("Invalid LastChar type (%T)", obj)
+			return nil, errors.New("Type check error")
+		}
+		font.lastChar = int(*intVal)
+	}
+
+	if obj := d.Get("Widths"); obj != nil {
+		font.Widths = obj
+
+		arr, ok := core.TraceToDirectObject(obj).(*core.PdfObjectArray)
+		if !ok {
+			common.Log.Debug("Widths attribute != array (%T)", obj)
+			return nil, errors.New("Type check error")
+		}
+
+		widths, err := arr.ToFloat64Array()
+		if err != nil {
+			common.Log.Debug("Error converting widths to array")
+			return nil, err
+		}
+
+		if len(widths) != (font.lastChar - font.firstChar + 1) {
+			common.Log.Debug("Invalid widths length != %d (%d)", font.lastChar-font.firstChar+1, len(widths))
+			return nil, errors.New("Range check error")
+		}
+
+		font.charWidths = widths
+	}
+
+	if obj := d.Get("FontDescriptor"); obj != nil {
+		descriptor, err := newPdfFontDescriptorFromPdfObject(obj)
+		if err != nil {
+			common.Log.Debug("Error loading font descriptor: %v", err)
+			return nil, err
+		}
+
+		font.FontDescriptor = descriptor
+
+		program, err := loadType1FontProgram(descriptor)
+		if err != nil {
+			// Text can still be encoded and decoded with the Encoding of the font dictionary.
+			common.Log.Debug("Unable to load font program: %v", err)
+		}
+		font.program = program
+	}
+
+	if name, ok := core.TraceToDirectObject(font.BaseFont).(*core.PdfObjectName); ok && font.program == nil {
+		if std, has := standard14Fonts[string(*name)]; has {
+			font.program = std
+		}
+	}
+
+	font.Encoding = d.Get("Encoding")
+	font.ToUnicode = d.Get("ToUnicode")
+	font.Encoder = font.loadEncoder()
+
+	return font, nil
+}
+
+// standard14Fonts are the metrics of the standard 14 fonts (section 9.6.2.2) by name.
+var standard14Fonts = map[string]fonts.Font{
+	"Courier":               fonts.NewFontCourier(),
+	"Courier-Bold":          fonts.NewFontCourierBold(),
+	"Courier-BoldOblique":   fonts.NewFontCourierBoldOblique(),
+	"Courier-Oblique":       fonts.NewFontCourierOblique(),
+	"Helvetica":             fonts.NewFontHelvetica(),
+	"Helvetica-Bold":        fonts.NewFontHelveticaBold(),
+	"Helvetica-BoldOblique": fonts.NewFontHelveticaBoldOblique(),
+	"Helvetica-Oblique":     fonts.NewFontHelveticaOblique(),
+	"Times-Roman":           fonts.NewFontTimesRoman(),
+	"Times-Bold":            fonts.NewFontTimesBold(),
+	"Times-BoldItalic":      fonts.NewFontTimesBoldItalic(),
+	"Times-Italic":          fonts.NewFontTimesItalic(),
+	"Symbol":                fonts.NewFontSymbol(),
+	"ZapfDingbats":          fonts.NewFontZapfDingbats(),
+}
+
+// loadType1FontProgram loads the font program embedded by the font descriptor `descriptor`, or
+// returns nil if not embedded.
+func loadType1FontProgram(descriptor *PdfFontDescriptor) (fonts.Font, error) {
+	if stream, ok := core.TraceToDirectObject(descriptor.FontFile).(*core.PdfObjectStream); ok {
+		data, err := core.DecodeStream(stream)
+		if err != nil {
+			return nil, err
+		}
+		t1font, err := fonts.NewType1FontFromBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		return t1font, nil
+	}
+
+	if stream, ok := core.TraceToDirectObject(descriptor.FontFile3).(*core.PdfObjectStream); ok {
+		subtype, _ := core.TraceToDirectObject(stream.Get("Subtype")).(*core.PdfObjectName)
+		if subtype == nil || *subtype != "Type1C" {
+			common.Log.Debug("Unsupported FontFile3 subtype (%v)", stream.Get("Subtype"))
+			return nil, errors.New("Unsupported font file")
+		}
+		data, err := core.DecodeStream(stream)
+		if err != nil {
+			return nil, err
+		}
+		cffont, err := fonts.NewCFFFontFromBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		return cffont, nil
+	}
+
+	return nil, nil
+}
+
+// loadEncoder returns the encoder given by the Encoding entry, applied to the built-in encoding of
+// the font program if it has no base encoding.  Defaults to the standard encoding.
+func (font *pdfFontType1) loadEncoder() textencoding.TextEncoder {
+	var encoding map[byte]string
+	switch program := font.program.(type) {
+	case *fonts.Type1Font:
+		encoding = program.Encoding()
+	case *fonts.CFFFont:
+		encoding = program.Encoding()
+	}
+	builtin := textencoding.NewCustomSimpleTextEncoder(encoding)
+	if len(encoding) == 0 {
+		builtin, _ = textencoding.NewSimpleTextEncoder("StandardEncoding", nil)
+	}
+
+	encoder, err := newSimpleFontEncoder(font.Encoding, builtin)
+	if err != nil {
+		common.Log.Debug("Unsupported font encoding, using the built-in encoding: %v", err)
+		return builtin
+	}
+	return encoder
+}
+
+func (this *pdfFontType1) ToPdfObject() core.PdfObject {
+	if this.container == nil {
+		this.container = &core.PdfIndirectObject{}
+	}
+	d := core.MakeDict()
+	this.container.PdfObject = d
+
+	d.Set("Type", core.MakeName("Font"))
+	if this.Subtype != nil {
+		d.Set("Subtype", this.Subtype)
+	} else {
+		d.Set("Subtype", core.MakeName("Type1"))
+	}
+
+	if this.BaseFont != nil {
+		d.Set("BaseFont", this.BaseFont)
+	}
+	if this.FirstChar != nil {
+		d.Set("FirstChar", this.FirstChar)
+	}
+	if this.LastChar != nil {
+		d.Set("LastChar", this.LastChar)
+	}
+	if this.Widths != nil {
+		d.Set("Widths", this.Widths)
+	}
+	if this.FontDescriptor != nil {
+		d.Set("FontDescriptor", this.FontDescriptor.ToPdfObject())
+	}
+	if this.Encoding != nil {
+		d.Set("Encoding", this.Encoding)
+	}
+	if this.ToUnicode != nil {
+		d.Set("ToUnicode", this.ToUnicode)
+	}
+
+	return this.container
+}
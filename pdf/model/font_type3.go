@@ -0,0 +1,339 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// pdfFontType3 represents a Type3 font (section 9.6.5), whose glyphs are defined by the content
+// streams of CharProcs in the glyph space given by FontMatrix.
+type pdfFontType3 struct {
+	// Encoder of text shown with the font, given by the Differences of Encoding.
+	Encoder textencoding.TextEncoder
+
+	firstChar  int
+	lastChar   int
+	charWidths []float64 // In glyph space.
+	fontMatrix [6]float64
+
+	FontBBox       core.PdfObject
+	FontMatrix     core.PdfObject
+	CharProcs      core.PdfObject
+	Encoding       core.PdfObject
+	FirstChar      core.PdfObject
+	LastChar       core.PdfObject
+	Widths         core.PdfObject
+	FontDescriptor *PdfFontDescriptor
+	Resources      core.PdfObject
+	ToUnicode      core.PdfObject
+
+	container *core.PdfIndirectObject
+}
+
+// Type3Glyph represents a glyph of a Type3 font created by NewPdfFontType3.
+type Type3Glyph struct {
+	// The rune shown by the glyph, which is named after it in the glyph list, or uniXXXX.
+	Rune rune
+
+	// The advance width in glyph space.
+	Width float64
+
+	// The glyph description, a content stream starting with the d0 or d1 operator.
+	Content []byte
+}
+
+// GetGlyphCharMetrics returns the metrics of `glyph` in thousandths of text space units.
+func (font pdfFontType3) GetGlyphCharMetrics(glyph string) (fonts.CharMetrics, bool) {
+	metrics := fonts.CharMetrics{GlyphName: glyph}
+
+	code, found := font.Encoder.GlyphToCharcode(glyph)
+	if !found {
+		return metrics, false
+	}
+
+	index := int(code) - font.firstChar
+	if index < 0 || index >= len(font.charWidths) {
+		common.Log.Debug("Code outside of widths range")
+		return metrics, false
+	}
+
+	metrics.Wx = 1000 * font.charWidths[index] * font.fontMatrix[0]
+	return metrics, true
+}
+
+// CharProc returns the glyph description of `glyph`, or nil if not defined by the font.
+func (font pdfFontType3) CharProc(glyph string) *core.PdfObjectStream {
+	charProcs, ok := core.TraceToDirectObject(font.CharProcs).(*core.PdfObjectDictionary)
+	if !ok {
+		return nil
+	}
+	stream, _ := core.TraceToDirectObject(charProcs.Get(core.PdfObjectName(glyph))).(*core.PdfObjectStream)
+	return stream
+}
+
+func newPdfFontType3FromPdfObject(obj core.PdfObject) (*pdfFontType3, error) {
+	font := &pdfFontType3{}
+
+	if ind, is := obj.(*core.PdfIndirectObject); is {
+		font.container = ind
+		obj = ind.PdfObject
+	}
+
+	d, ok := obj.(*core.PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("Font object invalid, not a dictionary (%T)", obj)
+		return nil, errors.New("Type check error")
+	}
+
+	font.FontBBox = d.Get("FontBBox")
+
+	if obj := d.Get("FontMatrix"); obj != nil {
+		font.FontMatrix = obj
+
+		arr, ok := core.TraceToDirectObject(obj).(*core.PdfObjectArray)
+		if !ok {
+			common.Log.Debug("Invalid FontMatrix type (%T)", obj)
+			return nil, errors.New("Type check error")
+		}
+		vals, err := arr.ToFloat64Array()
+		if err != nil || len(vals) != 6 {
+			common.Log.Debug("Invalid FontMatrix %v", arr)
+			return nil, errors.New("Range check error")
+		}
+		copy(font.fontMatrix[:], vals)
+	} else {
+		common.Log.Debug("ERROR: FontMatrix attribute missing")
+		return nil, errors.New("Required attribute missing")
+	}
+
+	if obj := d.Get("CharProcs"); obj != nil {
+		font.CharProcs = obj
+	} else {
+		common.Log.Debug("ERROR: CharProcs attribute missing")
+		return nil, errors.New("Required attribute missing")
+	}
+
+	if obj := d.Get("FirstChar"); obj != nil {
+		font.FirstChar = obj
+
+		intVal, ok := core.TraceToDirectObject(obj).(*core.PdfObjectInteger)
+		if !ok {
+			common.Log.Debug("Invalid FirstChar type (%T)", obj)
+			return nil, errors.New("Type check error")
+		}
+		font.firstChar = int(*intVal)
+	} else {
+		common.Log.Debug("ERROR: FirstChar attribute missing")
+		return nil, errors.New("Required attribute missing")
+	}
+
+	if obj := d.Get("LastChar"); obj != nil {
+		font.LastChar = obj
+
+		intVal, ok := core.TraceToDirectObject(obj).(*core.PdfObjectInteger)
+		if !ok {
+			common.Log.Debug("Invalid LastChar type (%T)", obj)
+			return nil, errors.New("Type check error")
+		}
+		font.lastChar = int(*intVal)
+	} else {
+		common.Log.Debug("ERROR: LastChar attribute missing")
+		return nil, errors.New("Required attribute missing")
+	}
+
+	if obj := d.Get("Widths"); obj != nil {
+		font.Widths = obj
+
+		arr, ok := core.TraceToDirectObject(obj).(*core.PdfObjectArray)
+		if !ok {
+			common.Log.Debug("Widths attribute != array (%T)", obj)
+			return nil, errors.New("Type check error")
+		}
+
+		widths, err := arr.ToFloat64Array()
+		if err != nil {
+			common.Log.Debug("Error converting widths to array")
+			return nil, err
+		}
+
+		if len(widths) != (font.lastChar - font.firstChar + 1) {
+			common.Log.Debug("Invalid widths length != %d (%d)", font.lastChar-font.firstChar+1, len(widths))
+			return nil, errors.New("Range check error")
+		}
+
+		font.charWidths = widths
+	} else {
+		common.Log.Debug("Widths missing from font")
+		return nil, errors.New("Required attribute missing")
+	}
+
+	if obj := d.Get("Encoding"); obj != nil {
+		font.Encoding = obj
+
+		if _, ok := core.TraceToDirectObject(obj).(*core.PdfObjectDictionary); !ok {
+			common.Log.Debug("Invalid Encoding type (%T)", obj)
+			return nil, errors.New("Type check error")
+		}
+		encoder, err := newSimpleFontEncoder(obj, textencoding.NewCustomSimpleTextEncoder(nil))
+		if err != nil {
+			return nil, err
+		}
+		font.Encoder = encoder
+	} else {
+		common.Log.Debug("ERROR: Encoding attribute missing")
+		return nil, errors.New("Required attribute missing")
+	}
+
+	if obj := d.Get("FontDescriptor"); obj != nil {
+		descriptor, err := newPdfFontDescriptorFromPdfObject(obj)
+		if err != nil {
+			common.Log.Debug("Error loading font descriptor: %v", err)
+			return nil, err
+		}
+
+		font.FontDescriptor = descriptor
+	}
+
+	font.Resources = d.Get("Resources")
+	font.ToUnicode = d.Get("ToUnicode")
+
+	return font, nil
+}
+
+// NewPdfFontType3 returns a Type3 font with the glyphs `glyphs` described in the glyph space
+// mapped to text space by `fontMatrix`, with the glyph bounding box `bbox`.  The glyphs are encoded
+// by their rune if below 256, and else by the lowest unused character code, and text shown with
+// the font must be encoded with its encoder.
+func NewPdfFontType3(glyphs []Type3Glyph, fontMatrix [6]float64, bbox PdfRectangle) (*PdfFont, error) {
+	if len(glyphs) == 0 {
+		return nil, errors.New("no glyphs")
+	}
+	if len(glyphs) > 256 {
+		return nil, errors.New("too many glyphs")
+	}
+
+	// Glyph names and codes.
+	names := make([]string, len(glyphs))
+	codes := make([]int, len(glyphs))
+	used := map[int]bool{}
+	nameUsed := map[string]bool{}
+	namer := textencoding.NewIdentityTextEncoder("")
+	for i, glyph := range glyphs {
+		name, found := namer.RuneToGlyph(glyph.Rune)
+		if !found || nameUsed[name] {
+			return nil, fmt.Errorf("invalid or duplicate glyph rune 0x%x", glyph.Rune)
+		}
+		names[i] = name
+		nameUsed[name] = true
+
+		codes[i] = -1
+		if glyph.Rune >= 0 && glyph.Rune < 256 && !used[int(glyph.Rune)] {
+			codes[i] = int(glyph.Rune)
+			used[codes[i]] = true
+		}
+	}
+	next := 0
+	for i := range glyphs {
+		if codes[i] >= 0 {
+			continue
+		}
+		for used[next] {
+			next++
+		}
+		codes[i] = next
+		used[next] = true
+	}
+
+	font := &pdfFontType3{fontMatrix: fontMatrix}
+	encoding := map[byte]string{}
+	widths := map[int]float64{}
+	charProcs := core.MakeDict()
+	font.firstChar, font.lastChar = 255, 0
+	for i, glyph := range glyphs {
+		code := codes[i]
+		encoding[byte(code)] = names[i]
+		widths[code] = glyph.Width
+		if code < font.firstChar {
+			font.firstChar = code
+		}
+		if code > font.lastChar {
+			font.lastChar = code
+		}
+
+		stream, err := core.MakeStream(glyph.Content, core.NewFlateEncoder())
+		if err != nil {
+			return nil, err
+		}
+		charProcs.Set(core.PdfObjectName(names[i]), stream)
+	}
+	font.charWidths = make([]float64, font.lastChar-font.firstChar+1)
+	for code, width := range widths {
+		font.charWidths[code-font.firstChar] = width
+	}
+
+	font.Encoder = textencoding.NewCustomSimpleTextEncoder(encoding)
+	font.FontBBox = bbox.ToPdfObject()
+	font.FontMatrix = core.MakeArrayFromFloats(fontMatrix[:])
+	font.CharProcs = charProcs
+	font.Encoding = font.Encoder.ToPdfObject()
+	font.FirstChar = core.MakeInteger(int64(font.firstChar))
+	font.LastChar = core.MakeInteger(int64(font.lastChar))
+	font.Widths = core.MakeArrayFromFloats(font.charWidths)
+	font.Resources = core.MakeDict()
+
+	return &PdfFont{context: font}, nil
+}
+
+func (this *pdfFontType3) ToPdfObject() core.PdfObject {
+	if this.container == nil {
+		this.container = &core.PdfIndirectObject{}
+	}
+	d := core.MakeDict()
+	this.container.PdfObject = d
+
+	d.Set("Type", core.MakeName("Font"))
+	d.Set("Subtype", core.MakeName("Type3"))
+
+	if this.FontBBox != nil {
+		d.Set("FontBBox", this.FontBBox)
+	}
+	if this.FontMatrix != nil {
+		d.Set("FontMatrix", this.FontMatrix)
+	}
+	if this.CharProcs != nil {
+		d.Set("CharProcs", this.CharProcs)
+	}
+	if this.Encoding != nil {
+		d.Set("Encoding", this.Encoding)
+	}
+	if this.FirstChar != nil {
+		d.Set("FirstChar", this.FirstChar)
+	}
+	if this.LastChar != nil {
+		d.Set("LastChar", this.LastChar)
+	}
+	if this.Widths != nil {
+		d.Set("Widths", this.Widths)
+	}
+	if this.FontDescriptor != nil {
+		d.Set("FontDescriptor", this.FontDescriptor.ToPdfObject())
+	}
+	if this.Resources != nil {
+		d.Set("Resources", this.Resources)
+	}
+	if this.ToUnicode != nil {
+		d.Set("ToUnicode", this.ToUnicode)
+	}
+
+	return this.container
+}
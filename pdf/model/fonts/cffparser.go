@@ -0,0 +1,775 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// Operators of the Top and Private DICTs, escaped operators are offset by 1200.
+const (
+	cffOpFontBBox      = 5
+	cffOpCharset       = 15
+	cffOpEncoding      = 16
+	cffOpCharStrings   = 17
+	cffOpPrivate       = 18
+	cffOpSubrs         = 19
+	cffOpDefaultWidthX = 20
+	cffOpNominalWidthX = 21
+	cffOpIsFixedPitch  = 1201
+	cffOpItalicAngle   = 1202
+	cffOpFontMatrix    = 1207
+	cffOpROS           = 1230
+	cffOpFDArray       = 1236
+	cffOpFDSelect      = 1237
+)
+
+// CFFFont represents a CFF (Type1C or CIDFontType0C) font program (section 9.6.2), with its
+// built-in encoding, glyph names, charstrings and metrics.  Implements the Font interface.
+type CFFFont struct {
+	FontName     string
+	FontMatrix   [6]float64
+	FontBBox     [4]float64
+	ItalicAngle  float64
+	IsFixedPitch bool
+
+	// CID-keyed fonts have CIDs instead of glyph names.
+	isCIDKeyed bool
+	cids       []uint16
+
+	encoding    map[byte]string
+	names       []string
+	nameToGID   map[string]int
+	charStrings [][]byte
+	widths      []float64
+	encoder     textencoding.TextEncoder
+
+	// Subroutines and Private DICTs used to interpret the charstrings, by glyph ID through
+	// fdSelect for CID-keyed fonts.
+	globalSubrs [][]byte
+	privates    []cffPrivate
+	fdSelect    []byte
+
+	data []byte
+}
+
+// cffDict is a Top, Font or Private DICT, the operands by operator.
+type cffDict map[int][]float64
+
+// cffPrivate holds the entries of a Private DICT used to interpret charstrings.
+type cffPrivate struct {
+	subrs         [][]byte
+	defaultWidthX float64
+	nominalWidthX float64
+}
+
+// NewCFFFontFromFile loads a bare CFF font program from a file.
+func NewCFFFontFromFile(filePath string) (*CFFFont, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return NewCFFFontFromBytes(data)
+}
+
+// NewCFFFontFromBytes parses the CFF font program `data`, as embedded in FontFile3 streams of
+// Subtype Type1C or CIDFontType0C.  Only the first font of the font set is loaded.
+func NewCFFFontFromBytes(data []byte) (*CFFFont, error) {
+	if len(data) < 4 || data[0] != 1 {
+		return nil, errors.New("unsupported CFF version")
+	}
+	font := &CFFFont{
+		FontMatrix: [6]float64{0.001, 0, 0, 0.001, 0, 0},
+		encoding:   map[byte]string{},
+		nameToGID:  map[string]int{},
+		data:       data,
+	}
+
+	pos := int(data[2]) // hdrSize
+	names, pos, err := readCFFIndex(data, pos)
+	if err != nil {
+		return nil, err
+	}
+	topDicts, pos, err := readCFFIndex(data, pos)
+	if err != nil {
+		return nil, err
+	}
+	strings, pos, err := readCFFIndex(data, pos)
+	if err != nil {
+		return nil, err
+	}
+	globalSubrs, _, err := readCFFIndex(data, pos)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 || len(topDicts) == 0 {
+		return nil, errors.New("empty CFF font set")
+	}
+	font.FontName = string(names[0])
+
+	sid := func(id int) string {
+		if id < len(cffStandardStrings) {
+			return cffStandardStrings[id]
+		}
+		if id-len(cffStandardStrings) < len(strings) {
+			return string(strings[id-len(cffStandardStrings)])
+		}
+		return fmt.Sprintf("sid%d", id)
+	}
+
+	top, err := parseCFFDict(topDicts[0])
+	if err != nil {
+		return nil, err
+	}
+	if vals := top[cffOpFontMatrix]; len(vals) == 6 {
+		copy(font.FontMatrix[:], vals)
+	}
+	if vals := top[cffOpFontBBox]; len(vals) == 4 {
+		copy(font.FontBBox[:], vals)
+	}
+	if vals := top[cffOpItalicAngle]; len(vals) == 1 {
+		font.ItalicAngle = vals[0]
+	}
+	if vals := top[cffOpIsFixedPitch]; len(vals) == 1 {
+		font.IsFixedPitch = vals[0] != 0
+	}
+	font.isCIDKeyed = top[cffOpROS] != nil
+
+	charStringsOffset := top.int(cffOpCharStrings, 0)
+	if charStringsOffset <= 0 {
+		return nil, errors.New("CharStrings missing")
+	}
+	font.charStrings, _, err = readCFFIndex(data, charStringsOffset)
+	if err != nil {
+		return nil, err
+	}
+	numGlyphs := len(font.charStrings)
+	if numGlyphs == 0 {
+		return nil, errors.New("CharStrings empty")
+	}
+
+	// The charset gives the glyph names (or CIDs) by glyph ID.
+	ids, err := readCFFCharset(data, top.int(cffOpCharset, 0), numGlyphs)
+	if err != nil {
+		return nil, err
+	}
+	if font.isCIDKeyed {
+		font.cids = ids
+	} else {
+		font.names = make([]string, numGlyphs)
+		for gid := range font.names {
+			if gid < len(ids) {
+				font.names[gid] = sid(int(ids[gid]))
+			} else {
+				font.names[gid] = fmt.Sprintf("gid%d", gid)
+			}
+			font.nameToGID[font.names[gid]] = gid
+		}
+		if err := font.readEncoding(top.int(cffOpEncoding, 0), ids, sid); err != nil {
+			return nil, err
+		}
+	}
+
+	// Private DICTs, by glyph ID for CID-keyed fonts.
+	font.privates, font.fdSelect, err = font.readPrivates(top)
+	if err != nil {
+		return nil, err
+	}
+	font.globalSubrs = globalSubrs
+	font.widths = make([]float64, numGlyphs)
+	for gid, charstring := range font.charStrings {
+		width, ok := type2CharStringWidth(charstring, font.private(gid), globalSubrs)
+		if !ok {
+			common.Log.Debug("Unable to get width of glyph %d", gid)
+		}
+		font.widths[gid] = 1000 * width * font.FontMatrix[0]
+	}
+
+	return font, nil
+}
+
+// readEncoding reads the built-in encoding at `offset` of a name-keyed font with the glyph SIDs
+// `ids`.
+func (font *CFFFont) readEncoding(offset int, ids []uint16, sid func(int) string) error {
+	data := font.data
+	switch offset {
+	case 0:
+		standard := textencoding.NewStandardTextEncoder()
+		for code := 0; code < 256; code++ {
+			glyph, found := standard.CharcodeToGlyph(byte(code))
+			if _, has := font.nameToGID[glyph]; found && has {
+				font.encoding[byte(code)] = glyph
+			}
+		}
+		return nil
+	case 1:
+		common.Log.Debug("Expert encoding of CFF fonts not supported")
+		return nil
+	}
+
+	if offset >= len(data) {
+		return errors.New("Encoding out of range")
+	}
+	format := data[offset]
+	pos := offset + 1
+	switch format & 0x7f {
+	case 0:
+		if pos >= len(data) {
+			return errors.New("Encoding out of range")
+		}
+		nCodes := int(data[pos])
+		pos++
+		if pos+nCodes > len(data) {
+			return errors.New("Encoding out of range")
+		}
+		for i := 0; i < nCodes && i+1 < len(font.names); i++ {
+			font.encoding[data[pos+i]] = font.names[i+1]
+		}
+		pos += nCodes
+	case 1:
+		if pos >= len(data) {
+			return errors.New("Encoding out of range")
+		}
+		nRanges := int(data[pos])
+		pos++
+		if pos+2*nRanges > len(data) {
+			return errors.New("Encoding out of range")
+		}
+		gid := 1
+		for i := 0; i < nRanges; i++ {
+			first, nLeft := int(data[pos]), int(data[pos+1])
+			pos += 2
+			for code := first; code <= first+nLeft && code < 256 && gid < len(font.names); code++ {
+				font.encoding[byte(code)] = font.names[gid]
+				gid++
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported Encoding format %d", format&0x7f)
+	}
+
+	// Supplementary codes of glyphs with several codes.
+	if format&0x80 != 0 && pos < len(data) {
+		nSups := int(data[pos])
+		pos++
+		for i := 0; i < nSups && pos+3 <= len(data); i++ {
+			font.encoding[data[pos]] = sid(int(binary.BigEndian.Uint16(data[pos+1:])))
+			pos += 3
+		}
+	}
+	return nil
+}
+
+// readPrivates returns the Private DICTs of the font, and for CID-keyed fonts the index of the
+// Private DICT by glyph ID.
+func (font *CFFFont) readPrivates(top cffDict) ([]cffPrivate, []byte, error) {
+	if !font.isCIDKeyed {
+		private, err := readCFFPrivate(font.data, top)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []cffPrivate{private}, nil, nil
+	}
+
+	fdArrayOffset := top.int(cffOpFDArray, 0)
+	if fdArrayOffset <= 0 {
+		return nil, nil, errors.New("FDArray missing")
+	}
+	fontDicts, _, err := readCFFIndex(font.data, fdArrayOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+	var privates []cffPrivate
+	for _, fontDict := range fontDicts {
+		dict, err := parseCFFDict(fontDict)
+		if err != nil {
+			return nil, nil, err
+		}
+		private, err := readCFFPrivate(font.data, dict)
+		if err != nil {
+			return nil, nil, err
+		}
+		privates = append(privates, private)
+	}
+	if len(privates) == 0 {
+		return nil, nil, errors.New("empty FDArray")
+	}
+	fdSelect, err := readCFFFDSelect(font.data, top.int(cffOpFDSelect, 0), len(font.charStrings))
+	if err != nil {
+		return nil, nil, err
+	}
+	return privates, fdSelect, nil
+}
+
+// private returns the Private DICT of the glyph `gid`.
+func (font *CFFFont) private(gid int) cffPrivate {
+	if font.fdSelect != nil && gid < len(font.fdSelect) && int(font.fdSelect[gid]) < len(font.privates) {
+		return font.privates[font.fdSelect[gid]]
+	}
+	return font.privates[0]
+}
+
+// readCFFPrivate reads the Private DICT referenced by the Top or Font DICT `dict`.
+func readCFFPrivate(data []byte, dict cffDict) (cffPrivate, error) {
+	private := cffPrivate{}
+	vals := dict[cffOpPrivate]
+	if len(vals) != 2 {
+		return private, nil
+	}
+	size, offset := int(vals[0]), int(vals[1])
+	if offset < 0 || size < 0 || offset+size > len(data) {
+		return private, errors.New("Private DICT out of range")
+	}
+	pdict, err := parseCFFDict(data[offset : offset+size])
+	if err != nil {
+		return private, err
+	}
+	private.defaultWidthX = pdict.float(cffOpDefaultWidthX, 0)
+	private.nominalWidthX = pdict.float(cffOpNominalWidthX, 0)
+	if subrs := pdict.int(cffOpSubrs, 0); subrs > 0 {
+		private.subrs, _, err = readCFFIndex(data, offset+subrs)
+		if err != nil {
+			return private, err
+		}
+	}
+	return private, nil
+}
+
+// readCFFCharset reads the charset at `offset`, the SIDs (or CIDs) by glyph ID.
+func readCFFCharset(data []byte, offset, numGlyphs int) ([]uint16, error) {
+	ids := make([]uint16, 1, numGlyphs)
+	switch offset {
+	case 0:
+		// ISOAdobe: the SIDs are the glyph IDs.
+		for gid := 1; gid < numGlyphs && gid < 229; gid++ {
+			ids = append(ids, uint16(gid))
+		}
+		return ids, nil
+	case 1, 2:
+		common.Log.Debug("Expert charsets of CFF fonts not supported")
+		return ids, nil
+	}
+
+	if offset >= len(data) {
+		return nil, errors.New("charset out of range")
+	}
+	format := data[offset]
+	pos := offset + 1
+	for len(ids) < numGlyphs {
+		switch format {
+		case 0:
+			if pos+2 > len(data) {
+				return nil, errors.New("charset out of range")
+			}
+			ids = append(ids, binary.BigEndian.Uint16(data[pos:]))
+			pos += 2
+		case 1, 2:
+			size := 3
+			if format == 2 {
+				size = 4
+			}
+			if pos+size > len(data) {
+				return nil, errors.New("charset out of range")
+			}
+			first := int(binary.BigEndian.Uint16(data[pos:]))
+			nLeft := int(data[pos+2])
+			if format == 2 {
+				nLeft = int(binary.BigEndian.Uint16(data[pos+2:]))
+			}
+			pos += size
+			for id := first; id <= first+nLeft && len(ids) < numGlyphs; id++ {
+				ids = append(ids, uint16(id))
+			}
+		default:
+			return nil, fmt.Errorf("unsupported charset format %d", format)
+		}
+	}
+	return ids, nil
+}
+
+// readCFFFDSelect reads the FDSelect at `offset`, the Font DICT index by glyph ID.
+func readCFFFDSelect(data []byte, offset, numGlyphs int) ([]byte, error) {
+	if offset <= 0 || offset >= len(data) {
+		return nil, errors.New("FDSelect missing")
+	}
+	fds := make([]byte, numGlyphs)
+	switch data[offset] {
+	case 0:
+		if offset+1+numGlyphs > len(data) {
+			return nil, errors.New("FDSelect out of range")
+		}
+		copy(fds, data[offset+1:])
+	case 3:
+		if offset+3 > len(data) {
+			return nil, errors.New("FDSelect out of range")
+		}
+		nRanges := int(binary.BigEndian.Uint16(data[offset+1:]))
+		pos := offset + 3
+		if pos+3*nRanges+2 > len(data) {
+			return nil, errors.New("FDSelect out of range")
+		}
+		for i := 0; i < nRanges; i++ {
+			first := int(binary.BigEndian.Uint16(data[pos:]))
+			fd := data[pos+2]
+			next := int(binary.BigEndian.Uint16(data[pos+3:]))
+			for gid := first; gid < next && gid < numGlyphs; gid++ {
+				fds[gid] = fd
+			}
+			pos += 3
+		}
+	default:
+		return nil, fmt.Errorf("unsupported FDSelect format %d", data[offset])
+	}
+	return fds, nil
+}
+
+// readCFFIndex reads the INDEX at `pos` of `data`, returning its objects and the position
+// following it.
+func readCFFIndex(data []byte, pos int) ([][]byte, int, error) {
+	if pos < 0 || pos+2 > len(data) {
+		return nil, 0, errors.New("INDEX out of range")
+	}
+	count := int(binary.BigEndian.Uint16(data[pos:]))
+	if count == 0 {
+		return nil, pos + 2, nil
+	}
+	if pos+3 > len(data) {
+		return nil, 0, errors.New("INDEX out of range")
+	}
+	offSize := int(data[pos+2])
+	if offSize < 1 || offSize > 4 {
+		return nil, 0, fmt.Errorf("invalid INDEX offSize %d", offSize)
+	}
+	offsets := pos + 3
+	base := offsets + (count+1)*offSize - 1
+	if base >= len(data) {
+		return nil, 0, errors.New("INDEX out of range")
+	}
+	offset := func(i int) int {
+		val := 0
+		for _, b := range data[offsets+i*offSize : offsets+(i+1)*offSize] {
+			val = val<<8 | int(b)
+		}
+		return base + val
+	}
+
+	objects := make([][]byte, count)
+	for i := range objects {
+		start, end := offset(i), offset(i+1)
+		if start > end || end > len(data) {
+			return nil, 0, errors.New("INDEX object out of range")
+		}
+		objects[i] = data[start:end]
+	}
+	return objects, offset(count), nil
+}
+
+// parseCFFDict parses the DICT data `data`.
+func parseCFFDict(data []byte) (cffDict, error) {
+	dict := cffDict{}
+	var operands []float64
+	for i := 0; i < len(data); {
+		b0 := int(data[i])
+		switch {
+		case b0 <= 21:
+			op := b0
+			i++
+			if b0 == 12 {
+				if i >= len(data) {
+					return nil, errors.New("truncated DICT operator")
+				}
+				op = 1200 + int(data[i])
+				i++
+			}
+			dict[op] = operands
+			operands = nil
+		case b0 == 30:
+			val, n, err := parseCFFReal(data[i+1:])
+			if err != nil {
+				return nil, err
+			}
+			operands = append(operands, val)
+			i += 1 + n
+		default:
+			val, n, ok := decodeCFFInteger(data[i:])
+			if !ok {
+				return nil, fmt.Errorf("invalid DICT operand %d", b0)
+			}
+			operands = append(operands, float64(val))
+			i += n
+		}
+	}
+	return dict, nil
+}
+
+func (dict cffDict) float(op int, def float64) float64 {
+	if vals := dict[op]; len(vals) > 0 {
+		return vals[len(vals)-1]
+	}
+	return def
+}
+
+func (dict cffDict) int(op int, def int) int {
+	return int(dict.float(op, float64(def)))
+}
+
+// decodeCFFInteger decodes the integer operand at the start of `data` of DICTs and Type 2
+// charstrings, returning its value and length.
+func decodeCFFInteger(data []byte) (int, int, bool) {
+	b0 := int(data[0])
+	switch {
+	case b0 >= 32 && b0 <= 246:
+		return b0 - 139, 1, true
+	case b0 >= 247 && b0 <= 250 && len(data) >= 2:
+		return (b0-247)*256 + int(data[1]) + 108, 2, true
+	case b0 >= 251 && b0 <= 254 && len(data) >= 2:
+		return -(b0-251)*256 - int(data[1]) - 108, 2, true
+	case b0 == 28 && len(data) >= 3:
+		return int(int16(binary.BigEndian.Uint16(data[1:]))), 3, true
+	case b0 == 29 && len(data) >= 5:
+		return int(int32(binary.BigEndian.Uint32(data[1:]))), 5, true
+	}
+	return 0, 0, false
+}
+
+// parseCFFReal parses the nibbles of a real number DICT operand, returning its value and length.
+func parseCFFReal(data []byte) (float64, int, error) {
+	var s []byte
+	for i, b := range data {
+		for _, nibble := range []byte{b >> 4, b & 0xf} {
+			switch {
+			case nibble <= 9:
+				s = append(s, '0'+nibble)
+			case nibble == 0xa:
+				s = append(s, '.')
+			case nibble == 0xb:
+				s = append(s, 'E')
+			case nibble == 0xc:
+				s = append(s, 'E', '-')
+			case nibble == 0xe:
+				s = append(s, '-')
+			case nibble == 0xf:
+				val, err := strconv.ParseFloat(string(s), 64)
+				return val, i + 1, err
+			}
+		}
+	}
+	return 0, 0, errors.New("unterminated real number")
+}
+
+// subrBias returns the bias of the subroutine numbers of the subroutines `subrs`.
+func subrBias(subrs [][]byte) int {
+	switch {
+	case len(subrs) < 1240:
+		return 107
+	case len(subrs) < 33900:
+		return 1131
+	}
+	return 32768
+}
+
+// type2CharStringWidth returns the advance width of the Type 2 charstring `charstring`, given by
+// an extra first argument of the first stack clearing operator or by the default width.
+func type2CharStringWidth(charstring []byte, private cffPrivate, globalSubrs [][]byte) (float64, bool) {
+	var stack []float64
+	var run func(charstring []byte, depth int) (float64, bool, bool)
+	// run returns the width, whether it was found and whether the charstring ended.
+	run = func(charstring []byte, depth int) (float64, bool, bool) {
+		if depth > 10 {
+			return 0, false, true
+		}
+		for i := 0; i < len(charstring); {
+			b0 := int(charstring[i])
+			if b0 == 28 || b0 >= 32 {
+				if b0 == 255 {
+					if i+5 > len(charstring) {
+						return 0, false, true
+					}
+					stack = append(stack, float64(int32(binary.BigEndian.Uint32(charstring[i+1:])))/65536)
+					i += 5
+					continue
+				}
+				val, n, ok := decodeCFFInteger(charstring[i:])
+				if !ok {
+					return 0, false, true
+				}
+				stack = append(stack, float64(val))
+				i += n
+				continue
+			}
+			i++
+
+			// Width given by the parity of the number of arguments.
+			width := func(even bool) (float64, bool, bool) {
+				if (len(stack)%2 == 1) == even {
+					return private.nominalWidthX + stack[0], true, true
+				}
+				return private.defaultWidthX, true, true
+			}
+			switch b0 {
+			case 1, 3, 18, 23: // hstem, vstem, hstemhm, vstemhm
+				return width(true)
+			case 19, 20: // hintmask, cntrmask
+				if len(stack) > 0 {
+					return width(true)
+				}
+				return private.defaultWidthX, true, true
+			case 21: // rmoveto
+				return width(len(stack) != 3)
+			case 4, 22: // vmoveto, hmoveto
+				return width(len(stack) != 2)
+			case 14: // endchar
+				if len(stack) == 1 || len(stack) == 5 {
+					return private.nominalWidthX + stack[0], true, true
+				}
+				return private.defaultWidthX, true, true
+			case 10, 29: // callsubr, callgsubr
+				if len(stack) == 0 {
+					return 0, false, true
+				}
+				subrs := private.subrs
+				if b0 == 29 {
+					subrs = globalSubrs
+				}
+				index := int(stack[len(stack)-1]) + subrBias(subrs)
+				stack = stack[:len(stack)-1]
+				if index < 0 || index >= len(subrs) {
+					return 0, false, true
+				}
+				if w, found, ended := run(subrs[index], depth+1); ended {
+					return w, found, true
+				}
+			case 11: // return
+				return 0, false, false
+			default:
+				// Path construction before any stack clearing operator: no width.
+				return private.defaultWidthX, true, true
+			}
+		}
+		return 0, false, false
+	}
+	w, found, _ := run(charstring, 0)
+	if !found {
+		return private.defaultWidthX, false
+	}
+	return w, true
+}
+
+// IsCIDKeyed returns true for CID-keyed fonts, whose glyphs are selected by CID instead of name.
+func (font *CFFFont) IsCIDKeyed() bool {
+	return font.isCIDKeyed
+}
+
+// Encoding returns the built-in encoding of name-keyed fonts, the glyph names by character code.
+func (font *CFFFont) Encoding() map[byte]string {
+	return font.encoding
+}
+
+// GlyphNames returns the names of the glyphs of name-keyed fonts by glyph ID.
+func (font *CFFFont) GlyphNames() []string {
+	return font.names
+}
+
+// NumGlyphs returns the number of glyphs of the font.
+func (font *CFFFont) NumGlyphs() int {
+	return len(font.charStrings)
+}
+
+// GlyphID returns the glyph ID of `glyph` of name-keyed fonts.
+func (font *CFFFont) GlyphID(glyph string) (int, bool) {
+	gid, has := font.nameToGID[glyph]
+	return gid, has
+}
+
+// CIDToGlyphID returns the glyph ID of `cid` of CID-keyed fonts.
+func (font *CFFFont) CIDToGlyphID(cid uint16) (int, bool) {
+	for gid, c := range font.cids {
+		if c == cid {
+			return gid, true
+		}
+	}
+	return 0, false
+}
+
+// CharString returns the Type 2 charstring of the glyph `gid`.
+func (font *CFFFont) CharString(gid int) ([]byte, bool) {
+	if gid < 0 || gid >= len(font.charStrings) {
+		return nil, false
+	}
+	return font.charStrings[gid], true
+}
+
+// GlyphWidth returns the advance width of the glyph `gid` in thousandths of text space units.
+func (font *CFFFont) GlyphWidth(gid int) (float64, bool) {
+	if gid < 0 || gid >= len(font.widths) {
+		return 0, false
+	}
+	return font.widths[gid], true
+}
+
+// SetEncoder sets the encoding of text shown with the font.  The built-in encoding is used if
+// not set.
+func (font *CFFFont) SetEncoder(encoder textencoding.TextEncoder) {
+	font.encoder = encoder
+}
+
+// GetGlyphCharMetrics returns the metrics of `glyph` of name-keyed fonts in thousandths of text
+// space units.
+func (font *CFFFont) GetGlyphCharMetrics(glyph string) (CharMetrics, bool) {
+	gid, has := font.nameToGID[glyph]
+	if !has {
+		return CharMetrics{GlyphName: glyph}, false
+	}
+	return CharMetrics{GlyphName: glyph, Wx: font.widths[gid]}, true
+}
+
+// ToPdfObject returns a Type1 font dictionary embedding the font program as a Type1C FontFile3
+// stream.  CID-keyed fonts are not simple fonts and give a null object.
+func (font *CFFFont) ToPdfObject() core.PdfObject {
+	if font.isCIDKeyed {
+		common.Log.Debug("CID-keyed CFF font %s cannot be used as a simple font", font.FontName)
+		return core.MakeNull()
+	}
+
+	encoding := font.encoding
+	if font.encoder != nil {
+		encoding = map[byte]string{}
+		for code := 0; code < 256; code++ {
+			if glyph, found := font.encoder.CharcodeToGlyph(byte(code)); found {
+				encoding[byte(code)] = glyph
+			}
+		}
+	}
+	widths := map[string]float64{}
+	for gid, name := range font.names {
+		widths[name] = font.widths[gid]
+	}
+
+	stream, err := core.MakeStream(font.data, core.NewFlateEncoder())
+	if err != nil {
+		common.Log.Debug("Unable to make stream: %v", err)
+		return core.MakeNull()
+	}
+	stream.Set("Subtype", core.MakeName("Type1C"))
+
+	descriptor := simpleFontDescriptor(font.FontName, font.FontBBox, font.ItalicAngle, font.IsFixedPitch,
+		font.FontMatrix[3])
+	descriptor.Set("FontFile3", stream)
+
+	fontDict := simpleFontDict("Type1", font.FontName, encoding, widths, descriptor)
+	if font.encoder != nil {
+		fontDict.Set("Encoding", font.encoder.ToPdfObject())
+	}
+	return core.MakeIndirectObject(fontDict)
+}
@@ -0,0 +1,191 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+)
+
+// makeCFFIndex returns an INDEX of `objects` with 2 byte offsets.
+func makeCFFIndex(objects ...[]byte) []byte {
+	index := make([]byte, 2)
+	binary.BigEndian.PutUint16(index, uint16(len(objects)))
+	if len(objects) == 0 {
+		return index
+	}
+	index = append(index, 2)
+	offset := 1
+	for i := 0; i <= len(objects); i++ {
+		index = append(index, byte(offset>>8), byte(offset))
+		if i < len(objects) {
+			offset += len(objects[i])
+		}
+	}
+	for _, object := range objects {
+		index = append(index, object...)
+	}
+	return index
+}
+
+// cffInt returns the 5 byte DICT encoding of `val`.
+func cffInt(val int) []byte {
+	b := []byte{29, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(b[1:], uint32(int32(val)))
+	return b
+}
+
+// makeTestCFFFont returns a CFF font program with the glyphs .notdef (default width 250), A (width
+// 600) and custom (width 550, given by hstem in a subroutine) encoded at 65, 66 and 97 (supplement).
+func makeTestCFFFont() []byte {
+	header := []byte{1, 0, 4, 1}
+	names := makeCFFIndex([]byte("TestCFF"))
+	strings := makeCFFIndex([]byte("custom"))
+	globalSubrs := makeCFFIndex()
+	charset := []byte{0, 0, 34, 1, 135} // SIDs 34 (A) and 391 (custom).
+	encoding := []byte{0x80, 2, 65, 66, 1, 97, 0, 34}
+	charStrings := makeCFFIndex(
+		[]byte{14},         // endchar
+		[]byte{239, 14},    // 100 endchar
+		[]byte{32, 10, 14}, // 0 callsubr endchar
+	)
+	subrs := makeCFFIndex([]byte{189, 139, 149, 1, 11}) // 50 0 10 hstem return
+
+	private := append(append([]byte{}, cffInt(250)...), cffOpDefaultWidthX)
+	private = append(append(private, cffInt(500)...), cffOpNominalWidthX)
+	private = append(append(private, cffInt(0)...), cffOpSubrs)
+	privateSize := len(private)
+	private = append(append([]byte{}, cffInt(250)...), cffOpDefaultWidthX)
+	private = append(append(private, cffInt(500)...), cffOpNominalWidthX)
+	private = append(append(private, cffInt(privateSize)...), cffOpSubrs)
+
+	topDict := func(charsetOffset, encodingOffset, charStringsOffset, privateOffset int) []byte {
+		var dict []byte
+		dict = append(dict, 100, 39, 250, 24, 249, 180, 5) // FontBBox -39 -100 900 800
+		dict = append(append(dict, cffInt(charsetOffset)...), cffOpCharset)
+		dict = append(append(dict, cffInt(encodingOffset)...), cffOpEncoding)
+		dict = append(append(dict, cffInt(charStringsOffset)...), cffOpCharStrings)
+		dict = append(append(dict, cffInt(privateSize)...), cffInt(privateOffset)...)
+		return append(dict, cffOpPrivate)
+	}
+
+	offset := len(header) + len(names) + len(makeCFFIndex(topDict(0, 0, 0, 0))) + len(strings) + len(globalSubrs)
+	charsetOffset := offset
+	encodingOffset := charsetOffset + len(charset)
+	charStringsOffset := encodingOffset + len(encoding)
+	privateOffset := charStringsOffset + len(charStrings)
+
+	var data []byte
+	for _, part := range [][]byte{header, names, makeCFFIndex(topDict(charsetOffset, encodingOffset,
+		charStringsOffset, privateOffset)), strings, globalSubrs, charset, encoding, charStrings, private, subrs} {
+		data = append(data, part...)
+	}
+	return data
+}
+
+func TestCFFStandardStrings(t *testing.T) {
+	if len(cffStandardStrings) != 391 {
+		t.Fatalf("Incorrect number of standard strings %d", len(cffStandardStrings))
+	}
+	for sid, name := range map[int]string{0: ".notdef", 34: "A", 199: "Zcaron", 229: "exclamsmall", 390: "Semibold"} {
+		if cffStandardStrings[sid] != name {
+			t.Errorf("Incorrect standard string %d: %s != %s", sid, cffStandardStrings[sid], name)
+		}
+	}
+}
+
+func TestCFFFontParsing(t *testing.T) {
+	font, err := NewCFFFontFromBytes(makeTestCFFFont())
+	if err != nil {
+		t.Fatalf("Error parsing font: %v", err)
+	}
+
+	if font.FontName != "TestCFF" {
+		t.Errorf("Incorrect FontName %q", font.FontName)
+	}
+	if font.FontBBox != [4]float64{-39, -100, 900, 800} {
+		t.Errorf("Incorrect FontBBox %v", font.FontBBox)
+	}
+	if font.IsCIDKeyed() {
+		t.Errorf("Font should not be CID-keyed")
+	}
+
+	names := font.GlyphNames()
+	if len(names) != 3 || names[0] != ".notdef" || names[1] != "A" || names[2] != "custom" {
+		t.Errorf("Incorrect glyph names %v", names)
+	}
+	encoding := font.Encoding()
+	if len(encoding) != 3 || encoding[65] != "A" || encoding[66] != "custom" || encoding[97] != "A" {
+		t.Errorf("Incorrect built-in encoding %v", encoding)
+	}
+
+	for glyph, wx := range map[string]float64{".notdef": 250, "A": 600, "custom": 550} {
+		metrics, found := font.GetGlyphCharMetrics(glyph)
+		if !found || metrics.Wx != wx {
+			t.Errorf("Incorrect metrics of %s: %+v (%t)", glyph, metrics, found)
+		}
+	}
+
+	if gid, found := font.GlyphID("custom"); !found || gid != 2 {
+		t.Errorf("Incorrect glyph ID of custom: %d", gid)
+	}
+	if charstring, found := font.CharString(2); !found || !bytes.Equal(charstring, []byte{32, 10, 14}) {
+		t.Errorf("Incorrect charstring of custom: %v", charstring)
+	}
+}
+
+// Test that fonts without glyphs are rejected.
+func TestCFFFontEmptyCharStrings(t *testing.T) {
+	data := makeTestCFFFont()
+	// CharStrings INDEX of 3 objects with 2 byte offsets, starting at offset 1.
+	i := bytes.Index(data, []byte{0, 3, 2, 0, 1})
+	if i < 0 {
+		t.Fatalf("CharStrings not found")
+	}
+	data[i+1] = 0
+	if _, err := NewCFFFontFromBytes(data); err == nil {
+		t.Errorf("Font without CharStrings accepted")
+	}
+}
+
+func TestCFFFontToPdfObject(t *testing.T) {
+	font, err := NewCFFFontFromBytes(makeTestCFFFont())
+	if err != nil {
+		t.Fatalf("Error parsing font: %v", err)
+	}
+
+	ind, ok := font.ToPdfObject().(*core.PdfIndirectObject)
+	if !ok {
+		t.Fatalf("Font not an indirect object")
+	}
+	dict := ind.PdfObject.(*core.PdfObjectDictionary)
+	widths, _ := dict.Get("Widths").(*core.PdfObjectArray)
+	if widths == nil || len(*widths) != 97-65+1 {
+		t.Fatalf("Incorrect Widths %v", dict.Get("Widths"))
+	}
+	if vals, _ := widths.ToFloat64Array(); vals[0] != 600 || vals[1] != 550 || vals[32] != 600 {
+		t.Errorf("Incorrect Widths %v", vals)
+	}
+
+	descriptor := dict.Get("FontDescriptor").(*core.PdfIndirectObject).PdfObject.(*core.PdfObjectDictionary)
+	stream, ok := descriptor.Get("FontFile3").(*core.PdfObjectStream)
+	if !ok {
+		t.Fatalf("FontFile3 missing")
+	}
+	if subtype, _ := stream.Get("Subtype").(*core.PdfObjectName); subtype == nil || *subtype != "Type1C" {
+		t.Errorf("Incorrect FontFile3 Subtype %v", stream.Get("Subtype"))
+	}
+	data, err := core.DecodeStream(stream)
+	if err != nil {
+		t.Fatalf("Error decoding FontFile3: %v", err)
+	}
+	if !bytes.Equal(data, makeTestCFFFont()) {
+		t.Errorf("FontFile3 does not match the font program")
+	}
+}
@@ -0,0 +1,588 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// Maximum nesting of charstring subroutine calls.
+const maxCharStringSubrDepth = 10
+
+// GlyphOutline returns the outline of `glyph` in glyph space units, interpreting its Type 1
+// charstring.  The components of accented characters (seac) are included, and the hints ignored.
+func (font *Type1Font) GlyphOutline(glyph string) (GlyphOutline, bool) {
+	charstring, has := font.charStrings[glyph]
+	if !has {
+		return nil, false
+	}
+	t := &type1Interpreter{font: font}
+	if err := t.run(charstring, 0); err != nil {
+		common.Log.Debug("Unable to interpret charstring of glyph %s: %v", glyph, err)
+		return nil, false
+	}
+	t.b.closePath()
+	return t.b.outline, true
+}
+
+// type1Interpreter interprets Type 1 charstrings (Adobe Type 1 Font Format, chapter 6) into
+// glyph outlines.
+type type1Interpreter struct {
+	font    *Type1Font
+	b       outlineBuilder
+	stack   []float64
+	psStack []float64 // Results of OtherSubrs, moved to the stack by pop.
+	x, y    float64
+	sbx     float64
+	ended   bool
+
+	// Flex: the reference point and the points of the two curves, collected by OtherSubr 2.
+	flex       bool
+	flexPoints []OutlinePoint
+}
+
+// run interprets `charstring`, a subroutine at `depth` if not zero.
+func (t *type1Interpreter) run(charstring []byte, depth int) error {
+	if depth > maxCharStringSubrDepth {
+		return errors.New("subroutines nested too deeply")
+	}
+	for i := 0; i < len(charstring) && !t.ended; {
+		if charstring[i] >= 32 {
+			val, n, ok := decodeCharStringNumber(charstring[i:])
+			if !ok {
+				return errors.New("invalid charstring number")
+			}
+			t.stack = append(t.stack, val)
+			i += n
+			continue
+		}
+		op := int(charstring[i])
+		i++
+		if op == 12 {
+			if i >= len(charstring) {
+				return errors.New("truncated charstring")
+			}
+			op = 1200 + int(charstring[i])
+			i++
+		}
+
+		args := t.stack
+		need := func(n int) error {
+			if len(args) < n {
+				return errors.New("stack underflow")
+			}
+			args = args[len(args)-n:]
+			return nil
+		}
+		clear := true
+		switch op {
+		case 1, 3, 1200, 1201, 1202: // hstem, vstem, dotsection, vstem3, hstem3
+		case 13: // hsbw
+			if err := need(2); err != nil {
+				return err
+			}
+			t.sbx, t.x, t.y = args[0], args[0], 0
+		case 1207: // sbw
+			if err := need(4); err != nil {
+				return err
+			}
+			t.sbx, t.x, t.y = args[0], args[0], args[1]
+		case 21: // rmoveto
+			if err := need(2); err != nil {
+				return err
+			}
+			t.moveTo(args[0], args[1])
+		case 22: // hmoveto
+			if err := need(1); err != nil {
+				return err
+			}
+			t.moveTo(args[0], 0)
+		case 4: // vmoveto
+			if err := need(1); err != nil {
+				return err
+			}
+			t.moveTo(0, args[0])
+		case 5: // rlineto
+			if err := need(2); err != nil {
+				return err
+			}
+			t.lineTo(args[0], args[1])
+		case 6: // hlineto
+			if err := need(1); err != nil {
+				return err
+			}
+			t.lineTo(args[0], 0)
+		case 7: // vlineto
+			if err := need(1); err != nil {
+				return err
+			}
+			t.lineTo(0, args[0])
+		case 8: // rrcurveto
+			if err := need(6); err != nil {
+				return err
+			}
+			t.curveTo(args[0], args[1], args[2], args[3], args[4], args[5])
+		case 30: // vhcurveto
+			if err := need(4); err != nil {
+				return err
+			}
+			t.curveTo(0, args[0], args[1], args[2], args[3], 0)
+		case 31: // hvcurveto
+			if err := need(4); err != nil {
+				return err
+			}
+			t.curveTo(args[0], 0, args[1], args[2], 0, args[3])
+		case 9: // closepath
+			t.b.closePath()
+		case 10: // callsubr
+			if err := need(1); err != nil {
+				return err
+			}
+			subr, ok := t.font.Subr(int(args[0]))
+			if !ok {
+				return errors.New("subroutine not found")
+			}
+			t.stack = t.stack[:len(t.stack)-1]
+			if err := t.run(subr, depth+1); err != nil {
+				return err
+			}
+			clear = false
+		case 11: // return
+			return nil
+		case 14: // endchar
+			t.b.closePath()
+			t.ended = true
+		case 1206: // seac
+			if err := need(5); err != nil {
+				return err
+			}
+			return t.seac(args[0], args[1], args[2], int(args[3]), int(args[4]))
+		case 1212: // div
+			if err := need(2); err != nil {
+				return err
+			}
+			t.stack = append(t.stack[:len(t.stack)-2], args[0]/args[1])
+			clear = false
+		case 1216: // callothersubr
+			if err := need(2); err != nil {
+				return err
+			}
+			othersubr, n := int(args[1]), int(args[0])
+			args = t.stack[:len(t.stack)-2]
+			if err := need(n); err != nil {
+				return err
+			}
+			t.stack = t.stack[:len(t.stack)-2-n]
+			t.callOtherSubr(othersubr, args)
+			clear = false
+		case 1217: // pop
+			if n := len(t.psStack); n > 0 {
+				t.stack = append(t.stack, t.psStack[n-1])
+				t.psStack = t.psStack[:n-1]
+			}
+			clear = false
+		case 1233: // setcurrentpoint
+			if err := need(2); err != nil {
+				return err
+			}
+			t.x, t.y = args[0], args[1]
+		default:
+			common.Log.Trace("Unsupported Type 1 charstring operator %d", op)
+		}
+		if clear {
+			t.stack = t.stack[:0]
+		}
+	}
+	return nil
+}
+
+// callOtherSubr runs the OtherSubr `othersubr` with the arguments `args`.  The flex and hint
+// replacement OtherSubrs 0 to 3 are supported.
+func (t *type1Interpreter) callOtherSubr(othersubr int, args []float64) {
+	switch othersubr {
+	case 0: // End of flex.
+		if t.flex && len(t.flexPoints) >= 7 {
+			p := t.flexPoints
+			t.b.cubicTo(p[1].X, p[1].Y, p[2].X, p[2].Y, p[3].X, p[3].Y)
+			t.b.cubicTo(p[4].X, p[4].Y, p[5].X, p[5].Y, p[6].X, p[6].Y)
+			t.x, t.y = p[6].X, p[6].Y
+		}
+		t.flex, t.flexPoints = false, nil
+		// The end point is popped back for setcurrentpoint.
+		t.psStack = append(t.psStack, t.y, t.x)
+	case 1: // Start of flex.
+		t.flex, t.flexPoints = true, nil
+	case 2: // Flex point.
+		t.flexPoints = append(t.flexPoints, OutlinePoint{t.x, t.y})
+	default:
+		// Hint replacement and others: the arguments are popped back.
+		for i := len(args) - 1; i >= 0; i-- {
+			t.psStack = append(t.psStack, args[i])
+		}
+	}
+}
+
+// seac composes the accented character of the StandardEncoding characters `bchar` and `achar`,
+// the accent offset by (`adx`, `ady`) with the left side bearing `asb`.
+func (t *type1Interpreter) seac(asb, adx, ady float64, bchar, achar int) error {
+	encoder := textencoding.NewStandardTextEncoder()
+	base, okBase := encoder.CharcodeToGlyph(byte(bchar))
+	accent, okAccent := encoder.CharcodeToGlyph(byte(achar))
+	if !okBase || !okAccent {
+		return errors.New("seac characters not in StandardEncoding")
+	}
+	baseOutline, okBase := t.font.GlyphOutline(base)
+	accentOutline, okAccent := t.font.GlyphOutline(accent)
+	if !okBase || !okAccent {
+		return errors.New("seac glyphs not found")
+	}
+	t.b.closePath()
+	t.b.outline = append(t.b.outline, baseOutline...)
+	t.b.outline = append(t.b.outline, accentOutline.Transform([6]float64{1, 0, 0, 1, adx + t.sbx - asb, ady})...)
+	t.ended = true
+	return nil
+}
+
+func (t *type1Interpreter) moveTo(dx, dy float64) {
+	t.x += dx
+	t.y += dy
+	if !t.flex {
+		t.b.moveTo(t.x, t.y)
+	}
+}
+
+func (t *type1Interpreter) lineTo(dx, dy float64) {
+	t.x += dx
+	t.y += dy
+	t.b.lineTo(t.x, t.y)
+}
+
+func (t *type1Interpreter) curveTo(dx1, dy1, dx2, dy2, dx3, dy3 float64) {
+	x1, y1 := t.x+dx1, t.y+dy1
+	x2, y2 := x1+dx2, y1+dy2
+	t.x, t.y = x2+dx3, y2+dy3
+	t.b.cubicTo(x1, y1, x2, y2, t.x, t.y)
+}
+
+// GlyphOutline returns the outline of the glyph `gid` in glyph space units, interpreting its Type 2
+// charstring.  The components of accented characters (endchar with seac arguments) are included,
+// and the hints ignored.
+func (font *CFFFont) GlyphOutline(gid int) (GlyphOutline, bool) {
+	if gid < 0 || gid >= len(font.charStrings) {
+		return nil, false
+	}
+	t := &type2Interpreter{font: font, private: font.private(gid)}
+	if err := t.run(font.charStrings[gid], 0); err != nil {
+		common.Log.Debug("Unable to interpret charstring of glyph %d: %v", gid, err)
+		return nil, false
+	}
+	t.b.closePath()
+	return t.b.outline, true
+}
+
+// type2Interpreter interprets Type 2 charstrings (Adobe Technical Note #5177) into glyph outlines.
+type type2Interpreter struct {
+	font      *CFFFont
+	private   cffPrivate
+	b         outlineBuilder
+	stack     []float64
+	x, y      float64
+	numStems  int
+	haveWidth bool // The optional width argument of the first stack clearing operator was read.
+	ended     bool
+}
+
+// run interprets `charstring`, a subroutine at `depth` if not zero.
+func (t *type2Interpreter) run(charstring []byte, depth int) error {
+	if depth > maxCharStringSubrDepth {
+		return errors.New("subroutines nested too deeply")
+	}
+	for i := 0; i < len(charstring) && !t.ended; {
+		b0 := int(charstring[i])
+		if b0 == 28 || b0 >= 32 {
+			if b0 == 255 {
+				if i+5 > len(charstring) {
+					return errors.New("truncated charstring")
+				}
+				t.stack = append(t.stack, float64(int32(binary.BigEndian.Uint32(charstring[i+1:])))/65536)
+				i += 5
+				continue
+			}
+			val, n, ok := decodeCFFInteger(charstring[i:])
+			if !ok {
+				return errors.New("invalid charstring number")
+			}
+			t.stack = append(t.stack, float64(val))
+			i += n
+			continue
+		}
+		op := b0
+		i++
+		if op == 12 {
+			if i >= len(charstring) {
+				return errors.New("truncated charstring")
+			}
+			op = 1200 + int(charstring[i])
+			i++
+		}
+
+		clear := true
+		switch op {
+		case 1, 3, 18, 23: // hstem, vstem, hstemhm, vstemhm
+			t.stems()
+		case 19, 20: // hintmask, cntrmask
+			t.stems()
+			i += (t.numStems + 7) / 8
+		case 21: // rmoveto
+			args := t.widthArgs(2)
+			if len(args) < 2 {
+				return errors.New("stack underflow")
+			}
+			t.moveTo(args[0], args[1])
+		case 22: // hmoveto
+			args := t.widthArgs(1)
+			if len(args) < 1 {
+				return errors.New("stack underflow")
+			}
+			t.moveTo(args[0], 0)
+		case 4: // vmoveto
+			args := t.widthArgs(1)
+			if len(args) < 1 {
+				return errors.New("stack underflow")
+			}
+			t.moveTo(0, args[0])
+		case 5: // rlineto
+			for args := t.stack; len(args) >= 2; args = args[2:] {
+				t.lineTo(args[0], args[1])
+			}
+		case 6, 7: // hlineto, vlineto
+			horizontal := op == 6
+			for _, d := range t.stack {
+				if horizontal {
+					t.lineTo(d, 0)
+				} else {
+					t.lineTo(0, d)
+				}
+				horizontal = !horizontal
+			}
+		case 8: // rrcurveto
+			for args := t.stack; len(args) >= 6; args = args[6:] {
+				t.curveTo(args[0], args[1], args[2], args[3], args[4], args[5])
+			}
+		case 24: // rcurveline
+			args := t.stack
+			for ; len(args) >= 8; args = args[6:] {
+				t.curveTo(args[0], args[1], args[2], args[3], args[4], args[5])
+			}
+			if len(args) >= 2 {
+				t.lineTo(args[0], args[1])
+			}
+		case 25: // rlinecurve
+			args := t.stack
+			for ; len(args) >= 8; args = args[2:] {
+				t.lineTo(args[0], args[1])
+			}
+			if len(args) >= 6 {
+				t.curveTo(args[0], args[1], args[2], args[3], args[4], args[5])
+			}
+		case 26: // vvcurveto
+			args := t.stack
+			dx1 := 0.0
+			if len(args)%2 == 1 {
+				dx1, args = args[0], args[1:]
+			}
+			for ; len(args) >= 4; args = args[4:] {
+				t.curveTo(dx1, args[0], args[1], args[2], 0, args[3])
+				dx1 = 0
+			}
+		case 27: // hhcurveto
+			args := t.stack
+			dy1 := 0.0
+			if len(args)%2 == 1 {
+				dy1, args = args[0], args[1:]
+			}
+			for ; len(args) >= 4; args = args[4:] {
+				t.curveTo(args[0], dy1, args[1], args[2], args[3], 0)
+				dy1 = 0
+			}
+		case 30, 31: // vhcurveto, hvcurveto
+			horizontal := op == 31
+			for args := t.stack; len(args) >= 4; args = args[4:] {
+				last := 0.0
+				if len(args) == 5 {
+					last = args[4]
+				}
+				if horizontal {
+					t.curveTo(args[0], 0, args[1], args[2], last, args[3])
+				} else {
+					t.curveTo(0, args[0], args[1], args[2], args[3], last)
+				}
+				horizontal = !horizontal
+			}
+		case 1235: // flex
+			if args := t.stack; len(args) >= 12 {
+				t.curveTo(args[0], args[1], args[2], args[3], args[4], args[5])
+				t.curveTo(args[6], args[7], args[8], args[9], args[10], args[11])
+			}
+		case 1234: // hflex
+			if args := t.stack; len(args) >= 7 {
+				t.curveTo(args[0], 0, args[1], args[2], args[3], 0)
+				t.curveTo(args[4], 0, args[5], -args[2], args[6], 0)
+			}
+		case 1236: // hflex1
+			if args := t.stack; len(args) >= 9 {
+				t.curveTo(args[0], args[1], args[2], args[3], args[4], 0)
+				t.curveTo(args[5], 0, args[6], args[7], args[8], -(args[1] + args[3] + args[7]))
+			}
+		case 1237: // flex1
+			if args := t.stack; len(args) >= 11 {
+				dx := args[0] + args[2] + args[4] + args[6] + args[8]
+				dy := args[1] + args[3] + args[5] + args[7] + args[9]
+				dx6, dy6 := args[10], -dy
+				if math.Abs(dx) <= math.Abs(dy) {
+					dx6, dy6 = -dx, args[10]
+				}
+				t.curveTo(args[0], args[1], args[2], args[3], args[4], args[5])
+				t.curveTo(args[6], args[7], args[8], args[9], dx6, dy6)
+			}
+		case 10, 29: // callsubr, callgsubr
+			if len(t.stack) == 0 {
+				return errors.New("stack underflow")
+			}
+			subrs := t.private.subrs
+			if op == 29 {
+				subrs = t.font.globalSubrs
+			}
+			index := int(t.stack[len(t.stack)-1]) + subrBias(subrs)
+			t.stack = t.stack[:len(t.stack)-1]
+			if index < 0 || index >= len(subrs) {
+				return errors.New("subroutine not found")
+			}
+			if err := t.run(subrs[index], depth+1); err != nil {
+				return err
+			}
+			clear = false
+		case 11: // return
+			return nil
+		case 14: // endchar
+			args := t.widthArgs(4)
+			if len(args) == 4 {
+				return t.seac(args[0], args[1], int(args[2]), int(args[3]))
+			}
+			t.b.closePath()
+			t.ended = true
+		default:
+			clear = t.arithmetic(op)
+		}
+		if clear {
+			t.stack = t.stack[:0]
+		}
+	}
+	return nil
+}
+
+// arithmetic runs the arithmetic and storage operator `op`, returning false if it is one.  The
+// operators of the transient array and random are not supported.
+func (t *type2Interpreter) arithmetic(op int) bool {
+	n := len(t.stack)
+	switch {
+	case op == 1209 && n >= 1: // abs
+		t.stack[n-1] = math.Abs(t.stack[n-1])
+	case op == 1210 && n >= 2: // add
+		t.stack = append(t.stack[:n-2], t.stack[n-2]+t.stack[n-1])
+	case op == 1211 && n >= 2: // sub
+		t.stack = append(t.stack[:n-2], t.stack[n-2]-t.stack[n-1])
+	case op == 1212 && n >= 2: // div
+		t.stack = append(t.stack[:n-2], t.stack[n-2]/t.stack[n-1])
+	case op == 1214 && n >= 1: // neg
+		t.stack[n-1] = -t.stack[n-1]
+	case op == 1218 && n >= 1: // drop
+		t.stack = t.stack[:n-1]
+	case op == 1224 && n >= 2: // mul
+		t.stack = append(t.stack[:n-2], t.stack[n-2]*t.stack[n-1])
+	case op == 1226 && n >= 1: // sqrt
+		t.stack[n-1] = math.Sqrt(t.stack[n-1])
+	case op == 1227 && n >= 1: // dup
+		t.stack = append(t.stack, t.stack[n-1])
+	case op == 1228 && n >= 2: // exch
+		t.stack[n-2], t.stack[n-1] = t.stack[n-1], t.stack[n-2]
+	default:
+		common.Log.Trace("Unsupported Type 2 charstring operator %d", op)
+		return true
+	}
+	return false
+}
+
+// stems counts the stem hints of the arguments of a stem hint operator.
+func (t *type2Interpreter) stems() {
+	args := t.stack
+	if !t.haveWidth && len(args)%2 == 1 {
+		args = args[1:]
+	}
+	t.haveWidth = true
+	t.numStems += len(args) / 2
+}
+
+// widthArgs returns the arguments of a stack clearing operator taking `n` arguments, without the
+// width given by an extra first argument of the first one.
+func (t *type2Interpreter) widthArgs(n int) []float64 {
+	args := t.stack
+	if !t.haveWidth && len(args) > n {
+		args = args[1:]
+	}
+	t.haveWidth = true
+	return args
+}
+
+// seac composes the accented character of the StandardEncoding characters `bchar` and `achar`,
+// the accent offset by (`adx`, `ady`).
+func (t *type2Interpreter) seac(adx, ady float64, bchar, achar int) error {
+	encoder := textencoding.NewStandardTextEncoder()
+	base, okBase := encoder.CharcodeToGlyph(byte(bchar))
+	accent, okAccent := encoder.CharcodeToGlyph(byte(achar))
+	if !okBase || !okAccent {
+		return errors.New("seac characters not in StandardEncoding")
+	}
+	baseGID, okBase := t.font.GlyphID(base)
+	accentGID, okAccent := t.font.GlyphID(accent)
+	if !okBase || !okAccent {
+		return errors.New("seac glyphs not found")
+	}
+	baseOutline, okBase := t.font.GlyphOutline(baseGID)
+	accentOutline, okAccent := t.font.GlyphOutline(accentGID)
+	if !okBase || !okAccent {
+		return errors.New("seac glyphs not interpreted")
+	}
+	t.b.closePath()
+	t.b.outline = append(t.b.outline, baseOutline...)
+	t.b.outline = append(t.b.outline, accentOutline.Transform([6]float64{1, 0, 0, 1, adx, ady})...)
+	t.ended = true
+	return nil
+}
+
+func (t *type2Interpreter) moveTo(dx, dy float64) {
+	t.x += dx
+	t.y += dy
+	t.b.moveTo(t.x, t.y)
+}
+
+func (t *type2Interpreter) lineTo(dx, dy float64) {
+	t.x += dx
+	t.y += dy
+	t.b.lineTo(t.x, t.y)
+}
+
+func (t *type2Interpreter) curveTo(dx1, dy1, dx2, dy2, dx3, dy3 float64) {
+	x1, y1 := t.x+dx1, t.y+dy1
+	x2, y2 := x1+dx2, y1+dy2
+	t.x, t.y = x2+dx3, y2+dy3
+	t.b.cubicTo(x1, y1, x2, y2, t.x, t.y)
+}
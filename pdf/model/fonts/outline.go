@@ -0,0 +1,96 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+// OutlineOp is the operation of a segment of a glyph outline.
+type OutlineOp int
+
+// Operations of the segments of glyph outlines.
+const (
+	OutlineMoveTo  OutlineOp = iota // Starts a contour at Points[0].
+	OutlineLineTo                   // Line to Points[0].
+	OutlineQuadTo                   // Quadratic Bézier curve through the control point Points[0] to Points[1].
+	OutlineCubicTo                  // Cubic Bézier curve through the control points Points[0] and Points[1] to Points[2].
+	OutlineClose                    // Closes the contour.
+)
+
+// OutlinePoint is a point of a glyph outline.
+type OutlinePoint struct {
+	X, Y float64
+}
+
+// OutlineSegment is a segment of a glyph outline, with the points used by its operation.
+type OutlineSegment struct {
+	Op     OutlineOp
+	Points [3]OutlinePoint
+}
+
+// GlyphOutline is the outline of a glyph, a sequence of closed contours to be filled with the
+// nonzero winding number rule, in the glyph space of the font program.
+type GlyphOutline []OutlineSegment
+
+// Transform returns the outline transformed by the matrix [a b c d e f], which maps (x, y) to
+// (a*x + c*y + e, b*x + d*y + f).
+func (outline GlyphOutline) Transform(m [6]float64) GlyphOutline {
+	transformed := make(GlyphOutline, len(outline))
+	for i, seg := range outline {
+		transformed[i].Op = seg.Op
+		for j, p := range seg.Points {
+			transformed[i].Points[j] = OutlinePoint{
+				X: m[0]*p.X + m[2]*p.Y + m[4],
+				Y: m[1]*p.X + m[3]*p.Y + m[5],
+			}
+		}
+	}
+	return transformed
+}
+
+// outlineBuilder builds glyph outlines from the path construction operators of charstrings,
+// closing the open contour when a new one starts and starting a contour at the current point when
+// drawing after a closepath.
+type outlineBuilder struct {
+	outline GlyphOutline
+	open    bool
+	x, y    float64 // Current point.
+}
+
+func (b *outlineBuilder) moveTo(x, y float64) {
+	b.closePath()
+	b.outline = append(b.outline, OutlineSegment{Op: OutlineMoveTo, Points: [3]OutlinePoint{{x, y}}})
+	b.open = true
+	b.x, b.y = x, y
+}
+
+func (b *outlineBuilder) lineTo(x, y float64) {
+	b.ensureOpen()
+	b.outline = append(b.outline, OutlineSegment{Op: OutlineLineTo, Points: [3]OutlinePoint{{x, y}}})
+	b.x, b.y = x, y
+}
+
+func (b *outlineBuilder) quadTo(x1, y1, x, y float64) {
+	b.ensureOpen()
+	b.outline = append(b.outline, OutlineSegment{Op: OutlineQuadTo, Points: [3]OutlinePoint{{x1, y1}, {x, y}}})
+	b.x, b.y = x, y
+}
+
+func (b *outlineBuilder) cubicTo(x1, y1, x2, y2, x, y float64) {
+	b.ensureOpen()
+	b.outline = append(b.outline, OutlineSegment{Op: OutlineCubicTo, Points: [3]OutlinePoint{{x1, y1}, {x2, y2}, {x, y}}})
+	b.x, b.y = x, y
+}
+
+func (b *outlineBuilder) closePath() {
+	if b.open {
+		b.outline = append(b.outline, OutlineSegment{Op: OutlineClose})
+		b.open = false
+	}
+}
+
+func (b *outlineBuilder) ensureOpen() {
+	if !b.open {
+		b.moveTo(b.x, b.y)
+	}
+}
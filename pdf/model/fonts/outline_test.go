@@ -0,0 +1,126 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+const testRobotoTTFFile = "../../../testfiles/roboto/Roboto-Regular.ttf"
+
+// equalOutlines returns true if the outlines `a` and `b` have the same segments.
+func equalOutlines(a, b GlyphOutline) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestType1GlyphOutline(t *testing.T) {
+	font := &Type1Font{
+		charStrings: map[string][]byte{
+			// 0 600 hsbw 100 0 rmoveto 400 0 rlineto 0 callsubr closepath endchar
+			"A": {139, 248, 236, 13, 239, 139, 21, 248, 36, 139, 5, 139, 10, 9, 14},
+		},
+		subrs: [][]byte{{139, 239, 5, 11}}, // 0 100 rlineto return
+	}
+
+	outline, ok := font.GlyphOutline("A")
+	if !ok {
+		t.Fatalf("Outline of A not found")
+	}
+	expected := GlyphOutline{
+		{Op: OutlineMoveTo, Points: [3]OutlinePoint{{100, 0}}},
+		{Op: OutlineLineTo, Points: [3]OutlinePoint{{500, 0}}},
+		{Op: OutlineLineTo, Points: [3]OutlinePoint{{500, 100}}},
+		{Op: OutlineClose},
+	}
+	if !equalOutlines(outline, expected) {
+		t.Errorf("Incorrect outline %v", outline)
+	}
+
+	if _, ok := font.GlyphOutline("B"); ok {
+		t.Errorf("Outline of missing glyph found")
+	}
+}
+
+func TestCFFGlyphOutline(t *testing.T) {
+	font := &CFFFont{
+		charStrings: [][]byte{
+			// 0 10 hstem hintmask 100 200 rmoveto 300 100 hlineto endchar
+			{139, 149, 1, 19, 0xFF, 239, 247, 92, 21, 247, 192, 239, 6, 14},
+		},
+		privates: []cffPrivate{{}},
+	}
+
+	outline, ok := font.GlyphOutline(0)
+	if !ok {
+		t.Fatalf("Outline of glyph 0 not found")
+	}
+	expected := GlyphOutline{
+		{Op: OutlineMoveTo, Points: [3]OutlinePoint{{100, 200}}},
+		{Op: OutlineLineTo, Points: [3]OutlinePoint{{400, 200}}},
+		{Op: OutlineLineTo, Points: [3]OutlinePoint{{400, 300}}},
+		{Op: OutlineClose},
+	}
+	if !equalOutlines(outline, expected) {
+		t.Errorf("Incorrect outline %v", outline)
+	}
+}
+
+func TestTtfGlyphOutline(t *testing.T) {
+	data, err := ioutil.ReadFile(testRobotoTTFFile)
+	if err != nil {
+		t.Fatalf("Error reading font: %v", err)
+	}
+	glyphs, err := NewTtfGlyphsFromBytes(data)
+	if err != nil {
+		t.Fatalf("Error loading glyphs: %v", err)
+	}
+	if glyphs.UnitsPerEm() != 2048 {
+		t.Errorf("Incorrect units per em %d", glyphs.UnitsPerEm())
+	}
+	chars, ok := glyphs.CmapSubtable(3, 1)
+	if !ok {
+		t.Fatalf("No (3,1) cmap subtable")
+	}
+
+	// Number of contours of simple and composite glyphs.
+	for r, contours := range map[rune]int{' ': 0, 'o': 2, 'B': 3, 'i': 2, 'Á': 3} {
+		outline, ok := glyphs.GlyphOutline(int(chars[uint16(r)]))
+		if !ok {
+			t.Errorf("Outline of %q not found", r)
+			continue
+		}
+		moves, closes := 0, 0
+		for _, seg := range outline {
+			switch seg.Op {
+			case OutlineMoveTo:
+				moves++
+			case OutlineClose:
+				closes++
+			}
+			for _, p := range seg.Points {
+				if p.X < -2048 || p.X > 2048 || p.Y < -2048 || p.Y > 2048 {
+					t.Errorf("Point of %q out of em: %v", r, p)
+				}
+			}
+		}
+		if moves != contours || closes != contours {
+			t.Errorf("Incorrect contours of %q: %d moves, %d closes, expected %d", r, moves, closes, contours)
+		}
+	}
+
+	if _, ok := glyphs.GlyphOutline(glyphs.NumGlyphs()); ok {
+		t.Errorf("Outline of glyph out of range found")
+	}
+}
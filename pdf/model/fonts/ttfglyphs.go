@@ -0,0 +1,359 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Flags of the points of simple glyphs and of the components of composite glyphs.
+const (
+	ttfOnCurvePoint          = 0x01
+	ttfXShortVector          = 0x02
+	ttfYShortVector          = 0x04
+	ttfRepeatFlag            = 0x08
+	ttfXIsSameOrPositive     = 0x10
+	ttfYIsSameOrPositive     = 0x20
+	ttfArgsAreXYValues       = 0x0002
+	ttfMaxCompositeNestLevel = 8
+)
+
+// TtfGlyphs gives the glyph outlines of a TrueType font program, such as embedded in FontFile2
+// streams, selected by glyph ID.
+type TtfGlyphs struct {
+	tables     map[string][]byte
+	glyphs     [][]byte
+	unitsPerEm int
+}
+
+// NewTtfGlyphsFromBytes loads the glyphs of the TrueType font program `data`.  Only the glyf,
+// head, loca and maxp tables are required.
+func NewTtfGlyphsFromBytes(data []byte) (*TtfGlyphs, error) {
+	tables, err := readTtfTables(data)
+	if err != nil {
+		return nil, err
+	}
+	glyphs, err := ttfSplitGlyphs(tables)
+	if err != nil {
+		return nil, err
+	}
+	unitsPerEm := int(binary.BigEndian.Uint16(tables["head"][18:]))
+	if unitsPerEm == 0 {
+		unitsPerEm = 1000
+	}
+	return &TtfGlyphs{tables: tables, glyphs: glyphs, unitsPerEm: unitsPerEm}, nil
+}
+
+// UnitsPerEm returns the number of glyph space units by em.
+func (t *TtfGlyphs) UnitsPerEm() int {
+	return t.unitsPerEm
+}
+
+// NumGlyphs returns the number of glyphs of the font.
+func (t *TtfGlyphs) NumGlyphs() int {
+	return len(t.glyphs)
+}
+
+// GlyphOutline returns the outline of the glyph `gid` in glyph space units.  Glyphs without
+// contours, such as spaces, have an empty outline.
+func (t *TtfGlyphs) GlyphOutline(gid int) (GlyphOutline, bool) {
+	if gid < 0 || gid >= len(t.glyphs) {
+		return nil, false
+	}
+	b := &outlineBuilder{}
+	if err := t.appendGlyph(b, gid, [6]float64{1, 0, 0, 1, 0, 0}, 0); err != nil {
+		return nil, false
+	}
+	return b.outline, true
+}
+
+// CmapSubtable returns the glyph IDs by character code of the format 0, 4 or 6 cmap subtable for
+// the platform `platformID` and encoding `encodingID`, or false if the font has none.
+func (t *TtfGlyphs) CmapSubtable(platformID, encodingID int) (map[uint16]uint16, bool) {
+	cmap := t.tables["cmap"]
+	if len(cmap) < 4 {
+		return nil, false
+	}
+	numTables := int(binary.BigEndian.Uint16(cmap[2:]))
+	for i := 0; i < numTables && 4+8*i+8 <= len(cmap); i++ {
+		record := cmap[4+8*i:]
+		if int(binary.BigEndian.Uint16(record)) != platformID || int(binary.BigEndian.Uint16(record[2:])) != encodingID {
+			continue
+		}
+		offset := int(binary.BigEndian.Uint32(record[4:]))
+		if offset+2 > len(cmap) {
+			return nil, false
+		}
+		var chars map[uint16]uint16
+		var err error
+		switch binary.BigEndian.Uint16(cmap[offset:]) {
+		case 0:
+			chars, err = ttfParseCmapFormat0(cmap, offset)
+		case 4:
+			chars, err = ttfParseCmapFormat4(cmap, offset)
+		case 6:
+			chars, err = ttfParseCmapFormat6(cmap, offset)
+		default:
+			continue
+		}
+		if err == nil {
+			return chars, true
+		}
+	}
+	return nil, false
+}
+
+// ttfParseCmapFormat0 returns the glyph IDs by character code of the format 0 subtable at
+// `offset` in `cmap`.
+func ttfParseCmapFormat0(cmap []byte, offset int) (map[uint16]uint16, error) {
+	if offset+6+256 > len(cmap) {
+		return nil, errors.New("truncated format 0 subtable")
+	}
+	chars := map[uint16]uint16{}
+	for code, gid := range cmap[offset+6 : offset+6+256] {
+		if gid != 0 {
+			chars[uint16(code)] = uint16(gid)
+		}
+	}
+	return chars, nil
+}
+
+// ttfParseCmapFormat6 returns the glyph IDs by character code of the format 6 subtable at
+// `offset` in `cmap`.
+func ttfParseCmapFormat6(cmap []byte, offset int) (map[uint16]uint16, error) {
+	if offset+10 > len(cmap) {
+		return nil, errors.New("truncated format 6 subtable")
+	}
+	firstCode := int(binary.BigEndian.Uint16(cmap[offset+6:]))
+	entryCount := int(binary.BigEndian.Uint16(cmap[offset+8:]))
+	if offset+10+2*entryCount > len(cmap) {
+		return nil, errors.New("truncated format 6 subtable")
+	}
+	chars := map[uint16]uint16{}
+	for i := 0; i < entryCount; i++ {
+		if gid := binary.BigEndian.Uint16(cmap[offset+10+2*i:]); gid != 0 {
+			chars[uint16(firstCode+i)] = gid
+		}
+	}
+	return chars, nil
+}
+
+// appendGlyph appends the contours of the glyph `gid` transformed by `m` to `b`, nesting the
+// components of composite glyphs up to ttfMaxCompositeNestLevel levels.
+func (t *TtfGlyphs) appendGlyph(b *outlineBuilder, gid int, m [6]float64, depth int) error {
+	if gid < 0 || gid >= len(t.glyphs) {
+		return errors.New("glyph out of range")
+	}
+	glyph := t.glyphs[gid]
+	if len(glyph) == 0 {
+		return nil // No contours.
+	}
+	if len(glyph) < 10 {
+		return errors.New("truncated glyph")
+	}
+	numberOfContours := int(int16(binary.BigEndian.Uint16(glyph)))
+	if numberOfContours >= 0 {
+		return ttfAppendSimpleGlyph(b, glyph, numberOfContours, m)
+	}
+	if depth >= ttfMaxCompositeNestLevel {
+		return errors.New("composite glyphs nested too deeply")
+	}
+
+	offset := 10
+	for offset+4 <= len(glyph) {
+		flags := binary.BigEndian.Uint16(glyph[offset:])
+		component := int(binary.BigEndian.Uint16(glyph[offset+2:]))
+		offset += 4
+
+		var dx, dy float64
+		if flags&ttfArg1And2AreWords != 0 {
+			if offset+4 > len(glyph) {
+				return errors.New("truncated composite glyph")
+			}
+			dx = float64(int16(binary.BigEndian.Uint16(glyph[offset:])))
+			dy = float64(int16(binary.BigEndian.Uint16(glyph[offset+2:])))
+			offset += 4
+		} else {
+			if offset+2 > len(glyph) {
+				return errors.New("truncated composite glyph")
+			}
+			dx, dy = float64(int8(glyph[offset])), float64(int8(glyph[offset+1]))
+			offset += 2
+		}
+		if flags&ttfArgsAreXYValues == 0 {
+			// Components positioned by matching points are not supported.
+			dx, dy = 0, 0
+		}
+
+		scale := [4]float64{1, 0, 0, 1}
+		f2dot14 := func(i int) float64 {
+			return float64(int16(binary.BigEndian.Uint16(glyph[offset+2*i:]))) / 16384
+		}
+		switch {
+		case flags&ttfWeHaveAScale != 0 && offset+2 <= len(glyph):
+			scale[0], scale[3] = f2dot14(0), f2dot14(0)
+			offset += 2
+		case flags&ttfWeHaveAnXAndYScale != 0 && offset+4 <= len(glyph):
+			scale[0], scale[3] = f2dot14(0), f2dot14(1)
+			offset += 4
+		case flags&ttfWeHaveATwoByTwo != 0 && offset+8 <= len(glyph):
+			scale = [4]float64{f2dot14(0), f2dot14(1), f2dot14(2), f2dot14(3)}
+			offset += 8
+		}
+
+		// The component transformation followed by `m`.
+		cm := [6]float64{
+			scale[0]*m[0] + scale[1]*m[2],
+			scale[0]*m[1] + scale[1]*m[3],
+			scale[2]*m[0] + scale[3]*m[2],
+			scale[2]*m[1] + scale[3]*m[3],
+			dx*m[0] + dy*m[2] + m[4],
+			dx*m[1] + dy*m[3] + m[5],
+		}
+		if err := t.appendGlyph(b, component, cm, depth+1); err != nil {
+			return err
+		}
+		if flags&ttfMoreComponents == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+// ttfAppendSimpleGlyph appends the contours of the simple glyph `glyph` with `numberOfContours`
+// contours transformed by `m` to `b`.
+func ttfAppendSimpleGlyph(b *outlineBuilder, glyph []byte, numberOfContours int, m [6]float64) error {
+	offset := 10
+	if offset+2*numberOfContours+2 > len(glyph) {
+		return errors.New("truncated glyph")
+	}
+	endPts := make([]int, numberOfContours)
+	numPoints := 0
+	for i := range endPts {
+		endPts[i] = int(binary.BigEndian.Uint16(glyph[offset+2*i:]))
+		if endPts[i] < numPoints {
+			return errors.New("invalid contour end points")
+		}
+		numPoints = endPts[i] + 1
+	}
+	offset += 2 * numberOfContours
+	offset += 2 + int(binary.BigEndian.Uint16(glyph[offset:])) // Instructions.
+
+	flags := make([]byte, 0, numPoints)
+	for len(flags) < numPoints {
+		if offset >= len(glyph) {
+			return errors.New("truncated glyph flags")
+		}
+		flag := glyph[offset]
+		offset++
+		flags = append(flags, flag)
+		if flag&ttfRepeatFlag != 0 {
+			if offset >= len(glyph) {
+				return errors.New("truncated glyph flags")
+			}
+			for n := int(glyph[offset]); n > 0 && len(flags) < numPoints; n-- {
+				flags = append(flags, flag)
+			}
+			offset++
+		}
+	}
+
+	// The coordinates are deltas from the previous point, short or long.
+	readCoords := func(short, sameOrPositive byte) ([]float64, error) {
+		coords := make([]float64, numPoints)
+		v := 0
+		for i, flag := range flags {
+			switch {
+			case flag&short != 0:
+				if offset >= len(glyph) {
+					return nil, errors.New("truncated glyph coordinates")
+				}
+				if flag&sameOrPositive != 0 {
+					v += int(glyph[offset])
+				} else {
+					v -= int(glyph[offset])
+				}
+				offset++
+			case flag&sameOrPositive == 0:
+				if offset+2 > len(glyph) {
+					return nil, errors.New("truncated glyph coordinates")
+				}
+				v += int(int16(binary.BigEndian.Uint16(glyph[offset:])))
+				offset += 2
+			}
+			coords[i] = float64(v)
+		}
+		return coords, nil
+	}
+	xs, err := readCoords(ttfXShortVector, ttfXIsSameOrPositive)
+	if err != nil {
+		return err
+	}
+	ys, err := readCoords(ttfYShortVector, ttfYIsSameOrPositive)
+	if err != nil {
+		return err
+	}
+
+	type point struct {
+		x, y    float64
+		onCurve bool
+	}
+	start := 0
+	for _, end := range endPts {
+		var contour []point
+		for i := start; i <= end; i++ {
+			x, y := xs[i], ys[i]
+			contour = append(contour, point{
+				x:       m[0]*x + m[2]*y + m[4],
+				y:       m[1]*x + m[3]*y + m[5],
+				onCurve: flags[i]&ttfOnCurvePoint != 0,
+			})
+		}
+		start = end + 1
+		if len(contour) == 0 {
+			continue
+		}
+
+		// Start at an on curve point, the midpoint of the first and last points if both are off
+		// curve, and end back at it.
+		n := len(contour)
+		var p0 point
+		rest := contour
+		switch {
+		case contour[0].onCurve:
+			p0, rest = contour[0], contour[1:]
+		case contour[n-1].onCurve:
+			p0, rest = contour[n-1], contour[:n-1]
+		default:
+			p0 = point{x: (contour[0].x + contour[n-1].x) / 2, y: (contour[0].y + contour[n-1].y) / 2, onCurve: true}
+		}
+		b.moveTo(p0.x, p0.y)
+
+		var control *point
+		for i := 0; i <= len(rest); i++ {
+			p := p0
+			if i < len(rest) {
+				p = rest[i]
+			}
+			switch {
+			case p.onCurve && control == nil:
+				b.lineTo(p.x, p.y)
+			case p.onCurve:
+				b.quadTo(control.x, control.y, p.x, p.y)
+				control = nil
+			case control == nil:
+				control = &rest[i]
+			default:
+				// Consecutive off curve points have an implicit on curve point between them.
+				b.quadTo(control.x, control.y, (control.x+p.x)/2, (control.y+p.y)/2)
+				control = &rest[i]
+			}
+		}
+		b.closePath()
+	}
+	return nil
+}
@@ -23,8 +23,10 @@ package fonts
 // Port to Go: Kurt Jung, 2013-07-15
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
 	"strings"
@@ -50,7 +52,7 @@ type TtfType struct {
 
 type ttfParser struct {
 	rec              TtfType
-	f                *os.File
+	f                io.ReadSeeker
 	tables           map[string]uint32
 	numberOfHMetrics uint16
 	numGlyphs        uint16
@@ -58,11 +60,22 @@ type ttfParser struct {
 
 // TtfParse extracts various metrics from a TrueType font file.
 func TtfParse(fileStr string) (TtfRec TtfType, err error) {
-	var t ttfParser
-	t.f, err = os.Open(fileStr)
+	f, err := os.Open(fileStr)
 	if err != nil {
 		return
 	}
+	defer f.Close()
+	return ttfParse(f)
+}
+
+// TtfParseBytes extracts various metrics from the TrueType font program `data`.
+func TtfParseBytes(data []byte) (TtfRec TtfType, err error) {
+	return ttfParse(bytes.NewReader(data))
+}
+
+func ttfParse(f io.ReadSeeker) (TtfRec TtfType, err error) {
+	var t ttfParser
+	t.f = f
 	version, err := t.ReadStr(4)
 	if err != nil {
 		return
@@ -93,7 +106,6 @@ func TtfParse(fileStr string) (TtfRec TtfType, err error) {
 	if err != nil {
 		return
 	}
-	t.f.Close()
 	TtfRec = t.rec
 	return
 }
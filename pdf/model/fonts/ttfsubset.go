@@ -0,0 +1,467 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Tables copied unchanged into font subsets.  Tables indexed by glyph ID which are not rewritten,
+// and tables not used for rendering by PDF consumers (kern, GSUB, GPOS, ...) are dropped.
+var ttfSubsetCopiedTables = []string{"OS/2", "cvt ", "fpgm", "gasp", "name", "prep"}
+
+// Flags of the components of composite glyphs.
+const (
+	ttfArg1And2AreWords   = 0x0001
+	ttfWeHaveAScale       = 0x0008
+	ttfMoreComponents     = 0x0020
+	ttfWeHaveAnXAndYScale = 0x0040
+	ttfWeHaveATwoByTwo    = 0x0080
+)
+
+// ttfFont is a TrueType font program split into its tables.
+type ttfFont struct {
+	tables           map[string][]byte
+	numGlyphs        int
+	numberOfHMetrics int
+	glyphs           [][]byte
+}
+
+// TtfSubset returns the subset of the TrueType font program `data` with the glyphs `gids`, the
+// glyphs they are composed of and the .notdef glyph, renumbered in the order of their glyph IDs.
+// The glyf, loca, hmtx and cmap tables are rewritten for the subset, and the glyph names of the
+// post table are dropped.  The new glyph IDs are returned by original glyph ID.
+func TtfSubset(data []byte, gids []uint16) ([]byte, map[uint16]uint16, error) {
+	font, err := parseTtfFont(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Collect the glyphs with the components of composite glyphs.
+	keep := map[uint16]bool{0: true}
+	queue := append([]uint16{}, gids...)
+	for len(queue) > 0 {
+		gid := queue[0]
+		queue = queue[1:]
+		if keep[gid] || int(gid) >= font.numGlyphs {
+			continue
+		}
+		keep[gid] = true
+		queue = append(queue, ttfGlyphComponents(font.glyphs[gid])...)
+	}
+	oldGIDs := make([]int, 0, len(keep))
+	for gid := range keep {
+		oldGIDs = append(oldGIDs, int(gid))
+	}
+	sort.Ints(oldGIDs)
+	newGIDs := map[uint16]uint16{}
+	for i, gid := range oldGIDs {
+		newGIDs[uint16(gid)] = uint16(i)
+	}
+
+	tables := map[string][]byte{}
+	for _, tag := range ttfSubsetCopiedTables {
+		if table, has := font.tables[tag]; has {
+			tables[tag] = table
+		}
+	}
+
+	// Glyph outlines, with long offsets.
+	var glyf bytes.Buffer
+	loca := make([]byte, 4*(len(oldGIDs)+1))
+	for i, gid := range oldGIDs {
+		binary.BigEndian.PutUint32(loca[4*i:], uint32(glyf.Len()))
+		glyph := ttfRenumberComponents(font.glyphs[gid], newGIDs)
+		glyf.Write(glyph)
+		for glyf.Len()%4 != 0 {
+			glyf.WriteByte(0)
+		}
+	}
+	binary.BigEndian.PutUint32(loca[4*len(oldGIDs):], uint32(glyf.Len()))
+	tables["glyf"] = glyf.Bytes()
+	tables["loca"] = loca
+
+	// Horizontal metrics, all given as long metrics.
+	hmtx := font.tables["hmtx"]
+	newHmtx := make([]byte, 4*len(oldGIDs))
+	for i, gid := range oldGIDs {
+		metric := gid
+		if metric >= font.numberOfHMetrics {
+			metric = font.numberOfHMetrics - 1
+		}
+		copy(newHmtx[4*i:4*i+2], hmtx[4*metric:])
+		if gid < font.numberOfHMetrics {
+			copy(newHmtx[4*i+2:4*i+4], hmtx[4*gid+2:])
+		} else {
+			offset := 4*font.numberOfHMetrics + 2*(gid-font.numberOfHMetrics)
+			if offset+2 <= len(hmtx) {
+				copy(newHmtx[4*i+2:4*i+4], hmtx[offset:])
+			}
+		}
+	}
+	tables["hmtx"] = newHmtx
+
+	hhea := append([]byte{}, font.tables["hhea"]...)
+	binary.BigEndian.PutUint16(hhea[34:], uint16(len(oldGIDs)))
+	tables["hhea"] = hhea
+
+	maxp := append([]byte{}, font.tables["maxp"]...)
+	binary.BigEndian.PutUint16(maxp[4:], uint16(len(oldGIDs)))
+	tables["maxp"] = maxp
+
+	head := append([]byte{}, font.tables["head"]...)
+	binary.BigEndian.PutUint32(head[8:], 0)  // checkSumAdjustment
+	binary.BigEndian.PutUint16(head[50:], 1) // indexToLocFormat
+	tables["head"] = head
+
+	if post, has := font.tables["post"]; has && len(post) >= 32 {
+		post = append([]byte{}, post[:32]...)
+		binary.BigEndian.PutUint32(post, 0x00030000)
+		tables["post"] = post
+	}
+
+	cmap, err := ttfSubsetCmap(font.tables["cmap"], newGIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	tables["cmap"] = cmap
+
+	return ttfAssemble(tables), newGIDs, nil
+}
+
+// parseTtfFont splits the TrueType font program `data` into its tables and glyphs.
+func parseTtfFont(data []byte) (*ttfFont, error) {
+	tables, err := readTtfTables(data)
+	if err != nil {
+		return nil, err
+	}
+	font := &ttfFont{tables: tables}
+
+	for _, tag := range []string{"cmap", "glyf", "head", "hhea", "hmtx", "loca", "maxp"} {
+		if _, has := font.tables[tag]; !has {
+			return nil, fmt.Errorf("table not found: %s", tag)
+		}
+	}
+	hhea := font.tables["hhea"]
+	if len(hhea) < 36 {
+		return nil, errors.New("truncated font tables")
+	}
+	font.numberOfHMetrics = int(binary.BigEndian.Uint16(hhea[34:]))
+	if font.numberOfHMetrics == 0 || len(font.tables["hmtx"]) < 4*font.numberOfHMetrics {
+		return nil, errors.New("invalid hmtx table")
+	}
+
+	font.glyphs, err = ttfSplitGlyphs(tables)
+	if err != nil {
+		return nil, err
+	}
+	font.numGlyphs = len(font.glyphs)
+	return font, nil
+}
+
+// readTtfTables returns the tables of the TrueType font program `data` by tag.
+func readTtfTables(data []byte) (map[string][]byte, error) {
+	if len(data) < 12 {
+		return nil, errors.New("font program too short")
+	}
+	if version := binary.BigEndian.Uint32(data); version != 0x00010000 && version != 0x74727565 {
+		return nil, fmt.Errorf("unsupported font program version 0x%08x", version)
+	}
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	if len(data) < 12+16*numTables {
+		return nil, errors.New("font program too short")
+	}
+
+	tables := map[string][]byte{}
+	for i := 0; i < numTables; i++ {
+		record := data[12+16*i:]
+		tag := string(record[:4])
+		offset := int(binary.BigEndian.Uint32(record[8:]))
+		length := int(binary.BigEndian.Uint32(record[12:]))
+		if offset < 0 || length < 0 || offset+length > len(data) {
+			return nil, fmt.Errorf("table %s out of range", tag)
+		}
+		tables[tag] = data[offset : offset+length]
+	}
+	return tables, nil
+}
+
+// ttfSplitGlyphs returns the glyph descriptions of the glyf table of `tables` by glyph ID.
+func ttfSplitGlyphs(tables map[string][]byte) ([][]byte, error) {
+	for _, tag := range []string{"glyf", "head", "loca", "maxp"} {
+		if _, has := tables[tag]; !has {
+			return nil, fmt.Errorf("table not found: %s", tag)
+		}
+	}
+	head, maxp := tables["head"], tables["maxp"]
+	if len(head) < 54 || len(maxp) < 6 {
+		return nil, errors.New("truncated font tables")
+	}
+	numGlyphs := int(binary.BigEndian.Uint16(maxp[4:]))
+
+	loca, glyf := tables["loca"], tables["glyf"]
+	longOffsets := binary.BigEndian.Uint16(head[50:]) != 0
+	offsets := make([]int, numGlyphs+1)
+	for i := range offsets {
+		if longOffsets {
+			if 4*i+4 > len(loca) {
+				return nil, errors.New("truncated loca table")
+			}
+			offsets[i] = int(binary.BigEndian.Uint32(loca[4*i:]))
+		} else {
+			if 2*i+2 > len(loca) {
+				return nil, errors.New("truncated loca table")
+			}
+			offsets[i] = 2 * int(binary.BigEndian.Uint16(loca[2*i:]))
+		}
+	}
+	glyphs := make([][]byte, numGlyphs)
+	for i := range glyphs {
+		start, end := offsets[i], offsets[i+1]
+		if start > end || end > len(glyf) {
+			return nil, fmt.Errorf("glyph %d out of range", i)
+		}
+		glyphs[i] = glyf[start:end]
+	}
+	return glyphs, nil
+}
+
+// ttfGlyphComponents returns the glyph IDs of the components of the composite glyph `glyph`, or
+// nil for simple glyphs.
+func ttfGlyphComponents(glyph []byte) []uint16 {
+	var gids []uint16
+	ttfForEachComponent(glyph, func(offset int) {
+		gids = append(gids, binary.BigEndian.Uint16(glyph[offset:]))
+	})
+	return gids
+}
+
+// ttfRenumberComponents returns `glyph` with the glyph IDs of its components replaced by their
+// new glyph IDs `newGIDs`.
+func ttfRenumberComponents(glyph []byte, newGIDs map[uint16]uint16) []byte {
+	if len(ttfGlyphComponents(glyph)) == 0 {
+		return glyph
+	}
+	glyph = append([]byte{}, glyph...)
+	ttfForEachComponent(glyph, func(offset int) {
+		gid := binary.BigEndian.Uint16(glyph[offset:])
+		binary.BigEndian.PutUint16(glyph[offset:], newGIDs[gid])
+	})
+	return glyph
+}
+
+// ttfForEachComponent calls `f` with the offsets of the glyph IDs of the components of the
+// composite glyph `glyph`.
+func ttfForEachComponent(glyph []byte, f func(offset int)) {
+	if len(glyph) < 10 || int16(binary.BigEndian.Uint16(glyph)) >= 0 {
+		return
+	}
+	offset := 10
+	for offset+4 <= len(glyph) {
+		flags := binary.BigEndian.Uint16(glyph[offset:])
+		f(offset + 2)
+		offset += 4
+		if flags&ttfArg1And2AreWords != 0 {
+			offset += 4
+		} else {
+			offset += 2
+		}
+		switch {
+		case flags&ttfWeHaveAScale != 0:
+			offset += 2
+		case flags&ttfWeHaveAnXAndYScale != 0:
+			offset += 4
+		case flags&ttfWeHaveATwoByTwo != 0:
+			offset += 8
+		}
+		if flags&ttfMoreComponents == 0 {
+			break
+		}
+	}
+}
+
+// ttfSubsetCmap returns a cmap table with a single format 4 subtable mapping the characters of the
+// (3,1) or (3,0) subtable of `cmap` whose glyphs are kept to their new glyph IDs `newGIDs`.
+func ttfSubsetCmap(cmap []byte, newGIDs map[uint16]uint16) ([]byte, error) {
+	if len(cmap) < 4 {
+		return nil, errors.New("truncated cmap table")
+	}
+	encodingID := -1
+	var chars map[uint16]uint16
+	numTables := int(binary.BigEndian.Uint16(cmap[2:]))
+	for i := 0; i < numTables && 4+8*i+8 <= len(cmap); i++ {
+		record := cmap[4+8*i:]
+		platformID := binary.BigEndian.Uint16(record)
+		encoding := int(binary.BigEndian.Uint16(record[2:]))
+		offset := int(binary.BigEndian.Uint32(record[4:]))
+		if platformID != 3 || (encoding != 0 && encoding != 1) || encoding < encodingID {
+			continue
+		}
+		if subtable, err := ttfParseCmapFormat4(cmap, offset); err == nil {
+			encodingID = encoding
+			chars = subtable
+		}
+	}
+	if encodingID < 0 {
+		return nil, errors.New("no (3,1) or (3,0) format 4 cmap subtable")
+	}
+
+	var codes []int
+	for code, gid := range chars {
+		if _, keep := newGIDs[gid]; keep && gid != 0 {
+			codes = append(codes, int(code))
+		}
+	}
+	sort.Ints(codes)
+
+	// Segments of consecutive codes mapped to consecutive glyphs.
+	type segment struct{ start, end, delta int }
+	var segments []segment
+	for _, code := range codes {
+		gid := int(newGIDs[chars[uint16(code)]])
+		if n := len(segments); n > 0 {
+			last := &segments[n-1]
+			if code == last.end+1 && gid-code == last.delta {
+				last.end = code
+				continue
+			}
+		}
+		segments = append(segments, segment{start: code, end: code, delta: gid - code})
+	}
+	segments = append(segments, segment{start: 0xFFFF, end: 0xFFFF, delta: 1})
+
+	segCount := len(segments)
+	searchRange, entrySelector := 2, 0
+	for searchRange*2 <= 2*segCount {
+		searchRange *= 2
+		entrySelector++
+	}
+	length := 16 + 8*segCount
+	sub := make([]byte, length)
+	binary.BigEndian.PutUint16(sub[0:], 4)
+	binary.BigEndian.PutUint16(sub[2:], uint16(length))
+	binary.BigEndian.PutUint16(sub[6:], uint16(2*segCount))
+	binary.BigEndian.PutUint16(sub[8:], uint16(searchRange))
+	binary.BigEndian.PutUint16(sub[10:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(sub[12:], uint16(2*segCount-searchRange))
+	for i, seg := range segments {
+		binary.BigEndian.PutUint16(sub[14+2*i:], uint16(seg.end))
+		binary.BigEndian.PutUint16(sub[16+2*segCount+2*i:], uint16(seg.start))
+		binary.BigEndian.PutUint16(sub[16+4*segCount+2*i:], uint16(seg.delta))
+	}
+
+	table := make([]byte, 12, 12+length)
+	binary.BigEndian.PutUint16(table[2:], 1)
+	binary.BigEndian.PutUint16(table[4:], 3)
+	binary.BigEndian.PutUint16(table[6:], uint16(encodingID))
+	binary.BigEndian.PutUint32(table[8:], 12)
+	return append(table, sub...), nil
+}
+
+// ttfParseCmapFormat4 returns the glyph IDs by character code of the format 4 subtable at `offset`
+// in `cmap`.
+func ttfParseCmapFormat4(cmap []byte, offset int) (map[uint16]uint16, error) {
+	if offset+14 > len(cmap) || binary.BigEndian.Uint16(cmap[offset:]) != 4 {
+		return nil, errors.New("not a format 4 subtable")
+	}
+	segCount := int(binary.BigEndian.Uint16(cmap[offset+6:])) / 2
+	endCodes := offset + 14
+	startCodes := endCodes + 2*segCount + 2
+	idDeltas := startCodes + 2*segCount
+	idRangeOffsets := idDeltas + 2*segCount
+	if idRangeOffsets+2*segCount > len(cmap) {
+		return nil, errors.New("truncated format 4 subtable")
+	}
+
+	chars := map[uint16]uint16{}
+	for i := 0; i < segCount; i++ {
+		end := int(binary.BigEndian.Uint16(cmap[endCodes+2*i:]))
+		start := int(binary.BigEndian.Uint16(cmap[startCodes+2*i:]))
+		delta := int(binary.BigEndian.Uint16(cmap[idDeltas+2*i:]))
+		rangeOffset := int(binary.BigEndian.Uint16(cmap[idRangeOffsets+2*i:]))
+		for code := start; code <= end && code < 0xFFFF; code++ {
+			gid := 0
+			if rangeOffset == 0 {
+				gid = (code + delta) & 0xFFFF
+			} else {
+				pos := idRangeOffsets + 2*i + rangeOffset + 2*(code-start)
+				if pos+2 > len(cmap) {
+					continue
+				}
+				gid = int(binary.BigEndian.Uint16(cmap[pos:]))
+				if gid != 0 {
+					gid = (gid + delta) & 0xFFFF
+				}
+			}
+			if gid != 0 {
+				chars[uint16(code)] = uint16(gid)
+			}
+		}
+	}
+	return chars, nil
+}
+
+// ttfAssemble returns the TrueType font program with the `tables`.
+func ttfAssemble(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	numTables := len(tags)
+	searchRange, entrySelector := 1, 0
+	for searchRange*2 <= numTables {
+		searchRange *= 2
+		entrySelector++
+	}
+	header := make([]byte, 12+16*numTables)
+	binary.BigEndian.PutUint32(header[0:], 0x00010000)
+	binary.BigEndian.PutUint16(header[4:], uint16(numTables))
+	binary.BigEndian.PutUint16(header[6:], uint16(16*searchRange))
+	binary.BigEndian.PutUint16(header[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(header[10:], uint16(16*(numTables-searchRange)))
+
+	var body bytes.Buffer
+	headOffset := 0
+	for i, tag := range tags {
+		table := tables[tag]
+		offset := len(header) + body.Len()
+		if tag == "head" {
+			headOffset = offset
+		}
+		record := header[12+16*i:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[4:], ttfChecksum(table))
+		binary.BigEndian.PutUint32(record[8:], uint32(offset))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(table)))
+		body.Write(table)
+		for body.Len()%4 != 0 {
+			body.WriteByte(0)
+		}
+	}
+
+	data := append(header, body.Bytes()...)
+	if headOffset > 0 {
+		binary.BigEndian.PutUint32(data[headOffset+8:], 0xB1B0AFBA-ttfChecksum(data))
+	}
+	return data
+}
+
+// ttfChecksum returns the checksum of a table or of the font program `data`.
+func ttfChecksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
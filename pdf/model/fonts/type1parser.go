@@ -0,0 +1,582 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// Keys of the eexec and charstring encryption (Type 1 Font Format, section 7).
+const (
+	type1EexecKey      = 55665
+	type1CharStringKey = 4330
+)
+
+var (
+	reType1FontName    = regexp.MustCompile(`/FontName\s*/([^\s/\[\]{}()<>%]+)`)
+	reType1FontMatrix  = regexp.MustCompile(`/FontMatrix\s*[\[{]([^\]}]*)[\]}]`)
+	reType1FontBBox    = regexp.MustCompile(`/FontBBox\s*[\[{]([^\]}]*)[\]}]`)
+	reType1ItalicAngle = regexp.MustCompile(`/ItalicAngle\s+([-+.0-9eE]+)`)
+	reType1FixedPitch  = regexp.MustCompile(`/isFixedPitch\s+(true|false)`)
+	reType1Encoding    = regexp.MustCompile(`/Encoding\s+(StandardEncoding|\d+\s+array)`)
+	reType1EncodingPut = regexp.MustCompile(`dup\s+(\d+)\s*/([^\s/\[\]{}()<>%]+)\s+put`)
+	reType1LenIV       = regexp.MustCompile(`/lenIV\s+(-?\d+)`)
+	reType1Def         = regexp.MustCompile(`\bdef\b`)
+)
+
+// Type1Font represents a Type 1 font program (section 9.6.2), with its built-in encoding, glyph
+// names, charstrings and metrics.  Implements the Font interface.
+type Type1Font struct {
+	FontName     string
+	FontMatrix   [6]float64
+	FontBBox     [4]float64
+	ItalicAngle  float64
+	IsFixedPitch bool
+
+	encoding    map[byte]string
+	charStrings map[string][]byte
+	subrs       [][]byte
+	widths      map[string]float64
+	encoder     textencoding.TextEncoder
+
+	// The clear text and the encrypted portion of the font program.
+	cleartext, binary []byte
+}
+
+// NewType1FontFromFile loads a Type 1 font program from a PFB or PFA file.
+func NewType1FontFromFile(filePath string) (*Type1Font, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return NewType1FontFromBytes(data)
+}
+
+// NewType1FontFromBytes parses the Type 1 font program `data`, either in the PFB or PFA format, or
+// as embedded in FontFile streams.
+func NewType1FontFromBytes(data []byte) (*Type1Font, error) {
+	cleartext, encrypted, err := splitType1Font(data)
+	if err != nil {
+		return nil, err
+	}
+
+	font := &Type1Font{
+		FontMatrix:  [6]float64{0.001, 0, 0, 0.001, 0, 0},
+		encoding:    map[byte]string{},
+		charStrings: map[string][]byte{},
+		widths:      map[string]float64{},
+		cleartext:   cleartext,
+		binary:      encrypted,
+	}
+	if err := font.parseCleartext(cleartext); err != nil {
+		return nil, err
+	}
+	if err := font.parsePrivate(decryptType1(encrypted, type1EexecKey, 4)); err != nil {
+		return nil, err
+	}
+	for glyph, charstring := range font.charStrings {
+		if wx, ok := type1CharStringWidth(charstring); ok {
+			font.widths[glyph] = 1000 * wx * font.FontMatrix[0]
+		}
+	}
+	return font, nil
+}
+
+// splitType1Font returns the clear text and the binary encrypted portion of the font program `data`.
+func splitType1Font(data []byte) ([]byte, []byte, error) {
+	if len(data) > 0 && data[0] == 0x80 {
+		// PFB segments.
+		var cleartext, encrypted []byte
+		for len(data) >= 2 && data[0] == 0x80 && data[1] != 3 {
+			if len(data) < 6 {
+				return nil, nil, errors.New("truncated PFB segment")
+			}
+			length := int(binary32LE(data[2:6]))
+			if length < 0 || 6+length > len(data) {
+				return nil, nil, errors.New("PFB segment out of range")
+			}
+			switch data[1] {
+			case 1:
+				if encrypted == nil {
+					cleartext = append(cleartext, data[6:6+length]...)
+				}
+			case 2:
+				encrypted = append(encrypted, data[6:6+length]...)
+			}
+			data = data[6+length:]
+		}
+		return cleartext, encrypted, nil
+	}
+
+	idx := bytes.Index(data, []byte("eexec"))
+	if idx < 0 {
+		return nil, nil, errors.New("eexec section missing")
+	}
+	end := idx + 5
+	for end < len(data) && (data[end] == '\r' || data[end] == '\n' || data[end] == ' ' || data[end] == '\t') {
+		end++
+	}
+	cleartext, encrypted := data[:end], data[end:]
+
+	// PFA fonts have the encrypted portion in hexadecimal.
+	isHex := len(encrypted) >= 4
+	for i := 0; i < 4 && i < len(encrypted); i++ {
+		if !isHexDigit(encrypted[i]) {
+			isHex = false
+		}
+	}
+	if isHex {
+		var digits []byte
+		for _, c := range encrypted {
+			if isHexDigit(c) {
+				digits = append(digits, c)
+			} else if c != '\r' && c != '\n' && c != ' ' && c != '\t' {
+				break
+			}
+		}
+		if len(digits)%2 == 1 {
+			digits = digits[:len(digits)-1]
+		}
+		decoded := make([]byte, len(digits)/2)
+		if _, err := hex.Decode(decoded, digits); err != nil {
+			return nil, nil, err
+		}
+		encrypted = decoded
+	}
+	return cleartext, encrypted, nil
+}
+
+func binary32LE(b []byte) uint32 {
+	return binary.LittleEndian.Uint32(b)
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+// decryptType1 decrypts `data` encrypted with the key `r`, and drops the first `skip` bytes.
+func decryptType1(data []byte, r uint16, skip int) []byte {
+	plain := make([]byte, len(data))
+	for i, c := range data {
+		plain[i] = c ^ byte(r>>8)
+		r = (uint16(c)+r)*52845 + 22719
+	}
+	if skip > len(plain) {
+		return nil
+	}
+	return plain[skip:]
+}
+
+// parseCleartext parses the font dictionary entries of the clear text portion of the font program.
+func (font *Type1Font) parseCleartext(cleartext []byte) error {
+	m := reType1FontName.FindSubmatch(cleartext)
+	if m == nil {
+		return errors.New("FontName missing")
+	}
+	font.FontName = string(m[1])
+
+	if m := reType1FontMatrix.FindSubmatch(cleartext); m != nil {
+		if vals, err := parseType1Numbers(m[1], 6); err == nil {
+			copy(font.FontMatrix[:], vals)
+		}
+	}
+	if m := reType1FontBBox.FindSubmatch(cleartext); m != nil {
+		if vals, err := parseType1Numbers(m[1], 4); err == nil {
+			copy(font.FontBBox[:], vals)
+		}
+	}
+	if m := reType1ItalicAngle.FindSubmatch(cleartext); m != nil {
+		font.ItalicAngle, _ = strconv.ParseFloat(string(m[1]), 64)
+	}
+	if m := reType1FixedPitch.FindSubmatch(cleartext); m != nil {
+		font.IsFixedPitch = string(m[1]) == "true"
+	}
+
+	loc := reType1Encoding.FindSubmatchIndex(cleartext)
+	if loc == nil {
+		common.Log.Debug("Type 1 font %s without Encoding", font.FontName)
+		return nil
+	}
+	if string(cleartext[loc[2]:loc[3]]) == "StandardEncoding" {
+		standard := textencoding.NewStandardTextEncoder()
+		for code := 0; code < 256; code++ {
+			if glyph, found := standard.CharcodeToGlyph(byte(code)); found {
+				font.encoding[byte(code)] = glyph
+			}
+		}
+		return nil
+	}
+	// The custom encoding ends with "readonly def" or "def".
+	rest := cleartext[loc[1]:]
+	if loc := reType1Def.FindIndex(rest); loc != nil {
+		rest = rest[:loc[0]]
+	}
+	for _, m := range reType1EncodingPut.FindAllSubmatch(rest, -1) {
+		code, err := strconv.Atoi(string(m[1]))
+		if err != nil || code > 255 {
+			continue
+		}
+		font.encoding[byte(code)] = string(m[2])
+	}
+	return nil
+}
+
+// parseType1Numbers parses the `n` numbers of `data`.
+func parseType1Numbers(data []byte, n int) ([]float64, error) {
+	fields := bytes.Fields(data)
+	if len(fields) != n {
+		return nil, fmt.Errorf("%d numbers expected, got %d", n, len(fields))
+	}
+	vals := make([]float64, n)
+	for i, field := range fields {
+		val, err := strconv.ParseFloat(string(field), 64)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = val
+	}
+	return vals, nil
+}
+
+// parsePrivate parses the subroutines and charstrings of the decrypted private portion of the
+// font program.
+func (font *Type1Font) parsePrivate(private []byte) error {
+	lenIV := 4
+	if m := reType1LenIV.FindSubmatch(private); m != nil {
+		lenIV, _ = strconv.Atoi(string(m[1]))
+	}
+	decrypt := func(charstring []byte) []byte {
+		if lenIV < 0 {
+			return charstring
+		}
+		return decryptType1(charstring, type1CharStringKey, lenIV)
+	}
+
+	if idx := bytes.Index(private, []byte("/Subrs")); idx >= 0 {
+		s := &type1Scanner{data: private, pos: idx + len("/Subrs")}
+		count, err := s.readInt()
+		if err != nil {
+			return err
+		}
+		if s.peekToken() == "array" {
+			s.readToken()
+		}
+		font.subrs = make([][]byte, count)
+		for i := 0; i < count; i++ {
+			if s.readToken() != "dup" {
+				break
+			}
+			index, err := s.readInt()
+			if err != nil {
+				return err
+			}
+			data, err := s.readBinary()
+			if err != nil {
+				return err
+			}
+			if index >= 0 && index < count {
+				font.subrs[index] = decrypt(data)
+			}
+			s.readToken() // NP, | or noaccess put
+			if s.peekToken() == "put" {
+				s.readToken()
+			}
+		}
+	}
+
+	idx := bytes.Index(private, []byte("/CharStrings"))
+	if idx < 0 {
+		return errors.New("CharStrings missing")
+	}
+	s := &type1Scanner{data: private, pos: idx + len("/CharStrings")}
+	if _, err := s.readInt(); err != nil {
+		return err
+	}
+	// dict dup begin
+	for token := s.readToken(); token != "begin"; token = s.readToken() {
+		if token == "" {
+			return errors.New("CharStrings dictionary not found")
+		}
+	}
+	for {
+		token := s.readToken()
+		if token == "" || token == "end" {
+			break
+		}
+		if token[0] != '/' {
+			continue
+		}
+		data, err := s.readBinary()
+		if err != nil {
+			return err
+		}
+		font.charStrings[token[1:]] = decrypt(data)
+		s.readToken() // ND, |- or noaccess def
+		if s.peekToken() == "def" {
+			s.readToken()
+		}
+	}
+	return nil
+}
+
+// type1Scanner reads the tokens and binary strings of the private portion of a font program.
+type type1Scanner struct {
+	data []byte
+	pos  int
+}
+
+func (s *type1Scanner) skipSpaces() {
+	for s.pos < len(s.data) {
+		switch s.data[s.pos] {
+		case ' ', '\t', '\r', '\n', '\f':
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+// readToken returns the next whitespace delimited token, or "" at the end of the data.
+func (s *type1Scanner) readToken() string {
+	s.skipSpaces()
+	start := s.pos
+	for s.pos < len(s.data) {
+		c := s.data[s.pos]
+		if c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' {
+			break
+		}
+		s.pos++
+	}
+	return string(s.data[start:s.pos])
+}
+
+func (s *type1Scanner) peekToken() string {
+	pos := s.pos
+	token := s.readToken()
+	s.pos = pos
+	return token
+}
+
+func (s *type1Scanner) readInt() (int, error) {
+	token := s.readToken()
+	val, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, fmt.Errorf("integer expected, got %q", token)
+	}
+	return val, nil
+}
+
+// readBinary reads a binary string "len RD <len bytes>", where RD is the name of the procedure
+// reading the string (RD or -|).
+func (s *type1Scanner) readBinary() ([]byte, error) {
+	length, err := s.readInt()
+	if err != nil {
+		return nil, err
+	}
+	s.readToken()
+	s.pos++ // Single space following RD.
+	if length < 0 || s.pos+length > len(s.data) {
+		return nil, errors.New("binary string out of range")
+	}
+	data := s.data[s.pos : s.pos+length]
+	s.pos += length
+	return data, nil
+}
+
+// type1CharStringWidth returns the horizontal advance width set by the hsbw or sbw command that
+// starts the Type 1 charstring `charstring`.
+func type1CharStringWidth(charstring []byte) (float64, bool) {
+	var stack []float64
+	for i := 0; i < len(charstring); {
+		v := charstring[i]
+		i++
+		switch {
+		case v >= 32:
+			val, n, ok := decodeCharStringNumber(charstring[i-1:])
+			if !ok {
+				return 0, false
+			}
+			stack = append(stack, val)
+			i += n - 1
+		case v == 13: // hsbw: sbx wx
+			if len(stack) < 2 {
+				return 0, false
+			}
+			return stack[1], true
+		case v == 12 && i < len(charstring) && charstring[i] == 7: // sbw: sbx sby wx wy
+			if len(stack) < 4 {
+				return 0, false
+			}
+			return stack[2], true
+		default:
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// decodeCharStringNumber decodes the number at the start of the Type 1 charstring data `data`,
+// returning its value and length.
+func decodeCharStringNumber(data []byte) (float64, int, bool) {
+	v := int(data[0])
+	switch {
+	case v >= 32 && v <= 246:
+		return float64(v - 139), 1, true
+	case v >= 247 && v <= 250 && len(data) >= 2:
+		return float64((v-247)*256 + int(data[1]) + 108), 2, true
+	case v >= 251 && v <= 254 && len(data) >= 2:
+		return float64(-(v-251)*256 - int(data[1]) - 108), 2, true
+	case v == 255 && len(data) >= 5:
+		return float64(int32(binary.BigEndian.Uint32(data[1:]))), 5, true
+	}
+	return 0, 0, false
+}
+
+// Encoding returns the built-in encoding of the font, the glyph names by character code.
+func (font *Type1Font) Encoding() map[byte]string {
+	return font.encoding
+}
+
+// GlyphNames returns the sorted names of the glyphs of the font.
+func (font *Type1Font) GlyphNames() []string {
+	names := make([]string, 0, len(font.charStrings))
+	for name := range font.charStrings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CharString returns the decrypted Type 1 charstring of `glyph`.
+func (font *Type1Font) CharString(glyph string) ([]byte, bool) {
+	charstring, has := font.charStrings[glyph]
+	return charstring, has
+}
+
+// Subr returns the decrypted subroutine `index` called by the charstrings.
+func (font *Type1Font) Subr(index int) ([]byte, bool) {
+	if index < 0 || index >= len(font.subrs) || font.subrs[index] == nil {
+		return nil, false
+	}
+	return font.subrs[index], true
+}
+
+// SetEncoder sets the encoding of text shown with the font.  The built-in encoding is used if
+// not set.
+func (font *Type1Font) SetEncoder(encoder textencoding.TextEncoder) {
+	font.encoder = encoder
+}
+
+// GetGlyphCharMetrics returns the metrics of `glyph` in thousandths of text space units.
+func (font *Type1Font) GetGlyphCharMetrics(glyph string) (CharMetrics, bool) {
+	wx, has := font.widths[glyph]
+	if !has {
+		return CharMetrics{GlyphName: glyph}, false
+	}
+	return CharMetrics{GlyphName: glyph, Wx: wx}, true
+}
+
+// ToPdfObject returns a Type1 font dictionary embedding the font program.
+func (font *Type1Font) ToPdfObject() core.PdfObject {
+	encoding := font.encoding
+	if font.encoder != nil {
+		encoding = map[byte]string{}
+		for code := 0; code < 256; code++ {
+			if glyph, found := font.encoder.CharcodeToGlyph(byte(code)); found {
+				encoding[byte(code)] = glyph
+			}
+		}
+	}
+
+	var data []byte
+	data = append(data, font.cleartext...)
+	data = append(data, font.binary...)
+	stream, err := core.MakeStream(data, core.NewFlateEncoder())
+	if err != nil {
+		common.Log.Debug("Unable to make stream: %v", err)
+		return core.MakeNull()
+	}
+	stream.Set("Length1", core.MakeInteger(int64(len(font.cleartext))))
+	stream.Set("Length2", core.MakeInteger(int64(len(font.binary))))
+	stream.Set("Length3", core.MakeInteger(0))
+
+	descriptor := simpleFontDescriptor(font.FontName, font.FontBBox, font.ItalicAngle, font.IsFixedPitch,
+		font.FontMatrix[3])
+	descriptor.Set("FontFile", stream)
+
+	fontDict := simpleFontDict("Type1", font.FontName, encoding, font.widths, descriptor)
+	if font.encoder != nil {
+		fontDict.Set("Encoding", font.encoder.ToPdfObject())
+	}
+	return core.MakeIndirectObject(fontDict)
+}
+
+// simpleFontDescriptor returns the font descriptor of an embedded simple font.  `scale` is the
+// vertical scale of the font matrix.
+func simpleFontDescriptor(fontName string, bbox [4]float64, italicAngle float64, fixedPitch bool, scale float64) *core.PdfObjectDictionary {
+	k := 1000 * scale
+	descriptor := core.MakeDict()
+	descriptor.Set("Type", core.MakeName("FontDescriptor"))
+	descriptor.Set("FontName", core.MakeName(fontName))
+	flags := int64(1 << 5) // Nonsymbolic.
+	if fixedPitch {
+		flags |= 1
+	}
+	if italicAngle != 0 {
+		flags |= 1 << 6
+	}
+	descriptor.Set("Flags", core.MakeInteger(flags))
+	descriptor.Set("FontBBox", core.MakeArrayFromFloats([]float64{k * bbox[0], k * bbox[1], k * bbox[2], k * bbox[3]}))
+	descriptor.Set("ItalicAngle", core.MakeFloat(italicAngle))
+	descriptor.Set("Ascent", core.MakeFloat(k*bbox[3]))
+	descriptor.Set("Descent", core.MakeFloat(k*bbox[1]))
+	descriptor.Set("CapHeight", core.MakeFloat(k*bbox[3]))
+	descriptor.Set("StemV", core.MakeInteger(80))
+	return descriptor
+}
+
+// simpleFontDict returns the font dictionary of a simple font with the Widths of the glyphs
+// `encoding` by code.
+func simpleFontDict(subtype, fontName string, encoding map[byte]string, widths map[string]float64, descriptor *core.PdfObjectDictionary) *core.PdfObjectDictionary {
+	firstChar, lastChar := 255, 0
+	for code := range encoding {
+		if int(code) < firstChar {
+			firstChar = int(code)
+		}
+		if int(code) > lastChar {
+			lastChar = int(code)
+		}
+	}
+	if firstChar > lastChar {
+		firstChar, lastChar = 0, 0
+	}
+	vals := make([]float64, 0, lastChar-firstChar+1)
+	for code := firstChar; code <= lastChar; code++ {
+		vals = append(vals, widths[encoding[byte(code)]])
+	}
+
+	fontDict := core.MakeDict()
+	fontDict.Set("Type", core.MakeName("Font"))
+	fontDict.Set("Subtype", core.MakeName(subtype))
+	fontDict.Set("BaseFont", core.MakeName(fontName))
+	fontDict.Set("FirstChar", core.MakeInteger(int64(firstChar)))
+	fontDict.Set("LastChar", core.MakeInteger(int64(lastChar)))
+	fontDict.Set("Widths", core.MakeArrayFromFloats(vals))
+	fontDict.Set("FontDescriptor", core.MakeIndirectObject(descriptor))
+	return fontDict
+}
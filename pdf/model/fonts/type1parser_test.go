@@ -0,0 +1,162 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fonts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+)
+
+// encryptType1 encrypts `plain` with the key `r`, prefixed by `lenIV` bytes.
+func encryptType1(plain []byte, r uint16, lenIV int) []byte {
+	data := append(bytes.Repeat([]byte{0}, lenIV), plain...)
+	encrypted := make([]byte, len(data))
+	for i, p := range data {
+		c := p ^ byte(r>>8)
+		encrypted[i] = c
+		r = (uint16(c)+r)*52845 + 22719
+	}
+	return encrypted
+}
+
+// makeTestType1Font returns a Type 1 font program with the glyphs .notdef, A (width 600) and
+// Omega (width 800) encoded at 65 and 1, either in the PFA format or as embedded in FontFile.
+func makeTestType1Font(pfa bool) []byte {
+	cleartext := `%!PS-AdobeFont-1.0: TestType1 001.000
+11 dict begin
+/FontName /TestType1 def
+/FontMatrix [0.001 0 0 0.001 0 0] readonly def
+/FontBBox {-10 -200 900 800} readonly def
+/FontInfo 2 dict dup begin
+/ItalicAngle -12 def
+/isFixedPitch false def
+end readonly def
+/Encoding 256 array
+0 1 255 {1 index exch /.notdef put} for
+dup 65 /A put
+dup 1 /Omega put
+readonly def
+currentdict end
+currentfile eexec
+`
+	charstrings := map[string][]byte{
+		".notdef": {139, 139, 13, 14},      // 0 0 hsbw endchar
+		"A":       {139, 248, 236, 13, 14}, // 0 600 hsbw endchar
+		"Omega":   {139, 249, 180, 13, 14}, // 0 800 hsbw endchar
+		"subr":    {139, 139, 139, 11},     // return
+	}
+
+	var private bytes.Buffer
+	private.WriteString("dup /Private 8 dict dup begin\n/RD{string currentfile exch readstring pop}executeonly def\n")
+	private.WriteString("/ND{noaccess def}executeonly def\n/NP{noaccess put}executeonly def\n/lenIV 4 def\n")
+	subr := encryptType1(charstrings["subr"], type1CharStringKey, 4)
+	fmt.Fprintf(&private, "/Subrs 1 array\ndup 0 %d RD ", len(subr))
+	private.Write(subr)
+	private.WriteString(" NP\nND\n2 index /CharStrings 3 dict dup begin\n")
+	for _, glyph := range []string{".notdef", "A", "Omega"} {
+		charstring := encryptType1(charstrings[glyph], type1CharStringKey, 4)
+		fmt.Fprintf(&private, "/%s %d RD ", glyph, len(charstring))
+		private.Write(charstring)
+		private.WriteString(" ND\n")
+	}
+	private.WriteString("end\nend\nreadonly put\nnoaccess put\ndup /FontName get exch definefont pop\nmark currentfile closefile\n")
+
+	encrypted := encryptType1(private.Bytes(), type1EexecKey, 4)
+	if pfa {
+		return append([]byte(cleartext), []byte(hex.EncodeToString(encrypted)+"\n")...)
+	}
+	return append([]byte(cleartext), encrypted...)
+}
+
+func TestType1FontParsing(t *testing.T) {
+	for _, pfa := range []bool{false, true} {
+		font, err := NewType1FontFromBytes(makeTestType1Font(pfa))
+		if err != nil {
+			t.Fatalf("Error parsing font (pfa %t): %v", pfa, err)
+		}
+
+		if font.FontName != "TestType1" {
+			t.Errorf("Incorrect FontName %q", font.FontName)
+		}
+		if font.FontBBox != [4]float64{-10, -200, 900, 800} {
+			t.Errorf("Incorrect FontBBox %v", font.FontBBox)
+		}
+		if font.ItalicAngle != -12 {
+			t.Errorf("Incorrect ItalicAngle %v", font.ItalicAngle)
+		}
+
+		encoding := font.Encoding()
+		if len(encoding) != 2 || encoding[65] != "A" || encoding[1] != "Omega" {
+			t.Errorf("Incorrect built-in encoding %v", encoding)
+		}
+		if names := font.GlyphNames(); len(names) != 3 {
+			t.Errorf("Incorrect glyph names %v", names)
+		}
+
+		for glyph, wx := range map[string]float64{"A": 600, "Omega": 800, ".notdef": 0} {
+			metrics, found := font.GetGlyphCharMetrics(glyph)
+			if !found || metrics.Wx != wx {
+				t.Errorf("Incorrect metrics of %s: %+v (%t)", glyph, metrics, found)
+			}
+		}
+		if _, found := font.GetGlyphCharMetrics("B"); found {
+			t.Errorf("Metrics of missing glyph found")
+		}
+
+		if charstring, found := font.CharString("A"); !found || !bytes.Equal(charstring, []byte{139, 248, 236, 13, 14}) {
+			t.Errorf("Incorrect charstring of A: %v", charstring)
+		}
+		if subr, found := font.Subr(0); !found || !bytes.Equal(subr, []byte{139, 139, 139, 11}) {
+			t.Errorf("Incorrect subroutine 0: %v", subr)
+		}
+	}
+}
+
+func TestType1FontToPdfObject(t *testing.T) {
+	font, err := NewType1FontFromBytes(makeTestType1Font(false))
+	if err != nil {
+		t.Fatalf("Error parsing font: %v", err)
+	}
+
+	ind, ok := font.ToPdfObject().(*core.PdfIndirectObject)
+	if !ok {
+		t.Fatalf("Font not an indirect object")
+	}
+	dict := ind.PdfObject.(*core.PdfObjectDictionary)
+	if dict.Get("Encoding") != nil {
+		t.Errorf("Built-in encoding should be implied")
+	}
+	if first, _ := dict.Get("FirstChar").(*core.PdfObjectInteger); first == nil || *first != 1 {
+		t.Errorf("Incorrect FirstChar %v", dict.Get("FirstChar"))
+	}
+	widths, _ := dict.Get("Widths").(*core.PdfObjectArray)
+	if widths == nil || len(*widths) != 65 {
+		t.Fatalf("Incorrect Widths %v", dict.Get("Widths"))
+	}
+	if vals, _ := widths.ToFloat64Array(); vals[0] != 800 || vals[64] != 600 {
+		t.Errorf("Incorrect Widths %v", vals)
+	}
+
+	descriptor := dict.Get("FontDescriptor").(*core.PdfIndirectObject).PdfObject.(*core.PdfObjectDictionary)
+	stream, ok := descriptor.Get("FontFile").(*core.PdfObjectStream)
+	if !ok {
+		t.Fatalf("FontFile missing")
+	}
+	data, err := core.DecodeStream(stream)
+	if err != nil {
+		t.Fatalf("Error decoding FontFile: %v", err)
+	}
+	if !bytes.Equal(data, makeTestType1Font(false)) {
+		t.Errorf("FontFile does not match the font program")
+	}
+	if length1, _ := stream.Get("Length1").(*core.PdfObjectInteger); length1 == nil || int(*length1) != len(font.cleartext) {
+		t.Errorf("Incorrect Length1 %v", stream.Get("Length1"))
+	}
+}
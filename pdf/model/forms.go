@@ -72,6 +72,7 @@ func (r *PdfReader) newPdfAcroFormFromDict(d *PdfObjectDictionary) (*PdfAcroForm
 				return nil, err
 			}
 			common.Log.Trace("AcroForm Field: %+v", *field)
+			field.form = acroForm
 			fields = append(fields, field)
 		}
 		acroForm.Fields = &fields
@@ -213,6 +214,7 @@ type PdfField struct {
 	RV PdfObject
 
 	primitive *PdfIndirectObject
+	form      *PdfAcroForm // Form of the field, nil if not known.
 }
 
 func NewPdfField() *PdfField {
@@ -232,7 +234,9 @@ func (r *PdfReader) newPdfFieldFromIndirectObject(container *PdfIndirectObject,
 		return nil, fmt.Errorf("Pdf Field indirect object not containing a dictionary")
 	}
 
-	field := NewPdfField()
+	// The field keeps its dictionary, shared with its widget annotation if merged, so that the
+	// fields of loaded forms are written back in place.
+	field := &PdfField{primitive: container}
 
 	// Field type (required in terminal fields).
 	// Can be /Btn /Tx /Ch /Sig
@@ -303,12 +307,9 @@ func (r *PdfReader) newPdfFieldFromIndirectObject(container *PdfIndirectObject,
 			if err != nil {
 				return nil, err
 			}
-			widget, ok := annot.GetContext().(*PdfAnnotationWidget)
-			if !ok {
+			if _, ok := annot.GetContext().(*PdfAnnotationWidget); !ok {
 				return nil, fmt.Errorf("Invalid widget")
 			}
-
-			widget.Parent = field.GetContainingPdfObject()
 			field.KidsA = append(field.KidsA, annot)
 			return field, nil
 		}
@@ -353,7 +354,8 @@ func (this *PdfField) GetContainingPdfObject() PdfObject {
 }
 
 // If Kids refer only to a single pdf widget annotation widget, then can merge it in.
-// Currently not merging it in.
+// Currently not merging it in.  Widget annotations merged in loaded fields share the dictionary of
+// the field and are not kids.
 func (this *PdfField) ToPdfObject() PdfObject {
 	container := this.primitive
 	dict := container.PdfObject.(*PdfObjectDictionary)
@@ -373,13 +375,17 @@ func (this *PdfField) ToPdfObject() PdfObject {
 	}
 	if this.KidsA != nil {
 		common.Log.Trace("KidsA: %+v", this.KidsA)
-		_, hasKids := dict.Get("Kids").(*PdfObjectArray)
-		if !hasKids {
-			dict.Set("Kids", &PdfObjectArray{})
-		}
-		arr := dict.Get("Kids").(*PdfObjectArray)
 		for _, child := range this.KidsA {
-			*arr = append(*arr, child.GetContext().ToPdfObject())
+			obj := child.GetContext().ToPdfObject()
+			if obj == container {
+				continue
+			}
+			arr, hasKids := dict.Get("Kids").(*PdfObjectArray)
+			if !hasKids {
+				arr = &PdfObjectArray{}
+				dict.Set("Kids", arr)
+			}
+			*arr = append(*arr, obj)
 		}
 	}
 
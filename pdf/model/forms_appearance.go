@@ -0,0 +1,681 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// Layout of the generated appearances of variable text fields.
+const (
+	appearanceFontSize    = 12.0 // Font size of auto sized multiline fields and list boxes.
+	appearanceMinFontSize = 4.0  // Smallest font size of auto sized fields.
+	appearancePadding     = 2.0  // Space between the border and the text.
+	appearanceLeading     = 1.15 // Line spacing, in font sizes.
+)
+
+// defaultAppearance is a parsed default appearance string (DA) of variable text (section 12.7.3.3).
+type defaultAppearance struct {
+	font  PdfObjectName // Font resource name.
+	size  float64       // Font size, 0 for auto sizing.
+	color string        // Operator of the color of the text, e.g. "0 g".
+}
+
+// parseDefaultAppearance parses the Tf operator and the g, rg and k color operators of the default
+// appearance string `da`.
+func parseDefaultAppearance(da string) defaultAppearance {
+	res := defaultAppearance{font: "Helv", color: "0 g"}
+	var operands []string
+	for _, tok := range strings.Fields(da) {
+		switch tok {
+		case "Tf":
+			if n := len(operands); n >= 2 && strings.HasPrefix(operands[n-2], "/") {
+				res.font = PdfObjectName(operands[n-2][1:])
+				if size, err := strconv.ParseFloat(operands[n-1], 64); err == nil {
+					res.size = size
+				}
+			}
+		case "g", "rg", "k":
+			n := map[string]int{"g": 1, "rg": 3, "k": 4}[tok]
+			if len(operands) >= n {
+				res.color = strings.Join(operands[len(operands)-n:], " ") + " " + tok
+			}
+		default:
+			operands = append(operands, tok)
+			continue
+		}
+		operands = nil
+	}
+	return res
+}
+
+// defaultAppearance returns the default appearance of the variable text of the field, inherited
+// from its ancestors and its form.
+func (this *PdfField) defaultAppearance() defaultAppearance {
+	if da, ok := this.inherited("DA").(*PdfObjectString); ok {
+		return parseDefaultAppearance(string(*da))
+	}
+	if form := this.getForm(); form != nil && form.DA != nil {
+		return parseDefaultAppearance(string(*form.DA))
+	}
+	return parseDefaultAppearance("")
+}
+
+// quadding returns the alignment (Q) of the variable text of the field, inherited from its
+// ancestors and its form: 0 for left, 1 for centered and 2 for right aligned.
+func (this *PdfField) quadding() int64 {
+	if q, ok := this.inherited("Q").(*PdfObjectInteger); ok {
+		return int64(*q)
+	}
+	if form := this.getForm(); form != nil && form.Q != nil {
+		return int64(*form.Q)
+	}
+	return 0
+}
+
+// appearanceFont is a font of generated appearances, with its resource name and font dictionary.
+type appearanceFont struct {
+	name      PdfObjectName
+	obj       PdfObject
+	font      *PdfFont
+	encoder   textencoding.TextEncoder
+	composite bool // Composite font with 2-byte codes.
+}
+
+// loadAppearanceFont returns the font resource `name` of the default resources of the form `form`,
+// or Helvetica with WinAnsiEncoding if not found.
+func loadAppearanceFont(form *PdfAcroForm, name PdfObjectName) (*appearanceFont, error) {
+	var obj PdfObject
+	if form != nil && form.DR != nil {
+		obj, _ = form.DR.GetFontByName(name)
+	}
+	if obj == nil {
+		common.Log.Debug("Font %s not in the default resources, using Helvetica", name)
+		d := MakeDict()
+		d.Set("Type", MakeName("Font"))
+		d.Set("Subtype", MakeName("Type1"))
+		d.Set("BaseFont", MakeName("Helvetica"))
+		d.Set("Encoding", MakeName("WinAnsiEncoding"))
+		obj = d
+	}
+	font, err := NewPdfFontFromPdfObject(obj)
+	if err != nil {
+		common.Log.Debug("ERROR: Unable to load font %s: %v", name, err)
+		return nil, err
+	}
+
+	f := &appearanceFont{name: name, obj: obj, font: font}
+	switch t := font.context.(type) {
+	case *pdfFontType1:
+		f.encoder = t.Encoder
+	case *pdfFontTrueType:
+		f.encoder = t.Encoder
+	case *pdfFontType0:
+		f.encoder = t.Encoder
+		f.composite = true
+	}
+	if f.encoder == nil {
+		common.Log.Debug("ERROR: Unsupported font %s (%T)", name, font.context)
+		return nil, errors.New("Unsupported font")
+	}
+	return f, nil
+}
+
+// encode returns the character codes of `text`, without the characters not in the encoding of the
+// font, and their width in thousandths of the font size.
+func (f *appearanceFont) encode(text string) (string, float64) {
+	var codes []byte
+	var width float64
+	for _, r := range text {
+		var code []byte
+		if f.composite {
+			code = []byte(f.encoder.Encode(string(r)))
+		} else if c, ok := f.encoder.RuneToCharcode(r); ok {
+			code = []byte{c}
+		}
+		if len(code) == 0 {
+			continue
+		}
+		var val uint64
+		for _, b := range code {
+			val = val<<8 | uint64(b)
+		}
+		if metrics, ok := f.font.GetCharMetrics(val); ok {
+			width += metrics.Wx
+		} else {
+			width += 500
+		}
+		codes = append(codes, code...)
+	}
+	return string(codes), width
+}
+
+// width returns the width of `text` in thousandths of the font size.
+func (f *appearanceFont) width(text string) float64 {
+	_, w := f.encode(text)
+	return w
+}
+
+// wrapText breaks `text` into lines no wider than `width` thousandths of the font size, at spaces
+// where possible.
+func (f *appearanceFont) wrapText(text string, width float64) []string {
+	text = strings.Replace(text, "\r\n", "\n", -1)
+	text = strings.Replace(text, "\r", "\n", -1)
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for i, word := range strings.Split(paragraph, " ") {
+			candidate := word
+			if i > 0 {
+				candidate = line + " " + word
+			}
+			if i == 0 || f.width(candidate) <= width {
+				line = candidate
+			} else {
+				lines = append(lines, line)
+				line = word
+			}
+			// Words wider than the lines are broken anywhere.
+			for runes := []rune(line); len(runes) > 1 && f.width(line) > width; runes = []rune(line) {
+				n := len(runes) - 1
+				for n > 1 && f.width(string(runes[:n])) > width {
+					n--
+				}
+				lines = append(lines, string(runes[:n]))
+				line = string(runes[n:])
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// showText writes the text object showing `text` at (`x`, `y`) with the font `f` of size `size`
+// and the color operator `color` to `b`.
+func (f *appearanceFont) showText(b *bytes.Buffer, text string, x, y, size float64, color string) {
+	codes, _ := f.encode(text)
+	fmt.Fprintf(b, "BT\n/%s %s Tf\n%s\n%s %s Td\n%s Tj\nET\n", f.name, appearanceNumber(size), color,
+		appearanceNumber(x), appearanceNumber(y), MakeString(codes).DefaultWriteString())
+}
+
+// widgetFrame is the geometry and border of the appearances of a widget annotation.
+type widgetFrame struct {
+	width, height float64   // Size of the appearance, rotated by the widget.
+	matrix        []float64 // Matrix of the appearance, nil if not rotated.
+	mk            *PdfObjectDictionary
+	borderWidth   float64 // Width of the border, 0 if none.
+	borderStyle   PdfObjectName
+	dash          []float64
+}
+
+// newWidgetFrame returns the frame of the appearances of `widget`, given by its rectangle, its
+// appearance characteristics (MK) and its border style (BS).
+func newWidgetFrame(widget *PdfAnnotationWidget) (*widgetFrame, error) {
+	arr, ok := TraceToDirectObject(widget.Rect).(*PdfObjectArray)
+	if !ok {
+		common.Log.Debug("ERROR: Widget annotation without Rect")
+		return nil, errors.New("Rect missing")
+	}
+	rect, err := NewPdfRectangle(*arr)
+	if err != nil {
+		return nil, err
+	}
+	frame := &widgetFrame{
+		width:       math.Abs(rect.Urx - rect.Llx),
+		height:      math.Abs(rect.Ury - rect.Lly),
+		borderWidth: 1,
+		borderStyle: "S",
+		dash:        []float64{3},
+	}
+
+	frame.mk, ok = TraceToDirectObject(widget.MK).(*PdfObjectDictionary)
+	if !ok {
+		frame.mk = MakeDict()
+	}
+	rotation := int64(0)
+	if r, ok := TraceToDirectObject(frame.mk.Get("R")).(*PdfObjectInteger); ok {
+		rotation = (int64(*r)%360 + 360) % 360
+	}
+	switch rotation {
+	case 90:
+		frame.width, frame.height = frame.height, frame.width
+		frame.matrix = []float64{0, 1, -1, 0, frame.height, 0}
+	case 180:
+		frame.matrix = []float64{-1, 0, 0, -1, frame.width, frame.height}
+	case 270:
+		frame.width, frame.height = frame.height, frame.width
+		frame.matrix = []float64{0, -1, 1, 0, 0, frame.width}
+	}
+
+	if bs, ok := TraceToDirectObject(widget.BS).(*PdfObjectDictionary); ok {
+		if w, err := getNumberAsFloat(TraceToDirectObject(bs.Get("W"))); err == nil {
+			frame.borderWidth = w
+		}
+		if s, ok := TraceToDirectObject(bs.Get("S")).(*PdfObjectName); ok {
+			frame.borderStyle = *s
+		}
+		if d, ok := TraceToDirectObject(bs.Get("D")).(*PdfObjectArray); ok {
+			if dash, err := d.ToFloat64Array(); err == nil && len(dash) > 0 {
+				frame.dash = dash
+			}
+		}
+	}
+	// Borders are only drawn in a color.
+	if mkColorOperator(frame.mk.Get("BC"), true) == "" {
+		frame.borderWidth = 0
+	}
+	return frame, nil
+}
+
+// inset returns the width of the border drawn around the appearance, doubled by beveled and inset
+// borders.
+func (f *widgetFrame) inset() float64 {
+	if f.borderStyle == "B" || f.borderStyle == "I" {
+		return 2 * f.borderWidth
+	}
+	return f.borderWidth
+}
+
+// drawBackground writes the background (MK BG) and the border (MK BC) of the appearance to `b`.
+func (f *widgetFrame) drawBackground(b *bytes.Buffer) {
+	w, h, bw := f.width, f.height, f.borderWidth
+	if op := mkColorOperator(f.mk.Get("BG"), false); op != "" {
+		fmt.Fprintf(b, "%s\n0 0 %s %s re f\n", op, appearanceNumber(w), appearanceNumber(h))
+	}
+	if bw <= 0 {
+		return
+	}
+	fmt.Fprintf(b, "q\n%s\n%s w\n", mkColorOperator(f.mk.Get("BC"), true), appearanceNumber(bw))
+	switch f.borderStyle {
+	case "U":
+		fmt.Fprintf(b, "0 %s m %s %s l S\nQ\n", appearanceNumber(bw/2), appearanceNumber(w), appearanceNumber(bw/2))
+		return
+	case "D":
+		dash := make([]string, len(f.dash))
+		for i, d := range f.dash {
+			dash[i] = appearanceNumber(d)
+		}
+		fmt.Fprintf(b, "[%s] 0 d\n", strings.Join(dash, " "))
+	}
+	fmt.Fprintf(b, "%s %s %s %s re S\n", appearanceNumber(bw/2), appearanceNumber(bw/2),
+		appearanceNumber(w-bw), appearanceNumber(h-bw))
+
+	// Beveled and inset borders are shaded inside the border, lighter at the top left.
+	if f.borderStyle == "B" || f.borderStyle == "I" {
+		topLeft, bottomRight := "1 g", "0.75 g"
+		if f.borderStyle == "I" {
+			topLeft = "0.5 g"
+		}
+		fmt.Fprintf(b, "%s\n%s m %s l %s l %s l %s l %s l f\n", topLeft,
+			appearancePoint(bw, bw), appearancePoint(bw, h-bw), appearancePoint(w-bw, h-bw),
+			appearancePoint(w-2*bw, h-2*bw), appearancePoint(2*bw, h-2*bw), appearancePoint(2*bw, 2*bw))
+		fmt.Fprintf(b, "%s\n%s m %s l %s l %s l %s l %s l f\n", bottomRight,
+			appearancePoint(w-bw, h-bw), appearancePoint(w-bw, bw), appearancePoint(bw, bw),
+			appearancePoint(2*bw, 2*bw), appearancePoint(w-2*bw, 2*bw), appearancePoint(w-2*bw, h-2*bw))
+	}
+	b.WriteString("Q\n")
+}
+
+// mkColorOperator returns the operator setting the color `obj` of an appearance characteristics
+// dictionary, an array of 1, 3 or 4 components, for filling or for stroking.  Returns an empty
+// string for transparent colors.
+func mkColorOperator(obj PdfObject, stroke bool) string {
+	arr, ok := TraceToDirectObject(obj).(*PdfObjectArray)
+	if !ok {
+		return ""
+	}
+	vals, err := arr.ToFloat64Array()
+	if err != nil {
+		return ""
+	}
+	var op string
+	switch len(vals) {
+	case 1:
+		op = "g"
+	case 3:
+		op = "rg"
+	case 4:
+		op = "k"
+	default:
+		return ""
+	}
+	if stroke {
+		op = strings.ToUpper(op)
+	}
+	parts := make([]string, 0, len(vals)+1)
+	for _, v := range vals {
+		parts = append(parts, appearanceNumber(v))
+	}
+	return strings.Join(append(parts, op), " ")
+}
+
+// appearanceNumber formats `v` for content streams, rounded to 4 decimals.
+func appearanceNumber(v float64) string {
+	v = math.Round(v*10000) / 10000
+	if v == 0 {
+		v = 0 // No negative zero.
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// appearancePoint formats the point (`x`, `y`) for content streams.
+func appearancePoint(x, y float64) string {
+	return appearanceNumber(x) + " " + appearanceNumber(y)
+}
+
+// setNormalAppearance sets the normal appearance of `widget` to a form XObject of the size of
+// `frame` with the content `content`, using the font `font`.  States of the normal appearance
+// other than the form XObject are replaced.
+func setNormalAppearance(widget *PdfAnnotationWidget, frame *widgetFrame, font *appearanceFont, content []byte) error {
+	xform, err := newAppearanceXObject(frame, font, content)
+	if err != nil {
+		return err
+	}
+	ap, ok := TraceToDirectObject(widget.AP).(*PdfObjectDictionary)
+	if !ok {
+		ap = MakeDict()
+		widget.AP = ap
+	}
+	ap.Set("N", xform.ToPdfObject())
+	return nil
+}
+
+// newAppearanceXObject returns a form XObject of the size of `frame` with the content `content`,
+// using the font `font` if not nil.
+func newAppearanceXObject(frame *widgetFrame, font *appearanceFont, content []byte) (*XObjectForm, error) {
+	xform := NewXObjectForm()
+	xform.BBox = MakeArrayFromFloats([]float64{0, 0, frame.width, frame.height})
+	if frame.matrix != nil {
+		xform.Matrix = MakeArrayFromFloats(frame.matrix)
+	}
+	xform.Resources = NewPdfPageResources()
+	if font != nil {
+		xform.Resources.SetFontByName(font.name, font.obj)
+	}
+	xform.Filter = NewFlateEncoder()
+	if err := xform.SetContentStream(content, xform.Filter); err != nil {
+		return nil, err
+	}
+	return xform, nil
+}
+
+// generateTextAppearance generates the normal appearance of the widget annotation `widget` of the
+// text or choice field, showing its value.
+func (this *PdfField) generateTextAppearance(widget *PdfAnnotationWidget) error {
+	frame, err := newWidgetFrame(widget)
+	if err != nil {
+		return err
+	}
+	da := this.defaultAppearance()
+	font, err := loadAppearanceFont(this.getForm(), da.font)
+	if err != nil {
+		return err
+	}
+	flags := this.GetFlags()
+	maxLen := int64(0)
+	if n, ok := this.inherited("MaxLen").(*PdfObjectInteger); ok {
+		maxLen = int64(*n)
+	}
+	comb := this.GetFieldType() == "Tx" && flags.Has(FieldFlagComb) && maxLen > 0 &&
+		flags&(FieldFlagMultiline|FieldFlagPassword|FieldFlagFileSelect) == 0
+
+	var b bytes.Buffer
+	frame.drawBackground(&b)
+	inset := frame.inset()
+	if comb && frame.borderWidth > 0 {
+		// The cells of comb fields are separated by lines of the border.
+		cell := frame.width / float64(maxLen)
+		fmt.Fprintf(&b, "q\n%s\n%s w\n", mkColorOperator(frame.mk.Get("BC"), true), appearanceNumber(frame.borderWidth))
+		for i := int64(1); i < maxLen; i++ {
+			x := appearanceNumber(cell * float64(i))
+			fmt.Fprintf(&b, "%s 0 m %s %s l S\n", x, x, appearanceNumber(frame.height))
+		}
+		b.WriteString("Q\n")
+	}
+
+	x, y := inset, inset
+	w, h := frame.width-2*inset, frame.height-2*inset
+	b.WriteString("/Tx BMC\nq\n")
+	fmt.Fprintf(&b, "%s %s %s %s re W n\n", appearanceNumber(x), appearanceNumber(y), appearanceNumber(w),
+		appearanceNumber(h))
+
+	switch {
+	case this.GetFieldType() == "Ch" && !flags.Has(FieldFlagCombo):
+		this.layoutListBox(&b, font, da, x, y, w, h)
+	case this.GetFieldType() == "Ch":
+		text := ""
+		if values := this.selectedTexts(); len(values) > 0 {
+			text = values[0]
+		}
+		layoutLine(&b, font, da, text, this.quadding(), x, y, w, h)
+	default:
+		text := ""
+		if v, ok := this.inherited("V").(*PdfObjectString); ok {
+			text = decodeTextString(string(*v))
+		}
+		if flags.Has(FieldFlagPassword) {
+			text = strings.Repeat("*", len([]rune(text)))
+		}
+		switch {
+		case comb:
+			layoutComb(&b, font, da, text, maxLen, x, y, w, h)
+		case flags.Has(FieldFlagMultiline):
+			layoutMultiline(&b, font, da, text, this.quadding(), x, y, w, h)
+		default:
+			layoutLine(&b, font, da, text, this.quadding(), x, y, w, h)
+		}
+	}
+	b.WriteString("Q\nEMC\n")
+	return setNormalAppearance(widget, frame, font, b.Bytes())
+}
+
+// baseline returns the baseline of a line of text of font size `size` centered vertically between
+// `y` and `y`+`h`.
+func baseline(y, h, size float64) float64 {
+	// The text is assumed to extend from 0.2 below to 0.8 above the baseline.
+	return y + h/2 - 0.3*size
+}
+
+// alignedX returns the start of a line of width `tw` aligned by `q` between `x` and `x`+`w`.
+func alignedX(q int64, x, w, tw float64) float64 {
+	switch q {
+	case 1:
+		return x + (w-tw)/2
+	case 2:
+		return x + w - appearancePadding - tw
+	}
+	return x + appearancePadding
+}
+
+// layoutLine writes `text` on a single line of the rectangle (`x`, `y`, `w`, `h`) aligned by `q`
+// to `b`.  Auto sized text fits in the rectangle.
+func layoutLine(b *bytes.Buffer, font *appearanceFont, da defaultAppearance, text string, q int64, x, y, w, h float64) {
+	if text == "" {
+		return
+	}
+	tw := font.width(text)
+	size := da.size
+	if size <= 0 {
+		size = (h - 2*appearancePadding) / appearanceLeading
+		if tw > 0 && tw*size/1000 > w-2*appearancePadding {
+			size = (w - 2*appearancePadding) * 1000 / tw
+		}
+		size = math.Max(size, appearanceMinFontSize)
+	}
+	font.showText(b, text, alignedX(q, x, w, tw*size/1000), baseline(y, h, size), size, da.color)
+}
+
+// layoutMultiline writes `text` wrapped in lines from the top of the rectangle (`x`, `y`, `w`, `h`)
+// aligned by `q` to `b`.  Auto sized text is shrunk until the lines fit in the rectangle.
+func layoutMultiline(b *bytes.Buffer, font *appearanceFont, da defaultAppearance, text string, q int64, x, y, w, h float64) {
+	if text == "" {
+		return
+	}
+	lineWidth := w - 2*appearancePadding
+	size := da.size
+	if size <= 0 {
+		size = appearanceFontSize
+		for size > appearanceMinFontSize {
+			lines := font.wrapText(text, lineWidth*1000/size)
+			if float64(len(lines))*size*appearanceLeading <= h-2*appearancePadding {
+				break
+			}
+			size -= 0.5
+		}
+	}
+	top := y + h - appearancePadding - 0.8*size
+	for i, line := range font.wrapText(text, lineWidth*1000/size) {
+		ly := top - float64(i)*size*appearanceLeading
+		if ly+size < y {
+			break
+		}
+		font.showText(b, line, alignedX(q, x, w, font.width(line)*size/1000), ly, size, da.color)
+	}
+}
+
+// layoutComb writes the characters of `text` centered in `maxLen` cells of the rectangle (`x`, `y`,
+// `w`, `h`) to `b`.
+func layoutComb(b *bytes.Buffer, font *appearanceFont, da defaultAppearance, text string, maxLen int64, x, y, w, h float64) {
+	cell := w / float64(maxLen)
+	size := da.size
+	if size <= 0 {
+		size = (h - 2*appearancePadding) / appearanceLeading
+		for _, r := range text {
+			if cw := font.width(string(r)); cw*size/1000 > cell {
+				size = cell * 1000 / cw
+			}
+		}
+		size = math.Max(size, appearanceMinFontSize)
+	}
+	for i, r := range []rune(text) {
+		cw := font.width(string(r)) * size / 1000
+		font.showText(b, string(r), x+cell*float64(i)+(cell-cw)/2, baseline(y, h, size), size, da.color)
+	}
+}
+
+// layoutListBox writes the items of the list box field to `b`, from its top index (TI) at the top
+// of the rectangle (`x`, `y`, `w`, `h`), highlighting the selected items.
+func (this *PdfField) layoutListBox(b *bytes.Buffer, font *appearanceFont, da defaultAppearance, x, y, w, h float64) {
+	options := this.choiceOptions()
+	selected := map[int]bool{}
+	if indices, ok := this.inherited("I").(*PdfObjectArray); ok {
+		for _, obj := range *indices {
+			if i, ok := TraceToDirectObject(obj).(*PdfObjectInteger); ok {
+				selected[int(*i)] = true
+			}
+		}
+	} else {
+		for _, text := range this.selectedTexts() {
+			for i, option := range options {
+				if option.text == text {
+					selected[i] = true
+				}
+			}
+		}
+	}
+	top := 0
+	if ti, ok := this.inherited("TI").(*PdfObjectInteger); ok && int(*ti) > 0 {
+		top = int(*ti)
+	}
+	size := da.size
+	if size <= 0 {
+		size = appearanceFontSize
+	}
+
+	rowHeight := size * appearanceLeading
+	rowTop := y + h
+	for i := top; i < len(options) && rowTop > y; i++ {
+		if selected[i] {
+			fmt.Fprintf(b, "0.6 0.75 0.86 rg\n%s %s %s %s re f\n", appearanceNumber(x),
+				appearanceNumber(rowTop-rowHeight), appearanceNumber(w), appearanceNumber(rowHeight))
+		}
+		font.showText(b, options[i].text, x+appearancePadding, baseline(rowTop-rowHeight, rowHeight, size), size,
+			da.color)
+		rowTop -= rowHeight
+	}
+}
+
+// generateButtonAppearance generates the normal appearances of the widget annotation `widget` of
+// the check box or radio button field that are missing, for its on state `on` and its Off state.
+// The existing appearances of the states are kept.  The on state shows the character of its
+// appearance characteristics (MK CA) in ZapfDingbats, by default a check mark for check boxes and
+// a bullet for radio buttons.
+func (this *PdfField) generateButtonAppearance(widget *PdfAnnotationWidget, on PdfObjectName) error {
+	frame, err := newWidgetFrame(widget)
+	if err != nil {
+		return err
+	}
+	da := this.defaultAppearance()
+	caption := "4"
+	if this.GetFlags().Has(FieldFlagRadio) {
+		caption = "l"
+	}
+	if ca, ok := TraceToDirectObject(frame.mk.Get("CA")).(*PdfObjectString); ok && len(*ca) > 0 {
+		caption = string(*ca)[:1]
+	}
+
+	fontDict := MakeDict()
+	fontDict.Set("Type", MakeName("Font"))
+	fontDict.Set("Subtype", MakeName("Type1"))
+	fontDict.Set("BaseFont", MakeName("ZapfDingbats"))
+	font := &appearanceFont{name: "ZaDb", obj: fontDict}
+
+	glyphWidth := 1000.0
+	if glyph, ok := textencoding.NewZapfDingbatsEncoder().CharcodeToGlyph(caption[0]); ok {
+		if metrics, ok := fonts.NewFontZapfDingbats().GetGlyphCharMetrics(glyph); ok && metrics.Wx > 0 {
+			glyphWidth = metrics.Wx
+		}
+	}
+	inset := frame.inset() + appearancePadding
+	w, h := frame.width-2*inset, frame.height-2*inset
+	size := da.size
+	if size <= 0 {
+		size = math.Max(math.Min(h, w*1000/glyphWidth), 1)
+	}
+
+	var off bytes.Buffer
+	frame.drawBackground(&off)
+	onContent := bytes.NewBuffer(append([]byte(nil), off.Bytes()...))
+	fmt.Fprintf(onContent, "q\nBT\n/%s %s Tf\n%s\n%s Td\n%s Tj\nET\nQ\n", font.name, appearanceNumber(size), da.color,
+		appearancePoint(inset+(w-glyphWidth*size/1000)/2, baseline(inset, h, size)),
+		MakeString(caption).DefaultWriteString())
+
+	ap, ok := TraceToDirectObject(widget.AP).(*PdfObjectDictionary)
+	if !ok {
+		ap = MakeDict()
+		widget.AP = ap
+	}
+	n, ok := TraceToDirectObject(ap.Get("N")).(*PdfObjectDictionary)
+	if !ok {
+		n = MakeDict()
+		ap.Set("N", n)
+	}
+	if n.Get(on) == nil {
+		onXObject, err := newAppearanceXObject(frame, font, onContent.Bytes())
+		if err != nil {
+			return err
+		}
+		n.Set(on, onXObject.ToPdfObject())
+	}
+	if n.Get("Off") == nil {
+		offXObject, err := newAppearanceXObject(frame, nil, off.Bytes())
+		if err != nil {
+			return err
+		}
+		n.Set("Off", offXObject.ToPdfObject())
+	}
+	return nil
+}
@@ -0,0 +1,554 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"errors"
+	"sort"
+	"unicode/utf16"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model/textencoding"
+)
+
+// Maximum depth of field hierarchies, to guard against cycles of Parent entries.
+const maxFieldDepth = 32
+
+// FieldFlag is a set of field flags (Ff), whose meaning depends on the field type (section
+// 12.7.3.1 and 12.7.4).
+type FieldFlag uint32
+
+// Field flags of all fields (Table 221).
+const (
+	FieldFlagReadOnly FieldFlag = 1 << 0
+	FieldFlagRequired FieldFlag = 1 << 1
+	FieldFlagNoExport FieldFlag = 1 << 2
+)
+
+// Field flags of button fields (Table 226).
+const (
+	FieldFlagNoToggleToOff  FieldFlag = 1 << 14
+	FieldFlagRadio          FieldFlag = 1 << 15
+	FieldFlagPushbutton     FieldFlag = 1 << 16
+	FieldFlagRadiosInUnison FieldFlag = 1 << 25
+)
+
+// Field flags of text fields (Table 228).
+const (
+	FieldFlagMultiline       FieldFlag = 1 << 12
+	FieldFlagPassword        FieldFlag = 1 << 13
+	FieldFlagFileSelect      FieldFlag = 1 << 20
+	FieldFlagDoNotSpellCheck FieldFlag = 1 << 22
+	FieldFlagDoNotScroll     FieldFlag = 1 << 23
+	FieldFlagComb            FieldFlag = 1 << 24
+	FieldFlagRichText        FieldFlag = 1 << 25
+)
+
+// Field flags of choice fields (Table 230).
+const (
+	FieldFlagCombo             FieldFlag = 1 << 17
+	FieldFlagEdit              FieldFlag = 1 << 18
+	FieldFlagSort              FieldFlag = 1 << 19
+	FieldFlagMultiSelect       FieldFlag = 1 << 21
+	FieldFlagCommitOnSelChange FieldFlag = 1 << 26
+)
+
+// Has returns true if all the flags of `flag` are set.
+func (f FieldFlag) Has(flag FieldFlag) bool {
+	return f&flag == flag
+}
+
+// GetFieldByName returns the terminal field with the fully qualified name `name` (section
+// 12.7.3.2), e.g. "address.city".
+func (this *PdfAcroForm) GetFieldByName(name string) (*PdfField, bool) {
+	var found *PdfField
+	this.forEachTerminalField(func(field *PdfField, fullName string, ft *PdfObjectName) error {
+		if found == nil && fullName == name {
+			found = field
+		}
+		return nil
+	})
+	return found, found != nil
+}
+
+// GetTerminalFields returns the terminal fields of the form, which have values and widget
+// annotations, in the order of the field hierarchy.
+func (this *PdfAcroForm) GetTerminalFields() []*PdfField {
+	var fields []*PdfField
+	this.forEachTerminalField(func(field *PdfField, name string, ft *PdfObjectName) error {
+		fields = append(fields, field)
+		return nil
+	})
+	return fields
+}
+
+// forEachTerminalField calls `f` for each terminal field of the form with its full name and
+// (inherited) field type.
+func (this *PdfAcroForm) forEachTerminalField(f func(field *PdfField, name string, ft *PdfObjectName) error) error {
+	if this.Fields == nil {
+		return nil
+	}
+	var visit func(field *PdfField, prefix string, ft *PdfObjectName, depth int) error
+	visit = func(field *PdfField, prefix string, ft *PdfObjectName, depth int) error {
+		if depth >= maxFieldDepth {
+			return errors.New("Field hierarchy too deep")
+		}
+		field.form = this
+		name := prefix
+		if t, ok := TraceToDirectObject(field.T).(*PdfObjectString); ok {
+			if name != "" {
+				name += "."
+			}
+			name += decodeTextString(string(*t))
+		}
+		if field.FT != nil {
+			ft = field.FT
+		}
+		// Kids without partial names are widget annotations of terminal fields.
+		var children []*PdfField
+		for _, kid := range field.KidsF {
+			if child, ok := kid.(*PdfField); ok && child.T != nil {
+				children = append(children, child)
+			}
+		}
+		if len(children) == 0 {
+			return f(field, name, ft)
+		}
+		for _, child := range children {
+			if err := visit(child, name, ft, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, field := range *this.Fields {
+		if err := visit(field, "", nil, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FullName returns the fully qualified name of the field (section 12.7.3.2), the partial names of
+// the field and its ancestors separated by periods.
+func (this *PdfField) FullName() string {
+	name := ""
+	for field, depth := this, 0; field != nil && depth < maxFieldDepth; field, depth = field.Parent, depth+1 {
+		t, ok := TraceToDirectObject(field.T).(*PdfObjectString)
+		if !ok {
+			continue
+		}
+		if name != "" {
+			name = "." + name
+		}
+		name = decodeTextString(string(*t)) + name
+	}
+	return name
+}
+
+// GetFieldType returns the field type (FT) of the field, inherited from its ancestors: Btn, Tx, Ch
+// or Sig.  Returns an empty name if not set.
+func (this *PdfField) GetFieldType() PdfObjectName {
+	if ft, ok := this.inherited("FT").(*PdfObjectName); ok {
+		return *ft
+	}
+	return ""
+}
+
+// GetFlags returns the field flags (Ff) of the field, inherited from its ancestors.
+func (this *PdfField) GetFlags() FieldFlag {
+	if ff, ok := this.inherited("Ff").(*PdfObjectInteger); ok {
+		return FieldFlag(*ff)
+	}
+	return 0
+}
+
+// GetWidgets returns the widget annotations of the terminal field, merged in its dictionary or
+// its kids.
+func (this *PdfField) GetWidgets() []*PdfAnnotationWidget {
+	var widgets []*PdfAnnotationWidget
+	annotations := this.KidsA
+	for _, kid := range this.KidsF {
+		if child, ok := kid.(*PdfField); ok && child.T == nil {
+			annotations = append(annotations, child.KidsA...)
+		}
+	}
+	for _, annotation := range annotations {
+		if widget, ok := annotation.GetContext().(*PdfAnnotationWidget); ok {
+			widgets = append(widgets, widget)
+		}
+	}
+	return widgets
+}
+
+// getForm returns the form of the field, known by its root field.  Returns nil if not known.
+func (this *PdfField) getForm() *PdfAcroForm {
+	field := this
+	for depth := 0; field.form == nil && field.Parent != nil && depth < maxFieldDepth; depth++ {
+		field = field.Parent
+	}
+	return field.form
+}
+
+// inherited returns the entry `key` of the field dictionary, inherited from the ancestors of the
+// field (section 12.7.3.1) if not set.  Returns nil if not found.
+func (this *PdfField) inherited(key PdfObjectName) PdfObject {
+	for field, depth := this, 0; field != nil && depth < maxFieldDepth; field, depth = field.Parent, depth+1 {
+		var obj PdfObject
+		switch key {
+		case "FT":
+			if field.FT != nil {
+				obj = field.FT
+			}
+		case "Ff":
+			obj = field.Ff
+		case "V":
+			obj = field.V
+		case "DA":
+			obj = field.DA
+		case "Q":
+			obj = field.Q
+		default:
+			if d, ok := field.primitive.PdfObject.(*PdfObjectDictionary); ok {
+				obj = d.Get(key)
+			}
+		}
+		if obj = TraceToDirectObject(obj); obj != nil {
+			return obj
+		}
+	}
+	return nil
+}
+
+// setValue sets the value (V) of the field.  The dictionary of the field is updated as well, so
+// that the value is written with the widget annotations of loaded fields.
+func (this *PdfField) setValue(val PdfObject) {
+	this.V = val
+	this.primitive.PdfObject.(*PdfObjectDictionary).Set("V", val)
+}
+
+// SetText sets the value of the text field to `text`, and generates the appearances of its widget
+// annotations.
+func (this *PdfField) SetText(text string) error {
+	if ft := this.GetFieldType(); ft != "Tx" {
+		common.Log.Debug("ERROR: Field %s is not a text field (%s)", this.FullName(), ft)
+		return errors.New("Not a text field")
+	}
+	if maxLen, ok := this.inherited("MaxLen").(*PdfObjectInteger); ok && len([]rune(text)) > int(*maxLen) {
+		common.Log.Debug("ERROR: Value of %d characters exceeds MaxLen %d", len([]rune(text)), *maxLen)
+		return errors.New("Value too long")
+	}
+	this.setValue(makeTextString(text))
+	return this.GenerateAppearances()
+}
+
+// SetCheckbox checks or unchecks the check box field.  The appearance state of its widget
+// annotations is set to their on state if `checked` and to Off otherwise, and their appearances are
+// generated if they have none for that state.
+func (this *PdfField) SetCheckbox(checked bool) error {
+	if ft := this.GetFieldType(); ft != "Btn" || this.GetFlags()&(FieldFlagRadio|FieldFlagPushbutton) != 0 {
+		common.Log.Debug("ERROR: Field %s is not a check box (%s)", this.FullName(), ft)
+		return errors.New("Not a check box")
+	}
+	widgets := this.GetWidgets()
+	value := PdfObjectName("Off")
+	if checked {
+		value = "Yes"
+		if len(widgets) > 0 {
+			value = onState(widgets[0])
+		}
+	}
+	this.setValue(MakeName(string(value)))
+	for _, widget := range widgets {
+		state := PdfObjectName("Off")
+		if checked {
+			state = onState(widget)
+		}
+		if err := this.setButtonState(widget, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetRadio selects the button of the radio button field whose on state, or export value in the
+// Opt array of the field, is `option`, and deselects the others.  Buttons with the same on state
+// are selected together.
+func (this *PdfField) SetRadio(option string) error {
+	if ft := this.GetFieldType(); ft != "Btn" || !this.GetFlags().Has(FieldFlagRadio) {
+		common.Log.Debug("ERROR: Field %s is not a radio button field (%s)", this.FullName(), ft)
+		return errors.New("Not a radio button field")
+	}
+	opt, _ := this.inherited("Opt").(*PdfObjectArray)
+	widgets := this.GetWidgets()
+	var selected PdfObjectName
+	for i, widget := range widgets {
+		state := onState(widget)
+		export := string(state)
+		if opt != nil && i < len(*opt) {
+			if s, ok := TraceToDirectObject((*opt)[i]).(*PdfObjectString); ok {
+				export = decodeTextString(string(*s))
+			}
+		}
+		if export == option || string(state) == option {
+			selected = state
+			break
+		}
+	}
+	if selected == "" {
+		common.Log.Debug("ERROR: Field %s has no button %q", this.FullName(), option)
+		return errors.New("Option not found")
+	}
+
+	this.setValue(MakeName(string(selected)))
+	for _, widget := range widgets {
+		state := PdfObjectName("Off")
+		if onState(widget) == selected {
+			state = selected
+		}
+		if err := this.setButtonState(widget, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetChoice selects the items `values` of the list box or combo box field, by their export values
+// or texts.  Combo boxes have a single value, which need not be an item if editable, and list
+// boxes several only if multiple selection is allowed.  The appearances of its widget annotations
+// are generated.
+func (this *PdfField) SetChoice(values ...string) error {
+	if ft := this.GetFieldType(); ft != "Ch" {
+		common.Log.Debug("ERROR: Field %s is not a choice field (%s)", this.FullName(), ft)
+		return errors.New("Not a choice field")
+	}
+	flags := this.GetFlags()
+	if len(values) > 1 && (flags.Has(FieldFlagCombo) || !flags.Has(FieldFlagMultiSelect)) {
+		common.Log.Debug("ERROR: Field %s does not allow multiple selection", this.FullName())
+		return errors.New("Multiple selection not allowed")
+	}
+
+	options := this.choiceOptions()
+	var indices []int
+	var exports []PdfObject
+	for _, value := range values {
+		index := -1
+		for i, option := range options {
+			if option.export == value || option.text == value {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			if !flags.Has(FieldFlagCombo | FieldFlagEdit) {
+				common.Log.Debug("ERROR: %q is not an item of field %s", value, this.FullName())
+				return errors.New("Item not found")
+			}
+			exports = append(exports, makeTextString(value))
+			continue
+		}
+		indices = append(indices, index)
+		exports = append(exports, makeTextString(options[index].export))
+	}
+
+	dict := this.primitive.PdfObject.(*PdfObjectDictionary)
+	switch len(exports) {
+	case 0:
+		this.setValue(MakeNull())
+	case 1:
+		this.setValue(exports[0])
+	default:
+		this.setValue(MakeArray(exports...))
+	}
+	if len(indices) > 0 && flags.Has(FieldFlagMultiSelect) {
+		sort.Ints(indices)
+		arr := MakeArray()
+		for _, i := range indices {
+			arr.Append(MakeInteger(int64(i)))
+		}
+		dict.Set("I", arr)
+	} else {
+		dict.Remove("I")
+	}
+	return this.GenerateAppearances()
+}
+
+// choiceOption is an item of a choice field, with its export value and displayed text.
+type choiceOption struct {
+	export, text string
+}
+
+// choiceOptions returns the items (Opt) of the choice field.
+func (this *PdfField) choiceOptions() []choiceOption {
+	opt, ok := this.inherited("Opt").(*PdfObjectArray)
+	if !ok {
+		return nil
+	}
+	var options []choiceOption
+	for _, obj := range *opt {
+		switch t := TraceToDirectObject(obj).(type) {
+		case *PdfObjectString:
+			text := decodeTextString(string(*t))
+			options = append(options, choiceOption{export: text, text: text})
+		case *PdfObjectArray:
+			if len(*t) != 2 {
+				continue
+			}
+			export, ok1 := TraceToDirectObject((*t)[0]).(*PdfObjectString)
+			text, ok2 := TraceToDirectObject((*t)[1]).(*PdfObjectString)
+			if ok1 && ok2 {
+				options = append(options, choiceOption{export: decodeTextString(string(*export)),
+					text: decodeTextString(string(*text))})
+			}
+		}
+	}
+	return options
+}
+
+// selectedTexts returns the displayed texts of the values of the choice field.
+func (this *PdfField) selectedTexts() []string {
+	var values []string
+	switch t := this.inherited("V").(type) {
+	case *PdfObjectString:
+		values = append(values, decodeTextString(string(*t)))
+	case *PdfObjectArray:
+		for _, obj := range *t {
+			if s, ok := TraceToDirectObject(obj).(*PdfObjectString); ok {
+				values = append(values, decodeTextString(string(*s)))
+			}
+		}
+	}
+	options := this.choiceOptions()
+	for i, value := range values {
+		for _, option := range options {
+			if option.export == value {
+				values[i] = option.text
+				break
+			}
+		}
+	}
+	return values
+}
+
+// onState returns the name of the on state of the button widget annotation `widget`, the state of
+// its normal appearances other than Off.  Defaults to Yes.
+func onState(widget *PdfAnnotationWidget) PdfObjectName {
+	if ap, ok := TraceToDirectObject(widget.AP).(*PdfObjectDictionary); ok {
+		if n, ok := TraceToDirectObject(ap.Get("N")).(*PdfObjectDictionary); ok {
+			for _, key := range n.Keys() {
+				if key != "Off" {
+					return key
+				}
+			}
+		}
+	}
+	return "Yes"
+}
+
+// setButtonState sets the appearance state of the button widget annotation `widget` to `state`,
+// generating its appearances if it has none for that state.
+func (this *PdfField) setButtonState(widget *PdfAnnotationWidget, state PdfObjectName) error {
+	hasState := false
+	if ap, ok := TraceToDirectObject(widget.AP).(*PdfObjectDictionary); ok {
+		if n, ok := TraceToDirectObject(ap.Get("N")).(*PdfObjectDictionary); ok {
+			hasState = n.Get(state) != nil
+		}
+	}
+	widget.AS = MakeName(string(state))
+	if !hasState {
+		if err := this.generateButtonAppearance(widget, onState(widget)); err != nil {
+			return err
+		}
+	}
+	widget.ToPdfObject()
+	return nil
+}
+
+// GenerateAppearances generates the normal appearances of the widget annotations of the terminal
+// field from its value, as set by SetText, SetCheckbox, SetRadio and SetChoice, so that it is
+// shown without NeedAppearances.  The appearances of buttons are only generated for those without
+// appearances.
+func (this *PdfField) GenerateAppearances() error {
+	for _, widget := range this.GetWidgets() {
+		if err := this.generateAppearance(widget); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generateAppearance generates the normal appearance of the widget annotation `widget` of the
+// terminal field, as GenerateAppearances.
+func (this *PdfField) generateAppearance(widget *PdfAnnotationWidget) error {
+	var err error
+	switch this.GetFieldType() {
+	case "Tx", "Ch":
+		err = this.generateTextAppearance(widget)
+	case "Btn":
+		if this.GetFlags().Has(FieldFlagPushbutton) {
+			return nil
+		}
+		if _, ok := TraceToDirectObject(widget.AP).(*PdfObjectDictionary); ok {
+			return nil
+		}
+		state := PdfObjectName("Off")
+		if v, ok := this.inherited("V").(*PdfObjectName); ok && *v == onState(widget) {
+			state = *v
+		}
+		widget.AS = MakeName(string(state))
+		err = this.generateButtonAppearance(widget, onState(widget))
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	widget.ToPdfObject()
+	return nil
+}
+
+// makeTextString returns `s` as a text string (section 7.9.2.2), in PDFDocEncoding if possible and
+// else in UTF-16BE.
+func makeTextString(s string) *PdfObjectString {
+	encoder := textencoding.NewPdfDocTextEncoder()
+	encoded := make([]byte, 0, len(s))
+	for _, r := range s {
+		code, ok := encoder.RuneToCharcode(r)
+		if !ok {
+			units := utf16.Encode([]rune(s))
+			encoded = []byte{0xFE, 0xFF}
+			for _, u := range units {
+				encoded = append(encoded, byte(u>>8), byte(u))
+			}
+			break
+		}
+		encoded = append(encoded, code)
+	}
+	return MakeString(string(encoded))
+}
+
+// decodeTextString returns the text string `s` (section 7.9.2.2) decoded from UTF-16BE if it
+// starts with the byte order mark, and else from PDFDocEncoding.
+func decodeTextString(s string) string {
+	if len(s) >= 2 && s[0] == 0xFE && s[1] == 0xFF {
+		units := make([]uint16, 0, len(s)/2)
+		for i := 2; i+1 < len(s); i += 2 {
+			units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+	encoder := textencoding.NewPdfDocTextEncoder()
+	runes := make([]rune, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if r, ok := encoder.CharcodeToRune(s[i]); ok {
+			runes = append(runes, r)
+		} else {
+			runes = append(runes, rune(s[i]))
+		}
+	}
+	return string(runes)
+}
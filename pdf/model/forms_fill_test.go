@@ -0,0 +1,320 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// newTestField returns a field named `name` of type `ft` with the flags `ff`.
+func newTestField(name string, ft string, ff int64) *PdfField {
+	field := NewPdfField()
+	field.T = MakeString(name)
+	if ft != "" {
+		field.FT = MakeName(ft)
+	}
+	if ff != 0 {
+		field.Ff = MakeInteger(ff)
+	}
+	return field
+}
+
+// addTestWidget adds a widget annotation of the rectangle `rect` to `field` and `page`.  The widget
+// is merged in the dictionary of the field if `merged`.
+func addTestWidget(field *PdfField, page *PdfPage, rect []float64, merged bool) *PdfAnnotationWidget {
+	widget := NewPdfAnnotationWidget()
+	widget.Rect = MakeArrayFromFloats(rect)
+	mk := MakeDict()
+	mk.Set("BC", MakeArrayFromFloats([]float64{0}))
+	mk.Set("BG", MakeArrayFromFloats([]float64{1}))
+	widget.MK = mk
+	if merged {
+		widget.primitive = field.primitive
+	} else {
+		widget.Parent = field.GetContainingPdfObject()
+	}
+	field.KidsA = append(field.KidsA, widget.PdfAnnotation)
+	page.Annotations = append(page.Annotations, widget.PdfAnnotation)
+	return widget
+}
+
+// writeTestForm writes a document with a form of text, check box, radio button and choice fields,
+// and a square annotation.
+func writeTestForm(t *testing.T) []byte {
+	page := newTestPage()
+	form := NewPdfAcroForm()
+	form.DA = MakeString("/Helv 0 Tf 0 g")
+	form.DR = NewPdfPageResources()
+	helv := MakeDict()
+	helv.Set("Type", MakeName("Font"))
+	helv.Set("Subtype", MakeName("Type1"))
+	helv.Set("BaseFont", MakeName("Helvetica"))
+	helv.Set("Encoding", MakeName("WinAnsiEncoding"))
+	form.DR.SetFontByName("Helv", MakeIndirectObject(helv))
+
+	person := newTestField("person", "", 0)
+	name := newTestField("name", "Tx", 0)
+	name.DA = MakeString("/Helv 12 Tf 0 0 1 rg")
+	name.Q = MakeInteger(1)
+	name.Parent = person
+	person.KidsF = []PdfModel{name}
+	addTestWidget(name, page, []float64{100, 700, 300, 720}, false)
+
+	notes := newTestField("notes", "Tx", int64(FieldFlagMultiline))
+	addTestWidget(notes, page, []float64{100, 600, 200, 680}, true)
+
+	code := newTestField("code", "Tx", int64(FieldFlagComb))
+	code.primitive.PdfObject.(*PdfObjectDictionary).Set("MaxLen", MakeInteger(4))
+	addTestWidget(code, page, []float64{100, 560, 180, 580}, true)
+
+	agree := newTestField("agree", "Btn", 0)
+	addTestWidget(agree, page, []float64{100, 520, 115, 535}, true)
+
+	size := newTestField("size", "Btn", int64(FieldFlagRadio|FieldFlagNoToggleToOff))
+	for i, state := range []string{"S", "L"} {
+		widget := addTestWidget(size, page, []float64{100 + 20*float64(i), 480, 115 + 20*float64(i), 495}, false)
+		// Radio buttons with appearances of their on states, as made by form editors.  The first
+		// one has no Off appearance.
+		n := MakeDict()
+		if i == 0 {
+			on, err := MakeStream([]byte("% Small\n"), nil)
+			if err != nil {
+				t.Fatalf("Failed to make stream: %v", err)
+			}
+			on.Set("BBox", MakeArrayFromFloats([]float64{0, 0, 15, 15}))
+			n.Set(PdfObjectName(state), on)
+		} else {
+			n.Set(PdfObjectName(state), MakeNull())
+			n.Set("Off", MakeNull())
+		}
+		ap := MakeDict()
+		ap.Set("N", n)
+		widget.AP = ap
+		widget.AS = MakeName("Off")
+	}
+
+	fruits := newTestField("fruits", "Ch", int64(FieldFlagMultiSelect))
+	fruits.primitive.PdfObject.(*PdfObjectDictionary).Set("Opt", MakeArray(MakeString("Apple"),
+		MakeArray(MakeString("b"), MakeString("Banana")), MakeString("Cherry")))
+	addTestWidget(fruits, page, []float64{300, 600, 400, 680}, true)
+
+	city := newTestField("city", "Ch", int64(FieldFlagCombo|FieldFlagEdit))
+	city.primitive.PdfObject.(*PdfObjectDictionary).Set("Opt", MakeArray(MakeString("Paris")))
+	addTestWidget(city, page, []float64{300, 560, 400, 580}, true)
+
+	form.Fields = &[]*PdfField{person, notes, code, agree, size, fruits, city}
+
+	// Annotation other than widgets, with an appearance scaled to its rectangle.
+	square := NewPdfAnnotationSquare()
+	square.Rect = MakeArrayFromFloats([]float64{400, 400, 440, 420})
+	appearance, err := MakeStream([]byte("0 0 20 10 re f"), nil)
+	if err != nil {
+		t.Fatalf("Failed to create stream: %v", err)
+	}
+	appearance.Set("BBox", MakeArrayFromFloats([]float64{0, 0, 20, 10}))
+	ap := MakeDict()
+	ap.Set("N", appearance)
+	square.AP = ap
+	page.Annotations = append(page.Annotations, square.PdfAnnotation)
+
+	return writeTestPages(t, []*PdfPage{page}, form)
+}
+
+// readTestForm returns the reader and the form of the document `data`.
+func readTestForm(t *testing.T, data []byte) (*PdfReader, *PdfAcroForm) {
+	reader := readTestDocument(t, data, nil)
+	if reader.AcroForm == nil {
+		t.Fatalf("No form")
+	}
+	return reader, reader.AcroForm
+}
+
+// getTestField returns the field `name` of `form`.
+func getTestField(t *testing.T, form *PdfAcroForm, name string) *PdfField {
+	field, found := form.GetFieldByName(name)
+	if !found {
+		t.Fatalf("Field %s not found", name)
+	}
+	return field
+}
+
+// normalAppearance returns the content of the normal appearance of `widget`, in the state `state`
+// if not empty.
+func normalAppearance(t *testing.T, widget *PdfAnnotationWidget, state PdfObjectName) string {
+	ap, ok := TraceToDirectObject(widget.AP).(*PdfObjectDictionary)
+	if !ok {
+		t.Fatalf("No appearance dictionary")
+	}
+	n := TraceToDirectObject(ap.Get("N"))
+	if state != "" {
+		states, ok := n.(*PdfObjectDictionary)
+		if !ok {
+			t.Fatalf("No appearance states")
+		}
+		n = TraceToDirectObject(states.Get(state))
+	}
+	stream, ok := n.(*PdfObjectStream)
+	if !ok {
+		t.Fatalf("No normal appearance (%T)", n)
+	}
+	content, err := DecodeStream(stream)
+	if err != nil {
+		t.Fatalf("Failed to decode appearance: %v", err)
+	}
+	return string(content)
+}
+
+// checkContains checks that `content` contains each of `expected`.
+func checkContains(t *testing.T, what, content string, expected ...string) {
+	for _, s := range expected {
+		if !strings.Contains(content, s) {
+			t.Errorf("%s does not contain %q:\n%s", what, s, content)
+		}
+	}
+}
+
+func TestFieldLookup(t *testing.T) {
+	_, form := readTestForm(t, writeTestForm(t))
+	var names []string
+	for _, field := range form.GetTerminalFields() {
+		names = append(names, field.FullName())
+	}
+	if strings.Join(names, ",") != "person.name,notes,code,agree,size,fruits,city" {
+		t.Errorf("Wrong terminal fields %v", names)
+	}
+	if _, found := form.GetFieldByName("person"); found {
+		t.Errorf("Non-terminal field found")
+	}
+
+	size := getTestField(t, form, "size")
+	if size.GetFieldType() != "Btn" || !size.GetFlags().Has(FieldFlagRadio|FieldFlagNoToggleToOff) {
+		t.Errorf("Wrong type %s and flags %b", size.GetFieldType(), size.GetFlags())
+	}
+	if n := len(size.GetWidgets()); n != 2 {
+		t.Errorf("Wrong number of widgets %d", n)
+	}
+	if n := len(getTestField(t, form, "notes").GetWidgets()); n != 1 {
+		t.Errorf("Wrong number of merged widgets %d", n)
+	}
+}
+
+func TestFieldFilling(t *testing.T) {
+	reader, form := readTestForm(t, writeTestForm(t))
+
+	name := getTestField(t, form, "person.name")
+	if err := name.SetText("Jürgen (Jr)"); err != nil {
+		t.Fatalf("Failed to set text: %v", err)
+	}
+	notes := getTestField(t, form, "notes")
+	if err := notes.SetText("Lorem ipsum dolor sit amet, consectetur adipiscing elit\nSed do"); err != nil {
+		t.Fatalf("Failed to set text: %v", err)
+	}
+	code := getTestField(t, form, "code")
+	if err := code.SetText("AB12"); err != nil {
+		t.Fatalf("Failed to set text: %v", err)
+	}
+	if err := code.SetText("ABCDE"); err == nil {
+		t.Errorf("Value longer than MaxLen set")
+	}
+	if err := getTestField(t, form, "agree").SetCheckbox(true); err != nil {
+		t.Fatalf("Failed to check: %v", err)
+	}
+	if err := getTestField(t, form, "size").SetRadio("L"); err != nil {
+		t.Fatalf("Failed to select radio button: %v", err)
+	}
+	if err := getTestField(t, form, "size").SetRadio("M"); err == nil {
+		t.Errorf("Unknown radio button selected")
+	}
+	if err := getTestField(t, form, "fruits").SetChoice("Banana", "Cherry"); err != nil {
+		t.Fatalf("Failed to select items: %v", err)
+	}
+	if err := getTestField(t, form, "city").SetChoice("Lyon"); err != nil {
+		t.Fatalf("Failed to edit combo box: %v", err)
+	}
+	if err := name.SetCheckbox(true); err == nil {
+		t.Errorf("Text field checked")
+	}
+
+	// The values and appearances are written with the loaded fields.
+	page, err := reader.GetPage(1)
+	if err != nil {
+		t.Fatalf("Failed to get page: %v", err)
+	}
+	_, form = readTestForm(t, writeTestPages(t, []*PdfPage{page}, form))
+
+	name = getTestField(t, form, "person.name")
+	if v, ok := TraceToDirectObject(name.V).(*PdfObjectString); !ok || decodeTextString(string(*v)) != "Jürgen (Jr)" {
+		t.Errorf("Wrong value %v", name.V)
+	}
+	checkContains(t, "Text appearance", normalAppearance(t, name.GetWidgets()[0], ""),
+		"1 g\n0 0 200 20 re f\n", "0 G\n1 w\n0.5 0.5 199 19 re S\n", "/Tx BMC\n", "1 1 198 18 re W n\n",
+		"/Helv 12 Tf\n0 0 1 rg\n", "(J\xfcrgen \\(Jr\\)) Tj", "EMC\n")
+
+	notesAppearance := normalAppearance(t, getTestField(t, form, "notes").GetWidgets()[0], "")
+	if n := strings.Count(notesAppearance, " Tj"); n < 3 {
+		t.Errorf("Text not wrapped in %d lines:\n%s", n, notesAppearance)
+	}
+	checkContains(t, "Comb appearance", normalAppearance(t, getTestField(t, form, "code").GetWidgets()[0], ""),
+		"20 0 m 20 20 l S\n", "(A) Tj", "(2) Tj")
+
+	agree := getTestField(t, form, "agree")
+	if v, ok := agree.V.(*PdfObjectName); !ok || *v != "Yes" {
+		t.Errorf("Wrong check box value %v", agree.V)
+	}
+	widget := agree.GetWidgets()[0]
+	if as, ok := widget.AS.(*PdfObjectName); !ok || *as != "Yes" {
+		t.Errorf("Wrong check box state %v", widget.AS)
+	}
+	checkContains(t, "Check box appearance", normalAppearance(t, widget, "Yes"), "/ZaDb", "(4) Tj")
+	normalAppearance(t, widget, "Off")
+
+	size := getTestField(t, form, "size")
+	if v, ok := size.V.(*PdfObjectName); !ok || *v != "L" {
+		t.Errorf("Wrong radio value %v", size.V)
+	}
+	for i, state := range []string{"Off", "L"} {
+		if as, ok := size.GetWidgets()[i].AS.(*PdfObjectName); !ok || string(*as) != state {
+			t.Errorf("Wrong state of radio button %d: %v", i, size.GetWidgets()[i].AS)
+		}
+	}
+	// Only the missing Off appearance is generated.
+	checkContains(t, "Radio button on appearance", normalAppearance(t, size.GetWidgets()[0], "S"), "% Small")
+	normalAppearance(t, size.GetWidgets()[0], "Off")
+
+	fruits := getTestField(t, form, "fruits")
+	if v, ok := fruits.V.(*PdfObjectArray); !ok || len(*v) != 2 {
+		t.Errorf("Wrong list box value %v", fruits.V)
+	}
+	checkContains(t, "List box appearance", normalAppearance(t, fruits.GetWidgets()[0], ""),
+		"(Apple) Tj", "0.6 0.75 0.86 rg\n", "(Banana) Tj", "(Cherry) Tj")
+	checkContains(t, "Combo box appearance",
+		normalAppearance(t, getTestField(t, form, "city").GetWidgets()[0], ""), "(Lyon) Tj")
+}
+
+func TestTextStrings(t *testing.T) {
+	for _, s := range []string{"Name", "Jürgen", "€ 5", "日本"} {
+		if decoded := decodeTextString(string(*makeTextString(s))); decoded != s {
+			t.Errorf("Text string %q decoded to %q", s, decoded)
+		}
+	}
+	if encoded := string(*makeTextString("日本")); !strings.HasPrefix(encoded, "\xfe\xff") {
+		t.Errorf("Text string not in UTF-16BE: %q", encoded)
+	}
+}
+
+func TestParseDefaultAppearance(t *testing.T) {
+	da := parseDefaultAppearance("/F1 9.5 Tf 1 0 0 rg")
+	if da.font != "F1" || da.size != 9.5 || da.color != "1 0 0 rg" {
+		t.Errorf("Wrong default appearance %+v", da)
+	}
+	da = parseDefaultAppearance("0.5 g /Helv 0 Tf")
+	if da.font != "Helv" || da.size != 0 || da.color != "0.5 g" {
+		t.Errorf("Wrong default appearance %+v", da)
+	}
+}
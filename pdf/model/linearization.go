@@ -0,0 +1,476 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// SetLinearization sets whether the output is linearized (Annex F), organized so that the first
+// page can be displayed before the whole file is read and the other pages accessed with range
+// requests.  Linearized output uses cross-reference tables: Write fails if object streams are also
+// enabled with SetObjectStreams.
+func (this *PdfWriter) SetLinearization(enable bool) {
+	this.linearize = enable
+}
+
+// writeLinearized writes a linearized file (section F.3), with the parts:
+//  1. Header.
+//  2. Linearization parameter dictionary.
+//  3. First-page cross-reference table and trailer.
+//  4. Document catalog and encryption dictionary.
+//  5. Primary hint stream.
+//  6. First page section: the first page and all the objects it uses.
+//  7. Remaining pages, each with the objects used only by it.
+//  8. Shared objects, used by several pages other than the first.
+//  9. Other objects.
+//  10. Main cross-reference table and trailer.
+//
+// The objects of parts 2 to 6 are numbered after those of parts 7 to 9, so that the two
+// cross-reference tables each have a single subsection.
+func (this *PdfWriter) writeLinearized(ws io.Writer) error {
+	var pages []*PdfIndirectObject
+	if pagesDict, ok := this.pages.PdfObject.(*PdfObjectDictionary); ok {
+		if kids, ok := pagesDict.Get("Kids").(*PdfObjectArray); ok {
+			for _, kid := range *kids {
+				if page, ok := kid.(*PdfIndirectObject); ok {
+					pages = append(pages, page)
+				}
+			}
+		}
+	}
+	if len(pages) == 0 {
+		common.Log.Debug("ERROR: Linearized output requires at least one page")
+		return errors.New("No pages")
+	}
+
+	// Objects used by each page, not following references to the other pages and the document
+	// level objects.
+	inDoc := map[PdfObject]bool{}
+	for _, obj := range this.objects {
+		inDoc[obj] = true
+	}
+	boundary := map[PdfObject]bool{this.root: true, this.pages: true, this.infoObj: true}
+	if this.encryptObj != nil {
+		boundary[this.encryptObj] = true
+	}
+	for _, page := range pages {
+		boundary[page] = true
+	}
+	pageObjs := make([][]PdfObject, len(pages))
+	usage := map[PdfObject]int{}
+	for i, page := range pages {
+		visited := map[PdfObject]bool{}
+		var visit func(obj PdfObject)
+		visit = func(obj PdfObject) {
+			forEachReferencedObject(obj, func(ref PdfObject) {
+				if boundary[ref] || visited[ref] || !inDoc[ref] {
+					return
+				}
+				visited[ref] = true
+				pageObjs[i] = append(pageObjs[i], ref)
+				usage[ref]++
+				visit(ref)
+			})
+		}
+		visit(page)
+	}
+
+	// Split the objects into the parts of the file.
+	assigned := map[PdfObject]bool{}
+	assign := func(part []PdfObject, obj PdfObject) []PdfObject {
+		assigned[obj] = true
+		return append(part, obj)
+	}
+	docPart := assign(nil, this.root)
+	if this.encryptObj != nil {
+		docPart = assign(docPart, this.encryptObj)
+	}
+	firstPagePart := assign(nil, pages[0])
+	for _, obj := range pageObjs[0] {
+		firstPagePart = assign(firstPagePart, obj)
+	}
+	pageParts := make([][]PdfObject, len(pages))
+	for i := 1; i < len(pages); i++ {
+		pageParts[i] = assign(nil, pages[i])
+		for _, obj := range pageObjs[i] {
+			if !assigned[obj] && usage[obj] == 1 {
+				pageParts[i] = assign(pageParts[i], obj)
+			}
+		}
+	}
+	var sharedPart []PdfObject
+	for i := 1; i < len(pages); i++ {
+		for _, obj := range pageObjs[i] {
+			if !assigned[obj] {
+				sharedPart = assign(sharedPart, obj)
+			}
+		}
+	}
+	var otherPart []PdfObject
+	for _, obj := range this.objects {
+		if !assigned[obj] {
+			otherPart = assign(otherPart, obj)
+		}
+	}
+
+	// Number the objects in the order of the file, parts 7 to 9 first.
+	var mainObjs []PdfObject
+	for _, part := range pageParts[1:] {
+		mainObjs = append(mainObjs, part...)
+	}
+	mainObjs = append(mainObjs, sharedPart...)
+	mainObjs = append(mainObjs, otherPart...)
+	objNum := int64(1)
+	for _, obj := range mainObjs {
+		setObjectNumber(obj, objNum)
+		objNum++
+	}
+	mainSize := objNum
+	linDictNum := objNum
+	objNum++
+	for _, obj := range docPart {
+		setObjectNumber(obj, objNum)
+		objNum++
+	}
+	hintNum := objNum
+	objNum++
+	for _, obj := range firstPagePart {
+		setObjectNumber(obj, objNum)
+		objNum++
+	}
+	size := objNum
+
+	// Encrypt and serialize the objects.
+	serialized := map[PdfObject][]byte{}
+	serialize := func(obj PdfObject) []byte {
+		var buf bytes.Buffer
+		w := bufio.NewWriter(&buf)
+		writeObject(w, objectNumber(obj), 0, obj)
+		w.Flush()
+		return buf.Bytes()
+	}
+	for _, obj := range this.objects {
+		if this.crypter != nil && obj != this.encryptObj {
+			if err := this.crypter.Encrypt(obj, objectNumber(obj), 0); err != nil {
+				common.Log.Debug("ERROR: Failed encrypting (%s)", err)
+				return err
+			}
+		}
+		serialized[obj] = serialize(obj)
+	}
+
+	// Part 2 and 3 have a fixed length, the values depending on the offsets are padded.
+	header := fmt.Sprintf("%%PDF-%d.%d\n%%âãÏÓ\n", this.majorVersion, this.minorVersion)
+	params := &LinearizationParams{FirstPageObject: objectNumber(pages[0]), NumPages: int64(len(pages))}
+	linDict := makeLinearizationDict(linDictNum, params)
+	firstXrefOffset := int64(len(header) + len(linDict))
+
+	firstPageEntries := []xrefEntry{{objNum: int(linDictNum), typ: xrefTypeInUse, offset: int64(len(header))}}
+	offset := firstXrefOffset + int64(len(this.makeFirstPageXref(firstPageEntries, size, linDictNum, hintNum, 0)))
+	for _, obj := range docPart {
+		firstPageEntries = append(firstPageEntries, xrefEntry{objNum: int(objectNumber(obj)), typ: xrefTypeInUse, offset: offset})
+		offset += int64(len(serialized[obj]))
+	}
+	hintOffset := offset
+
+	// Offsets of the following objects, as if the hint stream were not present.
+	offsets := map[PdfObject]int64{}
+	var firstPageEnd int64
+	for _, part := range [][]PdfObject{firstPagePart, mainObjs} {
+		for _, obj := range part {
+			offsets[obj] = offset
+			offset += int64(len(serialized[obj]))
+		}
+		if firstPageEnd == 0 {
+			firstPageEnd = offset
+		}
+	}
+	mainXrefOffset := offset
+
+	hints := makeLinearizationHints(pages, pageObjs, usage, firstPagePart, pageParts, sharedPart, offsets, serialized)
+	data, sharedOffset := hints.Encode()
+	hintStream, err := MakeStream(data, NewFlateEncoder())
+	if err != nil {
+		return err
+	}
+	hintStream.Set("S", MakeInteger(int64(sharedOffset)))
+	setObjectNumber(hintStream, hintNum)
+	if this.crypter != nil {
+		if err := this.crypter.Encrypt(hintStream, hintNum, 0); err != nil {
+			common.Log.Debug("ERROR: Failed encrypting (%s)", err)
+			return err
+		}
+	}
+	hintData := serialize(hintStream)
+	hintLength := int64(len(hintData))
+
+	firstPageEntries = append(firstPageEntries, xrefEntry{objNum: int(hintNum), typ: xrefTypeInUse, offset: hintOffset})
+	for _, obj := range firstPagePart {
+		firstPageEntries = append(firstPageEntries, xrefEntry{objNum: int(objectNumber(obj)), typ: xrefTypeInUse, offset: offsets[obj] + hintLength})
+	}
+	mainEntries := []xrefEntry{{typ: xrefTypeFree, gen: 65535}}
+	for _, obj := range mainObjs {
+		mainEntries = append(mainEntries, xrefEntry{objNum: int(objectNumber(obj)), typ: xrefTypeInUse, offset: offsets[obj] + hintLength})
+	}
+
+	// Part 10.
+	var mainXref bytes.Buffer
+	w := bufio.NewWriter(&mainXref)
+	writeXrefTable(w, mainEntries)
+	w.WriteString(fmt.Sprintf("trailer\n<</Size %d>>\nstartxref\n%d\n%%%%EOF\n", mainSize, firstXrefOffset))
+	w.Flush()
+
+	params.FileLength = mainXrefOffset + hintLength + int64(mainXref.Len())
+	params.HintOffset = hintOffset
+	params.HintLength = hintLength
+	params.FirstPageEnd = firstPageEnd + hintLength
+	// Offset of the end of line preceding the first entry.
+	params.MainXrefOffset = mainXrefOffset + hintLength + int64(len(fmt.Sprintf("xref\r\n0 %d\r\n", mainSize))) - 1
+
+	w = bufio.NewWriter(ws)
+	w.WriteString(header)
+	w.WriteString(makeLinearizationDict(linDictNum, params))
+	w.WriteString(this.makeFirstPageXref(firstPageEntries, size, linDictNum, hintNum, mainXrefOffset+hintLength))
+	for _, obj := range docPart {
+		w.Write(serialized[obj])
+	}
+	w.Write(hintData)
+	for _, part := range [][]PdfObject{firstPagePart, mainObjs} {
+		for _, obj := range part {
+			w.Write(serialized[obj])
+		}
+	}
+	w.Write(mainXref.Bytes())
+	return w.Flush()
+}
+
+// setObjectNumber sets the object number of the indirect or stream object `obj` to `num`.
+func setObjectNumber(obj PdfObject, num int64) {
+	switch t := obj.(type) {
+	case *PdfIndirectObject:
+		t.ObjectNumber, t.GenerationNumber = num, 0
+	case *PdfObjectStream:
+		t.ObjectNumber, t.GenerationNumber = num, 0
+	}
+}
+
+// makeLinearizationDict returns linearization parameter dictionary object `num` with parameters
+// `params`.  The values depending on offsets are padded to a fixed width.
+func makeLinearizationDict(num int64, params *LinearizationParams) string {
+	return fmt.Sprintf("%d 0 obj\n<</Linearized 1/L %-10d/H [%-10d %-10d]/O %d/E %-10d/N %d/T %-10d>>\nendobj\n",
+		num, params.FileLength, params.HintOffset, params.HintLength, params.FirstPageObject,
+		params.FirstPageEnd, params.NumPages, params.MainXrefOffset)
+}
+
+// makeFirstPageXref returns the first-page cross-reference table with entries `entries`, for
+// objects `linDictNum` to `size`-1, and its trailer.  The offset of the main cross-reference table
+// `mainXrefOffset` is padded to a fixed width.
+func (this *PdfWriter) makeFirstPageXref(entries []xrefEntry, size, linDictNum, hintNum, mainXrefOffset int64) string {
+	// Placeholder entries until the offsets are known, the table has one entry per object.
+	all := make([]xrefEntry, size-linDictNum)
+	for i := range all {
+		all[i] = xrefEntry{objNum: int(linDictNum) + i, typ: xrefTypeInUse}
+	}
+	for _, entry := range entries {
+		all[int64(entry.objNum)-linDictNum] = entry
+	}
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	writeXrefTable(w, all)
+	w.WriteString(fmt.Sprintf("trailer\n<</Size %d/Prev %-10d", size, mainXrefOffset))
+	trailer := MakeDict()
+	trailer.Set("Root", this.root)
+	trailer.Set("Info", this.infoObj)
+	if this.crypter != nil {
+		trailer.Set("Encrypt", this.encryptObj)
+		trailer.Set("ID", this.ids)
+	}
+	for _, key := range trailer.Keys() {
+		w.WriteString(key.DefaultWriteString())
+		w.WriteString(" ")
+		w.WriteString(trailer.Get(key).DefaultWriteString())
+	}
+	w.WriteString(">>\nstartxref\n0\n%%EOF\n")
+	w.Flush()
+	return buf.String()
+}
+
+// makeLinearizationHints makes the hint tables of the pages `pages` using the objects `pageObjs`,
+// `usage` being the number of pages using each object, given the objects of the parts of the file
+// and their offsets as if the hint stream were not present.  Each shared object is a group of its
+// own.  The content stream offsets and lengths of the pages are set to 0 and the page lengths, as
+// the content streams of a page are not necessarily contiguous.
+func makeLinearizationHints(pages []*PdfIndirectObject, pageObjs [][]PdfObject, usage map[PdfObject]int,
+	firstPagePart []PdfObject, pageParts [][]PdfObject, sharedPart []PdfObject,
+	offsets map[PdfObject]int64, serialized map[PdfObject][]byte) *LinearizationHints {
+	hints := &LinearizationHints{}
+	hints.FirstPageOffset = offsets[pages[0]]
+	hints.NumFirstPageShared = int64(len(firstPagePart))
+
+	// Shared object identifiers: the objects of the first page section, then the shared objects.
+	ids := map[PdfObject]int64{}
+	for _, part := range [][]PdfObject{firstPagePart, sharedPart} {
+		for _, obj := range part {
+			ids[obj] = int64(len(hints.SharedObjectGroups))
+			hints.SharedObjectGroups = append(hints.SharedObjectGroups,
+				SharedObjectGroupHint{Length: int64(len(serialized[obj])), NumObjects: 1})
+		}
+	}
+	if len(sharedPart) > 0 {
+		hints.FirstSharedObject = objectNumber(sharedPart[0])
+		hints.FirstSharedOffset = offsets[sharedPart[0]]
+	}
+
+	for i := range pages {
+		var hint PageOffsetHint
+		objs := pageParts[i]
+		if i == 0 {
+			objs = firstPagePart
+		}
+		for _, obj := range objs {
+			hint.PageLength += int64(len(serialized[obj]))
+		}
+		hint.NumObjects = int64(len(objs))
+		hint.ContentLength = hint.PageLength
+
+		for _, obj := range pageObjs[i] {
+			if usage[obj] > 1 {
+				hint.SharedObjects = append(hint.SharedObjects, ids[obj])
+				hint.Numerators = append(hint.Numerators, 0)
+			}
+		}
+		hints.Pages = append(hints.Pages, hint)
+	}
+	return hints
+}
+
+// IsLinearized returns true if the document is linearized, i.e. its first object is a
+// linearization parameter dictionary.  Use CheckLinearization to check that the linearization data
+// is valid.
+func (this *PdfReader) IsLinearized() (bool, error) {
+	params, err := this.parser.GetLinearizationParams()
+	if err != nil {
+		return false, err
+	}
+	return params != nil, nil
+}
+
+// reXrefFirstEntry matches the first entry of a cross-reference table, preceded by white space.
+var reXrefFirstEntry = regexp.MustCompile(`^\s+\d{10} \d{5} f`)
+
+// CheckLinearization returns true if the document is linearized and its linearization data is
+// consistent with the file: the linearization parameters, and the page and shared object offsets
+// of the hint tables.  The file is no longer linearized if it was updated incrementally.  An
+// encrypted document has to be decrypted first.
+func (this *PdfReader) CheckLinearization() (bool, error) {
+	params, err := this.parser.GetLinearizationParams()
+	if err != nil {
+		common.Log.Debug("Invalid linearization parameters: %v", err)
+		return false, nil
+	}
+	if params == nil {
+		return false, nil
+	}
+
+	fileSize, err := this.rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return false, err
+	}
+	if params.FileLength != fileSize {
+		common.Log.Debug("Linearization: File length %d != %d", params.FileLength, fileSize)
+		return false, nil
+	}
+	if params.NumPages != int64(len(this.pageList)) || len(this.pageList) == 0 {
+		common.Log.Debug("Linearization: Number of pages %d != %d", params.NumPages, len(this.pageList))
+		return false, nil
+	}
+	if params.FirstPageObject != this.pageList[0].ObjectNumber {
+		common.Log.Debug("Linearization: First page object %d != %d", params.FirstPageObject,
+			this.pageList[0].ObjectNumber)
+		return false, nil
+	}
+	firstPageOffset, ok := this.parser.GetObjectOffset(int(params.FirstPageObject))
+	if !ok || firstPageOffset >= params.FirstPageEnd || params.FirstPageEnd > fileSize {
+		common.Log.Debug("Linearization: Invalid first page end %d", params.FirstPageEnd)
+		return false, nil
+	}
+
+	// The main cross-reference table.
+	if params.MainXrefOffset < 0 || params.MainXrefOffset >= fileSize {
+		common.Log.Debug("Linearization: Invalid main cross-reference table offset %d", params.MainXrefOffset)
+		return false, nil
+	}
+	if _, err := this.rs.Seek(params.MainXrefOffset, io.SeekStart); err != nil {
+		return false, err
+	}
+	buf := make([]byte, 32)
+	n, _ := io.ReadFull(this.rs, buf)
+	if !reXrefFirstEntry.Match(buf[:n]) {
+		common.Log.Debug("Linearization: No cross-reference table at %d", params.MainXrefOffset)
+		return false, nil
+	}
+
+	// The hint tables, with offsets as if the hint stream were not present.
+	if params.HintOffset < 0 || params.HintLength < 0 || params.HintOffset+params.HintLength > fileSize {
+		common.Log.Debug("Linearization: Invalid hint stream location %d %d", params.HintOffset, params.HintLength)
+		return false, nil
+	}
+	hints, err := this.parser.GetLinearizationHints(params)
+	if err != nil {
+		common.Log.Debug("Linearization: Invalid hint stream: %v", err)
+		return false, nil
+	}
+	adjust := func(offset int64) int64 {
+		if offset >= params.HintOffset {
+			return offset + params.HintLength
+		}
+		return offset
+	}
+
+	// The pages follow each other.
+	offset := hints.FirstPageOffset
+	for i, page := range this.pageList {
+		actual, ok := this.parser.GetObjectOffset(int(page.ObjectNumber))
+		if !ok || actual != adjust(offset) {
+			common.Log.Debug("Linearization: Page %d offset %d != %d", i+1, actual, adjust(offset))
+			return false, nil
+		}
+		offset += hints.Pages[i].PageLength
+		for _, id := range hints.Pages[i].SharedObjects {
+			if id >= int64(len(hints.SharedObjectGroups)) {
+				common.Log.Debug("Linearization: Invalid shared object identifier %d", id)
+				return false, nil
+			}
+		}
+	}
+
+	// The shared object groups of the first page section follow each other from the first page
+	// object, those of the shared objects section from its first object.
+	objNum, offset := params.FirstPageObject, hints.FirstPageOffset
+	for i, group := range hints.SharedObjectGroups {
+		if int64(i) == hints.NumFirstPageShared {
+			objNum, offset = hints.FirstSharedObject, hints.FirstSharedOffset
+		}
+		actual, ok := this.parser.GetObjectOffset(int(objNum))
+		if !ok || actual != adjust(offset) {
+			common.Log.Debug("Linearization: Shared object %d offset %d != %d", objNum, actual, adjust(offset))
+			return false, nil
+		}
+		objNum += group.NumObjects
+		offset += group.Length
+	}
+	return true, nil
+}
@@ -0,0 +1,128 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// checkLinearization checks whether document `data` is linearized with valid linearization data.
+func checkLinearization(t *testing.T, data []byte, password []byte) (bool, bool) {
+	reader := readTestDocument(t, data, password)
+	linearized, err := reader.IsLinearized()
+	if err != nil {
+		t.Fatalf("IsLinearized failed: %v", err)
+	}
+	valid, err := reader.CheckLinearization()
+	if err != nil {
+		t.Fatalf("CheckLinearization failed: %v", err)
+	}
+	return linearized, valid
+}
+
+func TestWriteLinearized(t *testing.T) {
+	plain := writeTestDocument(t, 2, nil)
+	if linearized, valid := checkLinearization(t, plain, nil); linearized || valid {
+		t.Errorf("Document not linearized reported as linearized (%t %t)", linearized, valid)
+	}
+
+	for _, numPages := range []int{1, 2, 7} {
+		data := writeTestDocument(t, numPages, func(w *PdfWriter) {
+			w.SetLinearization(true)
+		})
+		checkTestDocument(t, data, numPages, nil)
+		if linearized, valid := checkLinearization(t, data, nil); !linearized || !valid {
+			t.Errorf("%d pages: Wrong linearization (%t %t)", numPages, linearized, valid)
+		}
+		if i := bytes.Index(data, []byte("/Linearized")); i < 0 || i > 100 {
+			t.Errorf("%d pages: Linearization dictionary not at the start", numPages)
+		}
+
+		// Updating the document breaks the linearization.
+		updated := appendTestContent(t, data, nil, nil)
+		if linearized, valid := checkLinearization(t, updated, nil); !linearized || valid {
+			t.Errorf("%d pages: Updated document reported valid (%t %t)", numPages, linearized, valid)
+		}
+	}
+
+	// Objects shared between pages.
+	data := writeTestDocument(t, 4, func(w *PdfWriter) {
+		font := MakeIndirectObject(MakeDict())
+		font.PdfObject.(*PdfObjectDictionary).Set("Type", MakeName("Font"))
+		font.PdfObject.(*PdfObjectDictionary).Set("Subtype", MakeName("Type1"))
+		font.PdfObject.(*PdfObjectDictionary).Set("BaseFont", MakeName("Courier"))
+		pagesDict := w.pages.PdfObject.(*PdfObjectDictionary)
+		for i, kid := range *pagesDict.Get("Kids").(*PdfObjectArray) {
+			// The font is shared by pages 2 to 4, the content stream by pages 1 and 3.
+			pageDict := kid.(*PdfIndirectObject).PdfObject.(*PdfObjectDictionary)
+			if i > 0 {
+				resources := TraceToDirectObject(pageDict.Get("Resources")).(*PdfObjectDictionary)
+				resources.Set("Font", MakeDict())
+				resources.Get("Font").(*PdfObjectDictionary).Set("F1", font)
+			}
+			if i == 2 {
+				first := (*pagesDict.Get("Kids").(*PdfObjectArray))[0].(*PdfIndirectObject)
+				contents := first.PdfObject.(*PdfObjectDictionary).Get("Contents").(*PdfObjectArray)
+				pageDict.Get("Contents").(*PdfObjectArray).Append((*contents)[0])
+			}
+		}
+		w.addObject(font)
+		w.SetLinearization(true)
+	})
+	checkTestDocument(t, data, 4, nil)
+	if linearized, valid := checkLinearization(t, data, nil); !linearized || !valid {
+		t.Errorf("Shared objects: Wrong linearization (%t %t)", linearized, valid)
+	}
+
+	reader := readTestDocument(t, data, nil)
+	params, err := reader.parser.GetLinearizationParams()
+	if err != nil || params == nil {
+		t.Fatalf("No linearization parameters: %v", err)
+	}
+	hints, err := reader.parser.GetLinearizationHints(params)
+	if err != nil {
+		t.Fatalf("Failed to load hints: %v", err)
+	}
+	if n := len(hints.SharedObjectGroups) - int(hints.NumFirstPageShared); n != 1 {
+		t.Errorf("Wrong number of shared objects %d", n)
+	}
+	for i, page := range hints.Pages {
+		if n := len(page.SharedObjects); n != map[int]int{0: 1, 1: 1, 2: 2, 3: 1}[i] {
+			t.Errorf("Page %d: Wrong number of shared objects %d", i+1, n)
+		}
+	}
+
+	// Encrypted.
+	data = writeTestDocument(t, 3, func(w *PdfWriter) {
+		if err := w.Encrypt([]byte("user"), []byte("owner"), &EncryptOptions{Algorithm: AES_128bit}); err != nil {
+			t.Fatalf("Failed to set encryption: %v", err)
+		}
+		w.SetLinearization(true)
+	})
+	checkTestDocument(t, data, 3, []byte("user"))
+	if linearized, valid := checkLinearization(t, data, []byte("user")); !linearized || !valid {
+		t.Errorf("Encrypted: Wrong linearization (%t %t)", linearized, valid)
+	}
+
+	// Object streams are not supported with linearization.
+	f, err := ioutil.TempFile("", "unidoc-linearization-test")
+	if err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	w := NewPdfWriter()
+	w.SetLinearization(true)
+	w.SetObjectStreams(true)
+	if err := w.Write(f); err == nil {
+		t.Errorf("Linearization with object streams written without error")
+	}
+}
@@ -295,11 +295,11 @@ func newPdfTilingPatternFromDictionary(dict *PdfObjectDictionary) (*PdfTilingPat
 		common.Log.Debug("Resources missing")
 		return nil, ErrRequiredAttributeMissing
 	}
-	dict, ok = TraceToDirectObject(obj).(*PdfObjectDictionary)
+	resDict, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
 	if !ok {
 		return nil, fmt.Errorf("Invalid resource dictionary (%T)", obj)
 	}
-	resources, err := NewPdfPageResourcesFromDict(dict)
+	resources, err := NewPdfPageResourcesFromDict(resDict)
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +307,7 @@ func newPdfTilingPatternFromDictionary(dict *PdfObjectDictionary) (*PdfTilingPat
 
 	// Matrix (optional).
 	if obj := dict.Get("Matrix"); obj != nil {
-		arr, ok := obj.(*PdfObjectArray)
+		arr, ok := TraceToDirectObject(obj).(*PdfObjectArray)
 		if !ok {
 			common.Log.Debug("Matrix not an array (got %T)", obj)
 			return nil, ErrTypeError
@@ -337,7 +337,7 @@ func newPdfShadingPatternFromDictionary(dict *PdfObjectDictionary) (*PdfShadingP
 
 	// Matrix (optional).
 	if obj := dict.Get("Matrix"); obj != nil {
-		arr, ok := obj.(*PdfObjectArray)
+		arr, ok := TraceToDirectObject(obj).(*PdfObjectArray)
 		if !ok {
 			common.Log.Debug("Matrix not an array (got %T)", obj)
 			return nil, ErrTypeError
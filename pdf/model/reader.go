@@ -6,6 +6,8 @@
 package model
 
 import (
+	"crypto"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
@@ -18,6 +20,7 @@ import (
 // PdfReader represents a PDF file reader. It is a frontend to the lower level parsing mechanism and provides
 // a higher level access to work with PDF structure and information, such as the page structure etc.
 type PdfReader struct {
+	rs          io.ReadSeeker
 	parser      *PdfParser
 	root        PdfObject
 	pages       *PdfObjectDictionary
@@ -39,6 +42,7 @@ type PdfReader struct {
 // not encrypted).
 func NewPdfReader(rs io.ReadSeeker) (*PdfReader, error) {
 	pdfReader := &PdfReader{}
+	pdfReader.rs = rs
 	pdfReader.traversed = map[PdfObject]bool{}
 
 	pdfReader.modelManager = NewModelManager()
@@ -121,6 +125,28 @@ func (this *PdfReader) Decrypt(password []byte) (bool, error) {
 	return true, nil
 }
 
+// DecryptWithKey decrypts the PDF file encrypted with the public-key security handler with the
+// private key `key` of the recipient certificate `cert`.  Returns true if successful, false if
+// `cert` is not one of the recipients.  The permissions of the recipient are returned by
+// CheckAccessRights.
+func (this *PdfReader) DecryptWithKey(cert *x509.Certificate, key crypto.Decrypter) (bool, error) {
+	success, err := this.parser.DecryptWithKey(cert, key)
+	if err != nil {
+		return false, err
+	}
+	if !success {
+		return false, nil
+	}
+
+	err = this.loadStructure()
+	if err != nil {
+		common.Log.Debug("ERROR: Fail to load structure (%s)", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
 // CheckAccessRights checks access rights and permissions for a specified password.  If either user/owner
 // password is specified,  full rights are granted, otherwise the access rights are specified by the
 // Permissions flag.
@@ -465,6 +491,7 @@ func (this *PdfReader) loadForms() (*PdfAcroForm, error) {
 	if err != nil {
 		return nil, err
 	}
+	container, _ := obj.(*PdfIndirectObject)
 	obj = TraceToDirectObject(obj)
 	if _, isNull := obj.(*PdfObjectNull); isNull {
 		common.Log.Trace("Acroform is a null object (empty)\n")
@@ -493,6 +520,12 @@ func (this *PdfReader) loadForms() (*PdfAcroForm, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The form keeps its dictionary, so that it is written back in place.
+	if container != nil {
+		acroForm.primitive = container
+	} else {
+		acroForm.primitive.PdfObject = formsDict
+	}
 
 	return acroForm, nil
 }
@@ -23,6 +23,8 @@ type PdfPageResources struct {
 	Font       PdfObject
 	ProcSet    PdfObject
 	Properties PdfObject
+	// Fonts set by SetFont, by resource name.
+	fonts map[PdfObjectName]*PdfFont
 	// Primitive reource container.
 	primitive *PdfObjectDictionary
 }
@@ -252,9 +254,30 @@ func (r *PdfPageResources) SetFontByName(keyName PdfObjectName, obj PdfObject) e
 	}
 
 	fontDict.Set(keyName, obj)
+	delete(r.fonts, keyName)
 	return nil
 }
 
+// SetFont sets the font specified by keyName to `font`.  Unlike SetFontByName, the font is kept so
+// that the writer can subset fonts created from TrueType files.
+func (r *PdfPageResources) SetFont(keyName PdfObjectName, font *PdfFont) error {
+	if err := r.SetFontByName(keyName, font.ToPdfObject()); err != nil {
+		return err
+	}
+	if r.fonts == nil {
+		r.fonts = map[PdfObjectName]*PdfFont{}
+	}
+	r.fonts[keyName] = font
+	return nil
+}
+
+// GetFont returns the font set by SetFont with the specified keyName.  Returns a bool value
+// indicating whether or not the font was found.
+func (r *PdfPageResources) GetFont(keyName PdfObjectName) (*PdfFont, bool) {
+	font, has := r.fonts[keyName]
+	return font, has
+}
+
 func (r *PdfPageResources) GetColorspaceByName(keyName PdfObjectName) (PdfColorspace, bool) {
 	if r.ColorSpace == nil {
 		return nil, false
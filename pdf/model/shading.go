@@ -364,6 +364,7 @@ func newPdfShadingType2FromDictionary(dict *PdfObjectDictionary) (*PdfShadingTyp
 		common.Log.Debug("Required attribute missing:  Coords")
 		return nil, ErrRequiredAttributeMissing
 	}
+	obj = TraceToDirectObject(obj)
 	arr, ok := obj.(*PdfObjectArray)
 	if !ok {
 		common.Log.Debug("Coords not an array (got %T)", obj)
@@ -439,6 +440,7 @@ func newPdfShadingType3FromDictionary(dict *PdfObjectDictionary) (*PdfShadingTyp
 		common.Log.Debug("Required attribute missing: Coords")
 		return nil, ErrRequiredAttributeMissing
 	}
+	obj = TraceToDirectObject(obj)
 	arr, ok := obj.(*PdfObjectArray)
 	if !ok {
 		common.Log.Debug("Coords not an array (got %T)", obj)
@@ -545,7 +547,7 @@ func newPdfShadingType4FromDictionary(dict *PdfObjectDictionary) (*PdfShadingTyp
 		common.Log.Debug("BitsPerFlag not an integer (got %T)", obj)
 		return nil, ErrTypeError
 	}
-	shading.BitsPerComponent = integer
+	shading.BitsPerFlag = integer
 
 	// Decode (required).
 	obj = dict.Get("Decode")
@@ -560,15 +562,20 @@ func newPdfShadingType4FromDictionary(dict *PdfObjectDictionary) (*PdfShadingTyp
 	}
 	shading.Decode = arr
 
-	// Function (required).
-	obj = dict.Get("Function")
-	if obj == nil {
-		common.Log.Debug("Required attribute missing:  Function")
-		return nil, ErrRequiredAttributeMissing
-	}
-	shading.Function = []PdfFunction{}
-	if array, is := obj.(*PdfObjectArray); is {
-		for _, obj := range *array {
+	// Function (optional).
+	if obj := dict.Get("Function"); obj != nil {
+		// Function (required).
+		shading.Function = []PdfFunction{}
+		if array, is := obj.(*PdfObjectArray); is {
+			for _, obj := range *array {
+				function, err := newPdfFunctionFromPdfObject(obj)
+				if err != nil {
+					common.Log.Debug("Error parsing function: %v", err)
+					return nil, err
+				}
+				shading.Function = append(shading.Function, function)
+			}
+		} else {
 			function, err := newPdfFunctionFromPdfObject(obj)
 			if err != nil {
 				common.Log.Debug("Error parsing function: %v", err)
@@ -576,15 +583,9 @@ func newPdfShadingType4FromDictionary(dict *PdfObjectDictionary) (*PdfShadingTyp
 			}
 			shading.Function = append(shading.Function, function)
 		}
-	} else {
-		function, err := newPdfFunctionFromPdfObject(obj)
-		if err != nil {
-			common.Log.Debug("Error parsing function: %v", err)
-			return nil, err
-		}
-		shading.Function = append(shading.Function, function)
 	}
 
+
 	return &shading, nil
 }
 
@@ -711,7 +712,7 @@ func newPdfShadingType6FromDictionary(dict *PdfObjectDictionary) (*PdfShadingTyp
 		common.Log.Debug("BitsPerFlag not an integer (got %T)", obj)
 		return nil, ErrTypeError
 	}
-	shading.BitsPerComponent = integer
+	shading.BitsPerFlag = integer
 
 	// Decode (required).
 	obj = dict.Get("Decode")
@@ -792,7 +793,7 @@ func newPdfShadingType7FromDictionary(dict *PdfObjectDictionary) (*PdfShadingTyp
 		common.Log.Debug("BitsPerFlag not an integer (got %T)", obj)
 		return nil, ErrTypeError
 	}
-	shading.BitsPerComponent = integer
+	shading.BitsPerFlag = integer
 
 	// Decode (required).
 	obj = dict.Get("Decode")
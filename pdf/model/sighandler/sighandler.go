@@ -0,0 +1,87 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package sighandler implements signature handlers creating CMS based digital signatures of PDF
+// documents: adbe.pkcs7.detached signatures and PAdES baseline (B-B) ETSI.CAdES.detached
+// signatures.
+package sighandler
+
+import (
+	"crypto"
+	"crypto/x509"
+	"errors"
+	"hash"
+	"time"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/pkcs7"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Space reserved for the signature value, in addition to the certificates.
+const signatureReserve = 8192
+
+// cmsDetached is a signature handler creating CMS SignedData signatures of detached content.
+type cmsDetached struct {
+	signer    crypto.Signer
+	certs     []*x509.Certificate
+	hash      crypto.Hash
+	subFilter string
+	pades     bool
+}
+
+// NewAdobePKCS7Detached creates a signature handler for adbe.pkcs7.detached signatures made with
+// `signer`, whose certificate is the first of the chain `certs`.  The digest algorithm is SHA-256.
+func NewAdobePKCS7Detached(signer crypto.Signer, certs []*x509.Certificate) (model.SignatureHandler, error) {
+	if len(certs) == 0 {
+		return nil, errors.New("No signing certificate")
+	}
+	return &cmsDetached{signer: signer, certs: certs, hash: crypto.SHA256, subFilter: "adbe.pkcs7.detached"}, nil
+}
+
+// NewEtsiPAdESDetached creates a signature handler for ETSI.CAdES.detached signatures conforming to
+// the PAdES B-B level made with `signer`, whose certificate is the first of the chain `certs`.
+// The signed attributes include the signing-certificate-v2 attribute, the signing time is that of
+// the signature dictionary.  The digest algorithm is SHA-256.
+func NewEtsiPAdESDetached(signer crypto.Signer, certs []*x509.Certificate) (model.SignatureHandler, error) {
+	if len(certs) == 0 {
+		return nil, errors.New("No signing certificate")
+	}
+	return &cmsDetached{signer: signer, certs: certs, hash: crypto.SHA256, subFilter: "ETSI.CAdES.detached", pades: true}, nil
+}
+
+// InitSignature implements interface model.SignatureHandler.
+func (this *cmsDetached) InitSignature(sig *model.PdfSignature) error {
+	sig.Filter = core.MakeName("Adobe.PPKLite")
+	sig.SubFilter = core.MakeName(this.subFilter)
+
+	size := signatureReserve
+	for _, cert := range this.certs {
+		size += len(cert.Raw)
+	}
+	sig.Contents = core.MakeString(string(make([]byte, size)))
+	return nil
+}
+
+// NewDigest implements interface model.SignatureHandler.
+func (this *cmsDetached) NewDigest(sig *model.PdfSignature) (hash.Hash, error) {
+	return this.hash.New(), nil
+}
+
+// Sign implements interface model.SignatureHandler.
+func (this *cmsDetached) Sign(sig *model.PdfSignature, digest hash.Hash) error {
+	opts := &pkcs7.SignOptions{Hash: this.hash}
+	if this.pades {
+		opts.SigningCertificateV2 = true
+	} else {
+		opts.SigningTime = time.Now()
+	}
+	data, err := pkcs7.Sign(digest.Sum(nil), this.signer, this.certs, opts)
+	if err != nil {
+		return err
+	}
+	sig.Contents = core.MakeString(string(data))
+	return nil
+}
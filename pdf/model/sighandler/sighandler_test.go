@@ -0,0 +1,549 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package sighandler
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/pkcs7"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// makeTestCertificate creates a certificate for `key` signed by `parent` with `parentKey`, or a
+// self-signed one if `parent` is nil.
+func makeTestCertificate(t *testing.T, name string, key crypto.Signer, parent *x509.Certificate,
+	parentKey crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+	return cert
+}
+
+// writeTestDocument writes a document with `numPages` pages and a text field Name on page 1.
+func writeTestDocument(t *testing.T, numPages int, password []byte) []byte {
+	w := model.NewPdfWriter()
+
+	field := model.NewPdfField()
+	field.FT = core.MakeName("Tx")
+	field.T = core.MakeString("Name")
+	field.V = core.MakeString("Alice")
+	widget := model.NewPdfAnnotationWidget()
+	widget.Rect = core.MakeArray(core.MakeInteger(100), core.MakeInteger(700), core.MakeInteger(300), core.MakeInteger(720))
+	widget.Parent = field.GetContainingPdfObject()
+	field.KidsA = []*model.PdfAnnotation{widget.PdfAnnotation}
+	form := model.NewPdfAcroForm()
+	form.Fields = &[]*model.PdfField{field}
+	w.SetForms(form)
+
+	for i := 0; i < numPages; i++ {
+		page := model.NewPdfPage()
+		page.MediaBox = &model.PdfRectangle{Urx: 612, Ury: 792}
+		page.Resources = model.NewPdfPageResources()
+		page.AddContentStreamByString("BT (Contract) Tj ET")
+		if i == 0 {
+			page.Annotations = []*model.PdfAnnotation{widget.PdfAnnotation}
+		}
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Failed to add page: %v", err)
+		}
+	}
+	if password != nil {
+		if err := w.Encrypt(password, password, nil); err != nil {
+			t.Fatalf("Failed to set encryption: %v", err)
+		}
+	}
+	f, err := ioutil.TempFile("", "unidoc-sighandler-test")
+	if err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err := w.Write(f); err != nil {
+		t.Fatalf("Failed to write document: %v", err)
+	}
+	data, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatalf("Failed to read document: %v", err)
+	}
+	return data
+}
+
+// readTestDocument reads document `data`.
+func readTestDocument(t *testing.T, data []byte, password []byte) *model.PdfReader {
+	reader, err := model.NewPdfReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to read document: %v", err)
+	}
+	if password != nil {
+		if ok, err := reader.Decrypt(password); err != nil || !ok {
+			t.Fatalf("Failed to decrypt document: %v", err)
+		}
+	}
+	return reader
+}
+
+// writeTestUpdate writes the incremental update of document `data` made by `update`.
+func writeTestUpdate(t *testing.T, data []byte, password []byte, update func(*model.PdfReader, *model.PdfAppender)) []byte {
+	reader := readTestDocument(t, data, password)
+	appender, err := model.NewPdfAppender(reader)
+	if err != nil {
+		t.Fatalf("Failed to create appender: %v", err)
+	}
+	update(reader, appender)
+
+	var buf bytes.Buffer
+	if err := appender.Write(&buf); err != nil {
+		t.Fatalf("Failed to write document: %v", err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), data) {
+		t.Fatalf("Original data changed")
+	}
+	return buf.Bytes()
+}
+
+// signTestDocument signs page `pageNum` of document `data` with `handler`.  `setup` is called for
+// the signature before signing if not nil.
+func signTestDocument(t *testing.T, data []byte, password []byte, pageNum int,
+	handler model.SignatureHandler, setup func(*model.PdfSignature)) []byte {
+	return writeTestUpdate(t, data, password, func(reader *model.PdfReader, appender *model.PdfAppender) {
+		sig := model.NewPdfSignature(handler)
+		sig.Reason = core.MakeString("Contract approval")
+		if setup != nil {
+			setup(sig)
+		}
+		field := model.NewPdfFieldSignature(sig)
+		field.T = core.MakeString("Signature" + strconv.Itoa(pageNum))
+		if err := appender.Sign(pageNum, field); err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+		if err := appender.Sign(pageNum, field); err == nil {
+			t.Errorf("Signed twice in an update")
+		}
+	})
+}
+
+// fillTestField sets the value of the text field Name of document `data` to `value`.
+func fillTestField(t *testing.T, data []byte, password []byte, value string) []byte {
+	return writeTestUpdate(t, data, password, func(reader *model.PdfReader, appender *model.PdfAppender) {
+		page, err := reader.GetPage(1)
+		if err != nil {
+			t.Fatalf("Failed to get page: %v", err)
+		}
+		widget := page.Annotations[0].GetContainingPdfObject().(*core.PdfIndirectObject)
+		field, ok := widget.PdfObject.(*core.PdfObjectDictionary).Get("Parent").(*core.PdfIndirectObject)
+		if !ok {
+			t.Fatalf("Field not found")
+		}
+		field.PdfObject.(*core.PdfObjectDictionary).Set("V", core.MakeString(value))
+		if err := appender.UpdateObject(field); err != nil {
+			t.Fatalf("Failed to update field: %v", err)
+		}
+	})
+}
+
+// appendTestContent adds a content stream to page 1 of document `data`.
+func appendTestContent(t *testing.T, data []byte, password []byte) []byte {
+	return writeTestUpdate(t, data, password, func(reader *model.PdfReader, appender *model.PdfAppender) {
+		page, err := reader.GetPage(1)
+		if err != nil {
+			t.Fatalf("Failed to get page: %v", err)
+		}
+		page.AddContentStreamByString("BT (Void) Tj ET")
+		if err := appender.UpdateObject(page.ToPdfObject()); err != nil {
+			t.Fatalf("Failed to update page: %v", err)
+		}
+	})
+}
+
+// nestTestPages moves the contents of page 1 of document `data` into an indirect array and page 2
+// into an intermediate page tree node.
+func nestTestPages(t *testing.T, data []byte, password []byte) []byte {
+	return writeTestUpdate(t, data, password, func(reader *model.PdfReader, appender *model.PdfAppender) {
+		page, err := reader.GetPage(1)
+		if err != nil {
+			t.Fatalf("Failed to get page: %v", err)
+		}
+		pageObj := page.GetContainingPdfObject().(*core.PdfIndirectObject)
+		pageDict := pageObj.PdfObject.(*core.PdfObjectDictionary)
+		pageDict.Set("Contents", &core.PdfIndirectObject{PdfObject: pageDict.Get("Contents")})
+
+		root := pageDict.Get("Parent").(*core.PdfIndirectObject)
+		kids := root.PdfObject.(*core.PdfObjectDictionary).Get("Kids").(*core.PdfObjectArray)
+		page2 := (*kids)[1].(*core.PdfIndirectObject)
+		nodeDict := core.MakeDict()
+		nodeDict.Set("Type", core.MakeName("Pages"))
+		nodeDict.Set("Parent", root)
+		nodeDict.Set("Kids", core.MakeArray(page2))
+		nodeDict.Set("Count", core.MakeInteger(1))
+		node := &core.PdfIndirectObject{PdfObject: nodeDict}
+		page2.PdfObject.(*core.PdfObjectDictionary).Set("Parent", node)
+		(*kids)[1] = node
+
+		for _, obj := range []core.PdfObject{pageObj, root, page2} {
+			if err := appender.UpdateObject(obj); err != nil {
+				t.Fatalf("Failed to update object: %v", err)
+			}
+		}
+	})
+}
+
+// insertTestPage inserts a page into the intermediate page tree node of document `data`, made by
+// nestTestPages, leaving the root node unchanged.
+func insertTestPage(t *testing.T, data []byte, password []byte) []byte {
+	return writeTestUpdate(t, data, password, func(reader *model.PdfReader, appender *model.PdfAppender) {
+		page, err := reader.GetPage(2)
+		if err != nil {
+			t.Fatalf("Failed to get page: %v", err)
+		}
+		node := page.GetContainingPdfObject().(*core.PdfIndirectObject).PdfObject.(*core.PdfObjectDictionary).
+			Get("Parent").(*core.PdfIndirectObject)
+		nodeDict := node.PdfObject.(*core.PdfObjectDictionary)
+		newPage := model.NewPdfPage()
+		newPage.MediaBox = &model.PdfRectangle{Urx: 612, Ury: 792}
+		newPage.AddContentStreamByString("BT (Void) Tj ET")
+		newPageObj := newPage.ToPdfObject().(*core.PdfIndirectObject)
+		newPageObj.PdfObject.(*core.PdfObjectDictionary).Set("Parent", node)
+		kids := nodeDict.Get("Kids").(*core.PdfObjectArray)
+		kids.Append(newPageObj)
+		nodeDict.Set("Count", core.MakeInteger(int64(len(*kids))))
+		if err := appender.UpdateObject(node); err != nil {
+			t.Fatalf("Failed to update page tree: %v", err)
+		}
+	})
+}
+
+// replaceTestContents replaces the content stream in the indirect contents array of page 1 of
+// document `data`, made by nestTestPages, leaving the page unchanged.
+func replaceTestContents(t *testing.T, data []byte, password []byte) []byte {
+	return writeTestUpdate(t, data, password, func(reader *model.PdfReader, appender *model.PdfAppender) {
+		page, err := reader.GetPage(1)
+		if err != nil {
+			t.Fatalf("Failed to get page: %v", err)
+		}
+		contents := page.GetContainingPdfObject().(*core.PdfIndirectObject).PdfObject.(*core.PdfObjectDictionary).
+			Get("Contents").(*core.PdfIndirectObject)
+		stream, err := core.MakeStream([]byte("BT (Void) Tj ET"), nil)
+		if err != nil {
+			t.Fatalf("Failed to make stream: %v", err)
+		}
+		contents.PdfObject = core.MakeArray(stream)
+		if err := appender.UpdateObject(contents); err != nil {
+			t.Fatalf("Failed to update contents: %v", err)
+		}
+	})
+}
+
+var reByteRange = regexp.MustCompile(`/ByteRange \[0 (\d+) +(\d+) +(\d+) *\]`)
+
+// checkSignatures checks that the signatures of `data`, in order, have the subfilters
+// `subFilters` and valid digests and that the last one covers the whole document.
+func checkSignatures(t *testing.T, data []byte, subFilters []string, cert *x509.Certificate) {
+	matches := reByteRange.FindAllSubmatch(data, -1)
+	if len(matches) != len(subFilters) {
+		t.Fatalf("Wrong number of signatures %d", len(matches))
+	}
+	for i, match := range matches {
+		var byteRange [3]int
+		for j := range byteRange {
+			byteRange[j], _ = strconv.Atoi(string(match[j+1]))
+		}
+		end := byteRange[1] + byteRange[2]
+		if i == len(matches)-1 && end != len(data) {
+			t.Errorf("%d: Signature does not cover the document (%d != %d)", i, end, len(data))
+		}
+		if data[byteRange[0]] != '<' || data[byteRange[1]-1] != '>' {
+			t.Fatalf("%d: Byte range not around Contents", i)
+		}
+
+		contents, err := hex.DecodeString(string(data[byteRange[0]+1 : byteRange[1]-1]))
+		if err != nil {
+			t.Fatalf("%d: Invalid Contents: %v", i, err)
+		}
+		sd, err := pkcs7.Parse(contents)
+		if err != nil {
+			t.Fatalf("%d: Failed to parse signature: %v", i, err)
+		}
+		signer := sd.Signers[0]
+		if signer.Certificate == nil || !signer.Certificate.Equal(cert) {
+			t.Errorf("%d: Wrong signing certificate", i)
+		}
+		digest := sha256.New()
+		digest.Write(data[:byteRange[0]])
+		digest.Write(data[byteRange[1]:end])
+		if md, err := signer.MessageDigest(); err != nil || !bytes.Equal(md, digest.Sum(nil)) {
+			t.Errorf("%d: Wrong digest (%v)", i, err)
+		}
+		pades := subFilters[i] == "ETSI.CAdES.detached"
+		if _, ok := signer.SignedAttributes[pkcs7.OIDAttributeSigningCertificateV2.String()]; ok != pades {
+			t.Errorf("%d: Wrong signing certificate attribute", i)
+		}
+		if _, ok := signer.SigningTime(); ok == pades {
+			t.Errorf("%d: Wrong signing time attribute", i)
+		}
+		if !bytes.Contains(data[byteRange[1]:], []byte("/SubFilter /"+subFilters[i])) {
+			t.Errorf("%d: SubFilter %s missing", i, subFilters[i])
+		}
+	}
+}
+
+// checkSignatureFields checks the signature fields of document `data`.
+func checkSignatureFields(t *testing.T, data []byte, password []byte, numFields int) {
+	reader := readTestDocument(t, data, password)
+	fields, err := reader.GetSignatureFields()
+	if err != nil {
+		t.Fatalf("Failed to get signature fields: %v", err)
+	}
+	if len(fields) != numFields {
+		t.Fatalf("Wrong number of signature fields %d", len(fields))
+	}
+	for i, field := range fields {
+		if name := field.FullName(); name != "Signature"+strconv.Itoa(i+1) {
+			t.Errorf("Wrong field name %s", name)
+		}
+		if field.V == nil || field.V.Reason == nil || *field.V.Reason != "Contract approval" {
+			t.Errorf("Wrong signature %+v", field.V)
+		}
+		if field.V != nil && (field.V.ByteRange == nil || len(*field.V.ByteRange) != 4) {
+			t.Errorf("Wrong byte range %v", field.V.ByteRange)
+		}
+	}
+	if flags := reader.AcroForm.SigFlags; flags == nil || *flags != 3 {
+		t.Errorf("Wrong SigFlags %v", flags)
+	}
+}
+
+func TestSign(t *testing.T) {
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	root := makeTestCertificate(t, "Test Root", rootKey, nil, nil)
+	cert := makeTestCertificate(t, "Test Signer", key, root, rootKey)
+	certs := []*x509.Certificate{cert, root}
+
+	pades, err := NewEtsiPAdESDetached(key, certs)
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	pkcs7Detached, err := NewAdobePKCS7Detached(key, certs)
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+
+	for _, password := range [][]byte{nil, []byte("password")} {
+		data := writeTestDocument(t, 2, password)
+
+		signed := signTestDocument(t, data, password, 1, pades, nil)
+		checkSignatures(t, signed, []string{"ETSI.CAdES.detached"}, cert)
+		checkSignatureFields(t, signed, password, 1)
+
+		// Signed again, the first signature covers the first revision.
+		signed = signTestDocument(t, signed, password, 2, pkcs7Detached, nil)
+		checkSignatures(t, signed, []string{"ETSI.CAdES.detached", "adbe.pkcs7.detached"}, cert)
+		checkSignatureFields(t, signed, password, 2)
+	}
+}
+
+// verifyTestDocument verifies the signatures of document `data` against `roots`.
+func verifyTestDocument(t *testing.T, data []byte, password []byte, roots *x509.CertPool) []*model.SignatureVerification {
+	results, err := readTestDocument(t, data, password).VerifySignatures(roots)
+	if err != nil {
+		t.Fatalf("Failed to verify signatures: %v", err)
+	}
+	return results
+}
+
+func TestVerify(t *testing.T) {
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	root := makeTestCertificate(t, "Test Root", rootKey, nil, nil)
+	otherRoot := makeTestCertificate(t, "Other Root", rootKey, nil, nil)
+	cert := makeTestCertificate(t, "Test Signer", key, root, rootKey)
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	otherRoots := x509.NewCertPool()
+	otherRoots.AddCert(otherRoot)
+
+	handler, err := NewEtsiPAdESDetached(key, []*x509.Certificate{cert, root})
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+
+	for _, password := range [][]byte{nil, []byte("password")} {
+		data := writeTestDocument(t, 2, password)
+		signed := signTestDocument(t, data, password, 1, handler, nil)
+
+		results := verifyTestDocument(t, signed, password, roots)
+		if len(results) != 1 {
+			t.Fatalf("Wrong number of signatures %d", len(results))
+		}
+		result := results[0]
+		if !result.Valid || !result.Trusted || len(result.Errors) > 0 {
+			t.Errorf("Signature not valid and trusted: %v", result.Errors)
+		}
+		if result.Certificate == nil || !result.Certificate.Equal(cert) || len(result.Chains) != 1 {
+			t.Errorf("Wrong certificate")
+		}
+		if result.Field.FullName() != "Signature1" || len(result.ByteRange) != 4 || result.AppendedBytes != 0 {
+			t.Errorf("Wrong signature %s %v %d", result.Field.FullName(), result.ByteRange, result.AppendedBytes)
+		}
+
+		// Untrusted.
+		for _, pool := range []*x509.CertPool{nil, otherRoots} {
+			result := verifyTestDocument(t, signed, password, pool)[0]
+			if !result.Valid || result.Trusted {
+				t.Errorf("Wrong verification with untrusted root (%t %t)", result.Valid, result.Trusted)
+			}
+		}
+
+		// Modified in the signed range.
+		modified := append([]byte{}, signed...)
+		modified[len(data)-3] = 'X'
+		if result := verifyTestDocument(t, modified, password, roots)[0]; result.Valid {
+			t.Errorf("Modified document verified")
+		}
+
+		// Bytes moved into the gap between the byte ranges, after the signature value.
+		match := reByteRange.FindSubmatch(signed)
+		end, _ := strconv.Atoi(string(match[2]))
+		wrapped := append([]byte{}, signed...)
+		if !bytes.HasSuffix(wrapped[:end], []byte("00>")) {
+			t.Fatalf("Signature value not padded")
+		}
+		copy(wrapped[end-3:end], ">  ")
+		if result := verifyTestDocument(t, wrapped, password, roots)[0]; result.Valid {
+			t.Errorf("Signature with bytes moved into the gap verified")
+		}
+
+		// Signature dictionary rewritten in an update, its signature value left in the gap.
+		wrapped = writeTestUpdate(t, signed, password, func(reader *model.PdfReader, appender *model.PdfAppender) {
+			fields, err := reader.GetSignatureFields()
+			if err != nil || len(fields) != 1 {
+				t.Fatalf("Failed to get signature field: %v", err)
+			}
+			if err := appender.UpdateObject(fields[0].V.GetContainingPdfObject()); err != nil {
+				t.Fatalf("Failed to update signature: %v", err)
+			}
+		})
+		if result := verifyTestDocument(t, wrapped, password, roots)[0]; result.Valid {
+			t.Errorf("Signature with rewritten dictionary verified")
+		}
+
+		// Content appended after the signature.
+		appended := appendTestContent(t, signed, password)
+		result = verifyTestDocument(t, appended, password, roots)[0]
+		if !result.Valid || result.AppendedBytes != int64(len(appended)-len(signed)) || len(result.Violations) > 0 {
+			t.Errorf("Wrong verification with content appended %t %d %v", result.Valid, result.AppendedBytes,
+				result.Violations)
+		}
+
+		// Certification permitting form filling and signing.
+		certified := signTestDocument(t, data, password, 1, handler, func(sig *model.PdfSignature) {
+			sig.SetDocMDP(model.DocMDPFillForms)
+		})
+		filled := fillTestField(t, certified, password, "Bob")
+		filled = signTestDocument(t, filled, password, 2, handler, nil)
+		results = verifyTestDocument(t, filled, password, roots)
+		if len(results) != 2 {
+			t.Fatalf("Wrong number of signatures %d", len(results))
+		}
+		for i, result := range results {
+			if !result.Valid || len(result.Violations) > 0 {
+				t.Errorf("%d: Wrong verification of permitted changes %t %v", i, result.Valid, result.Violations)
+			}
+		}
+		results = verifyTestDocument(t, appendTestContent(t, filled, password), password, roots)
+		if len(results[0].Violations) == 0 || len(results[1].Violations) > 0 {
+			t.Errorf("Wrong violations %v %v", results[0].Violations, results[1].Violations)
+		}
+
+		// Pages and contents changed without changing the certified pages.
+		nested := nestTestPages(t, data, password)
+		certified = signTestDocument(t, nested, password, 1, handler, func(sig *model.PdfSignature) {
+			sig.SetDocMDP(model.DocMDPFillForms)
+		})
+		filled = fillTestField(t, certified, password, "Bob")
+		if result := verifyTestDocument(t, filled, password, roots)[0]; len(result.Violations) > 0 {
+			t.Errorf("Violations of filled nested document %v", result.Violations)
+		}
+		inserted := insertTestPage(t, certified, password)
+		if result := verifyTestDocument(t, inserted, password, roots)[0]; len(result.Violations) == 0 {
+			t.Errorf("Page insertion not reported as violation")
+		}
+		replaced := replaceTestContents(t, certified, password)
+		if result := verifyTestDocument(t, replaced, password, roots)[0]; len(result.Violations) == 0 {
+			t.Errorf("Contents replacement not reported as violation")
+		}
+
+		// Certification permitting no changes.
+		certified = signTestDocument(t, data, password, 1, handler, func(sig *model.PdfSignature) {
+			sig.SetDocMDP(model.DocMDPNoChanges)
+		})
+		if result := verifyTestDocument(t, certified, password, roots)[0]; len(result.Violations) > 0 {
+			t.Errorf("Violations without changes %v", result.Violations)
+		}
+		signed = signTestDocument(t, certified, password, 2, handler, nil)
+		if result := verifyTestDocument(t, signed, password, roots)[0]; len(result.Violations) == 0 {
+			t.Errorf("Signing not reported as violation")
+		}
+
+		// Locked fields.
+		for _, action := range []string{"All", "Include", "Exclude"} {
+			locked := signTestDocument(t, data, password, 1, handler, func(sig *model.PdfSignature) {
+				sig.SetFieldMDP(action, []string{"Name"})
+			})
+			filled := fillTestField(t, locked, password, "Bob")
+			result := verifyTestDocument(t, filled, password, roots)[0]
+			if (len(result.Violations) > 0) != (action != "Exclude") {
+				t.Errorf("%s: Wrong violations %v", action, result.Violations)
+			}
+		}
+	}
+}
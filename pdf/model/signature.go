@@ -0,0 +1,559 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// SignatureHandler creates the signature values of digital signatures (section 12.8).
+type SignatureHandler interface {
+	// InitSignature sets the entries of the signature dictionary `sig` depending on the handler,
+	// such as Filter and SubFilter.  Contents is set to a string of zeros as long as the largest
+	// signature value the handler creates, so that space can be reserved for it.
+	InitSignature(sig *PdfSignature) error
+
+	// NewDigest creates the digest to which the signed byte ranges of the document are written.
+	NewDigest(sig *PdfSignature) (hash.Hash, error)
+
+	// Sign sets the Contents of `sig` to the signature value for `digest`, to which the signed
+	// byte ranges have been written.
+	Sign(sig *PdfSignature, digest hash.Hash) error
+}
+
+// PdfSignature represents a signature dictionary (section 12.8.1 Table 252).
+type PdfSignature struct {
+	Handler SignatureHandler
+
+	Type      *PdfObjectName
+	Filter    *PdfObjectName
+	SubFilter *PdfObjectName
+
+	// Signature value.  Written as a hexadecimal string, that is not encrypted.
+	Contents *PdfObjectString
+	// Certificates for adbe.x509.rsa_sha1 signatures.
+	Cert PdfObject
+	// Pairs of offsets and lengths of the signed byte ranges of the document.  Set when signing.
+	ByteRange *PdfObjectArray
+
+	Reference *PdfObjectArray
+	Changes   *PdfObjectArray
+
+	Name        *PdfObjectString
+	M           *PdfObjectString
+	Location    *PdfObjectString
+	Reason      *PdfObjectString
+	ContactInfo *PdfObjectString
+
+	R *PdfObjectInteger
+	V *PdfObjectInteger
+
+	PropBuild    *PdfObjectDictionary
+	PropAuthTime *PdfObjectInteger
+	PropAuthType *PdfObjectName
+
+	container *PdfIndirectObject
+}
+
+// NewPdfSignature creates a new signature dictionary for a signature created with `handler`.  The
+// signing time M is set to the current time.
+func NewPdfSignature(handler SignatureHandler) *PdfSignature {
+	sig := &PdfSignature{}
+	sig.Handler = handler
+	sig.Type = MakeName("Sig")
+
+	date := NewPdfDateFromTime(time.Now())
+	sig.M = date.ToPdfObject().(*PdfObjectString)
+
+	sig.container = MakeIndirectObject(MakeDict())
+	return sig
+}
+
+// newPdfSignatureFromIndirect loads a signature dictionary from `container`.
+func newPdfSignatureFromIndirect(container *PdfIndirectObject) (*PdfSignature, error) {
+	d, ok := container.PdfObject.(*PdfObjectDictionary)
+	if !ok {
+		return nil, errors.New("Signature not a dictionary")
+	}
+
+	sig := &PdfSignature{}
+	sig.container = container
+	sig.Type, _ = TraceToDirectObject(d.Get("Type")).(*PdfObjectName)
+	sig.Filter, _ = TraceToDirectObject(d.Get("Filter")).(*PdfObjectName)
+	sig.SubFilter, _ = TraceToDirectObject(d.Get("SubFilter")).(*PdfObjectName)
+	sig.Contents, _ = TraceToDirectObject(d.Get("Contents")).(*PdfObjectString)
+	sig.Cert = d.Get("Cert")
+	sig.ByteRange, _ = TraceToDirectObject(d.Get("ByteRange")).(*PdfObjectArray)
+	sig.Reference, _ = TraceToDirectObject(d.Get("Reference")).(*PdfObjectArray)
+	sig.Changes, _ = TraceToDirectObject(d.Get("Changes")).(*PdfObjectArray)
+	sig.Name, _ = TraceToDirectObject(d.Get("Name")).(*PdfObjectString)
+	sig.M, _ = TraceToDirectObject(d.Get("M")).(*PdfObjectString)
+	sig.Location, _ = TraceToDirectObject(d.Get("Location")).(*PdfObjectString)
+	sig.Reason, _ = TraceToDirectObject(d.Get("Reason")).(*PdfObjectString)
+	sig.ContactInfo, _ = TraceToDirectObject(d.Get("ContactInfo")).(*PdfObjectString)
+	sig.R, _ = TraceToDirectObject(d.Get("R")).(*PdfObjectInteger)
+	sig.V, _ = TraceToDirectObject(d.Get("V")).(*PdfObjectInteger)
+	sig.PropBuild, _ = TraceToDirectObject(d.Get("Prop_Build")).(*PdfObjectDictionary)
+	sig.PropAuthTime, _ = TraceToDirectObject(d.Get("Prop_AuthTime")).(*PdfObjectInteger)
+	sig.PropAuthType, _ = TraceToDirectObject(d.Get("Prop_AuthType")).(*PdfObjectName)
+	return sig, nil
+}
+
+// getByteRange returns the byte range of the signature as offsets and lengths of the two ranges.
+func (this *PdfSignature) getByteRange() ([]int64, error) {
+	if this.ByteRange == nil || len(*this.ByteRange) != 4 {
+		return nil, errors.New("Invalid ByteRange")
+	}
+	values, err := this.ByteRange.ToIntegerArray()
+	if err != nil {
+		return nil, err
+	}
+	byteRange := make([]int64, 4)
+	for i, val := range values {
+		byteRange[i] = int64(val)
+	}
+	if byteRange[0] != 0 || byteRange[1] < 0 || byteRange[2] < byteRange[1] || byteRange[3] < 0 {
+		return nil, errors.New("Invalid ByteRange")
+	}
+	return byteRange, nil
+}
+
+// DocMDPPermission is the permission of a certification signature (section 12.8.2.2).
+type DocMDPPermission int64
+
+const (
+	// No changes to the document are permitted.
+	DocMDPNoChanges DocMDPPermission = 1
+	// Filling in forms, instantiating page templates and signing are permitted.
+	DocMDPFillForms DocMDPPermission = 2
+	// Annotating is permitted as well.
+	DocMDPFillFormsAndAnnotate DocMDPPermission = 3
+)
+
+// SetDocMDP makes the signature a certification signature, permitting the changes `permission`
+// after signing.
+func (this *PdfSignature) SetDocMDP(permission DocMDPPermission) {
+	params := MakeDict()
+	params.Set("Type", MakeName("TransformParams"))
+	params.Set("P", MakeInteger(int64(permission)))
+	params.Set("V", MakeName("1.2"))
+	this.addReference("DocMDP", params)
+}
+
+// SetFieldMDP locks form fields after signing (section 12.8.2.4): all the fields if `action` is
+// All, the fields with full names `fields` if it is Include, the other ones if it is Exclude.
+func (this *PdfSignature) SetFieldMDP(action string, fields []string) {
+	params := MakeDict()
+	params.Set("Type", MakeName("TransformParams"))
+	params.Set("Action", MakeName(action))
+	if action != "All" {
+		arr := MakeArray()
+		for _, field := range fields {
+			arr.Append(MakeString(field))
+		}
+		params.Set("Fields", arr)
+	}
+	params.Set("V", MakeName("1.2"))
+	this.addReference("FieldMDP", params)
+}
+
+// addReference adds a signature reference with transform method `method` and parameters
+// `params`.
+func (this *PdfSignature) addReference(method PdfObjectName, params *PdfObjectDictionary) {
+	ref := MakeDict()
+	ref.Set("Type", MakeName("SigRef"))
+	ref.Set("TransformMethod", MakeName(string(method)))
+	ref.Set("TransformParams", params)
+	if this.Reference == nil {
+		this.Reference = MakeArray()
+	}
+	this.Reference.Append(ref)
+}
+
+// getTransformParams returns the parameters of the signature reference with transform method
+// `method`, nil if there is none.
+func (this *PdfSignature) getTransformParams(method PdfObjectName) *PdfObjectDictionary {
+	if this.Reference == nil {
+		return nil
+	}
+	for _, obj := range *this.Reference {
+		ref, ok := TraceToDirectObject(obj).(*PdfObjectDictionary)
+		if !ok {
+			continue
+		}
+		if name, ok := TraceToDirectObject(ref.Get("TransformMethod")).(*PdfObjectName); ok && *name == method {
+			params, _ := TraceToDirectObject(ref.Get("TransformParams")).(*PdfObjectDictionary)
+			if params == nil {
+				params = MakeDict()
+			}
+			return params
+		}
+	}
+	return nil
+}
+
+// GetDocMDP returns the permission of a certification signature.  The bool flag is false if the
+// signature is not a certification signature.
+func (this *PdfSignature) GetDocMDP() (DocMDPPermission, bool) {
+	params := this.getTransformParams("DocMDP")
+	if params == nil {
+		return 0, false
+	}
+	// The default permission is 2.
+	permission := DocMDPFillForms
+	if p, ok := TraceToDirectObject(params.Get("P")).(*PdfObjectInteger); ok && *p >= 1 && *p <= 3 {
+		permission = DocMDPPermission(*p)
+	}
+	return permission, true
+}
+
+// GetFieldMDP returns the action and the full names of the fields locked by the signature, as set
+// with SetFieldMDP.  The bool flag is false if the signature does not lock fields.
+func (this *PdfSignature) GetFieldMDP() (string, []string, bool) {
+	params := this.getTransformParams("FieldMDP")
+	if params == nil {
+		return "", nil, false
+	}
+	action, ok := TraceToDirectObject(params.Get("Action")).(*PdfObjectName)
+	if !ok {
+		return "", nil, false
+	}
+	var fields []string
+	if arr, ok := TraceToDirectObject(params.Get("Fields")).(*PdfObjectArray); ok {
+		for _, obj := range *arr {
+			if str, ok := TraceToDirectObject(obj).(*PdfObjectString); ok {
+				fields = append(fields, string(*str))
+			}
+		}
+	}
+	return string(*action), fields, true
+}
+
+// GetContainingPdfObject implements interface PdfModel.
+func (this *PdfSignature) GetContainingPdfObject() PdfObject {
+	return this.container
+}
+
+// ToPdfObject implements interface PdfModel.  While the signature is created, ByteRange and
+// Contents are written as placeholders of fixed size, which are replaced once the document has been
+// written.
+func (this *PdfSignature) ToPdfObject() PdfObject {
+	container := this.container
+	d := container.PdfObject.(*PdfObjectDictionary)
+
+	// ByteRange and Contents come first, so that they are not preceded by strings of the
+	// document, which could contain the placeholders.
+	if this.ByteRange != nil {
+		d.Set("ByteRange", this.ByteRange)
+	} else {
+		d.Set("ByteRange", rawPdfObject(byteRangePlaceholder))
+	}
+	if this.Contents != nil {
+		d.Set("Contents", rawPdfObject("<"+hex.EncodeToString([]byte(*this.Contents))+">"))
+	}
+
+	d.SetIfNotNil("Type", this.Type)
+	d.SetIfNotNil("Filter", this.Filter)
+	d.SetIfNotNil("SubFilter", this.SubFilter)
+	d.SetIfNotNil("Cert", this.Cert)
+	d.SetIfNotNil("Reference", this.Reference)
+	d.SetIfNotNil("Changes", this.Changes)
+	d.SetIfNotNil("Name", this.Name)
+	d.SetIfNotNil("M", this.M)
+	d.SetIfNotNil("Location", this.Location)
+	d.SetIfNotNil("Reason", this.Reason)
+	d.SetIfNotNil("ContactInfo", this.ContactInfo)
+	d.SetIfNotNil("R", this.R)
+	d.SetIfNotNil("V", this.V)
+	d.SetIfNotNil("Prop_Build", this.PropBuild)
+	d.SetIfNotNil("Prop_AuthTime", this.PropAuthTime)
+	d.SetIfNotNil("Prop_AuthType", this.PropAuthType)
+
+	return container
+}
+
+// byteRangePlaceholder is written for the ByteRange of a signature being created.  The actual byte
+// range is written with byteRangeFormat, padded to the same length.
+const (
+	byteRangePlaceholder = "[0 0          0          0         ]"
+	byteRangeFormat      = "[0 %-10d %-10d %-10d]"
+)
+
+// rawPdfObject is a PDF object written as is.  Used for the signature values, which are written
+// as hexadecimal strings and are not encrypted.
+type rawPdfObject string
+
+func (this rawPdfObject) String() string {
+	return string(this)
+}
+
+func (this rawPdfObject) DefaultWriteString() string {
+	return string(this)
+}
+
+// PdfFieldSignature represents a signature field (section 12.7.4.5) merged with its widget
+// annotation.
+type PdfFieldSignature struct {
+	*PdfField
+	*PdfAnnotationWidget
+
+	V    *PdfSignature
+	Lock *PdfObjectDictionary
+	SV   *PdfObjectDictionary
+
+	// Fully qualified name of a loaded field.
+	fullName string
+}
+
+// NewPdfFieldSignature creates a new signature field with value `signature`.  The widget annotation
+// has an empty rectangle, so that the signature is invisible, and is printed and locked.
+func NewPdfFieldSignature(signature *PdfSignature) *PdfFieldSignature {
+	field := &PdfFieldSignature{}
+	field.PdfField = NewPdfField()
+	field.PdfAnnotationWidget = NewPdfAnnotationWidget()
+	field.PdfAnnotationWidget.SetContext(field)
+
+	// The field and its widget share a dictionary.
+	field.PdfField.primitive = field.PdfAnnotationWidget.primitive
+
+	field.FT = MakeName("Sig")
+	field.Rect = MakeArray(MakeInteger(0), MakeInteger(0), MakeInteger(0), MakeInteger(0))
+	field.F = MakeInteger(132)
+	field.V = signature
+	return field
+}
+
+// GetContainingPdfObject implements interface PdfModel.
+func (this *PdfFieldSignature) GetContainingPdfObject() PdfObject {
+	return this.PdfField.primitive
+}
+
+// FullName returns the fully qualified name of the field (section 12.7.3.2).
+func (this *PdfFieldSignature) FullName() string {
+	if this.fullName != "" {
+		return this.fullName
+	}
+	if t, ok := TraceToDirectObject(this.T).(*PdfObjectString); ok {
+		return string(*t)
+	}
+	return ""
+}
+
+// ToPdfObject implements interface PdfModel.
+func (this *PdfFieldSignature) ToPdfObject() PdfObject {
+	if this.PdfAnnotationWidget != nil {
+		this.PdfAnnotationWidget.ToPdfObject()
+	}
+	this.PdfField.ToPdfObject()
+
+	container := this.PdfField.primitive
+	d := container.PdfObject.(*PdfObjectDictionary)
+	if this.V != nil {
+		d.Set("V", this.V.ToPdfObject())
+	}
+	d.SetIfNotNil("Lock", this.Lock)
+	d.SetIfNotNil("SV", this.SV)
+	return container
+}
+
+// Sign adds the signature field `field` to page `pageNum` and the AcroForm of the document.  The
+// signature value of `field`, created with its signature handler, is set when writing the update.
+// An update can contain a single signature, further signatures are added with further updates.
+func (this *PdfAppender) Sign(pageNum int, field *PdfFieldSignature) error {
+	if this.signature != nil {
+		return errors.New("Update already signed")
+	}
+	sig := field.V
+	if sig == nil || sig.Handler == nil {
+		return errors.New("Signature handler missing")
+	}
+	if err := sig.Handler.InitSignature(sig); err != nil {
+		return err
+	}
+	if sig.Contents == nil {
+		return errors.New("Signature handler did not reserve space for the signature")
+	}
+
+	page, err := this.reader.GetPage(pageNum)
+	if err != nil {
+		return err
+	}
+	field.P = page.GetPageAsIndirectObject()
+	page.Annotations = append(page.Annotations, field.PdfAnnotation)
+	if err := this.UpdateObject(page.ToPdfObject()); err != nil {
+		return err
+	}
+	if err := this.addFormField(field.ToPdfObject()); err != nil {
+		return err
+	}
+
+	// Certification signatures are referred to by the catalog.
+	if _, ok := sig.GetDocMDP(); ok {
+		catalog, err := this.getCatalog()
+		if err != nil {
+			return err
+		}
+		perms := MakeDict()
+		perms.Set("DocMDP", sig.container)
+		catalog.PdfObject.(*PdfObjectDictionary).Set("Perms", perms)
+		if err := this.UpdateObject(catalog); err != nil {
+			return err
+		}
+	}
+
+	this.signature = sig
+	return nil
+}
+
+// getCatalog returns the catalog of the document.
+func (this *PdfAppender) getCatalog() (*PdfIndirectObject, error) {
+	parser := this.reader.parser
+	rootRef, ok := parser.GetTrailer().Get("Root").(*PdfObjectReference)
+	if !ok {
+		return nil, errors.New("Invalid Root")
+	}
+	root, err := parser.LookupByReference(*rootRef)
+	if err != nil {
+		return nil, err
+	}
+	catalog, ok := root.(*PdfIndirectObject)
+	if !ok {
+		return nil, errors.New("Invalid catalog")
+	}
+	if _, ok := catalog.PdfObject.(*PdfObjectDictionary); !ok {
+		return nil, errors.New("Invalid catalog")
+	}
+	return catalog, nil
+}
+
+// addFormField adds the field `field` to the AcroForm of the document, creating it if needed, and
+// sets the signature flags.
+func (this *PdfAppender) addFormField(field PdfObject) error {
+	parser := this.reader.parser
+
+	catalog, err := this.getCatalog()
+	if err != nil {
+		return err
+	}
+	catalogDict := catalog.PdfObject.(*PdfObjectDictionary)
+
+	var form *PdfObjectDictionary
+	var formContainer PdfObject = catalog
+	ok := true
+	obj := catalogDict.Get("AcroForm")
+	if ref, isRef := obj.(*PdfObjectReference); isRef {
+		if obj, err = parser.LookupByReference(*ref); err != nil {
+			return err
+		}
+	}
+	switch t := obj.(type) {
+	case *PdfIndirectObject:
+		form, ok = t.PdfObject.(*PdfObjectDictionary)
+		formContainer = t
+	case *PdfObjectDictionary:
+		form = t
+	case nil, *PdfObjectNull:
+		form = MakeDict()
+		catalogDict.Set("AcroForm", MakeIndirectObject(form))
+		ok = true
+	}
+	if form == nil || !ok {
+		common.Log.Debug("ERROR: Invalid AcroForm %T", obj)
+		return errors.New("Invalid AcroForm")
+	}
+
+	obj = form.Get("Fields")
+	if ref, isRef := obj.(*PdfObjectReference); isRef {
+		if obj, err = parser.LookupByReference(*ref); err != nil {
+			return err
+		}
+	}
+	switch t := obj.(type) {
+	case *PdfIndirectObject:
+		fields, ok := t.PdfObject.(*PdfObjectArray)
+		if !ok {
+			return errors.New("Invalid Fields")
+		}
+		fields.Append(field)
+		if err := this.UpdateObject(t); err != nil {
+			return err
+		}
+	case *PdfObjectArray:
+		t.Append(field)
+	case nil:
+		form.Set("Fields", MakeArray(field))
+	default:
+		return fmt.Errorf("Invalid Fields (%T)", obj)
+	}
+
+	// SignaturesExist and AppendOnly.
+	form.Set("SigFlags", MakeInteger(3))
+	return this.UpdateObject(formContainer)
+}
+
+// signUpdate sets the byte range and the signature value of the signature being created in the
+// data `update` of the incremental update, in which the signature dictionary starts at
+// `sigOffset`.  The update follows the `originalSize` bytes of the original document.
+func (this *PdfAppender) signUpdate(update []byte, originalSize, sigOffset int64) error {
+	sig := this.signature
+
+	i := bytes.Index(update[sigOffset:], []byte(byteRangePlaceholder))
+	j := bytes.Index(update[sigOffset:], []byte("/Contents <"))
+	if i < 0 || j < 0 {
+		return errors.New("Signature placeholders not found")
+	}
+	byteRangeStart := sigOffset + int64(i)
+	contentsStart := sigOffset + int64(j) + int64(len("/Contents "))
+	k := bytes.IndexByte(update[contentsStart:], '>')
+	if k < 0 {
+		return errors.New("Signature placeholders not found")
+	}
+	contentsEnd := contentsStart + int64(k) + 1
+
+	size := originalSize + int64(len(update))
+	byteRange := []int64{0, originalSize + contentsStart, originalSize + contentsEnd, size - originalSize - contentsEnd}
+	copy(update[byteRangeStart:], fmt.Sprintf(byteRangeFormat, byteRange[1], byteRange[2], byteRange[3]))
+	sig.ByteRange = MakeArrayFromIntegers64(byteRange)
+
+	digest, err := sig.Handler.NewDigest(sig)
+	if err != nil {
+		return err
+	}
+	rs := this.reader.rs
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(digest, rs, originalSize); err != nil {
+		return err
+	}
+	digest.Write(update[:contentsStart])
+	digest.Write(update[contentsEnd:])
+
+	// The signature value is written in the space reserved by the placeholder, with trailing
+	// zeros.
+	reserved := int(contentsEnd-contentsStart-2) / 2
+	if err := sig.Handler.Sign(sig, digest); err != nil {
+		return err
+	}
+	if len(*sig.Contents) > reserved {
+		common.Log.Debug("ERROR: Signature too large (%d > %d)", len(*sig.Contents), reserved)
+		return errors.New("Signature too large")
+	}
+
+	value := make([]byte, reserved)
+	copy(value, *sig.Contents)
+	hex.Encode(update[contentsStart+1:], value)
+	return nil
+}
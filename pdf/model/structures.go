@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"time"
 
 	. "github.com/unidoc/unidoc/pdf/core"
 )
@@ -124,6 +125,27 @@ func NewPdfDate(dateStr string) (PdfDate, error) {
 	return d, nil
 }
 
+// Make a new PdfDate object from a time.
+func NewPdfDateFromTime(t time.Time) PdfDate {
+	d := PdfDate{}
+	d.year = int64(t.Year())
+	d.month = int64(t.Month())
+	d.day = int64(t.Day())
+	d.hour = int64(t.Hour())
+	d.minute = int64(t.Minute())
+	d.second = int64(t.Second())
+
+	_, offset := t.Zone()
+	d.utOffsetSign = '+'
+	if offset < 0 {
+		d.utOffsetSign = '-'
+		offset = -offset
+	}
+	d.utOffsetHours = int64(offset / 3600)
+	d.utOffsetMins = int64(offset % 3600 / 60)
+	return d
+}
+
 // Convert to a PDF string object.
 func (date *PdfDate) ToPdfObject() PdfObject {
 	str := fmt.Sprintf("D:%.4d%.2d%.2d%.2d%.2d%.2d%c%.2d'%.2d'",
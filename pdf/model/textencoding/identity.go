@@ -0,0 +1,95 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package textencoding
+
+import (
+	"fmt"
+
+	"github.com/unidoc/unidoc/pdf/core"
+)
+
+// IdentityEncoder represents a 2-byte identity encoding (Identity-H or Identity-V) of composite
+// fonts.  The character codes are the Unicode code points of the Basic Multilingual Plane, mapped
+// to glyphs by the CIDToGIDMap of the font.
+type IdentityEncoder struct {
+	baseName string
+}
+
+// NewIdentityTextEncoder returns an identity encoder with the CMap name `baseName` (Identity-H or
+// Identity-V).
+func NewIdentityTextEncoder(baseName string) IdentityEncoder {
+	return IdentityEncoder{baseName: baseName}
+}
+
+// Convert a raw utf8 string (series of runes) to an encoded string (series of 2-byte character
+// codes) to be used in PDF.  Runes outside of the Basic Multilingual Plane are skipped.
+func (enc IdentityEncoder) Encode(raw string) string {
+	encoded := []byte{}
+	for _, r := range raw {
+		if r > 0xFFFF {
+			continue
+		}
+		encoded = append(encoded, byte(r>>8), byte(r))
+	}
+	return string(encoded)
+}
+
+// Conversion between character code and glyph name.  Only applies to codes below 256.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc IdentityEncoder) CharcodeToGlyph(code byte) (string, bool) {
+	return enc.RuneToGlyph(rune(code))
+}
+
+// Conversion between glyph name and character code.  Only applies to codes below 256.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc IdentityEncoder) GlyphToCharcode(glyph string) (byte, bool) {
+	r, found := enc.GlyphToRune(glyph)
+	if !found {
+		return 0, false
+	}
+	return enc.RuneToCharcode(r)
+}
+
+// Convert rune to character code.  Only applies to codes below 256.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc IdentityEncoder) RuneToCharcode(val rune) (byte, bool) {
+	if val < 0 || val > 0xFF {
+		return 0, false
+	}
+	return byte(val), true
+}
+
+// Convert character code to rune.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc IdentityEncoder) CharcodeToRune(charcode byte) (rune, bool) {
+	return rune(charcode), true
+}
+
+// Convert rune to glyph name.  Runes without a name in the glyph list are named uniXXXX.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc IdentityEncoder) RuneToGlyph(val rune) (string, bool) {
+	if glyph, found := runeToGlyph(val, glyphlistRuneToGlyphMap); found {
+		return glyph, true
+	}
+	if val < 0 || val > 0xFFFF {
+		return "", false
+	}
+	return fmt.Sprintf("uni%04X", val), true
+}
+
+// Convert glyph to rune.  Glyph names of the form uniXXXX are also recognized.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc IdentityEncoder) GlyphToRune(glyph string) (rune, bool) {
+	if r, found := glyphToRune(glyph, glyphlistGlyphToRuneMap); found {
+		return r, true
+	}
+	return uniGlyphToRune(glyph)
+}
+
+// Convert to PDF Object.
+func (enc IdentityEncoder) ToPdfObject() core.PdfObject {
+	return core.MakeName(enc.baseName)
+}
@@ -0,0 +1,206 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package textencoding
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+)
+
+// SimpleEncoder represents a single byte encoding given by a table of glyph names: a base encoding
+// (section 9.6.6.1) modified by a Differences array, or the built-in encoding of a font program.
+type SimpleEncoder struct {
+	baseName    string          // Name of the base encoding, if any.
+	differences map[byte]string // Glyph names differing from the base encoding.
+	codeToGlyph map[byte]string
+	glyphToCode map[string]byte
+}
+
+// NewSimpleTextEncoder returns an encoder of the base encoding `baseName` (StandardEncoding,
+// WinAnsiEncoding, MacRomanEncoding, MacExpertEncoding or PDFDocEncoding), modified by the glyph
+// names `differences` by character code, as given by an encoding dictionary.
+func NewSimpleTextEncoder(baseName string, differences map[byte]string) (SimpleEncoder, error) {
+	base, has := baseEncodings[baseName]
+	if !has {
+		common.Log.Debug("Unsupported base encoding %s", baseName)
+		return SimpleEncoder{}, fmt.Errorf("unsupported base encoding %s", baseName)
+	}
+	return newSimpleEncoder(baseName, base, differences), nil
+}
+
+// NewCustomSimpleTextEncoder returns an encoder with the glyph names `encoding` by character
+// code, without base encoding.  Glyphs mapped by several codes are encoded with the lowest.
+func NewCustomSimpleTextEncoder(encoding map[byte]string) SimpleEncoder {
+	return newSimpleEncoder("", nil, encoding)
+}
+
+// newSimpleEncoder returns an encoder with the glyph names `base` by character code modified by
+// `differences`.
+func newSimpleEncoder(baseName string, base map[byte]string, differences map[byte]string) SimpleEncoder {
+	enc := SimpleEncoder{
+		baseName:    baseName,
+		differences: map[byte]string{},
+		codeToGlyph: map[byte]string{},
+		glyphToCode: map[string]byte{},
+	}
+	for code, glyph := range base {
+		enc.codeToGlyph[code] = glyph
+	}
+	for code, glyph := range differences {
+		enc.differences[code] = glyph
+		enc.codeToGlyph[code] = glyph
+	}
+	for code, glyph := range enc.codeToGlyph {
+		if c, has := enc.glyphToCode[glyph]; !has || code < c {
+			enc.glyphToCode[glyph] = code
+		}
+	}
+	return enc
+}
+
+// ApplyDifferences returns an encoder of the encoding modified by the glyph names `differences`
+// by character code.
+func (enc SimpleEncoder) ApplyDifferences(differences map[byte]string) SimpleEncoder {
+	merged := map[byte]string{}
+	for code, glyph := range enc.differences {
+		merged[code] = glyph
+	}
+	for code, glyph := range differences {
+		merged[code] = glyph
+	}
+	return newSimpleEncoder(enc.baseName, baseEncodings[enc.baseName], merged)
+}
+
+// Convert a raw utf8 string (series of runes) to an encoded string (series of character codes) to be used in PDF.
+func (enc SimpleEncoder) Encode(raw string) string {
+	encoded := []byte{}
+	for _, r := range raw {
+		code, has := enc.RuneToCharcode(r)
+		if has {
+			encoded = append(encoded, code)
+		}
+	}
+
+	return string(encoded)
+}
+
+// Conversion between character code and glyph name.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc SimpleEncoder) CharcodeToGlyph(code byte) (string, bool) {
+	glyph, has := enc.codeToGlyph[code]
+	if !has {
+		common.Log.Debug("Charcode -> Glyph error: charcode not found: %d\n", code)
+		return "", false
+	}
+	return glyph, true
+}
+
+// Conversion between glyph name and character code.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc SimpleEncoder) GlyphToCharcode(glyph string) (byte, bool) {
+	code, found := enc.glyphToCode[glyph]
+	if !found {
+		common.Log.Debug("Glyph -> Charcode error: glyph not found: %s\n", glyph)
+		return 0, false
+	}
+
+	return code, true
+}
+
+// Convert rune to character code.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc SimpleEncoder) RuneToCharcode(val rune) (byte, bool) {
+	glyph, found := enc.RuneToGlyph(val)
+	if !found {
+		return 0, false
+	}
+
+	return enc.GlyphToCharcode(glyph)
+}
+
+// Convert character code to rune.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc SimpleEncoder) CharcodeToRune(charcode byte) (rune, bool) {
+	glyph, found := enc.CharcodeToGlyph(charcode)
+	if !found {
+		return 0, false
+	}
+
+	return enc.GlyphToRune(glyph)
+}
+
+// Convert rune to glyph name.  Glyph names of the encoding are preferred over those of the glyph
+// list.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc SimpleEncoder) RuneToGlyph(val rune) (string, bool) {
+	glyph, found := runeToGlyph(val, glyphlistRuneToGlyphMap)
+	if found {
+		if _, has := enc.glyphToCode[glyph]; has {
+			return glyph, true
+		}
+	}
+	for code := 0; code < 256; code++ {
+		g, has := enc.codeToGlyph[byte(code)]
+		if !has || g == glyph {
+			continue
+		}
+		if r, ok := enc.GlyphToRune(g); ok && r == val {
+			return g, true
+		}
+	}
+	return glyph, found
+}
+
+// Convert glyph to rune.  Glyph names of the form uniXXXX are also recognized.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc SimpleEncoder) GlyphToRune(glyph string) (rune, bool) {
+	if r, found := glyphToRune(glyph, glyphlistGlyphToRuneMap); found {
+		return r, true
+	}
+	return uniGlyphToRune(glyph)
+}
+
+// Convert to PDF Object: the name of the base encoding if not modified, and otherwise an encoding
+// dictionary with the base encoding, if any, and the differences.
+func (enc SimpleEncoder) ToPdfObject() core.PdfObject {
+	if enc.baseName != "" && len(enc.differences) == 0 {
+		return core.MakeName(enc.baseName)
+	}
+
+	codes := make([]int, 0, len(enc.differences))
+	for code := range enc.differences {
+		codes = append(codes, int(code))
+	}
+	sort.Ints(codes)
+
+	differences := core.PdfObjectArray{}
+	for i, code := range codes {
+		if i == 0 || codes[i-1] != code-1 {
+			differences = append(differences, core.MakeInteger(int64(code)))
+		}
+		differences = append(differences, core.MakeName(enc.differences[byte(code)]))
+	}
+
+	dict := core.MakeDict()
+	dict.Set("Type", core.MakeName("Encoding"))
+	if enc.baseName != "" {
+		dict.Set("BaseEncoding", core.MakeName(enc.baseName))
+	}
+	dict.Set("Differences", &differences)
+	return dict
+}
+
+// Glyph names by character code of the base encodings by name.
+var baseEncodings = map[string]map[byte]string{
+	"StandardEncoding":  standardEncodingCharcodeToGlyphMap,
+	"WinAnsiEncoding":   winansiEncodingCharcodeToGlyphMap,
+	"MacRomanEncoding":  macRomanEncodingCharcodeToGlyphMap,
+	"MacExpertEncoding": macExpertEncodingCharcodeToGlyphMap,
+	"PDFDocEncoding":    pdfDocEncodingCharcodeToGlyphMap,
+}
@@ -0,0 +1,80 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package textencoding
+
+import (
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+)
+
+func TestSimpleEncoderBaseEncodings(t *testing.T) {
+	testcases := []struct {
+		enc   SimpleEncoder
+		code  byte
+		glyph string
+		r     rune
+	}{
+		{NewMacRomanTextEncoder(), 0x80, "Adieresis", 'Ä'},
+		{NewMacRomanTextEncoder(), 0xDB, "currency", '¤'},
+		{NewMacExpertTextEncoder(), 0x57, "fi", 'ﬁ'},
+		{NewPdfDocTextEncoder(), 0xA0, "Euro", '€'},
+		{NewPdfDocTextEncoder(), 0x93, "fi", 'ﬁ'},
+	}
+	for _, tc := range testcases {
+		glyph, found := tc.enc.CharcodeToGlyph(tc.code)
+		if !found || glyph != tc.glyph {
+			t.Errorf("Incorrect glyph of %#x: %q != %q", tc.code, glyph, tc.glyph)
+		}
+		r, found := tc.enc.CharcodeToRune(tc.code)
+		if !found || r != tc.r {
+			t.Errorf("Incorrect rune of %#x: %q != %q", tc.code, r, tc.r)
+		}
+		code, found := tc.enc.RuneToCharcode(tc.r)
+		if !found || code != tc.code {
+			t.Errorf("Incorrect code of %q: %#x != %#x", tc.r, code, tc.code)
+		}
+	}
+}
+
+func TestSimpleEncoderDifferences(t *testing.T) {
+	enc, err := NewSimpleTextEncoder("WinAnsiEncoding", map[byte]string{65: "alpha", 66: "uni2713", 200: "A"})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	for code, r := range map[byte]rune{65: 'α', 66: '✓', 67: 'C', 200: 'A'} {
+		if val, found := enc.CharcodeToRune(code); !found || val != r {
+			t.Errorf("Incorrect rune of %d: %q != %q", code, val, r)
+		}
+	}
+	if encoded := enc.Encode("ACα"); encoded != "\xc8C\x41" {
+		t.Errorf("Incorrect encoding %q", encoded)
+	}
+
+	dict, ok := enc.ToPdfObject().(*core.PdfObjectDictionary)
+	if !ok {
+		t.Fatalf("Encoding not a dictionary: %v", enc.ToPdfObject())
+	}
+	if base, _ := dict.Get("BaseEncoding").(*core.PdfObjectName); base == nil || *base != "WinAnsiEncoding" {
+		t.Errorf("Incorrect BaseEncoding %v", dict.Get("BaseEncoding"))
+	}
+	if differences := dict.Get("Differences").DefaultWriteString(); differences != "[65 /alpha /uni2713 200 /A]" {
+		t.Errorf("Incorrect Differences %q", differences)
+	}
+
+	custom := NewCustomSimpleTextEncoder(map[byte]string{1: "A"}).ApplyDifferences(map[byte]string{2: "B"})
+	if encoded := custom.Encode("AB"); encoded != "\x01\x02" {
+		t.Errorf("Incorrect encoding %q", encoded)
+	}
+	if _, err := NewSimpleTextEncoder("Unknown", nil); err == nil {
+		t.Errorf("Unknown base encoding should fail")
+	}
+
+	if name, ok := NewMacRomanTextEncoder().ToPdfObject().(*core.PdfObjectName); !ok || *name != "MacRomanEncoding" {
+		t.Errorf("Incorrect encoding object %v", NewMacRomanTextEncoder().ToPdfObject())
+	}
+}
@@ -0,0 +1,400 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package textencoding
+
+import (
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+)
+
+// StandardEncoding, the built-in encoding of most Type 1 fonts with Latin characters (Annex D).
+type StandardEncoder struct {
+}
+
+func NewStandardTextEncoder() StandardEncoder {
+	encoder := StandardEncoder{}
+	return encoder
+}
+
+func (enc StandardEncoder) ToPdfObject() core.PdfObject {
+	return core.MakeName("StandardEncoding")
+}
+
+// Convert a raw utf8 string (series of runes) to an encoded string (series of character codes) to be used in PDF.
+func (enc StandardEncoder) Encode(raw string) string {
+	encoded := []byte{}
+	for _, rune := range raw {
+		code, has := enc.RuneToCharcode(rune)
+		if has {
+			encoded = append(encoded, code)
+		}
+	}
+
+	return string(encoded)
+}
+
+// Conversion between character code and glyph name.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc StandardEncoder) CharcodeToGlyph(code byte) (string, bool) {
+	glyph, has := standardEncodingCharcodeToGlyphMap[code]
+	if !has {
+		common.Log.Debug("Charcode -> Glyph error: charcode not found: %d\n", code)
+		return "", false
+	}
+	return glyph, true
+}
+
+// Conversion between glyph name and character code.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc StandardEncoder) GlyphToCharcode(glyph string) (byte, bool) {
+	code, found := standardEncodingGlyphToCharcodeMap[glyph]
+	if !found {
+		common.Log.Debug("Glyph -> Charcode error: glyph not found: %s\n", glyph)
+		return 0, false
+	}
+
+	return code, true
+}
+
+// Convert rune to character code.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc StandardEncoder) RuneToCharcode(val rune) (byte, bool) {
+	glyph, found := enc.RuneToGlyph(val)
+	if !found {
+		return 0, false
+	}
+
+	return enc.GlyphToCharcode(glyph)
+}
+
+// Convert character code to rune.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc StandardEncoder) CharcodeToRune(charcode byte) (rune, bool) {
+	glyph, found := enc.CharcodeToGlyph(charcode)
+	if !found {
+		return 0, false
+	}
+
+	return enc.GlyphToRune(glyph)
+}
+
+// Convert rune to glyph name.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc StandardEncoder) RuneToGlyph(val rune) (string, bool) {
+	return runeToGlyph(val, glyphlistRuneToGlyphMap)
+}
+
+// Convert glyph to rune.
+// The bool return flag is true if there was a match, and false otherwise.
+func (enc StandardEncoder) GlyphToRune(glyph string) (rune, bool) {
+	return glyphToRune(glyph, glyphlistGlyphToRuneMap)
+}
+
+// Charcode to glyph name map (StandardEncoding).
+var standardEncodingCharcodeToGlyphMap = map[byte]string{
+	32:  "space",
+	33:  "exclam",
+	34:  "quotedbl",
+	35:  "numbersign",
+	36:  "dollar",
+	37:  "percent",
+	38:  "ampersand",
+	39:  "quoteright",
+	40:  "parenleft",
+	41:  "parenright",
+	42:  "asterisk",
+	43:  "plus",
+	44:  "comma",
+	45:  "hyphen",
+	46:  "period",
+	47:  "slash",
+	48:  "zero",
+	49:  "one",
+	50:  "two",
+	51:  "three",
+	52:  "four",
+	53:  "five",
+	54:  "six",
+	55:  "seven",
+	56:  "eight",
+	57:  "nine",
+	58:  "colon",
+	59:  "semicolon",
+	60:  "less",
+	61:  "equal",
+	62:  "greater",
+	63:  "question",
+	64:  "at",
+	65:  "A",
+	66:  "B",
+	67:  "C",
+	68:  "D",
+	69:  "E",
+	70:  "F",
+	71:  "G",
+	72:  "H",
+	73:  "I",
+	74:  "J",
+	75:  "K",
+	76:  "L",
+	77:  "M",
+	78:  "N",
+	79:  "O",
+	80:  "P",
+	81:  "Q",
+	82:  "R",
+	83:  "S",
+	84:  "T",
+	85:  "U",
+	86:  "V",
+	87:  "W",
+	88:  "X",
+	89:  "Y",
+	90:  "Z",
+	91:  "bracketleft",
+	92:  "backslash",
+	93:  "bracketright",
+	94:  "asciicircum",
+	95:  "underscore",
+	96:  "quoteleft",
+	97:  "a",
+	98:  "b",
+	99:  "c",
+	100: "d",
+	101: "e",
+	102: "f",
+	103: "g",
+	104: "h",
+	105: "i",
+	106: "j",
+	107: "k",
+	108: "l",
+	109: "m",
+	110: "n",
+	111: "o",
+	112: "p",
+	113: "q",
+	114: "r",
+	115: "s",
+	116: "t",
+	117: "u",
+	118: "v",
+	119: "w",
+	120: "x",
+	121: "y",
+	122: "z",
+	123: "braceleft",
+	124: "bar",
+	125: "braceright",
+	126: "asciitilde",
+	161: "exclamdown",
+	162: "cent",
+	163: "sterling",
+	164: "fraction",
+	165: "yen",
+	166: "florin",
+	167: "section",
+	168: "currency",
+	169: "quotesingle",
+	170: "quotedblleft",
+	171: "guillemotleft",
+	172: "guilsinglleft",
+	173: "guilsinglright",
+	174: "fi",
+	175: "fl",
+	177: "endash",
+	178: "dagger",
+	179: "daggerdbl",
+	180: "periodcentered",
+	182: "paragraph",
+	183: "bullet",
+	184: "quotesinglbase",
+	185: "quotedblbase",
+	186: "quotedblright",
+	187: "guillemotright",
+	188: "ellipsis",
+	189: "perthousand",
+	191: "questiondown",
+	193: "grave",
+	194: "acute",
+	195: "circumflex",
+	196: "tilde",
+	197: "macron",
+	198: "breve",
+	199: "dotaccent",
+	200: "dieresis",
+	202: "ring",
+	203: "cedilla",
+	205: "hungarumlaut",
+	206: "ogonek",
+	207: "caron",
+	208: "emdash",
+	225: "AE",
+	227: "ordfeminine",
+	232: "Lslash",
+	233: "Oslash",
+	234: "OE",
+	235: "ordmasculine",
+	241: "ae",
+	245: "dotlessi",
+	248: "lslash",
+	249: "oslash",
+	250: "oe",
+	251: "germandbls",
+}
+
+// Glyph to charcode map (StandardEncoding).
+var standardEncodingGlyphToCharcodeMap = map[string]byte{
+	"space":          32,
+	"exclam":         33,
+	"quotedbl":       34,
+	"numbersign":     35,
+	"dollar":         36,
+	"percent":        37,
+	"ampersand":      38,
+	"quoteright":     39,
+	"parenleft":      40,
+	"parenright":     41,
+	"asterisk":       42,
+	"plus":           43,
+	"comma":          44,
+	"hyphen":         45,
+	"period":         46,
+	"slash":          47,
+	"zero":           48,
+	"one":            49,
+	"two":            50,
+	"three":          51,
+	"four":           52,
+	"five":           53,
+	"six":            54,
+	"seven":          55,
+	"eight":          56,
+	"nine":           57,
+	"colon":          58,
+	"semicolon":      59,
+	"less":           60,
+	"equal":          61,
+	"greater":        62,
+	"question":       63,
+	"at":             64,
+	"A":              65,
+	"B":              66,
+	"C":              67,
+	"D":              68,
+	"E":              69,
+	"F":              70,
+	"G":              71,
+	"H":              72,
+	"I":              73,
+	"J":              74,
+	"K":              75,
+	"L":              76,
+	"M":              77,
+	"N":              78,
+	"O":              79,
+	"P":              80,
+	"Q":              81,
+	"R":              82,
+	"S":              83,
+	"T":              84,
+	"U":              85,
+	"V":              86,
+	"W":              87,
+	"X":              88,
+	"Y":              89,
+	"Z":              90,
+	"bracketleft":    91,
+	"backslash":      92,
+	"bracketright":   93,
+	"asciicircum":    94,
+	"underscore":     95,
+	"quoteleft":      96,
+	"a":              97,
+	"b":              98,
+	"c":              99,
+	"d":              100,
+	"e":              101,
+	"f":              102,
+	"g":              103,
+	"h":              104,
+	"i":              105,
+	"j":              106,
+	"k":              107,
+	"l":              108,
+	"m":              109,
+	"n":              110,
+	"o":              111,
+	"p":              112,
+	"q":              113,
+	"r":              114,
+	"s":              115,
+	"t":              116,
+	"u":              117,
+	"v":              118,
+	"w":              119,
+	"x":              120,
+	"y":              121,
+	"z":              122,
+	"braceleft":      123,
+	"bar":            124,
+	"braceright":     125,
+	"asciitilde":     126,
+	"exclamdown":     161,
+	"cent":           162,
+	"sterling":       163,
+	"fraction":       164,
+	"yen":            165,
+	"florin":         166,
+	"section":        167,
+	"currency":       168,
+	"quotesingle":    169,
+	"quotedblleft":   170,
+	"guillemotleft":  171,
+	"guilsinglleft":  172,
+	"guilsinglright": 173,
+	"fi":             174,
+	"fl":             175,
+	"endash":         177,
+	"dagger":         178,
+	"daggerdbl":      179,
+	"periodcentered": 180,
+	"paragraph":      182,
+	"bullet":         183,
+	"quotesinglbase": 184,
+	"quotedblbase":   185,
+	"quotedblright":  186,
+	"guillemotright": 187,
+	"ellipsis":       188,
+	"perthousand":    189,
+	"questiondown":   191,
+	"grave":          193,
+	"acute":          194,
+	"circumflex":     195,
+	"tilde":          196,
+	"macron":         197,
+	"breve":          198,
+	"dotaccent":      199,
+	"dieresis":       200,
+	"ring":           202,
+	"cedilla":        203,
+	"hungarumlaut":   205,
+	"ogonek":         206,
+	"caron":          207,
+	"emdash":         208,
+	"AE":             225,
+	"ordfeminine":    227,
+	"Lslash":         232,
+	"Oslash":         233,
+	"OE":             234,
+	"ordmasculine":   235,
+	"ae":             241,
+	"dotlessi":       245,
+	"lslash":         248,
+	"oslash":         249,
+	"oe":             250,
+	"germandbls":     251,
+}
@@ -5,7 +5,12 @@
 
 package textencoding
 
-import "github.com/unidoc/unidoc/common"
+import (
+	"strconv"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+)
 
 func glyphToRune(glyph string, glyphToRuneMap map[string]rune) (rune, bool) {
 	ucode, found := glyphToRuneMap[glyph]
@@ -17,6 +22,18 @@ func glyphToRune(glyph string, glyphToRuneMap map[string]rune) (rune, bool) {
 	return 0, false
 }
 
+// uniGlyphToRune returns the rune of glyph names of the form uniXXXX.
+func uniGlyphToRune(glyph string) (rune, bool) {
+	if !strings.HasPrefix(glyph, "uni") || len(glyph) != 7 {
+		return 0, false
+	}
+	val, err := strconv.ParseUint(glyph[3:], 16, 16)
+	if err != nil {
+		return 0, false
+	}
+	return rune(val), true
+}
+
 func runeToGlyph(ucode rune, runeToGlyphMap map[rune]string) (string, bool) {
 	glyph, found := runeToGlyphMap[ucode]
 	if found {
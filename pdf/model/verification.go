@@ -0,0 +1,592 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	"github.com/unidoc/unidoc/common"
+	. "github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/pkcs7"
+)
+
+// SignatureVerification is the result of the verification of a digital signature.
+type SignatureVerification struct {
+	// Signature field.
+	Field *PdfFieldSignature
+	// Byte ranges covered by the signature, as pairs of offsets and lengths.
+	ByteRange []int64
+
+	// Whether the signed digest is that of the byte ranges and the signature value is valid for the
+	// signing certificate.
+	Valid bool
+	// Signing certificate, nil if not found.
+	Certificate *x509.Certificate
+	// Signing time of the signed attributes, zero if absent.
+	SigningTime time.Time
+
+	// Whether the signing certificate chains to one of the trusted roots.
+	Trusted bool
+	// Chains of the signing certificate to the trusted roots.
+	Chains [][]*x509.Certificate
+
+	// Number of bytes appended to the document after the signed revision.
+	AppendedBytes int64
+	// Changes made after the signature that are not permitted by the certification (DocMDP) or
+	// the field locks (FieldMDP) of the signature.
+	Violations []string
+
+	// Reasons for the signature being invalid or untrusted.
+	Errors []error
+}
+
+// GetSignatureFields returns the signature fields of the form of the document, with their
+// signature dictionaries if signed.
+func (this *PdfReader) GetSignatureFields() ([]*PdfFieldSignature, error) {
+	var sigFields []*PdfFieldSignature
+	err := this.forEachTerminalField(func(field *PdfField, name string, ft *PdfObjectName) error {
+		if ft == nil || *ft != "Sig" {
+			return nil
+		}
+		sigField := &PdfFieldSignature{PdfField: field, fullName: name}
+		widgets := field.KidsA
+		for _, kid := range field.KidsF {
+			if widget, ok := kid.(*PdfField); ok {
+				widgets = append(widgets, widget.KidsA...)
+			}
+		}
+		if len(widgets) > 0 {
+			sigField.PdfAnnotationWidget, _ = widgets[0].GetContext().(*PdfAnnotationWidget)
+		}
+		if field.V != nil {
+			container, ok := field.V.(*PdfIndirectObject)
+			if !ok {
+				common.Log.Debug("ERROR: Signature of field %s not an indirect object (%T)", name, field.V)
+				return errors.New("Invalid signature")
+			}
+			sig, err := newPdfSignatureFromIndirect(container)
+			if err != nil {
+				return err
+			}
+			sigField.V = sig
+		}
+		sigFields = append(sigFields, sigField)
+		return nil
+	})
+	return sigFields, err
+}
+
+// readAll returns the data of the document.
+func (this *PdfReader) readAll() ([]byte, error) {
+	if this.rs == nil {
+		return nil, errors.New("Reader has no document loaded")
+	}
+	if _, err := this.rs.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(this.rs)
+}
+
+// forEachTerminalField calls `f` for each terminal field of the form of the document with its full
+// name and (inherited) field type.
+func (this *PdfReader) forEachTerminalField(f func(field *PdfField, name string, ft *PdfObjectName) error) error {
+	if this.AcroForm == nil {
+		return nil
+	}
+	return this.AcroForm.forEachTerminalField(f)
+}
+
+// VerifySignatures verifies the signatures of the signed signature fields of the document.  The
+// signing certificates are verified at the current time against the trusted roots `roots`, with
+// the certificates included in the signatures as intermediates.  Invalid signatures are reported
+// in the results, an error is returned if the signature fields cannot be loaded.
+func (this *PdfReader) VerifySignatures(roots *x509.CertPool) ([]*SignatureVerification, error) {
+	data, err := this.readAll()
+	if err != nil {
+		return nil, err
+	}
+	fields, err := this.GetSignatureFields()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*SignatureVerification
+	for _, field := range fields {
+		if field.V != nil {
+			results = append(results, this.verifySignature(field, data, roots))
+		}
+	}
+	return results, nil
+}
+
+// verifySignature verifies the signature of `field` in the document with data `data`.
+func (this *PdfReader) verifySignature(field *PdfFieldSignature, data []byte, roots *x509.CertPool) *SignatureVerification {
+	sig := field.V
+	result := &SignatureVerification{Field: field}
+
+	byteRange, err := sig.getByteRange()
+	if err != nil {
+		result.Errors = append(result.Errors, err)
+		return result
+	}
+	result.ByteRange = byteRange
+	end := byteRange[2] + byteRange[3]
+	if end > int64(len(data)) {
+		result.Errors = append(result.Errors, errors.New("ByteRange beyond the end of the document"))
+		return result
+	}
+	result.AppendedBytes = int64(len(data)) - end
+	if err := this.checkSignatureContents(sig, data, byteRange); err != nil {
+		result.Errors = append(result.Errors, err)
+		return result
+	}
+
+	signer, certs, err := verifySignatureValue(sig, data, byteRange)
+	if signer != nil {
+		result.Certificate = signer.Certificate
+		result.SigningTime, _ = signer.SigningTime()
+	}
+	if err != nil {
+		result.Errors = append(result.Errors, err)
+	} else {
+		result.Valid = true
+	}
+
+	if result.Certificate != nil {
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs {
+			intermediates.AddCert(cert)
+		}
+		opts := x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		}
+		if roots == nil {
+			result.Errors = append(result.Errors, errors.New("No trusted roots"))
+		} else if chains, err := result.Certificate.Verify(opts); err != nil {
+			result.Errors = append(result.Errors, err)
+		} else {
+			result.Trusted = true
+			result.Chains = chains
+		}
+	}
+
+	if result.AppendedBytes > 0 {
+		violations, err := this.checkPermissions(sig, data[:end])
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+		}
+		result.Violations = violations
+	}
+	return result
+}
+
+// checkSignatureContents checks that the gap between the signed byte ranges `byteRange` of `data`
+// is exactly the hexadecimal string written for the Contents of the signature dictionary of `sig`,
+// so that the signed ranges cannot wrap a signature value other than that of the dictionary.
+func (this *PdfReader) checkSignatureContents(sig *PdfSignature, data []byte, byteRange []int64) error {
+	if sig.Contents == nil {
+		return errors.New("Signature value missing")
+	}
+	gap := data[byteRange[1]:byteRange[2]]
+	if len(gap) < 2 || gap[0] != '<' || gap[len(gap)-1] != '>' {
+		common.Log.Debug("ERROR: Gap between the byte ranges not a hexadecimal string")
+		return errors.New("Invalid signature value")
+	}
+	contents := make([]byte, hex.DecodedLen(len(gap)-2))
+	if _, err := hex.Decode(contents, gap[1:len(gap)-1]); err != nil {
+		common.Log.Debug("ERROR: Gap between the byte ranges not a hexadecimal string (%v)", err)
+		return errors.New("Invalid signature value")
+	}
+	if !bytes.Equal(contents, []byte(*sig.Contents)) {
+		common.Log.Debug("ERROR: Gap between the byte ranges not the Contents of the signature")
+		return errors.New("Invalid signature value")
+	}
+	offset, ok := this.parser.GetDictEntryOffset(int(sig.container.ObjectNumber), "Contents")
+	if !ok || offset != byteRange[1] {
+		common.Log.Debug("ERROR: Contents of the signature not written between the byte ranges")
+		return errors.New("Invalid signature value")
+	}
+	return nil
+}
+
+// verifySignatureValue verifies the CMS signature value of `sig` for the byte ranges `byteRange`
+// of `data`.  Returns the signer and the certificates included in the signature.
+func verifySignatureValue(sig *PdfSignature, data []byte, byteRange []int64) (*pkcs7.SignerInfo, []*x509.Certificate, error) {
+	var subFilter PdfObjectName
+	if sig.SubFilter != nil {
+		subFilter = *sig.SubFilter
+	}
+	switch subFilter {
+	case "adbe.pkcs7.detached", "ETSI.CAdES.detached", "adbe.pkcs7.sha1":
+	default:
+		return nil, nil, fmt.Errorf("Unsupported signature type %s", subFilter)
+	}
+
+	sd, err := pkcs7.Parse([]byte(*sig.Contents))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sd.Signers) != 1 {
+		return nil, sd.Certificates, fmt.Errorf("Invalid number of signers %d", len(sd.Signers))
+	}
+	signer := sd.Signers[0]
+
+	writeRanges := func(w io.Writer) {
+		w.Write(data[byteRange[0] : byteRange[0]+byteRange[1]])
+		w.Write(data[byteRange[2] : byteRange[2]+byteRange[3]])
+	}
+	digest := signer.Hash.New()
+	if subFilter == "adbe.pkcs7.sha1" {
+		// The signed content is the SHA-1 digest of the byte ranges.
+		h := sha1.New()
+		writeRanges(h)
+		if !bytes.Equal(h.Sum(nil), sd.Content) {
+			return signer, sd.Certificates, errors.New("Digest mismatch")
+		}
+		digest.Write(sd.Content)
+	} else {
+		writeRanges(digest)
+	}
+	return signer, sd.Certificates, signer.Verify(digest.Sum(nil))
+}
+
+// checkPermissions returns the changes made to the document after the signature `sig`, whose
+// signed revision has data `revData`, that are not permitted by the signature.
+func (this *PdfReader) checkPermissions(sig *PdfSignature, revData []byte) ([]string, error) {
+	permission, certified := sig.GetDocMDP()
+	action, fieldNames, locked := sig.GetFieldMDP()
+	if !certified && !locked {
+		return nil, nil
+	}
+
+	revision, err := this.loadRevision(revData)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []string
+	if locked {
+		before, err := revision.getFieldValues()
+		if err != nil {
+			return nil, err
+		}
+		after, err := this.getFieldValues()
+		if err != nil {
+			return nil, err
+		}
+		listed := map[string]bool{}
+		for _, name := range fieldNames {
+			listed[name] = true
+		}
+		var names []string
+		for name := range before {
+			if (action == "Include" && !listed[name]) || (action == "Exclude" && listed[name]) {
+				continue
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if val, ok := after[name]; !ok {
+				violations = append(violations, fmt.Sprintf("Locked field %s removed", name))
+			} else if !equalObjects(before[name], val) {
+				violations = append(violations, fmt.Sprintf("Locked field %s changed", name))
+			}
+		}
+	}
+
+	if certified {
+		changeable := revision.getDocMDPObjects()
+		revObjNums := map[int]bool{}
+		for _, objNum := range revision.parser.GetObjectNums() {
+			revObjNums[objNum] = true
+		}
+		for _, objNum := range this.parser.GetObjectNums() {
+			if !revObjNums[objNum] {
+				// New objects are only used through changes of existing ones.
+				continue
+			}
+			obj, err := this.parser.LookupByNumber(objNum)
+			if err != nil {
+				return violations, err
+			}
+			revObj, err := revision.parser.LookupByNumber(objNum)
+			if err != nil {
+				return violations, err
+			}
+			if equalObjects(revObj, obj) {
+				continue
+			}
+			if reason := this.checkDocMDPChange(revObj, obj, changeable, permission); reason != "" {
+				violations = append(violations, fmt.Sprintf("Object %d: %s", objNum, reason))
+			}
+		}
+	}
+	return violations, nil
+}
+
+// loadRevision loads the revision of the document with data `data`, decrypted with the
+// encryption key of the document.
+func (this *PdfReader) loadRevision(data []byte) (*PdfReader, error) {
+	revision, err := NewPdfReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if crypter := this.parser.GetCrypter(); crypter != nil {
+		revCrypter := revision.parser.GetCrypter()
+		if revCrypter == nil {
+			return nil, errors.New("Revision not encrypted")
+		}
+		revCrypter.EncryptionKey = crypter.EncryptionKey
+		revCrypter.Authenticated = true
+		if err := revision.loadStructure(); err != nil {
+			return nil, err
+		}
+	}
+	return revision, nil
+}
+
+// getFieldValues returns the values of the terminal fields of the form by full name.
+func (this *PdfReader) getFieldValues() (map[string]PdfObject, error) {
+	values := map[string]PdfObject{}
+	err := this.forEachTerminalField(func(field *PdfField, name string, ft *PdfObjectName) error {
+		values[name] = field.V
+		return nil
+	})
+	return values, err
+}
+
+// docMDPObjects are the objects of a signed revision, by object number, that can be changed when
+// filling forms and annotating.
+type docMDPObjects struct {
+	acroForm int64
+	// Form fields reachable from the AcroForm.
+	fields map[int64]bool
+	// Annots arrays of the pages, and Fields and Kids arrays of the form.
+	arrays map[int64]bool
+}
+
+// getDocMDPObjects returns the objects of the document that can be changed when filling forms and
+// annotating.
+func (this *PdfReader) getDocMDPObjects() *docMDPObjects {
+	objs := &docMDPObjects{fields: map[int64]bool{}, arrays: map[int64]bool{}}
+	for _, page := range this.pageList {
+		if dict, ok := page.PdfObject.(*PdfObjectDictionary); ok {
+			objs.arrays[referencedObjectNumber(dict.Get("Annots"))] = true
+		}
+	}
+
+	var addFields func(obj PdfObject)
+	addFields = func(obj PdfObject) {
+		obj, err := this.traceToObject(obj)
+		if err != nil {
+			return
+		}
+		arr, ok := TraceToDirectObject(obj).(*PdfObjectArray)
+		if !ok {
+			return
+		}
+		objs.arrays[referencedObjectNumber(obj)] = true
+		for _, field := range *arr {
+			num := referencedObjectNumber(field)
+			if num == 0 || objs.fields[num] {
+				continue
+			}
+			objs.fields[num] = true
+			fieldObj, err := this.traceToObject(field)
+			if err != nil {
+				continue
+			}
+			if dict, ok := TraceToDirectObject(fieldObj).(*PdfObjectDictionary); ok {
+				addFields(dict.Get("Kids"))
+			}
+		}
+	}
+	if acroForm, err := this.traceToObject(this.catalog.Get("AcroForm")); err == nil {
+		objs.acroForm = referencedObjectNumber(acroForm)
+		if dict, ok := TraceToDirectObject(acroForm).(*PdfObjectDictionary); ok {
+			addFields(dict.Get("Fields"))
+		}
+	}
+	// Direct objects are changed with the objects containing them.
+	delete(objs.arrays, 0)
+	delete(objs.fields, 0)
+	return objs
+}
+
+// checkDocMDPChange returns the reason for the change of object `old` to `obj` not being permitted
+// by the DocMDP permission `permission`, an empty string if permitted (section 12.8.2.2.2).  The
+// kind of object is that of `old`, the objects which can be changed are `changeable`.
+func (this *PdfReader) checkDocMDPChange(old, obj PdfObject, changeable *docMDPObjects, permission DocMDPPermission) string {
+	if permission == DocMDPNoChanges {
+		return "Changes not permitted"
+	}
+	if _, isStream := obj.(*PdfObjectStream); isStream {
+		return "Stream changed"
+	}
+	objNum := referencedObjectNumber(old)
+	dict, isDict := TraceToDirectObject(obj).(*PdfObjectDictionary)
+	oldDict, wasDict := TraceToDirectObject(old).(*PdfObjectDictionary)
+	if !isDict || !wasDict {
+		if _, isArray := TraceToDirectObject(obj).(*PdfObjectArray); isArray && changeable.arrays[objNum] {
+			// Arrays of fields or annotations.
+			return ""
+		}
+		return "Object changed"
+	}
+
+	var typ, subtype PdfObjectName
+	if name, ok := TraceToDirectObject(oldDict.Get("Type")).(*PdfObjectName); ok {
+		typ = *name
+	}
+	if name, ok := TraceToDirectObject(oldDict.Get("Subtype")).(*PdfObjectName); ok {
+		subtype = *name
+	}
+
+	switch {
+	case typ == "Catalog":
+		for _, key := range changedKeys(oldDict, dict) {
+			if key != "AcroForm" && key != "DSS" {
+				return fmt.Sprintf("Catalog entry %s changed", key)
+			}
+		}
+	case typ == "Page":
+		for _, key := range changedKeys(oldDict, dict) {
+			if key != "Annots" {
+				return fmt.Sprintf("Page entry %s changed", key)
+			}
+		}
+		if permission >= DocMDPFillFormsAndAnnotate {
+			return ""
+		}
+		// Only widget annotations can be added.
+		oldAnnots := map[int64]bool{}
+		if arr, ok := TraceToDirectObject(oldDict.Get("Annots")).(*PdfObjectArray); ok {
+			for _, annot := range *arr {
+				oldAnnots[referencedObjectNumber(annot)] = true
+			}
+		}
+		if arr, ok := TraceToDirectObject(dict.Get("Annots")).(*PdfObjectArray); ok {
+			for _, annot := range *arr {
+				if oldAnnots[referencedObjectNumber(annot)] {
+					continue
+				}
+				annotObj, err := this.traceToObject(annot)
+				if err != nil {
+					return "Invalid annotation"
+				}
+				annotDict, ok := TraceToDirectObject(annotObj).(*PdfObjectDictionary)
+				if !ok {
+					return "Invalid annotation"
+				}
+				if name, ok := TraceToDirectObject(annotDict.Get("Subtype")).(*PdfObjectName); !ok || *name != "Widget" {
+					return "Annotation added"
+				}
+			}
+		}
+	case typ == "Sig" || typ == "DocTimeStamp":
+		return "Signature changed"
+	case subtype == "Widget":
+	case oldDict.Get("Rect") != nil && subtype != "":
+		if permission < DocMDPFillFormsAndAnnotate {
+			return "Annotation changed"
+		}
+	case objNum == changeable.acroForm || changeable.fields[objNum] || oldDict.Get("FT") != nil:
+		// Form fields and the AcroForm.
+	default:
+		return "Object changed"
+	}
+	return ""
+}
+
+// referencedObjectNumber returns the number of the object referred to by `obj`, 0 if a direct
+// object.
+func referencedObjectNumber(obj PdfObject) int64 {
+	if ref, ok := obj.(*PdfObjectReference); ok {
+		return ref.ObjectNumber
+	}
+	return objectNumber(obj)
+}
+
+// changedKeys returns the keys of the entries of dictionaries `a` and `b` that differ.
+func changedKeys(a, b *PdfObjectDictionary) []PdfObjectName {
+	var keys []PdfObjectName
+	for _, key := range a.Keys() {
+		if !equalObjects(a.Get(key), b.Get(key)) {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range b.Keys() {
+		if a.Get(key) == nil && b.Get(key) != nil {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// equalObjects returns true if `a` and `b` are equal, comparing the numbers of indirect objects
+// instead of their contents, except at the top level.
+func equalObjects(a, b PdfObject) bool {
+	var equal func(a, b PdfObject, top bool) bool
+	equal = func(a, b PdfObject, top bool) bool {
+		if a == nil || b == nil {
+			return a == nil && b == nil
+		}
+		if !top {
+			if numA, numB := referencedObjectNumber(a), referencedObjectNumber(b); numA != 0 || numB != 0 {
+				return numA == numB
+			}
+		}
+		switch t := a.(type) {
+		case *PdfIndirectObject:
+			u, ok := b.(*PdfIndirectObject)
+			return ok && equal(t.PdfObject, u.PdfObject, false)
+		case *PdfObjectStream:
+			u, ok := b.(*PdfObjectStream)
+			return ok && bytes.Equal(t.Stream, u.Stream) &&
+				equal(t.PdfObjectDictionary, u.PdfObjectDictionary, false)
+		case *PdfObjectDictionary:
+			u, ok := b.(*PdfObjectDictionary)
+			if !ok || len(t.Keys()) != len(u.Keys()) {
+				return false
+			}
+			for _, key := range t.Keys() {
+				if !equal(t.Get(key), u.Get(key), false) {
+					return false
+				}
+			}
+			return true
+		case *PdfObjectArray:
+			u, ok := b.(*PdfObjectArray)
+			if !ok || len(*t) != len(*u) {
+				return false
+			}
+			for i := range *t {
+				if !equal((*t)[i], (*u)[i], false) {
+					return false
+				}
+			}
+			return true
+		case *PdfObjectInteger, *PdfObjectFloat:
+			x, errA := getNumberAsFloat(a)
+			y, errB := getNumberAsFloat(b)
+			return errA == nil && errB == nil && x == y
+		}
+		return a.DefaultWriteString() == b.DefaultWriteString()
+	}
+	return equal(a, b, true)
+}
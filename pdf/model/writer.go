@@ -10,6 +10,7 @@ package model
 
 import (
 	"bufio"
+	"bytes"
 	"crypto/md5"
 	"crypto/rand"
 	"errors"
@@ -77,6 +78,18 @@ type PdfWriter struct {
 
 	// Forms.
 	acroForm *PdfAcroForm
+
+	// Compress objects in object streams and write a cross-reference stream (PDF 1.5).
+	useObjectStreams bool
+
+	// Write a linearized file (Annex F).
+	linearize bool
+
+	// Embed the TrueType fonts in full instead of subsets with the glyphs shown.
+	noFontSubsetting bool
+
+	// Font programs embedded from TrueType files, by the font objects of the added pages.
+	subsettableFonts map[PdfObject]*PdfObjectStream
 }
 
 func NewPdfWriter() PdfWriter {
@@ -85,6 +98,7 @@ func NewPdfWriter() PdfWriter {
 	w.objectsMap = map[PdfObject]bool{}
 	w.objects = []PdfObject{}
 	w.pendingObjects = map[PdfObject]*PdfObjectDictionary{}
+	w.subsettableFonts = map[PdfObject]*PdfObjectStream{}
 
 	// PDF Version.  Can be changed if using more advanced features in PDF.
 	// By default it is set to 1.3.
@@ -135,6 +149,23 @@ func (this *PdfWriter) SetVersion(majorVersion, minorVersion int) {
 	this.minorVersion = minorVersion
 }
 
+// SetObjectStreams sets whether the objects are compressed in object streams, and the
+// cross-reference table written as a cross-reference stream.  Requires PDF 1.5, the version of the
+// output is raised if lower.  Stream objects and the encryption dictionary are not compressed.
+// Object streams are not supported in linearized output: Write fails if linearization is also
+// enabled with SetLinearization.
+func (this *PdfWriter) SetObjectStreams(enable bool) {
+	this.useObjectStreams = enable
+}
+
+// SetFontSubsetting enables or disables the subsetting of the embedded TrueType fonts.  If enabled
+// (default), the programs of the fonts created by NewPdfFontFromTTFFile and
+// NewCompositePdfFontFromTTFFile are replaced by subsets with the glyphs shown on the written pages
+// when writing.  Fonts showing character codes that are not mapped to glyphs are kept in full.
+func (this *PdfWriter) SetFontSubsetting(enable bool) {
+	this.noFontSubsetting = !enable
+}
+
 // Set the optional content properties.
 func (this *PdfWriter) SetOCProperties(ocProperties PdfObject) error {
 	dict := this.catalog
@@ -269,6 +300,15 @@ func (this *PdfWriter) AddPage(page *PdfPage) error {
 	common.Log.Trace("Appending to page list %T", obj)
 	procPage(page)
 
+	if page.Resources != nil {
+		for name, font := range page.Resources.fonts {
+			program := font.subsettableProgram()
+			if obj, has := page.Resources.GetFontByName(name); has && program != nil {
+				this.subsettableFonts[obj] = program
+			}
+		}
+	}
+
 	pageObj, ok := obj.(*PdfIndirectObject)
 	if !ok {
 		return errors.New("Page should be an indirect object")
@@ -434,28 +474,33 @@ func (this *PdfWriter) SetForms(form *PdfAcroForm) error {
 // Write out an indirect / stream object.
 func (this *PdfWriter) writeObject(num int, obj PdfObject) {
 	common.Log.Trace("Write obj #%d\n", num)
+	writeObject(this.writer, int64(num), 0, obj)
+}
 
+// writeObject writes out the indirect / stream object `obj` with object number `num` and generation
+// number `gen` to `w`.
+func writeObject(w *bufio.Writer, num, gen int64, obj PdfObject) {
 	if pobj, isIndirect := obj.(*PdfIndirectObject); isIndirect {
-		outStr := fmt.Sprintf("%d 0 obj\n", num)
+		outStr := fmt.Sprintf("%d %d obj\n", num, gen)
 		outStr += pobj.PdfObject.DefaultWriteString()
 		outStr += "\nendobj\n"
-		this.writer.WriteString(outStr)
+		w.WriteString(outStr)
 		return
 	}
 
 	// XXX/TODO: Add a default encoder if Filter not specified?
 	// Still need to make sure is encrypted.
 	if pobj, isStream := obj.(*PdfObjectStream); isStream {
-		outStr := fmt.Sprintf("%d 0 obj\n", num)
+		outStr := fmt.Sprintf("%d %d obj\n", num, gen)
 		outStr += pobj.PdfObjectDictionary.DefaultWriteString()
 		outStr += "\nstream\n"
-		this.writer.WriteString(outStr)
-		this.writer.Write(pobj.Stream)
-		this.writer.WriteString("\nendstream\nendobj\n")
+		w.WriteString(outStr)
+		w.Write(pobj.Stream)
+		w.WriteString("\nendstream\nendobj\n")
 		return
 	}
 
-	this.writer.WriteString(obj.DefaultWriteString())
+	w.WriteString(obj.DefaultWriteString())
 }
 
 // Update all the object numbers prior to writing.
@@ -476,6 +521,14 @@ func (this *PdfWriter) updateObjectNumbers() {
 type EncryptOptions struct {
 	Permissions AccessPermissions
 	Algorithm   EncryptionAlgorithm
+
+	// Recipients of public-key encryption.  If set, the document is encrypted with the
+	// public-key security handler for the recipients instead of with passwords, and Permissions
+	// are those of each recipient.
+	Recipients []PubSecRecipient
+	// SubFilter of the public-key security handler: PubSecS3 (RC4 only), PubSecS4 (RC4 or AES
+	// 128 bit) or PubSecS5 (default).
+	SubFilter string
 }
 
 // EncryptionAlgorithm is used in EncryptOptions to change the default algorithm used to encrypt the document.
@@ -491,7 +544,12 @@ const (
 )
 
 // Encrypt the output file with a specified user/owner password.
+// The passwords are ignored if options has Recipients.
 func (this *PdfWriter) Encrypt(userPass, ownerPass []byte, options *EncryptOptions) error {
+	if options != nil && len(options.Recipients) > 0 {
+		return this.encryptPubSec(options)
+	}
+
 	crypter := PdfCrypt{}
 	this.crypter = &crypter
 
@@ -551,16 +609,7 @@ func (this *PdfWriter) Encrypt(userPass, ownerPass []byte, options *EncryptOptio
 	this.encryptDict = ed
 
 	// Prepare the ID object for the trailer.
-	hashcode := md5.Sum([]byte(time.Now().Format(time.RFC850)))
-	id0 := PdfObjectString(hashcode[:])
-	b := make([]byte, 100)
-	rand.Read(b)
-	hashcode = md5.Sum(b)
-	id1 := PdfObjectString(hashcode[:])
-	common.Log.Trace("Random b: % x", b)
-
-	this.ids = &PdfObjectArray{&id0, &id1}
-	common.Log.Trace("Gen Id 0: % x", id0)
+	id0 := this.generateIds()
 
 	// Generate encryption parameters
 	if crypter.R < 5 {
@@ -613,10 +662,115 @@ func (this *PdfWriter) Encrypt(userPass, ownerPass []byte, options *EncryptOptio
 	return nil
 }
 
+// encryptPubSec sets up the encryption of the output file with the public-key security handler for
+// the recipients of `options`.
+func (this *PdfWriter) encryptPubSec(options *EncryptOptions) error {
+	crypter := PdfCrypt{
+		Filter:           PubSecFilter,
+		Subfilter:        options.SubFilter,
+		EncryptedObjects: map[PdfObject]bool{},
+		CryptFilters:     CryptFilters{},
+		EncryptMetadata:  true,
+	}
+	if crypter.Subfilter == "" {
+		crypter.Subfilter = PubSecS5
+	}
+
+	var cf CryptFilter
+	switch options.Algorithm {
+	case RC4_128bit:
+		cf = NewCryptFilterV2(16)
+	case AES_128bit:
+		cf = NewCryptFilterAESV2()
+	case AES_256bit:
+		cf = NewCryptFilterAESV3()
+	default:
+		return fmt.Errorf("unsupported algorithm: %v", options.Algorithm)
+	}
+	crypter.Length = cf.Length * 8
+
+	switch crypter.Subfilter {
+	case PubSecS3:
+		if options.Algorithm != RC4_128bit {
+			return fmt.Errorf("unsupported algorithm for %s: %v", crypter.Subfilter, options.Algorithm)
+		}
+		crypter.V = 2
+		crypter.CryptFilters[StandardCryptFilter] = cf
+	case PubSecS4, PubSecS5:
+		if options.Algorithm == AES_256bit {
+			if crypter.Subfilter == PubSecS4 {
+				return fmt.Errorf("unsupported algorithm for %s: %v", crypter.Subfilter, options.Algorithm)
+			}
+			this.SetVersion(2, 0)
+			crypter.V = 5
+		} else {
+			this.SetVersion(1, 5)
+			crypter.V = 4
+		}
+		crypter.CryptFilters[PubSecCryptFilter] = cf
+		crypter.StreamFilter = PubSecCryptFilter
+		crypter.StringFilter = PubSecCryptFilter
+	default:
+		return fmt.Errorf("unsupported subfilter: %s", crypter.Subfilter)
+	}
+
+	if err := crypter.GeneratePubSecParams(options.Recipients); err != nil {
+		return err
+	}
+
+	// Generate the encryption dictionary.
+	ed := MakeDict()
+	ed.Set("Filter", MakeName(PubSecFilter))
+	ed.Set("SubFilter", MakeName(crypter.Subfilter))
+	ed.Set("V", MakeInteger(int64(crypter.V)))
+	ed.Set("Length", MakeInteger(int64(crypter.Length)))
+	if crypter.Subfilter != PubSecS5 {
+		recipients := PdfObjectArray{}
+		for _, r := range crypter.Recipients {
+			recipients = append(recipients, MakeString(string(r)))
+		}
+		ed.Set("Recipients", &recipients)
+	}
+	if crypter.V >= 4 {
+		if err := crypter.SaveCryptFilters(ed); err != nil {
+			return err
+		}
+	}
+	this.crypter = &crypter
+	this.encryptDict = ed
+	this.generateIds()
+
+	io := MakeIndirectObject(ed)
+	this.encryptObj = io
+	this.addObject(io)
+
+	return nil
+}
+
+// generateIds generates the file identifiers of the trailer and returns the first one.
+func (this *PdfWriter) generateIds() PdfObjectString {
+	hashcode := md5.Sum([]byte(time.Now().Format(time.RFC850)))
+	id0 := PdfObjectString(hashcode[:])
+	b := make([]byte, 100)
+	rand.Read(b)
+	hashcode = md5.Sum(b)
+	id1 := PdfObjectString(hashcode[:])
+	common.Log.Trace("Random b: % x", b)
+
+	this.ids = &PdfObjectArray{&id0, &id1}
+	common.Log.Trace("Gen Id 0: % x", id0)
+	return id0
+}
+
 // Write the pdf out.
 func (this *PdfWriter) Write(ws io.WriteSeeker) error {
 	common.Log.Trace("Write()")
 
+	if this.linearize && this.useObjectStreams {
+		common.Log.Debug("ERROR: Object streams are not supported in linearized output")
+		return errors.New("Object streams with linearization not supported")
+	}
+
 	lk := license.GetLicenseKey()
 	if lk == nil || !lk.IsLicensed() {
 		fmt.Printf("Unlicensed copy of unidoc\n")
@@ -661,9 +815,21 @@ func (this *PdfWriter) Write(ws io.WriteSeeker) error {
 			}
 		}
 	}
+	if !this.noFontSubsetting {
+		restore := this.subsetFonts()
+		defer restore()
+	}
+
+	if this.useObjectStreams && (this.majorVersion < 1 || this.majorVersion == 1 && this.minorVersion < 5) {
+		this.SetVersion(1, 5)
+	}
 	// Set version in the catalog.
 	this.catalog.Set("Version", MakeName(fmt.Sprintf("%d.%d", this.majorVersion, this.minorVersion)))
 
+	if this.linearize {
+		return this.writeLinearized(ws)
+	}
+
 	w := bufio.NewWriter(ws)
 	this.writer = w
 
@@ -673,15 +839,28 @@ func (this *PdfWriter) Write(ws io.WriteSeeker) error {
 
 	this.updateObjectNumbers()
 
-	offsets := []int64{}
+	// Cross-reference entries, indexed by object number.
+	entries := make([]xrefEntry, len(this.objects)+1)
+	entries[0] = xrefEntry{typ: xrefTypeFree, gen: 65535}
+	for idx := range this.objects {
+		entries[idx+1].objNum = idx + 1
+	}
+
+	var objStreams []*PdfObjectStream
+	if this.useObjectStreams {
+		objStreams = this.makeObjectStreams(entries)
+	}
 
 	// Write objects
 	common.Log.Trace("Writing %d obj", len(this.objects))
 	for idx, obj := range this.objects {
+		if entries[idx+1].typ == xrefTypeCompressed {
+			continue
+		}
 		common.Log.Trace("Writing %d", idx)
 		this.writer.Flush()
 		offset, _ := ws.Seek(0, os.SEEK_CUR)
-		offsets = append(offsets, offset)
+		entries[idx+1] = xrefEntry{objNum: idx + 1, typ: xrefTypeInUse, offset: offset}
 
 		// Encrypt prior to writing.
 		// Encrypt dictionary should not be encrypted.
@@ -695,40 +874,230 @@ func (this *PdfWriter) Write(ws io.WriteSeeker) error {
 		}
 		this.writeObject(idx+1, obj)
 	}
+
+	// Write the object streams, numbered after the other objects.
+	for _, objStream := range objStreams {
+		this.writer.Flush()
+		offset, _ := ws.Seek(0, os.SEEK_CUR)
+		entries = append(entries, xrefEntry{objNum: len(entries), typ: xrefTypeInUse, offset: offset})
+
+		if this.crypter != nil {
+			err := this.crypter.Encrypt(objStream, objStream.ObjectNumber, 0)
+			if err != nil {
+				common.Log.Debug("ERROR: Failed encrypting (%s)", err)
+				return err
+			}
+		}
+		this.writeObject(int(objStream.ObjectNumber), objStream)
+	}
 	w.Flush()
 
 	xrefOffset, _ := ws.Seek(0, os.SEEK_CUR)
-	// Write xref table.
-	this.writer.WriteString("xref\r\n")
-	outStr := fmt.Sprintf("%d %d\r\n", 0, len(this.objects)+1)
-	this.writer.WriteString(outStr)
-	outStr = fmt.Sprintf("%.10d %.5d f\r\n", 0, 65535)
-	this.writer.WriteString(outStr)
-	for _, offset := range offsets {
-		outStr = fmt.Sprintf("%.10d %.5d n\r\n", offset, 0)
-		this.writer.WriteString(outStr)
-	}
 
-	// Generate & write trailer
+	// Generate trailer
 	trailer := MakeDict()
 	trailer.Set("Info", this.infoObj)
 	trailer.Set("Root", this.root)
-	trailer.Set("Size", MakeInteger(int64(len(this.objects)+1)))
 	// If encrypted!
 	if this.crypter != nil {
 		trailer.Set("Encrypt", this.encryptObj)
 		trailer.Set("ID", this.ids)
 		common.Log.Trace("Ids: %s", this.ids)
 	}
-	this.writer.WriteString("trailer\n")
-	this.writer.WriteString(trailer.DefaultWriteString())
-	this.writer.WriteString("\n")
+
+	if this.useObjectStreams {
+		// The cross-reference stream has the last object number, its dictionary is the trailer.
+		entries = append(entries, xrefEntry{objNum: len(entries), typ: xrefTypeInUse, offset: xrefOffset})
+		trailer.Set("Size", MakeInteger(int64(len(entries))))
+		xrefStream, err := makeXrefStream(entries, trailer)
+		if err != nil {
+			return err
+		}
+		this.writeObject(len(entries)-1, xrefStream)
+	} else {
+		// Write xref table and trailer.
+		trailer.Set("Size", MakeInteger(int64(len(entries))))
+		writeXrefTable(this.writer, entries)
+		this.writer.WriteString("trailer\n")
+		this.writer.WriteString(trailer.DefaultWriteString())
+		this.writer.WriteString("\n")
+	}
 
 	// Make offset reference.
-	outStr = fmt.Sprintf("startxref\n%d\n", xrefOffset)
+	outStr := fmt.Sprintf("startxref\n%d\n", xrefOffset)
 	this.writer.WriteString(outStr)
 	this.writer.WriteString("%%EOF\n")
 	w.Flush()
 
 	return nil
 }
+
+// Maximum number of objects in an object stream.
+const objectStreamCapacity = 100
+
+// makeObjectStreams packs the indirect objects other than streams and the encryption dictionary
+// into object streams, and sets their cross-reference entries in `entries`.  The object streams are
+// numbered following the other objects.
+func (this *PdfWriter) makeObjectStreams(entries []xrefEntry) []*PdfObjectStream {
+	var packed [][]*PdfIndirectObject
+	for idx, obj := range this.objects {
+		io, isIndirect := obj.(*PdfIndirectObject)
+		if !isIndirect || obj == this.encryptObj {
+			continue
+		}
+		if len(packed) == 0 || len(packed[len(packed)-1]) == objectStreamCapacity {
+			packed = append(packed, nil)
+		}
+		n := len(packed) - 1
+		entries[idx+1] = xrefEntry{
+			objNum: idx + 1,
+			typ:    xrefTypeCompressed,
+			offset: int64(len(this.objects) + 1 + n),
+			gen:    len(packed[n]),
+		}
+		packed[n] = append(packed[n], io)
+
+		// The strings of compressed objects are encrypted with the object stream.
+		if this.crypter != nil {
+			this.crypter.EncryptedObjects[io] = true
+		}
+	}
+
+	var objStreams []*PdfObjectStream
+	for n, objs := range packed {
+		header := bytes.NewBuffer(nil)
+		body := bytes.NewBuffer(nil)
+		for _, io := range objs {
+			header.WriteString(fmt.Sprintf("%d %d ", io.ObjectNumber, body.Len()))
+			body.WriteString(io.PdfObject.DefaultWriteString())
+			body.WriteString("\n")
+		}
+		header.WriteString("\n")
+
+		encoder := NewFlateEncoder()
+		encoded, _ := encoder.EncodeBytes(append(header.Bytes(), body.Bytes()...))
+		objStream := &PdfObjectStream{}
+		objStream.ObjectNumber = int64(len(this.objects) + 1 + n)
+		objStream.PdfObjectDictionary = encoder.MakeStreamDict()
+		objStream.Set("Type", MakeName("ObjStm"))
+		objStream.Set("N", MakeInteger(int64(len(objs))))
+		objStream.Set("First", MakeInteger(int64(header.Len())))
+		objStream.Set("Length", MakeInteger(int64(len(encoded))))
+		objStream.Stream = encoded
+		objStreams = append(objStreams, objStream)
+	}
+	return objStreams
+}
+
+// Cross-reference entry types.
+const (
+	xrefTypeFree       = 0
+	xrefTypeInUse      = 1
+	xrefTypeCompressed = 2
+)
+
+// xrefEntry is a cross-reference table entry.
+type xrefEntry struct {
+	objNum int
+	typ    int
+	// Byte offset of an object in use, object number of the object stream containing a compressed
+	// object, or next free object number.
+	offset int64
+	// Generation number, or index of a compressed object in its object stream.
+	gen int
+}
+
+// xrefSubsections splits `entries`, sorted by object number, into subsections of consecutive
+// object numbers.
+func xrefSubsections(entries []xrefEntry) [][]xrefEntry {
+	var subsections [][]xrefEntry
+	start := 0
+	for i := 1; i <= len(entries); i++ {
+		if i == len(entries) || entries[i].objNum != entries[i-1].objNum+1 {
+			subsections = append(subsections, entries[start:i])
+			start = i
+		}
+	}
+	return subsections
+}
+
+// writeXrefTable writes a cross-reference table with the entries `entries`, sorted by object number.
+func writeXrefTable(w *bufio.Writer, entries []xrefEntry) {
+	w.WriteString("xref\r\n")
+	for _, subsection := range xrefSubsections(entries) {
+		outStr := fmt.Sprintf("%d %d\r\n", subsection[0].objNum, len(subsection))
+		w.WriteString(outStr)
+		for _, entry := range subsection {
+			if entry.typ == xrefTypeFree {
+				outStr = fmt.Sprintf("%.10d %.5d f\r\n", entry.offset, entry.gen)
+			} else {
+				outStr = fmt.Sprintf("%.10d %.5d n\r\n", entry.offset, entry.gen)
+			}
+			w.WriteString(outStr)
+		}
+	}
+}
+
+// makeXrefStream makes a cross-reference stream with the entries `entries`, sorted by object
+// number.  The entries of `trailer`, which has to include Size, are copied to the stream
+// dictionary.
+func makeXrefStream(entries []xrefEntry, trailer *PdfObjectDictionary) (*PdfObjectStream, error) {
+	// Field widths, enough for the largest values.
+	var max [3]int64
+	for _, entry := range entries {
+		if entry.offset > max[1] {
+			max[1] = entry.offset
+		}
+		if int64(entry.gen) > max[2] {
+			max[2] = int64(entry.gen)
+		}
+	}
+	widths := [3]int{1, 0, 0}
+	for i := 1; i < 3; i++ {
+		for widths[i] = 1; max[i]>>uint(8*widths[i]) != 0; widths[i]++ {
+		}
+	}
+
+	data := make([]byte, 0, len(entries)*(widths[0]+widths[1]+widths[2]))
+	for _, entry := range entries {
+		fields := [3]int64{int64(entry.typ), entry.offset, int64(entry.gen)}
+		for i, v := range fields {
+			for j := widths[i] - 1; j >= 0; j-- {
+				data = append(data, byte(v>>uint(8*j)))
+			}
+		}
+	}
+
+	encoder := NewFlateEncoder()
+	encoded, err := encoder.EncodeBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	xrefStream := &PdfObjectStream{}
+	xrefStream.PdfObjectDictionary = encoder.MakeStreamDict()
+	for _, key := range trailer.Keys() {
+		xrefStream.Set(key, trailer.Get(key))
+	}
+	xrefStream.Set("Type", MakeName("XRef"))
+	xrefStream.Set("W", MakeArrayFromIntegers(widths[:]))
+	// The default Index is [0 Size].
+	subsections := xrefSubsections(entries)
+	if len(subsections) > 1 || entries[0].objNum != 0 || len(entries) != int(trailerSize(trailer)) {
+		index := []int{}
+		for _, subsection := range subsections {
+			index = append(index, subsection[0].objNum, len(subsection))
+		}
+		xrefStream.Set("Index", MakeArrayFromIntegers(index))
+	}
+	xrefStream.Set("Length", MakeInteger(int64(len(encoded))))
+	xrefStream.Stream = encoded
+	return xrefStream, nil
+}
+
+// trailerSize returns the Size entry of `trailer`.
+func trailerSize(trailer *PdfObjectDictionary) int64 {
+	if size, ok := TraceToDirectObject(trailer.Get("Size")).(*PdfObjectInteger); ok {
+		return int64(*size)
+	}
+	return 0
+}
@@ -0,0 +1,252 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/unidoc/unidoc/pdf/core"
+)
+
+// newTestPage returns an empty letter size page with resources.
+func newTestPage() *PdfPage {
+	page := NewPdfPage()
+	page.MediaBox = &PdfRectangle{Urx: 612, Ury: 792}
+	page.Resources = NewPdfPageResources()
+	return page
+}
+
+// writeTestDocument writes a document with `numPages` pages, each with a content stream identifying
+// the page, using `setup` to configure the writer.  Returns the output file contents.
+func writeTestDocument(t *testing.T, numPages int, setup func(w *PdfWriter)) []byte {
+	w := NewPdfWriter()
+	for i := 1; i <= numPages; i++ {
+		page := newTestPage()
+		page.AddContentStreamByString(fmt.Sprintf("BT (Page %d) Tj ET", i))
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Failed to add page: %v", err)
+		}
+	}
+	if setup != nil {
+		setup(&w)
+	}
+	return writeTestWriter(t, &w)
+}
+
+// writeTestPages writes a document with the pages `pages` and the form `form` if not nil, and
+// returns the output file contents.
+func writeTestPages(t *testing.T, pages []*PdfPage, form *PdfAcroForm) []byte {
+	w := NewPdfWriter()
+	for _, page := range pages {
+		if err := w.AddPage(page); err != nil {
+			t.Fatalf("Failed to add page: %v", err)
+		}
+	}
+	if form != nil {
+		w.SetForms(form)
+	}
+	return writeTestWriter(t, &w)
+}
+
+// writeTestWriter writes the document of `w` and returns the output file contents.
+func writeTestWriter(t *testing.T, w *PdfWriter) []byte {
+	f, err := ioutil.TempFile("", "unidoc-writer-test")
+	if err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err := w.Write(f); err != nil {
+		t.Fatalf("Failed to write document: %v", err)
+	}
+	data, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatalf("Failed to read document: %v", err)
+	}
+	return data
+}
+
+// readTestDocument reads the document `data`, decrypted with `password` if not nil.
+func readTestDocument(t *testing.T, data []byte, password []byte) *PdfReader {
+	reader, err := NewPdfReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to read document: %v", err)
+	}
+	if password != nil {
+		if ok, err := reader.Decrypt(password); err != nil || !ok {
+			t.Fatalf("Failed to decrypt document: %v", err)
+		}
+	}
+	return reader
+}
+
+// checkTestDocument checks that `data` is a document written by writeTestDocument.
+func checkTestDocument(t *testing.T, data []byte, numPages int, password []byte) {
+	reader := readTestDocument(t, data, password)
+	n, err := reader.GetNumPages()
+	if err != nil || n != numPages {
+		t.Fatalf("Wrong number of pages %d (%v)", n, err)
+	}
+	for i := 1; i <= numPages; i++ {
+		page, err := reader.GetPage(i)
+		if err != nil {
+			t.Fatalf("Failed to get page %d: %v", i, err)
+		}
+		content, err := page.GetAllContentStreams()
+		if err != nil {
+			t.Fatalf("Failed to get content of page %d: %v", i, err)
+		}
+		if !strings.Contains(content, fmt.Sprintf("(Page %d) Tj", i)) {
+			t.Errorf("Wrong content of page %d: %q", i, content)
+		}
+	}
+
+	trailer, err := reader.GetTrailer()
+	if err != nil {
+		t.Fatalf("No trailer: %v", err)
+	}
+	info, err := reader.traceToObject(trailer.Get("Info"))
+	if err != nil {
+		t.Fatalf("No Info: %v", err)
+	}
+	if !strings.Contains(TraceToDirectObject(info).String(), "UniDoc") {
+		t.Errorf("Wrong Info dictionary %s", TraceToDirectObject(info))
+	}
+}
+
+func TestWriteObjectStreams(t *testing.T) {
+	plain := writeTestDocument(t, 3, nil)
+	checkTestDocument(t, plain, 3, nil)
+
+	// More pages than fit in one object stream.
+	const numPages = 150
+	data := writeTestDocument(t, numPages, func(w *PdfWriter) {
+		w.SetObjectStreams(true)
+	})
+	if !bytes.HasPrefix(data, []byte("%PDF-1.5")) {
+		t.Errorf("Wrong version %q", data[:8])
+	}
+	if bytes.Contains(data, []byte("xref\r\n")) || bytes.Contains(data, []byte("trailer")) {
+		t.Errorf("Cross-reference table written")
+	}
+	if n := bytes.Count(data, []byte("/ObjStm")); n < 2 {
+		t.Errorf("Wrong number of object streams %d", n)
+	}
+	if !bytes.Contains(data, []byte("/XRef")) {
+		t.Errorf("No cross-reference stream")
+	}
+	checkTestDocument(t, data, numPages, nil)
+
+	// Compressed objects are encrypted with their object stream.
+	for _, algorithm := range []EncryptionAlgorithm{RC4_128bit, AES_128bit, AES_256bit} {
+		data := writeTestDocument(t, 5, func(w *PdfWriter) {
+			w.SetObjectStreams(true)
+			if err := w.Encrypt([]byte("user"), []byte("owner"), &EncryptOptions{Algorithm: algorithm}); err != nil {
+				t.Fatalf("Failed to set encryption: %v", err)
+			}
+		})
+		if bytes.Contains(data, []byte("(Page 1)")) {
+			t.Errorf("Content not encrypted")
+		}
+		checkTestDocument(t, data, 5, []byte("user"))
+	}
+}
+
+func TestEncryptPubSec(t *testing.T) {
+	var keys []*rsa.PrivateKey
+	var certs []*x509.Certificate
+	for i := 0; i < 3; i++ {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("Failed to generate key: %v", err)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(int64(i + 1)),
+			Subject:      pkix.Name{CommonName: fmt.Sprintf("Recipient %d", i)},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+		if err != nil {
+			t.Fatalf("Failed to create certificate: %v", err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatalf("Failed to parse certificate: %v", err)
+		}
+		keys = append(keys, key)
+		certs = append(certs, cert)
+	}
+
+	recipients := []PubSecRecipient{
+		{Certificate: certs[0], Permissions: AccessPermissions{Printing: true, Modify: true}},
+		{Certificate: certs[1], Permissions: AccessPermissions{FillForms: true}},
+	}
+	tests := []struct {
+		subFilter string
+		algorithm EncryptionAlgorithm
+	}{
+		{PubSecS3, RC4_128bit},
+		{PubSecS4, RC4_128bit},
+		{PubSecS4, AES_128bit},
+		{PubSecS5, AES_128bit},
+		{"", AES_256bit},
+	}
+	for _, test := range tests {
+		data := writeTestDocument(t, 2, func(w *PdfWriter) {
+			options := &EncryptOptions{Algorithm: test.algorithm, Recipients: recipients, SubFilter: test.subFilter}
+			if err := w.Encrypt(nil, nil, options); err != nil {
+				t.Fatalf("Failed to set encryption: %v", err)
+			}
+		})
+		if bytes.Contains(data, []byte("(Page 1)")) {
+			t.Errorf("%s: Content not encrypted", test.subFilter)
+		}
+
+		// Other certificates and passwords cannot decrypt.
+		reader := readTestDocument(t, data, nil)
+		if ok, err := reader.DecryptWithKey(certs[2], keys[2]); err != nil || ok {
+			t.Errorf("%s: Decrypted with other key (%v)", test.subFilter, err)
+		}
+		if ok, _ := reader.Decrypt([]byte("")); ok {
+			t.Errorf("%s: Decrypted with password", test.subFilter)
+		}
+
+		for i, r := range recipients {
+			reader := readTestDocument(t, data, nil)
+			if ok, err := reader.DecryptWithKey(certs[i], keys[i]); err != nil || !ok {
+				t.Fatalf("%s: Failed to decrypt for recipient %d: %v", test.subFilter, i, err)
+			}
+			if ok, perms, err := reader.CheckAccessRights(nil); err != nil || !ok || perms != r.Permissions {
+				t.Errorf("%s: Wrong permissions of recipient %d: %+v", test.subFilter, i, perms)
+			}
+			page, err := reader.GetPage(2)
+			if err != nil {
+				t.Fatalf("%s: Failed to get page: %v", test.subFilter, err)
+			}
+			if content, err := page.GetAllContentStreams(); err != nil || !strings.Contains(content, "(Page 2) Tj") {
+				t.Errorf("%s: Wrong content %q (%v)", test.subFilter, content, err)
+			}
+		}
+	}
+
+	// Subfilters are limited in algorithms.
+	w := NewPdfWriter()
+	if err := w.Encrypt(nil, nil, &EncryptOptions{Algorithm: AES_128bit, Recipients: recipients, SubFilter: PubSecS3}); err == nil {
+		t.Errorf("AES accepted for %s", PubSecS3)
+	}
+}
@@ -408,6 +408,20 @@ func NewXObjectImageFromStream(stream *PdfObjectStream) (*XObjectImage, error) {
 			return nil, err
 		}
 		img.ColorSpace = cs
+	} else if jpxEnc, ok := encoder.(*JPXEncoder); ok && jpxEnc.ColorComponents > 0 {
+		// JPEG 2000 images may have no ColorSpace entry, the colour space is then specified in the
+		// image data.
+		switch jpxEnc.ColorComponents {
+		case 1:
+			img.ColorSpace = NewPdfColorspaceDeviceGray()
+		case 3:
+			img.ColorSpace = NewPdfColorspaceDeviceRGB()
+		case 4:
+			img.ColorSpace = NewPdfColorspaceDeviceCMYK()
+		default:
+			common.Log.Debug("ERROR: Unsupported number of JPX color components: %d", jpxEnc.ColorComponents)
+			return nil, errors.New("Unsupported colorspace")
+		}
 	} else {
 		// If not specified, assume gray..
 		common.Log.Debug("XObject Image colorspace not specified - assuming 1 color component")
@@ -509,18 +523,39 @@ func (ximg *XObjectImage) ToImage() (*Image, error) {
 	}
 	image.Width = *ximg.Width
 
-	if ximg.BitsPerComponent == nil {
+	jpxEnc, isJPX := ximg.Filter.(*JPXEncoder)
+	if isJPX {
+		// BitsPerComponent is ignored for JPEG 2000 images, which are decoded to 8 bits.
+		image.BitsPerComponent = int64(jpxEnc.BitsPerComponent)
+	} else if ximg.BitsPerComponent != nil {
+		image.BitsPerComponent = *ximg.BitsPerComponent
+	} else if isMask, ok := TraceToDirectObject(ximg.ImageMask).(*PdfObjectBool); ok && bool(*isMask) {
+		// Image masks have 1 bit per component, the entry is optional.
+		image.BitsPerComponent = 1
+	} else {
 		return nil, errors.New("Bits per component missing")
 	}
-	image.BitsPerComponent = *ximg.BitsPerComponent
 
 	image.ColorComponents = ximg.ColorSpace.GetNumComponents()
 
-	decoded, err := DecodeStream(ximg.primitive)
-	if err != nil {
-		return nil, err
+	if isJPX {
+		decoded, alpha, err := jpxEnc.DecodeBytesWithAlpha(ximg.Stream)
+		if err != nil {
+			return nil, err
+		}
+		image.Data = decoded
+		// With SMaskInData, the alpha channel embedded in the image data is used as soft mask.
+		if jpxEnc.SMaskInData > 0 && alpha != nil {
+			image.alphaData = alpha
+			image.hasAlpha = true
+		}
+	} else {
+		decoded, err := DecodeStream(ximg.primitive)
+		if err != nil {
+			return nil, err
+		}
+		image.Data = decoded
 	}
-	image.Data = decoded
 
 	if ximg.Decode != nil {
 		darr, ok := ximg.Decode.(*PdfObjectArray)
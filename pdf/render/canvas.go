@@ -0,0 +1,81 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image"
+)
+
+// canvas is the device the content streams are rendered on, the page or the transparent cell of a
+// tiling pattern.
+type canvas struct {
+	img *image.RGBA
+}
+
+// fill paints the pixels covered by `m` with `p` with the opacity `alpha`, clipped by `clip`.
+func (c *canvas) fill(m *mask, p paint, alpha float64, clip *mask) {
+	if !p.ok || alpha <= 0 {
+		return
+	}
+	i := 0
+	for y := m.rect.Min.Y; y < m.rect.Max.Y; y++ {
+		for x := m.rect.Min.X; x < m.rect.Max.X; x++ {
+			if cov := m.a[i]; cov > 0 {
+				c.blend(x, y, p.rgb, float64(cov)*alpha, clip)
+			}
+			i++
+		}
+	}
+}
+
+// fillImage paints the pixels covered by `m` with the colors of `src`, which covers the pixels of
+// `m`, with the opacity `alpha`, clipped by `clip`.  All the pixels of `src` are painted if `m` is
+// nil.
+func (c *canvas) fillImage(m *mask, src *image.NRGBA, alpha float64, clip *mask) {
+	if alpha <= 0 {
+		return
+	}
+	rect := src.Rect
+	if m != nil {
+		rect = m.rect
+	}
+	i := 0
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			cov := float32(1)
+			if m != nil {
+				cov = m.a[i]
+				i++
+			}
+			off := src.PixOffset(x, y)
+			if a := src.Pix[off+3]; cov > 0 && a > 0 {
+				rgb := [3]float64{float64(src.Pix[off]) / 0xFF, float64(src.Pix[off+1]) / 0xFF, float64(src.Pix[off+2]) / 0xFF}
+				c.blend(x, y, rgb, float64(cov)*float64(a)/0xFF*alpha, clip)
+			}
+		}
+	}
+}
+
+// blend blends the pixel (x, y) with the color `rgb` with the opacity `alpha`, clipped by `clip`.
+func (c *canvas) blend(x, y int, rgb [3]float64, alpha float64, clip *mask) {
+	if clip != nil {
+		alpha *= float64(clip.at(x, y))
+	}
+	if alpha <= 0 {
+		return
+	}
+	if alpha > 1 {
+		alpha = 1
+	}
+	// The pixels are alpha-premultiplied, and opaque on pages.
+	off := c.img.PixOffset(x, y)
+	for i, v := range rgb {
+		dst := float64(c.img.Pix[off+i])
+		c.img.Pix[off+i] = uint8(dst + (v*0xFF-dst)*alpha + 0.5)
+	}
+	dst := float64(c.img.Pix[off+3])
+	c.img.Pix[off+3] = uint8(dst + (0xFF-dst)*alpha + 0.5)
+}
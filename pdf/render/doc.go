@@ -0,0 +1,27 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package render rasterizes PDF pages to RGBA images, e.g. for thumbnails, previews and visual
+// regression tests, and converts them to SVG documents, e.g. for web viewers.
+//
+// Paths are filled and stroked with anti-aliasing and clipped, images are drawn with their soft
+// masks, stencil and color key masks, and text is drawn from the glyph outlines of embedded
+// TrueType, Type1 and CFF font programs and from the glyph descriptions of Type3 fonts.  Colors are
+// converted to RGB by their colorspaces, and painted with the constant opacity of ExtGState
+// parameter dictionaries.  Shadings of all types are drawn as patterns and by the sh operator, and
+// tiling patterns by repeating their rendered cell.  RenderShading rasterizes a single shading, e.g.
+// to replace sh operators with images.  The normal appearances of the annotations shown on screen are drawn over
+// the page.
+//
+// Fonts without embedded font program, such as the standard 14 fonts, blend modes, soft masks of
+// ExtGState parameter dictionaries and transparency groups are not supported by RenderPage: text in
+// such fonts is not drawn, and transparency groups are drawn as if not grouped.
+//
+// WritePageSVG converts the same content to SVG elements, in points: paths and clipping paths to
+// path and clipPath elements, images to PNG data URIs, tiling patterns to pattern elements,
+// transparency groups, soft masks and blend modes to groups, masks and mix-blend-mode, and shadings
+// to images.  Text in embedded fonts is drawn with glyph outlines defined once by glyph, and text in
+// other simple fonts converted to text elements in similar generic fonts.
+package render
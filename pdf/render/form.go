@@ -0,0 +1,137 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Annotation flags (12.5.3 "Annotation Flags") of annotations whose appearance is not shown.
+const (
+	annotationFlagHidden = 1 << 1
+	annotationFlagNoView = 1 << 5
+)
+
+// formStream is the content of a form XObject or annotation appearance stream.
+type formStream struct {
+	stream    *core.PdfObjectStream
+	content   string
+	resources *model.PdfPageResources
+	matrix    transform.Matrix // Maps the form space to the user space it is painted in.
+	bbox      *model.PdfRectangle
+}
+
+// formStack is the set of the form XObjects being rendered, nested in one another.  Painting a
+// form again within itself is a cycle.
+type formStack map[*core.PdfObjectStream]bool
+
+// newFormStream returns the form XObject `stream`, whose resources default to the resources
+// `parentResources` of the content stream painting it.
+func newFormStream(stream *core.PdfObjectStream, parentResources *model.PdfPageResources) (*formStream, error) {
+	xform, err := model.NewXObjectFormFromStream(stream)
+	if err != nil {
+		return nil, err
+	}
+	content, err := xform.GetContentStream()
+	if err != nil {
+		return nil, err
+	}
+	matrix, err := toMatrix(xform.Matrix)
+	if err != nil {
+		common.Log.Debug("Invalid form Matrix: %v", err)
+		return nil, err
+	}
+
+	form := &formStream{
+		stream:    stream,
+		content:   string(content),
+		resources: xform.Resources,
+		matrix:    matrix,
+	}
+	if form.resources == nil {
+		form.resources = parentResources
+	}
+	if arr, ok := core.TraceToDirectObject(xform.BBox).(*core.PdfObjectArray); ok {
+		form.bbox, _ = model.NewPdfRectangle(*arr)
+	}
+	return form, nil
+}
+
+// annotationAppearance is the normal appearance stream of an annotation painted on a page.
+type annotationAppearance struct {
+	form *formStream
+	ctm  transform.Matrix // Maps the transformed appearance box to the annotation rectangle.
+}
+
+// annotationAppearances returns the normal appearance streams (/AP /N) of the annotations
+// `annotations` of a page which are shown on screen.
+func annotationAppearances(annotations []*model.PdfAnnotation) []annotationAppearance {
+	var appearances []annotationAppearance
+	for _, annotation := range annotations {
+		if flags, ok := core.TraceToDirectObject(annotation.F).(*core.PdfObjectInteger); ok &&
+			*flags&(annotationFlagHidden|annotationFlagNoView) != 0 {
+			continue
+		}
+
+		stream := appearanceStream(annotation)
+		if stream == nil {
+			continue
+		}
+		form, err := newFormStream(stream, nil)
+		if err != nil {
+			common.Log.Debug("Unable to load annotation appearance: %v", err)
+			continue
+		}
+		rectArr, ok := core.TraceToDirectObject(annotation.Rect).(*core.PdfObjectArray)
+		if !ok || form.bbox == nil {
+			continue
+		}
+		rect, err := model.NewPdfRectangle(*rectArr)
+		if err != nil {
+			continue
+		}
+
+		// Algorithm 8.1: the appearance box transformed by Matrix is mapped to Rect.
+		llx, lly, urx, ury := form.matrix.TransformRect(form.bbox.Llx, form.bbox.Lly, form.bbox.Urx, form.bbox.Ury)
+		sx, sy := 1.0, 1.0
+		if urx > llx {
+			sx = (rect.Urx - rect.Llx) / (urx - llx)
+		}
+		if ury > lly {
+			sy = (rect.Ury - rect.Lly) / (ury - lly)
+		}
+		ctm := transform.TranslationMatrix(-llx, -lly).Mult(transform.ScaleMatrix(sx, sy)).
+			Mult(transform.TranslationMatrix(math.Min(rect.Llx, rect.Urx), math.Min(rect.Lly, rect.Ury)))
+		appearances = append(appearances, annotationAppearance{form: form, ctm: ctm})
+	}
+	return appearances
+}
+
+// appearanceStream returns the normal appearance stream of `annotation`, selected by its
+// appearance state if it has several, or nil if none.
+func appearanceStream(annotation *model.PdfAnnotation) *core.PdfObjectStream {
+	ap, ok := core.TraceToDirectObject(annotation.AP).(*core.PdfObjectDictionary)
+	if !ok {
+		return nil
+	}
+	switch n := core.TraceToDirectObject(ap.Get("N")).(type) {
+	case *core.PdfObjectStream:
+		return n
+	case *core.PdfObjectDictionary:
+		state, ok := core.TraceToDirectObject(annotation.AS).(*core.PdfObjectName)
+		if !ok {
+			return nil
+		}
+		stream, _ := core.TraceToDirectObject(n.Get(*state)).(*core.PdfObjectStream)
+		return stream
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Maximum number of samples by axis taken by pixel of downscaled images.
+const maxImageSupersampling = 4
+
+// rasterImage is an image decoded to RGB colors with an alpha channel, row 0 at the top.
+type rasterImage struct {
+	pix *image.NRGBA
+	// Stencil masks are painted with the fill color, the alpha channel of pix giving where.
+	stencil bool
+}
+
+// loadImageXObject returns the image XObject `stream` decoded, with the alpha channel of its soft
+// mask (SMask), stencil mask or color key mask (Mask).
+func loadImageXObject(stream *core.PdfObjectStream) (*rasterImage, error) {
+	ximg, err := model.NewXObjectImageFromStream(stream)
+	if err != nil {
+		return nil, err
+	}
+	img, err := ximg.ToImage()
+	if err != nil {
+		return nil, err
+	}
+	decode, _ := toFloats(ximg.Decode)
+
+	if isMask, ok := core.TraceToDirectObject(ximg.ImageMask).(*core.PdfObjectBool); ok && bool(*isMask) {
+		return newStencilImage(img, decode)
+	}
+
+	var colorKey []float64
+	if arr, ok := core.TraceToDirectObject(ximg.Mask).(*core.PdfObjectArray); ok {
+		colorKey, _ = arr.ToFloat64Array()
+	}
+	pix, err := decodeImage(img, ximg.ColorSpace, decode, colorKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if smask, ok := core.TraceToDirectObject(ximg.SMask).(*core.PdfObjectStream); ok {
+		alpha, err := loadMaskXObject(smask, false)
+		if err != nil {
+			common.Log.Debug("Unable to load SMask: %v", err)
+		} else {
+			applyAlpha(pix, alpha)
+		}
+	} else if mask, ok := core.TraceToDirectObject(ximg.Mask).(*core.PdfObjectStream); ok {
+		alpha, err := loadMaskXObject(mask, true)
+		if err != nil {
+			common.Log.Debug("Unable to load Mask: %v", err)
+		} else {
+			applyAlpha(pix, alpha)
+		}
+	}
+	return &rasterImage{pix: pix}, nil
+}
+
+// loadInlineImage returns the inline image `iimg` of a content stream with the resources
+// `resources` decoded.
+func loadInlineImage(iimg *contentstream.ContentStreamInlineImage, resources *model.PdfPageResources) (*rasterImage, error) {
+	img, err := iimg.ToImage(resources)
+	if err != nil {
+		return nil, err
+	}
+	decode, _ := toFloats(iimg.Decode)
+	if isMask, err := iimg.IsMask(); err == nil && isMask {
+		return newStencilImage(img, decode)
+	}
+	cs, err := iimg.GetColorSpace(resources)
+	if err != nil {
+		return nil, err
+	}
+	pix, err := decodeImage(img, cs, decode, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &rasterImage{pix: pix}, nil
+}
+
+// loadMaskXObject returns the gray levels of the image XObject `stream` used as the soft mask of
+// an image, or the alpha of the stencil mask `stream` if `stencil`.
+func loadMaskXObject(stream *core.PdfObjectStream, stencil bool) (*image.Alpha, error) {
+	ximg, err := model.NewXObjectImageFromStream(stream)
+	if err != nil {
+		return nil, err
+	}
+	if stencil && ximg.BitsPerComponent == nil {
+		bpc := int64(1)
+		ximg.BitsPerComponent = &bpc
+	}
+	img, err := ximg.ToImage()
+	if err != nil {
+		return nil, err
+	}
+	img.ColorComponents = 1
+	decode, _ := toFloats(ximg.Decode)
+	return decodeGray(img, decode, stencil)
+}
+
+// newStencilImage returns the stencil mask `img`, painting where its samples decoded by `decode`
+// are 0.
+func newStencilImage(img *model.Image, decode []float64) (*rasterImage, error) {
+	img.ColorComponents = 1
+	alpha, err := decodeGray(img, decode, true)
+	if err != nil {
+		return nil, err
+	}
+	pix := image.NewNRGBA(alpha.Rect)
+	for i, a := range alpha.Pix {
+		pix.Pix[4*i+3] = a
+	}
+	return &rasterImage{pix: pix, stencil: true}, nil
+}
+
+// sampleReader reads the samples of image data, whose rows start at byte boundaries.
+type sampleReader struct {
+	data     []byte
+	bpc      int
+	ncomp    int
+	rowBytes int
+}
+
+func newSampleReader(img *model.Image) (sampleReader, error) {
+	r := sampleReader{data: img.Data, bpc: int(img.BitsPerComponent), ncomp: img.ColorComponents}
+	switch r.bpc {
+	case 1, 2, 4, 8, 16:
+	default:
+		common.Log.Debug("Invalid image BitsPerComponent %d", r.bpc)
+		return r, errors.New("Range check error")
+	}
+	if img.Width <= 0 || img.Height <= 0 || r.ncomp <= 0 {
+		return r, errors.New("Empty image")
+	}
+	r.rowBytes = (int(img.Width)*r.ncomp*r.bpc + 7) / 8
+	return r, nil
+}
+
+// sample returns the sample of the component `c` of the pixel (x, y), or 0 if missing.
+func (r sampleReader) sample(x, y, c int) uint32 {
+	bit := (x*r.ncomp + c) * r.bpc
+	offset := y*r.rowBytes + bit/8
+	if r.bpc >= 8 {
+		if offset+r.bpc/8 > len(r.data) {
+			return 0
+		}
+		if r.bpc == 16 {
+			return uint32(r.data[offset])<<8 | uint32(r.data[offset+1])
+		}
+		return uint32(r.data[offset])
+	}
+	if offset >= len(r.data) {
+		return 0
+	}
+	shift := uint(8 - bit%8 - r.bpc)
+	return uint32(r.data[offset]>>shift) & (1<<uint(r.bpc) - 1)
+}
+
+// maxSample returns the maximum sample value.
+func (r sampleReader) maxSample() float64 {
+	return float64(uint32(1)<<uint(r.bpc) - 1)
+}
+
+// decodeImage returns the image `img` with the colorspace `cs` converted to RGB, its samples
+// mapped to color components by the Decode array `decode` or the default decode array of `cs` if
+// not valid.  Pixels whose samples are in the ranges of the color key mask `colorKey` are
+// transparent.
+func decodeImage(img *model.Image, cs model.PdfColorspace, decode []float64, colorKey []float64) (*image.NRGBA, error) {
+	ncomp := cs.GetNumComponents()
+	if img.ColorComponents != ncomp {
+		common.Log.Debug("Image with %d components in %d component colorspace", img.ColorComponents, ncomp)
+		img.ColorComponents = ncomp
+	}
+	reader, err := newSampleReader(img)
+	if err != nil {
+		return nil, err
+	}
+	maxSample := reader.maxSample()
+	ranges := cs.DecodeArray()
+	if len(decode) != 2*ncomp {
+		decode = ranges
+		if _, isIndexed := cs.(*model.PdfColorspaceSpecialIndexed); isIndexed {
+			decode = []float64{0, maxSample}
+		}
+	}
+	if len(decode) != 2*ncomp {
+		return nil, errors.New("Invalid decode array")
+	}
+	if len(colorKey) != 2*ncomp {
+		colorKey = nil
+	}
+
+	w, h := int(img.Width), int(img.Height)
+	pix := image.NewNRGBA(image.Rect(0, 0, w, h))
+	// Colors by samples, if they fit in the keys.
+	var cache map[uint64]color.NRGBA
+	if ncomp*reader.bpc <= 64 {
+		cache = map[uint64]color.NRGBA{}
+	}
+	vals := make([]float64, ncomp)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var key uint64
+			masked := colorKey != nil
+			for c := 0; c < ncomp; c++ {
+				s := reader.sample(x, y, c)
+				key = key<<uint(reader.bpc) | uint64(s)
+				if masked && (float64(s) < colorKey[2*c] || float64(s) > colorKey[2*c+1]) {
+					masked = false
+				}
+				v := decode[2*c] + float64(s)*(decode[2*c+1]-decode[2*c])/maxSample
+				if len(ranges) == 2*ncomp {
+					v = math.Max(ranges[2*c], math.Min(ranges[2*c+1], v))
+				}
+				vals[c] = v
+			}
+			if masked {
+				continue
+			}
+
+			col, found := cache[key]
+			if !found {
+				rgb, err := colorToRGB(cs, vals)
+				if err != nil {
+					return nil, err
+				}
+				col = color.NRGBA{toByte(rgb[0]), toByte(rgb[1]), toByte(rgb[2]), 0xFF}
+				if cache != nil {
+					cache[key] = col
+				}
+			}
+			pix.SetNRGBA(x, y, col)
+		}
+	}
+	return pix, nil
+}
+
+// decodeGray returns the gray levels of the single component image `img`, its samples mapped by
+// the Decode array `decode`, default [0 1].  The levels are inverted if `stencil`, stencil masks
+// painting where the decoded samples are 0.
+func decodeGray(img *model.Image, decode []float64, stencil bool) (*image.Alpha, error) {
+	reader, err := newSampleReader(img)
+	if err != nil {
+		return nil, err
+	}
+	if len(decode) != 2 {
+		decode = []float64{0, 1}
+	}
+	maxSample := reader.maxSample()
+	w, h := int(img.Width), int(img.Height)
+	alpha := image.NewAlpha(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			v := decode[0] + float64(reader.sample(x, y, 0))*(decode[1]-decode[0])/maxSample
+			if stencil {
+				v = 1 - v
+			}
+			alpha.Pix[y*w+x] = toByte(v)
+		}
+	}
+	return alpha, nil
+}
+
+// applyAlpha multiplies the alpha channel of `pix` by `alpha`, scaled to the size of `pix`.
+func applyAlpha(pix *image.NRGBA, alpha *image.Alpha) {
+	w, h := pix.Rect.Dx(), pix.Rect.Dy()
+	aw, ah := alpha.Rect.Dx(), alpha.Rect.Dy()
+	for y := 0; y < h; y++ {
+		ay := y * ah / h
+		for x := 0; x < w; x++ {
+			a := alpha.Pix[ay*aw+x*aw/w]
+			i := y*pix.Stride + 4*x + 3
+			pix.Pix[i] = uint8(uint32(pix.Pix[i]) * uint32(a) / 0xFF)
+		}
+	}
+}
+
+// colorToRGB returns the RGB components of the color of `cs` with the components `vals`.
+func colorToRGB(cs model.PdfColorspace, vals []float64) ([3]float64, error) {
+	col, err := cs.ColorFromFloats(vals)
+	if err != nil {
+		return [3]float64{}, err
+	}
+	return rgbOf(cs, col)
+}
+
+// rgbOf returns the RGB components of the color `col` of `cs`.
+func rgbOf(cs model.PdfColorspace, col model.PdfColor) ([3]float64, error) {
+	rgb, err := cs.ColorToRGB(col)
+	if err != nil {
+		return [3]float64{}, err
+	}
+	if t, ok := rgb.(*model.PdfColorDeviceRGB); ok {
+		return [3]float64{t.R(), t.G(), t.B()}, nil
+	}
+	common.Log.Debug("Unexpected RGB color %T", rgb)
+	return [3]float64{}, errors.New("Type check error")
+}
+
+// toByte returns the value `v` in [0, 1] scaled to [0, 255].
+func toByte(v float64) uint8 {
+	return uint8(math.Max(0, math.Min(1, v))*0xFF + 0.5)
+}
+
+// drawImage paints `img` mapped from the unit square to device space by `ctm`, stencil masks with
+// the color `fill`, with the opacity `alpha` and clipped by `clip`.
+func (c *canvas) drawImage(img *rasterImage, ctm transform.Matrix, fill [3]float64, alpha float64, clip *mask) {
+	rect := c.img.Rect
+	if clip != nil {
+		rect = rect.Intersect(clip.rect)
+	}
+	sampleImage(img, ctm, rect, func(x, y int, rgb [3]float64, a float64) {
+		if img.stencil {
+			rgb = fill
+		}
+		c.blend(x, y, rgb, a*alpha, clip)
+	})
+}
+
+// stencilMask returns the pixels of `bounds` covered by the stencil mask `img` mapped from the unit
+// square to device space by `ctm`.
+func stencilMask(img *rasterImage, ctm transform.Matrix, bounds image.Rectangle) *mask {
+	llx, lly, urx, ury := ctm.TransformRect(0, 0, 1, 1)
+	rect := image.Rect(int(math.Floor(llx)), int(math.Floor(lly)), int(math.Ceil(urx)), int(math.Ceil(ury))).
+		Intersect(bounds)
+	m := &mask{rect: rect, a: make([]float32, rect.Dx()*rect.Dy())}
+	sampleImage(img, ctm, rect, func(x, y int, rgb [3]float64, a float64) {
+		m.a[(y-rect.Min.Y)*rect.Dx()+x-rect.Min.X] = float32(a)
+	})
+	return m
+}
+
+// sampleImage calls `visit` with the average color and opacity of the samples of `img` in each
+// pixel of `bounds` it covers, `img` being mapped from the unit square to device space by `ctm`.
+func sampleImage(img *rasterImage, ctm transform.Matrix, bounds image.Rectangle,
+	visit func(x, y int, rgb [3]float64, a float64)) {
+	inv, ok := ctm.Inverse()
+	if !ok {
+		return
+	}
+	llx, lly, urx, ury := ctm.TransformRect(0, 0, 1, 1)
+	rect := image.Rect(int(math.Floor(llx)), int(math.Floor(lly)), int(math.Ceil(urx)), int(math.Ceil(ury))).
+		Intersect(bounds)
+
+	w, h := img.pix.Rect.Dx(), img.pix.Rect.Dy()
+	n := int(math.Ceil(math.Sqrt(float64(w*h) / math.Abs(ctm[0]*ctm[3]-ctm[1]*ctm[2]))))
+	if n < 1 {
+		n = 1
+	} else if n > maxImageSupersampling {
+		n = maxImageSupersampling
+	}
+	weight := 1 / float64(n*n)
+
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			var r, g, b, a float64
+			for j := 0; j < n; j++ {
+				for i := 0; i < n; i++ {
+					u, v := inv.Transform(float64(x)+(float64(i)+0.5)/float64(n), float64(y)+(float64(j)+0.5)/float64(n))
+					if u < 0 || u >= 1 || v <= 0 || v > 1 {
+						continue
+					}
+					px := int(u * float64(w))
+					py := int((1 - v) * float64(h))
+					if px >= w || py >= h {
+						continue
+					}
+					off := py*img.pix.Stride + 4*px
+					sa := float64(img.pix.Pix[off+3]) / 0xFF
+					r += float64(img.pix.Pix[off]) / 0xFF * sa
+					g += float64(img.pix.Pix[off+1]) / 0xFF * sa
+					b += float64(img.pix.Pix[off+2]) / 0xFF * sa
+					a += sa
+				}
+			}
+			if a == 0 {
+				continue
+			}
+			visit(x, y, [3]float64{r / a, g / a, b / a}, a*weight)
+		}
+	}
+}
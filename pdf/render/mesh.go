@@ -0,0 +1,457 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"errors"
+	"image"
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// meshReader reads the vertices of the mesh shadings of types 4 to 7 from their stream data
+// (8.7.4.5.5 "Type 4 Shadings (Free-Form Gouraud-Shaded Triangle Meshes)").
+type meshReader struct {
+	data []byte
+	pos  int // Position in bits.
+
+	bitsPerCoordinate, bitsPerComponent, bitsPerFlag int
+
+	decode  []float64 // xmin xmax ymin ymax, then the range of each color value.
+	nvalues int       // Number of color components, or 1 for the parametric value of functions.
+}
+
+// read returns the next `bits` bits, false if past the end of the data.
+func (r *meshReader) read(bits int) (uint64, bool) {
+	if r.pos+bits > 8*len(r.data) {
+		return 0, false
+	}
+	var v uint64
+	for i := 0; i < bits; i++ {
+		bit := r.data[r.pos>>3] >> uint(7-r.pos&7) & 1
+		v = v<<1 | uint64(bit)
+		r.pos++
+	}
+	return v, true
+}
+
+// align skips the bits padding the data to the next byte boundary.
+func (r *meshReader) align() {
+	r.pos = (r.pos + 7) &^ 7
+}
+
+// readDecoded returns the next value of `bits` bits mapped to the range of `decode`[`i`].
+func (r *meshReader) readDecoded(bits, i int) (float64, bool) {
+	v, ok := r.read(bits)
+	if !ok {
+		return 0, false
+	}
+	dmin, dmax := r.decode[2*i], r.decode[2*i+1]
+	return dmin + float64(v)*(dmax-dmin)/(math.Exp2(float64(bits))-1), true
+}
+
+// flag returns the next edge flag.
+func (r *meshReader) flag() (int, bool) {
+	v, ok := r.read(r.bitsPerFlag)
+	return int(v), ok
+}
+
+// point returns the coordinates of the next vertex or control point.
+func (r *meshReader) point() (point, bool) {
+	x, ok := r.readDecoded(r.bitsPerCoordinate, 0)
+	if !ok {
+		return point{}, false
+	}
+	y, ok := r.readDecoded(r.bitsPerCoordinate, 1)
+	return point{x, y}, ok
+}
+
+// color returns the color components or parametric value of the next vertex or patch corner.
+func (r *meshReader) color() ([]float64, bool) {
+	vals := make([]float64, r.nvalues)
+	for i := range vals {
+		v, ok := r.readDecoded(r.bitsPerComponent, 2+i)
+		if !ok {
+			return nil, false
+		}
+		vals[i] = v
+	}
+	return vals, true
+}
+
+// meshVertex is a vertex of a Gouraud-shaded triangle in device space, with its RGB color or, for
+// shadings with functions, its parametric value as first component.
+type meshVertex struct {
+	p point
+	c [3]float64
+}
+
+// meshPainter paints the triangles of mesh shadings on an image.
+type meshPainter struct {
+	img   *image.NRGBA
+	cs    model.PdfColorspace
+	table *colorTable // Colors of the parametric values, nil without functions.
+	ctm   transform.Matrix
+}
+
+// vertex returns the vertex of the point `p` of shading space with the color values `vals`.
+func (m *meshPainter) vertex(p point, vals []float64) (meshVertex, error) {
+	v := meshVertex{}
+	v.p.x, v.p.y = m.ctm.Transform(p.x, p.y)
+	if m.table != nil {
+		v.c[0] = vals[0]
+		return v, nil
+	}
+	rgb, err := colorToRGB(m.cs, clampComponents(m.cs, vals))
+	if err != nil {
+		return v, err
+	}
+	v.c = rgb
+	return v, nil
+}
+
+// drawMeshShading paints the mesh shading `shading` of type 4 to 7 on `img`, shading space being
+// mapped to device space by `ctm`.  `verticesPerRow` is only set for lattice-form meshes, and
+// `bitsPerFlag` only for free-form and patch meshes.
+func drawMeshShading(img *image.NRGBA, shading *model.PdfShading, bitsPerCoordinate, bitsPerComponent,
+	bitsPerFlag, verticesPerRow *core.PdfObjectInteger, decodeObj *core.PdfObjectArray, funcs []model.PdfFunction,
+	ctm transform.Matrix) error {
+	stream, ok := shading.GetContainingPdfObject().(*core.PdfObjectStream)
+	if !ok {
+		common.Log.Debug("Mesh shading not a stream (%T)", shading.GetContainingPdfObject())
+		return errors.New("Type check error")
+	}
+	if bitsPerCoordinate == nil || bitsPerComponent == nil || decodeObj == nil {
+		return errors.New("Required attribute missing")
+	}
+	data, err := core.DecodeStream(stream)
+	if err != nil {
+		return err
+	}
+	decode, err := decodeObj.ToFloat64Array()
+	if err != nil {
+		return err
+	}
+
+	cs := shading.ColorSpace
+	r := &meshReader{
+		data:              data,
+		bitsPerCoordinate: int(*bitsPerCoordinate),
+		bitsPerComponent:  int(*bitsPerComponent),
+		nvalues:           cs.GetNumComponents(),
+		decode:            decode,
+	}
+	if bitsPerFlag != nil {
+		r.bitsPerFlag = int(*bitsPerFlag)
+	}
+	m := &meshPainter{img: img, cs: cs, ctm: ctm}
+	if len(funcs) > 0 {
+		r.nvalues = 1
+		if len(decode) >= 6 {
+			if m.table, err = newColorTable(cs, funcs, decode[4], decode[5]); err != nil {
+				return err
+			}
+		}
+	}
+	if r.bitsPerCoordinate < 1 || r.bitsPerCoordinate > 32 || r.bitsPerComponent < 1 || r.bitsPerComponent > 16 ||
+		r.bitsPerFlag > 8 || len(decode) < 4+2*r.nvalues || (len(funcs) > 0 && m.table == nil) {
+		common.Log.Debug("Invalid mesh shading parameters")
+		return errors.New("Range check error")
+	}
+
+	switch *shading.ShadingType {
+	case 4:
+		return m.drawFreeForm(r)
+	case 5:
+		if verticesPerRow == nil || *verticesPerRow < 2 {
+			return errors.New("Range check error")
+		}
+		return m.drawLattice(r, int(*verticesPerRow))
+	default:
+		return m.drawPatches(r, *shading.ShadingType == 7)
+	}
+}
+
+// drawFreeForm paints the triangles of a free-form triangle mesh, whose vertices start at byte
+// boundaries.  The edge flags of the second and third vertices of new triangles are ignored.
+func (m *meshPainter) drawFreeForm(r *meshReader) error {
+	var tri [3]meshVertex
+	left := 0 // Number of vertices left to read of a new triangle.
+	started := false
+	for {
+		f, ok := r.flag()
+		if !ok {
+			return nil
+		}
+		p, ok := r.point()
+		if !ok {
+			return nil
+		}
+		vals, ok := r.color()
+		if !ok {
+			return nil
+		}
+		r.align()
+		v, err := m.vertex(p, vals)
+		if err != nil {
+			return err
+		}
+
+		if left > 0 {
+			tri[3-left] = v
+			left--
+			if left > 0 {
+				continue
+			}
+		} else {
+			if f != 0 && !started {
+				common.Log.Debug("Mesh edge flag %d without previous triangle", f)
+				f = 0
+			}
+			switch f {
+			case 0:
+				tri[0], left = v, 2
+				continue
+			case 1:
+				tri = [3]meshVertex{tri[1], tri[2], v}
+			case 2:
+				tri = [3]meshVertex{tri[0], tri[2], v}
+			default:
+				common.Log.Debug("Invalid mesh edge flag %d", f)
+				return nil
+			}
+		}
+		m.drawTriangle(tri[0], tri[1], tri[2])
+		started = true
+	}
+}
+
+// drawLattice paints the triangles of a lattice-form mesh with `verticesPerRow` vertices by row.
+func (m *meshPainter) drawLattice(r *meshReader, verticesPerRow int) error {
+	var prev, row []meshVertex
+	for {
+		p, ok := r.point()
+		if !ok {
+			return nil
+		}
+		vals, ok := r.color()
+		if !ok {
+			return nil
+		}
+		v, err := m.vertex(p, vals)
+		if err != nil {
+			return err
+		}
+		row = append(row, v)
+		if len(row) < verticesPerRow {
+			continue
+		}
+		if prev != nil {
+			for i := 0; i+1 < verticesPerRow; i++ {
+				m.drawTriangle(prev[i], prev[i+1], row[i])
+				m.drawTriangle(prev[i+1], row[i+1], row[i])
+			}
+		}
+		prev, row = row, nil
+	}
+}
+
+// Indexes of the control points of Coons and tensor-product patches in the order of the mesh data
+// (8.7.4.5.8 "Type 7 Shadings (Tensor-Product Patch Meshes)"), the first four of which are shared
+// with the previous patch by edge flags 1 to 3.
+var patchPointOrder = [16][2]int{
+	{0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 3}, {2, 3}, {3, 3}, {3, 2},
+	{3, 1}, {3, 0}, {2, 0}, {1, 0}, {1, 1}, {1, 2}, {2, 2}, {2, 1},
+}
+
+// Indexes of the control points of the previous patch shared by the edge flags 1 to 3.
+var patchSharedPoints = [3][4][2]int{
+	{{0, 3}, {1, 3}, {2, 3}, {3, 3}},
+	{{3, 3}, {3, 2}, {3, 1}, {3, 0}},
+	{{3, 0}, {2, 0}, {1, 0}, {0, 0}},
+}
+
+// patch is a tensor-product patch with its control points in device space and the colors of its
+// corners p00, p03, p33 and p30.
+type patch struct {
+	p [4][4]point
+	c [4][3]float64
+}
+
+// drawPatches paints the patches of a Coons patch mesh, or of a tensor-product patch mesh if
+// `tensor`.  The patches start at byte boundaries.
+func (m *meshPainter) drawPatches(r *meshReader, tensor bool) error {
+	npoints := 12
+	if tensor {
+		npoints = 16
+	}
+	var prev patch
+	hasPrev := false
+	for {
+		f, ok := r.flag()
+		if !ok {
+			return nil
+		}
+		if f < 0 || f > 3 {
+			common.Log.Debug("Invalid patch edge flag %d", f)
+			return nil
+		}
+		if f != 0 && !hasPrev {
+			common.Log.Debug("Patch edge flag %d without previous patch", f)
+			return nil
+		}
+
+		var pt patch
+		first, firstColor := 0, 0
+		if f != 0 {
+			for i, ij := range patchSharedPoints[f-1] {
+				dst := patchPointOrder[i]
+				pt.p[dst[0]][dst[1]] = prev.p[ij[0]][ij[1]]
+			}
+			pt.c[0], pt.c[1] = prev.c[f], prev.c[(f+1)%4]
+			first, firstColor = 4, 2
+		}
+		for i := first; i < npoints; i++ {
+			p, ok := r.point()
+			if !ok {
+				return nil
+			}
+			ij := patchPointOrder[i]
+			pt.p[ij[0]][ij[1]].x, pt.p[ij[0]][ij[1]].y = m.ctm.Transform(p.x, p.y)
+		}
+		for i := firstColor; i < 4; i++ {
+			vals, ok := r.color()
+			if !ok {
+				return nil
+			}
+			v, err := m.vertex(point{}, vals)
+			if err != nil {
+				return err
+			}
+			pt.c[i] = v.c
+		}
+		r.align()
+		if !tensor {
+			pt.setCoonsInteriorPoints()
+		}
+		m.drawPatch(&pt)
+		prev, hasPrev = pt, true
+	}
+}
+
+// setCoonsInteriorPoints sets the interior control points of the Coons patch `pt` from its
+// boundary (8.7.4.5.8).
+func (pt *patch) setCoonsInteriorPoints() {
+	p := &pt.p
+	interior := func(corner, a1, a2, far1, far2, b1, b2, opposite point) point {
+		return point{
+			(-4*corner.x + 6*(a1.x+a2.x) - 2*(far1.x+far2.x) + 3*(b1.x+b2.x) - opposite.x) / 9,
+			(-4*corner.y + 6*(a1.y+a2.y) - 2*(far1.y+far2.y) + 3*(b1.y+b2.y) - opposite.y) / 9,
+		}
+	}
+	p[1][1] = interior(p[0][0], p[0][1], p[1][0], p[0][3], p[3][0], p[3][1], p[1][3], p[3][3])
+	p[1][2] = interior(p[0][3], p[0][2], p[1][3], p[0][0], p[3][3], p[3][2], p[1][0], p[3][0])
+	p[2][1] = interior(p[3][0], p[3][1], p[2][0], p[3][3], p[0][0], p[0][1], p[2][3], p[0][3])
+	p[2][2] = interior(p[3][3], p[3][2], p[2][3], p[3][0], p[0][3], p[0][2], p[2][0], p[0][0])
+}
+
+// drawPatch paints `pt`, subdivided into a grid of Gouraud-shaded triangles.
+func (m *meshPainter) drawPatch(pt *patch) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for i := range pt.p {
+		for _, p := range pt.p[i] {
+			minX, maxX = math.Min(minX, p.x), math.Max(maxX, p.x)
+			minY, maxY = math.Min(minY, p.y), math.Max(maxY, p.y)
+		}
+	}
+	if !image.Rect(int(minX)-1, int(minY)-1, int(maxX)+1, int(maxY)+1).Overlaps(m.img.Rect) {
+		return
+	}
+	n := int(math.Max(maxX-minX, maxY-minY)/4) + 1
+	if n < 3 {
+		n = 3
+	} else if n > maxPatchSubdivisions {
+		n = maxPatchSubdivisions
+	}
+
+	grid := make([]meshVertex, (n+1)*(n+1))
+	for i := 0; i <= n; i++ {
+		u := float64(i) / float64(n)
+		bu := bernstein(u)
+		for j := 0; j <= n; j++ {
+			v := float64(j) / float64(n)
+			bv := bernstein(v)
+			var vtx meshVertex
+			for k := 0; k < 4; k++ {
+				for l := 0; l < 4; l++ {
+					w := bu[k] * bv[l]
+					vtx.p.x += w * pt.p[k][l].x
+					vtx.p.y += w * pt.p[k][l].y
+				}
+			}
+			for k := range vtx.c {
+				vtx.c[k] = (1-u)*(1-v)*pt.c[0][k] + (1-u)*v*pt.c[1][k] + u*v*pt.c[2][k] + u*(1-v)*pt.c[3][k]
+			}
+			grid[i*(n+1)+j] = vtx
+		}
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a, b := grid[i*(n+1)+j], grid[i*(n+1)+j+1]
+			c, d := grid[(i+1)*(n+1)+j], grid[(i+1)*(n+1)+j+1]
+			m.drawTriangle(a, b, c)
+			m.drawTriangle(b, d, c)
+		}
+	}
+}
+
+// bernstein returns the cubic Bernstein polynomials at `t`.
+func bernstein(t float64) [4]float64 {
+	s := 1 - t
+	return [4]float64{s * s * s, 3 * t * s * s, 3 * t * t * s, t * t * t}
+}
+
+// drawTriangle paints the pixels whose centers are within the triangle `a`, `b`, `c` with the
+// colors interpolated between its vertices.
+func (m *meshPainter) drawTriangle(a, b, c meshVertex) {
+	area := (b.p.x-a.p.x)*(c.p.y-a.p.y) - (b.p.y-a.p.y)*(c.p.x-a.p.x)
+	if area == 0 || math.IsNaN(area) {
+		return
+	}
+	minX := math.Min(a.p.x, math.Min(b.p.x, c.p.x))
+	maxX := math.Max(a.p.x, math.Max(b.p.x, c.p.x))
+	minY := math.Min(a.p.y, math.Min(b.p.y, c.p.y))
+	maxY := math.Max(a.p.y, math.Max(b.p.y, c.p.y))
+	rect := image.Rect(int(math.Floor(minX)), int(math.Floor(minY)), int(math.Ceil(maxX)), int(math.Ceil(maxY))).
+		Intersect(m.img.Rect)
+
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		py := float64(y) + 0.5
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			px := float64(x) + 0.5
+			// Barycentric coordinates of the pixel center.
+			wa := ((b.p.x-px)*(c.p.y-py) - (b.p.y-py)*(c.p.x-px)) / area
+			wb := ((c.p.x-px)*(a.p.y-py) - (c.p.y-py)*(a.p.x-px)) / area
+			wc := 1 - wa - wb
+			if wa < 0 || wb < 0 || wc < 0 {
+				continue
+			}
+			var col [3]float64
+			for k := range col {
+				col[k] = wa*a.c[k] + wb*b.c[k] + wc*c.c[k]
+			}
+			if m.table != nil {
+				col = m.table.value(col[0])
+			}
+			setPixel(m.img, x, y, col)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"math"
+
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+)
+
+// Maximum number of line segments a curve is flattened to.
+const maxCurveSegments = 100
+
+// Flattening tolerance, the maximum distance in pixels between curves and the line segments
+// approximating them.
+const flatness = 0.1
+
+// subpath is a subpath of a path flattened to line segments, in user space.
+type subpath struct {
+	points []point
+	closed bool
+}
+
+// path is the current path (8.5.2 "Path Construction Operators") with its curves flattened to
+// line segments, in user space.
+type path struct {
+	subpaths  []subpath
+	current   point
+	hasPoint  bool
+	tolerance float64 // Flattening tolerance in user space units.
+}
+
+// newPath returns an empty path whose curves are flattened for the device transformation `ctm` of
+// the user space.
+func newPath(ctm transform.Matrix) *path {
+	return &path{tolerance: flatness / deviceScale(ctm)}
+}
+
+// deviceScale returns the average scaling of lengths by `ctm`, or a tiny positive number if it is
+// degenerate.
+func deviceScale(ctm transform.Matrix) float64 {
+	scale := math.Sqrt(math.Abs(ctm[0]*ctm[3] - ctm[1]*ctm[2]))
+	if scale < 1e-9 {
+		return 1e-9
+	}
+	return scale
+}
+
+func (p *path) moveTo(x, y float64) {
+	p.subpaths = append(p.subpaths, subpath{points: []point{{x, y}}})
+	p.current = point{x, y}
+	p.hasPoint = true
+}
+
+func (p *path) lineTo(x, y float64) {
+	if !p.hasPoint {
+		p.moveTo(x, y)
+		return
+	}
+	last := &p.subpaths[len(p.subpaths)-1]
+	if last.closed {
+		// Segments after a closepath start a new subpath at its start point.
+		p.moveTo(p.current.x, p.current.y)
+		last = &p.subpaths[len(p.subpaths)-1]
+	}
+	last.points = append(last.points, point{x, y})
+	p.current = point{x, y}
+}
+
+// curveTo appends the cubic Bézier curve from the current point through the control points
+// (x1, y1) and (x2, y2) to (x3, y3).
+func (p *path) curveTo(x1, y1, x2, y2, x3, y3 float64) {
+	if !p.hasPoint {
+		p.moveTo(x1, y1)
+	}
+	x0, y0 := p.current.x, p.current.y
+	dd := math.Max(math.Hypot(x0-2*x1+x2, y0-2*y1+y2), math.Hypot(x1-2*x2+x3, y1-2*y2+y3))
+	n := curveSegments(0.75 * dd / p.tolerance)
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		u := 1 - t
+		a, b, c, d := u*u*u, 3*u*u*t, 3*u*t*t, t*t*t
+		p.lineTo(a*x0+b*x1+c*x2+d*x3, a*y0+b*y1+c*y2+d*y3)
+	}
+}
+
+// quadTo appends the quadratic Bézier curve from the current point through the control point
+// (x1, y1) to (x2, y2).
+func (p *path) quadTo(x1, y1, x2, y2 float64) {
+	if !p.hasPoint {
+		p.moveTo(x1, y1)
+	}
+	x0, y0 := p.current.x, p.current.y
+	n := curveSegments(0.25 * math.Hypot(x0-2*x1+x2, y0-2*y1+y2) / p.tolerance)
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		u := 1 - t
+		a, b, c := u*u, 2*u*t, t*t
+		p.lineTo(a*x0+b*x1+c*x2, a*y0+b*y1+c*y2)
+	}
+}
+
+// curveSegments returns the number of line segments flattening a curve whose error with one
+// segment is `err` tolerances, the error decreasing with the square of the number of segments.
+func curveSegments(err float64) int {
+	n := int(math.Ceil(math.Sqrt(err)))
+	if n < 1 {
+		return 1
+	}
+	if n > maxCurveSegments {
+		return maxCurveSegments
+	}
+	return n
+}
+
+// closePath closes the current subpath, the current point becoming its start point.
+func (p *path) closePath() {
+	if !p.hasPoint {
+		return
+	}
+	last := &p.subpaths[len(p.subpaths)-1]
+	last.closed = true
+	p.current = last.points[0]
+}
+
+// rect appends the rectangle of the re operator as a closed subpath.
+func (p *path) rect(x, y, w, h float64) {
+	p.moveTo(x, y)
+	p.lineTo(x+w, y)
+	p.lineTo(x+w, y+h)
+	p.lineTo(x, y+h)
+	p.closePath()
+}
+
+// appendOutline appends the glyph outline `outline` mapped to user space by `m`.
+func (p *path) appendOutline(outline fonts.GlyphOutline, m transform.Matrix) {
+	for _, seg := range outline {
+		var pts [3]point
+		for i, q := range seg.Points {
+			pts[i].x, pts[i].y = m.Transform(q.X, q.Y)
+		}
+		switch seg.Op {
+		case fonts.OutlineMoveTo:
+			p.moveTo(pts[0].x, pts[0].y)
+		case fonts.OutlineLineTo:
+			p.lineTo(pts[0].x, pts[0].y)
+		case fonts.OutlineQuadTo:
+			p.quadTo(pts[0].x, pts[0].y, pts[1].x, pts[1].y)
+		case fonts.OutlineCubicTo:
+			p.curveTo(pts[0].x, pts[0].y, pts[1].x, pts[1].y, pts[2].x, pts[2].y)
+		case fonts.OutlineClose:
+			p.closePath()
+		}
+	}
+}
+
+// fillPolygon returns the subpaths of the path implicitly closed and transformed to device space
+// by `ctm`, for filling.
+func (p *path) fillPolygon(ctm transform.Matrix) polygon {
+	var poly polygon
+	for _, sp := range p.subpaths {
+		if len(sp.points) < 3 {
+			continue
+		}
+		poly = append(poly, transformPoints(sp.points, ctm))
+	}
+	return poly
+}
+
+// transformPoints returns `points` transformed by `m`.
+func transformPoints(points []point, m transform.Matrix) []point {
+	transformed := make([]point, len(points))
+	for i, q := range points {
+		transformed[i].x, transformed[i].y = m.Transform(q.x, q.y)
+	}
+	return transformed
+}
@@ -0,0 +1,264 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image"
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Maximum width and height in pixels of the rendered cells of tiling patterns.
+const maxTileSize = 2048
+
+// Maximum number of copies of the cell of a tiling pattern drawn by row and column of a tile, for
+// cells overlapping their neighbours.
+const maxTileRepeats = 8
+
+// tileKey identifies the rendered cells of tiling patterns.
+type tileKey struct {
+	stream *core.PdfObjectStream
+	matrix transform.Matrix
+	rgb    [3]float64
+}
+
+// tile is a rendered cell of a tiling pattern, repeated over the painted areas.
+type tile struct {
+	img    *image.RGBA      // Cell of XStep by YStep, nil if nothing is painted.
+	toTile transform.Matrix // Device space to the pixels of `img`, modulo its size.
+}
+
+// newPatternPaint returns the paint of the pattern color `col` of the Pattern colorspace `cs`,
+// whose pattern is in `resources`.  Pattern space is the default space of the content stream,
+// mapped to device space by `base`.
+func newPatternPaint(cs model.PdfColorspace, col *model.PdfColorPattern, resources *model.PdfPageResources,
+	base transform.Matrix) paint {
+	if resources == nil {
+		return paint{}
+	}
+	pattern, found := resources.GetPatternByName(col.PatternName)
+	if !found {
+		common.Log.Debug("Pattern %s not in resources", col.PatternName)
+		return paint{}
+	}
+	p := paint{ok: true, pattern: pattern}
+
+	var matrixObj core.PdfObject
+	switch {
+	case pattern.IsTiling():
+		tiling := pattern.GetAsTilingPattern()
+		if tiling.Matrix != nil {
+			matrixObj = tiling.Matrix
+		}
+		if !tiling.IsColored() {
+			// Uncolored patterns are painted with the color of the underlying colorspace.
+			patternCS, ok := cs.(*model.PdfColorspaceSpecialPattern)
+			if !ok || patternCS.UnderlyingCS == nil || col.Color == nil {
+				common.Log.Debug("Uncolored pattern %s without color", col.PatternName)
+				return paint{}
+			}
+			rgb, err := rgbOf(patternCS.UnderlyingCS, col.Color)
+			if err != nil {
+				common.Log.Debug("Unable to convert color to RGB: %v", err)
+				return paint{}
+			}
+			p.rgb = rgb
+		}
+	case pattern.IsShading():
+		shading := pattern.GetAsShadingPattern()
+		if shading.Shading == nil {
+			return paint{}
+		}
+		if shading.Matrix != nil {
+			matrixObj = shading.Matrix
+		}
+	default:
+		return paint{}
+	}
+
+	matrix, err := toMatrix(matrixObj)
+	if err != nil {
+		common.Log.Debug("Invalid pattern matrix: %v", err)
+		return paint{}
+	}
+	p.patternMatrix = matrix.Mult(base)
+	return p
+}
+
+// paintMask paints the pixels covered by `m` with `p` with the opacity `alpha`, clipped by `clip`.
+func (r *renderer) paintMask(m *mask, p paint, alpha float64, clip *mask) {
+	if p.pattern == nil {
+		r.fill(m, p, alpha, clip)
+		return
+	}
+	if !p.ok || alpha <= 0 || m.rect.Empty() {
+		return
+	}
+
+	var layer *image.NRGBA
+	if p.pattern.IsShading() {
+		var err error
+		layer, err = rasterizeShading(p.pattern.GetAsShadingPattern().Shading, p.patternMatrix, m.rect, true)
+		if err != nil {
+			common.Log.Debug("Error rasterizing shading pattern: %v", err)
+		}
+	} else {
+		layer = r.tilingLayer(p, m.rect)
+	}
+	if layer != nil {
+		r.fillImage(m, layer, alpha, clip)
+	}
+}
+
+// paintShading paints `shading` over `bounds` as the sh operator, its shading space being mapped to
+// device space by `ctm`.
+func (r *renderer) paintShading(shading *model.PdfShading, ctm transform.Matrix, bounds image.Rectangle, state renderState) {
+	layer, err := rasterizeShading(shading, ctm, bounds, false)
+	if err != nil {
+		common.Log.Debug("Error rasterizing shading: %v", err)
+	}
+	if layer != nil {
+		r.fillImage(nil, layer, state.fillAlpha, state.clip)
+	}
+}
+
+// tilingLayer returns the pixels of `rect` painted by the tiling pattern of `p`, nil if none.
+func (r *renderer) tilingLayer(p paint, rect image.Rectangle) *image.NRGBA {
+	t := r.patternTile(p)
+	if t == nil || t.img == nil {
+		return nil
+	}
+	layer := image.NewNRGBA(rect)
+	w, h := t.img.Rect.Dx(), t.img.Rect.Dy()
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			tx, ty := t.toTile.Transform(float64(x)+0.5, float64(y)+0.5)
+			i := int(math.Floor(tx)) % w
+			if i < 0 {
+				i += w
+			}
+			j := int(math.Floor(ty)) % h
+			if j < 0 {
+				j += h
+			}
+			src := t.img.Pix[t.img.PixOffset(i, j):]
+			a := src[3]
+			if a == 0 {
+				continue
+			}
+			// Undo the alpha premultiplication of the cell.
+			dst := layer.Pix[layer.PixOffset(x, y):]
+			for k := 0; k < 3; k++ {
+				dst[k] = uint8(math.Min(0xFF, float64(src[k])*0xFF/float64(a)+0.5))
+			}
+			dst[3] = a
+		}
+	}
+	return layer
+}
+
+// patternTile returns the rendered cell of the tiling pattern of `p`, rendered once by pattern
+// matrix and color.  Returns nil if it cannot be rendered.
+func (r *renderer) patternTile(p paint) *tile {
+	pattern := p.pattern.GetAsTilingPattern()
+	stream, ok := pattern.GetContainingPdfObject().(*core.PdfObjectStream)
+	if !ok {
+		common.Log.Debug("Tiling pattern not a stream (%T)", pattern.GetContainingPdfObject())
+		return nil
+	}
+	key := tileKey{stream: stream, matrix: p.patternMatrix, rgb: p.rgb}
+	if t, cached := r.tiles[key]; cached {
+		return t
+	}
+	if r.forms[stream] {
+		common.Log.Debug("Tiling pattern painted within itself")
+		return nil
+	}
+	t := &tile{}
+	r.tiles[key] = t
+
+	if pattern.BBox == nil || pattern.XStep == nil || pattern.YStep == nil {
+		common.Log.Debug("Tiling pattern without BBox, XStep or YStep")
+		return t
+	}
+	xstep, ystep := float64(*pattern.XStep), float64(*pattern.YStep)
+	inv, ok := p.patternMatrix.Inverse()
+	if xstep == 0 || ystep == 0 || !ok {
+		return t
+	}
+	content, err := pattern.GetContentStream()
+	if err != nil {
+		common.Log.Debug("Unable to decode tiling pattern: %v", err)
+		return t
+	}
+
+	// The cell of XStep by YStep in pattern space is rendered at about the device resolution, with
+	// the scaling `fx`, `fy` of pattern space to its pixels.
+	m := p.patternMatrix
+	size := func(step, scale float64) int {
+		n := int(math.Ceil(math.Abs(step)*scale - 1e-6))
+		if n < 1 {
+			n = 1
+		} else if n > maxTileSize {
+			n = maxTileSize
+		}
+		return n
+	}
+	w, h := size(xstep, math.Hypot(m[0], m[1])), size(ystep, math.Hypot(m[2], m[3]))
+	fx, fy := float64(w)/xstep, float64(h)/ystep
+	t.toTile = inv.Mult(transform.ScaleMatrix(fx, fy))
+	t.img = image.NewRGBA(image.Rect(0, 0, w, h))
+
+	state := newRenderState()
+	if !pattern.IsColored() {
+		state.fill = paint{rgb: p.rgb, ok: true}
+		state.stroke = state.fill
+		state.colorLocked = true
+	}
+	box := pattern.BBox
+	bx0, bx1 := math.Min(box.Llx*fx, box.Urx*fx), math.Max(box.Llx*fx, box.Urx*fx)
+	by0, by1 := math.Min(box.Lly*fy, box.Ury*fy), math.Max(box.Lly*fy, box.Ury*fy)
+	repeats := func(b0, b1 float64, n int) (int, int) {
+		first := int(math.Floor(-b1 / float64(n)))
+		last := int(math.Ceil(1 - b0/float64(n)))
+		if last-first >= maxTileRepeats {
+			last = first + maxTileRepeats - 1
+		}
+		return first, last
+	}
+	i0, i1 := repeats(bx0, bx1, w)
+	j0, j1 := repeats(by0, by1, h)
+
+	// The cells overlapping the tile are drawn, clipped by their bounding boxes.
+	sub := &renderer{
+		canvas: canvas{img: t.img},
+		fonts:  r.fonts,
+		images: r.images,
+		forms:  r.forms,
+		tiles:  r.tiles,
+		depth:  r.depth,
+	}
+	r.forms[stream] = true
+	defer delete(r.forms, stream)
+	for i := i0; i <= i1; i++ {
+		for j := j0; j <= j1; j++ {
+			cellBase := transform.TranslationMatrix(float64(i)*xstep, float64(j)*ystep).Mult(transform.ScaleMatrix(fx, fy))
+			path := newPath(cellBase)
+			path.rect(box.Llx, box.Lly, box.Urx-box.Llx, box.Ury-box.Lly)
+			cellState := state
+			cellState.clip = rasterize(path.fillPolygon(cellBase), false, t.img.Rect)
+			if err := sub.render(string(content), pattern.Resources, cellBase, cellState); err != nil {
+				common.Log.Debug("Error rendering tiling pattern: %v", err)
+				return t
+			}
+		}
+	}
+	return t
+}
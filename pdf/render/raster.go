@@ -0,0 +1,174 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image"
+	"math"
+	"sort"
+)
+
+// Number of sub-scanlines sampled per row of pixels for anti-aliasing.  The coverage along the
+// sub-scanlines is computed exactly.
+const subScanlines = 4
+
+// point is a point in user or device space.
+type point struct {
+	x, y float64
+}
+
+// polygon is a set of closed contours in device space, e.g. the subpaths of a filled path.
+type polygon [][]point
+
+// mask is the coverage of the pixels of a region of the device, in [0, 1].  Pixels outside of
+// the region are not covered.
+type mask struct {
+	rect image.Rectangle
+	a    []float32 // By row of rect.
+}
+
+// at returns the coverage of the pixel (x, y).
+func (m *mask) at(x, y int) float32 {
+	if !(image.Point{x, y}).In(m.rect) {
+		return 0
+	}
+	return m.a[(y-m.rect.Min.Y)*m.rect.Dx()+x-m.rect.Min.X]
+}
+
+// intersect returns the coverage of the pixels covered by both `m` and `n`.  A nil mask covers
+// all pixels.
+func intersect(m, n *mask) *mask {
+	if m == nil {
+		return n
+	}
+	if n == nil {
+		return m
+	}
+	rect := m.rect.Intersect(n.rect)
+	result := &mask{rect: rect, a: make([]float32, rect.Dx()*rect.Dy())}
+	i := 0
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			result.a[i] = m.at(x, y) * n.at(x, y)
+			i++
+		}
+	}
+	return result
+}
+
+// edge is a non-horizontal edge of a polygon, from top to bottom.
+type edge struct {
+	x0, y0, x1, y1 float64
+	winding        int // 1 if going down in the polygon, else -1.
+}
+
+// crossing is the crossing of a sub-scanline by an edge.
+type crossing struct {
+	x       float64
+	winding int
+}
+
+// rasterize returns the coverage of the pixels of `bounds` by `poly` filled with the even-odd
+// rule if `evenOdd`, else with the nonzero winding number rule.
+func rasterize(poly polygon, evenOdd bool, bounds image.Rectangle) *mask {
+	var edges []edge
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, contour := range poly {
+		for i, p := range contour {
+			if math.IsNaN(p.x) || math.IsNaN(p.y) || math.IsInf(p.x, 0) || math.IsInf(p.y, 0) {
+				return &mask{}
+			}
+			minX, maxX = math.Min(minX, p.x), math.Max(maxX, p.x)
+			minY, maxY = math.Min(minY, p.y), math.Max(maxY, p.y)
+
+			q := contour[(i+1)%len(contour)]
+			switch {
+			case p.y < q.y:
+				edges = append(edges, edge{p.x, p.y, q.x, q.y, 1})
+			case p.y > q.y:
+				edges = append(edges, edge{q.x, q.y, p.x, p.y, -1})
+			}
+		}
+	}
+	if len(edges) == 0 {
+		return &mask{}
+	}
+	rect := image.Rect(int(math.Floor(minX)), int(math.Floor(minY)), int(math.Ceil(maxX))+1, int(math.Ceil(maxY))+1).
+		Intersect(bounds)
+	m := &mask{rect: rect, a: make([]float32, rect.Dx()*rect.Dy())}
+	if rect.Empty() {
+		return m
+	}
+
+	sort.Slice(edges, func(i, j int) bool { return edges[i].y0 < edges[j].y0 })
+	var active []edge
+	var crossings []crossing
+	next := 0
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		row := m.a[(y-rect.Min.Y)*rect.Dx() : (y-rect.Min.Y+1)*rect.Dx()]
+		for k := 0; k < subScanlines; k++ {
+			sy := float64(y) + (float64(k)+0.5)/subScanlines
+
+			// Update the edges crossing the sub-scanline.
+			for next < len(edges) && edges[next].y0 <= sy {
+				active = append(active, edges[next])
+				next++
+			}
+			crossings = crossings[:0]
+			n := 0
+			for _, e := range active {
+				if e.y1 <= sy {
+					continue
+				}
+				active[n] = e
+				n++
+				if e.y0 <= sy {
+					x := e.x0 + (sy-e.y0)*(e.x1-e.x0)/(e.y1-e.y0)
+					crossings = append(crossings, crossing{x, e.winding})
+				}
+			}
+			active = active[:n]
+			sort.Slice(crossings, func(i, j int) bool { return crossings[i].x < crossings[j].x })
+
+			// Cover the spans inside the polygon.
+			winding := 0
+			for i, c := range crossings {
+				winding += c.winding
+				inside := winding != 0
+				if evenOdd {
+					inside = winding%2 != 0
+				}
+				if inside && i+1 < len(crossings) {
+					coverSpan(row, rect.Min.X, c.x, crossings[i+1].x, 1.0/subScanlines)
+				}
+			}
+		}
+	}
+	return m
+}
+
+// coverSpan adds the coverage `weight` of the span from `x0` to `x1` of a sub-scanline to the
+// pixels of `row` starting at `minX`.
+func coverSpan(row []float32, minX int, x0, x1 float64, weight float32) {
+	x0 = math.Max(x0, float64(minX))
+	x1 = math.Min(x1, float64(minX+len(row)))
+	if x0 >= x1 {
+		return
+	}
+	i0, i1 := int(math.Floor(x0)), int(math.Floor(x1))
+	if i0 == i1 {
+		row[i0-minX] += weight * float32(x1-x0)
+		return
+	}
+	row[i0-minX] += weight * float32(float64(i0+1)-x0)
+	for i := i0 + 1; i < i1; i++ {
+		row[i-minX] += weight
+	}
+	if i1-minX < len(row) {
+		row[i1-minX] += weight * float32(x1-float64(i1))
+	}
+}
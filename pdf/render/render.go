@@ -0,0 +1,494 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Default miter limit of the graphics state.
+const defaultMiterLimit = 10
+
+// Number of operands of the path construction operators.
+var pathOperandCounts = map[string]int{"m": 2, "l": 2, "c": 6, "v": 4, "y": 4, "re": 4}
+
+// RenderPage renders `page` to an RGBA image with `dpi` pixels per inch, on a white background.
+// The image shows the crop box of the page, or its media box if not set, rotated by the Rotate
+// entry of the page.  If the content streams of the page cannot be processed, the image rendered
+// so far is returned with the error.
+func RenderPage(page *model.PdfPage, dpi float64) (*image.RGBA, error) {
+	if dpi <= 0 {
+		return nil, errors.New("Range check error")
+	}
+	llx, lly, urx, ury, rotate, err := pageBox(page)
+	if err != nil {
+		return nil, err
+	}
+	scale := dpi / 72
+	width := int(math.Ceil((urx-llx)*scale - 1e-6))
+	height := int(math.Ceil((ury-lly)*scale - 1e-6))
+	if width <= 0 || height <= 0 {
+		common.Log.Debug("Empty page box [%g %g %g %g]", llx, lly, urx, ury)
+		return nil, errors.New("Empty page")
+	}
+	base := pageBase(llx, lly, float64(width), float64(height), scale, rotate)
+	if rotate == 90 || rotate == 270 {
+		width, height = height, width
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Rect, image.White, image.Point{}, draw.Src)
+
+	contents, err := page.GetAllContentStreams()
+	if err != nil {
+		return img, err
+	}
+	r := newRenderer(img)
+	if err := r.render(contents, page.Resources, base, newRenderState()); err != nil {
+		return img, err
+	}
+
+	for _, appearance := range annotationAppearances(page.Annotations) {
+		form := appearance.form
+		r.forms[form.stream] = true
+		err := r.renderForm(form, appearance.ctm.Mult(base), newRenderState())
+		delete(r.forms, form.stream)
+		if err != nil {
+			common.Log.Debug("Error rendering annotation appearance: %v", err)
+		}
+	}
+	return img, nil
+}
+
+// pageBox returns the crop box of `page`, or its media box if not set, and the rotation of the
+// page in degrees, 0, 90, 180 or 270.
+func pageBox(page *model.PdfPage) (llx, lly, urx, ury float64, rotate int, err error) {
+	box, err := page.GetMediaBox()
+	if err != nil {
+		return 0, 0, 0, 0, 0, err
+	}
+	if page.CropBox != nil {
+		box = page.CropBox
+	}
+	if page.Rotate != nil {
+		rotate = (int(*page.Rotate)%360 + 360) % 360 / 90 * 90
+	}
+	return math.Min(box.Llx, box.Urx), math.Min(box.Lly, box.Ury), math.Max(box.Llx, box.Urx),
+		math.Max(box.Lly, box.Ury), rotate, nil
+}
+
+// pageBase returns the mapping of the default user space of a page whose box has its lower left
+// corner at (`llx`, `lly`) to a device space with `scale` units by point, the origin at the top
+// left corner of the page rotated by `rotate` degrees and y going down.  The box is `w` by `h`
+// units of device space before rotation.
+func pageBase(llx, lly, w, h, scale float64, rotate int) transform.Matrix {
+	var rotation transform.Matrix
+	switch rotate {
+	case 90:
+		rotation = transform.NewMatrix(0, 1, 1, 0, 0, 0)
+	case 180:
+		rotation = transform.NewMatrix(-1, 0, 0, 1, w, 0)
+	case 270:
+		rotation = transform.NewMatrix(0, -1, -1, 0, h, w)
+	default:
+		rotation = transform.NewMatrix(1, 0, 0, -1, 0, h)
+	}
+	return transform.TranslationMatrix(-llx, -lly).Mult(transform.ScaleMatrix(scale, scale)).Mult(rotation)
+}
+
+// paint is the color or pattern of painted paths, text or stencil masks.
+type paint struct {
+	rgb [3]float64 // Color, of the cells of uncolored tiling patterns for patterns.
+	ok  bool       // False if not supported, nothing is painted.
+
+	pattern       *model.PdfPattern // nil if not a pattern.
+	patternMatrix transform.Matrix  // Pattern space to device space.
+}
+
+// renderState is the graphics state (8.4 "Graphics State") of a rendered content stream besides the
+// CTM and the colorspaces tracked by its processor.
+type renderState struct {
+	clip                   *mask // nil if not clipped.
+	fill, stroke           paint
+	fillAlpha, strokeAlpha float64
+	style                  strokeStyle
+	text                   textState
+
+	// Color operators are ignored in the glyph descriptions of uncolored Type3 fonts (d1).
+	colorLocked bool
+}
+
+// newRenderState returns the initial graphics state of pages.
+func newRenderState() renderState {
+	return renderState{
+		fill:        paint{ok: true},
+		stroke:      paint{ok: true},
+		fillAlpha:   1,
+		strokeAlpha: 1,
+		style:       strokeStyle{width: 1, miterLimit: defaultMiterLimit},
+		text:        textState{horizScaling: 1},
+	}
+}
+
+// renderer renders content streams on a canvas.
+type renderer struct {
+	canvas
+	fonts  map[core.PdfObject]*renderFont
+	images map[*core.PdfObjectStream]*rasterImage // nil for images which cannot be decoded.
+	forms  formStack
+	tiles  map[tileKey]*tile
+	depth  int // Nesting of the Type3 glyph descriptions being rendered.
+}
+
+func newRenderer(img *image.RGBA) *renderer {
+	return &renderer{
+		canvas: canvas{img: img},
+		fonts:  map[core.PdfObject]*renderFont{},
+		images: map[*core.PdfObjectStream]*rasterImage{},
+		forms:  formStack{},
+		tiles:  map[tileKey]*tile{},
+	}
+}
+
+// render renders the content stream `contents` with the resources `resources` and the initial
+// graphics state `state`, its user space being mapped to device space by `base`.
+func (r *renderer) render(contents string, resources *model.PdfPageResources, base transform.Matrix, state renderState) error {
+	cstreamParser := contentstream.NewContentStreamParser(contents)
+	operations, err := cstreamParser.Parse()
+	if err != nil {
+		return err
+	}
+
+	processor := contentstream.NewContentStreamProcessor(*operations)
+	var stateStack []renderState
+	var p *path // Current path, nil if none.
+	clipping, clipEvenOdd := false, false
+	var textClip polygon // Glyphs added to the clipping path since BT.
+	textClipping := false
+
+	processor.AddHandler(contentstream.HandlerConditionEnumAllOperands, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			ctm := gs.CTM.Mult(base)
+			bounds := r.img.Rect
+			if state.clip != nil {
+				bounds = bounds.Intersect(state.clip.rect)
+			}
+
+			switch op.Operand {
+			case "q":
+				stateStack = append(stateStack, state)
+			case "Q":
+				if len(stateStack) > 0 {
+					state = stateStack[len(stateStack)-1]
+					stateStack = stateStack[:len(stateStack)-1]
+				}
+
+			// Path construction.
+			case "m", "l", "c", "v", "y", "re":
+				vals, err := getNumbersAsFloat(op.Params)
+				if err != nil || len(vals) != pathOperandCounts[op.Operand] {
+					common.Log.Debug("Invalid %s operands: %v", op.Operand, op.Params)
+					return nil
+				}
+				if p == nil {
+					p = newPath(ctm)
+				}
+				switch op.Operand {
+				case "m":
+					p.moveTo(vals[0], vals[1])
+				case "l":
+					p.lineTo(vals[0], vals[1])
+				case "c":
+					p.curveTo(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5])
+				case "v":
+					p.curveTo(p.current.x, p.current.y, vals[0], vals[1], vals[2], vals[3])
+				case "y":
+					p.curveTo(vals[0], vals[1], vals[2], vals[3], vals[2], vals[3])
+				case "re":
+					p.rect(vals[0], vals[1], vals[2], vals[3])
+				}
+			case "h":
+				if p != nil {
+					p.closePath()
+				}
+
+			// Path painting and clipping.
+			case "W", "W*":
+				clipping, clipEvenOdd = true, op.Operand == "W*"
+			case "S", "s", "f", "F", "f*", "B", "B*", "b", "b*", "n":
+				if p == nil {
+					p = newPath(ctm)
+				}
+				switch op.Operand {
+				case "s", "b", "b*":
+					p.closePath()
+				}
+				switch op.Operand {
+				case "f", "F", "f*", "B", "B*", "b", "b*":
+					evenOdd := op.Operand == "f*" || op.Operand == "B*" || op.Operand == "b*"
+					r.paintMask(rasterize(p.fillPolygon(ctm), evenOdd, bounds), state.fill, state.fillAlpha, state.clip)
+				}
+				switch op.Operand {
+				case "S", "s", "B", "B*", "b", "b*":
+					r.paintMask(rasterize(strokePolygon(p, state.style, ctm), false, bounds), state.stroke, state.strokeAlpha,
+						state.clip)
+				}
+				if clipping {
+					state.clip = intersect(state.clip, rasterize(p.fillPolygon(ctm), clipEvenOdd, bounds))
+					clipping = false
+				}
+				p = nil
+
+			// Graphics state parameters.
+			case "w", "J", "j", "M":
+				vals, err := getNumbersAsFloat(op.Params)
+				if err != nil || len(vals) != 1 {
+					common.Log.Debug("Invalid %s operands: %v", op.Operand, op.Params)
+					return nil
+				}
+				state.setLineParameter(op.Operand, vals[0])
+			case "d":
+				if len(op.Params) != 2 {
+					return nil
+				}
+				state.setDash(op.Params[0], op.Params[1])
+			case "gs":
+				if len(op.Params) != 1 || resources == nil {
+					return nil
+				}
+				name, ok := op.Params[0].(*core.PdfObjectName)
+				if !ok {
+					return nil
+				}
+				obj, found := resources.GetExtGState(*name)
+				if !found {
+					common.Log.Debug("ExtGState %s not in resources", *name)
+					return nil
+				}
+				if dict, ok := core.TraceToDirectObject(obj).(*core.PdfObjectDictionary); ok {
+					state.applyExtGState(dict)
+				}
+
+			// Colors.
+			case "CS", "SC", "SCN", "G", "RG", "K":
+				if !state.colorLocked {
+					state.stroke = newPaint(gs.ColorspaceStroking, gs.ColorStroking, resources, base)
+				}
+			case "cs", "sc", "scn", "g", "rg", "k":
+				if !state.colorLocked {
+					state.fill = newPaint(gs.ColorspaceNonStroking, gs.ColorNonStroking, resources, base)
+				}
+			case "d1":
+				state.colorLocked = true
+
+			// Shadings.
+			case "sh":
+				if len(op.Params) != 1 || resources == nil {
+					return nil
+				}
+				name, ok := op.Params[0].(*core.PdfObjectName)
+				if !ok {
+					return nil
+				}
+				shading, found := resources.GetShadingByName(*name)
+				if !found {
+					common.Log.Debug("Shading %s not in resources", *name)
+					return nil
+				}
+				r.paintShading(shading, ctm, bounds, state)
+
+			// Text.
+			case "BT":
+				state.text.tm = transform.IdentityMatrix()
+				state.text.tlm = transform.IdentityMatrix()
+				textClip, textClipping = nil, false
+			case "ET":
+				if textClipping {
+					state.clip = intersect(state.clip, rasterize(textClip, false, bounds))
+				}
+				textClip, textClipping = nil, false
+			case "Tf", "Tc", "Tw", "Tz", "TL", "Ts", "Tr", "Td", "TD", "Tm", "T*":
+				state.text.set(op, resources, r.fonts)
+			case "Tj", "TJ", "'", "\"":
+				if state.text.renderMode >= 4 {
+					textClipping = true
+				}
+				state.text.showTextOp(op, func(data []byte) {
+					r.showText(data, &state, ctm, resources, &textClip)
+				})
+
+			// XObjects and inline images.
+			case "Do":
+				if len(op.Params) != 1 || resources == nil {
+					return nil
+				}
+				name, ok := op.Params[0].(*core.PdfObjectName)
+				if !ok {
+					return nil
+				}
+				r.drawXObject(*name, resources, ctm, state)
+			case "BI":
+				if len(op.Params) != 1 {
+					return nil
+				}
+				iimg, ok := op.Params[0].(*contentstream.ContentStreamInlineImage)
+				if !ok {
+					return nil
+				}
+				img, err := loadInlineImage(iimg, resources)
+				if err != nil {
+					common.Log.Debug("Unable to decode inline image: %v", err)
+					return nil
+				}
+				r.paintImage(img, ctm, state)
+			}
+			return nil
+		})
+
+	return processor.Process(resources)
+}
+
+// drawXObject draws the image or form XObject `name` of `resources` with the graphics state
+// `state` and `ctm`.
+func (r *renderer) drawXObject(name core.PdfObjectName, resources *model.PdfPageResources, ctm transform.Matrix, state renderState) {
+	stream, xtype := resources.GetXObjectByName(name)
+	switch xtype {
+	case model.XObjectTypeImage:
+		img, loaded := r.images[stream]
+		if !loaded {
+			var err error
+			img, err = loadImageXObject(stream)
+			if err != nil {
+				common.Log.Debug("Unable to decode image XObject %s: %v", name, err)
+			}
+			r.images[stream] = img
+		}
+		if img != nil {
+			r.paintImage(img, ctm, state)
+		}
+	case model.XObjectTypeForm:
+		if r.forms[stream] {
+			common.Log.Debug("Form XObject %s painted within itself", name)
+			return
+		}
+		form, err := newFormStream(stream, resources)
+		if err != nil {
+			common.Log.Debug("Unable to load form XObject %s: %v", name, err)
+			return
+		}
+		r.forms[stream] = true
+		err = r.renderForm(form, ctm, state)
+		delete(r.forms, stream)
+		if err != nil {
+			common.Log.Debug("Error rendering form XObject %s: %v", name, err)
+		}
+	}
+}
+
+// renderForm renders `form` painted with the graphics state `state` and `ctm`, clipped by its
+// bounding box.
+func (r *renderer) renderForm(form *formStream, ctm transform.Matrix, state renderState) error {
+	formCtm := form.matrix.Mult(ctm)
+	if form.bbox != nil {
+		p := newPath(formCtm)
+		p.rect(form.bbox.Llx, form.bbox.Lly, form.bbox.Urx-form.bbox.Llx, form.bbox.Ury-form.bbox.Lly)
+		state.clip = intersect(state.clip, rasterize(p.fillPolygon(formCtm), false, r.img.Rect))
+	}
+	state.text = textState{horizScaling: 1}
+	return r.render(form.content, form.resources, formCtm, state)
+}
+
+// paintImage paints `img` with the graphics state `state`, mapped from the unit square to device
+// space by `ctm`.
+func (r *renderer) paintImage(img *rasterImage, ctm transform.Matrix, state renderState) {
+	if img.stencil && !state.fill.ok {
+		return
+	}
+	if img.stencil && state.fill.pattern != nil {
+		bounds := r.img.Rect
+		if state.clip != nil {
+			bounds = bounds.Intersect(state.clip.rect)
+		}
+		r.paintMask(stencilMask(img, ctm, bounds), state.fill, state.fillAlpha, state.clip)
+		return
+	}
+	r.drawImage(img, ctm, state.fill.rgb, state.fillAlpha, state.clip)
+}
+
+// newPaint returns the paint of the color `col` of the colorspace `cs`, set in a content stream
+// with the resources `resources` whose default space is mapped to device space by `base`.
+func newPaint(cs model.PdfColorspace, col model.PdfColor, resources *model.PdfPageResources, base transform.Matrix) paint {
+	if cs == nil || col == nil {
+		return paint{}
+	}
+	if pcol, isPattern := col.(*model.PdfColorPattern); isPattern {
+		return newPatternPaint(cs, pcol, resources, base)
+	}
+	rgb, err := rgbOf(cs, col)
+	if err != nil {
+		common.Log.Debug("Unable to convert color to RGB: %v", err)
+		return paint{}
+	}
+	return paint{rgb: rgb, ok: true}
+}
+
+// setLineParameter sets the line width, cap, join or miter limit set by the operator `operand`
+// (w, J, j or M) to `val`.
+func (state *renderState) setLineParameter(operand string, val float64) {
+	switch operand {
+	case "w", "LW":
+		state.style.width = math.Abs(val)
+	case "J", "LC":
+		state.style.cap = int(val)
+	case "j", "LJ":
+		state.style.join = int(val)
+	case "M", "ML":
+		state.style.miterLimit = val
+	}
+}
+
+// setDash sets the dash pattern to the dash array `arrayObj` and dash phase `phaseObj`.
+func (state *renderState) setDash(arrayObj, phaseObj core.PdfObject) {
+	dash, err := toFloats(arrayObj)
+	if err != nil {
+		common.Log.Debug("Invalid dash array: %v", err)
+		return
+	}
+	phase, err := getNumberAsFloat(core.TraceToDirectObject(phaseObj))
+	if err != nil {
+		common.Log.Debug("Invalid dash phase: %v", err)
+		return
+	}
+	state.style.dash, state.style.dashPhase = dash, phase
+}
+
+// applyExtGState sets the line style and constant opacity parameters of the graphics state
+// parameter dictionary `dict` (8.4.5 "Graphics State Parameter Dictionaries").
+func (state *renderState) applyExtGState(dict *core.PdfObjectDictionary) {
+	for _, key := range []core.PdfObjectName{"LW", "LC", "LJ", "ML"} {
+		if val, err := getNumberAsFloat(core.TraceToDirectObject(dict.Get(key))); err == nil {
+			state.setLineParameter(string(key), val)
+		}
+	}
+	if arr, ok := core.TraceToDirectObject(dict.Get("D")).(*core.PdfObjectArray); ok && len(*arr) == 2 {
+		state.setDash((*arr)[0], (*arr)[1])
+	}
+	if val, err := getNumberAsFloat(core.TraceToDirectObject(dict.Get("CA"))); err == nil {
+		state.strokeAlpha = math.Max(0, math.Min(1, val))
+	}
+	if val, err := getNumberAsFloat(core.TraceToDirectObject(dict.Get("ca"))); err == nil {
+		state.fillAlpha = math.Max(0, math.Min(1, val))
+	}
+}
@@ -0,0 +1,189 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+const testRobotoTTFFile = "../../testfiles/roboto/Roboto-Regular.ttf"
+
+// newTestPage returns a page with the media box [0 0 width height], the content `content` and the
+// resources `resources`.
+func newTestPage(t *testing.T, width, height float64, content string, resources *model.PdfPageResources) *model.PdfPage {
+	page := model.NewPdfPage()
+	page.MediaBox = &model.PdfRectangle{Urx: width, Ury: height}
+	page.Resources = resources
+	if err := page.SetContentStreams([]string{content}, nil); err != nil {
+		t.Fatalf("Error setting content: %v", err)
+	}
+	return page
+}
+
+// checkPixels checks the colors of the pixels of `img`, within `tolerance` by component.
+func checkPixels(t *testing.T, img *image.RGBA, expected map[image.Point]color.RGBA, tolerance int) {
+	for p, c := range expected {
+		got := img.RGBAAt(p.X, p.Y)
+		for _, d := range []int{int(got.R) - int(c.R), int(got.G) - int(c.G), int(got.B) - int(c.B)} {
+			if d < -tolerance || d > tolerance {
+				t.Errorf("Incorrect color of pixel %v: %v, expected %v", p, got, c)
+				break
+			}
+		}
+	}
+}
+
+// darkPixels returns the number of pixels of `img` darker than mid gray and their bounds.
+func darkPixels(img *image.RGBA) (int, image.Rectangle) {
+	count := 0
+	var bounds image.Rectangle
+	for y := img.Rect.Min.Y; y < img.Rect.Max.Y; y++ {
+		for x := img.Rect.Min.X; x < img.Rect.Max.X; x++ {
+			if c := img.RGBAAt(x, y); c.R < 0x80 && c.G < 0x80 && c.B < 0x80 {
+				count++
+				bounds = bounds.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	return count, bounds
+}
+
+var (
+	white = color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	black = color.RGBA{0, 0, 0, 0xFF}
+	red   = color.RGBA{0xFF, 0, 0, 0xFF}
+	blue  = color.RGBA{0, 0, 0xFF, 0xFF}
+)
+
+func TestRenderPaths(t *testing.T) {
+	resources := model.NewPdfPageResources()
+	gsDict := core.MakeDict()
+	gsDict.Set("ca", core.MakeFloat(0.5))
+	resources.AddExtGState("GS1", gsDict)
+
+	content := `
+1 0 0 rg 10 10 30 30 re f
+q 50 50 20 20 re W n 0 0 1 rg 0 0 100 100 re f Q
+4 w 10 95 m 90 95 l S
+q /GS1 gs 0 g 80 10 10 10 re f Q
+0 0 1 RG 2 w 1 J 0 j [4 4] 0 d 10 50 m 40 50 l S
+q 0 0 1 rg 0 0 100 100 re 20 20 60 60 re W* n 0 0 5 5 re f 45 45 5 5 re f Q
+`
+	img, err := RenderPage(newTestPage(t, 100, 100, content, resources), 72)
+	if err != nil {
+		t.Fatalf("Error rendering page: %v", err)
+	}
+	if img.Rect.Dx() != 100 || img.Rect.Dy() != 100 {
+		t.Fatalf("Incorrect image size %v", img.Rect)
+	}
+
+	checkPixels(t, img, map[image.Point]color.RGBA{
+		{25, 75}: red,                      // Filled rectangle.
+		{5, 50}:  white,                    // Outside of it.
+		{60, 40}: blue,                     // Clipped fill.
+		{80, 20}: white,                    // Outside of the clipping path.
+		{50, 5}:  black,                    // Stroked line.
+		{50, 10}: white,                    // Beside it.
+		{85, 85}: {0x80, 0x80, 0x80, 0xFF}, // Half opaque black.
+		{12, 50}: blue,                     // First dash.
+		{16, 50}: white,                    // First gap.
+		{2, 97}:  blue,                     // Inside the even-odd clipping path.
+		{47, 52}: white,                    // In its hole.
+	}, 2)
+}
+
+func TestRenderRotation(t *testing.T) {
+	page := newTestPage(t, 200, 100, "1 0 0 rg 0 0 20 20 re f", model.NewPdfPageResources())
+	rotate := int64(90)
+	page.Rotate = &rotate
+
+	img, err := RenderPage(page, 36)
+	if err != nil {
+		t.Fatalf("Error rendering page: %v", err)
+	}
+	if img.Rect.Dx() != 50 || img.Rect.Dy() != 100 {
+		t.Fatalf("Incorrect image size %v", img.Rect)
+	}
+	// The bottom left corner of the page is shown at the top left.
+	checkPixels(t, img, map[image.Point]color.RGBA{
+		{2, 2}:   red,
+		{2, 97}:  white,
+		{47, 2}:  white,
+		{47, 97}: white,
+	}, 2)
+}
+
+func TestRenderImages(t *testing.T) {
+	img := &model.Image{Width: 2, Height: 2, BitsPerComponent: 8, ColorComponents: 1, Data: []byte{0, 255, 255, 0}}
+	ximg, err := model.NewXObjectImageFromImage(img, nil, nil)
+	if err != nil {
+		t.Fatalf("Error creating image XObject: %v", err)
+	}
+	resources := model.NewPdfPageResources()
+	resources.SetXObjectByName("Im1", ximg.ToPdfObject().(*core.PdfObjectStream))
+
+	// Image drawn on the left half, stencil mask painting the left half of its unit square blue on
+	// the right half.
+	content := `
+q 50 0 0 100 0 0 cm /Im1 Do Q
+0 0 1 rg q 40 0 0 100 55 0 cm BI /W 8 /H 1 /IM true /F /AHx ID 0F> EI Q
+`
+	rendered, err := RenderPage(newTestPage(t, 100, 100, content, resources), 72)
+	if err != nil {
+		t.Fatalf("Error rendering page: %v", err)
+	}
+	checkPixels(t, rendered, map[image.Point]color.RGBA{
+		{12, 25}: black,
+		{37, 25}: white,
+		{12, 75}: white,
+		{37, 75}: black,
+		{60, 50}: blue,
+		{90, 50}: white,
+	}, 2)
+}
+
+func TestRenderText(t *testing.T) {
+	font, err := model.NewPdfFontFromTTFFile(testRobotoTTFFile)
+	if err != nil {
+		t.Fatalf("Error loading font: %v", err)
+	}
+	resources := model.NewPdfPageResources()
+	resources.SetFontByName("F1", font.ToPdfObject())
+
+	tests := []struct {
+		content string
+		visible bool
+	}{
+		{"BT /F1 80 Tf 10 10 Td (I) Tj ET", true},
+		{"BT /F1 80 Tf 3 Tr 10 10 Td (I) Tj ET", false},
+		{"BT /F1 80 Tf 7 Tr 10 10 Td (I) Tj ET 0 0 100 100 re f", true},
+	}
+	for _, test := range tests {
+		img, err := RenderPage(newTestPage(t, 100, 100, test.content, resources), 72)
+		if err != nil {
+			t.Fatalf("Error rendering page: %v", err)
+		}
+		count, bounds := darkPixels(img)
+		if !test.visible {
+			if count != 0 {
+				t.Errorf("Invisible text painted: %q", test.content)
+			}
+			continue
+		}
+		// The stem of I is about 0.09 em wide and 0.7 em high, from the baseline.
+		if count < 200 || count > 1000 {
+			t.Errorf("Incorrect text area %d: %q", count, test.content)
+		}
+		if bounds.Min.X < 10 || bounds.Max.X > 40 || bounds.Max.Y > 91 || bounds.Min.Y < 20 {
+			t.Errorf("Incorrect text bounds %v: %q", bounds, test.content)
+		}
+	}
+}
@@ -0,0 +1,419 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"errors"
+	"image"
+	"math"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// Number of colors of the parametric values of axial, radial and mesh shadings with functions.
+const shadingTableSize = 512
+
+// Maximum number of subdivisions of the sides of the patches of patch mesh shadings.
+const maxPatchSubdivisions = 64
+
+// RenderShading rasterizes `shading` to an image of the rectangle `bbox` of user space with `dpi`
+// pixels per inch, its top left pixel showing the top left corner of `bbox`.  The shading space is
+// mapped to user space by `matrix`.  As with the sh operator, the Background of the shading is not
+// painted, and the pixels outside of the shading geometry or its BBox are transparent.  This allows
+// shadings to be drawn as images, e.g. to flatten sh operators.
+func RenderShading(shading *model.PdfShading, bbox model.PdfRectangle, matrix [6]float64, dpi float64) (*image.NRGBA, error) {
+	if shading == nil || dpi <= 0 {
+		return nil, errors.New("Range check error")
+	}
+	llx, urx := math.Min(bbox.Llx, bbox.Urx), math.Max(bbox.Llx, bbox.Urx)
+	lly, ury := math.Min(bbox.Lly, bbox.Ury), math.Max(bbox.Lly, bbox.Ury)
+	scale := dpi / 72
+	width := int(math.Ceil((urx-llx)*scale - 1e-6))
+	height := int(math.Ceil((ury-lly)*scale - 1e-6))
+	if width <= 0 || height <= 0 {
+		common.Log.Debug("Empty shading box %v", bbox)
+		return nil, errors.New("Empty box")
+	}
+
+	ctm := transform.Matrix(matrix).Mult(transform.TranslationMatrix(-llx, -ury)).
+		Mult(transform.NewMatrix(scale, 0, 0, -scale, 0, 0))
+	return rasterizeShading(shading, ctm, image.Rect(0, 0, width, height), false)
+}
+
+// rasterizeShading returns the pixels of `rect` painted by `shading`, whose shading space is mapped
+// to device space by `ctm`.  The pixels outside of the shading geometry are painted with the
+// Background color of the shading if `background`, and are transparent otherwise.
+func rasterizeShading(shading *model.PdfShading, ctm transform.Matrix, rect image.Rectangle, background bool) (*image.NRGBA, error) {
+	img := image.NewNRGBA(rect)
+	if rect.Empty() {
+		return img, nil
+	}
+	inv, ok := ctm.Inverse()
+	if !ok {
+		return img, nil
+	}
+	cs := shading.ColorSpace
+	if cs == nil {
+		common.Log.Debug("Shading without colorspace")
+		return nil, errors.New("Colorspace missing")
+	}
+
+	if background && shading.Background != nil {
+		vals, err := shading.Background.ToFloat64Array()
+		if err != nil {
+			return nil, err
+		}
+		rgb, err := colorToRGB(cs, clampComponents(cs, vals))
+		if err != nil {
+			return nil, err
+		}
+		for y := rect.Min.Y; y < rect.Max.Y; y++ {
+			for x := rect.Min.X; x < rect.Max.X; x++ {
+				setPixel(img, x, y, rgb)
+			}
+		}
+	}
+
+	var err error
+	switch t := shading.GetContext().(type) {
+	case *model.PdfShadingType1:
+		err = drawFunctionShading(img, cs, t, inv)
+	case *model.PdfShadingType2:
+		err = drawAxialShading(img, cs, t, inv)
+	case *model.PdfShadingType3:
+		err = drawRadialShading(img, cs, t, inv)
+	case *model.PdfShadingType4:
+		err = drawMeshShading(img, shading, t.BitsPerCoordinate, t.BitsPerComponent, t.BitsPerFlag, nil, t.Decode,
+			t.Function, ctm)
+	case *model.PdfShadingType5:
+		err = drawMeshShading(img, shading, t.BitsPerCoordinate, t.BitsPerComponent, nil, t.VerticesPerRow, t.Decode,
+			t.Function, ctm)
+	case *model.PdfShadingType6:
+		err = drawMeshShading(img, shading, t.BitsPerCoordinate, t.BitsPerComponent, t.BitsPerFlag, nil, t.Decode,
+			t.Function, ctm)
+	case *model.PdfShadingType7:
+		err = drawMeshShading(img, shading, t.BitsPerCoordinate, t.BitsPerComponent, t.BitsPerFlag, nil, t.Decode,
+			t.Function, ctm)
+	default:
+		common.Log.Debug("Unsupported shading %T", t)
+		err = errors.New("Unsupported shading type")
+	}
+	if err != nil {
+		return img, err
+	}
+
+	// The BBox is given in shading space.
+	if box := shading.BBox; box != nil {
+		llx, urx := math.Min(box.Llx, box.Urx), math.Max(box.Llx, box.Urx)
+		lly, ury := math.Min(box.Lly, box.Ury), math.Max(box.Lly, box.Ury)
+		for y := rect.Min.Y; y < rect.Max.Y; y++ {
+			for x := rect.Min.X; x < rect.Max.X; x++ {
+				u, v := inv.Transform(float64(x)+0.5, float64(y)+0.5)
+				if u < llx || u > urx || v < lly || v > ury {
+					img.Pix[img.PixOffset(x, y)+3] = 0
+				}
+			}
+		}
+	}
+	return img, nil
+}
+
+// setPixel sets the pixel (x, y) of `img` to the opaque color `rgb`.
+func setPixel(img *image.NRGBA, x, y int, rgb [3]float64) {
+	off := img.PixOffset(x, y)
+	img.Pix[off] = toByte(rgb[0])
+	img.Pix[off+1] = toByte(rgb[1])
+	img.Pix[off+2] = toByte(rgb[2])
+	img.Pix[off+3] = 0xFF
+}
+
+// clampComponents returns the color components `vals` clamped to the ranges of the components of
+// `cs`, as function outputs may exceed them.
+func clampComponents(cs model.PdfColorspace, vals []float64) []float64 {
+	decode := cs.DecodeArray()
+	clamped := make([]float64, len(vals))
+	for i, v := range vals {
+		if 2*i+1 < len(decode) {
+			v = math.Max(decode[2*i], math.Min(decode[2*i+1], v))
+		}
+		clamped[i] = v
+	}
+	return clamped
+}
+
+// shadingColor returns the RGB color of the input values `in` of the shading functions `funcs`,
+// which are either a single function with an output by color component of `cs` or one function by
+// color component.
+func shadingColor(cs model.PdfColorspace, funcs []model.PdfFunction, in []float64) ([3]float64, error) {
+	var out []float64
+	if len(funcs) == 1 {
+		vals, err := funcs[0].Evaluate(in)
+		if err != nil {
+			return [3]float64{}, err
+		}
+		out = vals
+	} else {
+		for _, f := range funcs {
+			vals, err := f.Evaluate(in)
+			if err != nil {
+				return [3]float64{}, err
+			}
+			if len(vals) < 1 {
+				return [3]float64{}, errors.New("Range check error")
+			}
+			out = append(out, vals[0])
+		}
+	}
+	if len(out) < cs.GetNumComponents() {
+		common.Log.Debug("Shading function outputs %d values, colorspace has %d components", len(out),
+			cs.GetNumComponents())
+		return [3]float64{}, errors.New("Range check error")
+	}
+	return colorToRGB(cs, clampComponents(cs, out[:cs.GetNumComponents()]))
+}
+
+// colorTable is the colors of evenly spaced parametric values of shadings with functions.
+type colorTable struct {
+	t0, t1 float64
+	colors [][3]float64
+}
+
+// newColorTable returns the colors of `shadingTableSize` parametric values from `t0` to `t1`.
+func newColorTable(cs model.PdfColorspace, funcs []model.PdfFunction, t0, t1 float64) (*colorTable, error) {
+	if len(funcs) == 0 {
+		return nil, errors.New("Function missing")
+	}
+	table := &colorTable{t0: t0, t1: t1, colors: make([][3]float64, shadingTableSize)}
+	for i := range table.colors {
+		t := t0 + (t1-t0)*float64(i)/(shadingTableSize-1)
+		rgb, err := shadingColor(cs, funcs, []float64{t})
+		if err != nil {
+			return nil, err
+		}
+		table.colors[i] = rgb
+	}
+	return table, nil
+}
+
+// at returns the color of the parametric value at the fraction `s` of the range of the table.
+func (table *colorTable) at(s float64) [3]float64 {
+	i := int(s*(shadingTableSize-1) + 0.5)
+	if i < 0 {
+		i = 0
+	} else if i >= shadingTableSize {
+		i = shadingTableSize - 1
+	}
+	return table.colors[i]
+}
+
+// value returns the color of the parametric value `t`.
+func (table *colorTable) value(t float64) [3]float64 {
+	if table.t1 == table.t0 {
+		return table.colors[0]
+	}
+	return table.at((t - table.t0) / (table.t1 - table.t0))
+}
+
+// floatsOrDefault returns the numbers of `arr`, or `defaults` if nil or not of the same length.
+func floatsOrDefault(arr *core.PdfObjectArray, defaults ...float64) ([]float64, error) {
+	if arr == nil {
+		return defaults, nil
+	}
+	vals, err := arr.ToFloat64Array()
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) != len(defaults) {
+		common.Log.Debug("Expected %d numbers, got %d", len(defaults), len(vals))
+		return nil, errors.New("Range check error")
+	}
+	return vals, nil
+}
+
+// extendFlags returns the Extend entry of axial and radial shadings.
+func extendFlags(arr *core.PdfObjectArray) [2]bool {
+	var extend [2]bool
+	if arr == nil {
+		return extend
+	}
+	for i := 0; i < len(*arr) && i < 2; i++ {
+		if b, ok := core.TraceToDirectObject((*arr)[i]).(*core.PdfObjectBool); ok {
+			extend[i] = bool(*b)
+		}
+	}
+	return extend
+}
+
+// drawFunctionShading paints the function-based shading `shading` (8.7.4.5.2) on `img`, device
+// space being mapped to shading space by `inv`.
+func drawFunctionShading(img *image.NRGBA, cs model.PdfColorspace, shading *model.PdfShadingType1, inv transform.Matrix) error {
+	if len(shading.Function) == 0 {
+		return errors.New("Function missing")
+	}
+	domain, err := floatsOrDefault(shading.Domain, 0, 1, 0, 1)
+	if err != nil {
+		return err
+	}
+	var matrixObj core.PdfObject
+	if shading.Matrix != nil {
+		matrixObj = shading.Matrix
+	}
+	matrix, err := toMatrix(matrixObj)
+	if err != nil {
+		return err
+	}
+	// Device space to the space of the domain.
+	matrixInv, ok := matrix.Inverse()
+	if !ok {
+		return nil
+	}
+	m := inv.Mult(matrixInv)
+
+	xmin, xmax := math.Min(domain[0], domain[1]), math.Max(domain[0], domain[1])
+	ymin, ymax := math.Min(domain[2], domain[3]), math.Max(domain[2], domain[3])
+	rect := img.Rect
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			u, v := m.Transform(float64(x)+0.5, float64(y)+0.5)
+			if u < xmin || u > xmax || v < ymin || v > ymax {
+				continue
+			}
+			rgb, err := shadingColor(cs, shading.Function, []float64{u, v})
+			if err != nil {
+				return err
+			}
+			setPixel(img, x, y, rgb)
+		}
+	}
+	return nil
+}
+
+// drawAxialShading paints the axial shading `shading` (8.7.4.5.3) on `img`, device space being
+// mapped to shading space by `inv`.
+func drawAxialShading(img *image.NRGBA, cs model.PdfColorspace, shading *model.PdfShadingType2, inv transform.Matrix) error {
+	if shading.Coords == nil {
+		return errors.New("Coords missing")
+	}
+	coords, err := floatsOrDefault(shading.Coords, 0, 0, 0, 0)
+	if err != nil {
+		return err
+	}
+	domain, err := floatsOrDefault(shading.Domain, 0, 1)
+	if err != nil {
+		return err
+	}
+	table, err := newColorTable(cs, shading.Function, domain[0], domain[1])
+	if err != nil {
+		return err
+	}
+	extend := extendFlags(shading.Extend)
+
+	x0, y0 := coords[0], coords[1]
+	dx, dy := coords[2]-x0, coords[3]-y0
+	norm := dx*dx + dy*dy
+	if norm == 0 {
+		return nil
+	}
+	rect := img.Rect
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			u, v := inv.Transform(float64(x)+0.5, float64(y)+0.5)
+			s := ((u-x0)*dx + (v-y0)*dy) / norm
+			if s < 0 {
+				if !extend[0] {
+					continue
+				}
+				s = 0
+			} else if s > 1 {
+				if !extend[1] {
+					continue
+				}
+				s = 1
+			}
+			setPixel(img, x, y, table.at(s))
+		}
+	}
+	return nil
+}
+
+// drawRadialShading paints the radial shading `shading` (8.7.4.5.4) on `img`, device space being
+// mapped to shading space by `inv`.
+func drawRadialShading(img *image.NRGBA, cs model.PdfColorspace, shading *model.PdfShadingType3, inv transform.Matrix) error {
+	if shading.Coords == nil {
+		return errors.New("Coords missing")
+	}
+	coords, err := floatsOrDefault(shading.Coords, 0, 0, 0, 0, 0, 0)
+	if err != nil {
+		return err
+	}
+	domain, err := floatsOrDefault(shading.Domain, 0, 1)
+	if err != nil {
+		return err
+	}
+	table, err := newColorTable(cs, shading.Function, domain[0], domain[1])
+	if err != nil {
+		return err
+	}
+	extend := extendFlags(shading.Extend)
+
+	// The circles of the parametric values s have the centers c0 + s*cd and the radii r0 + s*dr.
+	// The color of a point p is that of the largest s for which p is on the circle, solving
+	// a*s^2 - 2*b*s + c = 0.
+	x0, y0, r0 := coords[0], coords[1], coords[2]
+	cdx, cdy, dr := coords[3]-x0, coords[4]-y0, coords[5]-r0
+	a := cdx*cdx + cdy*cdy - dr*dr
+	valid := func(s float64) (float64, bool) {
+		if r0+s*dr < 0 {
+			return 0, false
+		}
+		if s < 0 {
+			return 0, extend[0]
+		}
+		if s > 1 {
+			return 1, extend[1]
+		}
+		return s, true
+	}
+
+	rect := img.Rect
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			u, v := inv.Transform(float64(x)+0.5, float64(y)+0.5)
+			pdx, pdy := u-x0, v-y0
+			b := pdx*cdx + pdy*cdy + r0*dr
+			c := pdx*pdx + pdy*pdy - r0*r0
+
+			var roots []float64
+			if math.Abs(a) < 1e-12 {
+				if b == 0 {
+					continue
+				}
+				roots = []float64{c / (2 * b)}
+			} else {
+				disc := b*b - a*c
+				if disc < 0 {
+					continue
+				}
+				sq := math.Sqrt(disc)
+				s1, s2 := (b+sq)/a, (b-sq)/a
+				if s2 > s1 {
+					s1, s2 = s2, s1
+				}
+				roots = []float64{s1, s2}
+			}
+			for _, s := range roots {
+				if s, ok := valid(s); ok {
+					setPixel(img, x, y, table.at(s))
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// loadTestShading returns the shading of the shading dictionary or stream `obj`.
+func loadTestShading(t *testing.T, obj core.PdfObject) *model.PdfShading {
+	resources := model.NewPdfPageResources()
+	resources.SetShadingByName("Sh", obj)
+	shading, found := resources.GetShadingByName("Sh")
+	if !found {
+		t.Fatalf("Unable to load shading")
+	}
+	return shading
+}
+
+// newTestAxialShading returns the dictionary of an axial shading from red at (x0, y0) to blue at
+// (x1, y1).
+func newTestAxialShading(x0, y0, x1, y1 float64) *core.PdfObjectDictionary {
+	function := core.MakeDict()
+	function.Set("FunctionType", core.MakeInteger(2))
+	function.Set("Domain", core.MakeArrayFromFloats([]float64{0, 1}))
+	function.Set("C0", core.MakeArrayFromFloats([]float64{1, 0, 0}))
+	function.Set("C1", core.MakeArrayFromFloats([]float64{0, 0, 1}))
+	function.Set("N", core.MakeInteger(1))
+
+	dict := core.MakeDict()
+	dict.Set("ShadingType", core.MakeInteger(2))
+	dict.Set("ColorSpace", core.MakeName("DeviceRGB"))
+	dict.Set("Coords", core.MakeArrayFromFloats([]float64{x0, y0, x1, y1}))
+	dict.Set("Function", function)
+	return dict
+}
+
+// newTestMeshShading returns the stream of a mesh shading of type `shadingType` in DeviceRGB, with
+// 8 bit coordinates from 0 to 255, color components and flags, and the data `data`.
+func newTestMeshShading(t *testing.T, shadingType int64, data []byte) *core.PdfObjectStream {
+	stream, err := core.MakeStream(data, nil)
+	if err != nil {
+		t.Fatalf("Error creating stream: %v", err)
+	}
+	dict := stream.PdfObjectDictionary
+	dict.Set("ShadingType", core.MakeInteger(shadingType))
+	dict.Set("ColorSpace", core.MakeName("DeviceRGB"))
+	dict.Set("BitsPerCoordinate", core.MakeInteger(8))
+	dict.Set("BitsPerComponent", core.MakeInteger(8))
+	dict.Set("BitsPerFlag", core.MakeInteger(8))
+	dict.Set("Decode", core.MakeArrayFromFloats([]float64{0, 255, 0, 255, 0, 1, 0, 1, 0, 1}))
+	return stream
+}
+
+// checkShadingPixels checks the colors of the pixels of `img`, transparent for a zero alpha.
+func checkShadingPixels(t *testing.T, img *image.NRGBA, expected map[image.Point]color.NRGBA) {
+	for p, c := range expected {
+		got := img.NRGBAAt(p.X, p.Y)
+		if c.A == 0 {
+			if got.A != 0 {
+				t.Errorf("Pixel %v painted: %v", p, got)
+			}
+			continue
+		}
+		for _, d := range []int{int(got.R) - int(c.R), int(got.G) - int(c.G), int(got.B) - int(c.B), int(got.A) - int(c.A)} {
+			if d < -3 || d > 3 {
+				t.Errorf("Incorrect color of pixel %v: %v, expected %v", p, got, c)
+				break
+			}
+		}
+	}
+}
+
+var transparent = color.NRGBA{}
+
+func TestRenderShading(t *testing.T) {
+	identity := [6]float64{1, 0, 0, 1, 0, 0}
+
+	// Axial shading over the width, not extended.
+	axial := loadTestShading(t, newTestAxialShading(10, 0, 90, 0))
+	img, err := RenderShading(axial, model.PdfRectangle{Urx: 100, Ury: 10}, identity, 72)
+	if err != nil {
+		t.Fatalf("Error rendering shading: %v", err)
+	}
+	if img.Rect.Dx() != 100 || img.Rect.Dy() != 10 {
+		t.Fatalf("Incorrect image size %v", img.Rect)
+	}
+	checkShadingPixels(t, img, map[image.Point]color.NRGBA{
+		{5, 5}:  transparent,
+		{10, 5}: {0xFF, 0, 0, 0xFF},
+		{49, 5}: {0x81, 0, 0x7E, 0xFF},
+		{89, 5}: {0, 0, 0xFF, 0xFF},
+		{95, 5}: transparent,
+	})
+
+	// Radial shading from a point to a circle of radius 50, scaled by half and at 144 dpi.
+	dict := newTestAxialShading(0, 0, 0, 0)
+	dict.Set("ShadingType", core.MakeInteger(3))
+	dict.Set("Coords", core.MakeArrayFromFloats([]float64{50, 50, 0, 50, 50, 50}))
+	radial := loadTestShading(t, dict)
+	img, err = RenderShading(radial, model.PdfRectangle{Urx: 50, Ury: 50}, [6]float64{0.5, 0, 0, 0.5, 0, 0}, 144)
+	if err != nil {
+		t.Fatalf("Error rendering shading: %v", err)
+	}
+	checkShadingPixels(t, img, map[image.Point]color.NRGBA{
+		{50, 50}: {0xFF, 0, 0, 0xFF},
+		{75, 50}: {0x7F, 0, 0x80, 0xFF},
+		{2, 2}:   transparent,
+	})
+}
+
+func TestRenderMeshShading(t *testing.T) {
+	// Free-form mesh of a green triangle, and a second triangle sharing an edge (flag 1).
+	freeForm := loadTestShading(t, newTestMeshShading(t, 4, []byte{
+		0, 0, 0, 0, 0xFF, 0,
+		0, 100, 0, 0, 0xFF, 0,
+		0, 0, 100, 0, 0xFF, 0,
+		1, 100, 100, 0, 0xFF, 0,
+	}))
+	img, err := RenderShading(freeForm, model.PdfRectangle{Urx: 200, Ury: 200}, [6]float64{1, 0, 0, 1, 0, 0}, 72)
+	if err != nil {
+		t.Fatalf("Error rendering shading: %v", err)
+	}
+	green := color.NRGBA{0, 0xFF, 0, 0xFF}
+	checkShadingPixels(t, img, map[image.Point]color.NRGBA{
+		{10, 189}:  green,
+		{90, 110}:  green,
+		{150, 189}: transparent,
+		{10, 10}:   transparent,
+	})
+
+	// Coons patch of the square (0, 0) to (100, 100), red at the left side and blue at the right.
+	coons := loadTestShading(t, newTestMeshShading(t, 6, []byte{
+		0,
+		0, 0, 0, 33, 0, 67, 0, 100,
+		33, 100, 67, 100, 100, 100, 100, 67,
+		100, 33, 100, 0, 67, 0, 33, 0,
+		0xFF, 0, 0, 0xFF, 0, 0, 0, 0, 0xFF, 0, 0, 0xFF,
+	}))
+	img, err = RenderShading(coons, model.PdfRectangle{Urx: 200, Ury: 100}, [6]float64{1, 0, 0, 1, 0, 0}, 72)
+	if err != nil {
+		t.Fatalf("Error rendering shading: %v", err)
+	}
+	checkShadingPixels(t, img, map[image.Point]color.NRGBA{
+		{1, 50}:   {0xFE, 0, 0x01, 0xFF},
+		{50, 50}:  {0x7F, 0, 0x80, 0xFF},
+		{98, 50}:  {0x01, 0, 0xFE, 0xFF},
+		{150, 50}: transparent,
+	})
+}
+
+func TestRenderPatterns(t *testing.T) {
+	resources := model.NewPdfPageResources()
+	resources.SetShadingByName("Sh1", newTestAxialShading(0, 0, 50, 0))
+
+	// Shading pattern from red to blue over the left half of the page.
+	shadingPattern := core.MakeDict()
+	shadingPattern.Set("PatternType", core.MakeInteger(2))
+	shadingPattern.Set("Shading", newTestAxialShading(0, 0, 50, 0))
+	resources.SetPatternByName("P1", core.MakeIndirectObject(shadingPattern))
+
+	// Uncolored tiling pattern of vertical stripes 5 wide every 10.
+	cell, err := core.MakeStream([]byte("0 0 5 10 re f"), nil)
+	if err != nil {
+		t.Fatalf("Error creating stream: %v", err)
+	}
+	cell.Set("PatternType", core.MakeInteger(1))
+	cell.Set("PaintType", core.MakeInteger(2))
+	cell.Set("TilingType", core.MakeInteger(1))
+	cell.Set("BBox", core.MakeArrayFromFloats([]float64{0, 0, 10, 10}))
+	cell.Set("XStep", core.MakeFloat(10))
+	cell.Set("YStep", core.MakeFloat(10))
+	cell.Set("Resources", core.MakeDict())
+	resources.SetPatternByName("P2", cell)
+	resources.SetColorspaceByName("CS0", &model.PdfColorspaceSpecialPattern{UnderlyingCS: model.NewPdfColorspaceDeviceRGB()})
+
+	content := `
+/Pattern cs /P1 scn 0 0 50 50 re f
+/CS0 cs 1 0 0 /P2 scn 50 0 50 50 re f
+q 0 50 50 50 re W n /Sh1 sh Q
+`
+	img, err := RenderPage(newTestPage(t, 100, 100, content, resources), 72)
+	if err != nil {
+		t.Fatalf("Error rendering page: %v", err)
+	}
+	checkPixels(t, img, map[image.Point]color.RGBA{
+		{0, 75}:  red,                   // Start of the shading pattern.
+		{49, 75}: blue,                  // Its end.
+		{52, 75}: red,                   // Stripe of the tiling pattern.
+		{57, 75}: white,                 // Between the stripes.
+		{92, 52}: red,                   // Stripe of another tile.
+		{24, 25}: {0x84, 0, 0x7B, 0xFF}, // Shading painted by sh.
+		{75, 25}: white,                 // Outside of its clipping path.
+	}, 3)
+}
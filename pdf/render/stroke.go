@@ -0,0 +1,274 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"math"
+
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+)
+
+// Line cap styles (Table 54 "Line Cap Styles").
+const (
+	lineCapButt   = 0
+	lineCapRound  = 1
+	lineCapSquare = 2
+)
+
+// Line join styles (Table 55 "Line Join Styles").
+const (
+	lineJoinMiter = 0
+	lineJoinRound = 1
+	lineJoinBevel = 2
+)
+
+// Minimum width of stroked lines in pixels, the width of the thinnest lines.
+const minLineWidth = 1.0
+
+// strokeStyle is the line style of stroked paths (8.4.3 "Details of Graphics State Parameters").
+type strokeStyle struct {
+	width      float64
+	cap        int
+	join       int
+	miterLimit float64
+	dash       []float64
+	dashPhase  float64
+}
+
+// stroker outlines the stroke of a path in user space as a set of polygons, which are all
+// oriented the same way so that filling them with the nonzero winding number rule paints their
+// union.
+type stroker struct {
+	style strokeStyle
+	hw    float64 // Half the line width.
+	scale float64 // Device pixels by user space unit.
+	poly  polygon
+}
+
+// strokePolygon returns the outline of the stroke of `p` with `style`, transformed to device space
+// by `ctm`.
+func strokePolygon(p *path, style strokeStyle, ctm transform.Matrix) polygon {
+	s := stroker{style: style, scale: deviceScale(ctm)}
+	s.hw = math.Max(style.width, minLineWidth/s.scale) / 2
+	for _, sp := range p.subpaths {
+		for _, dash := range s.dashes(dedupPoints(sp.points), sp.closed) {
+			s.strokeSubpath(dash.points, dash.closed)
+		}
+	}
+	for i, contour := range s.poly {
+		s.poly[i] = transformPoints(contour, ctm)
+	}
+	return s.poly
+}
+
+// dedupPoints returns `points` without consecutive duplicates.
+func dedupPoints(points []point) []point {
+	var result []point
+	for i, p := range points {
+		if i == 0 || p != points[i-1] {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// dashes returns the dashes of the subpath `points`, the subpath itself if the line is solid.
+func (s *stroker) dashes(points []point, closed bool) []subpath {
+	total := 0.0
+	for _, d := range s.style.dash {
+		if d < 0 {
+			return []subpath{{points: points, closed: closed}}
+		}
+		total += d
+	}
+	if total <= 0 || len(points) < 2 {
+		return []subpath{{points: points, closed: closed}}
+	}
+	if closed {
+		points = append(append([]point(nil), points...), points[0])
+	}
+
+	// Position in the dash pattern at the start of the subpath.
+	index, on := 0, true
+	left := s.style.dash[0]
+	for phase := math.Mod(s.style.dashPhase, 2*total); phase > 0; {
+		if phase < left {
+			left -= phase
+			break
+		}
+		phase -= left
+		index = (index + 1) % len(s.style.dash)
+		on = !on
+		left = s.style.dash[index]
+	}
+
+	var dashes []subpath
+	var current []point
+	if on {
+		current = []point{points[0]}
+	}
+	for i := 1; i < len(points); i++ {
+		p, q := points[i-1], points[i]
+		length := math.Hypot(q.x-p.x, q.y-p.y)
+		pos := 0.0
+		for length-pos > left {
+			pos += left
+			t := pos / length
+			r := point{p.x + t*(q.x-p.x), p.y + t*(q.y-p.y)}
+			if on {
+				dashes = append(dashes, subpath{points: append(current, r)})
+				current = nil
+			} else {
+				current = []point{r}
+			}
+			index = (index + 1) % len(s.style.dash)
+			on = !on
+			left = s.style.dash[index]
+		}
+		left -= length - pos
+		if on {
+			current = append(current, q)
+		}
+	}
+	if on && len(current) > 0 {
+		dashes = append(dashes, subpath{points: current})
+	}
+	return dashes
+}
+
+// strokeSubpath adds the outline of the stroke of the subpath `points`.
+func (s *stroker) strokeSubpath(points []point, closed bool) {
+	if len(points) == 0 {
+		return
+	}
+	if len(points) == 1 || (len(points) == 2 && points[0] == points[1]) {
+		// Degenerate subpaths are painted only with round caps.
+		if s.style.cap == lineCapRound {
+			s.addCircle(points[0])
+		}
+		return
+	}
+	points = dedupPoints(points)
+	if closed && len(points) > 2 && points[0] == points[len(points)-1] {
+		points = points[:len(points)-1]
+	}
+
+	n := len(points)
+	segments := n - 1
+	if closed && n > 2 {
+		segments = n
+	}
+	for i := 0; i < segments; i++ {
+		s.addSegment(points[i], points[(i+1)%n])
+	}
+	for i := 1; i < segments; i++ {
+		s.addJoin(points[i-1], points[i], points[(i+1)%n])
+	}
+	if segments == n {
+		s.addJoin(points[n-1], points[0], points[1])
+	} else {
+		s.addCap(points[0], points[1])
+		s.addCap(points[n-1], points[n-2])
+	}
+}
+
+// addSegment adds the rectangle of the stroke of the line segment from `p` to `q`.
+func (s *stroker) addSegment(p, q point) {
+	nx, ny := s.normal(p, q)
+	s.add([]point{{p.x + nx, p.y + ny}, {q.x + nx, q.y + ny}, {q.x - nx, q.y - ny}, {p.x - nx, p.y - ny}})
+}
+
+// addJoin adds the join of the segments from `p` to `q` and from `q` to `r`.
+func (s *stroker) addJoin(p, q, r point) {
+	d0x, d0y := unit(q.x-p.x, q.y-p.y)
+	d1x, d1y := unit(r.x-q.x, r.y-q.y)
+	cross := d0x*d1y - d0y*d1x
+	dot := d0x*d1x + d0y*d1y
+	if math.Abs(cross) < 1e-9 && dot > 0 {
+		return
+	}
+	if s.style.join == lineJoinRound {
+		s.addCircle(q)
+		return
+	}
+
+	// The join is on the outer side of the turn.
+	n0x, n0y := s.normal(p, q)
+	n1x, n1y := s.normal(q, r)
+	if cross > 0 {
+		n0x, n0y, n1x, n1y = -n0x, -n0y, -n1x, -n1y
+	}
+	a := point{q.x + n0x, q.y + n0y}
+	b := point{q.x + n1x, q.y + n1y}
+	if s.style.join == lineJoinMiter && 1+dot > 1e-9 {
+		ratio := 1 / math.Sqrt((1+dot)/2)
+		if ratio <= s.style.miterLimit {
+			mx, my := unit(n0x+n1x, n0y+n1y)
+			tip := point{q.x + mx*s.hw*ratio, q.y + my*s.hw*ratio}
+			s.add([]point{q, a, tip, b})
+			return
+		}
+	}
+	s.add([]point{q, a, b})
+}
+
+// addCap adds the cap at the end `p` of the segment from `q` to `p`.
+func (s *stroker) addCap(p, q point) {
+	switch s.style.cap {
+	case lineCapRound:
+		s.addCircle(p)
+	case lineCapSquare:
+		dx, dy := unit(p.x-q.x, p.y-q.y)
+		nx, ny := s.normal(q, p)
+		ex, ey := p.x+dx*s.hw, p.y+dy*s.hw
+		s.add([]point{{p.x + nx, p.y + ny}, {ex + nx, ey + ny}, {ex - nx, ey - ny}, {p.x - nx, p.y - ny}})
+	}
+}
+
+// addCircle adds the disc of the line width centered at `p`.
+func (s *stroker) addCircle(p point) {
+	n := 8 + int(s.hw*s.scale*2)
+	if n > 64 {
+		n = 64
+	}
+	circle := make([]point, n)
+	for i := range circle {
+		angle := 2 * math.Pi * float64(i) / float64(n)
+		circle[i] = point{p.x + s.hw*math.Cos(angle), p.y + s.hw*math.Sin(angle)}
+	}
+	s.add(circle)
+}
+
+// normal returns the normal to the left of the segment from `p` to `q`, of length half the line
+// width.
+func (s *stroker) normal(p, q point) (float64, float64) {
+	dx, dy := unit(q.x-p.x, q.y-p.y)
+	return -dy * s.hw, dx * s.hw
+}
+
+// add adds the polygon `contour`, oriented counterclockwise.
+func (s *stroker) add(contour []point) {
+	area := 0.0
+	for i, p := range contour {
+		q := contour[(i+1)%len(contour)]
+		area += p.x*q.y - q.x*p.y
+	}
+	if area < 0 {
+		for i, j := 0, len(contour)-1; i < j; i, j = i+1, j-1 {
+			contour[i], contour[j] = contour[j], contour[i]
+		}
+	}
+	s.poly = append(s.poly, contour)
+}
+
+// unit returns the vector (dx, dy) normalized to unit length.
+func unit(dx, dy float64) (float64, float64) {
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return 0, 0
+	}
+	return dx / length, dy / length
+}
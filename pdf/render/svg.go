@@ -0,0 +1,1016 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// WritePageSVG writes `page` to `w` as an SVG document, one unit being one point, on a white
+// background.  The document shows the crop box of the page, or its media box if not set, rotated
+// by the Rotate entry of the page.  Paths, clipping paths, images, tiling patterns, transparency
+// groups, soft masks and blend modes are converted to their SVG counterparts, with colors in sRGB.
+// Text is drawn with the glyph outlines of embedded fonts, and as SVG text in similar generic
+// fonts for fonts without embedded font program.  Shadings are converted to images.  If the
+// content streams of the page cannot be processed, the document converted so far is written and
+// the error returned.
+func WritePageSVG(page *model.PdfPage, w io.Writer) error {
+	llx, lly, urx, ury, rotate, err := pageBox(page)
+	if err != nil {
+		return err
+	}
+	width, height := urx-llx, ury-lly
+	if width <= 0 || height <= 0 {
+		common.Log.Debug("Empty page box [%g %g %g %g]", llx, lly, urx, ury)
+		return errors.New("Empty page")
+	}
+	base := pageBase(llx, lly, width, height, 1, rotate)
+	if rotate == 90 || rotate == 270 {
+		width, height = height, width
+	}
+
+	c := newSVGConverter(image.Rect(0, 0, int(math.Ceil(width)), int(math.Ceil(height))))
+	contents, err := page.GetAllContentStreams()
+	if err == nil {
+		err = c.convert(contents, page.Resources, base, c.newState())
+	}
+	if err == nil {
+		for _, appearance := range annotationAppearances(page.Annotations) {
+			form := appearance.form
+			c.forms[form.stream] = true
+			err := c.convertForm(form, appearance.ctm.Mult(base), c.newState())
+			delete(c.forms, form.stream)
+			if err != nil {
+				common.Log.Debug("Error converting annotation appearance: %v", err)
+			}
+		}
+	}
+	c.body.close()
+
+	var doc bytes.Buffer
+	doc.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	fmt.Fprintf(&doc, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" `+
+		`version="1.1" width="%spt" height="%spt" viewBox="0 0 %s %s">`+"\n",
+		svgNumber(width), svgNumber(height), svgNumber(width), svgNumber(height))
+	if c.defs.Len() > 0 {
+		doc.WriteString("<defs>\n")
+		doc.Write(c.defs.Bytes())
+		doc.WriteString("</defs>\n")
+	}
+	fmt.Fprintf(&doc, `<rect width="%s" height="%s" fill="#ffffff"/>`+"\n", svgNumber(width), svgNumber(height))
+	doc.Write(c.body.Bytes())
+	doc.WriteString("</svg>\n")
+	if _, werr := w.Write(doc.Bytes()); werr != nil {
+		return werr
+	}
+	return err
+}
+
+// svgWriter is the SVG markup of converted content, whose elements are grouped by the attributes
+// of their graphics state applied in device space.
+type svgWriter struct {
+	bytes.Buffer
+	group string // Attributes of the open group, empty if none.
+}
+
+// element writes `elem` in a group with the attributes `group`.
+func (w *svgWriter) element(group, elem string) {
+	if group != w.group {
+		w.close()
+		if group != "" {
+			fmt.Fprintf(w, "<g%s>\n", group)
+		}
+		w.group = group
+	}
+	w.WriteString(elem)
+}
+
+// close closes the open group.
+func (w *svgWriter) close() {
+	if w.group != "" {
+		w.WriteString("</g>\n")
+		w.group = ""
+	}
+}
+
+// svgState is the graphics state of a converted content stream: the state of rendered content
+// streams, whose raster clipping path is not used, with the clipping path, soft mask and blend
+// mode referring to SVG elements.
+type svgState struct {
+	renderState
+	clip     string          // Id of the clipPath element, empty if not clipped.
+	clipRect image.Rectangle // Bounds of the clipping path in device space.
+	softMask string          // Id of the mask element, empty if none.
+	blend    string          // CSS mix-blend-mode, empty for Normal.
+}
+
+// groupAttrs returns the attributes of the groups of the elements painted with `state`.
+func (state *svgState) groupAttrs() string {
+	var b strings.Builder
+	if state.clip != "" {
+		fmt.Fprintf(&b, ` clip-path="url(#%s)"`, state.clip)
+	}
+	if state.softMask != "" {
+		fmt.Fprintf(&b, ` mask="url(#%s)"`, state.softMask)
+	}
+	if state.blend != "" {
+		fmt.Fprintf(&b, ` style="mix-blend-mode:%s"`, state.blend)
+	}
+	return b.String()
+}
+
+// svgPatternKey identifies the pattern elements of tiling patterns.
+type svgPatternKey struct {
+	stream *core.PdfObjectStream
+	matrix transform.Matrix // Pattern space to the user space of the painted elements.
+	rgb    [3]float64
+}
+
+// svgImageKey identifies the image elements of image XObjects, of stencil masks by color.
+type svgImageKey struct {
+	stream *core.PdfObjectStream
+	rgb    [3]float64
+}
+
+// svgGlyphKey identifies the path elements of glyph outlines.
+type svgGlyphKey struct {
+	font *renderFont
+	code uint64
+}
+
+// svgConverter converts content streams to SVG elements.
+type svgConverter struct {
+	body   svgWriter
+	out    *svgWriter // Elements of the content stream being converted.
+	defs   bytes.Buffer
+	bounds image.Rectangle // Page bounds in device space.
+
+	fonts    map[core.PdfObject]*renderFont
+	glyphs   map[svgGlyphKey]string                 // Empty for glyphs without outline.
+	rasters  map[*core.PdfObjectStream]*rasterImage // nil for images which cannot be decoded.
+	images   map[svgImageKey]string                 // Empty for images which cannot be encoded.
+	patterns map[svgPatternKey]string               // Empty for patterns which cannot be converted.
+	forms    formStack
+	ids      int
+	depth    int // Nesting of the Type3 glyph descriptions being converted.
+}
+
+func newSVGConverter(bounds image.Rectangle) *svgConverter {
+	c := &svgConverter{
+		bounds:   bounds,
+		fonts:    map[core.PdfObject]*renderFont{},
+		glyphs:   map[svgGlyphKey]string{},
+		rasters:  map[*core.PdfObjectStream]*rasterImage{},
+		images:   map[svgImageKey]string{},
+		patterns: map[svgPatternKey]string{},
+		forms:    formStack{},
+	}
+	c.out = &c.body
+	return c
+}
+
+// newState returns the initial graphics state of pages.
+func (c *svgConverter) newState() svgState {
+	return svgState{renderState: newRenderState(), clipRect: c.bounds}
+}
+
+// newID returns a new element id starting with `prefix`.
+func (c *svgConverter) newID(prefix string) string {
+	c.ids++
+	return prefix + strconv.Itoa(c.ids)
+}
+
+// convert converts the content stream `contents` with the resources `resources` and the initial
+// graphics state `state`, its user space being mapped to device space by `base`.
+func (c *svgConverter) convert(contents string, resources *model.PdfPageResources, base transform.Matrix, state svgState) error {
+	cstreamParser := contentstream.NewContentStreamParser(contents)
+	operations, err := cstreamParser.Parse()
+	if err != nil {
+		return err
+	}
+
+	processor := contentstream.NewContentStreamProcessor(*operations)
+	var stateStack []svgState
+	var p *svgPath // Current path, nil if none.
+	clipping, clipEvenOdd := false, false
+	var textClip []string // Glyphs added to the clipping path since BT.
+	textClipping := false
+
+	processor.AddHandler(contentstream.HandlerConditionEnumAllOperands, "",
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+			ctm := gs.CTM.Mult(base)
+
+			switch op.Operand {
+			case "q":
+				stateStack = append(stateStack, state)
+			case "Q":
+				if len(stateStack) > 0 {
+					state = stateStack[len(stateStack)-1]
+					stateStack = stateStack[:len(stateStack)-1]
+				}
+
+			// Path construction.
+			case "m", "l", "c", "v", "y", "re":
+				vals, err := getNumbersAsFloat(op.Params)
+				if err != nil || len(vals) != pathOperandCounts[op.Operand] {
+					common.Log.Debug("Invalid %s operands: %v", op.Operand, op.Params)
+					return nil
+				}
+				if p == nil {
+					p = newSVGPath()
+				}
+				switch op.Operand {
+				case "m":
+					p.moveTo(vals[0], vals[1])
+				case "l":
+					p.lineTo(vals[0], vals[1])
+				case "c":
+					p.curveTo(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5])
+				case "v":
+					p.curveTo(p.current.x, p.current.y, vals[0], vals[1], vals[2], vals[3])
+				case "y":
+					p.curveTo(vals[0], vals[1], vals[2], vals[3], vals[2], vals[3])
+				case "re":
+					p.rect(vals[0], vals[1], vals[2], vals[3])
+				}
+			case "h":
+				if p != nil {
+					p.closePath()
+				}
+
+			// Path painting and clipping.
+			case "W", "W*":
+				clipping, clipEvenOdd = true, op.Operand == "W*"
+			case "S", "s", "f", "F", "f*", "B", "B*", "b", "b*", "n":
+				if p == nil {
+					p = newSVGPath()
+				}
+				switch op.Operand {
+				case "s", "b", "b*":
+					p.closePath()
+				}
+				fill, stroke := false, false
+				switch op.Operand {
+				case "f", "F", "f*", "B", "B*", "b", "b*":
+					fill = true
+				}
+				switch op.Operand {
+				case "S", "s", "B", "B*", "b", "b*":
+					stroke = true
+				}
+				evenOdd := op.Operand == "f*" || op.Operand == "B*" || op.Operand == "b*"
+				c.paintPath(p, ctm, fill, evenOdd, stroke, &state)
+				if clipping {
+					c.addClip(p.element(ctm, clipEvenOdd, ""), p.bounds(ctm), &state)
+					clipping = false
+				}
+				p = nil
+
+			// Graphics state parameters.
+			case "w", "J", "j", "M":
+				vals, err := getNumbersAsFloat(op.Params)
+				if err != nil || len(vals) != 1 {
+					common.Log.Debug("Invalid %s operands: %v", op.Operand, op.Params)
+					return nil
+				}
+				state.setLineParameter(op.Operand, vals[0])
+			case "d":
+				if len(op.Params) != 2 {
+					return nil
+				}
+				state.setDash(op.Params[0], op.Params[1])
+			case "gs":
+				if len(op.Params) != 1 || resources == nil {
+					return nil
+				}
+				name, ok := op.Params[0].(*core.PdfObjectName)
+				if !ok {
+					return nil
+				}
+				obj, found := resources.GetExtGState(*name)
+				if !found {
+					common.Log.Debug("ExtGState %s not in resources", *name)
+					return nil
+				}
+				if dict, ok := core.TraceToDirectObject(obj).(*core.PdfObjectDictionary); ok {
+					c.applyExtGState(dict, &state, ctm, resources)
+				}
+
+			// Colors.
+			case "CS", "SC", "SCN", "G", "RG", "K":
+				if !state.colorLocked {
+					state.stroke = newPaint(gs.ColorspaceStroking, gs.ColorStroking, resources, base)
+				}
+			case "cs", "sc", "scn", "g", "rg", "k":
+				if !state.colorLocked {
+					state.fill = newPaint(gs.ColorspaceNonStroking, gs.ColorNonStroking, resources, base)
+				}
+			case "d1":
+				state.colorLocked = true
+
+			// Shadings.
+			case "sh":
+				if len(op.Params) != 1 || resources == nil {
+					return nil
+				}
+				name, ok := op.Params[0].(*core.PdfObjectName)
+				if !ok {
+					return nil
+				}
+				shading, found := resources.GetShadingByName(*name)
+				if !found {
+					common.Log.Debug("Shading %s not in resources", *name)
+					return nil
+				}
+				href, rect := c.shadingImage(shading, ctm, state.clipRect, false)
+				if href != "" {
+					c.out.element(state.groupAttrs(), svgImageElement(href, rect, state.fillAlpha, ""))
+				}
+
+			// Text.
+			case "BT":
+				state.text.tm = transform.IdentityMatrix()
+				state.text.tlm = transform.IdentityMatrix()
+				textClip, textClipping = nil, false
+			case "ET":
+				if textClipping {
+					c.addClip(strings.Join(textClip, ""), state.clipRect, &state)
+				}
+				textClip, textClipping = nil, false
+			case "Tf", "Tc", "Tw", "Tz", "TL", "Ts", "Tr", "Td", "TD", "Tm", "T*":
+				state.text.set(op, resources, c.fonts)
+			case "Tj", "TJ", "'", "\"":
+				if state.text.renderMode >= 4 {
+					textClipping = true
+				}
+				state.text.showTextOp(op, func(data []byte) {
+					c.showText(data, &state, ctm, resources, &textClip)
+				})
+
+			// XObjects and inline images.
+			case "Do":
+				if len(op.Params) != 1 || resources == nil {
+					return nil
+				}
+				name, ok := op.Params[0].(*core.PdfObjectName)
+				if !ok {
+					return nil
+				}
+				c.drawXObject(*name, resources, ctm, state)
+			case "BI":
+				if len(op.Params) != 1 {
+					return nil
+				}
+				iimg, ok := op.Params[0].(*contentstream.ContentStreamInlineImage)
+				if !ok {
+					return nil
+				}
+				img, err := loadInlineImage(iimg, resources)
+				if err != nil {
+					common.Log.Debug("Unable to decode inline image: %v", err)
+					return nil
+				}
+				c.drawImage(img, nil, ctm, state)
+			}
+			return nil
+		})
+
+	return processor.Process(resources)
+}
+
+// paintPath fills and strokes the path `p`, whose user space is mapped to device space by `ctm`,
+// with the graphics state `state`.
+func (c *svgConverter) paintPath(p *svgPath, ctm transform.Matrix, fill, evenOdd, stroke bool, state *svgState) {
+	if p.empty() || (!fill && !stroke) {
+		return
+	}
+	var attrs strings.Builder
+	painted := false
+	fillShading := fill && isShadingPaint(state.fill)
+	if fill && !fillShading {
+		if a, ok := c.paintAttrs("fill", state.fill, state.fillAlpha, ctm); ok {
+			attrs.WriteString(a)
+			if evenOdd {
+				attrs.WriteString(` fill-rule="evenodd"`)
+			}
+			painted = true
+		}
+	}
+	if !painted {
+		attrs.WriteString(` fill="none"`)
+	}
+	strokeShading := stroke && isShadingPaint(state.stroke)
+	if stroke && !strokeShading {
+		if a, ok := c.paintAttrs("stroke", state.stroke, state.strokeAlpha, ctm); ok {
+			attrs.WriteString(a)
+			attrs.WriteString(strokeAttrs(state.style, 1))
+			painted = true
+		}
+	}
+	if painted {
+		c.out.element(state.groupAttrs(), p.element(ctm, false, attrs.String()))
+	}
+
+	// Shadings are painted as images masked by the path.
+	if fillShading {
+		rule := ""
+		if evenOdd {
+			rule = ` fill-rule="evenodd"`
+		}
+		c.paintShadingMask(p.element(ctm, false, ` fill="#ffffff"`+rule), p.bounds(ctm), state.fill,
+			state.fillAlpha, state)
+	}
+	if strokeShading {
+		c.paintShadingMask(p.element(ctm, false, ` fill="none" stroke="#ffffff"`+strokeAttrs(state.style, 1)),
+			p.bounds(ctm).Inset(-int(math.Ceil(state.style.width*deviceScale(ctm)))-1), state.stroke,
+			state.strokeAlpha, state)
+	}
+}
+
+// isShadingPaint returns true if `p` is a shading pattern.
+func isShadingPaint(p paint) bool {
+	return p.ok && p.pattern != nil && p.pattern.IsShading()
+}
+
+// paintAttrs returns the attributes of the property `prop` (fill or stroke) painting with `p` with
+// the opacity `alpha` the elements whose user space is mapped to device space by `ctm`.  Returns
+// false if nothing is painted.  Shading patterns are painted by paintShadingMask.
+func (c *svgConverter) paintAttrs(prop string, p paint, alpha float64, ctm transform.Matrix) (string, bool) {
+	if !p.ok || alpha <= 0 {
+		return "", false
+	}
+	value := svgColor(p.rgb)
+	if p.pattern != nil {
+		if !p.pattern.IsTiling() {
+			return "", false
+		}
+		id := c.tilingPattern(p, ctm)
+		if id == "" {
+			return "", false
+		}
+		value = "url(#" + id + ")"
+	}
+	attrs := fmt.Sprintf(` %s="%s"`, prop, value)
+	if alpha < 1 {
+		attrs += fmt.Sprintf(` %s-opacity="%s"`, prop, svgNumber(alpha))
+	}
+	return attrs, true
+}
+
+// strokeAttrs returns the attributes of the stroke style `style`, in units of `unit` user space
+// units.
+func strokeAttrs(style strokeStyle, unit float64) string {
+	var b strings.Builder
+	if style.width == 0 {
+		// The thinnest line that can be rendered.
+		b.WriteString(` stroke-width="1" vector-effect="non-scaling-stroke"`)
+	} else {
+		fmt.Fprintf(&b, ` stroke-width="%s"`, svgNumber(style.width/unit))
+	}
+	switch style.cap {
+	case 1:
+		b.WriteString(` stroke-linecap="round"`)
+	case 2:
+		b.WriteString(` stroke-linecap="square"`)
+	}
+	switch style.join {
+	case 1:
+		b.WriteString(` stroke-linejoin="round"`)
+	case 2:
+		b.WriteString(` stroke-linejoin="bevel"`)
+	default:
+		if style.miterLimit >= 1 && style.miterLimit != 4 {
+			fmt.Fprintf(&b, ` stroke-miterlimit="%s"`, svgNumber(style.miterLimit))
+		}
+	}
+	dashed := false
+	for _, d := range style.dash {
+		if d < 0 {
+			dashed = false
+			break
+		}
+		if d > 0 {
+			dashed = true
+		}
+	}
+	if dashed {
+		dash := make([]string, len(style.dash))
+		for i, d := range style.dash {
+			dash[i] = svgNumber(d / unit)
+		}
+		fmt.Fprintf(&b, ` stroke-dasharray="%s"`, strings.Join(dash, " "))
+		if style.dashPhase != 0 {
+			fmt.Fprintf(&b, ` stroke-dashoffset="%s"`, svgNumber(style.dashPhase/unit))
+		}
+	}
+	return b.String()
+}
+
+// addClip intersects the clipping path of `state` with the clipping path elements `elems` in
+// device space, bounded by `rect`.
+func (c *svgConverter) addClip(elems string, rect image.Rectangle, state *svgState) {
+	id := c.newID("c")
+	parent := ""
+	if state.clip != "" {
+		parent = fmt.Sprintf(` clip-path="url(#%s)"`, state.clip)
+	}
+	fmt.Fprintf(&c.defs, `<clipPath id="%s" clipPathUnits="userSpaceOnUse"%s>%s</clipPath>`+"\n", id, parent, elems)
+	state.clip = id
+	state.clipRect = state.clipRect.Intersect(rect)
+}
+
+// Blend modes (11.3.5 "Blend Mode") by CSS mix-blend-mode.
+var svgBlendModes = map[core.PdfObjectName]string{
+	"Normal":     "",
+	"Compatible": "",
+	"Multiply":   "multiply",
+	"Screen":     "screen",
+	"Overlay":    "overlay",
+	"Darken":     "darken",
+	"Lighten":    "lighten",
+	"ColorDodge": "color-dodge",
+	"ColorBurn":  "color-burn",
+	"HardLight":  "hard-light",
+	"SoftLight":  "soft-light",
+	"Difference": "difference",
+	"Exclusion":  "exclusion",
+	"Hue":        "hue",
+	"Saturation": "saturation",
+	"Color":      "color",
+	"Luminosity": "luminosity",
+}
+
+// applyExtGState sets the parameters of the graphics state parameter dictionary `dict` set with
+// the CTM `ctm` in a content stream with the resources `resources`.
+func (c *svgConverter) applyExtGState(dict *core.PdfObjectDictionary, state *svgState, ctm transform.Matrix,
+	resources *model.PdfPageResources) {
+	state.renderState.applyExtGState(dict)
+
+	var modes []core.PdfObject
+	switch t := core.TraceToDirectObject(dict.Get("BM")).(type) {
+	case *core.PdfObjectName:
+		modes = []core.PdfObject{t}
+	case *core.PdfObjectArray:
+		modes = *t
+	}
+	// The first supported blend mode of arrays is used.
+	for _, obj := range modes {
+		if name, ok := core.TraceToDirectObject(obj).(*core.PdfObjectName); ok {
+			if mode, ok := svgBlendModes[*name]; ok {
+				state.blend = mode
+				break
+			}
+		}
+	}
+
+	switch t := core.TraceToDirectObject(dict.Get("SMask")).(type) {
+	case *core.PdfObjectName:
+		if *t == "None" {
+			state.softMask = ""
+		}
+	case *core.PdfObjectDictionary:
+		state.softMask = c.softMask(t, ctm, resources)
+	}
+}
+
+// softMask returns the id of the mask element of the soft mask dictionary `dict` (11.6.5.2 "Soft
+// Mask Dictionaries"), whose group is painted with `ctm`.  Returns an empty id if the mask cannot
+// be converted.
+func (c *svgConverter) softMask(dict *core.PdfObjectDictionary, ctm transform.Matrix, resources *model.PdfPageResources) string {
+	subtype, _ := core.TraceToDirectObject(dict.Get("S")).(*core.PdfObjectName)
+	stream, ok := core.TraceToDirectObject(dict.Get("G")).(*core.PdfObjectStream)
+	if subtype == nil || !ok {
+		common.Log.Debug("Invalid soft mask dictionary")
+		return ""
+	}
+	if c.forms[stream] {
+		common.Log.Debug("Soft mask group painted within itself")
+		return ""
+	}
+	form, err := newFormStream(stream, resources)
+	if err != nil {
+		common.Log.Debug("Unable to load soft mask group: %v", err)
+		return ""
+	}
+
+	w := &svgWriter{}
+	if *subtype == "Luminosity" {
+		// The backdrop color outside of the group, black by default.
+		if bc, err := toFloats(dict.Get("BC")); err == nil {
+			var rgb [3]float64
+			switch len(bc) {
+			case 1:
+				rgb = [3]float64{bc[0], bc[0], bc[0]}
+			case 3:
+				rgb = [3]float64{bc[0], bc[1], bc[2]}
+			case 4:
+				for i := range rgb {
+					rgb[i] = (1 - bc[i]) * (1 - bc[3])
+				}
+			}
+			fmt.Fprintf(w, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`+"\n", c.bounds.Min.X,
+				c.bounds.Min.Y, c.bounds.Dx(), c.bounds.Dy(), svgColor(rgb))
+		}
+	}
+	out := c.out
+	c.out = w
+	c.forms[stream] = true
+	err = c.convertForm(form, ctm, c.newState())
+	delete(c.forms, stream)
+	w.close()
+	c.out = out
+	if err != nil {
+		common.Log.Debug("Error converting soft mask group: %v", err)
+	}
+
+	id := c.newID("m")
+	style := ""
+	if *subtype == "Alpha" {
+		style = ` style="mask-type:alpha"`
+	}
+	fmt.Fprintf(&c.defs, `<mask id="%s" maskUnits="userSpaceOnUse" x="%d" y="%d" width="%d" height="%d"%s>`+"\n%s</mask>\n",
+		id, c.bounds.Min.X, c.bounds.Min.Y, c.bounds.Dx(), c.bounds.Dy(), style, w.String())
+	return id
+}
+
+// drawXObject draws the image or form XObject `name` of `resources` with the graphics state
+// `state` and `ctm`.
+func (c *svgConverter) drawXObject(name core.PdfObjectName, resources *model.PdfPageResources, ctm transform.Matrix, state svgState) {
+	stream, xtype := resources.GetXObjectByName(name)
+	switch xtype {
+	case model.XObjectTypeImage:
+		img, loaded := c.rasters[stream]
+		if !loaded {
+			var err error
+			img, err = loadImageXObject(stream)
+			if err != nil {
+				common.Log.Debug("Unable to decode image XObject %s: %v", name, err)
+			}
+			c.rasters[stream] = img
+		}
+		if img != nil {
+			c.drawImage(img, stream, ctm, state)
+		}
+	case model.XObjectTypeForm:
+		if c.forms[stream] {
+			common.Log.Debug("Form XObject %s painted within itself", name)
+			return
+		}
+		form, err := newFormStream(stream, resources)
+		if err != nil {
+			common.Log.Debug("Unable to load form XObject %s: %v", name, err)
+			return
+		}
+		c.forms[stream] = true
+		err = c.convertForm(form, ctm, state)
+		delete(c.forms, stream)
+		if err != nil {
+			common.Log.Debug("Error converting form XObject %s: %v", name, err)
+		}
+	}
+}
+
+// convertForm converts `form` painted with the graphics state `state` and `ctm`, clipped by its
+// bounding box.  Transparency groups are converted to groups with the opacity, soft mask and blend
+// mode of `state`.
+func (c *svgConverter) convertForm(form *formStream, ctm transform.Matrix, state svgState) error {
+	formCtm := form.matrix.Mult(ctm)
+	if form.bbox != nil {
+		p := newSVGPath()
+		p.rect(form.bbox.Llx, form.bbox.Lly, form.bbox.Urx-form.bbox.Llx, form.bbox.Ury-form.bbox.Lly)
+		c.addClip(p.element(formCtm, false, ""), p.bounds(formCtm), &state)
+	}
+	state.text = textState{horizScaling: 1}
+
+	group, ok := core.TraceToDirectObject(form.stream.Get("Group")).(*core.PdfObjectDictionary)
+	if !ok {
+		return c.convert(form.content, form.resources, formCtm, state)
+	}
+	if s, ok := core.TraceToDirectObject(group.Get("S")).(*core.PdfObjectName); !ok || *s != "Transparency" {
+		return c.convert(form.content, form.resources, formCtm, state)
+	}
+
+	// The elements of the group are composited together before being painted (11.4 "Transparency
+	// Groups").
+	inner := state
+	inner.fillAlpha, inner.strokeAlpha = 1, 1
+	inner.clip, inner.softMask, inner.blend = "", "", ""
+	w := &svgWriter{}
+	out := c.out
+	c.out = w
+	err := c.convert(form.content, form.resources, formCtm, inner)
+	w.close()
+	c.out = out
+
+	var attrs strings.Builder
+	if state.fillAlpha < 1 {
+		fmt.Fprintf(&attrs, ` opacity="%s"`, svgNumber(state.fillAlpha))
+	}
+	if isolated, ok := core.TraceToDirectObject(group.Get("I")).(*core.PdfObjectBool); ok && bool(*isolated) {
+		attrs.WriteString(` style="isolation:isolate"`)
+	}
+	c.out.element(state.groupAttrs(), fmt.Sprintf("<g%s>\n%s</g>\n", attrs.String(), w.String()))
+	return err
+}
+
+// tilingPattern returns the id of the pattern element of the tiling pattern of `p` painting the
+// elements whose user space is mapped to device space by `ctm`.  Returns an empty id if it cannot
+// be converted.
+func (c *svgConverter) tilingPattern(p paint, ctm transform.Matrix) string {
+	pattern := p.pattern.GetAsTilingPattern()
+	stream, ok := pattern.GetContainingPdfObject().(*core.PdfObjectStream)
+	if !ok {
+		common.Log.Debug("Tiling pattern not a stream (%T)", pattern.GetContainingPdfObject())
+		return ""
+	}
+	inv, ok := ctm.Inverse()
+	if !ok {
+		return ""
+	}
+	key := svgPatternKey{stream: stream, matrix: p.patternMatrix.Mult(inv), rgb: p.rgb}
+	if id, cached := c.patterns[key]; cached {
+		return id
+	}
+	if c.forms[stream] {
+		common.Log.Debug("Tiling pattern painted within itself")
+		return ""
+	}
+	c.patterns[key] = ""
+
+	if pattern.BBox == nil || pattern.XStep == nil || pattern.YStep == nil {
+		common.Log.Debug("Tiling pattern without BBox, XStep or YStep")
+		return ""
+	}
+	xstep, ystep := math.Abs(float64(*pattern.XStep)), math.Abs(float64(*pattern.YStep))
+	if xstep == 0 || ystep == 0 {
+		return ""
+	}
+	content, err := pattern.GetContentStream()
+	if err != nil {
+		common.Log.Debug("Unable to decode tiling pattern: %v", err)
+		return ""
+	}
+
+	box := pattern.BBox
+	llx, urx := math.Min(box.Llx, box.Urx), math.Max(box.Llx, box.Urx)
+	lly, ury := math.Min(box.Lly, box.Ury), math.Max(box.Lly, box.Ury)
+	state := c.newState()
+	state.clipRect = image.Rect(int(math.Floor(llx)), int(math.Floor(lly)), int(math.Ceil(urx)), int(math.Ceil(ury)))
+	if !pattern.IsColored() {
+		state.fill = paint{rgb: p.rgb, ok: true}
+		state.stroke = state.fill
+		state.colorLocked = true
+	}
+
+	// The tile of the pattern element is the XStep by YStep rectangle at the corner of the bounding
+	// box, on which the cells overlapping it are drawn.
+	repeats := func(size, step float64) int {
+		n := int(math.Ceil(size/step - 1e-6))
+		if n < 1 {
+			n = 1
+		} else if n > maxTileRepeats {
+			n = maxTileRepeats
+		}
+		return n
+	}
+	nx, ny := repeats(urx-llx, xstep), repeats(ury-lly, ystep)
+	w := &svgWriter{}
+	out := c.out
+	c.out = w
+	c.forms[stream] = true
+	for i := 1 - nx; i <= 0; i++ {
+		for j := 1 - ny; j <= 0; j++ {
+			cellBase := transform.TranslationMatrix(float64(i)*xstep, float64(j)*ystep)
+			cellState := state
+			cell := newSVGPath()
+			cell.rect(llx, lly, urx-llx, ury-lly)
+			c.addClip(cell.element(cellBase, false, ""), cell.bounds(cellBase), &cellState)
+			err = c.convert(string(content), pattern.Resources, cellBase, cellState)
+			if err != nil {
+				common.Log.Debug("Error converting tiling pattern: %v", err)
+			}
+		}
+	}
+	delete(c.forms, stream)
+	w.close()
+	c.out = out
+
+	id := c.newID("p")
+	fmt.Fprintf(&c.defs, `<pattern id="%s" patternUnits="userSpaceOnUse" x="%s" y="%s" width="%s" height="%s" `+
+		`patternTransform="%s">`+"\n%s</pattern>\n", id, svgNumber(llx), svgNumber(lly), svgNumber(xstep),
+		svgNumber(ystep), svgMatrix(key.matrix), w.String())
+	c.patterns[key] = id
+	return id
+}
+
+// paintShadingMask paints the shading pattern of `p` with the opacity `alpha`, masked by the
+// elements `elems` in device space bounded by `rect`.
+func (c *svgConverter) paintShadingMask(elems string, rect image.Rectangle, p paint, alpha float64, state *svgState) {
+	if alpha <= 0 {
+		return
+	}
+	rect = rect.Intersect(state.clipRect)
+	href, rect := c.shadingImage(p.pattern.GetAsShadingPattern().Shading, p.patternMatrix, rect, true)
+	if href == "" {
+		return
+	}
+	id := c.newID("m")
+	fmt.Fprintf(&c.defs, `<mask id="%s" maskUnits="userSpaceOnUse" x="%d" y="%d" width="%d" height="%d">%s</mask>`+"\n",
+		id, rect.Min.X, rect.Min.Y, rect.Dx(), rect.Dy(), elems)
+	c.out.element(state.groupAttrs(), svgImageElement(href, rect, alpha, id))
+}
+
+// shadingImage returns the data URI of the PNG image of `shading` over the rectangle `rect` of
+// device space, its shading space being mapped to device space by `ctm`, with its background
+// color if `background`.  Returns an empty URI if nothing is painted.
+func (c *svgConverter) shadingImage(shading *model.PdfShading, ctm transform.Matrix, rect image.Rectangle,
+	background bool) (string, image.Rectangle) {
+	if rect.Empty() {
+		return "", rect
+	}
+	scale := svgShadingDPI / 72.0
+	if size := math.Max(float64(rect.Dx()), float64(rect.Dy())) * scale; size > maxTileSize {
+		scale *= maxTileSize / size
+	}
+	pixels := image.Rect(0, 0, int(math.Ceil(float64(rect.Dx())*scale)), int(math.Ceil(float64(rect.Dy())*scale)))
+	m := ctm.Mult(transform.TranslationMatrix(-float64(rect.Min.X), -float64(rect.Min.Y))).
+		Mult(transform.ScaleMatrix(scale, scale))
+	img, err := rasterizeShading(shading, m, pixels, background)
+	if err != nil {
+		common.Log.Debug("Error rasterizing shading: %v", err)
+		if img == nil {
+			return "", rect
+		}
+	}
+	href, err := pngDataURI(img)
+	if err != nil {
+		common.Log.Debug("Unable to encode shading: %v", err)
+		return "", rect
+	}
+	return href, rect
+}
+
+// Resolution in pixels per inch of the images of shadings.
+const svgShadingDPI = 150
+
+// svgImageElement returns the image element of the image `href` drawn over `rect` with the
+// opacity `alpha`, masked by the mask element `maskID` if not empty.
+func svgImageElement(href string, rect image.Rectangle, alpha float64, maskID string) string {
+	var attrs strings.Builder
+	if alpha < 1 {
+		fmt.Fprintf(&attrs, ` opacity="%s"`, svgNumber(alpha))
+	}
+	if maskID != "" {
+		fmt.Fprintf(&attrs, ` mask="url(#%s)"`, maskID)
+	}
+	return fmt.Sprintf(`<image x="%d" y="%d" width="%d" height="%d" preserveAspectRatio="none" xlink:href="%s"%s/>`+"\n",
+		rect.Min.X, rect.Min.Y, rect.Dx(), rect.Dy(), href, attrs.String())
+}
+
+// svgPath is the path data of an SVG path element in user space.
+type svgPath struct {
+	d        strings.Builder
+	current  point
+	start    point // Start of the current subpath.
+	hasPoint bool
+
+	minX, minY, maxX, maxY float64 // Bounds of the points.
+}
+
+func newSVGPath() *svgPath {
+	return &svgPath{minX: math.Inf(1), minY: math.Inf(1), maxX: math.Inf(-1), maxY: math.Inf(-1)}
+}
+
+// add appends the command `cmd` with the points `pts`.
+func (p *svgPath) add(cmd byte, pts ...point) {
+	if p.d.Len() > 0 {
+		p.d.WriteByte(' ')
+	}
+	p.d.WriteByte(cmd)
+	for i, q := range pts {
+		if i > 0 {
+			p.d.WriteByte(' ')
+		}
+		p.d.WriteString(svgNumber(q.x))
+		p.d.WriteByte(' ')
+		p.d.WriteString(svgNumber(q.y))
+		p.minX, p.maxX = math.Min(p.minX, q.x), math.Max(p.maxX, q.x)
+		p.minY, p.maxY = math.Min(p.minY, q.y), math.Max(p.maxY, q.y)
+	}
+	if len(pts) > 0 {
+		p.current = pts[len(pts)-1]
+		p.hasPoint = true
+	}
+}
+
+func (p *svgPath) moveTo(x, y float64) {
+	p.add('M', point{x, y})
+	p.start = p.current
+}
+
+func (p *svgPath) lineTo(x, y float64) {
+	if !p.hasPoint {
+		p.moveTo(x, y)
+		return
+	}
+	p.add('L', point{x, y})
+}
+
+func (p *svgPath) curveTo(x1, y1, x2, y2, x3, y3 float64) {
+	if !p.hasPoint {
+		p.moveTo(x1, y1)
+	}
+	p.add('C', point{x1, y1}, point{x2, y2}, point{x3, y3})
+}
+
+func (p *svgPath) quadTo(x1, y1, x2, y2 float64) {
+	if !p.hasPoint {
+		p.moveTo(x1, y1)
+	}
+	// Quadratic curves are cubic curves with the control points at 2/3 of the way to the control
+	// point of the quadratic curve.
+	x0, y0 := p.current.x, p.current.y
+	p.curveTo(x0+2*(x1-x0)/3, y0+2*(y1-y0)/3, x2+2*(x1-x2)/3, y2+2*(y1-y2)/3, x2, y2)
+}
+
+func (p *svgPath) closePath() {
+	if !p.hasPoint {
+		return
+	}
+	p.add('Z')
+	p.current = p.start
+}
+
+func (p *svgPath) rect(x, y, w, h float64) {
+	p.moveTo(x, y)
+	p.lineTo(x+w, y)
+	p.lineTo(x+w, y+h)
+	p.lineTo(x, y+h)
+	p.closePath()
+}
+
+// empty returns true if the path has no points.
+func (p *svgPath) empty() bool {
+	return p.d.Len() == 0
+}
+
+// bounds returns the bounds in device space of the path, whose user space is mapped to device
+// space by `ctm`.
+func (p *svgPath) bounds(ctm transform.Matrix) image.Rectangle {
+	if p.empty() {
+		return image.Rectangle{}
+	}
+	llx, lly, urx, ury := ctm.TransformRect(p.minX, p.minY, p.maxX, p.maxY)
+	return image.Rect(int(math.Floor(llx)), int(math.Floor(lly)), int(math.Ceil(urx)), int(math.Ceil(ury)))
+}
+
+// element returns the path element of the path mapped to device space by `ctm`, with the
+// attributes `attrs`, or as a clipping path element with the even-odd rule if `clipEvenOdd`.
+func (p *svgPath) element(ctm transform.Matrix, clipEvenOdd bool, attrs string) string {
+	if clipEvenOdd {
+		attrs += ` clip-rule="evenodd"`
+	}
+	return fmt.Sprintf(`<path d="%s" transform="%s"%s/>`+"\n", p.d.String(), svgMatrix(ctm), attrs)
+}
+
+// svgNumber formats `v` for SVG attributes, with the precision of float32.
+func svgNumber(v float64) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return "0"
+	}
+	s := strconv.FormatFloat(float64(float32(v)), 'f', -1, 32)
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// svgMatrix formats `m` as an SVG transform.
+func svgMatrix(m transform.Matrix) string {
+	vals := make([]string, 6)
+	for i, v := range m {
+		vals[i] = svgNumber(v)
+	}
+	return "matrix(" + strings.Join(vals, " ") + ")"
+}
+
+// svgColor formats the RGB color `rgb` for SVG attributes.
+func svgColor(rgb [3]float64) string {
+	return fmt.Sprintf("#%02x%02x%02x", toByte(rgb[0]), toByte(rgb[1]), toByte(rgb[2]))
+}
@@ -0,0 +1,110 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+)
+
+// drawImage draws `img` with the graphics state `state`, mapped from the unit square to device
+// space by `ctm`.  The image elements of image XObjects `stream` are defined once, nil for inline
+// images.
+func (c *svgConverter) drawImage(img *rasterImage, stream *core.PdfObjectStream, ctm transform.Matrix, state svgState) {
+	if img.stencil && !state.fill.ok {
+		return
+	}
+	// Stencil masks painted with patterns are drawn in white to mask the pattern.
+	rgb := [3]float64{1, 1, 1}
+	if img.stencil && state.fill.pattern == nil {
+		rgb = state.fill.rgb
+	}
+	id := c.imageDef(img, stream, rgb)
+	if id == "" {
+		return
+	}
+	// The rows of image elements go down from the top of the unit square.
+	m := transform.NewMatrix(1, 0, 0, -1, 0, 1).Mult(ctm)
+	use := fmt.Sprintf(`<use xlink:href="#%s" transform="%s"`, id, svgMatrix(m))
+
+	if !img.stencil || state.fill.pattern == nil {
+		if state.fillAlpha <= 0 {
+			return
+		}
+		if state.fillAlpha < 1 {
+			use += fmt.Sprintf(` opacity="%s"`, svgNumber(state.fillAlpha))
+		}
+		c.out.element(state.groupAttrs(), use+"/>\n")
+		return
+	}
+
+	square := newSVGPath()
+	square.rect(0, 0, 1, 1)
+	if isShadingPaint(state.fill) {
+		c.paintShadingMask(use+"/>\n", square.bounds(ctm), state.fill, state.fillAlpha, &state)
+		return
+	}
+	attrs, ok := c.paintAttrs("fill", state.fill, state.fillAlpha, ctm)
+	if !ok {
+		return
+	}
+	maskID := c.newID("m")
+	fmt.Fprintf(&c.defs, `<mask id="%s">%s</mask>`+"\n", maskID, use+"/>")
+	c.out.element(state.groupAttrs(), fmt.Sprintf(`<g mask="url(#%s)">%s</g>`+"\n", maskID,
+		square.element(ctm, false, attrs)))
+}
+
+// imageDef returns the id of the image element of `img` of the unit square, stencil masks being
+// colored with `rgb`.  Returns an empty id if it cannot be encoded.
+func (c *svgConverter) imageDef(img *rasterImage, stream *core.PdfObjectStream, rgb [3]float64) string {
+	key := svgImageKey{stream: stream}
+	if img.stencil {
+		key.rgb = rgb
+	}
+	if stream != nil {
+		if id, cached := c.images[key]; cached {
+			return id
+		}
+	}
+
+	pix := img.pix
+	if img.stencil {
+		pix = image.NewNRGBA(img.pix.Rect)
+		r, g, b := toByte(rgb[0]), toByte(rgb[1]), toByte(rgb[2])
+		for i := 0; i < len(pix.Pix); i += 4 {
+			pix.Pix[i], pix.Pix[i+1], pix.Pix[i+2], pix.Pix[i+3] = r, g, b, img.pix.Pix[i+3]
+		}
+	}
+	id := ""
+	href, err := pngDataURI(pix)
+	if err != nil {
+		common.Log.Debug("Unable to encode image: %v", err)
+	} else {
+		id = c.newID("i")
+		fmt.Fprintf(&c.defs, `<image id="%s" width="1" height="1" preserveAspectRatio="none" xlink:href="%s"/>`+"\n",
+			id, href)
+	}
+	if stream != nil {
+		c.images[key] = id
+	}
+	return id
+}
+
+// pngDataURI returns `img` encoded as a PNG data URI.
+func pngDataURI(img image.Image) (string, error) {
+	var b bytes.Buffer
+	if err := png.Encode(&b, img); err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(b.Bytes()), nil
+}
@@ -0,0 +1,172 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/model"
+)
+
+// convertTestPage returns `page` converted to SVG, checking that it is well-formed XML.
+func convertTestPage(t *testing.T, page *model.PdfPage) string {
+	var b bytes.Buffer
+	if err := WritePageSVG(page, &b); err != nil {
+		t.Fatalf("Error converting page: %v", err)
+	}
+	decoder := xml.NewDecoder(bytes.NewReader(b.Bytes()))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Invalid SVG: %v\n%s", err, b.String())
+		}
+	}
+	return b.String()
+}
+
+// checkSVGContains checks that `svg` contains each of `expected`.
+func checkSVGContains(t *testing.T, svg string, expected ...string) {
+	for _, s := range expected {
+		if !strings.Contains(svg, s) {
+			t.Errorf("SVG does not contain %q:\n%s", s, svg)
+		}
+	}
+}
+
+func TestSVGPaths(t *testing.T) {
+	resources := model.NewPdfPageResources()
+	gsDict := core.MakeDict()
+	gsDict.Set("ca", core.MakeFloat(0.5))
+	gsDict.Set("BM", core.MakeName("Multiply"))
+	resources.AddExtGState("GS1", gsDict)
+
+	content := `
+1 0 0 rg 10 10 30 30 re f
+q 50 50 20 20 re W n 0 0 1 rg 0 0 100 100 re f* Q
+0 0 1 RG 2 w 1 J [4 4] 0 d 10 50 m 40 50 l S
+q /GS1 gs 0 g 80 10 10 10 re f Q
+`
+	svg := convertTestPage(t, newTestPage(t, 100, 200, content, resources))
+	checkSVGContains(t, svg,
+		`width="100pt" height="200pt" viewBox="0 0 100 200"`,
+		`<path d="M10 10 L40 10 L40 40 L10 40 Z" transform="matrix(1 0 0 -1 0 200)" fill="#ff0000"/>`,
+		`<clipPath id="c1" clipPathUnits="userSpaceOnUse"><path d="M50 50 L70 50 L70 70 L50 70 Z"`,
+		`<g clip-path="url(#c1)">`,
+		`fill="#0000ff" fill-rule="evenodd"`,
+		`stroke="#0000ff" stroke-width="2" stroke-linecap="round" stroke-miterlimit="10" stroke-dasharray="4 4"`,
+		`<g style="mix-blend-mode:multiply">`,
+		`fill="#000000" fill-opacity="0.5"`,
+	)
+
+	// Rotated pages are shown in their rotated box.
+	page := newTestPage(t, 200, 100, "1 0 0 rg 0 0 20 20 re f", model.NewPdfPageResources())
+	rotate := int64(90)
+	page.Rotate = &rotate
+	svg = convertTestPage(t, page)
+	checkSVGContains(t, svg, `viewBox="0 0 100 200"`, `transform="matrix(0 1 1 0 0 0)"`)
+}
+
+func TestSVGImagesAndText(t *testing.T) {
+	img := &model.Image{Width: 2, Height: 2, BitsPerComponent: 8, ColorComponents: 1, Data: []byte{0, 255, 255, 0}}
+	ximg, err := model.NewXObjectImageFromImage(img, nil, nil)
+	if err != nil {
+		t.Fatalf("Error creating image XObject: %v", err)
+	}
+	font, err := model.NewPdfFontFromTTFFile(testRobotoTTFFile)
+	if err != nil {
+		t.Fatalf("Error loading font: %v", err)
+	}
+	helvetica := core.MakeDict()
+	helvetica.Set("Type", core.MakeName("Font"))
+	helvetica.Set("Subtype", core.MakeName("Type1"))
+	helvetica.Set("BaseFont", core.MakeName("Helvetica-Bold"))
+
+	// Transparency group drawn half opaque.
+	group, err := core.MakeStream([]byte("0 1 0 rg 0 0 10 10 re f"), nil)
+	if err != nil {
+		t.Fatalf("Error creating stream: %v", err)
+	}
+	group.Set("Type", core.MakeName("XObject"))
+	group.Set("Subtype", core.MakeName("Form"))
+	group.Set("BBox", core.MakeArrayFromFloats([]float64{0, 0, 10, 10}))
+	groupDict := core.MakeDict()
+	groupDict.Set("S", core.MakeName("Transparency"))
+	group.Set("Group", groupDict)
+
+	resources := model.NewPdfPageResources()
+	resources.SetXObjectByName("Im1", ximg.ToPdfObject().(*core.PdfObjectStream))
+	resources.SetXObjectByName("Fm1", group)
+	resources.SetFontByName("F1", font.ToPdfObject())
+	resources.SetFontByName("F2", helvetica)
+	gsDict := core.MakeDict()
+	gsDict.Set("ca", core.MakeFloat(0.5))
+	resources.AddExtGState("GS1", gsDict)
+
+	content := `
+q 50 0 0 100 0 0 cm /Im1 Do Q
+BT /F1 20 Tf 10 10 Td (I) Tj ET
+BT /F2 10 Tf 10 50 Td (A&B) Tj ET
+q /GS1 gs /Fm1 Do Q
+`
+	svg := convertTestPage(t, newTestPage(t, 100, 100, content, resources))
+	checkSVGContains(t, svg,
+		`<image id="i1" width="1" height="1" preserveAspectRatio="none" xlink:href="data:image/png;base64,`,
+		`<use xlink:href="#i1" transform="matrix(50 0 0 100 0 0)"/>`,
+		`<use xlink:href="#g2" transform="matrix(0.02 0 0 -0.02 10 90)" fill="#000000"/>`,
+		`font-family="Helvetica, Arial, sans-serif" font-weight="bold" fill="#000000">A&amp;B</text>`,
+		`<g opacity="0.5">`,
+		`fill="#00ff00"`,
+	)
+	if !strings.Contains(svg, `<path id="g2" d="M`) {
+		t.Errorf("Glyph outline not defined")
+	}
+}
+
+func TestSVGPatterns(t *testing.T) {
+	resources := model.NewPdfPageResources()
+	shadingPattern := core.MakeDict()
+	shadingPattern.Set("PatternType", core.MakeInteger(2))
+	shadingPattern.Set("Shading", newTestAxialShading(0, 0, 50, 0))
+	resources.SetPatternByName("P1", core.MakeIndirectObject(shadingPattern))
+
+	cell, err := core.MakeStream([]byte("0 0 5 10 re f"), nil)
+	if err != nil {
+		t.Fatalf("Error creating stream: %v", err)
+	}
+	cell.Set("PatternType", core.MakeInteger(1))
+	cell.Set("PaintType", core.MakeInteger(2))
+	cell.Set("TilingType", core.MakeInteger(1))
+	cell.Set("BBox", core.MakeArrayFromFloats([]float64{0, 0, 10, 10}))
+	cell.Set("XStep", core.MakeFloat(10))
+	cell.Set("YStep", core.MakeFloat(10))
+	cell.Set("Resources", core.MakeDict())
+	resources.SetPatternByName("P2", cell)
+	resources.SetColorspaceByName("CS0", &model.PdfColorspaceSpecialPattern{UnderlyingCS: model.NewPdfColorspaceDeviceRGB()})
+
+	content := `
+/Pattern cs /P1 scn 0 0 50 50 re f
+/CS0 cs 1 0 0 /P2 scn 50 0 50 50 re f
+`
+	svg := convertTestPage(t, newTestPage(t, 100, 100, content, resources))
+	checkSVGContains(t, svg,
+		// The shading is an image masked by the filled path.
+		`<mask id="m1" maskUnits="userSpaceOnUse" x="0" y="50" width="50" height="50"><path d="M0 0 L50 0 L50 50 L0 50 Z" transform="matrix(1 0 0 -1 0 100)" fill="#ffffff"/>`,
+		`<image x="0" y="50" width="50" height="50" preserveAspectRatio="none" xlink:href="data:image/png;base64,`,
+		`mask="url(#m1)"/>`,
+		// The tiling pattern is painted in red, its pattern space being that of the page.
+		`<pattern id="p3" patternUnits="userSpaceOnUse" x="0" y="0" width="10" height="10" patternTransform="matrix(1 0 0 1 0 0)">`,
+		`<path d="M0 0 L5 0 L5 10 L0 10 Z" transform="matrix(1 0 0 1 0 0)" fill="#ff0000"/>`,
+		`fill="url(#p3)"`,
+	)
+}
@@ -0,0 +1,245 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+)
+
+// Units by em of the path elements of glyph outlines, to keep their coordinates readable.
+const svgGlyphUnits = 1000
+
+// showText converts the glyphs of the character codes `data` with the graphics state `state` and
+// `ctm` according to the text rendering mode, and advances the text matrix.  Glyphs of fonts
+// without embedded font program are converted to text elements, the others to their outlines.
+func (c *svgConverter) showText(data []byte, state *svgState, ctm transform.Matrix,
+	resources *model.PdfPageResources, textClip *[]string) {
+	font := state.text.font
+	mode := state.text.renderMode
+	if font != nil && font.font != nil && !font.embedded && !font.type3 {
+		if _, simple := font.font.CharcodeBytesToUnicode(nil); simple {
+			c.showTextElement(data, state, ctm, textClip)
+			return
+		}
+	}
+
+	state.text.showGlyphs(data, func(code []byte, val uint64, trm transform.Matrix) {
+		if font.type3 {
+			if mode != 3 && mode != 7 {
+				c.showType3Glyph(font, val, trm.Mult(ctm), *state, resources)
+			}
+			return
+		}
+		if mode == 3 {
+			return
+		}
+		id := c.glyph(font, val)
+		if id == "" {
+			return
+		}
+		m := transform.ScaleMatrix(1.0/svgGlyphUnits, 1.0/svgGlyphUnits).Mult(trm).Mult(ctm)
+		use := fmt.Sprintf(`<use xlink:href="#%s" transform="%s"`, id, svgMatrix(m))
+		unit := deviceScale(transform.ScaleMatrix(1.0/svgGlyphUnits, 1.0/svgGlyphUnits).Mult(trm))
+		c.paintText(use, "/>\n", m, unit, mode, state)
+		if mode >= 4 {
+			*textClip = append(*textClip, use+"/>\n")
+		}
+	})
+}
+
+// paintText fills and strokes according to the text rendering mode `mode` the element opened by
+// `open` and closed by `closing`, whose user space is mapped to device space by `m` and has `unit`
+// units of the user space of the text.
+func (c *svgConverter) paintText(open, closing string, m transform.Matrix, unit float64, mode int, state *svgState) {
+	fill := mode == 0 || mode == 2 || mode == 4 || mode == 6
+	stroke := mode == 1 || mode == 2 || mode == 5 || mode == 6
+	if !fill && !stroke {
+		return
+	}
+
+	var attrs strings.Builder
+	painted := false
+	if fill && !isShadingPaint(state.fill) {
+		if a, ok := c.paintAttrs("fill", state.fill, state.fillAlpha, m); ok {
+			attrs.WriteString(a)
+			painted = true
+		}
+	}
+	if !painted {
+		attrs.WriteString(` fill="none"`)
+	}
+	if stroke && !isShadingPaint(state.stroke) {
+		if a, ok := c.paintAttrs("stroke", state.stroke, state.strokeAlpha, m); ok {
+			attrs.WriteString(a)
+			attrs.WriteString(strokeAttrs(state.style, unit))
+			painted = true
+		}
+	}
+	if painted {
+		c.out.element(state.groupAttrs(), open+attrs.String()+closing)
+	}
+
+	// Shadings are painted as images masked by the glyphs, whose bounds are not known.
+	if fill && isShadingPaint(state.fill) {
+		c.paintShadingMask(open+` fill="#ffffff"`+closing, state.clipRect, state.fill, state.fillAlpha, state)
+	}
+	if stroke && isShadingPaint(state.stroke) {
+		c.paintShadingMask(open+` fill="none" stroke="#ffffff"`+strokeAttrs(state.style, unit)+closing,
+			state.clipRect, state.stroke, state.strokeAlpha, state)
+	}
+}
+
+// showTextElement converts the glyphs of the character codes `data` of a simple font without
+// embedded font program to a text element in a similar generic font, and advances the text
+// matrix.
+func (c *svgConverter) showTextElement(data []byte, state *svgState, ctm transform.Matrix, textClip *[]string) {
+	font := state.text.font
+	mode := state.text.renderMode
+	var first transform.Matrix // Text space of the first glyph to user space.
+	var xs []string
+	var text bytes.Buffer
+	state.text.showGlyphs(data, func(code []byte, val uint64, trm transform.Matrix) {
+		if len(xs) == 0 {
+			first = trm
+		}
+		str, _ := font.font.CharcodeBytesToUnicode(code)
+		runes := []rune(str)
+		if len(runes) == 0 {
+			return
+		}
+		// The glyphs are moved along the baseline of the first one in text space.
+		var x float64
+		if first[0] != 0 || first[1] != 0 {
+			x = (trm[4]-first[4])*first[0] + (trm[5]-first[5])*first[1]
+			x /= first[0]*first[0] + first[1]*first[1]
+		}
+		var w0 float64
+		if metrics, found := font.font.GetCharMetrics(val); found {
+			w0 = metrics.Wx / 1000
+		}
+		for i, r := range runes {
+			xs = append(xs, svgNumber(x+w0*float64(i)/float64(len(runes))))
+			xml.EscapeText(&text, []byte(string(r)))
+		}
+	})
+	if len(xs) == 0 || mode == 3 || mode == 7 {
+		return
+	}
+
+	// Text elements are drawn in a y-down space.
+	m := transform.ScaleMatrix(1, -1).Mult(first).Mult(ctm)
+	family, weight, style := svgFontFamily(font.baseFont)
+	elem := fmt.Sprintf(`<text xml:space="preserve" transform="%s" x="%s" y="0" font-size="1" font-family="%s"`,
+		svgMatrix(m), strings.Join(xs, " "), family)
+	if weight != "" {
+		elem += fmt.Sprintf(` font-weight="%s"`, weight)
+	}
+	if style != "" {
+		elem += fmt.Sprintf(` font-style="%s"`, style)
+	}
+	closing := ">" + text.String() + "</text>\n"
+
+	c.paintText(elem, closing, m, deviceScale(first), mode, state)
+	if mode >= 4 {
+		*textClip = append(*textClip, elem+closing)
+	}
+}
+
+// svgFontFamily returns the font-family, font-weight and font-style of text shown with the font
+// `baseFont`, emulating the standard fonts.
+func svgFontFamily(baseFont string) (family, weight, style string) {
+	name := strings.ToLower(baseFont)
+	switch {
+	case strings.Contains(name, "courier") || strings.Contains(name, "mono"):
+		family = "'Courier New', Courier, monospace"
+	case strings.Contains(name, "times") || strings.Contains(name, "serif") && !strings.Contains(name, "sans"):
+		family = "'Times New Roman', Times, serif"
+	case strings.Contains(name, "symbol"):
+		family = "Symbol"
+	case strings.Contains(name, "dingbats"):
+		family = "'Zapf Dingbats', ZapfDingbats"
+	default:
+		family = "Helvetica, Arial, sans-serif"
+	}
+	if strings.Contains(name, "bold") || strings.Contains(name, "black") || strings.Contains(name, "heavy") {
+		weight = "bold"
+	}
+	if strings.Contains(name, "italic") || strings.Contains(name, "oblique") {
+		style = "italic"
+	}
+	return family, weight, style
+}
+
+// glyph returns the id of the path element of the outline of the glyph of `code` of `font`, in
+// `svgGlyphUnits` by em.  Returns an empty id if the glyph has no outline.
+func (c *svgConverter) glyph(font *renderFont, code uint64) string {
+	key := svgGlyphKey{font: font, code: code}
+	if id, cached := c.glyphs[key]; cached {
+		return id
+	}
+	outline := font.outline(code)
+	if len(outline) == 0 {
+		c.glyphs[key] = ""
+		return ""
+	}
+	p := newSVGPath()
+	for _, seg := range outline {
+		var pts [3]point
+		for i, q := range seg.Points {
+			pts[i] = point{q.X * svgGlyphUnits, q.Y * svgGlyphUnits}
+		}
+		switch seg.Op {
+		case fonts.OutlineMoveTo:
+			p.moveTo(pts[0].x, pts[0].y)
+		case fonts.OutlineLineTo:
+			p.lineTo(pts[0].x, pts[0].y)
+		case fonts.OutlineQuadTo:
+			p.quadTo(pts[0].x, pts[0].y, pts[1].x, pts[1].y)
+		case fonts.OutlineCubicTo:
+			p.curveTo(pts[0].x, pts[0].y, pts[1].x, pts[1].y, pts[2].x, pts[2].y)
+		case fonts.OutlineClose:
+			p.closePath()
+		}
+	}
+	id := c.newID("g")
+	fmt.Fprintf(&c.defs, `<path id="%s" d="%s"/>`+"\n", id, p.d.String())
+	c.glyphs[key] = id
+	return id
+}
+
+// showType3Glyph converts the glyph description of `code` of the Type3 font `font`, whose text
+// space is mapped to device space by `trm`.  Glyph descriptions without resources use the
+// resources `resources` of the content stream showing the text.
+func (c *svgConverter) showType3Glyph(font *renderFont, code uint64, trm transform.Matrix, state svgState,
+	resources *model.PdfPageResources) {
+	if c.depth >= maxType3Depth {
+		common.Log.Debug("Type3 glyphs nested too deeply")
+		return
+	}
+	content, fontMatrix, glyphResources, ok := font.font.GetType3Glyph(code)
+	if !ok {
+		return
+	}
+	if glyphResources == nil {
+		glyphResources = resources
+	}
+	m := fontMatrix
+	state.text = textState{horizScaling: 1}
+	c.depth++
+	err := c.convert(string(content), glyphResources, transform.NewMatrix(m[0], m[1], m[2], m[3], m[4], m[5]).Mult(trm), state)
+	c.depth--
+	if err != nil {
+		common.Log.Debug("Error converting Type3 glyph of code %d: %v", code, err)
+	}
+}
@@ -0,0 +1,363 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/unidoc/unidoc/common"
+	"github.com/unidoc/unidoc/pdf/contentstream"
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/cmap"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+	"github.com/unidoc/unidoc/pdf/model"
+	"github.com/unidoc/unidoc/pdf/model/fonts"
+)
+
+// Maximum nesting of Type3 glyph descriptions showing text in Type3 fonts.
+const maxType3Depth = 4
+
+// textState is the text state (9.3 "Text State Parameters and Operators") with the text matrices.
+type textState struct {
+	charSpacing  float64 // Tc
+	wordSpacing  float64 // Tw
+	horizScaling float64 // Tz / 100
+	leading      float64 // TL
+	rise         float64 // Ts
+	renderMode   int     // Tr
+	fontSize     float64 // Tf
+	font         *renderFont
+
+	tm, tlm transform.Matrix // Text matrix and text line matrix.
+}
+
+// renderFont is a font of shown text with the outlines of its glyphs.
+type renderFont struct {
+	font *model.PdfFont // nil if not loaded.
+
+	// CMap splitting the codes of composite fonts by its codespace ranges, nil for simple fonts.
+	encoding *cmap.CMap
+	type3    bool
+	baseFont string // BaseFont without subset tag.
+	embedded bool   // True if the font program is embedded.
+
+	outlines map[uint64]fonts.GlyphOutline // By character code, nil if not found.
+}
+
+// set sets the text state parameter or text position set by `op`, loading the fonts of
+// `resources` once in `fontCache`.
+func (text *textState) set(op *contentstream.ContentStreamOperation, resources *model.PdfPageResources,
+	fontCache map[core.PdfObject]*renderFont) {
+	if op.Operand == "T*" {
+		text.nextLine()
+		return
+	}
+	if op.Operand == "Tf" {
+		if len(op.Params) != 2 {
+			common.Log.Debug("Error Tf should only get 2 input params, got %d", len(op.Params))
+			return
+		}
+		name, ok := op.Params[0].(*core.PdfObjectName)
+		if !ok {
+			common.Log.Debug("Error Tf font input not a name")
+			return
+		}
+		size, err := getNumberAsFloat(op.Params[1])
+		if err != nil {
+			common.Log.Debug("Invalid Tf font size: %v", err)
+			return
+		}
+		text.fontSize = size
+		text.font = loadRenderFont(resources, *name, fontCache)
+		return
+	}
+
+	// Operators with numeric operands.
+	vals, err := getNumbersAsFloat(op.Params)
+	if err != nil {
+		common.Log.Debug("Invalid %s operands: %v", op.Operand, err)
+		return
+	}
+	switch op.Operand {
+	case "Tc", "Tw", "Tz", "TL", "Ts", "Tr":
+		if len(vals) != 1 {
+			return
+		}
+		switch op.Operand {
+		case "Tc":
+			text.charSpacing = vals[0]
+		case "Tw":
+			text.wordSpacing = vals[0]
+		case "Tz":
+			text.horizScaling = vals[0] / 100
+		case "TL":
+			text.leading = vals[0]
+		case "Ts":
+			text.rise = vals[0]
+		case "Tr":
+			text.renderMode = int(vals[0])
+		}
+	case "Td", "TD":
+		if len(vals) != 2 {
+			return
+		}
+		if op.Operand == "TD" {
+			text.leading = -vals[1]
+		}
+		text.tlm = transform.TranslationMatrix(vals[0], vals[1]).Mult(text.tlm)
+		text.tm = text.tlm
+	case "Tm":
+		if len(vals) != 6 {
+			return
+		}
+		text.tlm = transform.NewMatrix(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5])
+		text.tm = text.tlm
+	}
+}
+
+// showTextOp calls `show` with the strings shown by the text showing operator `op` (Tj, TJ, ' or
+// "), after moving to the next line and setting the spacing of ' and ", and applying the position
+// adjustments of TJ.
+func (text *textState) showTextOp(op *contentstream.ContentStreamOperation, show func(data []byte)) {
+	if len(op.Params) < 1 {
+		return
+	}
+	switch op.Operand {
+	case "TJ":
+		arr, ok := op.Params[0].(*core.PdfObjectArray)
+		if !ok {
+			common.Log.Debug("Invalid parameter type, no array (%T)", op.Params[0])
+			return
+		}
+		for _, obj := range *arr {
+			if str, ok := obj.(*core.PdfObjectString); ok {
+				show([]byte(*str))
+				continue
+			}
+			adjustment, err := getNumberAsFloat(obj)
+			if err != nil {
+				common.Log.Debug("Invalid TJ element (%T)", obj)
+				continue
+			}
+			tx := -adjustment / 1000 * text.fontSize * text.horizScaling
+			text.tm = transform.TranslationMatrix(tx, 0).Mult(text.tm)
+		}
+		return
+	case "\"":
+		if len(op.Params) != 3 {
+			return
+		}
+		vals, err := getNumbersAsFloat(op.Params[:2])
+		if err != nil {
+			return
+		}
+		text.wordSpacing, text.charSpacing = vals[0], vals[1]
+	}
+	if op.Operand != "Tj" {
+		text.nextLine()
+	}
+	str, ok := op.Params[len(op.Params)-1].(*core.PdfObjectString)
+	if !ok {
+		common.Log.Debug("Invalid parameter type, not string (%T)", op.Params[len(op.Params)-1])
+		return
+	}
+	show([]byte(*str))
+}
+
+// nextLine moves to the start of the next line, as T*.
+func (text *textState) nextLine() {
+	text.tlm = transform.TranslationMatrix(0, -text.leading).Mult(text.tlm)
+	text.tm = text.tlm
+}
+
+// showGlyphs calls `visit` with the character codes `data` of the current font, with the
+// transformation of text space to user space of each, and advances the text matrix by their
+// widths.  Nothing is shown without a loaded font.
+func (text *textState) showGlyphs(data []byte, visit func(code []byte, val uint64, trm transform.Matrix)) {
+	font := text.font
+	if font == nil || font.font == nil {
+		common.Log.Debug("Text shown without font")
+		return
+	}
+
+	var codes [][]byte
+	if font.encoding != nil {
+		codes = font.encoding.SplitCharcodes(data)
+	} else {
+		for i := range data {
+			codes = append(codes, data[i:i+1])
+		}
+	}
+	for _, code := range codes {
+		var val uint64
+		for _, b := range code {
+			val = val<<8 | uint64(b)
+		}
+
+		// Text space to user space (9.4.4 "Text Space Details").
+		trm := transform.NewMatrix(text.fontSize*text.horizScaling, 0, 0, text.fontSize, 0, text.rise).Mult(text.tm)
+		visit(code, val, trm)
+
+		var w0 float64
+		if metrics, found := font.font.GetCharMetrics(val); found {
+			w0 = metrics.Wx / 1000
+		}
+		spacing := text.charSpacing
+		if len(code) == 1 && code[0] == ' ' {
+			spacing += text.wordSpacing
+		}
+		tx := (w0*text.fontSize + spacing) * text.horizScaling
+		text.tm = transform.TranslationMatrix(tx, 0).Mult(text.tm)
+	}
+}
+
+// showText paints the glyphs of the character codes `data` with the graphics state `state` and
+// `ctm` according to the text rendering mode, and advances the text matrix.
+func (r *renderer) showText(data []byte, state *renderState, ctm transform.Matrix,
+	resources *model.PdfPageResources, textClip *polygon) {
+	bounds := r.img.Rect
+	if state.clip != nil {
+		bounds = bounds.Intersect(state.clip.rect)
+	}
+	font := state.text.font
+	mode := state.text.renderMode
+	state.text.showGlyphs(data, func(code []byte, val uint64, trm transform.Matrix) {
+		if font.type3 {
+			if mode != 3 && mode != 7 {
+				r.showType3Glyph(font, val, trm.Mult(ctm), *state, resources)
+			}
+			return
+		}
+		outline := font.outline(val)
+		if len(outline) == 0 || mode == 3 {
+			return
+		}
+		p := newPath(ctm)
+		p.appendOutline(outline, trm)
+		switch mode {
+		case 0, 2, 4, 6:
+			r.paintMask(rasterize(p.fillPolygon(ctm), false, bounds), state.fill, state.fillAlpha, state.clip)
+		}
+		switch mode {
+		case 1, 2, 5, 6:
+			r.paintMask(rasterize(strokePolygon(p, state.style, ctm), false, bounds), state.stroke, state.strokeAlpha, state.clip)
+		}
+		if mode >= 4 {
+			*textClip = append(*textClip, p.fillPolygon(ctm)...)
+		}
+	})
+}
+
+// showType3Glyph renders the glyph description of `code` of the Type3 font `font`, whose text space
+// is mapped to device space by `trm`.  Glyph descriptions without resources use the resources
+// `resources` of the content stream showing the text.
+func (r *renderer) showType3Glyph(font *renderFont, code uint64, trm transform.Matrix, state renderState,
+	resources *model.PdfPageResources) {
+	if r.depth >= maxType3Depth {
+		common.Log.Debug("Type3 glyphs nested too deeply")
+		return
+	}
+	content, fontMatrix, glyphResources, ok := font.font.GetType3Glyph(code)
+	if !ok {
+		return
+	}
+	if glyphResources == nil {
+		glyphResources = resources
+	}
+	m := fontMatrix
+	state.text = textState{horizScaling: 1}
+	r.depth++
+	err := r.render(string(content), glyphResources, transform.NewMatrix(m[0], m[1], m[2], m[3], m[4], m[5]).Mult(trm), state)
+	r.depth--
+	if err != nil {
+		common.Log.Debug("Error rendering Type3 glyph of code %d: %v", code, err)
+	}
+}
+
+// outline returns the outline of the glyph of `code` in text space units, or nil if none.
+func (font *renderFont) outline(code uint64) fonts.GlyphOutline {
+	outline, cached := font.outlines[code]
+	if !cached {
+		outline, _ = font.font.GetGlyphOutline(code)
+		font.outlines[code] = outline
+	}
+	return outline
+}
+
+// loadRenderFont returns the font `name` of `resources`, loaded once by font dictionary in
+// `cache`.  Returns nil if not found.
+func loadRenderFont(resources *model.PdfPageResources, name core.PdfObjectName, cache map[core.PdfObject]*renderFont) *renderFont {
+	if resources == nil {
+		return nil
+	}
+	fontObj, found := resources.GetFontByName(name)
+	if !found {
+		common.Log.Debug("Font %s not in resources", name)
+		return nil
+	}
+	if font, has := cache[fontObj]; has {
+		return font
+	}
+
+	font := &renderFont{outlines: map[uint64]fonts.GlyphOutline{}}
+	cache[fontObj] = font
+
+	fontDict, ok := core.TraceToDirectObject(fontObj).(*core.PdfObjectDictionary)
+	if !ok {
+		common.Log.Debug("Font %s not a dictionary (%T)", name, fontObj)
+		return font
+	}
+	if name, ok := core.TraceToDirectObject(fontDict.Get("BaseFont")).(*core.PdfObjectName); ok {
+		font.baseFont = string(*name)
+		if i := strings.IndexByte(font.baseFont, '+'); i == 6 {
+			font.baseFont = font.baseFont[i+1:]
+		}
+	}
+	if subtype, ok := core.TraceToDirectObject(fontDict.Get("Subtype")).(*core.PdfObjectName); ok {
+		switch *subtype {
+		case "Type0":
+			font.encoding = loadEncodingCMap(fontDict)
+		case "Type3":
+			font.type3 = true
+		}
+	}
+
+	f, err := model.NewPdfFontFromPdfObject(fontObj)
+	if err != nil {
+		common.Log.Debug("Unable to load font %s: %v", name, err)
+		return font
+	}
+	font.font = f
+	if descriptor := f.GetFontDescriptor(); descriptor != nil {
+		font.embedded = descriptor.FontFile != nil || descriptor.FontFile2 != nil || descriptor.FontFile3 != nil
+	}
+	return font
+}
+
+// loadEncodingCMap returns the CMap of the Encoding of the Type0 font `fontDict`, giving the
+// lengths of its character codes.  Defaults to Identity-H.
+func loadEncodingCMap(fontDict *core.PdfObjectDictionary) *cmap.CMap {
+	var cm *cmap.CMap
+	var err error
+	switch t := core.TraceToDirectObject(fontDict.Get("Encoding")).(type) {
+	case *core.PdfObjectName:
+		cm, err = cmap.LoadPredefinedCmap(string(*t))
+	case *core.PdfObjectStream:
+		var decoded []byte
+		decoded, err = core.DecodeStream(t)
+		if err == nil {
+			cm, err = cmap.LoadCmapFromData(decoded)
+		}
+	default:
+		err = errors.New("Encoding missing")
+	}
+	if err != nil {
+		common.Log.Debug("Unable to load the encoding CMap, assuming Identity-H: %v", err)
+		cm, _ = cmap.LoadPredefinedCmap("Identity-H")
+	}
+	return cm
+}
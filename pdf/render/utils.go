@@ -0,0 +1,63 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"errors"
+
+	"github.com/unidoc/unidoc/pdf/core"
+	"github.com/unidoc/unidoc/pdf/internal/transform"
+)
+
+// getNumberAsFloat can retrieve numeric values from PdfObject (both integer/float).
+func getNumberAsFloat(obj core.PdfObject) (float64, error) {
+	if fObj, ok := obj.(*core.PdfObjectFloat); ok {
+		return float64(*fObj), nil
+	}
+
+	if iObj, ok := obj.(*core.PdfObjectInteger); ok {
+		return float64(*iObj), nil
+	}
+
+	return 0, errors.New("Not a number")
+}
+
+// getNumbersAsFloat retrieves the numeric values of `objs` (both integer/float).
+func getNumbersAsFloat(objs []core.PdfObject) ([]float64, error) {
+	vals := make([]float64, 0, len(objs))
+	for _, obj := range objs {
+		val, err := getNumberAsFloat(obj)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
+
+// toFloats returns the numbers of the array `obj`, or nil if not an array.
+func toFloats(obj core.PdfObject) ([]float64, error) {
+	arr, ok := core.TraceToDirectObject(obj).(*core.PdfObjectArray)
+	if !ok {
+		return nil, errors.New("Type check error")
+	}
+	return arr.ToFloat64Array()
+}
+
+// toMatrix returns the transformation of the Matrix array `obj`, or the identity if nil.
+func toMatrix(obj core.PdfObject) (transform.Matrix, error) {
+	if obj == nil {
+		return transform.IdentityMatrix(), nil
+	}
+	vals, err := toFloats(obj)
+	if err != nil {
+		return transform.Matrix{}, err
+	}
+	if len(vals) != 6 {
+		return transform.Matrix{}, errors.New("Range check error")
+	}
+	return transform.NewMatrix(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5]), nil
+}